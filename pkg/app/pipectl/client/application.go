@@ -31,6 +31,7 @@ func SyncApplication(
 	ctx context.Context,
 	cli apiservice.Client,
 	appID string,
+	destroy bool,
 	checkInterval, timeout time.Duration,
 	logger *zap.Logger,
 ) (string, error) {
@@ -39,6 +40,7 @@ func SyncApplication(
 
 	req := &apiservice.SyncApplicationRequest{
 		ApplicationId: appID,
+		Destroy:       destroy,
 	}
 	resp, err := cli.SyncApplication(ctx, req)
 	if err != nil {
@@ -31,6 +31,7 @@ type sync struct {
 	root *command
 
 	appID         string
+	destroy       bool
 	statuses      []string
 	checkInterval time.Duration
 	timeout       time.Duration
@@ -49,6 +50,7 @@ func newSyncCommand(root *command) *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&c.appID, "app-id", c.appID, "The application ID.")
+	cmd.Flags().BoolVar(&c.destroy, "destroy", c.destroy, "Whether to destroy all resources managed by the application through the approval-gated destroy pipeline. Only Terraform application is supported.")
 	cmd.Flags().StringSliceVar(&c.statuses, "wait-status", c.statuses, fmt.Sprintf("The list of waiting statuses. Empty means returning immediately after triggered. (%s)", strings.Join(model.DeploymentStatusStrings(), "|")))
 	cmd.Flags().DurationVar(&c.checkInterval, "check-interval", c.checkInterval, "The interval of checking the requested command.")
 	cmd.Flags().DurationVar(&c.timeout, "timeout", c.timeout, "Maximum execution time.")
@@ -70,7 +72,7 @@ func (c *sync) run(ctx context.Context, input cli.Input) error {
 	}
 	defer cli.Close()
 
-	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.checkInterval, c.timeout, input.Logger)
+	deploymentID, err := client.SyncApplication(ctx, cli, c.appID, c.destroy, c.checkInterval, c.timeout, input.Logger)
 	if err != nil {
 		return err
 	}
@@ -15,6 +15,7 @@
 package factory
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/datadog"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/prometheus"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/stackdriver"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -89,6 +91,21 @@ func NewProvider(analysisTempCfg *config.TemplatableAnalysisMetrics, providerCfg
 			options = append(options, datadog.WithAddress(cfg.Address))
 		}
 		return datadog.NewProvider(apiKey, applicationKey, options...)
+	case model.AnalysisProviderStackdriver:
+		var sa []byte
+		cfg := providerCfg.StackdriverConfig
+		if cfg.ServiceAccountFile != "" {
+			var err error
+			sa, err = os.ReadFile(cfg.ServiceAccountFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read the service account file: %w", err)
+			}
+		}
+		options := []prometheus.Option{
+			prometheus.WithLogger(logger),
+			prometheus.WithTimeout(analysisTempCfg.Timeout.Duration()),
+		}
+		return stackdriver.NewProvider(context.Background(), sa, cfg.ProjectID, options...)
 	default:
 		return nil, fmt.Errorf("any of providers config not found")
 	}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"net/http"
 	"time"
 
 	"github.com/prometheus/client_golang/api"
@@ -43,6 +44,8 @@ type Provider struct {
 	api      client
 	username string
 	password string
+	// The transport used to send requests, api.DefaultRoundTripper is used when it is nil.
+	roundTripper http.RoundTripper
 
 	timeout time.Duration
 	logger  *zap.Logger
@@ -64,8 +67,13 @@ func NewProvider(address string, opts ...Option) (*Provider, error) {
 	cfg := api.Config{
 		Address: address,
 	}
+	rt := api.DefaultRoundTripper
+	if p.roundTripper != nil {
+		rt = p.roundTripper
+		cfg.RoundTripper = rt
+	}
 	if p.username != "" && p.password != "" {
-		cfg.RoundTripper = config.NewBasicAuthRoundTripper(p.username, config.Secret(p.password), "", api.DefaultRoundTripper)
+		cfg.RoundTripper = config.NewBasicAuthRoundTripper(p.username, config.Secret(p.password), "", rt)
 	}
 	client, err := api.NewClient(cfg)
 	if err != nil {
@@ -96,6 +104,14 @@ func WithBasicAuth(username, password string) Option {
 	}
 }
 
+// WithRoundTripper sets the transport used to send requests,
+// e.g. to authenticate them against a managed Prometheus-compatible API.
+func WithRoundTripper(rt http.RoundTripper) Option {
+	return func(p *Provider) {
+		p.roundTripper = rt
+	}
+}
+
 func (p *Provider) Type() string {
 	return ProviderType
 }
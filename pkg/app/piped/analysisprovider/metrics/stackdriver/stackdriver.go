@@ -0,0 +1,88 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackdriver
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/api"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/google"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/prometheus"
+)
+
+const (
+	ProviderType = "StackdriverMonitoring"
+
+	monitoringReadScope = "https://www.googleapis.com/auth/monitoring.read"
+)
+
+// Provider is a client for Cloud Monitoring.
+// The queries are written in PromQL and run through the Prometheus-compatible API of Cloud Monitoring,
+// e.g. sum(rate(run_googleapis_com:request_count{monitored_resource="cloud_run_revision"}[1m])).
+type Provider struct {
+	prometheus *prometheus.Provider
+}
+
+// NewProvider creates a provider which queries the metrics of the given project.
+// The project of the service account is used when the project ID is empty,
+// and the application default credentials are used when the service account is empty.
+func NewProvider(ctx context.Context, serviceAccount []byte, projectID string, opts ...prometheus.Option) (*Provider, error) {
+	var (
+		creds *google.Credentials
+		err   error
+	)
+	if len(serviceAccount) > 0 {
+		creds, err = google.CredentialsFromJSON(ctx, serviceAccount, monitoringReadScope)
+	} else {
+		creds, err = google.FindDefaultCredentials(ctx, monitoringReadScope)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the credentials: %w", err)
+	}
+
+	if projectID == "" {
+		projectID = creds.ProjectID
+	}
+	if projectID == "" {
+		return nil, fmt.Errorf("project id is required")
+	}
+
+	opts = append(opts, prometheus.WithRoundTripper(&oauth2.Transport{
+		Source: creds.TokenSource,
+		Base:   api.DefaultRoundTripper,
+	}))
+	p, err := prometheus.NewProvider(makeAddress(projectID), opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{prometheus: p}, nil
+}
+
+func (p *Provider) Type() string {
+	return ProviderType
+}
+
+func (p *Provider) QueryPoints(ctx context.Context, query string, queryRange metrics.QueryRange) ([]metrics.DataPoint, error) {
+	return p.prometheus.QueryPoints(ctx, query, queryRange)
+}
+
+// makeAddress returns the address of the Prometheus-compatible API for the given project.
+func makeAddress(projectID string) string {
+	return fmt.Sprintf("https://monitoring.googleapis.com/v1/projects/%s/location/global/prometheus", projectID)
+}
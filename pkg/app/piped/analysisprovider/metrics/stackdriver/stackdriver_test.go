@@ -0,0 +1,76 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stackdriver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewProvider(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		serviceAccount string
+		projectID      string
+		wantErr        bool
+	}{
+		{
+			name:           "project of the service account",
+			serviceAccount: `{"type": "service_account", "project_id": "test-project", "client_email": "piped@test-project.iam.gserviceaccount.com"}`,
+			wantErr:        false,
+		},
+		{
+			name:           "specified project",
+			serviceAccount: `{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`,
+			projectID:      "test-project",
+			wantErr:        false,
+		},
+		{
+			name:           "missing project",
+			serviceAccount: `{"type": "authorized_user", "client_id": "id", "client_secret": "secret", "refresh_token": "token"}`,
+			wantErr:        true,
+		},
+		{
+			name:           "malformed service account",
+			serviceAccount: `{`,
+			projectID:      "test-project",
+			wantErr:        true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			p, err := NewProvider(context.Background(), []byte(tc.serviceAccount), tc.projectID)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if err == nil {
+				require.NotNil(t, p)
+				assert.Equal(t, ProviderType, p.Type())
+			}
+		})
+	}
+}
+
+func TestMakeAddress(t *testing.T) {
+	t.Parallel()
+
+	got := makeAddress("test-project")
+	assert.Equal(t, "https://monitoring.googleapis.com/v1/projects/test-project/location/global/prometheus", got)
+}
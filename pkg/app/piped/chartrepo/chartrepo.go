@@ -18,7 +18,10 @@ package chartrepo
 import (
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"strings"
 
 	"go.uber.org/zap"
 	"golang.org/x/sync/singleflight"
@@ -26,7 +29,11 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
-var updateGroup = &singleflight.Group{}
+var (
+	updateGroup = &singleflight.Group{}
+	// registryConfigDir is the directory to store the registry config files of the OCI chart repositories.
+	registryConfigDir = filepath.Join(os.TempDir(), "piped-helm-registry")
+)
 
 type registry interface {
 	Helm(ctx context.Context, version string) (string, bool, error)
@@ -60,6 +67,59 @@ func Add(ctx context.Context, repos []config.HelmChartRepository, reg registry,
 	return nil
 }
 
+// LoginOCI logs in to the registries of all specified OCI chart repositories.
+// The credentials are stored into the registry config file of each repository
+// so that the repositories hosted on the same registry can use different credentials.
+// https://helm.sh/docs/topics/registries/
+// helm registry login ghcr.io --username my-username --password-stdin --registry-config /path/to/config.json
+func LoginOCI(ctx context.Context, repos []config.HelmChartRepository, reg registry, logger *zap.Logger) error {
+	helm, _, err := reg.Helm(ctx, "")
+	if err != nil {
+		return fmt.Errorf("failed to find helm to login to OCI registries (%w)", err)
+	}
+	if err := os.MkdirAll(registryConfigDir, 0700); err != nil {
+		return fmt.Errorf("failed to create directory for registry config files (%w)", err)
+	}
+
+	for _, repo := range repos {
+		if repo.Username == "" && repo.Password == "" {
+			continue
+		}
+		args := []string{
+			"registry", "login", registryHost(repo.Address),
+			"--username", repo.Username,
+			"--password-stdin",
+			"--registry-config", RegistryConfigPath(repo.Name),
+		}
+		if repo.Insecure {
+			args = append(args, "--insecure")
+		}
+		cmd := exec.CommandContext(ctx, helm, args...)
+		cmd.Stdin = strings.NewReader(repo.Password)
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			return fmt.Errorf("failed to login to OCI chart repository %s: %s (%w)", repo.Name, string(out), err)
+		}
+		logger.Info(fmt.Sprintf("successfully logged in to OCI chart repository: %s", repo.Name))
+	}
+	return nil
+}
+
+// RegistryConfigPath returns the path to the registry config file of the given OCI chart repository.
+func RegistryConfigPath(name string) string {
+	return filepath.Join(registryConfigDir, name+".json")
+}
+
+// registryHost returns the host of the given OCI chart repository address.
+// e.g. oci://ghcr.io/org/charts -> ghcr.io
+func registryHost(address string) string {
+	host := strings.TrimPrefix(address, "oci://")
+	if i := strings.Index(host, "/"); i >= 0 {
+		host = host[:i]
+	}
+	return host
+}
+
 func Update(ctx context.Context, reg registry, logger *zap.Logger) error {
 	_, err, _ := updateGroup.Do("update", func() (interface{}, error) {
 		return nil, update(ctx, reg, logger)
@@ -49,6 +49,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/chartrepo"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/controller/controllermetrics"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/eventwatcher"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter"
@@ -91,6 +92,7 @@ type piped struct {
 	certFile                             string
 	adminPort                            int
 	toolsDir                             string
+	remoteSourcesDir                     string
 	enableDefaultKubernetesCloudProvider bool
 	gracePeriod                          time.Duration
 	addLoginUserToPasswd                 bool
@@ -104,10 +106,11 @@ func NewCommand() *cobra.Command {
 		panic(fmt.Sprintf("failed to detect the current user's home directory: %v", err))
 	}
 	p := &piped{
-		adminPort:      9085,
-		toolsDir:       path.Join(home, ".piped", "tools"),
-		gracePeriod:    30 * time.Second,
-		maxRecvMsgSize: 1024 * 1024 * 10, // 10MB
+		adminPort:        9085,
+		toolsDir:         path.Join(home, ".piped", "tools"),
+		remoteSourcesDir: path.Join(home, ".piped", "remote-sources"),
+		gracePeriod:      30 * time.Second,
+		maxRecvMsgSize:   1024 * 1024 * 10, // 10MB
 	}
 	cmd := &cobra.Command{
 		Use:   "piped",
@@ -124,6 +127,7 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().IntVar(&p.adminPort, "admin-port", p.adminPort, "The port number used to run a HTTP server for admin tasks such as metrics, healthz.")
 
 	cmd.Flags().StringVar(&p.toolsDir, "tools-dir", p.toolsDir, "The path to directory where to install needed tools such as kubectl, helm, kustomize.")
+	cmd.Flags().StringVar(&p.remoteSourcesDir, "remote-sources-dir", p.remoteSourcesDir, "The path to directory where to cache the remote kustomize bases and helm chart dependencies.")
 	cmd.Flags().BoolVar(&p.enableDefaultKubernetesCloudProvider, "enable-default-kubernetes-cloud-provider", p.enableDefaultKubernetesCloudProvider, "Whether the default kubernetes provider is enabled or not. This feature is deprecated.")
 	cmd.Flags().BoolVar(&p.addLoginUserToPasswd, "add-login-user-to-passwd", p.addLoginUserToPasswd, "Whether to add login user to $HOME/passwd. This is typically for applications running as a random user ID.")
 	cmd.Flags().DurationVar(&p.gracePeriod, "grace-period", p.gracePeriod, "How long to wait for graceful shutdown.")
@@ -183,6 +187,15 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		}
 	}
 
+	// Login to the registries of configured OCI chart repositories.
+	if repos := cfg.OCIHelmChartRepositories(); len(repos) > 0 {
+		reg := toolregistry.DefaultRegistry()
+		if err := chartrepo.LoginOCI(ctx, repos, reg, input.Logger); err != nil {
+			input.Logger.Error("failed to login to configured OCI chart repositories", zap.Error(err))
+			return err
+		}
+	}
+
 	// Login to chart registries.
 	if regs := cfg.ChartRegistries; len(regs) > 0 {
 		reg := toolregistry.DefaultRegistry()
@@ -297,6 +310,12 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 		input.Logger.Info("successfully cleaned gitClient")
 	}()
 
+	// Initialize the cache for remote kustomize bases and helm chart dependencies.
+	if err := deploysource.InitDefaultRemoteSourceCache(p.remoteSourcesDir, gitClient, input.Logger); err != nil {
+		input.Logger.Error("failed to initialize remote source cache", zap.Error(err))
+		return err
+	}
+
 	// Start running application store.
 	var applicationLister applicationstore.Lister
 	{
@@ -345,7 +364,7 @@ func (p *piped) run(ctx context.Context, input cli.Input) (runErr error) {
 	var liveStateGetter livestatestore.Getter
 	// Start running application live state store.
 	{
-		s := livestatestore.NewStore(ctx, cfg, applicationLister, p.gracePeriod, input.Logger)
+		s := livestatestore.NewStore(ctx, cfg, applicationLister, gitClient, p.gracePeriod, input.Logger)
 		group.Go(func() error {
 			return s.Run(ctx)
 		})
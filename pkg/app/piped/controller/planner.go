@@ -160,6 +160,7 @@ func (p *planner) Run(ctx context.Context) error {
 		ApplicationID:                  p.deployment.ApplicationId,
 		ApplicationName:                p.deployment.ApplicationName,
 		GitPath:                        *p.deployment.GitPath,
+		PlatformProviderName:           p.deployment.PlatformProvider,
 		Trigger:                        *p.deployment.Trigger,
 		MostRecentSuccessfulCommitHash: p.lastSuccessfulCommitHash,
 		PipedConfig:                    p.pipedConfig,
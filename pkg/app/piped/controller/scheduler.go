@@ -330,9 +330,11 @@ func (s *scheduler) Run(ctx context.Context) error {
 			continue
 		}
 
-		// If the stage was completed with exited stage, exit this deployment with success.
+		// If the stage was completed with exited stage, exit this deployment with success
+		// and mark all the remaining stages as skipped since they will never be executed.
 		if result == model.StageStatus_STAGE_EXITED {
 			deploymentStatus = model.DeploymentStatus_DEPLOYMENT_SUCCESS
+			s.skipRemainingStages(ctx, i+1)
 			break
 		}
 
@@ -505,6 +507,8 @@ func (s *scheduler) executeStage(sig executor.StopSignal, ps model.PipelineStage
 		AnalysisResultStore:   aStore,
 		Logger:                s.logger,
 		Notifier:              s.notifier,
+		SecretDecrypter:       s.secretDecrypter,
+		WorkingDir:            s.workingDir,
 	}
 
 	// Find the executor for this stage.
@@ -570,6 +574,21 @@ func (s *scheduler) reportStageStatus(ctx context.Context, stageID string, statu
 	return err
 }
 
+// skipRemainingStages reports the not-yet-started visible stages from the given index as skipped.
+func (s *scheduler) skipRemainingStages(ctx context.Context, from int) {
+	for _, ps := range s.deployment.Stages[from:] {
+		if !ps.Visible || ps.Name == model.StageRollback.String() {
+			continue
+		}
+		if ps.Status != model.StageStatus_STAGE_NOT_STARTED_YET {
+			continue
+		}
+		if err := s.reportStageStatus(ctx, ps.Id, model.StageStatus_STAGE_SKIPPED, ps.Requires); err != nil {
+			s.logger.Error("failed to report stage status", zap.String("stage-id", ps.Id), zap.Error(err))
+		}
+	}
+}
+
 func (s *scheduler) reportDeploymentStatusChanged(ctx context.Context, status model.DeploymentStatus, desc string) error {
 	var (
 		err   error
@@ -22,6 +22,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sync"
+	"text/template"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -188,6 +189,35 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 		fmt.Fprintf(lw, "Successfully attached data: %v\n", gac.Attachment.Targets)
 	}
 
+	if c := DefaultRemoteSourceCache(); c != nil && cfg.Kind == config.KindKubernetesApp {
+		sources, err := c.PrepareRemoteSources(ctx, appDir, cfg.KubernetesApplicationSpec.Input)
+		if err != nil {
+			fmt.Fprintf(lw, "Unable to prepare the remote sources (%v)\n", err)
+			return nil, err
+		}
+		if len(sources) > 0 {
+			fmt.Fprintf(lw, "Successfully prepared the cached remote sources: %v\n", sources)
+		}
+	}
+
+	if cfg.Kind == config.KindKubernetesApp && HasHelmValueFilesToPrepare(cfg.KubernetesApplicationSpec.Input) {
+		files, err := PrepareHelmValueFiles(ctx, appDir, gac, &cfg.KubernetesApplicationSpec.Input, p.secretDecrypter)
+		if err != nil {
+			fmt.Fprintf(lw, "Unable to prepare the helm values files (%v)\n", err)
+			return nil, err
+		}
+		fmt.Fprintf(lw, "Successfully prepared helm values files: %v\n", files)
+	}
+
+	if cfg.Kind == config.KindECSApp && cfg.ECSApplicationSpec.Input.Templating != nil {
+		targets, err := RenderECSDefinitions(appDir, gac, cfg.ECSApplicationSpec.Input, p.secretDecrypter)
+		if err != nil {
+			fmt.Fprintf(lw, "Unable to render the ECS definitions (%v)\n", err)
+			return nil, err
+		}
+		fmt.Fprintf(lw, "Successfully rendered ECS definitions: %v\n", targets)
+	}
+
 	return &DeploySource{
 		RepoDir:                  repoDir,
 		AppDir:                   appDir,
@@ -197,6 +227,59 @@ func (p *provider) prepare(ctx context.Context, lw io.Writer) (*DeploySource, er
 	}, nil
 }
 
+// RenderECSDefinitions renders the task and service definition files of the given ECS application
+// with the templating values so that a single definition can be shared between environments.
+func RenderECSDefinitions(appDir string, gac config.GenericApplicationSpec, in config.ECSDeploymentInput, sd secretDecrypter) ([]string, error) {
+	targets := []string{in.TaskDefinitionFile}
+	if in.ServiceDefinitionFile != "" {
+		targets = append(targets, in.ServiceDefinitionFile)
+	}
+	for _, s := range in.AdditionalServices {
+		targets = append(targets, s.TaskDefinitionFile, s.ServiceDefinitionFile)
+	}
+
+	secrets := map[string]string{}
+	if gac.Encryption != nil && len(gac.Encryption.EncryptedSecrets) > 0 {
+		if sd == nil {
+			return nil, fmt.Errorf("unable to decrypt the encrypted secrets since no secret decrypter was configured")
+		}
+		var err error
+		if secrets, err = sourceprocesser.DecryptSecretValues(*gac.Encryption, sd); err != nil {
+			return nil, err
+		}
+	}
+
+	labels := gac.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	values := in.Templating.Values
+	if values == nil {
+		values = map[string]string{}
+	}
+	data := map[string]interface{}{
+		"app": map[string]interface{}{
+			"name":   gac.Name,
+			"labels": labels,
+		},
+		"env":              in.Templating.Env,
+		"imageTag":         in.Templating.ImageTag,
+		"values":           values,
+		"encryptedSecrets": secrets,
+	}
+	funcs := template.FuncMap{
+		// Keep the secret references as they are since they are resolved by the ECS platform provider.
+		"secret": func(ref string) string {
+			return fmt.Sprintf("{{ secret %q }}", ref)
+		},
+	}
+
+	if err := sourceprocesser.RenderTemplates(appDir, targets, data, funcs); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
 func (p *provider) copy(lw io.Writer) (*DeploySource, error) {
 	p.copyNum++
 
@@ -0,0 +1,180 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	encryptedValueFileSuffix = ".enc"
+	remoteValueFileTimeout   = 30 * time.Second
+	// The maximum size of a values file fetched from remote URL.
+	maxRemoteValueFileSize = 10 << 20
+)
+
+var valueFileHTTPClient = http.DefaultClient
+
+// HasHelmValueFilesToPrepare reports whether the values files of the given input
+// must be prepared by PrepareHelmValueFiles before loading the manifests.
+func HasHelmValueFilesToPrepare(in config.KubernetesDeploymentInput) bool {
+	if in.HelmOptions == nil {
+		return false
+	}
+	for _, f := range in.HelmOptions.ValueFiles {
+		if strings.HasSuffix(f, encryptedValueFileSuffix) {
+			return true
+		}
+		if len(in.HelmOptions.ValueFileHeaders) > 0 && strings.HasPrefix(f, "https://") {
+			return true
+		}
+	}
+	return false
+}
+
+// PrepareHelmValueFiles makes the values files of the given Kubernetes application loadable by helm.
+// The encrypted values files (*.enc) are decrypted in place. The https values files are downloaded
+// with the configured headers into the application directory since helm can not add them while fetching,
+// and their entries in the input are replaced with the downloaded files.
+// It returns the list of prepared values files.
+func PrepareHelmValueFiles(ctx context.Context, appDir string, gac config.GenericApplicationSpec, in *config.KubernetesDeploymentInput, sd secretDecrypter) ([]string, error) {
+	if !HasHelmValueFilesToPrepare(*in) {
+		return nil, nil
+	}
+
+	opts := in.HelmOptions
+	var headers http.Header
+	if len(opts.ValueFileHeaders) > 0 {
+		secrets := map[string]string{}
+		if gac.Encryption != nil && len(gac.Encryption.EncryptedSecrets) > 0 {
+			if sd == nil {
+				return nil, fmt.Errorf("unable to decrypt the encrypted secrets since no secret decrypter was configured")
+			}
+			var err error
+			if secrets, err = sourceprocesser.DecryptSecretValues(*gac.Encryption, sd); err != nil {
+				return nil, err
+			}
+		}
+		var err error
+		if headers, err = renderValueFileHeaders(opts.ValueFileHeaders, secrets); err != nil {
+			return nil, err
+		}
+	}
+
+	prepared := make([]string, 0, len(opts.ValueFiles))
+	for i, f := range opts.ValueFiles {
+		switch {
+		case strings.HasSuffix(f, encryptedValueFileSuffix):
+			if err := decryptValueFile(appDir, f, sd); err != nil {
+				return nil, err
+			}
+			prepared = append(prepared, f)
+
+		case headers != nil && strings.HasPrefix(f, "https://"):
+			name := fmt.Sprintf(".remote-values-%d.yaml", i)
+			if err := downloadValueFile(ctx, f, headers, filepath.Join(appDir, name)); err != nil {
+				return nil, err
+			}
+			opts.ValueFiles[i] = name
+			prepared = append(prepared, f)
+		}
+	}
+	return prepared, nil
+}
+
+func renderValueFileHeaders(tmpls map[string]string, secrets map[string]string) (http.Header, error) {
+	data := map[string]interface{}{
+		"encryptedSecrets": secrets,
+	}
+	headers := make(http.Header, len(tmpls))
+	for k, v := range tmpls {
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse value file header %s (%w)", k, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render value file header %s (%w)", k, err)
+		}
+		headers.Set(k, buf.String())
+	}
+	return headers, nil
+}
+
+func decryptValueFile(appDir, path string, sd secretDecrypter) error {
+	if sd == nil {
+		return fmt.Errorf("unable to decrypt values file %s since no secret decrypter was configured", path)
+	}
+	absPath := filepath.Join(appDir, path)
+	if !strings.HasPrefix(absPath, filepath.Clean(appDir)+string(filepath.Separator)) {
+		return fmt.Errorf("values file %s references outside the application directory", path)
+	}
+	data, err := os.ReadFile(absPath)
+	if err != nil {
+		return fmt.Errorf("failed to read values file %s (%w)", path, err)
+	}
+	decrypted, err := sd.Decrypt(strings.TrimSpace(string(data)))
+	if err != nil {
+		return fmt.Errorf("failed to decrypt values file %s (%w)", path, err)
+	}
+	if err := os.WriteFile(absPath, []byte(decrypted), 0600); err != nil {
+		return fmt.Errorf("failed to write decrypted values file %s (%w)", path, err)
+	}
+	return nil
+}
+
+func downloadValueFile(ctx context.Context, url string, headers http.Header, dest string) error {
+	ctx, cancel := context.WithTimeout(ctx, remoteValueFileTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request for values file %s (%w)", url, err)
+	}
+	req.Header = headers.Clone()
+
+	resp, err := valueFileHTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to fetch values file %s (%w)", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to fetch values file %s: unexpected status code %d", url, resp.StatusCode)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxRemoteValueFileSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read values file %s (%w)", url, err)
+	}
+	if len(data) > maxRemoteValueFileSize {
+		return fmt.Errorf("values file %s exceeds the maximum size of %d bytes", url, maxRemoteValueFileSize)
+	}
+	if err := os.WriteFile(dest, data, 0600); err != nil {
+		return fmt.Errorf("failed to write values file %s (%w)", url, err)
+	}
+	return nil
+}
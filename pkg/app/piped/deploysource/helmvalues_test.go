@@ -0,0 +1,104 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type fakeDecrypter struct{}
+
+func (fakeDecrypter) Decrypt(text string) (string, error) {
+	if !strings.HasPrefix(text, "encrypted:") {
+		return "", fmt.Errorf("invalid encrypted text")
+	}
+	return strings.TrimPrefix(text, "encrypted:"), nil
+}
+
+func TestPrepareHelmValueFiles(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, "replicaCount: 3\n")
+	}))
+	defer server.Close()
+
+	orig := valueFileHTTPClient
+	valueFileHTTPClient = server.Client()
+	defer func() { valueFileHTTPClient = orig }()
+
+	appDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(appDir, "secret-values.yaml.enc"), []byte("encrypted:password: foo\n"), 0644)
+	require.NoError(t, err)
+
+	gac := config.GenericApplicationSpec{
+		Encryption: &config.SecretEncryption{
+			EncryptedSecrets: map[string]string{
+				"token": "encrypted:secret-token",
+			},
+		},
+	}
+	in := &config.KubernetesDeploymentInput{
+		HelmOptions: &config.InputHelmOptions{
+			ValueFiles: []string{
+				"values.yaml",
+				"secret-values.yaml.enc",
+				server.URL + "/values.yaml",
+			},
+			ValueFileHeaders: map[string]string{
+				"Authorization": "Bearer {{ .encryptedSecrets.token }}",
+			},
+		},
+	}
+	require.True(t, HasHelmValueFilesToPrepare(*in))
+
+	files, err := PrepareHelmValueFiles(context.Background(), appDir, gac, in, fakeDecrypter{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"secret-values.yaml.enc", server.URL + "/values.yaml"}, files)
+	assert.Equal(t, []string{"values.yaml", "secret-values.yaml.enc", ".remote-values-2.yaml"}, in.HelmOptions.ValueFiles)
+
+	data, err := os.ReadFile(filepath.Join(appDir, "secret-values.yaml.enc"))
+	require.NoError(t, err)
+	assert.Equal(t, "password: foo", string(data))
+
+	data, err = os.ReadFile(filepath.Join(appDir, ".remote-values-2.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "replicaCount: 3\n", string(data))
+
+	// Fail when the remote server rejects the request.
+	in.HelmOptions.ValueFiles = []string{server.URL + "/values.yaml"}
+	in.HelmOptions.ValueFileHeaders = map[string]string{"Authorization": "Bearer invalid"}
+	_, err = PrepareHelmValueFiles(context.Background(), appDir, gac, in, fakeDecrypter{})
+	assert.Error(t, err)
+
+	// Fail when the encrypted values file references outside the application directory.
+	in.HelmOptions.ValueFiles = []string{"../secret-values.yaml.enc"}
+	_, err = PrepareHelmValueFiles(context.Background(), appDir, gac, in, fakeDecrypter{})
+	assert.Error(t, err)
+}
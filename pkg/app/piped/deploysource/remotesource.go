@@ -0,0 +1,478 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	kustomizationFileName = "kustomization.yaml"
+	chartFileName         = "Chart.yaml"
+	// The directory in the application directory where the cached remote bases are copied to.
+	remoteBasesDirName = ".remote-bases"
+
+	remoteSourceTimeout = 5 * time.Minute
+	// The maximum size of a chart archive fetched from chart repository.
+	maxRemoteChartSize = 50 << 20
+)
+
+var exactChartVersionRegex = regexp.MustCompile(`^v?[0-9]+\.[0-9]+\.[0-9]+(-[0-9A-Za-z.-]+)?(\+[0-9A-Za-z.-]+)?$`)
+
+// RemoteSourceCache caches the remote kustomize bases and helm chart dependencies
+// keyed by their URLs and versions, so that they are not fetched on every deployment.
+type RemoteSourceCache struct {
+	dir    string
+	gc     gitClient
+	client *http.Client
+	group  *singleflight.Group
+	logger *zap.Logger
+}
+
+var defaultRemoteSourceCache *RemoteSourceCache
+
+// DefaultRemoteSourceCache returns the shared cache.
+// Nil is returned when it was not initialized.
+func DefaultRemoteSourceCache() *RemoteSourceCache {
+	return defaultRemoteSourceCache
+}
+
+// InitDefaultRemoteSourceCache initializes the shared cache storing the remote sources in the given directory.
+func InitDefaultRemoteSourceCache(dir string, gc gitClient, logger *zap.Logger) error {
+	c, err := NewRemoteSourceCache(dir, gc, logger)
+	if err != nil {
+		return err
+	}
+	defaultRemoteSourceCache = c
+	return nil
+}
+
+func NewRemoteSourceCache(dir string, gc gitClient, logger *zap.Logger) (*RemoteSourceCache, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &RemoteSourceCache{
+		dir:    dir,
+		gc:     gc,
+		client: http.DefaultClient,
+		group:  &singleflight.Group{},
+		logger: logger.Named("remote-source-cache"),
+	}, nil
+}
+
+// PrepareRemoteSources replaces the pinned remote bases in kustomization.yaml and fills the missing
+// dependencies of the local helm chart with the cached ones.
+// The digests of them are verified when they were specified in remoteSourceDigests.
+// It returns the list of prepared remote sources.
+func (c *RemoteSourceCache) PrepareRemoteSources(ctx context.Context, appDir string, in config.KubernetesDeploymentInput) ([]string, error) {
+	ctx, cancel := context.WithTimeout(ctx, remoteSourceTimeout)
+	defer cancel()
+
+	bases, err := c.prepareKustomizeRemoteBases(ctx, appDir, in.RemoteSourceDigests)
+	if err != nil {
+		return nil, err
+	}
+	if in.HelmChart == nil || in.HelmChart.GitRemote != "" || in.HelmChart.Repository != "" {
+		return bases, nil
+	}
+	deps, err := c.prepareHelmDependencies(ctx, filepath.Join(appDir, in.HelmChart.Path), in.RemoteSourceDigests)
+	if err != nil {
+		return nil, err
+	}
+	return append(bases, deps...), nil
+}
+
+type remoteKustomizeBase struct {
+	Remote string
+	Path   string
+	Ref    string
+}
+
+// parseRemoteKustomizeBase parses a remote base in the form of <repository>//<path>?ref=<ref>.
+// False is returned for the local bases and the remote bases not pinned to any ref,
+// since those are left to kustomize as they are.
+func parseRemoteKustomizeBase(s string) (remoteKustomizeBase, bool) {
+	remote, query, ok := strings.Cut(s, "?")
+	if !ok {
+		return remoteKustomizeBase{}, false
+	}
+	values, err := url.ParseQuery(query)
+	if err != nil || values.Get("ref") == "" {
+		return remoteKustomizeBase{}, false
+	}
+
+	scheme := ""
+	if i := strings.Index(remote, "://"); i >= 0 {
+		scheme, remote = remote[:i+3], remote[i+3:]
+	}
+	repo, dir, ok := strings.Cut(remote, "//")
+	if !ok {
+		return remoteKustomizeBase{}, false
+	}
+	if scheme == "" && !strings.HasPrefix(repo, "git@") {
+		scheme = "https://"
+	}
+	return remoteKustomizeBase{
+		Remote: scheme + repo,
+		Path:   dir,
+		Ref:    values.Get("ref"),
+	}, true
+}
+
+func (c *RemoteSourceCache) prepareKustomizeRemoteBases(ctx context.Context, appDir string, digests map[string]string) ([]string, error) {
+	kustomizationPath := filepath.Join(appDir, kustomizationFileName)
+	data, err := os.ReadFile(kustomizationPath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s (%w)", kustomizationFileName, err)
+	}
+
+	var kustomization map[string]interface{}
+	if err := yaml.Unmarshal(data, &kustomization); err != nil {
+		return nil, fmt.Errorf("failed to parse %s (%w)", kustomizationFileName, err)
+	}
+
+	prepared := make([]string, 0)
+	for _, field := range []string{"resources", "bases", "components"} {
+		items, ok := kustomization[field].([]interface{})
+		if !ok {
+			continue
+		}
+		for i, item := range items {
+			s, ok := item.(string)
+			if !ok {
+				continue
+			}
+			base, ok := parseRemoteKustomizeBase(s)
+			if !ok {
+				continue
+			}
+			dir, err := c.getKustomizeBase(ctx, s, base, digests[s])
+			if err != nil {
+				return nil, err
+			}
+			// Copy the cached base into the application directory to keep the deploy source self-contained.
+			rel := filepath.Join(remoteBasesDirName, cacheKey(s))
+			if err := copyDir(dir, filepath.Join(appDir, rel)); err != nil {
+				return nil, fmt.Errorf("failed to copy remote base %s (%w)", s, err)
+			}
+			items[i] = filepath.ToSlash(filepath.Join(rel, base.Path))
+			prepared = append(prepared, s)
+		}
+	}
+	if len(prepared) == 0 {
+		return nil, nil
+	}
+
+	out, err := yaml.Marshal(kustomization)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal %s (%w)", kustomizationFileName, err)
+	}
+	if err := os.WriteFile(kustomizationPath, out, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write %s (%w)", kustomizationFileName, err)
+	}
+	return prepared, nil
+}
+
+// getKustomizeBase returns the directory of the cached repository of the given remote base.
+func (c *RemoteSourceCache) getKustomizeBase(ctx context.Context, key string, base remoteKustomizeBase, digest string) (string, error) {
+	dest := filepath.Join(c.dir, cacheKey(base.Remote+"?ref="+base.Ref))
+	if _, err := os.Stat(dest); err != nil {
+		_, err, _ := c.group.Do(dest, func() (interface{}, error) {
+			return nil, c.fetchKustomizeBase(ctx, base, dest)
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch remote base %s (%w)", key, err)
+		}
+	}
+
+	actual, err := dirDigest(dest)
+	if err != nil {
+		return "", err
+	}
+	if digest != "" && digest != actual {
+		return "", fmt.Errorf("digest of remote base %s is %q but %q was expected", key, actual, digest)
+	}
+	c.logger.Info("use the cached remote base", zap.String("base", key), zap.String("digest", actual))
+	return dest, nil
+}
+
+func (c *RemoteSourceCache) fetchKustomizeBase(ctx context.Context, base remoteKustomizeBase, dest string) error {
+	if _, err := os.Stat(dest); err == nil {
+		return nil
+	}
+	tmp, err := os.MkdirTemp(c.dir, "fetching-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	repoDir := filepath.Join(tmp, "repo")
+	repo, err := c.gc.Clone(ctx, base.Remote, base.Remote, "", repoDir)
+	if err != nil {
+		return err
+	}
+	if err := repo.Checkout(ctx, base.Ref); err != nil {
+		return err
+	}
+	if err := os.RemoveAll(filepath.Join(repoDir, ".git")); err != nil {
+		return err
+	}
+	return os.Rename(repoDir, dest)
+}
+
+type chartDependency struct {
+	Name       string `json:"name"`
+	Version    string `json:"version"`
+	Repository string `json:"repository"`
+}
+
+type chartRepositoryIndex struct {
+	Entries map[string][]struct {
+		Version string   `json:"version"`
+		Digest  string   `json:"digest"`
+		URLs    []string `json:"urls"`
+	} `json:"entries"`
+}
+
+func (c *RemoteSourceCache) prepareHelmDependencies(ctx context.Context, chartDir string, digests map[string]string) ([]string, error) {
+	data, err := os.ReadFile(filepath.Join(chartDir, chartFileName))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s (%w)", chartFileName, err)
+	}
+
+	var chart struct {
+		Dependencies []chartDependency `json:"dependencies"`
+	}
+	if err := yaml.Unmarshal(data, &chart); err != nil {
+		return nil, fmt.Errorf("failed to parse %s (%w)", chartFileName, err)
+	}
+
+	prepared := make([]string, 0, len(chart.Dependencies))
+	for _, dep := range chart.Dependencies {
+		// Only the dependencies pinned to an exact version in http(s) chart repositories can be cached.
+		if !strings.HasPrefix(dep.Repository, "http://") && !strings.HasPrefix(dep.Repository, "https://") {
+			continue
+		}
+		if !exactChartVersionRegex.MatchString(dep.Version) {
+			continue
+		}
+		archive := filepath.Join(chartDir, "charts", fmt.Sprintf("%s-%s.tgz", dep.Name, dep.Version))
+		if _, err := os.Stat(archive); err == nil {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s:%s", strings.TrimSuffix(dep.Repository, "/"), dep.Name, dep.Version)
+		cached, err := c.getChart(ctx, key, dep, digests[key])
+		if err != nil {
+			return nil, err
+		}
+		if err := os.MkdirAll(filepath.Dir(archive), os.ModePerm); err != nil {
+			return nil, err
+		}
+		if err := copyFile(cached, archive); err != nil {
+			return nil, fmt.Errorf("failed to copy chart dependency %s (%w)", key, err)
+		}
+		prepared = append(prepared, key)
+	}
+	return prepared, nil
+}
+
+// getChart returns the path to the cached archive of the given chart dependency.
+func (c *RemoteSourceCache) getChart(ctx context.Context, key string, dep chartDependency, digest string) (string, error) {
+	dest := filepath.Join(c.dir, cacheKey(key)+".tgz")
+	if _, err := os.Stat(dest); err != nil {
+		_, err, _ := c.group.Do(dest, func() (interface{}, error) {
+			return nil, c.fetchChart(ctx, dep, dest)
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch chart dependency %s (%w)", key, err)
+		}
+	}
+
+	actual, err := fileDigest(dest)
+	if err != nil {
+		return "", err
+	}
+	if digest != "" && digest != actual {
+		return "", fmt.Errorf("digest of chart dependency %s is %q but %q was expected", key, actual, digest)
+	}
+	c.logger.Info("use the cached chart dependency", zap.String("chart", key), zap.String("digest", actual))
+	return dest, nil
+}
+
+func (c *RemoteSourceCache) fetchChart(ctx context.Context, dep chartDependency, dest string) error {
+	if _, err := os.Stat(dest); err == nil {
+		return nil
+	}
+	repoURL, err := url.Parse(strings.TrimSuffix(dep.Repository, "/") + "/")
+	if err != nil {
+		return err
+	}
+
+	data, err := c.download(ctx, repoURL.JoinPath("index.yaml").String(), maxRemoteChartSize)
+	if err != nil {
+		return err
+	}
+	var index chartRepositoryIndex
+	if err := yaml.Unmarshal(data, &index); err != nil {
+		return fmt.Errorf("failed to parse index of chart repository %s (%w)", dep.Repository, err)
+	}
+
+	for _, e := range index.Entries[dep.Name] {
+		if e.Version != dep.Version || len(e.URLs) == 0 {
+			continue
+		}
+		chartURL, err := repoURL.Parse(e.URLs[0])
+		if err != nil {
+			return err
+		}
+		data, err := c.download(ctx, chartURL.String(), maxRemoteChartSize)
+		if err != nil {
+			return err
+		}
+		// The digest in the index is the sha256 of the chart archive.
+		sum := sha256.Sum256(data)
+		if e.Digest != "" && e.Digest != hex.EncodeToString(sum[:]) {
+			return fmt.Errorf("chart %s does not match the digest in the repository index", chartURL)
+		}
+
+		tmp := dest + ".tmp"
+		if err := os.WriteFile(tmp, data, 0644); err != nil {
+			return err
+		}
+		return os.Rename(tmp, dest)
+	}
+	return fmt.Errorf("chart %s:%s was not found in repository %s", dep.Name, dep.Version, dep.Repository)
+}
+
+func (c *RemoteSourceCache) download(ctx context.Context, url string, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, url)
+	}
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", url, maxSize)
+	}
+	return data, nil
+}
+
+func cacheKey(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:32]
+}
+
+// dirDigest returns the sha256 digest calculated from the paths and contents of all files in the directory.
+func dirDigest(dir string) (string, error) {
+	files := make([]string, 0)
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.Type().IsRegular() {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+
+	h := sha256.New()
+	for _, f := range files {
+		rel, err := filepath.Rel(dir, f)
+		if err != nil {
+			return "", err
+		}
+		data, err := os.ReadFile(f)
+		if err != nil {
+			return "", err
+		}
+		sum := sha256.Sum256(data)
+		fmt.Fprintf(h, "%s %s\n", hex.EncodeToString(sum[:]), path.Clean(filepath.ToSlash(rel)))
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func fileDigest(file string) (string, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:]), nil
+}
+
+func copyDir(src, dest string) error {
+	if err := os.RemoveAll(dest); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), os.ModePerm); err != nil {
+		return err
+	}
+	cmd := exec.Command("cp", "-rf", src, dest)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, string(out))
+	}
+	return nil
+}
+
+func copyFile(src, dest string) error {
+	data, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(dest, data, 0644)
+}
@@ -0,0 +1,238 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deploysource
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/git/gittest"
+)
+
+func TestParseRemoteKustomizeBase(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		base     string
+		expected remoteKustomizeBase
+		ok       bool
+	}{
+		{
+			name: "https remote base",
+			base: "https://github.com/org/repo//deploy/base?ref=v1.0.0",
+			expected: remoteKustomizeBase{
+				Remote: "https://github.com/org/repo",
+				Path:   "deploy/base",
+				Ref:    "v1.0.0",
+			},
+			ok: true,
+		},
+		{
+			name: "remote base without scheme",
+			base: "github.com/org/repo//base?ref=abc123&timeout=90s",
+			expected: remoteKustomizeBase{
+				Remote: "https://github.com/org/repo",
+				Path:   "base",
+				Ref:    "abc123",
+			},
+			ok: true,
+		},
+		{
+			name: "ssh remote base",
+			base: "git@github.com:org/repo.git//base?ref=v1",
+			expected: remoteKustomizeBase{
+				Remote: "git@github.com:org/repo.git",
+				Path:   "base",
+				Ref:    "v1",
+			},
+			ok: true,
+		},
+		{
+			name: "remote base without ref",
+			base: "https://github.com/org/repo//base",
+		},
+		{
+			name: "local base",
+			base: "../base",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := parseRemoteKustomizeBase(tc.base)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPrepareKustomizeRemoteBases(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	const base = "https://github.com/org/repo//deploy/base?ref=v1.0.0"
+
+	repo := gittest.NewMockRepo(ctrl)
+	repo.EXPECT().Checkout(gomock.Any(), "v1.0.0").Return(nil)
+	gc := &fakeGitClient{
+		repo: repo,
+		files: map[string]string{
+			"deploy/base/kustomization.yaml": "resources:\n- deployment.yaml\n",
+			"deploy/base/deployment.yaml":    "kind: Deployment\n",
+		},
+	}
+	c, err := NewRemoteSourceCache(t.TempDir(), gc, zap.NewNop())
+	require.NoError(t, err)
+
+	prepare := func() string {
+		appDir := t.TempDir()
+		err := os.WriteFile(filepath.Join(appDir, kustomizationFileName), []byte(fmt.Sprintf("resources:\n- %s\n- service.yaml\n", base)), 0644)
+		require.NoError(t, err)
+
+		sources, err := c.prepareKustomizeRemoteBases(context.Background(), appDir, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{base}, sources)
+		return appDir
+	}
+
+	// The remote base is fetched only once.
+	for i := 0; i < 2; i++ {
+		appDir := prepare()
+		data, err := os.ReadFile(filepath.Join(appDir, kustomizationFileName))
+		require.NoError(t, err)
+		rel := filepath.Join(remoteBasesDirName, cacheKey(base), "deploy/base")
+		assert.Equal(t, fmt.Sprintf("resources:\n- %s\n- service.yaml\n", rel), string(data))
+		assert.FileExists(t, filepath.Join(appDir, rel, "deployment.yaml"))
+	}
+	assert.Equal(t, 1, gc.cloned)
+
+	// Fail when the digest does not match.
+	appDir := t.TempDir()
+	err = os.WriteFile(filepath.Join(appDir, kustomizationFileName), []byte(fmt.Sprintf("resources:\n- %s\n", base)), 0644)
+	require.NoError(t, err)
+	_, err = c.prepareKustomizeRemoteBases(context.Background(), appDir, map[string]string{base: "sha256:invalid"})
+	assert.Error(t, err)
+}
+
+func TestPrepareHelmDependencies(t *testing.T) {
+	t.Parallel()
+
+	archive := []byte("chart archive")
+	sum := sha256.Sum256(archive)
+	digest := hex.EncodeToString(sum[:])
+
+	var requested int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested++
+		switch r.URL.Path {
+		case "/charts/index.yaml":
+			fmt.Fprintf(w, "entries:\n  redis:\n  - version: 17.0.0\n    digest: %s\n    urls:\n    - redis-17.0.0.tgz\n", digest)
+		case "/charts/redis-17.0.0.tgz":
+			w.Write(archive)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewRemoteSourceCache(t.TempDir(), nil, zap.NewNop())
+	require.NoError(t, err)
+
+	chart := fmt.Sprintf(`apiVersion: v2
+name: app
+version: 0.1.0
+dependencies:
+- name: redis
+  version: 17.0.0
+  repository: %s/charts
+- name: common
+  version: ^1.0.0
+  repository: %s/charts
+- name: local
+  version: 0.1.0
+  repository: file://../local
+`, server.URL, server.URL)
+	key := fmt.Sprintf("%s/charts/redis:17.0.0", server.URL)
+
+	for i := 0; i < 2; i++ {
+		chartDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(chartDir, chartFileName), []byte(chart), 0644))
+
+		deps, err := c.prepareHelmDependencies(context.Background(), chartDir, map[string]string{key: "sha256:" + digest})
+		require.NoError(t, err)
+		assert.Equal(t, []string{key}, deps)
+
+		data, err := os.ReadFile(filepath.Join(chartDir, "charts", "redis-17.0.0.tgz"))
+		require.NoError(t, err)
+		assert.Equal(t, archive, data)
+	}
+	// The index and the archive are fetched only once.
+	assert.Equal(t, 2, requested)
+
+	chartDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(chartDir, chartFileName), []byte(chart), 0644))
+	_, err = c.prepareHelmDependencies(context.Background(), chartDir, map[string]string{key: "sha256:invalid"})
+	assert.Error(t, err)
+}
+
+func TestPrepareRemoteSourcesWithoutRemoteSources(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewRemoteSourceCache(t.TempDir(), nil, zap.NewNop())
+	require.NoError(t, err)
+
+	sources, err := c.PrepareRemoteSources(context.Background(), t.TempDir(), config.KubernetesDeploymentInput{})
+	require.NoError(t, err)
+	assert.Empty(t, sources)
+}
+
+type fakeGitClient struct {
+	repo   git.Repo
+	files  map[string]string
+	cloned int
+}
+
+func (c *fakeGitClient) Clone(_ context.Context, _, _, _, dest string) (git.Repo, error) {
+	c.cloned++
+	for name, content := range c.files {
+		p := filepath.Join(dest, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			return nil, err
+		}
+	}
+	return c.repo, nil
+}
@@ -0,0 +1,332 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type applicationLister interface {
+	ListByPlatformProvider(name string) []*model.Application
+}
+
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
+type secretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
+type reporter interface {
+	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+}
+
+type Detector interface {
+	Run(ctx context.Context) error
+	ProviderName() string
+}
+
+type detector struct {
+	provider        config.PipedPlatformProvider
+	appLister       applicationLister
+	gitClient       gitClient
+	reporter        reporter
+	interval        time.Duration
+	config          *config.PipedSpec
+	secretDecrypter secretDecrypter
+	logger          *zap.Logger
+
+	gitRepos map[string]git.Repo
+}
+
+func NewDetector(
+	cp config.PipedPlatformProvider,
+	appLister applicationLister,
+	gitClient gitClient,
+	reporter reporter,
+	cfg *config.PipedSpec,
+	sd secretDecrypter,
+	logger *zap.Logger,
+) Detector {
+
+	logger = logger.Named("azurefunctions-detector").With(
+		zap.String("platform-provider", cp.Name),
+	)
+	return &detector{
+		provider:        cp,
+		appLister:       appLister,
+		gitClient:       gitClient,
+		reporter:        reporter,
+		interval:        time.Minute,
+		config:          cfg,
+		secretDecrypter: sd,
+		gitRepos:        make(map[string]git.Repo),
+		logger:          logger,
+	}
+}
+
+func (d *detector) Run(ctx context.Context) error {
+	d.logger.Info("start running drift detector for azurefunctions applications")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("drift detector for azurefunctions applications has been stopped")
+			return nil
+
+		case <-ticker.C:
+			d.check(ctx)
+		}
+	}
+}
+
+func (d *detector) ProviderName() string {
+	return d.provider.Name
+}
+
+func (d *detector) check(ctx context.Context) {
+	appsByRepo := d.listGroupedApplication()
+
+	for repoID, apps := range appsByRepo {
+		gitRepo, ok := d.gitRepos[repoID]
+		if !ok {
+			// Clone repository for the first time.
+			gr, err := d.cloneGitRepository(ctx, repoID)
+			if err != nil {
+				d.logger.Error("failed to clone git repository",
+					zap.String("repo-id", repoID),
+					zap.Error(err),
+				)
+				continue
+			}
+			gitRepo = gr
+			d.gitRepos[repoID] = gitRepo
+		}
+
+		// Fetch the latest commit to compare the states.
+		branch := gitRepo.GetClonedBranch()
+		if err := gitRepo.Pull(ctx, branch); err != nil {
+			d.logger.Error("failed to pull repository branch",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Get the head commit of the repository.
+		headCommit, err := gitRepo.GetLatestCommit(ctx)
+		if err != nil {
+			d.logger.Error("failed to get head commit hash",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Start checking all applications in this repository.
+		for _, app := range apps {
+			if err := d.checkApplication(ctx, app, gitRepo, headCommit); err != nil {
+				d.logger.Error(fmt.Sprintf("failed to check application: %s", app.Id), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *detector) cloneGitRepository(ctx context.Context, repoID string) (git.Repo, error) {
+	repoCfg, ok := d.config.GetRepository(repoID)
+	if !ok {
+		return nil, fmt.Errorf("repository %s was not found in piped configuration", repoID)
+	}
+	return d.gitClient.Clone(ctx, repoID, repoCfg.Remote, repoCfg.Branch, "")
+}
+
+// listGroupedApplication retrieves all applications those should be handled by this director
+// and then groups them by repoID.
+func (d *detector) listGroupedApplication() map[string][]*model.Application {
+	var (
+		apps = d.appLister.ListByPlatformProvider(d.provider.Name)
+		m    = make(map[string][]*model.Application)
+	)
+	for _, app := range apps {
+		repoID := app.GitPath.Repo.Id
+		m[repoID] = append(m[repoID], app)
+	}
+	return m
+}
+
+func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
+	headManifest, err := d.loadHeadFunctionAppManifest(app, repo)
+	if err != nil {
+		return err
+	}
+	d.logger.Info(fmt.Sprintf("application %s has a function app manifest at commit %s", app.Id, headCommit.Hash))
+
+	// The live configuration is fetched at every check
+	// since there is no live state store for azurefunctions applications.
+	client, err := provider.DefaultRegistry().Client(d.provider.Name, d.provider.AzureFunctionsConfig, d.logger)
+	if err != nil {
+		return fmt.Errorf("failed to create azurefunctions client: %w", err)
+	}
+	live, err := d.loadLiveFunctionApp(ctx, client, headManifest.Spec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get live function app: %w", err)
+	}
+	d.logger.Info(fmt.Sprintf("application %s has a live function app", app.Id))
+
+	result, err := provider.DiffLiveFunctionApp(live, headManifest)
+	if err != nil {
+		return err
+	}
+
+	state := makeSyncState(result, headCommit.Hash)
+
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+}
+
+// loadLiveFunctionApp returns the configuration of the production slot of the given function app.
+func (d *detector) loadLiveFunctionApp(ctx context.Context, client provider.Client, name string) (provider.LiveFunctionApp, error) {
+	site, err := client.GetSite(ctx, name, "")
+	if err != nil {
+		return provider.LiveFunctionApp{}, err
+	}
+	settings, err := client.ListAppSettings(ctx, name, "")
+	if err != nil {
+		return provider.LiveFunctionApp{}, err
+	}
+	siteConfig, err := client.GetSiteConfig(ctx, name, "")
+	if err != nil {
+		return provider.LiveFunctionApp{}, err
+	}
+	return provider.LiveFunctionApp{
+		Tags:           site.Tags,
+		AppSettings:    settings,
+		ContainerImage: siteConfig.ContainerImage(),
+	}, nil
+}
+
+func (d *detector) loadHeadFunctionAppManifest(app *model.Application, repo git.Repo) (provider.FunctionAppManifest, error) {
+	var (
+		repoDir = repo.GetPath()
+		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+	)
+
+	cfg, err := d.loadApplicationConfiguration(repoDir, app)
+	if err != nil {
+		return provider.FunctionAppManifest{}, fmt.Errorf("failed to load application configuration: %w", err)
+	}
+	if cfg.AzureFunctionsApplicationSpec == nil {
+		return provider.FunctionAppManifest{}, fmt.Errorf("unsupport application kind %s", cfg.Kind)
+	}
+
+	var (
+		gds            = cfg.AzureFunctionsApplicationSpec.GenericApplicationSpec
+		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
+		attachmentUsed = gds.Attachment != nil
+	)
+
+	// We have to copy repository into another directory because
+	// decrypting the sealed secrets or attaching files might change the git repository.
+	if attachmentUsed || encryptionUsed {
+		dir, err := os.MkdirTemp("", "detector-git-processing")
+		if err != nil {
+			return provider.FunctionAppManifest{}, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
+		}
+		defer os.RemoveAll(dir)
+
+		repo, err = repo.Copy(filepath.Join(dir, "repo"))
+		if err != nil {
+			return provider.FunctionAppManifest{}, fmt.Errorf("failed to copy the cloned git repository (%w)", err)
+		}
+		repoDir := repo.GetPath()
+		appDir = filepath.Join(repoDir, app.GitPath.Path)
+	}
+
+	// Decrypting secrets to manifests.
+	if encryptionUsed {
+		if err := sourceprocesser.DecryptSecrets(appDir, *gds.Encryption, d.secretDecrypter); err != nil {
+			return provider.FunctionAppManifest{}, fmt.Errorf("failed to decrypt secrets (%w)", err)
+		}
+	}
+	// Then attaching configurated files to manifests.
+	if attachmentUsed {
+		if err := sourceprocesser.AttachData(appDir, *gds.Attachment); err != nil {
+			return provider.FunctionAppManifest{}, fmt.Errorf("failed to attach files (%w)", err)
+		}
+	}
+
+	sm, err := provider.LoadFunctionAppManifest(appDir, cfg.AzureFunctionsApplicationSpec.Input.FunctionAppManifestFile)
+	if err != nil {
+		return provider.FunctionAppManifest{}, fmt.Errorf("failed to load function app manifest: %w", err)
+	}
+	return sm, nil
+}
+
+func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
+	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
+	cfg, err := config.LoadFromYAML(path)
+	if err != nil {
+		return nil, err
+	}
+	if appKind, ok := cfg.Kind.ToApplicationKind(); !ok || appKind != app.Kind {
+		return nil, fmt.Errorf("application in application configuration file is not match, got: %s, expected: %s", appKind, app.Kind)
+	}
+	return cfg, nil
+}
+
+func makeSyncState(r *diff.Result, commit string) model.ApplicationSyncState {
+	if !r.HasDiff() {
+		return model.ApplicationSyncState{
+			Status:    model.ApplicationSyncStatus_SYNCED,
+			Timestamp: time.Now().Unix(),
+		}
+	}
+
+	shortReason := "The function app doesn't be synced"
+	if len(commit) >= 7 {
+		commit = commit[:7]
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Diff between the defined state in Git at commit %s and actual live state:\n\n", commit))
+	b.WriteString("--- Actual   (LiveState)\n+++ Expected (Git)\n\n")
+
+	renderer := diff.NewRenderer(diff.WithLeftPadding(1))
+	b.WriteString(renderer.Render(r.Nodes()))
+
+	return model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: shortReason,
+		Reason:      b.String(),
+		Timestamp:   time.Now().Unix(),
+	}
+}
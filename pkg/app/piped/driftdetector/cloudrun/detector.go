@@ -29,7 +29,6 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/config"
-	"github.com/pipe-cd/pipecd/pkg/diff"
 	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -205,13 +204,9 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	}
 	d.logger.Info(fmt.Sprintf("application %s has a live service manifest", app.Id))
 
-	result, err := provider.Diff(
-		liveManifest,
-		headManifest,
-		diff.WithEquateEmpty(),
-		diff.WithIgnoreAddingMapKeys(),
-		diff.WithCompareNumberAndNumericString(),
-	)
+	// Only the fields managed in Git are compared because the live service contains
+	// the revision name, traffic and labels set by piped and many default values set by Cloud Run.
+	result, err := provider.DiffLiveService(liveManifest, headManifest)
 	if err != nil {
 		return err
 	}
@@ -27,8 +27,13 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/azurefunctions"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/ecs"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/stepfunctions"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/driftdetector/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
@@ -131,6 +136,81 @@ func NewDetector(
 				logger,
 			))
 
+		case model.PlatformProviderECS:
+			sg, ok := stateGetter.ECSRunGetter(cp.Name)
+			if !ok {
+				return nil, fmt.Errorf(format, cp.Name)
+			}
+			d.detectors = append(d.detectors, ecs.NewDetector(
+				cp,
+				appLister,
+				gitClient,
+				sg,
+				d,
+				appManifestsCache,
+				cfg,
+				sd,
+				logger,
+			))
+
+		case model.PlatformProviderLambda:
+			sg, ok := stateGetter.LambdaGetter(cp.Name)
+			if !ok {
+				return nil, fmt.Errorf(format, cp.Name)
+			}
+			d.detectors = append(d.detectors, lambda.NewDetector(
+				cp,
+				appLister,
+				gitClient,
+				sg,
+				d,
+				appManifestsCache,
+				cfg,
+				sd,
+				logger,
+			))
+
+		case model.PlatformProviderContainerApps:
+			sg, ok := stateGetter.ContainerAppsGetter(cp.Name)
+			if !ok {
+				return nil, fmt.Errorf(format, cp.Name)
+			}
+			d.detectors = append(d.detectors, containerapps.NewDetector(
+				cp,
+				appLister,
+				gitClient,
+				sg,
+				d,
+				appManifestsCache,
+				cfg,
+				sd,
+				logger,
+			))
+
+		case model.PlatformProviderStepFunctions:
+			// The live state machines are fetched by the detector itself.
+			d.detectors = append(d.detectors, stepfunctions.NewDetector(
+				cp,
+				appLister,
+				gitClient,
+				d,
+				cfg,
+				sd,
+				logger,
+			))
+
+		case model.PlatformProviderAzureFunctions:
+			// The live function apps are fetched by the detector itself.
+			d.detectors = append(d.detectors, azurefunctions.NewDetector(
+				cp,
+				appLister,
+				gitClient,
+				d,
+				cfg,
+				sd,
+				logger,
+			))
+
 		case model.PlatformProviderTerraform:
 			if !*cp.TerraformConfig.DriftDetectionEnabled {
 				continue
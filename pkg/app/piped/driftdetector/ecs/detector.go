@@ -0,0 +1,423 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/ecs"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type applicationLister interface {
+	ListByPlatformProvider(name string) []*model.Application
+}
+
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
+type secretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
+type reporter interface {
+	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+}
+
+type Detector interface {
+	Run(ctx context.Context) error
+	ProviderName() string
+}
+
+type detector struct {
+	provider          config.PipedPlatformProvider
+	appLister         applicationLister
+	gitClient         gitClient
+	stateGetter       ecs.Getter
+	reporter          reporter
+	appManifestsCache cache.Cache
+	interval          time.Duration
+	config            *config.PipedSpec
+	secretDecrypter   secretDecrypter
+	logger            *zap.Logger
+
+	gitRepos map[string]git.Repo
+}
+
+func NewDetector(
+	cp config.PipedPlatformProvider,
+	appLister applicationLister,
+	gitClient gitClient,
+	stateGetter ecs.Getter,
+	reporter reporter,
+	appManifestsCache cache.Cache,
+	cfg *config.PipedSpec,
+	sd secretDecrypter,
+	logger *zap.Logger,
+) Detector {
+
+	logger = logger.Named("ecs-detector").With(
+		zap.String("platform-provider", cp.Name),
+	)
+	return &detector{
+		provider:          cp,
+		appLister:         appLister,
+		gitClient:         gitClient,
+		stateGetter:       stateGetter,
+		reporter:          reporter,
+		appManifestsCache: appManifestsCache,
+		interval:          time.Minute,
+		config:            cfg,
+		secretDecrypter:   sd,
+		gitRepos:          make(map[string]git.Repo),
+		logger:            logger,
+	}
+}
+
+func (d *detector) Run(ctx context.Context) error {
+	d.logger.Info("start running drift detector for ecs applications")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("drift detector for ecs applications has been stopped")
+			return nil
+
+		case <-ticker.C:
+			d.check(ctx)
+		}
+	}
+}
+
+func (d *detector) ProviderName() string {
+	return d.provider.Name
+}
+
+func (d *detector) check(ctx context.Context) {
+	appsByRepo := d.listGroupedApplication()
+
+	for repoID, apps := range appsByRepo {
+		gitRepo, ok := d.gitRepos[repoID]
+		if !ok {
+			// Clone repository for the first time.
+			gr, err := d.cloneGitRepository(ctx, repoID)
+			if err != nil {
+				d.logger.Error("failed to clone git repository",
+					zap.String("repo-id", repoID),
+					zap.Error(err),
+				)
+				continue
+			}
+			gitRepo = gr
+			d.gitRepos[repoID] = gitRepo
+		}
+
+		// Fetch the latest commit to compare the states.
+		branch := gitRepo.GetClonedBranch()
+		if err := gitRepo.Pull(ctx, branch); err != nil {
+			d.logger.Error("failed to pull repository branch",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Get the head commit of the repository.
+		headCommit, err := gitRepo.GetLatestCommit(ctx)
+		if err != nil {
+			d.logger.Error("failed to get head commit hash",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Start checking all applications in this repository.
+		for _, app := range apps {
+			if err := d.checkApplication(ctx, app, gitRepo, headCommit); err != nil {
+				d.logger.Error(fmt.Sprintf("failed to check application: %s", app.Id), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *detector) cloneGitRepository(ctx context.Context, repoID string) (git.Repo, error) {
+	repoCfg, ok := d.config.GetRepository(repoID)
+	if !ok {
+		return nil, fmt.Errorf("repository %s was not found in piped configuration", repoID)
+	}
+	return d.gitClient.Clone(ctx, repoID, repoCfg.Remote, repoCfg.Branch, "")
+}
+
+// listGroupedApplication retrieves all applications those should be handled by this director
+// and then groups them by repoID.
+func (d *detector) listGroupedApplication() map[string][]*model.Application {
+	var (
+		apps = d.appLister.ListByPlatformProvider(d.provider.Name)
+		m    = make(map[string][]*model.Application)
+	)
+	for _, app := range apps {
+		repoID := app.GitPath.Repo.Id
+		m[repoID] = append(m[repoID], app)
+	}
+	return m
+}
+
+func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
+	headDefs, autoScaling, err := d.loadHeadDefinitions(app, repo, headCommit)
+	if err != nil {
+		return err
+	}
+	if headDefs.ServiceDefinition == nil {
+		// Standalone tasks are not kept in the live state so there is nothing to compare.
+		d.logger.Debug(fmt.Sprintf("skip checking application %s since it runs standalone tasks", app.Id))
+		return nil
+	}
+	d.logger.Info(fmt.Sprintf("application %s has task and service definitions at commit %s", app.Id, headCommit.Hash))
+
+	// Every service is compared with the live one having the same name.
+	var (
+		services = append([]provider.Definitions{headDefs}, headDefs.AdditionalServices...)
+		results  = make([]serviceDiffResult, 0, len(services))
+	)
+	for i, defs := range services {
+		name := aws.ToString(defs.ServiceDefinition.ServiceName)
+		liveService, ok := d.stateGetter.GetServiceDefinition(app.Id, name)
+		if !ok {
+			return fmt.Errorf("failed to get live service definition of %s", name)
+		}
+		liveTaskDef, ok := d.stateGetter.GetTaskDefinition(app.Id, name)
+		if !ok {
+			return fmt.Errorf("failed to get live task definition of %s", name)
+		}
+
+		// The desired count is managed by Application Auto Scaling when it was configured for the main service,
+		// and by ECS itself for the daemon service.
+		ignoreDesiredCount := (i == 0 && autoScaling) || provider.IsDaemonService(*defs.ServiceDefinition)
+		serviceResult, err := provider.DiffLiveServiceDefinition(liveService, *defs.ServiceDefinition, ignoreDesiredCount)
+		if err != nil {
+			return err
+		}
+		taskDefResult, err := provider.DiffLiveTaskDefinition(liveTaskDef, defs.TaskDefinition)
+		if err != nil {
+			return err
+		}
+		results = append(results, serviceDiffResult{
+			name:          name,
+			serviceResult: serviceResult,
+			taskDefResult: taskDefResult,
+		})
+	}
+	d.logger.Info(fmt.Sprintf("application %s has live task and service definitions", app.Id))
+
+	state := makeSyncState(results, headCommit.Hash)
+
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+}
+
+// loadHeadDefinitions loads the task and service definitions at the given commit.
+// The returned boolean reports whether the application has its desired count managed by auto scaling.
+func (d *detector) loadHeadDefinitions(app *model.Application, repo git.Repo, headCommit git.Commit) (provider.Definitions, bool, error) {
+	var (
+		defsCache = provider.DefinitionsCache{
+			AppID:  app.Id,
+			Cache:  d.appManifestsCache,
+			Logger: d.logger,
+		}
+		repoDir = repo.GetPath()
+		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+	)
+
+	// The application configuration is always needed to know whether auto scaling is used.
+	cfg, err := d.loadApplicationConfiguration(repoDir, app)
+	if err != nil {
+		return provider.Definitions{}, false, fmt.Errorf("failed to load application configuration: %w", err)
+	}
+	if cfg.ECSApplicationSpec == nil {
+		return provider.Definitions{}, false, fmt.Errorf("unsupport application kind %s", cfg.Kind)
+	}
+	var (
+		gds         = cfg.ECSApplicationSpec.GenericApplicationSpec
+		input       = cfg.ECSApplicationSpec.Input
+		autoScaling = input.AutoScaling != nil
+	)
+
+	defs, ok := defsCache.Get(headCommit.Hash)
+	if ok {
+		return defs, autoScaling, nil
+	}
+
+	// When the definitions were not in the cache we have to load them.
+	var (
+		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
+		attachmentUsed = gds.Attachment != nil
+		templatingUsed = input.Templating != nil
+	)
+
+	// We have to copy repository into another directory because
+	// decrypting the sealed secrets, attaching files or rendering templates might change the git repository.
+	if attachmentUsed || encryptionUsed || templatingUsed {
+		dir, err := os.MkdirTemp("", "detector-git-processing")
+		if err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
+		}
+		defer os.RemoveAll(dir)
+
+		repo, err = repo.Copy(filepath.Join(dir, "repo"))
+		if err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to copy the cloned git repository (%w)", err)
+		}
+		repoDir := repo.GetPath()
+		appDir = filepath.Join(repoDir, app.GitPath.Path)
+	}
+
+	// Decrypting secrets to definitions.
+	if encryptionUsed {
+		if err := sourceprocesser.DecryptSecrets(appDir, *gds.Encryption, d.secretDecrypter); err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to decrypt secrets (%w)", err)
+		}
+	}
+	// Then attaching configurated files to definitions.
+	if attachmentUsed {
+		if err := sourceprocesser.AttachData(appDir, *gds.Attachment); err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to attach files (%w)", err)
+		}
+	}
+	// Finally rendering the templating values into definitions.
+	if templatingUsed {
+		if _, err := deploysource.RenderECSDefinitions(appDir, gds, input, d.secretDecrypter); err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to render definitions (%w)", err)
+		}
+	}
+
+	taskDef, err := provider.LoadTaskDefinition(appDir, input.TaskDefinitionFile)
+	if err != nil {
+		return provider.Definitions{}, false, fmt.Errorf("failed to load task definition: %w", err)
+	}
+	defs = provider.Definitions{TaskDefinition: taskDef}
+
+	if !input.IsStandaloneTask() {
+		service, err := provider.LoadServiceDefinition(appDir, input.ServiceDefinitionFile)
+		if err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to load service definition: %w", err)
+		}
+		defs.ServiceDefinition = &service
+	}
+	for _, s := range input.AdditionalServices {
+		taskDef, err := provider.LoadTaskDefinition(appDir, s.TaskDefinitionFile)
+		if err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to load task definition: %w", err)
+		}
+		service, err := provider.LoadServiceDefinition(appDir, s.ServiceDefinitionFile)
+		if err != nil {
+			return provider.Definitions{}, false, fmt.Errorf("failed to load service definition: %w", err)
+		}
+		defs.AdditionalServices = append(defs.AdditionalServices, provider.Definitions{
+			TaskDefinition:    taskDef,
+			ServiceDefinition: &service,
+		})
+	}
+	defsCache.Put(headCommit.Hash, defs)
+
+	return defs, autoScaling, nil
+}
+
+func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
+	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
+	cfg, err := config.LoadFromYAML(path)
+	if err != nil {
+		return nil, err
+	}
+	if appKind, ok := cfg.Kind.ToApplicationKind(); !ok || appKind != app.Kind {
+		return nil, fmt.Errorf("application in application configuration file is not match, got: %s, expected: %s", appKind, app.Kind)
+	}
+	return cfg, nil
+}
+
+// serviceDiffResult is the result of comparing a service and its task definition with the live ones.
+type serviceDiffResult struct {
+	name          string
+	serviceResult *diff.Result
+	taskDefResult *diff.Result
+}
+
+func (r serviceDiffResult) hasDiff() bool {
+	return r.serviceResult.HasDiff() || r.taskDefResult.HasDiff()
+}
+
+func makeSyncState(results []serviceDiffResult, commit string) model.ApplicationSyncState {
+	var outOfSync bool
+	for _, r := range results {
+		if r.hasDiff() {
+			outOfSync = true
+			break
+		}
+	}
+	if !outOfSync {
+		return model.ApplicationSyncState{
+			Status:    model.ApplicationSyncStatus_SYNCED,
+			Timestamp: time.Now().Unix(),
+		}
+	}
+
+	shortReason := "The task or service definition doesn't be synced"
+	if len(commit) >= 7 {
+		commit = commit[:7]
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Diff between the defined state in Git at commit %s and actual live state:\n\n", commit))
+	b.WriteString("--- Actual   (LiveState)\n+++ Expected (Git)\n\n")
+
+	renderer := diff.NewRenderer(diff.WithLeftPadding(1))
+	for _, r := range results {
+		if r.serviceResult.HasDiff() {
+			b.WriteString(fmt.Sprintf("# Service definition of %s\n", r.name))
+			b.WriteString(renderer.Render(r.serviceResult.Nodes()))
+			b.WriteString("\n")
+		}
+		if r.taskDefResult.HasDiff() {
+			b.WriteString(fmt.Sprintf("# Task definition of %s\n", r.name))
+			b.WriteString(renderer.Render(r.taskDefResult.Nodes()))
+			b.WriteString("\n")
+		}
+	}
+
+	return model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: shortReason,
+		Reason:      b.String(),
+		Timestamp:   time.Now().Unix(),
+	}
+}
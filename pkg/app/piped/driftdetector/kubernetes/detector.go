@@ -24,6 +24,7 @@ import (
 
 	"go.uber.org/zap"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/kubernetes"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
@@ -186,13 +187,13 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 	liveManifests = filterIgnoringManifests(liveManifests)
 	d.logger.Debug(fmt.Sprintf("application %s has %d live manifests", app.Id, len(liveManifests)))
 
-	ddCfg, err := d.getDriftDetectionConfig(repo.GetPath(), app)
+	spec, err := d.getApplicationSpec(repo.GetPath(), app)
 	if err != nil {
 		return err
 	}
 
 	ignoreConfig := make(map[string][]string, 0)
-	if ddCfg != nil {
+	if ddCfg := spec.DriftDetection; ddCfg != nil {
 		for _, ignoreField := range ddCfg.IgnoreFields {
 			// ignoreField is 'apiVersion:kind:namespace:name#fieldPath'
 			splited := strings.Split(ignoreField, "#")
@@ -209,6 +210,7 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		diff.WithIgnoreAddingMapKeys(),
 		diff.WithCompareNumberAndNumericString(),
 		diff.WithIgnoreConfig(ignoreConfig),
+		diff.WithIgnoredFieldPaths(spec.IgnoreFields),
 	)
 	if err != nil {
 		return err
@@ -246,11 +248,12 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 		var (
 			encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
 			attachmentUsed = gds.Attachment != nil
+			helmValuesUsed = deploysource.HasHelmValueFilesToPrepare(cfg.KubernetesApplicationSpec.Input)
 		)
 
 		// We have to copy repository into another directory because
-		// decrypting the sealed secrets or attaching files might change the git repository.
-		if attachmentUsed || encryptionUsed {
+		// decrypting the sealed secrets, attaching files or preparing the helm values files might change the git repository.
+		if attachmentUsed || encryptionUsed || helmValuesUsed {
 			dir, err := os.MkdirTemp("", "detector-git-processing")
 			if err != nil {
 				return nil, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
@@ -278,6 +281,13 @@ func (d *detector) loadHeadManifests(ctx context.Context, app *model.Application
 			}
 		}
 
+		// Then decrypting or fetching the helm values files.
+		if helmValuesUsed {
+			if _, err := deploysource.PrepareHelmValueFiles(ctx, appDir, gds, &cfg.KubernetesApplicationSpec.Input, d.secretDecrypter); err != nil {
+				return nil, fmt.Errorf("failed to prepare helm values files (%w)", err)
+			}
+		}
+
 		loader := provider.NewLoader(app.Name, appDir, repoDir, app.GitPath.ConfigFilename, cfg.KubernetesApplicationSpec.Input, d.gitClient, d.logger)
 		manifests, err = loader.LoadManifests(ctx)
 		if err != nil {
@@ -338,6 +348,7 @@ func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Appl
 		chartRepoName := cfg.KubernetesApplicationSpec.Input.HelmChart.Repository
 		if chartRepoName != "" {
 			cfg.KubernetesApplicationSpec.Input.HelmChart.Insecure = d.config.IsInsecureChartRepository(chartRepoName)
+			cfg.KubernetesApplicationSpec.Input.HelmChart.OCIAddress = d.config.OCIChartRepositoryAddress(chartRepoName)
 		}
 	}
 
@@ -399,15 +410,14 @@ func makeSyncState(r *provider.DiffListResult, commit string) model.ApplicationS
 	}
 }
 
-func (d *detector) getDriftDetectionConfig(repoDir string, app *model.Application) (*config.DriftDetection, error) {
+func (d *detector) getApplicationSpec(repoDir string, app *model.Application) (*config.KubernetesApplicationSpec, error) {
 	cfg, err := d.loadApplicationConfiguration(repoDir, app)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load application configuration: %w", err)
 	}
-	gds, ok := cfg.GetGenericApplication()
-	if !ok {
+	if cfg.KubernetesApplicationSpec == nil {
 		return nil, fmt.Errorf("unsupport application kind %s", cfg.Kind)
 	}
 
-	return gds.DriftDetection, nil
+	return cfg.KubernetesApplicationSpec, nil
 }
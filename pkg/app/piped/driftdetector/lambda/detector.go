@@ -13,3 +13,362 @@
 // limitations under the License.
 
 package lambda
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/lambda"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/sourceprocesser"
+	"github.com/pipe-cd/pipecd/pkg/cache"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/git"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type applicationLister interface {
+	ListByPlatformProvider(name string) []*model.Application
+}
+
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
+type secretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
+type reporter interface {
+	ReportApplicationSyncState(ctx context.Context, appID string, state model.ApplicationSyncState) error
+}
+
+type Detector interface {
+	Run(ctx context.Context) error
+	ProviderName() string
+}
+
+type detector struct {
+	provider          config.PipedPlatformProvider
+	appLister         applicationLister
+	gitClient         gitClient
+	stateGetter       lambda.Getter
+	reporter          reporter
+	appManifestsCache cache.Cache
+	interval          time.Duration
+	config            *config.PipedSpec
+	secretDecrypter   secretDecrypter
+	logger            *zap.Logger
+
+	gitRepos map[string]git.Repo
+}
+
+func NewDetector(
+	cp config.PipedPlatformProvider,
+	appLister applicationLister,
+	gitClient gitClient,
+	stateGetter lambda.Getter,
+	reporter reporter,
+	appManifestsCache cache.Cache,
+	cfg *config.PipedSpec,
+	sd secretDecrypter,
+	logger *zap.Logger,
+) Detector {
+
+	logger = logger.Named("lambda-detector").With(
+		zap.String("platform-provider", cp.Name),
+	)
+	return &detector{
+		provider:          cp,
+		appLister:         appLister,
+		gitClient:         gitClient,
+		stateGetter:       stateGetter,
+		reporter:          reporter,
+		appManifestsCache: appManifestsCache,
+		interval:          time.Minute,
+		config:            cfg,
+		secretDecrypter:   sd,
+		gitRepos:          make(map[string]git.Repo),
+		logger:            logger,
+	}
+}
+
+func (d *detector) Run(ctx context.Context) error {
+	d.logger.Info("start running drift detector for lambda applications")
+
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.logger.Info("drift detector for lambda applications has been stopped")
+			return nil
+
+		case <-ticker.C:
+			d.check(ctx)
+		}
+	}
+}
+
+func (d *detector) ProviderName() string {
+	return d.provider.Name
+}
+
+func (d *detector) check(ctx context.Context) {
+	appsByRepo := d.listGroupedApplication()
+
+	for repoID, apps := range appsByRepo {
+		gitRepo, ok := d.gitRepos[repoID]
+		if !ok {
+			// Clone repository for the first time.
+			gr, err := d.cloneGitRepository(ctx, repoID)
+			if err != nil {
+				d.logger.Error("failed to clone git repository",
+					zap.String("repo-id", repoID),
+					zap.Error(err),
+				)
+				continue
+			}
+			gitRepo = gr
+			d.gitRepos[repoID] = gitRepo
+		}
+
+		// Fetch the latest commit to compare the states.
+		branch := gitRepo.GetClonedBranch()
+		if err := gitRepo.Pull(ctx, branch); err != nil {
+			d.logger.Error("failed to pull repository branch",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Get the head commit of the repository.
+		headCommit, err := gitRepo.GetLatestCommit(ctx)
+		if err != nil {
+			d.logger.Error("failed to get head commit hash",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		// Start checking all applications in this repository.
+		for _, app := range apps {
+			if err := d.checkApplication(ctx, app, gitRepo, headCommit); err != nil {
+				d.logger.Error(fmt.Sprintf("failed to check application: %s", app.Id), zap.Error(err))
+			}
+		}
+	}
+}
+
+func (d *detector) cloneGitRepository(ctx context.Context, repoID string) (git.Repo, error) {
+	repoCfg, ok := d.config.GetRepository(repoID)
+	if !ok {
+		return nil, fmt.Errorf("repository %s was not found in piped configuration", repoID)
+	}
+	return d.gitClient.Clone(ctx, repoID, repoCfg.Remote, repoCfg.Branch, "")
+}
+
+// listGroupedApplication retrieves all applications those should be handled by this director
+// and then groups them by repoID.
+func (d *detector) listGroupedApplication() map[string][]*model.Application {
+	var (
+		apps = d.appLister.ListByPlatformProvider(d.provider.Name)
+		m    = make(map[string][]*model.Application)
+	)
+	for _, app := range apps {
+		repoID := app.GitPath.Repo.Id
+		m[repoID] = append(m[repoID], app)
+	}
+	return m
+}
+
+func (d *detector) checkApplication(ctx context.Context, app *model.Application, repo git.Repo, headCommit git.Commit) error {
+	headManifest, secretEnvs, err := d.loadHeadFunctionManifest(app, repo)
+	if err != nil {
+		return err
+	}
+	d.logger.Info(fmt.Sprintf("application %s has a function manifest at commit %s", app.Id, headCommit.Hash))
+
+	// The live configuration is fetched at every check
+	// since the lambda live state store does not keep the functions.
+	client, err := provider.DefaultRegistry().Client(d.provider.Name, d.provider.LambdaConfig, d.logger)
+	if err != nil {
+		return fmt.Errorf("failed to create lambda client: %w", err)
+	}
+	liveSpec, err := client.GetFunction(ctx, headManifest.Spec.Name)
+	if err != nil {
+		return fmt.Errorf("failed to get live function configuration: %w", err)
+	}
+	d.logger.Info(fmt.Sprintf("application %s has a live function configuration", app.Id))
+
+	// The image is deployed pinned to its digest, so compare with the digest the tag currently points to.
+	if headManifest.Spec.ImageURI != "" && strings.Contains(liveSpec.ImageURI, "@sha256:") && !strings.Contains(headManifest.Spec.ImageURI, "@") {
+		manifest, err := client.GetImageManifest(ctx, headManifest.Spec.ImageURI)
+		if err != nil {
+			return fmt.Errorf("failed to get image manifest: %w", err)
+		}
+		if uri, err := provider.ResolveImageURI(headManifest, manifest); err == nil {
+			headManifest.Spec.ImageURI = uri
+		}
+	}
+
+	// Never show the decrypted secrets in the reported diff.
+	liveSpec.Environments, headManifest.Spec.Environments = maskSecretEnvironments(liveSpec.Environments, headManifest.Spec.Environments, secretEnvs)
+
+	result, err := provider.DiffLiveFunction(liveSpec, headManifest.Spec)
+	if err != nil {
+		return err
+	}
+
+	state := makeSyncState(result, headCommit.Hash)
+
+	return d.reporter.ReportApplicationSyncState(ctx, app.Id, state)
+}
+
+func (d *detector) loadHeadFunctionManifest(app *model.Application, repo git.Repo) (provider.FunctionManifest, []string, error) {
+	var (
+		repoDir = repo.GetPath()
+		appDir  = filepath.Join(repoDir, app.GitPath.Path)
+	)
+
+	cfg, err := d.loadApplicationConfiguration(repoDir, app)
+	if err != nil {
+		return provider.FunctionManifest{}, nil, fmt.Errorf("failed to load application configuration: %w", err)
+	}
+	if cfg.LambdaApplicationSpec == nil {
+		return provider.FunctionManifest{}, nil, fmt.Errorf("unsupport application kind %s", cfg.Kind)
+	}
+
+	var (
+		gds            = cfg.LambdaApplicationSpec.GenericApplicationSpec
+		encryptionUsed = d.secretDecrypter != nil && gds.Encryption != nil
+		attachmentUsed = gds.Attachment != nil
+	)
+
+	// We have to copy repository into another directory because
+	// decrypting the sealed secrets or attaching files might change the git repository.
+	if attachmentUsed || encryptionUsed {
+		dir, err := os.MkdirTemp("", "detector-git-processing")
+		if err != nil {
+			return provider.FunctionManifest{}, nil, fmt.Errorf("failed to prepare a temporary directory for git repository (%w)", err)
+		}
+		defer os.RemoveAll(dir)
+
+		repo, err = repo.Copy(filepath.Join(dir, "repo"))
+		if err != nil {
+			return provider.FunctionManifest{}, nil, fmt.Errorf("failed to copy the cloned git repository (%w)", err)
+		}
+		repoDir := repo.GetPath()
+		appDir = filepath.Join(repoDir, app.GitPath.Path)
+	}
+
+	// Decrypting secrets to manifests.
+	if encryptionUsed {
+		if err := sourceprocesser.DecryptSecrets(appDir, *gds.Encryption, d.secretDecrypter); err != nil {
+			return provider.FunctionManifest{}, nil, fmt.Errorf("failed to decrypt secrets (%w)", err)
+		}
+	}
+	// Then attaching configurated files to manifests.
+	if attachmentUsed {
+		if err := sourceprocesser.AttachData(appDir, *gds.Attachment); err != nil {
+			return provider.FunctionManifest{}, nil, fmt.Errorf("failed to attach files (%w)", err)
+		}
+	}
+
+	fm, err := provider.LoadFunctionManifest(appDir, cfg.LambdaApplicationSpec.Input)
+	if err != nil {
+		return provider.FunctionManifest{}, nil, fmt.Errorf("failed to load function manifest: %w", err)
+	}
+
+	var encryptedSecrets map[string]string
+	if gds.Encryption != nil {
+		encryptedSecrets = gds.Encryption.EncryptedSecrets
+	}
+	secretEnvs, err := provider.DecryptEnvironments(&fm, encryptedSecrets, d.secretDecrypter)
+	if err != nil {
+		return provider.FunctionManifest{}, nil, fmt.Errorf("failed to decrypt environment variables: %w", err)
+	}
+	return fm, secretEnvs, nil
+}
+
+func (d *detector) loadApplicationConfiguration(repoPath string, app *model.Application) (*config.Config, error) {
+	path := filepath.Join(repoPath, app.GitPath.GetApplicationConfigFilePath())
+	cfg, err := config.LoadFromYAML(path)
+	if err != nil {
+		return nil, err
+	}
+	if appKind, ok := cfg.Kind.ToApplicationKind(); !ok || appKind != app.Kind {
+		return nil, fmt.Errorf("application in application configuration file is not match, got: %s, expected: %s", appKind, app.Kind)
+	}
+	return cfg, nil
+}
+
+const secretMask = "*****"
+
+// maskSecretEnvironments replaces the values of the given secret environment variables with masks.
+// The masks still differ when the values differ, so that the change is reported without the values.
+func maskSecretEnvironments(live, expected map[string]string, names []string) (map[string]string, map[string]string) {
+	if len(names) == 0 {
+		return live, expected
+	}
+	maskedLive := make(map[string]string, len(live))
+	for k, v := range live {
+		maskedLive[k] = v
+	}
+	maskedExpected := make(map[string]string, len(expected))
+	for k, v := range expected {
+		maskedExpected[k] = v
+	}
+	for _, name := range names {
+		lv, ok := live[name]
+		if !ok {
+			maskedExpected[name] = secretMask
+			continue
+		}
+		maskedLive[name] = secretMask
+		if lv == expected[name] {
+			maskedExpected[name] = secretMask
+		} else {
+			maskedExpected[name] = secretMask + " (changed)"
+		}
+	}
+	return maskedLive, maskedExpected
+}
+
+func makeSyncState(r *diff.Result, commit string) model.ApplicationSyncState {
+	if !r.HasDiff() {
+		return model.ApplicationSyncState{
+			Status:    model.ApplicationSyncStatus_SYNCED,
+			Timestamp: time.Now().Unix(),
+		}
+	}
+
+	shortReason := "The function configuration doesn't be synced"
+	if len(commit) >= 7 {
+		commit = commit[:7]
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("Diff between the defined state in Git at commit %s and actual live state:\n\n", commit))
+	b.WriteString("--- Actual   (LiveState)\n+++ Expected (Git)\n\n")
+
+	renderer := diff.NewRenderer(diff.WithLeftPadding(1))
+	b.WriteString(renderer.Render(r.Nodes()))
+
+	return model.ApplicationSyncState{
+		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+		ShortReason: shortReason,
+		Reason:      b.String(),
+		Timestamp:   time.Now().Unix(),
+	}
+}
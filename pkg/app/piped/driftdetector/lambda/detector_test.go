@@ -0,0 +1,76 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskSecretEnvironments(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		live             map[string]string
+		expected         map[string]string
+		names            []string
+		expectedLive     map[string]string
+		expectedExpected map[string]string
+	}{
+		{
+			name:             "no secrets",
+			live:             map[string]string{"FOO": "bar"},
+			expected:         map[string]string{"FOO": "baz"},
+			expectedLive:     map[string]string{"FOO": "bar"},
+			expectedExpected: map[string]string{"FOO": "baz"},
+		},
+		{
+			name:             "unchanged secret",
+			live:             map[string]string{"FOO": "bar", "PASSWORD": "pass"},
+			expected:         map[string]string{"FOO": "bar", "PASSWORD": "pass"},
+			names:            []string{"PASSWORD"},
+			expectedLive:     map[string]string{"FOO": "bar", "PASSWORD": "*****"},
+			expectedExpected: map[string]string{"FOO": "bar", "PASSWORD": "*****"},
+		},
+		{
+			name:             "changed secret",
+			live:             map[string]string{"PASSWORD": "old"},
+			expected:         map[string]string{"PASSWORD": "new"},
+			names:            []string{"PASSWORD"},
+			expectedLive:     map[string]string{"PASSWORD": "*****"},
+			expectedExpected: map[string]string{"PASSWORD": "***** (changed)"},
+		},
+		{
+			name:             "missing secret in live",
+			live:             map[string]string{},
+			expected:         map[string]string{"PASSWORD": "new"},
+			names:            []string{"PASSWORD"},
+			expectedLive:     map[string]string{},
+			expectedExpected: map[string]string{"PASSWORD": "*****"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			live, expected := maskSecretEnvironments(tc.live, tc.expected, tc.names)
+			assert.Equal(t, tc.expectedLive, live)
+			assert.Equal(t, tc.expectedExpected, expected)
+		})
+	}
+}
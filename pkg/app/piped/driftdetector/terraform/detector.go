@@ -35,6 +35,9 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// Planning terraform applications is heavy so the drift is checked less frequently than other platforms.
+const defaultInterval = 10 * time.Minute
+
 type applicationLister interface {
 	ListByPlatformProvider(name string) []*model.Application
 }
@@ -87,6 +90,10 @@ func NewDetector(
 	logger = logger.Named("terraform-detector").With(
 		zap.String("platform-provider", cp.Name),
 	)
+	interval := defaultInterval
+	if cp.TerraformConfig != nil && cp.TerraformConfig.DriftDetectionInterval > 0 {
+		interval = cp.TerraformConfig.DriftDetectionInterval.Duration()
+	}
 	return &detector{
 		provider:          cp,
 		appLister:         appLister,
@@ -94,7 +101,7 @@ func NewDetector(
 		stateGetter:       stateGetter,
 		reporter:          reporter,
 		appManifestsCache: appManifestsCache,
-		interval:          10 * time.Minute,
+		interval:          interval,
 		config:            cfg,
 		secretDecrypter:   sd,
 		gitRepos:          make(map[string]git.Repo),
@@ -225,17 +232,51 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 
 	// Set up terraform
 	version := appCfg.Input.TerraformVersion
+	if version == "" {
+		version, err = provider.DetermineVersion(appDir, *cpCfg.AutoInstallRequiredVersion)
+		if err != nil {
+			return err
+		}
+	}
 	terraformPath, _, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
 	if err != nil {
 		return err
 	}
 
+	var terragruntPath string
+	if appCfg.Input.Terragrunt {
+		terragruntPath, _, err = toolregistry.DefaultRegistry().Terragrunt(ctx, appCfg.Input.TerragruntVersion)
+		if err != nil {
+			return err
+		}
+	}
+
 	vars := make([]string, 0, len(cpCfg.Vars)+len(appCfg.Input.Vars))
 	vars = append(vars, cpCfg.Vars...)
 	vars = append(vars, appCfg.Input.Vars...)
 	flags := appCfg.Input.CommandFlags
 	envs := appCfg.Input.CommandEnvs
 
+	var encryptedSecrets map[string]string
+	if appCfg.Encryption != nil {
+		encryptedSecrets = appCfg.Encryption.EncryptedSecrets
+	}
+	secretVarEnvs, err := provider.MakeSecretVarEnvs(appCfg.Input.SecretVars, encryptedSecrets, d.secretDecrypter)
+	if err != nil {
+		return fmt.Errorf("failed to prepare the secret variables (%w)", err)
+	}
+	backendConfig, err := provider.RenderBackendConfig(appCfg.Input.BackendConfig, provider.BackendConfigData{
+		App: provider.BackendConfigApp{
+			ID:     app.Id,
+			Name:   app.Name,
+			Labels: app.Labels,
+		},
+		Workspace: appCfg.Input.Workspace,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to render the backend configuration (%w)", err)
+	}
+
 	executor := provider.NewTerraform(
 		terraformPath,
 		appDir,
@@ -244,6 +285,9 @@ func (d *detector) checkApplication(ctx context.Context, app *model.Application,
 		provider.WithVarFiles(appCfg.Input.VarFiles),
 		provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 		provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+		provider.WithAdditionalEnvs(secretVarEnvs, nil, nil, nil),
+		provider.WithTerragrunt(terragruntPath),
+		provider.WithBackendConfig(backendConfig),
 	)
 
 	buf := new(bytes.Buffer)
@@ -299,11 +343,16 @@ func makeSyncState(r provider.PlanResult, commit string) (*model.ApplicationSync
 	b.WriteString(fmt.Sprintf("Diff between the defined state in Git at commit %s and actual live state:\n\n", commit))
 	b.WriteString("--- Actual   (LiveState)\n+++ Expected (Git)\n\n")
 
-	details, err := r.Render()
-	if err != nil {
-		return nil, err
+	// Prefer the structured summary since it is easier to read than the raw plan output.
+	if r.Summary != nil {
+		b.WriteString(r.Summary.Render())
+	} else {
+		details, err := r.Render()
+		if err != nil {
+			return nil, err
+		}
+		b.WriteString(details)
 	}
-	b.WriteString(details)
 
 	return &model.ApplicationSyncState{
 		Status:      model.ApplicationSyncStatus_OUT_OF_SYNC,
@@ -0,0 +1,82 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeSyncState(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                string
+		result              provider.PlanResult
+		expectedStatus      model.ApplicationSyncStatus
+		expectedShortReason string
+		expectedReason      string
+	}{
+		{
+			name:           "no changes",
+			result:         provider.PlanResult{},
+			expectedStatus: model.ApplicationSyncStatus_SYNCED,
+		},
+		{
+			name: "drift with summary",
+			result: provider.PlanResult{
+				Changes: 1,
+				Summary: &provider.PlanSummary{
+					Resources: []provider.ResourceChange{
+						{
+							Address: "aws_instance.web",
+							Action:  provider.PlanActionUpdate,
+							Attributes: []provider.AttributeChange{
+								{Name: "instance_type", Before: `"t3.small"`, After: `"t3.micro"`},
+							},
+						},
+					},
+				},
+			},
+			expectedStatus:      model.ApplicationSyncStatus_OUT_OF_SYNC,
+			expectedShortReason: "There are 1 manifests not synced (0 imports, 0 adds, 0 deletes, 1 changes)",
+			expectedReason: `Diff between the defined state in Git at commit 0123456 and actual live state:
+
+--- Actual   (LiveState)
++++ Expected (Git)
+
+~ aws_instance.web will be updated in-place
+    instance_type: "t3.small" -> "t3.micro"
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			state, err := makeSyncState(tc.result, "0123456789")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedStatus, state.Status)
+			assert.Equal(t, tc.expectedShortReason, state.ShortReason)
+			assert.Equal(t, tc.expectedReason, state.Reason)
+		})
+	}
+}
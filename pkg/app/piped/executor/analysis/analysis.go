@@ -28,7 +28,9 @@ import (
 	logfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/log/factory"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics"
 	metricsfactory "github.com/pipe-cd/pipecd/pkg/app/piped/analysisprovider/metrics/factory"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -42,6 +44,7 @@ type Executor struct {
 
 	repoDir             string
 	config              *config.Config
+	cloudRunArgs        cloudRunArgs
 	startTime           time.Time
 	previousElapsedTime time.Duration
 }
@@ -78,6 +81,14 @@ func (e *Executor) Execute(sig executor.StopSignal) model.StageStatus {
 	e.repoDir = ds.RepoDir
 	e.config = ds.ApplicationConfig
 
+	if e.config.Kind == config.KindCloudRunApp {
+		e.cloudRunArgs, err = e.buildCloudRunArgs(ctx, ds)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to decide the Cloud Run revisions to be analyzed (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	templateCfg, err := config.LoadAnalysisTemplate(e.repoDir)
 	if errors.Is(err, config.ErrNotFound) {
 		e.Logger.Info("config file for AnalysisTemplate not found")
@@ -328,6 +339,10 @@ func (e *Executor) buildAppArgs(customArgs map[string]string) argsTemplate {
 		},
 		AppCustomArgs: customArgs,
 	}
+	if e.config.Kind == config.KindCloudRunApp {
+		args.CloudRun = e.cloudRunArgs
+		return args
+	}
 	if e.config.Kind != config.KindKubernetesApp {
 		return args
 	}
@@ -339,6 +354,45 @@ func (e *Executor) buildAppArgs(customArgs map[string]string) argsTemplate {
 	return args
 }
 
+// buildCloudRunArgs decides the names of the revision being deployed and the one running before the deployment
+// in the same way as the Cloud Run executor, so that the metrics of each revision can be queried.
+func (e *Executor) buildCloudRunArgs(ctx context.Context, ds *deploysource.DeploySource) (cloudRunArgs, error) {
+	var args cloudRunArgs
+	appCfg := ds.ApplicationConfig.CloudRunApplicationSpec
+	if appCfg == nil {
+		return args, fmt.Errorf("missing CloudRunApplicationSpec")
+	}
+	sm, err := cloudrun.LoadServiceManifest(ds.AppDir, appCfg.Input.ServiceManifestFile)
+	if err != nil {
+		return args, fmt.Errorf("failed to load the service manifest: %w", err)
+	}
+	args.Service = sm.Name
+	if args.Revision, err = cloudrun.DecideRevisionName(sm, e.Deployment.Trigger.Commit.Hash); err != nil {
+		return args, fmt.Errorf("failed to decide the revision name: %w", err)
+	}
+
+	// There is no previous revision for the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		return args, nil
+	}
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		return args, fmt.Errorf("failed to prepare running deploy source data: %w", err)
+	}
+	runningAppCfg := runningDS.ApplicationConfig.CloudRunApplicationSpec
+	if runningAppCfg == nil {
+		return args, fmt.Errorf("missing CloudRunApplicationSpec in running commit")
+	}
+	runningSM, err := cloudrun.LoadServiceManifest(runningDS.AppDir, runningAppCfg.Input.ServiceManifestFile)
+	if err != nil {
+		return args, fmt.Errorf("failed to load the running service manifest: %w", err)
+	}
+	if args.PreviousRevision, err = cloudrun.DecideRevisionName(runningSM, e.Deployment.RunningCommitHash); err != nil {
+		return args, fmt.Errorf("failed to decide the running revision name: %w", err)
+	}
+	return args, nil
+}
+
 func (e *Executor) checkSkipped(ctx context.Context) bool {
 	var skipCmd *model.ReportableCommand
 	commands := e.CommandLister.ListCommands()
@@ -133,8 +133,12 @@ func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error
 		To:   now,
 	}
 
-	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, a.cfg.Query, queryRange)
-	points, err := a.provider.QueryPoints(ctx, a.cfg.Query, queryRange)
+	query, err := a.renderQuery(a.cfg.Query, nil, "")
+	if err != nil {
+		return false, err
+	}
+	a.logPersister.Infof("[%s] Run query: %q, in range: %v", a.id, query, queryRange)
+	points, err := a.provider.QueryPoints(ctx, query, queryRange)
 	if err != nil {
 		return false, fmt.Errorf("failed to run query: %w", err)
 	}
@@ -154,7 +158,7 @@ func (a *metricsAnalyzer) analyzeWithThreshold(ctx context.Context) (bool, error
 		break
 	}
 	if !expected {
-		a.logPersister.Errorf("[%s] Failed because it found a data point (%s) that is outside the expected range (%s). Performed query: %q", a.id, &outiler, &a.cfg.Expected, a.cfg.Query)
+		a.logPersister.Errorf("[%s] Failed because it found a data point (%s) that is outside the expected range (%s). Performed query: %q", a.id, &outiler, &a.cfg.Expected, query)
 		return false, nil
 	}
 
@@ -416,9 +420,10 @@ func (a *metricsAnalyzer) compare(experiment, control []float64, deviation strin
 // NOTE: Changing its fields will force users to change the template definition.
 type argsTemplate struct {
 	// The args that are automatically populated.
-	App     appArgs
-	K8s     k8sArgs
-	Variant variantArgs
+	App      appArgs
+	K8s      k8sArgs
+	CloudRun cloudRunArgs
+	Variant  variantArgs
 
 	// User-defined custom args.
 	VariantCustomArgs map[string]string
@@ -435,6 +440,17 @@ type k8sArgs struct {
 	Namespace string
 }
 
+// cloudRunArgs allows the revisions of Cloud Run application to be embedded in the query.
+type cloudRunArgs struct {
+	// The name of the service.
+	Service string
+	// The name of the revision being deployed.
+	Revision string
+	// The name of the revision running before the deployment.
+	// Empty for the first deployment.
+	PreviousRevision string
+}
+
 // variantArgs allows variant-specific data to be embedded in the query.
 type variantArgs struct {
 	// One of "primary", "canary", or "baseline" will be populated.
@@ -448,6 +464,7 @@ func (a *metricsAnalyzer) renderQuery(queryTemplate string, variantCustomArgs ma
 		VariantCustomArgs: variantCustomArgs,
 		App:               a.argsTemplate.App,
 		K8s:               a.argsTemplate.K8s,
+		CloudRun:          a.argsTemplate.CloudRun,
 		AppCustomArgs:     a.argsTemplate.AppCustomArgs,
 	}
 
@@ -382,6 +382,24 @@ func Test_metricsAnalyzer_renderQuery(t *testing.T) {
 			want:    `variant="canary", app="app-1", pod="1234", id="xxxx"`,
 			wantErr: false,
 		},
+		{
+			name: "using cloud run built in args",
+			args: args{
+				queryTemplate: `revision_name="{{ if eq .Variant.Name "canary" }}{{ .CloudRun.Revision }}{{ else }}{{ .CloudRun.PreviousRevision }}{{ end }}", service_name="{{ .CloudRun.Service }}"`,
+				variant:       "primary",
+			},
+			metricsAnalyzer: &metricsAnalyzer{
+				argsTemplate: argsTemplate{
+					CloudRun: cloudRunArgs{
+						Service:          "helloworld",
+						Revision:         "helloworld-v011-2345678",
+						PreviousRevision: "helloworld-v010-1234567",
+					},
+				},
+			},
+			want:    `revision_name="helloworld-v010-1234567", service_name="helloworld"`,
+			wantErr: false,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -0,0 +1,216 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/appengine"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The key of the shared metadata to pass the version which was receiving the traffic
+	// before the deployment to the following stages and the rollback.
+	primaryVersionMetadataKey = "appengine-primary-version"
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageAppEngineSync, f)
+	r.Register(model.StageAppEnginePromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_APPENGINE, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderAppEngineConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_APPENGINE)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.AppEngineConfig
+	found = true
+	return
+}
+
+func loadAppYAML(in *executor.Input, appYAMLFile string, ds *deploysource.DeploySource) (provider.AppYAML, bool) {
+	in.LogPersister.Infof("Loading app.yaml at commit %s", ds.Revision)
+
+	app, err := provider.LoadAppYAML(ds.AppDir, appYAMLFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load app.yaml (%v)", err)
+		return provider.AppYAML{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the app.yaml of service %s at commit %s", app.ServiceID(), ds.Revision)
+	return app, true
+}
+
+// getLiveService returns the running service or nil if it does not exist yet.
+func getLiveService(ctx context.Context, in *executor.Input, client provider.Client, serviceID string) (*provider.Service, bool) {
+	svc, err := client.GetService(ctx, serviceID)
+	if errors.Is(err, provider.ErrNotFound) {
+		in.LogPersister.Infof("Service %s does not exist yet", serviceID)
+		return nil, true
+	}
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get service %s: %v", serviceID, err)
+		return nil, false
+	}
+	return svc, true
+}
+
+// deployVersion creates the version of the given commit from the source in the application directory.
+// Nothing is done when the version already exists since the same commit is always deployed as the same version.
+func deployVersion(ctx context.Context, in *executor.Input, client provider.Client, app provider.AppYAML, commitHash string, ds *deploysource.DeploySource) (string, bool) {
+	var (
+		serviceID = app.ServiceID()
+		versionID = provider.MakeVersionID(commitHash)
+	)
+
+	_, err := client.GetVersion(ctx, serviceID, versionID)
+	if err == nil {
+		in.LogPersister.Infof("Version %s of service %s was already deployed", versionID, serviceID)
+		return versionID, true
+	}
+	if !errors.Is(err, provider.ErrNotFound) {
+		in.LogPersister.Errorf("Failed to get version %s of service %s: %v", versionID, serviceID, err)
+		return "", false
+	}
+
+	v, err := app.Version(versionID)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to convert app.yaml into version %s: %v", versionID, err)
+		return "", false
+	}
+
+	archive, files, err := provider.ArchiveSource(ds.AppDir)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to archive the source of version %s: %v", versionID, err)
+		return "", false
+	}
+	object := fmt.Sprintf("pipecd/%s/%s.zip", in.Deployment.ApplicationId, versionID)
+	url, err := client.UploadSource(ctx, object, archive)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to upload the source of version %s: %v", versionID, err)
+		return "", false
+	}
+	in.LogPersister.Infof("Uploaded %d files to %s", files, url)
+
+	v.SetSource(url, files)
+
+	in.LogPersister.Infof("Deploying version %s of service %s", versionID, serviceID)
+	if err := client.CreateVersion(ctx, serviceID, v); err != nil {
+		in.LogPersister.Errorf("Failed to deploy version %s of service %s: %v", versionID, serviceID, err)
+		return "", false
+	}
+	in.LogPersister.Infof("Successfully deployed version %s of service %s", versionID, serviceID)
+	return versionID, true
+}
+
+// updateTraffic replaces the traffic split of the given service.
+// The labels of the service are updated together to bind it with the application.
+func updateTraffic(ctx context.Context, in *executor.Input, client provider.Client, serviceID string, split map[string]int, splitBy, commitHash string) bool {
+	ids := make([]string, 0, len(split))
+	for id := range split {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		in.LogPersister.Infof("Routing %d%% of traffic to version %s", split[id], id)
+	}
+	labels := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+	if err := client.UpdateService(ctx, serviceID, split, splitBy, labels); err != nil {
+		in.LogPersister.Errorf("Failed to update traffic of service %s: %v", serviceID, err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully updated traffic of service %s", serviceID)
+	return true
+}
+
+// promoteTraffic returns the traffic split routing the given percentage of traffic to the new version
+// and the rest to the primary version.
+func promoteTraffic(primary, version string, percent int) map[string]int {
+	if primary == "" || primary == version {
+		return map[string]int{version: 100}
+	}
+	return map[string]int{
+		primary: 100 - percent,
+		version: percent,
+	}
+}
+
+// pruneVersions deletes the old versions of the service created by piped
+// while keeping the latest ones which do not serve any traffic.
+// Failures are only logged since the deployment itself has already been completed.
+func pruneVersions(ctx context.Context, in *executor.Input, client provider.Client, serviceID string, keep int) {
+	if keep <= 0 {
+		return
+	}
+
+	svc, err := client.GetService(ctx, serviceID)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to get the service %s to prune old versions (%v)", serviceID, err)
+		return
+	}
+	versions, err := client.ListVersions(ctx, serviceID)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to list the versions of the service %s to prune old ones (%v)", serviceID, err)
+		return
+	}
+
+	deleted := 0
+	for _, id := range svc.PrunableVersionIDs(versions, keep) {
+		if err := client.DeleteVersion(ctx, serviceID, id); err != nil && !errors.Is(err, provider.ErrNotFound) {
+			in.LogPersister.Errorf("Failed to delete the old version %s (%v)", id, err)
+			continue
+		}
+		in.LogPersister.Infof("Deleted the old version %s", id)
+		deleted++
+	}
+	in.LogPersister.Infof("Pruned %d old versions of the service %s, keeping the latest %d ones not serving traffic", deleted, serviceID, keep)
+}
@@ -0,0 +1,68 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPromoteTraffic(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		primary  string
+		version  string
+		percent  int
+		expected map[string]int
+	}{
+		{
+			name:     "first deployment",
+			version:  "pipecd-0000002",
+			percent:  30,
+			expected: map[string]int{"pipecd-0000002": 100},
+		},
+		{
+			name:     "same version",
+			primary:  "pipecd-0000002",
+			version:  "pipecd-0000002",
+			percent:  30,
+			expected: map[string]int{"pipecd-0000002": 100},
+		},
+		{
+			name:     "canary",
+			primary:  "pipecd-0000001",
+			version:  "pipecd-0000002",
+			percent:  30,
+			expected: map[string]int{"pipecd-0000001": 70, "pipecd-0000002": 30},
+		},
+		{
+			name:     "promote all",
+			primary:  "pipecd-0000001",
+			version:  "pipecd-0000002",
+			percent:  100,
+			expected: map[string]int{"pipecd-0000001": 0, "pipecd-0000002": 100},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, promoteTraffic(tc.primary, tc.version, tc.percent))
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/appengine"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.AppEngineApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderAppEngineConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.AppEngineApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AppEngineApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(ctx, e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create App Engine client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageAppEngineSync:
+		status = e.ensureSync(ctx)
+	case model.StageAppEnginePromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for app engine application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	app, ok := loadAppYAML(&e.Input, e.appCfg.Input.AppYAMLFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	serviceID := app.ServiceID()
+
+	if _, ok := e.savePrimaryVersion(ctx, serviceID); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	versionID, ok := deployVersion(ctx, &e.Input, e.client, app, e.Deployment.CommitHash(), e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !updateTraffic(ctx, &e.Input, e.client, serviceID, map[string]int{versionID: 100}, e.appCfg.Input.SplitBy, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pruneVersions(ctx, &e.Input, e.client, serviceID, e.appCfg.Input.VersionRetention)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.AppEnginePromoteStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	app, ok := loadAppYAML(&e.Input, e.appCfg.Input.AppYAMLFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	serviceID := app.ServiceID()
+
+	primary, ok := e.savePrimaryVersion(ctx, serviceID)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The version is deployed by the first promote stage and reused by the following ones.
+	versionID, ok := deployVersion(ctx, &e.Input, e.client, app, e.Deployment.CommitHash(), e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	percent := options.Percent.Int()
+	if !updateTraffic(ctx, &e.Input, e.client, serviceID, promoteTraffic(primary, versionID, percent), e.appCfg.Input.SplitBy, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if percent == 100 {
+		pruneVersions(ctx, &e.Input, e.client, serviceID, e.appCfg.Input.VersionRetention)
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// savePrimaryVersion returns the version which was receiving the most traffic before the deployment.
+// It is saved to the shared metadata by the first stage so that the following stages and the rollback
+// can use it even after the traffic has been changed.
+// Empty is returned when the service does not exist yet.
+func (e *deployExecutor) savePrimaryVersion(ctx context.Context, serviceID string) (string, bool) {
+	if primary, ok := e.MetadataStore.Shared().Get(primaryVersionMetadataKey); ok {
+		return primary, true
+	}
+
+	svc, ok := getLiveService(ctx, &e.Input, e.client, serviceID)
+	if !ok {
+		return "", false
+	}
+	if svc == nil {
+		return "", true
+	}
+
+	primary := svc.PrimaryVersionID()
+	if primary == "" {
+		return "", true
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, primaryVersionMetadataKey, primary); err != nil {
+		e.LogPersister.Errorf("Failed to save the primary version to metadata: %v", err)
+		return "", false
+	}
+	e.LogPersister.Infof("Version %s is receiving the traffic of service %s before the deployment", primary, serviceID)
+	return primary, true
+}
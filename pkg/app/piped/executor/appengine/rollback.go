@@ -0,0 +1,109 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/appengine"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for app engine application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(ctx, platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create App Engine client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.AppEngineApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AppEngineApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	app, ok := loadAppYAML(&e.Input, appCfg.Input.AppYAMLFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	serviceID := app.ServiceID()
+
+	// Route the traffic back to the version which was receiving it before the deployment if it is still there.
+	if primary, ok := e.MetadataStore.Shared().Get(primaryVersionMetadataKey); ok {
+		_, err := client.GetVersion(ctx, serviceID, primary)
+		switch {
+		case err == nil:
+			if !updateTraffic(ctx, &e.Input, client, serviceID, map[string]int{primary: 100}, appCfg.Input.SplitBy, e.Deployment.RunningCommitHash) {
+				return model.StageStatus_STAGE_FAILURE
+			}
+			return model.StageStatus_STAGE_SUCCESS
+		case errors.Is(err, provider.ErrNotFound):
+			e.LogPersister.Infof("Version %s no longer exists, deploying the last deployed commit instead", primary)
+		default:
+			e.LogPersister.Errorf("Failed to get version %s of service %s: %v", primary, serviceID, err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	versionID, ok := deployVersion(ctx, &e.Input, client, app, e.Deployment.RunningCommitHash, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !updateTraffic(ctx, &e.Input, client, serviceID, map[string]int{versionID: 100}, appCfg.Input.SplitBy, e.Deployment.RunningCommitHash) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
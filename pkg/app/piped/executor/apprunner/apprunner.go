@@ -0,0 +1,274 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The interval to check the status of the service while an operation is in progress.
+var serviceStatusCheckInterval = 10 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageAppRunnerSync, f)
+	r.Register(model.StageAppRunnerCanaryRollout, f)
+	r.Register(model.StageAppRunnerTrafficRouting, f)
+	r.Register(model.StageAppRunnerPromote, f)
+	r.Register(model.StageAppRunnerCanaryClean, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_APPRUNNER, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderAppRunnerConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_APPRUNNER)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.AppRunnerConfig
+	found = true
+	return
+}
+
+func loadServiceManifest(in *executor.Input, serviceManifestFile string, ds *deploysource.DeploySource) (provider.ServiceManifest, bool) {
+	in.LogPersister.Infof("Loading service manifest at commit %s", ds.Revision)
+
+	sm, err := provider.LoadServiceManifest(ds.AppDir, serviceManifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load service manifest (%v)", err)
+		return provider.ServiceManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the service manifest at commit %s", ds.Revision)
+	return sm, true
+}
+
+// canaryServiceManifest returns the manifest of the CANARY service made from the given one.
+func canaryServiceManifest(sm provider.ServiceManifest) provider.ServiceManifest {
+	canary := sm
+	canary.Spec.ServiceName = provider.CanaryServiceName(sm.Spec.ServiceName)
+	canary.Spec.Tags = make(map[string]string, len(sm.Spec.Tags))
+	for k, v := range sm.Spec.Tags {
+		canary.Spec.Tags[k] = v
+	}
+	return canary
+}
+
+// applyServiceManifest creates or updates the service to the given manifest of the given commit
+// and waits until the service becomes running with it.
+func applyServiceManifest(ctx context.Context, in *executor.Input, client provider.Client, sm provider.ServiceManifest, commitHash string) (*provider.Service, bool) {
+	name := sm.Spec.ServiceName
+	tags := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+	sm.AddTags(tags)
+
+	in.LogPersister.Infof("Applying the service manifest of %s to deploy image %s", name, sm.ImageIdentifier())
+	service, err := client.FindService(ctx, name)
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		service, err = client.CreateService(ctx, sm)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to create service %s: %v", name, err)
+			return nil, false
+		}
+		in.LogPersister.Infof("Created service %s", name)
+
+	case err != nil:
+		in.LogPersister.Errorf("Failed to find service %s: %v", name, err)
+		return nil, false
+
+	default:
+		if service.Status == provider.ServiceStatusCreateFailed {
+			in.LogPersister.Errorf("Unable to update service %s because its creation has failed, please delete it to create it again", name)
+			return nil, false
+		}
+		// Another operation such as the previous deployment must be completed before updating.
+		if service.InProgress() {
+			in.LogPersister.Infof("Waiting for the ongoing operation of service %s to complete", name)
+			if service, err = waitForOperation(ctx, client, service.ServiceArn); err != nil {
+				in.LogPersister.Errorf("Failed while waiting for service %s: %v", name, err)
+				return nil, false
+			}
+		}
+		if service, err = client.UpdateService(ctx, service.ServiceArn, sm); err != nil {
+			in.LogPersister.Errorf("Failed to update service %s: %v", name, err)
+			return nil, false
+		}
+		// The tags are not updated by UpdateService.
+		if err := client.TagResource(ctx, service.ServiceArn, tags); err != nil {
+			in.LogPersister.Errorf("Failed to update the tags of service %s: %v", name, err)
+			return nil, false
+		}
+		in.LogPersister.Infof("Updated service %s", name)
+	}
+
+	in.LogPersister.Infof("Waiting for service %s to be running with the new version", name)
+	service, err = waitForOperation(ctx, client, service.ServiceArn)
+	if err != nil {
+		in.LogPersister.Errorf("Failed while waiting for service %s: %v", name, err)
+		return nil, false
+	}
+	if service.Status != provider.ServiceStatusRunning {
+		in.LogPersister.Errorf("Service %s ended up with status %s, please check its event logs on AWS console", name, service.Status)
+		return nil, false
+	}
+
+	in.LogPersister.Infof("Successfully deployed service %s, it is available at %s", name, service.ServiceURL)
+	return service, true
+}
+
+// waitForOperation waits until no operation is in progress on the given service.
+func waitForOperation(ctx context.Context, client provider.Client, serviceArn string) (*provider.Service, error) {
+	ticker := time.NewTicker(serviceStatusCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		service, err := client.DescribeService(ctx, serviceArn)
+		if err != nil {
+			return nil, err
+		}
+		if !service.InProgress() {
+			return service, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// findService returns the service having the given name or nil when there is no such service.
+func findService(ctx context.Context, client provider.Client, name string) (*provider.Service, error) {
+	service, err := client.FindService(ctx, name)
+	if errors.Is(err, provider.ErrNotFound) {
+		return nil, nil
+	}
+	return service, err
+}
+
+// buildWeightedRecordChanges returns the changes to route the given percentage of traffic
+// to the CANARY service and the rest to the PRIMARY service.
+// The CANARY record is skipped when the CANARY service is nil.
+func buildWeightedRecordChanges(routing *config.AppRunnerTrafficRouting, primary, canary *provider.Service, canaryPercent int) []provider.WeightedRecordChange {
+	changes := []provider.WeightedRecordChange{
+		{
+			Action:        provider.WeightedRecordActionUpsert,
+			RecordName:    routing.RecordName,
+			SetIdentifier: provider.PrimaryRecordSetIdentifier,
+			Target:        primary.ServiceURL,
+			Weight:        int64(100 - canaryPercent),
+			TTL:           routing.TTL,
+		},
+	}
+	if canary != nil {
+		changes = append(changes, provider.WeightedRecordChange{
+			Action:        provider.WeightedRecordActionUpsert,
+			RecordName:    routing.RecordName,
+			SetIdentifier: provider.CanaryRecordSetIdentifier,
+			Target:        canary.ServiceURL,
+			Weight:        int64(canaryPercent),
+			TTL:           routing.TTL,
+		})
+	}
+	return changes
+}
+
+// routeTraffic routes the given percentage of traffic to the CANARY service and the rest to the PRIMARY service.
+func routeTraffic(ctx context.Context, in *executor.Input, client provider.Client, routing *config.AppRunnerTrafficRouting, primary, canary *provider.Service, canaryPercent int) bool {
+	changes := buildWeightedRecordChanges(routing, primary, canary, canaryPercent)
+	if err := client.ChangeWeightedRecords(ctx, routing.HostedZoneID, changes); err != nil {
+		in.LogPersister.Errorf("Failed to update the weighted records of %s: %v", routing.RecordName, err)
+		return false
+	}
+	if canary == nil {
+		in.LogPersister.Infof("Successfully routed all traffic of %s to the PRIMARY service", routing.RecordName)
+	} else {
+		in.LogPersister.Infof("Successfully routed %d%% of traffic of %s to the PRIMARY service and %d%% to the CANARY service", 100-canaryPercent, routing.RecordName, canaryPercent)
+	}
+	return true
+}
+
+// removeCanary routes all traffic back to the PRIMARY service and deletes the CANARY service together with its record.
+func removeCanary(ctx context.Context, in *executor.Input, client provider.Client, routing *config.AppRunnerTrafficRouting, serviceName string) bool {
+	canaryName := provider.CanaryServiceName(serviceName)
+	canary, err := findService(ctx, client, canaryName)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to find the CANARY service %s: %v", canaryName, err)
+		return false
+	}
+	if canary == nil {
+		in.LogPersister.Infof("The CANARY service %s does not exist, nothing to clean", canaryName)
+		return true
+	}
+
+	if routing != nil {
+		primary, err := findService(ctx, client, serviceName)
+		if err != nil || primary == nil {
+			in.LogPersister.Errorf("Failed to find the PRIMARY service %s: %v", serviceName, err)
+			return false
+		}
+		if !routeTraffic(ctx, in, client, routing, primary, canary, 0) {
+			return false
+		}
+		// The deleted record must match the current one, so it is deleted after its weight became zero.
+		del := buildWeightedRecordChanges(routing, primary, canary, 0)[1]
+		del.Action = provider.WeightedRecordActionDelete
+		if err := client.ChangeWeightedRecords(ctx, routing.HostedZoneID, []provider.WeightedRecordChange{del}); err != nil {
+			in.LogPersister.Errorf("Failed to delete the CANARY record of %s: %v", routing.RecordName, err)
+			return false
+		}
+	}
+
+	if _, err := client.DeleteService(ctx, canary.ServiceArn); err != nil {
+		in.LogPersister.Errorf("Failed to delete the CANARY service %s: %v", canaryName, err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully deleted the CANARY service %s", canaryName)
+	return true
+}
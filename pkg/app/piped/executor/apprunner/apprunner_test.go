@@ -0,0 +1,96 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestBuildWeightedRecordChanges(t *testing.T) {
+	t.Parallel()
+
+	routing := &config.AppRunnerTrafficRouting{
+		HostedZoneID: "Z123",
+		RecordName:   "app.example.com",
+		TTL:          30,
+	}
+	primary := &provider.Service{ServiceURL: "primary.awsapprunner.com"}
+	canary := &provider.Service{ServiceURL: "canary.awsapprunner.com"}
+
+	testcases := []struct {
+		name          string
+		canary        *provider.Service
+		canaryPercent int
+		expected      []provider.WeightedRecordChange
+	}{
+		{
+			name:          "without canary service",
+			canaryPercent: 0,
+			expected: []provider.WeightedRecordChange{
+				{Action: "UPSERT", RecordName: "app.example.com", SetIdentifier: "pipecd-primary", Target: "primary.awsapprunner.com", Weight: 100, TTL: 30},
+			},
+		},
+		{
+			name:          "split traffic",
+			canary:        canary,
+			canaryPercent: 20,
+			expected: []provider.WeightedRecordChange{
+				{Action: "UPSERT", RecordName: "app.example.com", SetIdentifier: "pipecd-primary", Target: "primary.awsapprunner.com", Weight: 80, TTL: 30},
+				{Action: "UPSERT", RecordName: "app.example.com", SetIdentifier: "pipecd-canary", Target: "canary.awsapprunner.com", Weight: 20, TTL: 30},
+			},
+		},
+		{
+			name:          "all traffic to canary",
+			canary:        canary,
+			canaryPercent: 100,
+			expected: []provider.WeightedRecordChange{
+				{Action: "UPSERT", RecordName: "app.example.com", SetIdentifier: "pipecd-primary", Target: "primary.awsapprunner.com", Weight: 0, TTL: 30},
+				{Action: "UPSERT", RecordName: "app.example.com", SetIdentifier: "pipecd-canary", Target: "canary.awsapprunner.com", Weight: 100, TTL: 30},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			changes := buildWeightedRecordChanges(routing, primary, tc.canary, tc.canaryPercent)
+			assert.Equal(t, tc.expected, changes)
+		})
+	}
+}
+
+func TestCanaryServiceManifest(t *testing.T) {
+	t.Parallel()
+
+	sm := provider.ServiceManifest{
+		Spec: provider.ServiceManifestSpec{
+			ServiceName: "simple",
+			Tags:        map[string]string{"app": "simple"},
+		},
+	}
+	canary := canaryServiceManifest(sm)
+	canary.AddTags(map[string]string{"role": "canary"})
+
+	assert.Equal(t, "simple-canary", canary.Spec.ServiceName)
+	assert.Equal(t, map[string]string{"app": "simple", "role": "canary"}, canary.Spec.Tags)
+	// The original manifest must not be changed.
+	assert.Equal(t, "simple", sm.Spec.ServiceName)
+	assert.Equal(t, map[string]string{"app": "simple"}, sm.Spec.Tags)
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const canaryPercentageMetadataKey = "canary-percentage"
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.AppRunnerApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderAppRunnerConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.AppRunnerApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AppRunnerApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create App Runner client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageAppRunnerSync:
+		status = e.ensureSync(ctx)
+	case model.StageAppRunnerCanaryRollout:
+		status = e.ensureCanaryRollout(ctx)
+	case model.StageAppRunnerTrafficRouting:
+		status = e.ensureTrafficRouting(ctx)
+	case model.StageAppRunnerPromote:
+		status = e.ensurePromote(ctx)
+	case model.StageAppRunnerCanaryClean:
+		status = e.ensureCanaryClean(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for apprunner application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.deployPrimary(ctx, sm) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageStatus {
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The CANARY service receives no traffic until the traffic routing stage.
+	if _, ok := applyServiceManifest(ctx, &e.Input, e.client, canaryServiceManifest(sm), e.Deployment.CommitHash()); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.AppRunnerTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	routing := e.appCfg.Input.TrafficRouting
+	if routing == nil {
+		e.LogPersister.Errorf("Missing trafficRouting in the input of application configuration")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	primary, err := findService(ctx, e.client, sm.Spec.ServiceName)
+	if err != nil || primary == nil {
+		e.LogPersister.Errorf("Failed to find the PRIMARY service %s: %v", sm.Spec.ServiceName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	canaryName := provider.CanaryServiceName(sm.Spec.ServiceName)
+	canary, err := findService(ctx, e.client, canaryName)
+	if err != nil || canary == nil {
+		e.LogPersister.Errorf("Failed to find the CANARY service %s, it must be deployed by %s stage before: %v", canaryName, model.StageAppRunnerCanaryRollout, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	percent := options.Canary.Int()
+	metadata := map[string]string{
+		canaryPercentageMetadataKey: strconv.FormatInt(int64(percent), 10),
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+	}
+
+	if !routeTraffic(ctx, &e.Input, e.client, routing, primary, canary, percent) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.deployPrimary(ctx, sm) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryClean(ctx context.Context) model.StageStatus {
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !removeCanary(ctx, &e.Input, e.client, e.appCfg.Input.TrafficRouting, sm.Spec.ServiceName) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// deployPrimary deploys the given manifest to the PRIMARY service and routes all traffic to it.
+func (e *deployExecutor) deployPrimary(ctx context.Context, sm provider.ServiceManifest) bool {
+	primary, ok := applyServiceManifest(ctx, &e.Input, e.client, sm, e.Deployment.CommitHash())
+	if !ok {
+		return false
+	}
+
+	routing := e.appCfg.Input.TrafficRouting
+	if routing == nil {
+		return true
+	}
+	canaryName := provider.CanaryServiceName(sm.Spec.ServiceName)
+	canary, err := findService(ctx, e.client, canaryName)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to find the CANARY service %s: %v", canaryName, err)
+		return false
+	}
+	return routeTraffic(ctx, &e.Input, e.client, routing, primary, canary, 0)
+}
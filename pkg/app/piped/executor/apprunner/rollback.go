@@ -0,0 +1,93 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for apprunner application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.AppRunnerApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AppRunnerApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create App Runner client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, appCfg.Input.ServiceManifestFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Restore the PRIMARY service before removing the CANARY service
+	// so that the traffic is always served by a running service.
+	if _, ok := applyServiceManifest(ctx, &e.Input, client, sm, e.Deployment.RunningCommitHash); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !removeCanary(ctx, &e.Input, client, appCfg.Input.TrafficRouting, sm.Spec.ServiceName) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return model.StageStatus_STAGE_SUCCESS
+}
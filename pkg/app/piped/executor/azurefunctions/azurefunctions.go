@@ -0,0 +1,190 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageAzureFunctionsSync, f)
+	r.Register(model.StageAzureFunctionsSlotDeploy, f)
+	r.Register(model.StageAzureFunctionsSwap, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_AZUREFUNCTIONS, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderAzureFunctionsConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_AZUREFUNCTIONS)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.AzureFunctionsConfig
+	found = true
+	return
+}
+
+func loadFunctionAppManifest(in *executor.Input, manifestFile string, ds *deploysource.DeploySource) (provider.FunctionAppManifest, bool) {
+	in.LogPersister.Infof("Loading function app manifest at commit %s", ds.Revision)
+
+	m, err := provider.LoadFunctionAppManifest(ds.AppDir, manifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load function app manifest (%v)", err)
+		return provider.FunctionAppManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the function app manifest at commit %s", ds.Revision)
+	return m, true
+}
+
+func makeTags(in *executor.Input, commitHash string) map[string]string {
+	return map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+}
+
+// slotName returns the name of the given slot used in the messages.
+func slotName(slot string) string {
+	if slot == "" {
+		return provider.ProductionSlot
+	}
+	return slot
+}
+
+// deploy deploys the package or the container image of the given manifest to the given slot of the function app.
+// The empty slot means production. The slot is created when it does not exist yet.
+// The app settings and the tags not specified in the manifest are kept as they are.
+func deploy(ctx context.Context, in *executor.Input, client provider.Client, appDir string, m provider.FunctionAppManifest, slot, commitHash string) bool {
+	name := m.Spec.Name
+
+	site, err := client.GetSite(ctx, name, slot)
+	switch {
+	case errors.Is(err, provider.ErrNotFound) && slot != "":
+		in.LogPersister.Infof("Creating deployment slot %s of function app %s", slot, name)
+		if site, err = client.CreateSlot(ctx, name, slot); err != nil {
+			in.LogPersister.Errorf("Failed to create deployment slot %s of function app %s: %v", slot, name, err)
+			return false
+		}
+	case errors.Is(err, provider.ErrNotFound):
+		in.LogPersister.Errorf("Function app %s was not found. It must be created with its plan and storage account beforehand.", name)
+		return false
+	case err != nil:
+		in.LogPersister.Errorf("Failed to find function app %s: %v", name, err)
+		return false
+	}
+
+	current, err := client.ListAppSettings(ctx, name, slot)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the app settings of %s slot: %v", slotName(slot), err)
+		return false
+	}
+	if err := client.UpdateAppSettings(ctx, name, slot, m.MergeAppSettings(current)); err != nil {
+		in.LogPersister.Errorf("Failed to update the app settings of %s slot: %v", slotName(slot), err)
+		return false
+	}
+	in.LogPersister.Infof("Updated %d app settings of %s slot", len(m.AppSettings()), slotName(slot))
+
+	// The tags added by Azure such as the links to Application Insights are kept.
+	tags := make(map[string]string, len(site.Tags))
+	for k, v := range site.Tags {
+		tags[k] = v
+	}
+	for k, v := range m.Tags(makeTags(in, commitHash)) {
+		tags[k] = v
+	}
+	if err := client.UpdateTags(ctx, name, slot, tags); err != nil {
+		in.LogPersister.Errorf("Failed to update the tags of %s slot: %v", slotName(slot), err)
+		return false
+	}
+
+	switch {
+	case m.Spec.Container != nil:
+		if err := client.UpdateContainerImage(ctx, name, slot, m.Spec.Container.Image); err != nil {
+			in.LogPersister.Errorf("Failed to update the container image of %s slot: %v", slotName(slot), err)
+			return false
+		}
+		in.LogPersister.Infof("Updated %s slot to run container image %s", slotName(slot), m.Spec.Container.Image)
+
+	case m.ZipDeploy():
+		pkg, err := provider.ZipPackage(appDir, m)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to create the package: %v", err)
+			return false
+		}
+		in.LogPersister.Infof("Uploading the package of %d bytes to %s slot", pkg.Len(), slotName(slot))
+		if err := client.DeployZip(ctx, site, pkg); err != nil {
+			in.LogPersister.Errorf("Failed to deploy the package to %s slot: %v", slotName(slot), err)
+			return false
+		}
+
+	default:
+		in.LogPersister.Infof("Updated %s slot to run from package %s", slotName(slot), m.Spec.Package.URL)
+	}
+
+	site, err = client.GetSite(ctx, name, slot)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the state of %s slot: %v", slotName(slot), err)
+		return false
+	}
+	if !site.Running() {
+		in.LogPersister.Errorf("The %s slot of function app %s is not running, its state is %q", slotName(slot), name, site.Properties.State)
+		return false
+	}
+
+	in.LogPersister.Successf("Successfully deployed commit %s to %s slot of function app %s", commitHash, slotName(slot), name)
+	return true
+}
+
+func swap(ctx context.Context, in *executor.Input, client provider.Client, name, slot string) bool {
+	in.LogPersister.Infof("Swapping %s slot with production of function app %s", slot, name)
+	if err := client.SwapSlot(ctx, name, slot); err != nil {
+		in.LogPersister.Errorf("Failed to swap %s slot with production: %v", slot, err)
+		return false
+	}
+	in.LogPersister.Successf("Successfully swapped %s slot with production of function app %s", slot, name)
+	return true
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeClient struct {
+	provider.Client
+	sites    map[string]*provider.Site
+	settings map[string]map[string]string
+	images   map[string]string
+	created  []string
+}
+
+func (c *fakeClient) GetSite(_ context.Context, _, slot string) (*provider.Site, error) {
+	s, ok := c.sites[slot]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+	return s, nil
+}
+
+func (c *fakeClient) CreateSlot(_ context.Context, name, slot string) (*provider.Site, error) {
+	s := &provider.Site{
+		Name:       name + "/" + slot,
+		Tags:       c.sites[""].Tags,
+		Properties: provider.SiteProperties{State: "Running"},
+	}
+	c.sites[slot] = s
+	c.created = append(c.created, slot)
+	return s, nil
+}
+
+func (c *fakeClient) UpdateTags(_ context.Context, _, slot string, tags map[string]string) error {
+	c.sites[slot].Tags = tags
+	return nil
+}
+
+func (c *fakeClient) ListAppSettings(_ context.Context, _, slot string) (map[string]string, error) {
+	return c.settings[slot], nil
+}
+
+func (c *fakeClient) UpdateAppSettings(_ context.Context, _, slot string, settings map[string]string) error {
+	c.settings[slot] = settings
+	return nil
+}
+
+func (c *fakeClient) UpdateContainerImage(_ context.Context, _, slot, image string) error {
+	c.images[slot] = image
+	return nil
+}
+
+func TestDeploy(t *testing.T) {
+	t.Parallel()
+
+	m := provider.FunctionAppManifest{
+		Spec: provider.FunctionAppManifestSpec{
+			Name:        "orders",
+			Container:   &provider.Container{Image: "myregistry.azurecr.io/orders:v1.1.0"},
+			AppSettings: map[string]string{"QUEUE_NAME": "orders-v2"},
+			Tags:        map[string]string{"team": "payment"},
+		},
+	}
+	in := &executor.Input{
+		LogPersister: &fakeLogPersister{},
+		PipedConfig:  &config.PipedSpec{PipedID: "piped-1"},
+		Deployment:   &model.Deployment{ApplicationId: "app-1"},
+	}
+	newClient := func() *fakeClient {
+		return &fakeClient{
+			sites: map[string]*provider.Site{
+				"": {
+					Name:       "orders",
+					Tags:       map[string]string{"hidden-link: /app-insights-resource-id": "/subscriptions/sub/insights"},
+					Properties: provider.SiteProperties{State: "Running"},
+				},
+			},
+			settings: map[string]map[string]string{
+				"":        {"QUEUE_NAME": "orders", "AzureWebJobsStorage": "secret"},
+				"staging": {},
+			},
+			images: map[string]string{},
+		}
+	}
+	expectedTags := map[string]string{
+		"hidden-link: /app-insights-resource-id": "/subscriptions/sub/insights",
+		"team":                                   "payment",
+		provider.LabelManagedBy:                  provider.ManagedByPiped,
+		provider.LabelPiped:                      "piped-1",
+		provider.LabelApplication:                "app-1",
+		provider.LabelCommitHash:                 "0123abcdef",
+	}
+
+	// Deploy to production directly.
+	client := newClient()
+	ok := deploy(context.Background(), in, client, t.TempDir(), m, "", "0123abcdef")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"QUEUE_NAME": "orders-v2", "AzureWebJobsStorage": "secret"}, client.settings[""])
+	assert.Equal(t, "myregistry.azurecr.io/orders:v1.1.0", client.images[""])
+	assert.Equal(t, expectedTags, client.sites[""].Tags)
+	assert.Empty(t, client.created)
+
+	// The missing deployment slot is created.
+	client = newClient()
+	ok = deploy(context.Background(), in, client, t.TempDir(), m, "staging", "0123abcdef")
+	assert.True(t, ok)
+	assert.Equal(t, []string{"staging"}, client.created)
+	assert.Equal(t, map[string]string{"QUEUE_NAME": "orders-v2"}, client.settings["staging"])
+	assert.Equal(t, "myregistry.azurecr.io/orders:v1.1.0", client.images["staging"])
+	assert.Equal(t, expectedTags, client.sites["staging"].Tags)
+	assert.Empty(t, client.images[""])
+
+	// The function app itself must exist.
+	client = newClient()
+	delete(client.sites, "")
+	ok = deploy(context.Background(), in, client, t.TempDir(), m, "", "0123abcdef")
+	assert.False(t, ok)
+
+	// The stopped function app is reported as a failure.
+	client = newClient()
+	client.sites[""].Properties.State = "Stopped"
+	ok = deploy(context.Background(), in, client, t.TempDir(), m, "", "0123abcdef")
+	assert.False(t, ok)
+}
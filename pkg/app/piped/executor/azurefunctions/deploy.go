@@ -0,0 +1,155 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// Whether the deployment slot has been swapped with production by this deployment.
+	swappedMetadataKey = "azurefunctions-swapped"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.AzureFunctionsApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderAzureFunctionsConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.AzureFunctionsApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AzureFunctionsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Azure Functions client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageAzureFunctionsSync:
+		status = e.ensureSync(ctx)
+	case model.StageAzureFunctionsSlotDeploy:
+		status = e.ensureSlotDeploy(ctx)
+	case model.StageAzureFunctionsSwap:
+		status = e.ensureSwap(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for azurefunctions application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	m, ok := loadFunctionAppManifest(&e.Input, e.appCfg.Input.FunctionAppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Without the deployment slot, the new version is deployed to production directly.
+	slot := e.appCfg.Input.Slot
+	if !deploy(ctx, &e.Input, e.client, e.deploySource.AppDir, m, slot, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if slot == "" {
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if !e.swap(ctx, m.Spec.Name) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureSlotDeploy(ctx context.Context) model.StageStatus {
+	if e.appCfg.Input.Slot == "" {
+		e.LogPersister.Errorf("Unable to run %s stage without slot in the application configuration", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadFunctionAppManifest(&e.Input, e.appCfg.Input.FunctionAppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !deploy(ctx, &e.Input, e.client, e.deploySource.AppDir, m, e.appCfg.Input.Slot, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureSwap(ctx context.Context) model.StageStatus {
+	if e.appCfg.Input.Slot == "" {
+		e.LogPersister.Errorf("Unable to run %s stage without slot in the application configuration", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadFunctionAppManifest(&e.Input, e.appCfg.Input.FunctionAppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.swap(ctx, m.Spec.Name) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// swap swaps the deployment slot with production and records it
+// so that the rollback can swap the previous version back into production.
+func (e *deployExecutor) swap(ctx context.Context, name string) bool {
+	if !swap(ctx, &e.Input, e.client, name, e.appCfg.Input.Slot) {
+		return false
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, swappedMetadataKey, "true"); err != nil {
+		e.LogPersister.Errorf("Failed to save the swap to metadata: %v", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for azurefunctions application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	targetDS, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	targetCfg := targetDS.ApplicationConfig.AzureFunctionsApplicationSpec
+	if targetCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AzureFunctionsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Azure Functions client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if slot := targetCfg.Input.Slot; slot != "" {
+		return e.rollbackSwap(ctx, client, targetDS.AppDir, targetCfg.Input.FunctionAppManifestFile, slot)
+	}
+	return e.rollbackProduction(ctx, client)
+}
+
+// rollbackSwap swaps the previous version back into production when the deployment slot has been swapped.
+// Production is not changed until the swap, so there is nothing to roll back otherwise.
+func (e *rollbackExecutor) rollbackSwap(ctx context.Context, client provider.Client, appDir, manifestFile, slot string) model.StageStatus {
+	if swapped, _ := e.MetadataStore.Shared().Get(swappedMetadataKey); swapped != "true" {
+		e.LogPersister.Infof("The %s slot was not swapped with production, there is nothing to rollback", slot)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	m, err := provider.LoadFunctionAppManifest(appDir, manifestFile)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to load function app manifest (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The previous version of production is running on the slot after the swap.
+	if !swap(ctx, &e.Input, client, m.Spec.Name, slot) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, swappedMetadataKey, "false"); err != nil {
+		e.LogPersister.Errorf("Failed to save the swap to metadata: %v", err)
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rollbackProduction deploys the last deployed commit to production again.
+func (e *rollbackExecutor) rollbackProduction(ctx context.Context, client provider.Client) model.StageStatus {
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.AzureFunctionsApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing AzureFunctionsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadFunctionAppManifest(&e.Input, appCfg.Input.FunctionAppManifestFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !deploy(ctx, &e.Input, client, runningDS.AppDir, m, "", e.Deployment.RunningCommitHash) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
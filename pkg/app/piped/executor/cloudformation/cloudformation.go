@@ -0,0 +1,202 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The interval to check the status of the change set and the stack.
+var statusCheckInterval = 10 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageCloudFormationSync, f)
+	r.Register(model.StageCloudFormationChangeSet, f)
+	r.Register(model.StageCloudFormationExecute, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_CLOUDFORMATION, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderCloudFormationConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_CLOUDFORMATION)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.CloudFormationConfig
+	found = true
+	return
+}
+
+// changeSetName returns the name of the change set created by the given deployment.
+func changeSetName(deploymentID string) string {
+	return "pipecd-" + deploymentID
+}
+
+// rollbackChangeSetName returns the name of the change set created to roll back the given deployment.
+func rollbackChangeSetName(deploymentID string) string {
+	return "pipecd-rollback-" + deploymentID
+}
+
+// makeChangeSetInput returns the input to create a change set deploying the template at the given commit.
+func makeChangeSetInput(in *executor.Input, input config.CloudFormationDeploymentInput, appDir, name, commitHash string) (provider.ChangeSetInput, error) {
+	template, err := provider.LoadTemplate(appDir, input.TemplateFile)
+	if err != nil {
+		return provider.ChangeSetInput{}, fmt.Errorf("failed to load template %s: %w", input.TemplateFile, err)
+	}
+
+	return provider.ChangeSetInput{
+		StackName:     input.StackName,
+		ChangeSetName: name,
+		TemplateBody:  template,
+		Parameters:    input.Parameters,
+		Capabilities:  input.Capabilities,
+		Tags:          provider.MakeStackTags(input.Tags, in.PipedConfig.PipedID, in.Deployment.ApplicationId),
+		RoleARN:       input.RoleARN,
+		Description:   fmt.Sprintf("Created by PipeCD for deployment %s at commit %s", in.Deployment.Id, commitHash),
+	}, nil
+}
+
+// prepareChangeSet creates the change set and shows its changes.
+func prepareChangeSet(ctx context.Context, in *executor.Input, client provider.Client, csi provider.ChangeSetInput) (*provider.ChangeSet, bool) {
+	in.LogPersister.Infof("Creating change set %s of stack %s", csi.ChangeSetName, csi.StackName)
+	cs, err := provider.PrepareChangeSet(ctx, client, csi, statusCheckInterval)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to create change set (%v)", err)
+		return nil, false
+	}
+	if cs.NoChanges() {
+		return cs, true
+	}
+
+	if cs.ChangeSetType == provider.ChangeSetTypeCreate {
+		in.LogPersister.Infof("Stack %s will be created with the following resources", csi.StackName)
+	} else {
+		in.LogPersister.Infof("Stack %s will be updated with the following changes", csi.StackName)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(cs.Render(), "\n"), "\n") {
+		if line != "" {
+			in.LogPersister.Info(line)
+		}
+	}
+	in.LogPersister.Infof("Change set %s: %s", csi.ChangeSetName, cs.Summary())
+	return cs, true
+}
+
+// executeChangeSet executes the change set and waits until the stack is updated.
+func executeChangeSet(ctx context.Context, in *executor.Input, client provider.Client, stackName, name string) bool {
+	cs, err := client.DescribeChangeSet(ctx, stackName, name)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to find change set %s (%v)", name, err)
+		return false
+	}
+	if cs.ExecutionStatus != provider.ChangeSetExecutionStatusAvailable {
+		in.LogPersister.Errorf("Change set %s can not be executed since its execution status is %s. It might be outdated by another change to the stack", name, cs.ExecutionStatus)
+		return false
+	}
+
+	in.LogPersister.Infof("Executing change set %s of stack %s", name, stackName)
+	startedAt := time.Now()
+	if err := client.ExecuteChangeSet(ctx, stackName, name); err != nil {
+		in.LogPersister.Errorf("Failed to execute change set (%v)", err)
+		return false
+	}
+
+	in.LogPersister.Infof("Waiting for stack %s to be updated", stackName)
+	stack, err := provider.WaitStack(ctx, client, stackName, statusCheckInterval)
+	if err != nil {
+		in.LogPersister.Errorf("Failed while waiting for stack %s (%v)", stackName, err)
+		return false
+	}
+	if !stack.Succeeded() {
+		in.LogPersister.Errorf("Stack %s ended up with status %s (%s)", stackName, stack.StackStatus, stack.StackStatusReason)
+		reportFailedEvents(ctx, in, client, stackName, startedAt)
+		return false
+	}
+
+	for _, o := range stack.Outputs {
+		in.LogPersister.Infof("Output %s: %s", o.OutputKey, o.OutputValue)
+	}
+	in.LogPersister.Successf("Successfully executed change set %s, stack %s is %s", name, stackName, stack.StackStatus)
+	return true
+}
+
+// reportFailedEvents shows the failures of the resources since the given time to tell why the stack failed.
+func reportFailedEvents(ctx context.Context, in *executor.Input, client provider.Client, stackName string, since time.Time) {
+	events, err := client.DescribeStackEvents(ctx, stackName)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to get the events of stack %s (%v)", stackName, err)
+		return
+	}
+	// The events are returned in reverse chronological order.
+	for i := len(events) - 1; i >= 0; i-- {
+		e := events[i]
+		if e.Timestamp.Before(since) || !e.Failed() {
+			continue
+		}
+		in.LogPersister.Errorf("%s (%s) %s: %s", e.LogicalResourceID, e.ResourceType, e.ResourceStatus, e.ResourceStatusReason)
+	}
+}
+
+// deletePendingChangeSet deletes the change set which has not been executed yet.
+func deletePendingChangeSet(ctx context.Context, in *executor.Input, client provider.Client, stackName, name string) bool {
+	cs, err := client.DescribeChangeSet(ctx, stackName, name)
+	if errors.Is(err, provider.ErrNotFound) {
+		return true
+	}
+	if err != nil {
+		in.LogPersister.Errorf("Unable to find change set %s (%v)", name, err)
+		return false
+	}
+	if cs.ExecutionStatus != provider.ChangeSetExecutionStatusAvailable {
+		return true
+	}
+	if err := client.DeleteChangeSet(ctx, stackName, name); err != nil {
+		in.LogPersister.Errorf("Failed to delete change set %s (%v)", name, err)
+		return false
+	}
+	in.LogPersister.Infof("Deleted change set %s which has not been executed", name)
+	return true
+}
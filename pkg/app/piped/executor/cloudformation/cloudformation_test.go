@@ -0,0 +1,127 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeChangeSetInput(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(appDir, "template.yaml"), []byte("Resources: {}\n"), 0644)
+	require.NoError(t, err)
+
+	in := &executor.Input{
+		Deployment: &model.Deployment{
+			Id:            "deployment-id",
+			ApplicationId: "app-id",
+		},
+		PipedConfig: &config.PipedSpec{PipedID: "piped-id"},
+	}
+	input := config.CloudFormationDeploymentInput{
+		StackName:    "my-stack",
+		TemplateFile: "template.yaml",
+		Parameters:   map[string]string{"Env": "prod"},
+		Capabilities: []string{"CAPABILITY_IAM"},
+		Tags: map[string]string{
+			"team":                  "platform",
+			provider.LabelManagedBy: "someone",
+		},
+	}
+
+	got, err := makeChangeSetInput(in, input, appDir, "pipecd-deployment-id", "commit-hash")
+	require.NoError(t, err)
+	assert.Equal(t, provider.ChangeSetInput{
+		StackName:     "my-stack",
+		ChangeSetName: "pipecd-deployment-id",
+		TemplateBody:  "Resources: {}\n",
+		Parameters:    map[string]string{"Env": "prod"},
+		Capabilities:  []string{"CAPABILITY_IAM"},
+		Tags: map[string]string{
+			"team":                    "platform",
+			provider.LabelManagedBy:   provider.ManagedByPiped,
+			provider.LabelPiped:       "piped-id",
+			provider.LabelApplication: "app-id",
+		},
+		Description: "Created by PipeCD for deployment deployment-id at commit commit-hash",
+	}, got)
+
+	input.TemplateFile = "missing.yaml"
+	_, err = makeChangeSetInput(in, input, appDir, "pipecd-deployment-id", "commit-hash")
+	assert.Error(t, err)
+}
+
+func TestHasOnlyNoopStagesAfter(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		stages   []*model.PipelineStage
+		expected bool
+	}{
+		{
+			name: "approval and execute remain",
+			stages: []*model.PipelineStage{
+				{Id: "change-set", Name: model.StageCloudFormationChangeSet.String(), Visible: true},
+				{Id: "approval", Name: model.StageWaitApproval.String(), Visible: true},
+				{Id: "execute", Name: model.StageCloudFormationExecute.String(), Visible: true},
+				{Id: "rollback", Name: model.StageRollback.String()},
+			},
+			expected: true,
+		},
+		{
+			name: "the last stage",
+			stages: []*model.PipelineStage{
+				{Id: "change-set", Name: model.StageCloudFormationChangeSet.String(), Visible: true},
+			},
+			expected: true,
+		},
+		{
+			name: "script run remains",
+			stages: []*model.PipelineStage{
+				{Id: "change-set", Name: model.StageCloudFormationChangeSet.String(), Visible: true},
+				{Id: "execute", Name: model.StageCloudFormationExecute.String(), Visible: true},
+				{Id: "script", Name: model.StageScriptRun.String(), Visible: true},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{Stages: tc.stages},
+					Stage:      &model.PipelineStage{Id: "change-set"},
+				},
+			}
+			assert.Equal(t, tc.expected, e.hasOnlyNoopStagesAfter())
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The key of the shared metadata to store the name of the change set created by CLOUDFORMATION_CHANGE_SET stage.
+// The empty value means the change set had no changes.
+const changeSetMetadataKey = "cloudformation-change-set"
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource *deploysource.DeploySource
+	appCfg       *config.CloudFormationApplicationSpec
+	client       provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.Get(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.CloudFormationApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Error("Malformed application configuration: missing CloudFormationApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	name, cfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.client, err = provider.DefaultRegistry().Client(name, cfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create CloudFormation client for the provider %s: %v", name, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageCloudFormationSync:
+		status = e.ensureSync(ctx)
+
+	case model.StageCloudFormationChangeSet:
+		status = e.ensureChangeSet(ctx)
+
+	case model.StageCloudFormationExecute:
+		status = e.ensureExecute(ctx)
+
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for cloudformation application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	name := changeSetName(e.Deployment.Id)
+	csi, err := makeChangeSetInput(&e.Input, e.appCfg.Input, e.deploySource.AppDir, name, e.Deployment.CommitHash())
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare change set (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cs, ok := prepareChangeSet(ctx, &e.Input, e.client, csi)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if cs.NoChanges() {
+		e.LogPersister.Success("No changes to apply")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if !executeChangeSet(ctx, &e.Input, e.client, csi.StackName, name) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureChangeSet(ctx context.Context) model.StageStatus {
+	name := changeSetName(e.Deployment.Id)
+	csi, err := makeChangeSetInput(&e.Input, e.appCfg.Input, e.deploySource.AppDir, name, e.Deployment.CommitHash())
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare change set (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cs, ok := prepareChangeSet(ctx, &e.Input, e.client, csi)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if cs.NoChanges() {
+		if err := e.MetadataStore.Shared().Put(ctx, changeSetMetadataKey, ""); err != nil {
+			e.LogPersister.Errorf("Failed to store the change set to metadata store (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Success("No changes to apply")
+		if opts := e.StageConfig.CloudFormationChangeSetStageOptions; opts != nil && opts.ExitOnNoChanges {
+			return model.StageStatus_STAGE_EXITED
+		}
+		if e.hasOnlyNoopStagesAfter() {
+			e.LogPersister.Info("The remaining stages will be skipped since they have nothing to do without changes")
+			return model.StageStatus_STAGE_EXITED
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if err := e.MetadataStore.Shared().Put(ctx, changeSetMetadataKey, name); err != nil {
+		e.LogPersister.Errorf("Failed to store the change set to metadata store (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Created change set %s. It will be executed by %s stage", name, model.StageCloudFormationExecute)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureExecute(ctx context.Context) model.StageStatus {
+	name, ok := e.MetadataStore.Shared().Get(changeSetMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the change set to execute. %s stage must be executed before this stage in the same deployment", model.StageCloudFormationChangeSet)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if name == "" {
+		e.LogPersister.Success("No changes to apply")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if !executeChangeSet(ctx, &e.Input, e.client, e.appCfg.Input.StackName, name) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// The stages which have nothing to do when the change set has no changes.
+var noopStagesWithoutChanges = map[model.Stage]struct{}{
+	model.StageCloudFormationExecute: {},
+	model.StageWaitApproval:          {},
+}
+
+// hasOnlyNoopStagesAfter reports whether all the stages after the running one
+// have nothing to do when the change set has no changes, e.g. executing or waiting for an approval.
+func (e *deployExecutor) hasOnlyNoopStagesAfter() bool {
+	var found bool
+	for _, s := range e.Deployment.Stages {
+		if s.Id == e.Stage.Id {
+			found = true
+			continue
+		}
+		if !found || !s.Visible || s.Name == model.StageRollback.String() {
+			continue
+		}
+		if _, ok := noopStagesWithoutChanges[model.Stage(s.Name)]; !ok {
+			return false
+		}
+	}
+	return found
+}
@@ -0,0 +1,114 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for cloudformation application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	name, cfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	client, err := provider.DefaultRegistry().Client(name, cfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create CloudFormation client for the provider %s: %v", name, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	targetDS, err := e.TargetDSP.Get(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if targetCfg := targetDS.ApplicationConfig.CloudFormationApplicationSpec; targetCfg != nil {
+		// The change set which has not been executed must not be executed by anyone after the rollback.
+		if !deletePendingChangeSet(ctx, &e.Input, client, targetCfg.Input.StackName, changeSetName(e.Deployment.Id)) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	// There is nothing to do if this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.Get(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.CloudFormationApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Error("Malformed application configuration: missing CloudFormationApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Start rolling back to the state defined at commit %s", e.Deployment.RunningCommitHash)
+	csName := rollbackChangeSetName(e.Deployment.Id)
+	csi, err := makeChangeSetInput(&e.Input, appCfg.Input, runningDS.AppDir, csName, e.Deployment.RunningCommitHash)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare change set (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The change set has no changes when the stack has not been changed by the deployment
+	// or CloudFormation has already rolled back the failed update.
+	cs, ok := prepareChangeSet(ctx, &e.Input, client, csi)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if cs.NoChanges() {
+		e.LogPersister.Success("The stack is already in the state defined at the last deployed commit")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if !executeChangeSet(ctx, &e.Input, client, csi.StackName, csName) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully rolled back the changes")
+	return model.StageStatus_STAGE_SUCCESS
+}
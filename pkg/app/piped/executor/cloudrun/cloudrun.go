@@ -17,6 +17,7 @@ package cloudrun
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -40,6 +41,8 @@ func Register(r registerer) {
 	}
 	r.Register(model.StageCloudRunSync, f)
 	r.Register(model.StageCloudRunPromote, f)
+	r.Register(model.StageCloudRunTrafficRouting, f)
+	r.Register(model.StageCloudRunJobExecute, f)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_CLOUDRUN, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -218,6 +221,29 @@ func waitRevisionReady(ctx context.Context, client provider.Client, revisionName
 	}
 }
 
+// waitTagURL waits until the tag is routed to the service and returns its URL.
+func waitTagURL(ctx context.Context, client provider.Client, serviceName, tag string, retryDuration, retryTimeout time.Duration, lp executor.LogPersister) (string, bool) {
+	start := time.Now()
+	for {
+		svc, err := client.Get(ctx, serviceName)
+		if err != nil {
+			lp.Errorf("Failed to get the service %s (%v)", serviceName, err)
+			return "", false
+		}
+		if url, ok := svc.TagURL(tag); ok {
+			lp.Infof("The tagged revision can be accessed at %s", url)
+			return url, true
+		}
+
+		if time.Since(start) > retryTimeout {
+			lp.Errorf("URL of tag %s was not assigned to the service %s", tag, serviceName)
+			return "", false
+		}
+		lp.Infof("URL of tag %s is still not assigned, will retry after %v", tag, retryDuration)
+		time.Sleep(retryDuration)
+	}
+}
+
 func revisionExists(ctx context.Context, client provider.Client, revisionName string, lp executor.LogPersister) (bool, error) {
 	_, err := client.GetRevision(ctx, revisionName)
 	if err == nil {
@@ -232,6 +258,75 @@ func revisionExists(ctx context.Context, client provider.Client, revisionName st
 	return false, err
 }
 
+// configureRevisionScaling overrides the autoscaling annotations of the revision template by the given settings
+// and returns the name of the revision to be deployed with them.
+func configureRevisionScaling(sm provider.ServiceManifest, revision string, scaling *config.CloudRunRevisionScaling, lp executor.LogPersister) (string, bool) {
+	annotations := make(map[string]string, 3)
+	if scaling.MinInstances != nil {
+		annotations[provider.AnnotationMinScale] = strconv.Itoa(*scaling.MinInstances)
+	}
+	if scaling.MaxInstances != nil {
+		annotations[provider.AnnotationMaxScale] = strconv.Itoa(*scaling.MaxInstances)
+	}
+	if scaling.CPUAlwaysAllocated != nil {
+		annotations[provider.AnnotationCPUThrottling] = strconv.FormatBool(!*scaling.CPUAlwaysAllocated)
+	}
+	if err := sm.AddRevisionAnnotations(annotations); err != nil {
+		lp.Errorf("Unable to override the scaling of the revision for the service manifest %s (%v)", sm.Name, err)
+		return "", false
+	}
+
+	scaled := provider.MakeScaledRevisionName(revision, annotations)
+	lp.Infof("Revision %s will be deployed with the overridden scaling instead of revision %s", scaled, revision)
+	return scaled, true
+}
+
+// pruneRevisions deletes the old revisions of the service created by piped for the application
+// while keeping the latest ones which neither serve any traffic nor have a tag.
+// Failures are only logged since the deployment itself has already been completed.
+func pruneRevisions(ctx context.Context, client provider.Client, serviceName, appID string, keep int, lp executor.LogPersister) {
+	if keep <= 0 {
+		return
+	}
+
+	svc, err := client.Get(ctx, serviceName)
+	if err != nil {
+		lp.Errorf("Unable to get the service %s to prune old revisions (%v)", serviceName, err)
+		return
+	}
+
+	var (
+		revisions []*provider.Revision
+		options   = &provider.ListRevisionsOptions{
+			LabelSelector: provider.MakeApplicationRevisionsSelector(appID),
+		}
+	)
+	for {
+		revs, cursor, err := client.ListRevisions(ctx, options)
+		if err != nil {
+			lp.Errorf("Unable to list the revisions of the service %s to prune old ones (%v)", serviceName, err)
+			return
+		}
+		revisions = append(revisions, revs...)
+		if cursor == "" {
+			break
+		}
+		options.Cursor = cursor
+	}
+
+	names := svc.PrunableRevisionNames(revisions, keep)
+	deleted := 0
+	for _, name := range names {
+		if err := client.DeleteRevision(ctx, name); err != nil && err != provider.ErrRevisionNotFound {
+			lp.Errorf("Failed to delete the old revision %s (%v)", name, err)
+			continue
+		}
+		lp.Infof("Deleted the old revision %s", name)
+		deleted++
+	}
+	lp.Infof("Pruned %d old revisions of the service %s, keeping the latest %d ones not serving traffic", deleted, serviceName, keep)
+}
+
 func addBuiltinLabels(sm provider.ServiceManifest, hash, pipedID, appID, revisionName string, lp executor.LogPersister) bool {
 	labels := map[string]string{
 		provider.LabelManagedBy:   provider.ManagedByPiped,
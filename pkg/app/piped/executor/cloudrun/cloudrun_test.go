@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/config"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -91,3 +92,61 @@ func TestAddBuiltinLabels(t *testing.T) {
 	got = sm.RevisionLabels()
 	assert.Equal(t, want, got)
 }
+
+func TestAddJobBuiltinLabels(t *testing.T) {
+	t.Parallel()
+
+	jm, err := provider.ParseJobManifest([]byte(`
+apiVersion: run.googleapis.com/v1
+kind: Job
+metadata:
+  name: migrate
+spec:
+  template:
+    spec:
+      template:
+        spec:
+          containers:
+          - image: gcr.io/pipecd/migrate:v0.1.0
+`))
+	require.NoError(t, err)
+
+	addJobBuiltinLabels(jm, "commit-hash", "piped-id", "app-id")
+
+	want := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       "piped-id",
+		provider.LabelApplication: "app-id",
+		provider.LabelCommitHash:  "commit-hash",
+	}
+	assert.Equal(t, want, jm.Labels())
+}
+
+func TestConfigureRevisionScaling(t *testing.T) {
+	t.Parallel()
+
+	sm, err := provider.ParseServiceManifest([]byte(serviceManifest))
+	require.NoError(t, err)
+
+	maxInstances := 2
+	scaling := &config.CloudRunRevisionScaling{
+		MaxInstances:       &maxInstances,
+		CPUAlwaysAllocated: newBoolPointer(true),
+	}
+	revision, ok := configureRevisionScaling(sm, "helloworld-v010-1234567", scaling, &fakeLogPersister{})
+	require.True(t, ok)
+
+	want := map[string]string{
+		provider.AnnotationMaxScale:      "2",
+		provider.AnnotationCPUThrottling: "false",
+	}
+	assert.Equal(t, provider.MakeScaledRevisionName("helloworld-v010-1234567", want), revision)
+
+	svc, err := sm.RunService()
+	require.NoError(t, err)
+	assert.Equal(t, want, svc.Spec.Template.Metadata.Annotations)
+}
+
+func newBoolPointer(v bool) *bool {
+	return &v
+}
@@ -16,6 +16,7 @@ package cloudrun
 
 import (
 	"context"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -30,6 +31,7 @@ import (
 
 const (
 	promotePercentageMetadataKey = "promote-percentage"
+	tagURLMetadataKey            = "tag-url"
 	revisionCheckDuration        = 10 * time.Second
 	revisionCheckTimeout         = 2 * time.Minute
 )
@@ -40,6 +42,8 @@ type deployExecutor struct {
 	deploySource *deploysource.DeploySource
 	appCfg       *config.CloudRunApplicationSpec
 	client       provider.Client
+	// The region being deployed when the application is deployed to multiple regions.
+	region string
 }
 
 func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -73,19 +77,70 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status         model.StageStatus
 	)
 
+	if mr := e.appCfg.MultiRegion; mr != nil {
+		status = e.executeInRegions(ctx, cpName, cpCfg, mr)
+	} else {
+		status = e.executeStage(ctx)
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) executeStage(ctx context.Context) model.StageStatus {
 	switch model.Stage(e.Stage.Name) {
 	case model.StageCloudRunSync:
-		status = e.ensureSync(ctx)
+		return e.ensureSync(ctx)
 
 	case model.StageCloudRunPromote:
-		status = e.ensurePromote(ctx)
+		return e.ensurePromote(ctx)
+
+	case model.StageCloudRunTrafficRouting:
+		return e.ensureTrafficRouting(ctx)
+
+	case model.StageCloudRunJobExecute:
+		return e.ensureJobExecute(ctx)
 
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for cloudrun application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
 	}
+}
 
-	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+// executeInRegions executes the stage against all regions targeted by the multi-region application
+// and saves the sync status of each region into the stage metadata.
+func (e *deployExecutor) executeInRegions(ctx context.Context, cpName string, cpCfg *config.PlatformProviderCloudRunConfig, mr *config.CloudRunMultiRegion) model.StageStatus {
+	regions := regionNames(cpCfg.Region, mr)
+	clients, err := newRegionalClients(ctx, cpName, cpCfg, regions, e.Logger)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	statuses := runInRegions(regions, mr.Parallel, e.LogPersister, func(region string, lp executor.LogPersister) bool {
+		re := *e
+		re.client = clients[region]
+		re.region = region
+		re.LogPersister = lp
+		return re.executeStage(ctx) == model.StageStatus_STAGE_SUCCESS
+	})
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, statuses); err != nil {
+		e.Logger.Error("failed to store the sync status of regions to metadata store", zap.Error(err))
+	}
+
+	e.LogPersister.Info("Sync status of regions:")
+	if !reportRegionStatuses(regions, statuses, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// metadataKey returns the key of the stage metadata
+// which is qualified by the region when the application is deployed to multiple regions.
+func (e *deployExecutor) metadataKey(key string) string {
+	if e.region == "" {
+		return key
+	}
+	return fmt.Sprintf("%s.%s", key, e.region)
 }
 
 func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
@@ -115,10 +170,18 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if !syncJob(ctx, &e.Input, e.client, e.appCfg.Input.JobManifestFile, commit, e.deploySource) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	if !apply(ctx, e.client, sm, e.LogPersister) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if !syncIAMPolicy(ctx, &e.Input, e.client, sm.Name, e.appCfg.Input.IAMPolicyFile, e.deploySource) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	if err := waitRevisionReady(
 		ctx,
 		e.client,
@@ -130,6 +193,7 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	pruneRevisions(ctx, e.client, sm.Name, e.Deployment.ApplicationId, e.appCfg.Input.RevisionRetention, e.LogPersister)
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -146,43 +210,14 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
 	}
 
-	// Loaded the last deployed data.
-	if e.Deployment.RunningCommitHash == "" {
-		e.LogPersister.Errorf("Unable to determine the last deployed commit")
-		return model.StageStatus_STAGE_FAILURE
-	}
-
-	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
-	if err != nil {
-		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
-		return model.StageStatus_STAGE_FAILURE
-	}
-
-	runningAppCfg := runningDS.ApplicationConfig.CloudRunApplicationSpec
-	if runningAppCfg == nil {
-		e.LogPersister.Error("Malformed application configuration in running commit: missing CloudRunApplicationSpec")
-		return model.StageStatus_STAGE_FAILURE
-	}
-
-	lastDeployedSM, ok := loadServiceManifest(&e.Input, runningAppCfg.Input.ServiceManifestFile, runningDS)
-	if !ok {
-		return model.StageStatus_STAGE_FAILURE
-	}
-
-	lastDeployedRevision, ok := decideRevisionName(lastDeployedSM, e.Deployment.RunningCommitHash, e.LogPersister)
-	if !ok {
-		return model.StageStatus_STAGE_FAILURE
-	}
-
-	// Load the service manifest at the target commit.
-	sm, ok := loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	sm, revision, lastDeployedRevision, ok := e.loadRoutingTargets(ctx)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
-
-	revision, ok := decideRevisionName(sm, e.Deployment.Trigger.Commit.Hash, e.LogPersister)
-	if !ok {
-		return model.StageStatus_STAGE_FAILURE
+	if options.Scaling != nil {
+		if revision, ok = configureRevisionScaling(sm, revision, options.Scaling, e.LogPersister); !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
 	}
 
 	traffics := []provider.RevisionTraffic{
@@ -220,6 +255,10 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if !syncIAMPolicy(ctx, &e.Input, e.client, sm.Name, e.appCfg.Input.IAMPolicyFile, e.deploySource) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	if err := waitRevisionReady(
 		ctx,
 		e.client,
@@ -231,5 +270,144 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if options.Percent.Int() == 100 {
+		pruneRevisions(ctx, e.client, sm.Name, e.Deployment.ApplicationId, e.appCfg.Input.RevisionRetention, e.LogPersister)
+	}
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.CloudRunTrafficRoutingStageOptions
+	if options == nil || len(options.Steps) == 0 {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, revision, lastDeployedRevision, ok := e.loadRoutingTargets(ctx)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if options.Scaling != nil {
+		if revision, ok = configureRevisionScaling(sm, revision, options.Scaling, e.LogPersister); !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	exist, err := revisionExists(ctx, e.client, revision, e.LogPersister)
+	if err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	newRevision := revision
+	if exist {
+		newRevision = ""
+		e.LogPersister.Infof("Revision %s was already registered", revision)
+	}
+
+	commit := e.Deployment.CommitHash()
+	for i, step := range options.Steps {
+		percent := step.Int()
+		e.LogPersister.Infof("Shifting %d percent of traffic to revision %s (step %d/%d)", percent, revision, i+1, len(options.Steps))
+
+		traffics := []provider.RevisionTraffic{
+			{
+				RevisionName: revision,
+				Percent:      percent,
+				Tag:          options.Tag,
+			},
+			{
+				RevisionName: lastDeployedRevision,
+				Percent:      100 - percent,
+			},
+		}
+		if !configureServiceManifest(sm, newRevision, traffics, e.LogPersister) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !addBuiltinLabels(sm, commit, e.PipedConfig.PipedID, e.Deployment.ApplicationId, newRevision, e.LogPersister) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !apply(ctx, e.client, sm, e.LogPersister) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if i == 0 && !syncIAMPolicy(ctx, &e.Input, e.client, sm.Name, e.appCfg.Input.IAMPolicyFile, e.deploySource) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if err := waitRevisionReady(ctx, e.client, revision, revisionCheckDuration, revisionCheckTimeout, e.LogPersister); err != nil {
+			return model.StageStatus_STAGE_FAILURE
+		}
+
+		metadata := map[string]string{
+			promotePercentageMetadataKey: strconv.Itoa(percent),
+		}
+		if i == 0 && options.ExposeTagURL {
+			url, ok := waitTagURL(ctx, e.client, sm.Name, options.Tag, revisionCheckDuration, revisionCheckTimeout, e.LogPersister)
+			if !ok {
+				return model.StageStatus_STAGE_FAILURE
+			}
+			metadata[e.metadataKey(tagURLMetadataKey)] = url
+		}
+		if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+			e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+		}
+
+		if i == len(options.Steps)-1 {
+			break
+		}
+		e.LogPersister.Infof("Waiting %v before the next step", options.Interval.Duration())
+		timer := time.NewTimer(options.Interval.Duration())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			e.LogPersister.Info("Traffic routing was interrupted before completing all steps")
+			return model.StageStatus_STAGE_FAILURE
+		case <-timer.C:
+		}
+	}
+
+	last := options.Steps[len(options.Steps)-1].Int()
+	e.LogPersister.Successf("Successfully shifted %d percent of traffic to revision %s", last, revision)
+	if last == 100 {
+		pruneRevisions(ctx, e.client, sm.Name, e.Deployment.ApplicationId, e.appCfg.Input.RevisionRetention, e.LogPersister)
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// loadRoutingTargets loads the service manifest at the target commit
+// and decides the names of the new revision and the last deployed one to split the traffic.
+func (e *deployExecutor) loadRoutingTargets(ctx context.Context) (sm provider.ServiceManifest, revision, lastDeployedRevision string, ok bool) {
+	// Loaded the last deployed data.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit")
+		return
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return
+	}
+
+	runningAppCfg := runningDS.ApplicationConfig.CloudRunApplicationSpec
+	if runningAppCfg == nil {
+		e.LogPersister.Error("Malformed application configuration in running commit: missing CloudRunApplicationSpec")
+		return
+	}
+
+	lastDeployedSM, ok := loadServiceManifest(&e.Input, runningAppCfg.Input.ServiceManifestFile, runningDS)
+	if !ok {
+		return
+	}
+
+	lastDeployedRevision, ok = decideRevisionName(lastDeployedSM, e.Deployment.RunningCommitHash, e.LogPersister)
+	if !ok {
+		return
+	}
+
+	// Load the service manifest at the target commit.
+	sm, ok = loadServiceManifest(&e.Input, e.appCfg.Input.ServiceManifestFile, e.deploySource)
+	if !ok {
+		return
+	}
+
+	revision, ok = decideRevisionName(sm, e.Deployment.Trigger.Commit.Hash, e.LogPersister)
+	return
+}
@@ -0,0 +1,55 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+)
+
+// syncIAMPolicy reconciles the IAM policy of the service with the policy file
+// when the application specifies it. Nothing is changed when the bindings are already the same.
+func syncIAMPolicy(ctx context.Context, in *executor.Input, client provider.Client, serviceName, iamPolicyFile string, ds *deploysource.DeploySource) bool {
+	if iamPolicyFile == "" {
+		return true
+	}
+
+	in.LogPersister.Infof("Loading IAM policy at commit %s", ds.Revision)
+	pm, err := provider.LoadIAMPolicyManifest(ds.AppDir, iamPolicyFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load IAM policy (%v)", err)
+		return false
+	}
+
+	live, err := client.GetIAMPolicy(ctx, serviceName)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the IAM policy of the service %s (%v)", serviceName, err)
+		return false
+	}
+	if pm.Matches(live) {
+		in.LogPersister.Infof("IAM policy of the service %s is already up to date", serviceName)
+		return true
+	}
+
+	if _, err := client.SetIAMPolicy(ctx, serviceName, pm, live.Etag); err != nil {
+		in.LogPersister.Errorf("Failed to update the IAM policy of the service %s (%v)", serviceName, err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully updated the IAM policy of the service %s", serviceName)
+	return true
+}
@@ -0,0 +1,166 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/model"
+
+	"go.uber.org/zap"
+)
+
+const (
+	jobExecutionMetadataKey = "job-execution"
+	executionCheckDuration  = 10 * time.Second
+)
+
+func (e *deployExecutor) ensureJobExecute(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.CloudRunJobExecuteStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	jm, ok := loadJobManifest(&e.Input, e.appCfg.Input.JobManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	addJobBuiltinLabels(jm, e.Deployment.CommitHash(), e.PipedConfig.PipedID, e.Deployment.ApplicationId)
+	if !applyJob(ctx, e.client, jm, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Start running the job %s", jm.Name)
+	execution, err := e.client.RunJob(ctx, jm.Name)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to run the job %s (%v)", jm.Name, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	executionName := execution.Metadata.Name
+	if err := e.MetadataStore.Stage(e.Stage.Id).Put(ctx, e.metadataKey(jobExecutionMetadataKey), executionName); err != nil {
+		e.Logger.Error("failed to save job execution name to metadata", zap.Error(err))
+	}
+
+	if err := waitExecutionCompleted(ctx, e.client, executionName, executionCheckDuration, options.Timeout.Duration(), e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Successf("Successfully completed the execution %s of job %s", executionName, jm.Name)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// syncJob applies the job manifest when the application deploys a job.
+// Unlike the CLOUDRUN_JOB_EXECUTE stage, the job is not run.
+func syncJob(ctx context.Context, in *executor.Input, client provider.Client, jobManifestFile, commit string, ds *deploysource.DeploySource) bool {
+	if jobManifestFile == "" {
+		return true
+	}
+
+	jm, ok := loadJobManifest(in, jobManifestFile, ds)
+	if !ok {
+		return false
+	}
+
+	addJobBuiltinLabels(jm, commit, in.PipedConfig.PipedID, in.Deployment.ApplicationId)
+	return applyJob(ctx, client, jm, in.LogPersister)
+}
+
+func loadJobManifest(in *executor.Input, jobManifestFile string, ds *deploysource.DeploySource) (provider.JobManifest, bool) {
+	in.LogPersister.Infof("Loading job manifest at commit %s", ds.Revision)
+
+	jm, err := provider.LoadJobManifest(ds.AppDir, jobManifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load job manifest (%v)", err)
+		return provider.JobManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the job manifest at commit %s", ds.Revision)
+	return jm, true
+}
+
+func addJobBuiltinLabels(jm provider.JobManifest, hash, pipedID, appID string) {
+	jm.AddLabels(map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       pipedID,
+		provider.LabelApplication: appID,
+		provider.LabelCommitHash:  hash,
+	})
+}
+
+func applyJob(ctx context.Context, client provider.Client, jm provider.JobManifest, lp executor.LogPersister) bool {
+	lp.Info("Start applying the job manifest")
+
+	_, err := client.UpdateJob(ctx, jm)
+	if err == nil {
+		lp.Infof("Successfully updated the job %s", jm.Name)
+		return true
+	}
+
+	if err != provider.ErrJobNotFound {
+		lp.Errorf("Failed to update the job %s (%v)", jm.Name, err)
+		return false
+	}
+
+	lp.Infof("Job %s was not found, a new job will be created", jm.Name)
+
+	if _, err := client.CreateJob(ctx, jm); err != nil {
+		lp.Errorf("Failed to create the job %s (%v)", jm.Name, err)
+		return false
+	}
+
+	lp.Infof("Successfully created the job %s", jm.Name)
+	return true
+}
+
+func waitExecutionCompleted(ctx context.Context, client provider.Client, executionName string, retryDuration, retryTimeout time.Duration, lp executor.LogPersister) error {
+	start := time.Now()
+	for {
+		execution, err := client.GetExecution(ctx, executionName)
+		if err != nil {
+			lp.Errorf("Failed to get the execution %s (%v)", executionName, err)
+			return err
+		}
+
+		completed, succeeded, message := execution.Result()
+		if completed {
+			if !succeeded {
+				lp.Errorf("Execution %s failed: %s", executionName, message)
+				return fmt.Errorf("execution %s failed: %s", executionName, message)
+			}
+			return nil
+		}
+
+		if time.Since(start) > retryTimeout {
+			lp.Errorf("Execution %s was not completed in %v", executionName, retryTimeout)
+			return fmt.Errorf("execution %s timed out", executionName)
+		}
+
+		lp.Infof("Execution %s is still running, will check again after %v", executionName, retryDuration)
+		select {
+		case <-ctx.Done():
+			lp.Infof("Stopped waiting for the execution %s", executionName)
+			return ctx.Err()
+		case <-time.After(retryDuration):
+		}
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	regionSyncSucceeded = "SUCCESS"
+	regionSyncFailed    = "FAILURE"
+	regionSyncSkipped   = "SKIPPED"
+)
+
+// regionNames returns the regions targeted by the multi-region application.
+// The region of the platform provider always comes first.
+func regionNames(defaultRegion string, mr *config.CloudRunMultiRegion) []string {
+	names := make([]string, 0, len(mr.Regions)+1)
+	names = append(names, defaultRegion)
+	for _, r := range mr.Regions {
+		if r == defaultRegion {
+			continue
+		}
+		names = append(names, r)
+	}
+	return names
+}
+
+// newRegionalClients builds a Cloud Run client for each given region.
+func newRegionalClients(ctx context.Context, name string, cfg *config.PlatformProviderCloudRunConfig, regions []string, logger *zap.Logger) (map[string]provider.Client, error) {
+	clients := make(map[string]provider.Client, len(regions))
+	for _, r := range regions {
+		c, err := provider.DefaultRegistry().RegionalClient(ctx, name, r, cfg, logger)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create Cloud Run client for region %s (%w)", r, err)
+		}
+		clients[r] = c
+	}
+	return clients, nil
+}
+
+// runInRegions calls the given function for all regions and returns the sync status of each region.
+// When parallel is false, the regions are handled in the given order and the remaining ones are skipped after a failure.
+func runInRegions(regions []string, parallel bool, lp executor.LogPersister, f func(region string, lp executor.LogPersister) bool) map[string]string {
+	statuses := make(map[string]string, len(regions))
+
+	if !parallel {
+		for i, r := range regions {
+			lp.Infof("Start deploying to region %s (%d/%d)", r, i+1, len(regions))
+			if !f(r, regionLogPersister{lp, r}) {
+				lp.Errorf("Failed to deploy to region %s", r)
+				statuses[r] = regionSyncFailed
+				for _, n := range regions[i+1:] {
+					statuses[n] = regionSyncSkipped
+				}
+				return statuses
+			}
+			statuses[r] = regionSyncSucceeded
+		}
+		return statuses
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	lp.Infof("Start deploying to %d regions in parallel", len(regions))
+	for _, r := range regions {
+		r := r
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status := regionSyncSucceeded
+			if !f(r, regionLogPersister{lp, r}) {
+				lp.Errorf("Failed to deploy to region %s", r)
+				status = regionSyncFailed
+			}
+			mu.Lock()
+			statuses[r] = status
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return statuses
+}
+
+// reportRegionStatuses writes the sync status of each region into the stage log
+// and returns true only when all regions were synced successfully.
+func reportRegionStatuses(regions []string, statuses map[string]string, lp executor.LogPersister) bool {
+	ok := true
+	for _, r := range regions {
+		status := statuses[r]
+		if status == regionSyncSucceeded {
+			lp.Successf("- region %s: %s", r, status)
+			continue
+		}
+		ok = false
+		lp.Errorf("- region %s: %s", r, status)
+	}
+	return ok
+}
+
+// regionLogPersister prefixes all logs with the region
+// to make the logs of the regions deployed in parallel distinguishable.
+type regionLogPersister struct {
+	executor.LogPersister
+	region string
+}
+
+func (lp regionLogPersister) Info(log string) {
+	lp.LogPersister.Info(lp.prefix(log))
+}
+
+func (lp regionLogPersister) Infof(format string, a ...interface{}) {
+	lp.LogPersister.Info(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp regionLogPersister) Success(log string) {
+	lp.LogPersister.Success(lp.prefix(log))
+}
+
+func (lp regionLogPersister) Successf(format string, a ...interface{}) {
+	lp.LogPersister.Success(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp regionLogPersister) Error(log string) {
+	lp.LogPersister.Error(lp.prefix(log))
+}
+
+func (lp regionLogPersister) Errorf(format string, a ...interface{}) {
+	lp.LogPersister.Error(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp regionLogPersister) prefix(log string) string {
+	return fmt.Sprintf("[%s] %s", lp.region, log)
+}
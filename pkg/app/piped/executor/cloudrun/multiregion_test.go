@@ -0,0 +1,98 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(_ []byte) (int, error)         { return 0, nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+func TestRegionNames(t *testing.T) {
+	t.Parallel()
+
+	mr := &config.CloudRunMultiRegion{
+		Regions: []string{"us-central1", "asia-northeast1", "europe-west1"},
+	}
+	assert.Equal(t, []string{"asia-northeast1", "us-central1", "europe-west1"}, regionNames("asia-northeast1", mr))
+	assert.Equal(t, []string{"us-east1", "us-central1", "asia-northeast1", "europe-west1"}, regionNames("us-east1", mr))
+}
+
+func TestRunInRegions(t *testing.T) {
+	t.Parallel()
+
+	regions := []string{"asia-northeast1", "us-central1", "europe-west1"}
+
+	testcases := []struct {
+		name     string
+		parallel bool
+		failed   string
+		expected map[string]string
+	}{
+		{
+			name: "all regions succeeded",
+			expected: map[string]string{
+				"asia-northeast1": regionSyncSucceeded,
+				"us-central1":     regionSyncSucceeded,
+				"europe-west1":    regionSyncSucceeded,
+			},
+		},
+		{
+			name:   "remaining regions are skipped after a failure",
+			failed: "us-central1",
+			expected: map[string]string{
+				"asia-northeast1": regionSyncSucceeded,
+				"us-central1":     regionSyncFailed,
+				"europe-west1":    regionSyncSkipped,
+			},
+		},
+		{
+			name:     "all regions are deployed in parallel even if one failed",
+			parallel: true,
+			failed:   "us-central1",
+			expected: map[string]string{
+				"asia-northeast1": regionSyncSucceeded,
+				"us-central1":     regionSyncFailed,
+				"europe-west1":    regionSyncSucceeded,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			statuses := runInRegions(regions, tc.parallel, &fakeLogPersister{}, func(region string, _ executor.LogPersister) bool {
+				return region != tc.failed
+			})
+			assert.Equal(t, tc.expected, statuses)
+			assert.Equal(t, tc.failed == "", reportRegionStatuses(regions, statuses, &fakeLogPersister{}))
+		})
+	}
+}
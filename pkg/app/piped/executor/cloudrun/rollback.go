@@ -17,14 +17,19 @@ package cloudrun
 import (
 	"context"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 type rollbackExecutor struct {
 	executor.Input
 	client provider.Client
+
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderCloudRunConfig
 }
 
 func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -45,6 +50,7 @@ func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		e.LogPersister.Errorf("Unable to create ClourRun client for the provider (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
+	e.platformProviderName, e.platformProviderCfg = cpName, cpCfg
 
 	switch model.Stage(e.Stage.Name) {
 	case model.StageRollback:
@@ -102,9 +108,41 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !apply(ctx, e.client, sm, e.LogPersister) {
-		return model.StageStatus_STAGE_FAILURE
+	if mr := appCfg.MultiRegion; mr != nil {
+		regions := regionNames(e.platformProviderCfg.Region, mr)
+		clients, err := newRegionalClients(ctx, e.platformProviderName, e.platformProviderCfg, regions, e.Logger)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return model.StageStatus_STAGE_FAILURE
+		}
+		statuses := runInRegions(regions, mr.Parallel, e.LogPersister, func(region string, lp executor.LogPersister) bool {
+			in := e.Input
+			in.LogPersister = lp
+			return rollback(ctx, &in, clients[region], sm, &appCfg.Input, runningDS)
+		})
+		e.LogPersister.Info("Rollback status of regions:")
+		if !reportRegionStatuses(regions, statuses, e.LogPersister) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
 	}
 
+	if !rollback(ctx, &e.Input, e.client, sm, &appCfg.Input, runningDS) {
+		return model.StageStatus_STAGE_FAILURE
+	}
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+// rollback applies the service manifest of the running commit, which routes all traffic
+// to the revision of the running commit, together with the job manifest and the IAM policy if any.
+func rollback(ctx context.Context, in *executor.Input, client provider.Client, sm provider.ServiceManifest, input *config.CloudRunDeploymentInput, runningDS *deploysource.DeploySource) bool {
+	if !syncJob(ctx, in, client, input.JobManifestFile, in.Deployment.RunningCommitHash, runningDS) {
+		return false
+	}
+
+	if !apply(ctx, client, sm, in.LogPersister) {
+		return false
+	}
+
+	return syncIAMPolicy(ctx, in, client, sm.Name, input.IAMPolicyFile, runningDS)
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The keys of the shared metadata to pass the revisions between the stages.
+	// The primary revision is the one receiving the traffic before the deployment,
+	// and the new revision is the one created by the deployment.
+	primaryRevisionMetadataKey = "containerapps-primary-revision"
+	newRevisionMetadataKey     = "containerapps-new-revision"
+)
+
+var (
+	// The interval to check the status of the revision while it is being provisioned.
+	revisionCheckInterval = 5 * time.Second
+	// The duration to wait for Azure to create the revision after the app has been updated.
+	revisionCreateTimeout = time.Minute
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageContainerAppsSync, f)
+	r.Register(model.StageContainerAppsCanaryRollout, f)
+	r.Register(model.StageContainerAppsTrafficRouting, f)
+	r.Register(model.StageContainerAppsPromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_CONTAINERAPPS, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderContainerAppsConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_CONTAINERAPPS)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.ContainerAppsConfig
+	found = true
+	return
+}
+
+func loadAppManifest(in *executor.Input, appManifestFile string, ds *deploysource.DeploySource) (provider.AppManifest, bool) {
+	in.LogPersister.Infof("Loading app manifest at commit %s", ds.Revision)
+
+	m, err := provider.LoadAppManifest(ds.AppDir, appManifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load app manifest (%v)", err)
+		return provider.AppManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the app manifest of container app %s at commit %s", m.Name(), ds.Revision)
+	return m, true
+}
+
+// applyApp creates or updates the container app with the given manifest, which creates a new revision having the given suffix.
+// The traffic weights of the manifest are replaced with the given ones if specified.
+// It returns the name of the created revision.
+func applyApp(ctx context.Context, in *executor.Input, client provider.Client, m provider.AppManifest, commitHash, revisionSuffix string, traffic []provider.TrafficWeight) (string, bool) {
+	m.SetRevisionSuffix(revisionSuffix)
+	m.AddTags(map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	})
+	if traffic != nil {
+		m.SetTraffic(traffic)
+	}
+
+	in.LogPersister.Infof("Applying container app %s", m.Name())
+	if _, err := client.CreateOrUpdateApp(ctx, m); err != nil {
+		in.LogPersister.Errorf("Failed to apply container app %s: %v", m.Name(), err)
+		return "", false
+	}
+
+	revision := provider.RevisionName(m.Name(), revisionSuffix)
+	in.LogPersister.Infof("Successfully applied container app %s with revision %s", m.Name(), revision)
+	return revision, true
+}
+
+// waitRevision waits until the given revision has been provisioned.
+func waitRevision(ctx context.Context, in *executor.Input, client provider.Client, appName, revisionName string) bool {
+	ticker := time.NewTicker(revisionCheckInterval)
+	defer ticker.Stop()
+
+	var (
+		startedAt = time.Now()
+		lastState string
+	)
+	for {
+		r, err := client.GetRevision(ctx, appName, revisionName)
+		switch {
+		case errors.Is(err, provider.ErrNotFound):
+			if time.Since(startedAt) > revisionCreateTimeout {
+				in.LogPersister.Errorf("Revision %s was not created", revisionName)
+				return false
+			}
+		case err != nil:
+			in.LogPersister.Errorf("Failed to get revision %s: %v", revisionName, err)
+			return false
+		default:
+			p := r.Properties
+			switch p.ProvisioningState {
+			case provider.RevisionProvisioningStateProvisioned:
+				in.LogPersister.Infof("Revision %s has been provisioned with %d replicas", revisionName, p.Replicas)
+				return true
+			case provider.RevisionProvisioningStateFailed:
+				in.LogPersister.Errorf("Failed to provision revision %s: %s", revisionName, p.ProvisioningError)
+				return false
+			}
+			if state := p.ProvisioningState + "/" + p.RunningState; state != lastState {
+				in.LogPersister.Infof("Revision %s is %s (running state: %s)", revisionName, p.ProvisioningState, p.RunningState)
+				lastState = state
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Stopped waiting for revision %s: %v", revisionName, ctx.Err())
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// updateTraffic replaces the traffic weights of the given app.
+func updateTraffic(ctx context.Context, in *executor.Input, client provider.Client, appName string, traffic []provider.TrafficWeight) bool {
+	for _, t := range traffic {
+		in.LogPersister.Infof("Routing %d%% of traffic to revision %s", t.Weight, t.RevisionName)
+	}
+	if _, err := client.UpdateTraffic(ctx, appName, traffic); err != nil {
+		in.LogPersister.Errorf("Failed to update traffic of container app %s: %v", appName, err)
+		return false
+	}
+	in.LogPersister.Infof("Successfully updated traffic of container app %s", appName)
+	return true
+}
+
+// primaryRevision returns the revision receiving the most traffic.
+// The latest ready revision is returned when the app has no ingress.
+func primaryRevision(app *provider.App) string {
+	var (
+		name   = app.Properties.LatestReadyRevisionName
+		weight = -1
+	)
+	for _, t := range app.Traffic() {
+		if t.Weight > weight {
+			name, weight = t.RevisionName, t.Weight
+		}
+	}
+	return name
+}
+
+// trafficRevisions returns the revisions receiving any traffic.
+func trafficRevisions(app *provider.App) []string {
+	var revisions []string
+	for _, t := range app.Traffic() {
+		if t.Weight > 0 {
+			revisions = append(revisions, t.RevisionName)
+		}
+	}
+	return revisions
+}
+
+// canaryTraffic returns the traffic weights routing the given percentage of traffic to the new revision
+// and the rest to the primary revision.
+func canaryTraffic(primary, canary string, percentage int) []provider.TrafficWeight {
+	if primary == canary {
+		return []provider.TrafficWeight{{RevisionName: canary, Weight: 100}}
+	}
+	return []provider.TrafficWeight{
+		{RevisionName: primary, Weight: 100 - percentage},
+		{RevisionName: canary, Weight: percentage},
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/containerapps"
+)
+
+func TestPrimaryRevision(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name              string
+		app               *provider.App
+		expectedPrimary   string
+		expectedRevisions []string
+	}{
+		{
+			name: "no ingress",
+			app: &provider.App{Properties: provider.AppProperties{
+				LatestReadyRevisionName: "web--r1",
+			}},
+			expectedPrimary: "web--r1",
+		},
+		{
+			name: "latest revision",
+			app: &provider.App{Properties: provider.AppProperties{
+				LatestRevisionName:      "web--r2",
+				LatestReadyRevisionName: "web--r1",
+				Configuration: provider.AppConfiguration{
+					Ingress: &provider.Ingress{Traffic: []provider.TrafficWeight{
+						{LatestRevision: true, Weight: 100},
+					}},
+				},
+			}},
+			expectedPrimary:   "web--r2",
+			expectedRevisions: []string{"web--r2"},
+		},
+		{
+			name: "split traffic",
+			app: &provider.App{Properties: provider.AppProperties{
+				LatestRevisionName: "web--r3",
+				Configuration: provider.AppConfiguration{
+					Ingress: &provider.Ingress{Traffic: []provider.TrafficWeight{
+						{RevisionName: "web--r1", Weight: 20},
+						{RevisionName: "web--r2", Weight: 80},
+						{RevisionName: "web--r3", Weight: 0},
+					}},
+				},
+			}},
+			expectedPrimary:   "web--r2",
+			expectedRevisions: []string{"web--r1", "web--r2"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedPrimary, primaryRevision(tc.app))
+			assert.Equal(t, tc.expectedRevisions, trafficRevisions(tc.app))
+		})
+	}
+}
+
+func TestCanaryTraffic(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, []provider.TrafficWeight{
+		{RevisionName: "web--r1", Weight: 70},
+		{RevisionName: "web--r2", Weight: 30},
+	}, canaryTraffic("web--r1", "web--r2", 30))
+	assert.Equal(t, []provider.TrafficWeight{
+		{RevisionName: "web--r1", Weight: 0},
+		{RevisionName: "web--r2", Weight: 100},
+	}, canaryTraffic("web--r1", "web--r2", 100))
+	assert.Equal(t, []provider.TrafficWeight{
+		{RevisionName: "web--r1", Weight: 100},
+	}, canaryTraffic("web--r1", "web--r1", 30))
+}
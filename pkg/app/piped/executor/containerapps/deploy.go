@@ -0,0 +1,267 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.ContainerAppsApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderContainerAppsConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.ContainerAppsApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing ContainerAppsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Container Apps client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageContainerAppsSync:
+		status = e.ensureSync(ctx)
+	case model.StageContainerAppsCanaryRollout:
+		status = e.ensureCanaryRollout(ctx)
+	case model.StageContainerAppsTrafficRouting:
+		status = e.ensureTrafficRouting(ctx)
+	case model.StageContainerAppsPromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for container apps application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	m, ok := loadAppManifest(&e.Input, e.appCfg.Input.AppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	live, ok := e.getLiveApp(ctx, m.Name())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		suffix   = provider.MakeRevisionSuffix(e.Deployment.CommitHash(), e.Deployment.Id)
+		revision = provider.RevisionName(m.Name(), suffix)
+		metadata = map[string]string{newRevisionMetadataKey: revision}
+		// The previous revisions are kept active by Azure in multiple revision mode.
+		previous []string
+		traffic  []provider.TrafficWeight
+	)
+	if live != nil && m.MultipleRevisions() {
+		previous = trafficRevisions(live)
+		if primary := primaryRevision(live); primary != "" {
+			metadata[primaryRevisionMetadataKey] = primary
+		}
+	}
+	if m.HasIngress() {
+		traffic = []provider.TrafficWeight{{LatestRevision: true, Weight: 100}}
+	}
+
+	// The revisions are saved before applying so that they can be rolled back even if applying failed.
+	if err := e.MetadataStore.Shared().PutMulti(ctx, metadata); err != nil {
+		e.LogPersister.Errorf("Failed to save the revisions to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := applyApp(ctx, &e.Input, e.client, m, e.Deployment.CommitHash(), suffix, traffic); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !waitRevision(ctx, &e.Input, e.client, m.Name(), revision) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	for _, r := range previous {
+		if r == revision {
+			continue
+		}
+		if !deactivateRevision(ctx, &e.Input, e.client, m.Name(), r) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageStatus {
+	m, ok := loadAppManifest(&e.Input, e.appCfg.Input.AppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !m.MultipleRevisions() || !m.HasIngress() {
+		e.LogPersister.Errorf("Container app %s must have ingress and activeRevisionsMode %s to use %s stage", m.Name(), provider.ActiveRevisionsModeMultiple, model.StageContainerAppsCanaryRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	live, ok := e.getLiveApp(ctx, m.Name())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if live == nil {
+		e.LogPersister.Errorf("Container app %s does not exist yet, it must be deployed once before using %s stage", m.Name(), model.StageContainerAppsCanaryRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	primary := primaryRevision(live)
+	if primary == "" {
+		e.LogPersister.Errorf("Unable to find the revision receiving the traffic of container app %s", m.Name())
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		suffix   = provider.MakeRevisionSuffix(e.Deployment.CommitHash(), e.Deployment.Id)
+		revision = provider.RevisionName(m.Name(), suffix)
+		// Keep the current traffic so that the new revision receives no traffic until the traffic routing stage.
+		traffic = live.Traffic()
+	)
+	if len(traffic) == 0 {
+		traffic = []provider.TrafficWeight{{RevisionName: primary, Weight: 100}}
+	}
+
+	metadata := map[string]string{
+		primaryRevisionMetadataKey: primary,
+		newRevisionMetadataKey:     revision,
+	}
+	if err := e.MetadataStore.Shared().PutMulti(ctx, metadata); err != nil {
+		e.LogPersister.Errorf("Failed to save the revisions to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := applyApp(ctx, &e.Input, e.client, m, e.Deployment.CommitHash(), suffix, traffic); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !waitRevision(ctx, &e.Input, e.client, m.Name(), revision) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.ContainerAppsTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadAppManifest(&e.Input, e.appCfg.Input.AppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	primary, revision, ok := e.loadCanaryRevisions()
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !updateTraffic(ctx, &e.Input, e.client, m.Name(), canaryTraffic(primary, revision, options.Canary.Int())) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	m, ok := loadAppManifest(&e.Input, e.appCfg.Input.AppManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	primary, revision, ok := e.loadCanaryRevisions()
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The primary revision is removed from the traffic weights so that it can be deactivated.
+	if !updateTraffic(ctx, &e.Input, e.client, m.Name(), []provider.TrafficWeight{{RevisionName: revision, Weight: 100}}) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if primary != revision && !deactivateRevision(ctx, &e.Input, e.client, m.Name(), primary) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// getLiveApp returns the running container app or nil if it does not exist yet.
+func (e *deployExecutor) getLiveApp(ctx context.Context, name string) (*provider.App, bool) {
+	app, err := e.client.GetApp(ctx, name)
+	if errors.Is(err, provider.ErrNotFound) {
+		e.LogPersister.Infof("Container app %s does not exist yet", name)
+		return nil, true
+	}
+	if err != nil {
+		e.LogPersister.Errorf("Failed to get container app %s: %v", name, err)
+		return nil, false
+	}
+	return app, true
+}
+
+// loadCanaryRevisions returns the primary and new revisions saved by the canary rollout stage.
+func (e *deployExecutor) loadCanaryRevisions() (primary, revision string, ok bool) {
+	primary, ok = e.MetadataStore.Shared().Get(primaryRevisionMetadataKey)
+	if ok {
+		revision, ok = e.MetadataStore.Shared().Get(newRevisionMetadataKey)
+	}
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the revisions rolled out by %s stage, it must be executed before this stage", model.StageContainerAppsCanaryRollout)
+	}
+	return
+}
+
+func deactivateRevision(ctx context.Context, in *executor.Input, client provider.Client, appName, revisionName string) bool {
+	if err := client.DeactivateRevision(ctx, appName, revisionName); err != nil {
+		in.LogPersister.Errorf("Failed to deactivate revision %s: %v", revisionName, err)
+		return false
+	}
+	in.LogPersister.Infof("Deactivated revision %s", revisionName)
+	return true
+}
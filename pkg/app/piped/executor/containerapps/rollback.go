@@ -0,0 +1,145 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for container apps application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Container Apps client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Route the traffic back to the revision which was receiving it before the deployment if it is still there.
+	if primary, ok := e.MetadataStore.Shared().Get(primaryRevisionMetadataKey); ok {
+		return e.rollbackToRevision(ctx, client, primary)
+	}
+
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.ContainerAppsApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing ContainerAppsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadAppManifest(&e.Input, appCfg.Input.AppManifestFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var traffic []provider.TrafficWeight
+	if m.HasIngress() {
+		traffic = []provider.TrafficWeight{{LatestRevision: true, Weight: 100}}
+	}
+	suffix := provider.MakeRevisionSuffix(e.Deployment.RunningCommitHash, e.Deployment.Id)
+	revision, ok := applyApp(ctx, &e.Input, client, m, e.Deployment.RunningCommitHash, suffix, traffic)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !waitRevision(ctx, &e.Input, client, m.Name(), revision) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rollbackToRevision activates the given revision and routes all traffic to it,
+// then deactivates the revision created by the deployment.
+func (e *rollbackExecutor) rollbackToRevision(ctx context.Context, client provider.Client, primary string) model.StageStatus {
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	appCfg := ds.ApplicationConfig.ContainerAppsApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing ContainerAppsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+	m, ok := loadAppManifest(&e.Input, appCfg.Input.AppManifestFile, ds)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The primary revision was deactivated if the deployment had been promoted.
+	if err := client.ActivateRevision(ctx, m.Name(), primary); err != nil {
+		e.LogPersister.Errorf("Failed to activate revision %s: %v", primary, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !waitRevision(ctx, &e.Input, client, m.Name(), primary) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !updateTraffic(ctx, &e.Input, client, m.Name(), []provider.TrafficWeight{{RevisionName: primary, Weight: 100}}) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	revision, ok := e.MetadataStore.Shared().Get(newRevisionMetadataKey)
+	if !ok || revision == primary {
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	// The new revision might not have been created if the deployment failed while applying.
+	if _, err := client.GetRevision(ctx, m.Name(), revision); err != nil {
+		e.LogPersister.Infof("Skipped deactivating revision %s: %v", revision, err)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	if !deactivateRevision(ctx, &e.Input, client, m.Name(), revision) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	appCfg               *config.DockerComposeApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderDockerComposeConfig
+	compose              *provider.Compose
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.appCfg = ds.ApplicationConfig.DockerComposeApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing DockerComposeApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	execPath, ok := findDockerCompose(ctx, &e.Input, e.platformProviderCfg.ComposeVersion)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if e.compose, ok = newCompose(ctx, &e.Input, execPath, e.appCfg, ds); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageDockerComposeSync:
+		status = e.ensureSync(ctx)
+	case model.StageDockerComposeRollout:
+		status = e.ensureRollout(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for dockercompose application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	// The predefined stage of the quick sync uses the options configured in the application configuration.
+	options := e.StageConfig.DockerComposeSyncStageOptions
+	if options == nil {
+		options = &e.appCfg.QuickSync
+	}
+
+	if !e.apply(ctx, e.appCfg.Input.Hosts, options.WaitTimeout.Duration()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureRollout(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.DockerComposeRolloutStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.apply(ctx, options.Hosts, options.WaitTimeout.Duration()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// apply applies the compose file to the hosts having the given names,
+// and records them so that the rollback can restore the previous version on them.
+func (e *deployExecutor) apply(ctx context.Context, names []string, waitTimeout time.Duration) bool {
+	hosts, err := provider.FindHosts(e.platformProviderCfg, names)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to find the hosts in platform provider %s: %v", e.platformProviderName, err)
+		return false
+	}
+
+	// The hosts are recorded before applying since a failed host may have been changed partially.
+	applied := appliedHosts(&e.Input)
+	seen := make(map[string]struct{}, len(applied))
+	for _, h := range applied {
+		seen[h] = struct{}{}
+	}
+	for _, h := range hosts {
+		if _, ok := seen[h.Name]; !ok {
+			applied = append(applied, h.Name)
+		}
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, appliedHostsMetadataKey, strings.Join(applied, ",")); err != nil {
+		e.LogPersister.Errorf("Failed to save the applied hosts to metadata: %v", err)
+		return false
+	}
+
+	return applyHosts(ctx, &e.Input, e.compose, hosts, waitTimeout, e.Deployment.CommitHash())
+}
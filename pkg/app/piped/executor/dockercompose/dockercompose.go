@@ -0,0 +1,132 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The names of the hosts where the compose file has been applied by this deployment.
+	appliedHostsMetadataKey = "dockercompose-applied-hosts"
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageDockerComposeSync, f)
+	r.Register(model.StageDockerComposeRollout, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_DOCKERCOMPOSE, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderDockerComposeConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_DOCKERCOMPOSE)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.DockerComposeConfig
+	found = true
+	return
+}
+
+func findDockerCompose(ctx context.Context, in *executor.Input, version string) (string, bool) {
+	path, installed, err := toolregistry.DefaultRegistry().DockerCompose(ctx, version)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to find required docker-compose %q (%v)", version, err)
+		return "", false
+	}
+	if installed {
+		in.LogPersister.Infof("Docker-compose %q has just been installed to %q because of no pre-installed binary for that version", version, path)
+	}
+	return path, true
+}
+
+// newCompose returns a Compose applying the compose file of the given deploy source
+// after checking that the file is valid.
+func newCompose(ctx context.Context, in *executor.Input, execPath string, appCfg *config.DockerComposeApplicationSpec, ds *deploysource.DeploySource) (*provider.Compose, bool) {
+	in.LogPersister.Infof("Loading compose file at commit %s", ds.Revision)
+
+	if _, err := provider.LoadComposeFile(ds.AppDir, appCfg.Input.ComposeFile); err != nil {
+		in.LogPersister.Errorf("Failed to load compose file (%v)", err)
+		return nil, false
+	}
+
+	project := appCfg.Input.ProjectName
+	if project == "" {
+		project = provider.ProjectName(in.Application.Name)
+	}
+	compose := provider.NewCompose(execPath, ds.AppDir, appCfg.Input.ComposeFile, project)
+	if err := compose.Validate(ctx); err != nil {
+		in.LogPersister.Errorf("Failed to validate compose file (%v)", err)
+		return nil, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the compose file of project %s at commit %s", project, ds.Revision)
+	return compose, true
+}
+
+// applyHosts applies the compose file to the given hosts one by one.
+// The rollout is stopped at the first host whose services did not become running and healthy,
+// so that the other hosts keep running the current version.
+func applyHosts(ctx context.Context, in *executor.Input, compose *provider.Compose, hosts []config.DockerComposeHost, waitTimeout time.Duration, commitHash string) bool {
+	for i, h := range hosts {
+		in.LogPersister.Infof("Applying the compose file to host %s (%d/%d)", h.Name, i+1, len(hosts))
+		if err := compose.Up(ctx, in.LogPersister, h, waitTimeout); err != nil {
+			in.LogPersister.Errorf("Failed to apply the compose file to host %s: %v", h.Name, err)
+			return false
+		}
+		in.LogPersister.Successf("Successfully applied commit %s to host %s", commitHash, h.Name)
+	}
+	return true
+}
+
+// appliedHosts returns the names of the hosts where the compose file has been applied by this deployment.
+func appliedHosts(in *executor.Input) []string {
+	value, ok := in.MetadataStore.Shared().Get(appliedHostsMetadataKey)
+	if !ok || value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+func TestApplyHosts(t *testing.T) {
+	t.Parallel()
+
+	// The fake docker-compose records the hosts it was applied to,
+	// and fails on edge-02 as if its services did not become healthy.
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "applied")
+	execPath := filepath.Join(dir, "docker-compose")
+	script := "#!/bin/sh\necho $DOCKER_HOST >> " + logFile + "\n[ \"$DOCKER_HOST\" != \"ssh://deploy@edge-02\" ]\n"
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0o755))
+
+	compose := provider.NewCompose(execPath, dir, "docker-compose.yaml", "web")
+	in := &executor.Input{LogPersister: &fakeLogPersister{}}
+	hosts := []config.DockerComposeHost{
+		{Name: "edge-01", Address: "ssh://deploy@edge-01"},
+		{Name: "edge-02", Address: "ssh://deploy@edge-02"},
+		{Name: "edge-03", Address: "ssh://deploy@edge-03"},
+	}
+
+	ok := applyHosts(context.Background(), in, compose, hosts[:1], time.Minute, "0123abcdef")
+	assert.True(t, ok)
+
+	// The rollout is stopped at the failed host.
+	ok = applyHosts(context.Background(), in, compose, hosts[1:], time.Minute, "0123abcdef")
+	assert.False(t, ok)
+
+	data, err := os.ReadFile(logFile)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ssh://deploy@edge-01", "ssh://deploy@edge-02"}, strings.Fields(string(data)))
+}
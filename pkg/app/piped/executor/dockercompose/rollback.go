@@ -0,0 +1,107 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for dockercompose application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// ensureRollback applies the compose file of the last deployed commit again
+// to the hosts where the compose file has been applied by this deployment.
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	names := appliedHosts(&e.Input)
+	if len(names) == 0 {
+		e.LogPersister.Infof("The compose file was not applied to any host, there is nothing to rollback")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.DockerComposeApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing DockerComposeApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	hosts, err := provider.FindHosts(platformProviderCfg, names)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to find the hosts in platform provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	execPath, ok := findDockerCompose(ctx, &e.Input, platformProviderCfg.ComposeVersion)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	compose, ok := newCompose(ctx, &e.Input, execPath, appCfg, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// All hosts are tried to be restored even if one of them failed.
+	succeeded := true
+	for _, h := range hosts {
+		if !applyHosts(ctx, &e.Input, compose, []config.DockerComposeHost{h}, appCfg.QuickSync.WaitTimeout.Duration(), e.Deployment.RunningCommitHash) {
+			succeeded = false
+		}
+	}
+	if !succeeded {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
@@ -0,0 +1,312 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ec2asg"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The group and the target group which were receiving the traffic before the deployment.
+	blueGroupMetadataKey       = "ec2asg-blue-group"
+	blueTargetGroupMetadataKey = "ec2asg-blue-target-group"
+	// The group launched by the GREEN rollout stage and the target group it is attached to.
+	greenGroupMetadataKey       = "ec2asg-green-group"
+	greenTargetGroupMetadataKey = "ec2asg-green-target-group"
+
+	greenPercentMetadataKey = "ec2asg-green-percent"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.EC2ASGApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderEC2ASGConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.EC2ASGApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing EC2ASGApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create EC2 Auto Scaling client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageEC2ASGSync:
+		status = e.ensureSync(ctx)
+	case model.StageEC2ASGGreenRollout:
+		status = e.ensureGreenRollout(ctx)
+	case model.StageEC2ASGTrafficRouting:
+		status = e.ensureTrafficRouting(ctx)
+	case model.StageEC2ASGPromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for ec2asg application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	// The predefined stage of the quick sync uses the options configured in the application configuration.
+	options := e.StageConfig.EC2ASGSyncStageOptions
+	if options == nil {
+		options = &e.appCfg.QuickSync
+	}
+
+	m, ok := loadGroupManifest(&e.Input, e.appCfg.Input.AutoScalingGroupManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Without the traffic routing, the instances of the group are replaced in place.
+	if e.appCfg.Input.TrafficRouting == nil {
+		if !rollingUpdate(ctx, &e.Input, e.client, m, e.Deployment.CommitHash(), *options) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if !e.rolloutGreen(ctx, m) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !e.promoteGreen(ctx) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureGreenRollout(ctx context.Context) model.StageStatus {
+	if e.appCfg.Input.TrafficRouting == nil {
+		e.LogPersister.Errorf("Unable to run %s stage without trafficRouting in the application configuration", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadGroupManifest(&e.Input, e.appCfg.Input.AutoScalingGroupManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.rolloutGreen(ctx, m) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.EC2ASGTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	green, blue, ok := e.loadTargetGroups()
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	percent := options.Green.Int()
+	metadata := map[string]string{
+		greenPercentMetadataKey: strconv.Itoa(percent),
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+	}
+
+	if !routeTraffic(ctx, &e.Input, e.client, e.appCfg.Input.TrafficRouting.ListenerArns, green, blue, percent) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	if !e.promoteGreen(ctx) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rolloutGreen launches the GREEN group of the given manifest behind the target group which is not receiving the traffic,
+// and waits until all of its instances become healthy.
+// The groups to be used by the following stages and the rollback are saved to the shared metadata.
+func (e *deployExecutor) rolloutGreen(ctx context.Context, m provider.AutoScalingGroupManifest) bool {
+	routing := e.appCfg.Input.TrafficRouting
+
+	weights, err := e.client.GetTargetGroupWeights(ctx, routing.ListenerArns[0])
+	if err != nil {
+		e.LogPersister.Errorf("Failed to get the current traffic routing: %v", err)
+		return false
+	}
+	blueTargetGroup, greenTargetGroup := splitTargetGroups(weights, routing.TargetGroupArns)
+	e.LogPersister.Infof("Target group %s is receiving the traffic, the GREEN group will be attached to target group %s", blueTargetGroup, greenTargetGroup)
+
+	groups, err := e.client.ListAutoScalingGroups(ctx, provider.LabelApplication, e.Deployment.ApplicationId)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to list the auto scaling groups of the application: %v", err)
+		return false
+	}
+
+	var blue *provider.AutoScalingGroup
+	if blues := findGroupsAttachedTo(groups, blueTargetGroup); len(blues) > 0 {
+		blue = &blues[0]
+		e.LogPersister.Infof("Auto scaling group %s is the BLUE group", blue.AutoScalingGroupName)
+	} else {
+		e.LogPersister.Infof("No auto scaling group of the application is attached to target group %s", blueTargetGroup)
+	}
+
+	greenName := provider.GreenGroupName(m.Spec.Name, e.Deployment.Id)
+	// The groups left by the previous failed deployments are not receiving any traffic.
+	for _, g := range findGroupsAttachedTo(groups, greenTargetGroup) {
+		if g.AutoScalingGroupName == greenName {
+			continue
+		}
+		e.LogPersister.Infof("Deleting auto scaling group %s which is attached to the idle target group", g.AutoScalingGroupName)
+		if !deleteGroup(ctx, &e.Input, e.client, g.AutoScalingGroupName) {
+			return false
+		}
+	}
+
+	metadata := map[string]string{
+		greenGroupMetadataKey:       greenName,
+		greenTargetGroupMetadataKey: greenTargetGroup,
+		blueTargetGroupMetadataKey:  blueTargetGroup,
+	}
+	if blue != nil {
+		metadata[blueGroupMetadataKey] = blue.AutoScalingGroupName
+	}
+	// Save them before launching the GREEN group so that the rollback can find it even when this stage failed.
+	if err := e.MetadataStore.Shared().PutMulti(ctx, metadata); err != nil {
+		e.LogPersister.Errorf("Failed to save the auto scaling groups to metadata: %v", err)
+		return false
+	}
+
+	commitHash := e.Deployment.CommitHash()
+	m.AddTags(makeTags(&e.Input, commitHash))
+	version, ok := createLaunchTemplateVersion(ctx, &e.Input, e.client, m, commitHash)
+	if !ok {
+		return false
+	}
+
+	desired := desiredCapacity(m, blue)
+	m.Spec.DesiredCapacity = &desired
+	input := m.GroupInput(greenName, version, []string{greenTargetGroup})
+
+	_, err = e.client.DescribeAutoScalingGroup(ctx, greenName)
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		if err := e.client.CreateAutoScalingGroup(ctx, input); err != nil {
+			e.LogPersister.Errorf("Failed to create auto scaling group %s: %v", greenName, err)
+			return false
+		}
+		e.LogPersister.Infof("Created the GREEN group %s with %d instances", greenName, desired)
+
+	case err != nil:
+		e.LogPersister.Errorf("Failed to find auto scaling group %s: %v", greenName, err)
+		return false
+
+	default:
+		// The stage is retried.
+		if err := e.client.UpdateAutoScalingGroup(ctx, input); err != nil {
+			e.LogPersister.Errorf("Failed to update auto scaling group %s: %v", greenName, err)
+			return false
+		}
+		e.LogPersister.Infof("Updated the GREEN group %s to launch template version %s", greenName, version)
+	}
+
+	if !waitGroupReady(ctx, &e.Input, e.client, greenName, desired) {
+		return false
+	}
+	e.LogPersister.Successf("Successfully rolled out the GREEN group %s", greenName)
+	return true
+}
+
+// promoteGreen routes all traffic to the GREEN group and deletes the BLUE group.
+func (e *deployExecutor) promoteGreen(ctx context.Context) bool {
+	green, blue, ok := e.loadTargetGroups()
+	if !ok {
+		return false
+	}
+	if !routeTraffic(ctx, &e.Input, e.client, e.appCfg.Input.TrafficRouting.ListenerArns, green, blue, 100) {
+		return false
+	}
+
+	if blueGroup, ok := e.MetadataStore.Shared().Get(blueGroupMetadataKey); ok && blueGroup != "" {
+		if !deleteGroup(ctx, &e.Input, e.client, blueGroup) {
+			return false
+		}
+	}
+
+	greenGroup, _ := e.MetadataStore.Shared().Get(greenGroupMetadataKey)
+	e.LogPersister.Successf("Successfully promoted the GREEN group %s", greenGroup)
+	return true
+}
+
+// loadTargetGroups returns the GREEN and BLUE target groups saved by the GREEN rollout stage.
+func (e *deployExecutor) loadTargetGroups() (green, blue string, ok bool) {
+	if e.appCfg.Input.TrafficRouting == nil {
+		e.LogPersister.Errorf("Unable to run %s stage without trafficRouting in the application configuration", e.Stage.Name)
+		return
+	}
+	green, ok = e.MetadataStore.Shared().Get(greenTargetGroupMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the GREEN group, it must be launched by %s stage before", model.StageEC2ASGGreenRollout)
+		return
+	}
+	blue, ok = e.MetadataStore.Shared().Get(blueTargetGroupMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the BLUE target group, it must be saved by %s stage before", model.StageEC2ASGGreenRollout)
+		return
+	}
+	return
+}
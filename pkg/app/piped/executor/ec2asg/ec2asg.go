@@ -0,0 +1,242 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ec2asg"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var statusCheckInterval = 10 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageEC2ASGSync, f)
+	r.Register(model.StageEC2ASGGreenRollout, f)
+	r.Register(model.StageEC2ASGTrafficRouting, f)
+	r.Register(model.StageEC2ASGPromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_EC2ASG, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderEC2ASGConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_EC2ASG)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.EC2ASGConfig
+	found = true
+	return
+}
+
+func loadGroupManifest(in *executor.Input, manifestFile string, ds *deploysource.DeploySource) (provider.AutoScalingGroupManifest, bool) {
+	in.LogPersister.Infof("Loading auto scaling group manifest at commit %s", ds.Revision)
+
+	m, err := provider.LoadAutoScalingGroupManifest(ds.AppDir, manifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load auto scaling group manifest (%v)", err)
+		return provider.AutoScalingGroupManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the auto scaling group manifest at commit %s", ds.Revision)
+	return m, true
+}
+
+func makeTags(in *executor.Input, commitHash string) map[string]string {
+	return map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+}
+
+// createLaunchTemplateVersion creates a new version of the launch template to launch the instances from the image of the given manifest.
+func createLaunchTemplateVersion(ctx context.Context, in *executor.Input, client provider.Client, m provider.AutoScalingGroupManifest, commitHash string) (string, bool) {
+	lt := m.Spec.LaunchTemplate
+	version, err := client.CreateLaunchTemplateVersion(ctx, lt, provider.LaunchTemplateVersionDescription(commitHash))
+	if err != nil {
+		in.LogPersister.Errorf("Failed to create a new version of launch template %s: %v", lt.LaunchTemplateName, err)
+		return "", false
+	}
+	in.LogPersister.Infof("Created version %s of launch template %s with image %s", version, lt.LaunchTemplateName, lt.ImageID)
+	return version, true
+}
+
+// rollingUpdate updates the group of the given manifest to launch the instances from the image of the given commit,
+// and replaces all of its instances by an instance refresh.
+// The group is created when it does not exist yet.
+func rollingUpdate(ctx context.Context, in *executor.Input, client provider.Client, m provider.AutoScalingGroupManifest, commitHash string, opts config.EC2ASGSyncStageOptions) bool {
+	name := m.Spec.Name
+	m.AddTags(makeTags(in, commitHash))
+
+	version, ok := createLaunchTemplateVersion(ctx, in, client, m, commitHash)
+	if !ok {
+		return false
+	}
+	input := m.GroupInput(name, version, m.Spec.TargetGroupArns)
+
+	_, err := client.DescribeAutoScalingGroup(ctx, name)
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		if err := client.CreateAutoScalingGroup(ctx, input); err != nil {
+			in.LogPersister.Errorf("Failed to create auto scaling group %s: %v", name, err)
+			return false
+		}
+		in.LogPersister.Infof("Created auto scaling group %s, waiting for its instances to become healthy", name)
+		// There are no old instances to be replaced.
+		return waitGroupReady(ctx, in, client, name, desiredCapacity(m, nil))
+
+	case err != nil:
+		in.LogPersister.Errorf("Failed to find auto scaling group %s: %v", name, err)
+		return false
+	}
+
+	if err := client.UpdateAutoScalingGroup(ctx, input); err != nil {
+		in.LogPersister.Errorf("Failed to update auto scaling group %s: %v", name, err)
+		return false
+	}
+	// The tags are not updated by UpdateAutoScalingGroup.
+	if err := client.TagAutoScalingGroup(ctx, name, m.Spec.Tags); err != nil {
+		in.LogPersister.Errorf("Failed to update the tags of auto scaling group %s: %v", name, err)
+		return false
+	}
+	in.LogPersister.Infof("Updated auto scaling group %s to launch template version %s", name, version)
+
+	id, err := client.StartInstanceRefresh(ctx, name, opts.MinHealthyPercentage, opts.InstanceWarmup.Duration())
+	if err != nil {
+		in.LogPersister.Errorf("Failed to start instance refresh of auto scaling group %s: %v", name, err)
+		return false
+	}
+	in.LogPersister.Infof("Started instance refresh %s of auto scaling group %s with the minimum healthy percentage %d", id, name, opts.MinHealthyPercentage)
+
+	refresh, err := provider.WaitInstanceRefresh(ctx, client, name, id, statusCheckInterval)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to wait for instance refresh %s: %v", id, err)
+		return false
+	}
+	if refresh.Status != provider.InstanceRefreshStatusSuccessful {
+		in.LogPersister.Errorf("Instance refresh %s finished with status %s: %s", id, refresh.Status, refresh.StatusReason)
+		return false
+	}
+
+	in.LogPersister.Successf("Successfully replaced all instances of auto scaling group %s", name)
+	return true
+}
+
+func waitGroupReady(ctx context.Context, in *executor.Input, client provider.Client, name string, desired int) bool {
+	if _, err := provider.WaitGroupReady(ctx, client, name, desired, statusCheckInterval); err != nil {
+		in.LogPersister.Errorf("Failed to wait for %d instances of auto scaling group %s to become healthy: %v", desired, name, err)
+		return false
+	}
+	in.LogPersister.Infof("All %d instances of auto scaling group %s are healthy and in service", desired, name)
+	return true
+}
+
+// desiredCapacity returns the number of instances the group of the given manifest should have.
+// When the manifest does not specify it, the capacity of the current group is kept,
+// otherwise the minimum size is used.
+func desiredCapacity(m provider.AutoScalingGroupManifest, current *provider.AutoScalingGroup) int {
+	if m.Spec.DesiredCapacity != nil {
+		return *m.Spec.DesiredCapacity
+	}
+	if current != nil && current.DesiredCapacity >= m.Spec.MinSize && current.DesiredCapacity <= m.Spec.MaxSize {
+		return current.DesiredCapacity
+	}
+	return m.Spec.MinSize
+}
+
+// splitTargetGroups returns the target group receiving the most traffic as the active one and the other as the idle one.
+// The first target group is active when both of them are receiving the same amount of traffic.
+func splitTargetGroups(weights map[string]int, targetGroupArns []string) (active, idle string) {
+	first, second := targetGroupArns[0], targetGroupArns[1]
+	if weights[second] > weights[first] {
+		return second, first
+	}
+	return first, second
+}
+
+// findGroupsAttachedTo returns the groups attached to the given target group except the ones being deleted.
+func findGroupsAttachedTo(groups []provider.AutoScalingGroup, targetGroupArn string) []provider.AutoScalingGroup {
+	var out []provider.AutoScalingGroup
+	for _, g := range groups {
+		if g.Status != "" {
+			continue
+		}
+		if g.HasTargetGroup(targetGroupArn) {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// makeWeights returns the weights routing the given percentage of traffic to the GREEN target group
+// and the rest to the BLUE target group.
+func makeWeights(greenTargetGroupArn, blueTargetGroupArn string, greenPercent int) []provider.TargetGroupWeight {
+	return []provider.TargetGroupWeight{
+		{TargetGroupArn: greenTargetGroupArn, Weight: greenPercent},
+		{TargetGroupArn: blueTargetGroupArn, Weight: 100 - greenPercent},
+	}
+}
+
+func routeTraffic(ctx context.Context, in *executor.Input, client provider.Client, listenerArns []string, greenTargetGroupArn, blueTargetGroupArn string, greenPercent int) bool {
+	weights := makeWeights(greenTargetGroupArn, blueTargetGroupArn, greenPercent)
+	if err := client.ModifyListeners(ctx, listenerArns, weights); err != nil {
+		in.LogPersister.Errorf("Failed to route traffic: %v", err)
+		return false
+	}
+	in.LogPersister.Infof("Routed %d%% of traffic to target group %s and %d%% to target group %s", greenPercent, greenTargetGroupArn, 100-greenPercent, blueTargetGroupArn)
+	return true
+}
+
+// deleteGroup deletes the given group together with its instances.
+// It succeeds when the group has already been deleted.
+func deleteGroup(ctx context.Context, in *executor.Input, client provider.Client, name string) bool {
+	err := client.DeleteAutoScalingGroup(ctx, name)
+	if err != nil && !errors.Is(err, provider.ErrNotFound) {
+		in.LogPersister.Errorf("Failed to delete auto scaling group %s: %v", name, err)
+		return false
+	}
+	in.LogPersister.Infof("Deleted auto scaling group %s", name)
+	return true
+}
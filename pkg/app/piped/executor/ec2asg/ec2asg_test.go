@@ -0,0 +1,141 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ec2asg"
+)
+
+func TestSplitTargetGroups(t *testing.T) {
+	t.Parallel()
+
+	targetGroups := []string{"arn:tg-1", "arn:tg-2"}
+	testcases := []struct {
+		name           string
+		weights        map[string]int
+		expectedActive string
+		expectedIdle   string
+	}{
+		{
+			name:           "first one is receiving all traffic",
+			weights:        map[string]int{"arn:tg-1": 100},
+			expectedActive: "arn:tg-1",
+			expectedIdle:   "arn:tg-2",
+		},
+		{
+			name:           "second one is receiving most traffic",
+			weights:        map[string]int{"arn:tg-1": 20, "arn:tg-2": 80},
+			expectedActive: "arn:tg-2",
+			expectedIdle:   "arn:tg-1",
+		},
+		{
+			name:           "same weights",
+			weights:        map[string]int{"arn:tg-1": 50, "arn:tg-2": 50},
+			expectedActive: "arn:tg-1",
+			expectedIdle:   "arn:tg-2",
+		},
+		{
+			name:           "not forwarding to the target groups",
+			weights:        map[string]int{"arn:tg-other": 100},
+			expectedActive: "arn:tg-1",
+			expectedIdle:   "arn:tg-2",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			active, idle := splitTargetGroups(tc.weights, targetGroups)
+			assert.Equal(t, tc.expectedActive, active)
+			assert.Equal(t, tc.expectedIdle, idle)
+		})
+	}
+}
+
+func TestFindGroupsAttachedTo(t *testing.T) {
+	t.Parallel()
+
+	groups := []provider.AutoScalingGroup{
+		{AutoScalingGroupName: "web-1", TargetGroupARNs: []string{"arn:tg-1"}},
+		{AutoScalingGroupName: "web-2", TargetGroupARNs: []string{"arn:tg-2"}},
+		{AutoScalingGroupName: "web-3", TargetGroupARNs: []string{"arn:tg-2"}, Status: "Delete in progress"},
+		{AutoScalingGroupName: "web-4"},
+	}
+
+	got := findGroupsAttachedTo(groups, "arn:tg-2")
+	assert.Equal(t, []provider.AutoScalingGroup{groups[1]}, got)
+	assert.Empty(t, findGroupsAttachedTo(groups, "arn:tg-other"))
+}
+
+func TestDesiredCapacity(t *testing.T) {
+	t.Parallel()
+
+	three := 3
+	testcases := []struct {
+		name     string
+		desired  *int
+		current  *provider.AutoScalingGroup
+		expected int
+	}{
+		{
+			name:     "specified in manifest",
+			desired:  &three,
+			current:  &provider.AutoScalingGroup{DesiredCapacity: 2},
+			expected: 3,
+		},
+		{
+			name:     "keep the current capacity",
+			current:  &provider.AutoScalingGroup{DesiredCapacity: 4},
+			expected: 4,
+		},
+		{
+			name:     "current capacity is out of range",
+			current:  &provider.AutoScalingGroup{DesiredCapacity: 10},
+			expected: 1,
+		},
+		{
+			name:     "no current group",
+			expected: 1,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m := provider.AutoScalingGroupManifest{
+				Spec: provider.AutoScalingGroupManifestSpec{
+					MinSize:         1,
+					MaxSize:         5,
+					DesiredCapacity: tc.desired,
+				},
+			}
+			assert.Equal(t, tc.expected, desiredCapacity(m, tc.current))
+		})
+	}
+}
+
+func TestMakeWeights(t *testing.T) {
+	t.Parallel()
+
+	expected := []provider.TargetGroupWeight{
+		{TargetGroupArn: "arn:tg-green", Weight: 30},
+		{TargetGroupArn: "arn:tg-blue", Weight: 70},
+	}
+	assert.Equal(t, expected, makeWeights("arn:tg-green", "arn:tg-blue", 30))
+}
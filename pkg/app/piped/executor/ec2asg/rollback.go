@@ -0,0 +1,138 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ec2asg"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for ec2asg application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	targetDS, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	targetCfg := targetDS.ApplicationConfig.EC2ASGApplicationSpec
+	if targetCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing EC2ASGApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create EC2 Auto Scaling client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if targetCfg.Input.TrafficRouting != nil {
+		return e.rollbackBlueGreen(ctx, client, targetCfg.Input.TrafficRouting.ListenerArns)
+	}
+	return e.rollbackRolling(ctx, client)
+}
+
+// rollbackRolling re-applies the manifest of the last deployed commit by an instance refresh.
+func (e *rollbackExecutor) rollbackRolling(ctx context.Context, client provider.Client) model.StageStatus {
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.EC2ASGApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing EC2ASGApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadGroupManifest(&e.Input, appCfg.Input.AutoScalingGroupManifestFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !rollingUpdate(ctx, &e.Input, client, m, e.Deployment.RunningCommitHash, appCfg.QuickSync) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rollbackBlueGreen routes all traffic back to the BLUE group and deletes the GREEN group.
+func (e *rollbackExecutor) rollbackBlueGreen(ctx context.Context, client provider.Client, listenerArns []string) model.StageStatus {
+	greenGroup, ok := e.MetadataStore.Shared().Get(greenGroupMetadataKey)
+	if !ok {
+		e.LogPersister.Info("The GREEN group was not launched, there is nothing to rollback")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	greenTargetGroup, _ := e.MetadataStore.Shared().Get(greenTargetGroupMetadataKey)
+	blueTargetGroup, _ := e.MetadataStore.Shared().Get(blueTargetGroupMetadataKey)
+
+	// The GREEN group is kept when there is no group to serve the traffic instead of it.
+	blueGroup, _ := e.MetadataStore.Shared().Get(blueGroupMetadataKey)
+	if blueGroup == "" {
+		e.LogPersister.Errorf("Unable to rollback since there was no BLUE group before the deployment. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if _, err := client.DescribeAutoScalingGroup(ctx, blueGroup); err != nil {
+		e.LogPersister.Errorf("Unable to rollback to the BLUE group %s: %v", blueGroup, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !routeTraffic(ctx, &e.Input, client, listenerArns, greenTargetGroup, blueTargetGroup, 0) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !deleteGroup(ctx, &e.Input, client, greenGroup) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Successf("Successfully rolled back to the BLUE group %s", blueGroup)
+	return model.StageStatus_STAGE_SUCCESS
+}
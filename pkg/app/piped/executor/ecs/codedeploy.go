@@ -0,0 +1,267 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	codeDeployDeploymentIDKey = "codedeploy-deployment-id"
+	codeDeployInterval        = 15 * time.Second
+)
+
+func (e *deployExecutor) ensureCodeDeployDeploy(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.ECSCodeDeployDeployStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	timeout := options.Timeout.Duration()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	client, id, ok := createCodeDeployDeployment(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, &e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The traffic is shifted by the ECS_CODEDEPLOY_CONTINUE stage.
+	e.LogPersister.Infof("Waiting for the replacement task set to become ready to receive the traffic (timeout: %v)", timeout)
+	if !waitCodeDeployDeployment(ctx, &e.Input, client, id, false) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCodeDeployContinue(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.ECSCodeDeployContinueStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	id, ok := e.MetadataStore.Shared().Get(codeDeployDeploymentIDKey)
+	if !ok {
+		e.LogPersister.Errorf("No CodeDeploy deployment to continue, the %s stage must be run in advance", model.StageECSCodeDeployDeploy)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	timeout := options.Timeout.Duration()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	e.LogPersister.Infof("Waiting for the traffic to be shifted to the replacement task set by CodeDeploy deployment %s (timeout: %v)", id, timeout)
+	if !waitCodeDeployDeployment(ctx, &e.Input, client, id, true) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, e.appCfg.Input.KeepTaskDefinitionRevisions, e.appCfg.Input.TaskDefinitionFiles(), e.deploySource)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// syncCodeDeploy deploys the service by a CodeDeploy deployment and waits until it finishes.
+func (e *deployExecutor) syncCodeDeploy(ctx context.Context) model.StageStatus {
+	client, id, ok := createCodeDeployDeployment(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, &e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Waiting for CodeDeploy deployment %s to finish", id)
+	if !waitCodeDeployDeployment(ctx, &e.Input, client, id, true) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, e.appCfg.Input.KeepTaskDefinitionRevisions, e.appCfg.Input.TaskDefinitionFiles(), e.deploySource)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// createCodeDeployDeployment registers the task definition and creates a CodeDeploy deployment
+// which deploys it to the service. The ID of the created deployment is stored
+// so that the following stages and the rollback can refer it.
+func createCodeDeployDeployment(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, ecsInput *config.ECSDeploymentInput, ds *deploysource.DeploySource) (provider.Client, string, bool) {
+	taskDefinition, ok := loadTaskDefinition(in, ecsInput.TaskDefinitionFile, ds)
+	if !ok {
+		return nil, "", false
+	}
+	serviceDefinition, ok := loadServiceDefinition(in, ecsInput.ServiceDefinitionFile, ds)
+	if !ok {
+		return nil, "", false
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return nil, "", false
+	}
+
+	in.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return nil, "", false
+	}
+
+	cfg := *ecsInput.CodeDeploy
+	in.LogPersister.Infof("Start creating CodeDeploy deployment of deployment group %s for ECS service %s", cfg.DeploymentGroupName, aws.ToString(serviceDefinition.ServiceName))
+	desc := fmt.Sprintf("Deployed by PipeCD deployment %s at commit %s", in.Deployment.Id, in.Deployment.CommitHash())
+	id, err := client.CreateCodeDeployDeployment(ctx, cfg, serviceDefinition, *td, desc)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to create CodeDeploy deployment: %v", err)
+		return nil, "", false
+	}
+	if err := in.MetadataStore.Shared().Put(ctx, codeDeployDeploymentIDKey, id); err != nil {
+		in.LogPersister.Errorf("Unable to store the ID of CodeDeploy deployment %s to metadata store: %v", id, err)
+		return nil, "", false
+	}
+
+	in.LogPersister.Infof("Successfully created CodeDeploy deployment %s with task definition revision %d", id, td.Revision)
+	return client, id, true
+}
+
+// waitCodeDeployDeployment waits until the given CodeDeploy deployment succeeds while reporting its lifecycle events.
+// When continueReady is false, it also returns once the replacement task set becomes ready to receive the traffic.
+// Otherwise, the deployment waiting for the manual reroute is continued.
+func waitCodeDeployDeployment(ctx context.Context, in *executor.Input, client provider.Client, id string, continueReady bool) bool {
+	ticker := time.NewTicker(codeDeployInterval)
+	defer ticker.Stop()
+
+	var (
+		reported  = make(map[string]string)
+		continued bool
+	)
+	for {
+		d, err := client.GetCodeDeployDeployment(ctx, id)
+		if err != nil {
+			// Keep waiting since the error might be temporary.
+			in.LogPersister.Infof("Failed to get the state of CodeDeploy deployment %s: %v", id, err)
+		} else {
+			for _, ev := range d.LifecycleEvents {
+				if reported[ev.Name] == ev.Status {
+					continue
+				}
+				reported[ev.Name] = ev.Status
+				in.LogPersister.Infof("Lifecycle event %s: %s", ev.Name, ev.Status)
+			}
+
+			switch d.Status {
+			case provider.CodeDeployStatusSucceeded:
+				in.LogPersister.Successf("CodeDeploy deployment %s succeeded", id)
+				return true
+			case provider.CodeDeployStatusFailed, provider.CodeDeployStatusStopped:
+				in.LogPersister.Errorf("CodeDeploy deployment %s was %s: %s", id, d.Status, d.ErrorMessage)
+				return false
+			case provider.CodeDeployStatusReady:
+				if !continueReady {
+					in.LogPersister.Successf("The replacement task set of CodeDeploy deployment %s is ready to receive the traffic", id)
+					return true
+				}
+				if !continued {
+					if err := client.ContinueCodeDeployDeployment(ctx, id); err != nil {
+						in.LogPersister.Errorf("Failed to start shifting the traffic: %v", err)
+						return false
+					}
+					in.LogPersister.Infof("Started shifting the traffic to the replacement task set")
+					continued = true
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Timed out waiting for CodeDeploy deployment %s", id)
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// rollbackCodeDeploy rolls back the service deployed by the given CodeDeploy deployment.
+// The deployment in progress is stopped so that CodeDeploy reroutes the traffic to the original task set,
+// and the succeeded one is reverted by a new deployment of the running definitions.
+func rollbackCodeDeploy(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, id string, runningInput *config.ECSDeploymentInput, runningDS *deploysource.DeploySource) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	d, err := client.GetCodeDeployDeployment(ctx, id)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the state of CodeDeploy deployment %s: %v", id, err)
+		return false
+	}
+
+	switch d.Status {
+	case provider.CodeDeployStatusFailed, provider.CodeDeployStatusStopped:
+		in.LogPersister.Infof("CodeDeploy deployment %s was already %s, the traffic is served by the original task set", id, d.Status)
+		return true
+	case provider.CodeDeployStatusSucceeded:
+		if runningInput.CodeDeploy == nil {
+			in.LogPersister.Errorf("Unable to roll back CodeDeploy deployment %s since the running configuration doesn't use CodeDeploy", id)
+			return false
+		}
+		in.LogPersister.Infof("CodeDeploy deployment %s has already succeeded, start deploying the running definitions", id)
+		client, newID, ok := createCodeDeployDeployment(ctx, in, platformProviderName, platformProviderCfg, runningInput, runningDS)
+		if !ok {
+			return false
+		}
+		return waitCodeDeployDeployment(ctx, in, client, newID, true)
+	}
+
+	in.LogPersister.Infof("Stopping CodeDeploy deployment %s to reroute the traffic to the original task set", id)
+	if err := client.StopCodeDeployDeployment(ctx, id); err != nil {
+		in.LogPersister.Errorf("Failed to stop CodeDeploy deployment %s: %v", id, err)
+		return false
+	}
+
+	ticker := time.NewTicker(codeDeployInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Timed out waiting for CodeDeploy deployment %s to be stopped", id)
+			return false
+		case <-ticker.C:
+		}
+
+		d, err := client.GetCodeDeployDeployment(ctx, id)
+		if err != nil {
+			in.LogPersister.Infof("Failed to get the state of CodeDeploy deployment %s: %v", id, err)
+			continue
+		}
+		if d.IsCompleted() {
+			in.LogPersister.Successf("CodeDeploy deployment %s was %s and rolled back", id, d.Status)
+			return true
+		}
+	}
+}
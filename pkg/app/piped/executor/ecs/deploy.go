@@ -21,6 +21,7 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -54,6 +55,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	if !found {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	e.platformProviderCfg = provider.WithAssumeRole(e.platformProviderCfg, e.appCfg.Input)
 
 	var (
 		originalStatus = e.Stage.Status
@@ -71,6 +73,14 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status = e.ensureCanaryClean(ctx)
 	case model.StageECSTrafficRouting:
 		status = e.ensureTrafficRouting(ctx)
+	case model.StageECSTaskRun:
+		status = e.ensureTaskRun(ctx)
+	case model.StageECSWaitHealthy:
+		status = e.ensureWaitHealthy(ctx)
+	case model.StageECSCodeDeployDeploy:
+		status = e.ensureCodeDeployDeploy(ctx)
+	case model.StageECSCodeDeployContinue:
+		status = e.ensureCodeDeployContinue(ctx)
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for ECS application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -87,18 +97,42 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if ecsInput.IsScheduledTask() {
+		task, ok := loadScheduledTask(&e.Input, &ecsInput, e.deploySource)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !applyScheduledTask(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, task) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, ecsInput.KeepTaskDefinitionRevisions, ecsInput.TaskDefinitionFiles(), e.deploySource)
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	if ecsInput.IsStandaloneTask() {
 		if !runStandaloneTask(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, &ecsInput) {
 			return model.StageStatus_STAGE_FAILURE
 		}
+		pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, ecsInput.KeepTaskDefinitionRevisions, ecsInput.TaskDefinitionFiles(), e.deploySource)
 		return model.StageStatus_STAGE_SUCCESS
 	}
 
+	if ecsInput.CodeDeploy != nil {
+		return e.syncCodeDeploy(ctx)
+	}
+
 	servicedefinition, ok := loadServiceDefinition(&e.Input, ecsInput.ServiceDefinitionFile, e.deploySource)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if sidecar := ecsInput.SidecarOnly; sidecar != nil {
+		taskDefinition, ok = keepRunningImages(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, sidecar.ContainerName, taskDefinition, servicedefinition)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	var primary *types.LoadBalancer
 	// When the service is not accessed via ELB, the target group is not used.
 	if ecsInput.IsAccessedViaELB() {
@@ -109,10 +143,22 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	}
 
 	recreate := e.appCfg.QuickSync.Recreate
-	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, recreate, taskDefinition, servicedefinition, primary) {
+	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, recreate, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, primary) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if ecsInput.AutoScaling != nil {
+		if !applyAutoScaling(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, servicedefinition, *ecsInput.AutoScaling) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	if !syncAdditionalServices(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, recreate, !ecsInput.IgnoreCircuitBreaker, ecsInput.AdditionalServices, e.deploySource) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, ecsInput.KeepTaskDefinitionRevisions, ecsInput.TaskDefinitionFiles(), e.deploySource)
+
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -126,6 +172,13 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if sidecar := e.appCfg.Input.SidecarOnly; sidecar != nil {
+		taskDefinition, ok = keepRunningImages(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, sidecar.ContainerName, taskDefinition, servicedefinition)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	switch e.appCfg.Input.AccessType {
 	case config.AccessTypeELB:
 		primary, _, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
@@ -137,12 +190,12 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 			return model.StageStatus_STAGE_FAILURE
 		}
 
-		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, primary) {
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, primary) {
 			return model.StageStatus_STAGE_FAILURE
 		}
-	case config.AccessTypeServiceDiscovery:
+	case config.AccessTypeServiceDiscovery, config.AccessTypeAppMesh:
 		// Target groups are not used.
-		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, nil) {
 			return model.StageStatus_STAGE_FAILURE
 		}
 	default:
@@ -150,6 +203,19 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if e.appCfg.Input.AutoScaling != nil {
+		if !applyAutoScaling(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, servicedefinition, *e.appCfg.Input.AutoScaling) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	// The additional services are rolled out at once together with the PRIMARY variant.
+	if !syncAdditionalServices(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, false, !e.appCfg.Input.IgnoreCircuitBreaker, e.appCfg.Input.AdditionalServices, e.deploySource) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pruneTaskDefinitions(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, e.appCfg.Input.KeepTaskDefinitionRevisions, e.appCfg.Input.TaskDefinitionFiles(), e.deploySource)
+
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -163,6 +229,13 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if sidecar := e.appCfg.Input.SidecarOnly; sidecar != nil {
+		taskDefinition, ok = keepRunningImages(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, sidecar.ContainerName, taskDefinition, servicedefinition)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	switch e.appCfg.Input.AccessType {
 	case config.AccessTypeELB:
 		_, canary, ok := loadTargetGroups(&e.Input, e.appCfg, e.deploySource)
@@ -174,12 +247,26 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 			return model.StageStatus_STAGE_FAILURE
 		}
 
-		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, canary) {
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, canary) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	case config.AccessTypeAppMesh:
+		// The CANARY task set must be registered to the service discovered by the CANARY virtual node.
+		registry, err := provider.LoadServiceRegistry(e.appCfg.Input.AppMesh.CanaryServiceRegistry)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to load the service registry of CANARY variant (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if registry != nil {
+			servicedefinition.ServiceRegistries = []types.ServiceRegistry{*registry}
+		}
+
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, nil) {
 			return model.StageStatus_STAGE_FAILURE
 		}
 	case config.AccessTypeServiceDiscovery:
 		// Target groups are not used.
-		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, taskDefinition, servicedefinition, nil) {
+		if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, !e.appCfg.Input.IgnoreCircuitBreaker, taskDefinition, servicedefinition, nil) {
 			return model.StageStatus_STAGE_FAILURE
 		}
 	default:
@@ -191,7 +278,14 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 }
 
 func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
-	// Traffic Routing is not supported for other kinds than ELB.
+	if e.appCfg.Input.IsAccessedViaAppMesh() {
+		if !routingAppMesh(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, *e.appCfg.Input.AppMesh) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	// Traffic Routing is not supported for other kinds than ELB and App Mesh.
 	if !e.appCfg.Input.IsAccessedViaELB() {
 		e.LogPersister.Errorf("Unsupported access type %s in stage %s for ECS application", e.appCfg.Input.AccessType, e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
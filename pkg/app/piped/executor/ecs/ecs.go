@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"go.uber.org/zap"
 
@@ -58,6 +59,10 @@ func Register(r registerer) {
 	r.Register(model.StageECSPrimaryRollout, f)
 	r.Register(model.StageECSCanaryClean, f)
 	r.Register(model.StageECSTrafficRouting, f)
+	r.Register(model.StageECSTaskRun, f)
+	r.Register(model.StageECSWaitHealthy, f)
+	r.Register(model.StageECSCodeDeployDeploy, f)
+	r.Register(model.StageECSCodeDeployContinue, f)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_ECS, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -138,14 +143,50 @@ func loadTargetGroups(in *executor.Input, appCfg *config.ECSApplicationSpec, ds
 	return primary, canary, true
 }
 
-func applyTaskDefinition(ctx context.Context, cli provider.Client, taskDefinition types.TaskDefinition) (*types.TaskDefinition, error) {
-	td, err := cli.RegisterTaskDefinition(ctx, taskDefinition)
+// applyTaskDefinition registers a new revision of the given task definition.
+// The latest ACTIVE revision is reused instead when it was registered from the same definition,
+// so that the revisions don't grow by the deployments which change only the other definitions.
+func applyTaskDefinition(ctx context.Context, in *executor.Input, cli provider.Client, taskDefinition types.TaskDefinition) (*types.TaskDefinition, error) {
+	td, found, err := cli.FindSameTaskDefinition(ctx, taskDefinition)
+	if err != nil {
+		in.LogPersister.Infof("Unable to find the ACTIVE revision of ECS task definition of family %s, a new revision will be registered (%v)", *taskDefinition.Family, err)
+	}
+	if found {
+		in.LogPersister.Infof("No changes were detected in ECS task definition of family %s, reusing revision %d", *taskDefinition.Family, td.Revision)
+		return td, nil
+	}
+
+	td, err = cli.RegisterTaskDefinition(ctx, taskDefinition)
 	if err != nil {
 		return nil, fmt.Errorf("unable to register ECS task definition of family %s: %w", *taskDefinition.Family, err)
 	}
 	return td, nil
 }
 
+// keepRunningImages replaces the images of the containers other than the given sidecar container
+// with the ones currently running in the service, so that the deployment updates only the sidecar container.
+// The task definition is used as is when the service is not running yet.
+func keepRunningImages(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, sidecar string, taskDefinition types.TaskDefinition, serviceDefinition types.Service) (types.TaskDefinition, bool) {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return taskDefinition, false
+	}
+
+	running, found, err := client.GetRunningTaskDefinition(ctx, serviceDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the running task definition of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+		return taskDefinition, false
+	}
+	if !found {
+		in.LogPersister.Infof("No running task definition of ECS service %s was found, all containers will be updated", *serviceDefinition.ServiceName)
+		return taskDefinition, true
+	}
+
+	in.LogPersister.Infof("Only container %s will be updated, the other containers keep the images of the running task definition %s", sidecar, aws.ToString(running.TaskDefinitionArn))
+	return provider.KeepContainerImages(taskDefinition, *running, sidecar), true
+}
+
 func applyServiceDefinition(ctx context.Context, cli provider.Client, serviceDefinition types.Service) (*types.Service, error) {
 	found, err := cli.ServiceExists(ctx, *serviceDefinition.ClusterArn, *serviceDefinition.ServiceName)
 	if err != nil {
@@ -195,7 +236,7 @@ func runStandaloneTask(
 		provider.LabelApplication: in.Deployment.ApplicationId,
 		provider.LabelCommitHash:  in.Deployment.CommitHash(),
 	})
-	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
 		return false
@@ -206,7 +247,7 @@ func runStandaloneTask(
 		return true
 	}
 
-	err = client.RunTask(
+	_, err = client.RunTask(
 		ctx,
 		*td,
 		ecsInput.ClusterArn,
@@ -221,7 +262,9 @@ func runStandaloneTask(
 	return true
 }
 
-func createPrimaryTaskSet(ctx context.Context, client provider.Client, service types.Service, taskDef types.TaskDefinition, targetGroup *types.LoadBalancer) error {
+// createPrimaryTaskSet creates a new PRIMARY task set and removes all previous task sets
+// except the ones given by keepTaskSetArns.
+func createPrimaryTaskSet(ctx context.Context, client provider.Client, service types.Service, taskDef types.TaskDefinition, targetGroup *types.LoadBalancer, keepTaskSetArns ...string) error {
 	// Get current PRIMARY/ACTIVE task sets.
 	prevTaskSets, err := client.GetServiceTaskSets(ctx, service)
 	if err != nil {
@@ -242,9 +285,14 @@ func createPrimaryTaskSet(ctx context.Context, client provider.Client, service t
 	}
 
 	// Remove old taskSets if existed.
-	// HACK: All old task sets including canary are deleted here.
-	//       However, we need to discuss whether we should delete the canary here or in later stage(CanaryClean).
+	keeps := make(map[string]struct{}, len(keepTaskSetArns))
+	for _, arn := range keepTaskSetArns {
+		keeps[arn] = struct{}{}
+	}
 	for _, prevTaskSet := range prevTaskSets {
+		if _, ok := keeps[aws.ToString(prevTaskSet.TaskSetArn)]; ok {
+			continue
+		}
 		if err = client.DeleteTaskSet(ctx, *prevTaskSet); err != nil {
 			return err
 		}
@@ -253,7 +301,7 @@ func createPrimaryTaskSet(ctx context.Context, client provider.Client, service t
 	return nil
 }
 
-func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, recreate bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
+func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, recreate, respectCircuitBreaker bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
@@ -261,12 +309,16 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 	}
 
 	in.LogPersister.Infof("Start applying the ECS task definition")
-	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
 		return false
 	}
 
+	if provider.IsDaemonService(serviceDefinition) {
+		return syncDaemonService(ctx, in, client, respectCircuitBreaker, *td, serviceDefinition)
+	}
+
 	in.LogPersister.Infof("Start applying the ECS service definition")
 	service, err := applyServiceDefinition(ctx, client, serviceDefinition)
 	if err != nil {
@@ -306,7 +358,11 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 	}
 
 	in.LogPersister.Infof("Wait service to reach stable state")
-	if err := client.WaitServiceStable(ctx, *service); err != nil {
+	if err := client.WaitServiceStable(ctx, *service, respectCircuitBreaker); err != nil {
+		if errors.Is(err, provider.ErrDeploymentFailed) {
+			in.LogPersister.Errorf("The deployment of service %s was failed and rolled back by the deployment circuit breaker: %v", *serviceDefinition.ServiceName, err)
+			return false
+		}
 		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
 		return false
 	}
@@ -315,7 +371,105 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 	return true
 }
 
-func rollout(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
+// syncDaemonService updates the service with DAEMON scheduling strategy to run the given task definition.
+// The tasks are replaced by the rolling update of the ECS deployment controller, so neither task sets
+// nor the desired count are touched.
+func syncDaemonService(ctx context.Context, in *executor.Input, client provider.Client, respectCircuitBreaker bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service) bool {
+	serviceDefinition.TaskDefinition = taskDefinition.TaskDefinitionArn
+
+	in.LogPersister.Infof("Start applying the ECS service definition of daemon service %s", *serviceDefinition.ServiceName)
+	service, err := applyServiceDefinition(ctx, client, serviceDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply service %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Wait service to reach stable state")
+	if err := client.WaitServiceStable(ctx, *service, respectCircuitBreaker); err != nil {
+		if errors.Is(err, provider.ErrDeploymentFailed) {
+			in.LogPersister.Errorf("The deployment of service %s was failed and rolled back by the deployment circuit breaker: %v", *serviceDefinition.ServiceName, err)
+			return false
+		}
+		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully applied the service definition and the task definition for ECS daemon service %s and task definition of family %s", *serviceDefinition.ServiceName, *taskDefinition.Family)
+	return true
+}
+
+// syncAdditionalServices syncs the services deployed together with the main service in the declared order.
+// The additional services are not accessed via the target groups of the application.
+func syncAdditionalServices(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, recreate, respectCircuitBreaker bool, services []config.ECSServiceInput, ds *deploysource.DeploySource) bool {
+	for _, s := range services {
+		taskDefinition, ok := loadTaskDefinition(in, s.TaskDefinitionFile, ds)
+		if !ok {
+			return false
+		}
+		serviceDefinition, ok := loadServiceDefinition(in, s.ServiceDefinitionFile, ds)
+		if !ok {
+			return false
+		}
+
+		in.LogPersister.Infof("Start syncing additional service %s", aws.ToString(serviceDefinition.ServiceName))
+		if !sync(ctx, in, platformProviderName, platformProviderCfg, recreate, respectCircuitBreaker, taskDefinition, serviceDefinition, nil) {
+			return false
+		}
+	}
+	return true
+}
+
+func applyAutoScaling(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, serviceDefinition types.Service, autoScaling config.ECSAutoScaling) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Start applying the auto scaling configuration of ECS service %s", *serviceDefinition.ServiceName)
+	if err := client.ApplyAutoScaling(ctx, serviceDefinition, autoScaling); err != nil {
+		in.LogPersister.Errorf("Failed to apply the auto scaling configuration of ECS service %s: %v", *serviceDefinition.ServiceName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully applied the auto scaling configuration with %d scaling policies", len(autoScaling.Policies))
+	return true
+}
+
+// pruneTaskDefinitions deregisters the old ACTIVE revisions of the families
+// of the given task definition files except the latest keep ones.
+// The failures are only logged since the deployment itself has already succeeded.
+func pruneTaskDefinitions(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, keep int, taskDefinitionFiles []string, ds *deploysource.DeploySource) {
+	if keep <= 0 {
+		return
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s to prune old task definition revisions: %v", platformProviderName, err)
+		return
+	}
+
+	for _, file := range taskDefinitionFiles {
+		taskDefinition, err := provider.LoadTaskDefinition(ds.AppDir, file)
+		if err != nil {
+			in.LogPersister.Errorf("Unable to load ECS task definition %s to prune old revisions: %v", file, err)
+			continue
+		}
+		family := aws.ToString(taskDefinition.Family)
+		arns, err := client.PruneTaskDefinitions(ctx, family, keep)
+		for _, arn := range arns {
+			in.LogPersister.Infof("Deregistered old ECS task definition revision %s", arn)
+		}
+		if err != nil {
+			in.LogPersister.Errorf("Failed to prune old revisions of ECS task definition of family %s: %v", family, err)
+			continue
+		}
+		in.LogPersister.Infof("Successfully pruned %d old revisions of ECS task definition of family %s, keeping the latest %d ones", len(arns), family, keep)
+	}
+}
+
+func rollout(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, respectCircuitBreaker bool, taskDefinition types.TaskDefinition, serviceDefinition types.Service, targetGroup *types.LoadBalancer) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
@@ -323,7 +477,7 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	}
 
 	in.LogPersister.Infof("Start applying the ECS task definition")
-	td, err := applyTaskDefinition(ctx, client, taskDefinition)
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
 		return false
@@ -339,8 +493,14 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	// Create a task set in the specified cluster and service.
 	in.LogPersister.Infof("Start rolling out ECS task set")
 	if in.StageConfig.Name == model.StageECSPrimaryRollout {
+		// The CANARY task set will be removed by the ECS_CANARY_CLEAN stage,
+		// so keep it while creating PRIMARY task set.
+		var keepTaskSetArns []string
+		if canary, ok := loadCanaryTaskSet(in); ok {
+			keepTaskSetArns = append(keepTaskSetArns, aws.ToString(canary.TaskSetArn))
+		}
 		// Create PRIMARY task set in case of Primary rollout.
-		if err := createPrimaryTaskSet(ctx, client, *service, *td, targetGroup); err != nil {
+		if err := createPrimaryTaskSet(ctx, client, *service, *td, targetGroup, keepTaskSetArns...); err != nil {
 			in.LogPersister.Errorf("Failed to rolling out ECS task set for service %s: %v", *serviceDefinition.ServiceName, err)
 			return false
 		}
@@ -378,7 +538,11 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	}
 
 	in.LogPersister.Infof("Wait service to reach stable state")
-	if err := client.WaitServiceStable(ctx, *service); err != nil {
+	if err := client.WaitServiceStable(ctx, *service, respectCircuitBreaker); err != nil {
+		if errors.Is(err, provider.ErrDeploymentFailed) {
+			in.LogPersister.Errorf("The deployment of service %s was failed and rolled back by the deployment circuit breaker: %v", *serviceDefinition.ServiceName, err)
+			return false
+		}
 		in.LogPersister.Errorf("Failed to wait service %s to reach stable state: %v", *serviceDefinition.ServiceName, err)
 		return false
 	}
@@ -395,16 +559,11 @@ func clean(ctx context.Context, in *executor.Input, platformProviderName string,
 	}
 
 	// Get task set object from metadata store.
-	taskSetObjData, ok := in.MetadataStore.Shared().Get(canaryTaskSetKeyName)
+	taskSet, ok := loadCanaryTaskSet(in)
 	if !ok {
 		in.LogPersister.Error("Unable to restore taskset to clean: Not found")
 		return false
 	}
-	taskSet := &types.TaskSet{}
-	if err := json.Unmarshal([]byte(taskSetObjData), taskSet); err != nil {
-		in.LogPersister.Errorf("Unable to restore taskset to clean: %v", err)
-		return false
-	}
 
 	// Delete canary task set if present.
 	in.LogPersister.Infof("Cleaning CANARY task set %s from service %s", *taskSet.TaskSetArn, *taskSet.ServiceArn)
@@ -417,6 +576,20 @@ func clean(ctx context.Context, in *executor.Input, platformProviderName string,
 	return true
 }
 
+// loadCanaryTaskSet returns the CANARY task set which was created by the ECS_CANARY_ROLLOUT stage.
+func loadCanaryTaskSet(in *executor.Input) (*types.TaskSet, bool) {
+	taskSetObjData, ok := in.MetadataStore.Shared().Get(canaryTaskSetKeyName)
+	if !ok {
+		return nil, false
+	}
+	taskSet := &types.TaskSet{}
+	if err := json.Unmarshal([]byte(taskSetObjData), taskSet); err != nil {
+		in.Logger.Error("Unable to unmarshal the stored CANARY task set", zap.Error(err))
+		return nil, false
+	}
+	return taskSet, true
+}
+
 func routing(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, primaryTargetGroup types.LoadBalancer, canaryTargetGroup types.LoadBalancer) bool {
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
@@ -475,3 +648,35 @@ func routing(ctx context.Context, in *executor.Input, platformProviderName strin
 
 	return true
 }
+
+func routingAppMesh(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, mesh config.ECSAppMesh) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	options := in.StageConfig.ECSTrafficRoutingStageOptions
+	if options == nil {
+		in.LogPersister.Errorf("Malformed configuration for stage %s", in.Stage.Name)
+		return false
+	}
+	primary, canary := options.Percentage()
+
+	metadataPercentage := map[string]string{
+		trafficRoutePrimaryMetadataKey: strconv.FormatInt(int64(primary), 10),
+		trafficRouteCanaryMetadataKey:  strconv.FormatInt(int64(canary), 10),
+	}
+	if err := in.MetadataStore.Stage(in.Stage.Id).PutMulti(ctx, metadataPercentage); err != nil {
+		in.Logger.Error("Failed to store traffic routing config to metadata store", zap.Error(err))
+	}
+
+	in.LogPersister.Infof("Updating App Mesh route %s to route %d%% traffic to PRIMARY and %d%% traffic to CANARY", mesh.RouteName, primary, canary)
+	if err := client.ModifyMeshRoute(ctx, mesh, primary, canary); err != nil {
+		in.LogPersister.Errorf("Failed to routing traffic to PRIMARY/CANARY variants: %v", err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully updated App Mesh route %s", mesh.RouteName)
+	return true
+}
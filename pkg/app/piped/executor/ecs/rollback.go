@@ -72,16 +72,47 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 	if !found {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	platformProviderCfg = provider.WithAssumeRole(platformProviderCfg, appCfg.Input)
+
+	// The service deployed by CodeDeploy is rolled back by CodeDeploy as well.
+	if id, ok := e.MetadataStore.Shared().Get(codeDeployDeploymentIDKey); ok {
+		if !rollbackCodeDeploy(ctx, &e.Input, platformProviderName, platformProviderCfg, id, &appCfg.Input, runningDS) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	if appCfg.Input.CodeDeploy != nil {
+		e.LogPersister.Infof("No CodeDeploy deployment was created, nothing to roll back")
+		return model.StageStatus_STAGE_SUCCESS
+	}
 
 	taskDefinition, ok := loadTaskDefinition(&e.Input, appCfg.Input.TaskDefinitionFile, runningDS)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
+	if appCfg.Input.IsScheduledTask() {
+		task, ok := loadScheduledTask(&e.Input, &appCfg.Input, runningDS)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !applyScheduledTask(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, task) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	serviceDefinition, ok := loadServiceDefinition(&e.Input, appCfg.Input.ServiceDefinitionFile, runningDS)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if sidecar := appCfg.Input.SidecarOnly; sidecar != nil {
+		taskDefinition, ok = keepRunningImages(ctx, &e.Input, platformProviderName, platformProviderCfg, sidecar.ContainerName, taskDefinition, serviceDefinition)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	primary, canary, ok := loadTargetGroups(&e.Input, appCfg, runningDS)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
@@ -91,6 +122,32 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if appCfg.Input.IsAccessedViaAppMesh() {
+		if !rollbackAppMesh(ctx, &e.Input, platformProviderName, platformProviderCfg, *appCfg.Input.AppMesh) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	if appCfg.Input.AutoScaling != nil {
+		if !applyAutoScaling(ctx, &e.Input, platformProviderName, platformProviderCfg, serviceDefinition, *appCfg.Input.AutoScaling) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	for _, s := range appCfg.Input.AdditionalServices {
+		taskDefinition, ok := loadTaskDefinition(&e.Input, s.TaskDefinitionFile, runningDS)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		serviceDefinition, ok := loadServiceDefinition(&e.Input, s.ServiceDefinitionFile, runningDS)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if !rollback(ctx, &e.Input, platformProviderName, platformProviderCfg, taskDefinition, serviceDefinition, nil, nil) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
 	return model.StageStatus_STAGE_SUCCESS
 }
 
@@ -105,12 +162,23 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 	// Re-register TaskDef to get TaskDefArn.
 	// Consider using DescribeServices and get services[0].taskSets[0].taskDefinition (taskDefinition of PRIMARY taskSet)
 	// then store it in metadata store and use for rollback instead.
-	td, err := client.RegisterTaskDefinition(ctx, taskDefinition)
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to register new revision of ECS task definition %s: %v", *taskDefinition.Family, err)
 		return false
 	}
 
+	// The daemon service is rolled back by the rolling update of the ECS deployment controller.
+	if provider.IsDaemonService(serviceDefinition) {
+		serviceDefinition.TaskDefinition = td.TaskDefinitionArn
+		if _, err := applyServiceDefinition(ctx, client, serviceDefinition); err != nil {
+			in.LogPersister.Errorf("Unable to rollback ECS service %s configuration to previous stage: %v", *serviceDefinition.ServiceName, err)
+			return false
+		}
+		in.LogPersister.Infof("Rolled back the ECS daemon service %s and task definition %s configuration to original stage", *serviceDefinition.ServiceName, *taskDefinition.Family)
+		return true
+	}
+
 	// Rollback ECS service configuration to previous state including commit-hash of the tag.
 	service, err := applyServiceDefinition(ctx, client, serviceDefinition)
 	if err != nil {
@@ -177,3 +245,19 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 	in.LogPersister.Infof("Rolled back the ECS service %s and task definition %s configuration to original stage", *serviceDefinition.ServiceName, *taskDefinition.Family)
 	return true
 }
+
+func rollbackAppMesh(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, mesh config.ECSAppMesh) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	// Reset routing in case of rolling back progressive pipeline.
+	in.LogPersister.Infof("Resetting App Mesh route %s to route all traffic to PRIMARY", mesh.RouteName)
+	if err := client.ModifyMeshRoute(ctx, mesh, 100, 0); err != nil {
+		in.LogPersister.Errorf("Failed to routing traffic to PRIMARY variant: %v", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,84 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func loadScheduledTask(in *executor.Input, ecsInput *config.ECSDeploymentInput, ds *deploysource.DeploySource) (provider.ScheduledTask, bool) {
+	in.LogPersister.Infof("Loading scheduled task manifest at commit %s", ds.Revision)
+
+	task, err := provider.LoadScheduledTask(ds.AppDir, ecsInput.ScheduledTaskFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load ECS scheduled task (%v)", err)
+		return provider.ScheduledTask{}, false
+	}
+
+	// Fallback to the values of the deployment input.
+	if task.ClusterArn == "" {
+		task.ClusterArn = ecsInput.ClusterArn
+	}
+	if task.LaunchType == "" {
+		task.LaunchType = ecsInput.LaunchType
+	}
+	if task.AwsVpcConfiguration == nil {
+		task.AwsVpcConfiguration = &ecsInput.AwsVpcConfiguration
+	}
+
+	in.LogPersister.Infof("Successfully loaded the ECS scheduled task at commit %s", ds.Revision)
+	return task, true
+}
+
+// applyScheduledTask registers the task definition and updates the EventBridge rule
+// to run the registered revision.
+// The rule is updated only after the task definition was registered successfully
+// so that it never refers to a revision which does not exist.
+func applyScheduledTask(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderECSConfig, taskDefinition types.TaskDefinition, task provider.ScheduledTask) bool {
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, in, client, taskDefinition)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return false
+	}
+
+	tags := provider.MakeTags(map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  in.Deployment.CommitHash(),
+	})
+	in.LogPersister.Infof("Start applying the EventBridge rule %s with schedule %s", task.Name, task.ScheduleExpression)
+	if err := client.ApplyScheduledTask(ctx, task, *td, tags); err != nil {
+		in.LogPersister.Errorf("Failed to apply the scheduled task %s: %v", task.Name, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully applied the scheduled task %s to run task definition %s", task.Name, *td.TaskDefinitionArn)
+	return true
+}
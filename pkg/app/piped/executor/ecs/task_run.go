@@ -0,0 +1,165 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureTaskRun(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.ECSTaskRunStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	taskDefinition, ok := loadTaskDefinition(&e.Input, options.TaskDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	clusterArn := options.ClusterArn
+	if clusterArn == "" {
+		clusterArn = e.appCfg.Input.ClusterArn
+	}
+	if clusterArn == "" && !e.appCfg.Input.IsStandaloneTask() {
+		serviceDefinition, ok := loadServiceDefinition(&e.Input, e.appCfg.Input.ServiceDefinitionFile, e.deploySource)
+		if !ok {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		clusterArn = aws.ToString(serviceDefinition.ClusterArn)
+	}
+	if clusterArn == "" {
+		e.LogPersister.Error("Unable to determine the cluster to run the task, please specify clusterArn")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	vpcConfiguration := options.AwsVpcConfiguration
+	if vpcConfiguration == nil {
+		vpcConfiguration = &e.appCfg.Input.AwsVpcConfiguration
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Start applying the ECS task definition")
+	td, err := applyTaskDefinition(ctx, &e.Input, client, taskDefinition)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to apply ECS task definition: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	tags := provider.MakeTags(map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       e.PipedConfig.PipedID,
+		provider.LabelApplication: e.Deployment.ApplicationId,
+		provider.LabelCommitHash:  e.Deployment.CommitHash(),
+	})
+	e.LogPersister.Infof("Start running task of task definition %s on cluster %s", aws.ToString(td.TaskDefinitionArn), clusterArn)
+	tasks, err := client.RunTask(ctx, *td, clusterArn, e.appCfg.Input.LaunchType, vpcConfiguration, tags)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to run ECS task: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	taskArns := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		taskArns = append(taskArns, aws.ToString(t.TaskArn))
+	}
+
+	timeout := options.Timeout.Duration()
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	e.LogPersister.Infof("Waiting for tasks %v to be stopped (timeout: %v)", taskArns, timeout)
+	stoppedTasks, err := client.WaitTasksStopped(waitCtx, clusterArn, taskArns)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+			running := runningTaskArns(taskArns, stoppedTasks)
+			e.LogPersister.Errorf("Timed out waiting for tasks to be stopped, stopping tasks %v that are still running", running)
+			for _, arn := range running {
+				if err := client.StopTask(ctx, clusterArn, arn, "Stopped by PipeCD since ECS_TASK_RUN stage timed out"); err != nil {
+					e.LogPersister.Errorf("Failed to stop task %s: %v", arn, err)
+					continue
+				}
+				e.LogPersister.Infof("Stopped task %s", arn)
+			}
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Errorf("Failed while waiting for tasks to be stopped: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := checkStoppedTasks(stoppedTasks, *td); err != nil {
+		e.LogPersister.Errorf("The task was not completed successfully: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Successfully completed tasks %v", taskArns)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// runningTaskArns returns the ARNs of the given tasks which were not observed as stopped.
+// The tasks which have never been described are also treated as running.
+func runningTaskArns(taskArns []string, tasks []types.Task) []string {
+	stopped := make(map[string]bool, len(tasks))
+	for _, t := range tasks {
+		stopped[aws.ToString(t.TaskArn)] = aws.ToString(t.LastStatus) == "STOPPED"
+	}
+	running := make([]string, 0, len(taskArns))
+	for _, arn := range taskArns {
+		if !stopped[arn] {
+			running = append(running, arn)
+		}
+	}
+	return running
+}
+
+// checkStoppedTasks returns an error if any essential container of the given tasks
+// was exited with a non-zero exit code or was stopped before running.
+func checkStoppedTasks(tasks []types.Task, taskDefinition types.TaskDefinition) error {
+	essentials := make(map[string]bool, len(taskDefinition.ContainerDefinitions))
+	for _, cd := range taskDefinition.ContainerDefinitions {
+		// Containers are essential by default.
+		essentials[aws.ToString(cd.Name)] = cd.Essential == nil || *cd.Essential
+	}
+
+	for _, task := range tasks {
+		for _, c := range task.Containers {
+			name := aws.ToString(c.Name)
+			if essential, ok := essentials[name]; ok && !essential {
+				continue
+			}
+			if c.ExitCode == nil {
+				return fmt.Errorf("container %s of task %s was stopped without exit code (%s: %s)", name, aws.ToString(task.TaskArn), task.StopCode, aws.ToString(task.StoppedReason))
+			}
+			if *c.ExitCode != 0 {
+				return fmt.Errorf("container %s of task %s was exited with code %d (%s)", name, aws.ToString(task.TaskArn), *c.ExitCode, aws.ToString(c.Reason))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckStoppedTasks(t *testing.T) {
+	t.Parallel()
+
+	taskDefinition := types.TaskDefinition{
+		ContainerDefinitions: []types.ContainerDefinition{
+			{Name: aws.String("migrate")},
+			{Name: aws.String("sidecar"), Essential: aws.Bool(false)},
+		},
+	}
+
+	testcases := []struct {
+		name      string
+		tasks     []types.Task
+		expectErr bool
+	}{
+		{
+			name: "all essential containers exited successfully",
+			tasks: []types.Task{
+				{
+					TaskArn: aws.String("task-1"),
+					Containers: []types.Container{
+						{Name: aws.String("migrate"), ExitCode: aws.Int32(0)},
+						{Name: aws.String("sidecar"), ExitCode: aws.Int32(137)},
+					},
+				},
+			},
+		},
+		{
+			name: "essential container exited with non-zero code",
+			tasks: []types.Task{
+				{
+					TaskArn: aws.String("task-1"),
+					Containers: []types.Container{
+						{Name: aws.String("migrate"), ExitCode: aws.Int32(1)},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "essential container stopped without exit code",
+			tasks: []types.Task{
+				{
+					TaskArn:       aws.String("task-1"),
+					StopCode:      types.TaskStopCodeTaskFailedToStart,
+					StoppedReason: aws.String("CannotPullContainerError"),
+					Containers: []types.Container{
+						{Name: aws.String("migrate")},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkStoppedTasks(tc.tasks, taskDefinition)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestRunningTaskArns(t *testing.T) {
+	t.Parallel()
+
+	tasks := []types.Task{
+		{TaskArn: aws.String("task-1"), LastStatus: aws.String("STOPPED")},
+		{TaskArn: aws.String("task-2"), LastStatus: aws.String("RUNNING")},
+	}
+	got := runningTaskArns([]string{"task-1", "task-2", "task-3"}, tasks)
+	assert.Equal(t, []string{"task-2", "task-3"}, got)
+}
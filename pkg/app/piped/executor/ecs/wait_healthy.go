@@ -0,0 +1,86 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const waitHealthyInterval = 15 * time.Second
+
+func (e *deployExecutor) ensureWaitHealthy(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.ECSWaitHealthyStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	serviceDefinition, ok := loadServiceDefinition(&e.Input, e.appCfg.Input.ServiceDefinitionFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	serviceName := aws.ToString(serviceDefinition.ServiceName)
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create ECS client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	timeout := options.Timeout.Duration()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(waitHealthyInterval)
+	defer ticker.Stop()
+
+	e.LogPersister.Infof("Waiting for all tasks of service %s to become healthy (timeout: %v)", serviceName, timeout)
+	since := time.Now()
+	for {
+		health, err := client.GetServiceHealth(ctx, serviceDefinition, since, !e.appCfg.Input.IgnoreCircuitBreaker)
+		switch {
+		case errors.Is(err, provider.ErrDeploymentFailed):
+			e.LogPersister.Errorf("The deployment of service %s was failed: %v", serviceName, err)
+			return model.StageStatus_STAGE_FAILURE
+		case err != nil:
+			// Keep waiting since the error might be temporary.
+			e.LogPersister.Infof("Failed to get the health of service %s: %v", serviceName, err)
+		default:
+			for _, ev := range health.Events {
+				e.LogPersister.Infof("[%s] %s", ev.CreatedAt.Format(time.RFC3339), aws.ToString(ev.Message))
+				since = *ev.CreatedAt
+			}
+			if health.Healthy {
+				e.LogPersister.Successf("All tasks of service %s are healthy", serviceName)
+				return model.StageStatus_STAGE_SUCCESS
+			}
+			e.LogPersister.Infof("Service %s is not healthy yet: %s", serviceName, health.Reason)
+		}
+
+		select {
+		case <-ctx.Done():
+			e.LogPersister.Errorf("Timed out after %v waiting for service %s to become healthy", timeout, serviceName)
+			return model.StageStatus_STAGE_FAILURE
+		case <-ticker.C:
+		}
+	}
+}
@@ -67,6 +67,10 @@ type GitClient interface {
 	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
 }
 
+type SecretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
 type Input struct {
 	Stage       *model.PipelineStage
 	StageConfig config.PipelineStage
@@ -87,6 +91,12 @@ type Input struct {
 	AnalysisResultStore   AnalysisResultStore
 	Logger                *zap.Logger
 	Notifier              Notifier
+	// Decrypter for the secrets encrypted by piped's secret management.
+	// This is nil when the secret management is not configured.
+	SecretDecrypter SecretDecrypter
+	// The directory shared by all stages of the deployment
+	// to pass files between them. It is removed once the deployment is completed.
+	WorkingDir string
 }
 
 func DetermineStageStatus(sig StopSignalType, ori, got model.StageStatus) model.StageStatus {
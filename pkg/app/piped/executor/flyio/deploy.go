@@ -0,0 +1,209 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/flyio"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource *deploysource.DeploySource
+	appCfg       *config.FlyIOApplicationSpec
+	client       provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.FlyIOApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Error("Malformed application configuration: missing FlyIOApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Fly.io client for the provider %s (%v)", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageFlyIOSync:
+		status = e.ensureSync(ctx)
+
+	case model.StageFlyIOGreenRollout:
+		status = e.ensureGreenRollout(ctx)
+
+	case model.StageFlyIOPromote:
+		status = e.ensurePromote(ctx)
+
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for flyio application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// ensureSync creates the machines of the new version, waits until they become healthy,
+// routes the traffic to them and destroys the machines of the old version.
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.FlyIOSyncStageOptions
+	if options == nil {
+		options = &e.appCfg.QuickSync
+	}
+
+	cfg, ok := loadFlyConfig(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	blue, ok := listBlueMachines(ctx, &e.Input, e.client, cfg.App, nil)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	green, ok := e.rolloutGreen(ctx, cfg, blue, options.WaitTimeout.Duration())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.switchTraffic(ctx, cfg.App, green, blue) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// ensureGreenRollout creates the machines of the new version without routing any traffic to them
+// and waits until they become healthy.
+func (e *deployExecutor) ensureGreenRollout(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.FlyIOGreenRolloutStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cfg, ok := loadFlyConfig(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	blue, ok := listBlueMachines(ctx, &e.Input, e.client, cfg.App, greenMachines(&e.Input))
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := e.rolloutGreen(ctx, cfg, blue, options.WaitTimeout.Duration()); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// ensurePromote checks that the machines created by the FLYIO_GREEN_ROLLOUT stage are still healthy,
+// routes the traffic to them and destroys the machines of the old version.
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.FlyIOPromoteStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	green := greenMachines(&e.Input)
+	if len(green) == 0 {
+		e.LogPersister.Errorf("No machine to be promoted was found. The %s stage must be run before this stage", model.StageFlyIOGreenRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cfg, ok := loadFlyConfig(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Checking the health of %d machines before routing traffic to them", len(green))
+	if !waitHealthy(ctx, &e.Input, e.client, cfg.App, green, options.WaitTimeout.Duration()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	blue, ok := listBlueMachines(ctx, &e.Input, e.client, cfg.App, green)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.switchTraffic(ctx, cfg.App, green, blue) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rolloutGreen creates the machines of the new version next to the given blue machines
+// and waits until they become healthy.
+func (e *deployExecutor) rolloutGreen(ctx context.Context, cfg provider.FlyConfig, blue []*provider.Machine, timeout time.Duration) ([]string, bool) {
+	regions := decideGreenRegions(blue, cfg.PrimaryRegion, e.appCfg.Input.Machines)
+	labels := provider.MakeMachineLabels(e.PipedConfig.PipedID, e.Deployment.ApplicationId, e.Deployment.CommitHash())
+
+	e.LogPersister.Infof("Creating machines of app %s with image %s", cfg.App, cfg.Machine.Image)
+	green, ok := createGreenMachines(ctx, &e.Input, e.client, cfg.App, cfg.MachineConfigWithLabels(labels), regions, true)
+	if !ok {
+		return nil, false
+	}
+
+	e.LogPersister.Infof("Waiting for %d machines to become healthy", len(green))
+	if !waitHealthy(ctx, &e.Input, e.client, cfg.App, green, timeout) {
+		return nil, false
+	}
+	return green, true
+}
+
+// switchTraffic routes the traffic to the green machines and then destroys the blue machines.
+func (e *deployExecutor) switchTraffic(ctx context.Context, app string, green []string, blue []*provider.Machine) bool {
+	if !uncordonMachines(ctx, &e.Input, e.client, app, green) {
+		return false
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, promotedMetadataKey, "true"); err != nil {
+		e.LogPersister.Errorf("Failed to save the promoted state to metadata (%v)", err)
+		return false
+	}
+
+	if !destroyMachines(ctx, &e.Input, e.client, app, machineIDs(blue)) {
+		return false
+	}
+	e.LogPersister.Successf("Successfully routed all traffic to %d machines of commit %s", len(green), e.Deployment.CommitHash())
+	return true
+}
@@ -0,0 +1,260 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/flyio"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The comma-separated IDs of the machines created by this deployment.
+	greenMachinesMetadataKey = "flyio-green-machines"
+	// Whether the traffic has been routed to the machines created by this deployment.
+	promotedMetadataKey = "flyio-promoted"
+)
+
+// The interval to check whether the machines have become healthy.
+var healthCheckInterval = 5 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageFlyIOSync, f)
+	r.Register(model.StageFlyIOGreenRollout, f)
+	r.Register(model.StageFlyIOPromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_FLYIO, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderFlyIOConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_FLYIO)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.FlyIOConfig
+	found = true
+	return
+}
+
+func loadFlyConfig(in *executor.Input, appCfg *config.FlyIOApplicationSpec, ds *deploysource.DeploySource) (provider.FlyConfig, bool) {
+	in.LogPersister.Infof("Loading %s at commit %s", appCfg.Input.ConfigFile, ds.Revision)
+
+	cfg, err := provider.LoadFlyConfig(ds.AppDir, appCfg.Input.ConfigFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load %s (%v)", appCfg.Input.ConfigFile, err)
+		return provider.FlyConfig{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the configuration of app %s at commit %s", cfg.App, ds.Revision)
+	return cfg, true
+}
+
+// listBlueMachines returns the machines of the app serving the current version,
+// that is all machines of the default process group except the ones created by this deployment.
+func listBlueMachines(ctx context.Context, in *executor.Input, client provider.Client, app string, green []string) ([]*provider.Machine, bool) {
+	machines, err := client.ListMachines(ctx, app)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to list machines of app %s (%v)", app, err)
+		return nil, false
+	}
+
+	excluded := make(map[string]struct{}, len(green))
+	for _, id := range green {
+		excluded[id] = struct{}{}
+	}
+
+	blue := make([]*provider.Machine, 0, len(machines))
+	for _, m := range machines {
+		if _, ok := excluded[m.ID]; ok {
+			continue
+		}
+		if !m.IsAppMachine() || m.IsFailed() {
+			continue
+		}
+		blue = append(blue, m)
+	}
+	return blue, true
+}
+
+// decideGreenRegions returns the number of machines to be created in each region.
+// The same number of machines as the blue ones are created in each region,
+// or the given number of machines in the primary region when there is no blue machine.
+func decideGreenRegions(blue []*provider.Machine, primaryRegion string, machines int) map[string]int {
+	if len(blue) == 0 {
+		return map[string]int{primaryRegion: machines}
+	}
+	return provider.CountMachinesByRegion(blue)
+}
+
+// createGreenMachines creates the machines with the given configuration without registering them to the services.
+// The IDs of the created machines are saved to the metadata one by one when recorded is true,
+// so that they can be destroyed while rolling back even if the stage failed halfway.
+func createGreenMachines(ctx context.Context, in *executor.Input, client provider.Client, app string, cfg provider.MachineConfig, regions map[string]int, recorded bool) ([]string, bool) {
+	names := make([]string, 0, len(regions))
+	for r := range regions {
+		names = append(names, r)
+	}
+	sort.Strings(names)
+
+	var ids []string
+	for _, region := range names {
+		for i := 0; i < regions[region]; i++ {
+			m, err := client.CreateMachine(ctx, app, region, cfg, true)
+			if err != nil {
+				in.LogPersister.Errorf("Failed to create a machine in region %s (%v)", region, err)
+				return ids, false
+			}
+			in.LogPersister.Infof("Created machine %s in region %s", m.ID, m.Region)
+			ids = append(ids, m.ID)
+
+			if !recorded {
+				continue
+			}
+			if err := in.MetadataStore.Shared().Put(ctx, greenMachinesMetadataKey, strings.Join(ids, ",")); err != nil {
+				in.LogPersister.Errorf("Failed to save the created machines to metadata (%v)", err)
+				return ids, false
+			}
+		}
+	}
+	return ids, true
+}
+
+// waitHealthy waits until all the given machines have started and their health checks are passing.
+func waitHealthy(ctx context.Context, in *executor.Input, client provider.Client, app string, ids []string, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(healthCheckInterval)
+	defer ticker.Stop()
+
+	pending := append([]string(nil), ids...)
+	for {
+		var unhealthy []string
+		for _, id := range pending {
+			m, err := client.GetMachine(ctx, app, id)
+			switch {
+			case errors.Is(err, provider.ErrNotFound):
+				in.LogPersister.Errorf("Machine %s was not found", id)
+				return false
+			case err != nil:
+				in.LogPersister.Errorf("Failed to get machine %s (%v)", id, err)
+				return false
+			case m.IsHealthy():
+				in.LogPersister.Infof("Machine %s is healthy", id)
+			case m.IsFailed():
+				in.LogPersister.Errorf("Machine %s failed to become healthy: %s", id, m.StatusDescription())
+				return false
+			default:
+				in.LogPersister.Infof("Machine %s is still not healthy: %s", id, m.StatusDescription())
+				unhealthy = append(unhealthy, id)
+			}
+		}
+		if len(unhealthy) == 0 {
+			in.LogPersister.Successf("All %d machines are healthy", len(ids))
+			return true
+		}
+		pending = unhealthy
+
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Timed out waiting for machines %s to become healthy", strings.Join(pending, ", "))
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// uncordonMachines registers the given machines to the services so that they start receiving traffic.
+func uncordonMachines(ctx context.Context, in *executor.Input, client provider.Client, app string, ids []string) bool {
+	for _, id := range ids {
+		if err := client.UncordonMachine(ctx, app, id); err != nil {
+			in.LogPersister.Errorf("Failed to route traffic to machine %s (%v)", id, err)
+			return false
+		}
+		in.LogPersister.Infof("Machine %s started receiving traffic", id)
+	}
+	return true
+}
+
+// destroyMachines destroys the given machines.
+// The machines already destroyed are ignored.
+func destroyMachines(ctx context.Context, in *executor.Input, client provider.Client, app string, ids []string) bool {
+	for _, id := range ids {
+		err := client.DestroyMachine(ctx, app, id)
+		switch {
+		case errors.Is(err, provider.ErrNotFound):
+			in.LogPersister.Infof("Machine %s has already been destroyed", id)
+		case err != nil:
+			in.LogPersister.Errorf("Failed to destroy machine %s (%v)", id, err)
+			return false
+		default:
+			in.LogPersister.Infof("Destroyed machine %s", id)
+		}
+	}
+	return true
+}
+
+func machineIDs(machines []*provider.Machine) []string {
+	ids := make([]string, 0, len(machines))
+	for _, m := range machines {
+		ids = append(ids, m.ID)
+	}
+	return ids
+}
+
+// greenMachines returns the IDs of the machines created by this deployment.
+func greenMachines(in *executor.Input) []string {
+	value, ok := in.MetadataStore.Shared().Get(greenMachinesMetadataKey)
+	if !ok || value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// promoted reports whether the traffic has been routed to the machines created by this deployment.
+func promoted(in *executor.Input) bool {
+	value, ok := in.MetadataStore.Shared().Get(promotedMetadataKey)
+	return ok && value == "true"
+}
@@ -0,0 +1,153 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/flyio"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeClient struct {
+	provider.Client
+	// The states returned by GetMachine one by one for each machine.
+	machines  map[string][]*provider.Machine
+	destroyed []string
+}
+
+func (c *fakeClient) GetMachine(_ context.Context, _, id string) (*provider.Machine, error) {
+	states, ok := c.machines[id]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+	m := states[0]
+	if len(states) > 1 {
+		c.machines[id] = states[1:]
+	}
+	return m, nil
+}
+
+func (c *fakeClient) DestroyMachine(_ context.Context, _, id string) error {
+	if id == "gone" {
+		return provider.ErrNotFound
+	}
+	if id == "locked" {
+		return errors.New("machine is locked")
+	}
+	c.destroyed = append(c.destroyed, id)
+	return nil
+}
+
+func TestWaitHealthy(t *testing.T) {
+	healthCheckInterval = time.Millisecond
+
+	var (
+		starting = &provider.Machine{State: "starting"}
+		critical = &provider.Machine{State: "started", Checks: []*provider.CheckStatus{{Name: "status", Status: "critical"}}}
+		healthy  = &provider.Machine{State: "started", Checks: []*provider.CheckStatus{{Name: "status", Status: "passing"}}}
+		failed   = &provider.Machine{State: "failed"}
+	)
+	testcases := []struct {
+		name     string
+		machines map[string][]*provider.Machine
+		ids      []string
+		expected bool
+	}{
+		{
+			name: "healthy after a while",
+			machines: map[string][]*provider.Machine{
+				"m1": {starting, critical, healthy},
+				"m2": {healthy},
+			},
+			ids:      []string{"m1", "m2"},
+			expected: true,
+		},
+		{
+			name: "failed",
+			machines: map[string][]*provider.Machine{
+				"m1": {starting, failed},
+			},
+			ids:      []string{"m1"},
+			expected: false,
+		},
+		{
+			name:     "not found",
+			machines: map[string][]*provider.Machine{},
+			ids:      []string{"m1"},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := &executor.Input{LogPersister: &fakeLogPersister{}}
+			client := &fakeClient{machines: tc.machines}
+			got := waitHealthy(context.Background(), in, client, "my-app", tc.ids, time.Second)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestWaitHealthyTimeout(t *testing.T) {
+	healthCheckInterval = time.Millisecond
+
+	in := &executor.Input{LogPersister: &fakeLogPersister{}}
+	client := &fakeClient{machines: map[string][]*provider.Machine{
+		"m1": {{State: "starting"}},
+	}}
+	got := waitHealthy(context.Background(), in, client, "my-app", []string{"m1"}, 20*time.Millisecond)
+	assert.False(t, got)
+}
+
+func TestDecideGreenRegions(t *testing.T) {
+	t.Parallel()
+
+	blue := []*provider.Machine{
+		{ID: "m1", Region: "nrt"},
+		{ID: "m2", Region: "nrt"},
+		{ID: "m3", Region: "sin"},
+	}
+	assert.Equal(t, map[string]int{"nrt": 2, "sin": 1}, decideGreenRegions(blue, "iad", 1))
+	assert.Equal(t, map[string]int{"iad": 3}, decideGreenRegions(nil, "iad", 3))
+}
+
+func TestDestroyMachines(t *testing.T) {
+	t.Parallel()
+
+	in := &executor.Input{LogPersister: &fakeLogPersister{}}
+
+	client := &fakeClient{}
+	assert.True(t, destroyMachines(context.Background(), in, client, "my-app", []string{"m1", "gone", "m2"}))
+	assert.Equal(t, []string{"m1", "m2"}, client.destroyed)
+
+	client = &fakeClient{}
+	assert.False(t, destroyMachines(context.Background(), in, client, "my-app", []string{"m1", "locked", "m2"}))
+	assert.Equal(t, []string{"m1"}, client.destroyed)
+}
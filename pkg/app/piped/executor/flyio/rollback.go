@@ -0,0 +1,147 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/flyio"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for flyio application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// ensureRollback destroys the machines created by this deployment when they have not received any traffic yet.
+// Otherwise, the machines of the last deployed commit are created again and the traffic is routed back to them.
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	green := greenMachines(&e.Input)
+	if len(green) == 0 {
+		e.LogPersister.Infof("No machine was created by this deployment, there is nothing to rollback")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Fly.io client for the provider %s (%v)", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The machines of the old version are still serving the traffic.
+	if !promoted(&e.Input) {
+		return e.destroyGreen(ctx, client, green)
+	}
+
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.FlyIOApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing FlyIOApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cfg, ok := loadFlyConfig(&e.Input, appCfg, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	current, ok := listBlueMachines(ctx, &e.Input, client, cfg.App, nil)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	regions := decideGreenRegions(current, cfg.PrimaryRegion, appCfg.Input.Machines)
+	labels := provider.MakeMachineLabels(e.PipedConfig.PipedID, e.Deployment.ApplicationId, e.Deployment.RunningCommitHash)
+
+	e.LogPersister.Infof("Creating machines of app %s with image %s of commit %s", cfg.App, cfg.Machine.Image, e.Deployment.RunningCommitHash)
+	restored, ok := createGreenMachines(ctx, &e.Input, client, cfg.App, cfg.MachineConfigWithLabels(labels), regions, false)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !waitHealthy(ctx, &e.Input, client, cfg.App, restored, appCfg.QuickSync.WaitTimeout.Duration()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !uncordonMachines(ctx, &e.Input, client, cfg.App, restored) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !destroyMachines(ctx, &e.Input, client, cfg.App, machineIDs(current)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully routed all traffic back to %d machines of commit %s", len(restored), e.Deployment.RunningCommitHash)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// destroyGreen destroys the machines created by this deployment.
+func (e *rollbackExecutor) destroyGreen(ctx context.Context, client provider.Client, green []string) model.StageStatus {
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := ds.ApplicationConfig.FlyIOApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing FlyIOApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	cfg, ok := loadFlyConfig(&e.Input, appCfg, ds)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Destroying %d machines created by this deployment", len(green))
+	if !destroyMachines(ctx, &e.Input, client, cfg.App, green) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Success("Successfully destroyed the machines created by this deployment")
+	return model.StageStatus_STAGE_SUCCESS
+}
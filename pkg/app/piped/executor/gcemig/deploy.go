@@ -0,0 +1,203 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/gcemig"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The template launching the instances before the deployment.
+	primaryTemplateMetadataKey = "gcemig-primary-template"
+	// The template created for the target commit by the canary rollout stage.
+	canaryTemplateMetadataKey = "gcemig-canary-template"
+
+	canaryPercentMetadataKey = "gcemig-canary-percent"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.GCEMIGApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderGCEMIGConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.GCEMIGApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing GCEMIGApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(ctx, e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Compute Engine client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageGCEMIGSync:
+		status = e.ensureSync(ctx)
+	case model.StageGCEMIGCanaryRollout:
+		status = e.ensureCanaryRollout(ctx)
+	case model.StageGCEMIGPromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for gcemig application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	m, ok := loadGroupManifest(&e.Input, e.appCfg.Input.ManagedInstanceGroupManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	template, ok := ensureTemplate(ctx, &e.Input, e.client, m, e.Deployment.CommitHash())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.savePrimaryTemplate(ctx, m) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !rollout(ctx, &e.Input, e.client, m, provider.FullRollout(template)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.GCEMIGCanaryRolloutStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadGroupManifest(&e.Input, e.appCfg.Input.ManagedInstanceGroupManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.savePrimaryTemplate(ctx, m) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	canary, ok := ensureTemplate(ctx, &e.Input, e.client, m, e.Deployment.CommitHash())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, canaryTemplateMetadataKey, canary); err != nil {
+		e.LogPersister.Errorf("Failed to save the canary instance template to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	percent := options.Percent.Int()
+	metadata := map[string]string{
+		canaryPercentMetadataKey: strconv.Itoa(percent),
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		e.Logger.Error("failed to save canary percentage to metadata", zap.Error(err))
+	}
+
+	primary, _ := e.MetadataStore.Shared().Get(primaryTemplateMetadataKey)
+	if !rollout(ctx, &e.Input, e.client, m, provider.CanaryRollout(primary, canary, percent)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	canary, ok := e.MetadataStore.Shared().Get(canaryTemplateMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the canary instance template, it must be created by %s stage before", model.StageGCEMIGCanaryRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadGroupManifest(&e.Input, e.appCfg.Input.ManagedInstanceGroupManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !rollout(ctx, &e.Input, e.client, m, provider.FullRollout(canary)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully promoted instance template %s", provider.TemplateNameFromURL(canary))
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// savePrimaryTemplate saves the template launching the instances before the deployment to the shared metadata
+// so that the following stages and the rollback can use it.
+// It is saved only once since the group is launching the instances of the new template after the first rollout.
+func (e *deployExecutor) savePrimaryTemplate(ctx context.Context, m provider.ManagedInstanceGroupManifest) bool {
+	if _, ok := e.MetadataStore.Shared().Get(primaryTemplateMetadataKey); ok {
+		return true
+	}
+
+	var primary string
+	igm, err := e.client.GetInstanceGroupManager(ctx, m.Location(), m.Spec.Name)
+	switch {
+	case err == nil:
+		primary = igm.PrimaryTemplate()
+		e.LogPersister.Infof("Managed instance group %s is currently using instance template %s", m.Spec.Name, provider.TemplateNameFromURL(primary))
+	case errors.Is(err, provider.ErrNotFound):
+		// The group will be created by this deployment.
+	default:
+		e.LogPersister.Errorf("Failed to find managed instance group %s in %s: %v", m.Spec.Name, m.Location(), err)
+		return false
+	}
+
+	if err := e.MetadataStore.Shared().Put(ctx, primaryTemplateMetadataKey, primary); err != nil {
+		e.LogPersister.Errorf("Failed to save the primary instance template to metadata: %v", err)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/api/compute/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/gcemig"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var statusCheckInterval = 10 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageGCEMIGSync, f)
+	r.Register(model.StageGCEMIGCanaryRollout, f)
+	r.Register(model.StageGCEMIGPromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_GCEMIG, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderGCEMIGConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_GCEMIG)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.GCEMIGConfig
+	found = true
+	return
+}
+
+func loadGroupManifest(in *executor.Input, manifestFile string, ds *deploysource.DeploySource) (provider.ManagedInstanceGroupManifest, bool) {
+	in.LogPersister.Infof("Loading managed instance group manifest at commit %s", ds.Revision)
+
+	m, err := provider.LoadManagedInstanceGroupManifest(ds.AppDir, manifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load managed instance group manifest (%v)", err)
+		return provider.ManagedInstanceGroupManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the managed instance group manifest at commit %s", ds.Revision)
+	return m, true
+}
+
+func makeLabels(in *executor.Input, commitHash string) map[string]string {
+	return map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+}
+
+// ensureTemplate returns the URL of the instance template for the given commit of the group.
+// Since the instance templates are immutable, the template is created only when it does not exist yet.
+func ensureTemplate(ctx context.Context, in *executor.Input, client provider.Client, m provider.ManagedInstanceGroupManifest, commitHash string) (string, bool) {
+	name := provider.TemplateName(m.Spec.Name, commitHash)
+
+	t, err := client.GetInstanceTemplate(ctx, name)
+	switch {
+	case err == nil:
+		in.LogPersister.Infof("Instance template %s for commit %s already exists", name, commitHash)
+		return t.SelfLink, true
+
+	case !errors.Is(err, provider.ErrNotFound):
+		in.LogPersister.Errorf("Failed to find instance template %s: %v", name, err)
+		return "", false
+	}
+
+	t = m.InstanceTemplate(name, makeLabels(in, commitHash))
+	if t.Description == "" {
+		t.Description = fmt.Sprintf("Created by piped for commit %s of managed instance group %s", commitHash, m.Spec.Name)
+	}
+	if err := client.CreateInstanceTemplate(ctx, t); err != nil {
+		in.LogPersister.Errorf("Failed to create instance template %s: %v", name, err)
+		return "", false
+	}
+
+	// Get it again to use its URL for the versions of the group.
+	if t, err = client.GetInstanceTemplate(ctx, name); err != nil {
+		in.LogPersister.Errorf("Failed to get the created instance template %s: %v", name, err)
+		return "", false
+	}
+	in.LogPersister.Infof("Created instance template %s for commit %s", name, commitHash)
+	return t.SelfLink, true
+}
+
+// rollout updates the group of the given manifest to launch its instances by the given versions,
+// and waits until all of its instances have been replaced and become healthy.
+// The group is created with all instances launched from the template of the first version when it does not exist yet.
+func rollout(ctx context.Context, in *executor.Input, client provider.Client, m provider.ManagedInstanceGroupManifest, versions []*compute.InstanceGroupManagerVersion) bool {
+	var (
+		name = m.Spec.Name
+		loc  = m.Location()
+	)
+
+	_, err := client.GetInstanceGroupManager(ctx, loc, name)
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		if err := client.CreateInstanceGroupManager(ctx, loc, m.InstanceGroupManager(versions[0].InstanceTemplate)); err != nil {
+			in.LogPersister.Errorf("Failed to create managed instance group %s in %s: %v", name, loc, err)
+			return false
+		}
+		in.LogPersister.Infof("Created managed instance group %s in %s, waiting for its instances to become ready", name, loc)
+
+	case err != nil:
+		in.LogPersister.Errorf("Failed to find managed instance group %s in %s: %v", name, loc, err)
+		return false
+
+	default:
+		if err := client.PatchInstanceGroupManager(ctx, loc, name, m.RolloutPatch(versions)); err != nil {
+			in.LogPersister.Errorf("Failed to update managed instance group %s in %s: %v", name, loc, err)
+			return false
+		}
+		for _, v := range versions {
+			if v.TargetSize != nil {
+				in.LogPersister.Infof("Updating %d%% of the instances to instance template %s", v.TargetSize.Percent, provider.TemplateNameFromURL(v.InstanceTemplate))
+				continue
+			}
+			in.LogPersister.Infof("Updating the instances to instance template %s", provider.TemplateNameFromURL(v.InstanceTemplate))
+		}
+	}
+
+	if err := provider.WaitRollout(ctx, client, loc, name, statusCheckInterval); err != nil {
+		in.LogPersister.Errorf("Failed to wait for the instances of managed instance group %s to be updated: %v", name, err)
+		return false
+	}
+	in.LogPersister.Successf("All instances of managed instance group %s have been updated and are healthy", name)
+	return true
+}
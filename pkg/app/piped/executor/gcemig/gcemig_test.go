@@ -0,0 +1,150 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/gcemig"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeClient struct {
+	provider.Client
+	templates map[string]*provider.InstanceTemplate
+	created   []string
+	getErr    error
+}
+
+func (c *fakeClient) GetInstanceTemplate(_ context.Context, name string) (*provider.InstanceTemplate, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	t, ok := c.templates[name]
+	if !ok {
+		return nil, provider.ErrNotFound
+	}
+	return t, nil
+}
+
+func (c *fakeClient) CreateInstanceTemplate(_ context.Context, t *provider.InstanceTemplate) error {
+	t.SelfLink = "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/" + t.Name
+	c.templates[t.Name] = t
+	c.created = append(c.created, t.Name)
+	return nil
+}
+
+func TestEnsureTemplate(t *testing.T) {
+	t.Parallel()
+
+	m := provider.ManagedInstanceGroupManifest{
+		Spec: provider.ManagedInstanceGroupManifestSpec{
+			Name: "web",
+			Zone: "asia-northeast1-a",
+			InstanceTemplate: provider.InstanceTemplateSpec{
+				Properties: &compute.InstanceProperties{
+					MachineType: "e2-small",
+					Disks:       []*compute.AttachedDisk{{Boot: true}},
+				},
+			},
+		},
+	}
+	in := &executor.Input{
+		LogPersister: &fakeLogPersister{},
+		PipedConfig:  &config.PipedSpec{PipedID: "piped-1"},
+		Deployment:   &model.Deployment{ApplicationId: "app-1"},
+	}
+	const existingURL = "https://www.googleapis.com/compute/v1/projects/my-project/global/instanceTemplates/web-0123abc"
+
+	testcases := []struct {
+		name            string
+		client          *fakeClient
+		commitHash      string
+		expected        string
+		expectedCreated []string
+		expectedOK      bool
+	}{
+		{
+			name: "template already exists",
+			client: &fakeClient{
+				templates: map[string]*provider.InstanceTemplate{
+					"web-0123abc": {Name: "web-0123abc", SelfLink: existingURL},
+				},
+			},
+			commitHash: "0123abcdef",
+			expected:   existingURL,
+			expectedOK: true,
+		},
+		{
+			name:            "create a new template",
+			client:          &fakeClient{templates: map[string]*provider.InstanceTemplate{}},
+			commitHash:      "0123abcdef",
+			expected:        existingURL,
+			expectedCreated: []string{"web-0123abc"},
+			expectedOK:      true,
+		},
+		{
+			name: "failed to get template",
+			client: &fakeClient{
+				templates: map[string]*provider.InstanceTemplate{},
+				getErr:    errors.New("permission denied"),
+			},
+			commitHash: "0123abcdef",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := ensureTemplate(context.Background(), in, tc.client, m, tc.commitHash)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, tc.expectedCreated, tc.client.created)
+		})
+	}
+
+	// The labels and the description are set to the created template.
+	client := &fakeClient{templates: map[string]*provider.InstanceTemplate{}}
+	_, ok := ensureTemplate(context.Background(), in, client, m, "0123abcdef")
+	require.True(t, ok)
+	created := client.templates["web-0123abc"]
+	assert.Equal(t, map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       "piped-1",
+		provider.LabelApplication: "app-1",
+		provider.LabelCommitHash:  "0123abcdef",
+	}, created.Properties.Labels)
+	assert.Equal(t, "Created by piped for commit 0123abcdef of managed instance group web", created.Description)
+	// The manifest is not modified.
+	assert.Nil(t, m.Spec.InstanceTemplate.Properties.Labels)
+}
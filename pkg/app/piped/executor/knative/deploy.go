@@ -0,0 +1,198 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/knative"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource *deploysource.DeploySource
+	appCfg       *config.KnativeApplicationSpec
+	client       provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.KnativeApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Error("Malformed application configuration: missing KnativeApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Knative client for the provider %s (%v)", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageKnativeSync:
+		status = e.ensureSync(ctx)
+
+	case model.StageKnativePromote:
+		status = e.ensurePromote(ctx)
+
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for knative application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// ensureSync creates the new revision and routes all traffic to it.
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.KnativeSyncStageOptions
+	if options == nil {
+		options = &e.appCfg.QuickSync
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	revision := provider.DecideRevisionName(sm, e.Deployment.Trigger.Commit.Hash)
+	traffic := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      100,
+		},
+	}
+	if !configureServiceManifest(&e.Input, sm, revision, traffic, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := apply(ctx, &e.Input, e.client, sm, revision, options.WaitTimeout.Duration()); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// ensurePromote creates the new revision and splits the traffic between it and the running revision.
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.KnativePromoteStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	percent := options.Percent.Int()
+
+	runningRevision, ok := e.loadRunningRevision(ctx)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, e.appCfg, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	revision := provider.DecideRevisionName(sm, e.Deployment.Trigger.Commit.Hash)
+	traffic := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      percent,
+			Tag:          options.Tag,
+		},
+	}
+	// The running revision is kept in the traffic targets while it is serving any traffic.
+	if percent < 100 && runningRevision != revision {
+		traffic = append(traffic, provider.RevisionTraffic{
+			RevisionName: runningRevision,
+			Percent:      100 - percent,
+		})
+	}
+	if !configureServiceManifest(&e.Input, sm, revision, traffic, e.Deployment.CommitHash()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Routing %d percent of traffic to revision %s", percent, revision)
+	status, ok := apply(ctx, &e.Input, e.client, sm, revision, options.WaitTimeout.Duration())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	metadata := map[string]string{
+		promotePercentageMetadataKey: strconv.Itoa(percent),
+	}
+	if options.Tag != "" {
+		if url, ok := status.TagURL(options.Tag); ok {
+			e.LogPersister.Infof("Revision %s can be accessed at %s", revision, url)
+			metadata[tagURLMetadataKey] = url
+		}
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+	}
+
+	e.LogPersister.Successf("Successfully routed %d percent of traffic to revision %s", percent, revision)
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// loadRunningRevision returns the name of the revision deployed by the last successful deployment.
+func (e *deployExecutor) loadRunningRevision(ctx context.Context) (string, bool) {
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit")
+		return "", false
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return "", false
+	}
+
+	runningAppCfg := runningDS.ApplicationConfig.KnativeApplicationSpec
+	if runningAppCfg == nil {
+		e.LogPersister.Error("Malformed application configuration in running commit: missing KnativeApplicationSpec")
+		return "", false
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, runningAppCfg, runningDS)
+	if !ok {
+		return "", false
+	}
+	return provider.DecideRevisionName(sm, e.Deployment.RunningCommitHash), true
+}
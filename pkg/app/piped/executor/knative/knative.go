@@ -0,0 +1,193 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/knative"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// Whether the service has been changed by this deployment.
+	appliedMetadataKey = "knative-applied"
+	// The percentage of traffic routed to the new revision by the KNATIVE_PROMOTE stage.
+	promotePercentageMetadataKey = "promote-percentage"
+	// The URL of the tag assigned to the new revision by the KNATIVE_PROMOTE stage.
+	tagURLMetadataKey = "tag-url"
+)
+
+// The interval to check whether the revision and the service have become ready.
+var readyCheckInterval = 5 * time.Second
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageKnativeSync, f)
+	r.Register(model.StageKnativePromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_KNATIVE, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderKnativeConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_KNATIVE)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.KnativeConfig
+	found = true
+	return
+}
+
+// loadServiceManifest loads the service manifest of the given deploy source
+// and places it in the namespace configured in the application configuration.
+func loadServiceManifest(in *executor.Input, appCfg *config.KnativeApplicationSpec, ds *deploysource.DeploySource) (provider.ServiceManifest, bool) {
+	in.LogPersister.Infof("Loading service manifest at commit %s", ds.Revision)
+
+	sm, err := provider.LoadServiceManifest(ds.AppDir, appCfg.Input.ServiceManifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load service manifest (%v)", err)
+		return provider.ServiceManifest{}, false
+	}
+	if appCfg.Input.Namespace != "" {
+		sm.SetNamespace(appCfg.Input.Namespace)
+	}
+
+	in.LogPersister.Infof("Successfully loaded the service manifest at commit %s", ds.Revision)
+	return sm, true
+}
+
+// configureServiceManifest makes the template of the service create the given revision
+// and routes the traffic to the revisions as specified.
+func configureServiceManifest(in *executor.Input, sm provider.ServiceManifest, revision string, traffic []provider.RevisionTraffic, commitHash string) bool {
+	if err := sm.SetRevision(revision); err != nil {
+		in.LogPersister.Errorf("Unable to set revision name to the service manifest (%v)", err)
+		return false
+	}
+	if err := sm.SetTraffic(traffic); err != nil {
+		in.LogPersister.Errorf("Unable to configure traffic of the service manifest (%v)", err)
+		return false
+	}
+
+	labels := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+	sm.AddLabels(labels)
+
+	labels[provider.LabelRevisionName] = revision
+	if err := sm.AddRevisionLabels(labels); err != nil {
+		in.LogPersister.Errorf("Unable to add revision labels to the service manifest (%v)", err)
+		return false
+	}
+	return true
+}
+
+// apply applies the given service manifest and waits until the revision and the service become ready.
+func apply(ctx context.Context, in *executor.Input, client provider.Client, sm provider.ServiceManifest, revision string, timeout time.Duration) (*provider.ServiceStatus, bool) {
+	in.LogPersister.Infof("Applying service %s to namespace %s", sm.Name, sm.Namespace())
+	if err := client.ApplyService(ctx, sm); err != nil {
+		in.LogPersister.Errorf("Failed to apply service %s (%v)", sm.Name, err)
+		return nil, false
+	}
+	if err := in.MetadataStore.Shared().Put(ctx, appliedMetadataKey, "true"); err != nil {
+		in.LogPersister.Errorf("Failed to save the applied state to metadata (%v)", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if !waitReady(ctx, in, "revision "+revision, func() (*provider.Status, error) {
+		return client.GetRevisionStatus(ctx, sm.Namespace(), revision)
+	}) {
+		return nil, false
+	}
+
+	var status *provider.ServiceStatus
+	if !waitReady(ctx, in, "service "+sm.Name, func() (*provider.Status, error) {
+		s, err := client.GetServiceStatus(ctx, sm.Namespace(), sm.Name)
+		if err != nil {
+			return nil, err
+		}
+		status = s
+		return &s.Status, nil
+	}) {
+		return nil, false
+	}
+
+	in.LogPersister.Successf("Successfully applied service %s with revision %s", sm.Name, revision)
+	return status, true
+}
+
+// waitReady waits until the status returned by the given function becomes ready.
+// The resource not found yet is treated as not ready since it might not be created by the controller yet.
+func waitReady(ctx context.Context, in *executor.Input, name string, getStatus func() (*provider.Status, error)) bool {
+	ticker := time.NewTicker(readyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		status, err := getStatus()
+		switch {
+		case errors.Is(err, provider.ErrNotFound):
+			in.LogPersister.Infof("The %s was not found yet, will retry after %v", name, readyCheckInterval)
+		case err != nil:
+			in.LogPersister.Errorf("Failed to get the status of the %s (%v)", name, err)
+			return false
+		case status.IsReady():
+			in.LogPersister.Infof("The %s is ready", name)
+			return true
+		case status.IsFailed():
+			in.LogPersister.Errorf("The %s failed to become ready: %s", name, status.Message)
+			return false
+		default:
+			in.LogPersister.Infof("The %s is still not ready (%s), will retry after %v", name, status.Message, readyCheckInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Timed out waiting for the %s to become ready", name)
+			return false
+		case <-ticker.C:
+		}
+	}
+}
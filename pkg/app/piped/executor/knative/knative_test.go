@@ -0,0 +1,99 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/knative"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+func TestWaitReady(t *testing.T) {
+	readyCheckInterval = time.Millisecond
+
+	var (
+		notReady = &provider.Status{Observed: true, Ready: "Unknown", Message: "deploying"}
+		ready    = &provider.Status{Observed: true, Ready: "True"}
+		failed   = &provider.Status{Observed: true, Ready: "False", Message: "image not found"}
+		stale    = &provider.Status{Observed: false, Ready: "True"}
+	)
+	testcases := []struct {
+		name     string
+		statuses []*provider.Status
+		errs     []error
+		expected bool
+	}{
+		{
+			name:     "ready after a while",
+			statuses: []*provider.Status{nil, stale, notReady, ready},
+			errs:     []error{provider.ErrNotFound, nil, nil, nil},
+			expected: true,
+		},
+		{
+			name:     "failed",
+			statuses: []*provider.Status{notReady, failed},
+			errs:     []error{nil, nil},
+			expected: false,
+		},
+		{
+			name:     "unexpected error",
+			statuses: []*provider.Status{nil},
+			errs:     []error{errors.New("forbidden")},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			in := &executor.Input{LogPersister: &fakeLogPersister{}}
+			i := 0
+			got := waitReady(context.Background(), in, "revision helloworld-0123456", func() (*provider.Status, error) {
+				s, err := tc.statuses[i], tc.errs[i]
+				i++
+				return s, err
+			})
+			assert.Equal(t, tc.expected, got)
+			assert.Equal(t, len(tc.statuses), i)
+		})
+	}
+}
+
+func TestWaitReadyTimeout(t *testing.T) {
+	readyCheckInterval = time.Millisecond
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	in := &executor.Input{LogPersister: &fakeLogPersister{}}
+	got := waitReady(ctx, in, "service helloworld", func() (*provider.Status, error) {
+		return &provider.Status{Observed: true, Ready: "Unknown"}, nil
+	})
+	assert.False(t, got)
+}
@@ -0,0 +1,105 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/knative"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for knative application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+// ensureRollback applies the service manifest of the last deployed commit again
+// so that all traffic is routed back to the revision deployed from it.
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	if value, ok := e.MetadataStore.Shared().Get(appliedMetadataKey); !ok || value != "true" {
+		e.LogPersister.Infof("The service was not changed by this deployment, there is nothing to rollback")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.KnativeApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing KnativeApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Knative client for the provider %s (%v)", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, ok := loadServiceManifest(&e.Input, appCfg, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The revision deployed from the last deployed commit is reused since the template is the same.
+	revision := provider.DecideRevisionName(sm, e.Deployment.RunningCommitHash)
+	traffic := []provider.RevisionTraffic{
+		{
+			RevisionName: revision,
+			Percent:      100,
+		},
+	}
+	if !configureServiceManifest(&e.Input, sm, revision, traffic, e.Deployment.RunningCommitHash) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := apply(ctx, &e.Input, client, sm, revision, appCfg.QuickSync.WaitTimeout.Duration()); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
@@ -19,6 +19,9 @@ import (
 	"fmt"
 	"strings"
 
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -55,6 +58,20 @@ func (e *deployExecutor) ensureBaselineRollout(ctx context.Context) model.StageS
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	// Pin the images of BASELINE workloads to the ones running in the PRIMARY workloads
+	// since the running manifests may refer to mutable image tags.
+	if liveResources, ok := e.AppLiveResourceLister.ListKubernetesResources(); ok {
+		var pinned []string
+		manifests, pinned, err = pinRunningImages(manifests, liveResources, variantLabel, e.appCfg.VariantLabel.PrimaryValue, runningCommit)
+		if err != nil {
+			e.LogPersister.Errorf("Unable to pin the images of running workloads (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		for _, p := range pinned {
+			e.LogPersister.Infof("- pinned image: %s", p)
+		}
+	}
+
 	baselineManifests, err := e.generateBaselineManifests(manifests, *options, variantLabel, baselineVariant)
 	if err != nil {
 		e.LogPersister.Errorf("Unable to generate manifests for BASELINE variant (%v)", err)
@@ -85,6 +102,9 @@ func (e *deployExecutor) ensureBaselineRollout(ctx context.Context) model.StageS
 
 	// Start rolling out the resources for BASELINE variant.
 	e.LogPersister.Info("Start rolling out BASELINE variant...")
+	// Record the warning events of the variant's pods to help debugging the rollout.
+	stopRecordingEvents := startRecordingEvents(ctx, e.applierGetter, baselineManifests, e.LogPersister)
+	defer stopRecordingEvents()
 	if err := applyManifests(ctx, e.applierGetter, baselineManifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -193,3 +213,74 @@ func removeBaselineResources(ctx context.Context, ag applierGetter, resources []
 
 	return nil
 }
+
+// pinRunningImages returns the manifests whose Deployment container images are replaced
+// by the images of the live PRIMARY Deployments deployed at the given running commit.
+// The returned strings describe each replaced image.
+func pinRunningImages(manifests, liveResources []provider.Manifest, variantLabel, primaryVariant, runningCommit string) ([]provider.Manifest, []string, error) {
+	liveImages := make(map[string]map[string]string)
+	for _, m := range liveResources {
+		if m.Key.Kind != provider.KindDeployment {
+			continue
+		}
+		annotations := m.GetAnnotations()
+		if annotations[variantLabel] != primaryVariant || annotations[provider.LabelCommitHash] != runningCommit {
+			continue
+		}
+		d := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(d); err != nil {
+			return nil, nil, err
+		}
+		images := make(map[string]string)
+		for _, c := range d.Spec.Template.Spec.InitContainers {
+			images[c.Name] = c.Image
+		}
+		for _, c := range d.Spec.Template.Spec.Containers {
+			images[c.Name] = c.Image
+		}
+		liveImages[m.Key.Name] = images
+	}
+	if len(liveImages) == 0 {
+		return manifests, nil, nil
+	}
+
+	var (
+		out    = make([]provider.Manifest, 0, len(manifests))
+		pinned []string
+	)
+	for _, m := range manifests {
+		images, ok := liveImages[m.Key.Name]
+		if !ok || m.Key.Kind != provider.KindDeployment {
+			out = append(out, m)
+			continue
+		}
+		d := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(d); err != nil {
+			return nil, nil, err
+		}
+		var changed bool
+		pin := func(containers []corev1.Container) {
+			for i := range containers {
+				image, ok := images[containers[i].Name]
+				if !ok || image == containers[i].Image {
+					continue
+				}
+				pinned = append(pinned, fmt.Sprintf("%s/%s: %s -> %s", m.Key.Name, containers[i].Name, containers[i].Image, image))
+				containers[i].Image = image
+				changed = true
+			}
+		}
+		pin(d.Spec.Template.Spec.InitContainers)
+		pin(d.Spec.Template.Spec.Containers)
+		if !changed {
+			out = append(out, m)
+			continue
+		}
+		manifest, err := provider.ParseFromStructuredObject(d)
+		if err != nil {
+			return nil, nil, err
+		}
+		out = append(out, manifest)
+	}
+	return out, pinned, nil
+}
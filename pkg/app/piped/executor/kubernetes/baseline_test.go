@@ -0,0 +1,137 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+func TestPinRunningImages(t *testing.T) {
+	t.Parallel()
+
+	const running = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: gcr.io/pipecd/helloworld:latest
+      - name: proxy
+        image: envoyproxy/envoy:v1.24.0
+`
+
+	testcases := []struct {
+		name           string
+		live           string
+		expectedImages []string
+		expectedPinned []string
+	}{
+		{
+			name: "pin to the images of live primary workload",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/variant: primary
+    pipecd.dev/commit-hash: running-commit
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: gcr.io/pipecd/helloworld@sha256:0123456789abcdef
+      - name: proxy
+        image: envoyproxy/envoy:v1.24.0
+`,
+			expectedImages: []string{"gcr.io/pipecd/helloworld@sha256:0123456789abcdef", "envoyproxy/envoy:v1.24.0"},
+			expectedPinned: []string{"simple/app: gcr.io/pipecd/helloworld:latest -> gcr.io/pipecd/helloworld@sha256:0123456789abcdef"},
+		},
+		{
+			name: "live workload was deployed at another commit",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/variant: primary
+    pipecd.dev/commit-hash: another-commit
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: gcr.io/pipecd/helloworld:v0.2.0
+`,
+			expectedImages: []string{"gcr.io/pipecd/helloworld:latest", "envoyproxy/envoy:v1.24.0"},
+		},
+		{
+			name: "live workload is not primary",
+			live: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/variant: canary
+    pipecd.dev/commit-hash: running-commit
+spec:
+  template:
+    spec:
+      containers:
+      - name: app
+        image: gcr.io/pipecd/helloworld:v0.2.0
+`,
+			expectedImages: []string{"gcr.io/pipecd/helloworld:latest", "envoyproxy/envoy:v1.24.0"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(running)
+			require.NoError(t, err)
+			live, err := provider.ParseManifests(tc.live)
+			require.NoError(t, err)
+
+			got, pinned, err := pinRunningImages(manifests, live, "pipecd.dev/variant", "primary", "running-commit")
+			require.NoError(t, err)
+			require.Len(t, got, 1)
+			assert.Equal(t, tc.expectedPinned, pinned)
+
+			d := &appsv1.Deployment{}
+			require.NoError(t, got[0].ConvertToStructuredObject(d))
+			images := make([]string, 0, len(d.Spec.Template.Spec.Containers))
+			for _, c := range d.Spec.Template.Spec.Containers {
+				images = append(images, c.Image)
+			}
+			assert.Equal(t, tc.expectedImages, images)
+		})
+	}
+}
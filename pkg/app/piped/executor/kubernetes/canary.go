@@ -71,6 +71,13 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 		}
 	}
 
+	// The CANARY variant of the workloads scaled by HPA should be sized
+	// based on the number of the currently running replicas.
+	manifests, err = useLiveReplicasForHPATargets(ctx, e.applierGetter, manifests, e.appCfg.Workloads, e.LogPersister)
+	if err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	// Find and generate workload & service manifests for CANARY variant.
 	canaryManifests, err := e.generateCanaryManifests(manifests, *options, variantLabel, canaryVariant)
 	if err != nil {
@@ -102,6 +109,9 @@ func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageSta
 
 	// Start rolling out the resources for CANARY variant.
 	e.LogPersister.Info("Start rolling out CANARY variant...")
+	// Record the warning events of the variant's pods to help debugging the rollout.
+	stopRecordingEvents := startRecordingEvents(ctx, e.applierGetter, canaryManifests, e.LogPersister)
+	defer stopRecordingEvents()
 	if err := applyManifests(ctx, e.applierGetter, canaryManifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
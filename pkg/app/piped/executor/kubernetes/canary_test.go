@@ -178,6 +178,7 @@ func TestEnsureCanaryRollout(t *testing.T) {
 				applierGetter: &applierGroup{
 					defaultApplier: func() provider.Applier {
 						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().GetWarningEvents(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 						p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(fmt.Errorf("error"))
 						return p
 					}(),
@@ -233,6 +234,7 @@ func TestEnsureCanaryRollout(t *testing.T) {
 				applierGetter: &applierGroup{
 					defaultApplier: func() provider.Applier {
 						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().GetWarningEvents(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 						p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(nil)
 						return p
 					}(),
@@ -0,0 +1,205 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+const recordEventsInterval = 5 * time.Second
+
+// eventRecorder writes the warning events of the workloads and their pods into the stage log,
+// e.g. FailedScheduling, BackOff while pulling images or Unhealthy probes.
+type eventRecorder struct {
+	ag        applierGetter
+	workloads []provider.Manifest
+	since     time.Time
+	lp        executor.LogPersister
+	// The map with the key is "event's uid" and the value is
+	// the count of that event which has already been written.
+	recorded map[types.UID]int32
+}
+
+// startRecordingEvents starts writing the warning events of the workloads in the given manifests
+// into the stage log until the returned function is called.
+// The events happened before calling this function are ignored.
+func startRecordingEvents(ctx context.Context, ag applierGetter, manifests []provider.Manifest, lp executor.LogPersister) (stop func()) {
+	r := &eventRecorder{
+		ag:        ag,
+		workloads: findEventSourceWorkloads(manifests),
+		since:     time.Now().Truncate(time.Second),
+		lp:        lp,
+		recorded:  make(map[types.UID]int32),
+	}
+	if len(r.workloads) == 0 {
+		return func() {}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(recordEventsInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.record(ctx)
+			}
+		}
+	}()
+
+	return func() {
+		cancel()
+		wg.Wait()
+		// Record once more to not miss the events happened just before stopping.
+		r.record(context.Background())
+	}
+}
+
+func (r *eventRecorder) record(ctx context.Context) {
+	// The events are listed once for each namespace.
+	listed := make(map[string]struct{})
+	for _, w := range r.workloads {
+		if _, ok := listed[w.Key.Namespace]; ok {
+			continue
+		}
+		listed[w.Key.Namespace] = struct{}{}
+
+		applier, err := r.ag.Get(w.Key)
+		if err != nil {
+			continue
+		}
+		events, err := applier.GetWarningEvents(ctx, w.Key)
+		if err != nil {
+			// Recording events is just for debugging so the stage should not be affected.
+			continue
+		}
+		for _, e := range events {
+			r.recordEvent(e)
+		}
+	}
+}
+
+func (r *eventRecorder) recordEvent(e corev1.Event) {
+	if eventTime(e).Before(r.since) {
+		return
+	}
+	// Skip the event which has already been written unless it happened again.
+	if count, ok := r.recorded[e.UID]; ok && e.Count <= count {
+		return
+	}
+	if !isEventOfWorkloads(e, r.workloads) {
+		return
+	}
+	r.recorded[e.UID] = e.Count
+
+	if e.Count > 1 {
+		r.lp.Infof("[Warning] %s %s: %s: %s (x%d)", e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, e.Message, e.Count)
+		return
+	}
+	r.lp.Infof("[Warning] %s %s: %s: %s", e.InvolvedObject.Kind, e.InvolvedObject.Name, e.Reason, e.Message)
+}
+
+// eventTime returns the last time when the given event happened.
+func eventTime(e corev1.Event) time.Time {
+	if !e.LastTimestamp.IsZero() {
+		return e.LastTimestamp.Time
+	}
+	// The lastTimestamp field may be empty for the events created by the events.k8s.io API.
+	if !e.EventTime.IsZero() {
+		return e.EventTime.Time
+	}
+	return e.FirstTimestamp.Time
+}
+
+// findEventSourceWorkloads returns the workloads whose events should be recorded.
+func findEventSourceWorkloads(manifests []provider.Manifest) []provider.Manifest {
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		switch m.Key.Kind {
+		case provider.KindDeployment, provider.KindStatefulSet, provider.KindDaemonSet:
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// isEventOfWorkloads reports whether the given event is about one of the given workloads,
+// or the ReplicaSets and Pods created by them.
+// Since those resources are named by adding generated suffixes to the workload name,
+// the number of the suffixes is checked to not mix up the workloads of other variants,
+// e.g. the pods of "simple-canary" Deployment must not be treated as the ones of "simple".
+func isEventOfWorkloads(e corev1.Event, workloads []provider.Manifest) bool {
+	obj := e.InvolvedObject
+	for _, w := range workloads {
+		if obj.Kind == w.Key.Kind && obj.Name == w.Key.Name {
+			return true
+		}
+		switch {
+		case w.Key.Kind == provider.KindDeployment && obj.Kind == "ReplicaSet":
+			// e.g. simple-5d8f9c7b6
+			if hasGeneratedSuffixes(obj.Name, w.Key.Name, 1) {
+				return true
+			}
+		case w.Key.Kind == provider.KindDeployment && obj.Kind == "Pod":
+			// e.g. simple-5d8f9c7b6-x2k4p
+			if hasGeneratedSuffixes(obj.Name, w.Key.Name, 2) {
+				return true
+			}
+		case obj.Kind == "Pod":
+			// e.g. simple-0 of StatefulSet or simple-x2k4p of DaemonSet
+			if hasGeneratedSuffixes(obj.Name, w.Key.Name, 1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// hasGeneratedSuffixes reports whether the given name is made by adding
+// the given number of alphanumeric suffixes to the prefix, separated by hyphens.
+func hasGeneratedSuffixes(name, prefix string, num int) bool {
+	if !strings.HasPrefix(name, prefix+"-") {
+		return false
+	}
+	suffixes := strings.Split(strings.TrimPrefix(name, prefix+"-"), "-")
+	if len(suffixes) != num {
+		return false
+	}
+	for _, s := range suffixes {
+		if s == "" {
+			return false
+		}
+		for _, c := range s {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
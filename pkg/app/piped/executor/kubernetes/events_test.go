@@ -0,0 +1,163 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+func TestIsEventOfWorkloads(t *testing.T) {
+	t.Parallel()
+
+	workloads, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		kind     string
+		objName  string
+		expected bool
+	}{
+		{
+			name:     "deployment itself",
+			kind:     "Deployment",
+			objName:  "simple",
+			expected: true,
+		},
+		{
+			name:     "replicaset of deployment",
+			kind:     "ReplicaSet",
+			objName:  "simple-5d8f9c7b6",
+			expected: true,
+		},
+		{
+			name:     "pod of deployment",
+			kind:     "Pod",
+			objName:  "simple-5d8f9c7b6-x2k4p",
+			expected: true,
+		},
+		{
+			name:     "pod of another variant",
+			kind:     "Pod",
+			objName:  "simple-canary-5d8f9c7b6-x2k4p",
+			expected: false,
+		},
+		{
+			name:     "pod of statefulset",
+			kind:     "Pod",
+			objName:  "db-0",
+			expected: true,
+		},
+		{
+			name:     "unrelated pod",
+			kind:     "Pod",
+			objName:  "other-5d8f9c7b6-x2k4p",
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := corev1.Event{
+				InvolvedObject: corev1.ObjectReference{
+					Kind: tc.kind,
+					Name: tc.objName,
+				},
+			}
+			assert.Equal(t, tc.expected, isEventOfWorkloads(e, workloads))
+		})
+	}
+}
+
+type recordingLogPersister struct {
+	fakeLogPersister
+	logs []string
+}
+
+func (l *recordingLogPersister) Infof(format string, a ...interface{}) {
+	l.logs = append(l.logs, fmt.Sprintf(format, a...))
+}
+
+func TestEventRecorderRecordEvent(t *testing.T) {
+	t.Parallel()
+
+	workloads, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+`)
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	lp := &recordingLogPersister{}
+	r := &eventRecorder{
+		workloads: workloads,
+		since:     now,
+		lp:        lp,
+		recorded:  make(map[types.UID]int32),
+	}
+	makeEvent := func(uid, reason string, count int32, at time.Time) corev1.Event {
+		return corev1.Event{
+			ObjectMeta: metav1.ObjectMeta{
+				UID: types.UID(uid),
+			},
+			InvolvedObject: corev1.ObjectReference{
+				Kind: "Pod",
+				Name: "simple-5d8f9c7b6-x2k4p",
+			},
+			Reason:        reason,
+			Message:       "message",
+			Count:         count,
+			LastTimestamp: metav1.NewTime(at),
+		}
+	}
+
+	// The event happened before starting the stage.
+	r.recordEvent(makeEvent("1", "FailedScheduling", 1, now.Add(-time.Minute)))
+	// The new event.
+	r.recordEvent(makeEvent("2", "Unhealthy", 1, now))
+	// The same event without any change.
+	r.recordEvent(makeEvent("2", "Unhealthy", 1, now))
+	// The same event happened again.
+	r.recordEvent(makeEvent("2", "Unhealthy", 3, now.Add(time.Second)))
+
+	assert.Equal(t, []string{
+		"[Warning] Pod simple-5d8f9c7b6-x2k4p: Unhealthy: message",
+		"[Warning] Pod simple-5d8f9c7b6-x2k4p: Unhealthy: message (x3)",
+	}, lp.logs)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"go.uber.org/zap"
 	appsv1 "k8s.io/api/apps/v1"
@@ -33,10 +34,16 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/yamlprocessor"
 )
 
+const (
+	syncWaveTimeout       = 5 * time.Minute
+	syncWaveCheckInterval = 5 * time.Second
+)
+
 type deployExecutor struct {
 	executor.Input
 
 	commit string
+	appDir string
 	appCfg *config.KubernetesApplicationSpec
 
 	loader        provider.Loader
@@ -63,6 +70,10 @@ func Register(r registerer) {
 	r.Register(model.StageK8sBaselineRollout, f)
 	r.Register(model.StageK8sBaselineClean, f)
 	r.Register(model.StageK8sTrafficRouting, f)
+	r.Register(model.StageK8sWaitJob, f)
+	r.Register(model.StageK8sPrimaryClean, f)
+	r.Register(model.StageK8sValidate, f)
+	r.Register(model.StageK8sRolloutRestart, f)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_KUBERNETES, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -81,6 +92,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	e.appDir = ds.AppDir
 	e.appCfg = ds.ApplicationConfig.KubernetesApplicationSpec
 	if e.appCfg == nil {
 		e.LogPersister.Error("Malformed application configuration: missing KubernetesApplicationSpec")
@@ -91,6 +103,7 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		chartRepoName := e.appCfg.Input.HelmChart.Repository
 		if chartRepoName != "" {
 			e.appCfg.Input.HelmChart.Insecure = e.PipedConfig.IsInsecureChartRepository(chartRepoName)
+			e.appCfg.Input.HelmChart.OCIAddress = e.PipedConfig.OCIChartRepositoryAddress(chartRepoName)
 		}
 	}
 
@@ -146,6 +159,18 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	case model.StageK8sTrafficRouting:
 		status = e.ensureTrafficRouting(ctx)
 
+	case model.StageK8sWaitJob:
+		status = e.ensureWaitJob(ctx)
+
+	case model.StageK8sPrimaryClean:
+		status = e.ensurePrimaryClean(ctx)
+
+	case model.StageK8sValidate:
+		status = e.ensureValidate(ctx)
+
+	case model.StageK8sRolloutRestart:
+		status = e.ensureRolloutRestart(ctx)
+
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for kubernetes application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -233,38 +258,173 @@ func applyManifests(ctx context.Context, ag applierGetter, manifests []provider.
 		lp.Infof("Start applying %d manifests to %q namespace", len(manifests), namespace)
 	}
 
-	for _, m := range manifests {
-		applier, err := ag.Get(m.Key)
-		if err != nil {
-			lp.Error(err.Error())
+	waves, err := provider.GroupManifestsBySyncWave(manifests)
+	if err != nil {
+		lp.Error(err.Error())
+		return err
+	}
+
+	for i, wave := range waves {
+		if len(waves) > 1 {
+			lp.Infof("Start applying %d manifests of sync wave %d", len(wave.Manifests), wave.Wave)
+		}
+		for _, m := range wave.Manifests {
+			if err := applyManifest(ctx, ag, m, lp); err != nil {
+				return err
+			}
+		}
+		// Resources in the next waves may depend on the ones in this wave,
+		// so wait until they are established before moving on.
+		if i < len(waves)-1 {
+			if err := waitForEstablished(ctx, ag, wave.Manifests, lp); err != nil {
+				return err
+			}
+		}
+	}
+	lp.Successf("Successfully applied %d manifests", len(manifests))
+	return nil
+}
+
+func applyManifest(ctx context.Context, ag applierGetter, m provider.Manifest, lp executor.LogPersister) error {
+	applier, err := ag.Get(m.Key)
+	if err != nil {
+		lp.Error(err.Error())
+		return err
+	}
+
+	annotation := m.GetAnnotations()[provider.LabelSyncReplace]
+	if annotation != provider.UseReplaceEnabled {
+		if err := applier.ApplyManifest(ctx, m); err != nil {
+			lp.Errorf("Failed to apply manifest: %s (%w)", m.Key.ReadableString(), err)
 			return err
 		}
+		lp.Successf("- applied manifest: %s", m.Key.ReadableString())
+		return nil
+	}
+	// Always try to replace first and create if it fails due to resource not found error.
+	// This is because we cannot know whether resource already exists before executing command.
+	err = applier.ReplaceManifest(ctx, m)
+	if errors.Is(err, provider.ErrNotFound) {
+		lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
+		err = applier.CreateManifest(ctx, m)
+	}
+	if err != nil {
+		lp.Errorf("Failed to replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
+		return err
+	}
+	lp.Successf("- replaced or created manifest: %s", m.Key.ReadableString())
+	return nil
+}
 
-		annotation := m.GetAnnotations()[provider.LabelSyncReplace]
-		if annotation != provider.UseReplaceEnabled {
-			if err := applier.ApplyManifest(ctx, m); err != nil {
-				lp.Errorf("Failed to apply manifest: %s (%w)", m.Key.ReadableString(), err)
+// waitForEstablished waits until all the given resources become established
+// or the syncWaveTimeout is reached.
+func waitForEstablished(ctx context.Context, ag applierGetter, manifests []provider.Manifest, lp executor.LogPersister) error {
+	pending := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if provider.IsEstablished(m) {
+			continue
+		}
+		pending = append(pending, m)
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, syncWaveTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(syncWaveCheckInterval)
+	defer ticker.Stop()
+
+	lp.Infof("Waiting for %d resources to be established", len(pending))
+	for {
+		remaining := pending[:0]
+		for _, m := range pending {
+			applier, err := ag.Get(m.Key)
+			if err != nil {
+				lp.Error(err.Error())
 				return err
 			}
-			lp.Successf("- applied manifest: %s", m.Key.ReadableString())
+			live, err := applier.GetManifest(ctx, m.Key)
+			if err != nil || !provider.IsEstablished(live) {
+				remaining = append(remaining, m)
+				continue
+			}
+			lp.Successf("- established resource: %s", m.Key.ReadableString())
+		}
+		pending = remaining
+		if len(pending) == 0 {
+			return nil
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			for _, m := range pending {
+				lp.Errorf("Resource %s was not established in %v", m.Key.ReadableString(), syncWaveTimeout)
+			}
+			return fmt.Errorf("timed out waiting for %d resources to be established", len(pending))
+		}
+	}
+}
+
+// useLiveReplicasForHPATargets returns the given manifests while replacing the workloads
+// scaled by one of the HorizontalPodAutoscalers in the manifests with the duplicated ones
+// whose replicas are set to the number of the currently running replicas.
+// This prevents the replicas adjusted by HPA from being reset to the value defined in Git.
+func useLiveReplicasForHPATargets(ctx context.Context, ag applierGetter, manifests []provider.Manifest, workloadRefs []config.K8sResourceReference, lp executor.LogPersister) ([]provider.Manifest, error) {
+	hpas := findManifests(provider.KindHorizontalPodAutoscaler, "", manifests)
+	if len(hpas) == 0 {
+		return manifests, nil
+	}
+
+	workloads := findWorkloadManifests(manifests, workloadRefs)
+	workloadKeys := make(map[provider.ResourceKey]struct{}, len(workloads))
+	for _, w := range workloads {
+		workloadKeys[w.Key] = struct{}{}
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if _, ok := workloadKeys[m.Key]; !ok || !provider.IsScaledByHPA(m, hpas) {
+			out = append(out, m)
 			continue
 		}
-		// Always try to replace first and create if it fails due to resource not found error.
-		// This is because we cannot know whether resource already exists before executing command.
-		err = applier.ReplaceManifest(ctx, m)
+
+		applier, err := ag.Get(m.Key)
+		if err != nil {
+			lp.Error(err.Error())
+			return nil, err
+		}
+		live, err := applier.GetManifest(ctx, m.Key)
 		if errors.Is(err, provider.ErrNotFound) {
-			lp.Infof("Specified resource does not exist, so create the resource: %s (%w)", m.Key.ReadableString(), err)
-			err = applier.CreateManifest(ctx, m)
+			lp.Infof("Workload %s is scaled by HorizontalPodAutoscaler but is not running yet, so the replicas defined in Git will be used", m.Key.ReadableString())
+			out = append(out, m)
+			continue
 		}
 		if err != nil {
-			lp.Errorf("Failed to replace or create manifest: %s (%w)", m.Key.ReadableString(), err)
-			return err
+			lp.Errorf("Failed to get the live manifest of workload %s (%v)", m.Key.ReadableString(), err)
+			return nil, err
 		}
-		lp.Successf("- replaced or created manifest: %s", m.Key.ReadableString())
 
+		// The workload scaled down to zero (e.g. by K8S_PRIMARY_CLEAN stage) is no longer
+		// managed by HPA, so the replicas defined in Git will be used to scale it up again.
+		replicas, ok := live.GetReplicas()
+		if !ok || replicas == 0 {
+			out = append(out, m)
+			continue
+		}
+		// Because the loaded manifests are read-only
+		// we duplicate them to avoid updating the shared manifests data in cache.
+		m = duplicateManifest(m, "")
+		if err := m.SetReplicas(replicas); err != nil {
+			lp.Errorf("Failed to set replicas to workload %s (%v)", m.Key.ReadableString(), err)
+			return nil, err
+		}
+		lp.Infof("Workload %s is scaled by HorizontalPodAutoscaler, so the current %d replicas will be kept", m.Key.ReadableString(), replicas)
+		out = append(out, m)
 	}
-	lp.Successf("Successfully applied %d manifests", len(manifests))
-	return nil
+	return out, nil
 }
 
 func deleteResources(ctx context.Context, ag applierGetter, resources []provider.ResourceKey, lp executor.LogPersister) error {
@@ -711,6 +871,10 @@ func patchManifest(m provider.Manifest, patch config.K8sResourcePatch) (*provide
 				if err := p.ReplaceString(o.Path, o.Value); err != nil {
 					return nil, fmt.Errorf("failed to replace value at path: %s, error: %w", o.Path, err)
 				}
+			case config.K8sResourcePatchOpYAMLAdd:
+				if err := p.AddYAML(o.Path, o.Value); err != nil {
+					return nil, fmt.Errorf("failed to add value at path: %s, error: %w", o.Path, err)
+				}
 			default:
 				// TODO: Support more patch operation for K8sCanaryRolloutStageOptions.
 				return nil, fmt.Errorf("%s operation is not supported currently", o.Op)
@@ -463,6 +463,63 @@ spec:
 			namespace: "",
 			wantErr:   false,
 		},
+		{
+			name: "successfully apply manifests in sync waves",
+			applier: func() provider.Applier {
+				established, err := provider.ParseManifests(`
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+status:
+  conditions:
+    - type: Established
+      status: "True"
+`)
+				require.NoError(t, err)
+
+				p := kubernetestest.NewMockApplier(ctrl)
+				gomock.InOrder(
+					p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, m provider.Manifest) error {
+						assert.Equal(t, provider.KindCustomResourceDefinition, m.Key.Kind)
+						return nil
+					}),
+					p.EXPECT().GetManifest(gomock.Any(), gomock.Any()).Return(established[0], nil),
+					p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, m provider.Manifest) error {
+						assert.Equal(t, "Foo", m.Key.Kind)
+						return nil
+					}),
+				)
+				return p
+			}(),
+			manifest: `
+apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: simple
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+`,
+			namespace: "",
+			wantErr:   false,
+		},
+		{
+			name:    "invalid sync wave annotation",
+			applier: kubernetestest.NewMockApplier(ctrl),
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/sync-wave: "first"
+`,
+			namespace: "",
+			wantErr:   true,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -546,6 +603,148 @@ func TestDeleteResources(t *testing.T) {
 	}
 }
 
+func TestUseLiveReplicasForHPATargets(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: scaled
+spec:
+  replicas: 2
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: fixed
+spec:
+  replicas: 2
+---
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: scaled
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: scaled
+  minReplicas: 2
+  maxReplicas: 10
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 3)
+
+	live, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: scaled
+spec:
+  replicas: 7
+`)
+	require.NoError(t, err)
+
+	scaledDown, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: scaled
+spec:
+  replicas: 0
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name         string
+		manifests    []provider.Manifest
+		applier      provider.Applier
+		wantReplicas map[string]int32
+		wantErr      bool
+	}{
+		{
+			name:      "no hpa",
+			manifests: manifests[:2],
+			wantReplicas: map[string]int32{
+				"scaled": 2,
+				"fixed":  2,
+			},
+		},
+		{
+			name:      "use live replicas of the hpa target",
+			manifests: manifests,
+			applier: func() provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				p.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(live[0], nil)
+				return p
+			}(),
+			wantReplicas: map[string]int32{
+				"scaled": 7,
+				"fixed":  2,
+			},
+		},
+		{
+			name:      "hpa target is not running yet",
+			manifests: manifests,
+			applier: func() provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				p.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(provider.Manifest{}, provider.ErrNotFound)
+				return p
+			}(),
+			wantReplicas: map[string]int32{
+				"scaled": 2,
+				"fixed":  2,
+			},
+		},
+		{
+			name:      "hpa target was scaled down to zero",
+			manifests: manifests,
+			applier: func() provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				p.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(scaledDown[0], nil)
+				return p
+			}(),
+			wantReplicas: map[string]int32{
+				"scaled": 2,
+				"fixed":  2,
+			},
+		},
+		{
+			name:      "unable to get live manifest",
+			manifests: manifests,
+			applier: func() provider.Applier {
+				p := kubernetestest.NewMockApplier(ctrl)
+				p.EXPECT().GetManifest(gomock.Any(), manifests[0].Key).Return(provider.Manifest{}, fmt.Errorf("unexpected error"))
+				return p
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			ag := &applierGroup{defaultApplier: tc.applier}
+			got, err := useLiveReplicasForHPATargets(ctx, ag, tc.manifests, nil, &fakeLogPersister{})
+			require.Equal(t, tc.wantErr, err != nil)
+			if tc.wantErr {
+				return
+			}
+			require.Len(t, got, len(tc.manifests))
+			for _, m := range findManifests(provider.KindDeployment, "", got) {
+				replicas, _ := m.GetReplicas()
+				assert.Equal(t, tc.wantReplicas[m.Key.Name], replicas, m.Key.Name)
+			}
+			// The given manifests must not be updated.
+			replicas, _ := tc.manifests[0].GetReplicas()
+			assert.Equal(t, int32(2), replicas)
+		})
+	}
+}
+
 func TestAnnotateConfigHash(t *testing.T) {
 	t.Parallel()
 
@@ -863,6 +1062,34 @@ func TestPatchManifest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:      "add ops",
+			manifests: "testdata/patch_deployment_add_ops.yaml",
+			patch: config.K8sResourcePatch{
+				Ops: []config.K8sResourcePatchOp{
+					{
+						Op:    config.K8sResourcePatchOpYAMLAdd,
+						Path:  "$.spec.template.metadata.annotations",
+						Value: `debug.pipecd.dev/enabled: "true"`,
+					},
+					{
+						Op:    config.K8sResourcePatchOpYAMLReplace,
+						Path:  "$.spec.template.spec.containers[0].env[0].value",
+						Value: "debug",
+					},
+					{
+						Op:    config.K8sResourcePatchOpYAMLAdd,
+						Path:  "$.spec.template.spec.containers[0].env",
+						Value: "- name: DEBUG\n  value: \"true\"",
+					},
+					{
+						Op:    config.K8sResourcePatchOpYAMLAdd,
+						Path:  "$.spec.template.spec.containers[0].resources.requests",
+						Value: "cpu: 100m\nmemory: 128Mi",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,156 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	clusterSyncSucceeded = "SUCCESS"
+	clusterSyncFailed    = "FAILURE"
+	clusterSyncSkipped   = "SKIPPED"
+)
+
+// clusterNames returns the names of the platform providers targeted by the multi-cluster application.
+// The platform provider of the application always comes first.
+func clusterNames(defaultProvider string, mc *config.KubernetesMultiCluster) []string {
+	names := make([]string, 0, len(mc.PlatformProviders)+1)
+	names = append(names, defaultProvider)
+	for _, name := range mc.PlatformProviders {
+		if name == defaultProvider {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// newClusterApplierGroups builds an applier group for each given cluster.
+func newClusterApplierGroups(clusters []string, appCfg config.KubernetesApplicationSpec, pipedCfg *config.PipedSpec, logger *zap.Logger) (map[string]applierGetter, error) {
+	groups := make(map[string]applierGetter, len(clusters))
+	for _, name := range clusters {
+		ag, err := newApplierGroup(name, appCfg, pipedCfg, logger)
+		if err != nil {
+			return nil, err
+		}
+		groups[name] = ag
+	}
+	return groups, nil
+}
+
+// applyManifestsToClusters applies the given manifests to all clusters and returns the sync status of each cluster.
+// When parallel is false, the clusters are synced in the given order and the remaining ones are skipped after a failure.
+func applyManifestsToClusters(ctx context.Context, clusters []string, groups map[string]applierGetter, manifests []provider.Manifest, namespace string, parallel bool, lp executor.LogPersister) map[string]string {
+	statuses := make(map[string]string, len(clusters))
+
+	if !parallel {
+		for i, name := range clusters {
+			lp.Infof("Start syncing cluster %s (%d/%d)", name, i+1, len(clusters))
+			if err := applyManifests(ctx, groups[name], manifests, namespace, clusterLogPersister{lp, name}); err != nil {
+				lp.Errorf("Failed to sync cluster %s (%v)", name, err)
+				statuses[name] = clusterSyncFailed
+				for _, n := range clusters[i+1:] {
+					statuses[n] = clusterSyncSkipped
+				}
+				return statuses
+			}
+			statuses[name] = clusterSyncSucceeded
+		}
+		return statuses
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	lp.Infof("Start syncing %d clusters in parallel", len(clusters))
+	for _, name := range clusters {
+		name := name
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			status := clusterSyncSucceeded
+			if err := applyManifests(ctx, groups[name], manifests, namespace, clusterLogPersister{lp, name}); err != nil {
+				lp.Errorf("Failed to sync cluster %s (%v)", name, err)
+				status = clusterSyncFailed
+			}
+			mu.Lock()
+			statuses[name] = status
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	return statuses
+}
+
+// reportClusterStatuses writes the sync status of each cluster into the stage log
+// and returns true only when all clusters were synced successfully.
+func reportClusterStatuses(clusters []string, statuses map[string]string, lp executor.LogPersister) bool {
+	ok := true
+	for _, name := range clusters {
+		status := statuses[name]
+		if status == clusterSyncSucceeded {
+			lp.Successf("- cluster %s: %s", name, status)
+			continue
+		}
+		ok = false
+		lp.Errorf("- cluster %s: %s", name, status)
+	}
+	return ok
+}
+
+// clusterLogPersister prefixes all logs with the cluster name
+// to make the logs of the clusters synced in parallel distinguishable.
+type clusterLogPersister struct {
+	executor.LogPersister
+	cluster string
+}
+
+func (lp clusterLogPersister) Info(log string) {
+	lp.LogPersister.Info(lp.prefix(log))
+}
+
+func (lp clusterLogPersister) Infof(format string, a ...interface{}) {
+	lp.LogPersister.Info(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp clusterLogPersister) Success(log string) {
+	lp.LogPersister.Success(lp.prefix(log))
+}
+
+func (lp clusterLogPersister) Successf(format string, a ...interface{}) {
+	lp.LogPersister.Success(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp clusterLogPersister) Error(log string) {
+	lp.LogPersister.Error(lp.prefix(log))
+}
+
+func (lp clusterLogPersister) Errorf(format string, a ...interface{}) {
+	lp.LogPersister.Error(lp.prefix(fmt.Sprintf(format, a...)))
+}
+
+func (lp clusterLogPersister) prefix(log string) string {
+	return fmt.Sprintf("[%s] %s", lp.cluster, log)
+}
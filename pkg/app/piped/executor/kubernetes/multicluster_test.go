@@ -0,0 +1,113 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetestest"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestClusterNames(t *testing.T) {
+	t.Parallel()
+
+	mc := &config.KubernetesMultiCluster{
+		PlatformProviders: []string{"cluster-b", "cluster-a", "cluster-c"},
+	}
+	assert.Equal(t, []string{"cluster-a", "cluster-b", "cluster-c"}, clusterNames("cluster-a", mc))
+	assert.Equal(t, []string{"cluster-x", "cluster-b", "cluster-a", "cluster-c"}, clusterNames("cluster-x", mc))
+}
+
+func TestApplyManifestsToClusters(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: simple
+data:
+  key: value
+`)
+	require.NoError(t, err)
+
+	clusters := []string{"cluster-a", "cluster-b", "cluster-c"}
+
+	testcases := []struct {
+		name     string
+		parallel bool
+		failed   string
+		expected map[string]string
+	}{
+		{
+			name: "all clusters succeeded",
+			expected: map[string]string{
+				"cluster-a": clusterSyncSucceeded,
+				"cluster-b": clusterSyncSucceeded,
+				"cluster-c": clusterSyncSucceeded,
+			},
+		},
+		{
+			name:   "remaining clusters are skipped after a failure",
+			failed: "cluster-b",
+			expected: map[string]string{
+				"cluster-a": clusterSyncSucceeded,
+				"cluster-b": clusterSyncFailed,
+				"cluster-c": clusterSyncSkipped,
+			},
+		},
+		{
+			name:     "all clusters are synced in parallel even if one failed",
+			parallel: true,
+			failed:   "cluster-b",
+			expected: map[string]string{
+				"cluster-a": clusterSyncSucceeded,
+				"cluster-b": clusterSyncFailed,
+				"cluster-c": clusterSyncSucceeded,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := gomock.NewController(t)
+			groups := make(map[string]applierGetter, len(clusters))
+			for _, name := range clusters {
+				p := kubernetestest.NewMockApplier(ctrl)
+				var applyErr error
+				if name == tc.failed {
+					applyErr = errors.New("unexpected error")
+				}
+				p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(applyErr).MaxTimes(1)
+				groups[name] = &applierGroup{defaultApplier: p}
+			}
+
+			statuses := applyManifestsToClusters(context.Background(), clusters, groups, manifests, "", tc.parallel, &fakeLogPersister{})
+			assert.Equal(t, tc.expected, statuses)
+			assert.Equal(t, tc.failed == "", reportClusterStatuses(clusters, statuses, &fakeLogPersister{}))
+		})
+	}
+}
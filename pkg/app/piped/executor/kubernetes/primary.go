@@ -16,14 +16,21 @@ package kubernetes
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+const primaryCleanStartTimeKey = "startTime"
+
 func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageStatus {
 	var (
 		options        = e.StageConfig.K8sPrimaryRolloutStageOptions
@@ -60,16 +67,12 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 	case config.KubernetesTrafficRoutingMethodPodSelector:
 		primaryManifests = manifests
 
-	// In case of routing by Istio,
-	// VirtualService manifest will be used to manipulate the traffic ratio.
+	// In case of routing by Istio or SMI,
+	// VirtualService or TrafficSplit manifest will be used to manipulate the traffic ratio.
 	// Other manifests can be used as primary manifests.
-	case config.KubernetesTrafficRoutingMethodIstio:
-		// Firstly, find the VirtualService manifests.
-		istioCfg := e.appCfg.TrafficRouting.Istio
-		if istioCfg == nil {
-			istioCfg = &config.IstioTrafficRouting{}
-		}
-		trafficRoutingManifests, err := findIstioVirtualServiceManifests(manifests, istioCfg.VirtualService)
+	case config.KubernetesTrafficRoutingMethodIstio, config.KubernetesTrafficRoutingMethodSMI:
+		// Firstly, find the VirtualService or TrafficSplit manifests.
+		trafficRoutingManifests, err := findTrafficRoutingManifests(manifests, e.appCfg.Service.Name, e.appCfg.TrafficRouting)
 		if err != nil {
 			e.LogPersister.Errorf("Failed while finding traffic routing manifest: (%v)", err)
 			return model.StageStatus_STAGE_FAILURE
@@ -115,6 +118,11 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 	}
 	e.LogPersister.Successf("Successfully generated %d manifests for PRIMARY variant", len(primaryManifests))
 
+	// Keep the replicas of the workloads scaled by HPA.
+	if primaryManifests, err = useLiveReplicasForHPATargets(ctx, e.applierGetter, primaryManifests, e.appCfg.Workloads, e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
 		primaryManifests,
@@ -133,6 +141,9 @@ func (e *deployExecutor) ensurePrimaryRollout(ctx context.Context) model.StageSt
 
 	// Start applying all manifests to add or update running resources.
 	e.LogPersister.Info("Start rolling out PRIMARY variant...")
+	// Record the warning events of the variant's pods to help debugging the rollout.
+	stopRecordingEvents := startRecordingEvents(ctx, e.applierGetter, primaryManifests, e.LogPersister)
+	defer stopRecordingEvents()
 	if err := applyManifests(ctx, e.applierGetter, primaryManifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -242,3 +253,99 @@ func (e *deployExecutor) generatePrimaryManifests(manifests []provider.Manifest,
 
 	return manifests, nil
 }
+
+// ensurePrimaryClean scales down the workloads of the PRIMARY variant
+// after keeping them running for the configured grace period.
+// It is used in the blue/green flow where all traffic has been switched to the CANARY variant,
+// so that the traffic can be switched back to the previous version instantly during the grace period.
+func (e *deployExecutor) ensurePrimaryClean(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.K8sPrimaryCleanStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The PRIMARY variant is still running the manifests of the running commit.
+	e.LogPersister.Infof("Loading manifests at running commit %s for handling", e.Deployment.RunningCommitHash)
+	manifests, err := e.loadRunningManifests(ctx)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading running manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	workloads := findWorkloadManifests(manifests, e.appCfg.Workloads)
+	if len(workloads) == 0 {
+		e.LogPersister.Info("There are no workloads of PRIMARY variant to scale down")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	// Retrieve the saved startTime from the previous run
+	// to not restart the grace period when the stage was re-executed.
+	startTime := e.retrievePrimaryCleanStartTime()
+	if startTime.IsZero() {
+		startTime = time.Now()
+		e.savePrimaryCleanStartTime(ctx, startTime)
+	}
+
+	if remaining := options.GracePeriod.Duration() - time.Since(startTime); remaining > 0 {
+		e.LogPersister.Infof("Keeping PRIMARY variant running for %v before scaling it down", remaining)
+		timer := time.NewTimer(remaining)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			e.LogPersister.Info("Stopped waiting for the grace period of PRIMARY variant")
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	e.LogPersister.Infof("Start scaling down %d workloads of PRIMARY variant", len(workloads))
+	if err := scaleDownWorkloads(ctx, e.applierGetter, workloads, e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Success("Successfully scaled down PRIMARY variant")
+
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func scaleDownWorkloads(ctx context.Context, ag applierGetter, workloads []provider.Manifest, lp executor.LogPersister) error {
+	for _, w := range workloads {
+		applier, err := ag.Get(w.Key)
+		if err != nil {
+			lp.Error(err.Error())
+			return err
+		}
+		err = applier.Scale(ctx, w.Key, 0)
+		if errors.Is(err, provider.ErrNotFound) {
+			lp.Infof("Skipped scaling down workload %s because it was not found", w.Key.ReadableString())
+			continue
+		}
+		if err != nil {
+			lp.Errorf("Failed to scale down workload %s (%v)", w.Key.ReadableString(), err)
+			return err
+		}
+		lp.Successf("- scaled down workload: %s", w.Key.ReadableString())
+	}
+	return nil
+}
+
+func (e *deployExecutor) retrievePrimaryCleanStartTime() (t time.Time) {
+	s, ok := e.MetadataStore.Stage(e.Stage.Id).Get(primaryCleanStartTimeKey)
+	if !ok {
+		return
+	}
+	ut, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return
+	}
+	return time.Unix(ut, 0)
+}
+
+func (e *deployExecutor) savePrimaryCleanStartTime(ctx context.Context, t time.Time) {
+	value := strconv.FormatInt(t.Unix(), 10)
+	if err := e.MetadataStore.Stage(e.Stage.Id).Put(ctx, primaryCleanStartTimeKey, value); err != nil {
+		e.Logger.Error("failed to store metadata", zap.Error(err))
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -140,6 +141,7 @@ func TestEnsurePrimaryRollout(t *testing.T) {
 				applierGetter: &applierGroup{
 					defaultApplier: func() provider.Applier {
 						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().GetWarningEvents(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 						p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(nil)
 						return p
 					}(),
@@ -195,6 +197,7 @@ func TestEnsurePrimaryRollout(t *testing.T) {
 				applierGetter: &applierGroup{
 					defaultApplier: func() provider.Applier {
 						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().GetWarningEvents(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
 						p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(nil)
 						p.EXPECT().ApplyManifest(gomock.Any(), gomock.Any()).Return(nil)
 						return p
@@ -423,3 +426,149 @@ func TestFindRemoveManifests(t *testing.T) {
 		})
 	}
 }
+
+func TestEnsurePrimaryClean(t *testing.T) {
+	t.Parallel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	deployment := provider.MakeManifest(provider.ResourceKey{
+		APIVersion: "apps/v1",
+		Kind:       provider.KindDeployment,
+		Name:       "simple",
+	}, &unstructured.Unstructured{
+		Object: map[string]interface{}{"spec": map[string]interface{}{}},
+	})
+	runningManifestsCache := func(manifests []provider.Manifest) cache.Cache {
+		c := cachetest.NewMockCache(ctrl)
+		c.EXPECT().Get(gomock.Any()).Return(manifests, nil)
+		return c
+	}
+
+	testcases := []struct {
+		name     string
+		executor *deployExecutor
+		want     model.StageStatus
+	}{
+		{
+			name: "malformed configuration",
+			want: model.StageStatus_STAGE_FAILURE,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment:   &model.Deployment{},
+					LogPersister: &fakeLogPersister{},
+					Stage:        &model.PipelineStage{},
+					StageConfig:  config.PipelineStage{},
+					Logger:       zap.NewNop(),
+				},
+			},
+		},
+		{
+			name: "missing running commit",
+			want: model.StageStatus_STAGE_FAILURE,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment:   &model.Deployment{},
+					LogPersister: &fakeLogPersister{},
+					Stage:        &model.PipelineStage{},
+					StageConfig: config.PipelineStage{
+						K8sPrimaryCleanStageOptions: &config.K8sPrimaryCleanStageOptions{},
+					},
+					Logger: zap.NewNop(),
+				},
+				appCfg: &config.KubernetesApplicationSpec{},
+			},
+		},
+		{
+			name: "failed to scale down workloads",
+			want: model.StageStatus_STAGE_FAILURE,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{
+						RunningCommitHash: "running-commit",
+					},
+					LogPersister: &fakeLogPersister{},
+					Stage:        &model.PipelineStage{},
+					StageConfig: config.PipelineStage{
+						K8sPrimaryCleanStageOptions: &config.K8sPrimaryCleanStageOptions{},
+					},
+					AppManifestsCache: runningManifestsCache([]provider.Manifest{deployment}),
+					MetadataStore:     &fakeMetadataStore{},
+					Logger:            zap.NewNop(),
+				},
+				applierGetter: &applierGroup{
+					defaultApplier: func() provider.Applier {
+						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().Scale(gomock.Any(), deployment.Key, int32(0)).Return(fmt.Errorf("error"))
+						return p
+					}(),
+				},
+				appCfg: &config.KubernetesApplicationSpec{},
+			},
+		},
+		{
+			name: "skip not found workloads",
+			want: model.StageStatus_STAGE_SUCCESS,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{
+						RunningCommitHash: "running-commit",
+					},
+					LogPersister: &fakeLogPersister{},
+					Stage:        &model.PipelineStage{},
+					StageConfig: config.PipelineStage{
+						K8sPrimaryCleanStageOptions: &config.K8sPrimaryCleanStageOptions{},
+					},
+					AppManifestsCache: runningManifestsCache([]provider.Manifest{deployment}),
+					MetadataStore:     &fakeMetadataStore{},
+					Logger:            zap.NewNop(),
+				},
+				applierGetter: &applierGroup{
+					defaultApplier: func() provider.Applier {
+						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().Scale(gomock.Any(), deployment.Key, int32(0)).Return(provider.ErrNotFound)
+						return p
+					}(),
+				},
+				appCfg: &config.KubernetesApplicationSpec{},
+			},
+		},
+		{
+			name: "successfully scaled down workloads",
+			want: model.StageStatus_STAGE_SUCCESS,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{
+						RunningCommitHash: "running-commit",
+					},
+					LogPersister: &fakeLogPersister{},
+					Stage:        &model.PipelineStage{},
+					StageConfig: config.PipelineStage{
+						K8sPrimaryCleanStageOptions: &config.K8sPrimaryCleanStageOptions{
+							GracePeriod: config.Duration(time.Millisecond),
+						},
+					},
+					AppManifestsCache: runningManifestsCache([]provider.Manifest{deployment}),
+					MetadataStore:     &fakeMetadataStore{},
+					Logger:            zap.NewNop(),
+				},
+				applierGetter: &applierGroup{
+					defaultApplier: func() provider.Applier {
+						p := kubernetestest.NewMockApplier(ctrl)
+						p.EXPECT().Scale(gomock.Any(), deployment.Key, int32(0)).Return(nil)
+						return p
+					}(),
+				},
+				appCfg: &config.KubernetesApplicationSpec{},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			got := tc.executor.ensurePrimaryClean(ctx)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
@@ -71,6 +71,7 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		chartRepoName := appCfg.Input.HelmChart.Repository
 		if chartRepoName != "" {
 			appCfg.Input.HelmChart.Insecure = e.PipedConfig.IsInsecureChartRepository(chartRepoName)
+			appCfg.Input.HelmChart.OCIAddress = e.PipedConfig.OCIChartRepositoryAddress(chartRepoName)
 		}
 	}
 
@@ -135,6 +136,21 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if mc := appCfg.MultiCluster; mc != nil {
+		clusters := clusterNames(e.Deployment.PlatformProvider, mc)
+		groups, err := newClusterApplierGroups(clusters, *appCfg, e.PipedConfig, e.Logger)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return model.StageStatus_STAGE_FAILURE
+		}
+		statuses := applyManifestsToClusters(ctx, clusters, groups, manifests, appCfg.Input.Namespace, mc.Parallel, e.LogPersister)
+		e.LogPersister.Info("Rollback status of clusters:")
+		if !reportClusterStatuses(clusters, statuses, e.LogPersister) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
 	ag, err := newApplierGroup(e.Deployment.PlatformProvider, *appCfg, e.PipedConfig, e.Logger)
 	if err != nil {
 		e.LogPersister.Error(err.Error())
@@ -0,0 +1,197 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const rolloutRestartInterval = 5 * time.Second
+
+func (e *deployExecutor) ensureRolloutRestart(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.K8sRolloutRestartStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Load the manifests at the specified commit.
+	e.LogPersister.Infof("Loading manifests at commit %s for handling", e.commit)
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	refs := options.Workloads
+	if len(refs) == 0 {
+		refs = e.appCfg.Workloads
+	}
+	workloads := findWorkloadManifests(manifests, refs)
+	if len(workloads) == 0 {
+		e.LogPersister.Error("There are no workloads to restart")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	pending := make(map[provider.ResourceKey]provider.Applier, len(workloads))
+	e.LogPersister.Infof("Start restarting %d workloads", len(workloads))
+	for _, w := range workloads {
+		applier, err := e.applierGetter.Get(w.Key)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return model.StageStatus_STAGE_FAILURE
+		}
+		err = applier.RolloutRestart(ctx, w.Key)
+		if errors.Is(err, provider.ErrNotFound) {
+			e.LogPersister.Infof("Skipped restarting workload %s because it was not found", w.Key.ReadableString())
+			continue
+		}
+		if err != nil {
+			e.LogPersister.Errorf("Failed to restart workload %s (%v)", w.Key.ReadableString(), err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Successf("- restarted workload: %s", w.Key.ReadableString())
+		pending[w.Key] = applier
+	}
+
+	timeout := options.Timeout.Duration()
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(rolloutRestartInterval)
+	defer ticker.Stop()
+
+	e.LogPersister.Infof("Waiting for %d workloads to be rolled out (timeout: %v)", len(pending), timeout)
+	for {
+		for key, applier := range pending {
+			m, err := applier.GetManifest(waitCtx, key)
+			if err != nil {
+				// Keep waiting since the error might be temporary.
+				e.LogPersister.Infof("Failed to get workload %s: %v", key.ReadableString(), err)
+				continue
+			}
+			done, msg, err := determineRolloutStatus(m)
+			if err != nil {
+				e.LogPersister.Errorf("Unable to determine the rollout status of workload %s (%v)", key.ReadableString(), err)
+				return model.StageStatus_STAGE_FAILURE
+			}
+			if !done {
+				e.LogPersister.Infof("Workload %s is being rolled out: %s", key.ReadableString(), msg)
+				continue
+			}
+			e.LogPersister.Successf("Workload %s has been rolled out", key.ReadableString())
+			delete(pending, key)
+		}
+
+		if len(pending) == 0 {
+			e.LogPersister.Success("All workloads have been restarted successfully")
+			return model.StageStatus_STAGE_SUCCESS
+		}
+
+		select {
+		case <-waitCtx.Done():
+			for key := range pending {
+				e.LogPersister.Errorf("Timed out after %v waiting for workload %s to be rolled out", timeout, key.ReadableString())
+			}
+			return model.StageStatus_STAGE_FAILURE
+		case <-ticker.C:
+		}
+	}
+}
+
+// determineRolloutStatus reports whether the rollout of the given live workload has completed
+// by the same conditions as kubectl rollout status.
+// The returned message describes the progress when the rollout is still in progress.
+func determineRolloutStatus(m provider.Manifest) (bool, string, error) {
+	switch m.Key.Kind {
+	case provider.KindDeployment:
+		d := &appsv1.Deployment{}
+		if err := m.ConvertToStructuredObject(d); err != nil {
+			return false, "", err
+		}
+		if d.Status.ObservedGeneration < d.Generation {
+			return false, "waiting for the spec update to be observed", nil
+		}
+		replicas := int32(1)
+		if d.Spec.Replicas != nil {
+			replicas = *d.Spec.Replicas
+		}
+		if d.Status.UpdatedReplicas < replicas {
+			return false, fmt.Sprintf("%d out of %d new replicas have been updated", d.Status.UpdatedReplicas, replicas), nil
+		}
+		if d.Status.Replicas > d.Status.UpdatedReplicas {
+			return false, fmt.Sprintf("%d old replicas are pending termination", d.Status.Replicas-d.Status.UpdatedReplicas), nil
+		}
+		if d.Status.AvailableReplicas < d.Status.UpdatedReplicas {
+			return false, fmt.Sprintf("%d of %d updated replicas are available", d.Status.AvailableReplicas, d.Status.UpdatedReplicas), nil
+		}
+		return true, "", nil
+
+	case provider.KindStatefulSet:
+		s := &appsv1.StatefulSet{}
+		if err := m.ConvertToStructuredObject(s); err != nil {
+			return false, "", err
+		}
+		if s.Status.ObservedGeneration < s.Generation {
+			return false, "waiting for the spec update to be observed", nil
+		}
+		replicas := int32(1)
+		if s.Spec.Replicas != nil {
+			replicas = *s.Spec.Replicas
+		}
+		if s.Status.ReadyReplicas < replicas {
+			return false, fmt.Sprintf("%d of %d pods are ready", s.Status.ReadyReplicas, replicas), nil
+		}
+		if s.Status.UpdateRevision != s.Status.CurrentRevision {
+			return false, fmt.Sprintf("%d out of %d pods have been updated", s.Status.UpdatedReplicas, replicas), nil
+		}
+		return true, "", nil
+
+	case provider.KindDaemonSet:
+		d := &appsv1.DaemonSet{}
+		if err := m.ConvertToStructuredObject(d); err != nil {
+			return false, "", err
+		}
+		if d.Status.ObservedGeneration < d.Generation {
+			return false, "waiting for the spec update to be observed", nil
+		}
+		if d.Status.UpdatedNumberScheduled < d.Status.DesiredNumberScheduled {
+			return false, fmt.Sprintf("%d out of %d new pods have been updated", d.Status.UpdatedNumberScheduled, d.Status.DesiredNumberScheduled), nil
+		}
+		if d.Status.NumberAvailable < d.Status.DesiredNumberScheduled {
+			return false, fmt.Sprintf("%d of %d updated pods are available", d.Status.NumberAvailable, d.Status.DesiredNumberScheduled), nil
+		}
+		return true, "", nil
+
+	default:
+		return false, "", fmt.Errorf("unsupported workload kind %s", m.Key.Kind)
+	}
+}
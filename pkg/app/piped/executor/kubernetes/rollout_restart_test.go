@@ -0,0 +1,166 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+func TestDetermineRolloutStatus(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		done     bool
+		wantErr  bool
+	}{
+		{
+			name: "deployment whose new spec is not observed yet",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 3
+spec:
+  replicas: 2
+status:
+  observedGeneration: 2
+  replicas: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+		},
+		{
+			name: "deployment with old replicas pending termination",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 3
+spec:
+  replicas: 2
+status:
+  observedGeneration: 3
+  replicas: 3
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+		},
+		{
+			name: "deployment rolled out",
+			manifest: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  generation: 3
+spec:
+  replicas: 2
+status:
+  observedGeneration: 3
+  replicas: 2
+  updatedReplicas: 2
+  availableReplicas: 2
+`,
+			done: true,
+		},
+		{
+			name: "statefulset being updated",
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  readyReplicas: 3
+  updatedReplicas: 1
+  currentRevision: db-1
+  updateRevision: db-2
+`,
+		},
+		{
+			name: "statefulset rolled out",
+			manifest: `
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: db
+  generation: 2
+spec:
+  replicas: 3
+status:
+  observedGeneration: 2
+  readyReplicas: 3
+  updatedReplicas: 3
+  currentRevision: db-2
+  updateRevision: db-2
+`,
+			done: true,
+		},
+		{
+			name: "daemonset rolled out",
+			manifest: `
+apiVersion: apps/v1
+kind: DaemonSet
+metadata:
+  name: agent
+  generation: 2
+status:
+  observedGeneration: 2
+  desiredNumberScheduled: 2
+  updatedNumberScheduled: 2
+  numberAvailable: 2
+`,
+			done: true,
+		},
+		{
+			name: "unsupported kind",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			done, _, err := determineRolloutStatus(manifests[0])
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.done, done)
+		})
+	}
+}
@@ -18,7 +18,11 @@ import (
 	"context"
 	"time"
 
+	"go.uber.org/zap"
+
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -59,6 +63,15 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		}
 	}
 
+	// Check whether the live resources are already in sync with the manifests
+	// before adding the builtin annotations since they always contain the new commit hash.
+	// The live state only contains the resources in the default cluster
+	// so the check is not done for the multi-cluster application.
+	var inSync bool
+	if e.appCfg.MultiCluster == nil {
+		inSync = e.isInSyncWithLiveState(manifests)
+	}
+
 	// Add builtin annotations for tracking application live state.
 	addBuiltinAnnotations(
 		manifests,
@@ -75,12 +88,18 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	if mc := e.appCfg.MultiCluster; mc != nil {
+		return e.syncClusters(ctx, manifests, mc)
+	}
+
 	// Start applying all manifests to add or update running resources.
-	if err := applyManifests(ctx, e.applierGetter, manifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+	if inSync {
+		e.LogPersister.Success("Skipped applying manifests because all resources are already in sync")
+	} else if err := applyManifests(ctx, e.applierGetter, manifests, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !e.appCfg.QuickSync.Prune {
+	if !e.appCfg.QuickSync.Prune && !e.appCfg.QuickSync.PruneDryRun {
 		e.LogPersister.Info("Resource GC was skipped because sync.prune was not configured")
 		return model.StageStatus_STAGE_SUCCESS
 	}
@@ -88,12 +107,14 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	// Wait for all applied manifests to be stable.
 	// In theory, we don't need to wait for them to be stable before going to the next step
 	// but waiting for a while reduces the number of Kubernetes changes in a short time.
-	e.LogPersister.Info("Waiting for the applied manifests to be stable")
-	select {
-	case <-time.After(15 * time.Second):
-		break
-	case <-ctx.Done():
-		break
+	if !inSync {
+		e.LogPersister.Info("Waiting for the applied manifests to be stable")
+		select {
+		case <-time.After(15 * time.Second):
+			break
+		case <-ctx.Done():
+			break
+		}
 	}
 
 	// Find the running resources that are not defined in Git for removing.
@@ -108,12 +129,24 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 		e.LogPersister.Successf("- loaded live resource: %s", m.Key.ReadableString())
 	}
 
+	// Only the resources applied by piped are removed,
+	// the ones created by other tools are kept even when they are annotated with the application ID.
+	liveResources = findManagedResources(liveResources)
+
 	removeKeys := findRemoveResources(manifests, liveResources)
 	if len(removeKeys) == 0 {
 		e.LogPersister.Info("There are no live resources should be removed")
 		return model.StageStatus_STAGE_SUCCESS
 	}
 	e.LogPersister.Infof("Found %d live resources that are no longer defined in Git", len(removeKeys))
+	for _, k := range removeKeys {
+		e.LogPersister.Infof("- resource to be removed: %s", k.ReadableString())
+	}
+
+	if e.appCfg.QuickSync.PruneDryRun {
+		e.LogPersister.Infof("Skipped removing %d resources because sync.pruneDryRun was configured", len(removeKeys))
+		return model.StageStatus_STAGE_SUCCESS
+	}
 
 	// Start deleting all running resources that are not defined in Git.
 	if err := deleteResources(ctx, e.applierGetter, removeKeys, e.LogPersister); err != nil {
@@ -123,6 +156,56 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	return model.StageStatus_STAGE_SUCCESS
 }
 
+// isInSyncWithLiveState reports whether all given manifests have already been applied
+// and have no diff against the live resources.
+func (e *deployExecutor) isInSyncWithLiveState(manifests []provider.Manifest) bool {
+	liveResources, ok := e.AppLiveResourceLister.ListKubernetesResources()
+	if !ok {
+		return false
+	}
+
+	inSync, err := hasNoDiff(liveResources, manifests, e.appCfg.IgnoreFields, e.Logger)
+	if err != nil {
+		e.LogPersister.Infof("Unable to compare the manifests with the live resources, all manifests will be applied (%v)", err)
+		return false
+	}
+	return inSync
+}
+
+// hasNoDiff reports whether none of the given manifests is missing from or different to the live resources.
+// The live resources not defined in the manifests are not taken into account since they are handled by prune.
+func hasNoDiff(liveResources, manifests []provider.Manifest, ignoreFields []string, logger *zap.Logger) (bool, error) {
+	// DiffList sorts the given manifests so copy them to keep the order to apply.
+	news := make([]provider.Manifest, len(manifests))
+	copy(news, manifests)
+
+	result, err := provider.DiffList(
+		liveResources,
+		news,
+		logger,
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+		diff.WithCompareNumberAndNumericString(),
+		diff.WithIgnoredFieldPaths(ignoreFields),
+	)
+	if err != nil {
+		return false, err
+	}
+	return len(result.Adds) == 0 && len(result.Changes) == 0, nil
+}
+
+// findManagedResources returns the resources annotated as managed by piped.
+func findManagedResources(resources []provider.Manifest) []provider.Manifest {
+	managed := make([]provider.Manifest, 0, len(resources))
+	for _, m := range resources {
+		if m.GetAnnotations()[provider.LabelManagedBy] != provider.ManagedByPiped {
+			continue
+		}
+		managed = append(managed, m)
+	}
+	return managed
+}
+
 func findRemoveResources(manifests []provider.Manifest, liveResources []provider.Manifest) []provider.ResourceKey {
 	var (
 		keys       = make(map[provider.ResourceKey]struct{}, len(manifests))
@@ -144,3 +227,25 @@ func findRemoveResources(manifests []provider.Manifest, liveResources []provider
 	}
 	return removeKeys
 }
+
+// syncClusters applies the given manifests to all clusters targeted by the multi-cluster application
+// and saves the sync status of each cluster into the stage metadata.
+func (e *deployExecutor) syncClusters(ctx context.Context, manifests []provider.Manifest, mc *config.KubernetesMultiCluster) model.StageStatus {
+	clusters := clusterNames(e.Deployment.PlatformProvider, mc)
+	groups, err := newClusterApplierGroups(clusters, *e.appCfg, e.PipedConfig, e.Logger)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	statuses := applyManifestsToClusters(ctx, clusters, groups, manifests, e.appCfg.Input.Namespace, mc.Parallel, e.LogPersister)
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, statuses); err != nil {
+		e.Logger.Error("failed to store the sync status of clusters to metadata store", zap.Error(err))
+	}
+
+	e.LogPersister.Info("Sync status of clusters:")
+	if !reportClusterStatuses(clusters, statuses, e.LogPersister) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -79,8 +80,9 @@ func TestEnsureSync(t *testing.T) {
 							Commit: &model.Commit{},
 						},
 					},
-					PipedConfig:  &config.PipedSpec{},
-					LogPersister: &fakeLogPersister{},
+					PipedConfig:           &config.PipedSpec{},
+					LogPersister:          &fakeLogPersister{},
+					AppLiveResourceLister: fakeAppLiveResourceLister{},
 					AppManifestsCache: func() cache.Cache {
 						c := cachetest.NewMockCache(ctrl)
 						c.EXPECT().Get(gomock.Any()).Return(nil, fmt.Errorf("not found"))
@@ -125,8 +127,9 @@ func TestEnsureSync(t *testing.T) {
 							Commit: &model.Commit{},
 						},
 					},
-					PipedConfig:  &config.PipedSpec{},
-					LogPersister: &fakeLogPersister{},
+					PipedConfig:           &config.PipedSpec{},
+					LogPersister:          &fakeLogPersister{},
+					AppLiveResourceLister: fakeAppLiveResourceLister{},
 					AppManifestsCache: func() cache.Cache {
 						c := cachetest.NewMockCache(ctrl)
 						c.EXPECT().Get(gomock.Any()).Return(nil, fmt.Errorf("not found"))
@@ -161,6 +164,63 @@ func TestEnsureSync(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "skip applying manifests already in sync",
+			want: model.StageStatus_STAGE_SUCCESS,
+			executor: &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{
+						Trigger: &model.DeploymentTrigger{
+							Commit: &model.Commit{},
+						},
+					},
+					PipedConfig:  &config.PipedSpec{},
+					LogPersister: &fakeLogPersister{},
+					AppLiveResourceLister: fakeAppLiveResourceLister{
+						resources: []provider.Manifest{
+							provider.MakeManifest(provider.ResourceKey{
+								APIVersion: "apps/v1",
+								Kind:       provider.KindDeployment,
+							}, &unstructured.Unstructured{
+								Object: map[string]interface{}{
+									"metadata": map[string]interface{}{
+										"annotations": map[string]interface{}{
+											provider.LabelCommitHash: "old-commit",
+										},
+									},
+									"spec": map[string]interface{}{},
+								},
+							}),
+						},
+						ok: true,
+					},
+					AppManifestsCache: func() cache.Cache {
+						c := cachetest.NewMockCache(ctrl)
+						c.EXPECT().Get(gomock.Any()).Return(nil, fmt.Errorf("not found"))
+						c.EXPECT().Put(gomock.Any(), gomock.Any()).Return(nil)
+						return c
+					}(),
+					Logger: zap.NewNop(),
+				},
+				loader: func() provider.Loader {
+					p := kubernetestest.NewMockLoader(ctrl)
+					p.EXPECT().LoadManifests(gomock.Any()).Return([]provider.Manifest{
+						provider.MakeManifest(provider.ResourceKey{
+							APIVersion: "apps/v1",
+							Kind:       provider.KindDeployment,
+						}, &unstructured.Unstructured{
+							Object: map[string]interface{}{"spec": map[string]interface{}{}},
+						}),
+					}, nil)
+					return p
+				}(),
+				applierGetter: &applierGroup{
+					// No call to ApplyManifest is expected.
+					defaultApplier: kubernetestest.NewMockApplier(ctrl),
+				},
+				appCfg: &config.KubernetesApplicationSpec{},
+			},
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -172,6 +232,15 @@ func TestEnsureSync(t *testing.T) {
 	}
 }
 
+type fakeAppLiveResourceLister struct {
+	resources []provider.Manifest
+	ok        bool
+}
+
+func (l fakeAppLiveResourceLister) ListKubernetesResources() ([]provider.Manifest, bool) {
+	return l.resources, l.ok
+}
+
 func TestFindRemoveResources(t *testing.T) {
 	t.Parallel()
 
@@ -255,3 +324,113 @@ func TestFindRemoveResources(t *testing.T) {
 		})
 	}
 }
+
+func TestFindManagedResources(t *testing.T) {
+	t.Parallel()
+
+	makeManifest := func(name string, annotations map[string]string) provider.Manifest {
+		u := &unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind("ConfigMap")
+		u.SetName(name)
+		u.SetAnnotations(annotations)
+		return provider.MakeManifest(provider.MakeResourceKey(u), u)
+	}
+
+	resources := []provider.Manifest{
+		makeManifest("applied-by-piped", map[string]string{
+			provider.LabelManagedBy:   provider.ManagedByPiped,
+			provider.LabelApplication: "app-id",
+		}),
+		makeManifest("created-by-others", map[string]string{
+			provider.LabelApplication: "app-id",
+		}),
+		makeManifest("no-annotations", nil),
+	}
+
+	got := findManagedResources(resources)
+	assert.Equal(t, []provider.Manifest{resources[0]}, got)
+}
+
+func TestHasNoDiff(t *testing.T) {
+	t.Parallel()
+
+	liveResources, err := provider.ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  annotations:
+    pipecd.dev/commit-hash: old-commit
+spec:
+  replicas: 2
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: removed-from-git
+`)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name      string
+		manifests string
+		expected  bool
+	}{
+		{
+			name: "no diff",
+			manifests: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 2
+`,
+			expected: true,
+		},
+		{
+			name: "changed",
+			manifests: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 3
+`,
+			expected: false,
+		},
+		{
+			name: "added",
+			manifests: `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+spec:
+  replicas: 2
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: simple
+`,
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(tc.manifests)
+			require.NoError(t, err)
+
+			got, err := hasNoDiff(liveResources, manifests, nil, zap.NewNop())
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -113,6 +114,7 @@ func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageSt
 		primaryPercent,
 		canaryPercent,
 		baselinePercent,
+		options.CanaryMatch,
 	)
 	if err != nil {
 		e.LogPersister.Errorf("Unable generate traffic routing manifest: (%v)", err)
@@ -156,12 +158,19 @@ func findTrafficRoutingManifests(manifests []provider.Manifest, serviceName stri
 		}
 		return findIstioVirtualServiceManifests(manifests, istioConfig.VirtualService)
 
+	case config.KubernetesTrafficRoutingMethodSMI:
+		smiConfig := cfg.SMI
+		if smiConfig == nil {
+			smiConfig = &config.SMITrafficRouting{}
+		}
+		return findSMITrafficSplitManifests(manifests, smiConfig.TrafficSplit)
+
 	default:
 		return nil, fmt.Errorf("unsupport traffic routing method %v", method)
 	}
 }
 
-func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manifest, primaryPercent, canaryPercent, baselinePercent int, canaryMatch *config.K8sTrafficRoutingCanaryMatch) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	manifest = duplicateManifest(manifest, "")
@@ -169,7 +178,7 @@ func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manife
 	// When all traffic should be routed to primary variant
 	// we do not need to change the traffic manifest
 	// just copy and return the one specified in the target commit.
-	if primaryPercent == 100 {
+	if primaryPercent == 100 && canaryMatch == nil {
 		return manifest, nil
 	}
 
@@ -181,9 +190,13 @@ func (e *deployExecutor) generateTrafficRoutingManifest(manifest provider.Manife
 		}
 
 		if strings.HasPrefix(manifest.Key.APIVersion, "v1alpha3") {
-			return e.generateVirtualServiceManifestV1Alpha3(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent))
+			return e.generateVirtualServiceManifestV1Alpha3(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent), canaryMatch)
 		}
-		return e.generateVirtualServiceManifest(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent))
+		return e.generateVirtualServiceManifest(manifest, istioConfig.Host, istioConfig.EditableRoutes, int32(canaryPercent), int32(baselinePercent), canaryMatch)
+	}
+
+	if cfg != nil && cfg.Method == config.KubernetesTrafficRoutingMethodSMI {
+		return e.generateTrafficSplitManifest(manifest, canaryPercent, baselinePercent)
 	}
 
 	// Determine which variant will receive 100% percent of traffic.
@@ -243,7 +256,7 @@ func findIstioVirtualServiceManifests(manifests []provider.Manifest, ref config.
 	return out, nil
 }
 
-func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
+func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32, canaryMatch *config.K8sTrafficRoutingCanaryMatch) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	m = duplicateManifest(m, "")
@@ -267,12 +280,16 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 		editableMap[r] = struct{}{}
 	}
 
-	for _, http := range vs.Http {
+	canaryRoutes := make(map[int]*istiov1beta1.HTTPRoute)
+	for i, http := range vs.Http {
 		if len(editableMap) > 0 {
 			if _, ok := editableMap[http.Name]; !ok {
 				continue
 			}
 		}
+		if canaryMatch != nil {
+			canaryRoutes[i] = generateCanaryMatchRoute(http, host, e.appCfg.VariantLabel.CanaryValue, canaryMatch)
+		}
 
 		var (
 			otherHostWeight int32
@@ -321,6 +338,7 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 		routes = append(routes, otherHostRoutes...)
 		http.Route = routes
 	}
+	vs.Http = insertCanaryMatchRoutes(vs.Http, canaryRoutes)
 
 	if err := m.SetStructuredSpec(vs); err != nil {
 		return m, err
@@ -329,7 +347,7 @@ func (e *deployExecutor) generateVirtualServiceManifest(m provider.Manifest, hos
 	return m, nil
 }
 
-func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32) (provider.Manifest, error) {
+func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manifest, host string, editableRoutes []string, canaryPercent, baselinePercent int32, canaryMatch *config.K8sTrafficRoutingCanaryMatch) (provider.Manifest, error) {
 	// Because the loaded manifests are read-only
 	// so we duplicate them to avoid updating the shared manifests data in cache.
 	m = duplicateManifest(m, "")
@@ -353,12 +371,16 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 		editableMap[r] = struct{}{}
 	}
 
-	for _, http := range vs.Http {
+	canaryRoutes := make(map[int]*istiov1alpha3.HTTPRoute)
+	for i, http := range vs.Http {
 		if len(editableMap) > 0 {
 			if _, ok := editableMap[http.Name]; !ok {
 				continue
 			}
 		}
+		if canaryMatch != nil {
+			canaryRoutes[i] = generateCanaryMatchRouteV1Alpha3(http, host, e.appCfg.VariantLabel.CanaryValue, canaryMatch)
+		}
 
 		var (
 			otherHostWeight int32
@@ -407,6 +429,7 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 		routes = append(routes, otherHostRoutes...)
 		http.Route = routes
 	}
+	vs.Http = insertCanaryMatchRoutesV1Alpha3(vs.Http, canaryRoutes)
 
 	if err := m.SetStructuredSpec(vs); err != nil {
 		return m, err
@@ -415,6 +438,243 @@ func (e *deployExecutor) generateVirtualServiceManifestV1Alpha3(m provider.Manif
 	return m, nil
 }
 
+func findSMITrafficSplitManifests(manifests []provider.Manifest, ref config.K8sResourceReference) ([]provider.Manifest, error) {
+	const (
+		smiSplitAPIVersionPrefix = "split.smi-spec.io/"
+		smiTrafficSplitKind      = "TrafficSplit"
+	)
+
+	if ref.Kind != "" && ref.Kind != smiTrafficSplitKind {
+		return nil, fmt.Errorf("support only %q kind for TrafficSplit reference", smiTrafficSplitKind)
+	}
+
+	out := make([]provider.Manifest, 0, len(manifests))
+	for _, m := range manifests {
+		if !strings.HasPrefix(m.Key.APIVersion, smiSplitAPIVersionPrefix) {
+			continue
+		}
+		if m.Key.Kind != smiTrafficSplitKind {
+			continue
+		}
+		if ref.Name != "" && m.Key.Name != ref.Name {
+			continue
+		}
+		out = append(out, m)
+	}
+
+	return out, nil
+}
+
+// generateTrafficSplitManifest updates the backends of the given SMI TrafficSplit
+// to route the traffic to the services of all variants by the given percentages.
+// The weights of the backends which are not the services of variants are kept as is.
+func (e *deployExecutor) generateTrafficSplitManifest(m provider.Manifest, canaryPercent, baselinePercent int) (provider.Manifest, error) {
+	// Because the loaded manifests are read-only
+	// so we duplicate them to avoid updating the shared manifests data in cache.
+	m = duplicateManifest(m, "")
+
+	spec, err := m.GetNestedMap("spec")
+	if err != nil {
+		return m, err
+	}
+	rootService, _ := spec["service"].(string)
+	if rootService == "" {
+		return m, fmt.Errorf("missing spec.service in TrafficSplit %s", m.Key.ReadableString())
+	}
+
+	var (
+		primaryService  = makeSuffixedName(rootService, e.appCfg.VariantLabel.PrimaryValue)
+		canaryService   = makeSuffixedName(rootService, e.appCfg.VariantLabel.CanaryValue)
+		baselineService = makeSuffixedName(rootService, e.appCfg.VariantLabel.BaselineValue)
+	)
+
+	var (
+		otherWeight   int
+		otherBackends = make([]interface{}, 0)
+	)
+	backends, _ := spec["backends"].([]interface{})
+	for _, b := range backends {
+		backend, ok := b.(map[string]interface{})
+		if !ok {
+			return m, fmt.Errorf("malformed backend in TrafficSplit %s", m.Key.ReadableString())
+		}
+		switch backend["service"] {
+		case primaryService, canaryService, baselineService:
+			continue
+		}
+		weight, err := trafficSplitBackendWeight(backend["weight"])
+		if err != nil {
+			return m, err
+		}
+		otherWeight += weight
+		otherBackends = append(otherBackends, backend)
+	}
+
+	var (
+		variantsWeight = 100 - otherWeight
+		canaryWeight   = canaryPercent * variantsWeight / 100
+		baselineWeight = baselinePercent * variantsWeight / 100
+		primaryWeight  = variantsWeight - canaryWeight - baselineWeight
+		newBackends    = make([]interface{}, 0, len(otherBackends)+3)
+	)
+	newBackends = append(newBackends, map[string]interface{}{"service": primaryService, "weight": int64(primaryWeight)})
+	if canaryWeight > 0 {
+		newBackends = append(newBackends, map[string]interface{}{"service": canaryService, "weight": int64(canaryWeight)})
+	}
+	if baselineWeight > 0 {
+		newBackends = append(newBackends, map[string]interface{}{"service": baselineService, "weight": int64(baselineWeight)})
+	}
+	newBackends = append(newBackends, otherBackends...)
+	spec["backends"] = newBackends
+
+	if err := m.SetStructuredSpec(spec); err != nil {
+		return m, err
+	}
+	return m, nil
+}
+
+// trafficSplitBackendWeight returns the weight of a TrafficSplit backend as an integer.
+// The older API versions of TrafficSplit allow specifying the weight as a quantity string.
+func trafficSplitBackendWeight(v interface{}) (int, error) {
+	switch w := v.(type) {
+	case nil:
+		return 0, nil
+	case int64:
+		return int(w), nil
+	case float64:
+		return int(w), nil
+	case string:
+		return strconv.Atoi(w)
+	default:
+		return 0, fmt.Errorf("unsupported weight %v in TrafficSplit backend", v)
+	}
+}
+
+// generateCanaryMatchRoute returns a copy of the given route that routes
+// only the requests satisfying the canaryMatch conditions to the CANARY variant.
+func generateCanaryMatchRoute(http *istiov1beta1.HTTPRoute, host, canaryVariant string, canaryMatch *config.K8sTrafficRoutingCanaryMatch) *istiov1beta1.HTTPRoute {
+	headers := make(map[string]*istiov1beta1.StringMatch, len(canaryMatch.Headers)+1)
+	for name, value := range canaryMatch.Headers {
+		headers[strings.ToLower(name)] = &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Exact{Exact: value},
+		}
+	}
+	if canaryMatch.Cookie != "" {
+		headers["cookie"] = &istiov1beta1.StringMatch{
+			MatchType: &istiov1beta1.StringMatch_Regex{Regex: canaryCookieRegex(canaryMatch.Cookie)},
+		}
+	}
+
+	route := http.DeepCopy()
+	if route.Name != "" {
+		route.Name = makeSuffixedName(route.Name, canaryVariant)
+	}
+	if len(route.Match) == 0 {
+		route.Match = []*istiov1beta1.HTTPMatchRequest{{}}
+	}
+	for _, m := range route.Match {
+		if m.Name != "" {
+			m.Name = makeSuffixedName(m.Name, canaryVariant)
+		}
+		if m.Headers == nil {
+			m.Headers = make(map[string]*istiov1beta1.StringMatch, len(headers))
+		}
+		for name, value := range headers {
+			m.Headers[name] = value
+		}
+	}
+	route.Route = []*istiov1beta1.HTTPRouteDestination{
+		{
+			Destination: &istiov1beta1.Destination{
+				Host:   host,
+				Subset: canaryVariant,
+			},
+			Weight: 100,
+		},
+	}
+	return route
+}
+
+// insertCanaryMatchRoutes places each canary route right before the route it was generated from
+// so that the matched requests are handled by the canary route first.
+func insertCanaryMatchRoutes(routes []*istiov1beta1.HTTPRoute, canaryRoutes map[int]*istiov1beta1.HTTPRoute) []*istiov1beta1.HTTPRoute {
+	if len(canaryRoutes) == 0 {
+		return routes
+	}
+	out := make([]*istiov1beta1.HTTPRoute, 0, len(routes)+len(canaryRoutes))
+	for i, r := range routes {
+		if c, ok := canaryRoutes[i]; ok {
+			out = append(out, c)
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// generateCanaryMatchRouteV1Alpha3 is the same as generateCanaryMatchRoute but for v1alpha3 VirtualService.
+func generateCanaryMatchRouteV1Alpha3(http *istiov1alpha3.HTTPRoute, host, canaryVariant string, canaryMatch *config.K8sTrafficRoutingCanaryMatch) *istiov1alpha3.HTTPRoute {
+	headers := make(map[string]*istiov1alpha3.StringMatch, len(canaryMatch.Headers)+1)
+	for name, value := range canaryMatch.Headers {
+		headers[strings.ToLower(name)] = &istiov1alpha3.StringMatch{
+			MatchType: &istiov1alpha3.StringMatch_Exact{Exact: value},
+		}
+	}
+	if canaryMatch.Cookie != "" {
+		headers["cookie"] = &istiov1alpha3.StringMatch{
+			MatchType: &istiov1alpha3.StringMatch_Regex{Regex: canaryCookieRegex(canaryMatch.Cookie)},
+		}
+	}
+
+	route := http.DeepCopy()
+	if route.Name != "" {
+		route.Name = makeSuffixedName(route.Name, canaryVariant)
+	}
+	if len(route.Match) == 0 {
+		route.Match = []*istiov1alpha3.HTTPMatchRequest{{}}
+	}
+	for _, m := range route.Match {
+		if m.Name != "" {
+			m.Name = makeSuffixedName(m.Name, canaryVariant)
+		}
+		if m.Headers == nil {
+			m.Headers = make(map[string]*istiov1alpha3.StringMatch, len(headers))
+		}
+		for name, value := range headers {
+			m.Headers[name] = value
+		}
+	}
+	route.Route = []*istiov1alpha3.HTTPRouteDestination{
+		{
+			Destination: &istiov1alpha3.Destination{
+				Host:   host,
+				Subset: canaryVariant,
+			},
+			Weight: 100,
+		},
+	}
+	return route
+}
+
+// insertCanaryMatchRoutesV1Alpha3 is the same as insertCanaryMatchRoutes but for v1alpha3 VirtualService.
+func insertCanaryMatchRoutesV1Alpha3(routes []*istiov1alpha3.HTTPRoute, canaryRoutes map[int]*istiov1alpha3.HTTPRoute) []*istiov1alpha3.HTTPRoute {
+	if len(canaryRoutes) == 0 {
+		return routes
+	}
+	out := make([]*istiov1alpha3.HTTPRoute, 0, len(routes)+len(canaryRoutes))
+	for i, r := range routes {
+		if c, ok := canaryRoutes[i]; ok {
+			out = append(out, c)
+		}
+		out = append(out, r)
+	}
+	return out
+}
+
+// canaryCookieRegex returns the regex matching the cookie header containing the given name=value pair.
+func canaryCookieRegex(cookie string) string {
+	return fmt.Sprintf("^(.*?;\\s*)?(%s)(;.*)?$", regexp.QuoteMeta(cookie))
+}
+
 func checkVariantSelectorInService(m provider.Manifest, variantLabel, variant string) error {
 	selector, err := m.GetNestedStringMap("spec", "selector")
 	if err != nil {
@@ -42,6 +42,7 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 		name           string
 		manifestFile   string
 		editableRoutes []string
+		canaryMatch    *config.K8sTrafficRoutingCanaryMatch
 		expectedFile   string
 	}{
 		{
@@ -55,6 +56,16 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 			editableRoutes: []string{"only-primary-destination"},
 			expectedFile:   "testdata/generated-virtual-service-for-editable-routes.yaml",
 		},
+		{
+			name:           "route matched requests to canary",
+			manifestFile:   "testdata/virtual-service.yaml",
+			editableRoutes: []string{"only-primary-destination"},
+			canaryMatch: &config.K8sTrafficRoutingCanaryMatch{
+				Headers: map[string]string{"X-Canary": "true"},
+				Cookie:  "canary=always",
+			},
+			expectedFile: "testdata/generated-virtual-service-for-canary-match.yaml",
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -62,7 +73,7 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, 1, len(manifests))
 
-			generatedManifest, err := exec.generateVirtualServiceManifest(manifests[0], "helloworld", tc.editableRoutes, 30, 20)
+			generatedManifest, err := exec.generateVirtualServiceManifest(manifests[0], "helloworld", tc.editableRoutes, 30, 20, tc.canaryMatch)
 			assert.NoError(t, err)
 
 			expectedManifests, err := provider.LoadManifestsFromYAMLFile(tc.expectedFile)
@@ -79,6 +90,57 @@ func TestGenerateVirtualServiceManifest(t *testing.T) {
 	}
 }
 
+func TestGenerateTrafficSplitManifest(t *testing.T) {
+	t.Parallel()
+
+	exec := &deployExecutor{
+		appCfg: &config.KubernetesApplicationSpec{
+			VariantLabel: config.KubernetesVariantLabel{
+				Key:           "pipecd.dev/variant",
+				PrimaryValue:  "primary",
+				BaselineValue: "baseline",
+				CanaryValue:   "canary",
+			},
+		},
+	}
+
+	manifests, err := provider.LoadManifestsFromYAMLFile("testdata/traffic-split.yaml")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(manifests))
+
+	generatedManifest, err := exec.generateTrafficSplitManifest(manifests[0], 30, 20)
+	require.NoError(t, err)
+
+	expectedManifests, err := provider.LoadManifestsFromYAMLFile("testdata/generated-traffic-split.yaml")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(expectedManifests))
+
+	expected, err := expectedManifests[0].YamlBytes()
+	require.NoError(t, err)
+	got, err := generatedManifest.YamlBytes()
+	require.NoError(t, err)
+
+	assert.EqualValues(t, string(expected), string(got))
+}
+
+func TestFindSMITrafficSplitManifests(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.LoadManifestsFromYAMLFile("testdata/traffic-split.yaml")
+	require.NoError(t, err)
+
+	found, err := findSMITrafficSplitManifests(manifests, config.K8sResourceReference{Name: "helloworld"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(found))
+
+	found, err = findSMITrafficSplitManifests(manifests, config.K8sResourceReference{Name: "unknown"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(found))
+
+	_, err = findSMITrafficSplitManifests(manifests, config.K8sResourceReference{Kind: "VirtualService"})
+	assert.Error(t, err)
+}
+
 func TestCheckVariantSelectorInService(t *testing.T) {
 	t.Parallel()
 
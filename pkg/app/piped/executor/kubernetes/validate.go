@@ -0,0 +1,155 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureValidate(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.K8sValidateStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Load the manifests at the specified commit.
+	e.LogPersister.Infof("Loading manifests at commit %s for validating", e.commit)
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	if len(manifests) == 0 {
+		e.LogPersister.Info("There are no manifests to validate")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	manifestsPath, err := writeManifestsToTempFile(manifests)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while writing manifests to validate (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	defer os.Remove(manifestsPath)
+
+	if options.SkipSchemaValidation {
+		e.LogPersister.Info("Skipped the schema validation as configured")
+	} else {
+		path, installed, err := toolregistry.DefaultRegistry().Kubeconform(ctx, "")
+		if err != nil {
+			e.LogPersister.Errorf("Unable to find kubeconform (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if installed {
+			e.LogPersister.Info("kubeconform has just been installed because of no pre-installed binary")
+		}
+
+		e.LogPersister.Info("Start validating manifests against the Kubernetes schemas")
+		out, err := provider.NewKubeconform("", path, e.Logger).Validate(ctx, manifestsPath, *options)
+		if out != "" {
+			e.LogPersister.Info(out)
+		}
+		if err != nil {
+			e.LogPersister.Errorf("Found invalid manifests (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Success("All manifests are valid against the schemas")
+	}
+
+	policyDirs := e.findPolicyDirs(options.Policies)
+	if len(policyDirs) == 0 {
+		e.LogPersister.Info("There are no policies to check")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	path, installed, err := toolregistry.DefaultRegistry().Conftest(ctx, "")
+	if err != nil {
+		e.LogPersister.Errorf("Unable to find conftest (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if installed {
+		e.LogPersister.Info("conftest has just been installed because of no pre-installed binary")
+	}
+
+	e.LogPersister.Infof("Start checking manifests against the policies in %s", strings.Join(policyDirs, ", "))
+	out, err := provider.NewConftest("", path, e.Logger).Test(ctx, manifestsPath, policyDirs)
+	if out != "" {
+		e.LogPersister.Info(out)
+	}
+	if err != nil {
+		e.LogPersister.Errorf("Found manifests violating the policies (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("All manifests satisfy the policies")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// findPolicyDirs returns the list of directories containing the policies to check.
+// They are the ones specified in the stage options and the ones configured for the platform provider.
+func (e *deployExecutor) findPolicyDirs(policies []string) []string {
+	dirs := make([]string, 0, len(policies))
+	for _, p := range policies {
+		dirs = append(dirs, filepath.Join(e.appDir, p))
+	}
+
+	cp, ok := e.PipedConfig.FindPlatformProvider(e.Deployment.PlatformProvider, model.ApplicationKind_KUBERNETES)
+	if ok && cp.KubernetesConfig != nil {
+		dirs = append(dirs, cp.KubernetesConfig.PolicyDirs...)
+	}
+	return dirs
+}
+
+// writeManifestsToTempFile writes the given manifests into a temporary file
+// as a multi-document YAML and returns the path to that file.
+func writeManifestsToTempFile(manifests []provider.Manifest) (string, error) {
+	docs := make([]string, 0, len(manifests))
+	for _, m := range manifests {
+		data, err := m.YamlBytes()
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal manifest %s (%w)", m.Key.ReadableString(), err)
+		}
+		docs = append(docs, string(data))
+	}
+
+	f, err := os.CreateTemp("", "manifests-*.yaml")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.WriteString(strings.Join(docs, "---\n")); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
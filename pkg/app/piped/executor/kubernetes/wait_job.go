@@ -0,0 +1,242 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const waitJobInterval = 10 * time.Second
+
+func (e *deployExecutor) ensureWaitJob(ctx context.Context) model.StageStatus {
+	var (
+		options        = e.StageConfig.K8sWaitJobStageOptions
+		variantLabel   = e.appCfg.VariantLabel.Key
+		primaryVariant = e.appCfg.VariantLabel.PrimaryValue
+	)
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Load the manifests at the specified commit.
+	e.LogPersister.Infof("Loading manifests at commit %s for handling", e.commit)
+	manifests, err := loadManifests(
+		ctx,
+		e.Deployment.ApplicationId,
+		e.commit,
+		e.AppManifestsCache,
+		e.loader,
+		e.Logger,
+	)
+	if err != nil {
+		e.LogPersister.Errorf("Failed while loading manifests (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.LogPersister.Successf("Successfully loaded %d manifests", len(manifests))
+
+	jobs, err := findJobManifests(manifests, options.Jobs)
+	if err != nil {
+		e.LogPersister.Error(err.Error())
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if len(jobs) == 0 {
+		e.LogPersister.Error("There are no Job manifests to handle")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Because the loaded manifests are read-only
+	// we duplicate them to avoid updating the shared manifests data in cache.
+	jobs = duplicateManifests(jobs, "")
+
+	// Add builtin annotations for tracking application live state.
+	addBuiltinAnnotations(
+		jobs,
+		variantLabel,
+		primaryVariant,
+		e.commit,
+		e.PipedConfig.PipedID,
+		e.Deployment.ApplicationId,
+	)
+
+	// Most fields of Job are immutable,
+	// so the Jobs created by the previous deployments must be deleted before applying the new ones.
+	e.LogPersister.Info("Start deleting the Jobs created by the previous deployments")
+	for _, j := range jobs {
+		applier, err := e.applierGetter.Get(j.Key)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return model.StageStatus_STAGE_FAILURE
+		}
+		err = applier.Delete(ctx, j.Key)
+		if errors.Is(err, provider.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			e.LogPersister.Errorf("Failed to delete Job %s (%v)", j.Key.ReadableString(), err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Successf("- deleted Job: %s", j.Key.ReadableString())
+	}
+
+	if err := applyManifests(ctx, e.applierGetter, jobs, e.appCfg.Input.Namespace, e.LogPersister); err != nil {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	timeout := options.Timeout.Duration()
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(waitJobInterval)
+	defer ticker.Stop()
+
+	pending := make(map[provider.ResourceKey]provider.Applier, len(jobs))
+	for _, j := range jobs {
+		applier, err := e.applierGetter.Get(j.Key)
+		if err != nil {
+			e.LogPersister.Error(err.Error())
+			return model.StageStatus_STAGE_FAILURE
+		}
+		pending[j.Key] = applier
+	}
+
+	e.LogPersister.Infof("Waiting for %d Jobs to complete (timeout: %v)", len(jobs), timeout)
+	for {
+		for key, applier := range pending {
+			m, err := applier.GetManifest(waitCtx, key)
+			if err != nil {
+				// Keep waiting since the error might be temporary.
+				e.LogPersister.Infof("Failed to get Job %s: %v", key.ReadableString(), err)
+				continue
+			}
+			status, err := determineJobStatus(m)
+			if err != nil {
+				e.LogPersister.Errorf("Unable to determine the status of Job %s (%v)", key.ReadableString(), err)
+				return model.StageStatus_STAGE_FAILURE
+			}
+			if !status.done {
+				e.LogPersister.Infof("Job %s is running: active=%d, succeeded=%d, failed=%d", key.ReadableString(), status.active, status.succeeded, status.failed)
+				continue
+			}
+			writeJobLogs(ctx, applier, key, e.LogPersister)
+			if status.failure != "" {
+				e.LogPersister.Errorf("Job %s failed: %s", key.ReadableString(), status.failure)
+				return model.StageStatus_STAGE_FAILURE
+			}
+			e.LogPersister.Successf("Job %s completed", key.ReadableString())
+			delete(pending, key)
+		}
+
+		if len(pending) == 0 {
+			e.LogPersister.Success("All Jobs completed successfully")
+			return model.StageStatus_STAGE_SUCCESS
+		}
+
+		select {
+		case <-waitCtx.Done():
+			for key, applier := range pending {
+				writeJobLogs(ctx, applier, key, e.LogPersister)
+				e.LogPersister.Errorf("Timed out after %v waiting for Job %s to complete", timeout, key.ReadableString())
+			}
+			return model.StageStatus_STAGE_FAILURE
+		case <-ticker.C:
+		}
+	}
+}
+
+// findJobManifests returns the Job manifests with the given names.
+// Empty names means all Job manifests.
+func findJobManifests(manifests []provider.Manifest, names []string) ([]provider.Manifest, error) {
+	jobs := findManifests(provider.KindJob, "", manifests)
+	if len(names) == 0 {
+		return jobs, nil
+	}
+
+	byName := make(map[string]provider.Manifest, len(jobs))
+	for _, j := range jobs {
+		byName[j.Key.Name] = j
+	}
+	out := make([]provider.Manifest, 0, len(names))
+	for _, name := range names {
+		j, ok := byName[name]
+		if !ok {
+			return nil, fmt.Errorf("unable to find Job %s in the manifests", name)
+		}
+		out = append(out, j)
+	}
+	return out, nil
+}
+
+type jobStatus struct {
+	done      bool
+	failure   string
+	active    int32
+	succeeded int32
+	failed    int32
+}
+
+// determineJobStatus returns the status of the given live Job.
+// The failure is not empty when the Job failed, e.g. the backoffLimit was exhausted.
+func determineJobStatus(m provider.Manifest) (jobStatus, error) {
+	job := &batchv1.Job{}
+	if err := m.ConvertToStructuredObject(job); err != nil {
+		return jobStatus{}, err
+	}
+
+	status := jobStatus{
+		active:    job.Status.Active,
+		succeeded: job.Status.Succeeded,
+		failed:    job.Status.Failed,
+	}
+	for _, c := range job.Status.Conditions {
+		if c.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case batchv1.JobComplete:
+			status.done = true
+			return status, nil
+		case batchv1.JobFailed:
+			status.done = true
+			status.failure = fmt.Sprintf("%s: %s", c.Reason, c.Message)
+			return status, nil
+		}
+	}
+	return status, nil
+}
+
+// writeJobLogs writes the logs of pods created by the given Job into the stage log.
+func writeJobLogs(ctx context.Context, applier provider.Applier, key provider.ResourceKey, lp executor.LogPersister) {
+	logs, err := applier.GetJobLogs(ctx, key)
+	if err != nil {
+		lp.Infof("Unable to get the logs of Job %s (%v)", key.ReadableString(), err)
+		return
+	}
+	lp.Infof("Logs of Job %s:", key.ReadableString())
+	for _, line := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+		lp.Info(line)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+)
+
+func TestFindJobManifests(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := provider.ParseManifests(`
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: seed
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+`)
+	require.NoError(t, err)
+
+	jobs, err := findJobManifests(manifests, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(jobs))
+
+	jobs, err = findJobManifests(manifests, []string{"seed"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(jobs))
+	assert.Equal(t, "seed", jobs[0].Key.Name)
+
+	_, err = findJobManifests(manifests, []string{"unknown"})
+	assert.Error(t, err)
+}
+
+func TestDetermineJobStatus(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		expected jobStatus
+	}{
+		{
+			name: "running",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  active: 1
+  failed: 1
+`,
+			expected: jobStatus{active: 1, failed: 1},
+		},
+		{
+			name: "completed",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  succeeded: 1
+  conditions:
+  - type: Complete
+    status: "True"
+`,
+			expected: jobStatus{done: true, succeeded: 1},
+		},
+		{
+			name: "backoff limit exceeded",
+			manifest: `
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+status:
+  failed: 4
+  conditions:
+  - type: Failed
+    status: "True"
+    reason: BackoffLimitExceeded
+    message: Job has reached the specified backoff limit
+`,
+			expected: jobStatus{done: true, failed: 4, failure: "BackoffLimitExceeded: Job has reached the specified backoff limit"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := provider.ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			require.Equal(t, 1, len(manifests))
+
+			status, err := determineJobStatus(manifests[0])
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -16,10 +16,14 @@ package lambda
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 
@@ -28,6 +32,13 @@ import (
 
 const promotePercentageMetadataKey = "promote-percentage"
 
+var (
+	// The interval to check the CloudWatch alarms during the traffic shifting.
+	alarmCheckInterval = 30 * time.Second
+	// The interval to check whether the provisioned concurrency is ready.
+	provisionedConcurrencyCheckInterval = 10 * time.Second
+)
+
 type deployExecutor struct {
 	executor.Input
 
@@ -70,6 +81,10 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 		status = e.ensurePromote(ctx)
 	case model.StageLambdaCanaryRollout:
 		status = e.ensureRollout(ctx)
+	case model.StageLambdaTrafficRouting:
+		status = e.ensureTrafficRouting(ctx)
+	case model.StageLambdaProvisionedConcurrency:
+		status = e.ensureProvisionedConcurrency(ctx)
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for lambda application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -79,12 +94,16 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 }
 
 func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
-	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input.FunctionManifestFile, e.deploySource)
+	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input, e.deploySource)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm) {
+	if !publishLayers(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, e.deploySource.AppDir, e.appCfg.Input.Layers, &fm) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !sync(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, e.appCfg.Input.VersionRetention) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
@@ -104,12 +123,12 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
 	}
 
-	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input.FunctionManifestFile, e.deploySource)
+	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input, e.deploySource)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !promote(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm) {
+	if !promote(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, options.Percent.Int()) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
@@ -117,14 +136,199 @@ func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
 }
 
 func (e *deployExecutor) ensureRollout(ctx context.Context) model.StageStatus {
-	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input.FunctionManifestFile, e.deploySource)
+	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input, e.deploySource)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm) {
+	if !publishLayers(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, e.deploySource.AppDir, e.appCfg.Input.Layers, &fm) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !rollout(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, e.appCfg.Input.VersionRetention) {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.LambdaTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Lambda client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if len(options.Alarms) > 0 {
+		e.LogPersister.Infof("Watching the CloudWatch alarms during the traffic shifting: %s", strings.Join(options.Alarms, ", "))
+	}
+
+	for i, step := range options.Steps {
+		percent := step.Int()
+		e.LogPersister.Infof("Shifting %d percent of traffic to the new version (step %d/%d)", percent, i+1, len(options.Steps))
+
+		// Since the promoted traffic config is saved before updating the alias at each step,
+		// the rollback stage can restore the alias to the original one whenever this fails.
+		if !promote(ctx, &e.Input, e.platformProviderName, e.platformProviderCfg, fm, percent) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+
+		metadata := map[string]string{
+			promotePercentageMetadataKey: strconv.FormatInt(int64(percent), 10),
+		}
+		if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+			e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+		}
+
+		// The alarms are still checked after the last step
+		// to catch the failures caused by shifting all traffic.
+		var wait time.Duration
+		if i < len(options.Steps)-1 {
+			wait = options.Interval.Duration()
+			e.LogPersister.Infof("Waiting %v before the next step", wait)
+		}
+		if !e.watchAlarms(ctx, client, fm, options.Alarms, wait) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+	}
+
+	e.LogPersister.Successf("Successfully shifted %d percent of traffic to the new version", options.Steps[len(options.Steps)-1].Int())
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// watchAlarms waits for the given duration while checking the given alarms periodically.
+// When any alarm fires, the alias is reverted to the previous version and false is returned.
+func (e *deployExecutor) watchAlarms(ctx context.Context, client provider.Client, fm provider.FunctionManifest, alarms []string, wait time.Duration) bool {
+	deadline := time.Now().Add(wait)
+	for {
+		if len(alarms) > 0 {
+			firing, err := firingAlarms(ctx, client, alarms)
+			if err != nil {
+				e.LogPersister.Errorf("Failed to check the CloudWatch alarms (%v)", err)
+				return false
+			}
+			if len(firing) > 0 {
+				for _, a := range firing {
+					e.LogPersister.Errorf("CloudWatch alarm %s for metric %s fired at %s: %s", a.Name, a.MetricName, a.UpdatedAt.Format(time.RFC3339), a.Reason)
+				}
+				e.LogPersister.Info("Reverting the alias to the previous version")
+				if rollbackTraffic(ctx, &e.Input, client, fm) {
+					e.LogPersister.Info("Successfully reverted the alias to the previous version")
+				}
+				return false
+			}
+		}
+
+		remaining := time.Until(deadline)
+		if remaining <= 0 {
+			return true
+		}
+		if len(alarms) > 0 && remaining > alarmCheckInterval {
+			remaining = alarmCheckInterval
+		}
+
+		timer := time.NewTimer(remaining)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			e.LogPersister.Info("Traffic routing was interrupted before completing all steps")
+			return false
+		case <-timer.C:
+		}
+	}
+}
+
+// firingAlarms returns the alarms which are in ALARM state.
+func firingAlarms(ctx context.Context, client provider.Client, names []string) ([]provider.Alarm, error) {
+	alarms, err := client.DescribeAlarms(ctx, names)
+	if err != nil {
+		return nil, err
+	}
+	if len(alarms) != len(names) {
+		return nil, fmt.Errorf("some of the alarms %s were not found", strings.Join(names, ", "))
+	}
+	firing := make([]provider.Alarm, 0, len(alarms))
+	for _, a := range alarms {
+		if a.Firing() {
+			firing = append(firing, a)
+		}
+	}
+	return firing, nil
+}
+
+func (e *deployExecutor) ensureProvisionedConcurrency(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.LambdaProvisionedConcurrencyStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	fm, ok := loadFunctionManifest(&e.Input, e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Lambda client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	concurrency := e.appCfg.Input.ProvisionedConcurrency
+	if concurrency == 0 {
+		e.LogPersister.Infof("Removing the provisioned concurrency of Lambda function %s since it is not declared", fm.Spec.Name)
+		if err := client.DeleteProvisionedConcurrency(ctx, fm); err != nil {
+			e.LogPersister.Errorf("Failed to remove the provisioned concurrency (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Success("Successfully removed the provisioned concurrency")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	e.LogPersister.Infof("Applying the provisioned concurrency %d to Lambda function %s", concurrency, fm.Spec.Name)
+	if err := client.PutProvisionedConcurrency(ctx, fm, concurrency); err != nil {
+		e.LogPersister.Errorf("Failed to apply the provisioned concurrency (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("Waiting for the provisioned concurrency to be ready (timeout: %v)", options.Timeout.Duration())
+	ctx, cancel := context.WithTimeout(ctx, options.Timeout.Duration())
+	defer cancel()
+
+	ticker := time.NewTicker(provisionedConcurrencyCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		pc, err := client.GetProvisionedConcurrency(ctx, fm)
+		if err != nil {
+			e.LogPersister.Errorf("Failed to get the provisioned concurrency (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if pc.Ready() {
+			e.LogPersister.Successf("Successfully allocated the provisioned concurrency %d", pc.Allocated)
+			return model.StageStatus_STAGE_SUCCESS
+		}
+		if pc.Failed() {
+			e.LogPersister.Errorf("Failed to allocate the provisioned concurrency: %s", pc.Reason)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Infof("The provisioned concurrency is %s (allocated: %d/%d)", pc.Status, pc.Allocated, pc.Requested)
+
+		select {
+		case <-ctx.Done():
+			e.LogPersister.Errorf("The provisioned concurrency did not become ready in %v", options.Timeout.Duration())
+			return model.StageStatus_STAGE_FAILURE
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,98 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+)
+
+type eventSourceMappingUpdate struct {
+	uuid    string
+	mapping provider.EventSourceMapping
+}
+
+// syncEventSourceMappings makes the event source mappings of the function alias match the manifest.
+// Nothing is done when the manifest does not specify any mapping to keep the ones managed outside of PipeCD.
+func syncEventSourceMappings(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest) bool {
+	if fm.Spec.EventSourceMappings == nil {
+		return true
+	}
+
+	current, err := client.ListEventSourceMappings(ctx, fm)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to list event source mappings of Lambda function %s: %v", fm.Spec.Name, err)
+		return false
+	}
+
+	creates, updates, deletes := planEventSourceMappings(fm.Spec.EventSourceMappings, current)
+	for _, m := range creates {
+		if err := client.CreateEventSourceMapping(ctx, fm, m); err != nil {
+			in.LogPersister.Errorf("Failed to create event source mapping: %v", err)
+			return false
+		}
+		in.LogPersister.Infof("Created event source mapping from %s", m.EventSourceArn)
+	}
+	for _, u := range updates {
+		if err := client.UpdateEventSourceMapping(ctx, fm, u.uuid, u.mapping); err != nil {
+			in.LogPersister.Errorf("Failed to update event source mapping: %v", err)
+			return false
+		}
+		in.LogPersister.Infof("Updated event source mapping from %s", u.mapping.EventSourceArn)
+	}
+	for _, m := range deletes {
+		if err := client.DeleteEventSourceMapping(ctx, fm, m.UUID); err != nil {
+			in.LogPersister.Errorf("Failed to delete event source mapping: %v", err)
+			return false
+		}
+		in.LogPersister.Infof("Deleted event source mapping from %s", m.EventSourceArn)
+	}
+	return true
+}
+
+// planEventSourceMappings compares the desired mappings with the current ones by their event source ARN
+// and returns the mappings to be created, updated and deleted.
+// The starting position can not be changed once created, so it is not compared.
+func planEventSourceMappings(desired []provider.EventSourceMapping, current []provider.LiveEventSourceMapping) (creates []provider.EventSourceMapping, updates []eventSourceMappingUpdate, deletes []provider.LiveEventSourceMapping) {
+	currentByArn := make(map[string]provider.LiveEventSourceMapping, len(current))
+	for _, m := range current {
+		currentByArn[m.EventSourceArn] = m
+	}
+
+	desiredArns := make(map[string]struct{}, len(desired))
+	for _, m := range desired {
+		desiredArns[m.EventSourceArn] = struct{}{}
+		cur, ok := currentByArn[m.EventSourceArn]
+		if !ok {
+			creates = append(creates, m)
+			continue
+		}
+		enabled := m.Enabled == nil || *m.Enabled
+		curEnabled := cur.Enabled == nil || *cur.Enabled
+		batchSizeChanged := m.BatchSize > 0 && m.BatchSize != cur.BatchSize
+		if enabled != curEnabled || batchSizeChanged {
+			updates = append(updates, eventSourceMappingUpdate{uuid: cur.UUID, mapping: m})
+		}
+	}
+
+	for _, m := range current {
+		if _, ok := desiredArns[m.EventSourceArn]; !ok {
+			deletes = append(deletes, m)
+		}
+	}
+	return creates, updates, deletes
+}
@@ -0,0 +1,85 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+)
+
+func TestPlanEventSourceMappings(t *testing.T) {
+	t.Parallel()
+
+	const (
+		queueArn  = "arn:aws:sqs:us-east-1:123456789012:queue"
+		streamArn = "arn:aws:kinesis:us-east-1:123456789012:stream/stream"
+	)
+	testcases := []struct {
+		name            string
+		desired         []provider.EventSourceMapping
+		current         []provider.LiveEventSourceMapping
+		expectedCreates []provider.EventSourceMapping
+		expectedUpdates []eventSourceMappingUpdate
+		expectedDeletes []provider.LiveEventSourceMapping
+	}{
+		{
+			name:    "nothing changed",
+			desired: []provider.EventSourceMapping{{EventSourceArn: queueArn, BatchSize: 10}},
+			current: []provider.LiveEventSourceMapping{
+				{UUID: "1", EventSourceMapping: provider.EventSourceMapping{EventSourceArn: queueArn, BatchSize: 10, Enabled: aws.Bool(true)}},
+			},
+		},
+		{
+			name:            "create new mapping",
+			desired:         []provider.EventSourceMapping{{EventSourceArn: streamArn, StartingPosition: "LATEST"}},
+			expectedCreates: []provider.EventSourceMapping{{EventSourceArn: streamArn, StartingPosition: "LATEST"}},
+		},
+		{
+			name:    "update batch size and state",
+			desired: []provider.EventSourceMapping{{EventSourceArn: queueArn, BatchSize: 5, Enabled: aws.Bool(false)}},
+			current: []provider.LiveEventSourceMapping{
+				{UUID: "1", EventSourceMapping: provider.EventSourceMapping{EventSourceArn: queueArn, BatchSize: 10, Enabled: aws.Bool(true)}},
+			},
+			expectedUpdates: []eventSourceMappingUpdate{
+				{uuid: "1", mapping: provider.EventSourceMapping{EventSourceArn: queueArn, BatchSize: 5, Enabled: aws.Bool(false)}},
+			},
+		},
+		{
+			name:    "delete removed mapping",
+			desired: []provider.EventSourceMapping{},
+			current: []provider.LiveEventSourceMapping{
+				{UUID: "1", EventSourceMapping: provider.EventSourceMapping{EventSourceArn: queueArn}},
+			},
+			expectedDeletes: []provider.LiveEventSourceMapping{
+				{UUID: "1", EventSourceMapping: provider.EventSourceMapping{EventSourceArn: queueArn}},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			creates, updates, deletes := planEventSourceMappings(tc.desired, tc.current)
+			assert.Equal(t, tc.expectedCreates, creates)
+			assert.Equal(t, tc.expectedUpdates, updates)
+			assert.Equal(t, tc.expectedDeletes, deletes)
+		})
+	}
+}
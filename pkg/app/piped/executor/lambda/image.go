@@ -0,0 +1,70 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+)
+
+// pinImage replaces the image URI of the function manifest with the one pinned to the digest
+// of the image for the function architecture. Since Lambda does not run multi-architecture image indexes,
+// the digest of the platform-specific image is used, and the published version keeps running
+// the same image even when the tag is moved to another image later.
+func pinImage(ctx context.Context, in *executor.Input, client provider.Client, fm *provider.FunctionManifest) bool {
+	if fm.Spec.ImageURI == "" {
+		return true
+	}
+
+	manifest, err := client.GetImageManifest(ctx, fm.Spec.ImageURI)
+	if errors.Is(err, provider.ErrNotECRImage) {
+		in.LogPersister.Infof("Deploying image %s without pinning its digest since it does not look like an image stored in ECR", fm.Spec.ImageURI)
+		return true
+	}
+	if err != nil {
+		in.LogPersister.Errorf("Failed to get the manifest of image %s: %v", fm.Spec.ImageURI, err)
+		return false
+	}
+	archs := make([]string, 0, len(manifest.Architectures))
+	for arch := range manifest.Architectures {
+		archs = append(archs, arch)
+	}
+	sort.Strings(archs)
+	for _, arch := range archs {
+		in.LogPersister.Infof("Image %s has digest %s for architecture %s", fm.Spec.ImageURI, manifest.Architectures[arch], arch)
+	}
+
+	uri, err := provider.ResolveImageURI(*fm, manifest)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to deploy image to Lambda function %s: %v", fm.Spec.Name, err)
+		return false
+	}
+
+	// Record the pinned image since the tag can be moved to another image later.
+	pinnedImageKeyName := fmt.Sprintf("%s-pinned-image", fm.Spec.Name)
+	if err := in.MetadataStore.Shared().Put(ctx, pinnedImageKeyName, uri); err != nil {
+		in.LogPersister.Errorf("Failed to store the pinned image to metadata store for Lambda function %s: %v", fm.Spec.Name, err)
+		return false
+	}
+
+	in.LogPersister.Infof("Deploying image %s as %s", fm.Spec.ImageURI, uri)
+	fm.Spec.ImageURI = uri
+	return true
+}
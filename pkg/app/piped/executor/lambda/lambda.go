@@ -47,6 +47,8 @@ func Register(r registerer) {
 	r.Register(model.StageLambdaSync, f)
 	r.Register(model.StageLambdaPromote, f)
 	r.Register(model.StageLambdaCanaryRollout, f)
+	r.Register(model.StageLambdaTrafficRouting, f)
+	r.Register(model.StageLambdaProvisionedConcurrency, f)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_LAMBDA, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -73,20 +75,33 @@ func findPlatformProvider(in *executor.Input) (name string, cfg *config.Platform
 	return
 }
 
-func loadFunctionManifest(in *executor.Input, functionManifestFile string, ds *deploysource.DeploySource) (provider.FunctionManifest, bool) {
+func loadFunctionManifest(in *executor.Input, input config.LambdaDeploymentInput, ds *deploysource.DeploySource) (provider.FunctionManifest, bool) {
 	in.LogPersister.Infof("Loading service manifest at commit %s", ds.Revision)
 
-	fm, err := provider.LoadFunctionManifest(ds.AppDir, functionManifestFile)
+	fm, err := provider.LoadFunctionManifest(ds.AppDir, input)
 	if err != nil {
 		in.LogPersister.Errorf("Failed to load lambda function manifest (%v)", err)
 		return provider.FunctionManifest{}, false
 	}
 
+	var encryptedSecrets map[string]string
+	if enc := ds.GenericApplicationConfig.Encryption; enc != nil {
+		encryptedSecrets = enc.EncryptedSecrets
+	}
+	names, err := provider.DecryptEnvironments(&fm, encryptedSecrets, in.SecretDecrypter)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to decrypt the environment variables of lambda function manifest (%v)", err)
+		return provider.FunctionManifest{}, false
+	}
+	if len(names) > 0 {
+		in.LogPersister.Infof("Successfully decrypted the secrets of environment variables: %v", names)
+	}
+
 	in.LogPersister.Infof("Successfully loaded the lambda function manifest at commit %s", ds.Revision)
 	return fm, true
 }
 
-func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest) bool {
+func sync(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest, versionRetention int) bool {
 	in.LogPersister.Infof("Start applying the lambda function manifest")
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
@@ -95,7 +110,7 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 	}
 
 	// Build and publish new version of Lambda function.
-	version, ok := build(ctx, in, client, fm)
+	version, ok := build(ctx, in, client, fm, versionRetention)
 	if !ok {
 		in.LogPersister.Errorf("Failed to build new version for Lambda function %s", fm.Spec.Name)
 		return false
@@ -108,6 +123,9 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 			in.LogPersister.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
 			return false
 		}
+		if !syncEventSourceMappings(ctx, in, client, fm) {
+			return false
+		}
 		in.LogPersister.Infof("Successfully applied the lambda function manifest")
 		return true
 	}
@@ -140,11 +158,15 @@ func sync(ctx context.Context, in *executor.Input, platformProviderName string,
 		return false
 	}
 
+	if !syncEventSourceMappings(ctx, in, client, fm) {
+		return false
+	}
+
 	in.LogPersister.Infof("Successfully applied the manifest for Lambda function %s version (v%s)", fm.Spec.Name, version)
 	return true
 }
 
-func rollout(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest) bool {
+func rollout(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest, versionRetention int) bool {
 	in.LogPersister.Infof("Start rolling out the lambda function: %s", fm.Spec.Name)
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
@@ -153,7 +175,7 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	}
 
 	// Build and publish new version of Lambda function.
-	version, ok := build(ctx, in, client, fm)
+	version, ok := build(ctx, in, client, fm, versionRetention)
 	if !ok {
 		in.LogPersister.Errorf("Failed to build new version for Lambda function %s", fm.Spec.Name)
 		return false
@@ -184,7 +206,7 @@ func rollout(ctx context.Context, in *executor.Input, platformProviderName strin
 	return true
 }
 
-func promote(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest) bool {
+func promote(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, fm provider.FunctionManifest, percent int) bool {
 	in.LogPersister.Infof("Start promote new version of the lambda function: %s", fm.Spec.Name)
 	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
 	if err != nil {
@@ -199,16 +221,10 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 		return false
 	}
 
-	options := in.StageConfig.LambdaPromoteStageOptions
-	if options == nil {
-		in.LogPersister.Errorf("Malformed configuration for stage %s", in.Stage.Name)
-		return false
-	}
-
 	trafficCfg, err := client.GetTrafficConfig(ctx, fm)
 	// Create Alias on not yet existed.
 	if errors.Is(err, provider.ErrNotFound) {
-		if options.Percent.Int() != 100 {
+		if percent != 100 {
 			in.LogPersister.Errorf("Not previous version available to handle traffic, new version has to get 100 percent of traffic")
 			return false
 		}
@@ -216,6 +232,9 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 			in.LogPersister.Errorf("Failed to create traffic routing for Lambda function %s (version: %s): %v", fm.Spec.Name, version, err)
 			return false
 		}
+		if !syncEventSourceMappings(ctx, in, client, fm) {
+			return false
+		}
 		in.LogPersister.Infof("Successfully route all traffic to the lambda function %s (version %s)", fm.Spec.Name, version)
 		return true
 	}
@@ -225,7 +244,7 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 	}
 
 	// Update traffic to the new lambda version.
-	if !configureTrafficRouting(trafficCfg, version, options.Percent.Int()) {
+	if !configureTrafficRouting(trafficCfg, version, percent) {
 		in.LogPersister.Errorf("Failed to prepare traffic routing for Lambda function %s", fm.Spec.Name)
 		return false
 	}
@@ -247,7 +266,11 @@ func promote(ctx context.Context, in *executor.Input, platformProviderName strin
 		return false
 	}
 
-	in.LogPersister.Infof("Successfully promote new version (v%s) of Lambda function %s, it will handle %v percent of traffic", version, fm.Spec.Name, options.Percent)
+	if !syncEventSourceMappings(ctx, in, client, fm) {
+		return false
+	}
+
+	in.LogPersister.Infof("Successfully promote new version (v%s) of Lambda function %s, it will handle %d percent of traffic", version, fm.Spec.Name, percent)
 	return true
 }
 
@@ -280,7 +303,11 @@ func configureTrafficRouting(trafficCfg provider.RoutingTrafficConfig, version s
 	return true
 }
 
-func build(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest) (version string, ok bool) {
+func build(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest, versionRetention int) (version string, ok bool) {
+	if !pinImage(ctx, in, client, &fm) {
+		return
+	}
+
 	found, err := client.IsFunctionExist(ctx, fm.Spec.Name)
 	if err != nil {
 		in.LogPersister.Errorf("Unable to validate function name %s: %v", fm.Spec.Name, err)
@@ -321,6 +348,7 @@ func build(ctx context.Context, in *executor.Input, client provider.Client, fm p
 	}
 
 	in.LogPersister.Infof("Successfully committed new version (v%s) for Lambda function %s after duration %v", version, fm.Spec.Name, time.Since(startWaitingStamp))
+	pruneVersions(ctx, in, client, fm, versionRetention)
 	ok = true
 	return
 }
@@ -15,6 +15,8 @@
 package lambda
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"io"
 	"testing"
@@ -188,3 +190,93 @@ func TestPrepareZipFromSource(t *testing.T) {
 	assert.Nil(t, err)
 	assert.NotEqual(t, 0, len(data))
 }
+
+func TestArchiveDirectory(t *testing.T) {
+	t.Parallel()
+
+	data, err := archiveDirectory("testdata/raw")
+	require.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"test-1/", "test-1/text.txt", "test-2/", "test-2/.dotfile", "text.txt"}, names)
+
+	// The archive of the same content must have the same hash to reuse the published layer.
+	again, err := archiveDirectory("testdata/raw")
+	require.NoError(t, err)
+	assert.Equal(t, codeSha256(data), codeSha256(again))
+}
+
+type fakeAlarmClient struct {
+	provider.Client
+	alarms []provider.Alarm
+}
+
+func (c *fakeAlarmClient) DescribeAlarms(_ context.Context, names []string) ([]provider.Alarm, error) {
+	alarms := make([]provider.Alarm, 0, len(names))
+	for _, a := range c.alarms {
+		for _, n := range names {
+			if a.Name == n {
+				alarms = append(alarms, a)
+			}
+		}
+	}
+	return alarms, nil
+}
+
+func TestFiringAlarms(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeAlarmClient{
+		alarms: []provider.Alarm{
+			{Name: "errors", State: provider.AlarmStateAlarm},
+			{Name: "throttles", State: "OK"},
+			{Name: "duration", State: "INSUFFICIENT_DATA"},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		alarms   []string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "no alarm fires",
+			alarms:   []string{"throttles", "duration"},
+			expected: []string{},
+		},
+		{
+			name:     "an alarm fires",
+			alarms:   []string{"errors", "throttles"},
+			expected: []string{"errors"},
+		},
+		{
+			name:    "alarm not found",
+			alarms:  []string{"throttles", "unknown"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			firing, err := firingAlarms(context.Background(), client, tc.alarms)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			names := make([]string, 0, len(firing))
+			for _, a := range firing {
+				names = append(names, a.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// The modification time set to all files in the layer archives
+// to make the archive of the same content always have the same hash.
+var layerArchiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// publishLayers publishes the given layers and appends the ARNs of their versions
+// to the layers of the function manifest, so that they are applied together
+// with the function code in the same function version.
+func publishLayers(ctx context.Context, in *executor.Input, platformProviderName string, platformProviderCfg *config.PlatformProviderLambdaConfig, appDir string, layers []config.LambdaLayer, fm *provider.FunctionManifest) bool {
+	if len(layers) == 0 {
+		return true
+	}
+	client, err := provider.DefaultRegistry().Client(platformProviderName, platformProviderCfg, in.Logger)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to create Lambda client for the provider %s: %v", platformProviderName, err)
+		return false
+	}
+
+	arns := make([]string, 0, len(fm.Spec.Layers)+len(layers))
+	arns = append(arns, fm.Spec.Layers...)
+	for _, l := range layers {
+		layer := provider.Layer{
+			Name:                    l.Name,
+			S3Bucket:                l.S3Bucket,
+			S3Key:                   l.S3Key,
+			S3ObjectVersion:         l.S3ObjectVersion,
+			CompatibleRuntimes:      l.CompatibleRuntimes,
+			CompatibleArchitectures: l.CompatibleArchitectures,
+		}
+		if l.Path != "" {
+			data, err := archiveDirectory(filepath.Join(appDir, l.Path))
+			if err != nil {
+				in.LogPersister.Errorf("Failed to archive the content of Lambda layer %s: %v", l.Name, err)
+				return false
+			}
+			layer.ZipFile = data
+
+			// Reuse the latest version if its content has not been changed.
+			latest, err := client.GetLatestLayerVersion(ctx, l.Name)
+			if err != nil && !errors.Is(err, provider.ErrNotFound) {
+				in.LogPersister.Errorf("Failed to get the latest version of Lambda layer %s: %v", l.Name, err)
+				return false
+			}
+			if err == nil && latest.CodeSha256 == codeSha256(data) {
+				in.LogPersister.Infof("Lambda layer %s has no changes, reusing the latest version %s", l.Name, latest.ARN)
+				arns = append(arns, latest.ARN)
+				continue
+			}
+		}
+
+		lv, err := client.PublishLayerVersion(ctx, layer)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to publish Lambda layer %s: %v", l.Name, err)
+			return false
+		}
+		in.LogPersister.Infof("Successfully published Lambda layer %s as %s", l.Name, lv.ARN)
+		arns = append(arns, lv.ARN)
+	}
+
+	fm.Spec.Layers = arns
+	return true
+}
+
+// archiveDirectory returns a zip archive containing all files under the given directory.
+// The paths in the archive are relative to the directory.
+func archiveDirectory(dir string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+
+	err := filepath.Walk(dir, func(fp string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fp == dir {
+			return nil
+		}
+
+		header, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		header.Method = zip.Deflate
+		header.Modified = layerArchiveModTime
+		header.Name, err = filepath.Rel(dir, fp)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(header.Name)
+		if fi.IsDir() {
+			header.Name += "/"
+			header.Method = zip.Store
+		}
+		hw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		if fi.IsDir() {
+			return nil
+		}
+
+		f, err := os.Open(fp)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		_, err = io.Copy(hw, f)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// codeSha256 returns the hash of the given archive in the same format as Lambda.
+func codeSha256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,82 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+)
+
+// pruneVersions deletes the function versions older than the latest retention ones.
+// The versions routed by any alias are always kept, so this must be called before
+// updating the alias to keep the version currently serving the traffic for rollback.
+// Failing to prune is not treated as a deployment failure.
+func pruneVersions(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest, retention int) {
+	if retention <= 0 {
+		return
+	}
+
+	versions, err := client.ListFunctionVersions(ctx, fm)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to list versions of Lambda function %s to prune: %v", fm.Spec.Name, err)
+		return
+	}
+	aliased, err := client.ListAliasedVersions(ctx, fm)
+	if err != nil {
+		in.LogPersister.Errorf("Unable to list aliased versions of Lambda function %s to prune: %v", fm.Spec.Name, err)
+		return
+	}
+
+	pruned := versionsToPrune(versions, aliased, retention)
+	for _, v := range pruned {
+		if err := client.DeleteFunctionVersion(ctx, fm, v); err != nil {
+			in.LogPersister.Errorf("Unable to prune version %s of Lambda function %s: %v", v, fm.Spec.Name, err)
+			return
+		}
+	}
+	if len(pruned) > 0 {
+		in.LogPersister.Infof("Pruned %d old versions of Lambda function %s", len(pruned), fm.Spec.Name)
+	}
+}
+
+// versionsToPrune returns the versions beyond the latest retention ones, excluding the aliased ones.
+func versionsToPrune(versions []string, aliased map[string]struct{}, retention int) []string {
+	numbers := make([]int, 0, len(versions))
+	for _, v := range versions {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		numbers = append(numbers, n)
+	}
+	if len(numbers) <= retention {
+		return nil
+	}
+	sort.Sort(sort.Reverse(sort.IntSlice(numbers)))
+
+	pruned := make([]string, 0, len(numbers)-retention)
+	for _, n := range numbers[retention:] {
+		v := strconv.Itoa(n)
+		if _, ok := aliased[v]; ok {
+			continue
+		}
+		pruned = append(pruned, v)
+	}
+	return pruned
+}
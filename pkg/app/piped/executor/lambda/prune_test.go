@@ -0,0 +1,68 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVersionsToPrune(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		versions  []string
+		aliased   map[string]struct{}
+		retention int
+		expected  []string
+	}{
+		{
+			name:      "fewer versions than retention",
+			versions:  []string{"1", "2"},
+			retention: 3,
+			expected:  nil,
+		},
+		{
+			name:      "prune older versions",
+			versions:  []string{"1", "2", "10", "11", "9"},
+			retention: 2,
+			expected:  []string{"9", "2", "1"},
+		},
+		{
+			name:      "keep aliased versions",
+			versions:  []string{"1", "2", "3", "4"},
+			aliased:   map[string]struct{}{"2": {}},
+			retention: 1,
+			expected:  []string{"3", "1"},
+		},
+		{
+			name:      "ignore non numeric versions",
+			versions:  []string{"$LATEST", "1", "2"},
+			retention: 1,
+			expected:  []string{"1"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := versionsToPrune(tc.versions, tc.aliased, tc.retention)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
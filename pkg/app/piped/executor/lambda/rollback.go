@@ -70,11 +70,16 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	fm, ok := loadFunctionManifest(&e.Input, appCfg.Input.FunctionManifestFile, runningDS)
+	fm, ok := loadFunctionManifest(&e.Input, appCfg.Input, runningDS)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
+	// Publish the layers of the running commit to restore them together with the function configuration.
+	if !publishLayers(ctx, &e.Input, platformProviderName, platformProviderCfg, runningDS.AppDir, appCfg.Input.Layers, &fm) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	if !rollback(ctx, &e.Input, platformProviderName, platformProviderCfg, fm) {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -97,6 +102,11 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 	}
 	in.LogPersister.Infof("Rolled back the lambda function %s configuration to original stage", fm.Spec.Name)
 
+	return rollbackTraffic(ctx, in, client, fm)
+}
+
+// rollbackTraffic restores the traffic routing of the alias to the one before the deployment.
+func rollbackTraffic(ctx context.Context, in *executor.Input, client provider.Client, fm provider.FunctionManifest) bool {
 	// Rollback traffic routing to previous state.
 	// Restore original traffic config from metadata store.
 	originalTrafficKeyName := fmt.Sprintf("original-traffic-%s", in.Deployment.RunningCommitHash)
@@ -130,7 +140,7 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 	switch len(originalTrafficCfg) {
 	// Original traffic config has both PRIMARY and SECONDARY version config.
 	case 2:
-		if err = client.UpdateTrafficConfig(ctx, fm, originalTrafficCfg); err != nil {
+		if err := client.UpdateTrafficConfig(ctx, fm, originalTrafficCfg); err != nil {
 			in.LogPersister.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
 			return false
 		}
@@ -151,7 +161,7 @@ func rollback(ctx context.Context, in *executor.Input, platformProviderName stri
 			return false
 		}
 
-		if err = client.UpdateTrafficConfig(ctx, fm, promotedTrafficCfg); err != nil {
+		if err := client.UpdateTrafficConfig(ctx, fm, promotedTrafficCfg); err != nil {
 			in.LogPersister.Errorf("Failed to rollback original traffic config for Lambda function %s: %v", fm.Spec.Name, err)
 			return false
 		}
@@ -0,0 +1,155 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/nomad"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The key of the shared metadata to pass the job version rolled out by NOMAD_CANARY_ROLLOUT stage to NOMAD_PROMOTE stage.
+const jobVersionMetadataKey = "nomad-job-version"
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.NomadApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderNomadConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.NomadApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing NomadApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Nomad client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageNomadSync:
+		status = e.ensureSync(ctx)
+	case model.StageNomadCanaryRollout:
+		status = e.ensureCanaryRollout(ctx)
+	case model.StageNomadPromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for nomad application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	job, ok := loadJob(ctx, &e.Input, e.client, e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	version, ok := registerJob(ctx, &e.Input, e.client, job, e.Deployment.CommitHash())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The canaries are promoted as soon as they became healthy.
+	if _, ok := waitDeployment(ctx, &e.Input, e.client, job, version, false); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageStatus {
+	job, ok := loadJob(ctx, &e.Input, e.client, e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if job.Canaries() == 0 {
+		e.LogPersister.Errorf("Job %s has no canary, the canary field of its update block must be set to use %s stage", job.ID(), model.StageNomadCanaryRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	version, ok := registerJob(ctx, &e.Input, e.client, job, e.Deployment.CommitHash())
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := e.MetadataStore.Shared().Put(ctx, jobVersionMetadataKey, strconv.FormatUint(version, 10)); err != nil {
+		e.LogPersister.Errorf("Failed to save the job version to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The canaries are kept unpromoted until the promote stage.
+	if _, ok := waitDeployment(ctx, &e.Input, e.client, job, version, true); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	value, ok := e.MetadataStore.Shared().Get(jobVersionMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the job version rolled out by %s stage, it must be executed before this stage", model.StageNomadCanaryRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	version, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		e.LogPersister.Errorf("Malformed job version %q in metadata: %v", value, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	job, ok := loadJob(ctx, &e.Input, e.client, e.appCfg.Input, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The unpromoted canaries are promoted while waiting for the deployment to complete.
+	if _, ok := waitDeployment(ctx, &e.Input, e.client, job, version, false); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
@@ -0,0 +1,239 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/nomad"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+var (
+	// The interval to check the status of the deployment while it is in progress.
+	deploymentCheckInterval = 5 * time.Second
+	// The duration to wait for Nomad to create the deployment of the registered job version.
+	// Nomad creates no deployment when the new version does not change any allocation.
+	deploymentCreateTimeout = time.Minute
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageNomadSync, f)
+	r.Register(model.StageNomadCanaryRollout, f)
+	r.Register(model.StageNomadPromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_NOMAD, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderNomadConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_NOMAD)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.NomadConfig
+	found = true
+	return
+}
+
+// loadJob loads the job specification of the given deploy source.
+// The HCL job specification is parsed by the Nomad server to resolve its variables and functions.
+func loadJob(ctx context.Context, in *executor.Input, client provider.Client, input config.NomadDeploymentInput, ds *deploysource.DeploySource) (*provider.Job, bool) {
+	in.LogPersister.Infof("Loading job specification at commit %s", ds.Revision)
+
+	spec, err := provider.LoadJobSpec(ds.AppDir, input.JobFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load job specification (%v)", err)
+		return nil, false
+	}
+
+	var job *provider.Job
+	if spec.IsJSON {
+		job, err = provider.DecodeJob(spec.Data)
+	} else {
+		job, err = client.ParseJob(ctx, string(spec.Data), input.Variables)
+	}
+	if err != nil {
+		in.LogPersister.Errorf("Failed to parse job specification %s (%v)", spec.Name, err)
+		return nil, false
+	}
+	if job.ID() == "" {
+		in.LogPersister.Errorf("Missing job ID in job specification %s", spec.Name)
+		return nil, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the job specification of job %s at commit %s", job.ID(), ds.Revision)
+	return job, true
+}
+
+// registerJob registers the given job of the given commit and returns its new version.
+func registerJob(ctx context.Context, in *executor.Input, client provider.Client, job *provider.Job, commitHash string) (uint64, bool) {
+	job.SetMeta(map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	})
+
+	in.LogPersister.Infof("Registering job %s", job.ID())
+	version, err := client.RegisterJob(ctx, job)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to register job %s: %v", job.ID(), err)
+		return 0, false
+	}
+
+	in.LogPersister.Infof("Successfully registered version %d of job %s", version, job.ID())
+	return version, true
+}
+
+type deploymentAction int
+
+const (
+	// Wait for the deployment to make progress.
+	deploymentActionWait deploymentAction = iota
+	// Promote the healthy canaries of the deployment.
+	deploymentActionPromote
+	// All canaries of the deployment became healthy.
+	deploymentActionCanariesReady
+	// The deployment completed successfully.
+	deploymentActionSucceeded
+	// The deployment failed or was cancelled.
+	deploymentActionFailed
+	// The deployment of a newer version has been started.
+	deploymentActionSuperseded
+)
+
+// decideDeploymentAction decides what to do with the given latest deployment of the job
+// while waiting for the deployment of the given version.
+func decideDeploymentAction(d *provider.Deployment, version uint64, stopAtCanaries bool) deploymentAction {
+	if d == nil || d.JobVersion < version {
+		return deploymentActionWait
+	}
+	if d.JobVersion > version {
+		return deploymentActionSuperseded
+	}
+
+	switch d.Status {
+	case provider.DeploymentStatusSuccessful:
+		return deploymentActionSucceeded
+	case provider.DeploymentStatusFailed, provider.DeploymentStatusCancelled:
+		return deploymentActionFailed
+	}
+
+	if d.RequiresPromotion() && d.CanariesHealthy() {
+		if stopAtCanaries {
+			return deploymentActionCanariesReady
+		}
+		return deploymentActionPromote
+	}
+	return deploymentActionWait
+}
+
+// waitDeployment waits for the deployment of the given version of the job.
+// When stopAtCanaries is true, it returns as soon as all canaries became healthy,
+// otherwise the canaries are promoted once they became healthy and it waits until the deployment completes.
+// The returned deployment is nil when Nomad created no deployment for the version.
+func waitDeployment(ctx context.Context, in *executor.Input, client provider.Client, job *provider.Job, version uint64, stopAtCanaries bool) (*provider.Deployment, bool) {
+	if job.IsBatch() {
+		in.LogPersister.Infof("Job %s is a %s job, so its allocations are not waited to complete", job.ID(), job.Type())
+		return nil, true
+	}
+
+	ticker := time.NewTicker(deploymentCheckInterval)
+	defer ticker.Stop()
+
+	var (
+		startedAt    = time.Now()
+		lastProgress string
+	)
+	for {
+		d, err := client.LatestDeployment(ctx, job.Namespace(), job.ID())
+		if err != nil && !errors.Is(err, provider.ErrNotFound) {
+			in.LogPersister.Errorf("Failed to get the deployment of job %s: %v", job.ID(), err)
+			return nil, false
+		}
+
+		switch decideDeploymentAction(d, version, stopAtCanaries) {
+		case deploymentActionWait:
+			if d == nil || d.JobVersion < version {
+				if time.Since(startedAt) > deploymentCreateTimeout {
+					in.LogPersister.Infof("No deployment was created for version %d of job %s, it seems there is no allocation to update", version, job.ID())
+					return nil, true
+				}
+				break
+			}
+			if progress := d.Progress(); progress != lastProgress {
+				in.LogPersister.Infof("Deployment %s is %s (%s)", d.ID, d.Status, progress)
+				lastProgress = progress
+			}
+
+		case deploymentActionPromote:
+			in.LogPersister.Infof("All canaries of deployment %s became healthy, promoting them (%s)", d.ID, d.Progress())
+			if err := client.PromoteDeployment(ctx, d.Namespace, d.ID); err != nil {
+				in.LogPersister.Errorf("Failed to promote deployment %s: %v", d.ID, err)
+				return nil, false
+			}
+
+		case deploymentActionCanariesReady:
+			in.LogPersister.Infof("All canaries of deployment %s became healthy (%s)", d.ID, d.Progress())
+			return d, true
+
+		case deploymentActionSucceeded:
+			in.LogPersister.Infof("Deployment %s of job %s completed successfully (%s)", d.ID, job.ID(), d.Progress())
+			return d, true
+
+		case deploymentActionFailed:
+			in.LogPersister.Errorf("Deployment %s of job %s is %s: %s", d.ID, job.ID(), d.Status, d.StatusDescription)
+			return nil, false
+
+		case deploymentActionSuperseded:
+			in.LogPersister.Errorf("Version %d of job %s was superseded by version %d which was registered by someone else", version, job.ID(), d.JobVersion)
+			return nil, false
+		}
+
+		select {
+		case <-ctx.Done():
+			in.LogPersister.Errorf("Stopped waiting for the deployment of job %s: %v", job.ID(), ctx.Err())
+			return nil, false
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/nomad"
+)
+
+func TestDecideDeploymentAction(t *testing.T) {
+	t.Parallel()
+
+	var (
+		placing = map[string]provider.DeploymentState{
+			"web": {DesiredTotal: 2, DesiredCanaries: 1},
+		}
+		canariesHealthy = map[string]provider.DeploymentState{
+			"web": {DesiredTotal: 2, DesiredCanaries: 1, PlacedCanaries: []string{"a1"}, HealthyAllocs: 1},
+		}
+		promoted = map[string]provider.DeploymentState{
+			"web": {DesiredTotal: 2, DesiredCanaries: 1, PlacedCanaries: []string{"a1"}, HealthyAllocs: 1, Promoted: true},
+		}
+	)
+	testcases := []struct {
+		name           string
+		deployment     *provider.Deployment
+		stopAtCanaries bool
+		expected       deploymentAction
+	}{
+		{
+			name:     "no deployment yet",
+			expected: deploymentActionWait,
+		},
+		{
+			name:       "deployment of the previous version",
+			deployment: &provider.Deployment{JobVersion: 2, Status: provider.DeploymentStatusSuccessful},
+			expected:   deploymentActionWait,
+		},
+		{
+			name:       "deployment of a newer version",
+			deployment: &provider.Deployment{JobVersion: 4, Status: provider.DeploymentStatusRunning},
+			expected:   deploymentActionSuperseded,
+		},
+		{
+			name:       "successful",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusSuccessful},
+			expected:   deploymentActionSucceeded,
+		},
+		{
+			name:       "failed",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusFailed},
+			expected:   deploymentActionFailed,
+		},
+		{
+			name:       "cancelled",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusCancelled},
+			expected:   deploymentActionFailed,
+		},
+		{
+			name:       "canaries are being placed",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusRunning, TaskGroups: placing},
+			expected:   deploymentActionWait,
+		},
+		{
+			name:       "promote healthy canaries",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusRunning, TaskGroups: canariesHealthy},
+			expected:   deploymentActionPromote,
+		},
+		{
+			name:           "stop at healthy canaries",
+			deployment:     &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusRunning, TaskGroups: canariesHealthy},
+			stopAtCanaries: true,
+			expected:       deploymentActionCanariesReady,
+		},
+		{
+			name:       "canaries were promoted",
+			deployment: &provider.Deployment{JobVersion: 3, Status: provider.DeploymentStatusRunning, TaskGroups: promoted},
+			expected:   deploymentActionWait,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			action := decideDeploymentAction(tc.deployment, 3, tc.stopAtCanaries)
+			assert.Equal(t, tc.expected, action)
+		})
+	}
+}
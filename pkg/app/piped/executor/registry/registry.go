@@ -20,12 +20,25 @@ import (
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/analysis"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/appengine"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/cloudformation"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/containerapps"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/customsync"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/ec2asg"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/flyio"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/gcemig"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/knative"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/nomad"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/scriptrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/stepfunctions"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/wait"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor/waitapproval"
@@ -114,4 +127,17 @@ func init() {
 	waitapproval.Register(defaultRegistry)
 	customsync.Register(defaultRegistry)
 	scriptrun.Register(defaultRegistry)
+	apprunner.Register(defaultRegistry)
+	cloudformation.Register(defaultRegistry)
+	nomad.Register(defaultRegistry)
+	containerapps.Register(defaultRegistry)
+	appengine.Register(defaultRegistry)
+	stepfunctions.Register(defaultRegistry)
+	ec2asg.Register(defaultRegistry)
+	gcemig.Register(defaultRegistry)
+	azurefunctions.Register(defaultRegistry)
+	staticsite.Register(defaultRegistry)
+	dockercompose.Register(defaultRegistry)
+	knative.Register(defaultRegistry)
+	flyio.Register(defaultRegistry)
 }
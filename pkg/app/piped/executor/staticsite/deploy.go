@@ -0,0 +1,193 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The origin path served by the CDN before this deployment.
+	previousOriginPathMetadataKey = "staticsite-previous-origin-path"
+	// The slot the new version was uploaded to by the green rollout.
+	greenSlotMetadataKey = "staticsite-green-slot"
+	// Whether the CDN has been switched to the new version by this deployment.
+	promotedMetadataKey = "staticsite-promoted"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.StaticSiteApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderStaticSiteConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.StaticSiteApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing StaticSiteApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(ctx, e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create static site client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageStaticSiteSync:
+		status = e.ensureSync(ctx)
+	case model.StageStaticSiteGreenRollout:
+		status = e.ensureGreenRollout(ctx)
+	case model.StageStaticSitePromote:
+		status = e.ensurePromote(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for staticsite application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	m, ok := loadSiteManifest(&e.Input, e.appCfg.Input.SiteManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Without the CDN, the site is uploaded to the served path directly.
+	cdn := m.Spec.CDN
+	if cdn == nil {
+		if !upload(ctx, &e.Input, e.client, e.deploySource.AppDir, m, m.Spec.UploadPrefix("")) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	slot, ok := e.uploadInactiveSlot(ctx, m)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if !e.promote(ctx, *cdn, m.Spec.OriginPath(slot)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureGreenRollout(ctx context.Context) model.StageStatus {
+	m, ok := loadSiteManifest(&e.Input, e.appCfg.Input.SiteManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if m.Spec.CDN == nil {
+		e.LogPersister.Errorf("Unable to run %s stage without cdn in the site manifest", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	slot, ok := e.uploadInactiveSlot(ctx, m)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, greenSlotMetadataKey, slot); err != nil {
+		e.LogPersister.Errorf("Failed to save the green slot to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Infof("The new version can be checked at %s before promoting it", location(m.Spec.Bucket, m.Spec.UploadPrefix(slot)))
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	m, ok := loadSiteManifest(&e.Input, e.appCfg.Input.SiteManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if m.Spec.CDN == nil {
+		e.LogPersister.Errorf("Unable to run %s stage without cdn in the site manifest", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	slot, ok := e.MetadataStore.Shared().Get(greenSlotMetadataKey)
+	if !ok || slot == "" {
+		e.LogPersister.Errorf("Unable to find the slot uploaded by %s stage", model.StageStaticSiteGreenRollout)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.promote(ctx, *m.Spec.CDN, m.Spec.OriginPath(slot)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// uploadInactiveSlot uploads the site to the slot not served by the CDN
+// and records the currently served origin path to be able to switch back to it on rollback.
+func (e *deployExecutor) uploadInactiveSlot(ctx context.Context, m provider.SiteManifest) (string, bool) {
+	current, err := e.client.GetOriginPath(ctx, *m.Spec.CDN)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to get the origin path of the CDN: %v", err)
+		return "", false
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, previousOriginPathMetadataKey, current); err != nil {
+		e.LogPersister.Errorf("Failed to save the origin path to metadata: %v", err)
+		return "", false
+	}
+
+	slot := m.Spec.InactiveSlot(current)
+	e.LogPersister.Infof("The CDN is serving %q, the new version is uploaded to %s slot", current, slot)
+	if !upload(ctx, &e.Input, e.client, e.deploySource.AppDir, m, m.Spec.UploadPrefix(slot)) {
+		return "", false
+	}
+	return slot, true
+}
+
+// promote switches the CDN to the given origin path and records it
+// so that the rollback can switch the CDN back to the previous one.
+func (e *deployExecutor) promote(ctx context.Context, cdn provider.CDN, originPath string) bool {
+	// The switch is recorded first since the CDN may have been switched even if the invalidation failed.
+	if err := e.MetadataStore.Shared().Put(ctx, promotedMetadataKey, "true"); err != nil {
+		e.LogPersister.Errorf("Failed to save the promotion to metadata: %v", err)
+		return false
+	}
+	return switchOrigin(ctx, &e.Input, e.client, cdn, originPath)
+}
@@ -0,0 +1,134 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type rollbackExecutor struct {
+	executor.Input
+}
+
+func (e *rollbackExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	var (
+		ctx            = sig.Context()
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageRollback:
+		status = e.ensureRollback(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for staticsite application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus {
+	targetDS, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	targetCfg := targetDS.ApplicationConfig.StaticSiteApplicationSpec
+	if targetCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing StaticSiteApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadSiteManifest(&e.Input, targetCfg.Input.SiteManifestFile, targetDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	platformProviderName, platformProviderCfg, found := findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	client, err := provider.DefaultRegistry().Client(ctx, platformProviderName, platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create static site client for the provider %s: %v", platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if cdn := m.Spec.CDN; cdn != nil {
+		return e.rollbackOrigin(ctx, client, *cdn)
+	}
+	return e.rollbackUpload(ctx, client)
+}
+
+// rollbackOrigin switches the CDN back to the previous origin path when it has been switched.
+// The served site is not changed until the switch, so there is nothing to roll back otherwise.
+func (e *rollbackExecutor) rollbackOrigin(ctx context.Context, client provider.Client, cdn provider.CDN) model.StageStatus {
+	if promoted, _ := e.MetadataStore.Shared().Get(promotedMetadataKey); promoted != "true" {
+		e.LogPersister.Infof("The CDN was not switched to the new version, there is nothing to rollback")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	previous, ok := e.MetadataStore.Shared().Get(previousOriginPathMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the origin path served before this deployment")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !switchOrigin(ctx, &e.Input, client, cdn, previous) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if err := e.MetadataStore.Shared().Put(ctx, promotedMetadataKey, "false"); err != nil {
+		e.LogPersister.Errorf("Failed to save the promotion to metadata: %v", err)
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// rollbackUpload uploads the site of the last deployed commit again.
+func (e *rollbackExecutor) rollbackUpload(ctx context.Context, client provider.Client) model.StageStatus {
+	// Not rollback in case this is the first deployment.
+	if e.Deployment.RunningCommitHash == "" {
+		e.LogPersister.Errorf("Unable to determine the last deployed commit to rollback. It seems this is the first deployment.")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	runningDS, err := e.RunningDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare running deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	appCfg := runningDS.ApplicationConfig.StaticSiteApplicationSpec
+	if appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing StaticSiteApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	m, ok := loadSiteManifest(&e.Input, appCfg.Input.SiteManifestFile, runningDS)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !upload(ctx, &e.Input, client, runningDS.AppDir, m, m.Spec.UploadPrefix("")) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
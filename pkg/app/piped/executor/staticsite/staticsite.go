@@ -0,0 +1,159 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The number of files uploaded concurrently.
+const uploadConcurrency = 8
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageStaticSiteSync, f)
+	r.Register(model.StageStaticSiteGreenRollout, f)
+	r.Register(model.StageStaticSitePromote, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_STATICSITE, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderStaticSiteConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_STATICSITE)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.StaticSiteConfig
+	found = true
+	return
+}
+
+func loadSiteManifest(in *executor.Input, manifestFile string, ds *deploysource.DeploySource) (provider.SiteManifest, bool) {
+	in.LogPersister.Infof("Loading site manifest at commit %s", ds.Revision)
+
+	m, err := provider.LoadSiteManifest(ds.AppDir, manifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load site manifest (%v)", err)
+		return provider.SiteManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the site manifest at commit %s", ds.Revision)
+	return m, true
+}
+
+// upload makes the objects under the given prefix of the bucket the same as the files of the site.
+// Only the changed files are uploaded and the objects no longer in the site are deleted.
+func upload(ctx context.Context, in *executor.Input, client provider.Client, appDir string, m provider.SiteManifest, prefix string) bool {
+	bucket := m.Spec.Bucket
+
+	files, err := provider.LoadFiles(appDir, m, prefix)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load the files of the site: %v", err)
+		return false
+	}
+
+	// The trailing slash prevents listing the objects of the sibling paths sharing the prefix.
+	listPrefix := prefix
+	if listPrefix != "" {
+		listPrefix += "/"
+	}
+	objects, err := client.ListObjects(ctx, bucket, listPrefix)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to list the objects in bucket %s: %v", bucket, err)
+		return false
+	}
+
+	uploads, deletes := provider.PlanSync(files, objects)
+	in.LogPersister.Infof("Uploading %d of %d files to %s and deleting %d objects", len(uploads), len(files), location(bucket, prefix), len(deletes))
+
+	eg, ctx := errgroup.WithContext(ctx)
+	eg.SetLimit(uploadConcurrency)
+	for _, f := range uploads {
+		f := f
+		eg.Go(func() error {
+			return client.PutObject(ctx, bucket, f)
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		in.LogPersister.Errorf("Failed to upload the files to bucket %s: %v", bucket, err)
+		return false
+	}
+
+	if len(deletes) > 0 {
+		if err := client.DeleteObjects(ctx, bucket, deletes); err != nil {
+			in.LogPersister.Errorf("Failed to delete the objects from bucket %s: %v", bucket, err)
+			return false
+		}
+	}
+
+	in.LogPersister.Successf("Successfully uploaded the site to %s", location(bucket, prefix))
+	return true
+}
+
+// switchOrigin makes the CDN serve the given origin path and invalidates its cache.
+func switchOrigin(ctx context.Context, in *executor.Input, client provider.Client, cdn provider.CDN, originPath string) bool {
+	in.LogPersister.Infof("Switching the origin path of the CDN to %q", originPath)
+	if err := client.UpdateOriginPath(ctx, cdn, originPath); err != nil {
+		in.LogPersister.Errorf("Failed to update the origin path of the CDN: %v", err)
+		return false
+	}
+
+	paths := cdn.Paths()
+	in.LogPersister.Infof("Invalidating the cache of the CDN for %v", paths)
+	if err := client.Invalidate(ctx, cdn, paths); err != nil {
+		in.LogPersister.Errorf("Failed to invalidate the cache of the CDN: %v", err)
+		return false
+	}
+
+	in.LogPersister.Successf("Successfully switched the CDN to serve %q", originPath)
+	return true
+}
+
+// location returns the location of the uploaded site used in the messages.
+func location(bucket, prefix string) string {
+	if prefix == "" {
+		return "bucket " + bucket
+	}
+	return "bucket " + bucket + " at " + prefix
+}
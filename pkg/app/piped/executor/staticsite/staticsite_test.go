@@ -0,0 +1,112 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/staticsite"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+type fakeClient struct {
+	provider.Client
+	mu      sync.Mutex
+	objects map[string]string
+	put     []string
+	deleted []string
+}
+
+func (c *fakeClient) ListObjects(_ context.Context, _, prefix string) (map[string]string, error) {
+	out := make(map[string]string)
+	for k, v := range c.objects {
+		if strings.HasPrefix(k, prefix) {
+			out[k] = v
+		}
+	}
+	return out, nil
+}
+
+func (c *fakeClient) PutObject(_ context.Context, _ string, f provider.File) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.objects[f.Key] = f.MD5
+	c.put = append(c.put, f.Key)
+	return nil
+}
+
+func (c *fakeClient) DeleteObjects(_ context.Context, _ string, keys []string) error {
+	for _, k := range keys {
+		delete(c.objects, k)
+	}
+	c.deleted = append(c.deleted, keys...)
+	return nil
+}
+
+func TestUpload(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "public", "css"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "public", "index.html"), []byte("<html></html>"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "public", "css", "site.css"), []byte("body {}"), 0o644))
+
+	m := provider.SiteManifest{
+		Spec: provider.SiteManifestSpec{
+			Source: "public",
+			Bucket: "docs",
+		},
+	}
+	client := &fakeClient{
+		objects: map[string]string{
+			// Unchanged, the MD5 of "<html></html>".
+			"site/index.html": "c83301425b2ad1d496473a5ff3d9ecca",
+			// Changed.
+			"site/css/site.css": "0",
+			// No longer in the site.
+			"site/old.html": "0",
+			// Not under the prefix.
+			"site-old/index.html": "0",
+		},
+	}
+	in := &executor.Input{LogPersister: &fakeLogPersister{}}
+
+	ok := upload(context.Background(), in, client, appDir, m, "site")
+	require.True(t, ok)
+
+	sort.Strings(client.put)
+	assert.Equal(t, []string{"site/css/site.css"}, client.put)
+	assert.Equal(t, []string{"site/old.html"}, client.deleted)
+	assert.Contains(t, client.objects, "site-old/index.html")
+}
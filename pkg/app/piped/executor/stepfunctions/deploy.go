@@ -0,0 +1,263 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/stepfunctions"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	// The version the alias was routing to before the deployment.
+	primaryVersionMetadataKey = "stepfunctions-primary-version"
+	// The version published by the canary rollout stage.
+	newVersionMetadataKey        = "stepfunctions-new-version"
+	newVersionPercentMetadataKey = "stepfunctions-new-version-percent"
+)
+
+type deployExecutor struct {
+	executor.Input
+
+	deploySource         *deploysource.DeploySource
+	appCfg               *config.StepFunctionsApplicationSpec
+	platformProviderName string
+	platformProviderCfg  *config.PlatformProviderStepFunctionsConfig
+	client               provider.Client
+}
+
+func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
+	ctx := sig.Context()
+	ds, err := e.TargetDSP.GetReadOnly(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare target deploy source data (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.deploySource = ds
+	e.appCfg = ds.ApplicationConfig.StepFunctionsApplicationSpec
+	if e.appCfg == nil {
+		e.LogPersister.Errorf("Malformed application configuration: missing StepFunctionsApplicationSpec")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var found bool
+	e.platformProviderName, e.platformProviderCfg, found = findPlatformProvider(&e.Input)
+	if !found {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.client, err = provider.DefaultRegistry().Client(e.platformProviderName, e.platformProviderCfg, e.Logger)
+	if err != nil {
+		e.LogPersister.Errorf("Unable to create Step Functions client for the provider %s: %v", e.platformProviderName, err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		originalStatus = e.Stage.Status
+		status         model.StageStatus
+	)
+
+	switch model.Stage(e.Stage.Name) {
+	case model.StageStepFunctionsSync:
+		status = e.ensureSync(ctx)
+	case model.StageStepFunctionsCanaryRollout:
+		status = e.ensureCanaryRollout(ctx)
+	case model.StageStepFunctionsPromote:
+		status = e.ensurePromote(ctx)
+	case model.StageStepFunctionsTrafficRouting:
+		status = e.ensureTrafficRouting(ctx)
+	default:
+		e.LogPersister.Errorf("Unsupported stage %s for stepfunctions application", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	return executor.DetermineStageStatus(sig.Signal(), originalStatus, status)
+}
+
+func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
+	sm, ok := loadStateMachineManifest(&e.Input, e.appCfg.Input.StateMachineManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	commitHash := e.Deployment.CommitHash()
+	smArn, versionArn, ok := applyStateMachine(ctx, &e.Input, e.client, sm, commitHash, provider.VersionDescription(commitHash), true)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !routeExecutions(ctx, &e.Input, e.client, smArn, sm.Spec.Alias, makeRoutes("", versionArn, 100)) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCanaryRollout(ctx context.Context) model.StageStatus {
+	sm, ok := loadStateMachineManifest(&e.Input, e.appCfg.Input.StateMachineManifestFile, e.deploySource)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if _, ok := e.savePrimaryVersion(ctx, sm); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// The new version receives no executions through the alias until the promote or traffic routing stage.
+	commitHash := e.Deployment.CommitHash()
+	_, versionArn, ok := applyStateMachine(ctx, &e.Input, e.client, sm, commitHash, provider.VersionDescription(commitHash), true)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := e.MetadataStore.Shared().Put(ctx, newVersionMetadataKey, versionArn); err != nil {
+		e.LogPersister.Errorf("Failed to save the new version to metadata: %v", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensurePromote(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.StepFunctionsPromoteStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, primary, newVersion, ok := e.loadVersions()
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if !e.routeToNewVersion(ctx, sm, primary, newVersion, options.Percent.Int()) {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureTrafficRouting(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.StepFunctionsTrafficRoutingStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	sm, primary, newVersion, ok := e.loadVersions()
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	for i, step := range options.Steps {
+		if !e.routeToNewVersion(ctx, sm, primary, newVersion, step.Int()) {
+			return model.StageStatus_STAGE_FAILURE
+		}
+		if i == len(options.Steps)-1 {
+			break
+		}
+
+		wait := options.Interval.Duration()
+		e.LogPersister.Infof("Waiting %v before the next step", wait)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			e.LogPersister.Info("Traffic routing was interrupted before completing all steps")
+			return model.StageStatus_STAGE_FAILURE
+		case <-timer.C:
+		}
+	}
+
+	e.LogPersister.Successf("Successfully shifted %d percent of executions to the new version", options.Steps[len(options.Steps)-1].Int())
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// loadVersions returns the manifest together with the primary and new versions saved by the canary rollout stage.
+func (e *deployExecutor) loadVersions() (sm provider.StateMachineManifest, primary, newVersion string, ok bool) {
+	sm, ok = loadStateMachineManifest(&e.Input, e.appCfg.Input.StateMachineManifestFile, e.deploySource)
+	if !ok {
+		return
+	}
+
+	newVersion, ok = e.MetadataStore.Shared().Get(newVersionMetadataKey)
+	if !ok {
+		e.LogPersister.Errorf("Unable to find the new version, it must be published by %s stage before", model.StageStepFunctionsCanaryRollout)
+		return
+	}
+	// The primary version is empty when the alias did not exist before the deployment.
+	primary, _ = e.MetadataStore.Shared().Get(primaryVersionMetadataKey)
+	return
+}
+
+// routeToNewVersion routes the given percentage of the executions through the alias to the new version.
+func (e *deployExecutor) routeToNewVersion(ctx context.Context, sm provider.StateMachineManifest, primary, newVersion string, percent int) bool {
+	live, err := e.client.FindStateMachine(ctx, sm.Spec.Name)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to find state machine %s: %v", sm.Spec.Name, err)
+		return false
+	}
+
+	metadata := map[string]string{
+		newVersionPercentMetadataKey: strconv.Itoa(percent),
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).PutMulti(ctx, metadata); err != nil {
+		e.Logger.Error("failed to save routing percentages to metadata", zap.Error(err))
+	}
+
+	return routeExecutions(ctx, &e.Input, e.client, live.StateMachineArn, sm.Spec.Alias, makeRoutes(primary, newVersion, percent))
+}
+
+// savePrimaryVersion returns the version which the alias was routing the most executions to before the deployment.
+// It is saved to the shared metadata so that the following stages and the rollback
+// can use it even after the routing has been changed.
+// Empty is returned when the state machine or its alias does not exist yet.
+func (e *deployExecutor) savePrimaryVersion(ctx context.Context, sm provider.StateMachineManifest) (string, bool) {
+	if primary, ok := e.MetadataStore.Shared().Get(primaryVersionMetadataKey); ok {
+		return primary, true
+	}
+
+	live, err := e.client.FindStateMachine(ctx, sm.Spec.Name)
+	if err != nil {
+		if errors.Is(err, provider.ErrNotFound) {
+			return "", true
+		}
+		e.LogPersister.Errorf("Failed to find state machine %s: %v", sm.Spec.Name, err)
+		return "", false
+	}
+	alias, err := findAlias(ctx, e.client, provider.AliasArn(live.StateMachineArn, sm.Spec.Alias))
+	if err != nil {
+		e.LogPersister.Errorf("Failed to find alias %s: %v", sm.Spec.Alias, err)
+		return "", false
+	}
+	if alias == nil {
+		return "", true
+	}
+
+	primary := primaryVersion(alias)
+	if err := e.MetadataStore.Shared().Put(ctx, primaryVersionMetadataKey, primary); err != nil {
+		e.LogPersister.Errorf("Failed to save the primary version to metadata: %v", err)
+		return "", false
+	}
+	e.LogPersister.Infof("Alias %s was routing the executions to version %s before the deployment", sm.Spec.Alias, primary)
+	return primary, true
+}
@@ -0,0 +1,199 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"context"
+	"errors"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/stepfunctions"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type registerer interface {
+	Register(stage model.Stage, f executor.Factory) error
+	RegisterRollback(kind model.RollbackKind, f executor.Factory) error
+}
+
+func Register(r registerer) {
+	f := func(in executor.Input) executor.Executor {
+		return &deployExecutor{
+			Input: in,
+		}
+	}
+	r.Register(model.StageStepFunctionsSync, f)
+	r.Register(model.StageStepFunctionsCanaryRollout, f)
+	r.Register(model.StageStepFunctionsPromote, f)
+	r.Register(model.StageStepFunctionsTrafficRouting, f)
+
+	r.RegisterRollback(model.RollbackKind_Rollback_STEPFUNCTIONS, func(in executor.Input) executor.Executor {
+		return &rollbackExecutor{
+			Input: in,
+		}
+	})
+}
+
+func findPlatformProvider(in *executor.Input) (name string, cfg *config.PlatformProviderStepFunctionsConfig, found bool) {
+	name = in.Application.PlatformProvider
+	if name == "" {
+		in.LogPersister.Errorf("Missing the PlatformProvider name in the application configuration")
+		return
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(name, model.ApplicationKind_STEPFUNCTIONS)
+	if !ok {
+		in.LogPersister.Errorf("The specified platform provider %q was not found in piped configuration", name)
+		return
+	}
+
+	cfg = cp.StepFunctionsConfig
+	found = true
+	return
+}
+
+func loadStateMachineManifest(in *executor.Input, manifestFile string, ds *deploysource.DeploySource) (provider.StateMachineManifest, bool) {
+	in.LogPersister.Infof("Loading state machine manifest at commit %s", ds.Revision)
+
+	sm, err := provider.LoadStateMachineManifest(ds.AppDir, manifestFile)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to load state machine manifest (%v)", err)
+		return provider.StateMachineManifest{}, false
+	}
+
+	in.LogPersister.Infof("Successfully loaded the state machine manifest at commit %s", ds.Revision)
+	return sm, true
+}
+
+// applyStateMachine creates or updates the state machine to the given manifest of the given commit.
+// When publish is true, a new version is published and its ARN is returned.
+// Otherwise only the $LATEST revision is updated and the returned version ARN is empty.
+func applyStateMachine(ctx context.Context, in *executor.Input, client provider.Client, sm provider.StateMachineManifest, commitHash, versionDescription string, publish bool) (stateMachineArn, versionArn string, ok bool) {
+	name := sm.Spec.Name
+	tags := map[string]string{
+		provider.LabelManagedBy:   provider.ManagedByPiped,
+		provider.LabelPiped:       in.PipedConfig.PipedID,
+		provider.LabelApplication: in.Deployment.ApplicationId,
+		provider.LabelCommitHash:  commitHash,
+	}
+	sm.AddTags(tags)
+
+	in.LogPersister.Infof("Applying the state machine manifest of %s", name)
+	live, err := client.FindStateMachine(ctx, name)
+	switch {
+	case errors.Is(err, provider.ErrNotFound):
+		stateMachineArn, versionArn, err = client.CreateStateMachine(ctx, sm, publish, versionDescription)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to create state machine %s: %v", name, err)
+			return "", "", false
+		}
+		in.LogPersister.Infof("Created state machine %s", name)
+
+	case err != nil:
+		in.LogPersister.Errorf("Failed to find state machine %s: %v", name, err)
+		return "", "", false
+
+	default:
+		if live.Type != sm.Spec.Type {
+			in.LogPersister.Errorf("Unable to change the type of state machine %s from %s to %s, please delete it to create it again", name, live.Type, sm.Spec.Type)
+			return "", "", false
+		}
+		stateMachineArn = live.StateMachineArn
+		versionArn, err = client.UpdateStateMachine(ctx, stateMachineArn, sm, publish, versionDescription)
+		if err != nil {
+			in.LogPersister.Errorf("Failed to update state machine %s: %v", name, err)
+			return "", "", false
+		}
+		// The tags are not updated by UpdateStateMachine.
+		if err := client.TagResource(ctx, stateMachineArn, tags); err != nil {
+			in.LogPersister.Errorf("Failed to update the tags of state machine %s: %v", name, err)
+			return "", "", false
+		}
+		in.LogPersister.Infof("Updated state machine %s", name)
+	}
+
+	if publish {
+		in.LogPersister.Infof("Published version %s of state machine %s", versionArn, name)
+	}
+	return stateMachineArn, versionArn, true
+}
+
+// findAlias returns the alias having the given ARN or nil when there is no such alias.
+func findAlias(ctx context.Context, client provider.Client, aliasArn string) (*provider.Alias, error) {
+	alias, err := client.DescribeAlias(ctx, aliasArn)
+	if errors.Is(err, provider.ErrNotFound) {
+		return nil, nil
+	}
+	return alias, err
+}
+
+// primaryVersion returns the version receiving the most executions of the given alias.
+func primaryVersion(alias *provider.Alias) string {
+	var (
+		version string
+		weight  = -1
+	)
+	for _, r := range alias.RoutingConfiguration {
+		if r.Weight > weight {
+			version, weight = r.StateMachineVersionArn, r.Weight
+		}
+	}
+	return version
+}
+
+// makeRoutes returns the routing configuration routing the given percentage of executions
+// to the new version and the rest to the primary version.
+// The routes having no weight are omitted since the weights must be positive.
+func makeRoutes(primaryVersionArn, newVersionArn string, newPercent int) []provider.Route {
+	if primaryVersionArn == "" || primaryVersionArn == newVersionArn || newPercent >= 100 {
+		return []provider.Route{{StateMachineVersionArn: newVersionArn, Weight: 100}}
+	}
+	if newPercent <= 0 {
+		return []provider.Route{{StateMachineVersionArn: primaryVersionArn, Weight: 100}}
+	}
+	return []provider.Route{
+		{StateMachineVersionArn: newVersionArn, Weight: newPercent},
+		{StateMachineVersionArn: primaryVersionArn, Weight: 100 - newPercent},
+	}
+}
+
+// routeExecutions updates the alias of the state machine to the given routes.
+// The alias is created when it does not exist yet.
+func routeExecutions(ctx context.Context, in *executor.Input, client provider.Client, stateMachineArn, aliasName string, routes []provider.Route) bool {
+	aliasArn := provider.AliasArn(stateMachineArn, aliasName)
+	alias, err := findAlias(ctx, client, aliasArn)
+	if err != nil {
+		in.LogPersister.Errorf("Failed to find alias %s: %v", aliasArn, err)
+		return false
+	}
+
+	if alias == nil {
+		if _, err := client.CreateAlias(ctx, aliasName, routes); err != nil {
+			in.LogPersister.Errorf("Failed to create alias %s: %v", aliasName, err)
+			return false
+		}
+		in.LogPersister.Infof("Created alias %s", aliasName)
+	} else if err := client.UpdateAlias(ctx, aliasArn, routes); err != nil {
+		in.LogPersister.Errorf("Failed to update alias %s: %v", aliasName, err)
+		return false
+	}
+
+	for _, r := range routes {
+		in.LogPersister.Infof("Alias %s routes %d%% of executions to version %s", aliasName, r.Weight, r.StateMachineVersionArn)
+	}
+	return true
+}
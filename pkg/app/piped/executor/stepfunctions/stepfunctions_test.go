@@ -0,0 +1,103 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/stepfunctions"
+)
+
+func TestMakeRoutes(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		primary    string
+		newVersion string
+		percent    int
+		expected   []provider.Route
+	}{
+		{
+			name:       "no primary version",
+			newVersion: "arn:hello:2",
+			percent:    10,
+			expected: []provider.Route{
+				{StateMachineVersionArn: "arn:hello:2", Weight: 100},
+			},
+		},
+		{
+			name:       "same version",
+			primary:    "arn:hello:2",
+			newVersion: "arn:hello:2",
+			percent:    10,
+			expected: []provider.Route{
+				{StateMachineVersionArn: "arn:hello:2", Weight: 100},
+			},
+		},
+		{
+			name:       "split executions",
+			primary:    "arn:hello:1",
+			newVersion: "arn:hello:2",
+			percent:    10,
+			expected: []provider.Route{
+				{StateMachineVersionArn: "arn:hello:2", Weight: 10},
+				{StateMachineVersionArn: "arn:hello:1", Weight: 90},
+			},
+		},
+		{
+			name:       "no executions to new version",
+			primary:    "arn:hello:1",
+			newVersion: "arn:hello:2",
+			percent:    0,
+			expected: []provider.Route{
+				{StateMachineVersionArn: "arn:hello:1", Weight: 100},
+			},
+		},
+		{
+			name:       "all executions to new version",
+			primary:    "arn:hello:1",
+			newVersion: "arn:hello:2",
+			percent:    100,
+			expected: []provider.Route{
+				{StateMachineVersionArn: "arn:hello:2", Weight: 100},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := makeRoutes(tc.primary, tc.newVersion, tc.percent)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestPrimaryVersion(t *testing.T) {
+	t.Parallel()
+
+	alias := &provider.Alias{
+		RoutingConfiguration: []provider.Route{
+			{StateMachineVersionArn: "arn:hello:2", Weight: 30},
+			{StateMachineVersionArn: "arn:hello:1", Weight: 70},
+		},
+	}
+	assert.Equal(t, "arn:hello:1", primaryVersion(alias))
+	assert.Equal(t, "", primaryVersion(&provider.Alias{}))
+}
@@ -16,6 +16,13 @@ package terraform
 
 import (
 	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
@@ -23,14 +30,28 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// The key of the stage metadata to store the structured summary of terraform plan.
+// The value is used by web to render the resource-level diff.
+const planSummaryMetadataKey = "terraform-plan-summary"
+
+// The name of the file to save the plan produced by TERRAFORM_PLAN stage
+// in the working directory shared by all stages of the deployment.
+const planFileName = "terraform.tfplan"
+
+// The key of the stage metadata to store the estimated difference of the monthly cost.
+const monthlyCostDiffMetadataKey = "monthly-cost-diff"
+
 type deployExecutor struct {
 	executor.Input
 
-	repoDir       string
-	appDir        string
-	vars          []string
-	terraformPath string
-	appCfg        *config.TerraformApplicationSpec
+	repoDir        string
+	appDir         string
+	vars           []string
+	terraformPath  string
+	terragruntPath string
+	secretVarEnvs  []string
+	backendConfig  map[string]string
+	appCfg         *config.TerraformApplicationSpec
 }
 
 func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
@@ -59,13 +80,28 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	e.vars = append(e.vars, providerCfg.Vars...)
 	e.vars = append(e.vars, e.appCfg.Input.Vars...)
 
+	e.secretVarEnvs, err = makeSecretVarEnvs(e.appCfg, e.SecretDecrypter)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the secret variables (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.backendConfig, err = renderBackendConfig(e.appCfg.Input, e.Deployment)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to render the backend configuration (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	var (
 		originalStatus = e.Stage.Status
 		status         model.StageStatus
 	)
 
 	var ok bool
-	e.terraformPath, ok = findTerraform(ctx, e.appCfg.Input.TerraformVersion, e.LogPersister)
+	e.terraformPath, ok = findTerraform(ctx, e.appCfg.Input, e.appDir, providerCfg, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	e.terragruntPath, ok = findTerragrunt(ctx, e.appCfg.Input, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -80,6 +116,18 @@ func (e *deployExecutor) Execute(sig executor.StopSignal) model.StageStatus {
 	case model.StageTerraformApply:
 		status = e.ensureApply(ctx)
 
+	case model.StageTerraformCostEstimation:
+		status = e.ensureCostEstimation(ctx)
+
+	case model.StageTerraformPolicyCheck:
+		status = e.ensurePolicyCheck(ctx)
+
+	case model.StageTerraformImport:
+		status = e.ensureImport(ctx)
+
+	case model.StageTerraformDestroy:
+		status = e.ensureDestroy(ctx)
+
 	default:
 		e.LogPersister.Errorf("Unsupported stage %s for terraform application", e.Stage.Name)
 		return model.StageStatus_STAGE_FAILURE
@@ -99,6 +147,9 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
 		)
 	)
 
@@ -127,8 +178,9 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 	}
 
 	e.LogPersister.Infof("Detected %d import, %d add, %d change, %d destroy. Those changes will be applied automatically.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
+	e.savePlanSummary(ctx, planResult.Summary)
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	if err := e.runWithStateLock(ctx, func(w io.Writer) error { return cmd.Apply(ctx, w) }); err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -139,18 +191,32 @@ func (e *deployExecutor) ensureSync(ctx context.Context) model.StageStatus {
 
 func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 	var (
-		flags = e.appCfg.Input.CommandFlags
-		envs  = e.appCfg.Input.CommandEnvs
-		cmd   = provider.NewTerraform(
+		targets = e.StageConfig.TerraformPlanStageOptions.Targets
+		flags   = e.appCfg.Input.CommandFlags
+		envs    = e.appCfg.Input.CommandEnvs
+		cmd     = provider.NewTerraform(
 			e.terraformPath,
 			e.appDir,
 			provider.WithVars(e.vars),
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
+			provider.WithTargets(targets),
+			provider.WithPlanOut(e.planFilePath()),
+			provider.WithDestroy(e.isDestroying()),
 		)
 	)
 
+	if len(targets) > 0 {
+		e.LogPersister.Infof("Planning only the targeted resources: %s", strings.Join(targets, ", "))
+	}
+	if e.isDestroying() {
+		e.LogPersister.Info("Planning to destroy all resources managed by the application")
+	}
+
 	if ok := showUsingVersion(ctx, cmd, e.LogPersister); !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -175,14 +241,90 @@ func (e *deployExecutor) ensurePlan(ctx context.Context) model.StageStatus {
 		if e.StageConfig.TerraformPlanStageOptions.ExitOnNoChanges {
 			return model.StageStatus_STAGE_EXITED
 		}
+		if e.hasOnlyNoopStagesAfter() {
+			e.LogPersister.Info("The remaining stages will be skipped since they have nothing to do without changes")
+			return model.StageStatus_STAGE_EXITED
+		}
 		return model.StageStatus_STAGE_SUCCESS
 	}
 
+	e.savePlanSummary(ctx, planResult.Summary)
 	e.LogPersister.Successf("Detected %d import, %d add, %d change, %d destroy.", planResult.Imports, planResult.Adds, planResult.Changes, planResult.Destroys)
 	return model.StageStatus_STAGE_SUCCESS
 }
 
 func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
+	var (
+		targets = e.StageConfig.TerraformApplyStageOptions.Targets
+		flags   = e.appCfg.Input.CommandFlags
+		envs    = e.appCfg.Input.CommandEnvs
+		cmd     = provider.NewTerraform(
+			e.terraformPath,
+			e.appDir,
+			provider.WithVars(e.vars),
+			provider.WithVarFiles(e.appCfg.Input.VarFiles),
+			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
+			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
+			provider.WithTargets(targets),
+		)
+	)
+
+	if len(targets) > 0 {
+		e.LogPersister.Infof("Applying only the targeted resources: %s", strings.Join(targets, ", "))
+	}
+
+	if ok := showUsingVersion(ctx, cmd, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := cmd.Init(ctx, e.LogPersister); err != nil {
+		e.LogPersister.Errorf("Failed to init (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if ok := selectWorkspace(ctx, cmd, e.appCfg.Input.Workspace, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if e.StageConfig.TerraformApplyStageOptions.UsePlanFile {
+		planFile := e.planFilePath()
+		if _, err := os.Stat(planFile); err != nil {
+			e.LogPersister.Errorf("Unable to find the plan saved by TERRAFORM_PLAN stage (%v). TERRAFORM_PLAN stage must be executed before this stage in the same deployment", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Info("Applying the changes saved by TERRAFORM_PLAN stage")
+		if err := e.runWithStateLock(ctx, func(w io.Writer) error { return cmd.ApplyPlan(ctx, w, planFile) }); err != nil {
+			e.LogPersister.Errorf("Failed to apply changes (%v)", err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		e.LogPersister.Success("Successfully applied changes")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	if err := e.runWithStateLock(ctx, func(w io.Writer) error { return cmd.Apply(ctx, w) }); err != nil {
+		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully applied changes")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) ensureCostEstimation(ctx context.Context) model.StageStatus {
+	opts := e.StageConfig.TerraformCostEstimationStageOptions
+	if opts == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	infracostPath, ok := findInfracost(ctx, opts.InfracostVersion, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	var (
 		flags = e.appCfg.Input.CommandFlags
 		envs  = e.appCfg.Input.CommandEnvs
@@ -193,6 +335,9 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 			provider.WithVarFiles(e.appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
 		)
 	)
 
@@ -209,11 +354,152 @@ func (e *deployExecutor) ensureApply(ctx context.Context) model.StageStatus {
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
-		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
+	planResult, err := cmd.Plan(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to plan (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	e.LogPersister.Success("Successfully applied changes")
+	if planResult.NoChanges() {
+		e.LogPersister.Success("No changes to estimate the cost")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	if planResult.PlanJSON == nil {
+		e.LogPersister.Error("Unable to estimate the cost because the plan could not be converted to JSON")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Info("Start estimating the monthly cost of the changes by infracost")
+	estimate, err := provider.NewInfracost(infracostPath, e.appDir, envs.Shared).Breakdown(ctx, planResult.PlanJSON)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to estimate the cost (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := e.MetadataStore.Stage(e.Stage.Id).Put(ctx, monthlyCostDiffMetadataKey, estimate.FormatDiff()); err != nil {
+		e.Logger.Error("failed to store metadata", zap.Error(err))
+	}
+	e.LogPersister.Infof("Estimated monthly cost: %s", estimate)
+
+	if max := opts.MaxMonthlyCostIncrease; max > 0 && estimate.DiffMonthlyCost > max {
+		e.LogPersister.Errorf("The increase of the monthly cost %.2f %s exceeds the allowed maximum %.2f %s", estimate.DiffMonthlyCost, estimate.Currency, max, estimate.Currency)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully estimated the cost of the changes")
 	return model.StageStatus_STAGE_SUCCESS
 }
+
+// planFilePath returns the path to save the plan of TERRAFORM_PLAN stage.
+// Empty is returned when there is no directory shared between stages.
+func (e *deployExecutor) planFilePath() string {
+	if e.WorkingDir == "" {
+		return ""
+	}
+	return filepath.Join(e.WorkingDir, planFileName)
+}
+
+func (e *deployExecutor) savePlanSummary(ctx context.Context, summary *provider.PlanSummary) {
+	if summary == nil {
+		return
+	}
+	value, err := json.Marshal(summary)
+	if err != nil {
+		e.Logger.Error("failed to marshal terraform plan summary", zap.Error(err))
+		return
+	}
+	if err := e.MetadataStore.Stage(e.Stage.Id).Put(ctx, planSummaryMetadataKey, string(value)); err != nil {
+		e.Logger.Error("failed to store metadata", zap.Error(err))
+	}
+}
+
+// The stages which have nothing to do when the plan has no changes.
+var noopStagesWithoutChanges = map[model.Stage]struct{}{
+	model.StageTerraformApply:          {},
+	model.StageTerraformDestroy:        {},
+	model.StageTerraformCostEstimation: {},
+	model.StageTerraformPolicyCheck:    {},
+	model.StageWaitApproval:            {},
+}
+
+// hasOnlyNoopStagesAfter reports whether all the stages after the running one
+// have nothing to do when the plan has no changes, e.g. applying or waiting for an approval.
+func (e *deployExecutor) hasOnlyNoopStagesAfter() bool {
+	var found bool
+	for _, s := range e.Deployment.Stages {
+		if s.Id == e.Stage.Id {
+			found = true
+			continue
+		}
+		if !found || !s.Visible || s.Name == model.StageRollback.String() {
+			continue
+		}
+		if _, ok := noopStagesWithoutChanges[model.Stage(s.Name)]; !ok {
+			return false
+		}
+	}
+	return found
+}
+
+// isDestroying reports whether the running deployment was triggered to destroy the resources.
+func (e *deployExecutor) isDestroying() bool {
+	return e.Deployment.Trigger.SyncStrategy == model.SyncStrategy_DESTROY
+}
+
+func (e *deployExecutor) ensureDestroy(ctx context.Context) model.StageStatus {
+	if !e.isDestroying() {
+		e.LogPersister.Errorf("%s stage can be run only by the destroy deployment", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		flags = e.appCfg.Input.CommandFlags
+		envs  = e.appCfg.Input.CommandEnvs
+		cmd   = provider.NewTerraform(
+			e.terraformPath,
+			e.appDir,
+			provider.WithVars(e.vars),
+			provider.WithVarFiles(e.appCfg.Input.VarFiles),
+			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
+			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
+		)
+	)
+
+	if ok := showUsingVersion(ctx, cmd, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := cmd.Init(ctx, e.LogPersister); err != nil {
+		e.LogPersister.Errorf("Failed to init (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if ok := selectWorkspace(ctx, cmd, e.appCfg.Input.Workspace, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	// Only the destroy plan approved in the previous stages is applied
+	// so that the resources added after the approval are never destroyed.
+	planFile := e.planFilePath()
+	if _, err := os.Stat(planFile); err != nil {
+		e.LogPersister.Errorf("Unable to find the destroy plan saved by TERRAFORM_PLAN stage (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Info("Destroying the resources in the plan saved by TERRAFORM_PLAN stage")
+	if err := e.runWithStateLock(ctx, func(w io.Writer) error { return cmd.ApplyPlan(ctx, w, planFile) }); err != nil {
+		e.LogPersister.Errorf("Failed to destroy resources (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("Successfully destroyed all resources")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+func (e *deployExecutor) runWithStateLock(ctx context.Context, run func(w io.Writer) error) error {
+	key := stateKey(e.Deployment, e.appCfg.Input.Workspace, e.backendConfig)
+	return runWithStateLock(ctx, e.LogPersister, e.Deployment.Id, key, e.appCfg.Input.StateLockTimeout.Duration(), run)
+}
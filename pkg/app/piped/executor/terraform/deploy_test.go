@@ -0,0 +1,84 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestHasOnlyNoopStagesAfter(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		stages   []*model.PipelineStage
+		expected bool
+	}{
+		{
+			name: "approval and apply remain",
+			stages: []*model.PipelineStage{
+				{Id: "plan", Name: model.StageTerraformPlan.String(), Visible: true},
+				{Id: "approval", Name: model.StageWaitApproval.String(), Visible: true},
+				{Id: "apply", Name: model.StageTerraformApply.String(), Visible: true},
+				{Id: "rollback", Name: model.StageRollback.String()},
+			},
+			expected: true,
+		},
+		{
+			name: "the last stage",
+			stages: []*model.PipelineStage{
+				{Id: "plan", Name: model.StageTerraformPlan.String(), Visible: true},
+			},
+			expected: true,
+		},
+		{
+			name: "script run remains",
+			stages: []*model.PipelineStage{
+				{Id: "plan", Name: model.StageTerraformPlan.String(), Visible: true},
+				{Id: "apply", Name: model.StageTerraformApply.String(), Visible: true},
+				{Id: "script", Name: model.StageScriptRun.String(), Visible: true},
+			},
+			expected: false,
+		},
+		{
+			name: "stages before the running one are ignored",
+			stages: []*model.PipelineStage{
+				{Id: "import", Name: model.StageTerraformImport.String(), Visible: true},
+				{Id: "plan", Name: model.StageTerraformPlan.String(), Visible: true},
+				{Id: "apply", Name: model.StageTerraformApply.String(), Visible: true},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			e := &deployExecutor{
+				Input: executor.Input{
+					Deployment: &model.Deployment{Stages: tc.stages},
+					Stage:      &model.PipelineStage{Id: "plan"},
+				},
+			}
+			assert.Equal(t, tc.expected, e.hasOnlyNoopStagesAfter())
+		})
+	}
+}
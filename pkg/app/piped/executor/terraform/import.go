@@ -0,0 +1,87 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"io"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensureImport(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.TerraformImportStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	var (
+		flags = e.appCfg.Input.CommandFlags
+		envs  = e.appCfg.Input.CommandEnvs
+		cmd   = provider.NewTerraform(
+			e.terraformPath,
+			e.appDir,
+			provider.WithVars(e.vars),
+			provider.WithVarFiles(e.appCfg.Input.VarFiles),
+			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
+			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
+		)
+	)
+
+	if ok := showUsingVersion(ctx, cmd, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := cmd.Init(ctx, e.LogPersister); err != nil {
+		e.LogPersister.Errorf("Failed to init (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if ok := selectWorkspace(ctx, cmd, e.appCfg.Input.Workspace, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	managed, err := cmd.ListState(ctx)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to list the resources in state (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	managedAddresses := make(map[string]struct{}, len(managed))
+	for _, a := range managed {
+		managedAddresses[a] = struct{}{}
+	}
+
+	var imported int
+	for _, r := range options.Resources {
+		if _, ok := managedAddresses[r.Address]; ok {
+			e.LogPersister.Infof("Skipped importing %s because it is already managed in state", r.Address)
+			continue
+		}
+		e.LogPersister.Infof("Importing %s as %s", r.ID, r.Address)
+		if err := e.runWithStateLock(ctx, func(w io.Writer) error { return cmd.Import(ctx, w, r.Address, r.ID) }); err != nil {
+			e.LogPersister.Errorf("Failed to import %s (%v)", r.Address, err)
+			return model.StageStatus_STAGE_FAILURE
+		}
+		imported++
+	}
+
+	e.LogPersister.Successf("Successfully imported %d resource(s)", imported)
+	return model.StageStatus_STAGE_SUCCESS
+}
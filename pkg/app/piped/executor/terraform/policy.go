@@ -0,0 +1,120 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (e *deployExecutor) ensurePolicyCheck(ctx context.Context) model.StageStatus {
+	options := e.StageConfig.TerraformPolicyCheckStageOptions
+	if options == nil {
+		e.LogPersister.Errorf("Malformed configuration for stage %s", e.Stage.Name)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	policyDirs := e.findPolicyDirs(options.Policies)
+	if len(policyDirs) == 0 {
+		e.LogPersister.Info("There are no policies to check")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+
+	var (
+		flags = e.appCfg.Input.CommandFlags
+		envs  = e.appCfg.Input.CommandEnvs
+		cmd   = provider.NewTerraform(
+			e.terraformPath,
+			e.appDir,
+			provider.WithVars(e.vars),
+			provider.WithVarFiles(e.appCfg.Input.VarFiles),
+			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
+			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(e.secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(e.terragruntPath),
+			provider.WithBackendConfig(e.backendConfig),
+		)
+	)
+
+	if ok := showUsingVersion(ctx, cmd, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if err := cmd.Init(ctx, e.LogPersister); err != nil {
+		e.LogPersister.Errorf("Failed to init (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if ok := selectWorkspace(ctx, cmd, e.appCfg.Input.Workspace, e.LogPersister); !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	planResult, err := cmd.Plan(ctx, e.LogPersister)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to plan (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	if planResult.NoChanges() {
+		e.LogPersister.Success("No changes to check")
+		return model.StageStatus_STAGE_SUCCESS
+	}
+	if planResult.PlanJSON == nil {
+		e.LogPersister.Error("Unable to check the policies because the plan could not be converted to JSON")
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	path, installed, err := toolregistry.DefaultRegistry().Conftest(ctx, "")
+	if err != nil {
+		e.LogPersister.Errorf("Unable to find conftest (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	if installed {
+		e.LogPersister.Info("conftest has just been installed because of no pre-installed binary")
+	}
+
+	e.LogPersister.Infof("Start checking the plan against the policies in %s", strings.Join(policyDirs, ", "))
+	out, err := provider.NewConftest(path, e.Logger).Test(ctx, planResult.PlanJSON, policyDirs)
+	if out != "" {
+		e.LogPersister.Info(out)
+	}
+	if err != nil {
+		e.LogPersister.Errorf("Found changes violating the policies (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
+	e.LogPersister.Success("All changes satisfy the policies")
+	return model.StageStatus_STAGE_SUCCESS
+}
+
+// findPolicyDirs returns the list of directories containing the policies to check.
+// They are the ones specified in the stage options and the ones configured for the platform provider.
+func (e *deployExecutor) findPolicyDirs(policies []string) []string {
+	dirs := make([]string, 0, len(policies))
+	for _, p := range policies {
+		dirs = append(dirs, filepath.Join(e.appDir, p))
+	}
+
+	cp, ok := e.PipedConfig.FindPlatformProvider(e.Deployment.PlatformProvider, model.ApplicationKind_TERRAFORM)
+	if ok && cp.TerraformConfig != nil {
+		dirs = append(dirs, cp.TerraformConfig.PolicyDirs...)
+	}
+	return dirs
+}
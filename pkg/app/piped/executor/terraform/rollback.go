@@ -16,6 +16,7 @@ package terraform
 
 import (
 	"context"
+	"io"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
@@ -69,7 +70,11 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	terraformPath, ok := findTerraform(ctx, appCfg.Input.TerraformVersion, e.LogPersister)
+	terraformPath, ok := findTerraform(ctx, appCfg.Input, ds.AppDir, providerCfg, e.LogPersister)
+	if !ok {
+		return model.StageStatus_STAGE_FAILURE
+	}
+	terragruntPath, ok := findTerragrunt(ctx, appCfg.Input, e.LogPersister)
 	if !ok {
 		return model.StageStatus_STAGE_FAILURE
 	}
@@ -78,6 +83,17 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 	vars = append(vars, providerCfg.Vars...)
 	vars = append(vars, appCfg.Input.Vars...)
 
+	secretVarEnvs, err := makeSecretVarEnvs(appCfg, e.SecretDecrypter)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to prepare the secret variables (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+	backendConfig, err := renderBackendConfig(appCfg.Input, e.Deployment)
+	if err != nil {
+		e.LogPersister.Errorf("Failed to render the backend configuration (%v)", err)
+		return model.StageStatus_STAGE_FAILURE
+	}
+
 	e.LogPersister.Infof("Start rolling back to the state defined at commit %s", e.Deployment.RunningCommitHash)
 	var (
 		flags = appCfg.Input.CommandFlags
@@ -89,6 +105,9 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 			provider.WithVarFiles(appCfg.Input.VarFiles),
 			provider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 			provider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+			provider.WithAdditionalEnvs(secretVarEnvs, nil, nil, nil),
+			provider.WithTerragrunt(terragruntPath),
+			provider.WithBackendConfig(backendConfig),
 		)
 	)
 
@@ -105,7 +124,11 @@ func (e *rollbackExecutor) ensureRollback(ctx context.Context) model.StageStatus
 		return model.StageStatus_STAGE_FAILURE
 	}
 
-	if err := cmd.Apply(ctx, e.LogPersister); err != nil {
+	var (
+		key     = stateKey(e.Deployment, appCfg.Input.Workspace, backendConfig)
+		timeout = appCfg.Input.StateLockTimeout.Duration()
+	)
+	if err := runWithStateLock(ctx, e.LogPersister, e.Deployment.Id, key, timeout, func(w io.Writer) error { return cmd.Apply(ctx, w) }); err != nil {
 		e.LogPersister.Errorf("Failed to apply changes (%v)", err)
 		return model.StageStatus_STAGE_FAILURE
 	}
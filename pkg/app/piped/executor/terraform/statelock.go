@@ -0,0 +1,179 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/executor"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const defaultStateLockTimeout = 10 * time.Minute
+
+var stateLockRetryInterval = 10 * time.Second
+
+// stateLockQueue orders the deployments of this piped waiting for the same state lock,
+// so that they acquire the lock in the order they started waiting.
+type stateLockQueue struct {
+	waiters map[string][]string
+	mu      sync.Mutex
+}
+
+var defaultStateLockQueue = &stateLockQueue{
+	waiters: make(map[string][]string),
+}
+
+func (q *stateLockQueue) enqueue(path, id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for _, w := range q.waiters[path] {
+		if w == id {
+			return
+		}
+	}
+	q.waiters[path] = append(q.waiters[path], id)
+}
+
+// position returns the 1-based position of the given waiter in the queue.
+func (q *stateLockQueue) position(path, id string) int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i, w := range q.waiters[path] {
+		if w == id {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (q *stateLockQueue) dequeue(path, id string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	waiters := q.waiters[path]
+	for i, w := range waiters {
+		if w == id {
+			waiters = append(waiters[:i], waiters[i+1:]...)
+			break
+		}
+	}
+	if len(waiters) == 0 {
+		delete(q.waiters, path)
+		return
+	}
+	q.waiters[path] = waiters
+}
+
+// stateKey returns the identity of the state used by the given deployment.
+// It is built from the application directory, the workspace and the backend configuration
+// since they do not change while the lock of the state is passed between operations.
+func stateKey(d *model.Deployment, workspace string, backendConfig map[string]string) string {
+	if workspace == "" {
+		workspace = "default"
+	}
+	keys := make([]string, 0, len(backendConfig))
+	for k := range backendConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s/%s@%s", d.GetGitPath().GetRepo().GetId(), d.GetGitPath().GetPath(), workspace)
+	for _, k := range keys {
+		fmt.Fprintf(&b, ",%s=%s", k, backendConfig[k])
+	}
+	return b.String()
+}
+
+// runWithStateLock runs the given terraform command and retries it when the state lock is held by another operation.
+// While waiting, the deployment is queued with the other deployments of this piped waiting for the same lock,
+// and only the head of the queue retries. An error is returned when the lock was not acquired within the timeout.
+// The queue is keyed by the path of the state reported by the backend, or by the given state key
+// when the backend does not report it.
+func runWithStateLock(ctx context.Context, lp executor.LogPersister, id, key string, timeout time.Duration, run func(w io.Writer) error) error {
+	if timeout <= 0 {
+		timeout = defaultStateLockTimeout
+	}
+	var (
+		deadline = time.Now().Add(timeout)
+		path     string
+	)
+	defer func() {
+		if path != "" {
+			defaultStateLockQueue.dequeue(path, id)
+		}
+	}()
+
+	for {
+		var buf bytes.Buffer
+		err := run(io.MultiWriter(lp, &buf))
+		if err == nil {
+			return nil
+		}
+		info, locked := provider.ParseStateLockError(buf.String())
+		if !locked {
+			return err
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out waiting for the state lock held by %s (%w)", info.Who, err)
+		}
+
+		if path == "" {
+			// Fall back to the state key since the path is not reported by some backends.
+			// The lock ID can not be used here because it changes every time the lock is acquired.
+			path = info.Path
+			if path == "" {
+				path = key
+			}
+			defaultStateLockQueue.enqueue(path, id)
+		}
+		lp.Infof("The state lock is held by %s for %s since %s", info.Who, info.Operation, info.Created)
+
+		lastPosition := 0
+		for {
+			position := defaultStateLockQueue.position(path, id)
+			if position != lastPosition {
+				// The state key is not shown since the backend configuration may contain credentials.
+				if info.Path != "" {
+					lp.Infof("Waiting for the state lock at %s (queue position: %d)", info.Path, position)
+				} else {
+					lp.Infof("Waiting for the state lock (queue position: %d)", position)
+				}
+				lastPosition = position
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(stateLockRetryInterval):
+			}
+
+			if position == 1 || time.Now().After(deadline) {
+				break
+			}
+		}
+	}
+}
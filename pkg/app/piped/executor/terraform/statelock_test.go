@@ -0,0 +1,215 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type fakeLogPersister struct{}
+
+func (l *fakeLogPersister) Write(p []byte) (int, error)         { return len(p), nil }
+func (l *fakeLogPersister) Info(_ string)                       {}
+func (l *fakeLogPersister) Infof(_ string, _ ...interface{})    {}
+func (l *fakeLogPersister) Success(_ string)                    {}
+func (l *fakeLogPersister) Successf(_ string, _ ...interface{}) {}
+func (l *fakeLogPersister) Error(_ string)                      {}
+func (l *fakeLogPersister) Errorf(_ string, _ ...interface{})   {}
+
+const stateLockErrorOutput = `
+Error: Error acquiring the state lock
+
+Lock Info:
+  ID:        4a3c5f1e-8a8b-0c2d-6e1f-3b4a5c6d7e8f
+  Path:      tfstate-prod/network/terraform.tfstate
+  Operation: OperationTypeApply
+  Who:       piped@piped-7d9f8
+`
+
+func TestStateLockQueue(t *testing.T) {
+	t.Parallel()
+
+	q := &stateLockQueue{waiters: make(map[string][]string)}
+	q.enqueue("path", "deployment-1")
+	q.enqueue("path", "deployment-2")
+	q.enqueue("path", "deployment-1")
+	assert.Equal(t, 1, q.position("path", "deployment-1"))
+	assert.Equal(t, 2, q.position("path", "deployment-2"))
+	assert.Equal(t, 0, q.position("other-path", "deployment-1"))
+
+	q.dequeue("path", "deployment-1")
+	assert.Equal(t, 1, q.position("path", "deployment-2"))
+
+	q.dequeue("path", "deployment-2")
+	assert.Empty(t, q.waiters)
+}
+
+func TestRunWithStateLock(t *testing.T) {
+	stateLockRetryInterval = time.Millisecond
+
+	testcases := []struct {
+		name             string
+		lockedAttempts   int
+		runErr           error
+		timeout          time.Duration
+		expectedAttempts int
+		expectedErr      bool
+	}{
+		{
+			name:             "acquired without waiting",
+			expectedAttempts: 1,
+		},
+		{
+			name:             "acquired after the lock was released",
+			lockedAttempts:   2,
+			timeout:          time.Minute,
+			expectedAttempts: 3,
+		},
+		{
+			name:             "failed by another error",
+			runErr:           errors.New("invalid configuration"),
+			expectedAttempts: 1,
+			expectedErr:      true,
+		},
+		{
+			name:             "timed out",
+			lockedAttempts:   100,
+			timeout:          time.Nanosecond,
+			expectedAttempts: 1,
+			expectedErr:      true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			err := runWithStateLock(context.Background(), &fakeLogPersister{}, "deployment-id", "state-key", tc.timeout, func(w io.Writer) error {
+				attempts++
+				if attempts <= tc.lockedAttempts {
+					io.WriteString(w, stateLockErrorOutput)
+					return errors.New("exit status 1")
+				}
+				return tc.runErr
+			})
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expectedAttempts, attempts)
+			assert.Empty(t, defaultStateLockQueue.waiters)
+		})
+	}
+}
+
+func TestRunWithStateLockHandover(t *testing.T) {
+	stateLockRetryInterval = time.Millisecond
+
+	// The backend does not report the path of the state,
+	// and the ID of the lock changes when the lock is passed to another operation.
+	lockedBy := func(id string) string {
+		return fmt.Sprintf("Error: Error acquiring the state lock\n\nLock Info:\n  ID:        %s\n  Operation: OperationTypeApply\n  Who:       someone@example\n", id)
+	}
+
+	var (
+		released  = make(chan struct{})
+		mu        sync.Mutex
+		completed []string
+		attemptsB int
+		wg        sync.WaitGroup
+	)
+	complete := func(id string) {
+		mu.Lock()
+		defer mu.Unlock()
+		completed = append(completed, id)
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		attempts := 0
+		err := runWithStateLock(context.Background(), &fakeLogPersister{}, "deployment-a", "state-key", time.Minute, func(w io.Writer) error {
+			attempts++
+			if attempts == 1 {
+				io.WriteString(w, lockedBy("lock-1"))
+				return errors.New("exit status 1")
+			}
+			select {
+			case <-released:
+				complete("deployment-a")
+				return nil
+			default:
+				io.WriteString(w, lockedBy("lock-2"))
+				return errors.New("exit status 1")
+			}
+		})
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		return defaultStateLockQueue.position("state-key", "deployment-a") == 1
+	}, time.Second, time.Millisecond)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		err := runWithStateLock(context.Background(), &fakeLogPersister{}, "deployment-b", "state-key", time.Minute, func(w io.Writer) error {
+			mu.Lock()
+			attemptsB++
+			mu.Unlock()
+			select {
+			case <-released:
+				complete("deployment-b")
+				return nil
+			default:
+				io.WriteString(w, lockedBy("lock-2"))
+				return errors.New("exit status 1")
+			}
+		})
+		assert.NoError(t, err)
+	}()
+	assert.Eventually(t, func() bool {
+		return defaultStateLockQueue.position("state-key", "deployment-b") == 2
+	}, time.Second, time.Millisecond)
+
+	// Only the head of the queue retries while the lock is held.
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, 1, attemptsB)
+	mu.Unlock()
+
+	close(released)
+	wg.Wait()
+	assert.Equal(t, []string{"deployment-a", "deployment-b"}, completed)
+	assert.Empty(t, defaultStateLockQueue.waiters)
+}
+
+func TestStateKey(t *testing.T) {
+	t.Parallel()
+
+	d := &model.Deployment{
+		GitPath: &model.ApplicationGitPath{
+			Repo: &model.ApplicationGitRepository{Id: "repo"},
+			Path: "apps/network",
+		},
+	}
+	assert.Equal(t, "repo/apps/network@default", stateKey(d, "", nil))
+	assert.Equal(t, "repo/apps/network@prod,bucket=tfstate,key=network", stateKey(d, "prod", map[string]string{"key": "network", "bucket": "tfstate"}))
+}
@@ -38,6 +38,10 @@ func Register(r registerer) {
 	r.Register(model.StageTerraformSync, f)
 	r.Register(model.StageTerraformPlan, f)
 	r.Register(model.StageTerraformApply, f)
+	r.Register(model.StageTerraformCostEstimation, f)
+	r.Register(model.StageTerraformPolicyCheck, f)
+	r.Register(model.StageTerraformImport, f)
+	r.Register(model.StageTerraformDestroy, f)
 
 	r.RegisterRollback(model.RollbackKind_Rollback_TERRAFORM, func(in executor.Input) executor.Executor {
 		return &rollbackExecutor{
@@ -60,15 +64,55 @@ func selectWorkspace(ctx context.Context, cmd *provider.Terraform, workspace str
 	if workspace == "" {
 		return true
 	}
+
+	workspaces, err := cmd.ListWorkspaces(ctx)
+	if err != nil {
+		lp.Errorf("Failed to list workspaces (%v)", err)
+		return false
+	}
+
+	// Create the workspace if it does not exist yet
+	// so that a module directory can be used for multiple environments.
+	exists := false
+	for _, ws := range workspaces {
+		if ws == workspace {
+			exists = true
+			break
+		}
+	}
+	if !exists {
+		if err := cmd.NewWorkspace(ctx, workspace); err != nil {
+			lp.Errorf("Failed to create workspace %q (%v)", workspace, err)
+			return false
+		}
+		lp.Infof("Created and selected workspace %q because it did not exist", workspace)
+		return true
+	}
+
 	if err := cmd.SelectWorkspace(ctx, workspace); err != nil {
-		lp.Errorf("Failed to select workspace %q (%v). You might need to create the workspace before using by command %q", workspace, err, "terraform workspace new "+workspace)
+		lp.Errorf("Failed to select workspace %q (%v)", workspace, err)
 		return false
 	}
 	lp.Infof("Selected workspace %q", workspace)
 	return true
 }
 
-func findTerraform(ctx context.Context, version string, lp executor.LogPersister) (string, bool) {
+// findTerraform returns the path to the terraform binary for the application.
+// When the version is not specified, it is determined from "required_version" of the module.
+func findTerraform(ctx context.Context, input config.TerraformDeploymentInput, appDir string, cfg *config.PlatformProviderTerraformConfig, lp executor.LogPersister) (string, bool) {
+	version := input.TerraformVersion
+	if version == "" {
+		v, err := provider.DetermineVersion(appDir, *cfg.AutoInstallRequiredVersion)
+		if err != nil {
+			lp.Errorf("Unable to determine terraform version from required_version of the module (%v)", err)
+			return "", false
+		}
+		if v != "" {
+			lp.Infof("Using terraform %q that satisfies required_version of the module", v)
+		}
+		version = v
+	}
+
 	path, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
 	if err != nil {
 		lp.Errorf("Unable to find required terraform %q (%v)", version, err)
@@ -80,6 +124,55 @@ func findTerraform(ctx context.Context, version string, lp executor.LogPersister
 	return path, true
 }
 
+// findTerragrunt returns the path to the terragrunt binary if the application enables it.
+// An empty path is returned when terragrunt is not used.
+func findTerragrunt(ctx context.Context, input config.TerraformDeploymentInput, lp executor.LogPersister) (string, bool) {
+	if !input.Terragrunt {
+		return "", true
+	}
+	version := input.TerragruntVersion
+	path, installed, err := toolregistry.DefaultRegistry().Terragrunt(ctx, version)
+	if err != nil {
+		lp.Errorf("Unable to find required terragrunt %q (%v)", version, err)
+		return "", false
+	}
+	if installed {
+		lp.Infof("Terragrunt %q has just been installed to %q because of no pre-installed binary for that version", version, path)
+	}
+	return path, true
+}
+
+func findInfracost(ctx context.Context, version string, lp executor.LogPersister) (string, bool) {
+	path, installed, err := toolregistry.DefaultRegistry().Infracost(ctx, version)
+	if err != nil {
+		lp.Errorf("Unable to find required infracost %q (%v)", version, err)
+		return "", false
+	}
+	if installed {
+		lp.Infof("Infracost %q has just been installed to %q because of no pre-installed binary for that version", version, path)
+	}
+	return path, true
+}
+
+func makeSecretVarEnvs(appCfg *config.TerraformApplicationSpec, dcr executor.SecretDecrypter) ([]string, error) {
+	var encryptedSecrets map[string]string
+	if appCfg.Encryption != nil {
+		encryptedSecrets = appCfg.Encryption.EncryptedSecrets
+	}
+	return provider.MakeSecretVarEnvs(appCfg.Input.SecretVars, encryptedSecrets, dcr)
+}
+
+func renderBackendConfig(input config.TerraformDeploymentInput, d *model.Deployment) (map[string]string, error) {
+	return provider.RenderBackendConfig(input.BackendConfig, provider.BackendConfigData{
+		App: provider.BackendConfigApp{
+			ID:     d.ApplicationId,
+			Name:   d.ApplicationName,
+			Labels: d.Labels,
+		},
+		Workspace: input.Workspace,
+	})
+}
+
 func findPlatformProvider(in *executor.Input) (cfg *config.PlatformProviderTerraformConfig, found bool) {
 	var name = in.Application.PlatformProvider
 	if name == "" {
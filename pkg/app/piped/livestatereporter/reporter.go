@@ -25,8 +25,13 @@ import (
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/appengine"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/flyio"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatereporter/nomad"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore"
 	"github.com/pipe-cd/pipecd/pkg/app/server/service/pipedservice"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -79,6 +84,41 @@ func NewReporter(appLister applicationLister, stateGetter livestatestore.Getter,
 				continue
 			}
 			r.reporters = append(r.reporters, cloudrun.NewReporter(cp, appLister, sg, apiClient, logger))
+		case model.PlatformProviderECS:
+			sg, ok := stateGetter.ECSRunGetter(cp.Name)
+			if !ok {
+				r.logger.Error(fmt.Sprintf(errFmt, cp.Name))
+				continue
+			}
+			r.reporters = append(r.reporters, ecs.NewReporter(cp, appLister, sg, apiClient, logger))
+		case model.PlatformProviderNomad:
+			sg, ok := stateGetter.NomadGetter(cp.Name)
+			if !ok {
+				r.logger.Error(fmt.Sprintf(errFmt, cp.Name))
+				continue
+			}
+			r.reporters = append(r.reporters, nomad.NewReporter(cp, appLister, sg, apiClient, logger))
+		case model.PlatformProviderContainerApps:
+			sg, ok := stateGetter.ContainerAppsGetter(cp.Name)
+			if !ok {
+				r.logger.Error(fmt.Sprintf(errFmt, cp.Name))
+				continue
+			}
+			r.reporters = append(r.reporters, containerapps.NewReporter(cp, appLister, sg, apiClient, logger))
+		case model.PlatformProviderAppEngine:
+			sg, ok := stateGetter.AppEngineGetter(cp.Name)
+			if !ok {
+				r.logger.Error(fmt.Sprintf(errFmt, cp.Name))
+				continue
+			}
+			r.reporters = append(r.reporters, appengine.NewReporter(cp, appLister, sg, apiClient, logger))
+		case model.PlatformProviderFlyIO:
+			sg, ok := stateGetter.FlyIOGetter(cp.Name)
+			if !ok {
+				r.logger.Error(fmt.Sprintf(errFmt, cp.Name))
+				continue
+			}
+			r.reporters = append(r.reporters, flyio.NewReporter(cp, appLister, sg, apiClient, logger))
 		}
 	}
 
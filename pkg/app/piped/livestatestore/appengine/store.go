@@ -0,0 +1,120 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/appengine"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type store struct {
+	apps   atomic.Value
+	logger *zap.Logger
+	client provider.Client
+}
+
+type app struct {
+	// The live App Engine service.
+	service *provider.Service
+	// The states of the service and its versions.
+	states  []*model.AppEngineResourceState
+	version model.ApplicationLiveStateVersion
+}
+
+func (s *store) run(ctx context.Context) error {
+	services, err := s.client.ListServices(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list services: %w", err)
+	}
+
+	var (
+		now     = time.Now()
+		apps    = make(map[string]app)
+		version = model.ApplicationLiveStateVersion{
+			Timestamp: now.Unix(),
+		}
+	)
+	for _, svc := range services {
+		if svc.Labels[provider.LabelManagedBy] != provider.ManagedByPiped {
+			continue
+		}
+		appID := svc.Labels[provider.LabelApplication]
+		if appID == "" {
+			continue
+		}
+
+		versions, err := s.client.ListVersions(ctx, svc.Id)
+		if err != nil {
+			return fmt.Errorf("failed to list versions: %w", err)
+		}
+
+		apps[appID] = app{
+			service: svc,
+			states:  provider.MakeResourceStates(svc, versions, now),
+			version: version,
+		}
+	}
+
+	// Update apps to the latest.
+	s.apps.Store(apps)
+
+	return nil
+}
+
+func (s *store) loadApps() map[string]app {
+	apps := s.apps.Load()
+	if apps == nil {
+		return nil
+	}
+	return apps.(map[string]app)
+}
+
+func (s *store) getService(appID string) (*provider.Service, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return nil, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return nil, false
+	}
+	return app.service, true
+}
+
+func (s *store) getState(appID string) (State, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return State{}, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return State{}, false
+	}
+
+	state := State{
+		Resources: app.states,
+		Version:   app.version,
+	}
+	return state, true
+}
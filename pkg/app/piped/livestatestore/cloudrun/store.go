@@ -34,7 +34,8 @@ type store struct {
 
 type app struct {
 	service provider.ServiceManifest
-	// The states of service and all its active revsions which may handle the traffic.
+	// The states of service and all its active revsions which may handle the traffic,
+	// and the jobs deployed by the application.
 	states  []*model.CloudRunResourceState
 	version model.ApplicationLiveStateVersion
 }
@@ -62,14 +63,19 @@ func (s *store) run(ctx context.Context) error {
 		revs[id] = rs
 	}
 
+	jobs, err := s.fetchManagedJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch managed jobs: %w", err)
+	}
+
 	// Update apps to the latest.
-	apps := s.buildAppMap(svcs, revs)
+	apps := s.buildAppMap(svcs, revs, jobs)
 	s.apps.Store(apps)
 
 	return nil
 }
 
-func (s *store) buildAppMap(svcs []*provider.Service, revs map[string][]*provider.Revision) map[string]app {
+func (s *store) buildAppMap(svcs []*provider.Service, revs map[string][]*provider.Revision, jobs []*provider.Job) map[string]app {
 	apps, now := make(map[string]app, len(svcs)), time.Now()
 	version := model.ApplicationLiveStateVersion{
 		Timestamp: now.Unix(),
@@ -93,6 +99,27 @@ func (s *store) buildAppMap(svcs []*provider.Service, revs map[string][]*provide
 			version: version,
 		}
 	}
+
+	for _, job := range jobs {
+		if job.Metadata == nil {
+			continue
+		}
+		appID := job.Metadata.Labels[provider.LabelApplication]
+		if appID == "" {
+			continue
+		}
+		state, ok := provider.MakeJobResourceState(job, now)
+		if !ok {
+			s.logger.Error("failed to load cloudrun job into job manifest", zap.String("job", job.Metadata.Name))
+			continue
+		}
+
+		apps[appID] = app{
+			service: apps[appID].service,
+			states:  append(apps[appID].states, state),
+			version: version,
+		}
+	}
 	return apps
 }
 
@@ -121,6 +148,29 @@ func (s *store) fetchManagedServices(ctx context.Context) ([]*provider.Service,
 	return svcs, nil
 }
 
+func (s *store) fetchManagedJobs(ctx context.Context) ([]*provider.Job, error) {
+	const maxLimit = 500
+	var cursor string
+	jobs := make([]*provider.Job, 0, maxLimit)
+	for {
+		ops := &provider.ListOptions{
+			Limit:         maxLimit,
+			LabelSelector: provider.MakeManagedByPipedSelector(),
+			Cursor:        cursor,
+		}
+		v, next, err := s.client.ListJobs(ctx, ops)
+		if err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, v...)
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+	return jobs, nil
+}
+
 func (s *store) fetchActiveRevisions(ctx context.Context, names []string) ([]*provider.Revision, error) {
 	ops := &provider.ListRevisionsOptions{
 		LabelSelector: provider.MakeRevisionNamesSelector(names),
@@ -144,7 +194,8 @@ func (s *store) getServiceManifest(appID string) (provider.ServiceManifest, bool
 	}
 
 	app, ok := apps[appID]
-	if !ok {
+	// The application may deploy only jobs without any service.
+	if !ok || app.service.Name == "" {
 		return provider.ServiceManifest{}, false
 	}
 
@@ -16,36 +16,310 @@ package ecs
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/atomic"
 	"go.uber.org/zap"
 
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
 	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-type applicationLister interface {
-	List() []*model.Application
+type store struct {
+	apps             atomic.Value
+	logger           *zap.Logger
+	cfg              *config.PlatformProviderECSConfig
+	platformProvider string
+	pipedCfg         *config.PipedSpec
+	appLister        applicationLister
+	gitClient        gitClient
+	gitRepos         map[string]git.Repo
+	// The platform provider configurations for the roles assumed by the applications.
+	// They are reloaded from the application configurations every rolesInterval.
+	roles         []*config.PlatformProviderECSConfig
+	rolesLoadedAt time.Time
+	rolesInterval time.Duration
+	// Task definitions are immutable, so they are kept by their ARNs
+	// to avoid describing them on every sync.
+	taskDefinitions map[string]*types.TaskDefinition
 }
 
-type Store struct {
-	logger *zap.Logger
+type app struct {
+	// The services of the application keyed by their names.
+	// An application has multiple services when it deploys additional services.
+	services map[string]service
+	// The states of services, their active task sets and their running tasks.
+	states  []*model.ECSResourceState
+	version model.ApplicationLiveStateVersion
 }
 
-type Getter interface {
+type service struct {
+	service        *types.Service
+	taskDefinition *types.TaskDefinition
 }
 
-func NewStore(cfg *config.PlatformProviderECSConfig, platformProvider string, appLister applicationLister, logger *zap.Logger) *Store {
-	logger = logger.Named("ecs").
-		With(zap.String("platform-provider", platformProvider))
+func (s *store) run(ctx context.Context) error {
+	if time.Since(s.rolesLoadedAt) >= s.rolesInterval {
+		s.roles = s.loadRoles(ctx)
+		s.rolesLoadedAt = time.Now()
+	}
 
-	return &Store{
-		logger: logger,
+	var (
+		now             = time.Now()
+		apps            = make(map[string]app)
+		taskDefinitions = make(map[string]*types.TaskDefinition, len(s.taskDefinitions))
+		// The same service can be listed by multiple roles having access to the same account.
+		syncedServices = make(map[string]struct{})
+	)
+	for _, cfg := range s.roles {
+		client, err := provider.DefaultRegistry().Client(s.platformProvider, cfg, s.logger)
+		if err != nil {
+			return fmt.Errorf("failed to create ecs client for role %s: %w", cfg.AssumeRoleARN, err)
+		}
+		if err := s.syncServices(ctx, client, apps, taskDefinitions, syncedServices, now); err != nil {
+			return err
+		}
 	}
+	s.taskDefinitions = taskDefinitions
+
+	// Update apps to the latest.
+	s.apps.Store(apps)
+
+	return nil
 }
 
-func (s *Store) Run(ctx context.Context) error {
-	s.logger.Info("start running ecs app state store")
+// syncServices lists the managed services accessible by the given client and adds them into the given apps.
+func (s *store) syncServices(
+	ctx context.Context,
+	client provider.Client,
+	apps map[string]app,
+	taskDefinitions map[string]*types.TaskDefinition,
+	syncedServices map[string]struct{},
+	now time.Time,
+) error {
+	clusters, err := client.ListClusters(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list clusters: %w", err)
+	}
+
+	version := model.ApplicationLiveStateVersion{
+		Timestamp: now.Unix(),
+	}
+	for _, cluster := range clusters {
+		svcs, err := client.GetServices(ctx, cluster)
+		if err != nil {
+			return fmt.Errorf("failed to fetch managed services: %w", err)
+		}
 
-	s.logger.Info("ecs app state store has been stopped")
+		for _, svc := range svcs {
+			appID, ok := findApplicationID(svc.Tags)
+			if !ok {
+				continue
+			}
+			if _, ok := syncedServices[aws.ToString(svc.ServiceArn)]; ok {
+				continue
+			}
+			syncedServices[aws.ToString(svc.ServiceArn)] = struct{}{}
+
+			taskSets, err := client.GetServiceTaskSets(ctx, *svc)
+			if err != nil {
+				return fmt.Errorf("failed to fetch task sets: %w", err)
+			}
+			tasks, err := client.GetServiceTasks(ctx, *svc)
+			if err != nil {
+				return fmt.Errorf("failed to fetch running tasks: %w", err)
+			}
+
+			arn := primaryTaskDefinitionArn(svc, taskSets)
+			td, ok := taskDefinitions[arn]
+			if !ok {
+				td, ok = s.taskDefinitions[arn]
+			}
+			if !ok && arn != "" {
+				if td, err = client.GetTaskDefinition(ctx, arn); err != nil {
+					return fmt.Errorf("failed to fetch task definition: %w", err)
+				}
+			}
+			if td != nil {
+				taskDefinitions[arn] = td
+			}
+
+			a := apps[appID]
+			if a.services == nil {
+				a.services = make(map[string]service)
+			}
+			a.services[aws.ToString(svc.ServiceName)] = service{service: svc, taskDefinition: td}
+			a.states = append(a.states, provider.MakeResourceStates(svc, taskSets, tasks, now)...)
+			a.version = version
+			apps[appID] = a
+		}
+	}
 	return nil
 }
+
+// loadRoles returns the platform provider configurations for all distinct roles
+// specified by the ECS applications of this platform provider.
+// The one of the platform provider itself is always included.
+func (s *store) loadRoles(ctx context.Context) []*config.PlatformProviderECSConfig {
+	var (
+		roles = []*config.PlatformProviderECSConfig{s.cfg}
+		keys  = map[string]struct{}{
+			roleKey(s.cfg): {},
+		}
+	)
+	for repoID, apps := range s.listGroupedApplication() {
+		repo, err := s.syncGitRepository(ctx, repoID)
+		if err != nil {
+			s.logger.Error("failed to sync git repository",
+				zap.String("repo-id", repoID),
+				zap.Error(err),
+			)
+			continue
+		}
+
+		for _, app := range apps {
+			appCfg, err := config.LoadFromYAML(filepath.Join(repo.GetPath(), app.GitPath.GetApplicationConfigFilePath()))
+			if err != nil {
+				s.logger.Error(fmt.Sprintf("failed to load application configuration: %s", app.Id), zap.Error(err))
+				continue
+			}
+			if appCfg.ECSApplicationSpec == nil {
+				s.logger.Error(fmt.Sprintf("application %s has unsupported application kind %s", app.Id, appCfg.Kind))
+				continue
+			}
+			cfg := provider.WithAssumeRole(s.cfg, appCfg.ECSApplicationSpec.Input)
+			if _, ok := keys[roleKey(cfg)]; ok {
+				continue
+			}
+			keys[roleKey(cfg)] = struct{}{}
+			roles = append(roles, cfg)
+		}
+	}
+	return roles
+}
+
+func roleKey(cfg *config.PlatformProviderECSConfig) string {
+	return cfg.AssumeRoleARN + "/" + cfg.ExternalID
+}
+
+// syncGitRepository clones the given repository for the first time
+// and pulls its latest changes after that.
+func (s *store) syncGitRepository(ctx context.Context, repoID string) (git.Repo, error) {
+	repo, ok := s.gitRepos[repoID]
+	if !ok {
+		repoCfg, ok := s.pipedCfg.GetRepository(repoID)
+		if !ok {
+			return nil, fmt.Errorf("repository %s was not found in piped configuration", repoID)
+		}
+		r, err := s.gitClient.Clone(ctx, repoID, repoCfg.Remote, repoCfg.Branch, "")
+		if err != nil {
+			return nil, err
+		}
+		s.gitRepos[repoID] = r
+		return r, nil
+	}
+	if err := repo.Pull(ctx, repo.GetClonedBranch()); err != nil {
+		return nil, err
+	}
+	return repo, nil
+}
+
+// listGroupedApplication retrieves all ECS applications of this platform provider
+// and then groups them by repoID.
+func (s *store) listGroupedApplication() map[string][]*model.Application {
+	var (
+		apps = s.appLister.List()
+		m    = make(map[string][]*model.Application)
+	)
+	for _, app := range apps {
+		if app.Kind != model.ApplicationKind_ECS || app.PlatformProvider != s.platformProvider {
+			continue
+		}
+		repoID := app.GitPath.Repo.Id
+		m[repoID] = append(m[repoID], app)
+	}
+	return m
+}
+
+func findApplicationID(tags []types.Tag) (string, bool) {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == provider.LabelApplication {
+			return aws.ToString(tag.Value), true
+		}
+	}
+	return "", false
+}
+
+// primaryTaskDefinitionArn returns the ARN of the task definition used by the primary task set.
+// The one of the service is returned when the service does not use task sets.
+func primaryTaskDefinitionArn(svc *types.Service, taskSets []*types.TaskSet) string {
+	for _, ts := range taskSets {
+		if aws.ToString(ts.Status) == "PRIMARY" {
+			return aws.ToString(ts.TaskDefinition)
+		}
+	}
+	return aws.ToString(svc.TaskDefinition)
+}
+
+func (s *store) loadApps() map[string]app {
+	apps := s.apps.Load()
+	if apps == nil {
+		return nil
+	}
+	return apps.(map[string]app)
+}
+
+func (s *store) getService(appID, serviceName string) (service, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return service{}, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return service{}, false
+	}
+
+	svc, ok := app.services[serviceName]
+	return svc, ok
+}
+
+func (s *store) getServiceDefinition(appID, serviceName string) (types.Service, bool) {
+	svc, ok := s.getService(appID, serviceName)
+	if !ok || svc.service == nil {
+		return types.Service{}, false
+	}
+	return *svc.service, true
+}
+
+func (s *store) getTaskDefinition(appID, serviceName string) (types.TaskDefinition, bool) {
+	svc, ok := s.getService(appID, serviceName)
+	if !ok || svc.taskDefinition == nil {
+		return types.TaskDefinition{}, false
+	}
+	return *svc.taskDefinition, true
+}
+
+func (s *store) getState(appID string) (State, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return State{}, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return State{}, false
+	}
+
+	state := State{
+		Resources: app.states,
+		Version:   app.version,
+	}
+	return state, true
+}
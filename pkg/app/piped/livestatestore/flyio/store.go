@@ -0,0 +1,136 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/flyio"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type store struct {
+	apps   atomic.Value
+	logger *zap.Logger
+	client provider.Client
+}
+
+type app struct {
+	// The live Fly.io app.
+	app *provider.App
+	// The states of the app and its machines.
+	states  []*model.FlyIOResourceState
+	version model.ApplicationLiveStateVersion
+}
+
+func (s *store) run(ctx context.Context) error {
+	flyApps, err := s.client.ListApps(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list apps: %w", err)
+	}
+
+	var (
+		now     = time.Now()
+		apps    = make(map[string]app)
+		version = model.ApplicationLiveStateVersion{
+			Timestamp: now.Unix(),
+		}
+	)
+	for _, a := range flyApps {
+		machines, err := s.client.ListMachines(ctx, a.Name)
+		if errors.Is(err, provider.ErrNotFound) {
+			// The app has been deleted after listing.
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to list machines: %w", err)
+		}
+
+		appID := applicationID(machines)
+		if appID == "" {
+			continue
+		}
+
+		apps[appID] = app{
+			app:     a,
+			states:  provider.MakeResourceStates(a, machines, now),
+			version: version,
+		}
+	}
+
+	// Update apps to the latest.
+	s.apps.Store(apps)
+
+	return nil
+}
+
+// applicationID returns the id of the application deploying the given machines.
+// Empty is returned when no machine was created by piped.
+func applicationID(machines []*provider.Machine) string {
+	for _, m := range machines {
+		if m.Config.Metadata[provider.LabelManagedBy] != provider.ManagedByPiped {
+			continue
+		}
+		if id := m.Config.Metadata[provider.LabelApplication]; id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+func (s *store) loadApps() map[string]app {
+	apps := s.apps.Load()
+	if apps == nil {
+		return nil
+	}
+	return apps.(map[string]app)
+}
+
+func (s *store) getApp(appID string) (*provider.App, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return nil, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return nil, false
+	}
+	return app.app, true
+}
+
+func (s *store) getState(appID string) (State, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return State{}, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return State{}, false
+	}
+
+	state := State{
+		Resources: app.states,
+		Version:   app.version,
+	}
+	return state, true
+}
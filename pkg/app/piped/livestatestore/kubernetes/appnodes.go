@@ -15,6 +15,7 @@
 package kubernetes
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -22,13 +23,21 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// The maximum number of warning events kept for each resource.
+const maxWarningEventsPerResource = 5
+
 type appNodes struct {
 	appID         string
+	healthRules   []config.KubernetesResourceHealthRule
 	managingNodes map[string]node
 	dependedNodes map[string]node
+	// The map with the key is "resource's uid" and the value is
+	// the list of recent warning events of that resource.
+	warningEvents map[string][]*model.KubernetesResourceEvent
 	version       model.ApplicationLiveStateVersion
 	mu            sync.RWMutex
 }
@@ -59,10 +68,11 @@ func (a *appNodes) addManagingResource(uid string, key provider.ResourceKey, obj
 		appID:        a.appID,
 		key:          key,
 		unstructured: obj,
-		state:        provider.MakeKubernetesResourceState(uid, key, obj, now),
+		state:        provider.MakeKubernetesResourceState(uid, key, obj, a.healthRules, now),
 	}
 
 	a.mu.Lock()
+	n.state.WarningEvents = a.warningEvents[uid]
 	oriNode, hasOriNode := a.managingNodes[uid]
 	version := a.version
 	a.managingNodes[uid] = n
@@ -94,6 +104,7 @@ func (a *appNodes) deleteManagingResource(uid string, _ provider.ResourceKey, no
 
 	version := a.version
 	delete(a.managingNodes, uid)
+	delete(a.warningEvents, uid)
 	a.updateVersion(now)
 	a.mu.Unlock()
 
@@ -113,10 +124,11 @@ func (a *appNodes) addDependedResource(uid string, key provider.ResourceKey, obj
 		appID:        a.appID,
 		key:          key,
 		unstructured: obj,
-		state:        provider.MakeKubernetesResourceState(uid, key, obj, now),
+		state:        provider.MakeKubernetesResourceState(uid, key, obj, a.healthRules, now),
 	}
 
 	a.mu.Lock()
+	n.state.WarningEvents = a.warningEvents[uid]
 	oriNode, hasOriNode := a.dependedNodes[uid]
 	version := a.version
 	a.dependedNodes[uid] = n
@@ -148,6 +160,7 @@ func (a *appNodes) deleteDependedResource(uid string, _ provider.ResourceKey, no
 
 	version := a.version
 	delete(a.dependedNodes, uid)
+	delete(a.warningEvents, uid)
 	a.updateVersion(now)
 	a.mu.Unlock()
 
@@ -161,6 +174,49 @@ func (a *appNodes) deleteDependedResource(uid string, _ provider.ResourceKey, no
 	}, true
 }
 
+// addWarningEvent records the given warning event to the resource specified by uid
+// and returns an update event if the state of that resource has been changed.
+func (a *appNodes) addWarningEvent(uid string, event *model.KubernetesResourceEvent, now time.Time) (model.KubernetesResourceStateEvent, bool) {
+	a.mu.Lock()
+	managing := a.managingNodes[uid].unstructured != nil
+	key, obj := a.managingNodes[uid].key, a.managingNodes[uid].unstructured
+	if !managing {
+		key, obj = a.dependedNodes[uid].key, a.dependedNodes[uid].unstructured
+	}
+	if obj == nil {
+		a.mu.Unlock()
+		return model.KubernetesResourceStateEvent{}, false
+	}
+	a.warningEvents[uid] = mergeWarningEvents(a.warningEvents[uid], event)
+	a.mu.Unlock()
+
+	// Rebuild the state of the resource to include the new warning event.
+	if managing {
+		return a.addManagingResource(uid, key, obj, now)
+	}
+	return a.addDependedResource(uid, key, obj, now)
+}
+
+// mergeWarningEvents adds the given event into the list while deduplicating
+// by reason and message, and keeps only the most recent ones.
+func mergeWarningEvents(events []*model.KubernetesResourceEvent, event *model.KubernetesResourceEvent) []*model.KubernetesResourceEvent {
+	merged := make([]*model.KubernetesResourceEvent, 0, len(events)+1)
+	merged = append(merged, event)
+	for _, e := range events {
+		if e.Reason == event.Reason && e.Message == event.Message {
+			continue
+		}
+		merged = append(merged, e)
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].LastTimestamp > merged[j].LastTimestamp
+	})
+	if len(merged) > maxWarningEventsPerResource {
+		merged = merged[:maxWarningEventsPerResource]
+	}
+	return merged
+}
+
 func (a *appNodes) getManagingNodes() map[string]node {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
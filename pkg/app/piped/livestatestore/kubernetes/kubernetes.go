@@ -73,6 +73,7 @@ func NewStore(cfg *config.PlatformProviderKubernetesConfig, pipedConfig *config.
 		pipedConfig: pipedConfig,
 		store: &store{
 			pipedConfig: pipedConfig,
+			healthRules: cfg.HealthRules,
 			apps:        make(map[string]*appNodes),
 			resources:   make(map[string]appResource),
 			iterators:   make(map[int]int, 1),
@@ -96,14 +97,15 @@ func (s *Store) Run(ctx context.Context) error {
 
 	stopCh := make(chan struct{})
 	rf := reflector{
-		config:      s.config,
-		kubeConfig:  s.kubeConfig,
-		pipedConfig: s.pipedConfig,
-		onAdd:       s.store.onAddResource,
-		onUpdate:    s.store.onUpdateResource,
-		onDelete:    s.store.onDeleteResource,
-		stopCh:      stopCh,
-		logger:      s.logger.Named("reflector"),
+		config:         s.config,
+		kubeConfig:     s.kubeConfig,
+		pipedConfig:    s.pipedConfig,
+		onAdd:          s.store.onAddResource,
+		onUpdate:       s.store.onUpdateResource,
+		onDelete:       s.store.onDeleteResource,
+		onWarningEvent: s.store.onWarningEvent,
+		stopCh:         stopCh,
+		logger:         s.logger.Named("reflector"),
 	}
 	if err := rf.start(ctx); err != nil {
 		s.firstSyncedCh <- err
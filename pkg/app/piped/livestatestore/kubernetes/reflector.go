@@ -35,6 +35,8 @@ import (
 )
 
 var (
+	eventResource = schema.GroupVersionResource{Version: "v1", Resource: "events"}
+
 	// This is the default whitelist of resources that should be watched.
 	// User can add/remove other resources to be watched in piped config at cloud provider part.
 	groupWhitelist = map[string]struct{}{
@@ -145,6 +147,8 @@ type reflector struct {
 	onAdd    func(obj *unstructured.Unstructured)
 	onUpdate func(oldObj, obj *unstructured.Unstructured)
 	onDelete func(obj *unstructured.Unstructured)
+	// Called when a Warning event is reported for any resource.
+	onWarningEvent func(obj *unstructured.Unstructured)
 
 	watchingResourceKinds []provider.APIVersionKind
 	stopCh                chan struct{}
@@ -243,10 +247,39 @@ func (r *reflector) start(_ context.Context) error {
 		startInformer(metav1.NamespaceAll, targetResources)
 	}
 
+	if r.onWarningEvent != nil {
+		r.logger.Info("start running warning event informer")
+		r.startWarningEventInformer(dynamicClient, ns, stopCh)
+	}
+
 	r.logger.Info("all informer caches have been synced")
 	return nil
 }
 
+// startWarningEventInformer watches the Warning events in the given namespace
+// to report the recent problems of the resources.
+func (r *reflector) startWarningEventInformer(dynamicClient dynamic.Interface, namespace string, stopCh chan struct{}) {
+	tweakListOptions := func(opts *metav1.ListOptions) {
+		opts.FieldSelector = "type=Warning"
+	}
+	factory := dynamicinformer.NewFilteredDynamicSharedInformerFactory(dynamicClient, 30*time.Minute, namespace, tweakListOptions)
+	di := factory.ForResource(eventResource).Informer()
+	di.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			r.onWarningEvent(obj.(*unstructured.Unstructured))
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			r.onWarningEvent(obj.(*unstructured.Unstructured))
+		},
+	})
+	go di.Run(r.stopCh)
+	if cache.WaitForCacheSync(stopCh, di.HasSynced) {
+		r.logger.Info("informer cache for warning events has been synced")
+	} else {
+		r.logger.Info("informer cache for warning events has not been synced correctly")
+	}
+}
+
 func (r *reflector) onObjectAdd(obj interface{}) {
 	u := obj.(*unstructured.Unstructured)
 	key := provider.MakeResourceKey(u)
@@ -35,6 +35,7 @@ const (
 
 type store struct {
 	pipedConfig *config.PipedSpec
+	healthRules []config.KubernetesResourceHealthRule
 	apps        map[string]*appNodes
 	// The map with the key is "resource's uid" and the value is "appResource".
 	// Because the depended resource does not include the appID in its annotations
@@ -118,8 +119,10 @@ func (s *store) addResource(obj *unstructured.Unstructured, appID string) {
 		if !ok {
 			app = &appNodes{
 				appID:         appID,
+				healthRules:   s.healthRules,
 				managingNodes: make(map[string]node),
 				dependedNodes: make(map[string]node),
+				warningEvents: make(map[string][]*model.KubernetesResourceEvent),
 				version: model.ApplicationLiveStateVersion{
 					Timestamp: now.Unix(),
 				},
@@ -245,6 +248,53 @@ func (s *store) onDeleteResource(obj *unstructured.Unstructured) {
 	}
 }
 
+func (s *store) onWarningEvent(obj *unstructured.Unstructured) {
+	uid, _, _ := unstructured.NestedString(obj.Object, "involvedObject", "uid")
+	if uid == "" {
+		return
+	}
+
+	s.mu.RLock()
+	r, ok := s.resources[uid]
+	if !ok || r.appID == "" {
+		s.mu.RUnlock()
+		return
+	}
+	app, ok := s.apps[r.appID]
+	s.mu.RUnlock()
+	if !ok {
+		return
+	}
+
+	if event, ok := app.addWarningEvent(uid, makeResourceEvent(obj), time.Now()); ok {
+		s.addEvent(event)
+	}
+}
+
+func makeResourceEvent(obj *unstructured.Unstructured) *model.KubernetesResourceEvent {
+	reason, _, _ := unstructured.NestedString(obj.Object, "reason")
+	message, _, _ := unstructured.NestedString(obj.Object, "message")
+	count, _, _ := unstructured.NestedInt64(obj.Object, "count")
+
+	// The lastTimestamp field may be empty for the events created by
+	// the events.k8s.io API, so eventTime is used as the fallback.
+	timestamp, _, _ := unstructured.NestedString(obj.Object, "lastTimestamp")
+	if timestamp == "" {
+		timestamp, _, _ = unstructured.NestedString(obj.Object, "eventTime")
+	}
+	var lastTimestamp int64
+	if t, err := time.Parse(time.RFC3339, timestamp); err == nil {
+		lastTimestamp = t.Unix()
+	}
+
+	return &model.KubernetesResourceEvent{
+		Reason:        reason,
+		Message:       message,
+		Count:         int32(count),
+		LastTimestamp: lastTimestamp,
+	}
+}
+
 func (s *store) getAppManagingNodes(appID string) map[string]node {
 	s.mu.RLock()
 	app, ok := s.apps[appID]
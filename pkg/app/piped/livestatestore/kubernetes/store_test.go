@@ -0,0 +1,104 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestStoreOnWarningEvent(t *testing.T) {
+	t.Parallel()
+
+	s := &store{
+		apps:      make(map[string]*appNodes),
+		resources: make(map[string]appResource),
+		iterators: make(map[int]int),
+		logger:    zap.NewNop(),
+	}
+	s.onAddResource(&unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "config",
+			"namespace": "default",
+			"uid":       "config-uid",
+			"annotations": map[string]interface{}{
+				provider.LabelApplication:        "app-id",
+				provider.LabelOriginalAPIVersion: "v1",
+			},
+		},
+	}})
+	require.Len(t, s.events, 1)
+
+	makeEvent := func(uid, reason, message, lastTimestamp string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Event",
+			"involvedObject": map[string]interface{}{
+				"uid": uid,
+			},
+			"type":          "Warning",
+			"reason":        reason,
+			"message":       message,
+			"count":         int64(2),
+			"lastTimestamp": lastTimestamp,
+		}}
+	}
+
+	// Events for unknown resources are ignored.
+	s.onWarningEvent(makeEvent("unknown-uid", "Failed", "failed", "2023-01-01T00:00:00Z"))
+	require.Len(t, s.events, 1)
+
+	s.onWarningEvent(makeEvent("config-uid", "Failed", "failed", "2023-01-01T00:00:00Z"))
+	s.onWarningEvent(makeEvent("config-uid", "BackOff", "back-off", "2023-01-01T00:01:00Z"))
+	// The same event is deduplicated with the newer timestamp.
+	s.onWarningEvent(makeEvent("config-uid", "Failed", "failed", "2023-01-01T00:02:00Z"))
+	require.Len(t, s.events, 4)
+
+	state, ok := s.getAppLiveState("app-id")
+	require.True(t, ok)
+	require.Len(t, state.Resources, 1)
+	events := state.Resources[0].WarningEvents
+	require.Len(t, events, 2)
+	assert.Equal(t, "Failed", events[0].Reason)
+	assert.Equal(t, int32(2), events[0].Count)
+	assert.Equal(t, int64(1672531320), events[0].LastTimestamp)
+	assert.Equal(t, "BackOff", events[1].Reason)
+	assert.Equal(t, int64(1672531260), events[1].LastTimestamp)
+}
+
+func TestMergeWarningEvents(t *testing.T) {
+	t.Parallel()
+
+	var events []*model.KubernetesResourceEvent
+	for i := 0; i < maxWarningEventsPerResource+2; i++ {
+		events = mergeWarningEvents(events, &model.KubernetesResourceEvent{
+			Reason:        "Reason",
+			Message:       string(rune('a' + i)),
+			LastTimestamp: int64(i),
+		})
+	}
+	require.Len(t, events, maxWarningEventsPerResource)
+	assert.Equal(t, int64(maxWarningEventsPerResource+1), events[0].LastTimestamp)
+	assert.Equal(t, int64(2), events[len(events)-1].LastTimestamp)
+}
@@ -25,13 +25,18 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/appengine"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/containerapps"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/flyio"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/nomad"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/livestatestore/terraform"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/git"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -39,11 +44,19 @@ type applicationLister interface {
 	List() []*model.Application
 }
 
+type gitClient interface {
+	Clone(ctx context.Context, repoID, remote, branch, destination string) (git.Repo, error)
+}
+
 type Getter interface {
+	AppEngineGetter(platformProvider string) (appengine.Getter, bool)
 	CloudRunGetter(platformProvider string) (cloudrun.Getter, bool)
+	ContainerAppsGetter(platformProvider string) (containerapps.Getter, bool)
 	ECSRunGetter(platformProvider string) (ecs.Getter, bool)
+	FlyIOGetter(platformProvider string) (flyio.Getter, bool)
 	KubernetesGetter(platformProvider string) (kubernetes.Getter, bool)
 	LambdaGetter(platformProvider string) (lambda.Getter, bool)
+	NomadGetter(platformProvider string) (nomad.Getter, bool)
 	TerraformGetter(platformProvider string) (terraform.Getter, bool)
 }
 
@@ -72,6 +85,27 @@ type lambdaStore interface {
 
 type ecsStore interface {
 	Run(ctx context.Context) error
+	ecs.Getter
+}
+
+type nomadStore interface {
+	Run(ctx context.Context) error
+	nomad.Getter
+}
+
+type containerAppsStore interface {
+	Run(ctx context.Context) error
+	containerapps.Getter
+}
+
+type appEngineStore interface {
+	Run(ctx context.Context) error
+	appengine.Getter
+}
+
+type flyIOStore interface {
+	Run(ctx context.Context) error
+	flyio.Getter
 }
 
 // store manages a list of particular stores for all cloud providers.
@@ -86,22 +120,34 @@ type store struct {
 	lambdaStores map[string]lambdaStore
 	// Map thats contains a list of ecsStore where key is the cloud provider name.
 	ecsStores map[string]ecsStore
+	// Map thats contains a list of nomadStore where key is the cloud provider name.
+	nomadStores map[string]nomadStore
+	// Map thats contains a list of containerAppsStore where key is the cloud provider name.
+	containerAppsStores map[string]containerAppsStore
+	// Map thats contains a list of appEngineStore where key is the cloud provider name.
+	appEngineStores map[string]appEngineStore
+	// Map thats contains a list of flyIOStore where key is the cloud provider name.
+	flyIOStores map[string]flyIOStore
 
 	gracePeriod time.Duration
 	logger      *zap.Logger
 }
 
-func NewStore(ctx context.Context, cfg *config.PipedSpec, appLister applicationLister, gracePeriod time.Duration, logger *zap.Logger) Store {
+func NewStore(ctx context.Context, cfg *config.PipedSpec, appLister applicationLister, gitClient gitClient, gracePeriod time.Duration, logger *zap.Logger) Store {
 	logger = logger.Named("livestatestore")
 
 	s := &store{
-		kubernetesStores: make(map[string]kubernetesStore),
-		terraformStores:  make(map[string]terraformStore),
-		cloudrunStores:   make(map[string]cloudRunStore),
-		lambdaStores:     make(map[string]lambdaStore),
-		ecsStores:        make(map[string]ecsStore),
-		gracePeriod:      gracePeriod,
-		logger:           logger,
+		kubernetesStores:    make(map[string]kubernetesStore),
+		terraformStores:     make(map[string]terraformStore),
+		cloudrunStores:      make(map[string]cloudRunStore),
+		lambdaStores:        make(map[string]lambdaStore),
+		ecsStores:           make(map[string]ecsStore),
+		nomadStores:         make(map[string]nomadStore),
+		containerAppsStores: make(map[string]containerAppsStore),
+		appEngineStores:     make(map[string]appEngineStore),
+		flyIOStores:         make(map[string]flyIOStore),
+		gracePeriod:         gracePeriod,
+		logger:              logger,
 	}
 	for _, cp := range cfg.PlatformProviders {
 		switch cp.Type {
@@ -126,8 +172,44 @@ func NewStore(ctx context.Context, cfg *config.PipedSpec, appLister applicationL
 			s.lambdaStores[cp.Name] = store
 
 		case model.PlatformProviderECS:
-			store := ecs.NewStore(cp.ECSConfig, cp.Name, appLister, logger)
+			store, err := ecs.NewStore(cp.ECSConfig, cp.Name, cfg, appLister, gitClient, logger)
+			if err != nil {
+				logger.Error("failed to create a new ecs's livestatestore", zap.Error(err))
+				continue
+			}
 			s.ecsStores[cp.Name] = store
+
+		case model.PlatformProviderNomad:
+			store, err := nomad.NewStore(cp.NomadConfig, cp.Name, logger)
+			if err != nil {
+				logger.Error("failed to create a new nomad's livestatestore", zap.Error(err))
+				continue
+			}
+			s.nomadStores[cp.Name] = store
+
+		case model.PlatformProviderContainerApps:
+			store, err := containerapps.NewStore(cp.ContainerAppsConfig, cp.Name, logger)
+			if err != nil {
+				logger.Error("failed to create a new container apps's livestatestore", zap.Error(err))
+				continue
+			}
+			s.containerAppsStores[cp.Name] = store
+
+		case model.PlatformProviderAppEngine:
+			store, err := appengine.NewStore(ctx, cp.AppEngineConfig, cp.Name, logger)
+			if err != nil {
+				logger.Error("failed to create a new app engine's livestatestore", zap.Error(err))
+				continue
+			}
+			s.appEngineStores[cp.Name] = store
+
+		case model.PlatformProviderFlyIO:
+			store, err := flyio.NewStore(ctx, cp.FlyIOConfig, cp.Name, logger)
+			if err != nil {
+				logger.Error("failed to create a new fly.io's livestatestore", zap.Error(err))
+				continue
+			}
+			s.flyIOStores[cp.Name] = store
 		}
 	}
 
@@ -174,6 +256,34 @@ func (s *store) Run(ctx context.Context) error {
 		})
 	}
 
+	for i := range s.nomadStores {
+		cpName := i
+		group.Go(func() error {
+			return s.nomadStores[cpName].Run(ctx)
+		})
+	}
+
+	for i := range s.containerAppsStores {
+		cpName := i
+		group.Go(func() error {
+			return s.containerAppsStores[cpName].Run(ctx)
+		})
+	}
+
+	for i := range s.appEngineStores {
+		cpName := i
+		group.Go(func() error {
+			return s.appEngineStores[cpName].Run(ctx)
+		})
+	}
+
+	for i := range s.flyIOStores {
+		cpName := i
+		group.Go(func() error {
+			return s.flyIOStores[cpName].Run(ctx)
+		})
+	}
+
 	err := group.Wait()
 	if err == nil {
 		s.logger.Info("all state stores have been stopped")
@@ -187,16 +297,31 @@ func (s *store) Getter() Getter {
 	return s
 }
 
+func (s *store) AppEngineGetter(platformProvider string) (appengine.Getter, bool) {
+	ks, ok := s.appEngineStores[platformProvider]
+	return ks, ok
+}
+
 func (s *store) CloudRunGetter(platformProvider string) (cloudrun.Getter, bool) {
 	ks, ok := s.cloudrunStores[platformProvider]
 	return ks, ok
 }
 
+func (s *store) ContainerAppsGetter(platformProvider string) (containerapps.Getter, bool) {
+	ks, ok := s.containerAppsStores[platformProvider]
+	return ks, ok
+}
+
 func (s *store) ECSRunGetter(platformProvider string) (ecs.Getter, bool) {
 	ks, ok := s.ecsStores[platformProvider]
 	return ks, ok
 }
 
+func (s *store) FlyIOGetter(platformProvider string) (flyio.Getter, bool) {
+	ks, ok := s.flyIOStores[platformProvider]
+	return ks, ok
+}
+
 func (s *store) KubernetesGetter(platformProvider string) (kubernetes.Getter, bool) {
 	ks, ok := s.kubernetesStores[platformProvider]
 	return ks, ok
@@ -207,6 +332,11 @@ func (s *store) LambdaGetter(platformProvider string) (lambda.Getter, bool) {
 	return ks, ok
 }
 
+func (s *store) NomadGetter(platformProvider string) (nomad.Getter, bool) {
+	ks, ok := s.nomadStores[platformProvider]
+	return ks, ok
+}
+
 func (s *store) TerraformGetter(platformProvider string) (terraform.Getter, bool) {
 	ks, ok := s.terraformStores[platformProvider]
 	return ks, ok
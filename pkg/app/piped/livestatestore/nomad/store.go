@@ -0,0 +1,147 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/nomad"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+type store struct {
+	apps   atomic.Value
+	logger *zap.Logger
+	client provider.Client
+	// The meta of the jobs are kept by their versions to avoid getting the jobs on every sync
+	// when the job list API of the Nomad server does not return the meta.
+	metas map[jobKey]map[string]string
+}
+
+type jobKey struct {
+	namespace string
+	id        string
+	version   uint64
+}
+
+type app struct {
+	// The states of the job, its latest deployment and its allocations.
+	states  []*model.NomadResourceState
+	version model.ApplicationLiveStateVersion
+}
+
+func (s *store) run(ctx context.Context) error {
+	jobs, err := s.client.ListJobs(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	var (
+		now     = time.Now()
+		apps    = make(map[string]app)
+		metas   = make(map[jobKey]map[string]string, len(s.metas))
+		version = model.ApplicationLiveStateVersion{
+			Timestamp: now.Unix(),
+		}
+	)
+	for _, job := range jobs {
+		key := jobKey{namespace: job.Namespace, id: job.ID, version: job.Version}
+		meta := job.Meta
+		if meta == nil {
+			if meta, err = s.getJobMeta(ctx, key); err != nil {
+				return err
+			}
+		}
+		metas[key] = meta
+
+		if meta[provider.LabelManagedBy] != provider.ManagedByPiped {
+			continue
+		}
+		appID := meta[provider.LabelApplication]
+		if appID == "" {
+			continue
+		}
+
+		deployment, err := s.client.LatestDeployment(ctx, job.Namespace, job.ID)
+		if err != nil && !errors.Is(err, provider.ErrNotFound) {
+			return fmt.Errorf("failed to fetch the latest deployment: %w", err)
+		}
+		allocs, err := s.client.ListJobAllocations(ctx, job.Namespace, job.ID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch allocations: %w", err)
+		}
+
+		a := apps[appID]
+		a.states = append(a.states, provider.MakeResourceStates(job, deployment, allocs, now)...)
+		a.version = version
+		apps[appID] = a
+	}
+	s.metas = metas
+
+	// Update apps to the latest.
+	s.apps.Store(apps)
+
+	return nil
+}
+
+// getJobMeta returns the meta of the given job, which is cached until the job is updated.
+func (s *store) getJobMeta(ctx context.Context, key jobKey) (map[string]string, error) {
+	if meta, ok := s.metas[key]; ok {
+		return meta, nil
+	}
+	job, err := s.client.GetJob(ctx, key.namespace, key.id)
+	if errors.Is(err, provider.ErrNotFound) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch job: %w", err)
+	}
+	return map[string]string{
+		provider.LabelManagedBy:   job.Meta(provider.LabelManagedBy),
+		provider.LabelApplication: job.Meta(provider.LabelApplication),
+	}, nil
+}
+
+func (s *store) loadApps() map[string]app {
+	apps := s.apps.Load()
+	if apps == nil {
+		return nil
+	}
+	return apps.(map[string]app)
+}
+
+func (s *store) getState(appID string) (State, bool) {
+	apps := s.loadApps()
+	if apps == nil {
+		return State{}, false
+	}
+
+	app, ok := apps[appID]
+	if !ok {
+		return State{}, false
+	}
+
+	state := State{
+		Resources: app.states,
+		Version:   app.version,
+	}
+	return state, true
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/appengine"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Planner plans the deployment pipeline for App Engine application.
+type Planner struct {
+}
+
+type registerer interface {
+	Register(k model.ApplicationKind, p planner.Planner) error
+}
+
+// Register registers this planner into the given registerer.
+func Register(r registerer) {
+	r.Register(model.ApplicationKind_APPENGINE, &Planner{})
+}
+
+// Plan decides which pipeline should be used for the given input.
+func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Output, err error) {
+	ds, err := in.TargetDSP.Get(ctx, io.Discard)
+	if err != nil {
+		err = fmt.Errorf("error while preparing deploy source data (%v)", err)
+		return
+	}
+
+	cfg := ds.ApplicationConfig.AppEngineApplicationSpec
+	if cfg == nil {
+		err = fmt.Errorf("missing AppEngineApplicationSpec in application configuration")
+		return
+	}
+
+	app, err := provider.LoadAppYAML(ds.AppDir, cfg.Input.AppYAMLFile)
+	if err != nil {
+		err = fmt.Errorf("failed to load app.yaml %s: %w", cfg.Input.AppYAMLFile, err)
+		return
+	}
+	// Convert it here to find the invalid values before starting the deployment.
+	if _, err = app.Version(""); err != nil {
+		err = fmt.Errorf("invalid app.yaml %s: %w", cfg.Input.AppYAMLFile, err)
+		return
+	}
+
+	// The source in the application directory is deployed as the version named by the commit.
+	out.Version = provider.MakeVersionID(in.Trigger.Commit.Hash)
+	out.Versions = []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_GIT_SOURCE,
+			Version: out.Version,
+			Name:    app.ServiceID(),
+		},
+	}
+
+	autoRollback := *cfg.Input.AutoRollback
+
+	// In case the strategy has been decided by trigger.
+	// For example: user triggered the deployment via web console.
+	switch in.Trigger.SyncStrategy {
+	case model.SyncStrategy_QUICK_SYNC:
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	case model.SyncStrategy_PIPELINE:
+		if cfg.Pipeline == nil {
+			err = fmt.Errorf("unable to force sync with pipeline because no pipeline was specified")
+			return
+		}
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	}
+
+	// When no pipeline was configured, perform the quick sync.
+	if cfg.Pipeline == nil || len(cfg.Pipeline.Stages) == 0 {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+		out.Summary = fmt.Sprintf("Quick sync to deploy version %s and promote it immediately (pipeline was not configured)", out.Version)
+		return
+	}
+
+	// Force to use pipeline when the alwaysUsePipeline field was configured.
+	if cfg.Planner.AlwaysUsePipeline {
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
+		out.Summary = "Sync with the specified pipeline (alwaysUsePipeline was set)"
+		return
+	}
+
+	// If this is the first time to deploy this application or it was unable to retrieve last successful commit,
+	// we perform the quick sync strategy.
+	if in.MostRecentSuccessfulCommitHash == "" {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+		out.Summary = fmt.Sprintf("Quick sync to deploy version %s and promote it immediately (it seems this is the first deployment)", out.Version)
+		return
+	}
+
+	out.SyncStrategy = model.SyncStrategy_PIPELINE
+	out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
+	out.Summary = fmt.Sprintf("Sync with pipeline to update version from %s to %s", provider.MakeVersionID(in.MostRecentSuccessfulCommitHash), out.Version)
+	return
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Planner plans the deployment pipeline for Azure Functions application.
+type Planner struct {
+}
+
+type registerer interface {
+	Register(k model.ApplicationKind, p planner.Planner) error
+}
+
+// Register registers this planner into the given registerer.
+func Register(r registerer) {
+	r.Register(model.ApplicationKind_AZUREFUNCTIONS, &Planner{})
+}
+
+// Plan decides which pipeline should be used for the given input.
+func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Output, err error) {
+	ds, err := in.TargetDSP.Get(ctx, io.Discard)
+	if err != nil {
+		err = fmt.Errorf("error while preparing deploy source data (%v)", err)
+		return
+	}
+
+	cfg := ds.ApplicationConfig.AzureFunctionsApplicationSpec
+	if cfg == nil {
+		err = fmt.Errorf("missing AzureFunctionsApplicationSpec in application configuration")
+		return
+	}
+
+	m, err := provider.LoadFunctionAppManifest(ds.AppDir, cfg.Input.FunctionAppManifestFile)
+	if err != nil {
+		err = fmt.Errorf("failed to load function app manifest %s: %w", cfg.Input.FunctionAppManifestFile, err)
+		return
+	}
+
+	autoRollback := *cfg.Input.AutoRollback
+
+	if out.Versions, err = provider.FindArtifactVersions(ds.AppDir, m); err != nil {
+		err = fmt.Errorf("failed to find the version of function app %s: %w", m.Spec.Name, err)
+		return
+	}
+	if len(out.Versions) > 0 {
+		out.Version = out.Versions[0].Version
+	}
+
+	// In case the strategy has been decided by trigger.
+	// For example: user triggered the deployment via web console.
+	switch in.Trigger.SyncStrategy {
+	case model.SyncStrategy_QUICK_SYNC:
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	case model.SyncStrategy_PIPELINE:
+		if cfg.Pipeline == nil {
+			err = fmt.Errorf("unable to force sync with pipeline because no pipeline was specified")
+			return
+		}
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	}
+
+	now := time.Now()
+
+	// When no pipeline was configured, perform the quick sync.
+	if cfg.Pipeline == nil || len(cfg.Pipeline.Stages) == 0 {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, now)
+		out.Summary = fmt.Sprintf("Quick sync to deploy version %s to function app %s (pipeline was not configured)", out.Version, m.Spec.Name)
+		return
+	}
+
+	// Force to use pipeline when the alwaysUsePipeline field was configured.
+	if cfg.Planner.AlwaysUsePipeline {
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, now)
+		out.Summary = "Sync with the specified pipeline (alwaysUsePipeline was set)"
+		return
+	}
+
+	// If this is the first time to deploy this application or it was unable to retrieve last successful commit,
+	// we perform the quick sync strategy.
+	if in.MostRecentSuccessfulCommitHash == "" {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, now)
+		out.Summary = fmt.Sprintf("Quick sync to deploy version %s to function app %s (it seems this is the first deployment)", out.Version, m.Spec.Name)
+		return
+	}
+
+	out.SyncStrategy = model.SyncStrategy_PIPELINE
+	out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, now)
+	out.Summary = fmt.Sprintf("Sync with pipeline to deploy version %s to function app %s", out.Version, m.Spec.Name)
+	return
+}
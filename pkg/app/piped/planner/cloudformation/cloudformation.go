@@ -0,0 +1,108 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// Planner plans the deployment pipeline for CloudFormation application.
+type Planner struct {
+}
+
+type registerer interface {
+	Register(k model.ApplicationKind, p planner.Planner) error
+}
+
+// Register registers this planner into the given registerer.
+func Register(r registerer) {
+	r.Register(model.ApplicationKind_CLOUDFORMATION, &Planner{})
+}
+
+// Plan decides which pipeline should be used for the given input.
+func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Output, err error) {
+	ds, err := in.TargetDSP.Get(ctx, io.Discard)
+	if err != nil {
+		err = fmt.Errorf("error while preparing deploy source data (%v)", err)
+		return
+	}
+
+	cfg := ds.ApplicationConfig.CloudFormationApplicationSpec
+	if cfg == nil {
+		err = fmt.Errorf("missing CloudFormationApplicationSpec in application configuration")
+		return
+	}
+
+	// Fail fast before creating any change set when the template can not be used.
+	if _, err = provider.LoadTemplate(ds.AppDir, cfg.Input.TemplateFile); err != nil {
+		err = fmt.Errorf("failed to load template %s: %w", cfg.Input.TemplateFile, err)
+		return
+	}
+
+	// In case the strategy has been decided by trigger.
+	// For example: user triggered the deployment via web console.
+	switch in.Trigger.SyncStrategy {
+	case model.SyncStrategy_QUICK_SYNC:
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(cfg.Input.AutoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	case model.SyncStrategy_PIPELINE:
+		if cfg.Pipeline == nil {
+			err = fmt.Errorf("unable to force sync with pipeline because no pipeline was specified")
+			return
+		}
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, cfg.Input.AutoRollback, time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
+	}
+
+	now := time.Now()
+	out.Version = "N/A"
+	out.Versions = []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: "N/A",
+		},
+	}
+
+	if cfg.Pipeline == nil || len(cfg.Pipeline.Stages) == 0 {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(cfg.Input.AutoRollback, now)
+		out.Summary = fmt.Sprintf("Quick sync by automatically executing the changes of stack %s because no pipeline was configured", cfg.Input.StackName)
+		return
+	}
+
+	// Force to use pipeline when the alwaysUsePipeline field was configured.
+	if cfg.Planner.AlwaysUsePipeline {
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, cfg.Input.AutoRollback, now)
+		out.Summary = "Sync with the specified pipeline (alwaysUsePipeline was set)"
+		return
+	}
+
+	out.SyncStrategy = model.SyncStrategy_PIPELINE
+	out.Stages = buildProgressivePipeline(cfg.Pipeline, cfg.Input.AutoRollback, now)
+	out.Summary = "Sync with the specified progressive pipeline"
+	return
+}
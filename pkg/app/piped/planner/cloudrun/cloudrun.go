@@ -74,6 +74,11 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		out.Versions = versions
 	}
 
+	// Report the invalid service manifest at planning time instead of failing in the middle of the deployment.
+	if err = validateServiceManifest(ds.AppDir, cfg.Input.ServiceManifestFile); err != nil {
+		return
+	}
+
 	autoRollback := *cfg.Input.AutoRollback
 
 	// In case the strategy has been decided by trigger.
@@ -137,6 +142,18 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	return
 }
 
+// validateServiceManifest loads the service manifest and checks whether it can be deployed to Cloud Run.
+func validateServiceManifest(appDir, serviceManifestFile string) error {
+	sm, err := provider.LoadServiceManifest(appDir, serviceManifestFile)
+	if err != nil {
+		return fmt.Errorf("failed to load service manifest %s: %w", serviceManifestFile, err)
+	}
+	if err := provider.ValidateServiceManifest(sm); err != nil {
+		return fmt.Errorf("invalid service manifest %s: %w", serviceManifestFile, err)
+	}
+	return nil
+}
+
 func (p *Planner) determineVersion(appDir, serviceManifestFile string) (string, error) {
 	sm, err := provider.LoadServiceManifest(appDir, serviceManifestFile)
 	if err != nil {
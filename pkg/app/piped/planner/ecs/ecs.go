@@ -18,15 +18,22 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/ecs"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+var containerImageRegex = regexp.MustCompile(`^ContainerDefinitions\.\d+\.Image$`)
+
 // Planner plans the deployment pipeline for ECS application.
 type Planner struct {
 }
@@ -55,14 +62,19 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	}
 
 	// Determine application version from the task definition
-	if version, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile); e != nil {
+	taskDefinitionFiles := cfg.Input.TaskDefinitionFiles()
+	if cfg.Input.SidecarOnly != nil {
+		// Only the sidecar container is updated, so its image is reported as the version.
+		taskDefinitionFiles = []string{cfg.Input.TaskDefinitionFile}
+	}
+	if version, e := determineVersion(ds.AppDir, cfg.Input.SidecarOnly, taskDefinitionFiles...); e != nil {
 		out.Version = "unknown"
 		in.Logger.Warn("unable to determine target version", zap.Error(e))
 	} else {
 		out.Version = version
 	}
 
-	if versions, e := determineVersions(ds.AppDir, cfg.Input.TaskDefinitionFile); e != nil || len(versions) == 0 {
+	if versions, e := determineVersions(ds.AppDir, cfg.Input.SidecarOnly, taskDefinitionFiles...); e != nil || len(versions) == 0 {
 		in.Logger.Warn("unable to determine target versions", zap.Error(e))
 		out.Versions = []*model.ArtifactVersion{
 			{
@@ -74,6 +86,42 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		out.Versions = versions
 	}
 
+	// Report the invalid definitions at planning time instead of failing in the middle of the deployment.
+	sds, err := validateDefinitions(ds.AppDir, cfg.Input)
+	if err != nil {
+		return
+	}
+	if len(sds) > 0 {
+		sd := sds[0]
+		if cfg.Input.CodeDeploy != nil && (sd.DeploymentController == nil || sd.DeploymentController.Type != types.DeploymentControllerTypeCodeDeploy) {
+			err = fmt.Errorf("invalid service definition %s: deployment controller of type CODE_DEPLOY is required to use codeDeploy", cfg.Input.ServiceDefinitionFile)
+			return
+		}
+		if provider.IsDaemonService(sd) {
+			if e := validateDaemonService(cfg); e != nil {
+				err = e
+				return
+			}
+		}
+	}
+
+	if e := validateCodeDeploy(cfg); e != nil {
+		err = e
+		return
+	}
+
+	// Fail fast when the secrets referred from the task definition are not accessible
+	// instead of failing when ECS starts the tasks.
+	if e := validateSecrets(ctx, &in, ds.AppDir, cfg.Input); e != nil {
+		err = fmt.Errorf("invalid secret reference: %w", e)
+		return
+	}
+
+	if e := validateServiceConnect(ctx, &in, cfg.Input, sds); e != nil {
+		err = fmt.Errorf("invalid Service Connect configuration: %w", e)
+		return
+	}
+
 	autoRollback := *cfg.Input.AutoRollback
 
 	// In case the strategy has been decided by trigger.
@@ -111,6 +159,14 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		return
 	}
 
+	// Scheduled tasks are not running continuously so there is nothing to be rolled out progressively.
+	if cfg.Input.IsScheduledTask() {
+		out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+		out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+		out.Summary = fmt.Sprintf("Quick sync to update the scheduled task to run image %s", out.Version)
+		return
+	}
+
 	// If this is the first time to deploy this application or it was unable to retrieve last successful commit,
 	// we perform the quick sync strategy.
 	if in.MostRecentSuccessfulCommitHash == "" {
@@ -120,37 +176,470 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		return
 	}
 
-	// Load service manifest at the last deployed commit to decide running version.
-	ds, err = in.RunningDSP.Get(ctx, io.Discard)
-	if err == nil {
-		if lastVersion, e := determineVersion(ds.AppDir, cfg.Input.TaskDefinitionFile); e == nil {
-			out.SyncStrategy = model.SyncStrategy_PIPELINE
-			out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
-			out.Summary = fmt.Sprintf("Sync with pipeline to update image from %s to %s", lastVersion, out.Version)
+	// Load the task definition and service definition of the previously applied commit.
+	runningDs, err := in.RunningDSP.Get(ctx, io.Discard)
+	if err != nil {
+		err = fmt.Errorf("failed to prepare the running deploy source data (%v)", err)
+		return
+	}
+
+	runningCfg := runningDs.ApplicationConfig.ECSApplicationSpec
+	if runningCfg == nil {
+		err = fmt.Errorf("unable to find the running configuration")
+		return
+	}
+
+	olds, err := loadDefinitions(runningDs.AppDir, runningCfg.Input)
+	if err != nil {
+		err = fmt.Errorf("failed to load previously deployed definitions: %w", err)
+		return
+	}
+	news, err := loadDefinitions(ds.AppDir, cfg.Input)
+	if err != nil {
+		err = fmt.Errorf("failed to load definitions: %w", err)
+		return
+	}
+
+	// The images of the containers other than the sidecar are not updated, so their changes are ignored.
+	if sidecar := cfg.Input.SidecarOnly; sidecar != nil {
+		news.taskDefinition = provider.KeepContainerImages(news.taskDefinition, olds.taskDefinition, sidecar.ContainerName)
+	}
+
+	progressive, desc := decideStrategy(olds, news)
+	out.Summary = desc
+
+	if progressive {
+		out.SyncStrategy = model.SyncStrategy_PIPELINE
+		out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
+		return
+	}
+
+	out.SyncStrategy = model.SyncStrategy_QUICK_SYNC
+	out.Stages = buildQuickSyncPipeline(autoRollback, time.Now())
+	return
+}
+
+// validateDefinitions loads and validates the task and service definitions of the given input.
+// The loaded service definitions are returned in the order of the main service and the additional services.
+func validateDefinitions(appDir string, input config.ECSDeploymentInput) ([]types.Service, error) {
+	if input.IsStandaloneTask() {
+		td, err := provider.LoadTaskDefinition(appDir, input.TaskDefinitionFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load task definition %s: %w", input.TaskDefinitionFile, err)
+		}
+		// The launch type of the scheduled task is defined in the scheduled task file.
+		var launchType types.LaunchType
+		if !input.IsScheduledTask() {
+			launchType = types.LaunchType(input.LaunchType)
+		}
+		if err := provider.ValidateTaskDefinition(td, launchType); err != nil {
+			return nil, fmt.Errorf("invalid task definition %s: %w", input.TaskDefinitionFile, err)
+		}
+		return nil, nil
+	}
+
+	services := append([]config.ECSServiceInput{{
+		ServiceDefinitionFile: input.ServiceDefinitionFile,
+		TaskDefinitionFile:    input.TaskDefinitionFile,
+	}}, input.AdditionalServices...)
+
+	sds := make([]types.Service, 0, len(services))
+	for i, s := range services {
+		td, err := provider.LoadTaskDefinition(appDir, s.TaskDefinitionFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load task definition %s: %w", s.TaskDefinitionFile, err)
+		}
+		sd, err := provider.LoadServiceDefinition(appDir, s.ServiceDefinitionFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load service definition %s: %w", s.ServiceDefinitionFile, err)
+		}
+		if err := provider.ValidateServiceDefinition(sd); err != nil {
+			return nil, fmt.Errorf("invalid service definition %s: %w", s.ServiceDefinitionFile, err)
+		}
+		// The target groups are attached only to the main service.
+		var targetGroups config.ECSTargetGroups
+		if i == 0 {
+			targetGroups = input.TargetGroups
+			if input.SidecarOnly != nil {
+				if _, ok := provider.FindContainerDefinition(td, input.SidecarOnly.ContainerName); !ok {
+					return nil, fmt.Errorf("invalid task definition %s: container %s specified by sidecarOnly is not defined", s.TaskDefinitionFile, input.SidecarOnly.ContainerName)
+				}
+			}
+		}
+		if err := provider.ValidateServiceTaskDefinition(td, sd, targetGroups); err != nil {
+			return nil, fmt.Errorf("invalid task definition %s: %w", s.TaskDefinitionFile, err)
+		}
+		sds = append(sds, sd)
+	}
+	return sds, nil
+}
+
+func validateSecrets(ctx context.Context, in *planner.Input, appDir string, input config.ECSDeploymentInput) error {
+	tds := make([]types.TaskDefinition, 0, len(input.AdditionalServices)+1)
+	for _, f := range input.TaskDefinitionFiles() {
+		td, err := provider.LoadTaskDefinition(appDir, f)
+		if err != nil {
+			// The invalid task definition will be reported while deploying.
+			continue
+		}
+		tds = append(tds, td)
+	}
+	if len(tds) == 0 {
+		return nil
+	}
+
+	client, ok, err := newClient(in, input)
+	if !ok || err != nil {
+		return err
+	}
+	for _, td := range tds {
+		if err := client.ValidateSecrets(ctx, td); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateServiceConnect checks whether the Cloud Map namespaces used by Service Connect
+// of the given services exist.
+func validateServiceConnect(ctx context.Context, in *planner.Input, input config.ECSDeploymentInput, sds []types.Service) error {
+	var services []types.Service
+	for _, sd := range sds {
+		if c := provider.ServiceConnectConfiguration(sd); c != nil && c.Enabled {
+			services = append(services, sd)
+		}
+	}
+	if len(services) == 0 {
+		return nil
+	}
+	// The CANARY task set joins the namespace of the service, so the traffic can not be routed by App Mesh.
+	if input.IsAccessedViaAppMesh() {
+		return fmt.Errorf("serviceConnectConfiguration can not be used with accessType %s", config.AccessTypeAppMesh)
+	}
+
+	client, ok, err := newClient(in, input)
+	if !ok || err != nil {
+		return err
+	}
+	for _, sd := range services {
+		if err := client.ValidateServiceConnectNamespace(ctx, sd); err != nil {
+			return fmt.Errorf("service %s: %w", aws.ToString(sd.ServiceName), err)
+		}
+	}
+	return nil
+}
+
+// newClient returns the ECS client of the platform provider of the given input.
+// False is returned when the platform provider was not found so that the validation is skipped.
+func newClient(in *planner.Input, input config.ECSDeploymentInput) (provider.Client, bool, error) {
+	cp, ok := in.PipedConfig.FindPlatformProvider(in.PlatformProviderName, model.ApplicationKind_ECS)
+	if !ok {
+		in.Logger.Warn("unable to validate the definitions because the platform provider was not found",
+			zap.String("platform-provider", in.PlatformProviderName),
+		)
+		return nil, false, nil
+	}
+
+	cfg := provider.WithAssumeRole(cp.ECSConfig, input)
+	client, err := provider.DefaultRegistry().Client(in.PlatformProviderName, cfg, in.Logger)
+	if err != nil {
+		return nil, false, err
+	}
+	return client, true, nil
+}
+
+// validateDaemonService returns an error when the given application configuration
+// can not be used to deploy a service with DAEMON scheduling strategy.
+// Such services can not be rolled out progressively since task sets are not available for them.
+func validateDaemonService(cfg *config.ECSApplicationSpec) error {
+	if cfg.Input.AutoScaling != nil {
+		return fmt.Errorf("autoScaling can not be used for the service with DAEMON scheduling strategy")
+	}
+	if cfg.Pipeline == nil {
+		return nil
+	}
+	for _, s := range cfg.Pipeline.Stages {
+		switch s.Name {
+		case model.StageECSCanaryRollout, model.StageECSPrimaryRollout, model.StageECSTrafficRouting, model.StageECSCanaryClean:
+			return fmt.Errorf("stage %s is not available for the service with DAEMON scheduling strategy, use ECS_SYNC stage instead", s.Name)
+		}
+	}
+	return nil
+}
+
+// validateCodeDeploy returns an error when the pipeline can not be used to deploy the service by CodeDeploy.
+// The task sets of such services are driven by CodeDeploy instead of piped.
+func validateCodeDeploy(cfg *config.ECSApplicationSpec) error {
+	if cfg.Input.CodeDeploy == nil || cfg.Pipeline == nil {
+		return nil
+	}
+	var deployed bool
+	for _, s := range cfg.Pipeline.Stages {
+		switch s.Name {
+		case model.StageECSCanaryRollout, model.StageECSPrimaryRollout, model.StageECSTrafficRouting, model.StageECSCanaryClean:
+			return fmt.Errorf("stage %s is not available for the service deployed by CodeDeploy, use %s and %s stages instead", s.Name, model.StageECSCodeDeployDeploy, model.StageECSCodeDeployContinue)
+		case model.StageECSCodeDeployDeploy:
+			deployed = true
+		case model.StageECSCodeDeployContinue:
+			if !deployed {
+				return fmt.Errorf("stage %s must be placed after %s stage", s.Name, model.StageECSCodeDeployDeploy)
+			}
+		}
+	}
+	return nil
+}
+
+type definitions struct {
+	taskDefinition types.TaskDefinition
+	// Nil in case of standalone task.
+	serviceDefinition *types.Service
+	// Nil in case the auto scaling is not managed by piped.
+	autoScaling *config.ECSAutoScaling
+	// The definitions of the services deployed together with the main service.
+	additionalServices []definitions
+}
+
+func loadDefinitions(appDir string, input config.ECSDeploymentInput) (definitions, error) {
+	defs := definitions{
+		autoScaling: input.AutoScaling,
+	}
+
+	td, err := provider.LoadTaskDefinition(appDir, input.TaskDefinitionFile)
+	if err != nil {
+		return defs, err
+	}
+	defs.taskDefinition = td
+
+	if input.IsStandaloneTask() {
+		return defs, nil
+	}
+
+	sd, err := provider.LoadServiceDefinition(appDir, input.ServiceDefinitionFile)
+	if err != nil {
+		return defs, err
+	}
+	defs.serviceDefinition = &sd
+
+	for _, s := range input.AdditionalServices {
+		td, err := provider.LoadTaskDefinition(appDir, s.TaskDefinitionFile)
+		if err != nil {
+			return defs, err
+		}
+		sd, err := provider.LoadServiceDefinition(appDir, s.ServiceDefinitionFile)
+		if err != nil {
+			return defs, err
+		}
+		defs.additionalServices = append(defs.additionalServices, definitions{
+			taskDefinition:    td,
+			serviceDefinition: &sd,
+		})
+	}
+
+	return defs, nil
+}
+
+// decideStrategy compares the running definitions with the new ones
+// and decides to perform the progressive pipeline only when the image tags
+// of the containers are the only changes. Quick sync is used for all other changes
+// including the changes of the auto scaling configuration and the additional services.
+func decideStrategy(olds, news definitions) (progressive bool, desc string) {
+	switch {
+	case olds.serviceDefinition == nil && news.serviceDefinition == nil:
+		break
+	case olds.serviceDefinition == nil || news.serviceDefinition == nil:
+		desc = "Quick sync by applying all definitions because the service definition was added or removed"
+		return
+	default:
+		if reason := diffServiceDefinitions(*olds.serviceDefinition, *news.serviceDefinition); reason != "" {
+			desc = "Quick sync by applying all definitions " + reason
+			return
+		}
+	}
+
+	switch {
+	case olds.autoScaling == nil && news.autoScaling == nil:
+		break
+	case olds.autoScaling == nil || news.autoScaling == nil:
+		desc = "Quick sync by applying all definitions because the auto scaling configuration was added or removed"
+		return
+	default:
+		result, err := provider.DiffAutoScalings(*olds.autoScaling, *news.autoScaling)
+		if err != nil {
+			desc = fmt.Sprintf("Quick sync by applying all definitions due to an error while calculating the diff (%v)", err)
+			return
+		}
+		if result.HasDiff() {
+			desc = fmt.Sprintf("Quick sync by applying all definitions because %s of the auto scaling configuration was changed", result.Nodes()[0].PathString)
 			return
 		}
 	}
 
-	out.SyncStrategy = model.SyncStrategy_PIPELINE
-	out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
-	out.Summary = "Sync with the specified pipeline"
+	if len(olds.additionalServices) != len(news.additionalServices) {
+		desc = "Quick sync by applying all definitions because the additional services were added or removed"
+		return
+	}
+
+	images, reason := findImageUpdates(olds.taskDefinition, news.taskDefinition)
+	if reason != "" {
+		desc = "Quick sync by applying all definitions " + reason
+		return
+	}
+
+	for i, news := range news.additionalServices {
+		olds := olds.additionalServices[i]
+		name := aws.ToString(news.serviceDefinition.ServiceName)
+
+		if reason := diffServiceDefinitions(*olds.serviceDefinition, *news.serviceDefinition); reason != "" {
+			desc = fmt.Sprintf("Quick sync by applying all definitions %s (additional service %s)", reason, name)
+			return
+		}
+		imgs, reason := findImageUpdates(olds.taskDefinition, news.taskDefinition)
+		if reason != "" {
+			desc = fmt.Sprintf("Quick sync by applying all definitions %s (additional service %s)", reason, name)
+			return
+		}
+		images = append(images, imgs...)
+	}
+
+	if len(images) == 0 {
+		desc = "Quick sync by applying all definitions because no changes were detected"
+		return
+	}
+
+	progressive = true
+	desc = fmt.Sprintf("Sync progressively because of updating %s", strings.Join(uniqueStrings(images), ", "))
 	return
 }
 
-func determineVersion(appDir, taskDefinitonFile string) (string, error) {
-	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile)
+// diffServiceDefinitions returns the reason to perform the quick sync
+// when the given service definitions are different.
+func diffServiceDefinitions(old, new types.Service) string {
+	result, err := provider.DiffServiceDefinitions(old, new)
 	if err != nil {
-		return "", err
+		return fmt.Sprintf("due to an error while calculating the diff (%v)", err)
+	}
+	if result.HasDiff() {
+		return fmt.Sprintf("because %s of the service definition was changed", result.Nodes()[0].PathString)
+	}
+	return ""
+}
+
+// findImageUpdates returns the updated images between the given task definitions.
+// The reason to perform the quick sync is returned when the task definition
+// was changed by other than updating the image tags.
+func findImageUpdates(old, new types.TaskDefinition) (images []string, reason string) {
+	result, err := provider.DiffTaskDefinitions(old, new)
+	if err != nil {
+		reason = fmt.Sprintf("due to an error while calculating the diff (%v)", err)
+		return
+	}
+
+	nodes := result.Nodes()
+	images = make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		if !containerImageRegex.MatchString(n.PathString) {
+			reason = fmt.Sprintf("because %s of the task definition was changed", n.PathString)
+			return
+		}
+
+		beforeImg := parseContainerImage(n.StringX())
+		afterImg := parseContainerImage(n.StringY())
+		if beforeImg.name != afterImg.name {
+			reason = fmt.Sprintf("because image %s was replaced by %s", beforeImg.name, afterImg.name)
+			return
+		}
+		images = append(images, fmt.Sprintf("image %s from %s to %s", beforeImg.name, beforeImg.tag, afterImg.tag))
+	}
+	return
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	out := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		out = append(out, v)
 	}
+	return out
+}
 
-	return provider.FindImageTag(taskDefinition)
+type containerImage struct {
+	name string
+	tag  string
 }
 
-func determineVersions(appDir, taskDefinitonFile string) ([]*model.ArtifactVersion, error) {
-	taskDefinition, err := provider.LoadTaskDefinition(appDir, taskDefinitonFile)
+func parseContainerImage(image string) (img containerImage) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		img.tag = image[i+1:]
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		if img.tag == "" {
+			img.tag = image[i+1:]
+		}
+		image = image[:i]
+	}
+	paths := strings.Split(image, "/")
+	img.name = paths[len(paths)-1]
+	return
+}
+
+// determineVersion returns the version of the given task definitions.
+// The images shared between the task definitions are reported only once.
+// Only the image of the sidecar container is reported when sidecarOnly is specified.
+func determineVersion(appDir string, sidecarOnly *config.ECSSidecarOnly, taskDefinitionFiles ...string) (string, error) {
+	versions, err := determineVersions(appDir, sidecarOnly, taskDefinitionFiles...)
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	return provider.FindArtifactVersions(taskDefinition)
+	// In case the task is containing only one container
+	// return only the tag name.
+	if len(versions) == 1 {
+		return versions[0].Version, nil
+	}
+
+	// In case multiple containers are used
+	// return version in format: "tag-1 (name-1), tag-2 (name-2)"
+	parts := make([]string, 0, len(versions))
+	for _, v := range versions {
+		parts = append(parts, fmt.Sprintf("%s (%s)", v.Version, v.Name))
+	}
+	return strings.Join(parts, ", "), nil
+}
+
+func determineVersions(appDir string, sidecarOnly *config.ECSSidecarOnly, taskDefinitionFiles ...string) ([]*model.ArtifactVersion, error) {
+	var (
+		versions []*model.ArtifactVersion
+		images   = make(map[string]struct{})
+	)
+	for _, f := range taskDefinitionFiles {
+		taskDefinition, err := provider.LoadTaskDefinition(appDir, f)
+		if err != nil {
+			return nil, err
+		}
+		if sidecarOnly != nil {
+			cd, ok := provider.FindContainerDefinition(taskDefinition, sidecarOnly.ContainerName)
+			if !ok {
+				return nil, fmt.Errorf("container %s was not found in task definition %s", sidecarOnly.ContainerName, f)
+			}
+			taskDefinition.ContainerDefinitions = []types.ContainerDefinition{cd}
+		}
+
+		vs, err := provider.FindArtifactVersions(taskDefinition)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vs {
+			if _, ok := images[v.Url]; ok {
+				continue
+			}
+			images[v.Url] = struct{}{}
+			versions = append(versions, v)
+		}
+	}
+	return versions, nil
 }
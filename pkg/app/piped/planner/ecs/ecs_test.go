@@ -0,0 +1,701 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDecideStrategy(t *testing.T) {
+	t.Parallel()
+
+	taskDefinition := func(cpu string, images ...string) types.TaskDefinition {
+		td := types.TaskDefinition{
+			Family: aws.String("nginx"),
+			Cpu:    aws.String(cpu),
+		}
+		for i, image := range images {
+			td.ContainerDefinitions = append(td.ContainerDefinitions, types.ContainerDefinition{
+				Name:  aws.String(string(rune('a' + i))),
+				Image: aws.String(image),
+			})
+		}
+		return td
+	}
+	serviceDefinition := func(desiredCount int32) *types.Service {
+		return &types.Service{
+			ServiceName:  aws.String("nginx"),
+			DesiredCount: desiredCount,
+		}
+	}
+
+	autoScaling := func(maxCapacity int32, targetValue string) *config.ECSAutoScaling {
+		return &config.ECSAutoScaling{
+			MinCapacity: 1,
+			MaxCapacity: maxCapacity,
+			Policies: []config.ECSScalingPolicy{
+				{
+					Name:                                     "cpu",
+					PolicyType:                               config.ECSScalingPolicyTypeTargetTracking,
+					TargetTrackingScalingPolicyConfiguration: []byte(`{"targetValue": ` + targetValue + `}`),
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name            string
+		olds            definitions
+		news            definitions
+		wantProgressive bool
+		wantDesc        string
+	}{
+		{
+			name: "no changes",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because no changes were detected",
+		},
+		{
+			name: "only image tag was changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			wantProgressive: true,
+			wantDesc:        "Sync progressively because of updating image helloworld from v1.0.0 to v1.1.0",
+		},
+		{
+			name: "image tags of multiple containers were changed",
+			olds: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0", "gcr.io/pipecd/envoy:v1.20.0"),
+			},
+			news: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0", "gcr.io/pipecd/envoy:v1.21.0"),
+			},
+			wantProgressive: true,
+			wantDesc:        "Sync progressively because of updating image helloworld from v1.0.0 to v1.1.0, image envoy from v1.20.0 to v1.21.0",
+		},
+		{
+			name: "image name was changed",
+			olds: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+			},
+			news: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/hello:v1.0.0"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because image helloworld was replaced by hello",
+		},
+		{
+			name: "image tag and task definition structure were changed",
+			olds: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+			},
+			news: definitions{
+				taskDefinition: taskDefinition("512", "gcr.io/pipecd/helloworld:v1.1.0"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because Cpu of the task definition was changed",
+		},
+		{
+			name: "container was added",
+			olds: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+			},
+			news: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0", "gcr.io/pipecd/envoy:v1.20.0"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because ContainerDefinitions.1 of the task definition was changed",
+		},
+		{
+			name: "service definition was changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(3),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because DesiredCount of the service definition was changed",
+		},
+		{
+			name: "service definition was added",
+			olds: definitions{
+				taskDefinition: taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because the service definition was added or removed",
+		},
+		{
+			name: "only auto scaling policy was changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "70"),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "50"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because policies.0.targetTrackingScalingPolicyConfiguration.targetValue of the auto scaling configuration was changed",
+		},
+		{
+			name: "image tag and auto scaling capacity were changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "70"),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(8, "70"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because maxCapacity of the auto scaling configuration was changed",
+		},
+		{
+			name: "auto scaling was added",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "70"),
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because the auto scaling configuration was added or removed",
+		},
+		{
+			name: "auto scaling was not changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "70"),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+				autoScaling:       autoScaling(4, "70"),
+			},
+			wantProgressive: true,
+			wantDesc:        "Sync progressively because of updating image helloworld from v1.0.0 to v1.1.0",
+		},
+		{
+			name: "image tag of main and additional services were changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				additionalServices: []definitions{
+					{
+						taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0", "gcr.io/pipecd/worker:v1.0.0"),
+						serviceDefinition: serviceDefinition(1),
+					},
+				},
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+				additionalServices: []definitions{
+					{
+						taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0", "gcr.io/pipecd/worker:v1.1.0"),
+						serviceDefinition: serviceDefinition(1),
+					},
+				},
+			},
+			wantProgressive: true,
+			wantDesc:        "Sync progressively because of updating image helloworld from v1.0.0 to v1.1.0, image worker from v1.0.0 to v1.1.0",
+		},
+		{
+			name: "service definition of additional service was changed",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+				additionalServices: []definitions{
+					{
+						taskDefinition:    taskDefinition("256", "gcr.io/pipecd/worker:v1.0.0"),
+						serviceDefinition: serviceDefinition(1),
+					},
+				},
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+				additionalServices: []definitions{
+					{
+						taskDefinition:    taskDefinition("256", "gcr.io/pipecd/worker:v1.1.0"),
+						serviceDefinition: serviceDefinition(3),
+					},
+				},
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because DesiredCount of the service definition was changed (additional service nginx)",
+		},
+		{
+			name: "additional service was added",
+			olds: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.0.0"),
+				serviceDefinition: serviceDefinition(2),
+			},
+			news: definitions{
+				taskDefinition:    taskDefinition("256", "gcr.io/pipecd/helloworld:v1.1.0"),
+				serviceDefinition: serviceDefinition(2),
+				additionalServices: []definitions{
+					{
+						taskDefinition:    taskDefinition("256", "gcr.io/pipecd/worker:v1.1.0"),
+						serviceDefinition: serviceDefinition(1),
+					},
+				},
+			},
+			wantProgressive: false,
+			wantDesc:        "Quick sync by applying all definitions because the additional services were added or removed",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotProgressive, gotDesc := decideStrategy(tc.olds, tc.news)
+			assert.Equal(t, tc.wantProgressive, gotProgressive)
+			assert.Equal(t, tc.wantDesc, gotDesc)
+		})
+	}
+}
+
+func TestDetermineVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name           string
+		taskDefinition string
+		want           string
+	}{
+		{
+			name: "single container",
+			taskDefinition: `
+family: nginx
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+`,
+			want: "v1.0.0",
+		},
+		{
+			name: "app and sidecar containers",
+			taskDefinition: `
+family: nginx
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+  - name: envoy
+    image: public.ecr.aws/appmesh/aws-appmesh-envoy:v1.25.1.0-prod
+`,
+			want: "v1.0.0 (helloworld), v1.25.1.0-prod (aws-appmesh-envoy)",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "taskdef.yaml"), []byte(tc.taskDefinition), 0644))
+
+			got, err := determineVersion(dir, nil, "taskdef.yaml")
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestDetermineVersionOfMultipleServices(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "taskdef.yaml"), []byte(`
+family: web
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "worker-taskdef.yaml"), []byte(`
+family: worker
+containerDefinitions:
+  - name: worker
+    image: gcr.io/pipecd/helloworld:v1.0.0
+  - name: fluentbit
+    image: public.ecr.aws/aws-observability/aws-for-fluent-bit:2.31.0
+`), 0644))
+
+	got, err := determineVersion(dir, nil, "taskdef.yaml", "worker-taskdef.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.0.0 (helloworld), 2.31.0 (aws-for-fluent-bit)", got)
+}
+
+func TestDetermineVersionOfSidecar(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "taskdef.yaml"), []byte(`
+family: web
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+  - name: envoy
+    image: public.ecr.aws/appmesh/aws-appmesh-envoy:v1.25.4.0-prod
+`), 0644))
+
+	got, err := determineVersion(dir, &config.ECSSidecarOnly{ContainerName: "envoy"}, "taskdef.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "v1.25.4.0-prod", got)
+
+	_, err = determineVersion(dir, &config.ECSSidecarOnly{ContainerName: "log_router"}, "taskdef.yaml")
+	assert.Error(t, err)
+}
+
+func TestValidateDefinitions(t *testing.T) {
+	t.Parallel()
+
+	const (
+		taskDefinition = `
+family: web
+networkMode: awsvpc
+requiresCompatibilities:
+  - FARGATE
+cpu: "256"
+memory: "512"
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+    portMappings:
+      - containerPort: 9085
+`
+		serviceDefinition = `
+serviceName: web
+cluster: arn:aws:ecs:ap-northeast-1:123456789012:cluster/test
+desiredCount: 2
+launchType: FARGATE
+`
+	)
+
+	testcases := []struct {
+		name              string
+		taskDefinition    string
+		serviceDefinition string
+		input             config.ECSDeploymentInput
+		wantServices      int
+		expectErr         bool
+	}{
+		{
+			name:              "valid service",
+			taskDefinition:    taskDefinition,
+			serviceDefinition: serviceDefinition,
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "servicedef.yaml",
+				TargetGroups: config.ECSTargetGroups{
+					Primary: []byte(`{"targetGroupArn":"arn","containerName":"web","containerPort":9085}`),
+				},
+			},
+			wantServices: 1,
+		},
+		{
+			name:              "target group port is not exposed",
+			taskDefinition:    taskDefinition,
+			serviceDefinition: serviceDefinition,
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "servicedef.yaml",
+				TargetGroups: config.ECSTargetGroups{
+					Primary: []byte(`{"targetGroupArn":"arn","containerName":"web","containerPort":80}`),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:              "missing service name",
+			taskDefinition:    taskDefinition,
+			serviceDefinition: "cluster: test\n",
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "servicedef.yaml",
+			},
+			expectErr: true,
+		},
+		{
+			name:              "invalid fargate memory",
+			taskDefinition:    strings.Replace(taskDefinition, `memory: "512"`, `memory: "4096"`, 1),
+			serviceDefinition: serviceDefinition,
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "servicedef.yaml",
+			},
+			expectErr: true,
+		},
+		{
+			name:           "standalone task on fargate",
+			taskDefinition: strings.Replace(taskDefinition, "networkMode: awsvpc", "networkMode: bridge", 1),
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile: "taskdef.yaml",
+				LaunchType:         "FARGATE",
+			},
+			expectErr: true,
+		},
+		{
+			name:              "sidecar container is not defined",
+			taskDefinition:    taskDefinition,
+			serviceDefinition: serviceDefinition,
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "servicedef.yaml",
+				SidecarOnly:           &config.ECSSidecarOnly{ContainerName: "envoy"},
+			},
+			expectErr: true,
+		},
+		{
+			name:              "missing service definition file",
+			taskDefinition:    taskDefinition,
+			serviceDefinition: serviceDefinition,
+			input: config.ECSDeploymentInput{
+				TaskDefinitionFile:    "taskdef.yaml",
+				ServiceDefinitionFile: "not-found.yaml",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "taskdef.yaml"), []byte(tc.taskDefinition), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "servicedef.yaml"), []byte(tc.serviceDefinition), 0644))
+
+			sds, err := validateDefinitions(dir, tc.input)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+			assert.Len(t, sds, tc.wantServices)
+		})
+	}
+}
+
+func TestValidateDaemonService(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		cfg       *config.ECSApplicationSpec
+		expectErr bool
+	}{
+		{
+			name: "quick sync",
+			cfg:  &config.ECSApplicationSpec{},
+		},
+		{
+			name: "pipeline with sync stage",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: &config.DeploymentPipeline{
+						Stages: []config.PipelineStage{
+							{Name: model.StageWaitApproval},
+							{Name: model.StageECSSync},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "progressive pipeline",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: &config.DeploymentPipeline{
+						Stages: []config.PipelineStage{
+							{Name: model.StageECSCanaryRollout},
+							{Name: model.StageECSPrimaryRollout},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "auto scaling",
+			cfg: &config.ECSApplicationSpec{
+				Input: config.ECSDeploymentInput{
+					AutoScaling: &config.ECSAutoScaling{MinCapacity: 1, MaxCapacity: 2},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateDaemonService(tc.cfg)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestValidateServiceConnect(t *testing.T) {
+	t.Parallel()
+
+	withServiceConnect := func(enabled bool) types.Service {
+		return types.Service{
+			ServiceName: aws.String("nginx"),
+			Deployments: []types.Deployment{
+				{
+					Status: aws.String("PRIMARY"),
+					ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+						Enabled:   enabled,
+						Namespace: aws.String("internal"),
+					},
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name      string
+		input     config.ECSDeploymentInput
+		sds       []types.Service
+		expectErr bool
+	}{
+		{
+			name:  "service connect is not configured",
+			input: config.ECSDeploymentInput{AccessType: config.AccessTypeAppMesh},
+			sds:   []types.Service{{ServiceName: aws.String("nginx")}},
+		},
+		{
+			name:  "service connect is disabled",
+			input: config.ECSDeploymentInput{AccessType: config.AccessTypeAppMesh},
+			sds:   []types.Service{withServiceConnect(false)},
+		},
+		{
+			name:      "service connect with app mesh",
+			input:     config.ECSDeploymentInput{AccessType: config.AccessTypeAppMesh},
+			sds:       []types.Service{withServiceConnect(true)},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateServiceConnect(context.Background(), &planner.Input{}, tc.input, tc.sds)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestValidateCodeDeploy(t *testing.T) {
+	t.Parallel()
+
+	pipeline := func(stages ...model.Stage) *config.DeploymentPipeline {
+		p := &config.DeploymentPipeline{}
+		for _, s := range stages {
+			p.Stages = append(p.Stages, config.PipelineStage{Name: s})
+		}
+		return p
+	}
+	codeDeploy := &config.ECSCodeDeploy{ApplicationName: "app", DeploymentGroupName: "group"}
+
+	testcases := []struct {
+		name      string
+		cfg       *config.ECSApplicationSpec
+		expectErr bool
+	}{
+		{
+			name: "task set pipeline without codeDeploy",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: pipeline(model.StageECSCanaryRollout, model.StageECSPrimaryRollout),
+				},
+			},
+		},
+		{
+			name: "quick sync",
+			cfg: &config.ECSApplicationSpec{
+				Input: config.ECSDeploymentInput{CodeDeploy: codeDeploy},
+			},
+		},
+		{
+			name: "codedeploy pipeline",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: pipeline(model.StageECSCodeDeployDeploy, model.StageWaitApproval, model.StageECSCodeDeployContinue),
+				},
+				Input: config.ECSDeploymentInput{CodeDeploy: codeDeploy},
+			},
+		},
+		{
+			name: "task set stage",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: pipeline(model.StageECSCanaryRollout, model.StageECSCodeDeployDeploy),
+				},
+				Input: config.ECSDeploymentInput{CodeDeploy: codeDeploy},
+			},
+			expectErr: true,
+		},
+		{
+			name: "continue before deploy",
+			cfg: &config.ECSApplicationSpec{
+				GenericApplicationSpec: config.GenericApplicationSpec{
+					Pipeline: pipeline(model.StageECSCodeDeployContinue, model.StageECSCodeDeployDeploy),
+				},
+				Input: config.ECSDeploymentInput{CodeDeploy: codeDeploy},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCodeDeploy(tc.cfg)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -52,3 +53,40 @@ func TestBuildQuickSyncPipeline(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildProgressivePipeline(t *testing.T) {
+	t.Parallel()
+
+	pipeline := &config.DeploymentPipeline{
+		Stages: []config.PipelineStage{
+			{
+				Name: model.StageECSCanaryRollout,
+				ECSCanaryRolloutStageOptions: &config.ECSCanaryRolloutStageOptions{
+					Scale: config.Percentage{Number: 30},
+				},
+			},
+			{
+				Name: model.StageECSPrimaryRollout,
+			},
+			{
+				Name: model.StageECSCanaryClean,
+			},
+		},
+	}
+
+	stages := buildProgressivePipeline(pipeline, true, time.Now())
+	names := make([]string, 0, len(stages))
+	for _, s := range stages {
+		names = append(names, s.Name)
+	}
+
+	expected := []string{
+		string(model.StageECSCanaryRollout),
+		string(model.StageECSPrimaryRollout),
+		string(model.StageECSCanaryClean),
+		string(model.StageRollback),
+	}
+	assert.Equal(t, expected, names)
+	assert.Equal(t, []string{"stage-0"}, stages[1].Requires)
+	assert.Equal(t, []string{"stage-1"}, stages[2].Requires)
+}
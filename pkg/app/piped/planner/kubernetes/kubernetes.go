@@ -68,6 +68,7 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		chartRepoName := cfg.Input.HelmChart.Repository
 		if chartRepoName != "" {
 			cfg.Input.HelmChart.Insecure = in.PipedConfig.IsInsecureChartRepository(chartRepoName)
+			cfg.Input.HelmChart.OCIAddress = in.PipedConfig.OCIChartRepositoryAddress(chartRepoName)
 		}
 	}
 
@@ -214,7 +215,7 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		manifestCache.Put(in.MostRecentSuccessfulCommitHash, oldManifests)
 	}
 
-	progressive, desc := decideStrategy(oldManifests, newManifests, cfg.Workloads, in.Logger)
+	progressive, desc := decideStrategy(oldManifests, newManifests, cfg.Workloads, in.Logger, diff.WithIgnoredFieldPaths(cfg.IgnoreFields))
 	out.Summary = desc
 
 	if progressive {
@@ -230,7 +231,7 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 
 // First up, checks to see if the workload's `spec.template` has been changed,
 // and then checks if the configmap/secret's data.
-func decideStrategy(olds, news []provider.Manifest, workloadRefs []config.K8sResourceReference, logger *zap.Logger) (progressive bool, desc string) {
+func decideStrategy(olds, news []provider.Manifest, workloadRefs []config.K8sResourceReference, logger *zap.Logger, opts ...diff.Option) (progressive bool, desc string) {
 	oldWorkloads := findWorkloadManifests(olds, workloadRefs)
 	if len(oldWorkloads) == 0 {
 		desc = "Quick sync by applying all manifests because it was unable to find the currently running workloads"
@@ -248,7 +249,7 @@ func decideStrategy(olds, news []provider.Manifest, workloadRefs []config.K8sRes
 	for _, w := range workloads {
 		// If the workload's pod template was touched
 		// do progressive deployment with the specified pipeline.
-		diffResult, err := provider.Diff(w.old, w.new, logger)
+		diffResult, err := provider.Diff(w.old, w.new, logger, opts...)
 		if err != nil {
 			progressive = true
 			desc = fmt.Sprintf("Sync progressively due to an error while calculating the diff (%v)", err)
@@ -292,7 +293,7 @@ func decideStrategy(olds, news []provider.Manifest, workloadRefs []config.K8sRes
 			desc = fmt.Sprintf("Sync progressively because %s %s was deleted", oc.Key.Kind, oc.Key.Name)
 			return
 		}
-		result, err := provider.Diff(oc, nc, logger)
+		result, err := provider.Diff(oc, nc, logger, opts...)
 		if err != nil {
 			progressive = true
 			desc = fmt.Sprintf("Sync progressively due to an error while calculating the diff (%v)", err)
@@ -16,14 +16,17 @@ package lambda
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
 	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/lambda"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -55,14 +58,14 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	}
 
 	// Determine application version from the manifest
-	if version, e := determineVersion(ds.AppDir, cfg.Input.FunctionManifestFile); e != nil {
+	if version, e := determineVersion(ds.AppDir, cfg.Input); e != nil {
 		out.Version = "unknown"
 		in.Logger.Warn("unable to determine target version", zap.Error(e))
 	} else {
 		out.Version = version
 	}
 
-	if versions, e := determineVersions(ds.AppDir, cfg.Input.FunctionManifestFile); e != nil || len(versions) == 0 {
+	if versions, e := determineVersions(ds.AppDir, cfg.Input); e != nil || len(versions) == 0 {
 		in.Logger.Warn("unable to determine target versions", zap.Error(e))
 		out.Versions = []*model.ArtifactVersion{
 			{
@@ -74,6 +77,18 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		out.Versions = versions
 	}
 
+	// Use the digest of the container image as the version since its tag can be moved to another image.
+	if digest, e := determineImageDigest(ctx, &in, ds.AppDir, cfg.Input); e != nil {
+		in.Logger.Warn("unable to determine image digest", zap.Error(e))
+	} else if digest != "" {
+		out.Version = digest
+		for _, v := range out.Versions {
+			if v.Kind == model.ArtifactVersion_CONTAINER_IMAGE {
+				v.Version = digest
+			}
+		}
+	}
+
 	autoRollback := *cfg.Input.AutoRollback
 
 	// In case the strategy has been decided by trigger.
@@ -123,7 +138,7 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	// Load service manifest at the last deployed commit to decide running version.
 	ds, err = in.RunningDSP.Get(ctx, io.Discard)
 	if err == nil {
-		if lastVersion, e := determineVersion(ds.AppDir, cfg.Input.FunctionManifestFile); e == nil {
+		if lastVersion, e := determineVersion(ds.AppDir, cfg.Input); e == nil {
 			out.SyncStrategy = model.SyncStrategy_PIPELINE
 			out.Stages = buildProgressivePipeline(cfg.Pipeline, autoRollback, time.Now())
 			out.Summary = fmt.Sprintf("Sync with pipeline to update version from %s to %s", lastVersion, out.Version)
@@ -137,8 +152,8 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 	return
 }
 
-func determineVersion(appDir, functionManifestFile string) (string, error) {
-	fm, err := provider.LoadFunctionManifest(appDir, functionManifestFile)
+func determineVersion(appDir string, input config.LambdaDeploymentInput) (string, error) {
+	fm, err := provider.LoadFunctionManifest(appDir, input)
 	if err != nil {
 		return "", err
 	}
@@ -161,11 +176,45 @@ func determineVersion(appDir, functionManifestFile string) (string, error) {
 	return "", fmt.Errorf("unable to determine version from manifest")
 }
 
-func determineVersions(appDir, functionManifestFile string) ([]*model.ArtifactVersion, error) {
-	fm, err := provider.LoadFunctionManifest(appDir, functionManifestFile)
+func determineVersions(appDir string, input config.LambdaDeploymentInput) ([]*model.ArtifactVersion, error) {
+	fm, err := provider.LoadFunctionManifest(appDir, input)
 	if err != nil {
 		return nil, err
 	}
 
 	return provider.FindArtifactVersions(fm)
 }
+
+// determineImageDigest returns the digest of the image for the function architecture.
+// An empty string is returned when the function is not deployed from a container image
+// or the platform provider was not found.
+func determineImageDigest(ctx context.Context, in *planner.Input, appDir string, input config.LambdaDeploymentInput) (string, error) {
+	fm, err := provider.LoadFunctionManifest(appDir, input)
+	if err != nil {
+		return "", err
+	}
+	if fm.Spec.ImageURI == "" {
+		return "", nil
+	}
+
+	cp, ok := in.PipedConfig.FindPlatformProvider(in.PlatformProviderName, model.ApplicationKind_LAMBDA)
+	if !ok {
+		return "", nil
+	}
+	client, err := provider.DefaultRegistry().Client(in.PlatformProviderName, cp.LambdaConfig, in.Logger)
+	if err != nil {
+		return "", err
+	}
+	manifest, err := client.GetImageManifest(ctx, fm.Spec.ImageURI)
+	if errors.Is(err, provider.ErrNotECRImage) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	uri, err := provider.ResolveImageURI(fm, manifest)
+	if err != nil {
+		return "", err
+	}
+	return uri[strings.LastIndex(uri, "@")+1:], nil
+}
@@ -45,6 +45,7 @@ type Input struct {
 	ApplicationID                  string
 	ApplicationName                string
 	GitPath                        model.ApplicationGitPath
+	PlatformProviderName           string
 	Trigger                        model.DeploymentTrigger
 	MostRecentSuccessfulCommitHash string
 	PipedConfig                    *config.PipedSpec
@@ -15,18 +15,36 @@
 package planner
 
 import (
+	"time"
+
 	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 const (
-	PredefinedStageK8sSync            = "K8sSync"
-	PredefinedStageTerraformSync      = "TerraformSync"
-	PredefinedStageCloudRunSync       = "CloudRunSync"
-	PredefinedStageLambdaSync         = "LambdaSync"
-	PredefinedStageECSSync            = "ECSSync"
-	PredefinedStageRollback           = "Rollback"
-	PredefinedStageCustomSyncRollback = "CustomSyncRollback"
+	PredefinedStageK8sSync                  = "K8sSync"
+	PredefinedStageTerraformSync            = "TerraformSync"
+	PredefinedStageTerraformDestroyPlan     = "TerraformDestroyPlan"
+	PredefinedStageTerraformDestroyApproval = "TerraformDestroyApproval"
+	PredefinedStageTerraformDestroy         = "TerraformDestroy"
+	PredefinedStageCloudRunSync             = "CloudRunSync"
+	PredefinedStageLambdaSync               = "LambdaSync"
+	PredefinedStageECSSync                  = "ECSSync"
+	PredefinedStageAppRunnerSync            = "AppRunnerSync"
+	PredefinedStageCloudFormationSync       = "CloudFormationSync"
+	PredefinedStageNomadSync                = "NomadSync"
+	PredefinedStageContainerAppsSync        = "ContainerAppsSync"
+	PredefinedStageAppEngineSync            = "AppEngineSync"
+	PredefinedStageStepFunctionsSync        = "StepFunctionsSync"
+	PredefinedStageEC2ASGSync               = "EC2ASGSync"
+	PredefinedStageGCEMIGSync               = "GCEMIGSync"
+	PredefinedStageAzureFunctionsSync       = "AzureFunctionsSync"
+	PredefinedStageStaticSiteSync           = "StaticSiteSync"
+	PredefinedStageDockerComposeSync        = "DockerComposeSync"
+	PredefinedStageKnativeSync              = "KnativeSync"
+	PredefinedStageFlyIOSync                = "FlyIOSync"
+	PredefinedStageRollback                 = "Rollback"
+	PredefinedStageCustomSyncRollback       = "CustomSyncRollback"
 )
 
 var predefinedStages = map[string]config.PipelineStage{
@@ -40,6 +58,26 @@ var predefinedStages = map[string]config.PipelineStage{
 		Name: model.StageTerraformSync,
 		Desc: "Sync by automatically applying any detected changes",
 	},
+	PredefinedStageTerraformDestroyPlan: {
+		ID:                        PredefinedStageTerraformDestroyPlan,
+		Name:                      model.StageTerraformPlan,
+		Desc:                      "Show the resources to be destroyed",
+		TerraformPlanStageOptions: &config.TerraformPlanStageOptions{},
+	},
+	PredefinedStageTerraformDestroyApproval: {
+		ID:   PredefinedStageTerraformDestroyApproval,
+		Name: model.StageWaitApproval,
+		Desc: "Wait for an approval to destroy the resources",
+		WaitApprovalStageOptions: &config.WaitApprovalStageOptions{
+			Timeout:        config.Duration(6 * time.Hour),
+			MinApproverNum: 1,
+		},
+	},
+	PredefinedStageTerraformDestroy: {
+		ID:   PredefinedStageTerraformDestroy,
+		Name: model.StageTerraformDestroy,
+		Desc: "Destroy all resources managed by the application",
+	},
 	PredefinedStageCloudRunSync: {
 		ID:   PredefinedStageCloudRunSync,
 		Name: model.StageCloudRunSync,
@@ -55,6 +93,71 @@ var predefinedStages = map[string]config.PipelineStage{
 		Name: model.StageECSSync,
 		Desc: "Deploy the new version and configure all traffic to it",
 	},
+	PredefinedStageAppRunnerSync: {
+		ID:   PredefinedStageAppRunnerSync,
+		Name: model.StageAppRunnerSync,
+		Desc: "Deploy the new version and configure all traffic to it",
+	},
+	PredefinedStageCloudFormationSync: {
+		ID:   PredefinedStageCloudFormationSync,
+		Name: model.StageCloudFormationSync,
+		Desc: "Create a change set of the stack and execute it",
+	},
+	PredefinedStageNomadSync: {
+		ID:   PredefinedStageNomadSync,
+		Name: model.StageNomadSync,
+		Desc: "Register the new version of the job and promote it",
+	},
+	PredefinedStageContainerAppsSync: {
+		ID:   PredefinedStageContainerAppsSync,
+		Name: model.StageContainerAppsSync,
+		Desc: "Deploy a new revision and configure all traffic to it",
+	},
+	PredefinedStageAppEngineSync: {
+		ID:   PredefinedStageAppEngineSync,
+		Name: model.StageAppEngineSync,
+		Desc: "Deploy a new version and migrate all traffic to it",
+	},
+	PredefinedStageStepFunctionsSync: {
+		ID:   PredefinedStageStepFunctionsSync,
+		Name: model.StageStepFunctionsSync,
+		Desc: "Publish a new version and point the alias to it",
+	},
+	PredefinedStageEC2ASGSync: {
+		ID:   PredefinedStageEC2ASGSync,
+		Name: model.StageEC2ASGSync,
+		Desc: "Deploy the new image and replace all instances with it",
+	},
+	PredefinedStageGCEMIGSync: {
+		ID:   PredefinedStageGCEMIGSync,
+		Name: model.StageGCEMIGSync,
+		Desc: "Roll out the new instance template to all instances",
+	},
+	PredefinedStageAzureFunctionsSync: {
+		ID:   PredefinedStageAzureFunctionsSync,
+		Name: model.StageAzureFunctionsSync,
+		Desc: "Deploy the new version and swap it into production",
+	},
+	PredefinedStageStaticSiteSync: {
+		ID:   PredefinedStageStaticSiteSync,
+		Name: model.StageStaticSiteSync,
+		Desc: "Upload the site and switch the CDN to it",
+	},
+	PredefinedStageDockerComposeSync: {
+		ID:   PredefinedStageDockerComposeSync,
+		Name: model.StageDockerComposeSync,
+		Desc: "Apply the compose file to all hosts",
+	},
+	PredefinedStageKnativeSync: {
+		ID:   PredefinedStageKnativeSync,
+		Name: model.StageKnativeSync,
+		Desc: "Deploy the new revision and route all traffic to it",
+	},
+	PredefinedStageFlyIOSync: {
+		ID:   PredefinedStageFlyIOSync,
+		Name: model.StageFlyIOSync,
+		Desc: "Replace the machines with the ones of the new version",
+	},
 	PredefinedStageRollback: {
 		ID:   PredefinedStageRollback,
 		Name: model.StageRollback,
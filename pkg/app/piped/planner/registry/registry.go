@@ -19,10 +19,23 @@ import (
 	"sync"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/appengine"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/apprunner"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/azurefunctions"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/cloudformation"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/cloudrun"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/containerapps"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/ec2asg"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/ecs"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/flyio"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/gcemig"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/knative"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/kubernetes"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/lambda"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/nomad"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/stepfunctions"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/planner/terraform"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -73,4 +86,17 @@ func init() {
 	lambda.Register(defaultRegistry)
 	terraform.Register(defaultRegistry)
 	ecs.Register(defaultRegistry)
+	apprunner.Register(defaultRegistry)
+	cloudformation.Register(defaultRegistry)
+	nomad.Register(defaultRegistry)
+	containerapps.Register(defaultRegistry)
+	appengine.Register(defaultRegistry)
+	stepfunctions.Register(defaultRegistry)
+	ec2asg.Register(defaultRegistry)
+	gcemig.Register(defaultRegistry)
+	azurefunctions.Register(defaultRegistry)
+	staticsite.Register(defaultRegistry)
+	dockercompose.Register(defaultRegistry)
+	knative.Register(defaultRegistry)
+	flyio.Register(defaultRegistry)
 }
@@ -66,6 +66,41 @@ func buildQuickSyncPipeline(autoRollback bool, now time.Time) []*model.PipelineS
 	return out
 }
 
+// buildDestroyPipeline builds the pipeline to destroy all resources managed by the application.
+// The destroy plan must be approved before destroying.
+func buildDestroyPipeline(now time.Time) []*model.PipelineStage {
+	ids := []string{
+		planner.PredefinedStageTerraformDestroyPlan,
+		planner.PredefinedStageTerraformDestroyApproval,
+		planner.PredefinedStageTerraformDestroy,
+	}
+	out := make([]*model.PipelineStage, 0, len(ids))
+
+	preStageID := ""
+	for i, id := range ids {
+		s, _ := planner.GetPredefinedStage(id)
+		stage := &model.PipelineStage{
+			Id:         s.ID,
+			Name:       s.Name.String(),
+			Desc:       s.Desc,
+			Index:      int32(i),
+			Predefined: true,
+			Visible:    true,
+			Status:     model.StageStatus_STAGE_NOT_STARTED_YET,
+			Metadata:   planner.MakeInitialStageMetadata(s),
+			CreatedAt:  now.Unix(),
+			UpdatedAt:  now.Unix(),
+		}
+		if preStageID != "" {
+			stage.Requires = []string{preStageID}
+		}
+		preStageID = s.ID
+		out = append(out, stage)
+	}
+
+	return out
+}
+
 func buildProgressivePipeline(pp *config.DeploymentPipeline, autoRollback bool, now time.Time) []*model.PipelineStage {
 	var (
 		preStageID = ""
@@ -0,0 +1,46 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestBuildDestroyPipeline(t *testing.T) {
+	t.Parallel()
+
+	stages := buildDestroyPipeline(time.Now())
+	require.Len(t, stages, 3)
+
+	expected := []model.Stage{
+		model.StageTerraformPlan,
+		model.StageWaitApproval,
+		model.StageTerraformDestroy,
+	}
+	for i, stage := range stages {
+		assert.Equal(t, string(expected[i]), stage.Name)
+		assert.Equal(t, int32(i), stage.Index)
+		assert.True(t, stage.Predefined)
+		if i > 0 {
+			assert.Equal(t, []string{stages[i-1].Id}, stage.Requires)
+		}
+	}
+}
@@ -71,6 +71,11 @@ func (p *Planner) Plan(ctx context.Context, in planner.Input) (out planner.Outpu
 		out.Stages = buildProgressivePipeline(cfg.Pipeline, cfg.Input.AutoRollback, time.Now())
 		out.Summary = in.Trigger.StrategySummary
 		return
+	case model.SyncStrategy_DESTROY:
+		out.SyncStrategy = model.SyncStrategy_DESTROY
+		out.Stages = buildDestroyPipeline(time.Now())
+		out.Summary = in.Trigger.StrategySummary
+		return
 	}
 
 	now := time.Now()
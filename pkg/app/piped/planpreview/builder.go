@@ -249,6 +249,24 @@ func (b *builder) buildApp(ctx context.Context, worker int, command string, app
 		dr, err = b.terraformDiff(ctx, app, targetDSP, &buf)
 	case model.ApplicationKind_CLOUDRUN:
 		dr, err = b.cloudrundiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_CLOUDFORMATION:
+		dr, err = b.cloudformationDiff(ctx, app, targetDSP, command, &buf)
+	case model.ApplicationKind_STEPFUNCTIONS:
+		dr, err = b.stepfunctionsDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_EC2ASG:
+		dr, err = b.ec2asgDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_GCEMIG:
+		dr, err = b.gcemigDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_AZUREFUNCTIONS:
+		dr, err = b.azurefunctionsDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_STATICSITE:
+		dr, err = b.staticsiteDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_DOCKERCOMPOSE:
+		dr, err = b.dockercomposeDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_KNATIVE:
+		dr, err = b.knativeDiff(ctx, app, targetDSP, preCommit, &buf)
+	case model.ApplicationKind_FLYIO:
+		dr, err = b.flyioDiff(ctx, app, targetDSP, preCommit, &buf)
 	default:
 		// TODO: Calculating planpreview's diff for other application kinds.
 		dr = &diffResult{
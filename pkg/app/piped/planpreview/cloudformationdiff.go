@@ -0,0 +1,119 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/cloudformation"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The interval to check the status of the change set created for plan preview.
+const cloudFormationStatusCheckInterval = 5 * time.Second
+
+func (b *builder) cloudformationDiff(
+	ctx context.Context,
+	app *model.Application,
+	targetDSP deploysource.Provider,
+	command string,
+	buf *bytes.Buffer,
+) (*diffResult, error) {
+
+	cp, ok := b.pipedCfg.FindPlatformProvider(app.PlatformProvider, model.ApplicationKind_CLOUDFORMATION)
+	if !ok {
+		err := fmt.Errorf("platform provider %s was not found in Piped config", app.PlatformProvider)
+		fmt.Fprintln(buf, err.Error())
+		return nil, err
+	}
+
+	ds, err := targetDSP.Get(ctx, io.Discard)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to prepare deploy source data at the head commit (%v)\n", err)
+		return nil, err
+	}
+
+	appCfg := ds.ApplicationConfig.CloudFormationApplicationSpec
+	if appCfg == nil {
+		err := fmt.Errorf("missing CloudFormation spec field in application configuration")
+		fmt.Fprintln(buf, err.Error())
+		return nil, err
+	}
+
+	template, err := provider.LoadTemplate(ds.AppDir, appCfg.Input.TemplateFile)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to load template %s (%v)\n", appCfg.Input.TemplateFile, err)
+		return nil, err
+	}
+
+	client, err := provider.DefaultRegistry().Client(app.PlatformProvider, cp.CloudFormationConfig, b.logger)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to create CloudFormation client (%v)\n", err)
+		return nil, err
+	}
+
+	in := provider.ChangeSetInput{
+		StackName:     appCfg.Input.StackName,
+		ChangeSetName: "pipecd-planpreview-" + command,
+		TemplateBody:  template,
+		Parameters:    appCfg.Input.Parameters,
+		Capabilities:  appCfg.Input.Capabilities,
+		Tags:          provider.MakeStackTags(appCfg.Input.Tags, b.pipedCfg.PipedID, app.Id),
+		RoleARN:       appCfg.Input.RoleARN,
+		Description:   "Created by PipeCD for plan preview",
+	}
+	cs, err := provider.PrepareChangeSet(ctx, client, in, cloudFormationStatusCheckInterval)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to create change set (%v)\n", err)
+		return nil, err
+	}
+
+	if cs.NoChanges() {
+		fmt.Fprintln(buf, "No changes were detected")
+		return &diffResult{
+			summary:  "No changes were detected",
+			noChange: true,
+		}, nil
+	}
+
+	// The change set was created only to calculate the diff so it must not remain to be executed by anyone.
+	// CloudFormation creates the stack in REVIEW_IN_PROGRESS status for the change set of a new stack,
+	// so the stack itself has to be deleted as well.
+	if cs.ChangeSetType == provider.ChangeSetTypeCreate {
+		if err := client.DeleteStack(ctx, in.StackName); err != nil {
+			b.logger.Error("failed to delete the stack created for plan preview", zap.String("stack", in.StackName), zap.Error(err))
+		}
+	} else if err := client.DeleteChangeSet(ctx, in.StackName, in.ChangeSetName); err != nil {
+		b.logger.Error("failed to delete the change set created for plan preview", zap.String("change-set", in.ChangeSetName), zap.Error(err))
+	}
+
+	summary := cs.Summary()
+	if cs.ChangeSetType == provider.ChangeSetTypeCreate {
+		summary = fmt.Sprintf("stack %s will be created, %s", in.StackName, summary)
+	}
+
+	fmt.Fprint(buf, cs.Render())
+	fmt.Fprintln(buf, summary)
+	return &diffResult{
+		summary: summary,
+	}, nil
+}
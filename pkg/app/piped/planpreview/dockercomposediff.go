@@ -0,0 +1,93 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/dockercompose"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (b *builder) dockercomposeDiff(
+	ctx context.Context,
+	app *model.Application,
+	targetDSP deploysource.Provider,
+	lastCommit string,
+	buf *bytes.Buffer,
+) (*diffResult, error) {
+	newFile, err := b.loadComposeFile(ctx, targetDSP)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to load compose file at the head commit (%v)\n", err)
+		return nil, err
+	}
+
+	if lastCommit == "" {
+		fmt.Fprintf(buf, "failed to find the commit of the last successful deployment")
+		return nil, fmt.Errorf("cannot get the old compose file without the last successful deployment")
+	}
+
+	runningDSP := deploysource.NewProvider(
+		b.workingDir,
+		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
+		*app.GitPath,
+		b.secretDecrypter,
+	)
+	oldFile, err := b.loadComposeFile(ctx, runningDSP)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to load compose file at the running commit (%v)\n", err)
+		return nil, err
+	}
+
+	result, err := provider.DiffComposeFiles(oldFile, newFile)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to compare compose files (%v)\n", err)
+		return nil, err
+	}
+
+	if !result.HasDiff() {
+		fmt.Fprintln(buf, "No changes were detected")
+		return &diffResult{
+			summary:  "No changes were detected",
+			noChange: true,
+		}, nil
+	}
+
+	renderer := diff.NewRenderer(diff.WithLeftPadding(1))
+	fmt.Fprintf(buf, "--- Last Deploy\n+++ Head Commit\n\n%s\n", renderer.Render(result.Nodes()))
+
+	return &diffResult{
+		summary: fmt.Sprintf("%d changes were detected", result.NumNodes()),
+	}, nil
+}
+
+func (b *builder) loadComposeFile(ctx context.Context, dsp deploysource.Provider) (provider.ComposeFile, error) {
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return provider.ComposeFile{}, err
+	}
+
+	appCfg := ds.ApplicationConfig.DockerComposeApplicationSpec
+	if appCfg == nil {
+		return provider.ComposeFile{}, fmt.Errorf("malformed application configuration file")
+	}
+
+	return provider.LoadComposeFile(ds.AppDir, appCfg.Input.ComposeFile)
+}
@@ -60,12 +60,24 @@ func (b *builder) kubernetesDiff(
 		}
 	}
 
+	targetDS, err := targetDSP.GetReadOnly(ctx, io.Discard)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to prepare deploy source data at the head commit (%v)\n", err)
+		return nil, err
+	}
+	appCfg := targetDS.ApplicationConfig.KubernetesApplicationSpec
+	if appCfg == nil {
+		fmt.Fprintln(buf, "malformed application configuration file")
+		return nil, fmt.Errorf("malformed application configuration file")
+	}
+
 	result, err := provider.DiffList(
 		oldManifests,
 		newManifests,
 		b.logger,
 		diff.WithEquateEmpty(),
 		diff.WithCompareNumberAndNumericString(),
+		diff.WithIgnoredFieldPaths(appCfg.IgnoreFields),
 	)
 	if err != nil {
 		fmt.Fprintf(buf, "failed to compare manifests (%v)\n", err)
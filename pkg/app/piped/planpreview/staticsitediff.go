@@ -0,0 +1,114 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planpreview
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
+	provider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/staticsite"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func (b *builder) staticsiteDiff(
+	ctx context.Context,
+	app *model.Application,
+	targetDSP deploysource.Provider,
+	lastCommit string,
+	buf *bytes.Buffer,
+) (*diffResult, error) {
+	newManifest, newFiles, err := b.loadSite(ctx, targetDSP)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to load site at the head commit (%v)\n", err)
+		return nil, err
+	}
+
+	if lastCommit == "" {
+		fmt.Fprintf(buf, "failed to find the commit of the last successful deployment")
+		return nil, fmt.Errorf("cannot get the old site without the last successful deployment")
+	}
+
+	runningDSP := deploysource.NewProvider(
+		b.workingDir,
+		deploysource.NewGitSourceCloner(b.gitClient, b.repoCfg, "running", lastCommit),
+		*app.GitPath,
+		b.secretDecrypter,
+	)
+	oldManifest, oldFiles, err := b.loadSite(ctx, runningDSP)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to load site at the running commit (%v)\n", err)
+		return nil, err
+	}
+
+	result, err := provider.DiffSiteManifests(oldManifest, newManifest)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to compare site manifests (%v)\n", err)
+		return nil, err
+	}
+	changes := provider.DiffFiles(oldFiles, newFiles)
+
+	if !result.HasDiff() && changes.NumChanges() == 0 {
+		fmt.Fprintln(buf, "No changes were detected")
+		return &diffResult{
+			summary:  "No changes were detected",
+			noChange: true,
+		}, nil
+	}
+
+	if result.HasDiff() {
+		renderer := diff.NewRenderer(diff.WithLeftPadding(1))
+		fmt.Fprintf(buf, "--- Last Deploy\n+++ Head Commit\n\n%s\n", renderer.Render(result.Nodes()))
+	}
+	for _, f := range changes.Added {
+		fmt.Fprintf(buf, "+ %s\n", f)
+	}
+	for _, f := range changes.Modified {
+		fmt.Fprintf(buf, "~ %s\n", f)
+	}
+	for _, f := range changes.Deleted {
+		fmt.Fprintf(buf, "- %s\n", f)
+	}
+
+	return &diffResult{
+		summary: fmt.Sprintf("%d changes to the manifest and %d changed files were detected", result.NumNodes(), changes.NumChanges()),
+	}, nil
+}
+
+// loadSite returns the site manifest and the files of the site relative to its source directory.
+func (b *builder) loadSite(ctx context.Context, dsp deploysource.Provider) (provider.SiteManifest, []provider.File, error) {
+	ds, err := dsp.Get(ctx, io.Discard)
+	if err != nil {
+		return provider.SiteManifest{}, nil, err
+	}
+
+	appCfg := ds.ApplicationConfig.StaticSiteApplicationSpec
+	if appCfg == nil {
+		return provider.SiteManifest{}, nil, fmt.Errorf("malformed application configuration file")
+	}
+
+	m, err := provider.LoadSiteManifest(ds.AppDir, appCfg.Input.SiteManifestFile)
+	if err != nil {
+		return provider.SiteManifest{}, nil, err
+	}
+	files, err := provider.LoadFiles(ds.AppDir, m, "")
+	if err != nil {
+		return provider.SiteManifest{}, nil, err
+	}
+	return m, files, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/app/piped/deploysource"
 	terraformprovider "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/terraform"
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
@@ -55,6 +56,13 @@ func (b *builder) terraformDiff(
 	}
 
 	version := appCfg.Input.TerraformVersion
+	if version == "" {
+		version, err = terraformprovider.DetermineVersion(ds.AppDir, *cpCfg.AutoInstallRequiredVersion)
+		if err != nil {
+			fmt.Fprintf(buf, "unable to determine terraform version from required_version of the module (%v)\n", err)
+			return nil, err
+		}
+	}
 	terraformPath, installed, err := toolregistry.DefaultRegistry().Terraform(ctx, version)
 	if err != nil {
 		fmt.Fprintf(buf, "unable to find the specified terraform version %q (%v)\n", version, err)
@@ -64,12 +72,47 @@ func (b *builder) terraformDiff(
 		b.logger.Info(fmt.Sprintf("terraform %q has just been installed to %q because of no pre-installed binary for that version", version, terraformPath))
 	}
 
+	var terragruntPath string
+	if appCfg.Input.Terragrunt {
+		version := appCfg.Input.TerragruntVersion
+		terragruntPath, installed, err = toolregistry.DefaultRegistry().Terragrunt(ctx, version)
+		if err != nil {
+			fmt.Fprintf(buf, "unable to find the specified terragrunt version %q (%v)\n", version, err)
+			return nil, err
+		}
+		if installed {
+			b.logger.Info(fmt.Sprintf("terragrunt %q has just been installed to %q because of no pre-installed binary for that version", version, terragruntPath))
+		}
+	}
+
 	vars := make([]string, 0, len(cpCfg.Vars)+len(appCfg.Input.Vars))
 	vars = append(vars, cpCfg.Vars...)
 	vars = append(vars, appCfg.Input.Vars...)
 	flags := appCfg.Input.CommandFlags
 	envs := appCfg.Input.CommandEnvs
 
+	var encryptedSecrets map[string]string
+	if appCfg.Encryption != nil {
+		encryptedSecrets = appCfg.Encryption.EncryptedSecrets
+	}
+	secretVarEnvs, err := terraformprovider.MakeSecretVarEnvs(appCfg.Input.SecretVars, encryptedSecrets, b.secretDecrypter)
+	if err != nil {
+		fmt.Fprintf(buf, "failed to prepare the secret variables (%v)\n", err)
+		return nil, err
+	}
+	backendConfig, err := terraformprovider.RenderBackendConfig(appCfg.Input.BackendConfig, terraformprovider.BackendConfigData{
+		App: terraformprovider.BackendConfigApp{
+			ID:     app.Id,
+			Name:   app.Name,
+			Labels: app.Labels,
+		},
+		Workspace: appCfg.Input.Workspace,
+	})
+	if err != nil {
+		fmt.Fprintf(buf, "failed to render the backend configuration (%v)\n", err)
+		return nil, err
+	}
+
 	executor := terraformprovider.NewTerraform(
 		terraformPath,
 		ds.AppDir,
@@ -78,6 +121,9 @@ func (b *builder) terraformDiff(
 		terraformprovider.WithVarFiles(appCfg.Input.VarFiles),
 		terraformprovider.WithAdditionalFlags(flags.Shared, flags.Init, flags.Plan, flags.Apply),
 		terraformprovider.WithAdditionalEnvs(envs.Shared, envs.Init, envs.Plan, envs.Apply),
+		terraformprovider.WithAdditionalEnvs(secretVarEnvs, nil, nil, nil),
+		terraformprovider.WithTerragrunt(terragruntPath),
+		terraformprovider.WithBackendConfig(backendConfig),
 	)
 
 	if err := executor.Init(ctx, buf); err != nil {
@@ -112,8 +158,49 @@ func (b *builder) terraformDiff(
 	}
 
 	summary := fmt.Sprintf("%d to import, %d to add, %d to change, %d to destroy", result.Imports, result.Adds, result.Changes, result.Destroys)
+	// Show the resource-level changes instead of the raw output of terraform commands
+	// since they are easier to read.
+	if result.Summary != nil {
+		buf.Reset()
+		fmt.Fprint(buf, result.Summary.Render())
+	}
+
+	// Estimate the cost of the changes only when the pipeline includes the cost estimation stage.
+	if opts := findCostEstimationStageOptions(appCfg); opts != nil && result.PlanJSON != nil {
+		estimate, err := b.estimateTerraformCost(ctx, opts.InfracostVersion, ds.AppDir, envs.Shared, result.PlanJSON)
+		if err != nil {
+			fmt.Fprintf(buf, "unable to estimate the cost of the changes (%v)\n", err)
+		} else {
+			fmt.Fprintf(buf, "Estimated monthly cost: %s\n", estimate)
+			summary = fmt.Sprintf("%s, %s per month", summary, estimate.FormatDiff())
+		}
+	}
+
 	fmt.Fprintln(buf, summary)
 	return &diffResult{
 		summary: summary,
 	}, nil
 }
+
+func (b *builder) estimateTerraformCost(ctx context.Context, version, appDir string, envs []string, planJSON []byte) (*terraformprovider.CostEstimate, error) {
+	infracostPath, installed, err := toolregistry.DefaultRegistry().Infracost(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find the specified infracost version %q (%w)", version, err)
+	}
+	if installed {
+		b.logger.Info(fmt.Sprintf("infracost %q has just been installed to %q because of no pre-installed binary for that version", version, infracostPath))
+	}
+	return terraformprovider.NewInfracost(infracostPath, appDir, envs).Breakdown(ctx, planJSON)
+}
+
+func findCostEstimationStageOptions(appCfg *config.TerraformApplicationSpec) *config.TerraformCostEstimationStageOptions {
+	if appCfg.Pipeline == nil {
+		return nil
+	}
+	for _, s := range appCfg.Pipeline.Stages {
+		if s.Name == model.StageTerraformCostEstimation {
+			return s.TerraformCostEstimationStageOptions
+		}
+	}
+	return nil
+}
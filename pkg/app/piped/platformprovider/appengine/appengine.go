@@ -0,0 +1,205 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"context"
+	"errors"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/api/appengine/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	DefaultAppYAMLFilename = "app.yaml"
+	DefaultServiceID       = "default"
+)
+
+const (
+	// The labels added to the service by piped.
+	LabelManagedBy   = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   = "piped"
+
+	// The prefix of the IDs of the versions created by piped.
+	versionIDPrefix = "pipecd-"
+)
+
+// ErrNotFound is returned when the requested service or version does not exist.
+var ErrNotFound = errors.New("not found")
+
+type (
+	Service appengine.Service
+	Version appengine.Version
+)
+
+// Client is wrapper of App Engine Admin API.
+type Client interface {
+	// GetService returns the service having the given ID.
+	// ErrNotFound is returned when there is no such service.
+	GetService(ctx context.Context, serviceID string) (*Service, error)
+	// ListServices returns all services of the App Engine application.
+	ListServices(ctx context.Context) ([]*Service, error)
+	// GetVersion returns the given version of the service.
+	// ErrNotFound is returned when there is no such version.
+	GetVersion(ctx context.Context, serviceID, versionID string) (*Version, error)
+	ListVersions(ctx context.Context, serviceID string) ([]*Version, error)
+	// UploadSource uploads the given source archive to the staging bucket and returns its URL.
+	UploadSource(ctx context.Context, object string, archive []byte) (string, error)
+	// CreateVersion creates the given version of the service and waits until it has been deployed.
+	// The service is created together when it does not exist.
+	CreateVersion(ctx context.Context, serviceID string, v *Version) error
+	// UpdateService replaces the traffic split and the labels of the service
+	// and waits until the update has been completed.
+	// The split maps the version IDs to the percentages of traffic.
+	UpdateService(ctx context.Context, serviceID string, split map[string]int, shardBy string, labels map[string]string) error
+	DeleteVersion(ctx context.Context, serviceID, versionID string) error
+}
+
+// Registry holds a pool of App Engine client wrappers.
+type Registry interface {
+	Client(ctx context.Context, name string, cfg *config.PlatformProviderAppEngineConfig, logger *zap.Logger) (Client, error)
+}
+
+// LoadAppYAML loads the app.yaml file placing in the application directory.
+func LoadAppYAML(appDir, filename string) (AppYAML, error) {
+	if filename == "" {
+		filename = DefaultAppYAMLFilename
+	}
+	return loadAppYAML(filepath.Join(appDir, filename))
+}
+
+// MakeVersionID returns the ID of the version deployed from the given commit.
+// The same commit is always deployed as the same version.
+func MakeVersionID(commitHash string) string {
+	if len(commitHash) > 7 {
+		commitHash = commitHash[:7]
+	}
+	return versionIDPrefix + strings.ToLower(commitHash)
+}
+
+// SetSource sets the zip archive uploaded by UploadSource as the source of the version.
+func (v *Version) SetSource(url string, files int64) {
+	v.Deployment = &appengine.Deployment{
+		Zip: &appengine.ZipInfo{
+			SourceUrl:  url,
+			FilesCount: files,
+		},
+	}
+}
+
+// Allocations returns the percentages of traffic routed to the versions of the service.
+func (s *Service) Allocations() map[string]int {
+	if s.Split == nil {
+		return nil
+	}
+	allocations := make(map[string]int, len(s.Split.Allocations))
+	for id, a := range s.Split.Allocations {
+		allocations[id] = int(math.Round(a * 100))
+	}
+	return allocations
+}
+
+// PrimaryVersionID returns the ID of the version receiving the most traffic.
+func (s *Service) PrimaryVersionID() string {
+	var (
+		primary string
+		percent = -1
+	)
+	for id, p := range s.Allocations() {
+		if p > percent || p == percent && id < primary {
+			primary, percent = id, p
+		}
+	}
+	return primary
+}
+
+// PrunableVersionIDs returns the IDs of the versions created by piped which can be deleted
+// while keeping the given number of the latest ones in addition to the ones receiving traffic.
+func (s *Service) PrunableVersionIDs(versions []*Version, keep int) []string {
+	inUse := s.Allocations()
+	candidates := make([]*Version, 0, len(versions))
+	for _, v := range versions {
+		if !strings.HasPrefix(v.Id, versionIDPrefix) {
+			continue
+		}
+		if _, ok := inUse[v.Id]; ok {
+			continue
+		}
+		candidates = append(candidates, v)
+	}
+	if len(candidates) <= keep {
+		return nil
+	}
+
+	// Sort from the newest one since RFC3339 timestamps in UTC are lexicographically ordered.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].CreateTime > candidates[j].CreateTime
+	})
+	ids := make([]string, 0, len(candidates)-keep)
+	for _, v := range candidates[keep:] {
+		ids = append(ids, v.Id)
+	}
+	return ids
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(ctx context.Context, name string, cfg *config.PlatformProviderAppEngineConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(ctx, cfg.Project, cfg.StagingBucket, cfg.CredentialsFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of App Engine clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
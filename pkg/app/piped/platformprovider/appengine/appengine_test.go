@@ -0,0 +1,130 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/appengine/v1"
+)
+
+func TestMakeVersionID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "pipecd-0123abc", MakeVersionID("0123ABCDEF456789"))
+	assert.Equal(t, "pipecd-abc", MakeVersionID("abc"))
+}
+
+func TestServiceAllocations(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name            string
+		svc             *Service
+		expected        map[string]int
+		expectedPrimary string
+	}{
+		{
+			name: "no split",
+			svc:  &Service{},
+		},
+		{
+			name: "single version",
+			svc: &Service{
+				Split: &appengine.TrafficSplit{
+					Allocations: map[string]float64{"v1": 1},
+				},
+			},
+			expected:        map[string]int{"v1": 100},
+			expectedPrimary: "v1",
+		},
+		{
+			name: "multiple versions",
+			svc: &Service{
+				Split: &appengine.TrafficSplit{
+					Allocations: map[string]float64{"v1": 0.7, "v2": 0.3},
+				},
+			},
+			expected:        map[string]int{"v1": 70, "v2": 30},
+			expectedPrimary: "v1",
+		},
+		{
+			name: "tie",
+			svc: &Service{
+				Split: &appengine.TrafficSplit{
+					Allocations: map[string]float64{"v2": 0.5, "v1": 0.5},
+				},
+			},
+			expected:        map[string]int{"v1": 50, "v2": 50},
+			expectedPrimary: "v1",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.svc.Allocations())
+			assert.Equal(t, tc.expectedPrimary, tc.svc.PrimaryVersionID())
+		})
+	}
+}
+
+func TestServicePrunableVersionIDs(t *testing.T) {
+	t.Parallel()
+
+	var (
+		svc = &Service{
+			Split: &appengine.TrafficSplit{
+				Allocations: map[string]float64{"pipecd-0000004": 1},
+			},
+		}
+		versions = []*Version{
+			{Id: "pipecd-0000001", CreateTime: "2023-01-01T00:00:00Z"},
+			{Id: "pipecd-0000003", CreateTime: "2023-01-03T00:00:00Z"},
+			{Id: "manual", CreateTime: "2022-12-31T00:00:00Z"},
+			{Id: "pipecd-0000004", CreateTime: "2023-01-04T00:00:00Z"},
+			{Id: "pipecd-0000002", CreateTime: "2023-01-02T00:00:00Z"},
+		}
+	)
+
+	testcases := []struct {
+		name     string
+		keep     int
+		expected []string
+	}{
+		{
+			name:     "delete all unused versions",
+			keep:     0,
+			expected: []string{"pipecd-0000003", "pipecd-0000002", "pipecd-0000001"},
+		},
+		{
+			name:     "keep the latest one",
+			keep:     1,
+			expected: []string{"pipecd-0000002", "pipecd-0000001"},
+		},
+		{
+			name: "keep all",
+			keep: 3,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, svc.PrunableVersionIDs(versions, tc.keep))
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/api/appengine/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// AppYAML represents the subset of app.yaml for the standard environment supported by piped.
+// See https://cloud.google.com/appengine/docs/standard/reference/app-yaml
+type AppYAML struct {
+	Runtime            string              `json:"runtime"`
+	Env                string              `json:"env,omitempty"`
+	Service            string              `json:"service,omitempty"`
+	InstanceClass      string              `json:"instance_class,omitempty"`
+	Entrypoint         string              `json:"entrypoint,omitempty"`
+	ServiceAccount     string              `json:"service_account,omitempty"`
+	AppEngineAPIs      bool                `json:"app_engine_apis,omitempty"`
+	DefaultExpiration  string              `json:"default_expiration,omitempty"`
+	EnvVariables       map[string]string   `json:"env_variables,omitempty"`
+	BuildEnvVariables  map[string]string   `json:"build_env_variables,omitempty"`
+	InboundServices    []string            `json:"inbound_services,omitempty"`
+	AutomaticScaling   *AutomaticScaling   `json:"automatic_scaling,omitempty"`
+	BasicScaling       *BasicScaling       `json:"basic_scaling,omitempty"`
+	ManualScaling      *ManualScaling      `json:"manual_scaling,omitempty"`
+	Handlers           []Handler           `json:"handlers,omitempty"`
+	VPCAccessConnector *VPCAccessConnector `json:"vpc_access_connector,omitempty"`
+}
+
+type AutomaticScaling struct {
+	TargetCPUUtilization        float64 `json:"target_cpu_utilization,omitempty"`
+	TargetThroughputUtilization float64 `json:"target_throughput_utilization,omitempty"`
+	MaxConcurrentRequests       int64   `json:"max_concurrent_requests,omitempty"`
+	MinInstances                int64   `json:"min_instances,omitempty"`
+	MaxInstances                int64   `json:"max_instances,omitempty"`
+	// The idle instances and the pending latencies can be "automatic".
+	MinIdleInstances  automaticValue `json:"min_idle_instances,omitempty"`
+	MaxIdleInstances  automaticValue `json:"max_idle_instances,omitempty"`
+	MinPendingLatency automaticValue `json:"min_pending_latency,omitempty"`
+	MaxPendingLatency automaticValue `json:"max_pending_latency,omitempty"`
+}
+
+type BasicScaling struct {
+	MaxInstances int64  `json:"max_instances,omitempty"`
+	IdleTimeout  string `json:"idle_timeout,omitempty"`
+}
+
+type ManualScaling struct {
+	Instances int64 `json:"instances,omitempty"`
+}
+
+type Handler struct {
+	URL                      string            `json:"url"`
+	Script                   string            `json:"script,omitempty"`
+	StaticFiles              string            `json:"static_files,omitempty"`
+	StaticDir                string            `json:"static_dir,omitempty"`
+	Upload                   string            `json:"upload,omitempty"`
+	Secure                   string            `json:"secure,omitempty"`
+	Login                    string            `json:"login,omitempty"`
+	AuthFailAction           string            `json:"auth_fail_action,omitempty"`
+	RedirectHTTPResponseCode int               `json:"redirect_http_response_code,omitempty"`
+	Expiration               string            `json:"expiration,omitempty"`
+	HTTPHeaders              map[string]string `json:"http_headers,omitempty"`
+	MimeType                 string            `json:"mime_type,omitempty"`
+	RequireMatchingFile      bool              `json:"require_matching_file,omitempty"`
+	ApplicationReadable      bool              `json:"application_readable,omitempty"`
+}
+
+type VPCAccessConnector struct {
+	Name          string `json:"name"`
+	EgressSetting string `json:"egress_setting,omitempty"`
+}
+
+// automaticValue is a value of app.yaml which can be "automatic" instead of the actual value.
+// Empty means "automatic".
+type automaticValue string
+
+func (v *automaticValue) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		if s != "automatic" {
+			*v = automaticValue(s)
+		}
+		return nil
+	}
+	var n json.Number
+	if err := json.Unmarshal(data, &n); err != nil {
+		return fmt.Errorf("value must be a number, a string or \"automatic\": %s", string(data))
+	}
+	*v = automaticValue(n)
+	return nil
+}
+
+func loadAppYAML(path string) (AppYAML, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return AppYAML{}, err
+	}
+	return ParseAppYAML(data)
+}
+
+// ParseAppYAML parses the given app.yaml.
+// An error is returned when it contains a field not supported by piped.
+func ParseAppYAML(data []byte) (AppYAML, error) {
+	var a AppYAML
+	if err := yaml.UnmarshalStrict(data, &a); err != nil {
+		return AppYAML{}, err
+	}
+	if a.Runtime == "" {
+		return AppYAML{}, fmt.Errorf("runtime is required field")
+	}
+	switch a.Env {
+	case "", "standard":
+	case "flex", "flexible":
+		return AppYAML{}, fmt.Errorf("the flexible environment is not supported")
+	default:
+		return AppYAML{}, fmt.Errorf("unknown env %q", a.Env)
+	}
+	return a, nil
+}
+
+// ServiceID returns the ID of the service where the app is deployed.
+func (a AppYAML) ServiceID() string {
+	if a.Service == "" {
+		return DefaultServiceID
+	}
+	return a.Service
+}
+
+// Version converts the app.yaml into the version having the given ID.
+func (a AppYAML) Version(id string) (*Version, error) {
+	v := &appengine.Version{
+		Id:                id,
+		Runtime:           a.Runtime,
+		Env:               "standard",
+		InstanceClass:     a.InstanceClass,
+		ServiceAccount:    a.ServiceAccount,
+		AppEngineApis:     a.AppEngineAPIs,
+		EnvVariables:      a.EnvVariables,
+		BuildEnvVariables: a.BuildEnvVariables,
+	}
+	if a.Entrypoint != "" {
+		v.Entrypoint = &appengine.Entrypoint{Shell: a.Entrypoint}
+	}
+	if a.DefaultExpiration != "" {
+		d, err := parseExpiration(a.DefaultExpiration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid default_expiration: %w", err)
+		}
+		v.DefaultExpiration = d
+	}
+	for _, s := range a.InboundServices {
+		v.InboundServices = append(v.InboundServices, "INBOUND_SERVICE_"+strings.ToUpper(s))
+	}
+
+	scalings := 0
+	if s := a.AutomaticScaling; s != nil {
+		scalings++
+		as, err := s.convert()
+		if err != nil {
+			return nil, fmt.Errorf("invalid automatic_scaling: %w", err)
+		}
+		v.AutomaticScaling = as
+	}
+	if s := a.BasicScaling; s != nil {
+		scalings++
+		v.BasicScaling = &appengine.BasicScaling{MaxInstances: s.MaxInstances}
+		if s.IdleTimeout != "" {
+			d, err := parseDuration(s.IdleTimeout)
+			if err != nil {
+				return nil, fmt.Errorf("invalid idle_timeout of basic_scaling: %w", err)
+			}
+			v.BasicScaling.IdleTimeout = d
+		}
+	}
+	if s := a.ManualScaling; s != nil {
+		scalings++
+		v.ManualScaling = &appengine.ManualScaling{Instances: s.Instances}
+	}
+	if scalings > 1 {
+		return nil, fmt.Errorf("only one of automatic_scaling, basic_scaling and manual_scaling can be specified")
+	}
+
+	for i, h := range a.Handlers {
+		u, err := h.convert()
+		if err != nil {
+			return nil, fmt.Errorf("invalid handler %d: %w", i, err)
+		}
+		v.Handlers = append(v.Handlers, u)
+	}
+
+	if c := a.VPCAccessConnector; c != nil {
+		v.VpcAccessConnector = &appengine.VpcAccessConnector{Name: c.Name}
+		switch c.EgressSetting {
+		case "":
+		case "all-traffic":
+			v.VpcAccessConnector.EgressSetting = "ALL_TRAFFIC"
+		case "private-ranges-only":
+			v.VpcAccessConnector.EgressSetting = "PRIVATE_IP_RANGES"
+		default:
+			return nil, fmt.Errorf("unknown egress_setting %q of vpc_access_connector", c.EgressSetting)
+		}
+	}
+	return (*Version)(v), nil
+}
+
+func (s *AutomaticScaling) convert() (*appengine.AutomaticScaling, error) {
+	as := &appengine.AutomaticScaling{
+		MaxConcurrentRequests: s.MaxConcurrentRequests,
+	}
+	if s.TargetCPUUtilization != 0 || s.TargetThroughputUtilization != 0 || s.MinInstances != 0 || s.MaxInstances != 0 {
+		as.StandardSchedulerSettings = &appengine.StandardSchedulerSettings{
+			TargetCpuUtilization:        s.TargetCPUUtilization,
+			TargetThroughputUtilization: s.TargetThroughputUtilization,
+			MinInstances:                s.MinInstances,
+			MaxInstances:                s.MaxInstances,
+		}
+	}
+
+	var err error
+	if s.MinIdleInstances != "" {
+		if as.MinIdleInstances, err = strconv.ParseInt(string(s.MinIdleInstances), 10, 64); err != nil {
+			return nil, fmt.Errorf("min_idle_instances must be an integer or \"automatic\"")
+		}
+	}
+	if s.MaxIdleInstances != "" {
+		if as.MaxIdleInstances, err = strconv.ParseInt(string(s.MaxIdleInstances), 10, 64); err != nil {
+			return nil, fmt.Errorf("max_idle_instances must be an integer or \"automatic\"")
+		}
+	}
+	if s.MinPendingLatency != "" {
+		if as.MinPendingLatency, err = parseDuration(string(s.MinPendingLatency)); err != nil {
+			return nil, fmt.Errorf("invalid min_pending_latency: %w", err)
+		}
+	}
+	if s.MaxPendingLatency != "" {
+		if as.MaxPendingLatency, err = parseDuration(string(s.MaxPendingLatency)); err != nil {
+			return nil, fmt.Errorf("invalid max_pending_latency: %w", err)
+		}
+	}
+	return as, nil
+}
+
+func (h *Handler) convert() (*appengine.UrlMap, error) {
+	if h.URL == "" {
+		return nil, fmt.Errorf("url is required field")
+	}
+	u := &appengine.UrlMap{
+		UrlRegex: h.URL,
+	}
+
+	switch {
+	case h.Script != "" && h.StaticFiles == "" && h.StaticDir == "":
+		u.Script = &appengine.ScriptHandler{ScriptPath: h.Script}
+	case h.StaticFiles != "" && h.Script == "" && h.StaticDir == "":
+		if h.Upload == "" {
+			return nil, fmt.Errorf("upload must be specified together with static_files")
+		}
+		u.StaticFiles = &appengine.StaticFilesHandler{
+			Path:            h.StaticFiles,
+			UploadPathRegex: h.Upload,
+		}
+	case h.StaticDir != "" && h.Script == "" && h.StaticFiles == "":
+		// Serve the files under the directory in the same way as gcloud does.
+		var (
+			url = strings.TrimSuffix(h.URL, "/")
+			dir = strings.TrimSuffix(h.StaticDir, "/")
+		)
+		u.UrlRegex = url + "/(.*)"
+		u.StaticFiles = &appengine.StaticFilesHandler{
+			Path:            dir + `/\1`,
+			UploadPathRegex: dir + "/.*",
+		}
+	default:
+		return nil, fmt.Errorf("exactly one of script, static_files and static_dir must be specified")
+	}
+
+	if sf := u.StaticFiles; sf != nil {
+		sf.HttpHeaders = h.HTTPHeaders
+		sf.MimeType = h.MimeType
+		sf.RequireMatchingFile = h.RequireMatchingFile
+		sf.ApplicationReadable = h.ApplicationReadable
+		if h.Expiration != "" {
+			d, err := parseExpiration(h.Expiration)
+			if err != nil {
+				return nil, fmt.Errorf("invalid expiration: %w", err)
+			}
+			sf.Expiration = d
+		}
+	}
+
+	var ok bool
+	if u.SecurityLevel, ok = enumValue(h.Secure, "SECURE_", "always", "optional", "never", "default"); !ok {
+		return nil, fmt.Errorf("unknown secure %q", h.Secure)
+	}
+	if u.Login, ok = enumValue(h.Login, "LOGIN_", "optional", "required", "admin"); !ok {
+		return nil, fmt.Errorf("unknown login %q", h.Login)
+	}
+	if u.AuthFailAction, ok = enumValue(h.AuthFailAction, "AUTH_FAIL_ACTION_", "redirect", "unauthorized"); !ok {
+		return nil, fmt.Errorf("unknown auth_fail_action %q", h.AuthFailAction)
+	}
+	switch h.RedirectHTTPResponseCode {
+	case 0:
+	case 301, 302, 303, 307:
+		u.RedirectHttpResponseCode = fmt.Sprintf("REDIRECT_HTTP_RESPONSE_CODE_%d", h.RedirectHTTPResponseCode)
+	default:
+		return nil, fmt.Errorf("unknown redirect_http_response_code %d", h.RedirectHTTPResponseCode)
+	}
+	return u, nil
+}
+
+// enumValue returns the value of the Admin API enum for the given app.yaml value.
+func enumValue(value, prefix string, allowed ...string) (string, bool) {
+	if value == "" {
+		return "", true
+	}
+	for _, a := range allowed {
+		if value == a {
+			return prefix + strings.ToUpper(value), true
+		}
+	}
+	return "", false
+}
+
+// parseDuration converts the duration such as "30ms" and "10m" into the format of Admin API.
+func parseDuration(s string) (string, error) {
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return "", err
+	}
+	return formatDuration(d), nil
+}
+
+// parseExpiration converts the expiration such as "4d 5h" into the format of Admin API.
+func parseExpiration(s string) (string, error) {
+	var total time.Duration
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return "", fmt.Errorf("empty expiration")
+	}
+	for _, f := range fields {
+		if len(f) < 2 {
+			return "", fmt.Errorf("malformed expiration %q", s)
+		}
+		n, err := strconv.Atoi(f[:len(f)-1])
+		if err != nil || n < 0 {
+			return "", fmt.Errorf("malformed expiration %q", s)
+		}
+		var unit time.Duration
+		switch f[len(f)-1] {
+		case 'd':
+			unit = 24 * time.Hour
+		case 'h':
+			unit = time.Hour
+		case 'm':
+			unit = time.Minute
+		case 's':
+			unit = time.Second
+		default:
+			return "", fmt.Errorf("malformed expiration %q", s)
+		}
+		total += time.Duration(n) * unit
+	}
+	return formatDuration(total), nil
+}
+
+func formatDuration(d time.Duration) string {
+	return strconv.FormatFloat(d.Seconds(), 'f', -1, 64) + "s"
+}
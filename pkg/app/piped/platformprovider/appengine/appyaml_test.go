@@ -0,0 +1,266 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/appengine/v1"
+)
+
+func TestParseAppYAML(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		data        string
+		expected    AppYAML
+		expectedErr bool
+	}{
+		{
+			name: "minimal",
+			data: `runtime: go120`,
+			expected: AppYAML{
+				Runtime: "go120",
+			},
+		},
+		{
+			name: "automatic values",
+			data: `
+runtime: python311
+service: api
+automatic_scaling:
+  min_idle_instances: automatic
+  max_idle_instances: 3
+  max_pending_latency: 30ms
+`,
+			expected: AppYAML{
+				Runtime: "python311",
+				Service: "api",
+				AutomaticScaling: &AutomaticScaling{
+					MaxIdleInstances:  "3",
+					MaxPendingLatency: "30ms",
+				},
+			},
+		},
+		{
+			name:        "missing runtime",
+			data:        `service: api`,
+			expectedErr: true,
+		},
+		{
+			name: "flexible environment",
+			data: `
+runtime: custom
+env: flex
+`,
+			expectedErr: true,
+		},
+		{
+			name: "unsupported field",
+			data: `
+runtime: go120
+resources:
+  cpu: 2
+`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseAppYAML([]byte(tc.data))
+			assert.Equal(t, tc.expectedErr, err != nil, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestAppYAMLServiceID(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "default", AppYAML{Runtime: "go120"}.ServiceID())
+	assert.Equal(t, "api", AppYAML{Runtime: "go120", Service: "api"}.ServiceID())
+}
+
+func TestAppYAMLVersion(t *testing.T) {
+	t.Parallel()
+
+	data := `
+runtime: go120
+instance_class: F2
+entrypoint: ./server
+default_expiration: 1d 2h
+env_variables:
+  ENV: prod
+inbound_services:
+- warmup
+automatic_scaling:
+  target_cpu_utilization: 0.65
+  max_instances: 10
+  min_pending_latency: 1s
+handlers:
+- url: /static
+  static_dir: public/
+  expiration: 10m
+- url: /.*
+  script: auto
+  secure: always
+  redirect_http_response_code: 301
+vpc_access_connector:
+  name: projects/p/locations/l/connectors/c
+  egress_setting: all-traffic
+`
+	a, err := ParseAppYAML([]byte(data))
+	require.NoError(t, err)
+
+	got, err := a.Version("pipecd-0123abc")
+	require.NoError(t, err)
+	assert.Equal(t, &Version{
+		Id:                "pipecd-0123abc",
+		Runtime:           "go120",
+		Env:               "standard",
+		InstanceClass:     "F2",
+		Entrypoint:        &appengine.Entrypoint{Shell: "./server"},
+		DefaultExpiration: "93600s",
+		EnvVariables:      map[string]string{"ENV": "prod"},
+		InboundServices:   []string{"INBOUND_SERVICE_WARMUP"},
+		AutomaticScaling: &appengine.AutomaticScaling{
+			MinPendingLatency: "1s",
+			StandardSchedulerSettings: &appengine.StandardSchedulerSettings{
+				TargetCpuUtilization: 0.65,
+				MaxInstances:         10,
+			},
+		},
+		Handlers: []*appengine.UrlMap{
+			{
+				UrlRegex: "/static/(.*)",
+				StaticFiles: &appengine.StaticFilesHandler{
+					Path:            `public/\1`,
+					UploadPathRegex: "public/.*",
+					Expiration:      "600s",
+				},
+			},
+			{
+				UrlRegex:                 "/.*",
+				Script:                   &appengine.ScriptHandler{ScriptPath: "auto"},
+				SecurityLevel:            "SECURE_ALWAYS",
+				RedirectHttpResponseCode: "REDIRECT_HTTP_RESPONSE_CODE_301",
+			},
+		},
+		VpcAccessConnector: &appengine.VpcAccessConnector{
+			Name:          "projects/p/locations/l/connectors/c",
+			EgressSetting: "ALL_TRAFFIC",
+		},
+	}, got)
+}
+
+func TestAppYAMLVersionError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name string
+		app  AppYAML
+	}{
+		{
+			name: "multiple scaling types",
+			app: AppYAML{
+				Runtime:       "go120",
+				BasicScaling:  &BasicScaling{MaxInstances: 1},
+				ManualScaling: &ManualScaling{Instances: 1},
+			},
+		},
+		{
+			name: "handler without url",
+			app: AppYAML{
+				Runtime:  "go120",
+				Handlers: []Handler{{Script: "auto"}},
+			},
+		},
+		{
+			name: "handler with both script and static files",
+			app: AppYAML{
+				Runtime:  "go120",
+				Handlers: []Handler{{URL: "/.*", Script: "auto", StaticFiles: "index.html", Upload: "index.html"}},
+			},
+		},
+		{
+			name: "static files without upload",
+			app: AppYAML{
+				Runtime:  "go120",
+				Handlers: []Handler{{URL: "/", StaticFiles: "index.html"}},
+			},
+		},
+		{
+			name: "unknown login",
+			app: AppYAML{
+				Runtime:  "go120",
+				Handlers: []Handler{{URL: "/.*", Script: "auto", Login: "always"}},
+			},
+		},
+		{
+			name: "malformed idle instances",
+			app: AppYAML{
+				Runtime:          "go120",
+				AutomaticScaling: &AutomaticScaling{MinIdleInstances: "many"},
+			},
+		},
+		{
+			name: "unknown egress setting",
+			app: AppYAML{
+				Runtime:            "go120",
+				VPCAccessConnector: &VPCAccessConnector{Name: "c", EgressSetting: "none"},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tc.app.Version("v1")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestParseExpiration(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		value       string
+		expected    string
+		expectedErr bool
+	}{
+		{value: "30s", expected: "30s"},
+		{value: "4d 5h", expected: "363600s"},
+		{value: "1h 30m", expected: "5400s"},
+		{value: "", expectedErr: true},
+		{value: "1w", expectedErr: true},
+		{value: "h", expectedErr: true},
+		{value: "-1d", expectedErr: true},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.value, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseExpiration(tc.value)
+			assert.Equal(t, tc.expectedErr, err != nil, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
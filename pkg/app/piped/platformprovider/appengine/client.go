@@ -0,0 +1,209 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"go.uber.org/zap"
+	"google.golang.org/api/appengine/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+type client struct {
+	project       string
+	stagingBucket string
+	service       *appengine.APIService
+	storage       *storage.Client
+	pollInterval  time.Duration
+	logger        *zap.Logger
+}
+
+func newClient(ctx context.Context, project, stagingBucket, credentialsFile string, logger *zap.Logger) (*client, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required field")
+	}
+	if stagingBucket == "" {
+		stagingBucket = fmt.Sprintf("staging.%s.appspot.com", project)
+	}
+	c := &client{
+		project:       project,
+		stagingBucket: stagingBucket,
+		pollInterval:  5 * time.Second,
+		logger:        logger.Named("appengine"),
+	}
+
+	var options []option.ClientOption
+	if len(credentialsFile) > 0 {
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read credentials file (%w)", err)
+		}
+		options = append(options, option.WithCredentialsJSON(data))
+	}
+
+	service, err := appengine.NewService(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	c.service = service
+
+	storageClient, err := storage.NewClient(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	c.storage = storageClient
+
+	return c, nil
+}
+
+func (c *client) GetService(ctx context.Context, serviceID string) (*Service, error) {
+	svc, err := c.service.Apps.Services.Get(c.project, serviceID).Context(ctx).Do()
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return (*Service)(svc), nil
+}
+
+func (c *client) ListServices(ctx context.Context) ([]*Service, error) {
+	var services []*Service
+	err := c.service.Apps.Services.List(c.project).Pages(ctx, func(resp *appengine.ListServicesResponse) error {
+		for _, svc := range resp.Services {
+			services = append(services, (*Service)(svc))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return services, nil
+}
+
+func (c *client) GetVersion(ctx context.Context, serviceID, versionID string) (*Version, error) {
+	v, err := c.service.Apps.Services.Versions.Get(c.project, serviceID, versionID).Context(ctx).Do()
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return (*Version)(v), nil
+}
+
+func (c *client) ListVersions(ctx context.Context, serviceID string) ([]*Version, error) {
+	var versions []*Version
+	err := c.service.Apps.Services.Versions.List(c.project, serviceID).Pages(ctx, func(resp *appengine.ListVersionsResponse) error {
+		for _, v := range resp.Versions {
+			versions = append(versions, (*Version)(v))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return versions, nil
+}
+
+func (c *client) UploadSource(ctx context.Context, object string, archive []byte) (string, error) {
+	w := c.storage.Bucket(c.stagingBucket).Object(object).NewWriter(ctx)
+	w.ContentType = "application/zip"
+	if _, err := io.Copy(w, bytes.NewReader(archive)); err != nil {
+		w.Close()
+		return "", fmt.Errorf("failed to upload source to bucket %s: %w", c.stagingBucket, err)
+	}
+	if err := w.Close(); err != nil {
+		return "", fmt.Errorf("failed to upload source to bucket %s: %w", c.stagingBucket, err)
+	}
+	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", c.stagingBucket, object), nil
+}
+
+func (c *client) CreateVersion(ctx context.Context, serviceID string, v *Version) error {
+	op, err := c.service.Apps.Services.Versions.Create(c.project, serviceID, (*appengine.Version)(v)).Context(ctx).Do()
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, op)
+}
+
+func (c *client) UpdateService(ctx context.Context, serviceID string, split map[string]int, shardBy string, labels map[string]string) error {
+	allocations := make(map[string]float64, len(split))
+	for id, percent := range split {
+		if percent > 0 {
+			allocations[id] = float64(percent) / 100
+		}
+	}
+	svc := &appengine.Service{
+		Split: &appengine.TrafficSplit{
+			Allocations: allocations,
+			ShardBy:     shardBy,
+		},
+		Labels: labels,
+	}
+	op, err := c.service.Apps.Services.Patch(c.project, serviceID, svc).UpdateMask("split,labels").Context(ctx).Do()
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, op)
+}
+
+func (c *client) DeleteVersion(ctx context.Context, serviceID, versionID string) error {
+	op, err := c.service.Apps.Services.Versions.Delete(c.project, serviceID, versionID).Context(ctx).Do()
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, op)
+}
+
+// waitOperation waits until the given long-running operation has been done.
+func (c *client) waitOperation(ctx context.Context, op *appengine.Operation) error {
+	// The name of the operation is in the format of apps/{APP}/operations/{ID}.
+	id := op.Name[strings.LastIndex(op.Name, "/")+1:]
+
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		var err error
+		op, err = c.service.Apps.Operations.Get(c.project, id).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to get operation %s: %w", id, convertError(err))
+		}
+	}
+	if op.Error != nil {
+		return fmt.Errorf("operation %s failed: %s", id, op.Error.Message)
+	}
+	return nil
+}
+
+func convertError(err error) error {
+	var e *googleapi.Error
+	if errors.As(err, &e) && e.Code == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
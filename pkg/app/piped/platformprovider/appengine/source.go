@@ -0,0 +1,93 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// The modification time of the files in the source archive.
+// It is fixed to make the archive of the same files identical.
+var sourceArchiveModTime = time.Date(1980, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ArchiveSource creates a zip archive of all files under the given directory
+// to be uploaded as the source of a new version.
+// The paths in the archive are relative to the directory.
+// It returns the archive and the number of the files in it.
+func ArchiveSource(dir string) ([]byte, int64, error) {
+	var (
+		buf   = &bytes.Buffer{}
+		w     = zip.NewWriter(buf)
+		files int64
+	)
+
+	err := filepath.Walk(dir, func(fp string, fi os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fp == dir {
+			return nil
+		}
+		if fi.IsDir() {
+			if fi.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !fi.Mode().IsRegular() {
+			return nil
+		}
+
+		header, err := zip.FileInfoHeader(fi)
+		if err != nil {
+			return err
+		}
+		header.Method = zip.Deflate
+		header.Modified = sourceArchiveModTime
+		header.Name, err = filepath.Rel(dir, fp)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(header.Name)
+		hw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+
+		f, err := os.Open(fp)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		if _, err = io.Copy(hw, f); err != nil {
+			return err
+		}
+		files++
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, 0, err
+	}
+	return buf.Bytes(), files, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveSource(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"app.yaml":           "runtime: go120",
+		"main.go":            "package main",
+		"static/index.html":  "<html></html>",
+		".git/HEAD":          "ref: refs/heads/master",
+		"static/css/app.css": "body {}",
+	}
+	for name, content := range files {
+		p := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0755))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0644))
+	}
+
+	data, count, err := ArchiveSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+
+	r, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	require.NoError(t, err)
+	got := make(map[string]string, len(r.File))
+	for _, f := range r.File {
+		rc, err := f.Open()
+		require.NoError(t, err)
+		content, err := io.ReadAll(rc)
+		rc.Close()
+		require.NoError(t, err)
+		got[f.Name] = string(content)
+	}
+	assert.Equal(t, map[string]string{
+		"app.yaml":           "runtime: go120",
+		"main.go":            "package main",
+		"static/index.html":  "<html></html>",
+		"static/css/app.css": "body {}",
+	}, got)
+
+	// The same files must be archived identically.
+	again, _, err := ArchiveSource(dir)
+	require.NoError(t, err)
+	assert.Equal(t, data, again)
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	serviceKind = "Service"
+	versionKind = "Version"
+
+	versionServingStatusServing = "SERVING"
+)
+
+// MakeResourceStates returns the states of the given service and its versions.
+// Only the versions receiving traffic are included.
+func MakeResourceStates(svc *Service, versions []*Version, updatedAt time.Time) []*model.AppEngineResourceState {
+	allocations := svc.Allocations()
+	states := make([]*model.AppEngineResourceState, 0, len(allocations)+1)
+
+	// Set service state.
+	states = append(states, makeResourceState(
+		svc.Name,
+		nil,
+		svc.Id,
+		serviceKind,
+		model.AppEngineResourceState_HEALTHY,
+		serviceDescription(svc),
+		time.Time{},
+		updatedAt,
+	))
+
+	// Set version states.
+	for _, v := range versions {
+		percent, ok := allocations[v.Id]
+		if !ok {
+			continue
+		}
+		status, desc := versionHealthStatus(v, percent)
+		createdAt, _ := time.Parse(time.RFC3339, v.CreateTime)
+		states = append(states, makeResourceState(
+			v.Name,
+			[]string{svc.Name},
+			v.Id,
+			versionKind,
+			status,
+			desc,
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	return states
+}
+
+func makeResourceState(id string, parentIDs []string, name, kind string, status model.AppEngineResourceState_HealthStatus, desc string, createdAt, updatedAt time.Time) *model.AppEngineResourceState {
+	// The creation time is not given for services.
+	if createdAt.IsZero() {
+		createdAt = updatedAt
+	}
+
+	return &model.AppEngineResourceState{
+		Id:        id,
+		OwnerIds:  parentIDs,
+		ParentIds: parentIDs,
+		Name:      name,
+		Kind:      kind,
+
+		HealthStatus:      status,
+		HealthDescription: desc,
+
+		CreatedAt: createdAt.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}
+}
+
+func serviceDescription(svc *Service) string {
+	allocations := svc.Allocations()
+	ids := make([]string, 0, len(allocations))
+	for id := range allocations {
+		ids = append(ids, id)
+	}
+	if len(ids) == 0 {
+		return "Service has no traffic split"
+	}
+	if len(ids) == 1 {
+		return fmt.Sprintf("All traffic is routed to version %s", ids[0])
+	}
+
+	sort.Slice(ids, func(i, j int) bool {
+		if allocations[ids[i]] != allocations[ids[j]] {
+			return allocations[ids[i]] > allocations[ids[j]]
+		}
+		return ids[i] < ids[j]
+	})
+	splits := make([]string, 0, len(ids))
+	for _, id := range ids {
+		splits = append(splits, fmt.Sprintf("%s %d%%", id, allocations[id]))
+	}
+	return fmt.Sprintf("Traffic is split by %s: %s", svc.Split.ShardBy, strings.Join(splits, ", "))
+}
+
+func versionHealthStatus(v *Version, percent int) (model.AppEngineResourceState_HealthStatus, string) {
+	desc := fmt.Sprintf("Version of runtime %s is %s receiving %d%% of traffic", v.Runtime, v.ServingStatus, percent)
+	if v.ServingStatus != versionServingStatusServing {
+		return model.AppEngineResourceState_OTHER, desc
+	}
+	return model.AppEngineResourceState_HEALTHY, desc
+}
@@ -0,0 +1,100 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package appengine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/appengine/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeResourceStates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Unix(1700000000, 0)
+		created = time.Unix(1690000000, 0).UTC()
+		svc     = &Service{
+			Id:   "default",
+			Name: "apps/p/services/default",
+			Split: &appengine.TrafficSplit{
+				ShardBy:     "IP",
+				Allocations: map[string]float64{"pipecd-0000001": 0.9, "pipecd-0000002": 0.1},
+			},
+		}
+		versions = []*Version{
+			{
+				Id:            "pipecd-0000001",
+				Name:          "apps/p/services/default/versions/pipecd-0000001",
+				Runtime:       "go120",
+				ServingStatus: "SERVING",
+				CreateTime:    created.Format(time.RFC3339),
+			},
+			{
+				Id:            "pipecd-0000002",
+				Name:          "apps/p/services/default/versions/pipecd-0000002",
+				Runtime:       "go120",
+				ServingStatus: "STOPPED",
+				CreateTime:    created.Format(time.RFC3339),
+			},
+			{
+				Id:            "pipecd-0000000",
+				Name:          "apps/p/services/default/versions/pipecd-0000000",
+				Runtime:       "go120",
+				ServingStatus: "SERVING",
+				CreateTime:    created.Format(time.RFC3339),
+			},
+		}
+	)
+
+	states := MakeResourceStates(svc, versions, now)
+	assert.Equal(t, []*model.AppEngineResourceState{
+		{
+			Id:                "apps/p/services/default",
+			Name:              "default",
+			Kind:              "Service",
+			HealthStatus:      model.AppEngineResourceState_HEALTHY,
+			HealthDescription: "Traffic is split by IP: pipecd-0000001 90%, pipecd-0000002 10%",
+			CreatedAt:         now.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                "apps/p/services/default/versions/pipecd-0000001",
+			OwnerIds:          []string{"apps/p/services/default"},
+			ParentIds:         []string{"apps/p/services/default"},
+			Name:              "pipecd-0000001",
+			Kind:              "Version",
+			HealthStatus:      model.AppEngineResourceState_HEALTHY,
+			HealthDescription: "Version of runtime go120 is SERVING receiving 90% of traffic",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                "apps/p/services/default/versions/pipecd-0000002",
+			OwnerIds:          []string{"apps/p/services/default"},
+			ParentIds:         []string{"apps/p/services/default"},
+			Name:              "pipecd-0000002",
+			Kind:              "Version",
+			HealthStatus:      model.AppEngineResourceState_OTHER,
+			HealthDescription: "Version of runtime go120 is STOPPED receiving 10% of traffic",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+	}, states)
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+
+	// The suffix added to the name of the service to make the name of its CANARY service.
+	canaryServiceSuffix = "-canary"
+
+	// The set identifiers of the weighted records routing the traffic to the PRIMARY and CANARY services.
+	PrimaryRecordSetIdentifier = "pipecd-primary"
+	CanaryRecordSetIdentifier  = "pipecd-canary"
+)
+
+// ErrNotFound is returned when the requested App Runner service does not exist.
+var ErrNotFound = errors.New("apprunner service not found")
+
+// Client is wrapper of App Runner and Route 53 APIs.
+type Client interface {
+	// FindService returns the service having the given name.
+	// ErrNotFound is returned when there is no such service.
+	FindService(ctx context.Context, name string) (*Service, error)
+	DescribeService(ctx context.Context, serviceArn string) (*Service, error)
+	CreateService(ctx context.Context, sm ServiceManifest) (*Service, error)
+	UpdateService(ctx context.Context, serviceArn string, sm ServiceManifest) (*Service, error)
+	DeleteService(ctx context.Context, serviceArn string) (*Service, error)
+	TagResource(ctx context.Context, resourceArn string, tags map[string]string) error
+	ChangeWeightedRecords(ctx context.Context, hostedZoneID string, changes []WeightedRecordChange) error
+}
+
+// Registry holds a pool of aws client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderAppRunnerConfig, logger *zap.Logger) (Client, error)
+}
+
+// LoadServiceManifest returns ServiceManifest object from a given service manifest file.
+func LoadServiceManifest(appDir, serviceManifestFilename string) (ServiceManifest, error) {
+	path := filepath.Join(appDir, serviceManifestFilename)
+	return loadServiceManifest(path)
+}
+
+// CanaryServiceName returns the name of the CANARY service of the given service.
+func CanaryServiceName(serviceName string) string {
+	return serviceName + canaryServiceSuffix
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderAppRunnerConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of aws clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
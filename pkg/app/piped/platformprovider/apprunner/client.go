@@ -0,0 +1,225 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/smithy-go"
+	"go.uber.org/zap"
+)
+
+const (
+	listServicesMaxResults = 20
+
+	// The statuses of App Runner service.
+	ServiceStatusRunning             = string(types.ServiceStatusRunning)
+	ServiceStatusOperationInProgress = string(types.ServiceStatusOperationInProgress)
+	ServiceStatusCreateFailed        = string(types.ServiceStatusCreateFailed)
+	ServiceStatusDeleted             = string(types.ServiceStatusDeleted)
+	ServiceStatusDeleteFailed        = string(types.ServiceStatusDeleteFailed)
+	ServiceStatusPaused              = string(types.ServiceStatusPaused)
+)
+
+// Service represents the current state of an App Runner service.
+type Service struct {
+	ServiceArn  string
+	ServiceID   string
+	ServiceName string
+	ServiceURL  string
+	Status      string
+}
+
+// InProgress reports whether an operation such as a deployment is running on the service.
+func (s *Service) InProgress() bool {
+	return s.Status == ServiceStatusOperationInProgress
+}
+
+type client struct {
+	appRunnerClient *apprunner.Client
+	route53Client   *route53.Client
+	logger          *zap.Logger
+}
+
+func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required field")
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if credentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(profile))
+	}
+	if tokenPath != "" && roleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(tokenPath)
+		}))
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create apprunner client: %w", err)
+	}
+
+	return &client{
+		appRunnerClient: apprunner.NewFromConfig(cfg),
+		route53Client:   route53.NewFromConfig(cfg),
+		logger:          logger.Named("apprunner"),
+	}, nil
+}
+
+func (c *client) FindService(ctx context.Context, name string) (*Service, error) {
+	in := &apprunner.ListServicesInput{
+		MaxResults: aws.Int32(listServicesMaxResults),
+	}
+	for {
+		out, err := c.appRunnerClient.ListServices(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list apprunner services: %w", err)
+		}
+		for _, s := range out.ServiceSummaryList {
+			// The deleted services are not returned, but just in case.
+			if aws.ToString(s.ServiceName) == name && s.Status != types.ServiceStatusDeleted {
+				return c.DescribeService(ctx, aws.ToString(s.ServiceArn))
+			}
+		}
+		if out.NextToken == nil {
+			return nil, ErrNotFound
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func (c *client) DescribeService(ctx context.Context, serviceArn string) (*Service, error) {
+	out, err := c.appRunnerClient.DescribeService(ctx, &apprunner.DescribeServiceInput{
+		ServiceArn: aws.String(serviceArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe apprunner service %s: %w", serviceArn, wrapNotFound(err))
+	}
+	return makeService(out.Service), nil
+}
+
+func (c *client) CreateService(ctx context.Context, sm ServiceManifest) (*Service, error) {
+	spec := sm.Spec
+	out, err := c.appRunnerClient.CreateService(ctx, &apprunner.CreateServiceInput{
+		ServiceName:                 aws.String(spec.ServiceName),
+		SourceConfiguration:         spec.SourceConfiguration.toSDK(),
+		InstanceConfiguration:       spec.InstanceConfiguration.toSDK(),
+		HealthCheckConfiguration:    spec.HealthCheckConfiguration.toSDK(),
+		AutoScalingConfigurationArn: optionalString(spec.AutoScalingConfigurationArn),
+		NetworkConfiguration:        spec.NetworkConfiguration.toSDK(),
+		ObservabilityConfiguration:  spec.ObservabilityConfiguration.toSDK(),
+		Tags:                        makeTags(spec.Tags),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create apprunner service %s: %w", spec.ServiceName, err)
+	}
+	return makeService(out.Service), nil
+}
+
+func (c *client) UpdateService(ctx context.Context, serviceArn string, sm ServiceManifest) (*Service, error) {
+	// The name and the tags of the service can not be updated by UpdateService.
+	spec := sm.Spec
+	out, err := c.appRunnerClient.UpdateService(ctx, &apprunner.UpdateServiceInput{
+		ServiceArn:                  aws.String(serviceArn),
+		SourceConfiguration:         spec.SourceConfiguration.toSDK(),
+		InstanceConfiguration:       spec.InstanceConfiguration.toSDK(),
+		HealthCheckConfiguration:    spec.HealthCheckConfiguration.toSDK(),
+		AutoScalingConfigurationArn: optionalString(spec.AutoScalingConfigurationArn),
+		NetworkConfiguration:        spec.NetworkConfiguration.toSDK(),
+		ObservabilityConfiguration:  spec.ObservabilityConfiguration.toSDK(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to update apprunner service %s: %w", spec.ServiceName, wrapNotFound(err))
+	}
+	return makeService(out.Service), nil
+}
+
+func (c *client) DeleteService(ctx context.Context, serviceArn string) (*Service, error) {
+	out, err := c.appRunnerClient.DeleteService(ctx, &apprunner.DeleteServiceInput{
+		ServiceArn: aws.String(serviceArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete apprunner service %s: %w", serviceArn, wrapNotFound(err))
+	}
+	return makeService(out.Service), nil
+}
+
+func (c *client) TagResource(ctx context.Context, resourceArn string, tags map[string]string) error {
+	_, err := c.appRunnerClient.TagResource(ctx, &apprunner.TagResourceInput{
+		ResourceArn: aws.String(resourceArn),
+		Tags:        makeTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag apprunner resource %s: %w", resourceArn, wrapNotFound(err))
+	}
+	return nil
+}
+
+func makeService(s *types.Service) *Service {
+	if s == nil {
+		return nil
+	}
+	return &Service{
+		ServiceArn:  aws.ToString(s.ServiceArn),
+		ServiceID:   aws.ToString(s.ServiceId),
+		ServiceName: aws.ToString(s.ServiceName),
+		ServiceURL:  aws.ToString(s.ServiceUrl),
+		Status:      string(s.Status),
+	}
+}
+
+func makeTags(tags map[string]string) []types.Tag {
+	if len(tags) == 0 {
+		return nil
+	}
+	out := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Key) < aws.ToString(out[j].Key)
+	})
+	return out
+}
+
+// wrapNotFound converts the error returned when the service does not exist into ErrNotFound.
+func wrapNotFound(err error) error {
+	var e *types.ResourceNotFoundException
+	if errors.As(err, &e) {
+		return fmt.Errorf("%w: %s", ErrNotFound, e.ErrorMessage())
+	}
+	return err
+}
+
+// IsAPIError reports whether the given error is an AWS API error having the given code.
+func IsAPIError(err error, code string) bool {
+	var e smithy.APIError
+	return errors.As(err, &e) && e.ErrorCode() == code
+}
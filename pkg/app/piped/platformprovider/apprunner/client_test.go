@@ -0,0 +1,101 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &client{
+		appRunnerClient: apprunner.NewFromConfig(cfg, func(o *apprunner.Options) {
+			o.EndpointResolver = apprunner.EndpointResolverFromURL(ts.URL)
+		}),
+		route53Client: route53.NewFromConfig(cfg, func(o *route53.Options) {
+			o.EndpointResolver = route53.EndpointResolverFromURL(ts.URL)
+		}),
+		logger: zap.NewNop(),
+	}
+}
+
+func TestFindService(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Contains(t, r.Header.Get("Authorization"), "/apprunner/aws4_request")
+
+		var in map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AppRunner.ListServices":
+			if in["NextToken"] == nil {
+				io.WriteString(w, `{"ServiceSummaryList":[{"ServiceName":"other","ServiceArn":"arn:other"}],"NextToken":"next"}`)
+				return
+			}
+			io.WriteString(w, `{"ServiceSummaryList":[{"ServiceName":"simple","ServiceArn":"arn:simple"}]}`)
+		case "AppRunner.DescribeService":
+			assert.Equal(t, "arn:simple", in["ServiceArn"])
+			io.WriteString(w, `{"Service":{"ServiceName":"simple","ServiceArn":"arn:simple","ServiceUrl":"abc.awsapprunner.com","Status":"RUNNING"}}`)
+		default:
+			t.Errorf("unexpected target %s", r.Header.Get("X-Amz-Target"))
+		}
+	})
+
+	s, err := c.FindService(context.Background(), "simple")
+	require.NoError(t, err)
+	assert.Equal(t, &Service{
+		ServiceArn:  "arn:simple",
+		ServiceName: "simple",
+		ServiceURL:  "abc.awsapprunner.com",
+		Status:      ServiceStatusRunning,
+	}, s)
+
+	_, err = c.FindService(context.Background(), "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestDescribeServiceNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Amzn-Errortype", "ResourceNotFoundException")
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"__type":"com.amazonaws.apprunner#ResourceNotFoundException","Message":"service not found"}`)
+	})
+
+	_, err := c.DescribeService(context.Background(), "arn:missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
@@ -0,0 +1,97 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+)
+
+const (
+	// The actions of the changes to the weighted records.
+	WeightedRecordActionUpsert = string(types.ChangeActionUpsert)
+	WeightedRecordActionDelete = string(types.ChangeActionDelete)
+
+	defaultWeightedRecordTTL int64 = 60
+)
+
+// WeightedRecordChange represents a change to a weighted CNAME record
+// routing a part of the traffic to an App Runner service.
+type WeightedRecordChange struct {
+	Action        string
+	RecordName    string
+	SetIdentifier string
+	// The domain name of the App Runner service.
+	Target string
+	Weight int64
+	TTL    int64
+}
+
+func makeChangeBatch(changes []WeightedRecordChange) (*types.ChangeBatch, error) {
+	batch := &types.ChangeBatch{
+		Comment: aws.String("Changed by PipeCD"),
+		Changes: make([]types.Change, 0, len(changes)),
+	}
+	for _, c := range changes {
+		if c.Action != WeightedRecordActionUpsert && c.Action != WeightedRecordActionDelete {
+			return nil, fmt.Errorf("unsupported action %q", c.Action)
+		}
+		ttl := c.TTL
+		if ttl <= 0 {
+			ttl = defaultWeightedRecordTTL
+		}
+		batch.Changes = append(batch.Changes, types.Change{
+			Action: types.ChangeAction(c.Action),
+			ResourceRecordSet: &types.ResourceRecordSet{
+				Name:          aws.String(c.RecordName),
+				Type:          types.RRTypeCname,
+				SetIdentifier: aws.String(c.SetIdentifier),
+				Weight:        aws.Int64(c.Weight),
+				TTL:           aws.Int64(ttl),
+				ResourceRecords: []types.ResourceRecord{
+					{Value: aws.String(c.Target)},
+				},
+			},
+		})
+	}
+	return batch, nil
+}
+
+// ChangeWeightedRecords applies the given changes to the weighted records in the hosted zone.
+// All changes are applied atomically by Route 53.
+func (c *client) ChangeWeightedRecords(ctx context.Context, hostedZoneID string, changes []WeightedRecordChange) error {
+	if len(changes) == 0 {
+		return nil
+	}
+	batch, err := makeChangeBatch(changes)
+	if err != nil {
+		return fmt.Errorf("failed to build the record changes: %w", err)
+	}
+
+	id := strings.TrimPrefix(hostedZoneID, "/hostedzone/")
+	_, err = c.route53Client.ChangeResourceRecordSets(ctx, &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(id),
+		ChangeBatch:  batch,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change records in hosted zone %s: %w", id, err)
+	}
+	return nil
+}
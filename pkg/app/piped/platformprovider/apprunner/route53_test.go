@@ -0,0 +1,119 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMakeChangeBatch(t *testing.T) {
+	t.Parallel()
+
+	batch, err := makeChangeBatch([]WeightedRecordChange{
+		{
+			Action:        WeightedRecordActionUpsert,
+			RecordName:    "app.example.com",
+			SetIdentifier: PrimaryRecordSetIdentifier,
+			Target:        "primary.awsapprunner.com",
+			Weight:        80,
+		},
+		{
+			Action:        WeightedRecordActionDelete,
+			RecordName:    "app.example.com",
+			SetIdentifier: CanaryRecordSetIdentifier,
+			Target:        "canary.awsapprunner.com",
+			Weight:        20,
+			TTL:           30,
+		},
+	})
+	require.NoError(t, err)
+
+	expected := &types.ChangeBatch{
+		Comment: aws.String("Changed by PipeCD"),
+		Changes: []types.Change{
+			{
+				Action: types.ChangeActionUpsert,
+				ResourceRecordSet: &types.ResourceRecordSet{
+					Name:            aws.String("app.example.com"),
+					Type:            types.RRTypeCname,
+					SetIdentifier:   aws.String("pipecd-primary"),
+					Weight:          aws.Int64(80),
+					TTL:             aws.Int64(60),
+					ResourceRecords: []types.ResourceRecord{{Value: aws.String("primary.awsapprunner.com")}},
+				},
+			},
+			{
+				Action: types.ChangeActionDelete,
+				ResourceRecordSet: &types.ResourceRecordSet{
+					Name:            aws.String("app.example.com"),
+					Type:            types.RRTypeCname,
+					SetIdentifier:   aws.String("pipecd-canary"),
+					Weight:          aws.Int64(20),
+					TTL:             aws.Int64(30),
+					ResourceRecords: []types.ResourceRecord{{Value: aws.String("canary.awsapprunner.com")}},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, batch)
+
+	_, err = makeChangeBatch([]WeightedRecordChange{{Action: "CREATE"}})
+	assert.Error(t, err)
+}
+
+func TestChangeWeightedRecords(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2013-04-01/hostedzone/Z123/rrset", r.URL.Path)
+		assert.Contains(t, r.Header.Get("Authorization"), "/route53/aws4_request")
+		io.WriteString(w, `<ChangeResourceRecordSetsResponse/>`)
+	})
+
+	changes := []WeightedRecordChange{
+		{
+			Action:        WeightedRecordActionUpsert,
+			RecordName:    "app.example.com",
+			SetIdentifier: PrimaryRecordSetIdentifier,
+			Target:        "primary.awsapprunner.com",
+			Weight:        100,
+		},
+	}
+	err := c.ChangeWeightedRecords(context.Background(), "/hostedzone/Z123", changes)
+	assert.NoError(t, err)
+}
+
+func TestChangeWeightedRecordsError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>InvalidChangeBatch</Code><Message>record not found</Message></Error></ErrorResponse>`)
+	})
+
+	err := c.ChangeWeightedRecords(context.Background(), "Z123", []WeightedRecordChange{
+		{Action: WeightedRecordActionDelete, RecordName: "app.example.com", SetIdentifier: CanaryRecordSetIdentifier, Target: "canary.awsapprunner.com"},
+	})
+	require.Error(t, err)
+	assert.True(t, IsAPIError(err, "InvalidChangeBatch"))
+}
@@ -0,0 +1,334 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	versionV1Beta1      = "pipecd.dev/v1beta1"
+	serviceManifestKind = "AppRunnerService"
+
+	imageRepositoryTypeECR       = "ECR"
+	imageRepositoryTypeECRPublic = "ECR_PUBLIC"
+)
+
+// The name of the service must be 4 to 40 characters long.
+// The name of the CANARY service is limited to be shorter since it has a suffix.
+var serviceNameRegex = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{3,32}$`)
+
+type ServiceManifest struct {
+	Kind       string              `json:"kind"`
+	APIVersion string              `json:"apiVersion,omitempty"`
+	Spec       ServiceManifestSpec `json:"spec"`
+}
+
+func (sm *ServiceManifest) validate() error {
+	if sm.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", sm.APIVersion)
+	}
+	if sm.Kind != serviceManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", sm.Kind)
+	}
+	return sm.Spec.validate()
+}
+
+// ServiceManifestSpec contains configuration for AppRunnerService.
+// The fields are tagged with the member names of the App Runner API,
+// while the manifest can be written in camel case since the names are matched case-insensitively.
+type ServiceManifestSpec struct {
+	ServiceName                 string                      `json:"ServiceName"`
+	SourceConfiguration         SourceConfiguration         `json:"SourceConfiguration"`
+	InstanceConfiguration       *InstanceConfiguration      `json:"InstanceConfiguration,omitempty"`
+	HealthCheckConfiguration    *HealthCheckConfiguration   `json:"HealthCheckConfiguration,omitempty"`
+	AutoScalingConfigurationArn string                      `json:"AutoScalingConfigurationArn,omitempty"`
+	NetworkConfiguration        *NetworkConfiguration       `json:"NetworkConfiguration,omitempty"`
+	ObservabilityConfiguration  *ObservabilityConfiguration `json:"ObservabilityConfiguration,omitempty"`
+	// Tags are only added when the service is created, use TagResource to update them later.
+	Tags map[string]string `json:"Tags,omitempty"`
+}
+
+type SourceConfiguration struct {
+	ImageRepository             *ImageRepository             `json:"ImageRepository,omitempty"`
+	AuthenticationConfiguration *AuthenticationConfiguration `json:"AuthenticationConfiguration,omitempty"`
+	// Automatic deployments must be disabled since the service is deployed by piped.
+	AutoDeploymentsEnabled *bool `json:"AutoDeploymentsEnabled,omitempty"`
+}
+
+type ImageRepository struct {
+	// The URI of the container image, e.g. 123456789012.dkr.ecr.us-east-1.amazonaws.com/app:v1.0.0.
+	ImageIdentifier string `json:"ImageIdentifier"`
+	// Either ECR or ECR_PUBLIC.
+	ImageRepositoryType string              `json:"ImageRepositoryType"`
+	ImageConfiguration  *ImageConfiguration `json:"ImageConfiguration,omitempty"`
+}
+
+type ImageConfiguration struct {
+	Port                        string            `json:"Port,omitempty"`
+	StartCommand                string            `json:"StartCommand,omitempty"`
+	RuntimeEnvironmentVariables map[string]string `json:"RuntimeEnvironmentVariables,omitempty"`
+	// The ARNs of the Secrets Manager secrets or SSM parameters keyed by the environment variable names.
+	RuntimeEnvironmentSecrets map[string]string `json:"RuntimeEnvironmentSecrets,omitempty"`
+}
+
+type AuthenticationConfiguration struct {
+	// The ARN of the IAM role granting App Runner access to the private ECR repository.
+	AccessRoleArn string `json:"AccessRoleArn,omitempty"`
+}
+
+type InstanceConfiguration struct {
+	Cpu             string `json:"Cpu,omitempty"`
+	Memory          string `json:"Memory,omitempty"`
+	InstanceRoleArn string `json:"InstanceRoleArn,omitempty"`
+}
+
+type HealthCheckConfiguration struct {
+	// Either TCP or HTTP.
+	Protocol           string `json:"Protocol,omitempty"`
+	Path               string `json:"Path,omitempty"`
+	Interval           int32  `json:"Interval,omitempty"`
+	Timeout            int32  `json:"Timeout,omitempty"`
+	HealthyThreshold   int32  `json:"HealthyThreshold,omitempty"`
+	UnhealthyThreshold int32  `json:"UnhealthyThreshold,omitempty"`
+}
+
+type NetworkConfiguration struct {
+	EgressConfiguration  *EgressConfiguration  `json:"EgressConfiguration,omitempty"`
+	IngressConfiguration *IngressConfiguration `json:"IngressConfiguration,omitempty"`
+}
+
+type EgressConfiguration struct {
+	// Either DEFAULT or VPC.
+	EgressType      string `json:"EgressType,omitempty"`
+	VpcConnectorArn string `json:"VpcConnectorArn,omitempty"`
+}
+
+type IngressConfiguration struct {
+	IsPubliclyAccessible *bool `json:"IsPubliclyAccessible,omitempty"`
+}
+
+type ObservabilityConfiguration struct {
+	ObservabilityEnabled          bool   `json:"ObservabilityEnabled"`
+	ObservabilityConfigurationArn string `json:"ObservabilityConfigurationArn,omitempty"`
+}
+
+func (s ServiceManifestSpec) validate() error {
+	if s.ServiceName == "" {
+		return fmt.Errorf("serviceName is missing")
+	}
+	if !serviceNameRegex.MatchString(s.ServiceName) {
+		return fmt.Errorf("serviceName must be 4 to 33 characters long and consist of alphanumeric characters, hyphens and underscores")
+	}
+	src := s.SourceConfiguration
+	if src.ImageRepository == nil {
+		return fmt.Errorf("sourceConfiguration.imageRepository is missing: only the services deployed from a container image are supported")
+	}
+	if src.ImageRepository.ImageIdentifier == "" {
+		return fmt.Errorf("sourceConfiguration.imageRepository.imageIdentifier is missing")
+	}
+	switch src.ImageRepository.ImageRepositoryType {
+	case imageRepositoryTypeECR, imageRepositoryTypeECRPublic:
+	default:
+		return fmt.Errorf("sourceConfiguration.imageRepository.imageRepositoryType must be %s or %s", imageRepositoryTypeECR, imageRepositoryTypeECRPublic)
+	}
+	if src.AutoDeploymentsEnabled != nil && *src.AutoDeploymentsEnabled {
+		return fmt.Errorf("sourceConfiguration.autoDeploymentsEnabled must be false since the service is deployed by piped")
+	}
+	// The SDK omits the false value of isPubliclyAccessible from the request,
+	// so it would be silently deployed as a public service.
+	if n := s.NetworkConfiguration; n != nil && n.IngressConfiguration != nil {
+		if p := n.IngressConfiguration.IsPubliclyAccessible; p != nil && !*p {
+			return fmt.Errorf("networkConfiguration.ingressConfiguration.isPubliclyAccessible: false is not supported yet")
+		}
+	}
+	return nil
+}
+
+func (c SourceConfiguration) toSDK() *types.SourceConfiguration {
+	out := &types.SourceConfiguration{
+		AutoDeploymentsEnabled: c.AutoDeploymentsEnabled,
+	}
+	if r := c.ImageRepository; r != nil {
+		out.ImageRepository = &types.ImageRepository{
+			ImageIdentifier:     aws.String(r.ImageIdentifier),
+			ImageRepositoryType: types.ImageRepositoryType(r.ImageRepositoryType),
+		}
+		if ic := r.ImageConfiguration; ic != nil {
+			out.ImageRepository.ImageConfiguration = &types.ImageConfiguration{
+				Port:                        optionalString(ic.Port),
+				StartCommand:                optionalString(ic.StartCommand),
+				RuntimeEnvironmentVariables: ic.RuntimeEnvironmentVariables,
+				RuntimeEnvironmentSecrets:   ic.RuntimeEnvironmentSecrets,
+			}
+		}
+	}
+	if a := c.AuthenticationConfiguration; a != nil {
+		out.AuthenticationConfiguration = &types.AuthenticationConfiguration{
+			AccessRoleArn: optionalString(a.AccessRoleArn),
+		}
+	}
+	return out
+}
+
+func (c *InstanceConfiguration) toSDK() *types.InstanceConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &types.InstanceConfiguration{
+		Cpu:             optionalString(c.Cpu),
+		Memory:          optionalString(c.Memory),
+		InstanceRoleArn: optionalString(c.InstanceRoleArn),
+	}
+}
+
+func (c *HealthCheckConfiguration) toSDK() *types.HealthCheckConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &types.HealthCheckConfiguration{
+		Protocol:           types.HealthCheckProtocol(c.Protocol),
+		Path:               optionalString(c.Path),
+		Interval:           optionalInt32(c.Interval),
+		Timeout:            optionalInt32(c.Timeout),
+		HealthyThreshold:   optionalInt32(c.HealthyThreshold),
+		UnhealthyThreshold: optionalInt32(c.UnhealthyThreshold),
+	}
+}
+
+func (c *NetworkConfiguration) toSDK() *types.NetworkConfiguration {
+	if c == nil {
+		return nil
+	}
+	out := &types.NetworkConfiguration{}
+	if e := c.EgressConfiguration; e != nil {
+		out.EgressConfiguration = &types.EgressConfiguration{
+			EgressType:      types.EgressType(e.EgressType),
+			VpcConnectorArn: optionalString(e.VpcConnectorArn),
+		}
+	}
+	if i := c.IngressConfiguration; i != nil {
+		out.IngressConfiguration = &types.IngressConfiguration{
+			// The service is publicly accessible by default.
+			IsPubliclyAccessible: i.IsPubliclyAccessible == nil || *i.IsPubliclyAccessible,
+		}
+	}
+	return out
+}
+
+func (c *ObservabilityConfiguration) toSDK() *types.ServiceObservabilityConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &types.ServiceObservabilityConfiguration{
+		ObservabilityEnabled:          c.ObservabilityEnabled,
+		ObservabilityConfigurationArn: optionalString(c.ObservabilityConfigurationArn),
+	}
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return aws.String(v)
+}
+
+func optionalInt32(v int32) *int32 {
+	if v == 0 {
+		return nil
+	}
+	return aws.Int32(v)
+}
+
+// AddTags adds the given tags to the service.
+func (sm *ServiceManifest) AddTags(tags map[string]string) {
+	if sm.Spec.Tags == nil {
+		sm.Spec.Tags = make(map[string]string, len(tags))
+	}
+	for k, v := range tags {
+		sm.Spec.Tags[k] = v
+	}
+}
+
+// ImageIdentifier returns the URI of the container image deployed by the service.
+func (sm ServiceManifest) ImageIdentifier() string {
+	return sm.Spec.SourceConfiguration.ImageRepository.ImageIdentifier
+}
+
+func loadServiceManifest(path string) (ServiceManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ServiceManifest{}, err
+	}
+	return parseServiceManifest(data)
+}
+
+func parseServiceManifest(data []byte) (ServiceManifest, error) {
+	var sm ServiceManifest
+	if err := yaml.Unmarshal(data, &sm); err != nil {
+		return ServiceManifest{}, err
+	}
+	if err := sm.validate(); err != nil {
+		return ServiceManifest{}, err
+	}
+	return sm, nil
+}
+
+// FindImageTag returns the tag of the container image deployed by the service.
+func FindImageTag(sm ServiceManifest) (string, error) {
+	name, tag := parseContainerImage(sm.ImageIdentifier())
+	if name == "" {
+		return "", fmt.Errorf("image name could not be empty")
+	}
+	return tag, nil
+}
+
+// FindArtifactVersions parses artifact versions from the service manifest.
+func FindArtifactVersions(sm ServiceManifest) ([]*model.ArtifactVersion, error) {
+	name, tag := parseContainerImage(sm.ImageIdentifier())
+	if name == "" {
+		return nil, fmt.Errorf("image name could not be empty")
+	}
+	return []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    name,
+			Url:     sm.ImageIdentifier(),
+		},
+	}, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	// The image can be pinned by its digest instead of its tag.
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	name = paths[len(paths)-1]
+	return
+}
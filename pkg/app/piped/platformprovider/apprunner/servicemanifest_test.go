@@ -0,0 +1,250 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apprunner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseServiceManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		data      string
+		expected  ServiceManifest
+		expectErr bool
+	}{
+		{
+			name: "valid manifest",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: simple
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: 123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/simple:v0.1.0
+      imageRepositoryType: ECR
+      imageConfiguration:
+        port: "8080"
+    authenticationConfiguration:
+      accessRoleArn: arn:aws:iam::123456789012:role/apprunner-ecr-access
+    autoDeploymentsEnabled: false
+  instanceConfiguration:
+    cpu: "1 vCPU"
+    memory: "2 GB"
+  tags:
+    app: simple
+`,
+			expected: ServiceManifest{
+				Kind:       "AppRunnerService",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: ServiceManifestSpec{
+					ServiceName: "simple",
+					SourceConfiguration: SourceConfiguration{
+						ImageRepository: &ImageRepository{
+							ImageIdentifier:     "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/simple:v0.1.0",
+							ImageRepositoryType: "ECR",
+							ImageConfiguration: &ImageConfiguration{
+								Port: "8080",
+							},
+						},
+						AuthenticationConfiguration: &AuthenticationConfiguration{
+							AccessRoleArn: "arn:aws:iam::123456789012:role/apprunner-ecr-access",
+						},
+						AutoDeploymentsEnabled: boolPointer(false),
+					},
+					InstanceConfiguration: &InstanceConfiguration{
+						Cpu:    "1 vCPU",
+						Memory: "2 GB",
+					},
+					Tags: map[string]string{"app": "simple"},
+				},
+			},
+		},
+		{
+			name: "missing service name",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: public.ecr.aws/pipecd/helloworld:v0.1.0
+      imageRepositoryType: ECR_PUBLIC
+`,
+			expectErr: true,
+		},
+		{
+			name: "too long service name",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: a-service-name-which-is-too-long-x
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: public.ecr.aws/pipecd/helloworld:v0.1.0
+      imageRepositoryType: ECR_PUBLIC
+`,
+			expectErr: true,
+		},
+		{
+			name: "code repository is not supported",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: simple
+  sourceConfiguration:
+    codeRepository:
+      repositoryUrl: https://github.com/pipe-cd/examples
+`,
+			expectErr: true,
+		},
+		{
+			name: "unsupported image repository type",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: simple
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: gcr.io/pipecd/helloworld:v0.1.0
+      imageRepositoryType: GCR
+`,
+			expectErr: true,
+		},
+		{
+			name: "auto deployments enabled",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: simple
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: public.ecr.aws/pipecd/helloworld:v0.1.0
+      imageRepositoryType: ECR_PUBLIC
+    autoDeploymentsEnabled: true
+`,
+			expectErr: true,
+		},
+		{
+			name: "private service",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AppRunnerService
+spec:
+  serviceName: simple
+  sourceConfiguration:
+    imageRepository:
+      imageIdentifier: public.ecr.aws/pipecd/helloworld:v0.1.0
+      imageRepositoryType: ECR_PUBLIC
+  networkConfiguration:
+    ingressConfiguration:
+      isPubliclyAccessible: false
+`,
+			expectErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sm, err := parseServiceManifest([]byte(tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sm)
+		})
+	}
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		image    string
+		expected []*model.ArtifactVersion
+	}{
+		{
+			name:  "image with tag",
+			image: "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/team/simple:v0.1.0",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v0.1.0",
+					Name:    "simple",
+					Url:     "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/team/simple:v0.1.0",
+				},
+			},
+		},
+		{
+			name:  "image with digest",
+			image: "public.ecr.aws/pipecd/helloworld@sha256:abc",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "sha256:abc",
+					Name:    "helloworld",
+					Url:     "public.ecr.aws/pipecd/helloworld@sha256:abc",
+				},
+			},
+		},
+		{
+			name:  "registry with port and no tag",
+			image: "localhost:5000/helloworld",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "",
+					Name:    "helloworld",
+					Url:     "localhost:5000/helloworld",
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sm := ServiceManifest{
+				Spec: ServiceManifestSpec{
+					SourceConfiguration: SourceConfiguration{
+						ImageRepository: &ImageRepository{ImageIdentifier: tc.image},
+					},
+				},
+			}
+			versions, err := FindArtifactVersions(sm)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func boolPointer(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package azureapi provides a minimal client to call Azure Resource Manager API.
+// Requests are authorized by the access tokens retrieved by the credential of Azure SDK
+// selected by the credentials configured in the platform provider.
+package azureapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+)
+
+const (
+	defaultEndpoint = "https://management.azure.com"
+	requestTimeout  = 30 * time.Second
+)
+
+// APIError represents an error response returned from Azure Resource Manager API.
+type APIError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("azure api error: status=%d, code=%s, message=%s", e.StatusCode, e.Code, e.Message)
+}
+
+// IsNotFound checks whether the given error is an APIError telling the resource does not exist.
+func IsNotFound(err error) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.StatusCode == http.StatusNotFound
+}
+
+// Client sends authorized requests to Azure Resource Manager API.
+type Client struct {
+	endpoint   string
+	credential azcore.TokenCredential
+	httpClient *http.Client
+}
+
+type Option func(*Client)
+
+// WithEndpoint overrides the endpoint of Azure Resource Manager API.
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.endpoint = strings.TrimSuffix(endpoint, "/")
+	}
+}
+
+// WithHTTPClient sets the http client used to send requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// NewClient returns a client authorizing the requests with the tokens of the given credential.
+func NewClient(cred azcore.TokenCredential, opts ...Option) *Client {
+	c := &Client{
+		endpoint:   defaultEndpoint,
+		credential: cred,
+		httpClient: &http.Client{Timeout: requestTimeout},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Do sends a request to the given resource path with the given API version.
+// The in value is encoded as JSON request body if given and
+// the response body is decoded into the out value if given.
+func (c *Client) Do(ctx context.Context, method, path, apiVersion string, in, out interface{}) error {
+	u := c.endpoint + path + "?" + url.Values{"api-version": {apiVersion}}.Encode()
+	data, err := c.send(ctx, method, u, in)
+	if err != nil {
+		return err
+	}
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// List returns all items of the given collection by following its next links.
+func (c *Client) List(ctx context.Context, path, apiVersion string) ([]json.RawMessage, error) {
+	var (
+		u     = c.endpoint + path + "?" + url.Values{"api-version": {apiVersion}}.Encode()
+		items []json.RawMessage
+	)
+	for u != "" {
+		data, err := c.send(ctx, http.MethodGet, u, nil)
+		if err != nil {
+			return nil, err
+		}
+		var page struct {
+			Value    []json.RawMessage `json:"value"`
+			NextLink string            `json:"nextLink"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, err
+		}
+		items = append(items, page.Value...)
+		u = page.NextLink
+	}
+	return items, nil
+}
+
+func (c *Client) send(ctx context.Context, method, u string, in interface{}) ([]byte, error) {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if err := Authorize(req, c.credential); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		return nil, parseError(resp, data)
+	}
+	return data, nil
+}
+
+// Authorize sets the access token for Azure Resource Manager API retrieved by the given credential to the request.
+func Authorize(req *http.Request, cred azcore.TokenCredential) error {
+	token, err := cred.GetToken(req.Context(), policy.TokenRequestOptions{
+		Scopes: []string{managementScope},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to retrieve access token: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+	return nil
+}
+
+func parseError(resp *http.Response, data []byte) error {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+	}
+	var body struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &body); err == nil && body.Error.Code != "" {
+		apiErr.Code = body.Error.Code
+		apiErr.Message = body.Error.Message
+	} else {
+		apiErr.Message = strings.TrimSpace(string(data))
+	}
+	return apiErr
+}
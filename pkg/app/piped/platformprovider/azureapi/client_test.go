@@ -0,0 +1,132 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureapi
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticCredential string
+
+func (c staticCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: string(c), ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return NewClient(staticCredential("token"), WithEndpoint(server.URL))
+}
+
+func TestDo(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method)
+		assert.Equal(t, "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/containerApps/foo", r.URL.Path)
+		assert.Equal(t, "2023-05-01", r.URL.Query().Get("api-version"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		body, _ := io.ReadAll(r.Body)
+		assert.JSONEq(t, `{"location":"japaneast"}`, string(body))
+		w.Write([]byte(`{"name":"foo"}`))
+	})
+
+	var out struct {
+		Name string `json:"name"`
+	}
+	err := c.Do(context.Background(), http.MethodPut, "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/containerApps/foo", "2023-05-01", map[string]string{"location": "japaneast"}, &out)
+	require.NoError(t, err)
+	assert.Equal(t, "foo", out.Name)
+}
+
+func TestDoError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		status   int
+		body     string
+		expected *APIError
+		notFound bool
+	}{
+		{
+			name:   "not found",
+			status: http.StatusNotFound,
+			body:   `{"error":{"code":"ResourceNotFound","message":"The resource was not found."}}`,
+			expected: &APIError{
+				StatusCode: http.StatusNotFound,
+				Code:       "ResourceNotFound",
+				Message:    "The resource was not found.",
+			},
+			notFound: true,
+		},
+		{
+			name:   "unstructured body",
+			status: http.StatusInternalServerError,
+			body:   "internal error\n",
+			expected: &APIError{
+				StatusCode: http.StatusInternalServerError,
+				Message:    "internal error",
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+			err := c.Do(context.Background(), http.MethodGet, "/foo", "2023-05-01", nil, nil)
+			assert.Equal(t, tc.expected, err)
+			assert.Equal(t, tc.notFound, IsNotFound(err))
+		})
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	var serverURL string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		if r.URL.Query().Get("page") == "2" {
+			w.Write([]byte(`{"value":[{"name":"c"}]}`))
+			return
+		}
+		w.Write([]byte(`{"value":[{"name":"a"},{"name":"b"}],"nextLink":"` + serverURL + `/foo?api-version=2023-05-01&page=2"}`))
+	})
+	serverURL = c.endpoint
+
+	items, err := c.List(context.Background(), "/foo", "2023-05-01")
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.JSONEq(t, `{"name":"c"}`, string(items[2]))
+}
@@ -0,0 +1,116 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureapi
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	CredentialsTypeDefault          = "default"
+	CredentialsTypeClientSecret     = "clientSecret"
+	CredentialsTypeWorkloadIdentity = "workloadIdentity"
+	CredentialsTypeManagedIdentity  = "managedIdentity"
+
+	// The scope of the access tokens for Azure Resource Manager API.
+	managementScope = "https://management.azure.com/.default"
+)
+
+// NewCredential returns the credential of Azure SDK selected by the given credentials config.
+// The values not specified in the config are read from the environment variables used by Azure SDK.
+func NewCredential(creds config.AzureCredentials) (azcore.TokenCredential, error) {
+	typ := creds.Type
+	if typ == "" || typ == CredentialsTypeDefault {
+		switch {
+		case creds.ClientSecretFile != "":
+			typ = CredentialsTypeClientSecret
+		case creds.FederatedTokenFile != "":
+			typ = CredentialsTypeWorkloadIdentity
+		default:
+			cred, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+				TenantID: creds.TenantID,
+			})
+			if err != nil {
+				return nil, err
+			}
+			return cred, nil
+		}
+	}
+
+	switch typ {
+	case CredentialsTypeClientSecret:
+		tenantID := valueOrEnv(creds.TenantID, "AZURE_TENANT_ID")
+		clientID := valueOrEnv(creds.ClientID, "AZURE_CLIENT_ID")
+		if tenantID == "" || clientID == "" {
+			return nil, fmt.Errorf("tenant ID and client ID are required to use the client secret")
+		}
+		secret := os.Getenv("AZURE_CLIENT_SECRET")
+		if creds.ClientSecretFile != "" {
+			data, err := os.ReadFile(creds.ClientSecretFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read client secret file %s: %w", creds.ClientSecretFile, err)
+			}
+			secret = strings.TrimSpace(string(data))
+		}
+		if secret == "" {
+			return nil, fmt.Errorf("client secret must not be empty")
+		}
+		cred, err := azidentity.NewClientSecretCredential(tenantID, clientID, secret, nil)
+		if err != nil {
+			return nil, err
+		}
+		return cred, nil
+
+	case CredentialsTypeWorkloadIdentity:
+		cred, err := azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			TenantID:      creds.TenantID,
+			ClientID:      creds.ClientID,
+			TokenFilePath: creds.FederatedTokenFile,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return cred, nil
+
+	case CredentialsTypeManagedIdentity:
+		opts := &azidentity.ManagedIdentityCredentialOptions{}
+		// Empty means the system-assigned managed identity.
+		if clientID := valueOrEnv(creds.ClientID, "AZURE_CLIENT_ID"); clientID != "" {
+			opts.ID = azidentity.ClientID(clientID)
+		}
+		cred, err := azidentity.NewManagedIdentityCredential(opts)
+		if err != nil {
+			return nil, err
+		}
+		return cred, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported credentials type: %s", typ)
+	}
+}
+
+func valueOrEnv(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azureapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestNewCredential(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "secret")
+	require.NoError(t, os.WriteFile(secretFile, []byte("secret\n"), 0600))
+	tokenFile := filepath.Join(dir, "token")
+	require.NoError(t, os.WriteFile(tokenFile, []byte("token"), 0600))
+
+	testcases := []struct {
+		name     string
+		creds    config.AzureCredentials
+		expected azcore.TokenCredential
+	}{
+		{
+			name:     "client secret",
+			creds:    config.AzureCredentials{Type: CredentialsTypeClientSecret, TenantID: "tenant", ClientID: "client", ClientSecretFile: secretFile},
+			expected: &azidentity.ClientSecretCredential{},
+		},
+		{
+			name:     "workload identity",
+			creds:    config.AzureCredentials{Type: CredentialsTypeWorkloadIdentity, TenantID: "tenant", ClientID: "client", FederatedTokenFile: tokenFile},
+			expected: &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			name:     "managed identity",
+			creds:    config.AzureCredentials{Type: CredentialsTypeManagedIdentity, ClientID: "client"},
+			expected: &azidentity.ManagedIdentityCredential{},
+		},
+		{
+			name:     "default with client secret file",
+			creds:    config.AzureCredentials{TenantID: "tenant", ClientID: "client", ClientSecretFile: secretFile},
+			expected: &azidentity.ClientSecretCredential{},
+		},
+		{
+			name:     "default with federated token file",
+			creds:    config.AzureCredentials{Type: CredentialsTypeDefault, TenantID: "tenant", ClientID: "client", FederatedTokenFile: tokenFile},
+			expected: &azidentity.WorkloadIdentityCredential{},
+		},
+		{
+			name:     "default",
+			creds:    config.AzureCredentials{Type: CredentialsTypeDefault},
+			expected: &azidentity.DefaultAzureCredential{},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := NewCredential(tc.creds)
+			require.NoError(t, err)
+			assert.IsType(t, tc.expected, got)
+		})
+	}
+}
+
+func TestNewCredentialInvalid(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name  string
+		creds config.AzureCredentials
+	}{
+		{
+			name:  "client secret without tenant",
+			creds: config.AzureCredentials{Type: CredentialsTypeClientSecret, ClientID: "client", ClientSecretFile: "/dev/null"},
+		},
+		{
+			name:  "client secret with missing file",
+			creds: config.AzureCredentials{Type: CredentialsTypeClientSecret, TenantID: "tenant", ClientID: "client", ClientSecretFile: "/not/found"},
+		},
+		{
+			name:  "workload identity without token file",
+			creds: config.AzureCredentials{Type: CredentialsTypeWorkloadIdentity, TenantID: "tenant", ClientID: "client", FederatedTokenFile: ""},
+		},
+		{
+			name:  "unknown type",
+			creds: config.AzureCredentials{Type: "unknown"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := NewCredential(tc.creds)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The keys of the tags added by piped.
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+
+	// ProductionSlot is the name of the production slot of the function apps.
+	ProductionSlot = "production"
+)
+
+// ErrNotFound is returned when the requested function app or slot does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of Azure Resource Manager API and the deployment API of Azure Functions.
+// The empty slot means the production slot of the function app.
+type Client interface {
+	// GetSite returns the function app or its deployment slot.
+	// ErrNotFound is returned when there is no such app or slot.
+	GetSite(ctx context.Context, name, slot string) (*Site, error)
+	// CreateSlot creates the deployment slot of the given function app in the same plan.
+	CreateSlot(ctx context.Context, name, slot string) (*Site, error)
+	// UpdateTags replaces the tags of the function app or its deployment slot.
+	UpdateTags(ctx context.Context, name, slot string, tags map[string]string) error
+	GetSiteConfig(ctx context.Context, name, slot string) (*SiteConfig, error)
+	// UpdateContainerImage sets the container image run by the function app or its deployment slot.
+	UpdateContainerImage(ctx context.Context, name, slot, image string) error
+	ListAppSettings(ctx context.Context, name, slot string) (map[string]string, error)
+	// UpdateAppSettings replaces all app settings of the function app or its deployment slot.
+	UpdateAppSettings(ctx context.Context, name, slot string, settings map[string]string) error
+	// DeployZip uploads the given zip package to the given function app or deployment slot
+	// and waits until the deployment has been done.
+	DeployZip(ctx context.Context, site *Site, pkg io.Reader) error
+	// SwapSlot swaps the given deployment slot with production and waits until the swap has been done.
+	SwapSlot(ctx context.Context, name, slot string) error
+}
+
+// Registry holds a pool of Azure Functions client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderAzureFunctionsConfig, logger *zap.Logger) (Client, error)
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderAzureFunctionsConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.SubscriptionID, cfg.ResourceGroup, cfg.Credentials, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of Azure Functions clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,298 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azureapi"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	apiVersion = "2022-09-01"
+
+	defaultPollInterval = 5 * time.Second
+	requestTimeout      = 30 * time.Second
+	// Uploading the packages can take longer than the other requests.
+	uploadTimeout = 10 * time.Minute
+
+	// The statuses of the deployments of the deployment API (Kudu).
+	deploymentStatusFailed  = 3
+	deploymentStatusSuccess = 4
+)
+
+type client struct {
+	api           *azureapi.Client
+	credential    azcore.TokenCredential
+	uploadClient  *http.Client
+	resourceGroup string
+	pollInterval  time.Duration
+	logger        *zap.Logger
+}
+
+func newClient(subscriptionID, resourceGroup string, creds config.AzureCredentials, logger *zap.Logger) (*client, error) {
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscriptionId is required field")
+	}
+	if resourceGroup == "" {
+		return nil, fmt.Errorf("resourceGroup is required field")
+	}
+
+	hc := &http.Client{Timeout: requestTimeout}
+	cred, err := azureapi.NewCredential(creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load azure credentials: %w", err)
+	}
+
+	return &client{
+		api:           azureapi.NewClient(cred, azureapi.WithHTTPClient(hc)),
+		credential:    cred,
+		uploadClient:  &http.Client{Timeout: uploadTimeout},
+		resourceGroup: fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, resourceGroup),
+		pollInterval:  defaultPollInterval,
+		logger:        logger.Named("azurefunctions"),
+	}, nil
+}
+
+func (c *client) sitePath(name, slot string) string {
+	path := c.resourceGroup + "/providers/Microsoft.Web/sites/" + name
+	if slot != "" && slot != ProductionSlot {
+		path += "/slots/" + slot
+	}
+	return path
+}
+
+func (c *client) GetSite(ctx context.Context, name, slot string) (*Site, error) {
+	var site Site
+	if err := c.api.Do(ctx, http.MethodGet, c.sitePath(name, slot), apiVersion, nil, &site); err != nil {
+		if azureapi.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get function app %s: %w", siteName(name, slot), err)
+	}
+	return &site, nil
+}
+
+func (c *client) CreateSlot(ctx context.Context, name, slot string) (*Site, error) {
+	production, err := c.GetSite(ctx, name, "")
+	if err != nil {
+		return nil, err
+	}
+	// The configuration and the app settings of production are copied to the new slot.
+	in := map[string]interface{}{
+		"location": production.Location,
+		"kind":     production.Kind,
+		"properties": map[string]interface{}{
+			"serverFarmId": production.Properties.ServerFarmID,
+			"cloningInfo": map[string]interface{}{
+				"sourceWebAppId": production.ID,
+			},
+		},
+	}
+	var site Site
+	if err := c.api.Do(ctx, http.MethodPut, c.sitePath(name, slot), apiVersion, in, &site); err != nil {
+		return nil, fmt.Errorf("failed to create deployment slot %s: %w", siteName(name, slot), err)
+	}
+	return &site, nil
+}
+
+func (c *client) UpdateTags(ctx context.Context, name, slot string, tags map[string]string) error {
+	in := map[string]interface{}{
+		"tags": tags,
+	}
+	if err := c.api.Do(ctx, http.MethodPatch, c.sitePath(name, slot), apiVersion, in, nil); err != nil {
+		return fmt.Errorf("failed to update tags of function app %s: %w", siteName(name, slot), err)
+	}
+	return nil
+}
+
+func (c *client) GetSiteConfig(ctx context.Context, name, slot string) (*SiteConfig, error) {
+	var out struct {
+		Properties SiteConfig `json:"properties"`
+	}
+	if err := c.api.Do(ctx, http.MethodGet, c.sitePath(name, slot)+"/config/web", apiVersion, nil, &out); err != nil {
+		return nil, fmt.Errorf("failed to get configuration of function app %s: %w", siteName(name, slot), err)
+	}
+	return &out.Properties, nil
+}
+
+func (c *client) UpdateContainerImage(ctx context.Context, name, slot, image string) error {
+	in := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"linuxFxVersion": linuxFxVersionDockerPrefix + image,
+		},
+	}
+	if err := c.api.Do(ctx, http.MethodPatch, c.sitePath(name, slot)+"/config/web", apiVersion, in, nil); err != nil {
+		return fmt.Errorf("failed to update container image of function app %s: %w", siteName(name, slot), err)
+	}
+	return nil
+}
+
+func (c *client) ListAppSettings(ctx context.Context, name, slot string) (map[string]string, error) {
+	var out struct {
+		Properties map[string]string `json:"properties"`
+	}
+	if err := c.api.Do(ctx, http.MethodPost, c.sitePath(name, slot)+"/config/appsettings/list", apiVersion, nil, &out); err != nil {
+		return nil, fmt.Errorf("failed to list app settings of function app %s: %w", siteName(name, slot), err)
+	}
+	if out.Properties == nil {
+		out.Properties = make(map[string]string)
+	}
+	return out.Properties, nil
+}
+
+func (c *client) UpdateAppSettings(ctx context.Context, name, slot string, settings map[string]string) error {
+	in := map[string]interface{}{
+		"properties": settings,
+	}
+	if err := c.api.Do(ctx, http.MethodPut, c.sitePath(name, slot)+"/config/appsettings", apiVersion, in, nil); err != nil {
+		return fmt.Errorf("failed to update app settings of function app %s: %w", siteName(name, slot), err)
+	}
+	return nil
+}
+
+func (c *client) DeployZip(ctx context.Context, site *Site, pkg io.Reader) error {
+	host := site.ScmHost()
+	if host == "" {
+		return fmt.Errorf("unable to find the deployment endpoint of function app %s", site.Name)
+	}
+	u := "https://" + host + "/api/zipdeploy?isAsync=true"
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, pkg)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/zip")
+	resp, err := c.sendDeploymentRequest(req)
+	if err != nil {
+		return fmt.Errorf("failed to upload package to function app %s: %w", site.Name, err)
+	}
+	resp.Body.Close()
+
+	// The status of the asynchronous deployment is served at the location.
+	location, err := resp.Location()
+	if err != nil {
+		return fmt.Errorf("failed to find the status of the deployment to function app %s: %w", site.Name, err)
+	}
+	return c.waitDeployment(ctx, site.Name, location)
+}
+
+func (c *client) waitDeployment(ctx context.Context, name string, location *url.URL) error {
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, location.String(), nil)
+		if err != nil {
+			return err
+		}
+		resp, err := c.sendDeploymentRequest(req)
+		if err != nil {
+			return fmt.Errorf("failed to get the status of the deployment to function app %s: %w", name, err)
+		}
+		var status struct {
+			ID         string `json:"id"`
+			Status     int    `json:"status"`
+			StatusText string `json:"status_text"`
+			Complete   bool   `json:"complete"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&status)
+		resp.Body.Close()
+		if err != nil {
+			return fmt.Errorf("malformed status of the deployment to function app %s: %w", name, err)
+		}
+
+		switch {
+		case status.Status == deploymentStatusFailed:
+			return fmt.Errorf("deployment %s to function app %s failed: %s", status.ID, name, status.StatusText)
+		case status.Complete && status.Status == deploymentStatusSuccess:
+			return nil
+		}
+		c.logger.Debug(fmt.Sprintf("waiting for deployment %s to function app %s to be completed", status.ID, name))
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+// sendDeploymentRequest sends the given request to the deployment API authorized by the token of Azure Resource Manager API.
+func (c *client) sendDeploymentRequest(req *http.Request) (*http.Response, error) {
+	if err := azureapi.Authorize(req, c.credential); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.uploadClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		data, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("status=%d, body=%s", resp.StatusCode, strings.TrimSpace(string(data)))
+	}
+	return resp, nil
+}
+
+func (c *client) SwapSlot(ctx context.Context, name, slot string) error {
+	before, err := c.GetSite(ctx, name, "")
+	if err != nil {
+		return err
+	}
+
+	in := map[string]interface{}{
+		"targetSlot":   ProductionSlot,
+		"preserveVnet": true,
+	}
+	if err := c.api.Do(ctx, http.MethodPost, c.sitePath(name, slot)+"/slotsswap", apiVersion, in, nil); err != nil {
+		return fmt.Errorf("failed to swap deployment slot %s with production: %w", siteName(name, slot), err)
+	}
+
+	// The swap is done in the background, so wait until production records a new swap.
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+
+		site, err := c.GetSite(ctx, name, "")
+		if err != nil {
+			return err
+		}
+		if site.swapTimestamp().After(before.swapTimestamp()) && site.Running() {
+			return nil
+		}
+		c.logger.Debug(fmt.Sprintf("waiting for deployment slot %s to be swapped with production", siteName(name, slot)))
+	}
+}
+
+// siteName returns the name of the function app or its deployment slot used in the messages.
+func siteName(name, slot string) string {
+	if slot == "" || slot == ProductionSlot {
+		return name
+	}
+	return name + "/" + slot
+}
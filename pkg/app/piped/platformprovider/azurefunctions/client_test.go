@@ -0,0 +1,202 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azureapi"
+)
+
+type staticCredential string
+
+func (c staticCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: string(c), ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+const testSitePath = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.Web/sites/func"
+
+func newTestClient(t *testing.T, h http.HandlerFunc) (*client, *httptest.Server) {
+	ts := httptest.NewTLSServer(h)
+	t.Cleanup(ts.Close)
+	cred := staticCredential("token")
+	return &client{
+		api:           azureapi.NewClient(cred, azureapi.WithEndpoint(ts.URL), azureapi.WithHTTPClient(ts.Client())),
+		credential:    cred,
+		uploadClient:  ts.Client(),
+		resourceGroup: "/subscriptions/sub/resourceGroups/rg",
+		pollInterval:  time.Millisecond,
+		logger:        zap.NewNop(),
+	}, ts
+}
+
+func TestClientSitePath(t *testing.T) {
+	t.Parallel()
+
+	c := &client{resourceGroup: "/subscriptions/sub/resourceGroups/rg"}
+	assert.Equal(t, testSitePath, c.sitePath("func", ""))
+	assert.Equal(t, testSitePath, c.sitePath("func", ProductionSlot))
+	assert.Equal(t, testSitePath+"/slots/staging", c.sitePath("func", "staging"))
+}
+
+func TestClientGetSiteNotFound(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"ResourceNotFound","message":"not found"}}`))
+	})
+	_, err := c.GetSite(context.Background(), "func", "staging")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClientAppSettings(t *testing.T) {
+	t.Parallel()
+
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == testSitePath+"/slots/staging/config/appsettings/list":
+			w.Write([]byte(`{"properties":{"FUNCTIONS_WORKER_RUNTIME":"node"}}`))
+		case r.Method == http.MethodPut && r.URL.Path == testSitePath+"/slots/staging/config/appsettings":
+			var in struct {
+				Properties map[string]string `json:"properties"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, map[string]string{"FUNCTIONS_WORKER_RUNTIME": "node", "GREETING": "hello"}, in.Properties)
+			w.Write([]byte(`{}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	settings, err := c.ListAppSettings(context.Background(), "func", "staging")
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"FUNCTIONS_WORKER_RUNTIME": "node"}, settings)
+
+	settings["GREETING"] = "hello"
+	require.NoError(t, c.UpdateAppSettings(context.Background(), "func", "staging", settings))
+}
+
+func TestClientDeployZip(t *testing.T) {
+	t.Parallel()
+
+	var polls int32
+	c, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/zipdeploy":
+			assert.Equal(t, "true", r.URL.Query().Get("isAsync"))
+			assert.Equal(t, "application/zip", r.Header.Get("Content-Type"))
+			data, err := io.ReadAll(r.Body)
+			require.NoError(t, err)
+			assert.Equal(t, "zip", string(data))
+			w.Header().Set("Location", "https://"+r.Host+"/api/deployments/latest")
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == "/api/deployments/latest":
+			if atomic.AddInt32(&polls, 1) < 3 {
+				w.WriteHeader(http.StatusAccepted)
+				w.Write([]byte(`{"id":"d1","status":1,"complete":false}`))
+				return
+			}
+			w.Write([]byte(`{"id":"d1","status":4,"complete":true}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	site := &Site{
+		Name: "func",
+		Properties: SiteProperties{
+			HostNameSslStates: []HostNameSslState{
+				{Name: "func.azurewebsites.net", HostType: "Standard"},
+				{Name: strings.TrimPrefix(ts.URL, "https://"), HostType: hostTypeRepository},
+			},
+		},
+	}
+	require.NoError(t, c.DeployZip(context.Background(), site, strings.NewReader("zip")))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&polls))
+}
+
+func TestClientDeployZipFailed(t *testing.T) {
+	t.Parallel()
+
+	c, ts := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/zipdeploy":
+			w.Header().Set("Location", "https://"+r.Host+"/api/deployments/latest")
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.Write([]byte(`{"id":"d1","status":3,"status_text":"build failed","complete":true}`))
+		}
+	})
+
+	site := &Site{
+		Name: "func",
+		Properties: SiteProperties{
+			HostNameSslStates: []HostNameSslState{
+				{Name: strings.TrimPrefix(ts.URL, "https://"), HostType: hostTypeRepository},
+			},
+		},
+	}
+	err := c.DeployZip(context.Background(), site, strings.NewReader("zip"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "build failed")
+}
+
+func TestClientSwapSlot(t *testing.T) {
+	t.Parallel()
+
+	var (
+		swapped int32
+		gets    int32
+	)
+	c, _ := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == testSitePath+"/slots/staging/slotsswap":
+			var in map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, ProductionSlot, in["targetSlot"])
+			atomic.StoreInt32(&swapped, 1)
+			w.WriteHeader(http.StatusAccepted)
+		case r.Method == http.MethodGet && r.URL.Path == testSitePath:
+			// The swap status is updated after the second poll.
+			if atomic.LoadInt32(&swapped) == 0 || atomic.AddInt32(&gets, 1) < 2 {
+				w.Write([]byte(`{"name":"func","properties":{"state":"Running","slotSwapStatus":{"timestampUtc":"2023-01-01T00:00:00Z","sourceSlotName":"staging","destinationSlotName":"production"}}}`))
+				return
+			}
+			w.Write([]byte(`{"name":"func","properties":{"state":"Running","slotSwapStatus":{"timestampUtc":"2023-06-01T00:00:00Z","sourceSlotName":"staging","destinationSlotName":"production"}}}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	require.NoError(t, c.SwapSlot(context.Background(), "func", "staging"))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+}
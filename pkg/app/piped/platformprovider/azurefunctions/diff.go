@@ -0,0 +1,126 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// LiveFunctionApp is the current configuration of a function app compared with the manifest.
+type LiveFunctionApp struct {
+	Tags        map[string]string
+	AppSettings map[string]string
+	// The container image run by the function app, empty if it runs a package.
+	ContainerImage string
+}
+
+// DiffLiveFunctionApp calculates the diff between the configuration of the running function app and the one defined in Git.
+// Only the tags, the app settings and the container image are compared.
+// The tags and the app settings not specified in Git are ignored since they are kept by piped.
+func DiffLiveFunctionApp(live LiveFunctionApp, expected FunctionAppManifest) (*diff.Result, error) {
+	l := comparedFields(live.Tags, live.AppSettings)
+	if expected.Spec.Container != nil {
+		l["container"] = map[string]string{"image": live.ContainerImage}
+	}
+	lu, err := toUnstructured(l)
+	if err != nil {
+		return nil, err
+	}
+	eu, err := toUnstructured(expectedFields(expected))
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(lu, eu, expected.Spec.Name,
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+	)
+}
+
+// DiffFunctionAppManifests calculates the diff between the specs of the two given manifests.
+// The values of the app settings are compared by their hashes since they can contain secrets.
+func DiffFunctionAppManifests(old, new FunctionAppManifest) (*diff.Result, error) {
+	o, err := toUnstructured(manifestFields(old))
+	if err != nil {
+		return nil, err
+	}
+	n, err := toUnstructured(manifestFields(new))
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(o, n, new.Spec.Name, diff.WithEquateEmpty())
+}
+
+func manifestFields(m FunctionAppManifest) map[string]interface{} {
+	spec := m.Spec
+	spec.AppSettings = hashValues(spec.AppSettings)
+	return map[string]interface{}{
+		"spec": spec,
+	}
+}
+
+func expectedFields(m FunctionAppManifest) map[string]interface{} {
+	fields := comparedFields(m.Spec.Tags, m.AppSettings())
+	if c := m.Spec.Container; c != nil {
+		fields["container"] = map[string]string{"image": c.Image}
+	}
+	return fields
+}
+
+// comparedFields returns the tags excluding the ones added by piped and the app settings with their values hashed.
+func comparedFields(tags, settings map[string]string) map[string]interface{} {
+	t := make(map[string]string, len(tags))
+	for k, v := range tags {
+		if !strings.HasPrefix(k, "pipecd-dev-") {
+			t[k] = v
+		}
+	}
+	return map[string]interface{}{
+		"tags":        t,
+		"appSettings": hashValues(settings),
+	}
+}
+
+// hashValues returns a copy of the given settings with their values replaced by their hashes
+// so that the secrets in them are never shown in the diff.
+func hashValues(settings map[string]string) map[string]string {
+	if settings == nil {
+		return nil
+	}
+	out := make(map[string]string, len(settings))
+	for k, v := range settings {
+		sum := sha256.Sum256([]byte(v))
+		out[k] = "sha256:" + hex.EncodeToString(sum[:])[:12]
+	}
+	return out
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLiveFunctionApp(t *testing.T) {
+	t.Parallel()
+
+	expected := FunctionAppManifest{
+		Spec: FunctionAppManifestSpec{
+			Name:        "func",
+			Container:   &Container{Image: "myregistry.azurecr.io/hello:v1.0.0"},
+			AppSettings: map[string]string{"GREETING": "hello"},
+			Tags:        map[string]string{"team": "web"},
+		},
+	}
+
+	testcases := []struct {
+		name          string
+		live          LiveFunctionApp
+		expectedPaths []string
+	}{
+		{
+			name: "no diff with the settings and tags added by others",
+			live: LiveFunctionApp{
+				Tags: map[string]string{
+					"team":          "web",
+					LabelCommitHash: "0123abc",
+					"hidden-link":   "insights",
+				},
+				AppSettings: map[string]string{
+					"GREETING":            "hello",
+					"AzureWebJobsStorage": "connection",
+				},
+				ContainerImage: "myregistry.azurecr.io/hello:v1.0.0",
+			},
+			expectedPaths: []string{},
+		},
+		{
+			name: "changed on the portal",
+			live: LiveFunctionApp{
+				Tags: map[string]string{"team": "api"},
+				AppSettings: map[string]string{
+					"GREETING": "hi",
+				},
+				ContainerImage: "myregistry.azurecr.io/hello:v0.9.0",
+			},
+			expectedPaths: []string{"tags.team", "appSettings.GREETING", "container.image"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := DiffLiveFunctionApp(tc.live, expected)
+			require.NoError(t, err)
+			paths := make([]string, 0, result.NumNodes())
+			for _, n := range result.Nodes() {
+				paths = append(paths, n.PathString)
+			}
+			assert.ElementsMatch(t, tc.expectedPaths, paths)
+		})
+	}
+}
+
+func TestDiffFunctionAppManifests(t *testing.T) {
+	t.Parallel()
+
+	old := FunctionAppManifest{
+		Spec: FunctionAppManifestSpec{
+			Name:        "func",
+			Package:     &Package{Path: "src"},
+			AppSettings: map[string]string{"API_KEY": "old-secret", "GREETING": "hello"},
+		},
+	}
+	new := FunctionAppManifest{
+		Spec: FunctionAppManifestSpec{
+			Name:        "func",
+			Package:     &Package{Path: "src"},
+			AppSettings: map[string]string{"API_KEY": "new-secret", "GREETING": "hello"},
+		},
+	}
+
+	result, err := DiffFunctionAppManifests(old, new)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.NumNodes())
+	node := result.Nodes()[0]
+	assert.Equal(t, "spec.appSettings.API_KEY", node.PathString)
+	// The secrets are never shown in the diff.
+	assert.NotContains(t, node.StringX(), "secret")
+	assert.NotContains(t, node.StringY(), "secret")
+}
@@ -0,0 +1,326 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	versionV1Beta1          = "pipecd.dev/v1beta1"
+	functionAppManifestKind = "AzureFunctionApp"
+
+	// The app setting to run the function app from the deployed package.
+	// It is set to the URL of the package or 1 for the zip deployment.
+	AppSettingRunFromPackage = "WEBSITE_RUN_FROM_PACKAGE"
+
+	// The metadata file of the package used to find its version.
+	packageMetadataFile = "package.json"
+)
+
+type FunctionAppManifest struct {
+	Kind       string                  `json:"kind"`
+	APIVersion string                  `json:"apiVersion,omitempty"`
+	Spec       FunctionAppManifestSpec `json:"spec"`
+}
+
+func (m *FunctionAppManifest) validate() error {
+	if m.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", m.APIVersion)
+	}
+	if m.Kind != functionAppManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", m.Kind)
+	}
+	return m.Spec.validate()
+}
+
+// FunctionAppManifestSpec contains configuration for AzureFunctionApp.
+// The function app itself must have been created with its plan and storage account beforehand.
+type FunctionAppManifestSpec struct {
+	// The name of the function app.
+	Name string `json:"name"`
+	// The package of the functions. Either package or container must be specified.
+	Package *Package `json:"package,omitempty"`
+	// The container image running the functions. Either package or container must be specified.
+	Container *Container `json:"container,omitempty"`
+	// The app settings of the function app.
+	// The settings not specified here are kept as they are.
+	AppSettings map[string]string `json:"appSettings,omitempty"`
+	// The tags of the function app.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// Package represents the zip package of the functions.
+type Package struct {
+	// The path to the directory of the functions relative to the application directory.
+	// It is zipped and uploaded to the function app by the zip deployment.
+	Path string `json:"path,omitempty"`
+	// The URL of the zip package the function app runs from.
+	URL string `json:"url,omitempty"`
+	// The version of the package.
+	// Default is the version in package.json of the directory, or the file name of the URL.
+	Version string `json:"version,omitempty"`
+}
+
+type Container struct {
+	// The container image such as myregistry.azurecr.io/functions:v1.0.0.
+	Image string `json:"image"`
+}
+
+func (s FunctionAppManifestSpec) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if (s.Package == nil) == (s.Container == nil) {
+		return fmt.Errorf("either package or container must be specified")
+	}
+	if p := s.Package; p != nil {
+		if (p.Path == "") == (p.URL == "") {
+			return fmt.Errorf("either package.path or package.url must be specified")
+		}
+		if p.Path != "" && (filepath.IsAbs(p.Path) || strings.HasPrefix(filepath.Clean(p.Path), "..")) {
+			return fmt.Errorf("package.path must be a relative path inside the application directory")
+		}
+	}
+	if s.Container != nil && s.Container.Image == "" {
+		return fmt.Errorf("container.image is missing")
+	}
+	if _, ok := s.AppSettings[AppSettingRunFromPackage]; ok && s.Package != nil {
+		return fmt.Errorf("appSettings must not contain %s since it is set by piped", AppSettingRunFromPackage)
+	}
+	return nil
+}
+
+// ZipDeploy reports whether the package is uploaded to the function app by the zip deployment.
+func (m FunctionAppManifest) ZipDeploy() bool {
+	return m.Spec.Package != nil && m.Spec.Package.Path != ""
+}
+
+// AppSettings returns the app settings of the manifest added with the ones required to run the package.
+func (m FunctionAppManifest) AppSettings() map[string]string {
+	settings := make(map[string]string, len(m.Spec.AppSettings)+1)
+	for k, v := range m.Spec.AppSettings {
+		settings[k] = v
+	}
+	if p := m.Spec.Package; p != nil {
+		if p.URL != "" {
+			settings[AppSettingRunFromPackage] = p.URL
+		} else {
+			settings[AppSettingRunFromPackage] = "1"
+		}
+	}
+	return settings
+}
+
+// MergeAppSettings returns the given current app settings overwritten by the ones of the manifest.
+func (m FunctionAppManifest) MergeAppSettings(current map[string]string) map[string]string {
+	settings := make(map[string]string, len(current)+len(m.Spec.AppSettings)+1)
+	for k, v := range current {
+		settings[k] = v
+	}
+	for k, v := range m.AppSettings() {
+		settings[k] = v
+	}
+	return settings
+}
+
+// Tags returns the tags of the manifest added with the given ones.
+func (m FunctionAppManifest) Tags(tags map[string]string) map[string]string {
+	out := make(map[string]string, len(m.Spec.Tags)+len(tags))
+	for k, v := range m.Spec.Tags {
+		out[k] = v
+	}
+	for k, v := range tags {
+		out[k] = v
+	}
+	return out
+}
+
+// LoadFunctionAppManifest returns FunctionAppManifest object from a given function app manifest file.
+func LoadFunctionAppManifest(appDir, manifestFilename string) (FunctionAppManifest, error) {
+	path := filepath.Join(appDir, manifestFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FunctionAppManifest{}, err
+	}
+	return parseFunctionAppManifest(data)
+}
+
+func parseFunctionAppManifest(data []byte) (FunctionAppManifest, error) {
+	var m FunctionAppManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return FunctionAppManifest{}, err
+	}
+	if err := m.validate(); err != nil {
+		return FunctionAppManifest{}, err
+	}
+	return m, nil
+}
+
+// ZipPackage zips the package directory of the given manifest placed in the application directory.
+func ZipPackage(appDir string, m FunctionAppManifest) (*bytes.Buffer, error) {
+	if !m.ZipDeploy() {
+		return nil, fmt.Errorf("the manifest has no package directory")
+	}
+	root := filepath.Join(appDir, m.Spec.Package.Path)
+
+	buf := &bytes.Buffer{}
+	w := zip.NewWriter(buf)
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			if d.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(rel)
+		header.Method = zip.Deflate
+
+		fw, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(p)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		_, err = io.Copy(fw, f)
+		return err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to zip package directory %s: %w", m.Spec.Package.Path, err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// FindArtifactVersions returns the version of the package or the container image of the function app.
+func FindArtifactVersions(appDir string, m FunctionAppManifest) ([]*model.ArtifactVersion, error) {
+	if c := m.Spec.Container; c != nil {
+		name, tag := parseContainerImage(c.Image)
+		if name == "" {
+			return nil, fmt.Errorf("image name could not be empty")
+		}
+		return []*model.ArtifactVersion{
+			{
+				Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+				Version: tag,
+				Name:    name,
+				Url:     c.Image,
+			},
+		}, nil
+	}
+
+	p := m.Spec.Package
+	v := &model.ArtifactVersion{
+		Kind:    model.ArtifactVersion_UNKNOWN,
+		Version: p.Version,
+		Name:    m.Spec.Name,
+		Url:     p.URL,
+	}
+	if p.URL != "" {
+		if v.Version == "" {
+			u, err := url.Parse(p.URL)
+			if err != nil {
+				return nil, fmt.Errorf("invalid package url: %w", err)
+			}
+			v.Version = strings.TrimSuffix(path.Base(u.Path), ".zip")
+		}
+		return []*model.ArtifactVersion{v}, nil
+	}
+
+	metadata, err := loadPackageMetadata(filepath.Join(appDir, p.Path))
+	if err != nil {
+		return nil, err
+	}
+	if metadata.Name != "" {
+		v.Name = metadata.Name
+	}
+	if v.Version == "" {
+		v.Version = metadata.Version
+	}
+	return []*model.ArtifactVersion{v}, nil
+}
+
+type packageMetadata struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+// loadPackageMetadata reads the name and the version of the package in the given directory.
+// The empty metadata is returned when the directory has no metadata file.
+func loadPackageMetadata(dir string) (packageMetadata, error) {
+	data, err := os.ReadFile(filepath.Join(dir, packageMetadataFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		return packageMetadata{}, nil
+	}
+	if err != nil {
+		return packageMetadata{}, err
+	}
+	var metadata packageMetadata
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return packageMetadata{}, fmt.Errorf("malformed %s: %w", packageMetadataFile, err)
+	}
+	return metadata, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	// The image can be pinned by its digest instead of its tag.
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	name = paths[len(paths)-1]
+	return
+}
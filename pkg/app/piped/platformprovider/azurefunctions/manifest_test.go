@@ -0,0 +1,293 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseFunctionAppManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		data        string
+		expected    FunctionAppManifest
+		expectedErr bool
+	}{
+		{
+			name: "zip package",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  package:
+    path: src
+  appSettings:
+    FUNCTIONS_WORKER_RUNTIME: node
+`,
+			expected: FunctionAppManifest{
+				Kind:       "AzureFunctionApp",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionAppManifestSpec{
+					Name:        "func",
+					Package:     &Package{Path: "src"},
+					AppSettings: map[string]string{"FUNCTIONS_WORKER_RUNTIME": "node"},
+				},
+			},
+		},
+		{
+			name: "container",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  container:
+    image: myregistry.azurecr.io/func:v1.0.0
+`,
+			expected: FunctionAppManifest{
+				Kind:       "AzureFunctionApp",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionAppManifestSpec{
+					Name:      "func",
+					Container: &Container{Image: "myregistry.azurecr.io/func:v1.0.0"},
+				},
+			},
+		},
+		{
+			name: "both package and container",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  package:
+    path: src
+  container:
+    image: myregistry.azurecr.io/func:v1.0.0
+`,
+			expectedErr: true,
+		},
+		{
+			name: "both package path and url",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  package:
+    path: src
+    url: https://example.blob.core.windows.net/packages/func-1.0.0.zip
+`,
+			expectedErr: true,
+		},
+		{
+			name: "package path outside of the application directory",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  package:
+    path: ../src
+`,
+			expectedErr: true,
+		},
+		{
+			name: "run from package setting",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AzureFunctionApp
+spec:
+  name: func
+  package:
+    path: src
+  appSettings:
+    WEBSITE_RUN_FROM_PACKAGE: "0"
+`,
+			expectedErr: true,
+		},
+		{
+			name: "invalid kind",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: FunctionApp
+spec:
+  name: func
+`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := parseFunctionAppManifest([]byte(tc.data))
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, m)
+			}
+		})
+	}
+}
+
+func TestFunctionAppManifestAppSettings(t *testing.T) {
+	t.Parallel()
+
+	zipDeploy := FunctionAppManifest{
+		Spec: FunctionAppManifestSpec{
+			Package:     &Package{Path: "src"},
+			AppSettings: map[string]string{"GREETING": "hello"},
+		},
+	}
+	assert.Equal(t, map[string]string{"GREETING": "hello", AppSettingRunFromPackage: "1"}, zipDeploy.AppSettings())
+
+	runFromURL := FunctionAppManifest{
+		Spec: FunctionAppManifestSpec{
+			Package: &Package{URL: "https://example.com/func.zip"},
+		},
+	}
+	assert.Equal(t, map[string]string{AppSettingRunFromPackage: "https://example.com/func.zip"}, runFromURL.AppSettings())
+
+	current := map[string]string{
+		"AzureWebJobsStorage": "connection",
+		"GREETING":            "hi",
+	}
+	assert.Equal(t, map[string]string{
+		"AzureWebJobsStorage":    "connection",
+		"GREETING":               "hello",
+		AppSettingRunFromPackage: "1",
+	}, zipDeploy.MergeAppSettings(current))
+	// The current settings are not modified.
+	assert.Equal(t, "hi", current["GREETING"])
+}
+
+func TestZipPackage(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "src", "hello"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "src", ".git"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "src", "host.json"), []byte(`{"version":"2.0"}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "src", "hello", "index.js"), []byte("module.exports = {}"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "src", ".git", "HEAD"), []byte("ref"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "function.yaml"), []byte("kind: AzureFunctionApp"), 0o644))
+
+	m := FunctionAppManifest{Spec: FunctionAppManifestSpec{Package: &Package{Path: "src"}}}
+	buf, err := ZipPackage(appDir, m)
+	require.NoError(t, err)
+
+	r, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	names := make([]string, 0, len(r.File))
+	for _, f := range r.File {
+		names = append(names, f.Name)
+	}
+	assert.ElementsMatch(t, []string{"host.json", "hello/index.js"}, names)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "node"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "node", "package.json"), []byte(`{"name":"hello-func","version":"1.2.3"}`), 0o644))
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "python"), 0o755))
+
+	testcases := []struct {
+		name     string
+		spec     FunctionAppManifestSpec
+		expected []*model.ArtifactVersion
+	}{
+		{
+			name: "container image",
+			spec: FunctionAppManifestSpec{
+				Name:      "func",
+				Container: &Container{Image: "myregistry.azurecr.io/hello:v1.0.0"},
+			},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v1.0.0",
+					Name:    "hello",
+					Url:     "myregistry.azurecr.io/hello:v1.0.0",
+				},
+			},
+		},
+		{
+			name: "package metadata",
+			spec: FunctionAppManifestSpec{
+				Name:    "func",
+				Package: &Package{Path: "node"},
+			},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_UNKNOWN,
+					Version: "1.2.3",
+					Name:    "hello-func",
+				},
+			},
+		},
+		{
+			name: "no package metadata",
+			spec: FunctionAppManifestSpec{
+				Name:    "func",
+				Package: &Package{Path: "python", Version: "2023.1"},
+			},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_UNKNOWN,
+					Version: "2023.1",
+					Name:    "func",
+				},
+			},
+		},
+		{
+			name: "package url",
+			spec: FunctionAppManifestSpec{
+				Name:    "func",
+				Package: &Package{URL: "https://example.blob.core.windows.net/packages/func-1.0.0.zip?sv=2021"},
+			},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_UNKNOWN,
+					Version: "func-1.0.0",
+					Name:    "func",
+					Url:     "https://example.blob.core.windows.net/packages/func-1.0.0.zip?sv=2021",
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := FindArtifactVersions(appDir, FunctionAppManifest{Spec: tc.spec})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package azurefunctions
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	// The state of the function app serving the requests.
+	SiteStateRunning = "Running"
+
+	hostTypeRepository = "Repository"
+	// The prefix of linuxFxVersion of the function apps running a container image.
+	linuxFxVersionDockerPrefix = "DOCKER|"
+)
+
+// Site represents the current state of a function app or its deployment slot.
+type Site struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Location   string            `json:"location"`
+	Kind       string            `json:"kind"`
+	Tags       map[string]string `json:"tags"`
+	Properties SiteProperties    `json:"properties"`
+}
+
+type SiteProperties struct {
+	State             string             `json:"state"`
+	ServerFarmID      string             `json:"serverFarmId"`
+	DefaultHostName   string             `json:"defaultHostName"`
+	HostNameSslStates []HostNameSslState `json:"hostNameSslStates"`
+	SlotSwapStatus    *SlotSwapStatus    `json:"slotSwapStatus"`
+}
+
+type HostNameSslState struct {
+	Name     string `json:"name"`
+	HostType string `json:"hostType"`
+}
+
+// SlotSwapStatus represents the last swap operation of the deployment slots.
+type SlotSwapStatus struct {
+	TimestampUTC        time.Time `json:"timestampUtc"`
+	SourceSlotName      string    `json:"sourceSlotName"`
+	DestinationSlotName string    `json:"destinationSlotName"`
+}
+
+type SiteConfig struct {
+	LinuxFxVersion string `json:"linuxFxVersion"`
+}
+
+// ContainerImage returns the container image run by the function app,
+// or an empty string if it runs a package.
+func (c *SiteConfig) ContainerImage() string {
+	if !strings.HasPrefix(c.LinuxFxVersion, linuxFxVersionDockerPrefix) {
+		return ""
+	}
+	return strings.TrimPrefix(c.LinuxFxVersion, linuxFxVersionDockerPrefix)
+}
+
+// Running reports whether the function app is serving the requests.
+func (s *Site) Running() bool {
+	return s.Properties.State == SiteStateRunning
+}
+
+// ScmHost returns the host name of the deployment API (Kudu) of the function app.
+func (s *Site) ScmHost() string {
+	for _, h := range s.Properties.HostNameSslStates {
+		if h.HostType == hostTypeRepository {
+			return h.Name
+		}
+	}
+	// The deployment API is hosted on the scm subdomain of the default host name.
+	if i := strings.Index(s.Properties.DefaultHostName, "."); i > 0 {
+		return s.Properties.DefaultHostName[:i] + ".scm" + s.Properties.DefaultHostName[i:]
+	}
+	return ""
+}
+
+// swapTimestamp returns the time of the last swap of the deployment slots.
+func (s *Site) swapTimestamp() time.Time {
+	if s.Properties.SlotSwapStatus == nil {
+		return time.Time{}
+	}
+	return s.Properties.SlotSwapStatus.TimestampUTC
+}
@@ -0,0 +1,288 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	"go.uber.org/zap"
+)
+
+type client struct {
+	cfnClient *cloudformation.Client
+	logger    *zap.Logger
+}
+
+func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required field")
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if credentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(profile))
+	}
+	if tokenPath != "" && roleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(tokenPath)
+		}))
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create cloudformation client: %w", err)
+	}
+
+	return &client{
+		cfnClient: cloudformation.NewFromConfig(cfg),
+		logger:    logger.Named("cloudformation"),
+	}, nil
+}
+
+func (c *client) DescribeStack(ctx context.Context, stackName string) (*Stack, error) {
+	out, err := c.cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe stack %s: %w", stackName, wrapNotFound(err))
+	}
+	if len(out.Stacks) == 0 {
+		return nil, fmt.Errorf("failed to describe stack %s: %w", stackName, ErrNotFound)
+	}
+	return makeStack(out.Stacks[0]), nil
+}
+
+func (c *client) DeleteStack(ctx context.Context, stackName string) error {
+	_, err := c.cfnClient.DeleteStack(ctx, &cloudformation.DeleteStackInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete stack %s: %w", stackName, wrapNotFound(err))
+	}
+	return nil
+}
+
+// DescribeStackEvents returns the latest events of the stack in reverse chronological order.
+func (c *client) DescribeStackEvents(ctx context.Context, stackName string) ([]StackEvent, error) {
+	out, err := c.cfnClient.DescribeStackEvents(ctx, &cloudformation.DescribeStackEventsInput{
+		StackName: aws.String(stackName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe the events of stack %s: %w", stackName, wrapNotFound(err))
+	}
+	events := make([]StackEvent, 0, len(out.StackEvents))
+	for _, e := range out.StackEvents {
+		events = append(events, StackEvent{
+			Timestamp:            aws.ToTime(e.Timestamp),
+			LogicalResourceID:    aws.ToString(e.LogicalResourceId),
+			ResourceType:         aws.ToString(e.ResourceType),
+			ResourceStatus:       string(e.ResourceStatus),
+			ResourceStatusReason: aws.ToString(e.ResourceStatusReason),
+		})
+	}
+	return events, nil
+}
+
+func (c *client) CreateChangeSet(ctx context.Context, in ChangeSetInput) error {
+	if _, err := c.cfnClient.CreateChangeSet(ctx, in.toSDK()); err != nil {
+		return fmt.Errorf("failed to create change set %s of stack %s: %w", in.ChangeSetName, in.StackName, err)
+	}
+	return nil
+}
+
+func (c *client) DescribeChangeSet(ctx context.Context, stackName, changeSetName string) (*ChangeSet, error) {
+	var (
+		cs *ChangeSet
+		in = &cloudformation.DescribeChangeSetInput{
+			StackName:     aws.String(stackName),
+			ChangeSetName: aws.String(changeSetName),
+		}
+	)
+	for {
+		out, err := c.cfnClient.DescribeChangeSet(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe change set %s of stack %s: %w", changeSetName, stackName, wrapNotFound(err))
+		}
+		if cs == nil {
+			cs = &ChangeSet{
+				ChangeSetID:     aws.ToString(out.ChangeSetId),
+				ChangeSetName:   aws.ToString(out.ChangeSetName),
+				StackID:         aws.ToString(out.StackId),
+				StackName:       aws.ToString(out.StackName),
+				Status:          string(out.Status),
+				StatusReason:    aws.ToString(out.StatusReason),
+				ExecutionStatus: string(out.ExecutionStatus),
+			}
+		}
+		cs.Changes = append(cs.Changes, makeChanges(out.Changes)...)
+		if out.NextToken == nil {
+			return cs, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func (c *client) ExecuteChangeSet(ctx context.Context, stackName, changeSetName string) error {
+	_, err := c.cfnClient.ExecuteChangeSet(ctx, &cloudformation.ExecuteChangeSetInput{
+		StackName:     aws.String(stackName),
+		ChangeSetName: aws.String(changeSetName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to execute change set %s of stack %s: %w", changeSetName, stackName, wrapNotFound(err))
+	}
+	return nil
+}
+
+func (c *client) DeleteChangeSet(ctx context.Context, stackName, changeSetName string) error {
+	_, err := c.cfnClient.DeleteChangeSet(ctx, &cloudformation.DeleteChangeSetInput{
+		StackName:     aws.String(stackName),
+		ChangeSetName: aws.String(changeSetName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete change set %s of stack %s: %w", changeSetName, stackName, wrapNotFound(err))
+	}
+	return nil
+}
+
+// ChangeSetInput represents the values to create a change set.
+type ChangeSetInput struct {
+	StackName     string
+	ChangeSetName string
+	// CREATE for a new stack or UPDATE for an existing stack.
+	ChangeSetType string
+	TemplateBody  string
+	Parameters    map[string]string
+	Capabilities  []string
+	Tags          map[string]string
+	RoleARN       string
+	Description   string
+}
+
+func (in ChangeSetInput) toSDK() *cloudformation.CreateChangeSetInput {
+	out := &cloudformation.CreateChangeSetInput{
+		StackName:     aws.String(in.StackName),
+		ChangeSetName: aws.String(in.ChangeSetName),
+		ChangeSetType: types.ChangeSetType(in.ChangeSetType),
+		TemplateBody:  aws.String(in.TemplateBody),
+	}
+	for _, k := range sortedKeys(in.Parameters) {
+		out.Parameters = append(out.Parameters, types.Parameter{
+			ParameterKey:   aws.String(k),
+			ParameterValue: aws.String(in.Parameters[k]),
+		})
+	}
+	for _, c := range in.Capabilities {
+		out.Capabilities = append(out.Capabilities, types.Capability(c))
+	}
+	for _, k := range sortedKeys(in.Tags) {
+		out.Tags = append(out.Tags, types.Tag{
+			Key:   aws.String(k),
+			Value: aws.String(in.Tags[k]),
+		})
+	}
+	if in.RoleARN != "" {
+		out.RoleARN = aws.String(in.RoleARN)
+	}
+	if in.Description != "" {
+		out.Description = aws.String(in.Description)
+	}
+	return out
+}
+
+func makeStack(s types.Stack) *Stack {
+	stack := &Stack{
+		StackID:           aws.ToString(s.StackId),
+		StackName:         aws.ToString(s.StackName),
+		StackStatus:       string(s.StackStatus),
+		StackStatusReason: aws.ToString(s.StackStatusReason),
+	}
+	for _, o := range s.Outputs {
+		stack.Outputs = append(stack.Outputs, StackOutput{
+			OutputKey:   aws.ToString(o.OutputKey),
+			OutputValue: aws.ToString(o.OutputValue),
+		})
+	}
+	return stack
+}
+
+func makeChanges(changes []types.Change) []Change {
+	out := make([]Change, 0, len(changes))
+	for _, c := range changes {
+		rc := c.ResourceChange
+		if rc == nil {
+			continue
+		}
+		change := Change{
+			ResourceChange: ResourceChange{
+				Action:             string(rc.Action),
+				LogicalResourceID:  aws.ToString(rc.LogicalResourceId),
+				PhysicalResourceID: aws.ToString(rc.PhysicalResourceId),
+				ResourceType:       aws.ToString(rc.ResourceType),
+				Replacement:        string(rc.Replacement),
+			},
+		}
+		for _, d := range rc.Details {
+			if d.Target == nil {
+				continue
+			}
+			change.ResourceChange.Details = append(change.ResourceChange.Details, ResourceChangeDetail{
+				Target: ResourceTargetDefinition{
+					Attribute:          string(d.Target.Attribute),
+					Name:               aws.ToString(d.Target.Name),
+					RequiresRecreation: string(d.Target.RequiresRecreation),
+				},
+			})
+		}
+		out = append(out, change)
+	}
+	return out
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// wrapNotFound converts the error returned when the stack or the change set does not exist into ErrNotFound.
+func wrapNotFound(err error) error {
+	var e smithy.APIError
+	if !errors.As(err, &e) {
+		return err
+	}
+	// The API returns ValidationError instead of a dedicated code for the missing stack.
+	var csNotFound *types.ChangeSetNotFoundException
+	if errors.As(err, &csNotFound) || (e.ErrorCode() == "ValidationError" && strings.HasSuffix(e.ErrorMessage(), "does not exist")) {
+		return fmt.Errorf("%w: %s", ErrNotFound, e.ErrorMessage())
+	}
+	return err
+}
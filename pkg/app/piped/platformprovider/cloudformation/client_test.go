@@ -0,0 +1,176 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &client{
+		cfnClient: cloudformation.NewFromConfig(cfg, func(o *cloudformation.Options) {
+			o.EndpointResolver = cloudformation.EndpointResolverFromURL(ts.URL)
+		}),
+		logger: zap.NewNop(),
+	}
+}
+
+func TestChangeSetInputToSDK(t *testing.T) {
+	t.Parallel()
+
+	in := ChangeSetInput{
+		StackName:     "stack",
+		ChangeSetName: "pipecd-abc",
+		ChangeSetType: ChangeSetTypeUpdate,
+		TemplateBody:  "Resources: {}",
+		Parameters:    map[string]string{"Env": "dev", "Bucket": "b"},
+		Capabilities:  []string{"CAPABILITY_IAM"},
+		Tags:          map[string]string{"app": "simple"},
+		RoleARN:       "arn:aws:iam::123456789012:role/cfn",
+	}
+	expected := &cloudformation.CreateChangeSetInput{
+		StackName:     aws.String("stack"),
+		ChangeSetName: aws.String("pipecd-abc"),
+		ChangeSetType: types.ChangeSetTypeUpdate,
+		TemplateBody:  aws.String("Resources: {}"),
+		Parameters: []types.Parameter{
+			{ParameterKey: aws.String("Bucket"), ParameterValue: aws.String("b")},
+			{ParameterKey: aws.String("Env"), ParameterValue: aws.String("dev")},
+		},
+		Capabilities: []types.Capability{types.CapabilityCapabilityIam},
+		Tags: []types.Tag{
+			{Key: aws.String("app"), Value: aws.String("simple")},
+		},
+		RoleARN: aws.String("arn:aws:iam::123456789012:role/cfn"),
+	}
+	assert.Equal(t, expected, in.toSDK())
+}
+
+func TestDescribeChangeSet(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "DescribeChangeSet", r.PostForm.Get("Action"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/cloudformation/aws4_request")
+
+		if r.PostForm.Get("NextToken") == "" {
+			io.WriteString(w, `<DescribeChangeSetResponse><DescribeChangeSetResult>
+<ChangeSetName>pipecd-abc</ChangeSetName><Status>CREATE_COMPLETE</Status><ExecutionStatus>AVAILABLE</ExecutionStatus>
+<Changes><member><Type>Resource</Type><ResourceChange><Action>Add</Action><LogicalResourceId>Bucket</LogicalResourceId><ResourceType>AWS::S3::Bucket</ResourceType></ResourceChange></member></Changes>
+<NextToken>next</NextToken>
+</DescribeChangeSetResult></DescribeChangeSetResponse>`)
+			return
+		}
+		io.WriteString(w, `<DescribeChangeSetResponse><DescribeChangeSetResult>
+<ChangeSetName>pipecd-abc</ChangeSetName><Status>CREATE_COMPLETE</Status><ExecutionStatus>AVAILABLE</ExecutionStatus>
+<Changes><member><Type>Resource</Type><ResourceChange><Action>Modify</Action><LogicalResourceId>Function</LogicalResourceId><ResourceType>AWS::Lambda::Function</ResourceType><Replacement>False</Replacement>
+<Details><member><Target><Attribute>Properties</Attribute><Name>Code</Name><RequiresRecreation>Never</RequiresRecreation></Target></member></Details>
+</ResourceChange></member></Changes>
+</DescribeChangeSetResult></DescribeChangeSetResponse>`)
+	})
+
+	cs, err := c.DescribeChangeSet(context.Background(), "stack", "pipecd-abc")
+	require.NoError(t, err)
+	assert.Equal(t, &ChangeSet{
+		ChangeSetName:   "pipecd-abc",
+		Status:          "CREATE_COMPLETE",
+		ExecutionStatus: "AVAILABLE",
+		Changes: []Change{
+			{ResourceChange: ResourceChange{Action: "Add", LogicalResourceID: "Bucket", ResourceType: "AWS::S3::Bucket"}},
+			{ResourceChange: ResourceChange{
+				Action:            "Modify",
+				LogicalResourceID: "Function",
+				ResourceType:      "AWS::Lambda::Function",
+				Replacement:       "False",
+				Details: []ResourceChangeDetail{
+					{Target: ResourceTargetDefinition{Attribute: "Properties", Name: "Code", RequiresRecreation: "Never"}},
+				},
+			}},
+		},
+	}, cs)
+}
+
+func TestDescribeStackNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>ValidationError</Code><Message>Stack with id stack does not exist</Message></Error></ErrorResponse>`)
+	})
+
+	_, err := c.DescribeStack(context.Background(), "stack")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestWrapNotFound(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		err      error
+		notFound bool
+	}{
+		{
+			name:     "change set not found",
+			err:      &types.ChangeSetNotFoundException{Message: aws.String("ChangeSet [pipecd] does not exist")},
+			notFound: true,
+		},
+		{
+			name:     "stack not found",
+			err:      &smithy.GenericAPIError{Code: "ValidationError", Message: "Stack with id stack does not exist"},
+			notFound: true,
+		},
+		{
+			name: "validation error",
+			err:  &smithy.GenericAPIError{Code: "ValidationError", Message: "Template format error"},
+		},
+		{
+			name: "unknown error",
+			err:  errors.New("connection refused"),
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := wrapNotFound(tc.err)
+			assert.Equal(t, tc.notFound, errors.Is(err, ErrNotFound))
+			if !tc.notFound {
+				assert.Equal(t, tc.err, err)
+			}
+		})
+	}
+}
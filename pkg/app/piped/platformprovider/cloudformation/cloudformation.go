@@ -0,0 +1,129 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	ManagedByPiped   string = "piped"
+
+	// The maximum size of the template passed to the API directly.
+	// Larger templates must be uploaded to S3 in advance.
+	maxTemplateBodySize = 51200
+)
+
+// ErrNotFound is returned when the requested stack or change set does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of CloudFormation API.
+type Client interface {
+	// DescribeStack returns the stack having the given name.
+	// ErrNotFound is returned when there is no such stack.
+	DescribeStack(ctx context.Context, stackName string) (*Stack, error)
+	DeleteStack(ctx context.Context, stackName string) error
+	DescribeStackEvents(ctx context.Context, stackName string) ([]StackEvent, error)
+	CreateChangeSet(ctx context.Context, in ChangeSetInput) error
+	// DescribeChangeSet returns the change set having the given name together with all of its changes.
+	// ErrNotFound is returned when there is no such change set.
+	DescribeChangeSet(ctx context.Context, stackName, changeSetName string) (*ChangeSet, error)
+	ExecuteChangeSet(ctx context.Context, stackName, changeSetName string) error
+	DeleteChangeSet(ctx context.Context, stackName, changeSetName string) error
+}
+
+// Registry holds a pool of aws client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderCloudFormationConfig, logger *zap.Logger) (Client, error)
+}
+
+// MakeStackTags returns the tags of the stack managed by the given piped and application.
+// The deployed commit is not included since the stack tags are propagated to all resources
+// and changing them on every deployment would update every resource in the stack.
+func MakeStackTags(tags map[string]string, pipedID, appID string) map[string]string {
+	out := make(map[string]string, len(tags)+3)
+	for k, v := range tags {
+		out[k] = v
+	}
+	out[LabelManagedBy] = ManagedByPiped
+	out[LabelPiped] = pipedID
+	out[LabelApplication] = appID
+	return out
+}
+
+// LoadTemplate returns the body of the given template file.
+func LoadTemplate(appDir, templateFile string) (string, error) {
+	path := filepath.Join(appDir, templateFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	if len(data) > maxTemplateBodySize {
+		return "", fmt.Errorf("template %s is %d bytes, it must be no larger than %d bytes", templateFile, len(data), maxTemplateBodySize)
+	}
+	return string(data), nil
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderCloudFormationConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of aws clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
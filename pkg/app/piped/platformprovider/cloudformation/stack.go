@@ -0,0 +1,306 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// The types of change set.
+	ChangeSetTypeCreate = "CREATE"
+	ChangeSetTypeUpdate = "UPDATE"
+
+	stackStatusReviewInProgress = "REVIEW_IN_PROGRESS"
+
+	changeSetStatusCreatePending    = "CREATE_PENDING"
+	changeSetStatusCreateInProgress = "CREATE_IN_PROGRESS"
+	changeSetStatusFailed           = "FAILED"
+
+	// The execution status of the change set which can be executed.
+	ChangeSetExecutionStatusAvailable = "AVAILABLE"
+)
+
+// The stack statuses which can not be updated anymore.
+// Such stacks must be deleted manually before being deployed again.
+var unrecoverableStackStatuses = map[string]struct{}{
+	"CREATE_FAILED":     {},
+	"ROLLBACK_FAILED":   {},
+	"ROLLBACK_COMPLETE": {},
+	"DELETE_FAILED":     {},
+}
+
+// The stack statuses reached when the last operation completed successfully.
+var succeededStackStatuses = map[string]struct{}{
+	"CREATE_COMPLETE": {},
+	"UPDATE_COMPLETE": {},
+	"IMPORT_COMPLETE": {},
+}
+
+// The reasons of the failed change sets which contain no changes.
+var noChangesReasons = []string{
+	"The submitted information didn't contain changes",
+	"No updates are to be performed",
+}
+
+// Stack represents the current state of a stack.
+type Stack struct {
+	StackID           string
+	StackName         string
+	StackStatus       string
+	StackStatusReason string
+	Outputs           []StackOutput
+}
+
+type StackOutput struct {
+	OutputKey   string
+	OutputValue string
+}
+
+// InProgress reports whether an operation is running on the stack.
+// The stack created by a change set which has not been executed yet is not regarded as in progress.
+func (s *Stack) InProgress() bool {
+	return strings.HasSuffix(s.StackStatus, "_IN_PROGRESS") && s.StackStatus != stackStatusReviewInProgress
+}
+
+// Succeeded reports whether the last operation on the stack completed successfully.
+func (s *Stack) Succeeded() bool {
+	_, ok := succeededStackStatuses[s.StackStatus]
+	return ok
+}
+
+// StackEvent represents an event of a resource in a stack.
+type StackEvent struct {
+	Timestamp            time.Time
+	LogicalResourceID    string
+	ResourceType         string
+	ResourceStatus       string
+	ResourceStatusReason string
+}
+
+// Failed reports whether the event represents a failure of the resource operation.
+func (e StackEvent) Failed() bool {
+	return strings.HasSuffix(e.ResourceStatus, "_FAILED")
+}
+
+// ChangeSet represents a change set of a stack.
+type ChangeSet struct {
+	ChangeSetID     string
+	ChangeSetName   string
+	StackID         string
+	StackName       string
+	Status          string
+	StatusReason    string
+	ExecutionStatus string
+	Changes         []Change
+
+	// The type of the change set, which is not returned by DescribeChangeSet.
+	ChangeSetType string
+}
+
+type Change struct {
+	ResourceChange ResourceChange
+}
+
+type ResourceChange struct {
+	// Add, Modify, Remove, Import or Dynamic.
+	Action             string
+	LogicalResourceID  string
+	PhysicalResourceID string
+	ResourceType       string
+	Replacement        string
+	Details            []ResourceChangeDetail
+}
+
+type ResourceChangeDetail struct {
+	Target ResourceTargetDefinition
+}
+
+type ResourceTargetDefinition struct {
+	// Properties, Metadata, CreationPolicy, UpdatePolicy, DeletionPolicy or Tags.
+	Attribute          string
+	Name               string
+	RequiresRecreation string
+}
+
+// NoChanges reports whether the change set has nothing to change.
+// Note that the change set only for the outputs of the stack has no resource changes but has something to change.
+func (cs *ChangeSet) NoChanges() bool {
+	if cs.Status != changeSetStatusFailed {
+		return false
+	}
+	for _, r := range noChangesReasons {
+		if strings.Contains(cs.StatusReason, r) {
+			return true
+		}
+	}
+	return false
+}
+
+// Summary returns the numbers of the changes by action.
+func (cs *ChangeSet) Summary() string {
+	var adds, modifies, removes, imports int
+	for _, c := range cs.Changes {
+		switch c.ResourceChange.Action {
+		case "Add":
+			adds++
+		case "Modify", "Dynamic":
+			modifies++
+		case "Remove":
+			removes++
+		case "Import":
+			imports++
+		}
+	}
+	summary := fmt.Sprintf("%d to add, %d to modify, %d to remove", adds, modifies, removes)
+	if imports > 0 {
+		summary = fmt.Sprintf("%d to import, %s", imports, summary)
+	}
+	return summary
+}
+
+var actionSymbols = map[string]string{
+	"Add":     "+",
+	"Modify":  "~",
+	"Remove":  "-",
+	"Import":  "<=",
+	"Dynamic": "?",
+}
+
+// Render returns the human-readable resource-level changes of the change set.
+func (cs *ChangeSet) Render() string {
+	var b strings.Builder
+	for _, c := range cs.Changes {
+		rc := c.ResourceChange
+		symbol, ok := actionSymbols[rc.Action]
+		if !ok {
+			symbol = "?"
+		}
+		fmt.Fprintf(&b, "%s %s (%s)", symbol, rc.LogicalResourceID, rc.ResourceType)
+		if rc.Replacement == "True" || rc.Replacement == "Conditional" {
+			fmt.Fprintf(&b, " [replacement: %s]", rc.Replacement)
+		}
+		b.WriteString("\n")
+
+		seen := make(map[string]struct{}, len(rc.Details))
+		for _, d := range rc.Details {
+			target := d.Target.Attribute
+			if d.Target.Name != "" {
+				target = target + "." + d.Target.Name
+			}
+			if _, ok := seen[target]; ok {
+				continue
+			}
+			seen[target] = struct{}{}
+			if d.Target.RequiresRecreation != "" && d.Target.RequiresRecreation != "Never" {
+				fmt.Fprintf(&b, "    %s (requires recreation: %s)\n", target, d.Target.RequiresRecreation)
+				continue
+			}
+			fmt.Fprintf(&b, "    %s\n", target)
+		}
+	}
+	return b.String()
+}
+
+// PrepareChangeSet creates a change set from the given input and waits until it becomes ready.
+// The type of the change set is decided by the current state of the stack,
+// and the existing change set having the same name is replaced.
+// When the returned change set has no changes, it has already been deleted.
+func PrepareChangeSet(ctx context.Context, client Client, in ChangeSetInput, interval time.Duration) (*ChangeSet, error) {
+	in.ChangeSetType = ChangeSetTypeCreate
+	stack, err := client.DescribeStack(ctx, in.StackName)
+	switch {
+	case errors.Is(err, ErrNotFound):
+	case err != nil:
+		return nil, err
+	default:
+		if stack.InProgress() {
+			if stack, err = WaitStack(ctx, client, in.StackName, interval); err != nil {
+				return nil, err
+			}
+		}
+		if _, ok := unrecoverableStackStatuses[stack.StackStatus]; ok {
+			return nil, fmt.Errorf("stack %s can not be updated since it is in %s status (%s), it must be deleted before being deployed again", in.StackName, stack.StackStatus, stack.StackStatusReason)
+		}
+		// The stack created by a change set which has never been executed must be deployed by a CREATE change set.
+		if stack.StackStatus != stackStatusReviewInProgress {
+			in.ChangeSetType = ChangeSetTypeUpdate
+		}
+		if _, err := client.DescribeChangeSet(ctx, in.StackName, in.ChangeSetName); err == nil {
+			if err := client.DeleteChangeSet(ctx, in.StackName, in.ChangeSetName); err != nil {
+				return nil, err
+			}
+		} else if !errors.Is(err, ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	if err := client.CreateChangeSet(ctx, in); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		cs, err := client.DescribeChangeSet(ctx, in.StackName, in.ChangeSetName)
+		if err != nil {
+			return nil, err
+		}
+		cs.ChangeSetType = in.ChangeSetType
+		switch cs.Status {
+		case changeSetStatusCreatePending, changeSetStatusCreateInProgress:
+		case changeSetStatusFailed:
+			if !cs.NoChanges() {
+				return nil, fmt.Errorf("failed to create change set %s of stack %s: %s", in.ChangeSetName, in.StackName, cs.StatusReason)
+			}
+			// The failed change set is kept until being deleted.
+			if err := client.DeleteChangeSet(ctx, in.StackName, in.ChangeSetName); err != nil {
+				return nil, err
+			}
+			return cs, nil
+		default:
+			return cs, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitStack waits until no operation is in progress on the given stack.
+func WaitStack(ctx context.Context, client Client, stackName string, interval time.Duration) (*Stack, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		stack, err := client.DescribeStack(ctx, stackName)
+		if err != nil {
+			return nil, err
+		}
+		if !stack.InProgress() {
+			return stack, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
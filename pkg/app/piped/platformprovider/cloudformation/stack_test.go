@@ -0,0 +1,214 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudformation
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeSetRender(t *testing.T) {
+	t.Parallel()
+
+	cs := &ChangeSet{
+		Changes: []Change{
+			{ResourceChange: ResourceChange{Action: "Add", LogicalResourceID: "Bucket", ResourceType: "AWS::S3::Bucket"}},
+			{ResourceChange: ResourceChange{
+				Action:            "Modify",
+				LogicalResourceID: "Function",
+				ResourceType:      "AWS::Lambda::Function",
+				Replacement:       "Conditional",
+				Details: []ResourceChangeDetail{
+					{Target: ResourceTargetDefinition{Attribute: "Properties", Name: "Code", RequiresRecreation: "Never"}},
+					{Target: ResourceTargetDefinition{Attribute: "Properties", Name: "Code", RequiresRecreation: "Never"}},
+					{Target: ResourceTargetDefinition{Attribute: "Properties", Name: "FunctionName", RequiresRecreation: "Always"}},
+					{Target: ResourceTargetDefinition{Attribute: "Tags"}},
+				},
+			}},
+			{ResourceChange: ResourceChange{Action: "Remove", LogicalResourceID: "Topic", ResourceType: "AWS::SNS::Topic"}},
+		},
+	}
+
+	expected := `+ Bucket (AWS::S3::Bucket)
+~ Function (AWS::Lambda::Function) [replacement: Conditional]
+    Properties.Code
+    Properties.FunctionName (requires recreation: Always)
+    Tags
+- Topic (AWS::SNS::Topic)
+`
+	assert.Equal(t, expected, cs.Render())
+	assert.Equal(t, "1 to add, 1 to modify, 1 to remove", cs.Summary())
+}
+
+func TestChangeSetNoChanges(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		changeSet ChangeSet
+		expected  bool
+	}{
+		{
+			name:      "no changes",
+			changeSet: ChangeSet{Status: "FAILED", StatusReason: "The submitted information didn't contain changes. Submit different information to create a change set."},
+			expected:  true,
+		},
+		{
+			name:      "failed by other reason",
+			changeSet: ChangeSet{Status: "FAILED", StatusReason: "Template format error"},
+			expected:  false,
+		},
+		{
+			name:      "only outputs are changed",
+			changeSet: ChangeSet{Status: "CREATE_COMPLETE"},
+			expected:  false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.changeSet.NoChanges())
+		})
+	}
+}
+
+type fakeClient struct {
+	Client
+	stack *Stack
+	// Whether the change set of the same name exists before creating.
+	existing bool
+	// The change sets returned one by one after creating.
+	changeSets       []*ChangeSet
+	created          []ChangeSetInput
+	deletedChangeSet int
+}
+
+func (c *fakeClient) DescribeStack(_ context.Context, name string) (*Stack, error) {
+	if c.stack == nil {
+		return nil, fmt.Errorf("stack %s: %w", name, ErrNotFound)
+	}
+	return c.stack, nil
+}
+
+func (c *fakeClient) CreateChangeSet(_ context.Context, in ChangeSetInput) error {
+	c.created = append(c.created, in)
+	return nil
+}
+
+func (c *fakeClient) DescribeChangeSet(_ context.Context, _, name string) (*ChangeSet, error) {
+	if len(c.created) == 0 && c.existing {
+		return &ChangeSet{Status: "CREATE_COMPLETE"}, nil
+	}
+	if len(c.created) == 0 || len(c.changeSets) == 0 {
+		return nil, fmt.Errorf("change set %s: %w", name, ErrNotFound)
+	}
+	cs := c.changeSets[0]
+	c.changeSets = c.changeSets[1:]
+	return cs, nil
+}
+
+func (c *fakeClient) DeleteChangeSet(_ context.Context, _, _ string) error {
+	c.deletedChangeSet++
+	return nil
+}
+
+func TestPrepareChangeSet(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		client           *fakeClient
+		expectedType     string
+		expectedNoChange bool
+		expectedDeleted  int
+		expectErr        bool
+	}{
+		{
+			name: "new stack",
+			client: &fakeClient{
+				changeSets: []*ChangeSet{{Status: "CREATE_PENDING"}, {Status: "CREATE_COMPLETE"}},
+			},
+			expectedType: ChangeSetTypeCreate,
+		},
+		{
+			name: "stack created by the change set not executed",
+			client: &fakeClient{
+				stack:      &Stack{StackStatus: "REVIEW_IN_PROGRESS"},
+				changeSets: []*ChangeSet{{Status: "CREATE_COMPLETE"}},
+			},
+			expectedType: ChangeSetTypeCreate,
+		},
+		{
+			name: "existing stack with the change set of the same name",
+			client: &fakeClient{
+				stack:      &Stack{StackStatus: "UPDATE_COMPLETE"},
+				existing:   true,
+				changeSets: []*ChangeSet{{Status: "CREATE_COMPLETE"}},
+			},
+			expectedType:    ChangeSetTypeUpdate,
+			expectedDeleted: 1,
+		},
+		{
+			name: "no changes",
+			client: &fakeClient{
+				stack: &Stack{StackStatus: "UPDATE_COMPLETE"},
+				changeSets: []*ChangeSet{
+					{Status: "FAILED", StatusReason: "The submitted information didn't contain changes. Submit different information to create a change set."},
+				},
+			},
+			expectedType:     ChangeSetTypeUpdate,
+			expectedNoChange: true,
+			expectedDeleted:  1,
+		},
+		{
+			name: "failed to create",
+			client: &fakeClient{
+				stack:      &Stack{StackStatus: "UPDATE_COMPLETE"},
+				changeSets: []*ChangeSet{{Status: "FAILED", StatusReason: "Template format error"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "unrecoverable stack",
+			client: &fakeClient{
+				stack: &Stack{StackStatus: "ROLLBACK_COMPLETE"},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			cs, err := PrepareChangeSet(context.Background(), tc.client, ChangeSetInput{StackName: "stack", ChangeSetName: "pipecd"}, time.Millisecond)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, tc.client.created, 1)
+			assert.Equal(t, tc.expectedType, tc.client.created[0].ChangeSetType)
+			assert.Equal(t, tc.expectedType, cs.ChangeSetType)
+			assert.Equal(t, tc.expectedNoChange, cs.NoChanges())
+			assert.Equal(t, tc.expectedDeleted, tc.client.deletedChangeSet)
+		})
+	}
+}
@@ -107,6 +107,24 @@ func (c *client) Update(ctx context.Context, sm ServiceManifest) (*Service, erro
 	return (*Service)(service), nil
 }
 
+func (c *client) Get(ctx context.Context, serviceName string) (*Service, error) {
+	var (
+		svc  = run.NewNamespacesServicesService(c.client)
+		name = makeCloudRunServiceName(c.projectID, serviceName)
+		call = svc.Get(name)
+	)
+	call.Context(ctx)
+
+	service, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+			return nil, ErrServiceNotFound
+		}
+		return nil, err
+	}
+	return (*Service)(service), nil
+}
+
 func (c *client) List(ctx context.Context, options *ListOptions) ([]*Service, string, error) {
 	var (
 		svc    = run.NewNamespacesServicesService(c.client)
@@ -195,6 +213,177 @@ func (c *client) ListRevisions(ctx context.Context, options *ListRevisionsOption
 	return revs, cursor, nil
 }
 
+func (c *client) DeleteRevision(ctx context.Context, name string) error {
+	var (
+		svc  = run.NewNamespacesRevisionsService(c.client)
+		id   = makeCloudRunRevisionName(c.projectID, name)
+		call = svc.Delete(id)
+	)
+	call.Context(ctx)
+
+	if _, err := call.Do(); err != nil {
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+			return ErrRevisionNotFound
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *client) CreateJob(ctx context.Context, jm JobManifest) (*Job, error) {
+	jobCfg, err := jm.RunJob()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		svc    = run.NewNamespacesJobsService(c.client)
+		parent = makeCloudRunParent(c.projectID)
+		call   = svc.Create(parent, jobCfg)
+	)
+	call.Context(ctx)
+
+	job, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok {
+			return nil, fmt.Errorf("failed to create job: code=%d, message=%s, details=%s", e.Code, e.Message, e.Details)
+		}
+		return nil, err
+	}
+	return (*Job)(job), nil
+}
+
+func (c *client) UpdateJob(ctx context.Context, jm JobManifest) (*Job, error) {
+	jobCfg, err := jm.RunJob()
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		svc  = run.NewNamespacesJobsService(c.client)
+		name = makeCloudRunJobName(c.projectID, jm.Name)
+		call = svc.ReplaceJob(name, jobCfg)
+	)
+	call.Context(ctx)
+
+	job, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+	return (*Job)(job), nil
+}
+
+func (c *client) ListJobs(ctx context.Context, options *ListOptions) ([]*Job, string, error) {
+	var (
+		svc    = run.NewNamespacesJobsService(c.client)
+		parent = makeCloudRunParent(c.projectID)
+		call   = svc.List(parent)
+	)
+	call.Context(ctx)
+	if options.Limit != 0 {
+		call.Limit(options.Limit)
+	}
+	if options.LabelSelector != "" {
+		call.LabelSelector(options.LabelSelector)
+	}
+	if options.Cursor != "" {
+		call.Continue(options.Cursor)
+	}
+
+	resp, err := call.Do()
+	if err != nil {
+		return nil, "", err
+	}
+	var cursor string
+	if resp.Metadata != nil {
+		cursor = resp.Metadata.Continue
+	}
+
+	jobs := make([]*Job, 0, len(resp.Items))
+	for i := range resp.Items {
+		jobs = append(jobs, (*Job)(resp.Items[i]))
+	}
+
+	return jobs, cursor, nil
+}
+
+func (c *client) RunJob(ctx context.Context, name string) (*Execution, error) {
+	var (
+		svc  = run.NewNamespacesJobsService(c.client)
+		id   = makeCloudRunJobName(c.projectID, name)
+		call = svc.Run(id, &run.RunJobRequest{})
+	)
+	call.Context(ctx)
+
+	execution, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+			return nil, ErrJobNotFound
+		}
+		return nil, err
+	}
+	return (*Execution)(execution), nil
+}
+
+func (c *client) GetExecution(ctx context.Context, name string) (*Execution, error) {
+	var (
+		svc  = run.NewNamespacesExecutionsService(c.client)
+		id   = makeCloudRunExecutionName(c.projectID, name)
+		call = svc.Get(id)
+	)
+	call.Context(ctx)
+
+	execution, err := call.Do()
+	if err != nil {
+		return nil, err
+	}
+	return (*Execution)(execution), nil
+}
+
+func (c *client) GetIAMPolicy(ctx context.Context, serviceName string) (*Policy, error) {
+	var (
+		svc      = run.NewProjectsLocationsServicesService(c.client)
+		resource = makeCloudRunServiceResourceName(c.projectID, c.region, serviceName)
+		call     = svc.GetIamPolicy(resource)
+	)
+	call.Context(ctx)
+
+	policy, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok && e.Code == http.StatusNotFound {
+			return nil, ErrServiceNotFound
+		}
+		return nil, err
+	}
+	return (*Policy)(policy), nil
+}
+
+func (c *client) SetIAMPolicy(ctx context.Context, serviceName string, pm IAMPolicyManifest, etag string) (*Policy, error) {
+	var (
+		svc      = run.NewProjectsLocationsServicesService(c.client)
+		resource = makeCloudRunServiceResourceName(c.projectID, c.region, serviceName)
+		req      = &run.SetIamPolicyRequest{
+			Policy: pm.RunPolicy(etag),
+			// Keep the audit configs of the service as they are.
+			UpdateMask: "bindings,etag",
+		}
+		call = svc.SetIamPolicy(resource, req)
+	)
+	call.Context(ctx)
+
+	policy, err := call.Do()
+	if err != nil {
+		if e, ok := err.(*googleapi.Error); ok {
+			return nil, fmt.Errorf("failed to set iam policy: code=%d, message=%s, details=%s", e.Code, e.Message, e.Details)
+		}
+		return nil, err
+	}
+	return (*Policy)(policy), nil
+}
+
 func makeCloudRunParent(projectID string) string {
 	return fmt.Sprintf("namespaces/%s", projectID)
 }
@@ -206,3 +395,15 @@ func makeCloudRunServiceName(projectID, serviceID string) string {
 func makeCloudRunRevisionName(projectID, revisionID string) string {
 	return fmt.Sprintf("namespaces/%s/revisions/%s", projectID, revisionID)
 }
+
+func makeCloudRunJobName(projectID, jobID string) string {
+	return fmt.Sprintf("namespaces/%s/jobs/%s", projectID, jobID)
+}
+
+func makeCloudRunExecutionName(projectID, executionID string) string {
+	return fmt.Sprintf("namespaces/%s/executions/%s", projectID, executionID)
+}
+
+func makeCloudRunServiceResourceName(projectID, region, serviceID string) string {
+	return fmt.Sprintf("projects/%s/locations/%s/services/%s", projectID, region, serviceID)
+}
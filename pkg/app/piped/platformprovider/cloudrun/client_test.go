@@ -52,3 +52,40 @@ func TestMakeCloudRunRevisionName(t *testing.T) {
 	want := "namespaces/projectID/revisions/revisionID"
 	assert.Equal(t, want, got)
 }
+
+func TestMakeCloudRunJobName(t *testing.T) {
+	t.Parallel()
+
+	const (
+		projectID = "projectID"
+		jobID     = "jobID"
+	)
+	got := makeCloudRunJobName(projectID, jobID)
+	want := "namespaces/projectID/jobs/jobID"
+	assert.Equal(t, want, got)
+}
+
+func TestMakeCloudRunExecutionName(t *testing.T) {
+	t.Parallel()
+
+	const (
+		projectID   = "projectID"
+		executionID = "executionID"
+	)
+	got := makeCloudRunExecutionName(projectID, executionID)
+	want := "namespaces/projectID/executions/executionID"
+	assert.Equal(t, want, got)
+}
+
+func TestMakeCloudRunServiceResourceName(t *testing.T) {
+	t.Parallel()
+
+	const (
+		projectID = "projectID"
+		region    = "asia-northeast1"
+		serviceID = "serviceID"
+	)
+	got := makeCloudRunServiceResourceName(projectID, region, serviceID)
+	want := "projects/projectID/locations/asia-northeast1/services/serviceID"
+	assert.Equal(t, want, got)
+}
@@ -19,6 +19,7 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 
@@ -37,6 +38,7 @@ const (
 var (
 	ErrServiceNotFound  = errors.New("not found")
 	ErrRevisionNotFound = errors.New("not found")
+	ErrJobNotFound      = errors.New("not found")
 )
 
 var (
@@ -59,6 +61,9 @@ var (
 		"ContainerHealthy":   struct{}{},
 		"ResourcesAvailable": struct{}{},
 	}
+	TypeHealthyJobConditions = map[string]struct{}{
+		"Ready": struct{}{},
+	}
 )
 
 // Kind represents the kind of resource.
@@ -67,11 +72,15 @@ type Kind string
 const (
 	KindService  Kind = "Service"
 	KindRevision Kind = "Revision"
+	KindJob      Kind = "Job"
 )
 
 type (
-	Service  run.Service
-	Revision run.Revision
+	Service   run.Service
+	Revision  run.Revision
+	Job       run.Job
+	Execution run.Execution
+	Policy    run.Policy
 
 	StatusConditions struct {
 		Kind      Kind
@@ -90,14 +99,26 @@ const (
 	LabelCommitHash   = "pipecd-dev-commit-hash"   // Hash value of the deployed commit.
 	LabelRevisionName = "pipecd-dev-revision-name" // The name of revision.
 	ManagedByPiped    = "piped"
+
+	// The label set by Cloud Run to the revisions of a service.
+	serviceLabel = "serving.knative.dev/service"
 )
 
 type Client interface {
 	Create(ctx context.Context, sm ServiceManifest) (*Service, error)
 	Update(ctx context.Context, sm ServiceManifest) (*Service, error)
+	Get(ctx context.Context, serviceName string) (*Service, error)
 	List(ctx context.Context, options *ListOptions) ([]*Service, string, error)
 	GetRevision(ctx context.Context, name string) (*Revision, error)
 	ListRevisions(ctx context.Context, options *ListRevisionsOptions) ([]*Revision, string, error)
+	DeleteRevision(ctx context.Context, name string) error
+	CreateJob(ctx context.Context, jm JobManifest) (*Job, error)
+	UpdateJob(ctx context.Context, jm JobManifest) (*Job, error)
+	ListJobs(ctx context.Context, options *ListOptions) ([]*Job, string, error)
+	RunJob(ctx context.Context, name string) (*Execution, error)
+	GetExecution(ctx context.Context, name string) (*Execution, error)
+	GetIAMPolicy(ctx context.Context, serviceName string) (*Policy, error)
+	SetIAMPolicy(ctx context.Context, serviceName string, pm IAMPolicyManifest, etag string) (*Policy, error)
 }
 
 type ListOptions struct {
@@ -114,6 +135,9 @@ type ListRevisionsOptions struct {
 
 type Registry interface {
 	Client(ctx context.Context, name string, cfg *config.PlatformProviderCloudRunConfig, logger *zap.Logger) (Client, error)
+	// RegionalClient returns the client of the platform provider for the given region
+	// instead of the region configured in the platform provider.
+	RegionalClient(ctx context.Context, name, region string, cfg *config.PlatformProviderCloudRunConfig, logger *zap.Logger) (Client, error)
 }
 
 func LoadServiceManifest(appDir, serviceFilename string) (ServiceManifest, error) {
@@ -162,6 +186,34 @@ func (r *registry) Client(ctx context.Context, name string, cfg *config.Platform
 	return client, nil
 }
 
+func (r *registry) RegionalClient(ctx context.Context, name, region string, cfg *config.PlatformProviderCloudRunConfig, logger *zap.Logger) (Client, error) {
+	if region == cfg.Region {
+		return r.Client(ctx, name, cfg, logger)
+	}
+
+	key := fmt.Sprintf("%s/%s", name, region)
+	r.mu.RLock()
+	client, ok := r.clients[key]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(key, func() (interface{}, error) {
+		return newClient(ctx, cfg.Project, region, cfg.CredentialsFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[key] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
 func MakeManagedByPipedSelector() string {
 	return fmt.Sprintf("%s=%s", LabelManagedBy, ManagedByPiped)
 }
@@ -170,6 +222,10 @@ func MakeRevisionNamesSelector(names []string) string {
 	return fmt.Sprintf("%s in (%s)", LabelRevisionName, strings.Join(names, ","))
 }
 
+func MakeApplicationRevisionsSelector(appID string) string {
+	return fmt.Sprintf("%s=%s,%s=%s", LabelManagedBy, ManagedByPiped, LabelApplication, appID)
+}
+
 func (s *Service) ServiceManifest() (ServiceManifest, error) {
 	r := (*run.Service)(s)
 	data, err := r.MarshalJSON()
@@ -199,46 +255,73 @@ func (s *Service) ActiveRevisionNames() []string {
 	return ret
 }
 
-func (s *Service) StatusConditions() *StatusConditions {
-	var (
-		trueTypes   = make(map[string]struct{}, len(TypeConditions))
-		falseMsgs   = make(map[string]string, len(TypeConditions))
-		unknownMsgs = make(map[string]string, len(TypeConditions))
-	)
-
+// TagURL returns the URL to access the revision having the given tag.
+// False is returned when the tag has not been routed yet.
+func (s *Service) TagURL(tag string) (string, bool) {
 	if s.Status == nil {
+		return "", false
+	}
+	for _, t := range s.Status.Traffic {
+		if t.Tag == tag && t.Url != "" {
+			return t.Url, true
+		}
+	}
+	return "", false
+}
+
+// PrunableRevisionNames returns the names of the given revisions which can be deleted
+// while keeping the latest "keep" revisions of the service which neither serve any traffic nor have a tag.
+// The revisions serving traffic, having a tag or being the latest ones of the service are never returned.
+func (s *Service) PrunableRevisionNames(revisions []*Revision, keep int) []string {
+	if s.Metadata == nil {
 		return nil
 	}
-	for _, cond := range s.Status.Conditions {
-		if _, ok := TypeConditions[cond.Type]; !ok {
-			continue
+	inUse := make(map[string]struct{})
+	if s.Spec != nil {
+		for _, t := range s.Spec.Traffic {
+			inUse[t.RevisionName] = struct{}{}
 		}
-		switch cond.Status {
-		case "True":
-			trueTypes[cond.Type] = struct{}{}
-		case "False":
-			falseMsgs[cond.Reason] = cond.Message
-		default:
-			unknownMsgs[cond.Reason] = cond.Message
+	}
+	if s.Status != nil {
+		inUse[s.Status.LatestCreatedRevisionName] = struct{}{}
+		inUse[s.Status.LatestReadyRevisionName] = struct{}{}
+		for _, t := range s.Status.Traffic {
+			if t.Percent > 0 || t.Tag != "" {
+				inUse[t.RevisionName] = struct{}{}
+			}
 		}
 	}
 
-	fMsgs := make([]string, 0, len(falseMsgs))
-	for _, v := range falseMsgs {
-		fMsgs = append(fMsgs, v)
+	candidates := make([]*Revision, 0, len(revisions))
+	for _, r := range revisions {
+		if r.Metadata == nil || r.Metadata.Labels[serviceLabel] != s.Metadata.Name {
+			continue
+		}
+		if _, ok := inUse[r.Metadata.Name]; ok {
+			continue
+		}
+		candidates = append(candidates, r)
+	}
+	if len(candidates) <= keep {
+		return nil
 	}
 
-	uMsgs := make([]string, 0, len(unknownMsgs))
-	for _, v := range unknownMsgs {
-		uMsgs = append(uMsgs, v)
+	// Sort from the newest one since RFC3339 timestamps in UTC are lexicographically ordered.
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Metadata.CreationTimestamp > candidates[j].Metadata.CreationTimestamp
+	})
+	names := make([]string, 0, len(candidates)-keep)
+	for _, r := range candidates[keep:] {
+		names = append(names, r.Metadata.Name)
 	}
+	return names
+}
 
-	return &StatusConditions{
-		Kind:            KindService,
-		TrueTypes:       trueTypes,
-		FalseMessages:   fMsgs,
-		UnknownMessages: uMsgs,
+func (s *Service) StatusConditions() *StatusConditions {
+	if s.Status == nil {
+		return nil
 	}
+	return makeStatusConditions(KindService, s.Status.Conditions)
 }
 
 func (r *Revision) RevisionManifest() (RevisionManifest, error) {
@@ -251,16 +334,56 @@ func (r *Revision) RevisionManifest() (RevisionManifest, error) {
 }
 
 func (r *Revision) StatusConditions() *StatusConditions {
+	if r.Status == nil {
+		return nil
+	}
+	return makeStatusConditions(KindRevision, r.Status.Conditions)
+}
+
+func (j *Job) JobManifest() (JobManifest, error) {
+	job := (*run.Job)(j)
+	data, err := job.MarshalJSON()
+	if err != nil {
+		return JobManifest{}, err
+	}
+	return ParseJobManifest(data)
+}
+
+func (j *Job) StatusConditions() *StatusConditions {
+	if j.Status == nil {
+		return nil
+	}
+	return makeStatusConditions(KindJob, j.Status.Conditions)
+}
+
+// Result returns whether the execution has completed and succeeded.
+// The message describes the reason when the execution has failed.
+func (e *Execution) Result() (completed, succeeded bool, message string) {
+	if e.Status == nil {
+		return false, false, ""
+	}
+	for _, cond := range e.Status.Conditions {
+		if cond.Type != "Completed" {
+			continue
+		}
+		switch cond.Status {
+		case "True":
+			return true, true, ""
+		case "False":
+			return true, false, cond.Message
+		}
+	}
+	return false, false, ""
+}
+
+func makeStatusConditions(kind Kind, conds []*run.GoogleCloudRunV1Condition) *StatusConditions {
 	var (
 		trueTypes   = make(map[string]struct{}, len(TypeConditions))
 		falseMsgs   = make(map[string]string, len(TypeConditions))
 		unknownMsgs = make(map[string]string, len(TypeConditions))
 	)
 
-	if r.Status == nil {
-		return nil
-	}
-	for _, cond := range r.Status.Conditions {
+	for _, cond := range conds {
 		if _, ok := TypeConditions[cond.Type]; !ok {
 			continue
 		}
@@ -285,7 +408,7 @@ func (r *Revision) StatusConditions() *StatusConditions {
 	}
 
 	return &StatusConditions{
-		Kind:            KindRevision,
+		Kind:            kind,
 		TrueTypes:       trueTypes,
 		FalseMessages:   fMsgs,
 		UnknownMessages: uMsgs,
@@ -306,8 +429,11 @@ func (s *StatusConditions) HealthStatus() (model.CloudRunResourceState_HealthSta
 	}
 
 	mustPassConditions := TypeHealthyServiceConditions
-	if s.Kind == KindRevision {
+	switch s.Kind {
+	case KindRevision:
 		mustPassConditions = TypeHealthyRevisionConditions
+	case KindJob:
+		mustPassConditions = TypeHealthyJobConditions
 	}
 	for k := range mustPassConditions {
 		if _, ok := s.TrueTypes[k]; !ok {
@@ -19,6 +19,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -57,6 +58,14 @@ func TestMakeRevisionNamesSelector(t *testing.T) {
 	assert.Equal(t, want, got)
 }
 
+func TestMakeApplicationRevisionsSelector(t *testing.T) {
+	t.Parallel()
+
+	want := "pipecd-dev-managed-by=piped,pipecd-dev-application=app-id"
+	got := MakeApplicationRevisionsSelector("app-id")
+	assert.Equal(t, want, got)
+}
+
 func TestService(t *testing.T) {
 	t.Parallel()
 
@@ -82,6 +91,98 @@ func TestService(t *testing.T) {
 	assert.Len(t, names, 1)
 }
 
+func TestService_TagURL(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{
+		Status: &run.ServiceStatus{
+			Traffic: []*run.TrafficTarget{
+				{RevisionName: "helloworld-v010-1234567", Percent: 90},
+				{RevisionName: "helloworld-v011-2345678", Percent: 10, Tag: "canary", Url: "https://canary---helloworld-abcdefg-an.a.run.app"},
+			},
+		},
+	}
+
+	url, ok := s.TagURL("canary")
+	assert.True(t, ok)
+	assert.Equal(t, "https://canary---helloworld-abcdefg-an.a.run.app", url)
+
+	_, ok = s.TagURL("stable")
+	assert.False(t, ok)
+
+	_, ok = (&Service{}).TagURL("canary")
+	assert.False(t, ok)
+}
+
+func TestService_PrunableRevisionNames(t *testing.T) {
+	t.Parallel()
+
+	revision := func(name, service, createdAt string) *Revision {
+		return &Revision{
+			Metadata: &run.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{serviceLabel: service},
+				CreationTimestamp: createdAt,
+			},
+		}
+	}
+	s := &Service{
+		Metadata: &run.ObjectMeta{Name: "helloworld"},
+		Spec: &run.ServiceSpec{
+			Traffic: []*run.TrafficTarget{
+				{RevisionName: "helloworld-v006", Percent: 100},
+			},
+		},
+		Status: &run.ServiceStatus{
+			LatestCreatedRevisionName: "helloworld-v006",
+			LatestReadyRevisionName:   "helloworld-v006",
+			Traffic: []*run.TrafficTarget{
+				{RevisionName: "helloworld-v006", Percent: 100},
+				{RevisionName: "helloworld-v002", Tag: "stable"},
+			},
+		},
+	}
+	revisions := []*Revision{
+		revision("helloworld-v001", "helloworld", "2023-01-01T00:00:00Z"),
+		revision("helloworld-v003", "helloworld", "2023-01-03T00:00:00Z"),
+		revision("helloworld-v006", "helloworld", "2023-01-06T00:00:00Z"),
+		revision("helloworld-v002", "helloworld", "2023-01-02T00:00:00Z"),
+		revision("helloworld-v005", "helloworld", "2023-01-05T00:00:00Z"),
+		revision("helloworld-v004", "helloworld", "2023-01-04T00:00:00Z"),
+		revision("other-v001", "other", "2023-01-01T00:00:00Z"),
+	}
+
+	testcases := []struct {
+		name string
+		keep int
+		want []string
+	}{
+		{
+			name: "keep nothing",
+			keep: 0,
+			want: []string{"helloworld-v005", "helloworld-v004", "helloworld-v003", "helloworld-v001"},
+		},
+		{
+			name: "keep the latest two",
+			keep: 2,
+			want: []string{"helloworld-v003", "helloworld-v001"},
+		},
+		{
+			name: "keep more than existing",
+			keep: 10,
+			want: nil,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := s.PrunableRevisionNames(revisions, tc.keep)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
 func TestService_HealthStatus(t *testing.T) {
 	t.Parallel()
 
@@ -16,11 +16,14 @@ package cloudrun
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/exec"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
 	"github.com/pipe-cd/pipecd/pkg/diff"
 )
 
@@ -56,6 +59,104 @@ func Diff(old, new ServiceManifest, opts ...diff.Option) (*DiffResult, error) {
 	return ret, nil
 }
 
+// The annotations of the revision template checked by drift detection.
+var comparedAnnotations = map[string]string{
+	AnnotationMinScale:                        "minScale",
+	AnnotationMaxScale:                        "maxScale",
+	"run.googleapis.com/vpc-access-connector": "vpcConnector",
+	"run.googleapis.com/vpc-access-egress":    "vpcEgress",
+}
+
+// comparedService contains the fields of the service checked by drift detection.
+type comparedService struct {
+	Containers  []comparedContainer `json:"containers,omitempty"`
+	Annotations map[string]string   `json:"annotations,omitempty"`
+}
+
+// comparedContainer is compared by its index because Cloud Run may name the container by itself.
+type comparedContainer struct {
+	Image string            `json:"image,omitempty"`
+	Env   map[string]string `json:"env,omitempty"`
+}
+
+// DiffLiveService calculates the diff between the live service and the one defined in Git.
+// Only the images, environment variables, scaling and VPC connector annotations of the revision template
+// are compared since Cloud Run adds a large number of default values and the traffic is managed by piped.
+func DiffLiveService(live, expected ServiceManifest) (*DiffResult, error) {
+	l, err := toComparedService(live)
+	if err != nil {
+		return nil, err
+	}
+	e, err := toComparedService(expected)
+	if err != nil {
+		return nil, err
+	}
+
+	d, err := diff.DiffUnstructureds(l, e, expected.Name, diff.WithEquateEmpty())
+	if err != nil {
+		return nil, err
+	}
+	if !d.HasDiff() {
+		return &DiffResult{Diff: d}, nil
+	}
+	return &DiffResult{
+		Old:  live,
+		New:  expected,
+		Diff: d,
+	}, nil
+}
+
+func toComparedService(sm ServiceManifest) (unstructured.Unstructured, error) {
+	svc, err := sm.RunService()
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+
+	var cs comparedService
+	if t := svc.Spec.Template; t != nil {
+		if t.Metadata != nil {
+			for k, v := range t.Metadata.Annotations {
+				name, ok := comparedAnnotations[k]
+				if !ok {
+					continue
+				}
+				if cs.Annotations == nil {
+					cs.Annotations = make(map[string]string, len(comparedAnnotations))
+				}
+				cs.Annotations[name] = v
+			}
+		}
+		if t.Spec != nil {
+			for _, c := range t.Spec.Containers {
+				cc := comparedContainer{
+					Image: c.Image,
+				}
+				for _, env := range c.Env {
+					if cc.Env == nil {
+						cc.Env = make(map[string]string, len(c.Env))
+					}
+					cc.Env[env.Name] = env.Value
+					if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil {
+						ref := env.ValueFrom.SecretKeyRef
+						cc.Env[env.Name] = fmt.Sprintf("secretKeyRef(%s:%s)", ref.Name, ref.Key)
+					}
+				}
+				cs.Containers = append(cs.Containers, cc)
+			}
+		}
+	}
+
+	data, err := json.Marshal(cs)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
+
 type DiffRenderOptions struct {
 	// If true, use "diff" command to render.
 	UseDiffCommand bool
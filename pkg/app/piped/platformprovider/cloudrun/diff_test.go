@@ -163,3 +163,172 @@ func TestDiffByCommand(t *testing.T) {
 		})
 	}
 }
+
+func TestDiffLiveService(t *testing.T) {
+	t.Parallel()
+
+	const expected = `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    metadata:
+      annotations:
+        autoscaling.knative.dev/maxScale: '3'
+        run.googleapis.com/vpc-access-connector: connector
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.6.0
+        env:
+        - name: FOO
+          value: bar
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: password
+              key: latest
+`
+
+	testcases := []struct {
+		name     string
+		live     string
+		wantDiff bool
+	}{
+		{
+			name: "ignore the fields set by piped and Cloud Run",
+			live: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+  labels:
+    pipecd.dev/managed-by: piped
+  annotations:
+    run.googleapis.com/ingress-status: all
+spec:
+  template:
+    metadata:
+      name: helloworld-v060-0b13751
+      annotations:
+        autoscaling.knative.dev/maxScale: '3'
+        run.googleapis.com/vpc-access-connector: connector
+        run.googleapis.com/client-name: gcloud
+    spec:
+      containerConcurrency: 80
+      timeoutSeconds: 300
+      containers:
+      - name: user-container
+        image: gcr.io/pipecd/helloworld:v0.6.0
+        env:
+        - name: FOO
+          value: bar
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: password
+              key: latest
+  traffic:
+  - revisionName: helloworld-v060-0b13751
+    percent: 100
+`,
+		},
+		{
+			name: "image was changed",
+			live: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    metadata:
+      annotations:
+        autoscaling.knative.dev/maxScale: '3'
+        run.googleapis.com/vpc-access-connector: connector
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.5.0
+        env:
+        - name: FOO
+          value: bar
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: password
+              key: latest
+`,
+			wantDiff: true,
+		},
+		{
+			name: "env and scaling were changed",
+			live: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    metadata:
+      annotations:
+        autoscaling.knative.dev/maxScale: '10'
+        run.googleapis.com/vpc-access-connector: connector
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.6.0
+        env:
+        - name: FOO
+          value: baz
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: password
+              key: "1"
+`,
+			wantDiff: true,
+		},
+		{
+			name: "vpc connector was removed",
+			live: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    metadata:
+      annotations:
+        autoscaling.knative.dev/maxScale: '3'
+    spec:
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.6.0
+        env:
+        - name: FOO
+          value: bar
+        - name: PASSWORD
+          valueFrom:
+            secretKeyRef:
+              name: password
+              key: latest
+`,
+			wantDiff: true,
+		},
+	}
+
+	e, err := ParseServiceManifest([]byte(expected))
+	require.NoError(t, err)
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			l, err := ParseServiceManifest([]byte(tc.live))
+			require.NoError(t, err)
+
+			got, err := DiffLiveService(l, e)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDiff, !got.NoChange())
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"google.golang.org/api/run/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// The prefixes of the members which can be bound to a role.
+var iamMemberPrefixes = []string{
+	"user:",
+	"serviceAccount:",
+	"group:",
+	"domain:",
+}
+
+// IAMPolicyManifest represents the IAM policy of a Cloud Run service,
+// in the same format used by "gcloud run services set-iam-policy".
+// The policy is authoritative, the bindings not listed in it are removed from the service.
+type IAMPolicyManifest struct {
+	Bindings []IAMBinding `json:"bindings"`
+}
+
+// IAMBinding binds a list of members to a role, e.g. allUsers to roles/run.invoker.
+type IAMBinding struct {
+	Role    string   `json:"role"`
+	Members []string `json:"members"`
+}
+
+// LoadIAMPolicyManifest loads the IAM policy manifest placing at the given path in the application directory.
+func LoadIAMPolicyManifest(appDir, policyFilename string) (IAMPolicyManifest, error) {
+	if policyFilename == "" {
+		return IAMPolicyManifest{}, fmt.Errorf("iam policy file was not specified")
+	}
+	data, err := os.ReadFile(filepath.Join(appDir, policyFilename))
+	if err != nil {
+		return IAMPolicyManifest{}, err
+	}
+	return ParseIAMPolicyManifest(data)
+}
+
+func ParseIAMPolicyManifest(data []byte) (IAMPolicyManifest, error) {
+	var m IAMPolicyManifest
+	if err := yaml.UnmarshalStrict(data, &m); err != nil {
+		return IAMPolicyManifest{}, err
+	}
+	if err := m.validate(); err != nil {
+		return IAMPolicyManifest{}, err
+	}
+	return m, nil
+}
+
+func (m IAMPolicyManifest) validate() error {
+	for _, b := range m.Bindings {
+		if b.Role == "" {
+			return fmt.Errorf("role of iam policy binding must be specified")
+		}
+		if len(b.Members) == 0 {
+			return fmt.Errorf("members of iam policy binding for role %s must be specified", b.Role)
+		}
+		for _, member := range b.Members {
+			if !isValidIAMMember(member) {
+				return fmt.Errorf("invalid member %q of iam policy binding for role %s", member, b.Role)
+			}
+		}
+	}
+	return nil
+}
+
+func isValidIAMMember(member string) bool {
+	if member == "allUsers" || member == "allAuthenticatedUsers" {
+		return true
+	}
+	for _, prefix := range iamMemberPrefixes {
+		if strings.HasPrefix(member, prefix) && len(member) > len(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns true if the given live policy has the same bindings as the manifest.
+// The order of the bindings and members is ignored.
+func (m IAMPolicyManifest) Matches(p *Policy) bool {
+	live := make([]IAMBinding, 0, len(p.Bindings))
+	for _, b := range p.Bindings {
+		live = append(live, IAMBinding{Role: b.Role, Members: b.Members})
+	}
+	want, got := normalizeIAMBindings(m.Bindings), normalizeIAMBindings(live)
+	if len(want) != len(got) {
+		return false
+	}
+	for role, members := range want {
+		if strings.Join(members, ",") != strings.Join(got[role], ",") {
+			return false
+		}
+	}
+	return true
+}
+
+// RunPolicy returns the policy to be set to the service.
+// The etag of the live policy should be given to prevent overwriting concurrent changes.
+func (m IAMPolicyManifest) RunPolicy(etag string) *run.Policy {
+	bindings := make([]*run.Binding, 0, len(m.Bindings))
+	for _, b := range m.Bindings {
+		bindings = append(bindings, &run.Binding{
+			Role:    b.Role,
+			Members: b.Members,
+		})
+	}
+	return &run.Policy{
+		Bindings: bindings,
+		Etag:     etag,
+	}
+}
+
+// normalizeIAMBindings merges the bindings of the same role and sorts their members.
+func normalizeIAMBindings(bindings []IAMBinding) map[string][]string {
+	members := make(map[string]map[string]struct{}, len(bindings))
+	for _, b := range bindings {
+		if len(b.Members) == 0 {
+			continue
+		}
+		if members[b.Role] == nil {
+			members[b.Role] = make(map[string]struct{}, len(b.Members))
+		}
+		for _, member := range b.Members {
+			members[b.Role][member] = struct{}{}
+		}
+	}
+
+	out := make(map[string][]string, len(members))
+	for role, set := range members {
+		list := make([]string, 0, len(set))
+		for member := range set {
+			list = append(list, member)
+		}
+		sort.Strings(list)
+		out[role] = list
+	}
+	return out
+}
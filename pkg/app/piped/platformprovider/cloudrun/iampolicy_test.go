@@ -0,0 +1,190 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
+)
+
+func TestParseIAMPolicyManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		data    string
+		want    IAMPolicyManifest
+		wantErr bool
+	}{
+		{
+			name: "public service",
+			data: `
+bindings:
+- role: roles/run.invoker
+  members:
+  - allUsers
+`,
+			want: IAMPolicyManifest{
+				Bindings: []IAMBinding{
+					{Role: "roles/run.invoker", Members: []string{"allUsers"}},
+				},
+			},
+		},
+		{
+			name: "specific members",
+			data: `
+bindings:
+- role: roles/run.invoker
+  members:
+  - serviceAccount:caller@project.iam.gserviceaccount.com
+  - group:team@example.com
+`,
+			want: IAMPolicyManifest{
+				Bindings: []IAMBinding{
+					{Role: "roles/run.invoker", Members: []string{"serviceAccount:caller@project.iam.gserviceaccount.com", "group:team@example.com"}},
+				},
+			},
+		},
+		{
+			name: "no bindings",
+			data: `bindings: []`,
+			want: IAMPolicyManifest{Bindings: []IAMBinding{}},
+		},
+		{
+			name: "missing role",
+			data: `
+bindings:
+- members:
+  - allUsers
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing members",
+			data: `
+bindings:
+- role: roles/run.invoker
+`,
+			wantErr: true,
+		},
+		{
+			name: "invalid member",
+			data: `
+bindings:
+- role: roles/run.invoker
+  members:
+  - caller@example.com
+`,
+			wantErr: true,
+		},
+		{
+			name: "unknown field",
+			data: `
+bindings:
+- role: roles/run.invoker
+  member: allUsers
+`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseIAMPolicyManifest([]byte(tc.data))
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestIAMPolicyManifest_Matches(t *testing.T) {
+	t.Parallel()
+
+	pm := IAMPolicyManifest{
+		Bindings: []IAMBinding{
+			{Role: "roles/run.invoker", Members: []string{"user:a@example.com", "allUsers"}},
+			{Role: "roles/run.developer", Members: []string{"group:team@example.com"}},
+		},
+	}
+
+	testcases := []struct {
+		name   string
+		policy *Policy
+		want   bool
+	}{
+		{
+			name: "same bindings in different order",
+			policy: &Policy{
+				Bindings: []*run.Binding{
+					{Role: "roles/run.developer", Members: []string{"group:team@example.com"}},
+					{Role: "roles/run.invoker", Members: []string{"allUsers", "user:a@example.com"}},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "missing member",
+			policy: &Policy{
+				Bindings: []*run.Binding{
+					{Role: "roles/run.developer", Members: []string{"group:team@example.com"}},
+					{Role: "roles/run.invoker", Members: []string{"allUsers"}},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "extra binding",
+			policy: &Policy{
+				Bindings: []*run.Binding{
+					{Role: "roles/run.developer", Members: []string{"group:team@example.com"}},
+					{Role: "roles/run.invoker", Members: []string{"allUsers", "user:a@example.com"}},
+					{Role: "roles/run.admin", Members: []string{"user:b@example.com"}},
+				},
+			},
+			want: false,
+		},
+		{
+			name:   "empty policy",
+			policy: &Policy{},
+			want:   false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, pm.Matches(tc.policy))
+		})
+	}
+}
+
+func TestIAMPolicyManifest_RunPolicy(t *testing.T) {
+	t.Parallel()
+
+	pm := IAMPolicyManifest{
+		Bindings: []IAMBinding{
+			{Role: "roles/run.invoker", Members: []string{"allUsers"}},
+		},
+	}
+	got := pm.RunPolicy("etag")
+	require.Len(t, got.Bindings, 1)
+	assert.Equal(t, "roles/run.invoker", got.Bindings[0].Role)
+	assert.Equal(t, []string{"allUsers"}, got.Bindings[0].Members)
+	assert.Equal(t, "etag", got.Etag)
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/api/run/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// JobManifest represents the manifest of a Cloud Run job.
+type JobManifest struct {
+	Name string
+	u    *unstructured.Unstructured
+}
+
+func (m JobManifest) YamlBytes() ([]byte, error) {
+	return yaml.Marshal(m.u)
+}
+
+func (m JobManifest) Labels() map[string]string {
+	return m.u.GetLabels()
+}
+
+func (m JobManifest) AppID() (string, bool) {
+	v := m.Labels()
+	if v == nil || v[LabelApplication] == "" {
+		return "", false
+	}
+	return v[LabelApplication], true
+}
+
+func (m JobManifest) AddLabels(labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	lbls := m.u.GetLabels()
+	if lbls == nil {
+		m.u.SetLabels(labels)
+		return
+	}
+	for k, v := range labels {
+		lbls[k] = v
+	}
+	m.u.SetLabels(lbls)
+}
+
+func (m JobManifest) RunJob() (*run.Job, error) {
+	data, err := m.YamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	var j run.Job
+	if err := yaml.Unmarshal(data, &j); err != nil {
+		return nil, err
+	}
+	return &j, nil
+}
+
+// LoadJobManifest loads the job manifest placing at the given path in the application directory.
+func LoadJobManifest(appDir, jobFilename string) (JobManifest, error) {
+	if jobFilename == "" {
+		return JobManifest{}, fmt.Errorf("job manifest file was not specified")
+	}
+	data, err := os.ReadFile(filepath.Join(appDir, jobFilename))
+	if err != nil {
+		return JobManifest{}, err
+	}
+	return ParseJobManifest(data)
+}
+
+func ParseJobManifest(data []byte) (JobManifest, error) {
+	var obj unstructured.Unstructured
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return JobManifest{}, err
+	}
+	if obj.GetKind() != string(KindJob) {
+		return JobManifest{}, fmt.Errorf("kind of job manifest must be %s, but got %q", KindJob, obj.GetKind())
+	}
+
+	return JobManifest{
+		Name: obj.GetName(),
+		u:    &obj,
+	}, nil
+}
@@ -0,0 +1,139 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
+)
+
+const jobManifest = `
+apiVersion: run.googleapis.com/v1
+kind: Job
+metadata:
+  name: migrate
+  labels:
+    cloud.googleapis.com/location: asia-northeast1
+spec:
+  template:
+    spec:
+      taskCount: 1
+      template:
+        spec:
+          containers:
+          - image: gcr.io/pipecd/migrate:v0.1.0
+          maxRetries: 3
+`
+
+func TestJobManifest(t *testing.T) {
+	t.Parallel()
+
+	jm, err := ParseJobManifest([]byte(jobManifest))
+	require.NoError(t, err)
+	assert.Equal(t, "migrate", jm.Name)
+
+	_, ok := jm.AppID()
+	assert.False(t, ok)
+
+	jm.AddLabels(map[string]string{
+		LabelApplication: "app-id",
+	})
+	appID, ok := jm.AppID()
+	assert.True(t, ok)
+	assert.Equal(t, "app-id", appID)
+	assert.Equal(t, "asia-northeast1", jm.Labels()["cloud.googleapis.com/location"])
+
+	job, err := jm.RunJob()
+	require.NoError(t, err)
+	assert.Equal(t, "migrate", job.Metadata.Name)
+	assert.Equal(t, "app-id", job.Metadata.Labels[LabelApplication])
+	require.Len(t, job.Spec.Template.Spec.Template.Spec.Containers, 1)
+	assert.Equal(t, "gcr.io/pipecd/migrate:v0.1.0", job.Spec.Template.Spec.Template.Spec.Containers[0].Image)
+}
+
+func TestParseJobManifest(t *testing.T) {
+	t.Parallel()
+
+	_, err := ParseJobManifest([]byte(jobManifest))
+	require.NoError(t, err)
+
+	_, err = ParseJobManifest([]byte(serviceManifest))
+	require.Error(t, err)
+}
+
+func TestExecution_Result(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		execution     *Execution
+		wantCompleted bool
+		wantSucceeded bool
+		wantMessage   string
+	}{
+		{
+			name:      "no status",
+			execution: &Execution{},
+		},
+		{
+			name: "running",
+			execution: &Execution{
+				Status: &run.ExecutionStatus{
+					Conditions: []*run.GoogleCloudRunV1Condition{
+						{Type: "Completed", Status: "Unknown"},
+					},
+				},
+			},
+		},
+		{
+			name: "succeeded",
+			execution: &Execution{
+				Status: &run.ExecutionStatus{
+					Conditions: []*run.GoogleCloudRunV1Condition{
+						{Type: "Ready", Status: "True"},
+						{Type: "Completed", Status: "True"},
+					},
+				},
+			},
+			wantCompleted: true,
+			wantSucceeded: true,
+		},
+		{
+			name: "failed",
+			execution: &Execution{
+				Status: &run.ExecutionStatus{
+					Conditions: []*run.GoogleCloudRunV1Condition{
+						{Type: "Completed", Status: "False", Message: "Task migrate-abcde-task0 failed"},
+					},
+				},
+			},
+			wantCompleted: true,
+			wantMessage:   "Task migrate-abcde-task0 failed",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			completed, succeeded, message := tc.execution.Result()
+			assert.Equal(t, tc.wantCompleted, completed)
+			assert.Equal(t, tc.wantSucceeded, succeeded)
+			assert.Equal(t, tc.wantMessage, message)
+		})
+	}
+}
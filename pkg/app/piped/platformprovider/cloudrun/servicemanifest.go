@@ -16,7 +16,9 @@ package cloudrun
 
 import (
 	"fmt"
+	"hash/fnv"
 	"os"
+	"sort"
 	"strings"
 
 	"google.golang.org/api/run/v1"
@@ -27,6 +29,13 @@ import (
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// The annotations of the revision template to configure its autoscaling.
+const (
+	AnnotationMinScale      = "autoscaling.knative.dev/minScale"
+	AnnotationMaxScale      = "autoscaling.knative.dev/maxScale"
+	AnnotationCPUThrottling = "run.googleapis.com/cpu-throttling"
+)
+
 type ServiceManifest struct {
 	Name string
 	u    *unstructured.Unstructured
@@ -39,6 +48,8 @@ func (m ServiceManifest) SetRevision(name string) error {
 type RevisionTraffic struct {
 	RevisionName string `json:"revisionName"`
 	Percent      int    `json:"percent"`
+	// The tag assigned to the revision to access it at a dedicated URL.
+	Tag string `json:"tag,omitempty"`
 }
 
 func (m ServiceManifest) UpdateTraffic(revisions []RevisionTraffic) error {
@@ -120,6 +131,26 @@ func (m ServiceManifest) AddRevisionLabels(labels map[string]string) error {
 	return unstructured.SetNestedStringMap(m.u.Object, lbls, fields...)
 }
 
+func (m ServiceManifest) AddRevisionAnnotations(annotations map[string]string) error {
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	fields := []string{"spec", "template", "metadata", "annotations"}
+	annos, ok, err := unstructured.NestedStringMap(m.u.Object, fields...)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return unstructured.SetNestedStringMap(m.u.Object, annotations, fields...)
+	}
+
+	for k, v := range annotations {
+		annos[k] = v
+	}
+	return unstructured.SetNestedStringMap(m.u.Object, annos, fields...)
+}
+
 func (m ServiceManifest) RunService() (*run.Service, error) {
 	data, err := m.YamlBytes()
 	if err != nil {
@@ -166,6 +197,24 @@ func DecideRevisionName(sm ServiceManifest, commit string) (string, error) {
 	return fmt.Sprintf("%s-%s-%s", sm.Name, tag, commit), nil
 }
 
+// MakeScaledRevisionName returns the name of the revision deployed with the given autoscaling annotations
+// instead of the ones in the service manifest. Since the annotations of a revision can't be changed once
+// it was created, the revision is distinguished from the one having the original annotations by a suffix
+// derived from the annotations.
+func MakeScaledRevisionName(revision string, annotations map[string]string) string {
+	keys := make([]string, 0, len(annotations))
+	for k := range annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := fnv.New32a()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s;", k, annotations[k])
+	}
+	return fmt.Sprintf("%s-%08x", revision, h.Sum32())
+}
+
 func FindImageTag(sm ServiceManifest) (string, error) {
 	containers, ok, err := unstructured.NestedSlice(sm.u.Object, "spec", "template", "spec", "containers")
 	if err != nil {
@@ -103,6 +103,7 @@ func TestServiceManifest(t *testing.T) {
 		{
 			RevisionName: "helloworld-v011-2345678",
 			Percent:      50,
+			Tag:          "canary",
 		},
 	}
 	err = sm.UpdateTraffic(traffics)
@@ -132,6 +133,9 @@ func TestServiceManifest(t *testing.T) {
 	got, err := sm.RunService()
 	require.NoError(t, err)
 	assert.NotEmpty(t, got)
+	require.Len(t, got.Spec.Traffic, 2)
+	assert.Equal(t, "", got.Spec.Traffic[0].Tag)
+	assert.Equal(t, "canary", got.Spec.Traffic[1].Tag)
 
 	// AddRevisionLabels
 	err = sm.AddRevisionLabels(labels)
@@ -144,6 +148,19 @@ func TestServiceManifest(t *testing.T) {
 	// RevisionLabels
 	v := sm.RevisionLabels()
 	assert.Equal(t, labels, v)
+
+	// AddRevisionAnnotations
+	err = sm.AddRevisionAnnotations(map[string]string{
+		AnnotationMaxScale:      "2",
+		AnnotationCPUThrottling: "false",
+	})
+	require.NoError(t, err)
+	got, err = sm.RunService()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{
+		AnnotationMaxScale:      "2",
+		AnnotationCPUThrottling: "false",
+	}, got.Spec.Template.Metadata.Annotations)
 }
 
 func TestParseServiceManifest(t *testing.T) {
@@ -173,6 +190,28 @@ func TestDecideRevisionName(t *testing.T) {
 	require.Equal(t, "helloworld-v010-1234567", name)
 }
 
+func TestMakeScaledRevisionName(t *testing.T) {
+	t.Parallel()
+
+	annotations := map[string]string{
+		AnnotationMaxScale:      "1",
+		AnnotationCPUThrottling: "false",
+	}
+	name := MakeScaledRevisionName("helloworld-v010-1234567", annotations)
+	assert.Regexp(t, `^helloworld-v010-1234567-[0-9a-f]{8}$`, name)
+
+	// The same annotations always give the same name.
+	assert.Equal(t, name, MakeScaledRevisionName("helloworld-v010-1234567", map[string]string{
+		AnnotationCPUThrottling: "false",
+		AnnotationMaxScale:      "1",
+	}))
+
+	// Different annotations give a different name.
+	assert.NotEqual(t, name, MakeScaledRevisionName("helloworld-v010-1234567", map[string]string{
+		AnnotationMaxScale: "1",
+	}))
+}
+
 func TestFindImageTag(t *testing.T) {
 	t.Parallel()
 
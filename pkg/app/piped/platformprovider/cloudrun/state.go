@@ -16,8 +16,10 @@ package cloudrun
 
 import (
 	"sort"
+	"strconv"
 	"time"
 
+	"google.golang.org/api/run/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
@@ -30,10 +32,15 @@ func MakeResourceStates(svc *Service, revs []*Revision, updatedAt time.Time) []*
 	sm, err := svc.ServiceManifest()
 	if err == nil {
 		status, desc := svc.StatusConditions().HealthStatus()
-		states = append(states, makeResourceState(sm.u, status, desc, updatedAt))
+		state := makeResourceState(sm.u, status, desc, updatedAt)
+		if svc.Status != nil {
+			state.Conditions = makeResourceConditions(svc.Status.Conditions)
+		}
+		states = append(states, state)
 	}
 
 	// Set active revision states.
+	traffics := svc.revisionTraffics()
 	for _, r := range revs {
 		rm, err := r.RevisionManifest()
 		if err != nil {
@@ -41,11 +48,80 @@ func MakeResourceStates(svc *Service, revs []*Revision, updatedAt time.Time) []*
 		}
 
 		status, desc := r.StatusConditions().HealthStatus()
-		states = append(states, makeResourceState(rm.u, status, desc, updatedAt))
+		state := makeResourceState(rm.u, status, desc, updatedAt)
+		state.RevisionState = makeRevisionState(r, traffics[rm.Name])
+		if r.Status != nil {
+			state.Conditions = makeResourceConditions(r.Status.Conditions)
+		}
+		states = append(states, state)
 	}
 	return states
 }
 
+// revisionTraffics returns the traffic currently routed to each revision.
+func (s *Service) revisionTraffics() map[string]*model.CloudRunRevisionState {
+	if s.Status == nil {
+		return nil
+	}
+	traffics := make(map[string]*model.CloudRunRevisionState, len(s.Status.Traffic))
+	for _, t := range s.Status.Traffic {
+		rt, ok := traffics[t.RevisionName]
+		if !ok {
+			rt = &model.CloudRunRevisionState{}
+			traffics[t.RevisionName] = rt
+		}
+		rt.TrafficPercent += int32(t.Percent)
+		if t.Tag != "" {
+			rt.Tags = append(rt.Tags, t.Tag)
+		}
+	}
+	return traffics
+}
+
+func makeRevisionState(r *Revision, traffic *model.CloudRunRevisionState) *model.CloudRunRevisionState {
+	state := &model.CloudRunRevisionState{}
+	if traffic != nil {
+		state.TrafficPercent = traffic.TrafficPercent
+		state.Tags = traffic.Tags
+	}
+	if r.Metadata != nil {
+		state.MinInstances = parseScale(r.Metadata.Annotations[AnnotationMinScale])
+		state.MaxInstances = parseScale(r.Metadata.Annotations[AnnotationMaxScale])
+	}
+	if r.Spec != nil {
+		state.ContainerConcurrency = int32(r.Spec.ContainerConcurrency)
+	}
+	if r.Status != nil {
+		state.ImageDigest = r.Status.ImageDigest
+	}
+	return state
+}
+
+func parseScale(v string) int32 {
+	n, err := strconv.ParseInt(v, 10, 32)
+	if err != nil {
+		return 0
+	}
+	return int32(n)
+}
+
+func makeResourceConditions(conds []*run.GoogleCloudRunV1Condition) []*model.CloudRunResourceCondition {
+	out := make([]*model.CloudRunResourceCondition, 0, len(conds))
+	for _, c := range conds {
+		cond := &model.CloudRunResourceCondition{
+			Type:    c.Type,
+			Status:  c.Status,
+			Reason:  c.Reason,
+			Message: c.Message,
+		}
+		if t, err := time.Parse(time.RFC3339, c.LastTransitionTime); err == nil {
+			cond.LastTransitionTime = t.Unix()
+		}
+		out = append(out, cond)
+	}
+	return out
+}
+
 func makeResourceState(obj *unstructured.Unstructured, status model.CloudRunResourceState_HealthStatus, desc string, updatedAt time.Time) *model.CloudRunResourceState {
 	var (
 		owners       = obj.GetOwnerReferences()
@@ -76,3 +152,17 @@ func makeResourceState(obj *unstructured.Unstructured, status model.CloudRunReso
 
 	return state
 }
+
+// MakeJobResourceState returns the state of the given job.
+func MakeJobResourceState(job *Job, updatedAt time.Time) (*model.CloudRunResourceState, bool) {
+	jm, err := job.JobManifest()
+	if err != nil {
+		return nil, false
+	}
+	status, desc := job.StatusConditions().HealthStatus()
+	state := makeResourceState(jm.u, status, desc, updatedAt)
+	if job.Status != nil {
+		state.Conditions = makeResourceConditions(job.Status.Conditions)
+	}
+	return state, true
+}
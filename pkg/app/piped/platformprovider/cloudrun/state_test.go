@@ -20,6 +20,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/api/run/v1"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -43,10 +44,34 @@ func TestMakeResourceStates(t *testing.T) {
 
 	r := (*Revision)(rev)
 
+	s.Status.Traffic = []*run.TrafficTarget{
+		{RevisionName: "helloworld-v010-1234567", Percent: 80},
+		{RevisionName: "helloworld-v010-1234567", Tag: "canary"},
+		{RevisionName: "helloworld-v009-7654321", Percent: 20},
+	}
+
 	// MakeResourceStates
 	rs := []*Revision{r}
 	states := MakeResourceStates(s, rs, time.Now())
 	require.Len(t, states, 2)
 	assert.Equal(t, model.CloudRunResourceState_OTHER, states[0].HealthStatus)
+	assert.Nil(t, states[0].RevisionState)
+	require.Len(t, states[0].Conditions, 3)
+	assert.Equal(t, &model.CloudRunResourceCondition{
+		Type:               "Ready",
+		Status:             "False",
+		Reason:             "RevisionFailed",
+		Message:            "Revision helloworld-v010-1234567 is not ready.",
+		LastTransitionTime: time.Date(2022, 1, 31, 6, 18, 57, 0, time.UTC).Unix(),
+	}, states[0].Conditions[0])
+
 	assert.Equal(t, model.CloudRunResourceState_HEALTHY, states[1].HealthStatus)
+	assert.Equal(t, &model.CloudRunRevisionState{
+		TrafficPercent:       80,
+		Tags:                 []string{"canary"},
+		MaxInstances:         1,
+		ContainerConcurrency: 80,
+		ImageDigest:          "gcr.io/pipecd/helloworld@sha256:abcdefg",
+	}, states[1].RevisionState)
+	assert.Len(t, states[1].Conditions, 4)
 }
@@ -0,0 +1,371 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"google.golang.org/api/run/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+const (
+	serviceAPIVersion = "serving.knative.dev/v1"
+
+	annotationVPCConnector      = "run.googleapis.com/vpc-access-connector"
+	annotationVPCEgress         = "run.googleapis.com/vpc-access-egress"
+	annotationNetworkInterfaces = "run.googleapis.com/network-interfaces"
+
+	// The maximum length of the service name, which is shorter than a DNS label
+	// since the names of its revisions are generated by adding suffixes to it.
+	maxServiceNameLength    = 49
+	maxContainerConcurrency = 1000
+	maxTimeoutSeconds       = 3600
+
+	mebibyte = 1 << 20
+	gibibyte = 1 << 30
+)
+
+var (
+	serviceNameRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+	// A VPC connector can be specified by its name or its fully qualified name.
+	vpcConnectorRegex = regexp.MustCompile(`^(projects/[^/]+/locations/[^/]+/connectors/)?[a-z][-a-z0-9]{0,23}[a-z0-9]$`)
+	// The version of a secret must be "latest" or a version number.
+	secretVersionRegex = regexp.MustCompile(`^(latest|[1-9][0-9]*)$`)
+
+	vpcEgressSettings = map[string]struct{}{
+		"all":                 {},
+		"all-traffic":         {},
+		"private-ranges-only": {},
+	}
+	portNames = map[string]struct{}{
+		"http1": {},
+		"h2c":   {},
+	}
+	// The CPU sizes larger than 1 supported by Cloud Run.
+	cpuSizes = map[int64]struct{}{
+		1000: {},
+		2000: {},
+		4000: {},
+		6000: {},
+		8000: {},
+	}
+)
+
+// The memory limits (in GiB) of an instance which require more CPUs.
+// https://cloud.google.com/run/docs/configuring/services/memory-limits
+var minCPUsForMemory = []struct {
+	memoryGiB int64
+	cpus      int64
+}{
+	{24, 8},
+	{16, 6},
+	{8, 4},
+	{4, 2},
+}
+
+// The minimum memory (in GiB) required by the large CPU sizes.
+// https://cloud.google.com/run/docs/configuring/services/cpu
+var minMemoryForCPUs = map[int64]int64{
+	4: 2,
+	6: 4,
+	8: 4,
+}
+
+// ValidateServiceManifest returns an error if the given service manifest
+// can not be deployed to Cloud Run, so that it can be reported before calling any API.
+func ValidateServiceManifest(sm ServiceManifest) error {
+	if v := sm.u.GetAPIVersion(); v != serviceAPIVersion {
+		return fmt.Errorf("apiVersion must be %s, but got %q", serviceAPIVersion, v)
+	}
+	if k := sm.u.GetKind(); k != string(KindService) {
+		return fmt.Errorf("kind must be %s, but got %q", KindService, k)
+	}
+	if err := validateServiceName(sm.Name); err != nil {
+		return err
+	}
+
+	svc, err := sm.RunService()
+	if err != nil {
+		return fmt.Errorf("malformed service manifest: %w", err)
+	}
+	if svc.Spec == nil || svc.Spec.Template == nil || svc.Spec.Template.Spec == nil {
+		return fmt.Errorf("spec.template.spec is required")
+	}
+
+	var annotations map[string]string
+	if svc.Spec.Template.Metadata != nil {
+		annotations = svc.Spec.Template.Metadata.Annotations
+	}
+	if err := validateRevisionAnnotations(annotations); err != nil {
+		return err
+	}
+	return validateRevisionSpec(svc.Spec.Template.Spec, annotations)
+}
+
+func validateServiceName(name string) error {
+	if name == "" {
+		return fmt.Errorf("metadata.name is required")
+	}
+	if len(name) > maxServiceNameLength {
+		return fmt.Errorf("service name %s must be at most %d characters", name, maxServiceNameLength)
+	}
+	if !serviceNameRegex.MatchString(name) {
+		return fmt.Errorf("service name %s must consist of lowercase letters, digits and dashes, start with a letter and not end with a dash", name)
+	}
+	return nil
+}
+
+// validateRevisionAnnotations checks the annotations of the revision template configuring its scaling and networking.
+func validateRevisionAnnotations(annotations map[string]string) error {
+	minScale, hasMin, err := parseScaleAnnotation(annotations, AnnotationMinScale)
+	if err != nil {
+		return err
+	}
+	maxScale, hasMax, err := parseScaleAnnotation(annotations, AnnotationMaxScale)
+	if err != nil {
+		return err
+	}
+	if hasMax && maxScale == 0 {
+		return fmt.Errorf("annotation %s must be positive", AnnotationMaxScale)
+	}
+	if hasMin && hasMax && minScale > maxScale {
+		return fmt.Errorf("annotation %s (%d) must not be greater than %s (%d)", AnnotationMinScale, minScale, AnnotationMaxScale, maxScale)
+	}
+
+	connector, hasConnector := annotations[annotationVPCConnector]
+	if hasConnector && !vpcConnectorRegex.MatchString(connector) {
+		return fmt.Errorf("annotation %s must be a connector name or projects/PROJECT/locations/REGION/connectors/NAME, but got %q", annotationVPCConnector, connector)
+	}
+	if egress, ok := annotations[annotationVPCEgress]; ok {
+		if _, ok := vpcEgressSettings[egress]; !ok {
+			return fmt.Errorf("annotation %s must be all-traffic or private-ranges-only, but got %q", annotationVPCEgress, egress)
+		}
+		if _, ok := annotations[annotationNetworkInterfaces]; !ok && !hasConnector {
+			return fmt.Errorf("annotation %s requires %s or %s to be specified", annotationVPCEgress, annotationVPCConnector, annotationNetworkInterfaces)
+		}
+	}
+	return nil
+}
+
+func parseScaleAnnotation(annotations map[string]string, key string) (int, bool, error) {
+	v, ok := annotations[key]
+	if !ok {
+		return 0, false, nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		return 0, false, fmt.Errorf("annotation %s must be a non-negative integer, but got %q", key, v)
+	}
+	return n, true, nil
+}
+
+func validateRevisionSpec(spec *run.RevisionSpec, annotations map[string]string) error {
+	if len(spec.Containers) == 0 {
+		return fmt.Errorf("at least one container is required in spec.template.spec.containers")
+	}
+	if spec.ContainerConcurrency < 0 || spec.ContainerConcurrency > maxContainerConcurrency {
+		return fmt.Errorf("containerConcurrency must be in range [0, %d], but got %d", maxContainerConcurrency, spec.ContainerConcurrency)
+	}
+	if spec.TimeoutSeconds < 0 || spec.TimeoutSeconds > maxTimeoutSeconds {
+		return fmt.Errorf("timeoutSeconds must be in range [1, %d], but got %d", maxTimeoutSeconds, spec.TimeoutSeconds)
+	}
+
+	volumes := make(map[string]*run.Volume, len(spec.Volumes))
+	for _, v := range spec.Volumes {
+		if v.Name == "" {
+			return fmt.Errorf("name is required for volume")
+		}
+		if _, ok := volumes[v.Name]; ok {
+			return fmt.Errorf("volume %s is defined multiple times", v.Name)
+		}
+		volumes[v.Name] = v
+		if err := validateSecretVolume(v); err != nil {
+			return err
+		}
+	}
+
+	var (
+		names        = make(map[string]struct{}, len(spec.Containers))
+		ingressFound bool
+		multiple     = len(spec.Containers) > 1
+	)
+	for i, c := range spec.Containers {
+		name := c.Name
+		if name == "" {
+			if multiple {
+				return fmt.Errorf("name is required for container at index %d when multiple containers are defined", i)
+			}
+			name = fmt.Sprintf("at index %d", i)
+		} else {
+			if _, ok := names[name]; ok {
+				return fmt.Errorf("container %s is defined multiple times", name)
+			}
+			names[name] = struct{}{}
+		}
+
+		if c.Image == "" {
+			return fmt.Errorf("image is required for container %s", name)
+		}
+		if len(c.Ports) > 0 {
+			if ingressFound {
+				return fmt.Errorf("only one container can expose a port, but container %s also exposes one", name)
+			}
+			ingressFound = true
+		}
+		if err := validateContainerPorts(name, c.Ports); err != nil {
+			return err
+		}
+		if err := validateContainerResources(name, c.Resources, spec.ContainerConcurrency, annotations); err != nil {
+			return err
+		}
+		if err := validateContainerSecrets(name, c, volumes); err != nil {
+			return err
+		}
+	}
+	if multiple && !ingressFound {
+		return fmt.Errorf("one of the containers must expose a port to receive requests when multiple containers are defined")
+	}
+	return nil
+}
+
+func validateContainerPorts(container string, ports []*run.ContainerPort) error {
+	if len(ports) > 1 {
+		return fmt.Errorf("container %s can expose only one port, but got %d", container, len(ports))
+	}
+	for _, p := range ports {
+		if p.ContainerPort < 0 || p.ContainerPort > 65535 {
+			return fmt.Errorf("containerPort %d of container %s must be in range [1, 65535]", p.ContainerPort, container)
+		}
+		if _, ok := portNames[p.Name]; p.Name != "" && !ok {
+			return fmt.Errorf("port name of container %s must be http1 or h2c, but got %q", container, p.Name)
+		}
+	}
+	return nil
+}
+
+// validateContainerResources checks whether the combination of the CPU and memory limits is supported by Cloud Run.
+func validateContainerResources(container string, resources *run.ResourceRequirements, concurrency int64, annotations map[string]string) error {
+	if resources == nil {
+		return nil
+	}
+
+	var (
+		milliCPU int64
+		memory   int64
+	)
+	if v, ok := resources.Limits["cpu"]; ok {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return fmt.Errorf("cpu limit %q of container %s is not a valid quantity: %w", v, container, err)
+		}
+		milliCPU = q.MilliValue()
+		if milliCPU < 80 {
+			return fmt.Errorf("cpu limit %s of container %s must be at least 0.08", v, container)
+		}
+		if _, ok := cpuSizes[milliCPU]; milliCPU > 1000 && !ok {
+			return fmt.Errorf("cpu limit %s of container %s must be less than 1 or one of 1, 2, 4, 6 and 8", v, container)
+		}
+	}
+	if v, ok := resources.Limits["memory"]; ok {
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return fmt.Errorf("memory limit %q of container %s is not a valid quantity: %w", v, container, err)
+		}
+		memory = q.Value()
+		if memory < 128*mebibyte {
+			return fmt.Errorf("memory limit %s of container %s must be at least 128Mi", v, container)
+		}
+		if memory > 32*gibibyte {
+			return fmt.Errorf("memory limit %s of container %s must be at most 32Gi", v, container)
+		}
+	}
+
+	if milliCPU > 0 && milliCPU < 1000 {
+		if memory > 512*mebibyte {
+			return fmt.Errorf("memory limit of container %s must be at most 512Mi when cpu limit is less than 1", container)
+		}
+		if concurrency > 1 {
+			return fmt.Errorf("containerConcurrency must be 1 when cpu limit of container %s is less than 1", container)
+		}
+		if annotations[AnnotationCPUThrottling] == "false" {
+			return fmt.Errorf("cpu limit of container %s must be at least 1 to always allocate CPU", container)
+		}
+	}
+	if milliCPU == 0 || memory == 0 {
+		return nil
+	}
+	for _, r := range minCPUsForMemory {
+		if memory > r.memoryGiB*gibibyte && milliCPU < r.cpus*1000 {
+			return fmt.Errorf("memory limit of container %s larger than %dGi requires at least %d CPUs", container, r.memoryGiB, r.cpus)
+		}
+	}
+	if minMemory, ok := minMemoryForCPUs[milliCPU/1000]; ok && milliCPU%1000 == 0 && memory < minMemory*gibibyte {
+		return fmt.Errorf("memory limit of container %s must be at least %dGi for %d CPUs", container, minMemory, milliCPU/1000)
+	}
+	return nil
+}
+
+func validateSecretVolume(v *run.Volume) error {
+	if v.Secret == nil {
+		return nil
+	}
+	if v.Secret.SecretName == "" {
+		return fmt.Errorf("secretName is required for secret volume %s", v.Name)
+	}
+	for _, item := range v.Secret.Items {
+		if !secretVersionRegex.MatchString(item.Key) {
+			return fmt.Errorf("key of secret volume %s must be latest or a version number, but got %q", v.Name, item.Key)
+		}
+		if item.Path == "" {
+			return fmt.Errorf("path is required for the items of secret volume %s", v.Name)
+		}
+	}
+	return nil
+}
+
+// validateContainerSecrets checks whether the secrets referred from the container are well-formed
+// and its volume mounts refer to the volumes defined in the revision.
+func validateContainerSecrets(container string, c *run.Container, volumes map[string]*run.Volume) error {
+	for _, env := range c.Env {
+		if env.ValueFrom == nil || env.ValueFrom.SecretKeyRef == nil {
+			continue
+		}
+		ref := env.ValueFrom.SecretKeyRef
+		if ref.Name == "" {
+			return fmt.Errorf("secret name is required for env %s of container %s", env.Name, container)
+		}
+		if !secretVersionRegex.MatchString(ref.Key) {
+			return fmt.Errorf("secret key of env %s of container %s must be latest or a version number, but got %q", env.Name, container, ref.Key)
+		}
+	}
+
+	mountPaths := make(map[string]struct{}, len(c.VolumeMounts))
+	for _, m := range c.VolumeMounts {
+		if _, ok := volumes[m.Name]; !ok {
+			return fmt.Errorf("volume %s mounted by container %s is not defined in spec.template.spec.volumes", m.Name, container)
+		}
+		if m.MountPath == "" {
+			return fmt.Errorf("mountPath is required for volume %s mounted by container %s", m.Name, container)
+		}
+		if _, ok := mountPaths[m.MountPath]; ok {
+			return fmt.Errorf("mountPath %s is used multiple times in container %s", m.MountPath, container)
+		}
+		mountPaths[m.MountPath] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cloudrun
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validationServiceManifest = `
+apiVersion: %s
+kind: Service
+metadata:
+  name: %s
+spec:
+  template:
+    metadata:
+      annotations:
+%s
+    spec:
+%s
+`
+
+const validationContainers = `
+      containers:
+      - image: gcr.io/pipecd/helloworld:v0.1.0
+        ports:
+        - name: http1
+          containerPort: 9085
+        resources:
+          limits:
+            cpu: 1000m
+            memory: 512Mi`
+
+func TestValidateServiceManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		apiVersion  string
+		serviceName string
+		annotations string
+		spec        string
+		wantErr     string
+	}{
+		{
+			name:        "valid",
+			annotations: "        autoscaling.knative.dev/minScale: '1'\n        autoscaling.knative.dev/maxScale: '3'",
+			spec:        "      containerConcurrency: 80\n      timeoutSeconds: 300" + validationContainers,
+		},
+		{
+			name:       "wrong api version",
+			apiVersion: "serving.knative.dev/v1alpha1",
+			spec:       validationContainers,
+			wantErr:    `apiVersion must be serving.knative.dev/v1, but got "serving.knative.dev/v1alpha1"`,
+		},
+		{
+			name:        "invalid service name",
+			serviceName: "Hello_World",
+			spec:        validationContainers,
+			wantErr:     "service name Hello_World must consist of lowercase letters, digits and dashes, start with a letter and not end with a dash",
+		},
+		{
+			name:        "too long service name",
+			serviceName: "a1234567890123456789012345678901234567890123456789",
+			spec:        validationContainers,
+			wantErr:     "service name a1234567890123456789012345678901234567890123456789 must be at most 49 characters",
+		},
+		{
+			name:    "no container",
+			spec:    "      containers: []",
+			wantErr: "at least one container is required in spec.template.spec.containers",
+		},
+		{
+			name:    "missing image",
+			spec:    "      containers:\n      - name: app",
+			wantErr: "image is required for container app",
+		},
+		{
+			name:    "invalid port name",
+			spec:    "      containers:\n      - image: app\n        ports:\n        - name: http\n          containerPort: 8080",
+			wantErr: `port name of container at index 0 must be http1 or h2c, but got "http"`,
+		},
+		{
+			name:    "multiple containers without ingress",
+			spec:    "      containers:\n      - name: app\n        image: app\n      - name: sidecar\n        image: sidecar",
+			wantErr: "one of the containers must expose a port to receive requests when multiple containers are defined",
+		},
+		{
+			name:    "timeout too long",
+			spec:    "      timeoutSeconds: 3601" + validationContainers,
+			wantErr: "timeoutSeconds must be in range [1, 3600], but got 3601",
+		},
+		{
+			name:    "unsupported cpu",
+			spec:    "      containers:\n      - image: app\n        resources:\n          limits:\n            cpu: '3'",
+			wantErr: "cpu limit 3 of container at index 0 must be less than 1 or one of 1, 2, 4, 6 and 8",
+		},
+		{
+			name:    "too much memory for fractional cpu",
+			spec:    "      containerConcurrency: 1\n      containers:\n      - image: app\n        resources:\n          limits:\n            cpu: 500m\n            memory: 1Gi",
+			wantErr: "memory limit of container at index 0 must be at most 512Mi when cpu limit is less than 1",
+		},
+		{
+			name:    "concurrency with fractional cpu",
+			spec:    "      containerConcurrency: 80\n      containers:\n      - image: app\n        resources:\n          limits:\n            cpu: 500m\n            memory: 256Mi",
+			wantErr: "containerConcurrency must be 1 when cpu limit of container at index 0 is less than 1",
+		},
+		{
+			name:    "too much memory for cpu",
+			spec:    "      containers:\n      - image: app\n        resources:\n          limits:\n            cpu: '2'\n            memory: 16Gi",
+			wantErr: "memory limit of container at index 0 larger than 8Gi requires at least 4 CPUs",
+		},
+		{
+			name:    "too little memory for cpu",
+			spec:    "      containers:\n      - image: app\n        resources:\n          limits:\n            cpu: '8'\n            memory: 2Gi",
+			wantErr: "memory limit of container at index 0 must be at least 4Gi for 8 CPUs",
+		},
+		{
+			name:        "min scale greater than max scale",
+			annotations: "        autoscaling.knative.dev/minScale: '5'\n        autoscaling.knative.dev/maxScale: '3'",
+			spec:        validationContainers,
+			wantErr:     "annotation autoscaling.knative.dev/minScale (5) must not be greater than autoscaling.knative.dev/maxScale (3)",
+		},
+		{
+			name:        "valid vpc connector",
+			annotations: "        run.googleapis.com/vpc-access-connector: projects/my-project/locations/asia-northeast1/connectors/my-connector\n        run.googleapis.com/vpc-access-egress: private-ranges-only",
+			spec:        validationContainers,
+		},
+		{
+			name:        "invalid vpc connector",
+			annotations: "        run.googleapis.com/vpc-access-connector: projects/my-project/connectors/my-connector",
+			spec:        validationContainers,
+			wantErr:     `annotation run.googleapis.com/vpc-access-connector must be a connector name or projects/PROJECT/locations/REGION/connectors/NAME, but got "projects/my-project/connectors/my-connector"`,
+		},
+		{
+			name:        "egress without connector",
+			annotations: "        run.googleapis.com/vpc-access-egress: all-traffic",
+			spec:        validationContainers,
+			wantErr:     "annotation run.googleapis.com/vpc-access-egress requires run.googleapis.com/vpc-access-connector or run.googleapis.com/network-interfaces to be specified",
+		},
+		{
+			name: "valid secrets",
+			spec: "      volumes:\n      - name: config\n        secret:\n          secretName: app-config\n          items:\n          - key: latest\n            path: config.yaml\n      containers:\n      - image: app\n        env:\n        - name: TOKEN\n          valueFrom:\n            secretKeyRef:\n              name: token\n              key: '2'\n        volumeMounts:\n        - name: config\n          mountPath: /etc/app",
+		},
+		{
+			name:    "mounting undefined volume",
+			spec:    "      containers:\n      - image: app\n        volumeMounts:\n        - name: config\n          mountPath: /etc/app",
+			wantErr: "volume config mounted by container at index 0 is not defined in spec.template.spec.volumes",
+		},
+		{
+			name:    "secret volume without secret name",
+			spec:    "      volumes:\n      - name: config\n        secret:\n          items:\n          - key: latest\n            path: config.yaml" + validationContainers,
+			wantErr: "secretName is required for secret volume config",
+		},
+		{
+			name:    "invalid secret version",
+			spec:    "      containers:\n      - image: app\n        env:\n        - name: TOKEN\n          valueFrom:\n            secretKeyRef:\n              name: token\n              key: v1",
+			wantErr: `secret key of env TOKEN of container at index 0 must be latest or a version number, but got "v1"`,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			apiVersion, serviceName, annotations := tc.apiVersion, tc.serviceName, tc.annotations
+			if apiVersion == "" {
+				apiVersion = "serving.knative.dev/v1"
+			}
+			if serviceName == "" {
+				serviceName = "helloworld"
+			}
+			if annotations == "" {
+				annotations = "        run.googleapis.com/execution-environment: gen2"
+			}
+			data := fmt.Sprintf(validationServiceManifest, apiVersion, serviceName, annotations, tc.spec)
+			sm, err := ParseServiceManifest([]byte(data))
+			require.NoError(t, err)
+
+			err = ValidateServiceManifest(sm)
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	// The provisioning states of the container app.
+	AppProvisioningStateInProgress = "InProgress"
+	AppProvisioningStateSucceeded  = "Succeeded"
+	AppProvisioningStateFailed     = "Failed"
+	AppProvisioningStateCanceled   = "Canceled"
+
+	// The provisioning states of the revision.
+	RevisionProvisioningStateProvisioning = "Provisioning"
+	RevisionProvisioningStateProvisioned  = "Provisioned"
+	RevisionProvisioningStateFailed       = "Failed"
+
+	// The health states of the revision.
+	RevisionHealthStateHealthy   = "Healthy"
+	RevisionHealthStateUnhealthy = "Unhealthy"
+)
+
+// App represents the current state of a container app.
+type App struct {
+	ID         string            `json:"id"`
+	Name       string            `json:"name"`
+	Tags       map[string]string `json:"tags"`
+	Properties AppProperties     `json:"properties"`
+
+	// The whole resource returned by the API, which is used to compare with the manifest.
+	raw map[string]interface{}
+}
+
+type AppProperties struct {
+	ProvisioningState       string           `json:"provisioningState"`
+	RunningStatus           string           `json:"runningStatus"`
+	LatestRevisionName      string           `json:"latestRevisionName"`
+	LatestReadyRevisionName string           `json:"latestReadyRevisionName"`
+	Configuration           AppConfiguration `json:"configuration"`
+}
+
+type AppConfiguration struct {
+	ActiveRevisionsMode string   `json:"activeRevisionsMode"`
+	Ingress             *Ingress `json:"ingress"`
+}
+
+type Ingress struct {
+	Fqdn    string          `json:"fqdn"`
+	Traffic []TrafficWeight `json:"traffic"`
+}
+
+// TrafficWeight is the percentage of the traffic routed to a revision.
+// The revision is either specified by its name or the latest one.
+type TrafficWeight struct {
+	RevisionName   string `json:"revisionName,omitempty"`
+	Weight         int    `json:"weight"`
+	LatestRevision bool   `json:"latestRevision,omitempty"`
+	Label          string `json:"label,omitempty"`
+}
+
+func (t TrafficWeight) object() map[string]interface{} {
+	o := map[string]interface{}{
+		"weight": t.Weight,
+	}
+	if t.RevisionName != "" {
+		o["revisionName"] = t.RevisionName
+	}
+	if t.LatestRevision {
+		o["latestRevision"] = true
+	}
+	if t.Label != "" {
+		o["label"] = t.Label
+	}
+	return o
+}
+
+func (a *App) UnmarshalJSON(data []byte) error {
+	type app App
+	if err := json.Unmarshal(data, (*app)(a)); err != nil {
+		return err
+	}
+	raw, err := decodeObject(data)
+	if err != nil {
+		return err
+	}
+	a.raw = raw
+	return nil
+}
+
+// Provisioning reports whether the changes to the app are still being applied.
+func (a *App) Provisioning() bool {
+	return a.Properties.ProvisioningState == AppProvisioningStateInProgress
+}
+
+// MultipleRevisions reports whether multiple revisions of the app can be active at the same time.
+func (a *App) MultipleRevisions() bool {
+	return a.Properties.Configuration.ActiveRevisionsMode == ActiveRevisionsModeMultiple
+}
+
+// Traffic returns the traffic weights of the app with the latest revision resolved to its name.
+func (a *App) Traffic() []TrafficWeight {
+	ingress := a.Properties.Configuration.Ingress
+	if ingress == nil {
+		return nil
+	}
+	traffic := make([]TrafficWeight, 0, len(ingress.Traffic))
+	for _, t := range ingress.Traffic {
+		if t.LatestRevision {
+			t.RevisionName = a.Properties.LatestRevisionName
+			t.LatestRevision = false
+		}
+		traffic = append(traffic, t)
+	}
+	return traffic
+}
+
+// Revision represents the current state of a revision of a container app.
+type Revision struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	Properties RevisionProperties `json:"properties"`
+}
+
+type RevisionProperties struct {
+	CreatedTime       time.Time `json:"createdTime"`
+	Active            bool      `json:"active"`
+	Replicas          int       `json:"replicas"`
+	TrafficWeight     int       `json:"trafficWeight"`
+	HealthState       string    `json:"healthState"`
+	ProvisioningState string    `json:"provisioningState"`
+	ProvisioningError string    `json:"provisioningError"`
+	RunningState      string    `json:"runningState"`
+}
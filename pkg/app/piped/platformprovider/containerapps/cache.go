@@ -0,0 +1,68 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+)
+
+type AppManifestCache struct {
+	AppID  string
+	Cache  cache.Cache
+	Logger *zap.Logger
+}
+
+func (c AppManifestCache) Get(commit string) (AppManifest, bool) {
+	key := appManifestCacheKey(c.AppID, commit)
+	item, err := c.Cache.Get(key)
+	if err == nil {
+		return item.(AppManifest), true
+	}
+
+	if errors.Is(err, cache.ErrNotFound) {
+		c.Logger.Info("app manifest were not found in cache",
+			zap.String("app-id", c.AppID),
+			zap.String("commit-hash", commit),
+		)
+		return AppManifest{}, false
+	}
+
+	c.Logger.Error("failed while retrieving app manifest from cache",
+		zap.String("app-id", c.AppID),
+		zap.String("commit-hash", commit),
+		zap.Error(err),
+	)
+	return AppManifest{}, false
+}
+
+func (c AppManifestCache) Put(commit string, m AppManifest) {
+	key := appManifestCacheKey(c.AppID, commit)
+	if err := c.Cache.Put(key, m); err != nil {
+		c.Logger.Error("failed while putting app manifest from cache",
+			zap.String("app-id", c.AppID),
+			zap.String("commit-hash", commit),
+			zap.Error(err),
+		)
+	}
+}
+
+func appManifestCacheKey(appID, commit string) string {
+	return fmt.Sprintf("%s/%s", appID, commit)
+}
@@ -0,0 +1,193 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azureapi"
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	apiVersion = "2023-05-01"
+
+	defaultPollInterval = 5 * time.Second
+	requestTimeout      = 30 * time.Second
+)
+
+type client struct {
+	api           *azureapi.Client
+	resourceGroup string
+	pollInterval  time.Duration
+	logger        *zap.Logger
+}
+
+func newClient(subscriptionID, resourceGroup string, creds config.AzureCredentials, logger *zap.Logger) (*client, error) {
+	if subscriptionID == "" {
+		return nil, fmt.Errorf("subscriptionId is required field")
+	}
+	if resourceGroup == "" {
+		return nil, fmt.Errorf("resourceGroup is required field")
+	}
+
+	hc := &http.Client{Timeout: requestTimeout}
+	cred, err := azureapi.NewCredential(creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load azure credentials: %w", err)
+	}
+
+	return &client{
+		api:           azureapi.NewClient(cred, azureapi.WithHTTPClient(hc)),
+		resourceGroup: fmt.Sprintf("/subscriptions/%s/resourceGroups/%s", subscriptionID, resourceGroup),
+		pollInterval:  defaultPollInterval,
+		logger:        logger.Named("containerapps"),
+	}, nil
+}
+
+func (c *client) appPath(name string) string {
+	return c.resourceGroup + "/providers/Microsoft.App/containerApps/" + name
+}
+
+func (c *client) revisionPath(appName, revisionName string) string {
+	return c.appPath(appName) + "/revisions/" + revisionName
+}
+
+func (c *client) GetApp(ctx context.Context, name string) (*App, error) {
+	var app App
+	if err := c.api.Do(ctx, http.MethodGet, c.appPath(name), apiVersion, nil, &app); err != nil {
+		if azureapi.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get container app %s: %w", name, err)
+	}
+	return &app, nil
+}
+
+func (c *client) CreateOrUpdateApp(ctx context.Context, m AppManifest) (*App, error) {
+	name := m.Name()
+	if err := c.api.Do(ctx, http.MethodPut, c.appPath(name), apiVersion, m, nil); err != nil {
+		return nil, fmt.Errorf("failed to create or update container app %s: %w", name, err)
+	}
+	return c.waitProvisioned(ctx, name)
+}
+
+func (c *client) UpdateTraffic(ctx context.Context, name string, traffic []TrafficWeight) (*App, error) {
+	items := make([]interface{}, 0, len(traffic))
+	for _, t := range traffic {
+		items = append(items, t.object())
+	}
+	// The arrays are replaced as a whole by the JSON merge patch.
+	in := map[string]interface{}{
+		"properties": map[string]interface{}{
+			"configuration": map[string]interface{}{
+				"ingress": map[string]interface{}{
+					"traffic": items,
+				},
+			},
+		},
+	}
+	if err := c.api.Do(ctx, http.MethodPatch, c.appPath(name), apiVersion, in, nil); err != nil {
+		return nil, fmt.Errorf("failed to update traffic of container app %s: %w", name, err)
+	}
+	return c.waitProvisioned(ctx, name)
+}
+
+// waitProvisioned waits until the ongoing operation on the given app has been completed.
+func (c *client) waitProvisioned(ctx context.Context, name string) (*App, error) {
+	for {
+		app, err := c.GetApp(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		switch app.Properties.ProvisioningState {
+		case AppProvisioningStateFailed, AppProvisioningStateCanceled:
+			return nil, fmt.Errorf("provisioning of container app %s was %s", name, app.Properties.ProvisioningState)
+		case AppProvisioningStateInProgress:
+			c.logger.Debug(fmt.Sprintf("waiting for container app %s to be provisioned", name))
+		default:
+			return app, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(c.pollInterval):
+		}
+	}
+}
+
+func (c *client) ListApps(ctx context.Context) ([]*App, error) {
+	items, err := c.api.List(ctx, c.resourceGroup+"/providers/Microsoft.App/containerApps", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list container apps: %w", err)
+	}
+	apps := make([]*App, 0, len(items))
+	for _, item := range items {
+		var app App
+		if err := json.Unmarshal(item, &app); err != nil {
+			return nil, err
+		}
+		apps = append(apps, &app)
+	}
+	return apps, nil
+}
+
+func (c *client) ListRevisions(ctx context.Context, appName string) ([]*Revision, error) {
+	items, err := c.api.List(ctx, c.appPath(appName)+"/revisions", apiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list revisions of container app %s: %w", appName, err)
+	}
+	revisions := make([]*Revision, 0, len(items))
+	for _, item := range items {
+		var r Revision
+		if err := json.Unmarshal(item, &r); err != nil {
+			return nil, err
+		}
+		revisions = append(revisions, &r)
+	}
+	return revisions, nil
+}
+
+func (c *client) GetRevision(ctx context.Context, appName, revisionName string) (*Revision, error) {
+	var r Revision
+	if err := c.api.Do(ctx, http.MethodGet, c.revisionPath(appName, revisionName), apiVersion, nil, &r); err != nil {
+		if azureapi.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get revision %s: %w", revisionName, err)
+	}
+	return &r, nil
+}
+
+func (c *client) ActivateRevision(ctx context.Context, appName, revisionName string) error {
+	if err := c.api.Do(ctx, http.MethodPost, c.revisionPath(appName, revisionName)+"/activate", apiVersion, nil, nil); err != nil {
+		return fmt.Errorf("failed to activate revision %s: %w", revisionName, err)
+	}
+	return nil
+}
+
+func (c *client) DeactivateRevision(ctx context.Context, appName, revisionName string) error {
+	if err := c.api.Do(ctx, http.MethodPost, c.revisionPath(appName, revisionName)+"/deactivate", apiVersion, nil, nil); err != nil {
+		return fmt.Errorf("failed to deactivate revision %s: %w", revisionName, err)
+	}
+	return nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/azureapi"
+)
+
+type staticCredential string
+
+func (c staticCredential) GetToken(_ context.Context, _ policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	return azcore.AccessToken{Token: string(c), ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+const testAppPath = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/containerApps/web"
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cred := staticCredential("token")
+	return &client{
+		api:           azureapi.NewClient(cred, azureapi.WithEndpoint(ts.URL), azureapi.WithHTTPClient(ts.Client())),
+		resourceGroup: "/subscriptions/sub/resourceGroups/rg",
+		pollInterval:  time.Millisecond,
+		logger:        zap.NewNop(),
+	}
+}
+
+func TestClientCreateOrUpdateApp(t *testing.T) {
+	t.Parallel()
+
+	var gets int32
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testAppPath, r.URL.Path)
+		assert.Equal(t, apiVersion, r.URL.Query().Get("api-version"))
+		switch r.Method {
+		case http.MethodPut:
+			var in map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, "web", in["name"])
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"name":"web","properties":{"provisioningState":"InProgress"}}`))
+		case http.MethodGet:
+			if atomic.AddInt32(&gets, 1) == 1 {
+				w.Write([]byte(`{"name":"web","properties":{"provisioningState":"InProgress"}}`))
+				return
+			}
+			w.Write([]byte(`{"name":"web","properties":{"provisioningState":"Succeeded","latestRevisionName":"web--r1"}}`))
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	m, err := ParseAppManifest([]byte(testAppManifest))
+	require.NoError(t, err)
+
+	app, err := c.CreateOrUpdateApp(context.Background(), m)
+	require.NoError(t, err)
+	assert.Equal(t, "web--r1", app.Properties.LatestRevisionName)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+}
+
+func TestClientUpdateTrafficFailed(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			var in map[string]interface{}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, map[string]interface{}{
+				"properties": map[string]interface{}{
+					"configuration": map[string]interface{}{
+						"ingress": map[string]interface{}{
+							"traffic": []interface{}{
+								map[string]interface{}{"revisionName": "web--r1", "weight": float64(90)},
+								map[string]interface{}{"revisionName": "web--r2", "weight": float64(10)},
+							},
+						},
+					},
+				},
+			}, in)
+			w.WriteHeader(http.StatusAccepted)
+		case http.MethodGet:
+			w.Write([]byte(`{"name":"web","properties":{"provisioningState":"Failed"}}`))
+		}
+	})
+
+	_, err := c.UpdateTraffic(context.Background(), "web", []TrafficWeight{
+		{RevisionName: "web--r1", Weight: 90},
+		{RevisionName: "web--r2", Weight: 10},
+	})
+	assert.EqualError(t, err, "provisioning of container app web was Failed")
+}
+
+func TestClientGetAppNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"error":{"code":"ResourceNotFound","message":"not found"}}`))
+	})
+
+	_, err := c.GetApp(context.Background(), "web")
+	assert.Equal(t, ErrNotFound, err)
+}
+
+func TestClientListRevisions(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, testAppPath+"/revisions", r.URL.Path)
+		w.Write([]byte(`{"value":[{"name":"web--r1","properties":{"createdTime":"2023-05-01T10:00:00Z","active":true,"replicas":2,"trafficWeight":100,"healthState":"Healthy","provisioningState":"Provisioned"}}]}`))
+	})
+
+	revisions, err := c.ListRevisions(context.Background(), "web")
+	require.NoError(t, err)
+	require.Len(t, revisions, 1)
+	assert.Equal(t, "web--r1", revisions[0].Name)
+	assert.Equal(t, RevisionProperties{
+		CreatedTime:       time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		Active:            true,
+		Replicas:          2,
+		TrafficWeight:     100,
+		HealthState:       RevisionHealthStateHealthy,
+		ProvisioningState: RevisionProvisioningStateProvisioned,
+	}, revisions[0].Properties)
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The keys of the tags added by piped.
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+)
+
+// ErrNotFound is returned when the requested container app or revision does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of Azure Resource Manager API for Azure Container Apps.
+type Client interface {
+	// GetApp returns the container app having the given name.
+	// ErrNotFound is returned when there is no such app.
+	GetApp(ctx context.Context, name string) (*App, error)
+	// CreateOrUpdateApp applies the given manifest and waits until the app has been provisioned.
+	CreateOrUpdateApp(ctx context.Context, m AppManifest) (*App, error)
+	// UpdateTraffic replaces the traffic weights of the given app and waits until the app has been provisioned.
+	UpdateTraffic(ctx context.Context, name string, traffic []TrafficWeight) (*App, error)
+	// ListApps returns all container apps in the resource group of the platform provider.
+	ListApps(ctx context.Context) ([]*App, error)
+	ListRevisions(ctx context.Context, appName string) ([]*Revision, error)
+	// GetRevision returns the given revision of the app.
+	// ErrNotFound is returned when there is no such revision.
+	GetRevision(ctx context.Context, appName, revisionName string) (*Revision, error)
+	ActivateRevision(ctx context.Context, appName, revisionName string) error
+	DeactivateRevision(ctx context.Context, appName, revisionName string) error
+}
+
+// Registry holds a pool of container apps client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderContainerAppsConfig, logger *zap.Logger) (Client, error)
+}
+
+// MakeRevisionSuffix returns the revision suffix which is unique for each deployment
+// so that a new revision is always created by the deployment.
+func MakeRevisionSuffix(commitHash, deploymentID string) string {
+	return strings.ToLower(fmt.Sprintf("r%s-%s", shorten(commitHash, 7), shorten(deploymentID, 8)))
+}
+
+// RevisionName returns the name of the revision having the given suffix.
+func RevisionName(appName, suffix string) string {
+	return appName + "--" + suffix
+}
+
+func shorten(s string, n int) string {
+	if len(s) > n {
+		return s[:n]
+	}
+	return s
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderContainerAppsConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.SubscriptionID, cfg.ResourceGroup, cfg.Credentials, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of container apps clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"encoding/json"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// DiffLiveApp calculates the diff between the running app and the one defined in Git.
+// Only the tags and the template and configuration of the app are compared,
+// excluding the fields changed by piped during the deployment such as the revision suffix and the traffic weights.
+// The fields not specified in Git are ignored since Azure fills them with the default values.
+func DiffLiveApp(live *App, expected AppManifest) (*diff.Result, error) {
+	lu, err := toUnstructured(comparedFields(live.raw))
+	if err != nil {
+		return nil, err
+	}
+	eu, err := toUnstructured(comparedFields(expected.raw))
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(lu, eu, expected.Name(),
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+}
+
+// comparedFields returns a copy of the given app resource containing only the compared fields.
+func comparedFields(raw map[string]interface{}) map[string]interface{} {
+	tags := make(map[string]interface{})
+	for k, v := range objectField(raw, "tags") {
+		if !strings.HasPrefix(k, "pipecd-dev-") {
+			tags[k] = v
+		}
+	}
+
+	template := copyObject(objectField(raw, "properties", "template"))
+	delete(template, "revisionSuffix")
+
+	configuration := copyObject(objectField(raw, "properties", "configuration"))
+	// The values of the secrets are never returned by the API.
+	delete(configuration, "secrets")
+	if ingress, ok := configuration["ingress"].(map[string]interface{}); ok {
+		ingress = copyObject(ingress)
+		delete(ingress, "traffic")
+		configuration["ingress"] = ingress
+	}
+
+	return map[string]interface{}{
+		"tags": tags,
+		"properties": map[string]interface{}{
+			"template":      template,
+			"configuration": configuration,
+		},
+	}
+}
+
+// copyObject returns a shallow copy of the given object.
+func copyObject(m map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLiveApp(t *testing.T) {
+	t.Parallel()
+
+	expected, err := ParseAppManifest([]byte(testAppManifest))
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		live     string
+		wantDiff bool
+	}{
+		{
+			name: "synced",
+			live: `{
+				"id": "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/containerApps/web",
+				"name": "web",
+				"tags": {"pipecd-dev-managed-by": "piped"},
+				"properties": {
+					"provisioningState": "Succeeded",
+					"configuration": {
+						"activeRevisionsMode": "Multiple",
+						"secrets": [{"name": "password"}],
+						"ingress": {
+							"external": true,
+							"targetPort": 8080,
+							"transport": "Auto",
+							"traffic": [{"revisionName": "web--r1", "weight": 100}]
+						}
+					},
+					"template": {
+						"revisionSuffix": "r1",
+						"containers": [
+							{"name": "web", "image": "myacr.azurecr.io/web:v1.0.0", "resources": {"cpu": 0.5, "memory": "1Gi", "ephemeralStorage": "2Gi"}},
+							{"name": "sidecar", "image": "envoyproxy/envoy@sha256:abc"}
+						]
+					}
+				}
+			}`,
+		},
+		{
+			name: "image was changed",
+			live: `{
+				"name": "web",
+				"properties": {
+					"configuration": {"activeRevisionsMode": "Multiple", "ingress": {"external": true, "targetPort": 8080}},
+					"template": {
+						"containers": [
+							{"name": "web", "image": "myacr.azurecr.io/web:v0.9.0", "resources": {"cpu": 0.5, "memory": "1Gi"}},
+							{"name": "sidecar", "image": "envoyproxy/envoy@sha256:abc"}
+						]
+					}
+				}
+			}`,
+			wantDiff: true,
+		},
+		{
+			name: "ingress was changed",
+			live: `{
+				"name": "web",
+				"properties": {
+					"configuration": {"activeRevisionsMode": "Multiple", "ingress": {"external": false, "targetPort": 8080}},
+					"template": {
+						"containers": [
+							{"name": "web", "image": "myacr.azurecr.io/web:v1.0.0", "resources": {"cpu": 0.5, "memory": "1Gi"}},
+							{"name": "sidecar", "image": "envoyproxy/envoy@sha256:abc"}
+						]
+					}
+				}
+			}`,
+			wantDiff: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var live App
+			require.NoError(t, json.Unmarshal([]byte(tc.live), &live))
+
+			result, err := DiffLiveApp(&live, expected)
+			require.NoError(t, err)
+			assert.Equal(t, tc.wantDiff, result.HasDiff())
+		})
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	ActiveRevisionsModeSingle   = "Single"
+	ActiveRevisionsModeMultiple = "Multiple"
+)
+
+// AppManifest is the container app resource written in the format of Azure Resource Manager API.
+// The fields not used by piped are kept as is, so the app can be applied without any loss.
+type AppManifest struct {
+	raw map[string]interface{}
+}
+
+// LoadAppManifest returns AppManifest object from a given app manifest file.
+func LoadAppManifest(appDir, appManifestFile string) (AppManifest, error) {
+	path := filepath.Join(appDir, appManifestFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return AppManifest{}, err
+	}
+	return ParseAppManifest(data)
+}
+
+// ParseAppManifest returns the app written in the given YAML or JSON data.
+func ParseAppManifest(data []byte) (AppManifest, error) {
+	js, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return AppManifest{}, err
+	}
+	raw, err := decodeObject(js)
+	if err != nil {
+		return AppManifest{}, err
+	}
+	m := AppManifest{raw: raw}
+	if err := m.validate(); err != nil {
+		return AppManifest{}, err
+	}
+	return m, nil
+}
+
+func (m AppManifest) validate() error {
+	if m.Name() == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if stringField(m.raw, "location") == "" {
+		return fmt.Errorf("location is missing")
+	}
+	if len(m.containers()) == 0 {
+		return fmt.Errorf("properties.template.containers is missing")
+	}
+	switch mode := m.activeRevisionsMode(); mode {
+	case "", ActiveRevisionsModeSingle, ActiveRevisionsModeMultiple:
+	default:
+		return fmt.Errorf("properties.configuration.activeRevisionsMode must be %s or %s", ActiveRevisionsModeSingle, ActiveRevisionsModeMultiple)
+	}
+	return nil
+}
+
+// MarshalJSON returns the request body to create or update the app.
+func (m AppManifest) MarshalJSON() ([]byte, error) {
+	return json.Marshal(m.raw)
+}
+
+// Name returns the name of the app.
+func (m AppManifest) Name() string {
+	return stringField(m.raw, "name")
+}
+
+// MultipleRevisions reports whether multiple revisions of the app can be active at the same time.
+// It is required to split the traffic between the revisions.
+func (m AppManifest) MultipleRevisions() bool {
+	return m.activeRevisionsMode() == ActiveRevisionsModeMultiple
+}
+
+// HasIngress reports whether the app receives the traffic through its ingress.
+func (m AppManifest) HasIngress() bool {
+	return objectField(m.raw, "properties", "configuration", "ingress") != nil
+}
+
+func (m AppManifest) activeRevisionsMode() string {
+	return stringField(objectField(m.raw, "properties", "configuration"), "activeRevisionsMode")
+}
+
+// SetRevisionSuffix sets the suffix of the revision created by applying the manifest.
+func (m AppManifest) SetRevisionSuffix(suffix string) {
+	ensureObjectField(m.raw, "properties", "template")["revisionSuffix"] = suffix
+}
+
+// AddTags adds the given tags to the app.
+func (m AppManifest) AddTags(tags map[string]string) {
+	t := ensureObjectField(m.raw, "tags")
+	for k, v := range tags {
+		t[k] = v
+	}
+}
+
+// SetTraffic sets the traffic weights of the ingress of the app.
+// It does nothing when the app has no ingress.
+func (m AppManifest) SetTraffic(traffic []TrafficWeight) {
+	if !m.HasIngress() {
+		return
+	}
+	items := make([]interface{}, 0, len(traffic))
+	for _, t := range traffic {
+		items = append(items, t.object())
+	}
+	ensureObjectField(m.raw, "properties", "configuration", "ingress")["traffic"] = items
+}
+
+// Images returns the images of all containers of the app.
+func (m AppManifest) Images() []string {
+	var images []string
+	for _, c := range m.containers() {
+		if image := stringField(c, "image"); image != "" {
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+func (m AppManifest) containers() []map[string]interface{} {
+	items, _ := objectField(m.raw, "properties", "template")["containers"].([]interface{})
+	containers := make([]map[string]interface{}, 0, len(items))
+	for _, item := range items {
+		if c, ok := item.(map[string]interface{}); ok {
+			containers = append(containers, c)
+		}
+	}
+	return containers
+}
+
+// FindArtifactVersions returns the versions of the container images used by the app.
+func FindArtifactVersions(m AppManifest) ([]*model.ArtifactVersion, error) {
+	images := m.Images()
+	versions := make([]*model.ArtifactVersion, 0, len(images))
+	for _, image := range images {
+		name, tag := parseContainerImage(image)
+		if name == "" {
+			return nil, fmt.Errorf("image name could not be empty")
+		}
+		versions = append(versions, &model.ArtifactVersion{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    name,
+			Url:     image,
+		})
+	}
+	return versions, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	// The image can be pinned by its digest instead of its tag.
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	name = paths[len(paths)-1]
+	return
+}
+
+func decodeObject(data []byte) (map[string]interface{}, error) {
+	var raw map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	// Keep the numbers as is to compare them with the live ones without losing the precision.
+	d.UseNumber()
+	if err := d.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("empty object")
+	}
+	return raw, nil
+}
+
+// objectField returns the object nested under the given keys or nil if it does not exist.
+func objectField(m map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, k := range keys {
+		next, _ := m[k].(map[string]interface{})
+		if next == nil {
+			return nil
+		}
+		m = next
+	}
+	return m
+}
+
+// ensureObjectField returns the object nested under the given keys by creating the missing ones.
+func ensureObjectField(m map[string]interface{}, keys ...string) map[string]interface{} {
+	for _, k := range keys {
+		next, _ := m[k].(map[string]interface{})
+		if next == nil {
+			next = make(map[string]interface{})
+			m[k] = next
+		}
+		m = next
+	}
+	return m
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
@@ -0,0 +1,172 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const testAppManifest = `
+name: web
+location: japaneast
+properties:
+  managedEnvironmentId: /subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/managedEnvironments/env
+  configuration:
+    activeRevisionsMode: Multiple
+    ingress:
+      external: true
+      targetPort: 8080
+  template:
+    containers:
+      - name: web
+        image: myacr.azurecr.io/web:v1.0.0
+        resources:
+          cpu: 0.5
+          memory: 1Gi
+      - name: sidecar
+        image: envoyproxy/envoy@sha256:abc
+`
+
+func TestParseAppManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name          string
+		data          string
+		expectedError bool
+	}{
+		{
+			name: "valid",
+			data: testAppManifest,
+		},
+		{
+			name:          "missing name",
+			data:          "location: japaneast\nproperties:\n  template:\n    containers:\n      - image: web\n",
+			expectedError: true,
+		},
+		{
+			name:          "missing location",
+			data:          "name: web\nproperties:\n  template:\n    containers:\n      - image: web\n",
+			expectedError: true,
+		},
+		{
+			name:          "missing containers",
+			data:          "name: web\nlocation: japaneast\n",
+			expectedError: true,
+		},
+		{
+			name:          "invalid active revisions mode",
+			data:          "name: web\nlocation: japaneast\nproperties:\n  configuration:\n    activeRevisionsMode: multiple\n  template:\n    containers:\n      - image: web\n",
+			expectedError: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := ParseAppManifest([]byte(tc.data))
+			assert.Equal(t, tc.expectedError, err != nil)
+		})
+	}
+}
+
+func TestAppManifest(t *testing.T) {
+	t.Parallel()
+
+	m, err := ParseAppManifest([]byte(testAppManifest))
+	require.NoError(t, err)
+
+	assert.Equal(t, "web", m.Name())
+	assert.True(t, m.MultipleRevisions())
+	assert.True(t, m.HasIngress())
+	assert.Equal(t, []string{"myacr.azurecr.io/web:v1.0.0", "envoyproxy/envoy@sha256:abc"}, m.Images())
+
+	m.SetRevisionSuffix("rabcdef1-12345678")
+	m.AddTags(map[string]string{LabelManagedBy: ManagedByPiped})
+	m.SetTraffic([]TrafficWeight{
+		{RevisionName: "web--v1", Weight: 80},
+		{LatestRevision: true, Weight: 20},
+	})
+
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+
+	var out struct {
+		Tags       map[string]string `json:"tags"`
+		Properties struct {
+			Configuration struct {
+				Ingress struct {
+					TargetPort int             `json:"targetPort"`
+					Traffic    []TrafficWeight `json:"traffic"`
+				} `json:"ingress"`
+			} `json:"configuration"`
+			Template struct {
+				RevisionSuffix string `json:"revisionSuffix"`
+				Containers     []struct {
+					Resources struct {
+						CPU json.Number `json:"cpu"`
+					} `json:"resources"`
+				} `json:"containers"`
+			} `json:"template"`
+		} `json:"properties"`
+	}
+	require.NoError(t, json.Unmarshal(data, &out))
+	assert.Equal(t, map[string]string{LabelManagedBy: ManagedByPiped}, out.Tags)
+	assert.Equal(t, 8080, out.Properties.Configuration.Ingress.TargetPort)
+	assert.Equal(t, []TrafficWeight{
+		{RevisionName: "web--v1", Weight: 80},
+		{LatestRevision: true, Weight: 20},
+	}, out.Properties.Configuration.Ingress.Traffic)
+	assert.Equal(t, "rabcdef1-12345678", out.Properties.Template.RevisionSuffix)
+	assert.Equal(t, json.Number("0.5"), out.Properties.Template.Containers[0].Resources.CPU)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	m, err := ParseAppManifest([]byte(testAppManifest))
+	require.NoError(t, err)
+
+	versions, err := FindArtifactVersions(m)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "v1.0.0",
+			Name:    "web",
+			Url:     "myacr.azurecr.io/web:v1.0.0",
+		},
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "sha256:abc",
+			Name:    "envoy",
+			Url:     "envoyproxy/envoy@sha256:abc",
+		},
+	}, versions)
+}
+
+func TestMakeRevisionSuffix(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "rabcdef1-d2e3f4a5", MakeRevisionSuffix("ABCDEF1234567890", "D2E3F4A5-1234"))
+	assert.Equal(t, "rabc-d1", MakeRevisionSuffix("abc", "d1"))
+	assert.Equal(t, "web--rabc-d1", RevisionName("web", "rabc-d1"))
+}
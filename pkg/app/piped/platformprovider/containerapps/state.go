@@ -0,0 +1,120 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	appKind      = "App"
+	revisionKind = "Revision"
+
+	appRunningStatusStopped = "Stopped"
+)
+
+// MakeResourceStates returns the states of the given app and its revisions.
+// Only the revisions which are active or receiving the traffic are included.
+func MakeResourceStates(app *App, revisions []*Revision, updatedAt time.Time) []*model.ContainerAppsResourceState {
+	states := make([]*model.ContainerAppsResourceState, 0, len(revisions)+1)
+
+	// Set app state.
+	status, desc := appHealthStatus(app)
+	states = append(states, makeResourceState(
+		app.ID,
+		nil,
+		app.Name,
+		appKind,
+		status,
+		desc,
+		time.Time{},
+		updatedAt,
+	))
+
+	// Set revision states.
+	for _, r := range revisions {
+		if !r.Properties.Active && r.Properties.TrafficWeight == 0 {
+			continue
+		}
+		status, desc := revisionHealthStatus(r)
+		states = append(states, makeResourceState(
+			r.ID,
+			[]string{app.ID},
+			r.Name,
+			revisionKind,
+			status,
+			desc,
+			r.Properties.CreatedTime,
+			updatedAt,
+		))
+	}
+
+	return states
+}
+
+func makeResourceState(id string, parentIDs []string, name, kind string, status model.ContainerAppsResourceState_HealthStatus, desc string, createdAt, updatedAt time.Time) *model.ContainerAppsResourceState {
+	// The creation time is not given for apps.
+	if createdAt.IsZero() {
+		createdAt = updatedAt
+	}
+
+	return &model.ContainerAppsResourceState{
+		Id:        id,
+		OwnerIds:  parentIDs,
+		ParentIds: parentIDs,
+		Name:      name,
+		Kind:      kind,
+
+		HealthStatus:      status,
+		HealthDescription: desc,
+
+		CreatedAt: createdAt.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}
+}
+
+func appHealthStatus(app *App) (model.ContainerAppsResourceState_HealthStatus, string) {
+	p := app.Properties
+	desc := fmt.Sprintf("App is %s", p.ProvisioningState)
+	if p.RunningStatus != "" {
+		desc = fmt.Sprintf("%s and %s", desc, p.RunningStatus)
+	}
+	if p.ProvisioningState != AppProvisioningStateSucceeded || p.RunningStatus == appRunningStatusStopped {
+		return model.ContainerAppsResourceState_OTHER, desc
+	}
+	return model.ContainerAppsResourceState_HEALTHY, desc
+}
+
+func revisionHealthStatus(r *Revision) (model.ContainerAppsResourceState_HealthStatus, string) {
+	p := r.Properties
+	desc := fmt.Sprintf("Revision is %s with %d replicas receiving %d%% of traffic", p.ProvisioningState, p.Replicas, p.TrafficWeight)
+	if !p.Active {
+		desc += " while inactive"
+	}
+	if p.ProvisioningState != RevisionProvisioningStateProvisioned {
+		if p.ProvisioningError != "" {
+			desc = fmt.Sprintf("%s: %s", desc, p.ProvisioningError)
+		}
+		return model.ContainerAppsResourceState_OTHER, desc
+	}
+	// The health state is None when the revision has been scaled to zero.
+	if p.HealthState == RevisionHealthStateUnhealthy {
+		return model.ContainerAppsResourceState_OTHER, desc + " but unhealthy"
+	}
+	return model.ContainerAppsResourceState_HEALTHY, desc
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package containerapps
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeResourceStates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Unix(1700000000, 0)
+		created = time.Unix(1690000000, 0)
+		appID   = "/subscriptions/sub/resourceGroups/rg/providers/Microsoft.App/containerApps/web"
+		app     = &App{
+			ID:   appID,
+			Name: "web",
+			Properties: AppProperties{
+				ProvisioningState: AppProvisioningStateSucceeded,
+				RunningStatus:     "Running",
+			},
+		}
+		revisions = []*Revision{
+			{
+				ID:   appID + "/revisions/web--r1",
+				Name: "web--r1",
+				Properties: RevisionProperties{
+					CreatedTime:       created,
+					Active:            true,
+					Replicas:          2,
+					TrafficWeight:     90,
+					HealthState:       RevisionHealthStateHealthy,
+					ProvisioningState: RevisionProvisioningStateProvisioned,
+				},
+			},
+			{
+				ID:   appID + "/revisions/web--r2",
+				Name: "web--r2",
+				Properties: RevisionProperties{
+					CreatedTime:       created,
+					Active:            true,
+					Replicas:          1,
+					TrafficWeight:     10,
+					HealthState:       RevisionHealthStateUnhealthy,
+					ProvisioningState: RevisionProvisioningStateProvisioned,
+				},
+			},
+			{
+				ID:   appID + "/revisions/web--r0",
+				Name: "web--r0",
+				Properties: RevisionProperties{
+					CreatedTime:       created,
+					ProvisioningState: RevisionProvisioningStateProvisioned,
+				},
+			},
+		}
+	)
+
+	states := MakeResourceStates(app, revisions, now)
+	assert.Equal(t, []*model.ContainerAppsResourceState{
+		{
+			Id:                appID,
+			Name:              "web",
+			Kind:              "App",
+			HealthStatus:      model.ContainerAppsResourceState_HEALTHY,
+			HealthDescription: "App is Succeeded and Running",
+			CreatedAt:         now.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                appID + "/revisions/web--r1",
+			OwnerIds:          []string{appID},
+			ParentIds:         []string{appID},
+			Name:              "web--r1",
+			Kind:              "Revision",
+			HealthStatus:      model.ContainerAppsResourceState_HEALTHY,
+			HealthDescription: "Revision is Provisioned with 2 replicas receiving 90% of traffic",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                appID + "/revisions/web--r2",
+			OwnerIds:          []string{appID},
+			ParentIds:         []string{appID},
+			Name:              "web--r2",
+			Kind:              "Revision",
+			HealthStatus:      model.ContainerAppsResourceState_OTHER,
+			HealthDescription: "Revision is Provisioned with 1 replicas receiving 10% of traffic but unhealthy",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+	}, states)
+}
@@ -0,0 +1,128 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// The characters not allowed in the compose project names.
+var invalidProjectNameChars = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// Compose is a wrapper of docker-compose applying a compose file to the Docker hosts.
+type Compose struct {
+	execPath    string
+	projectDir  string
+	composeFile string
+	project     string
+}
+
+// NewCompose returns a Compose applying the given compose file placed in the project directory
+// as the given compose project.
+func NewCompose(execPath, projectDir, composeFile, project string) *Compose {
+	return &Compose{
+		execPath:    execPath,
+		projectDir:  projectDir,
+		composeFile: composeFile,
+		project:     project,
+	}
+}
+
+// Validate checks the compose file without connecting to any host.
+func (c *Compose) Validate(ctx context.Context) error {
+	args := append(c.makeCommonArgs(), "config", "--quiet")
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = c.projectDir
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("invalid compose file: %s (%w)", strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
+
+// Up creates or updates the services on the given host
+// and waits until all of them become running and healthy.
+// The containers of the services removed from the compose file are also removed.
+func (c *Compose) Up(ctx context.Context, w io.Writer, host config.DockerComposeHost, waitTimeout time.Duration) error {
+	args := append(c.makeCommonArgs(),
+		"up",
+		"--detach",
+		"--remove-orphans",
+		"--wait",
+		"--wait-timeout",
+		strconv.Itoa(int(waitTimeout.Seconds())),
+	)
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = c.projectDir
+	cmd.Env = append(os.Environ(), HostEnvs(host)...)
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	io.WriteString(w, fmt.Sprintf("docker-compose %s\n", strings.Join(args, " ")))
+	return cmd.Run()
+}
+
+func (c *Compose) makeCommonArgs() []string {
+	return []string{
+		"--project-name", c.project,
+		"--project-directory", c.projectDir,
+		"--file", c.composeFile,
+		"--ansi", "never",
+	}
+}
+
+// HostEnvs returns the environment variables making docker-compose connect to the given host.
+func HostEnvs(host config.DockerComposeHost) []string {
+	envs := []string{"DOCKER_HOST=" + host.Address}
+	if host.CertPath != "" {
+		envs = append(envs, "DOCKER_CERT_PATH="+host.CertPath, "DOCKER_TLS_VERIFY=1")
+	}
+	return envs
+}
+
+// ProjectName converts the given name into a valid compose project name,
+// which consists of lowercase letters, digits, dashes and underscores.
+func ProjectName(name string) string {
+	name = invalidProjectNameChars.ReplaceAllString(strings.ToLower(name), "-")
+	return strings.TrimLeft(name, "-_")
+}
+
+// FindHosts returns the hosts having the given names in order.
+// All hosts of the platform provider are returned when no name is given.
+func FindHosts(cfg *config.PlatformProviderDockerComposeConfig, names []string) ([]config.DockerComposeHost, error) {
+	if len(names) == 0 {
+		return cfg.Hosts, nil
+	}
+	hosts := make([]config.DockerComposeHost, 0, len(names))
+	for _, name := range names {
+		h, ok := cfg.FindHost(name)
+		if !ok {
+			return nil, fmt.Errorf("host %s was not found in the platform provider", name)
+		}
+		hosts = append(hosts, h)
+	}
+	return hosts, nil
+}
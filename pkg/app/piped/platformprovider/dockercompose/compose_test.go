@@ -0,0 +1,90 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestComposeUp(t *testing.T) {
+	t.Parallel()
+
+	// The fake docker-compose prints the given arguments and the host to connect.
+	dir := t.TempDir()
+	execPath := filepath.Join(dir, "docker-compose")
+	script := "#!/bin/sh\necho \"args: $*\"\necho \"host: $DOCKER_HOST $DOCKER_CERT_PATH $DOCKER_TLS_VERIFY\"\n"
+	require.NoError(t, os.WriteFile(execPath, []byte(script), 0o755))
+
+	c := NewCompose(execPath, dir, "docker-compose.yaml", "web")
+	host := config.DockerComposeHost{
+		Name:     "edge-01",
+		Address:  "tcp://edge-01:2376",
+		CertPath: "/etc/piped/certs/edge-01",
+	}
+
+	var buf bytes.Buffer
+	err := c.Up(context.Background(), &buf, host, 2*time.Minute)
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "args: --project-name web --project-directory "+dir+" --file docker-compose.yaml --ansi never up --detach --remove-orphans --wait --wait-timeout 120\n")
+	assert.Contains(t, buf.String(), "host: tcp://edge-01:2376 /etc/piped/certs/edge-01 1\n")
+}
+
+func TestProjectName(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		expected string
+	}{
+		{name: "web", expected: "web"},
+		{name: "Edge Web.v2", expected: "edge-web-v2"},
+		{name: "_web_api", expected: "web_api"},
+	}
+	for _, tc := range testcases {
+		assert.Equal(t, tc.expected, ProjectName(tc.name))
+	}
+}
+
+func TestFindHosts(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.PlatformProviderDockerComposeConfig{
+		Hosts: []config.DockerComposeHost{
+			{Name: "edge-01", Address: "ssh://deploy@edge-01"},
+			{Name: "edge-02", Address: "ssh://deploy@edge-02"},
+		},
+	}
+
+	hosts, err := FindHosts(cfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Hosts, hosts)
+
+	hosts, err = FindHosts(cfg, []string{"edge-02", "edge-01"})
+	require.NoError(t, err)
+	assert.Equal(t, []config.DockerComposeHost{cfg.Hosts[1], cfg.Hosts[0]}, hosts)
+
+	_, err = FindHosts(cfg, []string{"edge-03"})
+	assert.Error(t, err)
+}
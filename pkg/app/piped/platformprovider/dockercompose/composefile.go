@@ -0,0 +1,131 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// ComposeFile represents the services defined in a compose file.
+// Only the fields used by piped are decoded, the file itself is applied by docker-compose.
+type ComposeFile struct {
+	Services map[string]Service `json:"services"`
+
+	// The whole content of the file used to compare it.
+	object map[string]interface{}
+}
+
+type Service struct {
+	// The container image of the service.
+	// It is empty when the image is built from the source.
+	Image string `json:"image,omitempty"`
+}
+
+// LoadComposeFile returns ComposeFile object from a given compose file.
+func LoadComposeFile(appDir, composeFilename string) (ComposeFile, error) {
+	path := filepath.Join(appDir, composeFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ComposeFile{}, err
+	}
+	return parseComposeFile(data)
+}
+
+func parseComposeFile(data []byte) (ComposeFile, error) {
+	var f ComposeFile
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return ComposeFile{}, err
+	}
+	if len(f.Services) == 0 {
+		return ComposeFile{}, fmt.Errorf("no service was defined in the compose file")
+	}
+	if err := yaml.Unmarshal(data, &f.object); err != nil {
+		return ComposeFile{}, err
+	}
+	return f, nil
+}
+
+// FindArtifactVersions returns the container images of the services.
+// The services built from the source are ignored.
+func FindArtifactVersions(f ComposeFile) ([]*model.ArtifactVersion, error) {
+	names := make([]string, 0, len(f.Services))
+	for name := range f.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var (
+		versions = make([]*model.ArtifactVersion, 0, len(names))
+		images   = make(map[string]struct{}, len(names))
+	)
+	for _, name := range names {
+		image := f.Services[name].Image
+		if image == "" {
+			continue
+		}
+		if _, ok := images[image]; ok {
+			continue
+		}
+		images[image] = struct{}{}
+
+		imageName, tag := parseContainerImage(image)
+		if imageName == "" {
+			return nil, fmt.Errorf("image name of service %s could not be empty", name)
+		}
+		versions = append(versions, &model.ArtifactVersion{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    imageName,
+			Url:     image,
+		})
+	}
+	return versions, nil
+}
+
+// DiffComposeFiles calculates the diff between the two given compose files.
+func DiffComposeFiles(old, new ComposeFile) (*diff.Result, error) {
+	return diff.DiffUnstructureds(
+		unstructured.Unstructured{Object: old.object},
+		unstructured.Unstructured{Object: new.object},
+		"compose",
+		diff.WithEquateEmpty(),
+	)
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	// The image can be pinned by its digest instead of its tag.
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	name = paths[len(paths)-1]
+	if tag == "" {
+		tag = "latest"
+	}
+	return
+}
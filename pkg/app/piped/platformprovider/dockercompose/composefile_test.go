@@ -0,0 +1,103 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dockercompose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseComposeFile(t *testing.T) {
+	t.Parallel()
+
+	f, err := parseComposeFile([]byte(`
+services:
+  web:
+    image: ghcr.io/example/web:v1.2.0
+    ports:
+      - "80:8080"
+  worker:
+    build: ./worker
+`))
+	require.NoError(t, err)
+	assert.Equal(t, map[string]Service{
+		"web":    {Image: "ghcr.io/example/web:v1.2.0"},
+		"worker": {},
+	}, f.Services)
+
+	_, err = parseComposeFile([]byte(`
+volumes:
+  data: {}
+`))
+	assert.Error(t, err)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	f := ComposeFile{
+		Services: map[string]Service{
+			"web":     {Image: "ghcr.io/example/web:v1.2.0"},
+			"web-ssr": {Image: "ghcr.io/example/web:v1.2.0"},
+			"redis":   {Image: "redis"},
+			"worker":  {},
+		},
+	}
+	versions, err := FindArtifactVersions(f)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "latest",
+			Name:    "redis",
+			Url:     "redis",
+		},
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "v1.2.0",
+			Name:    "web",
+			Url:     "ghcr.io/example/web:v1.2.0",
+		},
+	}, versions)
+}
+
+func TestDiffComposeFiles(t *testing.T) {
+	t.Parallel()
+
+	old, err := parseComposeFile([]byte(`
+services:
+  web:
+    image: ghcr.io/example/web:v1.2.0
+`))
+	require.NoError(t, err)
+	new, err := parseComposeFile([]byte(`
+services:
+  web:
+    image: ghcr.io/example/web:v1.3.0
+`))
+	require.NoError(t, err)
+
+	result, err := DiffComposeFiles(old, old)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	result, err = DiffComposeFiles(old, new)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.NumNodes())
+}
@@ -0,0 +1,510 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	astypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/smithy-go"
+	"go.uber.org/zap"
+)
+
+const (
+	// The lifecycle state and the health status of the instances serving the traffic.
+	InstanceLifecycleStateInService = "InService"
+	InstanceHealthStatusHealthy     = "Healthy"
+
+	// The statuses of an instance refresh.
+	InstanceRefreshStatusSuccessful = "Successful"
+	InstanceRefreshStatusFailed     = "Failed"
+	InstanceRefreshStatusCancelled  = "Cancelled"
+)
+
+// AutoScalingGroup represents the current state of an auto scaling group.
+type AutoScalingGroup struct {
+	AutoScalingGroupName string
+	AutoScalingGroupARN  string
+	LaunchTemplate       LaunchTemplateSpecification
+	MinSize              int
+	MaxSize              int
+	DesiredCapacity      int
+	TargetGroupARNs      []string
+	Instances            []Instance
+	// Set only while the group is being deleted.
+	Status string
+}
+
+type LaunchTemplateSpecification struct {
+	LaunchTemplateID   string
+	LaunchTemplateName string
+	Version            string
+}
+
+type Instance struct {
+	InstanceID     string
+	LifecycleState string
+	HealthStatus   string
+	LaunchTemplate LaunchTemplateSpecification
+}
+
+// ReadyInstances returns the number of the healthy in-service instances launched from the current launch template version of the group.
+func (g *AutoScalingGroup) ReadyInstances() int {
+	n := 0
+	for _, i := range g.Instances {
+		if i.LifecycleState != InstanceLifecycleStateInService || i.HealthStatus != InstanceHealthStatusHealthy {
+			continue
+		}
+		if i.LaunchTemplate.Version != g.LaunchTemplate.Version {
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// HasTargetGroup reports whether the group is attached to the given target group.
+func (g *AutoScalingGroup) HasTargetGroup(arn string) bool {
+	for _, tg := range g.TargetGroupARNs {
+		if tg == arn {
+			return true
+		}
+	}
+	return false
+}
+
+// InstanceRefresh represents the progress of an instance refresh.
+type InstanceRefresh struct {
+	InstanceRefreshID  string
+	Status             string
+	StatusReason       string
+	PercentageComplete int
+}
+
+// Done reports whether the instance refresh has been finished regardless of its result.
+func (r *InstanceRefresh) Done() bool {
+	switch r.Status {
+	case InstanceRefreshStatusSuccessful, InstanceRefreshStatusFailed, InstanceRefreshStatusCancelled:
+		return true
+	}
+	// The instance refresh can also be rolled back by Auto Scaling, which ends with a Rollback prefixed status.
+	return strings.HasPrefix(r.Status, "Rollback") && r.Status != "RollbackInProgress"
+}
+
+// GroupInput represents the values to create or update an auto scaling group.
+type GroupInput struct {
+	Name                   string
+	LaunchTemplateName     string
+	LaunchTemplateVersion  string
+	MinSize                int
+	MaxSize                int
+	DesiredCapacity        *int
+	Subnets                []string
+	TargetGroupArns        []string
+	HealthCheckType        string
+	HealthCheckGracePeriod int
+	Tags                   map[string]string
+}
+
+func (in GroupInput) createInput() *autoscaling.CreateAutoScalingGroupInput {
+	var tags []astypes.Tag
+	for _, k := range sortedKeys(in.Tags) {
+		tags = append(tags, astypes.Tag{
+			Key:               aws.String(k),
+			Value:             aws.String(in.Tags[k]),
+			PropagateAtLaunch: aws.Bool(true),
+		})
+	}
+	return &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(in.Name),
+		LaunchTemplate: &astypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(in.LaunchTemplateName),
+			Version:            aws.String(in.LaunchTemplateVersion),
+		},
+		MinSize:                aws.Int32(int32(in.MinSize)),
+		MaxSize:                aws.Int32(int32(in.MaxSize)),
+		DesiredCapacity:        optionalInt32(in.DesiredCapacity),
+		VPCZoneIdentifier:      aws.String(strings.Join(in.Subnets, ",")),
+		HealthCheckType:        optionalString(in.HealthCheckType),
+		HealthCheckGracePeriod: optionalGracePeriod(in.HealthCheckGracePeriod),
+		TargetGroupARNs:        in.TargetGroupArns,
+		Tags:                   tags,
+	}
+}
+
+// updateInput returns the input to update the group.
+// The target groups and the tags are not updated by UpdateAutoScalingGroup.
+func (in GroupInput) updateInput() *autoscaling.UpdateAutoScalingGroupInput {
+	return &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(in.Name),
+		LaunchTemplate: &astypes.LaunchTemplateSpecification{
+			LaunchTemplateName: aws.String(in.LaunchTemplateName),
+			Version:            aws.String(in.LaunchTemplateVersion),
+		},
+		MinSize:                aws.Int32(int32(in.MinSize)),
+		MaxSize:                aws.Int32(int32(in.MaxSize)),
+		DesiredCapacity:        optionalInt32(in.DesiredCapacity),
+		VPCZoneIdentifier:      aws.String(strings.Join(in.Subnets, ",")),
+		HealthCheckType:        optionalString(in.HealthCheckType),
+		HealthCheckGracePeriod: optionalGracePeriod(in.HealthCheckGracePeriod),
+	}
+}
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	return aws.Int32(int32(*v))
+}
+
+func optionalString(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return aws.String(v)
+}
+
+func optionalGracePeriod(seconds int) *int32 {
+	if seconds <= 0 {
+		return nil
+	}
+	return aws.Int32(int32(seconds))
+}
+
+// TargetGroupWeight is the weight of the traffic forwarded to a target group.
+type TargetGroupWeight struct {
+	TargetGroupArn string
+	Weight         int
+}
+
+type client struct {
+	ec2Client         *ec2.Client
+	autoScalingClient *autoscaling.Client
+	elbClient         *elasticloadbalancingv2.Client
+	logger            *zap.Logger
+}
+
+func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required field")
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if credentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(profile))
+	}
+	if tokenPath != "" && roleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(tokenPath)
+		}))
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create ec2asg client: %w", err)
+	}
+
+	return &client{
+		ec2Client:         ec2.NewFromConfig(cfg),
+		autoScalingClient: autoscaling.NewFromConfig(cfg),
+		elbClient:         elasticloadbalancingv2.NewFromConfig(cfg),
+		logger:            logger.Named("ec2asg"),
+	}, nil
+}
+
+func (c *client) CreateLaunchTemplateVersion(ctx context.Context, lt LaunchTemplate, description string) (string, error) {
+	out, err := c.ec2Client.CreateLaunchTemplateVersion(ctx, &ec2.CreateLaunchTemplateVersionInput{
+		LaunchTemplateName: aws.String(lt.LaunchTemplateName),
+		SourceVersion:      aws.String("$Latest"),
+		VersionDescription: aws.String(description),
+		LaunchTemplateData: &ec2types.RequestLaunchTemplateData{
+			ImageId:      aws.String(lt.ImageID),
+			InstanceType: ec2types.InstanceType(lt.InstanceType),
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create a version of launch template %s: %w", lt.LaunchTemplateName, err)
+	}
+	if out.LaunchTemplateVersion == nil {
+		return "", fmt.Errorf("no version was returned for launch template %s", lt.LaunchTemplateName)
+	}
+	return strconv.FormatInt(aws.ToInt64(out.LaunchTemplateVersion.VersionNumber), 10), nil
+}
+
+func (c *client) DescribeAutoScalingGroup(ctx context.Context, name string) (*AutoScalingGroup, error) {
+	groups, err := c.describeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: []string{name},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe auto scaling group %s: %w", name, err)
+	}
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("failed to describe auto scaling group %s: %w", name, ErrNotFound)
+	}
+	return &groups[0], nil
+}
+
+func (c *client) ListAutoScalingGroups(ctx context.Context, tagKey, tagValue string) ([]AutoScalingGroup, error) {
+	groups, err := c.describeAutoScalingGroups(ctx, &autoscaling.DescribeAutoScalingGroupsInput{
+		Filters: []astypes.Filter{
+			{Name: aws.String("tag:" + tagKey), Values: []string{tagValue}},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list auto scaling groups: %w", err)
+	}
+	return groups, nil
+}
+
+func (c *client) describeAutoScalingGroups(ctx context.Context, in *autoscaling.DescribeAutoScalingGroupsInput) ([]AutoScalingGroup, error) {
+	var groups []AutoScalingGroup
+	for {
+		out, err := c.autoScalingClient.DescribeAutoScalingGroups(ctx, in)
+		if err != nil {
+			return nil, err
+		}
+		for _, g := range out.AutoScalingGroups {
+			groups = append(groups, makeAutoScalingGroup(g))
+		}
+		if out.NextToken == nil {
+			return groups, nil
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func (c *client) CreateAutoScalingGroup(ctx context.Context, in GroupInput) error {
+	if _, err := c.autoScalingClient.CreateAutoScalingGroup(ctx, in.createInput()); err != nil {
+		return fmt.Errorf("failed to create auto scaling group %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+func (c *client) UpdateAutoScalingGroup(ctx context.Context, in GroupInput) error {
+	if _, err := c.autoScalingClient.UpdateAutoScalingGroup(ctx, in.updateInput()); err != nil {
+		return fmt.Errorf("failed to update auto scaling group %s: %w", in.Name, err)
+	}
+	return nil
+}
+
+func (c *client) DeleteAutoScalingGroup(ctx context.Context, name string) error {
+	// The instances of the group are terminated together.
+	_, err := c.autoScalingClient.DeleteAutoScalingGroup(ctx, &autoscaling.DeleteAutoScalingGroupInput{
+		AutoScalingGroupName: aws.String(name),
+		ForceDelete:          aws.Bool(true),
+	})
+	if err != nil {
+		if isGroupNotFound(err) {
+			err = ErrNotFound
+		}
+		return fmt.Errorf("failed to delete auto scaling group %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c *client) TagAutoScalingGroup(ctx context.Context, name string, tags map[string]string) error {
+	in := &autoscaling.CreateOrUpdateTagsInput{
+		Tags: make([]astypes.Tag, 0, len(tags)),
+	}
+	for _, k := range sortedKeys(tags) {
+		in.Tags = append(in.Tags, astypes.Tag{
+			ResourceId:        aws.String(name),
+			ResourceType:      aws.String("auto-scaling-group"),
+			Key:               aws.String(k),
+			Value:             aws.String(tags[k]),
+			PropagateAtLaunch: aws.Bool(true),
+		})
+	}
+	if _, err := c.autoScalingClient.CreateOrUpdateTags(ctx, in); err != nil {
+		return fmt.Errorf("failed to tag auto scaling group %s: %w", name, err)
+	}
+	return nil
+}
+
+func (c *client) StartInstanceRefresh(ctx context.Context, name string, minHealthyPercentage int, instanceWarmup time.Duration) (string, error) {
+	in := &autoscaling.StartInstanceRefreshInput{
+		AutoScalingGroupName: aws.String(name),
+		Strategy:             astypes.RefreshStrategyRolling,
+		Preferences: &astypes.RefreshPreferences{
+			MinHealthyPercentage: aws.Int32(int32(minHealthyPercentage)),
+		},
+	}
+	if instanceWarmup > 0 {
+		in.Preferences.InstanceWarmup = aws.Int32(int32(instanceWarmup.Seconds()))
+	}
+	out, err := c.autoScalingClient.StartInstanceRefresh(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("failed to start instance refresh of auto scaling group %s: %w", name, err)
+	}
+	return aws.ToString(out.InstanceRefreshId), nil
+}
+
+func (c *client) DescribeInstanceRefresh(ctx context.Context, name, instanceRefreshID string) (*InstanceRefresh, error) {
+	out, err := c.autoScalingClient.DescribeInstanceRefreshes(ctx, &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		InstanceRefreshIds:   []string{instanceRefreshID},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe instance refresh %s of auto scaling group %s: %w", instanceRefreshID, name, err)
+	}
+	if len(out.InstanceRefreshes) == 0 {
+		return nil, fmt.Errorf("instance refresh %s of auto scaling group %s was not found", instanceRefreshID, name)
+	}
+	r := out.InstanceRefreshes[0]
+	return &InstanceRefresh{
+		InstanceRefreshID:  aws.ToString(r.InstanceRefreshId),
+		Status:             string(r.Status),
+		StatusReason:       aws.ToString(r.StatusReason),
+		PercentageComplete: int(aws.ToInt32(r.PercentageComplete)),
+	}, nil
+}
+
+func (c *client) GetTargetGroupWeights(ctx context.Context, listenerArn string) (map[string]int, error) {
+	out, err := c.elbClient.DescribeListeners(ctx, &elasticloadbalancingv2.DescribeListenersInput{
+		ListenerArns: []string{listenerArn},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe listener %s: %w", listenerArn, err)
+	}
+	if len(out.Listeners) == 0 {
+		return nil, fmt.Errorf("listener %s was not found", listenerArn)
+	}
+	return forwardWeights(out.Listeners[0].DefaultActions), nil
+}
+
+func (c *client) ModifyListeners(ctx context.Context, listenerArns []string, weights []TargetGroupWeight) error {
+	for _, listenerArn := range listenerArns {
+		rules, err := c.describeRules(ctx, listenerArn)
+		if err != nil {
+			return err
+		}
+
+		for _, rule := range rules {
+			if rule.IsDefault {
+				actions, _ := modifyForwardActions(rule.Actions, weights, false)
+				_, err = c.elbClient.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
+					ListenerArn:    aws.String(listenerArn),
+					DefaultActions: actions,
+				})
+				if err != nil {
+					return fmt.Errorf("failed to modify listener %s: %w", listenerArn, err)
+				}
+				continue
+			}
+
+			// Modify only the rules forwarding to the target groups
+			// to avoid touching the rules of other applications sharing the same listener.
+			actions, modified := modifyForwardActions(rule.Actions, weights, true)
+			if !modified {
+				continue
+			}
+			_, err = c.elbClient.ModifyRule(ctx, &elasticloadbalancingv2.ModifyRuleInput{
+				RuleArn: rule.RuleArn,
+				Actions: actions,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to modify rule %s of listener %s: %w", aws.ToString(rule.RuleArn), listenerArn, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *client) describeRules(ctx context.Context, listenerArn string) ([]elbtypes.Rule, error) {
+	var (
+		rules  []elbtypes.Rule
+		marker *string
+	)
+	for {
+		out, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+			Marker:      marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe rules of listener %s: %w", listenerArn, err)
+		}
+		rules = append(rules, out.Rules...)
+		if out.NextMarker == nil {
+			return rules, nil
+		}
+		marker = out.NextMarker
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func makeAutoScalingGroup(g astypes.AutoScalingGroup) AutoScalingGroup {
+	out := AutoScalingGroup{
+		AutoScalingGroupName: aws.ToString(g.AutoScalingGroupName),
+		AutoScalingGroupARN:  aws.ToString(g.AutoScalingGroupARN),
+		LaunchTemplate:       makeLaunchTemplateSpecification(g.LaunchTemplate),
+		MinSize:              int(aws.ToInt32(g.MinSize)),
+		MaxSize:              int(aws.ToInt32(g.MaxSize)),
+		DesiredCapacity:      int(aws.ToInt32(g.DesiredCapacity)),
+		TargetGroupARNs:      g.TargetGroupARNs,
+		Status:               aws.ToString(g.Status),
+	}
+	for _, i := range g.Instances {
+		out.Instances = append(out.Instances, Instance{
+			InstanceID:     aws.ToString(i.InstanceId),
+			LifecycleState: string(i.LifecycleState),
+			HealthStatus:   aws.ToString(i.HealthStatus),
+			LaunchTemplate: makeLaunchTemplateSpecification(i.LaunchTemplate),
+		})
+	}
+	return out
+}
+
+func makeLaunchTemplateSpecification(lt *astypes.LaunchTemplateSpecification) LaunchTemplateSpecification {
+	if lt == nil {
+		return LaunchTemplateSpecification{}
+	}
+	return LaunchTemplateSpecification{
+		LaunchTemplateID:   aws.ToString(lt.LaunchTemplateId),
+		LaunchTemplateName: aws.ToString(lt.LaunchTemplateName),
+		Version:            aws.ToString(lt.Version),
+	}
+}
+
+// isGroupNotFound reports whether the given error was returned for a group which does not exist.
+func isGroupNotFound(err error) bool {
+	var e smithy.APIError
+	return errors.As(err, &e) && e.ErrorCode() == "ValidationError" && strings.Contains(e.ErrorMessage(), "not found")
+}
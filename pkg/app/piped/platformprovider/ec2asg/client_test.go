@@ -0,0 +1,265 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &client{
+		ec2Client: ec2.NewFromConfig(cfg, func(o *ec2.Options) {
+			o.EndpointResolver = ec2.EndpointResolverFromURL(ts.URL)
+		}),
+		autoScalingClient: autoscaling.NewFromConfig(cfg, func(o *autoscaling.Options) {
+			o.EndpointResolver = autoscaling.EndpointResolverFromURL(ts.URL)
+		}),
+		logger: zap.NewNop(),
+	}
+}
+
+func TestGroupInputToSDK(t *testing.T) {
+	t.Parallel()
+
+	desired := 2
+	in := GroupInput{
+		Name:                   "web-abcdefgh",
+		LaunchTemplateName:     "web",
+		LaunchTemplateVersion:  "3",
+		MinSize:                1,
+		MaxSize:                4,
+		DesiredCapacity:        &desired,
+		Subnets:                []string{"subnet-a", "subnet-b"},
+		TargetGroupArns:        []string{"arn:tg-green"},
+		HealthCheckType:        "ELB",
+		HealthCheckGracePeriod: 60,
+		Tags:                   map[string]string{"team": "web", "env": "dev"},
+	}
+
+	launchTemplate := &types.LaunchTemplateSpecification{
+		LaunchTemplateName: aws.String("web"),
+		Version:            aws.String("3"),
+	}
+	update := &autoscaling.UpdateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String("web-abcdefgh"),
+		LaunchTemplate:         launchTemplate,
+		MinSize:                aws.Int32(1),
+		MaxSize:                aws.Int32(4),
+		DesiredCapacity:        aws.Int32(2),
+		VPCZoneIdentifier:      aws.String("subnet-a,subnet-b"),
+		HealthCheckType:        aws.String("ELB"),
+		HealthCheckGracePeriod: aws.Int32(60),
+	}
+	assert.Equal(t, update, in.updateInput())
+
+	create := &autoscaling.CreateAutoScalingGroupInput{
+		AutoScalingGroupName:   aws.String("web-abcdefgh"),
+		LaunchTemplate:         launchTemplate,
+		MinSize:                aws.Int32(1),
+		MaxSize:                aws.Int32(4),
+		DesiredCapacity:        aws.Int32(2),
+		VPCZoneIdentifier:      aws.String("subnet-a,subnet-b"),
+		HealthCheckType:        aws.String("ELB"),
+		HealthCheckGracePeriod: aws.Int32(60),
+		TargetGroupARNs:        []string{"arn:tg-green"},
+		Tags: []types.Tag{
+			{Key: aws.String("env"), Value: aws.String("dev"), PropagateAtLaunch: aws.Bool(true)},
+			{Key: aws.String("team"), Value: aws.String("web"), PropagateAtLaunch: aws.Bool(true)},
+		},
+	}
+	assert.Equal(t, create, in.createInput())
+
+	in = GroupInput{Name: "web-abcdefgh", LaunchTemplateName: "web", LaunchTemplateVersion: "3", MinSize: 1, MaxSize: 1}
+	minimum := in.createInput()
+	assert.Nil(t, minimum.DesiredCapacity)
+	assert.Nil(t, minimum.HealthCheckType)
+	assert.Nil(t, minimum.HealthCheckGracePeriod)
+	assert.Nil(t, minimum.Tags)
+}
+
+func TestCreateLaunchTemplateVersion(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "CreateLaunchTemplateVersion", r.PostForm.Get("Action"))
+		assert.Equal(t, "2016-11-15", r.PostForm.Get("Version"))
+		assert.Equal(t, "web", r.PostForm.Get("LaunchTemplateName"))
+		assert.Equal(t, "$Latest", r.PostForm.Get("SourceVersion"))
+		assert.Equal(t, "ami-0123", r.PostForm.Get("LaunchTemplateData.ImageId"))
+		assert.Equal(t, "", r.PostForm.Get("LaunchTemplateData.InstanceType"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/ec2/aws4_request")
+
+		io.WriteString(w, `<CreateLaunchTemplateVersionResponse><launchTemplateVersion><launchTemplateName>web</launchTemplateName><versionNumber>7</versionNumber></launchTemplateVersion></CreateLaunchTemplateVersionResponse>`)
+	})
+
+	version, err := c.CreateLaunchTemplateVersion(context.Background(), LaunchTemplate{LaunchTemplateName: "web", ImageID: "ami-0123"}, "desc")
+	require.NoError(t, err)
+	assert.Equal(t, "7", version)
+}
+
+func TestListAutoScalingGroups(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "DescribeAutoScalingGroups", r.PostForm.Get("Action"))
+		assert.Equal(t, "2011-01-01", r.PostForm.Get("Version"))
+		assert.Equal(t, "tag:pipecd-dev-application", r.PostForm.Get("Filters.member.1.Name"))
+		assert.Equal(t, "app-id", r.PostForm.Get("Filters.member.1.Values.member.1"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/autoscaling/aws4_request")
+
+		if r.PostForm.Get("NextToken") == "" {
+			io.WriteString(w, `<DescribeAutoScalingGroupsResponse><DescribeAutoScalingGroupsResult><AutoScalingGroups><member>
+<AutoScalingGroupName>web-1</AutoScalingGroupName><LaunchTemplate><LaunchTemplateName>web</LaunchTemplateName><Version>3</Version></LaunchTemplate>
+<MinSize>1</MinSize><MaxSize>4</MaxSize><DesiredCapacity>2</DesiredCapacity>
+<TargetGroupARNs><member>arn:tg-blue</member></TargetGroupARNs>
+<Instances>
+<member><InstanceId>i-1</InstanceId><LifecycleState>InService</LifecycleState><HealthStatus>Healthy</HealthStatus><LaunchTemplate><LaunchTemplateName>web</LaunchTemplateName><Version>3</Version></LaunchTemplate></member>
+<member><InstanceId>i-2</InstanceId><LifecycleState>Pending</LifecycleState><HealthStatus>Healthy</HealthStatus><LaunchTemplate><LaunchTemplateName>web</LaunchTemplateName><Version>3</Version></LaunchTemplate></member>
+</Instances>
+</member></AutoScalingGroups><NextToken>next</NextToken></DescribeAutoScalingGroupsResult></DescribeAutoScalingGroupsResponse>`)
+			return
+		}
+		io.WriteString(w, `<DescribeAutoScalingGroupsResponse><DescribeAutoScalingGroupsResult><AutoScalingGroups><member>
+<AutoScalingGroupName>web-2</AutoScalingGroupName><MinSize>1</MinSize><MaxSize>4</MaxSize><DesiredCapacity>1</DesiredCapacity><Status>Delete in progress</Status>
+</member></AutoScalingGroups></DescribeAutoScalingGroupsResult></DescribeAutoScalingGroupsResponse>`)
+	})
+
+	groups, err := c.ListAutoScalingGroups(context.Background(), LabelApplication, "app-id")
+	require.NoError(t, err)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, "web-1", groups[0].AutoScalingGroupName)
+	assert.Equal(t, LaunchTemplateSpecification{LaunchTemplateName: "web", Version: "3"}, groups[0].LaunchTemplate)
+	assert.Equal(t, 2, groups[0].DesiredCapacity)
+	assert.Equal(t, []string{"arn:tg-blue"}, groups[0].TargetGroupARNs)
+	assert.True(t, groups[0].HasTargetGroup("arn:tg-blue"))
+	assert.False(t, groups[0].HasTargetGroup("arn:tg-green"))
+	assert.Equal(t, 1, groups[0].ReadyInstances())
+
+	assert.Equal(t, "web-2", groups[1].AutoScalingGroupName)
+	assert.Equal(t, "Delete in progress", groups[1].Status)
+}
+
+func TestDescribeAutoScalingGroupNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `<DescribeAutoScalingGroupsResponse><DescribeAutoScalingGroupsResult><AutoScalingGroups/></DescribeAutoScalingGroupsResult></DescribeAutoScalingGroupsResponse>`)
+	})
+
+	_, err := c.DescribeAutoScalingGroup(context.Background(), "web")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestDeleteAutoScalingGroupNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "DeleteAutoScalingGroup", r.PostForm.Get("Action"))
+		assert.Equal(t, "true", r.PostForm.Get("ForceDelete"))
+
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `<ErrorResponse><Error><Type>Sender</Type><Code>ValidationError</Code><Message>AutoScalingGroup name not found - web</Message></Error></ErrorResponse>`)
+	})
+
+	err := c.DeleteAutoScalingGroup(context.Background(), "web")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestInstanceRefresh(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		switch r.PostForm.Get("Action") {
+		case "StartInstanceRefresh":
+			assert.Equal(t, "web", r.PostForm.Get("AutoScalingGroupName"))
+			assert.Equal(t, "Rolling", r.PostForm.Get("Strategy"))
+			assert.Equal(t, "90", r.PostForm.Get("Preferences.MinHealthyPercentage"))
+			assert.Equal(t, "120", r.PostForm.Get("Preferences.InstanceWarmup"))
+			io.WriteString(w, `<StartInstanceRefreshResponse><StartInstanceRefreshResult><InstanceRefreshId>refresh-1</InstanceRefreshId></StartInstanceRefreshResult></StartInstanceRefreshResponse>`)
+		case "DescribeInstanceRefreshes":
+			assert.Equal(t, "refresh-1", r.PostForm.Get("InstanceRefreshIds.member.1"))
+			io.WriteString(w, `<DescribeInstanceRefreshesResponse><DescribeInstanceRefreshesResult><InstanceRefreshes><member>
+<InstanceRefreshId>refresh-1</InstanceRefreshId><Status>Successful</Status><PercentageComplete>100</PercentageComplete>
+</member></InstanceRefreshes></DescribeInstanceRefreshesResult></DescribeInstanceRefreshesResponse>`)
+		default:
+			t.Errorf("unexpected action %s", r.PostForm.Get("Action"))
+		}
+	})
+
+	id, err := c.StartInstanceRefresh(context.Background(), "web", 90, 2*time.Minute)
+	require.NoError(t, err)
+	assert.Equal(t, "refresh-1", id)
+
+	refresh, err := WaitInstanceRefresh(context.Background(), c, "web", id, time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, &InstanceRefresh{
+		InstanceRefreshID:  "refresh-1",
+		Status:             InstanceRefreshStatusSuccessful,
+		PercentageComplete: 100,
+	}, refresh)
+}
+
+func TestInstanceRefreshDone(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		status   string
+		expected bool
+	}{
+		{status: "Pending", expected: false},
+		{status: "InProgress", expected: false},
+		{status: "Cancelling", expected: false},
+		{status: "RollbackInProgress", expected: false},
+		{status: "Successful", expected: true},
+		{status: "Failed", expected: true},
+		{status: "Cancelled", expected: true},
+		{status: "RollbackSuccessful", expected: true},
+		{status: "RollbackFailed", expected: true},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.status, func(t *testing.T) {
+			t.Parallel()
+			r := InstanceRefresh{Status: tc.status}
+			assert.Equal(t, tc.expected, r.Done())
+		})
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffAutoScalingGroupManifests(t *testing.T) {
+	t.Parallel()
+
+	old := AutoScalingGroupManifest{
+		Spec: AutoScalingGroupManifestSpec{
+			Name: "web",
+			LaunchTemplate: LaunchTemplate{
+				LaunchTemplateName: "web",
+				ImageID:            "ami-0123456789abcdef0",
+			},
+			MinSize: 1,
+			MaxSize: 4,
+			Subnets: []string{"subnet-a"},
+		},
+	}
+	new := old
+	new.Spec.LaunchTemplate.ImageID = "ami-0fedcba9876543210"
+	new.Spec.MaxSize = 6
+
+	result, err := DiffAutoScalingGroupManifests(old, new)
+	require.NoError(t, err)
+	paths := make([]string, 0, result.NumNodes())
+	for _, n := range result.Nodes() {
+		paths = append(paths, n.PathString)
+	}
+	assert.ElementsMatch(t, []string{"launchTemplate.imageId", "maxSize"}, paths)
+
+	result, err = DiffAutoScalingGroupManifests(old, old)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+
+	// The length of the deployment ID added to the name of the GREEN group.
+	greenGroupSuffixLength = 8
+)
+
+// ErrNotFound is returned when the requested auto scaling group does not exist.
+var ErrNotFound = errors.New("auto scaling group not found")
+
+// Client is wrapper of EC2, Auto Scaling and Elastic Load Balancing APIs.
+type Client interface {
+	// CreateLaunchTemplateVersion creates a new version of the launch template from its latest version
+	// with the image and the instance type of the given one, and returns the number of the created version.
+	CreateLaunchTemplateVersion(ctx context.Context, lt LaunchTemplate, description string) (string, error)
+	// DescribeAutoScalingGroup returns the group having the given name.
+	// ErrNotFound is returned when there is no such group.
+	DescribeAutoScalingGroup(ctx context.Context, name string) (*AutoScalingGroup, error)
+	// ListAutoScalingGroups returns all groups having the given tag.
+	ListAutoScalingGroups(ctx context.Context, tagKey, tagValue string) ([]AutoScalingGroup, error)
+	CreateAutoScalingGroup(ctx context.Context, in GroupInput) error
+	// UpdateAutoScalingGroup updates the launch template, the capacity and the health check of the group.
+	// The tags and the target groups given by the input are not updated.
+	UpdateAutoScalingGroup(ctx context.Context, in GroupInput) error
+	DeleteAutoScalingGroup(ctx context.Context, name string) error
+	TagAutoScalingGroup(ctx context.Context, name string, tags map[string]string) error
+	StartInstanceRefresh(ctx context.Context, name string, minHealthyPercentage int, instanceWarmup time.Duration) (string, error)
+	DescribeInstanceRefresh(ctx context.Context, name, instanceRefreshID string) (*InstanceRefresh, error)
+	// GetTargetGroupWeights returns the weights of the target groups in the default forward action of the listener.
+	GetTargetGroupWeights(ctx context.Context, listenerArn string) (map[string]int, error)
+	// ModifyListeners updates the forward actions of the listeners routing traffic to the given target groups.
+	ModifyListeners(ctx context.Context, listenerArns []string, weights []TargetGroupWeight) error
+}
+
+// Registry holds a pool of aws client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderEC2ASGConfig, logger *zap.Logger) (Client, error)
+}
+
+// GreenGroupName returns the name of the GREEN group launched by the given deployment.
+func GreenGroupName(name, deploymentID string) string {
+	if len(deploymentID) > greenGroupSuffixLength {
+		deploymentID = deploymentID[:greenGroupSuffixLength]
+	}
+	return name + "-" + deploymentID
+}
+
+// LaunchTemplateVersionDescription returns the description of the launch template version created for the given commit.
+func LaunchTemplateVersionDescription(commitHash string) string {
+	return "Deployed by PipeCD at commit " + commitHash
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderEC2ASGConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of aws clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,168 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	versionV1Beta1    = "pipecd.dev/v1beta1"
+	groupManifestKind = "EC2AutoScalingGroup"
+
+	healthCheckTypeEC2 = "EC2"
+	healthCheckTypeELB = "ELB"
+)
+
+type AutoScalingGroupManifest struct {
+	Kind       string                       `json:"kind"`
+	APIVersion string                       `json:"apiVersion,omitempty"`
+	Spec       AutoScalingGroupManifestSpec `json:"spec"`
+}
+
+func (m *AutoScalingGroupManifest) validate() error {
+	if m.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", m.APIVersion)
+	}
+	if m.Kind != groupManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", m.Kind)
+	}
+	return m.Spec.validate()
+}
+
+// AutoScalingGroupManifestSpec contains configuration for EC2AutoScalingGroup.
+type AutoScalingGroupManifestSpec struct {
+	// The name of the group.
+	// In the blue/green deployment, it is used as the prefix of the names of the groups.
+	Name           string         `json:"name"`
+	LaunchTemplate LaunchTemplate `json:"launchTemplate"`
+	MinSize        int            `json:"minSize"`
+	MaxSize        int            `json:"maxSize"`
+	// The number of instances the group should have.
+	// When it is omitted, the current capacity is kept so that the scaling policies keep working.
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+	// The IDs of the subnets the instances are launched in.
+	Subnets []string `json:"subnets"`
+	// The target groups the group is attached to when it is created.
+	// It is ignored in the blue/green deployment where the target groups are configured in the application configuration.
+	TargetGroupArns []string `json:"targetGroupArns,omitempty"`
+	// Either EC2 or ELB. Default is EC2.
+	HealthCheckType string `json:"healthCheckType,omitempty"`
+	// The seconds to wait before checking the health of a new instance.
+	HealthCheckGracePeriod int               `json:"healthCheckGracePeriod,omitempty"`
+	Tags                   map[string]string `json:"tags,omitempty"`
+}
+
+// LaunchTemplate represents the launch template whose new version is created for each deployment.
+// The other launch parameters are inherited from the latest version of the template.
+type LaunchTemplate struct {
+	LaunchTemplateName string `json:"launchTemplateName"`
+	// The ID of the AMI, e.g. ami-0123456789abcdef0.
+	ImageID      string `json:"imageId"`
+	InstanceType string `json:"instanceType,omitempty"`
+}
+
+func (s AutoScalingGroupManifestSpec) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if s.LaunchTemplate.LaunchTemplateName == "" {
+		return fmt.Errorf("launchTemplate.launchTemplateName is missing")
+	}
+	if !strings.HasPrefix(s.LaunchTemplate.ImageID, "ami-") {
+		return fmt.Errorf("launchTemplate.imageId must be the ID of an AMI")
+	}
+	if s.MinSize < 0 || s.MinSize > s.MaxSize {
+		return fmt.Errorf("minSize must be between 0 and maxSize")
+	}
+	if s.DesiredCapacity != nil && (*s.DesiredCapacity < s.MinSize || *s.DesiredCapacity > s.MaxSize) {
+		return fmt.Errorf("desiredCapacity must be between minSize and maxSize")
+	}
+	if len(s.Subnets) == 0 {
+		return fmt.Errorf("subnets must not be empty")
+	}
+	switch s.HealthCheckType {
+	case "", healthCheckTypeEC2, healthCheckTypeELB:
+	default:
+		return fmt.Errorf("healthCheckType must be %s or %s", healthCheckTypeEC2, healthCheckTypeELB)
+	}
+	return nil
+}
+
+// AddTags adds the given tags to the group.
+func (m *AutoScalingGroupManifest) AddTags(tags map[string]string) {
+	if m.Spec.Tags == nil {
+		m.Spec.Tags = make(map[string]string, len(tags))
+	}
+	for k, v := range tags {
+		m.Spec.Tags[k] = v
+	}
+}
+
+// GroupInput returns the input to create or update the group of the given name
+// launching the instances from the given version of the launch template.
+func (m AutoScalingGroupManifest) GroupInput(name, launchTemplateVersion string, targetGroupArns []string) GroupInput {
+	return GroupInput{
+		Name:                   name,
+		LaunchTemplateName:     m.Spec.LaunchTemplate.LaunchTemplateName,
+		LaunchTemplateVersion:  launchTemplateVersion,
+		MinSize:                m.Spec.MinSize,
+		MaxSize:                m.Spec.MaxSize,
+		DesiredCapacity:        m.Spec.DesiredCapacity,
+		Subnets:                m.Spec.Subnets,
+		TargetGroupArns:        targetGroupArns,
+		HealthCheckType:        m.Spec.HealthCheckType,
+		HealthCheckGracePeriod: m.Spec.HealthCheckGracePeriod,
+		Tags:                   m.Spec.Tags,
+	}
+}
+
+// LoadAutoScalingGroupManifest returns AutoScalingGroupManifest object from a given manifest file.
+func LoadAutoScalingGroupManifest(appDir, manifestFilename string) (AutoScalingGroupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, manifestFilename))
+	if err != nil {
+		return AutoScalingGroupManifest{}, err
+	}
+	return parseAutoScalingGroupManifest(data)
+}
+
+func parseAutoScalingGroupManifest(data []byte) (AutoScalingGroupManifest, error) {
+	var m AutoScalingGroupManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return AutoScalingGroupManifest{}, err
+	}
+	if err := m.validate(); err != nil {
+		return AutoScalingGroupManifest{}, err
+	}
+	return m, nil
+}
+
+// FindArtifactVersions returns the AMI deployed by the group.
+func FindArtifactVersions(m AutoScalingGroupManifest) []*model.ArtifactVersion {
+	return []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: m.Spec.LaunchTemplate.ImageID,
+			Name:    m.Spec.LaunchTemplate.LaunchTemplateName,
+		},
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestParseAutoScalingGroupManifest(t *testing.T) {
+	t.Parallel()
+
+	desired := 2
+	testcases := []struct {
+		name        string
+		data        string
+		expected    AutoScalingGroupManifest
+		expectedErr bool
+	}{
+		{
+			name: "valid manifest",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: EC2AutoScalingGroup
+spec:
+  name: web
+  launchTemplate:
+    launchTemplateName: web
+    imageId: ami-0123456789abcdef0
+    instanceType: t3.small
+  minSize: 1
+  maxSize: 4
+  desiredCapacity: 2
+  subnets:
+    - subnet-a
+    - subnet-b
+  healthCheckType: ELB
+  healthCheckGracePeriod: 60
+  tags:
+    team: web
+`,
+			expected: AutoScalingGroupManifest{
+				Kind:       "EC2AutoScalingGroup",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: AutoScalingGroupManifestSpec{
+					Name: "web",
+					LaunchTemplate: LaunchTemplate{
+						LaunchTemplateName: "web",
+						ImageID:            "ami-0123456789abcdef0",
+						InstanceType:       "t3.small",
+					},
+					MinSize:                1,
+					MaxSize:                4,
+					DesiredCapacity:        &desired,
+					Subnets:                []string{"subnet-a", "subnet-b"},
+					HealthCheckType:        "ELB",
+					HealthCheckGracePeriod: 60,
+					Tags:                   map[string]string{"team": "web"},
+				},
+			},
+		},
+		{
+			name: "invalid kind",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: AutoScalingGroup
+spec:
+  name: web
+`,
+			expectedErr: true,
+		},
+		{
+			name: "not an image id",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: EC2AutoScalingGroup
+spec:
+  name: web
+  launchTemplate:
+    launchTemplateName: web
+    imageId: ubuntu
+  minSize: 1
+  maxSize: 4
+  subnets:
+    - subnet-a
+`,
+			expectedErr: true,
+		},
+		{
+			name: "desired capacity out of range",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: EC2AutoScalingGroup
+spec:
+  name: web
+  launchTemplate:
+    launchTemplateName: web
+    imageId: ami-0123456789abcdef0
+  minSize: 1
+  maxSize: 4
+  desiredCapacity: 5
+  subnets:
+    - subnet-a
+`,
+			expectedErr: true,
+		},
+		{
+			name: "invalid health check type",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: EC2AutoScalingGroup
+spec:
+  name: web
+  launchTemplate:
+    launchTemplateName: web
+    imageId: ami-0123456789abcdef0
+  minSize: 1
+  maxSize: 4
+  subnets:
+    - subnet-a
+  healthCheckType: HTTP
+`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := parseAutoScalingGroupManifest([]byte(tc.data))
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, m)
+		})
+	}
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	m := AutoScalingGroupManifest{
+		Spec: AutoScalingGroupManifestSpec{
+			LaunchTemplate: LaunchTemplate{
+				LaunchTemplateName: "web",
+				ImageID:            "ami-0123456789abcdef0",
+			},
+		},
+	}
+	expected := []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: "ami-0123456789abcdef0",
+			Name:    "web",
+		},
+	}
+	assert.Equal(t, expected, FindArtifactVersions(m))
+}
+
+func TestGreenGroupName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "web-0123abcd", GreenGroupName("web", "0123abcd-4567-89ef"))
+	assert.Equal(t, "web-abc", GreenGroupName("web", "abc"))
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// forwardWeights returns the weights of the target groups of the forward action in the given actions.
+// A forward action to a single target group is treated as routing all traffic to it.
+func forwardWeights(actions []elbtypes.Action) map[string]int {
+	weights := make(map[string]int)
+	for _, action := range actions {
+		if action.Type != elbtypes.ActionTypeEnumForward {
+			continue
+		}
+		if action.ForwardConfig != nil && len(action.ForwardConfig.TargetGroups) > 0 {
+			for _, tg := range action.ForwardConfig.TargetGroups {
+				weights[aws.ToString(tg.TargetGroupArn)] = int(aws.ToInt32(tg.Weight))
+			}
+			continue
+		}
+		if action.TargetGroupArn != nil {
+			weights[*action.TargetGroupArn] = 100
+		}
+	}
+	return weights
+}
+
+// forwardsTo reports whether the given forward action is routing traffic
+// to at least one of the given target groups.
+func forwardsTo(action elbtypes.Action, weights []TargetGroupWeight) bool {
+	for arn := range forwardWeights([]elbtypes.Action{action}) {
+		for _, w := range weights {
+			if w.TargetGroupArn == arn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// modifyForwardActions returns a copy of the given actions where the forward actions are replaced
+// by the ones routing traffic to the given target groups with the given weights.
+// In case onlyRelated is true, only the forward actions which are routing traffic to
+// the given target groups will be modified.
+// The second returned value tells whether any action was modified.
+func modifyForwardActions(actions []elbtypes.Action, weights []TargetGroupWeight, onlyRelated bool) ([]elbtypes.Action, bool) {
+	var (
+		modified        bool
+		modifiedActions = make([]elbtypes.Action, 0, len(actions))
+	)
+	for _, action := range actions {
+		if action.Type != elbtypes.ActionTypeEnumForward || (onlyRelated && !forwardsTo(action, weights)) {
+			// Keep other actions unchanged.
+			modifiedActions = append(modifiedActions, action)
+			continue
+		}
+
+		targetGroups := make([]elbtypes.TargetGroupTuple, 0, len(weights))
+		for _, w := range weights {
+			targetGroups = append(targetGroups, elbtypes.TargetGroupTuple{
+				TargetGroupArn: aws.String(w.TargetGroupArn),
+				Weight:         aws.Int32(int32(w.Weight)),
+			})
+		}
+		forward := &elbtypes.ForwardActionConfig{
+			TargetGroups: targetGroups,
+		}
+		// Keep the stickiness configured on the load balancer.
+		if action.ForwardConfig != nil {
+			forward.TargetGroupStickinessConfig = action.ForwardConfig.TargetGroupStickinessConfig
+		}
+		modifiedActions = append(modifiedActions, elbtypes.Action{
+			Type:          elbtypes.ActionTypeEnumForward,
+			Order:         action.Order,
+			ForwardConfig: forward,
+		})
+		modified = true
+	}
+	return modifiedActions, modified
+}
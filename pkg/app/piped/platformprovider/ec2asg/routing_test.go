@@ -0,0 +1,139 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestForwardWeights(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		actions  []elbtypes.Action
+		expected map[string]int
+	}{
+		{
+			name: "single target group",
+			actions: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumForward, TargetGroupArn: aws.String("arn:tg-blue")},
+			},
+			expected: map[string]int{"arn:tg-blue": 100},
+		},
+		{
+			name: "weighted target groups",
+			actions: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumAuthenticateOidc},
+				{
+					Type: elbtypes.ActionTypeEnumForward,
+					ForwardConfig: &elbtypes.ForwardActionConfig{
+						TargetGroups: []elbtypes.TargetGroupTuple{
+							{TargetGroupArn: aws.String("arn:tg-blue"), Weight: aws.Int32(30)},
+							{TargetGroupArn: aws.String("arn:tg-green"), Weight: aws.Int32(70)},
+						},
+					},
+				},
+			},
+			expected: map[string]int{"arn:tg-blue": 30, "arn:tg-green": 70},
+		},
+		{
+			name: "no forward action",
+			actions: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumFixedResponse},
+			},
+			expected: map[string]int{},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, forwardWeights(tc.actions))
+		})
+	}
+}
+
+func TestModifyForwardActions(t *testing.T) {
+	t.Parallel()
+
+	weights := []TargetGroupWeight{
+		{TargetGroupArn: "arn:tg-green", Weight: 20},
+		{TargetGroupArn: "arn:tg-blue", Weight: 80},
+	}
+	expectedForward := elbtypes.Action{
+		Type:  elbtypes.ActionTypeEnumForward,
+		Order: aws.Int32(2),
+		ForwardConfig: &elbtypes.ForwardActionConfig{
+			TargetGroups: []elbtypes.TargetGroupTuple{
+				{TargetGroupArn: aws.String("arn:tg-green"), Weight: aws.Int32(20)},
+				{TargetGroupArn: aws.String("arn:tg-blue"), Weight: aws.Int32(80)},
+			},
+		},
+	}
+	authenticate := elbtypes.Action{Type: elbtypes.ActionTypeEnumAuthenticateOidc, Order: aws.Int32(1)}
+
+	testcases := []struct {
+		name             string
+		actions          []elbtypes.Action
+		onlyRelated      bool
+		expected         []elbtypes.Action
+		expectedModified bool
+	}{
+		{
+			name: "related forward action",
+			actions: []elbtypes.Action{
+				authenticate,
+				{Type: elbtypes.ActionTypeEnumForward, Order: aws.Int32(2), TargetGroupArn: aws.String("arn:tg-blue")},
+			},
+			onlyRelated:      true,
+			expected:         []elbtypes.Action{authenticate, expectedForward},
+			expectedModified: true,
+		},
+		{
+			name: "unrelated forward action",
+			actions: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumForward, Order: aws.Int32(2), TargetGroupArn: aws.String("arn:tg-other")},
+			},
+			onlyRelated: true,
+			expected: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumForward, Order: aws.Int32(2), TargetGroupArn: aws.String("arn:tg-other")},
+			},
+			expectedModified: false,
+		},
+		{
+			name: "default action",
+			actions: []elbtypes.Action{
+				{Type: elbtypes.ActionTypeEnumForward, Order: aws.Int32(2), TargetGroupArn: aws.String("arn:tg-other")},
+			},
+			onlyRelated:      false,
+			expected:         []elbtypes.Action{expectedForward},
+			expectedModified: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			actions, modified := modifyForwardActions(tc.actions, weights, tc.onlyRelated)
+			assert.Equal(t, tc.expected, actions)
+			assert.Equal(t, tc.expectedModified, modified)
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ec2asg
+
+import (
+	"context"
+	"time"
+)
+
+// WaitInstanceRefresh waits until the given instance refresh has been finished and returns its final state.
+func WaitInstanceRefresh(ctx context.Context, client Client, name, instanceRefreshID string, interval time.Duration) (*InstanceRefresh, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		refresh, err := client.DescribeInstanceRefresh(ctx, name, instanceRefreshID)
+		if err != nil {
+			return nil, err
+		}
+		if refresh.Done() {
+			return refresh, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// WaitGroupReady waits until the given number of the instances of the group become healthy and in service.
+func WaitGroupReady(ctx context.Context, client Client, name string, desired int, interval time.Duration) (*AutoScalingGroup, error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		group, err := client.DescribeAutoScalingGroup(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if group.ReadyInstances() >= desired {
+			return group, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	amtypes "github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+func loadServiceRegistry(data json.RawMessage) (*types.ServiceRegistry, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	registry := &types.ServiceRegistry{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(registry); err != nil {
+		return nil, fmt.Errorf("invalid service registry definition given: %v", err)
+	}
+	return registry, nil
+}
+
+// setMeshRouteWeights replaces the weighted targets of the given App Mesh route spec.
+// All other fields of the spec are kept unchanged since the whole spec is sent to update the route.
+func setMeshRouteWeights(spec *amtypes.RouteSpec, primaryNode string, primary int, canaryNode string, canary int) error {
+	var action *[]amtypes.WeightedTarget
+	switch {
+	case spec.HttpRoute != nil && spec.HttpRoute.Action != nil:
+		action = &spec.HttpRoute.Action.WeightedTargets
+	case spec.Http2Route != nil && spec.Http2Route.Action != nil:
+		action = &spec.Http2Route.Action.WeightedTargets
+	case spec.GrpcRoute != nil && spec.GrpcRoute.Action != nil:
+		action = &spec.GrpcRoute.Action.WeightedTargets
+	case spec.TcpRoute != nil && spec.TcpRoute.Action != nil:
+		action = &spec.TcpRoute.Action.WeightedTargets
+	default:
+		return fmt.Errorf("no supported route type was found in route spec")
+	}
+
+	// Keep the port of the current targets since all targets of a route must use the same port.
+	var port *int32
+	for _, t := range *action {
+		if t.Port != nil {
+			port = t.Port
+			break
+		}
+	}
+	*action = []amtypes.WeightedTarget{
+		{VirtualNode: aws.String(primaryNode), Weight: int32(primary), Port: port},
+		{VirtualNode: aws.String(canaryNode), Weight: int32(canary), Port: port},
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	amtypes "github.com/aws/aws-sdk-go-v2/service/appmesh/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetMeshRouteWeights(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		spec        string
+		expected    string
+		expectedErr bool
+	}{
+		{
+			name: "http route",
+			spec: `{
+  "priority": 1,
+  "httpRoute": {
+    "match": {"prefix": "/"},
+    "action": {
+      "weightedTargets": [
+        {"virtualNode": "web-primary", "weight": 100, "port": 8080}
+      ]
+    }
+  }
+}`,
+			expected: `{
+  "priority": 1,
+  "httpRoute": {
+    "match": {"prefix": "/"},
+    "action": {
+      "weightedTargets": [
+        {"virtualNode": "web-primary", "weight": 80, "port": 8080},
+        {"virtualNode": "web-canary", "weight": 20, "port": 8080}
+      ]
+    }
+  }
+}`,
+		},
+		{
+			name: "tcp route without port",
+			spec: `{
+  "tcpRoute": {
+    "action": {
+      "weightedTargets": [
+        {"virtualNode": "web-primary", "weight": 50},
+        {"virtualNode": "web-canary", "weight": 50}
+      ]
+    }
+  }
+}`,
+			expected: `{
+  "tcpRoute": {
+    "action": {
+      "weightedTargets": [
+        {"virtualNode": "web-primary", "weight": 80},
+        {"virtualNode": "web-canary", "weight": 20}
+      ]
+    }
+  }
+}`,
+		},
+		{
+			name:        "unsupported route",
+			spec:        `{"priority": 1}`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			var spec amtypes.RouteSpec
+			require.NoError(t, json.Unmarshal([]byte(tc.spec), &spec))
+
+			err := setMeshRouteWeights(&spec, "web-primary", 80, "web-canary", 20)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			var expected amtypes.RouteSpec
+			require.NoError(t, json.Unmarshal([]byte(tc.expected), &expected))
+			assert.Equal(t, expected, spec)
+		})
+	}
+}
+
+func TestLoadServiceRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, err := loadServiceRegistry(nil)
+	require.NoError(t, err)
+	assert.Nil(t, registry)
+
+	registry, err = loadServiceRegistry(json.RawMessage(`{"registryArn":"arn:aws:servicediscovery:xyz","containerName":"web"}`))
+	require.NoError(t, err)
+	assert.Equal(t, &types.ServiceRegistry{
+		RegistryArn:   aws.String("arn:aws:servicediscovery:xyz"),
+		ContainerName: aws.String("web"),
+	}, registry)
+
+	_, err = loadServiceRegistry(json.RawMessage(`{"unknown":"xyz"}`))
+	assert.Error(t, err)
+}
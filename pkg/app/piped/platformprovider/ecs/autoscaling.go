@@ -0,0 +1,115 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// scalableTarget identifies the desired count of an ECS service as a target of Application Auto Scaling.
+type scalableTarget struct {
+	ServiceNamespace  aastypes.ServiceNamespace
+	ResourceID        string
+	ScalableDimension aastypes.ScalableDimension
+}
+
+// makeScalableTarget returns the scalable target of the given service.
+// The resource ID is in the form of service/{clusterName}/{serviceName}.
+func makeScalableTarget(service types.Service) (scalableTarget, error) {
+	cluster := aws.ToString(service.ClusterArn)
+	if i := strings.LastIndex(cluster, "/"); i >= 0 {
+		cluster = cluster[i+1:]
+	}
+	name := aws.ToString(service.ServiceName)
+	if cluster == "" || name == "" {
+		return scalableTarget{}, fmt.Errorf("cluster and service name are required to determine the scalable target")
+	}
+	return scalableTarget{
+		ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+		ResourceID:        fmt.Sprintf("service/%s/%s", cluster, name),
+		ScalableDimension: aastypes.ScalableDimensionECSServiceDesiredCount,
+	}, nil
+}
+
+func makePutScalingPolicyInput(target scalableTarget, policy config.ECSScalingPolicy) (*applicationautoscaling.PutScalingPolicyInput, error) {
+	in := &applicationautoscaling.PutScalingPolicyInput{
+		ServiceNamespace:  target.ServiceNamespace,
+		ResourceId:        aws.String(target.ResourceID),
+		ScalableDimension: target.ScalableDimension,
+		PolicyName:        aws.String(policy.Name),
+		PolicyType:        aastypes.PolicyType(policy.PolicyType),
+	}
+
+	switch policy.PolicyType {
+	case config.ECSScalingPolicyTypeStepScaling:
+		if len(policy.StepScalingPolicyConfiguration) == 0 {
+			break
+		}
+		in.StepScalingPolicyConfiguration = &aastypes.StepScalingPolicyConfiguration{}
+		if err := decodePolicyConfiguration(policy.StepScalingPolicyConfiguration, in.StepScalingPolicyConfiguration); err != nil {
+			return nil, fmt.Errorf("invalid configuration of scaling policy %s: %w", policy.Name, err)
+		}
+	default:
+		if len(policy.TargetTrackingScalingPolicyConfiguration) == 0 {
+			break
+		}
+		in.TargetTrackingScalingPolicyConfiguration = &aastypes.TargetTrackingScalingPolicyConfiguration{}
+		if err := decodePolicyConfiguration(policy.TargetTrackingScalingPolicyConfiguration, in.TargetTrackingScalingPolicyConfiguration); err != nil {
+			return nil, fmt.Errorf("invalid configuration of scaling policy %s: %w", policy.Name, err)
+		}
+	}
+	return in, nil
+}
+
+// decodePolicyConfiguration decodes the given configuration written in camelCase
+// as same as the other definitions into the given policy configuration of the API.
+func decodePolicyConfiguration(data json.RawMessage, out interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(out)
+}
+
+// findStalePolicies returns the names of the registered policies which are not declared anymore.
+func findStalePolicies(registered []string, declared []config.ECSScalingPolicy) []string {
+	names := make(map[string]struct{}, len(declared))
+	for _, p := range declared {
+		names[p.Name] = struct{}{}
+	}
+	var stale []string
+	for _, name := range registered {
+		if _, ok := names[name]; !ok {
+			stale = append(stale, name)
+		}
+	}
+	return stale
+}
+
+// DiffAutoScalings calculates the diff between two given auto scaling configurations.
+// The paths of the returned nodes are built from the json field names of config.ECSAutoScaling,
+// e.g. policies.0.targetTrackingScalingPolicyConfiguration.targetValue.
+func DiffAutoScalings(old, new config.ECSAutoScaling, opts ...diff.Option) (*diff.Result, error) {
+	return diffObjects(old, new, "autoScaling", opts...)
+}
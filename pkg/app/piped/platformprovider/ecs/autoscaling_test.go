@@ -0,0 +1,134 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	aastypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeScalableTarget(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		service   types.Service
+		expected  string
+		expectErr bool
+	}{
+		{
+			name: "cluster arn",
+			service: types.Service{
+				ClusterArn:  aws.String("arn:aws:ecs:ap-northeast-1:123456789012:cluster/default"),
+				ServiceName: aws.String("web"),
+			},
+			expected: "service/default/web",
+		},
+		{
+			name: "cluster name",
+			service: types.Service{
+				ClusterArn:  aws.String("default"),
+				ServiceName: aws.String("web"),
+			},
+			expected: "service/default/web",
+		},
+		{
+			name: "missing cluster",
+			service: types.Service{
+				ServiceName: aws.String("web"),
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := makeScalableTarget(tc.service)
+			assert.Equal(t, tc.expectErr, err != nil)
+			assert.Equal(t, tc.expected, got.ResourceID)
+		})
+	}
+}
+
+func TestMakePutScalingPolicyInput(t *testing.T) {
+	t.Parallel()
+
+	target := scalableTarget{
+		ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+		ResourceID:        "service/default/web",
+		ScalableDimension: aastypes.ScalableDimensionECSServiceDesiredCount,
+	}
+	policy := config.ECSScalingPolicy{
+		Name:                                     "cpu",
+		PolicyType:                               config.ECSScalingPolicyTypeTargetTracking,
+		TargetTrackingScalingPolicyConfiguration: json.RawMessage(`{"targetValue":70,"customizedMetricSpecification":{"dimensions":[{"name":"ServiceName","value":"web"}]}}`),
+		StepScalingPolicyConfiguration:           json.RawMessage(`{"AdjustmentType":"ChangeInCapacity"}`),
+	}
+
+	in, err := makePutScalingPolicyInput(target, policy)
+	require.NoError(t, err)
+	assert.Equal(t, &applicationautoscaling.PutScalingPolicyInput{
+		ServiceNamespace:  aastypes.ServiceNamespaceEcs,
+		ResourceId:        aws.String("service/default/web"),
+		ScalableDimension: aastypes.ScalableDimensionECSServiceDesiredCount,
+		PolicyName:        aws.String("cpu"),
+		PolicyType:        aastypes.PolicyTypeTargetTrackingScaling,
+		TargetTrackingScalingPolicyConfiguration: &aastypes.TargetTrackingScalingPolicyConfiguration{
+			TargetValue: aws.Float64(70),
+			CustomizedMetricSpecification: &aastypes.CustomizedMetricSpecification{
+				Dimensions: []aastypes.MetricDimension{{Name: aws.String("ServiceName"), Value: aws.String("web")}},
+			},
+		},
+	}, in)
+
+	policy = config.ECSScalingPolicy{
+		Name:                           "steps",
+		PolicyType:                     config.ECSScalingPolicyTypeStepScaling,
+		StepScalingPolicyConfiguration: json.RawMessage(`{"adjustmentType":"ChangeInCapacity","stepAdjustments":[{"metricIntervalLowerBound":0,"scalingAdjustment":1}]}`),
+	}
+	in, err = makePutScalingPolicyInput(target, policy)
+	require.NoError(t, err)
+	assert.Nil(t, in.TargetTrackingScalingPolicyConfiguration)
+	assert.Equal(t, &aastypes.StepScalingPolicyConfiguration{
+		AdjustmentType: aastypes.AdjustmentTypeChangeInCapacity,
+		StepAdjustments: []aastypes.StepAdjustment{
+			{MetricIntervalLowerBound: aws.Float64(0), ScalingAdjustment: aws.Int32(1)},
+		},
+	}, in.StepScalingPolicyConfiguration)
+
+	policy.StepScalingPolicyConfiguration = json.RawMessage(`{"unknown":"xyz"}`)
+	_, err = makePutScalingPolicyInput(target, policy)
+	assert.Error(t, err)
+}
+
+func TestFindStalePolicies(t *testing.T) {
+	t.Parallel()
+
+	declared := []config.ECSScalingPolicy{
+		{Name: "cpu"},
+		{Name: "memory"},
+	}
+	got := findStalePolicies([]string{"cpu", "requests", "memory", "old"}, declared)
+	assert.Equal(t, []string{"requests", "old"}, got)
+}
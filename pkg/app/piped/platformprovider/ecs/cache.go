@@ -0,0 +1,78 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/cache"
+)
+
+// Definitions holds the task definition and the service definition of an application.
+// ServiceDefinition is nil when the application runs standalone tasks.
+type Definitions struct {
+	TaskDefinition    types.TaskDefinition
+	ServiceDefinition *types.Service
+	// The definitions of the services deployed together with the main service.
+	AdditionalServices []Definitions
+}
+
+type DefinitionsCache struct {
+	AppID  string
+	Cache  cache.Cache
+	Logger *zap.Logger
+}
+
+func (c DefinitionsCache) Get(commit string) (Definitions, bool) {
+	key := definitionsCacheKey(c.AppID, commit)
+	item, err := c.Cache.Get(key)
+	if err == nil {
+		return item.(Definitions), true
+	}
+
+	if errors.Is(err, cache.ErrNotFound) {
+		c.Logger.Info("definitions were not found in cache",
+			zap.String("app-id", c.AppID),
+			zap.String("commit-hash", commit),
+		)
+		return Definitions{}, false
+	}
+
+	c.Logger.Error("failed while retrieving definitions from cache",
+		zap.String("app-id", c.AppID),
+		zap.String("commit-hash", commit),
+		zap.Error(err),
+	)
+	return Definitions{}, false
+}
+
+func (c DefinitionsCache) Put(commit string, defs Definitions) {
+	key := definitionsCacheKey(c.AppID, commit)
+	if err := c.Cache.Put(key, defs); err != nil {
+		c.Logger.Error("failed while putting definitions into cache",
+			zap.String("app-id", c.AppID),
+			zap.String("commit-hash", commit),
+			zap.Error(err),
+		)
+	}
+}
+
+func definitionsCacheKey(appID, commit string) string {
+	return fmt.Sprintf("%s/%s", appID, commit)
+}
@@ -18,15 +18,26 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/appmesh"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/servicediscovery"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider"
@@ -42,15 +53,28 @@ const (
 	// TaskSetStable's constants.
 	retryTaskSetStable         = 40
 	retryTaskSetStableInterval = 15 * time.Second
+
+	// TasksStopped's constants.
+	retryTaskStoppedInterval = 10 * time.Second
 )
 
 type client struct {
-	ecsClient *ecs.Client
-	elbClient *elasticloadbalancingv2.Client
-	logger    *zap.Logger
+	awsConfig     aws.Config
+	ecsClient     *ecs.Client
+	elbClient     *elasticloadbalancingv2.Client
+	appMeshClient *appmesh.Client
+	// Application Auto Scaling client.
+	autoScalingClient *applicationautoscaling.Client
+	// EventBridge client.
+	eventBridgeClient *eventbridge.Client
+	// Cloud Map client.
+	serviceDiscoveryClient *servicediscovery.Client
+	// CodeDeploy client.
+	codeDeployClient *codedeploy.Client
+	logger           *zap.Logger
 }
 
-func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (Client, error) {
+func newClient(region, profile, credentialsFile, roleARN, tokenPath, assumeRoleARN, externalID string, logger *zap.Logger) (Client, error) {
 	if region == "" {
 		return nil, fmt.Errorf("region is required field")
 	}
@@ -77,13 +101,30 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config to create ecs client: %w", err)
 	}
+	if assumeRoleARN != "" {
+		provider := stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), assumeRoleARN, func(o *stscreds.AssumeRoleOptions) {
+			if externalID != "" {
+				o.ExternalID = aws.String(externalID)
+			}
+		})
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+	}
+	c.awsConfig = cfg
 	c.ecsClient = ecs.NewFromConfig(cfg)
 	c.elbClient = elasticloadbalancingv2.NewFromConfig(cfg)
+	c.appMeshClient = appmesh.NewFromConfig(cfg)
+	c.autoScalingClient = applicationautoscaling.NewFromConfig(cfg)
+	c.eventBridgeClient = eventbridge.NewFromConfig(cfg)
+	c.serviceDiscoveryClient = servicediscovery.NewFromConfig(cfg)
+	c.codeDeployClient = codedeploy.NewFromConfig(cfg)
 
 	return c, nil
 }
 
 func (c *client) CreateService(ctx context.Context, service types.Service) (*types.Service, error) {
+	if IsDaemonService(service) {
+		return c.createDaemonService(ctx, service)
+	}
 	if service.DeploymentController == nil || service.DeploymentController.Type != types.DeploymentControllerTypeExternal {
 		return nil, fmt.Errorf("failed to create ECS service %s: deployment controller of type EXTERNAL is required", *service.ServiceName)
 	}
@@ -102,6 +143,7 @@ func (c *client) CreateService(ctx context.Context, service types.Service) (*typ
 		PropagateTags:                 types.PropagateTagsService,
 		Role:                          service.RoleArn,
 		SchedulingStrategy:            service.SchedulingStrategy,
+		ServiceConnectConfiguration:   ServiceConnectConfiguration(service),
 		Tags:                          service.Tags,
 	}
 	output, err := c.ecsClient.CreateService(ctx, input)
@@ -114,19 +156,59 @@ func (c *client) CreateService(ctx context.Context, service types.Service) (*typ
 	// as part of service definition for that purpose.
 	// ref: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html
 	output.Service.LaunchType = service.LaunchType
+	output.Service.CapacityProviderStrategy = service.CapacityProviderStrategy
 	output.Service.NetworkConfiguration = service.NetworkConfiguration
 	output.Service.ServiceRegistries = service.ServiceRegistries
+	// Keep the Service Connect configuration so that the task sets created from the returned service,
+	// e.g. the CANARY one, are known to join the same namespace.
+	output.Service.Deployments = service.Deployments
 
 	return output.Service, nil
 }
 
+// createDaemonService creates the given service with the ECS deployment controller
+// since task sets are not available for the services with DAEMON scheduling strategy.
+// The desired count is not specified because ECS places one task on each container instance.
+func (c *client) createDaemonService(ctx context.Context, service types.Service) (*types.Service, error) {
+	input := &ecs.CreateServiceInput{
+		Cluster:                       service.ClusterArn,
+		ServiceName:                   service.ServiceName,
+		TaskDefinition:                service.TaskDefinition,
+		DeploymentController:          &types.DeploymentController{Type: types.DeploymentControllerTypeEcs},
+		DeploymentConfiguration:       service.DeploymentConfiguration,
+		EnableECSManagedTags:          service.EnableECSManagedTags,
+		EnableExecuteCommand:          service.EnableExecuteCommand,
+		HealthCheckGracePeriodSeconds: service.HealthCheckGracePeriodSeconds,
+		LaunchType:                    service.LaunchType,
+		LoadBalancers:                 service.LoadBalancers,
+		NetworkConfiguration:          service.NetworkConfiguration,
+		PlacementConstraints:          service.PlacementConstraints,
+		PropagateTags:                 types.PropagateTagsService,
+		Role:                          service.RoleArn,
+		SchedulingStrategy:            types.SchedulingStrategyDaemon,
+		ServiceConnectConfiguration:   ServiceConnectConfiguration(service),
+		ServiceRegistries:             service.ServiceRegistries,
+		Tags:                          service.Tags,
+	}
+	output, err := c.ecsClient.CreateService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ECS service %s: %w", *service.ServiceName, err)
+	}
+	return output.Service, nil
+}
+
 func (c *client) UpdateService(ctx context.Context, service types.Service) (*types.Service, error) {
+	if IsDaemonService(service) {
+		return c.updateDaemonService(ctx, service)
+	}
 	input := &ecs.UpdateServiceInput{
 		Cluster:              service.ClusterArn,
 		Service:              service.ServiceName,
 		DesiredCount:         aws.Int32(service.DesiredCount),
 		EnableExecuteCommand: aws.Bool(service.EnableExecuteCommand),
 		PlacementStrategy:    service.PlacementStrategy,
+		// The current configuration is kept when Service Connect is not configured in the definition.
+		ServiceConnectConfiguration: ServiceConnectConfiguration(service),
 		// TODO: Support update other properties of service.
 		// PlacementConstraints:    service.PlacementConstraints,
 	}
@@ -134,18 +216,46 @@ func (c *client) UpdateService(ctx context.Context, service types.Service) (*typ
 	if err != nil {
 		return nil, fmt.Errorf("failed to update ECS service %s: %w", *service.ServiceName, err)
 	}
+	if err := validateExternalDeploymentController(*output.Service); err != nil {
+		return nil, err
+	}
 
 	// Hack: Since we use EXTERNAL deployment controller, the below configurations are not allowed to be passed
 	// in UpdateService step, but it required in further step (CreateTaskSet step). We reassign those values
 	// as part of service definition for that purpose.
 	// ref: https://docs.aws.amazon.com/AmazonECS/latest/APIReference/API_CreateService.html
 	output.Service.LaunchType = service.LaunchType
+	output.Service.CapacityProviderStrategy = service.CapacityProviderStrategy
 	output.Service.NetworkConfiguration = service.NetworkConfiguration
 	output.Service.ServiceRegistries = service.ServiceRegistries
+	// Keep the Service Connect configuration so that the task sets created from the returned service,
+	// e.g. the CANARY one, are known to join the same namespace.
+	output.Service.Deployments = service.Deployments
 
 	return output.Service, nil
 }
 
+// updateDaemonService updates the task definition of the given service to start a rolling update
+// by the ECS deployment controller. The desired count is left as it is managed by ECS.
+func (c *client) updateDaemonService(ctx context.Context, service types.Service) (*types.Service, error) {
+	input := &ecs.UpdateServiceInput{
+		Cluster:                       service.ClusterArn,
+		Service:                       service.ServiceName,
+		TaskDefinition:                service.TaskDefinition,
+		DeploymentConfiguration:       service.DeploymentConfiguration,
+		EnableExecuteCommand:          aws.Bool(service.EnableExecuteCommand),
+		HealthCheckGracePeriodSeconds: service.HealthCheckGracePeriodSeconds,
+		NetworkConfiguration:          service.NetworkConfiguration,
+		PlacementConstraints:          service.PlacementConstraints,
+		ServiceConnectConfiguration:   ServiceConnectConfiguration(service),
+	}
+	output, err := c.ecsClient.UpdateService(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ECS service %s: %w", *service.ServiceName, err)
+	}
+	return output.Service, nil
+}
+
 func (c *client) RegisterTaskDefinition(ctx context.Context, taskDefinition types.TaskDefinition) (*types.TaskDefinition, error) {
 	input := &ecs.RegisterTaskDefinitionInput{
 		Family:                  taskDefinition.Family,
@@ -159,8 +269,14 @@ func (c *client) RegisterTaskDefinition(ctx context.Context, taskDefinition type
 		// Requires defined at task level in case Fargate is used.
 		Cpu:    taskDefinition.Cpu,
 		Memory: taskDefinition.Memory,
-		// TODO: Support tags for registering task definition.
 	}
+	hash, err := HashTaskDefinition(taskDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to calculate hash of ECS task definition of family %s: %w", *taskDefinition.Family, err)
+	}
+	input.Tags = MakeTags(map[string]string{
+		LabelTaskDefinitionHash: hash,
+	})
 	output, err := c.ecsClient.RegisterTaskDefinition(ctx, input)
 	if err != nil {
 		return nil, fmt.Errorf("failed to register ECS task definition of family %s: %w", *taskDefinition.Family, err)
@@ -168,9 +284,132 @@ func (c *client) RegisterTaskDefinition(ctx context.Context, taskDefinition type
 	return output.TaskDefinition, nil
 }
 
-func (c *client) RunTask(ctx context.Context, taskDefinition types.TaskDefinition, clusterArn string, launchType string, awsVpcConfiguration *appconfig.ECSVpcConfiguration, tags []types.Tag) error {
+func (c *client) GetTaskDefinition(ctx context.Context, taskDefinitionArn string) (*types.TaskDefinition, error) {
+	input := &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: aws.String(taskDefinitionArn),
+	}
+	output, err := c.ecsClient.DescribeTaskDefinition(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get ECS task definition %s: %w", taskDefinitionArn, err)
+	}
+	return output.TaskDefinition, nil
+}
+
+func (c *client) GetRunningTaskDefinition(ctx context.Context, service types.Service) (*types.TaskDefinition, bool, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster:  service.ClusterArn,
+		Services: []string{aws.ToString(service.ServiceName)},
+	}
+	output, err := c.ecsClient.DescribeServices(ctx, input)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to describe ECS service %s: %w", aws.ToString(service.ServiceName), err)
+	}
+	if len(output.Services) == 0 || aws.ToString(output.Services[0].Status) != "ACTIVE" {
+		return nil, false, nil
+	}
+
+	arn := runningTaskDefinitionArn(output.Services[0])
+	if arn == "" {
+		return nil, false, nil
+	}
+	td, err := c.GetTaskDefinition(ctx, arn)
+	if err != nil {
+		return nil, false, err
+	}
+	return td, true, nil
+}
+
+func (c *client) FindSameTaskDefinition(ctx context.Context, taskDefinition types.TaskDefinition) (*types.TaskDefinition, bool, error) {
+	hash, err := HashTaskDefinition(taskDefinition)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to calculate hash of ECS task definition of family %s: %w", *taskDefinition.Family, err)
+	}
+
+	// Specifying only the family returns the latest ACTIVE revision.
+	input := &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: taskDefinition.Family,
+		Include:        []types.TaskDefinitionField{types.TaskDefinitionFieldTags},
+	}
+	output, err := c.ecsClient.DescribeTaskDefinition(ctx, input)
+	if err != nil {
+		// ClientException is returned when no ACTIVE revision of the family exists.
+		var ce *types.ClientException
+		if errors.As(err, &ce) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("failed to get ECS task definition of family %s: %w", *taskDefinition.Family, err)
+	}
+
+	for _, tag := range output.Tags {
+		if aws.ToString(tag.Key) == LabelTaskDefinitionHash && aws.ToString(tag.Value) == hash {
+			return output.TaskDefinition, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (c *client) PruneTaskDefinitions(ctx context.Context, family string, keep int) ([]string, error) {
+	type revision struct {
+		arn      string
+		revision int
+	}
+	var (
+		revisions []revision
+		nextToken *string
+	)
+	for {
+		output, err := c.ecsClient.ListTaskDefinitions(ctx, &ecs.ListTaskDefinitionsInput{
+			FamilyPrefix: aws.String(family),
+			Status:       types.TaskDefinitionStatusActive,
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list ECS task definitions of family %s: %w", family, err)
+		}
+		// FamilyPrefix also matches the families which start with the given one.
+		for _, arn := range output.TaskDefinitionArns {
+			if taskDefinitionFamily(arn) != family {
+				continue
+			}
+			rev, ok := taskDefinitionRevision(arn)
+			if !ok {
+				return nil, fmt.Errorf("invalid ECS task definition ARN %s", arn)
+			}
+			revisions = append(revisions, revision{arn: arn, revision: rev})
+		}
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+	if len(revisions) <= keep {
+		return nil, nil
+	}
+
+	// Sort the revisions from the latest one.
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].revision > revisions[j].revision
+	})
+
+	deregistered := make([]string, 0, len(revisions)-keep)
+	for _, r := range revisions[keep:] {
+		if _, err := c.ecsClient.DeregisterTaskDefinition(ctx, &ecs.DeregisterTaskDefinitionInput{
+			TaskDefinition: aws.String(r.arn),
+		}); err != nil {
+			return deregistered, fmt.Errorf("failed to deregister ECS task definition %s: %w", r.arn, err)
+		}
+		deregistered = append(deregistered, r.arn)
+	}
+	return deregistered, nil
+}
+
+func (c *client) RunTask(ctx context.Context, taskDefinition types.TaskDefinition, clusterArn string, launchType string, awsVpcConfiguration *appconfig.ECSVpcConfiguration, tags []types.Tag) ([]types.Task, error) {
 	if taskDefinition.TaskDefinitionArn == nil {
-		return fmt.Errorf("failed to run task of task family %s: no task definition provided", *taskDefinition.Family)
+		return nil, fmt.Errorf("failed to run task of task family %s: no task definition provided", *taskDefinition.Family)
 	}
 
 	input := &ecs.RunTaskInput{
@@ -190,9 +429,72 @@ func (c *client) RunTask(ctx context.Context, taskDefinition types.TaskDefinitio
 		}
 	}
 
-	_, err := c.ecsClient.RunTask(ctx, input)
+	output, err := c.ecsClient.RunTask(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to run ECS task %s: %w", *taskDefinition.TaskDefinitionArn, err)
+		return nil, fmt.Errorf("failed to run ECS task %s: %w", *taskDefinition.TaskDefinitionArn, err)
+	}
+	if len(output.Failures) > 0 {
+		f := output.Failures[0]
+		return nil, fmt.Errorf("failed to run ECS task %s: %s (%s)", *taskDefinition.TaskDefinitionArn, aws.ToString(f.Reason), aws.ToString(f.Detail))
+	}
+	return output.Tasks, nil
+}
+
+// WaitTasksStopped blocks until all the given tasks reach STOPPED status.
+// It returns the stopped tasks including their containers' exit codes.
+// When the given context is done before that, the last described tasks are returned with the context error
+// so that the caller can know which tasks are still running.
+func (c *client) WaitTasksStopped(ctx context.Context, clusterArn string, taskArns []string) ([]types.Task, error) {
+	input := &ecs.DescribeTasksInput{
+		Cluster: aws.String(clusterArn),
+		Tasks:   taskArns,
+	}
+
+	ticker := time.NewTicker(retryTaskStoppedInterval)
+	defer ticker.Stop()
+
+	var tasks []types.Task
+	for {
+		output, err := c.ecsClient.DescribeTasks(ctx, input)
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return tasks, ctxErr
+			}
+			return nil, fmt.Errorf("failed to describe ECS tasks: %w", err)
+		}
+		if len(output.Failures) > 0 {
+			f := output.Failures[0]
+			return nil, fmt.Errorf("failed to describe ECS task %s: %s", aws.ToString(f.Arn), aws.ToString(f.Reason))
+		}
+		tasks = output.Tasks
+
+		stopped := true
+		for _, task := range tasks {
+			if aws.ToString(task.LastStatus) != "STOPPED" {
+				stopped = false
+				break
+			}
+		}
+		if stopped {
+			return tasks, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return tasks, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *client) StopTask(ctx context.Context, clusterArn, taskArn, reason string) error {
+	input := &ecs.StopTaskInput{
+		Cluster: aws.String(clusterArn),
+		Task:    aws.String(taskArn),
+		Reason:  aws.String(reason),
+	}
+	if _, err := c.ecsClient.StopTask(ctx, input); err != nil {
+		return fmt.Errorf("failed to stop ECS task %s: %w", taskArn, err)
 	}
 	return nil
 }
@@ -214,6 +516,11 @@ func (c *client) CreateTaskSet(ctx context.Context, service types.Service, taskD
 		LaunchType:           service.LaunchType,
 		ServiceRegistries:    service.ServiceRegistries,
 	}
+	// The launch type and the capacity provider strategy can not be specified at the same time.
+	if len(service.CapacityProviderStrategy) > 0 {
+		input.LaunchType = ""
+		input.CapacityProviderStrategy = service.CapacityProviderStrategy
+	}
 	if targetGroup != nil {
 		input.LoadBalancers = []types.LoadBalancer{*targetGroup}
 	}
@@ -308,7 +615,9 @@ func (c *client) GetServiceTaskSets(ctx context.Context, service types.Service)
 // Note: This function follow the implementation of the AWS CLI.
 // AWS does not public API for waiting service stable, thus we use describe-service and workaround instead.
 // ref: https://docs.aws.amazon.com/cli/latest/reference/ecs/wait/services-stable.html
-func (c *client) WaitServiceStable(ctx context.Context, service types.Service) error {
+// When respectCircuitBreaker is true, it stops waiting and returns ErrDeploymentFailed
+// as soon as the deployment circuit breaker marks a deployment of the service as FAILED.
+func (c *client) WaitServiceStable(ctx context.Context, service types.Service, respectCircuitBreaker bool) error {
 	input := &ecs.DescribeServicesInput{
 		Cluster:  service.ClusterArn,
 		Services: []string{*service.ServiceArn},
@@ -326,7 +635,14 @@ func (c *client) WaitServiceStable(ctx context.Context, service types.Service) e
 		}
 
 		svc := output.Services[0]
-		if svc.PendingCount == 0 && svc.RunningCount >= svc.DesiredCount {
+		if respectCircuitBreaker {
+			if d, failed := findFailedDeployment(svc); failed {
+				err := fmt.Errorf("%w: %s", ErrDeploymentFailed, aws.ToString(d.RolloutStateReason))
+				return nil, backoff.NewError(err, false)
+			}
+		}
+		// The ECS deployment controller keeps the previous deployments until the rolling update completes.
+		if svc.PendingCount == 0 && svc.RunningCount >= svc.DesiredCount && len(svc.Deployments) <= 1 {
 			return nil, nil
 		}
 
@@ -336,6 +652,46 @@ func (c *client) WaitServiceStable(ctx context.Context, service types.Service) e
 	return err
 }
 
+// GetServiceHealth describes the service and the health of the targets in all its target groups.
+// When respectCircuitBreaker is true, it returns ErrDeploymentFailed if the deployment circuit breaker
+// marked a deployment of the service as FAILED.
+func (c *client) GetServiceHealth(ctx context.Context, service types.Service, since time.Time, respectCircuitBreaker bool) (*ServiceHealth, error) {
+	input := &ecs.DescribeServicesInput{
+		Cluster:  service.ClusterArn,
+		Services: []string{*service.ServiceName},
+	}
+	output, err := c.ecsClient.DescribeServices(ctx, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get service %s: %w", *service.ServiceName, err)
+	}
+	if len(output.Services) == 0 {
+		return nil, platformprovider.ErrNotFound
+	}
+
+	svc := output.Services[0]
+	if respectCircuitBreaker {
+		if d, failed := findFailedDeployment(svc); failed {
+			return nil, fmt.Errorf("%w: %s", ErrDeploymentFailed, aws.ToString(d.RolloutStateReason))
+		}
+	}
+
+	arns := serviceTargetGroupArns(svc)
+	targetHealths := make(map[string][]elbtypes.TargetHealthDescription, len(arns))
+	for _, arn := range arns {
+		input := &elasticloadbalancingv2.DescribeTargetHealthInput{
+			TargetGroupArn: aws.String(arn),
+		}
+		output, err := c.elbClient.DescribeTargetHealth(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe health of targets in target group %s: %w", arn, err)
+		}
+		targetHealths[arn] = output.TargetHealthDescriptions
+	}
+
+	health := makeServiceHealth(svc, arns, targetHealths, since)
+	return &health, nil
+}
+
 func (c *client) DeleteTaskSet(ctx context.Context, taskSet types.TaskSet) error {
 	input := &ecs.DeleteTaskSetInput{
 		Cluster: taskSet.ClusterArn,
@@ -346,6 +702,19 @@ func (c *client) DeleteTaskSet(ctx context.Context, taskSet types.TaskSet) error
 		return fmt.Errorf("failed to delete ECS task set %s: %w", *taskSet.TaskSetArn, err)
 	}
 
+	// The same task definition revision is shared by the task sets when the definition was not changed,
+	// e.g. the PRIMARY and CANARY task sets, so keep it while it's used by the other task sets.
+	output, err := c.ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+		Cluster:  taskSet.ClusterArn,
+		Services: []string{aws.ToString(taskSet.ServiceArn)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get service %s: %w", aws.ToString(taskSet.ServiceArn), err)
+	}
+	if len(output.Services) > 0 && isTaskDefinitionUsedByOtherTaskSets(output.Services[0], taskSet) {
+		return nil
+	}
+
 	// Inactive deleted taskset's task definition.
 	taskDefInput := &ecs.DeregisterTaskDefinitionInput{
 		TaskDefinition: taskSet.TaskDefinition,
@@ -392,6 +761,120 @@ func (c *client) ServiceExists(ctx context.Context, clusterName string, serviceN
 	return false, nil
 }
 
+func (c *client) ListClusters(ctx context.Context) ([]string, error) {
+	var (
+		clusters  []string
+		nextToken *string
+	)
+	for {
+		output, err := c.ecsClient.ListClusters(ctx, &ecs.ListClustersInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list clusters: %w", err)
+		}
+		clusters = append(clusters, output.ClusterArns...)
+		if output.NextToken == nil {
+			return clusters, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
+func (c *client) GetServices(ctx context.Context, clusterName string) ([]*types.Service, error) {
+	var (
+		serviceArns []string
+		nextToken   *string
+	)
+	for {
+		output, err := c.ecsClient.ListServices(ctx, &ecs.ListServicesInput{
+			Cluster:   aws.String(clusterName),
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list services of cluster %s: %w", clusterName, err)
+		}
+		serviceArns = append(serviceArns, output.ServiceArns...)
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	// DescribeServices accepts up to 10 services at once.
+	const maxServices = 10
+	services := make([]*types.Service, 0, len(serviceArns))
+	for i := 0; i < len(serviceArns); i += maxServices {
+		end := i + maxServices
+		if end > len(serviceArns) {
+			end = len(serviceArns)
+		}
+		output, err := c.ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
+			Cluster:  aws.String(clusterName),
+			Services: serviceArns[i:end],
+			Include: []types.ServiceField{
+				types.ServiceFieldTags,
+			},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe services of cluster %s: %w", clusterName, err)
+		}
+		for j := range output.Services {
+			if !IsPipeCDManagedService(&output.Services[j]) {
+				continue
+			}
+			services = append(services, &output.Services[j])
+		}
+	}
+
+	return services, nil
+}
+
+func (c *client) GetServiceTasks(ctx context.Context, service types.Service) ([]*types.Task, error) {
+	var (
+		taskArns  []string
+		nextToken *string
+	)
+	for {
+		output, err := c.ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
+			Cluster:       service.ClusterArn,
+			ServiceName:   service.ServiceName,
+			DesiredStatus: types.DesiredStatusRunning,
+			NextToken:     nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list tasks of service %s: %w", *service.ServiceName, err)
+		}
+		taskArns = append(taskArns, output.TaskArns...)
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	// DescribeTasks accepts up to 100 tasks at once.
+	const maxTasks = 100
+	tasks := make([]*types.Task, 0, len(taskArns))
+	for i := 0; i < len(taskArns); i += maxTasks {
+		end := i + maxTasks
+		if end > len(taskArns) {
+			end = len(taskArns)
+		}
+		output, err := c.ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
+			Cluster: service.ClusterArn,
+			Tasks:   taskArns[i:end],
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe tasks of service %s: %w", *service.ServiceName, err)
+		}
+		for j := range output.Tasks {
+			tasks = append(tasks, &output.Tasks[j])
+		}
+	}
+
+	return tasks, nil
+}
+
 func (c *client) GetListenerArns(ctx context.Context, targetGroup types.LoadBalancer) ([]string, error) {
 	loadBalancerArn, err := c.getLoadBalancerArn(ctx, *targetGroup.TargetGroupArn)
 	if err != nil {
@@ -438,51 +921,62 @@ func (c *client) ModifyListeners(ctx context.Context, listenerArns []string, rou
 	}
 
 	for _, listenerArn := range listenerArns {
-		// Describe the listener to get the current actions
-		describeListenersOutput, err := c.elbClient.DescribeListeners(ctx, &elasticloadbalancingv2.DescribeListenersInput{
-			ListenerArns: []string{listenerArn},
-		})
+		rules, err := c.describeRules(ctx, listenerArn)
 		if err != nil {
-			return fmt.Errorf("error describing listener %s: %w", listenerArn, err)
-		}
-
-		// Prepare the actions to be modified
-		var modifiedActions []elbtypes.Action
-		for _, action := range describeListenersOutput.Listeners[0].DefaultActions {
-			if action.Type == elbtypes.ActionTypeEnumForward {
-				// Modify only the forward action
-				modifiedAction := elbtypes.Action{
-					Type: elbtypes.ActionTypeEnumForward,
-					ForwardConfig: &elbtypes.ForwardActionConfig{
-						TargetGroups: []elbtypes.TargetGroupTuple{
-							{
-								TargetGroupArn: aws.String(routingTrafficCfg[0].TargetGroupArn),
-								Weight:         aws.Int32(int32(routingTrafficCfg[0].Weight)),
-							},
-							{
-								TargetGroupArn: aws.String(routingTrafficCfg[1].TargetGroupArn),
-								Weight:         aws.Int32(int32(routingTrafficCfg[1].Weight)),
-							},
-						},
-					},
+			return err
+		}
+
+		for _, rule := range rules {
+			if rule.IsDefault {
+				// Modify all forward actions of the default rule.
+				modifiedActions, _ := routingTrafficCfg.modifyForwardActions(rule.Actions, false)
+				_, err = c.elbClient.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
+					ListenerArn:    aws.String(listenerArn),
+					DefaultActions: modifiedActions,
+				})
+				if err != nil {
+					return fmt.Errorf("error modifying listener %s: %w", listenerArn, err)
 				}
-				modifiedActions = append(modifiedActions, modifiedAction)
-			} else {
-				// Keep other actions unchanged
-				modifiedActions = append(modifiedActions, action)
+				continue
+			}
+
+			// Modify only the forward actions which are routing traffic to the PRIMARY/CANARY target groups
+			// to avoid touching the rules of other services sharing the same listener.
+			modifiedActions, modified := routingTrafficCfg.modifyForwardActions(rule.Actions, true)
+			if !modified {
+				continue
+			}
+			_, err = c.elbClient.ModifyRule(ctx, &elasticloadbalancingv2.ModifyRuleInput{
+				RuleArn: rule.RuleArn,
+				Actions: modifiedActions,
+			})
+			if err != nil {
+				return fmt.Errorf("error modifying rule %s of listener %s: %w", aws.ToString(rule.RuleArn), listenerArn, err)
 			}
 		}
+	}
+	return nil
+}
 
-		// Modify the listener
-		_, err = c.elbClient.ModifyListener(ctx, &elasticloadbalancingv2.ModifyListenerInput{
-			ListenerArn:    aws.String(listenerArn),
-			DefaultActions: modifiedActions,
+func (c *client) describeRules(ctx context.Context, listenerArn string) ([]elbtypes.Rule, error) {
+	var (
+		rules  []elbtypes.Rule
+		marker *string
+	)
+	for {
+		output, err := c.elbClient.DescribeRules(ctx, &elasticloadbalancingv2.DescribeRulesInput{
+			ListenerArn: aws.String(listenerArn),
+			Marker:      marker,
 		})
 		if err != nil {
-			return fmt.Errorf("error modifying listener %s: %w", listenerArn, err)
+			return nil, fmt.Errorf("error describing rules of listener %s: %w", listenerArn, err)
+		}
+		rules = append(rules, output.Rules...)
+		if output.NextMarker == nil {
+			return rules, nil
 		}
+		marker = output.NextMarker
 	}
-	return nil
 }
 
 func (c *client) TagResource(ctx context.Context, resourceArn string, tags []types.Tag) error {
@@ -496,3 +990,334 @@ func (c *client) TagResource(ctx context.Context, resourceArn string, tags []typ
 	}
 	return nil
 }
+
+func (c *client) ModifyMeshRoute(ctx context.Context, mesh appconfig.ECSAppMesh, primary, canary int) error {
+	var meshOwner *string
+	if mesh.MeshOwner != "" {
+		meshOwner = aws.String(mesh.MeshOwner)
+	}
+
+	output, err := c.appMeshClient.DescribeRoute(ctx, &appmesh.DescribeRouteInput{
+		MeshName:          aws.String(mesh.MeshName),
+		VirtualRouterName: aws.String(mesh.VirtualRouterName),
+		RouteName:         aws.String(mesh.RouteName),
+		MeshOwner:         meshOwner,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe App Mesh route %s: %w", mesh.RouteName, err)
+	}
+	if output.Route == nil || output.Route.Spec == nil {
+		return fmt.Errorf("failed to update App Mesh route %s: route spec was not found", mesh.RouteName)
+	}
+
+	spec := output.Route.Spec
+	if err := setMeshRouteWeights(spec, mesh.PrimaryVirtualNode, primary, mesh.CanaryVirtualNode, canary); err != nil {
+		return fmt.Errorf("failed to update App Mesh route %s: %w", mesh.RouteName, err)
+	}
+
+	_, err = c.appMeshClient.UpdateRoute(ctx, &appmesh.UpdateRouteInput{
+		MeshName:          aws.String(mesh.MeshName),
+		VirtualRouterName: aws.String(mesh.VirtualRouterName),
+		RouteName:         aws.String(mesh.RouteName),
+		MeshOwner:         meshOwner,
+		Spec:              spec,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update App Mesh route %s: %w", mesh.RouteName, err)
+	}
+	return nil
+}
+
+func (c *client) ApplyAutoScaling(ctx context.Context, service types.Service, autoScaling appconfig.ECSAutoScaling) error {
+	target, err := makeScalableTarget(service)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.autoScalingClient.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
+		ServiceNamespace:  target.ServiceNamespace,
+		ResourceId:        aws.String(target.ResourceID),
+		ScalableDimension: target.ScalableDimension,
+		MinCapacity:       aws.Int32(autoScaling.MinCapacity),
+		MaxCapacity:       aws.Int32(autoScaling.MaxCapacity),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register scalable target %s: %w", target.ResourceID, err)
+	}
+
+	for _, p := range autoScaling.Policies {
+		in, err := makePutScalingPolicyInput(target, p)
+		if err != nil {
+			return err
+		}
+		if _, err := c.autoScalingClient.PutScalingPolicy(ctx, in); err != nil {
+			return fmt.Errorf("failed to put scaling policy %s: %w", p.Name, err)
+		}
+	}
+
+	registered, err := c.describeScalingPolicyNames(ctx, target)
+	if err != nil {
+		return err
+	}
+	for _, name := range findStalePolicies(registered, autoScaling.Policies) {
+		_, err := c.autoScalingClient.DeleteScalingPolicy(ctx, &applicationautoscaling.DeleteScalingPolicyInput{
+			ServiceNamespace:  target.ServiceNamespace,
+			ResourceId:        aws.String(target.ResourceID),
+			ScalableDimension: target.ScalableDimension,
+			PolicyName:        aws.String(name),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete scaling policy %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+func (c *client) describeScalingPolicyNames(ctx context.Context, target scalableTarget) ([]string, error) {
+	var (
+		names     []string
+		nextToken *string
+	)
+	for {
+		output, err := c.autoScalingClient.DescribeScalingPolicies(ctx, &applicationautoscaling.DescribeScalingPoliciesInput{
+			ServiceNamespace:  target.ServiceNamespace,
+			ResourceId:        aws.String(target.ResourceID),
+			ScalableDimension: target.ScalableDimension,
+			NextToken:         nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to describe scaling policies of %s: %w", target.ResourceID, err)
+		}
+		for _, p := range output.ScalingPolicies {
+			names = append(names, aws.ToString(p.PolicyName))
+		}
+		if output.NextToken == nil {
+			return names, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
+func (c *client) ApplyScheduledTask(ctx context.Context, task ScheduledTask, taskDefinition types.TaskDefinition, tags []types.Tag) error {
+	var eventBusName *string
+	if task.EventBusName != "" {
+		eventBusName = aws.String(task.EventBusName)
+	}
+
+	// Keep the current state of the rule to restore it in case of failure.
+	var prev *eventbridge.PutRuleInput
+	output, err := c.eventBridgeClient.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
+		Name:         aws.String(task.Name),
+		EventBusName: eventBusName,
+	})
+	var notFound *ebtypes.ResourceNotFoundException
+	switch {
+	case err == nil:
+		prev = &eventbridge.PutRuleInput{
+			Name:               output.Name,
+			Description:        output.Description,
+			ScheduleExpression: output.ScheduleExpression,
+			EventPattern:       output.EventPattern,
+			RoleArn:            output.RoleArn,
+			State:              output.State,
+			EventBusName:       eventBusName,
+		}
+	case errors.As(err, &notFound):
+		break
+	default:
+		return fmt.Errorf("failed to describe rule %s: %w", task.Name, err)
+	}
+
+	if _, err := c.eventBridgeClient.PutRule(ctx, makeEventBridgeRule(task, tags)); err != nil {
+		return fmt.Errorf("failed to put rule %s: %w", task.Name, err)
+	}
+
+	targetsOutput, err := c.eventBridgeClient.PutTargets(ctx, &eventbridge.PutTargetsInput{
+		Rule:         aws.String(task.Name),
+		EventBusName: eventBusName,
+		Targets:      []ebtypes.Target{makeEventBridgeTarget(task, taskDefinition)},
+	})
+	if err == nil && targetsOutput.FailedEntryCount > 0 {
+		e := targetsOutput.FailedEntries[0]
+		err = fmt.Errorf("%s: %s", aws.ToString(e.ErrorCode), aws.ToString(e.ErrorMessage))
+	}
+	if err == nil {
+		return nil
+	}
+
+	// Restore the previous state of the rule so that the schedule is not changed partially.
+	if prev != nil {
+		if _, e := c.eventBridgeClient.PutRule(ctx, prev); e != nil {
+			c.logger.Error("failed to restore the previous state of rule", zap.String("rule", task.Name), zap.Error(e))
+		}
+	} else {
+		_, e := c.eventBridgeClient.DeleteRule(ctx, &eventbridge.DeleteRuleInput{
+			Name:         aws.String(task.Name),
+			EventBusName: eventBusName,
+		})
+		if e != nil {
+			c.logger.Error("failed to delete the created rule", zap.String("rule", task.Name), zap.Error(e))
+		}
+	}
+	return fmt.Errorf("failed to put target of rule %s: %w", task.Name, err)
+}
+
+func (c *client) ValidateSecrets(ctx context.Context, taskDefinition types.TaskDefinition) error {
+	for _, r := range findSecretReferences(taskDefinition) {
+		ref, err := parseSecretReference(r)
+		if err != nil {
+			return err
+		}
+
+		// The secret may be stored in another region than the one of the task.
+		region := c.awsConfig.Region
+		if ref.Region != "" {
+			region = ref.Region
+		}
+
+		switch ref.Service {
+		case secretServiceSSM:
+			cli := ssm.NewFromConfig(c.awsConfig, func(o *ssm.Options) { o.Region = region })
+			_, err = cli.GetParameter(ctx, &ssm.GetParameterInput{
+				Name:           aws.String(ref.ID),
+				WithDecryption: aws.Bool(false),
+			})
+		case secretServiceSecretsManager:
+			cli := secretsmanager.NewFromConfig(c.awsConfig, func(o *secretsmanager.Options) { o.Region = region })
+			_, err = cli.DescribeSecret(ctx, &secretsmanager.DescribeSecretInput{
+				SecretId: aws.String(ref.ID),
+			})
+		}
+		if err != nil {
+			return fmt.Errorf("unable to access the secret %s: %w", r, err)
+		}
+	}
+	return nil
+}
+
+func (c *client) ValidateServiceConnectNamespace(ctx context.Context, service types.Service) error {
+	cfg := ServiceConnectConfiguration(service)
+	if cfg == nil || !cfg.Enabled {
+		return nil
+	}
+
+	namespace := aws.ToString(cfg.Namespace)
+	if namespace == "" {
+		output, err := c.ecsClient.DescribeClusters(ctx, &ecs.DescribeClustersInput{
+			Clusters: []string{aws.ToString(service.ClusterArn)},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to describe ECS cluster %s: %w", aws.ToString(service.ClusterArn), err)
+		}
+		if len(output.Clusters) == 0 {
+			return fmt.Errorf("the ECS cluster %s was not found", aws.ToString(service.ClusterArn))
+		}
+		if d := output.Clusters[0].ServiceConnectDefaults; d != nil {
+			namespace = aws.ToString(d.Namespace)
+		}
+		if namespace == "" {
+			return fmt.Errorf("namespace of serviceConnectConfiguration is required since ECS cluster %s has no default Service Connect namespace", aws.ToString(service.ClusterArn))
+		}
+	}
+
+	// The namespace can be specified by either its name or its ARN.
+	var nextToken *string
+	for {
+		output, err := c.serviceDiscoveryClient.ListNamespaces(ctx, &servicediscovery.ListNamespacesInput{
+			NextToken: nextToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list Cloud Map namespaces: %w", err)
+		}
+		for _, ns := range output.Namespaces {
+			if aws.ToString(ns.Name) == namespace || aws.ToString(ns.Arn) == namespace {
+				return nil
+			}
+		}
+		if output.NextToken == nil {
+			return fmt.Errorf("the Cloud Map namespace %s used by Service Connect was not found", namespace)
+		}
+		nextToken = output.NextToken
+	}
+}
+
+func (c *client) CreateCodeDeployDeployment(ctx context.Context, cfg appconfig.ECSCodeDeploy, service types.Service, taskDefinition types.TaskDefinition, description string) (string, error) {
+	content, err := makeCodeDeployAppSpec(cfg, service, aws.ToString(taskDefinition.TaskDefinitionArn))
+	if err != nil {
+		return "", err
+	}
+	in := &codedeploy.CreateDeploymentInput{
+		ApplicationName:     aws.String(cfg.ApplicationName),
+		DeploymentGroupName: aws.String(cfg.DeploymentGroupName),
+		Description:         aws.String(description),
+		Revision: &cdtypes.RevisionLocation{
+			RevisionType: cdtypes.RevisionLocationTypeAppSpecContent,
+			AppSpecContent: &cdtypes.AppSpecContent{
+				Content: aws.String(content),
+			},
+		},
+	}
+	if cfg.DeploymentConfigName != "" {
+		in.DeploymentConfigName = aws.String(cfg.DeploymentConfigName)
+	}
+	output, err := c.codeDeployClient.CreateDeployment(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("failed to create CodeDeploy deployment of deployment group %s: %w", cfg.DeploymentGroupName, err)
+	}
+	return aws.ToString(output.DeploymentId), nil
+}
+
+func (c *client) GetCodeDeployDeployment(ctx context.Context, id string) (*CodeDeployDeployment, error) {
+	deployment, err := c.codeDeployClient.GetDeployment(ctx, &codedeploy.GetDeploymentInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get CodeDeploy deployment %s: %w", id, err)
+	}
+	if deployment.DeploymentInfo == nil {
+		return nil, fmt.Errorf("CodeDeploy deployment %s was not found", id)
+	}
+
+	// The lifecycle events are reported for each target, which is the service in case of ECS.
+	targetIDs, err := c.codeDeployClient.ListDeploymentTargets(ctx, &codedeploy.ListDeploymentTargetsInput{
+		DeploymentId: aws.String(id),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list targets of CodeDeploy deployment %s: %w", id, err)
+	}
+	var targets []cdtypes.DeploymentTarget
+	if len(targetIDs.TargetIds) > 0 {
+		output, err := c.codeDeployClient.BatchGetDeploymentTargets(ctx, &codedeploy.BatchGetDeploymentTargetsInput{
+			DeploymentId: aws.String(id),
+			TargetIds:    targetIDs.TargetIds,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get targets of CodeDeploy deployment %s: %w", id, err)
+		}
+		targets = output.DeploymentTargets
+	}
+
+	return makeCodeDeployDeployment(id, deployment.DeploymentInfo, targets), nil
+}
+
+func (c *client) ContinueCodeDeployDeployment(ctx context.Context, id string) error {
+	_, err := c.codeDeployClient.ContinueDeployment(ctx, &codedeploy.ContinueDeploymentInput{
+		DeploymentId:       aws.String(id),
+		DeploymentWaitType: cdtypes.DeploymentWaitTypeReadyWait,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to continue CodeDeploy deployment %s: %w", id, err)
+	}
+	return nil
+}
+
+func (c *client) StopCodeDeployDeployment(ctx context.Context, id string) error {
+	_, err := c.codeDeployClient.StopDeployment(ctx, &codedeploy.StopDeploymentInput{
+		DeploymentId:        aws.String(id),
+		AutoRollbackEnabled: aws.Bool(true),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to stop CodeDeploy deployment %s: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// The statuses of a CodeDeploy deployment.
+const (
+	CodeDeployStatusCreated    = "Created"
+	CodeDeployStatusQueued     = "Queued"
+	CodeDeployStatusInProgress = "InProgress"
+	CodeDeployStatusBaking     = "Baking"
+	CodeDeployStatusReady      = "Ready"
+	CodeDeployStatusSucceeded  = "Succeeded"
+	CodeDeployStatusFailed     = "Failed"
+	CodeDeployStatusStopped    = "Stopped"
+)
+
+// CodeDeployDeployment represents the state of a CodeDeploy deployment of the service.
+type CodeDeployDeployment struct {
+	ID     string
+	Status string
+	// The message describing why the deployment was failed or stopped.
+	ErrorMessage string
+	// The lifecycle events of the deployment in the order of execution.
+	LifecycleEvents []CodeDeployLifecycleEvent
+}
+
+// CodeDeployLifecycleEvent represents the state of a lifecycle event of the CodeDeploy deployment.
+type CodeDeployLifecycleEvent struct {
+	Name   string
+	Status string
+}
+
+// IsCompleted returns true when the deployment will not make any progress anymore.
+func (d CodeDeployDeployment) IsCompleted() bool {
+	switch d.Status {
+	case CodeDeployStatusSucceeded, CodeDeployStatusFailed, CodeDeployStatusStopped:
+		return true
+	}
+	return false
+}
+
+type codeDeployAppSpec struct {
+	Version   string                          `json:"version"`
+	Resources []map[string]codeDeployResource `json:"Resources"`
+	Hooks     []map[string]string             `json:"Hooks,omitempty"`
+}
+
+type codeDeployResource struct {
+	Type       string                       `json:"Type"`
+	Properties codeDeployResourceProperties `json:"Properties"`
+}
+
+type codeDeployResourceProperties struct {
+	TaskDefinition           string                          `json:"TaskDefinition"`
+	LoadBalancerInfo         codeDeployLoadBalancerInfo      `json:"LoadBalancerInfo"`
+	PlatformVersion          string                          `json:"PlatformVersion,omitempty"`
+	NetworkConfiguration     *codeDeployNetworkConfiguration `json:"NetworkConfiguration,omitempty"`
+	CapacityProviderStrategy []codeDeployCapacityProvider    `json:"CapacityProviderStrategy,omitempty"`
+}
+
+type codeDeployLoadBalancerInfo struct {
+	ContainerName string `json:"ContainerName"`
+	ContainerPort int    `json:"ContainerPort"`
+}
+
+type codeDeployNetworkConfiguration struct {
+	AwsvpcConfiguration codeDeployAwsvpcConfiguration `json:"AwsvpcConfiguration"`
+}
+
+type codeDeployAwsvpcConfiguration struct {
+	Subnets        []string `json:"Subnets"`
+	SecurityGroups []string `json:"SecurityGroups,omitempty"`
+	AssignPublicIp string   `json:"AssignPublicIp,omitempty"`
+}
+
+type codeDeployCapacityProvider struct {
+	CapacityProvider string `json:"CapacityProvider"`
+	Base             int32  `json:"Base"`
+	Weight           int32  `json:"Weight"`
+}
+
+// makeCodeDeployAppSpec returns the AppSpec content in JSON format which deploys
+// the given task definition revision to the service.
+func makeCodeDeployAppSpec(cfg config.ECSCodeDeploy, service types.Service, taskDefinitionArn string) (string, error) {
+	props := codeDeployResourceProperties{
+		TaskDefinition: taskDefinitionArn,
+		LoadBalancerInfo: codeDeployLoadBalancerInfo{
+			ContainerName: cfg.ContainerName,
+			ContainerPort: cfg.ContainerPort,
+		},
+		PlatformVersion: aws.ToString(service.PlatformVersion),
+	}
+	if nc := service.NetworkConfiguration; nc != nil && nc.AwsvpcConfiguration != nil {
+		props.NetworkConfiguration = &codeDeployNetworkConfiguration{
+			AwsvpcConfiguration: codeDeployAwsvpcConfiguration{
+				Subnets:        nc.AwsvpcConfiguration.Subnets,
+				SecurityGroups: nc.AwsvpcConfiguration.SecurityGroups,
+				AssignPublicIp: string(nc.AwsvpcConfiguration.AssignPublicIp),
+			},
+		}
+	}
+	for _, s := range service.CapacityProviderStrategy {
+		props.CapacityProviderStrategy = append(props.CapacityProviderStrategy, codeDeployCapacityProvider{
+			CapacityProvider: aws.ToString(s.CapacityProvider),
+			Base:             s.Base,
+			Weight:           s.Weight,
+		})
+	}
+
+	spec := codeDeployAppSpec{
+		Version: "0.0",
+		Resources: []map[string]codeDeployResource{
+			{
+				"TargetService": {
+					Type:       "AWS::ECS::Service",
+					Properties: props,
+				},
+			},
+		},
+	}
+	for _, h := range cfg.Hooks {
+		spec.Hooks = append(spec.Hooks, map[string]string{h.Event: h.FunctionName})
+	}
+
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal AppSpec: %w", err)
+	}
+	return string(data), nil
+}
+
+// makeCodeDeployDeployment builds the state of the deployment from the responses of CodeDeploy API.
+func makeCodeDeployDeployment(id string, info *cdtypes.DeploymentInfo, targets []cdtypes.DeploymentTarget) *CodeDeployDeployment {
+	d := &CodeDeployDeployment{
+		ID:     id,
+		Status: string(info.Status),
+	}
+	if info.ErrorInformation != nil {
+		d.ErrorMessage = aws.ToString(info.ErrorInformation.Message)
+	}
+	for _, t := range targets {
+		if t.EcsTarget == nil {
+			continue
+		}
+		for _, ev := range t.EcsTarget.LifecycleEvents {
+			d.LifecycleEvents = append(d.LifecycleEvents, CodeDeployLifecycleEvent{
+				Name:   aws.ToString(ev.LifecycleEventName),
+				Status: string(ev.Status),
+			})
+		}
+	}
+	return d
+}
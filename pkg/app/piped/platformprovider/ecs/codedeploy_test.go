@@ -0,0 +1,104 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cdtypes "github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestMakeCodeDeployAppSpec(t *testing.T) {
+	t.Parallel()
+
+	cfg := config.ECSCodeDeploy{
+		ApplicationName:     "app",
+		DeploymentGroupName: "group",
+		ContainerName:       "web",
+		ContainerPort:       8080,
+		Hooks: []config.ECSCodeDeployHook{
+			{Event: "AfterAllowTestTraffic", FunctionName: "run-e2e-test"},
+		},
+	}
+
+	testcases := []struct {
+		name     string
+		service  types.Service
+		expected string
+	}{
+		{
+			name:     "minimum service",
+			service:  types.Service{},
+			expected: `{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3","LoadBalancerInfo":{"ContainerName":"web","ContainerPort":8080}}}}],"Hooks":[{"AfterAllowTestTraffic":"run-e2e-test"}]}`,
+		},
+		{
+			name: "fargate service",
+			service: types.Service{
+				PlatformVersion: aws.String("LATEST"),
+				NetworkConfiguration: &types.NetworkConfiguration{
+					AwsvpcConfiguration: &types.AwsVpcConfiguration{
+						Subnets:        []string{"subnet-1"},
+						SecurityGroups: []string{"sg-1"},
+						AssignPublicIp: types.AssignPublicIpDisabled,
+					},
+				},
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 1},
+				},
+			},
+			expected: `{"version":"0.0","Resources":[{"TargetService":{"Type":"AWS::ECS::Service","Properties":{"TaskDefinition":"arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3","LoadBalancerInfo":{"ContainerName":"web","ContainerPort":8080},"PlatformVersion":"LATEST","NetworkConfiguration":{"AwsvpcConfiguration":{"Subnets":["subnet-1"],"SecurityGroups":["sg-1"],"AssignPublicIp":"DISABLED"}},"CapacityProviderStrategy":[{"CapacityProvider":"FARGATE_SPOT","Base":0,"Weight":1}]}}}],"Hooks":[{"AfterAllowTestTraffic":"run-e2e-test"}]}`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := makeCodeDeployAppSpec(cfg, tc.service, "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3")
+			require.NoError(t, err)
+			assert.JSONEq(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCodeDeployDeployment(t *testing.T) {
+	t.Parallel()
+
+	info := &cdtypes.DeploymentInfo{Status: cdtypes.DeploymentStatusReady}
+	target := cdtypes.DeploymentTarget{
+		EcsTarget: &cdtypes.ECSTarget{
+			LifecycleEvents: []cdtypes.LifecycleEvent{
+				{LifecycleEventName: aws.String("BeforeInstall"), Status: cdtypes.LifecycleEventStatusSucceeded},
+			},
+		},
+	}
+
+	got := makeCodeDeployDeployment("d-123", info, []cdtypes.DeploymentTarget{target, {}})
+	assert.Equal(t, &CodeDeployDeployment{
+		ID:     "d-123",
+		Status: CodeDeployStatusReady,
+		LifecycleEvents: []CodeDeployLifecycleEvent{
+			{Name: "BeforeInstall", Status: "Succeeded"},
+		},
+	}, got)
+	assert.False(t, got.IsCompleted())
+
+	got.Status = CodeDeployStatusStopped
+	assert.True(t, got.IsCompleted())
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// DiffTaskDefinitions calculates the diff between two given task definitions.
+// The paths of the returned nodes are built from the field names of types.TaskDefinition,
+// e.g. ContainerDefinitions.0.Image.
+func DiffTaskDefinitions(old, new types.TaskDefinition, opts ...diff.Option) (*diff.Result, error) {
+	return diffObjects(old, new, aws.ToString(new.Family), opts...)
+}
+
+// DiffServiceDefinitions calculates the diff between two given service definitions.
+// The paths of the returned nodes are built from the field names of types.Service,
+// e.g. DesiredCount.
+func DiffServiceDefinitions(old, new types.Service, opts ...diff.Option) (*diff.Result, error) {
+	return diffObjects(old, new, aws.ToString(new.ServiceName), opts...)
+}
+
+// DiffLiveTaskDefinition calculates the diff between the running task definition and the one defined in Git.
+// Only the fields registered by PipeCD are compared, and the fields not specified in Git are ignored
+// since ECS fills them with the default values.
+func DiffLiveTaskDefinition(live, expected types.TaskDefinition) (*diff.Result, error) {
+	return diffLiveObjects(registeredTaskDefinition(live), registeredTaskDefinition(expected), aws.ToString(expected.Family))
+}
+
+// DiffLiveServiceDefinition calculates the diff between the running service and the one defined in Git.
+// Only the fields updated by PipeCD are compared. The desired count is not compared
+// when ignoreDesiredCount is true, e.g. the service is scaled by Application Auto Scaling.
+func DiffLiveServiceDefinition(live, expected types.Service, ignoreDesiredCount bool) (*diff.Result, error) {
+	l, e := updatedServiceDefinition(live), updatedServiceDefinition(expected)
+	if ignoreDesiredCount {
+		l.DesiredCount, e.DesiredCount = 0, 0
+	}
+	return diffLiveObjects(l, e, aws.ToString(expected.ServiceName))
+}
+
+// registeredTaskDefinition returns a task definition containing only the fields
+// passed to RegisterTaskDefinition API.
+func registeredTaskDefinition(td types.TaskDefinition) types.TaskDefinition {
+	return types.TaskDefinition{
+		Family:                  td.Family,
+		ContainerDefinitions:    td.ContainerDefinitions,
+		RequiresCompatibilities: td.RequiresCompatibilities,
+		ExecutionRoleArn:        td.ExecutionRoleArn,
+		TaskRoleArn:             td.TaskRoleArn,
+		NetworkMode:             td.NetworkMode,
+		Volumes:                 td.Volumes,
+		RuntimePlatform:         td.RuntimePlatform,
+		Cpu:                     td.Cpu,
+		Memory:                  td.Memory,
+	}
+}
+
+// updatedServiceDefinition returns a service containing only the fields
+// passed to UpdateService API.
+func updatedServiceDefinition(s types.Service) types.Service {
+	return types.Service{
+		ServiceName:          s.ServiceName,
+		DesiredCount:         s.DesiredCount,
+		EnableExecuteCommand: s.EnableExecuteCommand,
+		PlacementStrategy:    s.PlacementStrategy,
+	}
+}
+
+func diffLiveObjects(live, expected interface{}, key string) (*diff.Result, error) {
+	lu, err := toUnstructured(live)
+	if err != nil {
+		return nil, err
+	}
+	eu, err := toUnstructured(expected)
+	if err != nil {
+		return nil, err
+	}
+	removeUnspecifiedFields(eu.Object)
+	return diff.DiffUnstructureds(lu, eu, key,
+		diff.WithEquateEmpty(),
+		diff.WithIgnoreAddingMapKeys(),
+		diff.WithCompareNumberAndNumericString(),
+	)
+}
+
+// removeUnspecifiedFields removes the nil and empty string fields which were not specified in the definition file
+// so that they are not compared with the default values filled by ECS.
+func removeUnspecifiedFields(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, f := range v {
+			if f == nil || f == "" {
+				delete(v, k)
+				continue
+			}
+			removeUnspecifiedFields(f)
+		}
+	case []interface{}:
+		for _, f := range v {
+			removeUnspecifiedFields(f)
+		}
+	}
+}
+
+func diffObjects(old, new interface{}, key string, opts ...diff.Option) (*diff.Result, error) {
+	ou, err := toUnstructured(old)
+	if err != nil {
+		return nil, err
+	}
+	nu, err := toUnstructured(new)
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(ou, nu, key, opts...)
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,164 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffTaskDefinitions(t *testing.T) {
+	t.Parallel()
+
+	old, err := parseTaskDefinition([]byte(`
+family: nginx
+cpu: 256
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+`))
+	require.NoError(t, err)
+
+	new, err := parseTaskDefinition([]byte(`
+family: nginx
+cpu: 256
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.1.0
+`))
+	require.NoError(t, err)
+
+	result, err := DiffTaskDefinitions(old, old)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	result, err = DiffTaskDefinitions(old, new)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.NumNodes())
+
+	node := result.Nodes()[0]
+	assert.Equal(t, "ContainerDefinitions.0.Image", node.PathString)
+	assert.Equal(t, "gcr.io/pipecd/helloworld:v1.0.0", node.StringX())
+	assert.Equal(t, "gcr.io/pipecd/helloworld:v1.1.0", node.StringY())
+}
+
+func TestDiffServiceDefinitions(t *testing.T) {
+	t.Parallel()
+
+	old, err := parseServiceDefinition([]byte(`
+cluster: arn:aws:ecs:ap-northeast-1:123456789012:cluster/test-cluster
+serviceName: nginx-service
+desiredCount: 2
+`))
+	require.NoError(t, err)
+
+	new, err := parseServiceDefinition([]byte(`
+cluster: arn:aws:ecs:ap-northeast-1:123456789012:cluster/test-cluster
+serviceName: nginx-service
+desiredCount: 3
+`))
+	require.NoError(t, err)
+
+	result, err := DiffServiceDefinitions(old, new)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.NumNodes())
+	assert.Equal(t, "DesiredCount", result.Nodes()[0].PathString)
+}
+
+func TestDiffLiveTaskDefinition(t *testing.T) {
+	t.Parallel()
+
+	expected, err := parseTaskDefinition([]byte(`
+family: nginx
+cpu: 256
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+    portMappings:
+      - containerPort: 80
+`))
+	require.NoError(t, err)
+
+	live := types.TaskDefinition{
+		TaskDefinitionArn: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/nginx:3"),
+		Revision:          3,
+		Status:            types.TaskDefinitionStatusActive,
+		Family:            aws.String("nginx"),
+		Cpu:               aws.String("256"),
+		NetworkMode:       types.NetworkModeBridge,
+		ContainerDefinitions: []types.ContainerDefinition{
+			{
+				Name:      aws.String("web"),
+				Image:     aws.String("gcr.io/pipecd/helloworld:v1.0.0"),
+				Essential: aws.Bool(true),
+				PortMappings: []types.PortMapping{
+					{ContainerPort: aws.Int32(80), HostPort: aws.Int32(0), Protocol: types.TransportProtocolTcp},
+				},
+			},
+		},
+	}
+
+	result, err := DiffLiveTaskDefinition(live, expected)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	live.ContainerDefinitions[0].Image = aws.String("gcr.io/pipecd/helloworld:v0.9.0")
+	result, err = DiffLiveTaskDefinition(live, expected)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.NumNodes())
+
+	node := result.Nodes()[0]
+	assert.Equal(t, "ContainerDefinitions.0.Image", node.PathString)
+	assert.Equal(t, "gcr.io/pipecd/helloworld:v0.9.0", node.StringX())
+	assert.Equal(t, "gcr.io/pipecd/helloworld:v1.0.0", node.StringY())
+}
+
+func TestDiffLiveServiceDefinition(t *testing.T) {
+	t.Parallel()
+
+	expected, err := parseServiceDefinition([]byte(`
+cluster: test-cluster
+serviceName: nginx-service
+desiredCount: 2
+`))
+	require.NoError(t, err)
+
+	live := types.Service{
+		ClusterArn:   aws.String("arn:aws:ecs:ap-northeast-1:123456789012:cluster/test-cluster"),
+		ServiceArn:   aws.String("arn:aws:ecs:ap-northeast-1:123456789012:service/test-cluster/nginx-service"),
+		ServiceName:  aws.String("nginx-service"),
+		Status:       aws.String("ACTIVE"),
+		DesiredCount: 2,
+		RunningCount: 2,
+	}
+
+	result, err := DiffLiveServiceDefinition(live, expected, false)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	live.DesiredCount = 5
+	result, err = DiffLiveServiceDefinition(live, expected, false)
+	require.NoError(t, err)
+	require.Equal(t, 1, result.NumNodes())
+	assert.Equal(t, "DesiredCount", result.Nodes()[0].PathString)
+
+	result, err = DiffLiveServiceDefinition(live, expected, true)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+}
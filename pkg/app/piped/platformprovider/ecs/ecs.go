@@ -16,8 +16,11 @@ package ecs
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"path/filepath"
 	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
@@ -33,35 +36,102 @@ const (
 	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
 	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
 	ManagedByPiped   string = "piped"
+	// Hash value of the registered task definition, used to avoid registering the same revision again.
+	LabelTaskDefinitionHash string = "pipecd-dev-taskdef-hash"
 )
 
 // Client is wrapper of ECS client.
 type Client interface {
 	ECS
 	ELB
+	AppMesh
+	AutoScaling
+	EventBridge
+	Secrets
 }
 
 type ECS interface {
 	ServiceExists(ctx context.Context, clusterName string, servicesName string) (bool, error)
 	CreateService(ctx context.Context, service types.Service) (*types.Service, error)
 	UpdateService(ctx context.Context, service types.Service) (*types.Service, error)
-	WaitServiceStable(ctx context.Context, service types.Service) error
+	WaitServiceStable(ctx context.Context, service types.Service, respectCircuitBreaker bool) error
+	// GetServiceHealth returns the health of the given service including the health of the targets
+	// registered by its tasks and the service events occurred after the given time.
+	GetServiceHealth(ctx context.Context, service types.Service, since time.Time, respectCircuitBreaker bool) (*ServiceHealth, error)
 	RegisterTaskDefinition(ctx context.Context, taskDefinition types.TaskDefinition) (*types.TaskDefinition, error)
-	RunTask(ctx context.Context, taskDefinition types.TaskDefinition, clusterArn string, launchType string, awsVpcConfiguration *config.ECSVpcConfiguration, tags []types.Tag) error
+	GetTaskDefinition(ctx context.Context, taskDefinitionArn string) (*types.TaskDefinition, error)
+	// GetRunningTaskDefinition returns the task definition used by the PRIMARY task set of the given service,
+	// or the one of the service itself in case it is deployed by the ECS deployment controller.
+	// False is returned when the service or its PRIMARY task set does not exist.
+	GetRunningTaskDefinition(ctx context.Context, service types.Service) (*types.TaskDefinition, bool, error)
+	// FindSameTaskDefinition returns the latest ACTIVE revision of the family of the given task definition
+	// when the revision was registered from the same definition.
+	FindSameTaskDefinition(ctx context.Context, taskDefinition types.TaskDefinition) (*types.TaskDefinition, bool, error)
+	// PruneTaskDefinitions deregisters the ACTIVE revisions of the given family
+	// except the latest keep ones, and returns the ARNs of the deregistered revisions.
+	PruneTaskDefinitions(ctx context.Context, family string, keep int) ([]string, error)
+	RunTask(ctx context.Context, taskDefinition types.TaskDefinition, clusterArn string, launchType string, awsVpcConfiguration *config.ECSVpcConfiguration, tags []types.Tag) ([]types.Task, error)
+	WaitTasksStopped(ctx context.Context, clusterArn string, taskArns []string) ([]types.Task, error)
+	// StopTask stops the given running or pending task with the given reason.
+	StopTask(ctx context.Context, clusterArn, taskArn, reason string) error
 	GetServiceTaskSets(ctx context.Context, service types.Service) ([]*types.TaskSet, error)
 	CreateTaskSet(ctx context.Context, service types.Service, taskDefinition types.TaskDefinition, targetGroup *types.LoadBalancer, scale int) (*types.TaskSet, error)
 	DeleteTaskSet(ctx context.Context, taskSet types.TaskSet) error
 	UpdateServicePrimaryTaskSet(ctx context.Context, service types.Service, taskSet types.TaskSet) (*types.TaskSet, error)
 	TagResource(ctx context.Context, resourceArn string, tags []types.Tag) error
+	// ListClusters returns the ARNs of all clusters.
+	ListClusters(ctx context.Context) ([]string, error)
+	// GetServices returns all services managed by PipeCD in the given cluster.
+	GetServices(ctx context.Context, clusterName string) ([]*types.Service, error)
+	// GetServiceTasks returns all running tasks of the given service.
+	GetServiceTasks(ctx context.Context, service types.Service) ([]*types.Task, error)
 }
 
 type ELB interface {
 	GetListenerArns(ctx context.Context, targetGroup types.LoadBalancer) ([]string, error)
 	// ModifyListeners modifies the actions of type ActionTypeEnumForward to perform routing traffic
 	// to the given target groups. Other actions won't be modified.
+	// All forward actions of the default rules are modified, while only the ones routing traffic
+	// to the given target groups are modified for the other listener rules.
 	ModifyListeners(ctx context.Context, listenerArns []string, routingTrafficCfg RoutingTrafficConfig) error
 }
 
+type AppMesh interface {
+	// ModifyMeshRoute updates the weighted targets of the App Mesh route to perform routing traffic
+	// to the PRIMARY and CANARY virtual nodes. Other fields of the route won't be modified.
+	ModifyMeshRoute(ctx context.Context, mesh config.ECSAppMesh, primary, canary int) error
+}
+
+type AutoScaling interface {
+	// ApplyAutoScaling registers the scalable target and puts the scaling policies of the service.
+	// The registered policies which are not declared in the given configuration are deleted.
+	ApplyAutoScaling(ctx context.Context, service types.Service, autoScaling config.ECSAutoScaling) error
+}
+
+type EventBridge interface {
+	// ApplyScheduledTask creates or updates the EventBridge rule and its target to run the given task definition.
+	// The previous state of the rule is restored when the target could not be updated.
+	ApplyScheduledTask(ctx context.Context, task ScheduledTask, taskDefinition types.TaskDefinition, tags []types.Tag) error
+}
+
+type Secrets interface {
+	// ValidateSecrets checks whether all the SSM parameters and the Secrets Manager secrets
+	// referred from the given task definition exist and are accessible.
+	ValidateSecrets(ctx context.Context, taskDefinition types.TaskDefinition) error
+	// ValidateServiceConnectNamespace checks whether the Cloud Map namespace used by Service Connect
+	// of the given service exists. The default namespace of the cluster is checked when no namespace was specified.
+	ValidateServiceConnectNamespace(ctx context.Context, service types.Service) error
+
+	// CreateCodeDeployDeployment creates a CodeDeploy deployment which deploys the given task definition revision
+	// to the service by the blue/green deployment, and returns the ID of the created deployment.
+	CreateCodeDeployDeployment(ctx context.Context, cfg config.ECSCodeDeploy, service types.Service, taskDefinition types.TaskDefinition, description string) (string, error)
+	GetCodeDeployDeployment(ctx context.Context, id string) (*CodeDeployDeployment, error)
+	// ContinueCodeDeployDeployment starts shifting the traffic of the deployment waiting for the manual reroute.
+	ContinueCodeDeployDeployment(ctx context.Context, id string) error
+	// StopCodeDeployDeployment stops the given deployment and rolls back the service to the original task set.
+	StopCodeDeployDeployment(ctx context.Context, id string) error
+}
+
 // Registry holds a pool of aws client wrappers.
 type Registry interface {
 	Client(name string, cfg *config.PlatformProviderECSConfig, logger *zap.Logger) (Client, error)
@@ -73,17 +143,75 @@ func LoadServiceDefinition(appDir, serviceDefinitionFilename string) (types.Serv
 	return loadServiceDefinition(path)
 }
 
+// ValidateServiceDefinition returns an error if the given service definition
+// can not be used to create task sets.
+func ValidateServiceDefinition(service types.Service) error {
+	if err := validateRequiredServiceFields(service); err != nil {
+		return err
+	}
+	if err := validateSchedulingStrategy(service); err != nil {
+		return err
+	}
+	if err := validateServiceConnect(service); err != nil {
+		return err
+	}
+	return validateCapacityProviderStrategy(service)
+}
+
+// ValidateTaskDefinition returns an error if the given task definition
+// can not be registered or can not be run with the given launch type.
+// The launch type can be empty when it is decided by the capacity providers.
+func ValidateTaskDefinition(taskDefinition types.TaskDefinition, launchType types.LaunchType) error {
+	return validateTaskDefinition(taskDefinition, launchType)
+}
+
+// ValidateServiceTaskDefinition returns an error if the given task definition
+// can not be used by the given service, e.g. the port used by the load balancer is not exposed.
+func ValidateServiceTaskDefinition(taskDefinition types.TaskDefinition, service types.Service, targetGroups config.ECSTargetGroups) error {
+	if err := validateTaskDefinition(taskDefinition, serviceLaunchType(service)); err != nil {
+		return err
+	}
+	return validateServicePorts(taskDefinition, service, targetGroups)
+}
+
 // LoadTaskDefinition returns TaskDefinition object from a given task definition file.
 func LoadTaskDefinition(appDir, taskDefinition string) (types.TaskDefinition, error) {
 	path := filepath.Join(appDir, taskDefinition)
 	return loadTaskDefinition(path)
 }
 
+// LoadScheduledTask returns ScheduledTask object from a given scheduled task file.
+func LoadScheduledTask(appDir, scheduledTaskFilename string) (ScheduledTask, error) {
+	path := filepath.Join(appDir, scheduledTaskFilename)
+	return loadScheduledTask(path)
+}
+
+// LoadServiceRegistry returns ServiceRegistry object from the given raw definition.
+// Nil is returned when the definition is empty.
+func LoadServiceRegistry(data json.RawMessage) (*types.ServiceRegistry, error) {
+	return loadServiceRegistry(data)
+}
+
 // LoadTargetGroups returns primary & canary target groups according to the defined in pipe definition file.
 func LoadTargetGroups(targetGroups config.ECSTargetGroups) (*types.LoadBalancer, *types.LoadBalancer, error) {
 	return loadTargetGroups(targetGroups)
 }
 
+// WithAssumeRole returns the platform provider config to be used for the given application.
+// The role to assume and its external ID are overridden when the application specifies its own ones.
+// The external ID can be specified alone to assume the role of the platform provider.
+func WithAssumeRole(cfg *config.PlatformProviderECSConfig, ecsInput config.ECSDeploymentInput) *config.PlatformProviderECSConfig {
+	if ecsInput.AssumeRoleARN == "" && ecsInput.ExternalID == "" {
+		return cfg
+	}
+	c := *cfg
+	if ecsInput.AssumeRoleARN != "" {
+		c.AssumeRoleARN = ecsInput.AssumeRoleARN
+	}
+	c.ExternalID = ecsInput.ExternalID
+	return &c
+}
+
 type registry struct {
 	clients  map[string]Client
 	mu       sync.RWMutex
@@ -91,15 +219,26 @@ type registry struct {
 }
 
 func (r *registry) Client(name string, cfg *config.PlatformProviderECSConfig, logger *zap.Logger) (Client, error) {
+	if cfg.ExternalID != "" && cfg.AssumeRoleARN == "" {
+		return nil, fmt.Errorf("externalID can not be used without assumeRoleARN")
+	}
+
+	// The clients are separated by the assumed role
+	// since each application can use its own role.
+	key := name
+	if cfg.AssumeRoleARN != "" {
+		key = fmt.Sprintf("%s/%s/%s", name, cfg.AssumeRoleARN, cfg.ExternalID)
+	}
+
 	r.mu.RLock()
-	client, ok := r.clients[name]
+	client, ok := r.clients[key]
 	r.mu.RUnlock()
 	if ok {
 		return client, nil
 	}
 
-	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
-		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+	c, err, _ := r.newGroup.Do(key, func() (interface{}, error) {
+		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, cfg.AssumeRoleARN, cfg.ExternalID, logger)
 	})
 	if err != nil {
 		return nil, err
@@ -107,7 +246,7 @@ func (r *registry) Client(name string, cfg *config.PlatformProviderECSConfig, lo
 
 	client = c.(Client)
 	r.mu.Lock()
-	r.clients[name] = client
+	r.clients[key] = client
 	r.mu.Unlock()
 
 	return client, nil
@@ -0,0 +1,73 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestWithAssumeRole(t *testing.T) {
+	t.Parallel()
+
+	cfg := &config.PlatformProviderECSConfig{
+		Region:        "ap-northeast-1",
+		AssumeRoleARN: "arn:aws:iam::111111111111:role/piped",
+	}
+
+	got := WithAssumeRole(cfg, config.ECSDeploymentInput{})
+	assert.Same(t, cfg, got)
+
+	got = WithAssumeRole(cfg, config.ECSDeploymentInput{
+		AssumeRoleARN: "arn:aws:iam::222222222222:role/deployer",
+		ExternalID:    "external-id",
+	})
+	assert.Equal(t, &config.PlatformProviderECSConfig{
+		Region:        "ap-northeast-1",
+		AssumeRoleARN: "arn:aws:iam::222222222222:role/deployer",
+		ExternalID:    "external-id",
+	}, got)
+	// The original config must not be modified.
+	assert.Equal(t, "arn:aws:iam::111111111111:role/piped", cfg.AssumeRoleARN)
+
+	// The external ID alone is used to assume the role of the platform provider.
+	got = WithAssumeRole(cfg, config.ECSDeploymentInput{
+		ExternalID: "external-id",
+	})
+	assert.Equal(t, &config.PlatformProviderECSConfig{
+		Region:        "ap-northeast-1",
+		AssumeRoleARN: "arn:aws:iam::111111111111:role/piped",
+		ExternalID:    "external-id",
+	}, got)
+}
+
+func TestRegistryClientExternalIDWithoutRole(t *testing.T) {
+	t.Parallel()
+
+	r := &registry{
+		clients:  make(map[string]Client),
+		newGroup: &singleflight.Group{},
+	}
+	_, err := r.Client("ecs", &config.PlatformProviderECSConfig{
+		Region:     "ap-northeast-1",
+		ExternalID: "external-id",
+	}, zap.NewNop())
+	assert.Error(t, err)
+}
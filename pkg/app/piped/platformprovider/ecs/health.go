@@ -0,0 +1,138 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// ServiceHealth represents the health of a service and its tasks at a point of time.
+type ServiceHealth struct {
+	// Healthy is true when the service reached its steady state
+	// and all targets registered by its tasks passed the health checks.
+	Healthy bool
+	// Reason describes why the service is not healthy yet.
+	Reason string
+	// Events contains the service events occurred after the given time, oldest first.
+	Events []types.ServiceEvent
+}
+
+// serviceTargetGroupArns returns the ARNs of all target groups used by the service and its task sets.
+func serviceTargetGroupArns(service types.Service) []string {
+	var (
+		arns = make([]string, 0, len(service.LoadBalancers))
+		seen = make(map[string]struct{})
+	)
+	add := func(lbs []types.LoadBalancer) {
+		for _, lb := range lbs {
+			arn := aws.ToString(lb.TargetGroupArn)
+			if arn == "" {
+				continue
+			}
+			if _, ok := seen[arn]; ok {
+				continue
+			}
+			seen[arn] = struct{}{}
+			arns = append(arns, arn)
+		}
+	}
+
+	add(service.LoadBalancers)
+	for _, ts := range service.TaskSets {
+		add(ts.LoadBalancers)
+	}
+	return arns
+}
+
+// makeServiceHealth evaluates the health of the given service by its deployments, its task sets
+// and the health of the targets in the given target groups.
+func makeServiceHealth(service types.Service, targetGroupArns []string, targetHealths map[string][]elbtypes.TargetHealthDescription, since time.Time) ServiceHealth {
+	health := ServiceHealth{
+		Events: serviceEventsSince(service, since),
+	}
+
+	if service.PendingCount > 0 || service.RunningCount < service.DesiredCount {
+		health.Reason = fmt.Sprintf("%d/%d tasks are running", service.RunningCount, service.DesiredCount)
+		return health
+	}
+	if len(service.Deployments) > 1 {
+		health.Reason = fmt.Sprintf("%d deployments are in progress", len(service.Deployments))
+		return health
+	}
+	for _, ts := range service.TaskSets {
+		if ts.StabilityStatus != types.StabilityStatusSteadyState {
+			health.Reason = fmt.Sprintf("task set %s is %s", aws.ToString(ts.Id), ts.StabilityStatus)
+			return health
+		}
+	}
+
+	for _, arn := range targetGroupArns {
+		healthy := 0
+		for _, desc := range targetHealths[arn] {
+			if desc.TargetHealth == nil {
+				continue
+			}
+			switch desc.TargetHealth.State {
+			case elbtypes.TargetHealthStateEnumHealthy:
+				healthy++
+			case elbtypes.TargetHealthStateEnumInitial, elbtypes.TargetHealthStateEnumUnhealthy, elbtypes.TargetHealthStateEnumUnavailable:
+				health.Reason = fmt.Sprintf("target %s of target group %s is %s (%s)",
+					targetID(desc.Target),
+					arn,
+					desc.TargetHealth.State,
+					desc.TargetHealth.Reason,
+				)
+				return health
+			}
+			// The draining and unused targets do not affect the health of the new tasks.
+		}
+		if healthy == 0 && service.DesiredCount > 0 {
+			health.Reason = fmt.Sprintf("no healthy target is registered in target group %s", arn)
+			return health
+		}
+	}
+
+	health.Healthy = true
+	return health
+}
+
+// serviceEventsSince returns the events of the given service occurred after the given time, oldest first.
+func serviceEventsSince(service types.Service, since time.Time) []types.ServiceEvent {
+	// The events of a service are ordered from the newest.
+	events := make([]types.ServiceEvent, 0, len(service.Events))
+	for i := len(service.Events) - 1; i >= 0; i-- {
+		e := service.Events[i]
+		if e.CreatedAt == nil || !e.CreatedAt.After(since) {
+			continue
+		}
+		events = append(events, e)
+	}
+	return events
+}
+
+func targetID(target *elbtypes.TargetDescription) string {
+	if target == nil {
+		return ""
+	}
+	if target.Port == nil {
+		return aws.ToString(target.Id)
+	}
+	return fmt.Sprintf("%s:%d", aws.ToString(target.Id), *target.Port)
+}
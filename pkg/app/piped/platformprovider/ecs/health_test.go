@@ -0,0 +1,169 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceTargetGroupArns(t *testing.T) {
+	t.Parallel()
+
+	service := types.Service{
+		LoadBalancers: []types.LoadBalancer{
+			{TargetGroupArn: aws.String("tg-primary")},
+		},
+		TaskSets: []types.TaskSet{
+			{LoadBalancers: []types.LoadBalancer{{TargetGroupArn: aws.String("tg-primary")}}},
+			{LoadBalancers: []types.LoadBalancer{{TargetGroupArn: aws.String("tg-canary")}}},
+			{LoadBalancers: []types.LoadBalancer{{LoadBalancerName: aws.String("classic")}}},
+		},
+	}
+	assert.Equal(t, []string{"tg-primary", "tg-canary"}, serviceTargetGroupArns(service))
+}
+
+func TestMakeServiceHealth(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	steadyService := types.Service{
+		DesiredCount: 2,
+		RunningCount: 2,
+		Deployments:  []types.Deployment{{Status: aws.String("PRIMARY")}},
+	}
+	target := func(id string, state elbtypes.TargetHealthStateEnum) elbtypes.TargetHealthDescription {
+		return elbtypes.TargetHealthDescription{
+			Target:       &elbtypes.TargetDescription{Id: aws.String(id), Port: aws.Int32(80)},
+			TargetHealth: &elbtypes.TargetHealth{State: state, Reason: elbtypes.TargetHealthReasonEnumFailedHealthChecks},
+		}
+	}
+
+	testcases := []struct {
+		name            string
+		service         types.Service
+		targetGroupArns []string
+		targetHealths   map[string][]elbtypes.TargetHealthDescription
+		expectedHealthy bool
+		expectedReason  string
+	}{
+		{
+			name: "tasks are pending",
+			service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 1,
+				PendingCount: 1,
+			},
+			expectedReason: "1/2 tasks are running",
+		},
+		{
+			name: "deployment is in progress",
+			service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 2,
+				Deployments:  []types.Deployment{{Status: aws.String("PRIMARY")}, {Status: aws.String("ACTIVE")}},
+			},
+			expectedReason: "2 deployments are in progress",
+		},
+		{
+			name: "task set is stabilizing",
+			service: types.Service{
+				DesiredCount: 2,
+				RunningCount: 2,
+				TaskSets: []types.TaskSet{
+					{Id: aws.String("ecs-svc/1"), StabilityStatus: types.StabilityStatusSteadyState},
+					{Id: aws.String("ecs-svc/2"), StabilityStatus: types.StabilityStatusStabilizing},
+				},
+			},
+			expectedReason: "task set ecs-svc/2 is STABILIZING",
+		},
+		{
+			name:            "target is unhealthy",
+			service:         steadyService,
+			targetGroupArns: []string{"tg"},
+			targetHealths: map[string][]elbtypes.TargetHealthDescription{
+				"tg": {
+					target("10.0.0.1", elbtypes.TargetHealthStateEnumHealthy),
+					target("10.0.0.2", elbtypes.TargetHealthStateEnumUnhealthy),
+				},
+			},
+			expectedReason: "target 10.0.0.2:80 of target group tg is unhealthy (Target.FailedHealthChecks)",
+		},
+		{
+			name:            "no healthy target",
+			service:         steadyService,
+			targetGroupArns: []string{"tg"},
+			targetHealths: map[string][]elbtypes.TargetHealthDescription{
+				"tg": {
+					target("10.0.0.1", elbtypes.TargetHealthStateEnumDraining),
+				},
+			},
+			expectedReason: "no healthy target is registered in target group tg",
+		},
+		{
+			name:            "healthy ignoring draining targets",
+			service:         steadyService,
+			targetGroupArns: []string{"tg"},
+			targetHealths: map[string][]elbtypes.TargetHealthDescription{
+				"tg": {
+					target("10.0.0.1", elbtypes.TargetHealthStateEnumHealthy),
+					target("10.0.0.2", elbtypes.TargetHealthStateEnumHealthy),
+					target("10.0.0.3", elbtypes.TargetHealthStateEnumDraining),
+				},
+			},
+			expectedHealthy: true,
+		},
+		{
+			name:            "healthy without target group",
+			service:         steadyService,
+			expectedHealthy: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			health := makeServiceHealth(tc.service, tc.targetGroupArns, tc.targetHealths, now)
+			assert.Equal(t, tc.expectedHealthy, health.Healthy)
+			assert.Equal(t, tc.expectedReason, health.Reason)
+		})
+	}
+}
+
+func TestServiceEventsSince(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)
+	service := types.Service{
+		Events: []types.ServiceEvent{
+			{Id: aws.String("3"), CreatedAt: aws.Time(now.Add(2 * time.Minute))},
+			{Id: aws.String("2"), CreatedAt: aws.Time(now.Add(time.Minute))},
+			{Id: aws.String("1"), CreatedAt: aws.Time(now)},
+		},
+	}
+
+	events := serviceEventsSince(service, now)
+	ids := make([]string, 0, len(events))
+	for _, e := range events {
+		ids = append(ids, aws.ToString(e.Id))
+	}
+	assert.Equal(t, []string{"2", "3"}, ids)
+}
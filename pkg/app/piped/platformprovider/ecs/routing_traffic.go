@@ -14,9 +14,72 @@
 
 package ecs
 
+import (
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
 type RoutingTrafficConfig []targetGroupWeight
 
 type targetGroupWeight struct {
 	TargetGroupArn string
 	Weight         int
 }
+
+// hasTargetGroup checks whether the given forward action is routing traffic
+// to at least one of the target groups of this config.
+func (c RoutingTrafficConfig) hasTargetGroup(action elbtypes.Action) bool {
+	arns := make([]string, 0, 1)
+	if action.TargetGroupArn != nil {
+		arns = append(arns, *action.TargetGroupArn)
+	}
+	if action.ForwardConfig != nil {
+		for _, tg := range action.ForwardConfig.TargetGroups {
+			arns = append(arns, aws.ToString(tg.TargetGroupArn))
+		}
+	}
+	for _, arn := range arns {
+		for _, w := range c {
+			if w.TargetGroupArn == arn {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// modifyForwardActions returns a copy of the given actions where the forward actions are replaced
+// by the ones routing traffic to the target groups of this config.
+// In case onlyRelated is true, only the forward actions which are routing traffic to
+// the target groups of this config will be modified.
+// The second returned value tells whether any action was modified.
+func (c RoutingTrafficConfig) modifyForwardActions(actions []elbtypes.Action, onlyRelated bool) ([]elbtypes.Action, bool) {
+	var (
+		modified        bool
+		modifiedActions = make([]elbtypes.Action, 0, len(actions))
+	)
+	for _, action := range actions {
+		if action.Type != elbtypes.ActionTypeEnumForward || (onlyRelated && !c.hasTargetGroup(action)) {
+			// Keep other actions unchanged.
+			modifiedActions = append(modifiedActions, action)
+			continue
+		}
+
+		targetGroups := make([]elbtypes.TargetGroupTuple, 0, len(c))
+		for _, w := range c {
+			targetGroups = append(targetGroups, elbtypes.TargetGroupTuple{
+				TargetGroupArn: aws.String(w.TargetGroupArn),
+				Weight:         aws.Int32(int32(w.Weight)),
+			})
+		}
+		modifiedActions = append(modifiedActions, elbtypes.Action{
+			Type:  elbtypes.ActionTypeEnumForward,
+			Order: action.Order,
+			ForwardConfig: &elbtypes.ForwardActionConfig{
+				TargetGroups: targetGroups,
+			},
+		})
+		modified = true
+	}
+	return modifiedActions, modified
+}
@@ -0,0 +1,148 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	elbtypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModifyForwardActions(t *testing.T) {
+	t.Parallel()
+
+	cfg := RoutingTrafficConfig{
+		{
+			TargetGroupArn: "primary-tg",
+			Weight:         20,
+		},
+		{
+			TargetGroupArn: "canary-tg",
+			Weight:         80,
+		},
+	}
+	expectedForward := elbtypes.Action{
+		Type:  elbtypes.ActionTypeEnumForward,
+		Order: aws.Int32(1),
+		ForwardConfig: &elbtypes.ForwardActionConfig{
+			TargetGroups: []elbtypes.TargetGroupTuple{
+				{
+					TargetGroupArn: aws.String("primary-tg"),
+					Weight:         aws.Int32(20),
+				},
+				{
+					TargetGroupArn: aws.String("canary-tg"),
+					Weight:         aws.Int32(80),
+				},
+			},
+		},
+	}
+	redirect := elbtypes.Action{
+		Type: elbtypes.ActionTypeEnumRedirect,
+	}
+
+	testcases := []struct {
+		name             string
+		actions          []elbtypes.Action
+		onlyRelated      bool
+		expectedActions  []elbtypes.Action
+		expectedModified bool
+	}{
+		{
+			name: "modify forward action of default rule",
+			actions: []elbtypes.Action{
+				{
+					Type:           elbtypes.ActionTypeEnumForward,
+					Order:          aws.Int32(1),
+					TargetGroupArn: aws.String("other-tg"),
+				},
+			},
+			onlyRelated:      false,
+			expectedActions:  []elbtypes.Action{expectedForward},
+			expectedModified: true,
+		},
+		{
+			name: "modify forward action of related rule",
+			actions: []elbtypes.Action{
+				{
+					Type:           elbtypes.ActionTypeEnumForward,
+					Order:          aws.Int32(1),
+					TargetGroupArn: aws.String("primary-tg"),
+				},
+			},
+			onlyRelated:      true,
+			expectedActions:  []elbtypes.Action{expectedForward},
+			expectedModified: true,
+		},
+		{
+			name: "modify weighted forward action of related rule",
+			actions: []elbtypes.Action{
+				{
+					Type:  elbtypes.ActionTypeEnumForward,
+					Order: aws.Int32(1),
+					ForwardConfig: &elbtypes.ForwardActionConfig{
+						TargetGroups: []elbtypes.TargetGroupTuple{
+							{
+								TargetGroupArn: aws.String("primary-tg"),
+								Weight:         aws.Int32(100),
+							},
+							{
+								TargetGroupArn: aws.String("canary-tg"),
+								Weight:         aws.Int32(0),
+							},
+						},
+					},
+				},
+			},
+			onlyRelated:      true,
+			expectedActions:  []elbtypes.Action{expectedForward},
+			expectedModified: true,
+		},
+		{
+			name: "do not modify unrelated rule",
+			actions: []elbtypes.Action{
+				{
+					Type:           elbtypes.ActionTypeEnumForward,
+					TargetGroupArn: aws.String("other-tg"),
+				},
+			},
+			onlyRelated: true,
+			expectedActions: []elbtypes.Action{
+				{
+					Type:           elbtypes.ActionTypeEnumForward,
+					TargetGroupArn: aws.String("other-tg"),
+				},
+			},
+			expectedModified: false,
+		},
+		{
+			name:             "keep non-forward action",
+			actions:          []elbtypes.Action{redirect},
+			onlyRelated:      false,
+			expectedActions:  []elbtypes.Action{redirect},
+			expectedModified: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			actions, modified := cfg.modifyForwardActions(tc.actions, tc.onlyRelated)
+			assert.Equal(t, tc.expectedActions, actions)
+			assert.Equal(t, tc.expectedModified, modified)
+		})
+	}
+}
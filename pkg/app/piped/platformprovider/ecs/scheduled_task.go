@@ -0,0 +1,155 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	scheduleStateEnabled  = "ENABLED"
+	scheduleStateDisabled = "DISABLED"
+)
+
+// ScheduledTask represents an EventBridge rule which runs the task periodically.
+type ScheduledTask struct {
+	// The name of the EventBridge rule.
+	Name string `json:"name"`
+	// The description of the rule.
+	Description string `json:"description,omitempty"`
+	// The schedule expression of the rule, e.g. "cron(0 3 * * ? *)" or "rate(1 hour)".
+	ScheduleExpression string `json:"scheduleExpression"`
+	// The state of the rule. ENABLED or DISABLED.
+	// Default is ENABLED.
+	State string `json:"state,omitempty"`
+	// The name of the event bus associated with the rule.
+	// Empty means the default event bus.
+	EventBusName string `json:"eventBusName,omitempty"`
+	// The ID of the rule target. Default is the rule name.
+	TargetID string `json:"targetId,omitempty"`
+	// The ARN of the cluster where the task runs.
+	// Default is the clusterArn of the deployment input.
+	ClusterArn string `json:"clusterArn,omitempty"`
+	// The ARN of the IAM role used by EventBridge to run the task.
+	RoleArn string `json:"roleArn"`
+	// The number of tasks to run. Default is 1.
+	TaskCount int32 `json:"taskCount,omitempty"`
+	// The launch type of the task.
+	// Default is the launchType of the deployment input.
+	LaunchType string `json:"launchType,omitempty"`
+	// The platform version of the task in case of FARGATE.
+	PlatformVersion string `json:"platformVersion,omitempty"`
+	// The configuration of the awsvpc network.
+	// Default is the awsvpcConfiguration of the deployment input.
+	AwsVpcConfiguration *config.ECSVpcConfiguration `json:"awsvpcConfiguration,omitempty"`
+	// The JSON text passed to the task, e.g. container overrides.
+	Input string `json:"input,omitempty"`
+}
+
+func loadScheduledTask(path string) (ScheduledTask, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ScheduledTask{}, err
+	}
+	return parseScheduledTask(data)
+}
+
+func parseScheduledTask(data []byte) (ScheduledTask, error) {
+	var obj ScheduledTask
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return ScheduledTask{}, err
+	}
+	if obj.Name == "" {
+		return ScheduledTask{}, fmt.Errorf("name is required for scheduled task")
+	}
+	if obj.ScheduleExpression == "" {
+		return ScheduledTask{}, fmt.Errorf("scheduleExpression is required for scheduled task")
+	}
+	if obj.RoleArn == "" {
+		return ScheduledTask{}, fmt.Errorf("roleArn is required for scheduled task")
+	}
+	switch obj.State {
+	case "":
+		obj.State = scheduleStateEnabled
+	case scheduleStateEnabled, scheduleStateDisabled:
+		break
+	default:
+		return ScheduledTask{}, fmt.Errorf("invalid state %s for scheduled task", obj.State)
+	}
+	if obj.TargetID == "" {
+		obj.TargetID = obj.Name
+	}
+	if obj.TaskCount == 0 {
+		obj.TaskCount = 1
+	}
+	return obj, nil
+}
+
+func makeEventBridgeRule(task ScheduledTask, tags []types.Tag) *eventbridge.PutRuleInput {
+	in := &eventbridge.PutRuleInput{
+		Name:               aws.String(task.Name),
+		ScheduleExpression: aws.String(task.ScheduleExpression),
+		State:              ebtypes.RuleState(task.State),
+	}
+	if task.Description != "" {
+		in.Description = aws.String(task.Description)
+	}
+	if task.EventBusName != "" {
+		in.EventBusName = aws.String(task.EventBusName)
+	}
+	for _, t := range tags {
+		in.Tags = append(in.Tags, ebtypes.Tag{Key: t.Key, Value: t.Value})
+	}
+	return in
+}
+
+func makeEventBridgeTarget(task ScheduledTask, taskDefinition types.TaskDefinition) ebtypes.Target {
+	target := ebtypes.Target{
+		Id:      aws.String(task.TargetID),
+		Arn:     aws.String(task.ClusterArn),
+		RoleArn: aws.String(task.RoleArn),
+		EcsParameters: &ebtypes.EcsParameters{
+			TaskDefinitionArn: taskDefinition.TaskDefinitionArn,
+			TaskCount:         aws.Int32(task.TaskCount),
+			LaunchType:        ebtypes.LaunchType(task.LaunchType),
+			PropagateTags:     ebtypes.PropagateTagsTaskDefinition,
+		},
+	}
+	if task.Input != "" {
+		target.Input = aws.String(task.Input)
+	}
+	if task.PlatformVersion != "" {
+		target.EcsParameters.PlatformVersion = aws.String(task.PlatformVersion)
+	}
+	if vpc := task.AwsVpcConfiguration; vpc != nil && len(vpc.Subnets) > 0 {
+		target.EcsParameters.NetworkConfiguration = &ebtypes.NetworkConfiguration{
+			AwsvpcConfiguration: &ebtypes.AwsVpcConfiguration{
+				Subnets:        vpc.Subnets,
+				SecurityGroups: vpc.SecurityGroups,
+				AssignPublicIp: ebtypes.AssignPublicIp(vpc.AssignPublicIP),
+			},
+		}
+	}
+	return target
+}
@@ -0,0 +1,124 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	ebtypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestParseScheduledTask(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		input       string
+		expected    ScheduledTask
+		expectedErr bool
+	}{
+		{
+			name: "default values are set",
+			input: `
+name: nightly-batch
+scheduleExpression: cron(0 3 * * ? *)
+roleArn: arn:aws:iam::123456789012:role/ecsEventsRole
+awsvpcConfiguration:
+  subnets:
+    - subnet-1
+`,
+			expected: ScheduledTask{
+				Name:               "nightly-batch",
+				ScheduleExpression: "cron(0 3 * * ? *)",
+				State:              "ENABLED",
+				TargetID:           "nightly-batch",
+				RoleArn:            "arn:aws:iam::123456789012:role/ecsEventsRole",
+				TaskCount:          1,
+				AwsVpcConfiguration: &config.ECSVpcConfiguration{
+					Subnets: []string{"subnet-1"},
+				},
+			},
+		},
+		{
+			name: "missing schedule expression",
+			input: `
+name: nightly-batch
+roleArn: arn:aws:iam::123456789012:role/ecsEventsRole
+`,
+			expectedErr: true,
+		},
+		{
+			name: "invalid state",
+			input: `
+name: nightly-batch
+scheduleExpression: rate(1 hour)
+roleArn: arn:aws:iam::123456789012:role/ecsEventsRole
+state: PAUSED
+`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseScheduledTask([]byte(tc.input))
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestMakeEventBridgeTarget(t *testing.T) {
+	t.Parallel()
+
+	task := ScheduledTask{
+		Name:       "nightly-batch",
+		TargetID:   "nightly-batch",
+		ClusterArn: "arn:aws:ecs:ap-northeast-1:123456789012:cluster/default",
+		RoleArn:    "arn:aws:iam::123456789012:role/ecsEventsRole",
+		TaskCount:  1,
+		LaunchType: "FARGATE",
+		AwsVpcConfiguration: &config.ECSVpcConfiguration{
+			Subnets:        []string{"subnet-1"},
+			AssignPublicIP: "DISABLED",
+		},
+	}
+	taskDefinition := types.TaskDefinition{
+		TaskDefinitionArn: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/batch:3"),
+	}
+
+	assert.Equal(t, ebtypes.Target{
+		Id:      aws.String("nightly-batch"),
+		Arn:     aws.String("arn:aws:ecs:ap-northeast-1:123456789012:cluster/default"),
+		RoleArn: aws.String("arn:aws:iam::123456789012:role/ecsEventsRole"),
+		EcsParameters: &ebtypes.EcsParameters{
+			TaskDefinitionArn: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/batch:3"),
+			TaskCount:         aws.Int32(1),
+			LaunchType:        ebtypes.LaunchTypeFargate,
+			PropagateTags:     ebtypes.PropagateTagsTaskDefinition,
+			NetworkConfiguration: &ebtypes.NetworkConfiguration{
+				AwsvpcConfiguration: &ebtypes.AwsVpcConfiguration{
+					Subnets:        []string{"subnet-1"},
+					AssignPublicIp: ebtypes.AssignPublicIpDisabled,
+				},
+			},
+		},
+	}, makeEventBridgeTarget(task, taskDefinition))
+}
@@ -0,0 +1,110 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
+
+const (
+	secretServiceSSM            = "ssm"
+	secretServiceSecretsManager = "secretsmanager"
+)
+
+// secretReference represents a reference to an SSM parameter or a Secrets Manager secret.
+type secretReference struct {
+	// Either ssm or secretsmanager.
+	Service string
+	// Empty means the region of the platform provider.
+	Region string
+	// The name or ARN used to identify the parameter or the secret.
+	ID string
+}
+
+// parseSecretReference parses the given valueFrom of the task definition.
+// Non-ARN values are treated as the names of SSM parameters in the same region.
+func parseSecretReference(ref string) (secretReference, error) {
+	if !strings.HasPrefix(ref, "arn:") {
+		if ref == "" {
+			return secretReference{}, fmt.Errorf("secret reference could not be empty")
+		}
+		return secretReference{Service: secretServiceSSM, ID: ref}, nil
+	}
+
+	// arn:partition:service:region:account-id:resource
+	parts := strings.SplitN(ref, ":", 6)
+	if len(parts) != 6 {
+		return secretReference{}, fmt.Errorf("invalid secret reference %s", ref)
+	}
+	switch parts[2] {
+	case secretServiceSSM:
+		return secretReference{Service: secretServiceSSM, Region: parts[3], ID: ref}, nil
+	case secretServiceSecretsManager:
+		// ECS allows to specify a JSON key, a version stage and a version id after the secret ARN,
+		// e.g. arn:aws:secretsmanager:region:account-id:secret:name:json-key:version-stage:version-id
+		// so only the first 7 parts are used to identify the secret.
+		resource := strings.SplitN(parts[5], ":", 3)
+		if len(resource) < 2 || resource[0] != "secret" {
+			return secretReference{}, fmt.Errorf("invalid secret reference %s", ref)
+		}
+		id := strings.Join(parts[:5], ":") + ":secret:" + resource[1]
+		return secretReference{Service: secretServiceSecretsManager, Region: parts[3], ID: id}, nil
+	default:
+		return secretReference{}, fmt.Errorf("unsupported service %s of secret reference %s", parts[2], ref)
+	}
+}
+
+// findSecretReferences returns all secret references used in the given task definition.
+func findSecretReferences(taskDefinition types.TaskDefinition) []string {
+	var (
+		refs = make([]string, 0)
+		seen = make(map[string]struct{})
+		add  = func(ref string) {
+			if _, ok := seen[ref]; ok || ref == "" {
+				return
+			}
+			seen[ref] = struct{}{}
+			refs = append(refs, ref)
+		}
+	)
+	for _, cd := range taskDefinition.ContainerDefinitions {
+		for _, s := range cd.Secrets {
+			add(aws.ToString(s.ValueFrom))
+		}
+		if cd.LogConfiguration != nil {
+			for _, s := range cd.LogConfiguration.SecretOptions {
+				add(aws.ToString(s.ValueFrom))
+			}
+		}
+		if cd.RepositoryCredentials != nil {
+			add(aws.ToString(cd.RepositoryCredentials.CredentialsParameter))
+		}
+	}
+	return refs
+}
+
+// templateSecretFunc is used as the "secret" function of the definition templates.
+// It returns the given reference as is after validating its format,
+// so that it can be used as the valueFrom of the task definition.
+func templateSecretFunc(ref string) (string, error) {
+	if _, err := parseSecretReference(ref); err != nil {
+		return "", err
+	}
+	return ref, nil
+}
@@ -0,0 +1,113 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSecretReference(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		ref       string
+		expected  secretReference
+		expectErr bool
+	}{
+		{
+			ref:      "db-password",
+			expected: secretReference{Service: "ssm", ID: "db-password"},
+		},
+		{
+			ref: "arn:aws:ssm:us-east-1:123456789012:parameter/prod/db-password",
+			expected: secretReference{
+				Service: "ssm",
+				Region:  "us-east-1",
+				ID:      "arn:aws:ssm:us-east-1:123456789012:parameter/prod/db-password",
+			},
+		},
+		{
+			ref: "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:prod/db-AbCdEf",
+			expected: secretReference{
+				Service: "secretsmanager",
+				Region:  "ap-northeast-1",
+				ID:      "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:prod/db-AbCdEf",
+			},
+		},
+		{
+			ref: "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:prod/db-AbCdEf:password::",
+			expected: secretReference{
+				Service: "secretsmanager",
+				Region:  "ap-northeast-1",
+				ID:      "arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:prod/db-AbCdEf",
+			},
+		},
+		{
+			ref:       "arn:aws:s3:::bucket/key",
+			expectErr: true,
+		},
+		{
+			ref:       "arn:aws:secretsmanager:ap-northeast-1:123456789012",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.ref, func(t *testing.T) {
+			got, err := parseSecretReference(tc.ref)
+			assert.Equal(t, tc.expectErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParseTaskDefinitionWithSecretTemplate(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`
+family: web
+containerDefinitions:
+  - name: web
+    image: gcr.io/pipecd/helloworld:v1.0.0
+    secrets:
+      - name: DB_PASSWORD
+        valueFrom: {{ secret "arn:aws:ssm:ap-northeast-1:123456789012:parameter/db-password" }}
+      - name: API_KEY
+        valueFrom: arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:api-key-AbCdEf
+    repositoryCredentials:
+      credentialsParameter: arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:registry-AbCdEf
+  - name: sidecar
+    image: gcr.io/pipecd/envoy:v1.0.0
+    secrets:
+      - name: DB_PASSWORD
+        valueFrom: {{ secret "arn:aws:ssm:ap-northeast-1:123456789012:parameter/db-password" }}
+`)
+	td, err := parseTaskDefinition(data)
+	require.NoError(t, err)
+	assert.Equal(t, "arn:aws:ssm:ap-northeast-1:123456789012:parameter/db-password", aws.ToString(td.ContainerDefinitions[0].Secrets[0].ValueFrom))
+
+	assert.Equal(t, []string{
+		"arn:aws:ssm:ap-northeast-1:123456789012:parameter/db-password",
+		"arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:api-key-AbCdEf",
+		"arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:registry-AbCdEf",
+	}, findSecretReferences(td))
+
+	_, err = parseTaskDefinition([]byte(`family: {{ secret "arn:aws:s3:::bucket" }}`))
+	assert.Error(t, err)
+}
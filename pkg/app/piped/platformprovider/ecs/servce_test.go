@@ -155,6 +155,52 @@ deploymentController:
 				},
 			},
 		},
+		{
+			name: "yaml format input with service connect configuration",
+			input: `
+cluster: arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY
+serviceName: nginx-external-canary
+desiredCount: 2
+deploymentController:
+  type: EXTERNAL
+serviceConnectConfiguration:
+  enabled: true
+  namespace: internal
+  services:
+    - portName: http
+      discoveryName: nginx
+      clientAliases:
+        - port: 80
+          dnsName: nginx.internal
+`,
+			expected: types.Service{
+				ClusterArn:   aws.String("arn:aws:ecs:ap-northeast-1:XXXX:cluster/YYYY"),
+				ServiceName:  aws.String("nginx-external-canary"),
+				DesiredCount: 2,
+				RoleArn:      aws.String(""),
+				DeploymentController: &types.DeploymentController{
+					Type: types.DeploymentControllerTypeExternal,
+				},
+				Deployments: []types.Deployment{
+					{
+						Status: aws.String("PRIMARY"),
+						ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+							Enabled:   true,
+							Namespace: aws.String("internal"),
+							Services: []types.ServiceConnectService{
+								{
+									PortName:      aws.String("http"),
+									DiscoveryName: aws.String("nginx"),
+									ClientAliases: []types.ServiceConnectClientAlias{
+										{Port: aws.Int32(80), DnsName: aws.String("nginx.internal")},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -165,3 +211,267 @@ deploymentController:
 		})
 	}
 }
+
+func TestFindFailedDeployment(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		service  types.Service
+		expected bool
+	}{
+		{
+			name: "circuit breaker is not enabled",
+			service: types.Service{
+				Deployments: []types.Deployment{
+					{Id: aws.String("ecs-svc/1"), RolloutState: types.DeploymentRolloutStateFailed},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "no failed deployment",
+			service: types.Service{
+				DeploymentConfiguration: &types.DeploymentConfiguration{
+					DeploymentCircuitBreaker: &types.DeploymentCircuitBreaker{Enable: true, Rollback: true},
+				},
+				Deployments: []types.Deployment{
+					{Id: aws.String("ecs-svc/1"), RolloutState: types.DeploymentRolloutStateInProgress},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "deployment was marked as failed",
+			service: types.Service{
+				DeploymentConfiguration: &types.DeploymentConfiguration{
+					DeploymentCircuitBreaker: &types.DeploymentCircuitBreaker{Enable: true, Rollback: true},
+				},
+				Deployments: []types.Deployment{
+					{Id: aws.String("ecs-svc/1"), RolloutState: types.DeploymentRolloutStateCompleted},
+					{Id: aws.String("ecs-svc/2"), RolloutState: types.DeploymentRolloutStateFailed},
+				},
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, failed := findFailedDeployment(tc.service)
+			assert.Equal(t, tc.expected, failed)
+			if failed {
+				assert.Equal(t, "ecs-svc/2", aws.ToString(d.Id))
+			}
+		})
+	}
+}
+
+func TestValidateCapacityProviderStrategy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		service   types.Service
+		expectErr bool
+	}{
+		{
+			name:    "no strategy",
+			service: types.Service{LaunchType: types.LaunchTypeFargate},
+		},
+		{
+			name: "fargate and fargate spot",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE"), Base: 1, Weight: 1},
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 3},
+				},
+			},
+		},
+		{
+			name: "launch type is also specified",
+			service: types.Service{
+				LaunchType: types.LaunchTypeFargate,
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 1},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "base is specified for multiple providers",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE"), Base: 1, Weight: 1},
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Base: 1, Weight: 1},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "all weights are zero",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE"), Base: 2},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated provider",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 1},
+					{CapacityProvider: aws.String("FARGATE_SPOT"), Weight: 2},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateCapacityProviderStrategy(tc.service)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestValidateSchedulingStrategy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		service   types.Service
+		expectErr bool
+	}{
+		{
+			name: "replica service with external deployment controller",
+			service: types.Service{
+				SchedulingStrategy:   types.SchedulingStrategyReplica,
+				DeploymentController: &types.DeploymentController{Type: types.DeploymentControllerTypeExternal},
+			},
+		},
+		{
+			name: "daemon service without deployment controller",
+			service: types.Service{
+				SchedulingStrategy: types.SchedulingStrategyDaemon,
+			},
+		},
+		{
+			name: "daemon service with ecs deployment controller",
+			service: types.Service{
+				SchedulingStrategy:   types.SchedulingStrategyDaemon,
+				DeploymentController: &types.DeploymentController{Type: types.DeploymentControllerTypeEcs},
+			},
+		},
+		{
+			name: "daemon service with external deployment controller",
+			service: types.Service{
+				SchedulingStrategy:   types.SchedulingStrategyDaemon,
+				DeploymentController: &types.DeploymentController{Type: types.DeploymentControllerTypeExternal},
+			},
+			expectErr: true,
+		},
+		{
+			name: "daemon service with capacity provider strategy",
+			service: types.Service{
+				SchedulingStrategy: types.SchedulingStrategyDaemon,
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE"), Weight: 1},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateSchedulingStrategy(tc.service)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestValidateServiceConnect(t *testing.T) {
+	t.Parallel()
+
+	withServiceConnect := func(cfg types.ServiceConnectConfiguration) types.Service {
+		return types.Service{
+			Deployments: []types.Deployment{
+				{Status: aws.String("PRIMARY"), ServiceConnectConfiguration: &cfg},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name      string
+		service   types.Service
+		expectErr bool
+	}{
+		{
+			name:    "service connect is not configured",
+			service: types.Service{},
+		},
+		{
+			name: "client only service",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Enabled:   true,
+				Namespace: aws.String("internal"),
+			}),
+		},
+		{
+			name: "server service",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Enabled: true,
+				Services: []types.ServiceConnectService{
+					{PortName: aws.String("http"), ClientAliases: []types.ServiceConnectClientAlias{{Port: aws.Int32(80)}}},
+					{PortName: aws.String("grpc"), DiscoveryName: aws.String("nginx-grpc")},
+				},
+			}),
+		},
+		{
+			name: "missing port name",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Enabled:  true,
+				Services: []types.ServiceConnectService{{DiscoveryName: aws.String("nginx")}},
+			}),
+			expectErr: true,
+		},
+		{
+			name: "duplicated discovery name",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Enabled: true,
+				Services: []types.ServiceConnectService{
+					{PortName: aws.String("http")},
+					{PortName: aws.String("admin"), DiscoveryName: aws.String("http")},
+				},
+			}),
+			expectErr: true,
+		},
+		{
+			name: "invalid client alias port",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Enabled: true,
+				Services: []types.ServiceConnectService{
+					{PortName: aws.String("http"), ClientAliases: []types.ServiceConnectClientAlias{{Port: aws.Int32(0)}}},
+				},
+			}),
+			expectErr: true,
+		},
+		{
+			name: "services with disabled service connect",
+			service: withServiceConnect(types.ServiceConnectConfiguration{
+				Services: []types.ServiceConnectService{{PortName: aws.String("http")}},
+			}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateServiceConnect(tc.service)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
@@ -15,13 +15,23 @@
 package ecs
 
 import (
+	"errors"
+	"fmt"
 	"os"
 
 	"sigs.k8s.io/yaml"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 )
 
+// ErrDeploymentFailed is returned when a deployment of the service was marked as FAILED
+// by the deployment circuit breaker.
+var ErrDeploymentFailed = errors.New("deployment was failed by the deployment circuit breaker")
+
+// primaryDeploymentStatus is the status of the most recent deployment of the service.
+const primaryDeploymentStatus = "PRIMARY"
+
 func loadServiceDefinition(path string) (types.Service, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -54,6 +64,21 @@ func parseServiceDefinition(data []byte) (types.Service, error) {
 		obj.RoleArn = &roleArn
 	}
 
+	// types.Service doesn't have the Service Connect configuration since ECS reports it
+	// for each deployment, so keep it as the PRIMARY deployment of the service.
+	serviceConnect, err := parseServiceDefinitionForServiceConnect(data)
+	if err != nil {
+		return types.Service{}, err
+	}
+	if serviceConnect != nil {
+		obj.Deployments = []types.Deployment{
+			{
+				Status:                      aws.String(primaryDeploymentStatus),
+				ServiceConnectConfiguration: serviceConnect,
+			},
+		}
+	}
+
 	return obj, nil
 }
 
@@ -76,3 +101,154 @@ func parseServiceDefinitionForRole(data []byte) (string, error) {
 	}
 	return obj.Role, nil
 }
+
+func parseServiceDefinitionForServiceConnect(data []byte) (*types.ServiceConnectConfiguration, error) {
+	var obj struct {
+		ServiceConnectConfiguration *types.ServiceConnectConfiguration `json:"serviceConnectConfiguration"`
+	}
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return obj.ServiceConnectConfiguration, nil
+}
+
+// ServiceConnectConfiguration returns the Service Connect configuration of the PRIMARY deployment
+// of the given service. Nil is returned when Service Connect is not configured.
+func ServiceConnectConfiguration(service types.Service) *types.ServiceConnectConfiguration {
+	for _, d := range service.Deployments {
+		if aws.ToString(d.Status) == primaryDeploymentStatus {
+			return d.ServiceConnectConfiguration
+		}
+	}
+	return nil
+}
+
+// validateServiceConnect checks whether the Service Connect configuration of the given service
+// can be passed to ECS. The existence of the namespace is checked by the client.
+func validateServiceConnect(service types.Service) error {
+	cfg := ServiceConnectConfiguration(service)
+	if cfg == nil {
+		return nil
+	}
+	if !cfg.Enabled {
+		if len(cfg.Services) > 0 {
+			return fmt.Errorf("services of serviceConnectConfiguration can not be specified when Service Connect is disabled")
+		}
+		return nil
+	}
+
+	names := make(map[string]struct{}, len(cfg.Services))
+	for i, s := range cfg.Services {
+		if aws.ToString(s.PortName) == "" {
+			return fmt.Errorf("portName is required for serviceConnectConfiguration.services[%d]", i)
+		}
+		// The port name is used as the discovery name when it is not specified.
+		name := aws.ToString(s.DiscoveryName)
+		if name == "" {
+			name = aws.ToString(s.PortName)
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("discovery name %s is specified multiple times in serviceConnectConfiguration", name)
+		}
+		names[name] = struct{}{}
+
+		for _, a := range s.ClientAliases {
+			if port := aws.ToInt32(a.Port); port < 1 || port > 65535 {
+				return fmt.Errorf("port of client alias of serviceConnectConfiguration.services[%d] must be in range [1, 65535]", i)
+			}
+		}
+	}
+	return nil
+}
+
+// findFailedDeployment returns the deployment of the given service which was marked as FAILED
+// by the deployment circuit breaker.
+// The service deployments are ignored when the circuit breaker is not enabled.
+func findFailedDeployment(service types.Service) (*types.Deployment, bool) {
+	cfg := service.DeploymentConfiguration
+	if cfg == nil || cfg.DeploymentCircuitBreaker == nil || !cfg.DeploymentCircuitBreaker.Enable {
+		return nil, false
+	}
+	for i := range service.Deployments {
+		if service.Deployments[i].RolloutState == types.DeploymentRolloutStateFailed {
+			return &service.Deployments[i], true
+		}
+	}
+	return nil, false
+}
+
+// validateCapacityProviderStrategy checks whether the capacity provider strategy of the given service
+// can be used to create task sets.
+func validateCapacityProviderStrategy(service types.Service) error {
+	strategy := service.CapacityProviderStrategy
+	if len(strategy) == 0 {
+		return nil
+	}
+	if service.LaunchType != "" {
+		return fmt.Errorf("launchType and capacityProviderStrategy can not be specified at the same time")
+	}
+
+	var (
+		names       = make(map[string]struct{}, len(strategy))
+		hasBase     bool
+		totalWeight int32
+	)
+	for _, s := range strategy {
+		name := aws.ToString(s.CapacityProvider)
+		if name == "" {
+			return fmt.Errorf("capacityProvider is required for each item of capacityProviderStrategy")
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("capacity provider %s is specified multiple times", name)
+		}
+		names[name] = struct{}{}
+
+		if s.Weight < 0 || s.Weight > 1000 {
+			return fmt.Errorf("weight of capacity provider %s must be in range [0, 1000]", name)
+		}
+		if s.Base < 0 || s.Base > 100000 {
+			return fmt.Errorf("base of capacity provider %s must be in range [0, 100000]", name)
+		}
+		if s.Base > 0 {
+			if hasBase {
+				return fmt.Errorf("base can be specified for only one capacity provider")
+			}
+			hasBase = true
+		}
+		totalWeight += s.Weight
+	}
+	if totalWeight == 0 {
+		return fmt.Errorf("at least one capacity provider must have a weight greater than 0")
+	}
+	return nil
+}
+
+// IsDaemonService returns true when the given service places one task on each container instance.
+// Such services are deployed by the ECS deployment controller instead of task sets.
+func IsDaemonService(service types.Service) bool {
+	return service.SchedulingStrategy == types.SchedulingStrategyDaemon
+}
+
+// validateSchedulingStrategy checks whether the deployment controller of the given service
+// supports its scheduling strategy.
+func validateSchedulingStrategy(service types.Service) error {
+	if !IsDaemonService(service) {
+		return nil
+	}
+	if service.DeploymentController != nil && service.DeploymentController.Type != types.DeploymentControllerTypeEcs {
+		return fmt.Errorf("deployment controller of type %s does not support DAEMON scheduling strategy, use ECS instead", service.DeploymentController.Type)
+	}
+	if len(service.CapacityProviderStrategy) > 0 {
+		return fmt.Errorf("capacityProviderStrategy can not be used with DAEMON scheduling strategy")
+	}
+	return nil
+}
+
+func IsPipeCDManagedService(service *types.Service) bool {
+	for _, tag := range service.Tags {
+		if aws.ToString(tag.Key) == LabelManagedBy && aws.ToString(tag.Value) == ManagedByPiped {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	serviceKind = "Service"
+	taskSetKind = "TaskSet"
+	taskKind    = "Task"
+)
+
+// MakeResourceStates returns the states of the given service, its task sets and its running tasks.
+// Tasks started by a task set are placed under that task set, the others are placed under the service.
+func MakeResourceStates(service *types.Service, taskSets []*types.TaskSet, tasks []*types.Task, updatedAt time.Time) []*model.ECSResourceState {
+	states := make([]*model.ECSResourceState, 0, len(taskSets)+len(tasks)+1)
+
+	// Set service state.
+	serviceArn := aws.ToString(service.ServiceArn)
+	status, desc := serviceHealthStatus(service)
+	states = append(states, makeResourceState(
+		serviceArn,
+		nil,
+		aws.ToString(service.ServiceName),
+		serviceKind,
+		status,
+		desc,
+		service.CreatedAt,
+		updatedAt,
+	))
+
+	// Set task set states.
+	taskSetArns := make(map[string]string, len(taskSets))
+	for _, ts := range taskSets {
+		arn := aws.ToString(ts.TaskSetArn)
+		taskSetArns[aws.ToString(ts.Id)] = arn
+
+		status, desc := taskSetHealthStatus(ts)
+		states = append(states, makeResourceState(
+			arn,
+			[]string{serviceArn},
+			aws.ToString(ts.Id),
+			taskSetKind,
+			status,
+			desc,
+			ts.CreatedAt,
+			updatedAt,
+		))
+	}
+
+	// Set running task states.
+	for _, t := range tasks {
+		parent := serviceArn
+		if arn, ok := taskSetArns[aws.ToString(t.StartedBy)]; ok {
+			parent = arn
+		}
+
+		arn := aws.ToString(t.TaskArn)
+		status, desc := taskHealthStatus(t)
+		states = append(states, makeResourceState(
+			arn,
+			[]string{parent},
+			arn[strings.LastIndex(arn, "/")+1:],
+			taskKind,
+			status,
+			desc,
+			t.CreatedAt,
+			updatedAt,
+		))
+	}
+
+	return states
+}
+
+func makeResourceState(id string, parentIDs []string, name, kind string, status model.ECSResourceState_HealthStatus, desc string, createdAt *time.Time, updatedAt time.Time) *model.ECSResourceState {
+	// The creation time might be missing on resources being provisioned.
+	creationTime := updatedAt
+	if createdAt != nil {
+		creationTime = *createdAt
+	}
+
+	return &model.ECSResourceState{
+		Id:        id,
+		OwnerIds:  parentIDs,
+		ParentIds: parentIDs,
+		Name:      name,
+		Kind:      kind,
+
+		HealthStatus:      status,
+		HealthDescription: desc,
+
+		CreatedAt: creationTime.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}
+}
+
+func serviceHealthStatus(service *types.Service) (model.ECSResourceState_HealthStatus, string) {
+	if s := aws.ToString(service.Status); s != "ACTIVE" {
+		return model.ECSResourceState_OTHER, fmt.Sprintf("Service is %s", s)
+	}
+	desc := fmt.Sprintf("%d/%d tasks are running", service.RunningCount, service.DesiredCount)
+	if service.RunningCount < service.DesiredCount {
+		return model.ECSResourceState_OTHER, desc
+	}
+	return model.ECSResourceState_HEALTHY, desc
+}
+
+func taskSetHealthStatus(taskSet *types.TaskSet) (model.ECSResourceState_HealthStatus, string) {
+	desc := fmt.Sprintf("%s task set, %d/%d tasks are running", aws.ToString(taskSet.Status), taskSet.RunningCount, taskSet.ComputedDesiredCount)
+	if taskSet.StabilityStatus != types.StabilityStatusSteadyState {
+		return model.ECSResourceState_OTHER, fmt.Sprintf("%s, not reached a steady state yet", desc)
+	}
+	return model.ECSResourceState_HEALTHY, desc
+}
+
+func taskHealthStatus(task *types.Task) (model.ECSResourceState_HealthStatus, string) {
+	if s := aws.ToString(task.LastStatus); s != "RUNNING" {
+		return model.ECSResourceState_OTHER, fmt.Sprintf("Task is %s", s)
+	}
+	if task.HealthStatus == types.HealthStatusUnhealthy {
+		return model.ECSResourceState_OTHER, "Task is running but its health check is failing"
+	}
+	return model.ECSResourceState_HEALTHY, "Task is running"
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeResourceStates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		createdAt  = time.Unix(100, 0)
+		updatedAt  = time.Unix(200, 0)
+		serviceArn = "arn:aws:ecs:ap-northeast-1:123456789012:service/cluster/service"
+		taskSetArn = "arn:aws:ecs:ap-northeast-1:123456789012:task-set/cluster/service/ecs-svc/1"
+	)
+	service := &types.Service{
+		ServiceArn:   aws.String(serviceArn),
+		ServiceName:  aws.String("service"),
+		Status:       aws.String("ACTIVE"),
+		RunningCount: 1,
+		DesiredCount: 2,
+		CreatedAt:    &createdAt,
+	}
+	taskSets := []*types.TaskSet{
+		{
+			TaskSetArn:           aws.String(taskSetArn),
+			Id:                   aws.String("ecs-svc/1"),
+			Status:               aws.String("PRIMARY"),
+			StabilityStatus:      types.StabilityStatusSteadyState,
+			RunningCount:         1,
+			ComputedDesiredCount: 1,
+			CreatedAt:            &createdAt,
+		},
+	}
+	tasks := []*types.Task{
+		{
+			TaskArn:      aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task/cluster/task1"),
+			StartedBy:    aws.String("ecs-svc/1"),
+			LastStatus:   aws.String("RUNNING"),
+			HealthStatus: types.HealthStatusHealthy,
+			CreatedAt:    &createdAt,
+		},
+		{
+			TaskArn:      aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task/cluster/task2"),
+			LastStatus:   aws.String("PENDING"),
+			HealthStatus: types.HealthStatusUnknown,
+		},
+	}
+
+	expected := []*model.ECSResourceState{
+		{
+			Id:                serviceArn,
+			Name:              "service",
+			Kind:              "Service",
+			HealthStatus:      model.ECSResourceState_OTHER,
+			HealthDescription: "1/2 tasks are running",
+			CreatedAt:         100,
+			UpdatedAt:         200,
+		},
+		{
+			Id:                taskSetArn,
+			OwnerIds:          []string{serviceArn},
+			ParentIds:         []string{serviceArn},
+			Name:              "ecs-svc/1",
+			Kind:              "TaskSet",
+			HealthStatus:      model.ECSResourceState_HEALTHY,
+			HealthDescription: "PRIMARY task set, 1/1 tasks are running",
+			CreatedAt:         100,
+			UpdatedAt:         200,
+		},
+		{
+			Id:                "arn:aws:ecs:ap-northeast-1:123456789012:task/cluster/task1",
+			OwnerIds:          []string{taskSetArn},
+			ParentIds:         []string{taskSetArn},
+			Name:              "task1",
+			Kind:              "Task",
+			HealthStatus:      model.ECSResourceState_HEALTHY,
+			HealthDescription: "Task is running",
+			CreatedAt:         100,
+			UpdatedAt:         200,
+		},
+		{
+			Id:                "arn:aws:ecs:ap-northeast-1:123456789012:task/cluster/task2",
+			OwnerIds:          []string{serviceArn},
+			ParentIds:         []string{serviceArn},
+			Name:              "task2",
+			Kind:              "Task",
+			HealthStatus:      model.ECSResourceState_OTHER,
+			HealthDescription: "Task is PENDING",
+			CreatedAt:         200,
+			UpdatedAt:         200,
+		},
+	}
+
+	got := MakeResourceStates(service, taskSets, tasks, updatedAt)
+	assert.Equal(t, expected, got)
+}
@@ -15,16 +15,60 @@
 package ecs
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"text/template"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
+// HashTaskDefinition returns the hash value of the fields of the given task definition
+// which are passed to RegisterTaskDefinition API.
+func HashTaskDefinition(taskDefinition types.TaskDefinition) (string, error) {
+	data, err := json.Marshal(registeredTaskDefinition(taskDefinition))
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// taskDefinitionFamily returns the family of the given task definition ARN
+// formatted as arn:aws:ecs:region:account:task-definition/family:revision.
+func taskDefinitionFamily(arn string) string {
+	if i := strings.LastIndex(arn, "/"); i >= 0 {
+		arn = arn[i+1:]
+	}
+	if i := strings.LastIndex(arn, ":"); i >= 0 {
+		arn = arn[:i]
+	}
+	return arn
+}
+
+// taskDefinitionRevision returns the revision of the given task definition ARN
+// formatted as arn:aws:ecs:region:account:task-definition/family:revision.
+func taskDefinitionRevision(arn string) (int, bool) {
+	i := strings.LastIndex(arn, ":")
+	if i < 0 {
+		return 0, false
+	}
+	revision, err := strconv.Atoi(arn[i+1:])
+	if err != nil {
+		return 0, false
+	}
+	return revision, true
+}
+
 func loadTaskDefinition(path string) (types.TaskDefinition, error) {
 	data, err := os.ReadFile(path)
 	if err != nil {
@@ -34,6 +78,11 @@ func loadTaskDefinition(path string) (types.TaskDefinition, error) {
 }
 
 func parseTaskDefinition(data []byte) (types.TaskDefinition, error) {
+	data, err := renderDefinition("taskdef", data)
+	if err != nil {
+		return types.TaskDefinition{}, err
+	}
+
 	var obj types.TaskDefinition
 	if err := yaml.Unmarshal(data, &obj); err != nil {
 		return types.TaskDefinition{}, err
@@ -41,43 +90,113 @@ func parseTaskDefinition(data []byte) (types.TaskDefinition, error) {
 	return obj, nil
 }
 
+// renderDefinition renders the given definition as a template
+// when it contains any template action.
+// The "secret" function can be used to refer the SSM parameters or the Secrets Manager secrets,
+// e.g. valueFrom: {{ secret "arn:aws:ssm:ap-northeast-1:123456789012:parameter/db-password" }}
+func renderDefinition(name string, data []byte) ([]byte, error) {
+	if !bytes.Contains(data, []byte("{{")) {
+		return data, nil
+	}
+
+	tmpl, err := template.New(name).
+		Funcs(template.FuncMap{"secret": templateSecretFunc}).
+		Option("missingkey=error").
+		Parse(string(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s as template: %w", name, err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, fmt.Errorf("failed to render %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
 // FindImageTag parses image tag from given ECS task definition.
 func FindImageTag(taskDefinition types.TaskDefinition) (string, error) {
 	if len(taskDefinition.ContainerDefinitions) == 0 {
 		return "", fmt.Errorf("container definition could not be empty")
 	}
-	name, tag := parseContainerImage(*taskDefinition.ContainerDefinitions[0].Image)
+	name, tag := parseContainerImage(aws.ToString(taskDefinition.ContainerDefinitions[0].Image))
 	if name == "" {
 		return "", fmt.Errorf("image name could not be empty")
 	}
 	return tag, nil
 }
 
+// parseContainerImage returns the name and the tag of the given image.
+// The registry host which may contain a port number is not considered as a part of the tag,
+// and the digest is used as the tag when the image is referred by its digest.
 func parseContainerImage(image string) (name, tag string) {
-	parts := strings.Split(image, ":")
-	if len(parts) == 2 {
-		tag = parts[1]
+	if i := strings.Index(image, "@"); i >= 0 {
+		tag = image[i+1:]
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		if tag == "" {
+			tag = image[i+1:]
+		}
+		image = image[:i]
 	}
-	paths := strings.Split(parts[0], "/")
+	paths := strings.Split(image, "/")
 	name = paths[len(paths)-1]
 	return
 }
 
+// KeepContainerImages returns a copy of the given task definition whose containers except the given one
+// use the images of the same named containers of the running task definition, so that only the given
+// container is updated. The containers not defined in the running task definition keep their images.
+func KeepContainerImages(taskDefinition, running types.TaskDefinition, container string) types.TaskDefinition {
+	images := make(map[string]*string, len(running.ContainerDefinitions))
+	for _, cd := range running.ContainerDefinitions {
+		images[aws.ToString(cd.Name)] = cd.Image
+	}
+
+	containers := make([]types.ContainerDefinition, 0, len(taskDefinition.ContainerDefinitions))
+	for _, cd := range taskDefinition.ContainerDefinitions {
+		name := aws.ToString(cd.Name)
+		if image, ok := images[name]; ok && name != container {
+			cd.Image = image
+		}
+		containers = append(containers, cd)
+	}
+	taskDefinition.ContainerDefinitions = containers
+	return taskDefinition
+}
+
+// FindContainerDefinition returns the container definition of the given name.
+func FindContainerDefinition(taskDefinition types.TaskDefinition, name string) (types.ContainerDefinition, bool) {
+	for _, cd := range taskDefinition.ContainerDefinitions {
+		if aws.ToString(cd.Name) == name {
+			return cd, true
+		}
+	}
+	return types.ContainerDefinition{}, false
+}
+
 // FindArtifactVersions parses artifact versions from ECS task definition.
+// The versions of all containers including sidecars are returned
+// in the order of the container definitions.
 func FindArtifactVersions(taskDefinition types.TaskDefinition) ([]*model.ArtifactVersion, error) {
 	if len(taskDefinition.ContainerDefinitions) == 0 {
 		return nil, fmt.Errorf("container definition could not be empty")
 	}
 
 	// Remove duplicate images.
-	imageMap := map[string]struct{}{}
+	imageMap := make(map[string]struct{}, len(taskDefinition.ContainerDefinitions))
+	versions := make([]*model.ArtifactVersion, 0, len(taskDefinition.ContainerDefinitions))
 	for _, cd := range taskDefinition.ContainerDefinitions {
-		imageMap[*cd.Image] = struct{}{}
-	}
+		image := aws.ToString(cd.Image)
+		if image == "" {
+			return nil, fmt.Errorf("image of container %s could not be empty", aws.ToString(cd.Name))
+		}
+		if _, ok := imageMap[image]; ok {
+			continue
+		}
+		imageMap[image] = struct{}{}
 
-	versions := make([]*model.ArtifactVersion, 0, len(imageMap))
-	for i := range imageMap {
-		name, tag := parseContainerImage(i)
+		name, tag := parseContainerImage(image)
 		if name == "" {
 			return nil, fmt.Errorf("image name could not be empty")
 		}
@@ -86,7 +205,7 @@ func FindArtifactVersions(taskDefinition types.TaskDefinition) ([]*model.Artifac
 			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
 			Version: tag,
 			Name:    name,
-			Url:     i,
+			Url:     image,
 		})
 	}
 
@@ -14,7 +14,12 @@
 
 package ecs
 
-import "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+)
 
 func IsPipeCDManagedTaskSet(ts *types.TaskSet) bool {
 	for _, tag := range ts.Tags {
@@ -24,3 +29,48 @@ func IsPipeCDManagedTaskSet(ts *types.TaskSet) bool {
 	}
 	return false
 }
+
+// isTaskDefinitionUsedByOtherTaskSets returns true when the task definition of the given task set
+// is still used by another task set of the service which is not being drained.
+func isTaskDefinitionUsedByOtherTaskSets(service types.Service, taskSet types.TaskSet) bool {
+	for _, ts := range service.TaskSets {
+		if aws.ToString(ts.TaskSetArn) == aws.ToString(taskSet.TaskSetArn) {
+			continue
+		}
+		if aws.ToString(ts.Status) == "DRAINING" {
+			continue
+		}
+		if aws.ToString(ts.TaskDefinition) == aws.ToString(taskSet.TaskDefinition) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateExternalDeploymentController returns an error when the task sets of the given service
+// can not be managed by piped. The deployment controller of an existing service can not be changed,
+// so the service created with the other controller must be recreated.
+func validateExternalDeploymentController(service types.Service) error {
+	controller := types.DeploymentControllerTypeEcs
+	if service.DeploymentController != nil {
+		controller = service.DeploymentController.Type
+	}
+	if controller != types.DeploymentControllerTypeExternal {
+		return fmt.Errorf("ECS service %s uses deployment controller of type %s, recreate it with EXTERNAL deployment controller to manage its task sets", aws.ToString(service.ServiceName), controller)
+	}
+	return nil
+}
+
+// runningTaskDefinitionArn returns the ARN of the task definition used by the PRIMARY task set of the given service.
+// The task definition of the service itself is returned when the service has no task set.
+func runningTaskDefinitionArn(service types.Service) string {
+	if len(service.TaskSets) == 0 {
+		return aws.ToString(service.TaskDefinition)
+	}
+	for _, ts := range service.TaskSets {
+		if aws.ToString(ts.Status) == "PRIMARY" {
+			return aws.ToString(ts.TaskDefinition)
+		}
+	}
+	return ""
+}
@@ -59,3 +59,127 @@ func TestIsPipeCDManagedTaskSet(t *testing.T) {
 		})
 	}
 }
+
+func TestIsTaskDefinitionUsedByOtherTaskSets(t *testing.T) {
+	t.Parallel()
+
+	taskSet := types.TaskSet{
+		TaskSetArn:     aws.String("canary"),
+		TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:2"),
+	}
+
+	testcases := []struct {
+		name     string
+		service  types.Service
+		expected bool
+	}{
+		{
+			name: "only the deleted task set",
+			service: types.Service{TaskSets: []types.TaskSet{
+				{TaskSetArn: aws.String("canary"), Status: aws.String("DRAINING"), TaskDefinition: taskSet.TaskDefinition},
+			}},
+			expected: false,
+		},
+		{
+			name: "shared by the primary task set",
+			service: types.Service{TaskSets: []types.TaskSet{
+				{TaskSetArn: aws.String("primary"), Status: aws.String("PRIMARY"), TaskDefinition: taskSet.TaskDefinition},
+				{TaskSetArn: aws.String("canary"), Status: aws.String("DRAINING"), TaskDefinition: taskSet.TaskDefinition},
+			}},
+			expected: true,
+		},
+		{
+			name: "shared by a draining task set",
+			service: types.Service{TaskSets: []types.TaskSet{
+				{TaskSetArn: aws.String("old"), Status: aws.String("DRAINING"), TaskDefinition: taskSet.TaskDefinition},
+			}},
+			expected: false,
+		},
+		{
+			name: "other task set uses another revision",
+			service: types.Service{TaskSets: []types.TaskSet{
+				{TaskSetArn: aws.String("primary"), Status: aws.String("PRIMARY"), TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:1")},
+			}},
+			expected: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := isTaskDefinitionUsedByOtherTaskSets(tc.service, taskSet)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestValidateExternalDeploymentController(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		service   types.Service
+		expectErr bool
+	}{
+		{
+			name:    "external deployment controller",
+			service: types.Service{DeploymentController: &types.DeploymentController{Type: types.DeploymentControllerTypeExternal}},
+		},
+		{
+			name:      "rolling update deployment controller",
+			service:   types.Service{DeploymentController: &types.DeploymentController{Type: types.DeploymentControllerTypeEcs}},
+			expectErr: true,
+		},
+		{
+			name:      "no deployment controller",
+			service:   types.Service{},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateExternalDeploymentController(tc.service)
+			assert.Equal(t, tc.expectErr, err != nil)
+		})
+	}
+}
+
+func TestRunningTaskDefinitionArn(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		service types.Service
+		want    string
+	}{
+		{
+			name: "primary task set",
+			service: types.Service{
+				TaskSets: []types.TaskSet{
+					{Status: aws.String("ACTIVE"), TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:2")},
+					{Status: aws.String("PRIMARY"), TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:1")},
+				},
+			},
+			want: "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:1",
+		},
+		{
+			name: "no primary task set",
+			service: types.Service{
+				TaskSets: []types.TaskSet{
+					{Status: aws.String("DRAINING"), TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:1")},
+				},
+			},
+		},
+		{
+			name:    "service deployed by the ECS deployment controller",
+			service: types.Service{TaskDefinition: aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3")},
+			want:    "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/web:3",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, runningTaskDefinitionArn(tc.service))
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
@@ -282,7 +283,193 @@ func TestFindArtifactVersions(t *testing.T) {
 			td, _ := parseTaskDefinition(tc.input)
 			versions, err := FindArtifactVersions(td)
 			assert.Equal(t, tc.expectedErr, err != nil)
-			assert.ElementsMatch(t, tc.expected, versions)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestParseContainerImage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		image    string
+		wantName string
+		wantTag  string
+	}{
+		{
+			image:    "gcr.io/pipecd/helloworld:v1.0.0",
+			wantName: "helloworld",
+			wantTag:  "v1.0.0",
+		},
+		{
+			image:    "nginx",
+			wantName: "nginx",
+		},
+		{
+			image:    "localhost:5000/pipecd/helloworld:v1.0.0",
+			wantName: "helloworld",
+			wantTag:  "v1.0.0",
+		},
+		{
+			image:    "localhost:5000/pipecd/helloworld",
+			wantName: "helloworld",
+		},
+		{
+			image:    "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/envoy@sha256:abcdef",
+			wantName: "envoy",
+			wantTag:  "sha256:abcdef",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.image, func(t *testing.T) {
+			name, tag := parseContainerImage(tc.image)
+			assert.Equal(t, tc.wantName, name)
+			assert.Equal(t, tc.wantTag, tag)
+		})
+	}
+}
+
+func TestHashTaskDefinition(t *testing.T) {
+	t.Parallel()
+
+	taskDefinition := func(image string) types.TaskDefinition {
+		return types.TaskDefinition{
+			Family: aws.String("nginx"),
+			ContainerDefinitions: []types.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String(image)},
+			},
+		}
+	}
+
+	base, err := HashTaskDefinition(taskDefinition("gcr.io/pipecd/helloworld:v1.0.0"))
+	require.NoError(t, err)
+
+	// The fields set by ECS while registering don't affect the hash.
+	registered := taskDefinition("gcr.io/pipecd/helloworld:v1.0.0")
+	registered.TaskDefinitionArn = aws.String("arn:aws:ecs:ap-northeast-1:123456789012:task-definition/nginx:3")
+	registered.Revision = 3
+	got, err := HashTaskDefinition(registered)
+	require.NoError(t, err)
+	assert.Equal(t, base, got)
+
+	got, err = HashTaskDefinition(taskDefinition("gcr.io/pipecd/helloworld:v1.1.0"))
+	require.NoError(t, err)
+	assert.NotEqual(t, base, got)
+}
+
+func TestTaskDefinitionFamily(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		arn  string
+		want string
+	}{
+		{
+			arn:  "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/nginx-service-fam:12",
+			want: "nginx-service-fam",
+		},
+		{
+			arn:  "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/nginx-service-fam-canary:3",
+			want: "nginx-service-fam-canary",
+		},
+		{
+			arn:  "nginx-service-fam:1",
+			want: "nginx-service-fam",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.arn, func(t *testing.T) {
+			assert.Equal(t, tc.want, taskDefinitionFamily(tc.arn))
+		})
+	}
+}
+
+func TestTaskDefinitionRevision(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		arn    string
+		want   int
+		wantOK bool
+	}{
+		{
+			arn:    "arn:aws:ecs:ap-northeast-1:123456789012:task-definition/nginx-service-fam:12",
+			want:   12,
+			wantOK: true,
+		},
+		{
+			arn:    "nginx-service-fam:1",
+			want:   1,
+			wantOK: true,
+		},
+		{
+			arn:    "nginx-service-fam",
+			wantOK: false,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.arn, func(t *testing.T) {
+			got, ok := taskDefinitionRevision(tc.arn)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestKeepContainerImages(t *testing.T) {
+	t.Parallel()
+
+	taskDefinition := func(images ...string) types.TaskDefinition {
+		names := []string{"web", "envoy", "log_router"}
+		td := types.TaskDefinition{Family: aws.String("web")}
+		for i, image := range images {
+			td.ContainerDefinitions = append(td.ContainerDefinitions, types.ContainerDefinition{
+				Name:  aws.String(names[i]),
+				Image: aws.String(image),
+			})
+		}
+		return td
+	}
+
+	testcases := []struct {
+		name           string
+		taskDefinition types.TaskDefinition
+		running        types.TaskDefinition
+		container      string
+		want           types.TaskDefinition
+	}{
+		{
+			name:           "only the sidecar is updated",
+			taskDefinition: taskDefinition("web:v2", "envoy:v1.26"),
+			running:        taskDefinition("web:v1", "envoy:v1.25"),
+			container:      "envoy",
+			want:           taskDefinition("web:v1", "envoy:v1.26"),
+		},
+		{
+			name:           "new container keeps its image",
+			taskDefinition: taskDefinition("web:v2", "envoy:v1.26", "fluentbit:2.31"),
+			running:        taskDefinition("web:v1", "envoy:v1.25"),
+			container:      "envoy",
+			want:           taskDefinition("web:v1", "envoy:v1.26", "fluentbit:2.31"),
+		},
+		{
+			name:           "no running task definition",
+			taskDefinition: taskDefinition("web:v2", "envoy:v1.26"),
+			container:      "envoy",
+			want:           taskDefinition("web:v2", "envoy:v1.26"),
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			original := aws.ToString(tc.taskDefinition.ContainerDefinitions[0].Image)
+			got := KeepContainerImages(tc.taskDefinition, tc.running, tc.container)
+			assert.Equal(t, tc.want, got)
+			// The given task definition must not be modified.
+			assert.Equal(t, original, aws.ToString(tc.taskDefinition.ContainerDefinitions[0].Image))
 		})
 	}
 }
@@ -0,0 +1,311 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// fargateMemories is the list of the memory sizes (in MiB) supported by Fargate for each CPU size (in CPU units).
+// https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task-cpu-memory-error.html
+var fargateMemories = map[int]struct{ min, max, step int }{
+	256:   {512, 2048, 512},
+	512:   {1024, 4096, 1024},
+	1024:  {2048, 8192, 1024},
+	2048:  {4096, 16384, 1024},
+	4096:  {8192, 30720, 1024},
+	8192:  {16384, 61440, 4096},
+	16384: {32768, 122880, 8192},
+}
+
+// validateRequiredServiceFields checks whether the fields required to create the given service are specified.
+func validateRequiredServiceFields(service types.Service) error {
+	if aws.ToString(service.ServiceName) == "" {
+		return fmt.Errorf("serviceName is required")
+	}
+	if aws.ToString(service.ClusterArn) == "" {
+		return fmt.Errorf("cluster is required")
+	}
+	if service.DesiredCount < 0 {
+		return fmt.Errorf("desiredCount must be greater than or equal to 0")
+	}
+	return nil
+}
+
+// validateTaskDefinition checks whether the given task definition can be registered and run with the given launch type.
+func validateTaskDefinition(taskDefinition types.TaskDefinition, launchType types.LaunchType) error {
+	if aws.ToString(taskDefinition.Family) == "" {
+		return fmt.Errorf("family is required")
+	}
+	if len(taskDefinition.ContainerDefinitions) == 0 {
+		return fmt.Errorf("at least one container definition is required")
+	}
+
+	var (
+		names        = make(map[string]struct{}, len(taskDefinition.ContainerDefinitions))
+		hasEssential bool
+	)
+	for i, c := range taskDefinition.ContainerDefinitions {
+		name := aws.ToString(c.Name)
+		if name == "" {
+			return fmt.Errorf("name is required for container definition at index %d", i)
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("container %s is defined multiple times", name)
+		}
+		names[name] = struct{}{}
+		if aws.ToString(c.Image) == "" {
+			return fmt.Errorf("image is required for container %s", name)
+		}
+		// The container is essential when the essential field is omitted.
+		if c.Essential == nil || *c.Essential {
+			hasEssential = true
+		}
+	}
+	if !hasEssential {
+		return fmt.Errorf("at least one container must be essential")
+	}
+
+	if err := validatePortMappings(taskDefinition); err != nil {
+		return err
+	}
+	if launchType == types.LaunchTypeFargate || requiresFargate(taskDefinition) {
+		return validateFargateTaskDefinition(taskDefinition)
+	}
+	return nil
+}
+
+// validatePortMappings checks whether the port mappings of the given task definition do not conflict with each other.
+// The containers of the task using awsvpc or host network mode share the same network namespace,
+// so the container ports must be unique across all containers of the task.
+func validatePortMappings(taskDefinition types.TaskDefinition) error {
+	var (
+		sharedNetwork = taskDefinition.NetworkMode == types.NetworkModeAwsvpc || taskDefinition.NetworkMode == types.NetworkModeHost
+		names         = make(map[string]struct{})
+		taskPorts     = make(map[string]string)
+	)
+	for _, c := range taskDefinition.ContainerDefinitions {
+		container := aws.ToString(c.Name)
+		containerPorts := make(map[string]struct{}, len(c.PortMappings))
+		for _, pm := range c.PortMappings {
+			if name := aws.ToString(pm.Name); name != "" {
+				if _, ok := names[name]; ok {
+					return fmt.Errorf("port mapping name %s is used multiple times", name)
+				}
+				names[name] = struct{}{}
+			}
+			// The port range can not be compared with the single ports, it is validated by ECS.
+			if pm.ContainerPortRange != nil {
+				continue
+			}
+			if pm.ContainerPort == nil {
+				return fmt.Errorf("containerPort is required for port mapping of container %s", container)
+			}
+			port := *pm.ContainerPort
+			if port < 1 || port > 65535 {
+				return fmt.Errorf("containerPort %d of container %s must be in range [1, 65535]", port, container)
+			}
+			if sharedNetwork && pm.HostPort != nil && *pm.HostPort != 0 && *pm.HostPort != port {
+				return fmt.Errorf("hostPort %d of container %s must be the same as containerPort %d in %s network mode", *pm.HostPort, container, port, taskDefinition.NetworkMode)
+			}
+
+			protocol := pm.Protocol
+			if protocol == "" {
+				protocol = types.TransportProtocolTcp
+			}
+			key := fmt.Sprintf("%d/%s", port, protocol)
+			if _, ok := containerPorts[key]; ok {
+				return fmt.Errorf("port %s of container %s is mapped multiple times", key, container)
+			}
+			containerPorts[key] = struct{}{}
+			if !sharedNetwork {
+				continue
+			}
+			if other, ok := taskPorts[key]; ok {
+				return fmt.Errorf("port %s is used by both container %s and %s in %s network mode", key, other, container, taskDefinition.NetworkMode)
+			}
+			taskPorts[key] = container
+		}
+	}
+	return nil
+}
+
+func requiresFargate(taskDefinition types.TaskDefinition) bool {
+	for _, c := range taskDefinition.RequiresCompatibilities {
+		if c == types.CompatibilityFargate {
+			return true
+		}
+	}
+	return false
+}
+
+// validateFargateTaskDefinition checks whether the given task definition can be run on Fargate.
+func validateFargateTaskDefinition(taskDefinition types.TaskDefinition) error {
+	if taskDefinition.NetworkMode != types.NetworkModeAwsvpc {
+		return fmt.Errorf("networkMode must be awsvpc to run the task on Fargate")
+	}
+	if taskDefinition.Cpu == nil || taskDefinition.Memory == nil {
+		return fmt.Errorf("task-level cpu and memory are required to run the task on Fargate")
+	}
+
+	cpu, err := parseTaskCPU(*taskDefinition.Cpu)
+	if err != nil {
+		return err
+	}
+	memory, err := parseTaskMemory(*taskDefinition.Memory)
+	if err != nil {
+		return err
+	}
+	m, ok := fargateMemories[cpu]
+	if !ok {
+		return fmt.Errorf("cpu %s is not supported by Fargate, use one of 256, 512, 1024, 2048, 4096, 8192 and 16384", *taskDefinition.Cpu)
+	}
+	if memory < m.min || memory > m.max || (memory-m.min)%m.step != 0 {
+		return fmt.Errorf("memory %s is not supported by Fargate with cpu %s, use the value between %d and %d in increments of %d", *taskDefinition.Memory, *taskDefinition.Cpu, m.min, m.max, m.step)
+	}
+	return nil
+}
+
+// parseTaskCPU returns the CPU units of the given task-level cpu, e.g. "1024" or "1 vCPU".
+func parseTaskCPU(cpu string) (int, error) {
+	v := strings.TrimSpace(strings.ToLower(cpu))
+	if strings.HasSuffix(v, "vcpu") {
+		f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(v, "vcpu")), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid cpu %s: %w", cpu, err)
+		}
+		return int(f * 1024), nil
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cpu %s: %w", cpu, err)
+	}
+	return n, nil
+}
+
+// parseTaskMemory returns the MiB of the given task-level memory, e.g. "2048" or "2 GB".
+func parseTaskMemory(memory string) (int, error) {
+	v := strings.TrimSpace(strings.ToLower(memory))
+	if strings.HasSuffix(v, "gb") {
+		f, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(v, "gb")), 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid memory %s: %w", memory, err)
+		}
+		return int(f * 1024), nil
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimSuffix(v, "mb")))
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory %s: %w", memory, err)
+	}
+	return n, nil
+}
+
+// serviceLaunchType returns the launch type of the tasks of the given service.
+// The service using only Fargate capacity providers is treated as the Fargate one.
+func serviceLaunchType(service types.Service) types.LaunchType {
+	if service.LaunchType != "" || len(service.CapacityProviderStrategy) == 0 {
+		return service.LaunchType
+	}
+	for _, s := range service.CapacityProviderStrategy {
+		switch aws.ToString(s.CapacityProvider) {
+		case "FARGATE", "FARGATE_SPOT":
+		default:
+			return ""
+		}
+	}
+	return types.LaunchTypeFargate
+}
+
+// validateServicePorts checks whether the ports referred from the given service and target groups
+// are exposed by the containers of the given task definition.
+func validateServicePorts(taskDefinition types.TaskDefinition, service types.Service, targetGroups config.ECSTargetGroups) error {
+	lbs := append([]types.LoadBalancer(nil), service.LoadBalancers...)
+	if len(targetGroups.Primary) > 0 {
+		primary, canary, err := loadTargetGroups(targetGroups)
+		if err != nil {
+			return err
+		}
+		lbs = append(lbs, *primary)
+		if canary != nil {
+			lbs = append(lbs, *canary)
+		}
+	}
+	for _, lb := range lbs {
+		if lb.ContainerName == nil && lb.ContainerPort == nil {
+			continue
+		}
+		if !hasContainerPort(taskDefinition, aws.ToString(lb.ContainerName), aws.ToInt32(lb.ContainerPort)) {
+			return fmt.Errorf("port %d of container %s used by the load balancer is not exposed by the task definition", aws.ToInt32(lb.ContainerPort), aws.ToString(lb.ContainerName))
+		}
+	}
+
+	for _, r := range service.ServiceRegistries {
+		if r.ContainerName == nil && r.ContainerPort == nil {
+			continue
+		}
+		if !hasContainerPort(taskDefinition, aws.ToString(r.ContainerName), aws.ToInt32(r.ContainerPort)) {
+			return fmt.Errorf("port %d of container %s used by the service registry is not exposed by the task definition", aws.ToInt32(r.ContainerPort), aws.ToString(r.ContainerName))
+		}
+	}
+
+	sc := ServiceConnectConfiguration(service)
+	if sc == nil || !sc.Enabled {
+		return nil
+	}
+	for _, s := range sc.Services {
+		name := aws.ToString(s.PortName)
+		if !hasPortMappingName(taskDefinition, name) {
+			return fmt.Errorf("port mapping named %s used by Service Connect is not defined in the task definition", name)
+		}
+	}
+	return nil
+}
+
+// hasContainerPort returns true when the given container of the task definition exposes the given port.
+// The port is not compared when it is not specified.
+func hasContainerPort(taskDefinition types.TaskDefinition, container string, port int32) bool {
+	for _, c := range taskDefinition.ContainerDefinitions {
+		if aws.ToString(c.Name) != container {
+			continue
+		}
+		if port == 0 {
+			return true
+		}
+		for _, pm := range c.PortMappings {
+			if aws.ToInt32(pm.ContainerPort) == port {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func hasPortMappingName(taskDefinition types.TaskDefinition, name string) bool {
+	for _, c := range taskDefinition.ContainerDefinitions {
+		for _, pm := range c.PortMappings {
+			if aws.ToString(pm.Name) == name {
+				return true
+			}
+		}
+	}
+	return false
+}
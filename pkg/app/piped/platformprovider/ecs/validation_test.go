@@ -0,0 +1,365 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ecs
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestValidateTaskDefinition(t *testing.T) {
+	t.Parallel()
+
+	fargate := func(cpu, memory string) types.TaskDefinition {
+		return types.TaskDefinition{
+			Family:                  aws.String("nginx"),
+			NetworkMode:             types.NetworkModeAwsvpc,
+			RequiresCompatibilities: []types.Compatibility{types.CompatibilityFargate},
+			Cpu:                     aws.String(cpu),
+			Memory:                  aws.String(memory),
+			ContainerDefinitions: []types.ContainerDefinition{
+				{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name           string
+		taskDefinition types.TaskDefinition
+		launchType     types.LaunchType
+		expectErr      bool
+	}{
+		{
+			name:           "valid fargate task",
+			taskDefinition: fargate("256", "512"),
+		},
+		{
+			name:           "valid fargate task with units",
+			taskDefinition: fargate("1 vCPU", "3 GB"),
+		},
+		{
+			name:           "unsupported cpu",
+			taskDefinition: fargate("300", "512"),
+			expectErr:      true,
+		},
+		{
+			name:           "memory out of range",
+			taskDefinition: fargate("256", "4096"),
+			expectErr:      true,
+		},
+		{
+			name:           "memory not in increments",
+			taskDefinition: fargate("8192", "18432"),
+			expectErr:      true,
+		},
+		{
+			name: "fargate launch type without cpu",
+			taskDefinition: types.TaskDefinition{
+				Family:      aws.String("nginx"),
+				NetworkMode: types.NetworkModeAwsvpc,
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+				},
+			},
+			launchType: types.LaunchTypeFargate,
+			expectErr:  true,
+		},
+		{
+			name: "ec2 task without cpu",
+			taskDefinition: types.TaskDefinition{
+				Family:      aws.String("nginx"),
+				NetworkMode: types.NetworkModeBridge,
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+				},
+			},
+			launchType: types.LaunchTypeEc2,
+		},
+		{
+			name: "missing family",
+			taskDefinition: types.TaskDefinition{
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name:           "no container",
+			taskDefinition: types.TaskDefinition{Family: aws.String("nginx")},
+			expectErr:      true,
+		},
+		{
+			name: "missing image",
+			taskDefinition: types.TaskDefinition{
+				Family: aws.String("nginx"),
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web")},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "duplicated container name",
+			taskDefinition: types.TaskDefinition{
+				Family: aws.String("nginx"),
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web"), Image: aws.String("nginx:1.25")},
+					{Name: aws.String("web"), Image: aws.String("envoy:1.25")},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "no essential container",
+			taskDefinition: types.TaskDefinition{
+				Family: aws.String("nginx"),
+				ContainerDefinitions: []types.ContainerDefinition{
+					{Name: aws.String("web"), Image: aws.String("nginx:1.25"), Essential: aws.Bool(false)},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateTaskDefinition(tc.taskDefinition, tc.launchType)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
+
+func TestValidatePortMappings(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string, mappings ...types.PortMapping) types.ContainerDefinition {
+		return types.ContainerDefinition{Name: aws.String(name), Image: aws.String(name), PortMappings: mappings}
+	}
+
+	testcases := []struct {
+		name           string
+		taskDefinition types.TaskDefinition
+		expectErr      bool
+	}{
+		{
+			name: "valid awsvpc ports",
+			taskDefinition: types.TaskDefinition{
+				NetworkMode: types.NetworkModeAwsvpc,
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(80), Name: aws.String("http")}),
+					container("envoy", types.PortMapping{ContainerPort: aws.Int32(9901)}),
+				},
+			},
+		},
+		{
+			name: "same container port used by different containers in bridge mode",
+			taskDefinition: types.TaskDefinition{
+				NetworkMode: types.NetworkModeBridge,
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(8080)}),
+					container("admin", types.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(8081)}),
+				},
+			},
+		},
+		{
+			name: "same container port used by different containers in awsvpc mode",
+			taskDefinition: types.TaskDefinition{
+				NetworkMode: types.NetworkModeAwsvpc,
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPort: aws.Int32(80)}),
+					container("admin", types.PortMapping{ContainerPort: aws.Int32(80)}),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "different host port in awsvpc mode",
+			taskDefinition: types.TaskDefinition{
+				NetworkMode: types.NetworkModeAwsvpc,
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(8080)}),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "same port with different protocols",
+			taskDefinition: types.TaskDefinition{
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("dns",
+						types.PortMapping{ContainerPort: aws.Int32(53)},
+						types.PortMapping{ContainerPort: aws.Int32(53), Protocol: types.TransportProtocolUdp},
+					),
+				},
+			},
+		},
+		{
+			name: "duplicated port mapping name",
+			taskDefinition: types.TaskDefinition{
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web",
+						types.PortMapping{ContainerPort: aws.Int32(80), Name: aws.String("http")},
+						types.PortMapping{ContainerPort: aws.Int32(8080), Name: aws.String("http")},
+					),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "container port out of range",
+			taskDefinition: types.TaskDefinition{
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPort: aws.Int32(70000)}),
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "container port range",
+			taskDefinition: types.TaskDefinition{
+				ContainerDefinitions: []types.ContainerDefinition{
+					container("web", types.PortMapping{ContainerPortRange: aws.String("8000-8010")}),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validatePortMappings(tc.taskDefinition)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
+
+func TestServiceLaunchType(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		service types.Service
+		want    types.LaunchType
+	}{
+		{
+			name:    "launch type",
+			service: types.Service{LaunchType: types.LaunchTypeEc2},
+			want:    types.LaunchTypeEc2,
+		},
+		{
+			name: "fargate capacity providers",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("FARGATE")},
+					{CapacityProvider: aws.String("FARGATE_SPOT")},
+				},
+			},
+			want: types.LaunchTypeFargate,
+		},
+		{
+			name: "ec2 capacity provider",
+			service: types.Service{
+				CapacityProviderStrategy: []types.CapacityProviderStrategyItem{
+					{CapacityProvider: aws.String("my-asg")},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, serviceLaunchType(tc.service))
+		})
+	}
+}
+
+func TestValidateServicePorts(t *testing.T) {
+	t.Parallel()
+
+	taskDefinition := types.TaskDefinition{
+		ContainerDefinitions: []types.ContainerDefinition{
+			{
+				Name:         aws.String("web"),
+				PortMappings: []types.PortMapping{{ContainerPort: aws.Int32(80), Name: aws.String("http")}},
+			},
+		},
+	}
+	withServiceConnect := func(portName string) types.Service {
+		return types.Service{
+			Deployments: []types.Deployment{
+				{
+					Status: aws.String(primaryDeploymentStatus),
+					ServiceConnectConfiguration: &types.ServiceConnectConfiguration{
+						Enabled:  true,
+						Services: []types.ServiceConnectService{{PortName: aws.String(portName)}},
+					},
+				},
+			},
+		}
+	}
+
+	testcases := []struct {
+		name         string
+		service      types.Service
+		targetGroups config.ECSTargetGroups
+		expectErr    bool
+	}{
+		{
+			name:         "target group port is exposed",
+			targetGroups: config.ECSTargetGroups{Primary: []byte(`{"targetGroupArn":"arn","containerName":"web","containerPort":80}`)},
+		},
+		{
+			name:         "target group port is not exposed",
+			targetGroups: config.ECSTargetGroups{Primary: []byte(`{"targetGroupArn":"arn","containerName":"web","containerPort":8080}`)},
+			expectErr:    true,
+		},
+		{
+			name: "target group container is not defined",
+			targetGroups: config.ECSTargetGroups{
+				Primary: []byte(`{"targetGroupArn":"arn","containerName":"web","containerPort":80}`),
+				Canary:  []byte(`{"targetGroupArn":"arn","containerName":"api","containerPort":80}`),
+			},
+			expectErr: true,
+		},
+		{
+			name: "service registry port is not exposed",
+			service: types.Service{
+				ServiceRegistries: []types.ServiceRegistry{{ContainerName: aws.String("web"), ContainerPort: aws.Int32(443)}},
+			},
+			expectErr: true,
+		},
+		{
+			name:    "service connect port name is defined",
+			service: withServiceConnect("http"),
+		},
+		{
+			name:      "service connect port name is not defined",
+			service:   withServiceConnect("grpc"),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateServicePorts(taskDefinition, tc.service, tc.targetGroups)
+			assert.Equal(t, tc.expectErr, err != nil, err)
+		})
+	}
+}
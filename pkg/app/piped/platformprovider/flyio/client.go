@@ -0,0 +1,192 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const requestTimeout = 30 * time.Second
+
+type client struct {
+	apiURL     string
+	org        string
+	token      string
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+func newClient(apiURL, org, token string, logger *zap.Logger) *client {
+	return &client{
+		apiURL:     strings.TrimSuffix(apiURL, "/"),
+		org:        org,
+		token:      token,
+		httpClient: &http.Client{Timeout: requestTimeout},
+		logger:     logger.Named("flyio"),
+	}
+}
+
+// apiError is returned when the Machines API responded with an error status.
+type apiError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("fly.io api returned status %d: %s", e.StatusCode, e.Message)
+}
+
+func (c *client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.apiURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode >= http.StatusMultipleChoices {
+		var e struct {
+			Error string `json:"error"`
+		}
+		msg := strings.TrimSpace(string(data))
+		if json.Unmarshal(data, &e) == nil && e.Error != "" {
+			msg = e.Error
+		}
+		return &apiError{StatusCode: resp.StatusCode, Message: msg}
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+func machinesPath(app string) string {
+	return "/v1/apps/" + url.PathEscape(app) + "/machines"
+}
+
+func machinePath(app, id string) string {
+	return machinesPath(app) + "/" + url.PathEscape(id)
+}
+
+func (c *client) ListApps(ctx context.Context) ([]*App, error) {
+	var out struct {
+		Apps []*App `json:"apps"`
+	}
+	path := "/v1/apps?org_slug=" + url.QueryEscape(c.org)
+	if err := c.do(ctx, http.MethodGet, path, nil, &out); err != nil {
+		return nil, fmt.Errorf("failed to list apps of organization %s: %w", c.org, err)
+	}
+	return out.Apps, nil
+}
+
+func (c *client) ListMachines(ctx context.Context, app string) ([]*Machine, error) {
+	var machines []*Machine
+	if err := c.do(ctx, http.MethodGet, machinesPath(app), nil, &machines); err != nil {
+		if err == ErrNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to list machines of app %s: %w", app, err)
+	}
+	return machines, nil
+}
+
+func (c *client) GetMachine(ctx context.Context, app, id string) (*Machine, error) {
+	var m Machine
+	if err := c.do(ctx, http.MethodGet, machinePath(app, id), nil, &m); err != nil {
+		if err == ErrNotFound {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to get machine %s of app %s: %w", id, app, err)
+	}
+	return &m, nil
+}
+
+func (c *client) CreateMachine(ctx context.Context, app, region string, cfg MachineConfig, skipServiceRegistration bool) (*Machine, error) {
+	in := struct {
+		Region                  string        `json:"region,omitempty"`
+		Config                  MachineConfig `json:"config"`
+		SkipServiceRegistration bool          `json:"skip_service_registration,omitempty"`
+	}{
+		Region:                  region,
+		Config:                  cfg,
+		SkipServiceRegistration: skipServiceRegistration,
+	}
+	var m Machine
+	if err := c.do(ctx, http.MethodPost, machinesPath(app), in, &m); err != nil {
+		return nil, fmt.Errorf("failed to create machine of app %s in region %s: %w", app, region, err)
+	}
+	c.logger.Info("created a machine",
+		zap.String("app", app),
+		zap.String("machine", m.ID),
+		zap.String("region", m.Region),
+	)
+	return &m, nil
+}
+
+func (c *client) UncordonMachine(ctx context.Context, app, id string) error {
+	if err := c.do(ctx, http.MethodPost, machinePath(app, id)+"/uncordon", nil, nil); err != nil {
+		return fmt.Errorf("failed to uncordon machine %s of app %s: %w", id, app, err)
+	}
+	return nil
+}
+
+func (c *client) DestroyMachine(ctx context.Context, app, id string) error {
+	if err := c.do(ctx, http.MethodDelete, machinePath(app, id)+"?force=true", nil, nil); err != nil {
+		if err == ErrNotFound {
+			return err
+		}
+		return fmt.Errorf("failed to destroy machine %s of app %s: %w", id, app, err)
+	}
+	c.logger.Info("destroyed a machine",
+		zap.String("app", app),
+		zap.String("machine", id),
+	)
+	return nil
+}
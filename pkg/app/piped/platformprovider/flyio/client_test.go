@@ -0,0 +1,131 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		h(w, r)
+	}))
+	t.Cleanup(ts.Close)
+	return newClient(ts.URL+"/", "my-team", "token", zap.NewNop())
+}
+
+func TestClientListApps(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method)
+		assert.Equal(t, "/v1/apps", r.URL.Path)
+		assert.Equal(t, "my-team", r.URL.Query().Get("org_slug"))
+		w.Write([]byte(`{"total_apps":1,"apps":[{"id":"app-id","name":"my-app","machine_count":2}]}`))
+	})
+	apps, err := c.ListApps(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []*App{{ID: "app-id", Name: "my-app", MachineCount: 2}}, apps)
+}
+
+func TestClientListMachines(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/apps/my-app/machines":
+			w.Write([]byte(`[{"id":"m1","state":"started","region":"nrt","config":{"image":"nginx","metadata":{"fly_process_group":"app"}},"checks":[{"name":"status","status":"passing"}]}]`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":"app not found"}`))
+		}
+	})
+
+	machines, err := c.ListMachines(context.Background(), "my-app")
+	require.NoError(t, err)
+	require.Len(t, machines, 1)
+	assert.Equal(t, "m1", machines[0].ID)
+	assert.True(t, machines[0].IsAppMachine())
+	assert.True(t, machines[0].IsHealthy())
+
+	_, err = c.ListMachines(context.Background(), "unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestClientCreateMachine(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/apps/my-app/machines", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var in map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, "nrt", in["region"])
+		assert.Equal(t, true, in["skip_service_registration"])
+		assert.Equal(t, "nginx", in["config"].(map[string]interface{})["image"])
+		w.Write([]byte(`{"id":"m2","state":"created","region":"nrt"}`))
+	})
+
+	m, err := c.CreateMachine(context.Background(), "my-app", "nrt", MachineConfig{Image: "nginx"}, true)
+	require.NoError(t, err)
+	assert.Equal(t, "m2", m.ID)
+}
+
+func TestClientUncordonAndDestroyMachine(t *testing.T) {
+	t.Parallel()
+
+	var requests []string
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.RequestURI())
+		if r.URL.Path == "/v1/apps/my-app/machines/gone" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"ok":true}`))
+	})
+
+	require.NoError(t, c.UncordonMachine(context.Background(), "my-app", "m1"))
+	require.NoError(t, c.DestroyMachine(context.Background(), "my-app", "m1"))
+	assert.ErrorIs(t, c.DestroyMachine(context.Background(), "my-app", "gone"), ErrNotFound)
+	assert.Equal(t, []string{
+		"POST /v1/apps/my-app/machines/m1/uncordon",
+		"DELETE /v1/apps/my-app/machines/m1?force=true",
+		"DELETE /v1/apps/my-app/machines/gone?force=true",
+	}, requests)
+}
+
+func TestClientAPIError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(`{"error":"invalid guest"}`))
+	})
+
+	_, err := c.CreateMachine(context.Background(), "my-app", "nrt", MachineConfig{Image: "nginx"}, false)
+	require.Error(t, err)
+	assert.Equal(t, "failed to create machine of app my-app in region nrt: fly.io api returned status 422: invalid guest", err.Error())
+}
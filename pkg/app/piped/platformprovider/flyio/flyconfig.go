@@ -0,0 +1,592 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// The VM size presets of Fly.io.
+var vmSizes = map[string]MachineGuest{
+	"shared-cpu-1x":   {CPUKind: "shared", CPUs: 1, MemoryMB: 256},
+	"shared-cpu-2x":   {CPUKind: "shared", CPUs: 2, MemoryMB: 512},
+	"shared-cpu-4x":   {CPUKind: "shared", CPUs: 4, MemoryMB: 1024},
+	"shared-cpu-8x":   {CPUKind: "shared", CPUs: 8, MemoryMB: 2048},
+	"performance-1x":  {CPUKind: "performance", CPUs: 1, MemoryMB: 2048},
+	"performance-2x":  {CPUKind: "performance", CPUs: 2, MemoryMB: 4096},
+	"performance-4x":  {CPUKind: "performance", CPUs: 4, MemoryMB: 8192},
+	"performance-8x":  {CPUKind: "performance", CPUs: 8, MemoryMB: 16384},
+	"performance-16x": {CPUKind: "performance", CPUs: 16, MemoryMB: 32768},
+}
+
+const defaultVMSize = "shared-cpu-1x"
+
+// The top-level keys of fly.toml supported by piped.
+// The others such as processes and mounts are rejected instead of being ignored silently.
+var supportedFlyConfigKeys = map[string]struct{}{
+	"app":            {},
+	"primary_region": {},
+	"kill_signal":    {},
+	"kill_timeout":   {},
+	"build":          {},
+	"deploy":         {},
+	"env":            {},
+	"http_service":   {},
+	"services":       {},
+	"checks":         {},
+	"vm":             {},
+	"metrics":        {},
+	"statics":        {},
+}
+
+var memoryRegex = regexp.MustCompile(`^(?i)(\d+)\s*(mb|gb)?$`)
+
+// FlyConfig represents the content of a fly.toml file.
+type FlyConfig struct {
+	// The name of the Fly.io app.
+	App string
+	// The region where the machines are created when the app does not have any machines.
+	PrimaryRegion string
+	// The configuration of the machines built from the file.
+	Machine MachineConfig
+
+	// The decoded content of the file.
+	raw map[string]interface{}
+}
+
+func LoadFlyConfig(appDir, fileName string) (FlyConfig, error) {
+	path := filepath.Join(appDir, fileName)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FlyConfig{}, err
+	}
+	return ParseFlyConfig(data)
+}
+
+func ParseFlyConfig(data []byte) (FlyConfig, error) {
+	raw, err := decodeTOML(string(data))
+	if err != nil {
+		return FlyConfig{}, err
+	}
+
+	for k := range raw {
+		if _, ok := supportedFlyConfigKeys[k]; !ok {
+			return FlyConfig{}, fmt.Errorf("%s is not supported", k)
+		}
+	}
+
+	d := &flyConfigDecoder{}
+	root := tomlTable{m: raw}
+	cfg := FlyConfig{
+		App:           d.str(root, "app"),
+		PrimaryRegion: d.str(root, "primary_region"),
+		raw:           raw,
+	}
+	cfg.Machine = d.machineConfig(root)
+	if d.err != nil {
+		return FlyConfig{}, d.err
+	}
+
+	if cfg.App == "" {
+		return FlyConfig{}, fmt.Errorf("app must be set")
+	}
+	if cfg.Machine.Image == "" {
+		return FlyConfig{}, fmt.Errorf("build.image must be set since piped deploys the pre-built image")
+	}
+	return cfg, nil
+}
+
+// MachineConfigWithLabels returns a copy of the machine configuration having the given labels in its metadata.
+func (c FlyConfig) MachineConfigWithLabels(labels map[string]string) MachineConfig {
+	cfg := c.Machine
+	cfg.Metadata = make(map[string]string, len(c.Machine.Metadata)+len(labels))
+	for k, v := range c.Machine.Metadata {
+		cfg.Metadata[k] = v
+	}
+	for k, v := range labels {
+		cfg.Metadata[k] = v
+	}
+	return cfg
+}
+
+// FindArtifactVersions returns the version of the image deployed by the given configuration.
+func FindArtifactVersions(cfg FlyConfig) ([]*model.ArtifactVersion, error) {
+	if cfg.Machine.Image == "" {
+		return nil, fmt.Errorf("build.image was missing")
+	}
+	name, tag := parseContainerImage(cfg.Machine.Image)
+	return []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    name,
+			Url:     cfg.Machine.Image,
+		},
+	}, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	last := paths[len(paths)-1]
+	if i := strings.LastIndex(last, ":"); i >= 0 {
+		// The digest takes precedence over the tag when both were specified.
+		if tag == "" {
+			tag = last[i+1:]
+		}
+		last = last[:i]
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	return last, tag
+}
+
+// tomlTable is a decoded TOML table with its path used in the error messages.
+type tomlTable struct {
+	path string
+	m    map[string]interface{}
+}
+
+func (t tomlTable) key(k string) string {
+	if t.path == "" {
+		return k
+	}
+	return t.path + "." + k
+}
+
+// flyConfigDecoder reads the values of the decoded fly.toml.
+// It keeps the first error so that the values can be read without checking the error one by one.
+type flyConfigDecoder struct {
+	err error
+}
+
+func (d *flyConfigDecoder) fail(format string, args ...interface{}) {
+	if d.err == nil {
+		d.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (d *flyConfigDecoder) str(t tomlTable, k string) string {
+	v, ok := t.m[k]
+	if !ok {
+		return ""
+	}
+	s, ok := v.(string)
+	if !ok {
+		d.fail("%s must be a string", t.key(k))
+	}
+	return s
+}
+
+func (d *flyConfigDecoder) integer(t tomlTable, k string) (int, bool) {
+	v, ok := t.m[k]
+	if !ok {
+		return 0, false
+	}
+	n, ok := v.(int64)
+	if !ok {
+		d.fail("%s must be an integer", t.key(k))
+		return 0, false
+	}
+	return int(n), true
+}
+
+func (d *flyConfigDecoder) boolean(t tomlTable, k string) *bool {
+	v, ok := t.m[k]
+	if !ok {
+		return nil
+	}
+	b, ok := v.(bool)
+	if !ok {
+		d.fail("%s must be a boolean", t.key(k))
+		return nil
+	}
+	return &b
+}
+
+func (d *flyConfigDecoder) strings(t tomlTable, k string) []string {
+	v, ok := t.m[k]
+	if !ok {
+		return nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		d.fail("%s must be an array of strings", t.key(k))
+		return nil
+	}
+	out := make([]string, 0, len(list))
+	for _, e := range list {
+		s, ok := e.(string)
+		if !ok {
+			d.fail("%s must be an array of strings", t.key(k))
+			return nil
+		}
+		out = append(out, s)
+	}
+	return out
+}
+
+func (d *flyConfigDecoder) table(t tomlTable, k string) (tomlTable, bool) {
+	v, ok := t.m[k]
+	if !ok {
+		return tomlTable{}, false
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		d.fail("%s must be a table", t.key(k))
+		return tomlTable{}, false
+	}
+	return tomlTable{path: t.key(k), m: m}, true
+}
+
+// tables returns the tables of the given array of tables.
+// A single table is also accepted as an array having only that table.
+func (d *flyConfigDecoder) tables(t tomlTable, k string) []tomlTable {
+	v, ok := t.m[k]
+	if !ok {
+		return nil
+	}
+	if m, ok := v.(map[string]interface{}); ok {
+		return []tomlTable{{path: t.key(k), m: m}}
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		d.fail("%s must be an array of tables", t.key(k))
+		return nil
+	}
+	out := make([]tomlTable, 0, len(list))
+	for i, e := range list {
+		m, ok := e.(map[string]interface{})
+		if !ok {
+			d.fail("%s must be an array of tables", t.key(k))
+			return nil
+		}
+		out = append(out, tomlTable{path: fmt.Sprintf("%s[%d]", t.key(k), i), m: m})
+	}
+	return out
+}
+
+// duration returns the given duration as a string accepted by the Machines API.
+// An integer value is interpreted in the given unit.
+func (d *flyConfigDecoder) duration(t tomlTable, k string, unit time.Duration) string {
+	v, ok := t.m[k]
+	if !ok {
+		return ""
+	}
+	switch v := v.(type) {
+	case int64:
+		return (time.Duration(v) * unit).String()
+	case string:
+		if _, err := time.ParseDuration(v); err != nil {
+			d.fail("%s must be a duration: %v", t.key(k), err)
+			return ""
+		}
+		return v
+	}
+	d.fail("%s must be a duration", t.key(k))
+	return ""
+}
+
+// onlyDefaultProcess fails if the given table is restricted to the processes other than the default one.
+func (d *flyConfigDecoder) onlyDefaultProcess(t tomlTable) {
+	for _, p := range d.strings(t, "processes") {
+		if p != defaultProcessGroup {
+			d.fail("%s must contain only %q since process groups are not supported", t.key("processes"), defaultProcessGroup)
+			return
+		}
+	}
+}
+
+func (d *flyConfigDecoder) machineConfig(root tomlTable) MachineConfig {
+	cfg := MachineConfig{
+		Metadata: map[string]string{
+			processGroupMetadataKey: defaultProcessGroup,
+		},
+	}
+
+	if build, ok := d.table(root, "build"); ok {
+		cfg.Image = d.str(build, "image")
+	}
+	if deploy, ok := d.table(root, "deploy"); ok {
+		if _, ok := deploy.m["release_command"]; ok {
+			d.fail("deploy.release_command is not supported")
+		}
+	}
+
+	if env, ok := d.table(root, "env"); ok {
+		cfg.Env = make(map[string]string, len(env.m))
+		for k, v := range env.m {
+			switch v.(type) {
+			case map[string]interface{}, []interface{}:
+				d.fail("%s must be a scalar value", env.key(k))
+			default:
+				cfg.Env[k] = fmt.Sprint(v)
+			}
+		}
+	}
+
+	signal := d.str(root, "kill_signal")
+	timeout := d.duration(root, "kill_timeout", time.Second)
+	if signal != "" || timeout != "" {
+		cfg.StopConfig = &MachineStopConfig{
+			Signal:  signal,
+			Timeout: timeout,
+		}
+	}
+
+	cfg.Guest = d.guest(root)
+
+	if hs, ok := d.table(root, "http_service"); ok {
+		cfg.Services = append(cfg.Services, d.httpService(hs))
+	}
+	for _, s := range d.tables(root, "services") {
+		cfg.Services = append(cfg.Services, d.service(s))
+	}
+
+	if checks, ok := d.table(root, "checks"); ok {
+		cfg.Checks = make(map[string]MachineCheck, len(checks.m))
+		for name := range checks.m {
+			if c, ok := d.table(checks, name); ok {
+				cfg.Checks[name] = d.check(c, time.Second)
+			}
+		}
+	}
+
+	if metrics, ok := d.table(root, "metrics"); ok {
+		port, _ := d.integer(metrics, "port")
+		cfg.Metrics = &MachineMetrics{
+			Port: port,
+			Path: d.str(metrics, "path"),
+		}
+	}
+
+	for _, s := range d.tables(root, "statics") {
+		cfg.Statics = append(cfg.Statics, MachineStatic{
+			GuestPath: d.str(s, "guest_path"),
+			URLPrefix: d.str(s, "url_prefix"),
+		})
+	}
+
+	return cfg
+}
+
+func (d *flyConfigDecoder) guest(root tomlTable) *MachineGuest {
+	vms := d.tables(root, "vm")
+	if len(vms) > 1 {
+		d.fail("vm must be defined only once since process groups are not supported")
+		return nil
+	}
+
+	guest := vmSizes[defaultVMSize]
+	if len(vms) == 0 {
+		return &guest
+	}
+
+	vm := vms[0]
+	d.onlyDefaultProcess(vm)
+	if size := d.str(vm, "size"); size != "" {
+		preset, ok := vmSizes[size]
+		if !ok {
+			d.fail("unknown vm size %s", size)
+			return nil
+		}
+		guest = preset
+	}
+	if kind := d.str(vm, "cpu_kind"); kind != "" {
+		guest.CPUKind = kind
+	}
+	if cpus, ok := d.integer(vm, "cpus"); ok {
+		guest.CPUs = cpus
+	}
+	if memory, ok := d.integer(vm, "memory_mb"); ok {
+		guest.MemoryMB = memory
+	}
+	switch memory := vm.m["memory"].(type) {
+	case nil:
+	case int64:
+		guest.MemoryMB = int(memory)
+	case string:
+		m := memoryRegex.FindStringSubmatch(strings.TrimSpace(memory))
+		if m == nil {
+			d.fail("%s must be a size such as 512mb or 1gb", vm.key("memory"))
+			return nil
+		}
+		n, _ := strconv.Atoi(m[1])
+		if strings.EqualFold(m[2], "gb") {
+			n *= 1024
+		}
+		guest.MemoryMB = n
+	default:
+		d.fail("%s must be a size such as 512mb or 1gb", vm.key("memory"))
+	}
+	return &guest
+}
+
+// autostop returns the value of auto_stop_machines which is either a boolean or one of "off", "stop" and "suspend".
+func (d *flyConfigDecoder) autostop(t tomlTable) interface{} {
+	switch v := t.m["auto_stop_machines"].(type) {
+	case nil:
+		return nil
+	case bool:
+		return v
+	case string:
+		switch v {
+		case "off", "stop", "suspend":
+			return v
+		}
+	}
+	d.fail("%s must be a boolean or one of off, stop and suspend", t.key("auto_stop_machines"))
+	return nil
+}
+
+func (d *flyConfigDecoder) minMachinesRunning(t tomlTable) *int {
+	n, ok := d.integer(t, "min_machines_running")
+	if !ok {
+		return nil
+	}
+	return &n
+}
+
+func (d *flyConfigDecoder) concurrency(t tomlTable) *MachineConcurrency {
+	c, ok := d.table(t, "concurrency")
+	if !ok {
+		return nil
+	}
+	hard, _ := d.integer(c, "hard_limit")
+	soft, _ := d.integer(c, "soft_limit")
+	return &MachineConcurrency{
+		Type:      d.str(c, "type"),
+		HardLimit: hard,
+		SoftLimit: soft,
+	}
+}
+
+// httpService converts the http_service section, the shorthand of a service
+// accepting HTTP on port 80 and HTTPS on port 443.
+func (d *flyConfigDecoder) httpService(t tomlTable) MachineService {
+	d.onlyDefaultProcess(t)
+	port, ok := d.integer(t, "internal_port")
+	if !ok {
+		d.fail("%s must be set", t.key("internal_port"))
+	}
+	forceHTTPS := d.boolean(t, "force_https")
+
+	svc := MachineService{
+		Protocol:           "tcp",
+		InternalPort:       port,
+		Autostop:           d.autostop(t),
+		Autostart:          d.boolean(t, "auto_start_machines"),
+		MinMachinesRunning: d.minMachinesRunning(t),
+		Ports: []MachinePort{
+			{Port: 80, Handlers: []string{"http"}, ForceHTTPS: forceHTTPS != nil && *forceHTTPS},
+			{Port: 443, Handlers: []string{"tls", "http"}},
+		},
+		Concurrency: d.concurrency(t),
+	}
+	for _, c := range d.tables(t, "checks") {
+		check := d.check(c, time.Millisecond)
+		check.Type = "http"
+		svc.Checks = append(svc.Checks, check)
+	}
+	return svc
+}
+
+func (d *flyConfigDecoder) service(t tomlTable) MachineService {
+	d.onlyDefaultProcess(t)
+	port, ok := d.integer(t, "internal_port")
+	if !ok {
+		d.fail("%s must be set", t.key("internal_port"))
+	}
+	protocol := d.str(t, "protocol")
+	if protocol == "" {
+		protocol = "tcp"
+	}
+
+	svc := MachineService{
+		Protocol:           protocol,
+		InternalPort:       port,
+		Autostop:           d.autostop(t),
+		Autostart:          d.boolean(t, "auto_start_machines"),
+		MinMachinesRunning: d.minMachinesRunning(t),
+		Concurrency:        d.concurrency(t),
+	}
+	for _, p := range d.tables(t, "ports") {
+		port, _ := d.integer(p, "port")
+		forceHTTPS := d.boolean(p, "force_https")
+		svc.Ports = append(svc.Ports, MachinePort{
+			Port:       port,
+			Handlers:   d.strings(p, "handlers"),
+			ForceHTTPS: forceHTTPS != nil && *forceHTTPS,
+		})
+	}
+	for _, c := range d.tables(t, "tcp_checks") {
+		check := d.check(c, time.Millisecond)
+		check.Type = "tcp"
+		svc.Checks = append(svc.Checks, check)
+	}
+	for _, c := range d.tables(t, "http_checks") {
+		check := d.check(c, time.Millisecond)
+		check.Type = "http"
+		svc.Checks = append(svc.Checks, check)
+	}
+	return svc
+}
+
+// check converts a health check.
+// The integer durations are interpreted in the given unit since it differs between the sections.
+func (d *flyConfigDecoder) check(t tomlTable, unit time.Duration) MachineCheck {
+	port, _ := d.integer(t, "port")
+	skip := d.boolean(t, "tls_skip_verify")
+	check := MachineCheck{
+		Type:          d.str(t, "type"),
+		Port:          port,
+		Interval:      d.duration(t, "interval", unit),
+		Timeout:       d.duration(t, "timeout", unit),
+		GracePeriod:   d.duration(t, "grace_period", unit),
+		Method:        d.str(t, "method"),
+		Path:          d.str(t, "path"),
+		Protocol:      d.str(t, "protocol"),
+		TLSSkipVerify: skip != nil && *skip,
+	}
+	if headers, ok := d.table(t, "headers"); ok {
+		names := make([]string, 0, len(headers.m))
+		for name := range headers.m {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			var values []string
+			if _, ok := headers.m[name].([]interface{}); ok {
+				values = d.strings(headers, name)
+			} else {
+				values = []string{d.str(headers, name)}
+			}
+			check.Headers = append(check.Headers, MachineHeader{Name: name, Values: values})
+		}
+	}
+	return check
+}
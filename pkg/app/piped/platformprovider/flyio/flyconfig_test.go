@@ -0,0 +1,367 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func newBool(b bool) *bool {
+	return &b
+}
+
+func newInt(n int) *int {
+	return &n
+}
+
+func TestParseFlyConfig(t *testing.T) {
+	t.Parallel()
+
+	data := `
+app = "my-app"
+primary_region = "nrt"
+kill_signal = "SIGTERM"
+kill_timeout = 10
+
+[build]
+  image = "registry.fly.io/my-app:v1.2.0"
+
+[deploy]
+  strategy = "bluegreen"
+
+[env]
+  PORT = "8080"
+  WORKERS = 4
+
+[http_service]
+  internal_port = 8080
+  force_https = true
+  auto_stop_machines = "suspend"
+  auto_start_machines = true
+  min_machines_running = 1
+  processes = ["app"]
+
+  [http_service.concurrency]
+    type = "requests"
+    soft_limit = 200
+    hard_limit = 250
+
+  [[http_service.checks]]
+    grace_period = "10s"
+    interval = "30s"
+    method = "GET"
+    timeout = "5s"
+    path = "/healthz"
+    [http_service.checks.headers]
+      X-Forwarded-Proto = "https"
+
+[[services]]
+  protocol = "tcp"
+  internal_port = 9090
+
+  [[services.ports]]
+    port = 9090
+
+  [[services.tcp_checks]]
+    interval = 15000
+    timeout = 2000
+
+[checks.status]
+  type = "http"
+  port = 5500
+  interval = 10
+  path = "/status"
+
+[[vm]]
+  size = "shared-cpu-2x"
+  memory = "1gb"
+`
+	cfg, err := ParseFlyConfig([]byte(data))
+	require.NoError(t, err)
+
+	assert.Equal(t, "my-app", cfg.App)
+	assert.Equal(t, "nrt", cfg.PrimaryRegion)
+	expected := MachineConfig{
+		Image: "registry.fly.io/my-app:v1.2.0",
+		Env: map[string]string{
+			"PORT":    "8080",
+			"WORKERS": "4",
+		},
+		Guest: &MachineGuest{CPUKind: "shared", CPUs: 2, MemoryMB: 1024},
+		Services: []MachineService{
+			{
+				Protocol:           "tcp",
+				InternalPort:       8080,
+				Autostop:           "suspend",
+				Autostart:          newBool(true),
+				MinMachinesRunning: newInt(1),
+				Ports: []MachinePort{
+					{Port: 80, Handlers: []string{"http"}, ForceHTTPS: true},
+					{Port: 443, Handlers: []string{"tls", "http"}},
+				},
+				Concurrency: &MachineConcurrency{Type: "requests", HardLimit: 250, SoftLimit: 200},
+				Checks: []MachineCheck{
+					{
+						Type:        "http",
+						Interval:    "30s",
+						Timeout:     "5s",
+						GracePeriod: "10s",
+						Method:      "GET",
+						Path:        "/healthz",
+						Headers: []MachineHeader{
+							{Name: "X-Forwarded-Proto", Values: []string{"https"}},
+						},
+					},
+				},
+			},
+			{
+				Protocol:     "tcp",
+				InternalPort: 9090,
+				Ports: []MachinePort{
+					{Port: 9090},
+				},
+				Checks: []MachineCheck{
+					{Type: "tcp", Interval: "15s", Timeout: "2s"},
+				},
+			},
+		},
+		Checks: map[string]MachineCheck{
+			"status": {Type: "http", Port: 5500, Interval: "10s", Path: "/status"},
+		},
+		Metadata: map[string]string{
+			"fly_process_group": "app",
+		},
+		StopConfig: &MachineStopConfig{Signal: "SIGTERM", Timeout: "10s"},
+	}
+	assert.Equal(t, expected, cfg.Machine)
+}
+
+func TestParseFlyConfigDefaultVM(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseFlyConfig([]byte("app = \"my-app\"\n[build]\nimage = \"nginx\"\n"))
+	require.NoError(t, err)
+	assert.Equal(t, &MachineGuest{CPUKind: "shared", CPUs: 1, MemoryMB: 256}, cfg.Machine.Guest)
+}
+
+func TestParseFlyConfigError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "missing app",
+			data:     "[build]\nimage = \"nginx\"\n",
+			expected: "app must be set",
+		},
+		{
+			name:     "missing image",
+			data:     "app = \"my-app\"\n[build]\ndockerfile = \"Dockerfile\"\n",
+			expected: "build.image must be set since piped deploys the pre-built image",
+		},
+		{
+			name:     "unsupported section",
+			data:     "app = \"my-app\"\n[mounts]\nsource = \"data\"\n",
+			expected: "mounts is not supported",
+		},
+		{
+			name:     "release command",
+			data:     "app = \"my-app\"\n[build]\nimage = \"nginx\"\n[deploy]\nrelease_command = \"migrate\"\n",
+			expected: "deploy.release_command is not supported",
+		},
+		{
+			name:     "other process group",
+			data:     "app = \"my-app\"\n[build]\nimage = \"nginx\"\n[http_service]\ninternal_port = 80\nprocesses = [\"web\"]\n",
+			expected: "http_service.processes must contain only \"app\" since process groups are not supported",
+		},
+		{
+			name:     "invalid duration",
+			data:     "app = \"my-app\"\n[build]\nimage = \"nginx\"\n[[services]]\ninternal_port = 80\n[[services.tcp_checks]]\ninterval = \"often\"\n",
+			expected: "services[0].tcp_checks[0].interval must be a duration: time: invalid duration \"often\"",
+		},
+		{
+			name:     "unknown vm size",
+			data:     "app = \"my-app\"\n[build]\nimage = \"nginx\"\n[vm]\nsize = \"huge\"\n",
+			expected: "unknown vm size huge",
+		},
+		{
+			name:     "invalid memory",
+			data:     "app = \"my-app\"\n[build]\nimage = \"nginx\"\n[vm]\nmemory = \"1tb\"\n",
+			expected: "vm.memory must be a size such as 512mb or 1gb",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := ParseFlyConfig([]byte(tc.data))
+			require.Error(t, err)
+			assert.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
+
+func TestMachineConfigWithLabels(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := ParseFlyConfig([]byte("app = \"my-app\"\n[build]\nimage = \"nginx\"\n"))
+	require.NoError(t, err)
+
+	mc := cfg.MachineConfigWithLabels(MakeMachineLabels("piped-id", "app-id", "commit-hash"))
+	assert.Equal(t, map[string]string{
+		"fly_process_group":      "app",
+		"pipecd-dev-managed-by":  "piped",
+		"pipecd-dev-piped":       "piped-id",
+		"pipecd-dev-application": "app-id",
+		"pipecd-dev-commit-hash": "commit-hash",
+	}, mc.Metadata)
+	// The original configuration must not be changed.
+	assert.Equal(t, map[string]string{"fly_process_group": "app"}, cfg.Machine.Metadata)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		image    string
+		expected []*model.ArtifactVersion
+	}{
+		{
+			name:  "tag",
+			image: "registry.fly.io/my-app:v1.2.0",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v1.2.0",
+					Name:    "my-app",
+					Url:     "registry.fly.io/my-app:v1.2.0",
+				},
+			},
+		},
+		{
+			name:  "digest",
+			image: "ghcr.io/org/my-app:v1@sha256:abc",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "sha256:abc",
+					Name:    "my-app",
+					Url:     "ghcr.io/org/my-app:v1@sha256:abc",
+				},
+			},
+		},
+		{
+			name:  "no tag",
+			image: "nginx",
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "latest",
+					Name:    "nginx",
+					Url:     "nginx",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			versions, err := FindArtifactVersions(FlyConfig{Machine: MachineConfig{Image: tc.image}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestDiffFlyConfigs(t *testing.T) {
+	t.Parallel()
+
+	old, err := ParseFlyConfig([]byte("app = \"my-app\"\n[build]\nimage = \"nginx:1.24\"\n[env]\nPORT = \"80\"\n"))
+	require.NoError(t, err)
+	new, err := ParseFlyConfig([]byte("app = \"my-app\"\n\n[build]\n  image = \"nginx:1.24\"\n[env]\n  PORT = \"80\"\n"))
+	require.NoError(t, err)
+
+	result, err := DiffFlyConfigs(old, new)
+	require.NoError(t, err)
+	assert.False(t, result.HasDiff())
+
+	new, err = ParseFlyConfig([]byte("app = \"my-app\"\n[build]\nimage = \"nginx:1.25\"\n[env]\nPORT = \"80\"\n"))
+	require.NoError(t, err)
+
+	result, err = DiffFlyConfigs(old, new)
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.NumNodes())
+}
+
+func TestMachineHealth(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		machine Machine
+		healthy bool
+		failed  bool
+	}{
+		{
+			name:    "started without checks",
+			machine: Machine{State: "started"},
+			healthy: true,
+		},
+		{
+			name: "started with passing checks",
+			machine: Machine{State: "started", Checks: []*CheckStatus{
+				{Name: "servicecheck-00-http-8080", Status: "passing"},
+			}},
+			healthy: true,
+		},
+		{
+			name: "started with critical checks",
+			machine: Machine{State: "started", Checks: []*CheckStatus{
+				{Name: "servicecheck-00-http-8080", Status: "passing"},
+				{Name: "status", Status: "critical"},
+			}},
+		},
+		{
+			name:    "starting",
+			machine: Machine{State: "starting"},
+		},
+		{
+			name:    "failed",
+			machine: Machine{State: "failed"},
+			failed:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.healthy, tc.machine.IsHealthy())
+			assert.Equal(t, tc.failed, tc.machine.IsFailed())
+		})
+	}
+}
@@ -0,0 +1,152 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The keys of the metadata added to the machines by piped.
+	LabelManagedBy   = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication = "pipecd-dev-application" // The application this machine belongs to.
+	LabelCommitHash  = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   = "piped"
+
+	// The metadata key used by Fly.io to group the machines of an app by process.
+	// Only the machines of the default process group are managed by piped.
+	processGroupMetadataKey = "fly_process_group"
+	defaultProcessGroup     = "app"
+
+	defaultAPIURL     = "https://api.machines.dev"
+	accessTokenEnvKey = "FLY_API_TOKEN"
+)
+
+// ErrNotFound is returned when the requested Fly.io resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of the Fly.io Machines API.
+type Client interface {
+	// ListApps returns the apps of the organization.
+	ListApps(ctx context.Context) ([]*App, error)
+	// ListMachines returns the machines of the given app.
+	// ErrNotFound is returned when there is no such app.
+	ListMachines(ctx context.Context, app string) ([]*Machine, error)
+	// GetMachine returns the given machine.
+	// ErrNotFound is returned when there is no such machine.
+	GetMachine(ctx context.Context, app, id string) (*Machine, error)
+	// CreateMachine creates a new machine in the given region.
+	// The machine does not receive any traffic until it is uncordoned when skipServiceRegistration is true.
+	CreateMachine(ctx context.Context, app, region string, cfg MachineConfig, skipServiceRegistration bool) (*Machine, error)
+	// UncordonMachine registers the given machine to the services so that it starts receiving traffic.
+	UncordonMachine(ctx context.Context, app, id string) error
+	// DestroyMachine stops and deletes the given machine.
+	// ErrNotFound is returned when there is no such machine.
+	DestroyMachine(ctx context.Context, app, id string) error
+}
+
+// Registry holds a pool of Fly.io client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderFlyIOConfig, logger *zap.Logger) (Client, error)
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderFlyIOConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		token, err := loadAccessToken(cfg.AccessTokenFile)
+		if err != nil {
+			return nil, err
+		}
+		apiURL := cfg.APIURL
+		if apiURL == "" {
+			apiURL = defaultAPIURL
+		}
+		return newClient(apiURL, cfg.Org, token, logger), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of Fly.io clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
+
+// loadAccessToken reads the access token from the given file,
+// or from the FLY_API_TOKEN environment variable when the file was not specified.
+func loadAccessToken(path string) (string, error) {
+	if path == "" {
+		token := os.Getenv(accessTokenEnvKey)
+		if token == "" {
+			return "", fmt.Errorf("either accessTokenFile or the environment variable %s must be set", accessTokenEnvKey)
+		}
+		return token, nil
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read access token file %s: %w", path, err)
+	}
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("access token file %s is empty", path)
+	}
+	return token, nil
+}
+
+// MakeMachineLabels returns the metadata added to the machines created by piped.
+func MakeMachineLabels(pipedID, appID, commitHash string) map[string]string {
+	return map[string]string{
+		LabelManagedBy:   ManagedByPiped,
+		LabelPiped:       pipedID,
+		LabelApplication: appID,
+		LabelCommitHash:  commitHash,
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	machineStateStarted    = "started"
+	machineStateStopped    = "stopped"
+	machineStateSuspended  = "suspended"
+	machineStateFailed     = "failed"
+	machineStateDestroying = "destroying"
+	machineStateDestroyed  = "destroyed"
+
+	checkStatusPassing  = "passing"
+	checkStatusCritical = "critical"
+)
+
+// App represents a Fly.io app.
+type App struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	MachineCount int    `json:"machine_count"`
+}
+
+// Machine represents a Fly.io machine.
+type Machine struct {
+	ID         string         `json:"id"`
+	Name       string         `json:"name"`
+	State      string         `json:"state"`
+	Region     string         `json:"region"`
+	InstanceID string         `json:"instance_id"`
+	PrivateIP  string         `json:"private_ip"`
+	Config     MachineConfig  `json:"config"`
+	Checks     []*CheckStatus `json:"checks,omitempty"`
+	CreatedAt  string         `json:"created_at"`
+	UpdatedAt  string         `json:"updated_at"`
+}
+
+// CheckStatus represents the latest result of a health check of a machine.
+type CheckStatus struct {
+	Name      string `json:"name"`
+	Status    string `json:"status"`
+	Output    string `json:"output"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// MachineConfig represents the configuration of a Fly.io machine.
+type MachineConfig struct {
+	Image      string                  `json:"image"`
+	Env        map[string]string       `json:"env,omitempty"`
+	Guest      *MachineGuest           `json:"guest,omitempty"`
+	Services   []MachineService        `json:"services,omitempty"`
+	Checks     map[string]MachineCheck `json:"checks,omitempty"`
+	Metadata   map[string]string       `json:"metadata,omitempty"`
+	Metrics    *MachineMetrics         `json:"metrics,omitempty"`
+	Statics    []MachineStatic         `json:"statics,omitempty"`
+	StopConfig *MachineStopConfig      `json:"stop_config,omitempty"`
+}
+
+type MachineGuest struct {
+	CPUKind  string `json:"cpu_kind"`
+	CPUs     int    `json:"cpus"`
+	MemoryMB int    `json:"memory_mb"`
+}
+
+type MachineService struct {
+	Protocol     string `json:"protocol"`
+	InternalPort int    `json:"internal_port"`
+	// Either a boolean or one of "off", "stop" and "suspend".
+	Autostop           interface{}         `json:"autostop,omitempty"`
+	Autostart          *bool               `json:"autostart,omitempty"`
+	MinMachinesRunning *int                `json:"min_machines_running,omitempty"`
+	Ports              []MachinePort       `json:"ports,omitempty"`
+	Concurrency        *MachineConcurrency `json:"concurrency,omitempty"`
+	Checks             []MachineCheck      `json:"checks,omitempty"`
+}
+
+type MachinePort struct {
+	Port       int      `json:"port"`
+	Handlers   []string `json:"handlers,omitempty"`
+	ForceHTTPS bool     `json:"force_https,omitempty"`
+}
+
+type MachineConcurrency struct {
+	Type      string `json:"type,omitempty"`
+	HardLimit int    `json:"hard_limit,omitempty"`
+	SoftLimit int    `json:"soft_limit,omitempty"`
+}
+
+type MachineCheck struct {
+	Type          string          `json:"type,omitempty"`
+	Port          int             `json:"port,omitempty"`
+	Interval      string          `json:"interval,omitempty"`
+	Timeout       string          `json:"timeout,omitempty"`
+	GracePeriod   string          `json:"grace_period,omitempty"`
+	Method        string          `json:"method,omitempty"`
+	Path          string          `json:"path,omitempty"`
+	Protocol      string          `json:"protocol,omitempty"`
+	TLSSkipVerify bool            `json:"tls_skip_verify,omitempty"`
+	Headers       []MachineHeader `json:"headers,omitempty"`
+}
+
+type MachineHeader struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+type MachineMetrics struct {
+	Port int    `json:"port"`
+	Path string `json:"path"`
+}
+
+type MachineStatic struct {
+	GuestPath string `json:"guest_path"`
+	URLPrefix string `json:"url_prefix"`
+}
+
+type MachineStopConfig struct {
+	Signal  string `json:"signal,omitempty"`
+	Timeout string `json:"timeout,omitempty"`
+}
+
+// IsAppMachine reports whether the machine belongs to the default process group of the app.
+// The other machines such as the ones created by "fly machine run" are not touched by piped.
+func (m *Machine) IsAppMachine() bool {
+	return m.Config.Metadata[processGroupMetadataKey] == defaultProcessGroup
+}
+
+// IsHealthy reports whether the machine has started and all of its health checks are passing.
+func (m *Machine) IsHealthy() bool {
+	if m.State != machineStateStarted {
+		return false
+	}
+	for _, c := range m.Checks {
+		if c.Status != checkStatusPassing {
+			return false
+		}
+	}
+	return true
+}
+
+// IsFailed reports whether the machine will never become healthy.
+func (m *Machine) IsFailed() bool {
+	switch m.State {
+	case machineStateFailed, machineStateDestroying, machineStateDestroyed:
+		return true
+	}
+	return false
+}
+
+// StatusDescription returns a human readable description of the state and the health checks of the machine.
+func (m *Machine) StatusDescription() string {
+	desc := fmt.Sprintf("Machine in region %s is %s", m.Region, m.State)
+	if len(m.Checks) == 0 {
+		return desc
+	}
+	checks := make([]string, 0, len(m.Checks))
+	for _, c := range m.Checks {
+		checks = append(checks, fmt.Sprintf("%s is %s", c.Name, c.Status))
+	}
+	sort.Strings(checks)
+	return fmt.Sprintf("%s (checks: %s)", desc, strings.Join(checks, ", "))
+}
+
+// CountMachinesByRegion returns the number of the given machines in each region.
+func CountMachinesByRegion(machines []*Machine) map[string]int {
+	counts := make(map[string]int)
+	for _, m := range machines {
+		counts[m.Region]++
+	}
+	return counts
+}
@@ -0,0 +1,125 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	appKind     = "App"
+	machineKind = "Machine"
+)
+
+// MakeResourceStates returns the states of the given app and its machines.
+// Only the machines of the default process group are included.
+func MakeResourceStates(app *App, machines []*Machine, updatedAt time.Time) []*model.FlyIOResourceState {
+	states := make([]*model.FlyIOResourceState, 0, len(machines)+1)
+
+	appID := app.ID
+	if appID == "" {
+		appID = app.Name
+	}
+
+	appMachines := make([]*Machine, 0, len(machines))
+	for _, m := range machines {
+		if m.IsAppMachine() {
+			appMachines = append(appMachines, m)
+		}
+	}
+	sort.Slice(appMachines, func(i, j int) bool {
+		return appMachines[i].ID < appMachines[j].ID
+	})
+
+	// Set app state.
+	states = append(states, makeResourceState(
+		appID,
+		nil,
+		app.Name,
+		appKind,
+		model.FlyIOResourceState_HEALTHY,
+		fmt.Sprintf("App has %d machines", len(appMachines)),
+		time.Time{},
+		updatedAt,
+	))
+
+	// Set machine states.
+	for _, m := range appMachines {
+		status, desc := machineHealthStatus(m)
+		name := m.Name
+		if name == "" {
+			name = m.ID
+		}
+		createdAt, _ := time.Parse(time.RFC3339, m.CreatedAt)
+		states = append(states, makeResourceState(
+			m.ID,
+			[]string{appID},
+			name,
+			machineKind,
+			status,
+			desc,
+			createdAt,
+			updatedAt,
+		))
+	}
+
+	return states
+}
+
+func makeResourceState(id string, parentIDs []string, name, kind string, status model.FlyIOResourceState_HealthStatus, desc string, createdAt, updatedAt time.Time) *model.FlyIOResourceState {
+	// The creation time is not given for apps.
+	if createdAt.IsZero() {
+		createdAt = updatedAt
+	}
+
+	return &model.FlyIOResourceState{
+		Id:        id,
+		OwnerIds:  parentIDs,
+		ParentIds: parentIDs,
+		Name:      name,
+		Kind:      kind,
+
+		HealthStatus:      status,
+		HealthDescription: desc,
+
+		CreatedAt: createdAt.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}
+}
+
+// machineHealthStatus returns the health status of the given machine.
+// The machines stopped or suspended by the autostop feature are healthy
+// since they are started again when a request comes.
+func machineHealthStatus(m *Machine) (model.FlyIOResourceState_HealthStatus, string) {
+	desc := m.StatusDescription()
+	switch {
+	case m.IsFailed():
+		return model.FlyIOResourceState_OTHER, desc
+	case m.IsHealthy():
+		return model.FlyIOResourceState_HEALTHY, desc
+	case m.State == machineStateStopped, m.State == machineStateSuspended:
+		return model.FlyIOResourceState_HEALTHY, desc
+	}
+	for _, c := range m.Checks {
+		if c.Status == checkStatusCritical {
+			return model.FlyIOResourceState_OTHER, desc
+		}
+	}
+	return model.FlyIOResourceState_UNKNOWN, desc
+}
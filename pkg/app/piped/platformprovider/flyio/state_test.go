@@ -0,0 +1,114 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestMakeResourceStates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now      = time.Unix(1700000000, 0)
+		created  = time.Unix(1690000000, 0).UTC()
+		app      = &App{ID: "app-id", Name: "my-app", MachineCount: 4}
+		appGroup = map[string]string{"fly_process_group": "app"}
+		machines = []*Machine{
+			{
+				ID:        "m2",
+				Name:      "falling-leaf-2",
+				State:     "started",
+				Region:    "nrt",
+				Config:    MachineConfig{Metadata: appGroup},
+				Checks:    []*CheckStatus{{Name: "status", Status: "critical"}},
+				CreatedAt: created.Format(time.RFC3339),
+			},
+			{
+				ID:        "m1",
+				Name:      "falling-leaf-1",
+				State:     "started",
+				Region:    "nrt",
+				Config:    MachineConfig{Metadata: appGroup},
+				Checks:    []*CheckStatus{{Name: "status", Status: "passing"}},
+				CreatedAt: created.Format(time.RFC3339),
+			},
+			{
+				ID:        "m3",
+				State:     "suspended",
+				Region:    "sin",
+				Config:    MachineConfig{Metadata: appGroup},
+				CreatedAt: created.Format(time.RFC3339),
+			},
+			{
+				ID:        "console",
+				State:     "started",
+				Region:    "nrt",
+				CreatedAt: created.Format(time.RFC3339),
+			},
+		}
+	)
+
+	states := MakeResourceStates(app, machines, now)
+	assert.Equal(t, []*model.FlyIOResourceState{
+		{
+			Id:                "app-id",
+			Name:              "my-app",
+			Kind:              "App",
+			HealthStatus:      model.FlyIOResourceState_HEALTHY,
+			HealthDescription: "App has 3 machines",
+			CreatedAt:         now.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                "m1",
+			OwnerIds:          []string{"app-id"},
+			ParentIds:         []string{"app-id"},
+			Name:              "falling-leaf-1",
+			Kind:              "Machine",
+			HealthStatus:      model.FlyIOResourceState_HEALTHY,
+			HealthDescription: "Machine in region nrt is started (checks: status is passing)",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                "m2",
+			OwnerIds:          []string{"app-id"},
+			ParentIds:         []string{"app-id"},
+			Name:              "falling-leaf-2",
+			Kind:              "Machine",
+			HealthStatus:      model.FlyIOResourceState_OTHER,
+			HealthDescription: "Machine in region nrt is started (checks: status is critical)",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+		{
+			Id:                "m3",
+			OwnerIds:          []string{"app-id"},
+			ParentIds:         []string{"app-id"},
+			Name:              "m3",
+			Kind:              "Machine",
+			HealthStatus:      model.FlyIOResourceState_HEALTHY,
+			HealthDescription: "Machine in region sin is suspended",
+			CreatedAt:         created.Unix(),
+			UpdatedAt:         now.Unix(),
+		},
+	}, states)
+}
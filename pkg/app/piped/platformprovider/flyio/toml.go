@@ -0,0 +1,582 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// decodeTOML decodes the given TOML document into a map.
+// Tables are decoded as map[string]interface{}, arrays as []interface{},
+// integers as int64, floats as float64, booleans as bool and strings as string.
+// Date and time values are not supported since fly.toml does not use them.
+func decodeTOML(data string) (map[string]interface{}, error) {
+	p := &tomlParser{
+		src:  data,
+		root: make(map[string]interface{}),
+	}
+	if err := p.parse(); err != nil {
+		return nil, fmt.Errorf("line %d: %w", p.line(), err)
+	}
+	return p.root, nil
+}
+
+type tomlParser struct {
+	src  string
+	pos  int
+	root map[string]interface{}
+}
+
+func (p *tomlParser) line() int {
+	return strings.Count(p.src[:p.pos], "\n") + 1
+}
+
+func (p *tomlParser) eof() bool {
+	return p.pos >= len(p.src)
+}
+
+func (p *tomlParser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *tomlParser) hasPrefix(s string) bool {
+	return strings.HasPrefix(p.src[p.pos:], s)
+}
+
+// skipSpaces skips the spaces and the tabs.
+func (p *tomlParser) skipSpaces() {
+	for !p.eof() && (p.peek() == ' ' || p.peek() == '\t') {
+		p.pos++
+	}
+}
+
+// skipComment skips the comment until the end of the line.
+func (p *tomlParser) skipComment() {
+	if p.peek() != '#' {
+		return
+	}
+	for !p.eof() && p.peek() != '\n' {
+		p.pos++
+	}
+}
+
+// skipBlank skips the spaces, the comments and the newlines.
+func (p *tomlParser) skipBlank() {
+	for {
+		p.skipSpaces()
+		p.skipComment()
+		switch {
+		case p.hasPrefix("\n"):
+			p.pos++
+		case p.hasPrefix("\r\n"):
+			p.pos += 2
+		default:
+			return
+		}
+	}
+}
+
+// expectLineEnd consumes the rest of the line which must contain only spaces and a comment.
+func (p *tomlParser) expectLineEnd() error {
+	p.skipSpaces()
+	p.skipComment()
+	switch {
+	case p.eof():
+		return nil
+	case p.hasPrefix("\n"):
+		p.pos++
+		return nil
+	case p.hasPrefix("\r\n"):
+		p.pos += 2
+		return nil
+	}
+	return fmt.Errorf("unexpected character %q at the end of line", p.peek())
+}
+
+func (p *tomlParser) parse() error {
+	current := p.root
+	for {
+		p.skipBlank()
+		if p.eof() {
+			return nil
+		}
+
+		if p.peek() == '[' {
+			table, err := p.parseTableHeader()
+			if err != nil {
+				return err
+			}
+			current = table
+		} else {
+			key, err := p.parseKey()
+			if err != nil {
+				return err
+			}
+			p.skipSpaces()
+			if p.peek() != '=' {
+				return fmt.Errorf("expected = after key %s", strings.Join(key, "."))
+			}
+			p.pos++
+			p.skipSpaces()
+			value, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			if err := setTOMLValue(current, key, value); err != nil {
+				return err
+			}
+		}
+
+		if err := p.expectLineEnd(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseTableHeader parses the header of a table or an array of tables
+// and returns the table into which the following key/value pairs are put.
+func (p *tomlParser) parseTableHeader() (map[string]interface{}, error) {
+	isArray := p.hasPrefix("[[")
+	if isArray {
+		p.pos += 2
+	} else {
+		p.pos++
+	}
+	p.skipSpaces()
+	key, err := p.parseKey()
+	if err != nil {
+		return nil, err
+	}
+	p.skipSpaces()
+	closing := "]"
+	if isArray {
+		closing = "]]"
+	}
+	if !p.hasPrefix(closing) {
+		return nil, fmt.Errorf("expected %s after table name %s", closing, strings.Join(key, "."))
+	}
+	p.pos += len(closing)
+
+	parent, err := descendTOMLTables(p.root, key[:len(key)-1])
+	if err != nil {
+		return nil, err
+	}
+	last := key[len(key)-1]
+
+	if isArray {
+		table := make(map[string]interface{})
+		switch v := parent[last].(type) {
+		case nil:
+			parent[last] = []interface{}{table}
+		case []interface{}:
+			parent[last] = append(v, table)
+		default:
+			return nil, fmt.Errorf("key %s is already defined as a non array", strings.Join(key, "."))
+		}
+		return table, nil
+	}
+
+	switch v := parent[last].(type) {
+	case nil:
+		table := make(map[string]interface{})
+		parent[last] = table
+		return table, nil
+	case map[string]interface{}:
+		return v, nil
+	default:
+		return nil, fmt.Errorf("key %s is already defined as a non table", strings.Join(key, "."))
+	}
+}
+
+// descendTOMLTables returns the table at the given path, creating the missing ones.
+// The last table is used in case of an array of tables.
+func descendTOMLTables(table map[string]interface{}, path []string) (map[string]interface{}, error) {
+	for i, k := range path {
+		switch v := table[k].(type) {
+		case nil:
+			next := make(map[string]interface{})
+			table[k] = next
+			table = next
+		case map[string]interface{}:
+			table = v
+		case []interface{}:
+			if len(v) == 0 {
+				return nil, fmt.Errorf("key %s is already defined as an empty array", strings.Join(path[:i+1], "."))
+			}
+			last, ok := v[len(v)-1].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("key %s is already defined as a non table", strings.Join(path[:i+1], "."))
+			}
+			table = last
+		default:
+			return nil, fmt.Errorf("key %s is already defined as a non table", strings.Join(path[:i+1], "."))
+		}
+	}
+	return table, nil
+}
+
+func setTOMLValue(table map[string]interface{}, key []string, value interface{}) error {
+	parent, err := descendTOMLTables(table, key[:len(key)-1])
+	if err != nil {
+		return err
+	}
+	last := key[len(key)-1]
+	if _, ok := parent[last]; ok {
+		return fmt.Errorf("key %s is defined more than once", strings.Join(key, "."))
+	}
+	parent[last] = value
+	return nil
+}
+
+// parseKey parses a bare, quoted or dotted key.
+func (p *tomlParser) parseKey() ([]string, error) {
+	var key []string
+	for {
+		p.skipSpaces()
+		var (
+			part string
+			err  error
+		)
+		switch p.peek() {
+		case '"':
+			part, err = p.parseBasicString()
+		case '\'':
+			part, err = p.parseLiteralString()
+		default:
+			start := p.pos
+			for !p.eof() && isBareKeyChar(p.peek()) {
+				p.pos++
+			}
+			if start == p.pos {
+				return nil, fmt.Errorf("invalid key")
+			}
+			part = p.src[start:p.pos]
+		}
+		if err != nil {
+			return nil, err
+		}
+		key = append(key, part)
+
+		p.skipSpaces()
+		if p.peek() != '.' {
+			return key, nil
+		}
+		p.pos++
+	}
+}
+
+func isBareKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '-'
+}
+
+func (p *tomlParser) parseValue() (interface{}, error) {
+	switch {
+	case p.eof():
+		return nil, fmt.Errorf("missing value")
+	case p.hasPrefix(`"""`):
+		return p.parseMultilineBasicString()
+	case p.hasPrefix(`'''`):
+		return p.parseMultilineLiteralString()
+	case p.peek() == '"':
+		return p.parseBasicString()
+	case p.peek() == '\'':
+		return p.parseLiteralString()
+	case p.peek() == '[':
+		return p.parseArray()
+	case p.peek() == '{':
+		return p.parseInlineTable()
+	}
+
+	start := p.pos
+	for !p.eof() && !strings.ContainsRune(" \t\r\n,]}#", rune(p.peek())) {
+		p.pos++
+	}
+	return parseTOMLScalar(p.src[start:p.pos])
+}
+
+func (p *tomlParser) parseArray() ([]interface{}, error) {
+	p.pos++ // [
+	values := make([]interface{}, 0)
+	for {
+		p.skipBlank()
+		if p.peek() == ']' {
+			p.pos++
+			return values, nil
+		}
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+
+		p.skipBlank()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case ']':
+			p.pos++
+			return values, nil
+		default:
+			return nil, fmt.Errorf("expected , or ] in array")
+		}
+	}
+}
+
+func (p *tomlParser) parseInlineTable() (map[string]interface{}, error) {
+	p.pos++ // {
+	table := make(map[string]interface{})
+	p.skipSpaces()
+	if p.peek() == '}' {
+		p.pos++
+		return table, nil
+	}
+	for {
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+		p.skipSpaces()
+		if p.peek() != '=' {
+			return nil, fmt.Errorf("expected = after key %s", strings.Join(key, "."))
+		}
+		p.pos++
+		p.skipSpaces()
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if err := setTOMLValue(table, key, v); err != nil {
+			return nil, err
+		}
+
+		p.skipSpaces()
+		switch p.peek() {
+		case ',':
+			p.pos++
+		case '}':
+			p.pos++
+			return table, nil
+		default:
+			return nil, fmt.Errorf("expected , or } in inline table")
+		}
+	}
+}
+
+func (p *tomlParser) parseBasicString() (string, error) {
+	p.pos++ // "
+	var b strings.Builder
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return "", fmt.Errorf("unterminated string")
+		}
+		c := p.peek()
+		switch c {
+		case '"':
+			p.pos++
+			return b.String(), nil
+		case '\\':
+			if err := p.parseEscape(&b); err != nil {
+				return "", err
+			}
+		default:
+			b.WriteByte(c)
+			p.pos++
+		}
+	}
+}
+
+func (p *tomlParser) parseMultilineBasicString() (string, error) {
+	p.pos += 3 // """
+	p.skipFirstNewline()
+	var b strings.Builder
+	for {
+		if p.eof() {
+			return "", fmt.Errorf("unterminated multi-line string")
+		}
+		if p.hasPrefix(`"""`) {
+			// Up to two quotes are allowed right before the closing delimiter.
+			n := 3
+			for n < 5 && p.pos+n < len(p.src) && p.src[p.pos+n] == '"' {
+				n++
+			}
+			b.WriteString(strings.Repeat(`"`, n-3))
+			p.pos += n
+			return b.String(), nil
+		}
+		c := p.peek()
+		if c != '\\' {
+			b.WriteByte(c)
+			p.pos++
+			continue
+		}
+		// A backslash at the end of a line trims all whitespace up to the next non-whitespace character.
+		rest := strings.TrimLeft(p.src[p.pos+1:], " \t")
+		if strings.HasPrefix(rest, "\n") || strings.HasPrefix(rest, "\r\n") {
+			p.pos = len(p.src) - len(strings.TrimLeft(rest, " \t\r\n"))
+			continue
+		}
+		if err := p.parseEscape(&b); err != nil {
+			return "", err
+		}
+	}
+}
+
+func (p *tomlParser) parseLiteralString() (string, error) {
+	p.pos++ // '
+	start := p.pos
+	for {
+		if p.eof() || p.peek() == '\n' {
+			return "", fmt.Errorf("unterminated string")
+		}
+		if p.peek() == '\'' {
+			s := p.src[start:p.pos]
+			p.pos++
+			return s, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *tomlParser) parseMultilineLiteralString() (string, error) {
+	p.pos += 3 // '''
+	p.skipFirstNewline()
+	end := strings.Index(p.src[p.pos:], `'''`)
+	if end < 0 {
+		return "", fmt.Errorf("unterminated multi-line string")
+	}
+	end += p.pos
+	// Up to two quotes are allowed right before the closing delimiter.
+	for i := 0; i < 2 && end+3 < len(p.src) && p.src[end+3] == '\''; i++ {
+		end++
+	}
+	s := p.src[p.pos:end]
+	p.pos = end + 3
+	return s, nil
+}
+
+// skipFirstNewline skips the newline immediately following the opening delimiter of a multi-line string.
+func (p *tomlParser) skipFirstNewline() {
+	switch {
+	case p.hasPrefix("\n"):
+		p.pos++
+	case p.hasPrefix("\r\n"):
+		p.pos += 2
+	}
+}
+
+func (p *tomlParser) parseEscape(b *strings.Builder) error {
+	p.pos++ // \
+	if p.eof() {
+		return fmt.Errorf("unterminated escape sequence")
+	}
+	c := p.peek()
+	p.pos++
+	switch c {
+	case 'b':
+		b.WriteByte('\b')
+	case 't':
+		b.WriteByte('\t')
+	case 'n':
+		b.WriteByte('\n')
+	case 'f':
+		b.WriteByte('\f')
+	case 'r':
+		b.WriteByte('\r')
+	case 'e':
+		b.WriteByte('\x1b')
+	case '"':
+		b.WriteByte('"')
+	case '\\':
+		b.WriteByte('\\')
+	case 'u', 'U':
+		n := 4
+		if c == 'U' {
+			n = 8
+		}
+		if p.pos+n > len(p.src) {
+			return fmt.Errorf("invalid unicode escape sequence")
+		}
+		code, err := strconv.ParseUint(p.src[p.pos:p.pos+n], 16, 32)
+		if err != nil || !utf8.ValidRune(rune(code)) {
+			return fmt.Errorf("invalid unicode escape sequence \\%c%s", c, p.src[p.pos:p.pos+n])
+		}
+		b.WriteRune(rune(code))
+		p.pos += n
+	default:
+		return fmt.Errorf("invalid escape sequence \\%c", c)
+	}
+	return nil
+}
+
+func parseTOMLScalar(s string) (interface{}, error) {
+	switch s {
+	case "":
+		return nil, fmt.Errorf("missing value")
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "inf", "+inf":
+		return math.Inf(1), nil
+	case "-inf":
+		return math.Inf(-1), nil
+	case "nan", "+nan", "-nan":
+		return math.NaN(), nil
+	}
+
+	if strings.Contains(s, "__") || strings.HasPrefix(s, "_") || strings.HasSuffix(s, "_") {
+		return nil, fmt.Errorf("invalid number %s", s)
+	}
+	n := strings.ReplaceAll(s, "_", "")
+
+	for prefix, base := range map[string]int{"0x": 16, "0o": 8, "0b": 2} {
+		if strings.HasPrefix(n, prefix) {
+			v, err := strconv.ParseInt(n[2:], base, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid integer %s", s)
+			}
+			return v, nil
+		}
+	}
+
+	if strings.ContainsAny(n, ".eE") {
+		v, err := strconv.ParseFloat(n, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %s", s)
+		}
+		return v, nil
+	}
+
+	v, err := strconv.ParseInt(n, 10, 64)
+	if err != nil {
+		if strings.ContainsAny(n, "-:T") && len(n) >= 8 {
+			return nil, fmt.Errorf("date and time values are not supported: %s", s)
+		}
+		return nil, fmt.Errorf("invalid value %s", s)
+	}
+	// Leading zeros are not allowed in decimal integers.
+	digits := strings.TrimLeft(n, "+-")
+	if len(digits) > 1 && digits[0] == '0' {
+		return nil, fmt.Errorf("invalid integer %s", s)
+	}
+	return v, nil
+}
@@ -0,0 +1,202 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flyio
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeTOML(t *testing.T) {
+	t.Parallel()
+
+	data := `# fly.toml app configuration file
+app = "my-app" # the name of the app
+primary_region = 'nrt'
+kill_timeout = 5
+swap_size_mb = 1_024
+ratio = 0.5
+exp = 1e3
+hex = 0xff
+enabled = true
+"quoted.key" = "value"
+site.name = "dotted"
+escaped = "tab\there \"quoted\" é"
+regex = '<\i\c*\s*>'
+multiline = """
+first \
+  second
+third"""
+literal = '''
+raw\n line'''
+list = [
+  "a",
+  "b", # comment
+]
+empty = []
+inline = { port = 80, handlers = ["http"] }
+
+[build]
+  image = "registry.fly.io/my-app:v1"
+
+[env]
+  PORT = "8080"
+
+[http_service.concurrency]
+  type = "requests"
+
+[[services]]
+  internal_port = 8080
+
+  [[services.ports]]
+    port = 80
+
+  [[services.ports]]
+    port = 443
+
+[[services]]
+  internal_port = 9090
+`
+	got, err := decodeTOML(data)
+	require.NoError(t, err)
+
+	expected := map[string]interface{}{
+		"app":            "my-app",
+		"primary_region": "nrt",
+		"kill_timeout":   int64(5),
+		"swap_size_mb":   int64(1024),
+		"ratio":          0.5,
+		"exp":            1000.0,
+		"hex":            int64(255),
+		"enabled":        true,
+		"quoted.key":     "value",
+		"site": map[string]interface{}{
+			"name": "dotted",
+		},
+		"escaped":   "tab\there \"quoted\" é",
+		"regex":     `<\i\c*\s*>`,
+		"multiline": "first second\nthird",
+		"literal":   `raw\n line`,
+		"list":      []interface{}{"a", "b"},
+		"empty":     []interface{}{},
+		"inline": map[string]interface{}{
+			"port":     int64(80),
+			"handlers": []interface{}{"http"},
+		},
+		"build": map[string]interface{}{
+			"image": "registry.fly.io/my-app:v1",
+		},
+		"env": map[string]interface{}{
+			"PORT": "8080",
+		},
+		"http_service": map[string]interface{}{
+			"concurrency": map[string]interface{}{
+				"type": "requests",
+			},
+		},
+		"services": []interface{}{
+			map[string]interface{}{
+				"internal_port": int64(8080),
+				"ports": []interface{}{
+					map[string]interface{}{"port": int64(80)},
+					map[string]interface{}{"port": int64(443)},
+				},
+			},
+			map[string]interface{}{
+				"internal_port": int64(9090),
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+}
+
+func TestDecodeTOMLSpecialFloats(t *testing.T) {
+	t.Parallel()
+
+	got, err := decodeTOML("a = inf\nb = -inf\nc = nan\n")
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(got["a"].(float64), 1))
+	assert.True(t, math.IsInf(got["b"].(float64), -1))
+	assert.True(t, math.IsNaN(got["c"].(float64)))
+}
+
+func TestDecodeTOMLError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		data     string
+		expected string
+	}{
+		{
+			name:     "duplicated key",
+			data:     "app = \"a\"\napp = \"b\"\n",
+			expected: "line 2: key app is defined more than once",
+		},
+		{
+			name:     "missing equal",
+			data:     "app \"a\"\n",
+			expected: "line 1: expected = after key app",
+		},
+		{
+			name:     "unterminated string",
+			data:     "\napp = \"a\n",
+			expected: "line 2: unterminated string",
+		},
+		{
+			name:     "trailing characters",
+			data:     "app = \"a\" \"b\"\n",
+			expected: "line 1: unexpected character '\"' at the end of line",
+		},
+		{
+			name:     "table redefined as array",
+			data:     "[build]\n[[build]]\n",
+			expected: "line 2: key build is already defined as a non array",
+		},
+		{
+			name:     "value redefined as table",
+			data:     "app = \"a\"\n[app]\n",
+			expected: "line 2: key app is already defined as a non table",
+		},
+		{
+			name:     "date time",
+			data:     "released = 1979-05-27T07:32:00Z\n",
+			expected: "line 1: date and time values are not supported: 1979-05-27T07:32:00Z",
+		},
+		{
+			name:     "leading zero",
+			data:     "port = 080\n",
+			expected: "line 1: invalid integer 080",
+		},
+		{
+			name:     "invalid escape",
+			data:     "app = \"a\\qb\"\n",
+			expected: "line 1: invalid escape sequence \\q",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := decodeTOML(tc.data)
+			require.Error(t, err)
+			assert.Equal(t, tc.expected, err.Error())
+		})
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+
+	"go.uber.org/zap"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+)
+
+const operationStatusDone = "DONE"
+
+type client struct {
+	project string
+	service *compute.Service
+	logger  *zap.Logger
+}
+
+func newClient(ctx context.Context, project, credentialsFile string, logger *zap.Logger) (*client, error) {
+	if project == "" {
+		return nil, fmt.Errorf("project is required field")
+	}
+	c := &client{
+		project: project,
+		logger:  logger.Named("gcemig"),
+	}
+
+	var options []option.ClientOption
+	if len(credentialsFile) > 0 {
+		data, err := os.ReadFile(credentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read credentials file (%w)", err)
+		}
+		options = append(options, option.WithCredentialsJSON(data))
+	}
+
+	service, err := compute.NewService(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	c.service = service
+
+	return c, nil
+}
+
+func (c *client) GetInstanceGroupManager(ctx context.Context, loc Location, name string) (*InstanceGroupManager, error) {
+	var (
+		igm *compute.InstanceGroupManager
+		err error
+	)
+	if loc.Zone != "" {
+		igm, err = c.service.InstanceGroupManagers.Get(c.project, loc.Zone, name).Context(ctx).Do()
+	} else {
+		igm, err = c.service.RegionInstanceGroupManagers.Get(c.project, loc.Region, name).Context(ctx).Do()
+	}
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return (*InstanceGroupManager)(igm), nil
+}
+
+func (c *client) CreateInstanceGroupManager(ctx context.Context, loc Location, igm *InstanceGroupManager) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if loc.Zone != "" {
+		op, err = c.service.InstanceGroupManagers.Insert(c.project, loc.Zone, (*compute.InstanceGroupManager)(igm)).Context(ctx).Do()
+	} else {
+		op, err = c.service.RegionInstanceGroupManagers.Insert(c.project, loc.Region, (*compute.InstanceGroupManager)(igm)).Context(ctx).Do()
+	}
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, loc, op)
+}
+
+func (c *client) PatchInstanceGroupManager(ctx context.Context, loc Location, name string, igm *InstanceGroupManager) error {
+	var (
+		op  *compute.Operation
+		err error
+	)
+	if loc.Zone != "" {
+		op, err = c.service.InstanceGroupManagers.Patch(c.project, loc.Zone, name, (*compute.InstanceGroupManager)(igm)).Context(ctx).Do()
+	} else {
+		op, err = c.service.RegionInstanceGroupManagers.Patch(c.project, loc.Region, name, (*compute.InstanceGroupManager)(igm)).Context(ctx).Do()
+	}
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, loc, op)
+}
+
+func (c *client) ListManagedInstances(ctx context.Context, loc Location, name string) ([]*ManagedInstance, error) {
+	var (
+		instances []*ManagedInstance
+		err       error
+	)
+	add := func(items []*compute.ManagedInstance) {
+		for _, i := range items {
+			instances = append(instances, (*ManagedInstance)(i))
+		}
+	}
+	if loc.Zone != "" {
+		err = c.service.InstanceGroupManagers.ListManagedInstances(c.project, loc.Zone, name).Pages(ctx, func(resp *compute.InstanceGroupManagersListManagedInstancesResponse) error {
+			add(resp.ManagedInstances)
+			return nil
+		})
+	} else {
+		err = c.service.RegionInstanceGroupManagers.ListManagedInstances(c.project, loc.Region, name).Pages(ctx, func(resp *compute.RegionInstanceGroupManagersListInstancesResponse) error {
+			add(resp.ManagedInstances)
+			return nil
+		})
+	}
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return instances, nil
+}
+
+func (c *client) GetInstanceTemplate(ctx context.Context, name string) (*InstanceTemplate, error) {
+	t, err := c.service.InstanceTemplates.Get(c.project, name).Context(ctx).Do()
+	if err != nil {
+		return nil, convertError(err)
+	}
+	return (*InstanceTemplate)(t), nil
+}
+
+func (c *client) CreateInstanceTemplate(ctx context.Context, t *InstanceTemplate) error {
+	op, err := c.service.InstanceTemplates.Insert(c.project, (*compute.InstanceTemplate)(t)).Context(ctx).Do()
+	if err != nil {
+		return convertError(err)
+	}
+	return c.waitOperation(ctx, Location{}, op)
+}
+
+// waitOperation waits until the given operation has been done.
+// The global operation is waited when the location is empty.
+func (c *client) waitOperation(ctx context.Context, loc Location, op *compute.Operation) error {
+	var err error
+	for op.Status != operationStatusDone {
+		// Wait returns when the operation has been done or about 2 minutes have passed.
+		switch {
+		case loc.Zone != "":
+			op, err = c.service.ZoneOperations.Wait(c.project, loc.Zone, op.Name).Context(ctx).Do()
+		case loc.Region != "":
+			op, err = c.service.RegionOperations.Wait(c.project, loc.Region, op.Name).Context(ctx).Do()
+		default:
+			op, err = c.service.GlobalOperations.Wait(c.project, op.Name).Context(ctx).Do()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to wait for operation: %w", convertError(err))
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		e := op.Error.Errors[0]
+		return fmt.Errorf("operation %s failed: %s: %s", op.Name, e.Code, e.Message)
+	}
+	return nil
+}
+
+func convertError(err error) error {
+	var e *googleapi.Error
+	if errors.As(err, &e) && e.Code == http.StatusNotFound {
+		return ErrNotFound
+	}
+	return err
+}
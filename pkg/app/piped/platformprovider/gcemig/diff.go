@@ -0,0 +1,48 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// DiffManagedInstanceGroupManifests calculates the diff between the specs of the two given manifests.
+func DiffManagedInstanceGroupManifests(old, new ManagedInstanceGroupManifest) (*diff.Result, error) {
+	o, err := toUnstructured(old.Spec)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toUnstructured(new.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(o, n, new.Spec.Name, diff.WithEquateEmpty())
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,221 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The labels added to the instance templates by piped.
+	LabelManagedBy   = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   = "piped"
+
+	// The names of the versions of the group while the canary rollout is in progress.
+	PrimaryVersionName = "primary"
+	CanaryVersionName  = "canary"
+
+	// The maximum length of the name of Compute Engine resources.
+	maxNameLength = 63
+	// The length of the commit hash added to the names of the instance templates.
+	templateSuffixLength = 7
+
+	instanceActionNone = "NONE"
+	healthStateHealthy = "HEALTHY"
+)
+
+// ErrNotFound is returned when the requested resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+type (
+	InstanceGroupManager compute.InstanceGroupManager
+	InstanceTemplate     compute.InstanceTemplate
+	ManagedInstance      compute.ManagedInstance
+)
+
+// Location is either the zone or the region of a managed instance group.
+type Location struct {
+	Zone   string
+	Region string
+}
+
+func (l Location) String() string {
+	if l.Zone != "" {
+		return l.Zone
+	}
+	return l.Region
+}
+
+// Client is wrapper of Compute Engine API.
+type Client interface {
+	// GetInstanceGroupManager returns the managed instance group having the given name.
+	// ErrNotFound is returned when there is no such group.
+	GetInstanceGroupManager(ctx context.Context, loc Location, name string) (*InstanceGroupManager, error)
+	// CreateInstanceGroupManager creates the given managed instance group and waits until the operation has been done.
+	CreateInstanceGroupManager(ctx context.Context, loc Location, igm *InstanceGroupManager) error
+	// PatchInstanceGroupManager updates the fields set in the given group and waits until the operation has been done.
+	// The instances are updated by the group in the background after that.
+	PatchInstanceGroupManager(ctx context.Context, loc Location, name string, igm *InstanceGroupManager) error
+	ListManagedInstances(ctx context.Context, loc Location, name string) ([]*ManagedInstance, error)
+	// GetInstanceTemplate returns the global instance template having the given name.
+	// ErrNotFound is returned when there is no such template.
+	GetInstanceTemplate(ctx context.Context, name string) (*InstanceTemplate, error)
+	// CreateInstanceTemplate creates the given global instance template and waits until the operation has been done.
+	CreateInstanceTemplate(ctx context.Context, t *InstanceTemplate) error
+}
+
+// Registry holds a pool of Compute Engine client wrappers.
+type Registry interface {
+	Client(ctx context.Context, name string, cfg *config.PlatformProviderGCEMIGConfig, logger *zap.Logger) (Client, error)
+}
+
+// TemplateName returns the name of the instance template created for the given commit of the group.
+// Since the instance templates are immutable, the same commit is always deployed with the same template.
+func TemplateName(groupName, commitHash string) string {
+	if len(commitHash) > templateSuffixLength {
+		commitHash = commitHash[:templateSuffixLength]
+	}
+	if max := maxNameLength - templateSuffixLength - 1; len(groupName) > max {
+		groupName = strings.TrimSuffix(groupName[:max], "-")
+	}
+	return groupName + "-" + strings.ToLower(commitHash)
+}
+
+// TemplateNameFromURL returns the name of the instance template from its URL
+// such as https://www.googleapis.com/compute/v1/projects/{PROJECT}/global/instanceTemplates/{NAME}.
+func TemplateNameFromURL(url string) string {
+	return path.Base(url)
+}
+
+// FullRollout returns the versions to launch all instances from the given template.
+func FullRollout(template string) []*compute.InstanceGroupManagerVersion {
+	return []*compute.InstanceGroupManagerVersion{
+		{InstanceTemplate: template},
+	}
+}
+
+// CanaryRollout returns the versions to launch the given percentage of the instances
+// from the canary template and the rest from the primary template.
+func CanaryRollout(primary, canary string, percent int) []*compute.InstanceGroupManagerVersion {
+	if primary == "" || primary == canary || percent >= 100 {
+		return FullRollout(canary)
+	}
+	return []*compute.InstanceGroupManagerVersion{
+		{
+			Name:             PrimaryVersionName,
+			InstanceTemplate: primary,
+		},
+		{
+			Name:             CanaryVersionName,
+			InstanceTemplate: canary,
+			TargetSize: &compute.FixedOrPercent{
+				Percent: int64(percent),
+			},
+		},
+	}
+}
+
+// PrimaryTemplate returns the template launching the instances which are not the target of the canary rollout.
+func (igm *InstanceGroupManager) PrimaryTemplate() string {
+	for _, v := range igm.Versions {
+		if v.TargetSize == nil {
+			return v.InstanceTemplate
+		}
+	}
+	return igm.InstanceTemplate
+}
+
+// RolloutDone reports whether all instances have been launched from the templates of the versions of the group.
+func (igm *InstanceGroupManager) RolloutDone() bool {
+	return igm.Status != nil && igm.Status.IsStable && igm.Status.VersionTarget != nil && igm.Status.VersionTarget.IsReached
+}
+
+// HasHealthCheck reports whether the health of the instances is checked by the autohealing policy of the group.
+func (igm *InstanceGroupManager) HasHealthCheck() bool {
+	for _, p := range igm.AutoHealingPolicies {
+		if p.HealthCheck != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// Healthy reports whether the instance is running without any pending action and has passed all health checks.
+func (i *ManagedInstance) Healthy(requireHealthCheck bool) bool {
+	if i.CurrentAction != instanceActionNone {
+		return false
+	}
+	if requireHealthCheck && len(i.InstanceHealth) == 0 {
+		return false
+	}
+	for _, h := range i.InstanceHealth {
+		if h.DetailedHealthState != healthStateHealthy {
+			return false
+		}
+	}
+	return true
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(ctx context.Context, name string, cfg *config.PlatformProviderGCEMIGConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(ctx, cfg.Project, cfg.CredentialsFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of Compute Engine clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,205 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/compute/v1"
+)
+
+func TestTemplateName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "web-0123abc", TemplateName("web", "0123ABCdef456"))
+	assert.Equal(t, "web-abc", TemplateName("web", "abc"))
+
+	long := "a-very-long-name-of-the-managed-instance-group-exceeding-the-limit"
+	name := TemplateName(long, "0123abcdef")
+	assert.Equal(t, "a-very-long-name-of-the-managed-instance-group-exceedin-0123abc", name)
+	assert.LessOrEqual(t, len(name), maxNameLength)
+}
+
+func TestTemplateNameFromURL(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "web-0123abc", TemplateNameFromURL("https://www.googleapis.com/compute/v1/projects/p/global/instanceTemplates/web-0123abc"))
+	assert.Equal(t, "web-0123abc", TemplateNameFromURL("web-0123abc"))
+}
+
+func TestCanaryRollout(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		primary  string
+		canary   string
+		percent  int
+		expected []*compute.InstanceGroupManagerVersion
+	}{
+		{
+			name:    "partial rollout",
+			primary: "old",
+			canary:  "new",
+			percent: 20,
+			expected: []*compute.InstanceGroupManagerVersion{
+				{Name: PrimaryVersionName, InstanceTemplate: "old"},
+				{Name: CanaryVersionName, InstanceTemplate: "new", TargetSize: &compute.FixedOrPercent{Percent: 20}},
+			},
+		},
+		{
+			name:     "all instances",
+			primary:  "old",
+			canary:   "new",
+			percent:  100,
+			expected: []*compute.InstanceGroupManagerVersion{{InstanceTemplate: "new"}},
+		},
+		{
+			name:     "no primary template",
+			canary:   "new",
+			percent:  20,
+			expected: []*compute.InstanceGroupManagerVersion{{InstanceTemplate: "new"}},
+		},
+		{
+			name:     "same template",
+			primary:  "new",
+			canary:   "new",
+			percent:  20,
+			expected: []*compute.InstanceGroupManagerVersion{{InstanceTemplate: "new"}},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, CanaryRollout(tc.primary, tc.canary, tc.percent))
+		})
+	}
+}
+
+func TestPrimaryTemplate(t *testing.T) {
+	t.Parallel()
+
+	igm := &InstanceGroupManager{
+		InstanceTemplate: "old",
+		Versions: []*compute.InstanceGroupManagerVersion{
+			{Name: CanaryVersionName, InstanceTemplate: "new", TargetSize: &compute.FixedOrPercent{Percent: 20}},
+			{Name: PrimaryVersionName, InstanceTemplate: "old"},
+		},
+	}
+	assert.Equal(t, "old", igm.PrimaryTemplate())
+
+	igm = &InstanceGroupManager{InstanceTemplate: "old"}
+	assert.Equal(t, "old", igm.PrimaryTemplate())
+}
+
+func TestManagedInstanceHealthy(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name               string
+		instance           ManagedInstance
+		requireHealthCheck bool
+		expected           bool
+	}{
+		{
+			name:     "running without health check",
+			instance: ManagedInstance{CurrentAction: "NONE"},
+			expected: true,
+		},
+		{
+			name:     "being created",
+			instance: ManagedInstance{CurrentAction: "CREATING"},
+			expected: false,
+		},
+		{
+			name:               "health not reported yet",
+			instance:           ManagedInstance{CurrentAction: "NONE"},
+			requireHealthCheck: true,
+			expected:           false,
+		},
+		{
+			name: "healthy",
+			instance: ManagedInstance{
+				CurrentAction:  "NONE",
+				InstanceHealth: []*compute.ManagedInstanceInstanceHealth{{DetailedHealthState: "HEALTHY"}},
+			},
+			requireHealthCheck: true,
+			expected:           true,
+		},
+		{
+			name: "unhealthy",
+			instance: ManagedInstance{
+				CurrentAction:  "NONE",
+				InstanceHealth: []*compute.ManagedInstanceInstanceHealth{{DetailedHealthState: "TIMEOUT"}},
+			},
+			requireHealthCheck: true,
+			expected:           false,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.instance.Healthy(tc.requireHealthCheck))
+		})
+	}
+}
+
+type fakeClient struct {
+	Client
+	igms      []*InstanceGroupManager
+	instances [][]*ManagedInstance
+	polls     int
+}
+
+func (c *fakeClient) GetInstanceGroupManager(_ context.Context, _ Location, _ string) (*InstanceGroupManager, error) {
+	c.polls++
+	return c.igms[c.polls-1], nil
+}
+
+func (c *fakeClient) ListManagedInstances(_ context.Context, _ Location, _ string) ([]*ManagedInstance, error) {
+	return c.instances[c.polls-1], nil
+}
+
+func TestWaitRollout(t *testing.T) {
+	t.Parallel()
+
+	stable := &compute.InstanceGroupManagerStatus{
+		IsStable:      true,
+		VersionTarget: &compute.InstanceGroupManagerStatusVersionTarget{IsReached: true},
+	}
+	healthChecked := []*compute.InstanceGroupManagerAutoHealingPolicy{{HealthCheck: "hc"}}
+	c := &fakeClient{
+		igms: []*InstanceGroupManager{
+			{Status: &compute.InstanceGroupManagerStatus{IsStable: false}, AutoHealingPolicies: healthChecked},
+			{Status: stable, AutoHealingPolicies: healthChecked},
+			{Status: stable, AutoHealingPolicies: healthChecked},
+		},
+		instances: [][]*ManagedInstance{
+			nil,
+			{{CurrentAction: "NONE", InstanceHealth: []*compute.ManagedInstanceInstanceHealth{{DetailedHealthState: "UNKNOWN"}}}},
+			{{CurrentAction: "NONE", InstanceHealth: []*compute.ManagedInstanceInstanceHealth{{DetailedHealthState: "HEALTHY"}}}},
+		},
+	}
+
+	err := WaitRollout(context.Background(), c, Location{Zone: "asia-northeast1-a"}, "web", time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, 3, c.polls)
+}
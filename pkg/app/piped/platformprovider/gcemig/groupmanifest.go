@@ -0,0 +1,254 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/api/compute/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	versionV1Beta1    = "pipecd.dev/v1beta1"
+	groupManifestKind = "GCEManagedInstanceGroup"
+
+	updatePolicyTypeProactive = "PROACTIVE"
+	minimalActionReplace      = "REPLACE"
+)
+
+type ManagedInstanceGroupManifest struct {
+	Kind       string                           `json:"kind"`
+	APIVersion string                           `json:"apiVersion,omitempty"`
+	Spec       ManagedInstanceGroupManifestSpec `json:"spec"`
+}
+
+func (m *ManagedInstanceGroupManifest) validate() error {
+	if m.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", m.APIVersion)
+	}
+	if m.Kind != groupManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", m.Kind)
+	}
+	return m.Spec.validate()
+}
+
+// ManagedInstanceGroupManifestSpec contains configuration for GCEManagedInstanceGroup.
+type ManagedInstanceGroupManifestSpec struct {
+	// The name of the managed instance group.
+	Name string `json:"name"`
+	// The zone of the zonal group. Either zone or region must be specified.
+	Zone string `json:"zone,omitempty"`
+	// The region of the regional group. Either zone or region must be specified.
+	Region string `json:"region,omitempty"`
+	// The prefix of the names of the instances. Default is the name of the group.
+	// It is used only when the group is created.
+	BaseInstanceName string `json:"baseInstanceName,omitempty"`
+	// The number of instances the group has when it is created.
+	// The size of the existing group is not changed so that the autoscaler keeps working.
+	// Default is 1.
+	TargetSize int64 `json:"targetSize,omitempty"`
+	// The instance template created for each deployment.
+	InstanceTemplate InstanceTemplateSpec `json:"instanceTemplate"`
+	// The health check used to recreate unhealthy instances and to gate the progress of the rollout.
+	AutoHealing *AutoHealing `json:"autoHealing,omitempty"`
+	// How the instances are replaced by the new ones.
+	UpdatePolicy UpdatePolicy         `json:"updatePolicy,omitempty"`
+	NamedPorts   []*compute.NamedPort `json:"namedPorts,omitempty"`
+}
+
+// InstanceTemplateSpec represents the instance template launching the instances of the group.
+type InstanceTemplateSpec struct {
+	Description string `json:"description,omitempty"`
+	// The properties of the instances in the same format as the Compute Engine API.
+	// ref: https://cloud.google.com/compute/docs/reference/rest/v1/instanceTemplates#InstanceProperties
+	Properties *compute.InstanceProperties `json:"properties"`
+}
+
+type AutoHealing struct {
+	// The URL of the health check, e.g. projects/{PROJECT}/global/healthChecks/{NAME}.
+	HealthCheck string `json:"healthCheck"`
+	// The seconds to wait for a new instance to start before checking its health.
+	InitialDelaySec int64 `json:"initialDelaySec,omitempty"`
+}
+
+type UpdatePolicy struct {
+	// The maximum number or percentage of instances created above the target size during the rollout.
+	// Default is decided by Compute Engine.
+	MaxSurge *config.Percentage `json:"maxSurge,omitempty"`
+	// The maximum number or percentage of instances unavailable during the rollout.
+	// Default is decided by Compute Engine.
+	MaxUnavailable *config.Percentage `json:"maxUnavailable,omitempty"`
+	// Either REPLACE, RESTART or REFRESH. Default is REPLACE.
+	MinimalAction string `json:"minimalAction,omitempty"`
+	// Either SUBSTITUTE or RECREATE. RECREATE keeps the names of the instances.
+	// Default is SUBSTITUTE.
+	ReplacementMethod string `json:"replacementMethod,omitempty"`
+}
+
+func (s ManagedInstanceGroupManifestSpec) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if (s.Zone == "") == (s.Region == "") {
+		return fmt.Errorf("either zone or region must be specified")
+	}
+	if s.TargetSize < 0 {
+		return fmt.Errorf("targetSize must not be negative")
+	}
+	p := s.InstanceTemplate.Properties
+	if p == nil {
+		return fmt.Errorf("instanceTemplate.properties is missing")
+	}
+	if p.MachineType == "" {
+		return fmt.Errorf("instanceTemplate.properties.machineType is missing")
+	}
+	if len(p.Disks) == 0 {
+		return fmt.Errorf("instanceTemplate.properties.disks must not be empty")
+	}
+	if s.AutoHealing != nil && s.AutoHealing.HealthCheck == "" {
+		return fmt.Errorf("autoHealing.healthCheck is missing")
+	}
+	switch s.UpdatePolicy.MinimalAction {
+	case "", "REPLACE", "RESTART", "REFRESH":
+	default:
+		return fmt.Errorf("updatePolicy.minimalAction must be one of REPLACE, RESTART or REFRESH")
+	}
+	switch s.UpdatePolicy.ReplacementMethod {
+	case "", "SUBSTITUTE", "RECREATE":
+	default:
+		return fmt.Errorf("updatePolicy.replacementMethod must be either SUBSTITUTE or RECREATE")
+	}
+	return nil
+}
+
+// Location returns the zone or the region of the group.
+func (m ManagedInstanceGroupManifest) Location() Location {
+	return Location{Zone: m.Spec.Zone, Region: m.Spec.Region}
+}
+
+// InstanceTemplate returns the instance template of the given name with the given labels added to its instances.
+func (m ManagedInstanceGroupManifest) InstanceTemplate(name string, labels map[string]string) *InstanceTemplate {
+	props := *m.Spec.InstanceTemplate.Properties
+	props.Labels = make(map[string]string, len(m.Spec.InstanceTemplate.Properties.Labels)+len(labels))
+	for k, v := range m.Spec.InstanceTemplate.Properties.Labels {
+		props.Labels[k] = v
+	}
+	for k, v := range labels {
+		props.Labels[k] = v
+	}
+	return &InstanceTemplate{
+		Name:        name,
+		Description: m.Spec.InstanceTemplate.Description,
+		Properties:  &props,
+	}
+}
+
+// InstanceGroupManager returns the group to be created with all instances launched from the given template.
+func (m ManagedInstanceGroupManifest) InstanceGroupManager(template string) *InstanceGroupManager {
+	igm := m.RolloutPatch(FullRollout(template))
+	igm.Name = m.Spec.Name
+	igm.BaseInstanceName = m.Spec.BaseInstanceName
+	if igm.BaseInstanceName == "" {
+		igm.BaseInstanceName = m.Spec.Name
+	}
+	igm.TargetSize = m.Spec.TargetSize
+	if igm.TargetSize == 0 {
+		igm.TargetSize = 1
+	}
+	igm.NamedPorts = m.Spec.NamedPorts
+	return igm
+}
+
+// RolloutPatch returns the patch to roll out the given versions to the group proactively by following the update policy.
+func (m ManagedInstanceGroupManifest) RolloutPatch(versions []*compute.InstanceGroupManagerVersion) *InstanceGroupManager {
+	p := m.Spec.UpdatePolicy
+	policy := &compute.InstanceGroupManagerUpdatePolicy{
+		Type:              updatePolicyTypeProactive,
+		MinimalAction:     p.MinimalAction,
+		ReplacementMethod: p.ReplacementMethod,
+		MaxSurge:          fixedOrPercent(p.MaxSurge),
+		MaxUnavailable:    fixedOrPercent(p.MaxUnavailable),
+	}
+	if policy.MinimalAction == "" {
+		policy.MinimalAction = minimalActionReplace
+	}
+
+	igm := &InstanceGroupManager{
+		Versions:     versions,
+		UpdatePolicy: policy,
+	}
+	if h := m.Spec.AutoHealing; h != nil {
+		igm.AutoHealingPolicies = []*compute.InstanceGroupManagerAutoHealingPolicy{
+			{
+				HealthCheck:     h.HealthCheck,
+				InitialDelaySec: h.InitialDelaySec,
+			},
+		}
+	}
+	return igm
+}
+
+func fixedOrPercent(p *config.Percentage) *compute.FixedOrPercent {
+	if p == nil {
+		return nil
+	}
+	if p.HasSuffix {
+		return &compute.FixedOrPercent{Percent: int64(p.Number)}
+	}
+	// Zero is omitted from the request unless it is forced to be sent.
+	return &compute.FixedOrPercent{Fixed: int64(p.Number), ForceSendFields: []string{"Fixed"}}
+}
+
+// LoadManagedInstanceGroupManifest returns ManagedInstanceGroupManifest object from a given manifest file.
+func LoadManagedInstanceGroupManifest(appDir, manifestFilename string) (ManagedInstanceGroupManifest, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, manifestFilename))
+	if err != nil {
+		return ManagedInstanceGroupManifest{}, err
+	}
+	return parseManagedInstanceGroupManifest(data)
+}
+
+func parseManagedInstanceGroupManifest(data []byte) (ManagedInstanceGroupManifest, error) {
+	var m ManagedInstanceGroupManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return ManagedInstanceGroupManifest{}, err
+	}
+	if err := m.validate(); err != nil {
+		return ManagedInstanceGroupManifest{}, err
+	}
+	return m, nil
+}
+
+// FindArtifactVersions returns the images of the boot disks of the instances.
+func FindArtifactVersions(m ManagedInstanceGroupManifest) []*model.ArtifactVersion {
+	versions := make([]*model.ArtifactVersion, 0, 1)
+	for _, d := range m.Spec.InstanceTemplate.Properties.Disks {
+		if d.InitializeParams == nil || d.InitializeParams.SourceImage == "" {
+			continue
+		}
+		versions = append(versions, &model.ArtifactVersion{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: d.InitializeParams.SourceImage,
+			Name:    d.DeviceName,
+		})
+	}
+	return versions
+}
@@ -0,0 +1,218 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/compute/v1"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const testManifest = `
+apiVersion: pipecd.dev/v1beta1
+kind: GCEManagedInstanceGroup
+spec:
+  name: web
+  zone: asia-northeast1-a
+  targetSize: 3
+  instanceTemplate:
+    properties:
+      machineType: e2-small
+      disks:
+        - boot: true
+          deviceName: boot
+          initializeParams:
+            sourceImage: projects/my-project/global/images/web-v2
+      networkInterfaces:
+        - network: global/networks/default
+      labels:
+        team: web
+  autoHealing:
+    healthCheck: projects/my-project/global/healthChecks/web
+    initialDelaySec: 120
+  updatePolicy:
+    maxSurge: 20%
+    maxUnavailable: 0
+  namedPorts:
+    - name: http
+      port: 8080
+`
+
+func TestParseManagedInstanceGroupManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		data        string
+		expectedErr bool
+	}{
+		{
+			name: "valid manifest",
+			data: testManifest,
+		},
+		{
+			name: "invalid kind",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: ManagedInstanceGroup
+spec:
+  name: web
+`,
+			expectedErr: true,
+		},
+		{
+			name: "both zone and region",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: GCEManagedInstanceGroup
+spec:
+  name: web
+  zone: asia-northeast1-a
+  region: asia-northeast1
+  instanceTemplate:
+    properties:
+      machineType: e2-small
+      disks:
+        - boot: true
+`,
+			expectedErr: true,
+		},
+		{
+			name: "missing disks",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: GCEManagedInstanceGroup
+spec:
+  name: web
+  region: asia-northeast1
+  instanceTemplate:
+    properties:
+      machineType: e2-small
+`,
+			expectedErr: true,
+		},
+		{
+			name: "invalid minimal action",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: GCEManagedInstanceGroup
+spec:
+  name: web
+  region: asia-northeast1
+  instanceTemplate:
+    properties:
+      machineType: e2-small
+      disks:
+        - boot: true
+  updatePolicy:
+    minimalAction: NONE
+`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := parseManagedInstanceGroupManifest([]byte(tc.data))
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestManagedInstanceGroupManifest(t *testing.T) {
+	t.Parallel()
+
+	m, err := parseManagedInstanceGroupManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	assert.Equal(t, Location{Zone: "asia-northeast1-a"}, m.Location())
+	assert.Equal(t, &config.Percentage{Number: 20, HasSuffix: true}, m.Spec.UpdatePolicy.MaxSurge)
+	assert.Equal(t, &config.Percentage{Number: 0}, m.Spec.UpdatePolicy.MaxUnavailable)
+
+	template := m.InstanceTemplate("web-0123abc", map[string]string{LabelCommitHash: "0123abc"})
+	assert.Equal(t, "web-0123abc", template.Name)
+	assert.Equal(t, "e2-small", template.Properties.MachineType)
+	assert.Equal(t, map[string]string{"team": "web", LabelCommitHash: "0123abc"}, template.Properties.Labels)
+	// The labels of the manifest are not modified.
+	assert.Equal(t, map[string]string{"team": "web"}, m.Spec.InstanceTemplate.Properties.Labels)
+
+	expectedPolicy := &compute.InstanceGroupManagerUpdatePolicy{
+		Type:           "PROACTIVE",
+		MinimalAction:  "REPLACE",
+		MaxSurge:       &compute.FixedOrPercent{Percent: 20},
+		MaxUnavailable: &compute.FixedOrPercent{Fixed: 0, ForceSendFields: []string{"Fixed"}},
+	}
+	expectedAutoHealing := []*compute.InstanceGroupManagerAutoHealingPolicy{
+		{HealthCheck: "projects/my-project/global/healthChecks/web", InitialDelaySec: 120},
+	}
+
+	patch := m.RolloutPatch(FullRollout("web-0123abc"))
+	assert.Equal(t, &InstanceGroupManager{
+		Versions:            []*compute.InstanceGroupManagerVersion{{InstanceTemplate: "web-0123abc"}},
+		UpdatePolicy:        expectedPolicy,
+		AutoHealingPolicies: expectedAutoHealing,
+	}, patch)
+
+	igm := m.InstanceGroupManager("web-0123abc")
+	assert.Equal(t, &InstanceGroupManager{
+		Name:                "web",
+		BaseInstanceName:    "web",
+		TargetSize:          3,
+		NamedPorts:          []*compute.NamedPort{{Name: "http", Port: 8080}},
+		Versions:            []*compute.InstanceGroupManagerVersion{{InstanceTemplate: "web-0123abc"}},
+		UpdatePolicy:        expectedPolicy,
+		AutoHealingPolicies: expectedAutoHealing,
+	}, igm)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	m, err := parseManagedInstanceGroupManifest([]byte(testManifest))
+	require.NoError(t, err)
+
+	expected := []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: "projects/my-project/global/images/web-v2",
+			Name:    "boot",
+		},
+	}
+	assert.Equal(t, expected, FindArtifactVersions(m))
+}
+
+func TestDiffManagedInstanceGroupManifests(t *testing.T) {
+	t.Parallel()
+
+	old, err := parseManagedInstanceGroupManifest([]byte(testManifest))
+	require.NoError(t, err)
+	new, err := parseManagedInstanceGroupManifest([]byte(testManifest))
+	require.NoError(t, err)
+	new.Spec.InstanceTemplate.Properties.MachineType = "e2-medium"
+
+	result, err := DiffManagedInstanceGroupManifests(old, new)
+	require.NoError(t, err)
+	paths := make([]string, 0, result.NumNodes())
+	for _, n := range result.Nodes() {
+		paths = append(paths, n.PathString)
+	}
+	assert.ElementsMatch(t, []string{"instanceTemplate.properties.machineType"}, paths)
+}
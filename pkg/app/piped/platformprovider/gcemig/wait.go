@@ -0,0 +1,63 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gcemig
+
+import (
+	"context"
+	"time"
+)
+
+// WaitRollout waits until all instances of the group have been launched from the templates of its versions.
+// When the group has a health check, it also waits until all instances pass it.
+func WaitRollout(ctx context.Context, client Client, loc Location, name string, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		done, err := rolloutDone(ctx, client, loc, name)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func rolloutDone(ctx context.Context, client Client, loc Location, name string) (bool, error) {
+	igm, err := client.GetInstanceGroupManager(ctx, loc, name)
+	if err != nil {
+		return false, err
+	}
+	if !igm.RolloutDone() {
+		return false, nil
+	}
+
+	instances, err := client.ListManagedInstances(ctx, loc, name)
+	if err != nil {
+		return false, err
+	}
+	requireHealthCheck := igm.HasHealthCheck()
+	for _, i := range instances {
+		if !i.Healthy(requireHealthCheck) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,244 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+const (
+	conditionTrue  = "True"
+	conditionFalse = "False"
+
+	// The prefix of the annotations added by Knative such as the creator of the service.
+	servingAnnotationPrefix = "serving.knative.dev/"
+)
+
+var (
+	serviceResource = schema.GroupVersionResource{
+		Group:    "serving.knative.dev",
+		Version:  "v1",
+		Resource: "services",
+	}
+	revisionResource = schema.GroupVersionResource{
+		Group:    "serving.knative.dev",
+		Version:  "v1",
+		Resource: "revisions",
+	}
+)
+
+// Status represents the readiness of a Knative resource reported by its Ready condition.
+type Status struct {
+	// Whether the controller has observed the latest spec of the resource.
+	Observed bool
+	// The status of the Ready condition, one of True, False and Unknown.
+	Ready string
+	// The reason of the status when the resource is not ready.
+	Message string
+}
+
+// IsReady returns true when the latest spec of the resource has become ready.
+func (s Status) IsReady() bool {
+	return s.Observed && s.Ready == conditionTrue
+}
+
+// IsFailed returns true when the latest spec of the resource has failed to become ready.
+func (s Status) IsFailed() bool {
+	return s.Observed && s.Ready == conditionFalse
+}
+
+// ServiceStatus represents the status of a Knative service.
+type ServiceStatus struct {
+	Status
+	// The traffic targets currently served by the service.
+	Traffic []TrafficStatus
+}
+
+// TrafficStatus represents a traffic target served by the service.
+type TrafficStatus struct {
+	RevisionName string
+	Percent      int
+	Tag          string
+	// The dedicated URL of the tag, empty if the target has no tag.
+	URL string
+}
+
+// TagURL returns the URL of the given tag.
+func (s ServiceStatus) TagURL(tag string) (string, bool) {
+	for _, t := range s.Traffic {
+		if t.Tag == tag && t.URL != "" {
+			return t.URL, true
+		}
+	}
+	return "", false
+}
+
+type client struct {
+	client dynamic.Interface
+	logger *zap.Logger
+}
+
+func newClient(masterURL, kubeConfigPath string, logger *zap.Logger) (*client, error) {
+	cfg, err := clientcmd.BuildConfigFromFlags(masterURL, kubeConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build kubernetes config: %w", err)
+	}
+	dc, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes dynamic client: %w", err)
+	}
+	return &client{
+		client: dc,
+		logger: logger.Named("knative"),
+	}, nil
+}
+
+func (c *client) ApplyService(ctx context.Context, sm ServiceManifest) error {
+	obj := sm.u.DeepCopy()
+	obj.SetNamespace(sm.Namespace())
+	services := c.client.Resource(serviceResource).Namespace(obj.GetNamespace())
+
+	live, err := services.Get(ctx, obj.GetName(), metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if _, err := services.Create(ctx, obj, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create service %s: %w", obj.GetName(), err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get service %s: %w", obj.GetName(), err)
+	}
+
+	// Keep the annotations added by Knative since some of them can't be changed once set.
+	annotations := obj.GetAnnotations()
+	for k, v := range live.GetAnnotations() {
+		if !strings.HasPrefix(k, servingAnnotationPrefix) {
+			continue
+		}
+		if _, ok := annotations[k]; ok {
+			continue
+		}
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[k] = v
+	}
+	obj.SetAnnotations(annotations)
+	obj.SetResourceVersion(live.GetResourceVersion())
+
+	if _, err := services.Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update service %s: %w", obj.GetName(), err)
+	}
+	return nil
+}
+
+func (c *client) GetServiceStatus(ctx context.Context, namespace, name string) (*ServiceStatus, error) {
+	obj, err := c.get(ctx, serviceResource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := readStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	traffic, _, err := unstructured.NestedSlice(obj.Object, "status", "traffic")
+	if err != nil {
+		return nil, err
+	}
+	out := &ServiceStatus{
+		Status:  status,
+		Traffic: make([]TrafficStatus, 0, len(traffic)),
+	}
+	for _, t := range traffic {
+		m, ok := t.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid traffic format of service %s", name)
+		}
+		revision, _, _ := unstructured.NestedString(m, "revisionName")
+		percent, _, _ := unstructured.NestedInt64(m, "percent")
+		tag, _, _ := unstructured.NestedString(m, "tag")
+		url, _, _ := unstructured.NestedString(m, "url")
+		out.Traffic = append(out.Traffic, TrafficStatus{
+			RevisionName: revision,
+			Percent:      int(percent),
+			Tag:          tag,
+			URL:          url,
+		})
+	}
+	return out, nil
+}
+
+func (c *client) GetRevisionStatus(ctx context.Context, namespace, name string) (*Status, error) {
+	obj, err := c.get(ctx, revisionResource, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	status, err := readStatus(obj)
+	if err != nil {
+		return nil, err
+	}
+	return &status, nil
+}
+
+func (c *client) get(ctx context.Context, resource schema.GroupVersionResource, namespace, name string) (*unstructured.Unstructured, error) {
+	obj, err := c.client.Resource(resource).Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s %s: %w", resource.Resource, name, err)
+	}
+	return obj, nil
+}
+
+// readStatus reads the Ready condition of the given Knative resource.
+func readStatus(obj *unstructured.Unstructured) (Status, error) {
+	observed, _, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if err != nil {
+		return Status{}, err
+	}
+	conditions, _, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil {
+		return Status{}, err
+	}
+
+	status := Status{
+		Observed: observed == obj.GetGeneration(),
+		Ready:    "Unknown",
+	}
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(m, "type"); t != "Ready" {
+			continue
+		}
+		status.Ready, _, _ = unstructured.NestedString(m, "status")
+		status.Message, _, _ = unstructured.NestedString(m, "message")
+	}
+	return status, nil
+}
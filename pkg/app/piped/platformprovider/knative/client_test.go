@@ -0,0 +1,130 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+)
+
+func newFakeClient(objects ...runtime.Object) *client {
+	dc := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		serviceResource:  "ServiceList",
+		revisionResource: "RevisionList",
+	}, objects...)
+	return &client{
+		client: dc,
+		logger: zap.NewNop(),
+	}
+}
+
+func TestApplyService(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c := newFakeClient()
+
+	sm, err := ParseServiceManifest([]byte(testServiceManifest))
+	require.NoError(t, err)
+	require.NoError(t, c.ApplyService(ctx, sm))
+
+	services := c.client.Resource(serviceResource).Namespace("default")
+	live, err := services.Get(ctx, "helloworld", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// Simulate the annotations added by Knative.
+	live.SetAnnotations(map[string]string{"serving.knative.dev/creator": "admin"})
+	_, err = services.Update(ctx, live, metav1.UpdateOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, sm.SetRevision("helloworld-0123456"))
+	require.NoError(t, c.ApplyService(ctx, sm))
+
+	live, err = services.Get(ctx, "helloworld", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"serving.knative.dev/creator": "admin"}, live.GetAnnotations())
+	name, _, _ := unstructured.NestedString(live.Object, "spec", "template", "metadata", "name")
+	assert.Equal(t, "helloworld-0123456", name)
+}
+
+func TestGetServiceStatus(t *testing.T) {
+	t.Parallel()
+
+	service := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Service",
+		"metadata": map[string]interface{}{
+			"name":       "helloworld",
+			"namespace":  "default",
+			"generation": int64(2),
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "ConfigurationsReady", "status": "True"},
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+			"traffic": []interface{}{
+				map[string]interface{}{"revisionName": "helloworld-0123456", "percent": int64(20), "tag": "candidate", "url": "http://candidate-helloworld.default.example.com"},
+				map[string]interface{}{"revisionName": "helloworld-fedcba9", "percent": int64(80)},
+			},
+		},
+	}}
+	revision := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "serving.knative.dev/v1",
+		"kind":       "Revision",
+		"metadata": map[string]interface{}{
+			"name":       "helloworld-0123456",
+			"namespace":  "default",
+			"generation": int64(1),
+		},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(1),
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "False", "message": "image not found"},
+			},
+		},
+	}}
+	c := newFakeClient(service, revision)
+	ctx := context.Background()
+
+	ss, err := c.GetServiceStatus(ctx, "default", "helloworld")
+	require.NoError(t, err)
+	assert.True(t, ss.IsReady())
+	assert.Equal(t, []TrafficStatus{
+		{RevisionName: "helloworld-0123456", Percent: 20, Tag: "candidate", URL: "http://candidate-helloworld.default.example.com"},
+		{RevisionName: "helloworld-fedcba9", Percent: 80},
+	}, ss.Traffic)
+	url, ok := ss.TagURL("candidate")
+	assert.True(t, ok)
+	assert.Equal(t, "http://candidate-helloworld.default.example.com", url)
+
+	rs, err := c.GetRevisionStatus(ctx, "default", "helloworld-0123456")
+	require.NoError(t, err)
+	assert.True(t, rs.IsFailed())
+	assert.Equal(t, "image not found", rs.Message)
+
+	_, err = c.GetRevisionStatus(ctx, "default", "helloworld-unknown")
+	assert.ErrorIs(t, err, ErrNotFound)
+}
@@ -0,0 +1,24 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// DiffServiceManifests calculates the diff between the two given service manifests.
+func DiffServiceManifests(old, new ServiceManifest) (*diff.Result, error) {
+	return diff.DiffUnstructureds(*old.u, *new.u, new.Name, diff.WithEquateEmpty())
+}
@@ -0,0 +1,95 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The keys of the labels added by piped.
+	LabelManagedBy    = "pipecd-dev-managed-by"    // Always be piped.
+	LabelPiped        = "pipecd-dev-piped"         // The id of piped handling this application.
+	LabelApplication  = "pipecd-dev-application"   // The application this resource belongs to.
+	LabelCommitHash   = "pipecd-dev-commit-hash"   // Hash value of the deployed commit.
+	LabelRevisionName = "pipecd-dev-revision-name" // The name of revision.
+	ManagedByPiped    = "piped"
+)
+
+// ErrNotFound is returned when the requested Knative resource does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of the Knative Serving API of a kubernetes cluster.
+type Client interface {
+	// ApplyService creates the service in the given manifest or replaces the existing one with it.
+	ApplyService(ctx context.Context, sm ServiceManifest) error
+	// GetServiceStatus returns the status of the given service.
+	// ErrNotFound is returned when there is no such service.
+	GetServiceStatus(ctx context.Context, namespace, name string) (*ServiceStatus, error)
+	// GetRevisionStatus returns the status of the given revision.
+	// ErrNotFound is returned when there is no such revision.
+	GetRevisionStatus(ctx context.Context, namespace, name string) (*Status, error)
+}
+
+// Registry holds a pool of Knative client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderKnativeConfig, logger *zap.Logger) (Client, error)
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderKnativeConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.MasterURL, cfg.KubeConfigPath, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of Knative clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,234 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	servingAPIVersion = "serving.knative.dev/v1"
+	serviceKind       = "Service"
+	defaultNamespace  = "default"
+)
+
+// ServiceManifest represents a Knative service defined in Git.
+type ServiceManifest struct {
+	Name string
+	u    *unstructured.Unstructured
+}
+
+// RevisionTraffic represents a traffic target of the service.
+type RevisionTraffic struct {
+	RevisionName string `json:"revisionName"`
+	Percent      int    `json:"percent"`
+	// The tag assigned to the revision to access it at a dedicated URL.
+	Tag string `json:"tag,omitempty"`
+}
+
+// Namespace returns the namespace of the service.
+// The default namespace is returned when it was not specified.
+func (m ServiceManifest) Namespace() string {
+	if ns := m.u.GetNamespace(); ns != "" {
+		return ns
+	}
+	return defaultNamespace
+}
+
+func (m ServiceManifest) SetNamespace(namespace string) {
+	m.u.SetNamespace(namespace)
+}
+
+// SetRevision sets the name of the revision created from the template of the service.
+func (m ServiceManifest) SetRevision(name string) error {
+	return unstructured.SetNestedField(m.u.Object, name, "spec", "template", "metadata", "name")
+}
+
+// SetTraffic replaces the traffic targets of the service with the given ones.
+func (m ServiceManifest) SetTraffic(revisions []RevisionTraffic) error {
+	items := make([]interface{}, 0, len(revisions))
+	for i := range revisions {
+		out, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&revisions[i])
+		if err != nil {
+			return fmt.Errorf("unable to set traffic for object: %w", err)
+		}
+		// The revision must be referred by its name to be pinned.
+		out["latestRevision"] = false
+		items = append(items, out)
+	}
+	return unstructured.SetNestedSlice(m.u.Object, items, "spec", "traffic")
+}
+
+func (m ServiceManifest) AddLabels(labels map[string]string) {
+	if len(labels) == 0 {
+		return
+	}
+
+	lbls := m.u.GetLabels()
+	if lbls == nil {
+		m.u.SetLabels(labels)
+		return
+	}
+	for k, v := range labels {
+		lbls[k] = v
+	}
+	m.u.SetLabels(lbls)
+}
+
+func (m ServiceManifest) AddRevisionLabels(labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	fields := []string{"spec", "template", "metadata", "labels"}
+	lbls, ok, err := unstructured.NestedStringMap(m.u.Object, fields...)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return unstructured.SetNestedStringMap(m.u.Object, labels, fields...)
+	}
+
+	for k, v := range labels {
+		lbls[k] = v
+	}
+	return unstructured.SetNestedStringMap(m.u.Object, lbls, fields...)
+}
+
+// LoadServiceManifest returns ServiceManifest object from a given service manifest file.
+func LoadServiceManifest(appDir, serviceFilename string) (ServiceManifest, error) {
+	path := filepath.Join(appDir, serviceFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return ServiceManifest{}, err
+	}
+	return ParseServiceManifest(data)
+}
+
+func ParseServiceManifest(data []byte) (ServiceManifest, error) {
+	var obj unstructured.Unstructured
+	if err := yaml.Unmarshal(data, &obj); err != nil {
+		return ServiceManifest{}, err
+	}
+	if obj.GetAPIVersion() != servingAPIVersion {
+		return ServiceManifest{}, fmt.Errorf("unsupported version: %s", obj.GetAPIVersion())
+	}
+	if obj.GetKind() != serviceKind {
+		return ServiceManifest{}, fmt.Errorf("invalid manifest kind given: %s", obj.GetKind())
+	}
+	if obj.GetName() == "" {
+		return ServiceManifest{}, fmt.Errorf("metadata.name is missing")
+	}
+	if _, err := containerImages(obj); err != nil {
+		return ServiceManifest{}, err
+	}
+
+	return ServiceManifest{
+		Name: obj.GetName(),
+		u:    &obj,
+	}, nil
+}
+
+// DecideRevisionName returns the name of the revision deployed from the given commit.
+// Knative requires the name of a revision to be prefixed by the name of its service.
+func DecideRevisionName(sm ServiceManifest, commit string) string {
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	return fmt.Sprintf("%s-%s", sm.Name, commit)
+}
+
+// FindArtifactVersions returns the container images of the service.
+// The image of the first container comes first since it is the main one of the revision.
+func FindArtifactVersions(sm ServiceManifest) ([]*model.ArtifactVersion, error) {
+	images, err := containerImages(*sm.u)
+	if err != nil {
+		return nil, err
+	}
+
+	versions := make([]*model.ArtifactVersion, 0, len(images))
+	for _, image := range images {
+		name, tag := parseContainerImage(image)
+		versions = append(versions, &model.ArtifactVersion{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    name,
+			Url:     image,
+		})
+	}
+	return versions, nil
+}
+
+// containerImages returns the unique images of the containers of the revision template
+// in the order of the containers.
+func containerImages(obj unstructured.Unstructured) ([]string, error) {
+	containers, ok, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+	if err != nil {
+		return nil, err
+	}
+	if !ok || len(containers) == 0 {
+		return nil, fmt.Errorf("spec.template.spec.containers was missing")
+	}
+
+	images := make([]string, 0, len(containers))
+	seen := make(map[string]struct{}, len(containers))
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid container format")
+		}
+		image, _, err := unstructured.NestedString(container, "image")
+		if err != nil {
+			return nil, err
+		}
+		if image == "" {
+			return nil, fmt.Errorf("image was missing")
+		}
+		if _, ok := seen[image]; ok {
+			continue
+		}
+		seen[image] = struct{}{}
+		images = append(images, image)
+	}
+	return images, nil
+}
+
+func parseContainerImage(image string) (name, tag string) {
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	last := paths[len(paths)-1]
+	if i := strings.LastIndex(last, ":"); i >= 0 {
+		// The digest takes precedence over the tag when both were specified.
+		if tag == "" {
+			tag = last[i+1:]
+		}
+		last = last[:i]
+	}
+	if tag == "" {
+		tag = "latest"
+	}
+	return last, tag
+}
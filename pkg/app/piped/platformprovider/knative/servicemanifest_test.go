@@ -0,0 +1,165 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package knative
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const testServiceManifest = `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+        - image: gcr.io/knative-samples/helloworld-go:v0.1.0
+        - image: ghcr.io/example/proxy@sha256:abc
+`
+
+func TestParseServiceManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		data    string
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			data: testServiceManifest,
+		},
+		{
+			name: "wrong api version",
+			data: `
+apiVersion: serving.knative.dev/v1alpha1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+        - image: helloworld
+`,
+			wantErr: true,
+		},
+		{
+			name: "wrong kind",
+			data: `
+apiVersion: serving.knative.dev/v1
+kind: Configuration
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+        - image: helloworld
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing image",
+			data: `
+apiVersion: serving.knative.dev/v1
+kind: Service
+metadata:
+  name: helloworld
+spec:
+  template:
+    spec:
+      containers:
+        - name: app
+`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sm, err := ParseServiceManifest([]byte(tc.data))
+			if tc.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "helloworld", sm.Name)
+			assert.Equal(t, "default", sm.Namespace())
+		})
+	}
+}
+
+func TestServiceManifestTraffic(t *testing.T) {
+	t.Parallel()
+
+	sm, err := ParseServiceManifest([]byte(testServiceManifest))
+	require.NoError(t, err)
+
+	revision := DecideRevisionName(sm, "0123456789abcdef")
+	assert.Equal(t, "helloworld-0123456", revision)
+
+	require.NoError(t, sm.SetRevision(revision))
+	require.NoError(t, sm.SetTraffic([]RevisionTraffic{
+		{RevisionName: revision, Percent: 20, Tag: "candidate"},
+		{RevisionName: "helloworld-fedcba9", Percent: 80},
+	}))
+	require.NoError(t, sm.AddRevisionLabels(map[string]string{LabelCommitHash: "0123456789abcdef"}))
+	sm.AddLabels(map[string]string{LabelManagedBy: ManagedByPiped})
+
+	name, _, _ := unstructured.NestedString(sm.u.Object, "spec", "template", "metadata", "name")
+	assert.Equal(t, revision, name)
+	traffic, _, _ := unstructured.NestedSlice(sm.u.Object, "spec", "traffic")
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"revisionName": revision, "percent": int64(20), "tag": "candidate", "latestRevision": false},
+		map[string]interface{}{"revisionName": "helloworld-fedcba9", "percent": int64(80), "latestRevision": false},
+	}, traffic)
+	labels, _, _ := unstructured.NestedStringMap(sm.u.Object, "spec", "template", "metadata", "labels")
+	assert.Equal(t, map[string]string{LabelCommitHash: "0123456789abcdef"}, labels)
+	assert.Equal(t, map[string]string{LabelManagedBy: ManagedByPiped}, sm.u.GetLabels())
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	sm, err := ParseServiceManifest([]byte(testServiceManifest))
+	require.NoError(t, err)
+
+	versions, err := FindArtifactVersions(sm)
+	require.NoError(t, err)
+	assert.Equal(t, []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "v0.1.0",
+			Name:    "helloworld-go",
+			Url:     "gcr.io/knative-samples/helloworld-go:v0.1.0",
+		},
+		{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: "sha256:abc",
+			Name:    "proxy",
+			Url:     "ghcr.io/example/proxy@sha256:abc",
+		},
+	}, versions)
+}
@@ -21,6 +21,7 @@ import (
 	"sync"
 
 	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
 	"github.com/pipe-cd/pipecd/pkg/config"
@@ -35,6 +36,16 @@ type Applier interface {
 	ReplaceManifest(ctx context.Context, manifest Manifest) error
 	// Delete deletes the given resource from Kubernetes cluster.
 	Delete(ctx context.Context, key ResourceKey) error
+	// GetManifest returns the live manifest of the given resource from Kubernetes cluster.
+	GetManifest(ctx context.Context, key ResourceKey) (Manifest, error)
+	// GetJobLogs returns the logs of all pods created by the given Job.
+	GetJobLogs(ctx context.Context, key ResourceKey) (string, error)
+	// GetWarningEvents returns the warning events recorded in the namespace of the given resource.
+	GetWarningEvents(ctx context.Context, key ResourceKey) ([]corev1.Event, error)
+	// Scale changes the number of replicas of the given workload.
+	Scale(ctx context.Context, key ResourceKey, replicas int32) error
+	// RolloutRestart restarts the pods of the given workload.
+	RolloutRestart(ctx context.Context, key ResourceKey) error
 }
 
 type applier struct {
@@ -45,6 +56,10 @@ type applier struct {
 	kubectl  *Kubectl
 	initOnce sync.Once
 	initErr  error
+
+	// The namespaces which have been created or found by autoCreateNamespace.
+	ensuredNamespaces map[string]struct{}
+	namespacesMu      sync.Mutex
 }
 
 func NewApplier(input config.KubernetesDeploymentInput, cp config.PlatformProviderKubernetesConfig, logger *zap.Logger) Applier {
@@ -52,6 +67,8 @@ func NewApplier(input config.KubernetesDeploymentInput, cp config.PlatformProvid
 		input:            input,
 		platformProvider: cp,
 		logger:           logger.Named("kubernetes-applier"),
+
+		ensuredNamespaces: make(map[string]struct{}),
 	}
 }
 
@@ -65,12 +82,7 @@ func (a *applier) ApplyManifest(ctx context.Context, manifest Manifest) error {
 	}
 
 	if a.input.AutoCreateNamespace {
-		err := a.kubectl.CreateNamespace(
-			ctx,
-			a.platformProvider.KubeConfigPath,
-			a.getNamespaceToRun(manifest.Key),
-		)
-		if err != nil && !errors.Is(err, errResourceAlreadyExists) {
+		if err := a.ensureNamespace(ctx, a.getNamespaceToRun(manifest.Key)); err != nil {
 			return err
 		}
 	}
@@ -80,9 +92,37 @@ func (a *applier) ApplyManifest(ctx context.Context, manifest Manifest) error {
 		a.platformProvider.KubeConfigPath,
 		a.getNamespaceToRun(manifest.Key),
 		manifest,
+		a.input.ServerSideApply,
 	)
 }
 
+// ensureNamespace creates the given namespace if it does not exist.
+// Each namespace is checked only once by this applier.
+func (a *applier) ensureNamespace(ctx context.Context, namespace string) error {
+	// Cluster-scoped resources have no namespace.
+	if namespace == "" {
+		return nil
+	}
+
+	a.namespacesMu.Lock()
+	defer a.namespacesMu.Unlock()
+	if _, ok := a.ensuredNamespaces[namespace]; ok {
+		return nil
+	}
+
+	err := a.kubectl.CreateNamespace(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		namespace,
+		a.input.NamespaceMetadata,
+	)
+	if err != nil && !errors.Is(err, errResourceAlreadyExists) {
+		return err
+	}
+	a.ensuredNamespaces[namespace] = struct{}{}
+	return nil
+}
+
 // CreateManifest uses kubectl to create the given manifests.
 func (a *applier) CreateManifest(ctx context.Context, manifest Manifest) error {
 	a.initOnce.Do(func() {
@@ -93,12 +133,7 @@ func (a *applier) CreateManifest(ctx context.Context, manifest Manifest) error {
 	}
 
 	if a.input.AutoCreateNamespace {
-		err := a.kubectl.CreateNamespace(
-			ctx,
-			a.platformProvider.KubeConfigPath,
-			a.getNamespaceToRun(manifest.Key),
-		)
-		if err != nil && !errors.Is(err, errResourceAlreadyExists) {
+		if err := a.ensureNamespace(ctx, a.getNamespaceToRun(manifest.Key)); err != nil {
 			return err
 		}
 	}
@@ -170,6 +205,90 @@ func (a *applier) Delete(ctx context.Context, k ResourceKey) (err error) {
 	)
 }
 
+// GetManifest returns the live manifest of the given resource from Kubernetes cluster.
+func (a *applier) GetManifest(ctx context.Context, k ResourceKey) (Manifest, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return Manifest{}, a.initErr
+	}
+
+	return a.kubectl.Get(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+		k,
+	)
+}
+
+// GetJobLogs returns the logs of all pods created by the given Job.
+func (a *applier) GetJobLogs(ctx context.Context, k ResourceKey) (string, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return "", a.initErr
+	}
+
+	return a.kubectl.JobLogs(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+		k,
+	)
+}
+
+// GetWarningEvents returns the warning events recorded in the namespace of the given resource.
+func (a *applier) GetWarningEvents(ctx context.Context, k ResourceKey) ([]corev1.Event, error) {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return nil, a.initErr
+	}
+
+	return a.kubectl.WarningEvents(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+	)
+}
+
+// Scale changes the number of replicas of the given workload.
+func (a *applier) Scale(ctx context.Context, k ResourceKey, replicas int32) error {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return a.initErr
+	}
+
+	return a.kubectl.Scale(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+		k,
+		replicas,
+	)
+}
+
+func (a *applier) RolloutRestart(ctx context.Context, k ResourceKey) error {
+	a.initOnce.Do(func() {
+		a.kubectl, a.initErr = a.findKubectl(ctx, a.getToolVersionToRun())
+	})
+	if a.initErr != nil {
+		return a.initErr
+	}
+
+	return a.kubectl.RolloutRestart(
+		ctx,
+		a.platformProvider.KubeConfigPath,
+		a.getNamespaceToRun(k),
+		k,
+	)
+}
+
 // getNamespaceToRun returns namespace used on kubectl apply/delete commands.
 // priority: config.KubernetesDeploymentInput > kubernetes.ResourceKey
 func (a *applier) getNamespaceToRun(k ResourceKey) string {
@@ -188,6 +307,15 @@ func (a *applier) getToolVersionToRun() string {
 	return a.platformProvider.KubectlVersion
 }
 
+// getIdentityToRun returns the identity which should be used for commands.
+// priority: applicationConfig.KubectlIdentity > pipedConfig.KubectlIdentity
+func (a *applier) getIdentityToRun() *config.KubernetesKubectlIdentity {
+	if a.input.KubectlIdentity != nil {
+		return a.input.KubectlIdentity
+	}
+	return a.platformProvider.KubectlIdentity
+}
+
 func (a *applier) findKubectl(ctx context.Context, version string) (*Kubectl, error) {
 	path, installed, err := toolregistry.DefaultRegistry().Kubectl(ctx, version)
 	if err != nil {
@@ -196,7 +324,7 @@ func (a *applier) findKubectl(ctx context.Context, version string) (*Kubectl, er
 	if installed {
 		a.logger.Info(fmt.Sprintf("kubectl %s has just been installed because of no pre-installed binary for that version", version))
 	}
-	return NewKubectl(version, path), nil
+	return NewKubectl(version, path).WithIdentity(a.getIdentityToRun()), nil
 }
 
 type multiApplier struct {
@@ -245,3 +373,45 @@ func (a *multiApplier) Delete(ctx context.Context, key ResourceKey) error {
 	}
 	return nil
 }
+
+// GetManifest is not supported for multiple appliers
+// because the live state of the resource may differ between the clusters.
+func (a *multiApplier) GetManifest(_ context.Context, _ ResourceKey) (Manifest, error) {
+	return Manifest{}, errors.New("unable to get the live manifest from multiple platform providers")
+}
+
+// GetJobLogs is not supported for multiple appliers.
+func (a *multiApplier) GetJobLogs(_ context.Context, _ ResourceKey) (string, error) {
+	return "", errors.New("unable to get the logs from multiple platform providers")
+}
+
+// GetWarningEvents returns the warning events from all platform providers.
+func (a *multiApplier) GetWarningEvents(ctx context.Context, key ResourceKey) ([]corev1.Event, error) {
+	var events []corev1.Event
+	for _, a := range a.appliers {
+		es, err := a.GetWarningEvents(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, es...)
+	}
+	return events, nil
+}
+
+func (a *multiApplier) Scale(ctx context.Context, key ResourceKey, replicas int32) error {
+	for _, a := range a.appliers {
+		if err := a.Scale(ctx, key, replicas); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *multiApplier) RolloutRestart(ctx context.Context, key ResourceKey) error {
+	for _, a := range a.appliers {
+		if err := a.RolloutRestart(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
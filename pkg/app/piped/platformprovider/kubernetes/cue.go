@@ -0,0 +1,76 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+type Cue struct {
+	version  string
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewCue(version, path string, logger *zap.Logger) *Cue {
+	return &Cue{
+		version:  version,
+		execPath: path,
+		logger:   logger,
+	}
+}
+
+func (c *Cue) Template(ctx context.Context, appName, appDir string, opts *config.InputCueOptions) (string, error) {
+	args := []string{
+		"export",
+		"--out",
+		"json",
+	}
+	pkg := "."
+	if opts != nil {
+		if opts.Expression != "" {
+			args = append(args, "--expression", opts.Expression)
+		}
+		for _, k := range sortedKeys(opts.Tags) {
+			args = append(args, "--inject", fmt.Sprintf("%s=%s", k, opts.Tags[k]))
+		}
+		if opts.Package != "" {
+			pkg = opts.Package
+		}
+	}
+	args = append(args, pkg)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = appDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	c.logger.Info(fmt.Sprintf("start templating a CUE application %s", appName),
+		zap.Any("args", args),
+	)
+
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
@@ -59,6 +59,10 @@ func Diff(old, new Manifest, logger *zap.Logger, opts ...diff.Option) (*diff.Res
 		}
 	}
 
+	if old.Key.IsExternalSecret() && new.Key.IsExternalSecret() {
+		new.u = normalizeNewExternalSecret(old.u, new.u)
+	}
+
 	key := old.Key.String()
 	normalized, err := remarshal(new.u)
 	if err != nil {
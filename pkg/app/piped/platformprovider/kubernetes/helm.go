@@ -35,6 +35,9 @@ var (
 	allowedURLSchemes = []string{"http", "https"}
 )
 
+// ociScheme is the scheme of the address of the OCI chart repository.
+const ociScheme = "oci://"
+
 type Helm struct {
 	version  string
 	execPath string
@@ -140,9 +143,33 @@ type helmRemoteChart struct {
 	Name       string
 	Version    string
 	Insecure   bool
+	// The digest of the chart pulled from the OCI chart repository.
+	Digest string
+	// The address of the OCI chart repository configured in piped.
+	OCIAddress string
+}
+
+// ociChartRef returns the reference of the chart in the OCI chart repository.
+// False is returned when the chart is not hosted on any OCI chart repository.
+func (c helmRemoteChart) ociChartRef() (string, bool) {
+	address := c.OCIAddress
+	if address == "" && strings.HasPrefix(c.Repository, ociScheme) {
+		address = c.Repository
+	}
+	if address == "" {
+		return "", false
+	}
+	return fmt.Sprintf("%s/%s", strings.TrimSuffix(address, "/"), c.Name), true
 }
 
 func (h *Helm) TemplateRemoteChart(ctx context.Context, appName, appDir, namespace string, chart helmRemoteChart, opts *config.InputHelmOptions) (string, error) {
+	if ref, ok := chart.ociChartRef(); ok {
+		return h.templateOCIChart(ctx, appName, appDir, namespace, ref, chart, opts)
+	}
+	if chart.Digest != "" {
+		return "", fmt.Errorf("digest can be specified only for the chart in OCI chart repository")
+	}
+
 	releaseName := appName
 	if opts != nil && opts.ReleaseName != "" {
 		releaseName = opts.ReleaseName
@@ -221,6 +248,70 @@ func (h *Helm) TemplateRemoteChart(ctx context.Context, appName, appDir, namespa
 	return executor()
 }
 
+// templateOCIChart pulls the chart from the OCI chart repository and renders it as a local chart.
+// The digest of the pulled chart is verified when it was pinned.
+func (h *Helm) templateOCIChart(ctx context.Context, appName, appDir, namespace, ref string, chart helmRemoteChart, opts *config.InputHelmOptions) (string, error) {
+	dir, err := os.MkdirTemp("", "helm-oci-chart-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary directory for chart %s: %w", ref, err)
+	}
+	defer os.RemoveAll(dir)
+
+	args := []string{
+		"pull",
+		ref,
+		fmt.Sprintf("--version=%s", chart.Version),
+		fmt.Sprintf("--destination=%s", dir),
+	}
+	// The credentials of the repository configured in piped are stored in its own registry config file.
+	if chart.OCIAddress != "" {
+		args = append(args, fmt.Sprintf("--registry-config=%s", chartrepo.RegistryConfigPath(chart.Repository)))
+	}
+	if chart.Insecure {
+		args = append(args, "--insecure-skip-tls-verify")
+	}
+
+	h.logger.Info(fmt.Sprintf("start pulling a chart from OCI chart repository for application %s", appName),
+		zap.Any("args", args),
+	)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, h.execPath, args...)
+	cmd.Dir = appDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("failed to pull chart %s: %w: %s", ref, err, stderr.String())
+	}
+
+	if chart.Digest != "" {
+		// helm prints the digest of the pulled chart to stderr or stdout depending on its version.
+		digest := parsePulledChartDigest(stdout.String() + "\n" + stderr.String())
+		if digest != chart.Digest {
+			return "", fmt.Errorf("digest of chart %s:%s is %q but %q was expected", ref, chart.Version, digest, chart.Digest)
+		}
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.tgz"))
+	if err != nil || len(files) != 1 {
+		return "", fmt.Errorf("unable to find the pulled chart %s in %s", ref, dir)
+	}
+	return h.TemplateLocalChart(ctx, appName, appDir, namespace, files[0], opts)
+}
+
+// parsePulledChartDigest returns the digest printed by helm pull command.
+// e.g.
+// Pulled: ghcr.io/pipe-cd/chart/helloworld:v0.1.0
+// Digest: sha256:0123456789abcdef
+func parsePulledChartDigest(out string) string {
+	for _, line := range strings.Split(out, "\n") {
+		if digest, ok := strings.CutPrefix(strings.TrimSpace(line), "Digest:"); ok {
+			return strings.TrimSpace(digest)
+		}
+	}
+	return ""
+}
+
 // verifyHelmValueFilePath verifies if the path of the values file references
 // a remote URL or inside the path where the application configuration file (i.e. *.pipecd.yaml) is located.
 func verifyHelmValueFilePath(appDir, valueFilePath string) error {
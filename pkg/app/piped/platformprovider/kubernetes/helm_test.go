@@ -166,3 +166,49 @@ func TestVerifyHelmValueFilePath(t *testing.T) {
 		})
 	}
 }
+
+func TestHelmRemoteChartOCIChartRef(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		chart   helmRemoteChart
+		want    string
+		wantOCI bool
+	}{
+		{
+			name:  "http repository",
+			chart: helmRemoteChart{Repository: "pipecd", Name: "helloworld"},
+		},
+		{
+			name:    "oci repository configured in piped",
+			chart:   helmRemoteChart{Repository: "pipecd", Name: "helloworld", OCIAddress: "oci://ghcr.io/pipe-cd/chart/"},
+			want:    "oci://ghcr.io/pipe-cd/chart/helloworld",
+			wantOCI: true,
+		},
+		{
+			name:    "oci address specified as repository",
+			chart:   helmRemoteChart{Repository: "oci://ghcr.io/pipe-cd/chart", Name: "helloworld"},
+			want:    "oci://ghcr.io/pipe-cd/chart/helloworld",
+			wantOCI: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := tc.chart.ociChartRef()
+			assert.Equal(t, tc.wantOCI, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestParsePulledChartDigest(t *testing.T) {
+	t.Parallel()
+
+	out := `Pulled: ghcr.io/pipe-cd/chart/helloworld:v0.1.0
+Digest: sha256:1b0a6e8b5c0e3a2f4d6c8e0a2b4d6f8a0c2e4a6b8d0f2a4c6e8a0b2d4f6a8c0e
+`
+	assert.Equal(t, "sha256:1b0a6e8b5c0e3a2f4d6c8e0a2b4d6f8a0c2e4a6b8d0f2a4c6e8a0b2d4f6a8c0e", parsePulledChartDigest(out))
+	assert.Equal(t, "", parsePulledChartDigest("Error: not found"))
+}
@@ -0,0 +1,35 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// IsScaledByHPA reports whether the given workload is the scale target
+// of one of the given HorizontalPodAutoscaler manifests.
+func IsScaledByHPA(workload Manifest, hpas []Manifest) bool {
+	for _, h := range hpas {
+		if h.Key.Kind != KindHorizontalPodAutoscaler || h.Key.Namespace != workload.Key.Namespace {
+			continue
+		}
+		kind, _, _ := unstructured.NestedString(h.u.Object, "spec", "scaleTargetRef", "kind")
+		name, _, _ := unstructured.NestedString(h.u.Object, "spec", "scaleTargetRef", "name")
+		if kind == workload.Key.Kind && name == workload.Key.Name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,62 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsScaledByHPA(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+---
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: simple
+---
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: simple
+  namespace: other
+---
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata:
+  name: simple
+spec:
+  scaleTargetRef:
+    apiVersion: apps/v1
+    kind: Deployment
+    name: simple
+`)
+	require.NoError(t, err)
+	require.Len(t, manifests, 4)
+
+	hpas := manifests[3:]
+	assert.True(t, IsScaledByHPA(manifests[0], hpas))
+	assert.False(t, IsScaledByHPA(manifests[1], hpas))
+	assert.False(t, IsScaledByHPA(manifests[2], hpas))
+	assert.False(t, IsScaledByHPA(manifests[0], nil))
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"sort"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const defaultJsonnetFile = "main.jsonnet"
+
+type Jsonnet struct {
+	version  string
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewJsonnet(version, path string, logger *zap.Logger) *Jsonnet {
+	return &Jsonnet{
+		version:  version,
+		execPath: path,
+		logger:   logger,
+	}
+}
+
+func (c *Jsonnet) Template(ctx context.Context, appName, appDir string, opts *config.InputJsonnetOptions) (string, error) {
+	file := defaultJsonnetFile
+	args := make([]string, 0)
+	if opts != nil {
+		if opts.File != "" {
+			file = opts.File
+		}
+		for _, p := range opts.JPaths {
+			args = append(args, "--jpath", p)
+		}
+		for _, k := range sortedKeys(opts.ExtVars) {
+			args = append(args, "--ext-str", fmt.Sprintf("%s=%s", k, opts.ExtVars[k]))
+		}
+		for _, k := range sortedKeys(opts.TLAs) {
+			args = append(args, "--tla-str", fmt.Sprintf("%s=%s", k, opts.TLAs[k]))
+		}
+	}
+	args = append(args, file)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Dir = appDir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	c.logger.Info(fmt.Sprintf("start templating a Jsonnet application %s", appName),
+		zap.Any("args", args),
+	)
+
+	if err := cmd.Run(); err != nil {
+		return stdout.String(), fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return stdout.String(), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -17,16 +17,23 @@ package kubernetes
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os/exec"
 	"strings"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
 	"github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes/kubernetesmetrics"
+	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
+// The field manager name used while applying manifests by server-side apply.
+const serverSideApplyFieldManager = "piped"
+
 var (
 	errorReplaceNotFound     = errors.New("specified resource is not found")
 	errorNotFoundLiteral     = "Error from server (NotFound)"
@@ -38,6 +45,7 @@ type Kubectl struct {
 	version  string
 	execPath string
 	config   *rest.Config
+	identity *config.KubernetesKubectlIdentity
 }
 
 func NewKubectl(version, path string) *Kubectl {
@@ -47,7 +55,32 @@ func NewKubectl(version, path string) *Kubectl {
 	}
 }
 
-func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest) (err error) {
+// WithIdentity returns a Kubectl that runs all commands with the given identity.
+func (c *Kubectl) WithIdentity(identity *config.KubernetesKubectlIdentity) *Kubectl {
+	kc := *c
+	kc.identity = identity
+	return &kc
+}
+
+// identityArgs returns the global flags for running commands with the configured identity.
+func (c *Kubectl) identityArgs() []string {
+	if c.identity == nil {
+		return nil
+	}
+	args := make([]string, 0, 4+2*len(c.identity.AsGroups))
+	if c.identity.Context != "" {
+		args = append(args, "--context", c.identity.Context)
+	}
+	if c.identity.As != "" {
+		args = append(args, "--as", c.identity.As)
+	}
+	for _, g := range c.identity.AsGroups {
+		args = append(args, "--as-group", g)
+	}
+	return args
+}
+
+func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manifest Manifest, ssa *config.K8sServerSideApply) (err error) {
 	defer func() {
 		kubernetesmetrics.IncKubectlCallsCounter(
 			c.version,
@@ -61,16 +94,23 @@ func (c *Kubectl) Apply(ctx context.Context, kubeconfig, namespace string, manif
 		return err
 	}
 
-	args := make([]string, 0, 8)
+	args := make([]string, 0, 11)
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.identityArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
 
 	args = append(args, "apply")
-	if annotation := manifest.GetAnnotations()[LabelServerSideApply]; annotation == UseServerSideApply {
+	switch {
+	case ssa != nil && ssa.Enabled:
+		args = append(args, "--server-side", "--field-manager", serverSideApplyFieldManager)
+		if ssa.ForceConflicts {
+			args = append(args, "--force-conflicts")
+		}
+	case manifest.GetAnnotations()[LabelServerSideApply] == UseServerSideApply:
 		args = append(args, "--server-side")
 	}
 	args = append(args, "-f", "-")
@@ -104,6 +144,7 @@ func (c *Kubectl) Create(ctx context.Context, kubeconfig, namespace string, mani
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.identityArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
@@ -138,6 +179,7 @@ func (c *Kubectl) Replace(ctx context.Context, kubeconfig, namespace string, man
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.identityArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
@@ -172,6 +214,7 @@ func (c *Kubectl) Delete(ctx context.Context, kubeconfig, namespace string, r Re
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.identityArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
@@ -202,6 +245,7 @@ func (c *Kubectl) Get(ctx context.Context, kubeconfig, namespace string, r Resou
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
+	args = append(args, c.identityArgs()...)
 	if namespace != "" {
 		args = append(args, "--namespace", namespace)
 	}
@@ -226,16 +270,157 @@ func (c *Kubectl) Get(ctx context.Context, kubeconfig, namespace string, r Resou
 	return ms[0], nil
 }
 
-func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string) (err error) {
-	args := make([]string, 0, 7)
+// WarningEvents returns all events of Warning type in the given namespace.
+func (c *Kubectl) WarningEvents(ctx context.Context, kubeconfig, namespace string) (events []corev1.Event, err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelGetCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 9)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.identityArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "get", "events", "--field-selector", "type=Warning", "-o", "json")
+
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events: %v", err)
+	}
+
+	var list corev1.EventList
+	if err := json.Unmarshal(out, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse events: %v", err)
+	}
+	return list.Items, nil
+}
+
+// JobLogs returns the logs of all containers of the pods created by the given Job.
+// Each line is prefixed by the pod and container name.
+func (c *Kubectl) JobLogs(ctx context.Context, kubeconfig, namespace string, r ResourceKey) (logs string, err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelLogsCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 12)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.identityArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "logs", "--selector", "job-name="+r.Name, "--all-containers", "--prefix", "--tail", "-1")
+
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to get logs: %s, %v", string(out), err)
+	}
+	return string(out), nil
+}
+
+func (c *Kubectl) Scale(ctx context.Context, kubeconfig, namespace string, r ResourceKey, replicas int32) (err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelScaleCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 8)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.identityArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "scale", r.Kind+"/"+r.Name, fmt.Sprintf("--replicas=%d", replicas))
+
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	out, err := cmd.CombinedOutput()
+
+	if strings.Contains(string(out), "(NotFound)") {
+		return fmt.Errorf("failed to scale: %s, (%w), %v", string(out), ErrNotFound, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to scale: %s, %v", string(out), err)
+	}
+	return nil
+}
+
+// RolloutRestart restarts the pods of the given workload
+// by updating the restartedAt annotation of its pod template.
+func (c *Kubectl) RolloutRestart(ctx context.Context, kubeconfig, namespace string, r ResourceKey) (err error) {
+	defer func() {
+		kubernetesmetrics.IncKubectlCallsCounter(
+			c.version,
+			kubernetesmetrics.LabelRolloutCommand,
+			err == nil,
+		)
+	}()
+
+	args := make([]string, 0, 8)
 	if kubeconfig != "" {
 		args = append(args, "--kubeconfig", kubeconfig)
 	}
-	args = append(args, "create", "namespace", namespace)
+	args = append(args, c.identityArgs()...)
+	if namespace != "" {
+		args = append(args, "--namespace", namespace)
+	}
+	args = append(args, "rollout", "restart", r.Kind+"/"+r.Name)
 
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	out, err := cmd.CombinedOutput()
 
+	if strings.Contains(string(out), "(NotFound)") {
+		return fmt.Errorf("failed to restart: %s, (%w), %v", string(out), ErrNotFound, err)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to restart: %s, %v", string(out), err)
+	}
+	return nil
+}
+
+// CreateNamespace creates the given namespace with the given labels and annotations.
+func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace string, metadata *config.K8sNamespaceMetadata) (err error) {
+	args := make([]string, 0, 7)
+	if kubeconfig != "" {
+		args = append(args, "--kubeconfig", kubeconfig)
+	}
+	args = append(args, c.identityArgs()...)
+
+	var cmd *exec.Cmd
+	if metadata == nil {
+		args = append(args, "create", "namespace", namespace)
+		cmd = exec.CommandContext(ctx, c.execPath, args...)
+	} else {
+		// The labels and annotations can not be specified by "kubectl create namespace"
+		// so the namespace manifest is created instead.
+		data, err := makeNamespaceManifest(namespace, metadata).YamlBytes()
+		if err != nil {
+			return err
+		}
+		args = append(args, "create", "-f", "-")
+		cmd = exec.CommandContext(ctx, c.execPath, args...)
+		cmd.Stdin = bytes.NewReader(data)
+	}
+	out, err := cmd.CombinedOutput()
+
 	if strings.Contains(string(out), errAlreadyExistsLiteral) {
 		return errResourceAlreadyExists
 	}
@@ -244,3 +429,17 @@ func (c *Kubectl) CreateNamespace(ctx context.Context, kubeconfig, namespace str
 	}
 	return nil
 }
+
+func makeNamespaceManifest(namespace string, metadata *config.K8sNamespaceMetadata) Manifest {
+	u := &unstructured.Unstructured{}
+	u.SetAPIVersion("v1")
+	u.SetKind("Namespace")
+	u.SetName(namespace)
+	if len(metadata.Labels) > 0 {
+		u.SetLabels(metadata.Labels)
+	}
+	if len(metadata.Annotations) > 0 {
+		u.SetAnnotations(metadata.Annotations)
+	}
+	return MakeManifest(MakeResourceKey(u), u)
+}
@@ -0,0 +1,94 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestKubectlIdentityArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		identity *config.KubernetesKubectlIdentity
+		expected []string
+	}{
+		{
+			name:     "no identity",
+			expected: nil,
+		},
+		{
+			name: "context only",
+			identity: &config.KubernetesKubectlIdentity{
+				Context: "team-a",
+			},
+			expected: []string{"--context", "team-a"},
+		},
+		{
+			name: "impersonation",
+			identity: &config.KubernetesKubectlIdentity{
+				As:       "system:serviceaccount:team-a:deployer",
+				AsGroups: []string{"team-a", "developers"},
+			},
+			expected: []string{
+				"--as", "system:serviceaccount:team-a:deployer",
+				"--as-group", "team-a",
+				"--as-group", "developers",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kubectl := NewKubectl("1.18.2", "kubectl").WithIdentity(tc.identity)
+			assert.Equal(t, tc.expected, kubectl.identityArgs())
+		})
+	}
+}
+
+func TestMakeNamespaceManifest(t *testing.T) {
+	t.Parallel()
+
+	m := makeNamespaceManifest("team-a", &config.K8sNamespaceMetadata{
+		Labels: map[string]string{
+			"istio-injection": "enabled",
+		},
+		Annotations: map[string]string{
+			"owner": "team-a",
+		},
+	})
+	data, err := m.YamlBytes()
+	require.NoError(t, err)
+
+	expected := `apiVersion: v1
+kind: Namespace
+metadata:
+  annotations:
+    owner: team-a
+  labels:
+    istio-injection: enabled
+  name: team-a
+`
+	assert.Equal(t, expected, string(data))
+}
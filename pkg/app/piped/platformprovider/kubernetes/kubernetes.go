@@ -34,6 +34,7 @@ const (
 	LabelServerSideApply      = "pipecd.dev/server-side-apply"      // Use server side apply instead of client side apply.
 	AnnotationConfigHash      = "pipecd.dev/config-hash"            // The hash value of all mouting config resources.
 	AnnotationOrder           = "pipecd.dev/order"                  // The order number of resource used to sort them before using.
+	AnnotationSyncWave        = "pipecd.dev/sync-wave"              // The wave number of resource. Resources in lower waves are applied and established first.
 
 	ManagedByPiped           = "piped"
 	IgnoreDriftDetectionTrue = "true"
@@ -39,6 +39,9 @@ const (
 	LabelReplaceCommand ToolCommand = "replace"
 	LabelDeleteCommand  ToolCommand = "delete"
 	LabelGetCommand     ToolCommand = "get"
+	LabelLogsCommand    ToolCommand = "logs"
+	LabelScaleCommand   ToolCommand = "scale"
+	LabelRolloutCommand ToolCommand = "rollout"
 )
 
 type CommandOutput string
@@ -10,6 +10,7 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	kubernetes "github.com/pipe-cd/pipecd/pkg/app/piped/platformprovider/kubernetes"
+	v1 "k8s.io/api/core/v1"
 )
 
 // MockApplier is a mock of Applier interface.
@@ -77,6 +78,51 @@ func (mr *MockApplierMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockApplier)(nil).Delete), arg0, arg1)
 }
 
+// GetJobLogs mocks base method.
+func (m *MockApplier) GetJobLogs(arg0 context.Context, arg1 kubernetes.ResourceKey) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobLogs", arg0, arg1)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobLogs indicates an expected call of GetJobLogs.
+func (mr *MockApplierMockRecorder) GetJobLogs(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobLogs", reflect.TypeOf((*MockApplier)(nil).GetJobLogs), arg0, arg1)
+}
+
+// GetManifest mocks base method.
+func (m *MockApplier) GetManifest(arg0 context.Context, arg1 kubernetes.ResourceKey) (kubernetes.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManifest", arg0, arg1)
+	ret0, _ := ret[0].(kubernetes.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManifest indicates an expected call of GetManifest.
+func (mr *MockApplierMockRecorder) GetManifest(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManifest", reflect.TypeOf((*MockApplier)(nil).GetManifest), arg0, arg1)
+}
+
+// GetWarningEvents mocks base method.
+func (m *MockApplier) GetWarningEvents(arg0 context.Context, arg1 kubernetes.ResourceKey) ([]v1.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWarningEvents", arg0, arg1)
+	ret0, _ := ret[0].([]v1.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWarningEvents indicates an expected call of GetWarningEvents.
+func (mr *MockApplierMockRecorder) GetWarningEvents(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWarningEvents", reflect.TypeOf((*MockApplier)(nil).GetWarningEvents), arg0, arg1)
+}
+
 // ReplaceManifest mocks base method.
 func (m *MockApplier) ReplaceManifest(arg0 context.Context, arg1 kubernetes.Manifest) error {
 	m.ctrl.T.Helper()
@@ -91,6 +137,34 @@ func (mr *MockApplierMockRecorder) ReplaceManifest(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceManifest", reflect.TypeOf((*MockApplier)(nil).ReplaceManifest), arg0, arg1)
 }
 
+// RolloutRestart mocks base method.
+func (m *MockApplier) RolloutRestart(arg0 context.Context, arg1 kubernetes.ResourceKey) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RolloutRestart", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RolloutRestart indicates an expected call of RolloutRestart.
+func (mr *MockApplierMockRecorder) RolloutRestart(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RolloutRestart", reflect.TypeOf((*MockApplier)(nil).RolloutRestart), arg0, arg1)
+}
+
+// Scale mocks base method.
+func (m *MockApplier) Scale(arg0 context.Context, arg1 kubernetes.ResourceKey, arg2 int32) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scale indicates an expected call of Scale.
+func (mr *MockApplierMockRecorder) Scale(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scale", reflect.TypeOf((*MockApplier)(nil).Scale), arg0, arg1, arg2)
+}
+
 // MockLoader is a mock of Loader interface.
 type MockLoader struct {
 	ctrl     *gomock.Controller
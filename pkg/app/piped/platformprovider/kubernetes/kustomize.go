@@ -18,7 +18,9 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"os/exec"
+	"sort"
 
 	"go.uber.org/zap"
 )
@@ -37,22 +39,19 @@ func NewKustomize(version, path string, logger *zap.Logger) *Kustomize {
 	}
 }
 
-func (c *Kustomize) Template(ctx context.Context, appName, appDir string, opts map[string]string) (string, error) {
-	args := []string{
-		"build",
-		".",
-	}
-
-	for k, v := range opts {
-		args = append(args, fmt.Sprintf("--%s", k))
-		if v != "" {
-			args = append(args, v)
-		}
-	}
+func (c *Kustomize) Template(ctx context.Context, appName, appDir string, opts, envs map[string]string, helm *Helm) (string, error) {
+	args := makeKustomizeBuildArgs(opts, helm)
 
 	var stdout, stderr bytes.Buffer
 	cmd := exec.CommandContext(ctx, c.execPath, args...)
 	cmd.Dir = appDir
+	if len(envs) > 0 {
+		// The environment variables are used by the exec plugins.
+		cmd.Env = os.Environ()
+		for k, v := range envs {
+			cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%s", k, v))
+		}
+	}
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 
@@ -65,3 +64,32 @@ func (c *Kustomize) Template(ctx context.Context, appName, appDir string, opts m
 	}
 	return stdout.String(), nil
 }
+
+func makeKustomizeBuildArgs(opts map[string]string, helm *Helm) []string {
+	args := []string{
+		"build",
+		".",
+	}
+
+	// Sort the options to build the same command for the same input.
+	keys := make([]string, 0, len(opts))
+	for k := range opts {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		args = append(args, fmt.Sprintf("--%s", k))
+		if v := opts[k]; v != "" {
+			args = append(args, v)
+		}
+	}
+
+	// Use the helm binary managed by piped to inflate the helm charts
+	// unless the helm command was specified explicitly.
+	if _, ok := opts["enable-helm"]; ok && helm != nil {
+		if _, ok := opts["helm-command"]; !ok {
+			args = append(args, "--helm-command", helm.execPath)
+		}
+	}
+	return args
+}
@@ -40,7 +40,58 @@ func TestKustomizeTemplate(t *testing.T) {
 	kustomize := NewKustomize("", kustomizePath, zap.NewNop())
 	out, err := kustomize.Template(ctx, appName, appDir, map[string]string{
 		"load_restrictor": "LoadRestrictionsNone",
-	})
+	}, nil, nil)
 	require.NoError(t, err)
 	assert.True(t, len(out) > 0)
 }
+
+func TestMakeKustomizeBuildArgs(t *testing.T) {
+	t.Parallel()
+
+	helm := NewHelm("", "/usr/local/bin/helm", zap.NewNop())
+	testcases := []struct {
+		name     string
+		opts     map[string]string
+		helm     *Helm
+		expected []string
+	}{
+		{
+			name:     "no options",
+			expected: []string{"build", "."},
+		},
+		{
+			name: "sorted options",
+			opts: map[string]string{
+				"load-restrictor":      "LoadRestrictionsNone",
+				"enable-alpha-plugins": "",
+				"enable-exec":          "",
+			},
+			expected: []string{"build", ".", "--enable-alpha-plugins", "--enable-exec", "--load-restrictor", "LoadRestrictionsNone"},
+		},
+		{
+			name: "enable helm with the helm binary of piped",
+			opts: map[string]string{
+				"enable-helm": "",
+			},
+			helm:     helm,
+			expected: []string{"build", ".", "--enable-helm", "--helm-command", "/usr/local/bin/helm"},
+		},
+		{
+			name: "enable helm with the specified helm command",
+			opts: map[string]string{
+				"enable-helm":  "",
+				"helm-command": "/opt/helm",
+			},
+			helm:     helm,
+			expected: []string{"build", ".", "--enable-helm", "--helm-command", "/opt/helm"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := makeKustomizeBuildArgs(tc.opts, tc.helm)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
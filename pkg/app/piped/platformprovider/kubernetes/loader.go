@@ -36,6 +36,8 @@ type TemplatingMethod string
 const (
 	TemplatingMethodHelm      TemplatingMethod = "helm"
 	TemplatingMethodKustomize TemplatingMethod = "kustomize"
+	TemplatingMethodJsonnet   TemplatingMethod = "jsonnet"
+	TemplatingMethodCue       TemplatingMethod = "cue"
 	TemplatingMethodNone      TemplatingMethod = "none"
 )
 
@@ -60,6 +62,8 @@ type loader struct {
 	templatingMethod TemplatingMethod
 	kustomize        *Kustomize
 	helm             *Helm
+	jsonnet          *Jsonnet
+	cue              *Cue
 	initOnce         sync.Once
 	initErr          error
 }
@@ -93,10 +97,15 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 	l.initOnce.Do(func() {
 		var initErrorHelm, initErrorKustomize error
 		l.templatingMethod = determineTemplatingMethod(l.input, l.appDir)
-		if l.templatingMethod != TemplatingMethodNone {
+		switch l.templatingMethod {
+		case TemplatingMethodHelm, TemplatingMethodKustomize:
 			l.helm, initErrorHelm = l.findHelm(ctx, l.input.HelmVersion)
 			l.kustomize, initErrorKustomize = l.findKustomize(ctx, l.input.KustomizeVersion)
 			l.initErr = errors.Join(initErrorHelm, initErrorKustomize)
+		case TemplatingMethodJsonnet:
+			l.jsonnet, l.initErr = l.findJsonnet(ctx, l.input.JsonnetVersion)
+		case TemplatingMethodCue:
+			l.cue, l.initErr = l.findCue(ctx, l.input.CueVersion)
 		}
 	})
 	if l.initErr != nil {
@@ -127,6 +136,8 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 				Name:       l.input.HelmChart.Name,
 				Version:    l.input.HelmChart.Version,
 				Insecure:   l.input.HelmChart.Insecure,
+				Digest:     l.input.HelmChart.Digest,
+				OCIAddress: l.input.HelmChart.OCIAddress,
 			}
 			data, err = l.helm.TemplateRemoteChart(ctx,
 				l.appName,
@@ -152,20 +163,42 @@ func (l *loader) LoadManifests(ctx context.Context) (manifests []Manifest, err e
 
 	case TemplatingMethodKustomize:
 		var data string
-		data, err = l.kustomize.Template(ctx, l.appName, l.appDir, l.input.KustomizeOptions)
+		data, err = l.kustomize.Template(ctx, l.appName, l.appDir, l.input.KustomizeOptions, l.input.KustomizeEnvs, l.helm)
 		if err != nil {
 			err = fmt.Errorf("unable to run kustomize template: %w", err)
 			return
 		}
 		manifests, err = ParseManifests(data)
 
+	case TemplatingMethodJsonnet:
+		var data string
+		data, err = l.jsonnet.Template(ctx, l.appName, l.appDir, l.input.JsonnetOptions)
+		if err != nil {
+			err = fmt.Errorf("unable to run jsonnet: %w", err)
+			return
+		}
+		manifests, err = ParseJSONManifests(data)
+
+	case TemplatingMethodCue:
+		var data string
+		data, err = l.cue.Template(ctx, l.appName, l.appDir, l.input.CueOptions)
+		if err != nil {
+			err = fmt.Errorf("unable to run cue export: %w", err)
+			return
+		}
+		manifests, err = ParseJSONManifests(data)
+
 	case TemplatingMethodNone:
 		manifests, err = LoadPlainYAMLManifests(l.appDir, l.input.Manifests, l.configFileName)
 
 	default:
 		err = fmt.Errorf("unsupport templating method %v", l.templatingMethod)
 	}
+	if err != nil {
+		return
+	}
 
+	err = validateSecretManifests(manifests)
 	return
 }
 
@@ -217,7 +250,32 @@ func (l *loader) findHelm(ctx context.Context, version string) (*Helm, error) {
 	return NewHelm(version, path, l.logger), nil
 }
 
+func (l *loader) findJsonnet(ctx context.Context, version string) (*Jsonnet, error) {
+	path, installed, err := toolregistry.DefaultRegistry().Jsonnet(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("no jsonnet %s (%v)", version, err)
+	}
+	if installed {
+		l.logger.Info(fmt.Sprintf("jsonnet %s has just been installed because of no pre-installed binary for that version", version))
+	}
+	return NewJsonnet(version, path, l.logger), nil
+}
+
+func (l *loader) findCue(ctx context.Context, version string) (*Cue, error) {
+	path, installed, err := toolregistry.DefaultRegistry().Cue(ctx, version)
+	if err != nil {
+		return nil, fmt.Errorf("no cue %s (%v)", version, err)
+	}
+	if installed {
+		l.logger.Info(fmt.Sprintf("cue %s has just been installed because of no pre-installed binary for that version", version))
+	}
+	return NewCue(version, path, l.logger), nil
+}
+
 func determineTemplatingMethod(input config.KubernetesDeploymentInput, appDirPath string) TemplatingMethod {
+	if input.TemplatingMethod != "" {
+		return TemplatingMethod(input.TemplatingMethod)
+	}
 	if input.HelmChart != nil {
 		return TemplatingMethodHelm
 	}
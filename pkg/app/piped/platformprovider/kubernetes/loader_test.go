@@ -15,10 +15,15 @@
 package kubernetes
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
 )
 
 func TestSortManifests(t *testing.T) {
@@ -76,3 +81,55 @@ func TestSortManifests(t *testing.T) {
 		})
 	}
 }
+
+func TestDetermineTemplatingMethod(t *testing.T) {
+	t.Parallel()
+
+	kustomizeDir := t.TempDir()
+	err := os.WriteFile(filepath.Join(kustomizeDir, kustomizationFileName), []byte("resources: []"), 0644)
+	require.NoError(t, err)
+
+	testcases := []struct {
+		name     string
+		input    config.KubernetesDeploymentInput
+		appDir   string
+		expected TemplatingMethod
+	}{
+		{
+			name:     "plain manifests",
+			appDir:   t.TempDir(),
+			expected: TemplatingMethodNone,
+		},
+		{
+			name:     "helm chart",
+			input:    config.KubernetesDeploymentInput{HelmChart: &config.InputHelmChart{Path: "chart"}},
+			appDir:   t.TempDir(),
+			expected: TemplatingMethodHelm,
+		},
+		{
+			name:     "kustomization file",
+			appDir:   kustomizeDir,
+			expected: TemplatingMethodKustomize,
+		},
+		{
+			name:     "explicitly specified jsonnet",
+			input:    config.KubernetesDeploymentInput{TemplatingMethod: config.KubernetesTemplatingMethodJsonnet},
+			appDir:   kustomizeDir,
+			expected: TemplatingMethodJsonnet,
+		},
+		{
+			name:     "explicitly specified cue",
+			input:    config.KubernetesDeploymentInput{TemplatingMethod: config.KubernetesTemplatingMethodCue},
+			appDir:   t.TempDir(),
+			expected: TemplatingMethodCue,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, determineTemplatingMethod(tc.input, tc.appDir))
+		})
+	}
+}
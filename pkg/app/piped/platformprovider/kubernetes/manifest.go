@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -115,6 +116,21 @@ func (m Manifest) AddStringMapValues(values map[string]string, fields ...string)
 	return unstructured.SetNestedStringMap(m.u.Object, curMap, fields...)
 }
 
+// GetReplicas returns the value of spec.replicas field.
+// The second returned value is false when the field was not specified.
+func (m Manifest) GetReplicas() (int32, bool) {
+	replicas, ok, err := unstructured.NestedInt64(m.u.Object, "spec", "replicas")
+	if err != nil || !ok {
+		return 0, false
+	}
+	return int32(replicas), true
+}
+
+// SetReplicas sets the given value to spec.replicas field.
+func (m Manifest) SetReplicas(replicas int32) error {
+	return unstructured.SetNestedField(m.u.Object, int64(replicas), "spec", "replicas")
+}
+
 func (m Manifest) GetSpec() (interface{}, error) {
 	spec, ok, err := unstructured.NestedFieldNoCopy(m.u.Object, "spec")
 	if err != nil {
@@ -247,3 +263,56 @@ func ParseManifests(data string) ([]Manifest, error) {
 	}
 	return manifests, nil
 }
+
+// ParseJSONManifests parses the JSON value rendered by jsonnet or cue into manifests.
+// The value can be a resource, a list of values or an object whose fields are values,
+// they are flattened recursively. The fields of an object are visited in the order of their names.
+func ParseJSONManifests(data string) ([]Manifest, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(data), &value); err != nil {
+		return nil, fmt.Errorf("failed to parse the rendered value as JSON: %w", err)
+	}
+	manifests := make([]Manifest, 0)
+	if err := flattenJSONManifests(value, "$", &manifests); err != nil {
+		return nil, err
+	}
+	return manifests, nil
+}
+
+func flattenJSONManifests(value interface{}, path string, manifests *[]Manifest) error {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		for i, item := range v {
+			if err := flattenJSONManifests(item, fmt.Sprintf("%s[%d]", path, i), manifests); err != nil {
+				return err
+			}
+		}
+		return nil
+	case map[string]interface{}:
+		if _, ok := v["kind"].(string); ok {
+			if _, ok := v["apiVersion"].(string); ok {
+				obj := &unstructured.Unstructured{Object: v}
+				*manifests = append(*manifests, Manifest{
+					Key: MakeResourceKey(obj),
+					u:   obj,
+				})
+				return nil
+			}
+		}
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			if err := flattenJSONManifests(v[k], fmt.Sprintf("%s.%s", path, k), manifests); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unexpected value at %s: must be a resource, a list or an object", path)
+	}
+}
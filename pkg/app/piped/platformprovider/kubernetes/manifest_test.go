@@ -22,6 +22,70 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
+func TestParseJSONManifests(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		data     string
+		expected []string
+		wantErr  bool
+	}{
+		{
+			name:     "single resource",
+			data:     `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}`,
+			expected: []string{"ConfigMap/config"},
+		},
+		{
+			name: "list of resources",
+			data: `[
+				{"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "app"}},
+				{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "app"}}
+			]`,
+			expected: []string{"Deployment/app", "Service/app"},
+		},
+		{
+			name: "nested object of resources",
+			data: `{
+				"service": {"apiVersion": "v1", "kind": "Service", "metadata": {"name": "app"}},
+				"deployment": {"apiVersion": "apps/v1", "kind": "Deployment", "metadata": {"name": "app"}},
+				"configs": [{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "config"}}, null]
+			}`,
+			expected: []string{"ConfigMap/config", "Deployment/app", "Service/app"},
+		},
+		{
+			name:    "invalid value",
+			data:    `{"replicas": 2}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			data:    `apiVersion: v1`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseJSONManifests(tc.data)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			keys := make([]string, 0, len(manifests))
+			for _, m := range manifests {
+				keys = append(keys, m.Key.Kind+"/"+m.Key.Name)
+			}
+			assert.Equal(t, tc.expected, keys)
+		})
+	}
+}
+
 func TestParseManifests(t *testing.T) {
 	maker := func(name, kind string, metadata map[string]interface{}) Manifest {
 		return Manifest{
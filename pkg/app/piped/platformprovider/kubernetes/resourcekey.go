@@ -79,7 +79,10 @@ const (
 	KindClusterRoleBinding       = "ClusterRoleBinding"
 	KindNameSpace                = "NameSpace"
 	KindPodDisruptionBudget      = "PodDisruptionBudget"
+	KindHorizontalPodAutoscaler  = "HorizontalPodAutoscaler"
 	KindCustomResourceDefinition = "CustomResourceDefinition"
+	KindSealedSecret             = "SealedSecret"
+	KindExternalSecret           = "ExternalSecret"
 
 	DefaultNamespace = "default"
 )
@@ -180,6 +183,16 @@ func (k ResourceKey) IsSecret() bool {
 	return true
 }
 
+// IsSealedSecret reports whether the key is for a SealedSecret of Bitnami Sealed Secrets.
+func (k ResourceKey) IsSealedSecret() bool {
+	return k.Kind == KindSealedSecret && strings.HasPrefix(k.APIVersion, "bitnami.com/")
+}
+
+// IsExternalSecret reports whether the key is for an ExternalSecret of External Secrets Operator.
+func (k ResourceKey) IsExternalSecret() bool {
+	return k.Kind == KindExternalSecret && strings.HasPrefix(k.APIVersion, "external-secrets.io/")
+}
+
 // IsLess reports whether the key should sort before the given key.
 func (k ResourceKey) IsLess(a ResourceKey) bool {
 	if k.APIVersion < a.APIVersion {
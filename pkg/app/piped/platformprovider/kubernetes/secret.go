@@ -0,0 +1,141 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// validateSecretManifests validates the structure of SealedSecret and ExternalSecret manifests
+// to find the broken ones at rendering time instead of while applying them.
+func validateSecretManifests(manifests []Manifest) error {
+	for _, m := range manifests {
+		var err error
+		switch {
+		case m.Key.IsSealedSecret():
+			err = validateSealedSecret(m)
+		case m.Key.IsExternalSecret():
+			err = validateExternalSecret(m)
+		default:
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s %s: %w", m.Key.Kind, m.Key.Name, err)
+		}
+	}
+	return nil
+}
+
+func validateSealedSecret(m Manifest) error {
+	data, _, err := unstructured.NestedMap(m.u.Object, "spec", "encryptedData")
+	if err != nil {
+		return fmt.Errorf("spec.encryptedData must be a map: %w", err)
+	}
+	for k, v := range data {
+		s, ok := v.(string)
+		if !ok {
+			return fmt.Errorf("spec.encryptedData.%s must be a string", k)
+		}
+		if err := validateSealedPayload(s); err != nil {
+			return fmt.Errorf("spec.encryptedData.%s is malformed: %w", k, err)
+		}
+	}
+	return nil
+}
+
+// validateSealedPayload checks that the given value is a base64-encoded payload sealed by kubeseal.
+// The payload consists of the 2-byte length of the encrypted session key,
+// the encrypted session key and the data encrypted by that session key.
+func validateSealedPayload(value string) error {
+	payload, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return fmt.Errorf("unable to decode base64: %w", err)
+	}
+	if len(payload) < 2 {
+		return fmt.Errorf("payload is too short")
+	}
+	keyLen := int(binary.BigEndian.Uint16(payload))
+	if keyLen == 0 || len(payload) <= 2+keyLen {
+		return fmt.Errorf("payload does not contain both the session key and the encrypted data")
+	}
+	return nil
+}
+
+func validateExternalSecret(m Manifest) error {
+	data, _, err := unstructured.NestedSlice(m.u.Object, "spec", "data")
+	if err != nil {
+		return fmt.Errorf("spec.data must be a list: %w", err)
+	}
+	dataFrom, _, err := unstructured.NestedSlice(m.u.Object, "spec", "dataFrom")
+	if err != nil {
+		return fmt.Errorf("spec.dataFrom must be a list: %w", err)
+	}
+	if len(data) == 0 && len(dataFrom) == 0 {
+		return fmt.Errorf("either spec.data or spec.dataFrom must be specified")
+	}
+
+	storeName, _, _ := unstructured.NestedString(m.u.Object, "spec", "secretStoreRef", "name")
+	storeKind, _, _ := unstructured.NestedString(m.u.Object, "spec", "secretStoreRef", "kind")
+	if storeKind != "" && storeKind != "SecretStore" && storeKind != "ClusterSecretStore" {
+		return fmt.Errorf("spec.secretStoreRef.kind must be SecretStore or ClusterSecretStore")
+	}
+
+	for i, d := range data {
+		item, ok := d.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("spec.data[%d] must be a map", i)
+		}
+		if v, _, _ := unstructured.NestedString(item, "secretKey"); v == "" {
+			return fmt.Errorf("spec.data[%d].secretKey must be set", i)
+		}
+		if v, _, _ := unstructured.NestedString(item, "remoteRef", "key"); v == "" {
+			return fmt.Errorf("spec.data[%d].remoteRef.key must be set", i)
+		}
+		if storeName == "" {
+			if v, _, _ := unstructured.NestedString(item, "sourceRef", "storeRef", "name"); v == "" {
+				return fmt.Errorf("spec.data[%d] must have sourceRef.storeRef.name since spec.secretStoreRef.name is not set", i)
+			}
+		}
+	}
+	return nil
+}
+
+// normalizeNewExternalSecret makes the new ExternalSecret close to the old one
+// by using the old refreshInterval when both represent the same duration.
+// This is required because Kubernetes formats the live duration, e.g. "1h" becomes "1h0m0s".
+func normalizeNewExternalSecret(old, new *unstructured.Unstructured) *unstructured.Unstructured {
+	ov, _, _ := unstructured.NestedString(old.Object, "spec", "refreshInterval")
+	nv, _, _ := unstructured.NestedString(new.Object, "spec", "refreshInterval")
+	if ov == "" || nv == "" || ov == nv {
+		return new
+	}
+	od, err := time.ParseDuration(ov)
+	if err != nil {
+		return new
+	}
+	nd, err := time.ParseDuration(nv)
+	if err != nil || od != nd {
+		return new
+	}
+
+	normalized := new.DeepCopy()
+	unstructured.SetNestedField(normalized.Object, ov, "spec", "refreshInterval")
+	return normalized
+}
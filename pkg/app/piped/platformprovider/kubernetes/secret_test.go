@@ -0,0 +1,213 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestValidateSecretManifests(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		manifests string
+		wantErr   bool
+	}{
+		{
+			name: "valid sealed secret",
+			manifests: `
+apiVersion: bitnami.com/v1alpha1
+kind: SealedSecret
+metadata:
+  name: mysecret
+spec:
+  encryptedData:
+    password: AAJrZXlkYXRh
+`,
+		},
+		{
+			name: "sealed secret with non base64 payload",
+			manifests: `
+apiVersion: bitnami.com/v1alpha1
+kind: SealedSecret
+metadata:
+  name: mysecret
+spec:
+  encryptedData:
+    password: not-sealed!
+`,
+			wantErr: true,
+		},
+		{
+			name: "sealed secret with truncated payload",
+			manifests: `
+apiVersion: bitnami.com/v1alpha1
+kind: SealedSecret
+metadata:
+  name: mysecret
+spec:
+  encryptedData:
+    password: AAlrZXk=
+`,
+			wantErr: true,
+		},
+		{
+			name: "valid external secret",
+			manifests: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: mysecret
+spec:
+  refreshInterval: 1h
+  secretStoreRef:
+    kind: ClusterSecretStore
+    name: vault
+  data:
+    - secretKey: password
+      remoteRef:
+        key: secret/db
+        property: password
+`,
+		},
+		{
+			name: "external secret without remote key",
+			manifests: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: mysecret
+spec:
+  secretStoreRef:
+    name: vault
+  data:
+    - secretKey: password
+`,
+			wantErr: true,
+		},
+		{
+			name: "external secret without secret store",
+			manifests: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: mysecret
+spec:
+  data:
+    - secretKey: password
+      remoteRef:
+        key: secret/db
+`,
+			wantErr: true,
+		},
+		{
+			name: "external secret without data",
+			manifests: `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: mysecret
+spec:
+  secretStoreRef:
+    name: vault
+`,
+			wantErr: true,
+		},
+		{
+			name: "other kinds are not validated",
+			manifests: `
+apiVersion: example.com/v1
+kind: SealedSecret
+metadata:
+  name: mysecret
+spec:
+  encryptedData:
+    password: not-sealed!
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifests)
+			require.NoError(t, err)
+
+			err = validateSecretManifests(manifests)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDiffExternalSecretRefreshInterval(t *testing.T) {
+	t.Parallel()
+
+	const format = `
+apiVersion: external-secrets.io/v1beta1
+kind: ExternalSecret
+metadata:
+  name: mysecret
+spec:
+  refreshInterval: %s
+  secretStoreRef:
+    name: vault
+  data:
+    - secretKey: password
+      remoteRef:
+        key: secret/db
+`
+	testcases := []struct {
+		name    string
+		old     string
+		new     string
+		hasDiff bool
+	}{
+		{
+			name: "same duration in different formats",
+			old:  "1h0m0s",
+			new:  "1h",
+		},
+		{
+			name:    "different durations",
+			old:     "1h0m0s",
+			new:     "30m",
+			hasDiff: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			olds, err := ParseManifests(fmt.Sprintf(format, tc.old))
+			require.NoError(t, err)
+			news, err := ParseManifests(fmt.Sprintf(format, tc.new))
+			require.NoError(t, err)
+
+			result, err := Diff(olds[0], news[0], zap.NewNop())
+			require.NoError(t, err)
+			assert.Equal(t, tc.hasDiff, result.HasDiff())
+		})
+	}
+}
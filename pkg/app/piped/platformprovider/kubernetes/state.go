@@ -27,15 +27,19 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/kubernetes/scheme"
 
+	"github.com/pipe-cd/pipecd/pkg/config"
 	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
-func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.Unstructured, now time.Time) model.KubernetesResourceState {
+// MakeKubernetesResourceState builds the state of the given resource.
+// The given health rules are used to determine the health status of resources
+// that matches one of them instead of the built-in assessment.
+func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.Unstructured, healthRules []config.KubernetesResourceHealthRule, now time.Time) model.KubernetesResourceState {
 	var (
 		owners       = obj.GetOwnerReferences()
 		ownerIDs     = make([]string, 0, len(owners))
 		creationTime = obj.GetCreationTimestamp()
-		status, desc = determineResourceHealth(key, obj)
+		status, desc = determineResourceHealthWithRules(key, obj, healthRules)
 	)
 
 	for _, owner := range owners {
@@ -59,10 +63,123 @@ func MakeKubernetesResourceState(uid string, key ResourceKey, obj *unstructured.
 		CreatedAt: creationTime.Unix(),
 		UpdatedAt: now.Unix(),
 	}
+	if key.Kind == KindPod {
+		state.PodState = makeKubernetesPodState(obj)
+	}
 
 	return state
 }
 
+// makeKubernetesPodState builds the phase and the container states of the given pod.
+// Nil is returned when the given object can not be converted to a pod.
+func makeKubernetesPodState(obj *unstructured.Unstructured) *model.KubernetesPodState {
+	p := &corev1.Pod{}
+	if err := scheme.Scheme.Convert(obj, p, nil); err != nil {
+		return nil
+	}
+
+	statuses := make([]corev1.ContainerStatus, 0, len(p.Status.InitContainerStatuses)+len(p.Status.ContainerStatuses))
+	statuses = append(statuses, p.Status.InitContainerStatuses...)
+	statuses = append(statuses, p.Status.ContainerStatuses...)
+
+	containers := make([]*model.KubernetesContainerState, 0, len(statuses))
+	for _, cs := range statuses {
+		c := &model.KubernetesContainerState{
+			Name:         cs.Name,
+			Ready:        cs.Ready,
+			RestartCount: cs.RestartCount,
+		}
+		switch {
+		case cs.State.Waiting != nil:
+			c.State = "Waiting"
+			c.Reason = cs.State.Waiting.Reason
+			c.Message = cs.State.Waiting.Message
+		case cs.State.Terminated != nil:
+			c.State = "Terminated"
+			c.Reason = cs.State.Terminated.Reason
+			c.Message = cs.State.Terminated.Message
+		case cs.State.Running != nil:
+			c.State = "Running"
+			// Show why the container was restarted last time.
+			if t := cs.LastTerminationState.Terminated; t != nil {
+				c.Reason = t.Reason
+				c.Message = t.Message
+			}
+		}
+		containers = append(containers, c)
+	}
+
+	return &model.KubernetesPodState{
+		Phase:      string(p.Status.Phase),
+		Containers: containers,
+	}
+}
+
+func determineResourceHealthWithRules(key ResourceKey, obj *unstructured.Unstructured, rules []config.KubernetesResourceHealthRule) (status model.KubernetesResourceState_HealthStatus, desc string) {
+	for _, r := range rules {
+		if r.APIVersion == key.APIVersion && r.Kind == key.Kind {
+			return determineHealthByRule(r, obj)
+		}
+	}
+	return determineResourceHealth(key, obj)
+}
+
+// determineHealthByRule reports HEALTHY only when all checks of the given rule pass.
+func determineHealthByRule(rule config.KubernetesResourceHealthRule, obj *unstructured.Unstructured) (status model.KubernetesResourceState_HealthStatus, desc string) {
+	status = model.KubernetesResourceState_OTHER
+	for _, c := range rule.Checks {
+		if c.Condition != "" {
+			expected := c.Value
+			if expected == "" {
+				expected = "True"
+			}
+			actual, ok := findStatusCondition(obj, c.Condition)
+			if !ok {
+				desc = fmt.Sprintf("Condition %q was not found in status.conditions", c.Condition)
+				return
+			}
+			if actual != expected {
+				desc = fmt.Sprintf("Condition %q is %q, expected %q", c.Condition, actual, expected)
+				return
+			}
+			continue
+		}
+
+		v, ok, err := unstructured.NestedFieldNoCopy(obj.Object, strings.Split(c.Field, ".")...)
+		if err != nil || !ok {
+			desc = fmt.Sprintf("Field %q was not found", c.Field)
+			return
+		}
+		if actual := fmt.Sprint(v); actual != c.Value {
+			desc = fmt.Sprintf("Field %q is %q, expected %q", c.Field, actual, c.Value)
+			return
+		}
+	}
+
+	status = model.KubernetesResourceState_HEALTHY
+	return
+}
+
+// findStatusCondition returns the status of the item in "status.conditions" with the given type.
+func findStatusCondition(obj *unstructured.Unstructured, conditionType string) (string, bool) {
+	conditions, ok, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !ok {
+		return "", false
+	}
+	for _, c := range conditions {
+		m, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if t, _, _ := unstructured.NestedString(m, "type"); t != conditionType {
+			continue
+		}
+		s, _, _ := unstructured.NestedString(m, "status")
+		return s, true
+	}
+	return "", false
+}
+
 func determineResourceHealth(key ResourceKey, obj *unstructured.Unstructured) (status model.KubernetesResourceState_HealthStatus, desc string) {
 	if !IsKubernetesBuiltInResource(key.APIVersion) {
 		desc = fmt.Sprintf("\"%s/%s\" was applied successfully but its health status couldn't be determined exactly. (Because tracking status for this kind of resource is not supported yet.)", key.APIVersion, key.Kind)
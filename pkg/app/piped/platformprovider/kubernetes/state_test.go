@@ -0,0 +1,185 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDetermineResourceHealthWithRules(t *testing.T) {
+	t.Parallel()
+
+	rules := []config.KubernetesResourceHealthRule{
+		{
+			APIVersion: "cert-manager.io/v1",
+			Kind:       "Certificate",
+			Checks:     []config.KubernetesResourceHealthCheck{{Condition: "Ready"}},
+		},
+		{
+			APIVersion: "example.com/v1",
+			Kind:       "Database",
+			Checks: []config.KubernetesResourceHealthCheck{
+				{Field: "status.phase", Value: "Running"},
+				{Field: "status.replicas", Value: "3"},
+			},
+		},
+	}
+
+	testcases := []struct {
+		name           string
+		obj            map[string]interface{}
+		expectedStatus model.KubernetesResourceState_HealthStatus
+	}{
+		{
+			name: "condition is true",
+			obj: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Issuing", "status": "False"},
+						map[string]interface{}{"type": "Ready", "status": "True"},
+					},
+				},
+			},
+			expectedStatus: model.KubernetesResourceState_HEALTHY,
+		},
+		{
+			name: "condition is false",
+			obj: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+				"status": map[string]interface{}{
+					"conditions": []interface{}{
+						map[string]interface{}{"type": "Ready", "status": "False"},
+					},
+				},
+			},
+			expectedStatus: model.KubernetesResourceState_OTHER,
+		},
+		{
+			name: "condition is missing",
+			obj: map[string]interface{}{
+				"apiVersion": "cert-manager.io/v1",
+				"kind":       "Certificate",
+			},
+			expectedStatus: model.KubernetesResourceState_OTHER,
+		},
+		{
+			name: "all fields match",
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"status": map[string]interface{}{
+					"phase":    "Running",
+					"replicas": int64(3),
+				},
+			},
+			expectedStatus: model.KubernetesResourceState_HEALTHY,
+		},
+		{
+			name: "one field does not match",
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Database",
+				"status": map[string]interface{}{
+					"phase":    "Running",
+					"replicas": int64(1),
+				},
+			},
+			expectedStatus: model.KubernetesResourceState_OTHER,
+		},
+		{
+			name: "no matching rule",
+			obj: map[string]interface{}{
+				"apiVersion": "example.com/v1",
+				"kind":       "Cache",
+			},
+			expectedStatus: model.KubernetesResourceState_UNKNOWN,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := &unstructured.Unstructured{Object: tc.obj}
+			status, _ := determineResourceHealthWithRules(MakeResourceKey(obj), obj, rules)
+			assert.Equal(t, tc.expectedStatus, status)
+		})
+	}
+}
+
+func TestMakeKubernetesPodState(t *testing.T) {
+	t.Parallel()
+
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata": map[string]interface{}{
+			"name": "simple",
+		},
+		"status": map[string]interface{}{
+			"phase": "Running",
+			"initContainerStatuses": []interface{}{
+				map[string]interface{}{
+					"name":  "init",
+					"ready": true,
+					"state": map[string]interface{}{
+						"terminated": map[string]interface{}{"reason": "Completed", "exitCode": int64(0)},
+					},
+				},
+			},
+			"containerStatuses": []interface{}{
+				map[string]interface{}{
+					"name":         "app",
+					"ready":        false,
+					"restartCount": int64(3),
+					"state": map[string]interface{}{
+						"waiting": map[string]interface{}{"reason": "CrashLoopBackOff", "message": "back-off restarting failed container"},
+					},
+				},
+				map[string]interface{}{
+					"name":         "sidecar",
+					"ready":        true,
+					"restartCount": int64(1),
+					"state": map[string]interface{}{
+						"running": map[string]interface{}{},
+					},
+					"lastState": map[string]interface{}{
+						"terminated": map[string]interface{}{"reason": "OOMKilled", "exitCode": int64(137)},
+					},
+				},
+			},
+		},
+	}}
+
+	expected := &model.KubernetesPodState{
+		Phase: "Running",
+		Containers: []*model.KubernetesContainerState{
+			{Name: "init", Ready: true, State: "Terminated", Reason: "Completed"},
+			{Name: "app", RestartCount: 3, State: "Waiting", Reason: "CrashLoopBackOff", Message: "back-off restarting failed container"},
+			{Name: "sidecar", Ready: true, RestartCount: 1, State: "Running", Reason: "OOMKilled"},
+		},
+	}
+	assert.Equal(t, expected, makeKubernetesPodState(obj))
+}
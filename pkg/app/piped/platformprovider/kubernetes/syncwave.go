@@ -0,0 +1,90 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// defaultSyncWaves contains the sync waves of the kinds that other resources commonly depend on.
+// They are used when the resource does not have the sync-wave annotation.
+var defaultSyncWaves = map[string]int{
+	"Namespace":                  -1,
+	KindCustomResourceDefinition: -1,
+}
+
+// SyncWave is a group of manifests that should be applied together.
+type SyncWave struct {
+	Wave      int
+	Manifests []Manifest
+}
+
+// GroupManifestsBySyncWave groups the given manifests by their sync waves
+// and returns the groups in ascending order of the wave.
+// The order of manifests in each group follows the given order.
+func GroupManifestsBySyncWave(manifests []Manifest) ([]SyncWave, error) {
+	var (
+		waves  = make(map[int][]Manifest)
+		orders = make([]int, 0)
+	)
+	for _, m := range manifests {
+		wave, err := syncWaveOf(m)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := waves[wave]; !ok {
+			orders = append(orders, wave)
+		}
+		waves[wave] = append(waves[wave], m)
+	}
+	sort.Ints(orders)
+
+	out := make([]SyncWave, 0, len(orders))
+	for _, w := range orders {
+		out = append(out, SyncWave{Wave: w, Manifests: waves[w]})
+	}
+	return out, nil
+}
+
+func syncWaveOf(m Manifest) (int, error) {
+	v, ok := m.GetAnnotations()[AnnotationSyncWave]
+	if !ok {
+		return defaultSyncWaves[m.Key.Kind], nil
+	}
+	wave, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s annotation %q in %s", AnnotationSyncWave, v, m.Key.ReadableString())
+	}
+	return wave, nil
+}
+
+// IsEstablished reports whether the given live resource is ready to be used by other resources.
+// Only CustomResourceDefinition and Namespace are checked, other resources are considered as established once applied.
+func IsEstablished(m Manifest) bool {
+	switch m.Key.Kind {
+	case KindCustomResourceDefinition:
+		status, ok := findStatusCondition(m.u, "Established")
+		return ok && status == "True"
+	case "Namespace":
+		phase, _, _ := unstructured.NestedString(m.u.Object, "status", "phase")
+		return phase == "Active"
+	default:
+		return true
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGroupManifestsBySyncWave(t *testing.T) {
+	t.Parallel()
+
+	manifests, err := ParseManifests(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: example.com/v1
+kind: Foo
+metadata:
+  name: foo
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingWebhookConfiguration
+metadata:
+  name: webhook
+  annotations:
+    pipecd.dev/sync-wave: "-1"
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+---
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: post-job
+  annotations:
+    pipecd.dev/sync-wave: "1"
+`)
+	require.NoError(t, err)
+
+	waves, err := GroupManifestsBySyncWave(manifests)
+	require.NoError(t, err)
+
+	names := make(map[int][]string, len(waves))
+	orders := make([]int, 0, len(waves))
+	for _, w := range waves {
+		orders = append(orders, w.Wave)
+		for _, m := range w.Manifests {
+			names[w.Wave] = append(names[w.Wave], m.Key.Name)
+		}
+	}
+	assert.Equal(t, []int{-1, 0, 1}, orders)
+	assert.Equal(t, []string{"webhook", "foos.example.com", "ns"}, names[-1])
+	assert.Equal(t, []string{"app", "foo"}, names[0])
+	assert.Equal(t, []string{"post-job"}, names[1])
+
+	invalid, err := ParseManifests(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+  annotations:
+    pipecd.dev/sync-wave: "first"
+`)
+	require.NoError(t, err)
+	_, err = GroupManifestsBySyncWave(invalid)
+	assert.Error(t, err)
+}
+
+func TestIsEstablished(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		manifest string
+		expected bool
+	}{
+		{
+			name: "established crd",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+status:
+  conditions:
+    - type: NamesAccepted
+      status: "True"
+    - type: Established
+      status: "True"
+`,
+			expected: true,
+		},
+		{
+			name: "not yet established crd",
+			manifest: `
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: foos.example.com
+status:
+  conditions:
+    - type: Established
+      status: "False"
+`,
+			expected: false,
+		},
+		{
+			name: "active namespace",
+			manifest: `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns
+status:
+  phase: Active
+`,
+			expected: true,
+		},
+		{
+			name: "namespace without status",
+			manifest: `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: ns
+`,
+			expected: false,
+		},
+		{
+			name: "other kinds",
+			manifest: `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`,
+			expected: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			manifests, err := ParseManifests(tc.manifest)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, IsEstablished(manifests[0]))
+		})
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+
+	"go.uber.org/zap"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// Kubeconform validates manifests against the schemas of Kubernetes resources.
+type Kubeconform struct {
+	version  string
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewKubeconform(version, path string, logger *zap.Logger) *Kubeconform {
+	return &Kubeconform{
+		version:  version,
+		execPath: path,
+		logger:   logger,
+	}
+}
+
+// Validate validates all manifests in the given file.
+// The returned output contains the reasons of all invalid resources.
+func (c *Kubeconform) Validate(ctx context.Context, path string, opts config.K8sValidateStageOptions) (string, error) {
+	args := kubeconformArgs(path, opts)
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	c.logger.Info("start validating manifests by kubeconform", zap.Any("args", args))
+	if err := cmd.Run(); err != nil {
+		return out.String(), fmt.Errorf("failed to validate manifests: %w", err)
+	}
+	return out.String(), nil
+}
+
+func kubeconformArgs(path string, opts config.K8sValidateStageOptions) []string {
+	args := []string{"-summary"}
+	if opts.KubernetesVersion != "" {
+		args = append(args, "-kubernetes-version", opts.KubernetesVersion)
+	}
+	if len(opts.SchemaLocations) > 0 {
+		// The default location must be specified explicitly
+		// to keep validating the built-in resources.
+		args = append(args, "-schema-location", "default")
+		for _, l := range opts.SchemaLocations {
+			args = append(args, "-schema-location", l)
+		}
+	}
+	if !opts.FailOnMissingSchemas {
+		args = append(args, "-ignore-missing-schemas")
+	}
+	return append(args, path)
+}
+
+// Conftest checks manifests against the OPA policies written in Rego.
+type Conftest struct {
+	version  string
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewConftest(version, path string, logger *zap.Logger) *Conftest {
+	return &Conftest{
+		version:  version,
+		execPath: path,
+		logger:   logger,
+	}
+}
+
+// Test checks all manifests in the given file against the policies in the given directories.
+// The returned output contains all failures and warnings reported by the policies.
+func (c *Conftest) Test(ctx context.Context, path string, policyDirs []string) (string, error) {
+	args := conftestArgs(path, policyDirs)
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	c.logger.Info("start checking manifests by conftest", zap.Any("args", args))
+	if err := cmd.Run(); err != nil {
+		return out.String(), fmt.Errorf("failed to check policies: %w", err)
+	}
+	return out.String(), nil
+}
+
+func conftestArgs(path string, policyDirs []string) []string {
+	args := []string{"test", "--all-namespaces", "--no-color"}
+	for _, d := range policyDirs {
+		args = append(args, "--policy", d)
+	}
+	return append(args, path)
+}
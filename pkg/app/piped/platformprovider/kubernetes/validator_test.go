@@ -0,0 +1,89 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kubernetes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+func TestKubeconformArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		opts     config.K8sValidateStageOptions
+		expected []string
+	}{
+		{
+			name:     "default options",
+			expected: []string{"-summary", "-ignore-missing-schemas", "manifests.yaml"},
+		},
+		{
+			name: "with kubernetes version",
+			opts: config.K8sValidateStageOptions{
+				KubernetesVersion: "1.27.0",
+			},
+			expected: []string{"-summary", "-kubernetes-version", "1.27.0", "-ignore-missing-schemas", "manifests.yaml"},
+		},
+		{
+			name: "with additional schema locations",
+			opts: config.K8sValidateStageOptions{
+				SchemaLocations:      []string{"schemas/{{ .ResourceKind }}.json"},
+				FailOnMissingSchemas: true,
+			},
+			expected: []string{"-summary", "-schema-location", "default", "-schema-location", "schemas/{{ .ResourceKind }}.json", "manifests.yaml"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			args := kubeconformArgs("manifests.yaml", tc.opts)
+			assert.Equal(t, tc.expected, args)
+		})
+	}
+}
+
+func TestConftestArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		policyDirs []string
+		expected   []string
+	}{
+		{
+			name:     "no policy directory",
+			expected: []string{"test", "--all-namespaces", "--no-color", "manifests.yaml"},
+		},
+		{
+			name:       "multiple policy directories",
+			policyDirs: []string{"app/policies", "/etc/piped/policies"},
+			expected:   []string{"test", "--all-namespaces", "--no-color", "--policy", "app/policies", "--policy", "/etc/piped/policies", "manifests.yaml"},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			args := conftestArgs("manifests.yaml", tc.policyDirs)
+			assert.Equal(t, tc.expected, args)
+		})
+	}
+}
@@ -16,17 +16,23 @@ package lambda
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/backoff"
@@ -44,8 +50,11 @@ const (
 var ErrNotFound = errors.New("lambda resource not found")
 
 type client struct {
-	client *lambda.Client
-	logger *zap.Logger
+	client           *lambda.Client
+	s3Client         *s3.Client
+	cloudWatchClient *cloudwatch.Client
+	awsCfg           aws.Config
+	logger           *zap.Logger
 }
 
 func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
@@ -88,6 +97,9 @@ func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logg
 		return nil, fmt.Errorf("failed to load config to create lambda client: %w", err)
 	}
 	c.client = lambda.NewFromConfig(cfg)
+	c.s3Client = s3.NewFromConfig(cfg)
+	c.cloudWatchClient = cloudwatch.NewFromConfig(cfg)
+	c.awsCfg = cfg
 
 	return c, nil
 }
@@ -108,6 +120,36 @@ func (c *client) IsFunctionExist(ctx context.Context, name string) (bool, error)
 	return true, nil
 }
 
+func (c *client) GetFunction(ctx context.Context, name string) (FunctionManifestSpec, error) {
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+	}
+	output, err := c.client.GetFunction(ctx, input)
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return FunctionManifestSpec{}, ErrNotFound
+		}
+		return FunctionManifestSpec{}, fmt.Errorf("failed to get function %s: %w", name, err)
+	}
+
+	spec := FunctionManifestSpec{Name: name}
+	if cfg := output.Configuration; cfg != nil {
+		spec.Role = aws.ToString(cfg.Role)
+		spec.Handler = aws.ToString(cfg.Handler)
+		spec.Runtime = string(cfg.Runtime)
+		spec.Memory = aws.ToInt32(cfg.MemorySize)
+		spec.Timeout = aws.ToInt32(cfg.Timeout)
+		if cfg.Environment != nil {
+			spec.Environments = cfg.Environment.Variables
+		}
+	}
+	if output.Code != nil {
+		spec.ImageURI = aws.ToString(output.Code.ImageUri)
+	}
+	return spec, nil
+}
+
 func (c *client) CreateFunction(ctx context.Context, fm FunctionManifest) error {
 	input := &lambda.CreateFunctionInput{
 		FunctionName: aws.String(fm.Spec.Name),
@@ -118,6 +160,7 @@ func (c *client) CreateFunction(ctx context.Context, fm FunctionManifest) error
 		Environment: &types.Environment{
 			Variables: fm.Spec.Environments,
 		},
+		Layers: fm.Spec.Layers,
 	}
 	if len(fm.Spec.Architectures) != 0 {
 		var architectures []types.Architecture
@@ -155,11 +198,14 @@ func (c *client) CreateFunction(ctx context.Context, fm FunctionManifest) error
 		input.Handler = aws.String(fm.Spec.Handler)
 		input.Runtime = types.Runtime(fm.Spec.Runtime)
 	}
-	_, err := c.client.CreateFunction(ctx, input)
+	if err := c.verifyS3Package(ctx, fm); err != nil {
+		return err
+	}
+	output, err := c.client.CreateFunction(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to create Lambda function %s: %w", fm.Spec.Name, err)
 	}
-	return nil
+	return verifyCodeSha256(fm, aws.ToString(output.CodeSha256))
 }
 
 func (c *client) CreateFunctionFromSource(ctx context.Context, fm FunctionManifest, zip io.Reader) error {
@@ -183,6 +229,7 @@ func (c *client) CreateFunctionFromSource(ctx context.Context, fm FunctionManife
 		Environment: &types.Environment{
 			Variables: fm.Spec.Environments,
 		},
+		Layers: fm.Spec.Layers,
 	}
 	if len(fm.Spec.Architectures) != 0 {
 		architectures := make([]types.Architecture, 0, len(fm.Spec.Architectures))
@@ -210,6 +257,10 @@ func (c *client) CreateFunctionFromSource(ctx context.Context, fm FunctionManife
 }
 
 func (c *client) UpdateFunction(ctx context.Context, fm FunctionManifest) error {
+	// Verify the package before changing anything of the function.
+	if err := c.verifyS3Package(ctx, fm); err != nil {
+		return err
+	}
 	// UpdateFunctionConfiguration must be called before UpdateFunctionCode.
 	// Lambda has named by state.
 	// If Lambda's state is pending, UpdateFunctionConfiguration is failed. This error is explained as a ResourceConflictException.
@@ -239,10 +290,14 @@ func (c *client) UpdateFunction(ctx context.Context, fm FunctionManifest) error
 		}
 		codeInput.Architectures = architectures
 	}
-	_, err := c.client.UpdateFunctionCode(ctx, codeInput)
+	output, err := c.client.UpdateFunctionCode(ctx, codeInput)
 	if err != nil {
 		return fmt.Errorf("failed to update function code for Lambda function %s: %w", fm.Spec.Name, err)
 	}
+	// The package might be replaced after the verification, so check the deployed one as well.
+	if err := verifyCodeSha256(fm, aws.ToString(output.CodeSha256)); err != nil {
+		return err
+	}
 
 	// Tag/Untag function if necessary.
 	return c.updateTagsConfig(ctx, fm)
@@ -280,6 +335,51 @@ func (c *client) UpdateFunctionFromSource(ctx context.Context, fm FunctionManife
 	return c.updateTagsConfig(ctx, fm)
 }
 
+// verifyS3Package verifies that the checksum of the zip package stored in S3
+// matches the expected one specified in the function manifest.
+func (c *client) verifyS3Package(ctx context.Context, fm FunctionManifest) error {
+	if fm.Spec.S3Sha256 == "" {
+		return nil
+	}
+	input := &s3.GetObjectInput{
+		Bucket: aws.String(fm.Spec.S3Bucket),
+		Key:    aws.String(fm.Spec.S3Key),
+	}
+	if fm.Spec.S3ObjectVersion != "" {
+		input.VersionId = aws.String(fm.Spec.S3ObjectVersion)
+	}
+	output, err := c.s3Client.GetObject(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to get the package s3://%s/%s of Lambda function %s: %w", fm.Spec.S3Bucket, fm.Spec.S3Key, fm.Spec.Name, err)
+	}
+	defer output.Body.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, output.Body); err != nil {
+		return fmt.Errorf("failed to read the package s3://%s/%s of Lambda function %s: %w", fm.Spec.S3Bucket, fm.Spec.S3Key, fm.Spec.Name, err)
+	}
+	if sum := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(sum, fm.Spec.S3Sha256) {
+		return fmt.Errorf("checksum mismatch of the package s3://%s/%s of Lambda function %s: expected %s, got %s", fm.Spec.S3Bucket, fm.Spec.S3Key, fm.Spec.Name, fm.Spec.S3Sha256, sum)
+	}
+	return nil
+}
+
+// verifyCodeSha256 verifies that the base64-encoded checksum of the deployed code
+// reported by Lambda matches the expected one specified in the function manifest.
+func verifyCodeSha256(fm FunctionManifest, codeSha256 string) error {
+	if fm.Spec.S3Sha256 == "" {
+		return nil
+	}
+	expected, err := hex.DecodeString(fm.Spec.S3Sha256)
+	if err != nil {
+		return fmt.Errorf("invalid s3Sha256 of Lambda function %s: %w", fm.Spec.Name, err)
+	}
+	if base64.StdEncoding.EncodeToString(expected) != codeSha256 {
+		return fmt.Errorf("checksum mismatch of the deployed code of Lambda function %s: expected %s, got %s", fm.Spec.Name, base64.StdEncoding.EncodeToString(expected), codeSha256)
+	}
+	return nil
+}
+
 func (c *client) updateFunctionConfiguration(ctx context.Context, fm FunctionManifest) error {
 	retry := backoff.NewRetry(RequestRetryTime, backoff.NewConstant(RetryIntervalDuration))
 	updateFunctionConfigurationSucceed := false
@@ -305,6 +405,11 @@ func (c *client) updateFunctionConfiguration(ctx context.Context, fm FunctionMan
 				Size: aws.Int32(fm.Spec.EphemeralStorage.Size),
 			}
 		}
+		// Only update the layers when they are specified to keep
+		// the ones attached outside of PipeCD unchanged.
+		if fm.Spec.Layers != nil {
+			configInput.Layers = fm.Spec.Layers
+		}
 		if fm.Spec.VPCConfig != nil {
 			configInput.VpcConfig = &types.VpcConfig{
 				SecurityGroupIds: fm.Spec.VPCConfig.SecurityGroupIDs,
@@ -353,6 +458,73 @@ func (c *client) PublishFunction(ctx context.Context, fm FunctionManifest) (stri
 	return aws.ToString(cfg.Version), nil
 }
 
+// ListFunctionVersions returns all the published versions of the function, excluding $LATEST.
+func (c *client) ListFunctionVersions(ctx context.Context, fm FunctionManifest) ([]string, error) {
+	var (
+		versions []string
+		marker   *string
+	)
+	for {
+		output, err := c.client.ListVersionsByFunction(ctx, &lambda.ListVersionsByFunctionInput{
+			FunctionName: aws.String(fm.Spec.Name),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list versions of Lambda function %s: %w", fm.Spec.Name, err)
+		}
+		for _, v := range output.Versions {
+			if version := aws.ToString(v.Version); version != "$LATEST" {
+				versions = append(versions, version)
+			}
+		}
+		if output.NextMarker == nil {
+			return versions, nil
+		}
+		marker = output.NextMarker
+	}
+}
+
+// ListAliasedVersions returns the versions of the function which are routed by any of its aliases.
+func (c *client) ListAliasedVersions(ctx context.Context, fm FunctionManifest) (map[string]struct{}, error) {
+	var (
+		versions = make(map[string]struct{})
+		marker   *string
+	)
+	for {
+		output, err := c.client.ListAliases(ctx, &lambda.ListAliasesInput{
+			FunctionName: aws.String(fm.Spec.Name),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list aliases of Lambda function %s: %w", fm.Spec.Name, err)
+		}
+		for _, a := range output.Aliases {
+			versions[aws.ToString(a.FunctionVersion)] = struct{}{}
+			if a.RoutingConfig == nil {
+				continue
+			}
+			for v := range a.RoutingConfig.AdditionalVersionWeights {
+				versions[v] = struct{}{}
+			}
+		}
+		if output.NextMarker == nil {
+			return versions, nil
+		}
+		marker = output.NextMarker
+	}
+}
+
+func (c *client) DeleteFunctionVersion(ctx context.Context, fm FunctionManifest, version string) error {
+	input := &lambda.DeleteFunctionInput{
+		FunctionName: aws.String(fm.Spec.Name),
+		Qualifier:    aws.String(version),
+	}
+	if _, err := c.client.DeleteFunction(ctx, input); err != nil {
+		return fmt.Errorf("failed to delete version %s of Lambda function %s: %w", version, fm.Spec.Name, err)
+	}
+	return nil
+}
+
 // GetTrafficConfig returns lambda provider.ErrNotFound in case remote traffic config is not existed.
 func (c *client) GetTrafficConfig(ctx context.Context, fm FunctionManifest) (routingTrafficCfg RoutingTrafficConfig, err error) {
 	input := &lambda.GetAliasInput{
@@ -457,6 +629,244 @@ func (c *client) UpdateTrafficConfig(ctx context.Context, fm FunctionManifest, r
 	return nil
 }
 
+// ProvisionedConcurrency represents the provisioned concurrency configuration of the alias.
+type ProvisionedConcurrency struct {
+	Requested int32
+	Allocated int32
+	Available int32
+	// One of IN_PROGRESS, READY and FAILED.
+	Status string
+	Reason string
+}
+
+// Ready reports whether all the requested concurrency has been allocated.
+func (p ProvisionedConcurrency) Ready() bool {
+	return p.Status == string(types.ProvisionedConcurrencyStatusEnumReady)
+}
+
+// Failed reports whether the allocation of the requested concurrency has failed.
+func (p ProvisionedConcurrency) Failed() bool {
+	return p.Status == string(types.ProvisionedConcurrencyStatusEnumFailed)
+}
+
+func (c *client) PutProvisionedConcurrency(ctx context.Context, fm FunctionManifest, concurrency int32) error {
+	input := &lambda.PutProvisionedConcurrencyConfigInput{
+		FunctionName:                    aws.String(fm.Spec.Name),
+		Qualifier:                       aws.String(defaultAliasName),
+		ProvisionedConcurrentExecutions: aws.Int32(concurrency),
+	}
+	if _, err := c.client.PutProvisionedConcurrencyConfig(ctx, input); err != nil {
+		return fmt.Errorf("failed to put provisioned concurrency for Lambda function %s: %w", fm.Spec.Name, err)
+	}
+	return nil
+}
+
+func (c *client) GetProvisionedConcurrency(ctx context.Context, fm FunctionManifest) (ProvisionedConcurrency, error) {
+	input := &lambda.GetProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(fm.Spec.Name),
+		Qualifier:    aws.String(defaultAliasName),
+	}
+	output, err := c.client.GetProvisionedConcurrencyConfig(ctx, input)
+	if err != nil {
+		var nfe *types.ProvisionedConcurrencyConfigNotFoundException
+		if errors.As(err, &nfe) {
+			return ProvisionedConcurrency{}, ErrNotFound
+		}
+		return ProvisionedConcurrency{}, fmt.Errorf("failed to get provisioned concurrency of Lambda function %s: %w", fm.Spec.Name, err)
+	}
+	return ProvisionedConcurrency{
+		Requested: aws.ToInt32(output.RequestedProvisionedConcurrentExecutions),
+		Allocated: aws.ToInt32(output.AllocatedProvisionedConcurrentExecutions),
+		Available: aws.ToInt32(output.AvailableProvisionedConcurrentExecutions),
+		Status:    string(output.Status),
+		Reason:    aws.ToString(output.StatusReason),
+	}, nil
+}
+
+func (c *client) DeleteProvisionedConcurrency(ctx context.Context, fm FunctionManifest) error {
+	input := &lambda.DeleteProvisionedConcurrencyConfigInput{
+		FunctionName: aws.String(fm.Spec.Name),
+		Qualifier:    aws.String(defaultAliasName),
+	}
+	if _, err := c.client.DeleteProvisionedConcurrencyConfig(ctx, input); err != nil {
+		var nfe *types.ProvisionedConcurrencyConfigNotFoundException
+		if errors.As(err, &nfe) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete provisioned concurrency of Lambda function %s: %w", fm.Spec.Name, err)
+	}
+	return nil
+}
+
+// Layer represents the content of a Lambda layer to be published.
+type Layer struct {
+	Name string
+	// The zip archive of the layer content.
+	// This is used when the layer is not stored in S3.
+	ZipFile                 []byte
+	S3Bucket                string
+	S3Key                   string
+	S3ObjectVersion         string
+	CompatibleRuntimes      []string
+	CompatibleArchitectures []string
+}
+
+// LayerVersion represents a published version of a Lambda layer.
+type LayerVersion struct {
+	ARN string
+	// The base64-encoded SHA-256 hash of the layer archive.
+	CodeSha256 string
+}
+
+func (c *client) PublishLayerVersion(ctx context.Context, layer Layer) (LayerVersion, error) {
+	input := &lambda.PublishLayerVersionInput{
+		LayerName: aws.String(layer.Name),
+		Content:   &types.LayerVersionContentInput{},
+	}
+	if len(layer.ZipFile) != 0 {
+		input.Content.ZipFile = layer.ZipFile
+	} else {
+		input.Content.S3Bucket = aws.String(layer.S3Bucket)
+		input.Content.S3Key = aws.String(layer.S3Key)
+		if layer.S3ObjectVersion != "" {
+			input.Content.S3ObjectVersion = aws.String(layer.S3ObjectVersion)
+		}
+	}
+	for _, r := range layer.CompatibleRuntimes {
+		input.CompatibleRuntimes = append(input.CompatibleRuntimes, types.Runtime(r))
+	}
+	for _, a := range layer.CompatibleArchitectures {
+		input.CompatibleArchitectures = append(input.CompatibleArchitectures, types.Architecture(a))
+	}
+	output, err := c.client.PublishLayerVersion(ctx, input)
+	if err != nil {
+		return LayerVersion{}, fmt.Errorf("failed to publish version of Lambda layer %s: %w", layer.Name, err)
+	}
+	lv := LayerVersion{ARN: aws.ToString(output.LayerVersionArn)}
+	if output.Content != nil {
+		lv.CodeSha256 = aws.ToString(output.Content.CodeSha256)
+	}
+	return lv, nil
+}
+
+func (c *client) GetLatestLayerVersion(ctx context.Context, name string) (LayerVersion, error) {
+	// The versions are listed in the descending order of the version number.
+	listOutput, err := c.client.ListLayerVersions(ctx, &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(name),
+		MaxItems:  aws.Int32(1),
+	})
+	if err != nil {
+		var nfe *types.ResourceNotFoundException
+		if errors.As(err, &nfe) {
+			return LayerVersion{}, ErrNotFound
+		}
+		return LayerVersion{}, fmt.Errorf("failed to list versions of Lambda layer %s: %w", name, err)
+	}
+	if len(listOutput.LayerVersions) == 0 {
+		return LayerVersion{}, ErrNotFound
+	}
+
+	output, err := c.client.GetLayerVersion(ctx, &lambda.GetLayerVersionInput{
+		LayerName:     aws.String(name),
+		VersionNumber: listOutput.LayerVersions[0].Version,
+	})
+	if err != nil {
+		return LayerVersion{}, fmt.Errorf("failed to get version of Lambda layer %s: %w", name, err)
+	}
+	lv := LayerVersion{ARN: aws.ToString(output.LayerVersionArn)}
+	if output.Content != nil {
+		lv.CodeSha256 = aws.ToString(output.Content.CodeSha256)
+	}
+	return lv, nil
+}
+
+// LiveEventSourceMapping represents an event source mapping currently attached to the function alias.
+type LiveEventSourceMapping struct {
+	UUID string
+	EventSourceMapping
+}
+
+func (c *client) ListEventSourceMappings(ctx context.Context, fm FunctionManifest) ([]LiveEventSourceMapping, error) {
+	var (
+		mappings []LiveEventSourceMapping
+		marker   *string
+	)
+	for {
+		output, err := c.client.ListEventSourceMappings(ctx, &lambda.ListEventSourceMappingsInput{
+			FunctionName: aws.String(aliasedFunctionName(fm)),
+			Marker:       marker,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list event source mappings of Lambda function %s: %w", fm.Spec.Name, err)
+		}
+		for _, m := range output.EventSourceMappings {
+			state := aws.ToString(m.State)
+			enabled := state != "Disabled" && state != "Disabling"
+			mappings = append(mappings, LiveEventSourceMapping{
+				UUID: aws.ToString(m.UUID),
+				EventSourceMapping: EventSourceMapping{
+					EventSourceArn:   aws.ToString(m.EventSourceArn),
+					BatchSize:        aws.ToInt32(m.BatchSize),
+					Enabled:          aws.Bool(enabled),
+					StartingPosition: string(m.StartingPosition),
+				},
+			})
+		}
+		if output.NextMarker == nil {
+			return mappings, nil
+		}
+		marker = output.NextMarker
+	}
+}
+
+func (c *client) CreateEventSourceMapping(ctx context.Context, fm FunctionManifest, m EventSourceMapping) error {
+	input := &lambda.CreateEventSourceMappingInput{
+		FunctionName:   aws.String(aliasedFunctionName(fm)),
+		EventSourceArn: aws.String(m.EventSourceArn),
+		Enabled:        m.Enabled,
+	}
+	if m.BatchSize > 0 {
+		input.BatchSize = aws.Int32(m.BatchSize)
+	}
+	if m.StartingPosition != "" {
+		input.StartingPosition = types.EventSourcePosition(m.StartingPosition)
+	}
+	if _, err := c.client.CreateEventSourceMapping(ctx, input); err != nil {
+		return fmt.Errorf("failed to create event source mapping from %s to Lambda function %s: %w", m.EventSourceArn, fm.Spec.Name, err)
+	}
+	return nil
+}
+
+func (c *client) UpdateEventSourceMapping(ctx context.Context, fm FunctionManifest, uuid string, m EventSourceMapping) error {
+	input := &lambda.UpdateEventSourceMappingInput{
+		UUID:         aws.String(uuid),
+		FunctionName: aws.String(aliasedFunctionName(fm)),
+		Enabled:      aws.Bool(m.Enabled == nil || *m.Enabled),
+	}
+	if m.BatchSize > 0 {
+		input.BatchSize = aws.Int32(m.BatchSize)
+	}
+	if _, err := c.client.UpdateEventSourceMapping(ctx, input); err != nil {
+		return fmt.Errorf("failed to update event source mapping %s of Lambda function %s: %w", uuid, fm.Spec.Name, err)
+	}
+	return nil
+}
+
+func (c *client) DeleteEventSourceMapping(ctx context.Context, fm FunctionManifest, uuid string) error {
+	input := &lambda.DeleteEventSourceMappingInput{
+		UUID: aws.String(uuid),
+	}
+	if _, err := c.client.DeleteEventSourceMapping(ctx, input); err != nil {
+		return fmt.Errorf("failed to delete event source mapping %s of Lambda function %s: %w", uuid, fm.Spec.Name, err)
+	}
+	return nil
+}
+
+// aliasedFunctionName returns the qualified name of the alias which receives the traffic.
+func aliasedFunctionName(fm FunctionManifest) string {
+	return fm.Spec.Name + ":" + defaultAliasName
+}
+
 func (c *client) updateTagsConfig(ctx context.Context, fm FunctionManifest) error {
 	getFuncInput := &lambda.GetFunctionInput{
 		FunctionName: aws.String(fm.Spec.Name),
@@ -131,3 +131,42 @@ func TestMakeFlowControlTagsMap(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyCodeSha256(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		s3Sha256   string
+		codeSha256 string
+		wantErr    bool
+	}{
+		{
+			name:       "no checksum specified",
+			codeSha256: "any",
+			wantErr:    false,
+		},
+		{
+			name:       "matched",
+			s3Sha256:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			codeSha256: "n4bQgYhMfWWaL+qgxVrQFaO/TxsrC4Is0V1sFbDwCgg=",
+			wantErr:    false,
+		},
+		{
+			name:       "mismatched",
+			s3Sha256:   "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+			codeSha256: "47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU=",
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fm := FunctionManifest{Spec: FunctionManifestSpec{S3Sha256: tc.s3Sha256}}
+			err := verifyCodeSha256(fm, tc.codeSha256)
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+)
+
+const (
+	// AlarmStateAlarm is the state of the alarm whose metric is outside of the defined threshold.
+	AlarmStateAlarm = "ALARM"
+)
+
+// Alarm represents the current state of a CloudWatch metric alarm.
+type Alarm struct {
+	Name       string
+	MetricName string
+	State      string
+	Reason     string
+	UpdatedAt  time.Time
+}
+
+// Firing reports whether the alarm is in ALARM state.
+func (a Alarm) Firing() bool {
+	return a.State == AlarmStateAlarm
+}
+
+// DescribeAlarms returns the current states of the given CloudWatch metric alarms.
+func (c *client) DescribeAlarms(ctx context.Context, names []string) ([]Alarm, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	out, err := c.cloudWatchClient.DescribeAlarms(ctx, &cloudwatch.DescribeAlarmsInput{
+		AlarmNames: names,
+		MaxRecords: aws.Int32(int32(len(names))),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe alarms: %w", err)
+	}
+	return makeAlarms(out.MetricAlarms), nil
+}
+
+func makeAlarms(metricAlarms []types.MetricAlarm) []Alarm {
+	alarms := make([]Alarm, 0, len(metricAlarms))
+	for _, m := range metricAlarms {
+		alarms = append(alarms, Alarm{
+			Name:       aws.ToString(m.AlarmName),
+			MetricName: aws.ToString(m.MetricName),
+			State:      string(m.StateValue),
+			Reason:     aws.ToString(m.StateReason),
+			UpdatedAt:  aws.ToTime(m.StateUpdatedTimestamp),
+		})
+	}
+	return alarms
+}
@@ -0,0 +1,127 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestCloudWatchClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &client{
+		cloudWatchClient: cloudwatch.NewFromConfig(cfg, func(o *cloudwatch.Options) {
+			o.EndpointResolver = cloudwatch.EndpointResolverFromURL(ts.URL)
+		}),
+		logger: zap.NewNop(),
+	}
+}
+
+func TestDescribeAlarms(t *testing.T) {
+	t.Parallel()
+
+	data := `<DescribeAlarmsResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <DescribeAlarmsResult>
+    <MetricAlarms>
+      <member>
+        <AlarmName>function-errors</AlarmName>
+        <MetricName>Errors</MetricName>
+        <StateValue>ALARM</StateValue>
+        <StateReason>Threshold Crossed: 1 datapoint [5.0] was greater than the threshold (1.0).</StateReason>
+        <StateUpdatedTimestamp>2023-05-01T10:00:00.000Z</StateUpdatedTimestamp>
+      </member>
+      <member>
+        <AlarmName>function-throttles</AlarmName>
+        <MetricName>Throttles</MetricName>
+        <StateValue>OK</StateValue>
+        <StateReason>Threshold Crossed: no datapoints were received.</StateReason>
+      </member>
+    </MetricAlarms>
+  </DescribeAlarmsResult>
+  <ResponseMetadata>
+    <RequestId>f3b4c1a2-0000-0000-0000-000000000000</RequestId>
+  </ResponseMetadata>
+</DescribeAlarmsResponse>`
+
+	c := newTestCloudWatchClient(t, func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "DescribeAlarms", r.PostForm.Get("Action"))
+		assert.Equal(t, "function-errors", r.PostForm.Get("AlarmNames.member.1"))
+		assert.Equal(t, "function-throttles", r.PostForm.Get("AlarmNames.member.2"))
+		assert.Equal(t, "2", r.PostForm.Get("MaxRecords"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/monitoring/aws4_request")
+		io.WriteString(w, data)
+	})
+
+	alarms, err := c.DescribeAlarms(context.Background(), []string{"function-errors", "function-throttles"})
+	require.NoError(t, err)
+	expected := []Alarm{
+		{
+			Name:       "function-errors",
+			MetricName: "Errors",
+			State:      AlarmStateAlarm,
+			Reason:     "Threshold Crossed: 1 datapoint [5.0] was greater than the threshold (1.0).",
+			UpdatedAt:  time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			Name:       "function-throttles",
+			MetricName: "Throttles",
+			State:      "OK",
+			Reason:     "Threshold Crossed: no datapoints were received.",
+		},
+	}
+	assert.Equal(t, expected, alarms)
+	assert.True(t, alarms[0].Firing())
+	assert.False(t, alarms[1].Firing())
+}
+
+func TestDescribeAlarmsError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestCloudWatchClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `<ErrorResponse xmlns="http://monitoring.amazonaws.com/doc/2010-08-01/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>User is not authorized to perform: cloudwatch:DescribeAlarms</Message>
+  </Error>
+</ErrorResponse>`)
+	})
+
+	_, err := c.DescribeAlarms(context.Background(), []string{"function-errors"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "AccessDenied")
+
+	alarms, err := c.DescribeAlarms(context.Background(), nil)
+	require.NoError(t, err)
+	assert.Empty(t, alarms)
+}
@@ -0,0 +1,76 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// comparedFunction contains the fields of the function configuration checked by drift detection.
+type comparedFunction struct {
+	Image        string            `json:"image,omitempty"`
+	Role         string            `json:"role,omitempty"`
+	Memory       int32             `json:"memory,omitempty"`
+	Timeout      int32             `json:"timeout,omitempty"`
+	Environments map[string]string `json:"environments,omitempty"`
+}
+
+// DiffLiveFunction calculates the diff between the live configuration of the function and the one defined in Git.
+// The image, role, memory, timeout and environment variables are compared.
+// The image is compared only when the function is deployed from a container image
+// since the code of the other packages can not be known from the manifest.
+func DiffLiveFunction(live, expected FunctionManifestSpec) (*diff.Result, error) {
+	l := comparedFunction{
+		Role:         live.Role,
+		Memory:       live.Memory,
+		Timeout:      live.Timeout,
+		Environments: live.Environments,
+	}
+	e := comparedFunction{
+		Role:         expected.Role,
+		Memory:       expected.Memory,
+		Timeout:      expected.Timeout,
+		Environments: expected.Environments,
+	}
+	if expected.ImageURI != "" {
+		l.Image, e.Image = live.ImageURI, expected.ImageURI
+	}
+
+	lu, err := toUnstructured(l)
+	if err != nil {
+		return nil, err
+	}
+	eu, err := toUnstructured(e)
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(lu, eu, expected.Name, diff.WithEquateEmpty())
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLiveFunction(t *testing.T) {
+	t.Parallel()
+
+	expected := FunctionManifestSpec{
+		Name:         "my-function",
+		Role:         "arn:aws:iam::123456789012:role/lambda",
+		ImageURI:     "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/my-function:v1",
+		Memory:       512,
+		Timeout:      30,
+		Environments: map[string]string{"FOO": "bar"},
+	}
+
+	testcases := []struct {
+		name          string
+		live          FunctionManifestSpec
+		expected      FunctionManifestSpec
+		expectedPaths []string
+	}{
+		{
+			name: "no diff",
+			live: FunctionManifestSpec{
+				Name:         "my-function",
+				Role:         "arn:aws:iam::123456789012:role/lambda",
+				ImageURI:     "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/my-function:v1",
+				Handler:      "ignored",
+				Memory:       512,
+				Timeout:      30,
+				Environments: map[string]string{"FOO": "bar"},
+			},
+			expected:      expected,
+			expectedPaths: []string{},
+		},
+		{
+			name: "changed on the console",
+			live: FunctionManifestSpec{
+				Name:         "my-function",
+				Role:         "arn:aws:iam::123456789012:role/lambda",
+				ImageURI:     "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/my-function:v2",
+				Memory:       1024,
+				Timeout:      30,
+				Environments: map[string]string{"FOO": "baz", "DEBUG": "true"},
+			},
+			expected:      expected,
+			expectedPaths: []string{"environments.DEBUG", "environments.FOO", "image", "memory"},
+		},
+		{
+			name: "image is not compared for zip package",
+			live: FunctionManifestSpec{
+				Name:     "my-function",
+				Role:     "arn:aws:iam::123456789012:role/lambda",
+				ImageURI: "",
+				Memory:   512,
+				Timeout:  30,
+			},
+			expected: FunctionManifestSpec{
+				Name:     "my-function",
+				Role:     "arn:aws:iam::123456789012:role/other",
+				S3Bucket: "bucket",
+				S3Key:    "key",
+				Memory:   512,
+				Timeout:  30,
+			},
+			expectedPaths: []string{"role"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := DiffLiveFunction(tc.live, tc.expected)
+			require.NoError(t, err)
+			paths := make([]string, 0, result.NumNodes())
+			for _, n := range result.Nodes() {
+				paths = append(paths, n.PathString)
+			}
+			assert.ElementsMatch(t, tc.expectedPaths, paths)
+		})
+	}
+}
@@ -0,0 +1,257 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+const (
+	mediaTypeDockerManifest     = "application/vnd.docker.distribution.manifest.v2+json"
+	mediaTypeDockerManifestList = "application/vnd.docker.distribution.manifest.list.v2+json"
+	mediaTypeOCIManifest        = "application/vnd.oci.image.manifest.v1+json"
+	mediaTypeOCIIndex           = "application/vnd.oci.image.index.v1+json"
+
+	// The architecture used by Lambda when the function does not specify any.
+	defaultArchitecture = "x86_64"
+)
+
+// The URI of the image stored in ECR, e.g. 123456789012.dkr.ecr.us-east-1.amazonaws.com/repo:tag
+var ecrImageURIRegex = regexp.MustCompile(`^(\d{12})\.dkr\.ecr\.([a-z0-9-]+)\.amazonaws\.com(?:\.cn)?/([^:@]+)(?::([^@]+))?(?:@(sha256:[a-f0-9]{64}))?$`)
+
+// ErrNotECRImage is returned when the image URI does not refer to an image stored in ECR.
+var ErrNotECRImage = errors.New("image is not stored in ECR")
+
+// ImageManifest represents the manifest of a container image stored in ECR.
+type ImageManifest struct {
+	// The digest of the manifest referred by the image URI, which can be an image index.
+	Digest string
+	// The digests of the platform-specific image manifests keyed by the Lambda architecture name.
+	Architectures map[string]string
+}
+
+type ecrImage struct {
+	registryID string
+	region     string
+	repository string
+	tag        string
+	digest     string
+}
+
+// name returns the image URI without the tag and digest.
+func (i ecrImage) name() string {
+	return fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s", i.registryID, i.region, i.repository)
+}
+
+func parseECRImageURI(uri string) (ecrImage, error) {
+	m := ecrImageURIRegex.FindStringSubmatch(uri)
+	if m == nil {
+		return ecrImage{}, fmt.Errorf("%s: %w", uri, ErrNotECRImage)
+	}
+	img := ecrImage{
+		registryID: m[1],
+		region:     m[2],
+		repository: m[3],
+		tag:        m[4],
+		digest:     m[5],
+	}
+	if img.tag == "" && img.digest == "" {
+		img.tag = "latest"
+	}
+	return img, nil
+}
+
+// GetImageManifest returns the digests of the given container image stored in ECR.
+// When the image is a multi-architecture image index, the digest of the image for each architecture is returned.
+func (c *client) GetImageManifest(ctx context.Context, imageURI string) (ImageManifest, error) {
+	img, err := parseECRImageURI(imageURI)
+	if err != nil {
+		return ImageManifest{}, err
+	}
+
+	imageID := types.ImageIdentifier{}
+	if img.digest != "" {
+		imageID.ImageDigest = aws.String(img.digest)
+	} else {
+		imageID.ImageTag = aws.String(img.tag)
+	}
+	out, err := c.ecrClient(img.region).BatchGetImage(ctx, &ecr.BatchGetImageInput{
+		RegistryId:         aws.String(img.registryID),
+		RepositoryName:     aws.String(img.repository),
+		ImageIds:           []types.ImageIdentifier{imageID},
+		AcceptedMediaTypes: []string{mediaTypeDockerManifest, mediaTypeDockerManifestList, mediaTypeOCIManifest, mediaTypeOCIIndex},
+	})
+	if err != nil {
+		return ImageManifest{}, fmt.Errorf("failed to get image %s: %w", imageURI, err)
+	}
+	if len(out.Failures) > 0 {
+		return ImageManifest{}, fmt.Errorf("failed to get image %s: %s: %s", imageURI, out.Failures[0].FailureCode, aws.ToString(out.Failures[0].FailureReason))
+	}
+	if len(out.Images) == 0 {
+		return ImageManifest{}, fmt.Errorf("image %s was not found", imageURI)
+	}
+
+	image := out.Images[0]
+	manifest := ImageManifest{}
+	if image.ImageId != nil {
+		manifest.Digest = aws.ToString(image.ImageId.ImageDigest)
+	}
+	configDigest, archs, err := parseImageManifest([]byte(aws.ToString(image.ImageManifest)))
+	if err != nil {
+		return ImageManifest{}, fmt.Errorf("invalid manifest of image %s: %w", imageURI, err)
+	}
+	if archs != nil {
+		manifest.Architectures = archs
+		return manifest, nil
+	}
+
+	// The architecture of the single-architecture image is written in its config blob.
+	arch, err := c.getImageArchitecture(ctx, img, configDigest)
+	if err != nil {
+		return ImageManifest{}, fmt.Errorf("failed to get architecture of image %s: %w", imageURI, err)
+	}
+	manifest.Architectures = map[string]string{arch: manifest.Digest}
+	return manifest, nil
+}
+
+func (c *client) getImageArchitecture(ctx context.Context, img ecrImage, configDigest string) (string, error) {
+	out, err := c.ecrClient(img.region).GetDownloadUrlForLayer(ctx, &ecr.GetDownloadUrlForLayerInput{
+		RegistryId:     aws.String(img.registryID),
+		RepositoryName: aws.String(img.repository),
+		LayerDigest:    aws.String(configDigest),
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get the download URL of image config: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, aws.ToString(out.DownloadUrl), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to download image config: status code %d", resp.StatusCode)
+	}
+
+	var config struct {
+		Architecture string `json:"architecture"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&config); err != nil {
+		return "", fmt.Errorf("failed to parse image config: %w", err)
+	}
+	return lambdaArchitecture(config.Architecture), nil
+}
+
+// parseImageManifest returns the digests of the images for each architecture when the given manifest is an image index,
+// otherwise the digest of the config blob of the image.
+func parseImageManifest(data []byte) (configDigest string, archs map[string]string, err error) {
+	var m struct {
+		MediaType string `json:"mediaType"`
+		Config    struct {
+			Digest string `json:"digest"`
+		} `json:"config"`
+		Manifests []struct {
+			Digest   string `json:"digest"`
+			Platform struct {
+				Architecture string `json:"architecture"`
+				OS           string `json:"os"`
+			} `json:"platform"`
+		} `json:"manifests"`
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return "", nil, err
+	}
+
+	switch m.MediaType {
+	case mediaTypeDockerManifestList, mediaTypeOCIIndex:
+	default:
+		if m.Manifests == nil {
+			if m.Config.Digest == "" {
+				return "", nil, fmt.Errorf("config digest is missing")
+			}
+			return m.Config.Digest, nil, nil
+		}
+	}
+
+	archs = make(map[string]string, len(m.Manifests))
+	for _, d := range m.Manifests {
+		// The attestation manifests pushed by buildx have the unknown platform.
+		if d.Platform.OS != "linux" {
+			continue
+		}
+		archs[lambdaArchitecture(d.Platform.Architecture)] = d.Digest
+	}
+	return "", archs, nil
+}
+
+// lambdaArchitecture converts the architecture name used by the container images into the one used by Lambda.
+func lambdaArchitecture(arch string) string {
+	if arch == "amd64" {
+		return "x86_64"
+	}
+	return arch
+}
+
+// ResolveImageURI returns the image URI pinned to the digest of the image for the architecture of the function.
+// An error is returned when the image does not support the architecture.
+func ResolveImageURI(fm FunctionManifest, manifest ImageManifest) (string, error) {
+	img, err := parseECRImageURI(fm.Spec.ImageURI)
+	if err != nil {
+		return "", err
+	}
+
+	arch := defaultArchitecture
+	if len(fm.Spec.Architectures) > 0 {
+		arch = fm.Spec.Architectures[0].Name
+	}
+	digest, ok := manifest.Architectures[arch]
+	if !ok {
+		supported := make([]string, 0, len(manifest.Architectures))
+		for a := range manifest.Architectures {
+			supported = append(supported, a)
+		}
+		sort.Strings(supported)
+		return "", fmt.Errorf("image %s does not support architecture %s of the function, supported architectures: %v", fm.Spec.ImageURI, arch, supported)
+	}
+	return fmt.Sprintf("%s@%s", img.name(), digest), nil
+}
+
+// ecrClient returns the ECR client for the given region, which can differ from the region of the function.
+func (c *client) ecrClient(region string) *ecr.Client {
+	return ecr.NewFromConfig(c.awsCfg, func(o *ecr.Options) {
+		o.Region = region
+	})
+}
+
+func (c *client) httpClient() aws.HTTPClient {
+	if c.awsCfg.HTTPClient != nil {
+		return c.awsCfg.HTTPClient
+	}
+	return http.DefaultClient
+}
@@ -0,0 +1,239 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testDigest = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+
+func TestParseECRImageURI(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		uri      string
+		expected ecrImage
+		wantErr  bool
+	}{
+		{
+			name:     "tagged image",
+			uri:      "123456789012.dkr.ecr.us-east-1.amazonaws.com/team/app:v0.1.0",
+			expected: ecrImage{registryID: "123456789012", region: "us-east-1", repository: "team/app", tag: "v0.1.0"},
+		},
+		{
+			name:     "image without tag",
+			uri:      "123456789012.dkr.ecr.us-east-1.amazonaws.com/app",
+			expected: ecrImage{registryID: "123456789012", region: "us-east-1", repository: "app", tag: "latest"},
+		},
+		{
+			name:     "image with digest",
+			uri:      "123456789012.dkr.ecr.ap-northeast-1.amazonaws.com/app@" + testDigest,
+			expected: ecrImage{registryID: "123456789012", region: "ap-northeast-1", repository: "app", digest: testDigest},
+		},
+		{
+			name:    "image not stored in ECR",
+			uri:     "gcr.io/project/app:v0.1.0",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseECRImageURI(tc.uri)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestParseImageManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                 string
+		data                 string
+		expectedConfigDigest string
+		expectedArchs        map[string]string
+		wantErr              bool
+	}{
+		{
+			name: "single architecture image",
+			data: `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.docker.distribution.manifest.v2+json",
+  "config": {"mediaType": "application/vnd.docker.container.image.v1+json", "digest": "sha256:config"}
+}`,
+			expectedConfigDigest: "sha256:config",
+		},
+		{
+			name: "image index",
+			data: `{
+  "schemaVersion": 2,
+  "mediaType": "application/vnd.oci.image.index.v1+json",
+  "manifests": [
+    {"digest": "sha256:amd64", "platform": {"architecture": "amd64", "os": "linux"}},
+    {"digest": "sha256:arm64", "platform": {"architecture": "arm64", "os": "linux"}},
+    {"digest": "sha256:attestation", "platform": {"architecture": "unknown", "os": "unknown"}}
+  ]
+}`,
+			expectedArchs: map[string]string{
+				"x86_64": "sha256:amd64",
+				"arm64":  "sha256:arm64",
+			},
+		},
+		{
+			name:    "missing config",
+			data:    `{"schemaVersion": 2, "mediaType": "application/vnd.oci.image.manifest.v1+json"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			configDigest, archs, err := parseImageManifest([]byte(tc.data))
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expectedConfigDigest, configDigest)
+			assert.Equal(t, tc.expectedArchs, archs)
+		})
+	}
+}
+
+func TestResolveImageURI(t *testing.T) {
+	t.Parallel()
+
+	const image = "123456789012.dkr.ecr.us-east-1.amazonaws.com/app"
+	manifest := ImageManifest{
+		Digest: "sha256:index",
+		Architectures: map[string]string{
+			"x86_64": "sha256:amd64",
+			"arm64":  testDigest,
+		},
+	}
+	testcases := []struct {
+		name          string
+		architectures []Architecture
+		manifest      ImageManifest
+		expected      string
+		wantErr       bool
+	}{
+		{
+			name:     "default architecture",
+			manifest: manifest,
+			expected: image + "@sha256:amd64",
+		},
+		{
+			name:          "specified architecture",
+			architectures: []Architecture{{Name: "arm64"}},
+			manifest:      manifest,
+			expected:      image + "@" + testDigest,
+		},
+		{
+			name:          "unsupported architecture",
+			architectures: []Architecture{{Name: "arm64"}},
+			manifest: ImageManifest{
+				Digest:        "sha256:amd64",
+				Architectures: map[string]string{"x86_64": "sha256:amd64"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			fm := FunctionManifest{
+				Spec: FunctionManifestSpec{
+					ImageURI:      image + ":v0.1.0",
+					Architectures: tc.architectures,
+				},
+			}
+			got, err := ResolveImageURI(fm, tc.manifest)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestGetImageManifest(t *testing.T) {
+	t.Parallel()
+
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/config" {
+			io.WriteString(w, `{"architecture":"arm64","os":"linux"}`)
+			return
+		}
+		assert.Contains(t, r.Header.Get("Authorization"), "/us-east-1/ecr/aws4_request")
+
+		var in map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, "123456789012", in["registryId"])
+		assert.Equal(t, "repo", in["repositoryName"])
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AmazonEC2ContainerRegistry_V20150921.BatchGetImage":
+			assert.Equal(t, []interface{}{map[string]interface{}{"imageTag": "v1"}}, in["imageIds"])
+			manifest, err := json.Marshal(`{"mediaType":"application/vnd.docker.distribution.manifest.v2+json","config":{"digest":"sha256:config"}}`)
+			require.NoError(t, err)
+			io.WriteString(w, `{"images":[{"imageId":{"imageDigest":"`+testDigest+`","imageTag":"v1"},"imageManifest":`+string(manifest)+`}],"failures":[]}`)
+		case "AmazonEC2ContainerRegistry_V20150921.GetDownloadUrlForLayer":
+			assert.Equal(t, "sha256:config", in["layerDigest"])
+			io.WriteString(w, `{"downloadUrl":"`+ts.URL+`/config","layerDigest":"sha256:config"}`)
+		default:
+			t.Errorf("unexpected target %s", r.Header.Get("X-Amz-Target"))
+		}
+	}))
+	t.Cleanup(ts.Close)
+
+	c := &client{
+		awsCfg: aws.Config{
+			Region:      "ap-northeast-1",
+			Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+			EndpointResolverWithOptions: aws.EndpointResolverWithOptionsFunc(func(service, region string, options ...interface{}) (aws.Endpoint, error) {
+				return aws.Endpoint{URL: ts.URL, SigningRegion: region}, nil
+			}),
+		},
+		logger: zap.NewNop(),
+	}
+
+	manifest, err := c.GetImageManifest(context.Background(), "123456789012.dkr.ecr.us-east-1.amazonaws.com/repo:v1")
+	require.NoError(t, err)
+	assert.Equal(t, ImageManifest{
+		Digest:        testDigest,
+		Architectures: map[string]string{"arm64": testDigest},
+	}, manifest)
+}
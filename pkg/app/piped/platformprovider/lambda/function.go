@@ -15,6 +15,8 @@
 package lambda
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
 	"strings"
@@ -74,6 +76,28 @@ type FunctionManifestSpec struct {
 	Tags             map[string]string `json:"tags,omitempty"`
 	Environments     map[string]string `json:"environments,omitempty"`
 	VPCConfig        *VPCConfig        `json:"vpcConfig,omitempty"`
+	// ARNs of the layer versions to be added to the function.
+	Layers []string `json:"layers,omitempty"`
+	// The location of the zip package in the form of "s3://bucket/key".
+	// This is an alternative to specifying s3Bucket and s3Key.
+	S3URI string `json:"s3Uri,omitempty"`
+	// The expected SHA-256 checksum of the zip package stored in S3, in hex.
+	// The package is verified with it before updating the function code.
+	S3Sha256 string `json:"s3Sha256,omitempty"`
+	// The event source mappings invoking the alias of the function.
+	// The mappings are managed only when this is specified.
+	EventSourceMappings []EventSourceMapping `json:"eventSourceMappings,omitempty"`
+}
+
+// EventSourceMapping represents a mapping which invokes the function with the records read from an event source.
+type EventSourceMapping struct {
+	// The ARN of the event source such as an SQS queue, a Kinesis stream or a DynamoDB stream.
+	EventSourceArn string `json:"eventSourceArn"`
+	BatchSize      int32  `json:"batchSize,omitempty"`
+	// Whether the mapping is enabled. Default is true.
+	Enabled *bool `json:"enabled,omitempty"`
+	// The position to start reading the stream from. Required for Kinesis and DynamoDB streams.
+	StartingPosition string `json:"startingPosition,omitempty"`
 }
 
 type VPCConfig struct {
@@ -85,6 +109,14 @@ func (fmp FunctionManifestSpec) validate() error {
 	if fmp.Name == "" {
 		return fmt.Errorf("lambda function is missing")
 	}
+	if fmp.S3Sha256 != "" {
+		if fmp.S3Bucket == "" {
+			return fmt.Errorf("s3Sha256 can be used only with the zip package stored in S3")
+		}
+		if b, err := hex.DecodeString(fmp.S3Sha256); err != nil || len(b) != sha256.Size {
+			return fmt.Errorf("s3Sha256 must be a hex-encoded SHA-256 checksum")
+		}
+	}
 	if fmp.ImageURI == "" && fmp.S3Bucket == "" {
 		if err := fmp.SourceCode.validate(); err != nil {
 			return err
@@ -98,6 +130,11 @@ func (fmp FunctionManifestSpec) validate() error {
 			return fmt.Errorf("runtime is missing")
 		}
 	}
+	for _, m := range fmp.EventSourceMappings {
+		if m.EventSourceArn == "" {
+			return fmt.Errorf("eventSourceArn of event source mapping is missing")
+		}
+	}
 	for _, arch := range fmp.Architectures {
 		if err := arch.validate(); err != nil {
 			return fmt.Errorf("architecture is invalid: %w", err)
@@ -171,12 +208,35 @@ func parseFunctionManifest(data []byte) (FunctionManifest, error) {
 	if err := yaml.Unmarshal(data, &obj); err != nil {
 		return FunctionManifest{}, err
 	}
+	if obj.Spec.S3URI != "" {
+		if obj.Spec.S3Bucket != "" || obj.Spec.S3Key != "" {
+			return FunctionManifest{}, fmt.Errorf("s3Uri can not be used together with s3Bucket and s3Key")
+		}
+		bucket, key, err := parseS3URI(obj.Spec.S3URI)
+		if err != nil {
+			return FunctionManifest{}, err
+		}
+		obj.Spec.S3Bucket, obj.Spec.S3Key = bucket, key
+	}
 	if err := obj.validate(); err != nil {
 		return FunctionManifest{}, err
 	}
 	return obj, nil
 }
 
+// parseS3URI splits the given URI in the form of "s3://bucket/key" into the bucket and the key.
+func parseS3URI(uri string) (bucket, key string, err error) {
+	path, ok := strings.CutPrefix(uri, "s3://")
+	if !ok {
+		return "", "", fmt.Errorf("s3Uri must start with s3://: %s", uri)
+	}
+	bucket, key, _ = strings.Cut(path, "/")
+	if bucket == "" || key == "" {
+		return "", "", fmt.Errorf("s3Uri must be in the form of s3://bucket/key: %s", uri)
+	}
+	return bucket, key, nil
+}
+
 // DecideRevisionName returns revision name to apply.
 func DecideRevisionName(fm FunctionManifest, commit string) (string, error) {
 	tag, err := FindImageTag(fm)
@@ -229,12 +289,16 @@ func FindArtifactVersions(fm FunctionManifest) ([]*model.ArtifactVersion, error)
 		}, nil
 	}
 
-	// Extract s3 object version as application version.
-	if fm.Spec.S3ObjectVersion != "" {
+	// Extract s3 object version and checksum as application version.
+	if fm.Spec.S3ObjectVersion != "" || fm.Spec.S3Sha256 != "" {
+		version := fm.Spec.S3ObjectVersion
+		if fm.Spec.S3Sha256 != "" {
+			version = strings.TrimPrefix(fmt.Sprintf("%s@sha256:%s", version, fm.Spec.S3Sha256), "@")
+		}
 		return []*model.ArtifactVersion{
 			{
 				Kind:    model.ArtifactVersion_S3_OBJECT,
-				Version: fm.Spec.S3ObjectVersion,
+				Version: version,
 				Name:    fm.Spec.S3Key,
 				Url:     fmt.Sprintf("https://console.aws.amazon.com/s3/object/%s?prefix=%s", fm.Spec.S3Bucket, fm.Spec.S3Key),
 			},
@@ -288,6 +288,97 @@ func TestParseFunctionManifest(t *testing.T) {
 	  "s3Key": "function-code",
 	  "s3ObjectVersion": "xyz"
   }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "correct config for LambdaFunction with s3 uri and checksum",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 10,
+	  "handler": "app.handler",
+	  "runtime": "python3.9",
+	  "s3Uri": "s3://pipecd-sample/functions/code.zip",
+	  "s3Sha256": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+  }
+}`,
+			wantSpec: FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionManifestSpec{
+					Name:     "SimpleFunction",
+					Role:     "arn:aws:iam::xxxxx:role/lambda-role",
+					Memory:   128,
+					Timeout:  10,
+					Handler:  "app.handler",
+					Runtime:  "python3.9",
+					S3Bucket: "pipecd-sample",
+					S3Key:    "functions/code.zip",
+					S3URI:    "s3://pipecd-sample/functions/code.zip",
+					S3Sha256: "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid s3 uri",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 10,
+	  "handler": "app.handler",
+	  "runtime": "python3.9",
+	  "s3Uri": "s3://pipecd-sample"
+  }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "s3 uri with s3 bucket",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 10,
+	  "handler": "app.handler",
+	  "runtime": "python3.9",
+	  "s3Uri": "s3://pipecd-sample/code.zip",
+	  "s3Bucket": "pipecd-sample"
+  }
+}`,
+			wantSpec: FunctionManifest{},
+			wantErr:  true,
+		},
+		{
+			name: "invalid s3 checksum",
+			data: `{
+  "apiVersion": "pipecd.dev/v1beta1",
+  "kind": "LambdaFunction",
+  "spec": {
+	  "name": "SimpleFunction",
+	  "role": "arn:aws:iam::xxxxx:role/lambda-role",
+	  "memory": 128,
+	  "timeout": 10,
+	  "handler": "app.handler",
+	  "runtime": "python3.9",
+	  "s3Bucket": "pipecd-sample",
+	  "s3Key": "code.zip",
+	  "s3Sha256": "not-a-checksum"
+  }
 }`,
 			wantSpec: FunctionManifest{},
 			wantErr:  true,
@@ -376,6 +467,58 @@ spec:
 			},
 			expectedErr: false,
 		},
+		{
+			name: "[From S3] ok: using s3 object with checksum",
+			input: []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: LambdaFunction
+spec:
+  name: SimpleZipPackingS3Function
+  role: arn:aws:iam::76xxxxxxx:role/lambda-role
+  s3Uri: s3://pipecd-sample-lambda/pipecd-sample-src
+  s3ObjectVersion: 1pTK9_v0Kd7I8Sk4n6abzCL
+  s3Sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+  handler: app.lambdaHandler
+  runtime: nodejs14.x
+  memory: 512
+  timeout: 30
+`),
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_S3_OBJECT,
+					Version: "1pTK9_v0Kd7I8Sk4n6abzCL@sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					Name:    "pipecd-sample-src",
+					Url:     "https://console.aws.amazon.com/s3/object/pipecd-sample-lambda?prefix=pipecd-sample-src",
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "[From S3] ok: using unversioned s3 object with checksum",
+			input: []byte(`
+apiVersion: pipecd.dev/v1beta1
+kind: LambdaFunction
+spec:
+  name: SimpleZipPackingS3Function
+  role: arn:aws:iam::76xxxxxxx:role/lambda-role
+  s3Bucket: pipecd-sample-lambda
+  s3Key: pipecd-sample-src
+  s3Sha256: 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+  handler: app.lambdaHandler
+  runtime: nodejs14.x
+  memory: 512
+  timeout: 30
+`),
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_S3_OBJECT,
+					Version: "sha256:9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					Name:    "pipecd-sample-src",
+					Url:     "https://console.aws.amazon.com/s3/object/pipecd-sample-lambda?prefix=pipecd-sample-src",
+				},
+			},
+			expectedErr: false,
+		},
 		{
 			name: "[From Source Code] ok: using source code",
 			input: []byte(`
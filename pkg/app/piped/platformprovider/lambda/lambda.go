@@ -29,14 +29,29 @@ import (
 // Client is wrapper of AWS client.
 type Client interface {
 	IsFunctionExist(ctx context.Context, name string) (bool, error)
+	GetFunction(ctx context.Context, name string) (FunctionManifestSpec, error)
 	CreateFunction(ctx context.Context, fm FunctionManifest) error
 	CreateFunctionFromSource(ctx context.Context, fm FunctionManifest, zip io.Reader) error
 	UpdateFunction(ctx context.Context, fm FunctionManifest) error
 	UpdateFunctionFromSource(ctx context.Context, fm FunctionManifest, zip io.Reader) error
 	PublishFunction(ctx context.Context, fm FunctionManifest) (version string, err error)
+	ListFunctionVersions(ctx context.Context, fm FunctionManifest) ([]string, error)
+	ListAliasedVersions(ctx context.Context, fm FunctionManifest) (map[string]struct{}, error)
+	DeleteFunctionVersion(ctx context.Context, fm FunctionManifest, version string) error
 	GetTrafficConfig(ctx context.Context, fm FunctionManifest) (routingTrafficCfg RoutingTrafficConfig, err error)
 	CreateTrafficConfig(ctx context.Context, fm FunctionManifest, version string) error
 	UpdateTrafficConfig(ctx context.Context, fm FunctionManifest, routingTraffic RoutingTrafficConfig) error
+	PutProvisionedConcurrency(ctx context.Context, fm FunctionManifest, concurrency int32) error
+	GetProvisionedConcurrency(ctx context.Context, fm FunctionManifest) (ProvisionedConcurrency, error)
+	DeleteProvisionedConcurrency(ctx context.Context, fm FunctionManifest) error
+	PublishLayerVersion(ctx context.Context, layer Layer) (LayerVersion, error)
+	GetLatestLayerVersion(ctx context.Context, name string) (LayerVersion, error)
+	ListEventSourceMappings(ctx context.Context, fm FunctionManifest) ([]LiveEventSourceMapping, error)
+	CreateEventSourceMapping(ctx context.Context, fm FunctionManifest, m EventSourceMapping) error
+	UpdateEventSourceMapping(ctx context.Context, fm FunctionManifest, uuid string, m EventSourceMapping) error
+	DeleteEventSourceMapping(ctx context.Context, fm FunctionManifest, uuid string) error
+	DescribeAlarms(ctx context.Context, names []string) ([]Alarm, error)
+	GetImageManifest(ctx context.Context, imageURI string) (ImageManifest, error)
 }
 
 // Registry holds a pool of aws client wrappers.
@@ -44,9 +59,14 @@ type Registry interface {
 	Client(name string, cfg *config.PlatformProviderLambdaConfig, logger *zap.Logger) (Client, error)
 }
 
-// LoadFunctionManifest returns FunctionManifest object from a given Function config manifest file.
-func LoadFunctionManifest(appDir, functionManifestFilename string) (FunctionManifest, error) {
-	path := filepath.Join(appDir, functionManifestFilename)
+// LoadFunctionManifest returns FunctionManifest object from the SAM template or the function manifest file
+// specified in the given deployment input.
+func LoadFunctionManifest(appDir string, input config.LambdaDeploymentInput) (FunctionManifest, error) {
+	if input.SAMTemplateFile != "" {
+		path := filepath.Join(appDir, input.SAMTemplateFile)
+		return LoadFunctionManifestFromSAMTemplate(path, input.SAMFunction, input.SAMParameters)
+	}
+	path := filepath.Join(appDir, input.FunctionManifestFile)
 	return loadFunctionManifest(path)
 }
 
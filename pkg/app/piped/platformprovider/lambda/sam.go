@@ -0,0 +1,582 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	samFunctionType                = "AWS::Serverless::Function"
+	cfnFunctionType                = "AWS::Lambda::Function"
+	cfnEventSourceMappingType      = "AWS::Lambda::EventSourceMapping"
+	samIntrinsicFunctionTagPrefix  = "!"
+	samIntrinsicFunctionNamePrefix = "Fn::"
+	// The default values applied by CloudFormation when the properties are omitted.
+	samDefaultMemorySize = 128
+	samDefaultTimeout    = 3
+)
+
+// samTemplate is the subset of the SAM/CloudFormation template used to build the function manifest.
+type samTemplate struct {
+	Parameters map[string]struct {
+		Default interface{} `json:"Default"`
+	} `json:"Parameters"`
+	Globals struct {
+		Function map[string]interface{} `json:"Function"`
+	} `json:"Globals"`
+	Resources map[string]struct {
+		Type       string                 `json:"Type"`
+		Properties map[string]interface{} `json:"Properties"`
+	} `json:"Resources"`
+}
+
+// samFunctionProperties contains the properties of both AWS::Serverless::Function
+// and AWS::Lambda::Function resources piped can deploy.
+type samFunctionProperties struct {
+	FunctionName string `json:"FunctionName"`
+	Role         string `json:"Role"`
+	Handler      string `json:"Handler"`
+	Runtime      string `json:"Runtime"`
+	MemorySize   int32  `json:"MemorySize"`
+	Timeout      int32  `json:"Timeout"`
+	Environment  struct {
+		Variables map[string]string `json:"Variables"`
+	} `json:"Environment"`
+	Architectures    []string `json:"Architectures"`
+	Layers           []string `json:"Layers"`
+	EphemeralStorage *struct {
+		Size int32 `json:"Size"`
+	} `json:"EphemeralStorage"`
+	VpcConfig *struct {
+		SecurityGroupIds []string `json:"SecurityGroupIds"`
+		SubnetIds        []string `json:"SubnetIds"`
+	} `json:"VpcConfig"`
+	// The tags are a map for AWS::Serverless::Function and a list for AWS::Lambda::Function.
+	Tags json.RawMessage `json:"Tags"`
+
+	// The properties for AWS::Serverless::Function.
+	CodeUri  json.RawMessage `json:"CodeUri"`
+	ImageUri string          `json:"ImageUri"`
+	Events   map[string]struct {
+		Type       string `json:"Type"`
+		Properties struct {
+			Queue            string `json:"Queue"`
+			Stream           string `json:"Stream"`
+			BatchSize        int32  `json:"BatchSize"`
+			Enabled          *bool  `json:"Enabled"`
+			StartingPosition string `json:"StartingPosition"`
+		} `json:"Properties"`
+	} `json:"Events"`
+
+	// The properties for AWS::Lambda::Function.
+	Code *struct {
+		S3Bucket        string `json:"S3Bucket"`
+		S3Key           string `json:"S3Key"`
+		S3ObjectVersion string `json:"S3ObjectVersion"`
+		ImageUri        string `json:"ImageUri"`
+	} `json:"Code"`
+}
+
+// samEventSourceMappingProperties contains the properties of AWS::Lambda::EventSourceMapping resource.
+type samEventSourceMappingProperties struct {
+	EventSourceArn   string `json:"EventSourceArn"`
+	BatchSize        int32  `json:"BatchSize"`
+	Enabled          *bool  `json:"Enabled"`
+	StartingPosition string `json:"StartingPosition"`
+}
+
+// LoadFunctionManifestFromSAMTemplate returns FunctionManifest object built from the function
+// defined in the given SAM or CloudFormation template file.
+// The function is specified by its logical ID, which can be omitted when the template contains only one function.
+// The "Ref" and "Fn::Sub" intrinsic functions referring to the template parameters are resolved
+// with the given parameters or their default values.
+func LoadFunctionManifestFromSAMTemplate(path, function string, params map[string]string) (FunctionManifest, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return FunctionManifest{}, err
+	}
+	return parseSAMTemplate(data, function, params)
+}
+
+func parseSAMTemplate(data []byte, function string, params map[string]string) (FunctionManifest, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return FunctionManifest{}, fmt.Errorf("failed to parse SAM template: %w", err)
+	}
+	raw, err := samNodeToValue(&node)
+	if err != nil {
+		return FunctionManifest{}, fmt.Errorf("failed to parse SAM template: %w", err)
+	}
+
+	var tmpl samTemplate
+	if err := remarshal(raw, &tmpl); err != nil {
+		return FunctionManifest{}, fmt.Errorf("failed to parse SAM template: %w", err)
+	}
+
+	function, err = findSAMFunction(tmpl, function)
+	if err != nil {
+		return FunctionManifest{}, err
+	}
+
+	// The parameters passed by the application configuration take precedence over the default values.
+	values := make(map[string]string, len(tmpl.Parameters)+len(params))
+	for name, p := range tmpl.Parameters {
+		if p.Default != nil {
+			values[name] = fmt.Sprint(p.Default)
+		}
+	}
+	for name, v := range params {
+		values[name] = v
+	}
+	r := &samResolver{values: values}
+
+	resource := tmpl.Resources[function]
+	props := resource.Properties
+	if resource.Type == samFunctionType {
+		props = mergeSAMGlobals(tmpl.Globals.Function, props)
+	}
+	resolved, err := r.resolve(props)
+	if err != nil {
+		return FunctionManifest{}, fmt.Errorf("failed to resolve the properties of %s: %w", function, err)
+	}
+	var fp samFunctionProperties
+	if err := remarshal(resolved, &fp); err != nil {
+		return FunctionManifest{}, fmt.Errorf("invalid properties of %s: %w", function, err)
+	}
+	if fp.FunctionName == "" {
+		return FunctionManifest{}, fmt.Errorf("FunctionName of %s must be specified to be deployed by piped", function)
+	}
+	// Let the other resources refer to the function by its logical ID.
+	r.values[function] = fp.FunctionName
+
+	spec, err := makeFunctionManifestSpec(fp)
+	if err != nil {
+		return FunctionManifest{}, fmt.Errorf("invalid properties of %s: %w", function, err)
+	}
+
+	// Collect the event source mappings defined as separated resources.
+	ids := make([]string, 0, len(tmpl.Resources))
+	for id := range tmpl.Resources {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		if tmpl.Resources[id].Type != cfnEventSourceMappingType {
+			continue
+		}
+		props := tmpl.Resources[id].Properties
+		if !r.refersFunction(props["FunctionName"], function, fp.FunctionName) {
+			continue
+		}
+		resolved, err := r.resolve(withoutKey(props, "FunctionName"))
+		if err != nil {
+			return FunctionManifest{}, fmt.Errorf("failed to resolve the properties of %s: %w", id, err)
+		}
+		var mp samEventSourceMappingProperties
+		if err := remarshal(resolved, &mp); err != nil {
+			return FunctionManifest{}, fmt.Errorf("invalid properties of %s: %w", id, err)
+		}
+		spec.EventSourceMappings = append(spec.EventSourceMappings, EventSourceMapping{
+			EventSourceArn:   mp.EventSourceArn,
+			BatchSize:        mp.BatchSize,
+			Enabled:          mp.Enabled,
+			StartingPosition: mp.StartingPosition,
+		})
+	}
+	// Make the mappings managed even when no mapping is defined
+	// so that the removed ones are also removed from the function.
+	if spec.EventSourceMappings == nil {
+		spec.EventSourceMappings = []EventSourceMapping{}
+	}
+
+	fm := FunctionManifest{
+		Kind:       functionManifestKind,
+		APIVersion: versionV1Beta1,
+		Spec:       spec,
+	}
+	if err := fm.validate(); err != nil {
+		return FunctionManifest{}, err
+	}
+	return fm, nil
+}
+
+// findSAMFunction returns the logical ID of the function to be deployed.
+func findSAMFunction(tmpl samTemplate, function string) (string, error) {
+	if function != "" {
+		r, ok := tmpl.Resources[function]
+		if !ok || (r.Type != samFunctionType && r.Type != cfnFunctionType) {
+			return "", fmt.Errorf("function %s was not found in SAM template", function)
+		}
+		return function, nil
+	}
+
+	var functions []string
+	for id, r := range tmpl.Resources {
+		if r.Type == samFunctionType || r.Type == cfnFunctionType {
+			functions = append(functions, id)
+		}
+	}
+	switch len(functions) {
+	case 0:
+		return "", fmt.Errorf("no function was found in SAM template")
+	case 1:
+		return functions[0], nil
+	default:
+		sort.Strings(functions)
+		return "", fmt.Errorf("the function to be deployed must be specified since SAM template contains multiple functions: %v", functions)
+	}
+}
+
+// mergeSAMGlobals returns the properties of the function with the values in the Globals section as defaults.
+// The maps of environment variables and tags are merged as SAM does.
+func mergeSAMGlobals(globals, props map[string]interface{}) map[string]interface{} {
+	merged := make(map[string]interface{}, len(globals)+len(props))
+	for k, v := range globals {
+		merged[k] = v
+	}
+	for k, v := range props {
+		gv, ok := merged[k]
+		if !ok {
+			merged[k] = v
+			continue
+		}
+		gm, gok := gv.(map[string]interface{})
+		pm, pok := v.(map[string]interface{})
+		if !gok || !pok || isIntrinsicFunction(gm) || isIntrinsicFunction(pm) {
+			merged[k] = v
+			continue
+		}
+		merged[k] = mergeSAMGlobals(gm, pm)
+	}
+	return merged
+}
+
+func makeFunctionManifestSpec(fp samFunctionProperties) (FunctionManifestSpec, error) {
+	spec := FunctionManifestSpec{
+		Name:         fp.FunctionName,
+		Role:         fp.Role,
+		Handler:      fp.Handler,
+		Runtime:      fp.Runtime,
+		Memory:       fp.MemorySize,
+		Timeout:      fp.Timeout,
+		Environments: fp.Environment.Variables,
+		Layers:       fp.Layers,
+		ImageURI:     fp.ImageUri,
+	}
+	if spec.Memory == 0 {
+		spec.Memory = samDefaultMemorySize
+	}
+	if spec.Timeout == 0 {
+		spec.Timeout = samDefaultTimeout
+	}
+	for _, a := range fp.Architectures {
+		spec.Architectures = append(spec.Architectures, Architecture{Name: a})
+	}
+	if fp.EphemeralStorage != nil {
+		spec.EphemeralStorage = &EphemeralStorage{Size: fp.EphemeralStorage.Size}
+	}
+	if fp.VpcConfig != nil {
+		spec.VPCConfig = &VPCConfig{
+			SecurityGroupIDs: fp.VpcConfig.SecurityGroupIds,
+			SubnetIDs:        fp.VpcConfig.SubnetIds,
+		}
+	}
+
+	if len(fp.Tags) != 0 {
+		if err := json.Unmarshal(fp.Tags, &spec.Tags); err != nil {
+			var tags []struct {
+				Key   string `json:"Key"`
+				Value string `json:"Value"`
+			}
+			if err := json.Unmarshal(fp.Tags, &tags); err != nil {
+				return FunctionManifestSpec{}, fmt.Errorf("invalid Tags: %w", err)
+			}
+			spec.Tags = make(map[string]string, len(tags))
+			for _, t := range tags {
+				spec.Tags[t.Key] = t.Value
+			}
+		}
+	}
+
+	if fp.Code != nil {
+		spec.S3Bucket = fp.Code.S3Bucket
+		spec.S3Key = fp.Code.S3Key
+		spec.S3ObjectVersion = fp.Code.S3ObjectVersion
+		if fp.Code.ImageUri != "" {
+			spec.ImageURI = fp.Code.ImageUri
+		}
+	}
+	if len(fp.CodeUri) != 0 && string(fp.CodeUri) != "null" {
+		var uri string
+		if err := json.Unmarshal(fp.CodeUri, &uri); err == nil {
+			if !strings.HasPrefix(uri, "s3://") {
+				return FunctionManifestSpec{}, fmt.Errorf("CodeUri must be an S3 location since the local code can not be packaged by piped: %s", uri)
+			}
+			bucket, key, err := parseS3URI(uri)
+			if err != nil {
+				return FunctionManifestSpec{}, err
+			}
+			spec.S3Bucket, spec.S3Key = bucket, key
+		} else {
+			var loc struct {
+				Bucket  string `json:"Bucket"`
+				Key     string `json:"Key"`
+				Version string `json:"Version"`
+			}
+			if err := json.Unmarshal(fp.CodeUri, &loc); err != nil {
+				return FunctionManifestSpec{}, fmt.Errorf("invalid CodeUri: %w", err)
+			}
+			spec.S3Bucket, spec.S3Key, spec.S3ObjectVersion = loc.Bucket, loc.Key, loc.Version
+		}
+	}
+
+	names := make([]string, 0, len(fp.Events))
+	for name := range fp.Events {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		e := fp.Events[name]
+		m := EventSourceMapping{
+			BatchSize:        e.Properties.BatchSize,
+			Enabled:          e.Properties.Enabled,
+			StartingPosition: e.Properties.StartingPosition,
+		}
+		switch e.Type {
+		case "SQS":
+			m.EventSourceArn = e.Properties.Queue
+		case "Kinesis", "DynamoDB":
+			m.EventSourceArn = e.Properties.Stream
+		default:
+			// The other events are not backed by event source mappings.
+			continue
+		}
+		spec.EventSourceMappings = append(spec.EventSourceMappings, m)
+	}
+	return spec, nil
+}
+
+// refersFunction reports whether the given FunctionName property of an event source mapping
+// refers to the function with the given logical ID and name.
+// The mappings for the other functions are ignored even when they can not be resolved.
+func (r *samResolver) refersFunction(v interface{}, id, name string) bool {
+	if m, ok := v.(map[string]interface{}); ok && isIntrinsicFunction(m) {
+		if ref, ok := m["Ref"]; ok {
+			return ref == id
+		}
+		if attr, ok := m["Fn::GetAtt"]; ok {
+			switch a := attr.(type) {
+			case string:
+				return a == id+".Arn"
+			case []interface{}:
+				return len(a) == 2 && a[0] == id && a[1] == "Arn"
+			}
+		}
+	}
+	resolved, err := r.resolve(v)
+	if err != nil {
+		return false
+	}
+	ref, ok := resolved.(string)
+	return ok && isSameFunction(ref, name)
+}
+
+// isSameFunction reports whether the given function name, name with qualifier or ARN refers to the function.
+func isSameFunction(ref, name string) bool {
+	if ref == name || strings.HasPrefix(ref, name+":") {
+		return true
+	}
+	return strings.HasSuffix(ref, ":function:"+name) || strings.Contains(ref, ":function:"+name+":")
+}
+
+type samResolver struct {
+	values map[string]string
+}
+
+var samSubVariableRegex = regexp.MustCompile(`\$\{([^!}][^}]*)\}`)
+
+// resolve returns the given value with the supported intrinsic functions replaced by the resolved values.
+func (r *samResolver) resolve(v interface{}) (interface{}, error) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		if isIntrinsicFunction(t) {
+			for k, arg := range t {
+				return r.resolveFunction(k, arg)
+			}
+		}
+		out := make(map[string]interface{}, len(t))
+		for k, e := range t {
+			rv, err := r.resolve(e)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = rv
+		}
+		return out, nil
+	case []interface{}:
+		out := make([]interface{}, 0, len(t))
+		for _, e := range t {
+			rv, err := r.resolve(e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, rv)
+		}
+		return out, nil
+	default:
+		return v, nil
+	}
+}
+
+func (r *samResolver) resolveFunction(name string, arg interface{}) (interface{}, error) {
+	switch name {
+	case "Ref":
+		ref, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("invalid argument of Ref: %v", arg)
+		}
+		v, ok := r.values[ref]
+		if !ok {
+			return nil, fmt.Errorf("unable to resolve Ref %s, only the parameters and the deployed function can be referred", ref)
+		}
+		return v, nil
+	case "Fn::Sub":
+		s, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf("only the string argument of Fn::Sub is supported: %v", arg)
+		}
+		var missing []string
+		out := samSubVariableRegex.ReplaceAllStringFunc(s, func(m string) string {
+			ref := samSubVariableRegex.FindStringSubmatch(m)[1]
+			v, ok := r.values[ref]
+			if !ok {
+				missing = append(missing, ref)
+			}
+			return v
+		})
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("unable to resolve %v in Fn::Sub, only the parameters and the deployed function can be referred", missing)
+		}
+		return strings.ReplaceAll(out, "${!", "${"), nil
+	default:
+		return nil, fmt.Errorf("intrinsic function %s is not supported", name)
+	}
+}
+
+// samNodeToValue converts the given YAML node into a generic value while
+// converting the short form of the intrinsic functions such as "!Ref Param"
+// into the full form such as {"Ref": "Param"}.
+func samNodeToValue(n *yaml.Node) (interface{}, error) {
+	var (
+		v   interface{}
+		err error
+	)
+	switch n.Kind {
+	case yaml.DocumentNode:
+		if len(n.Content) == 0 {
+			return nil, nil
+		}
+		return samNodeToValue(n.Content[0])
+	case yaml.AliasNode:
+		return samNodeToValue(n.Alias)
+	case yaml.MappingNode:
+		m := make(map[string]interface{}, len(n.Content)/2)
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			value, err := samNodeToValue(n.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			m[n.Content[i].Value] = value
+		}
+		v = m
+	case yaml.SequenceNode:
+		s := make([]interface{}, 0, len(n.Content))
+		for _, c := range n.Content {
+			value, err := samNodeToValue(c)
+			if err != nil {
+				return nil, err
+			}
+			s = append(s, value)
+		}
+		v = s
+	case yaml.ScalarNode:
+		v, err = samScalarValue(n)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if !strings.HasPrefix(n.Tag, samIntrinsicFunctionTagPrefix) || strings.HasPrefix(n.Tag, "!!") {
+		return v, nil
+	}
+	name := strings.TrimPrefix(n.Tag, samIntrinsicFunctionTagPrefix)
+	if name != "Ref" {
+		name = samIntrinsicFunctionNamePrefix + name
+	}
+	return map[string]interface{}{name: v}, nil
+}
+
+func samScalarValue(n *yaml.Node) (interface{}, error) {
+	switch n.ShortTag() {
+	case "!!int":
+		return strconv.ParseInt(n.Value, 0, 64)
+	case "!!float":
+		return strconv.ParseFloat(n.Value, 64)
+	case "!!bool":
+		return strconv.ParseBool(n.Value)
+	case "!!null":
+		return nil, nil
+	default:
+		return n.Value, nil
+	}
+}
+
+// isIntrinsicFunction reports whether the given map is the full form of an intrinsic function.
+func isIntrinsicFunction(m map[string]interface{}) bool {
+	if len(m) != 1 {
+		return false
+	}
+	for k := range m {
+		return k == "Ref" || strings.HasPrefix(k, samIntrinsicFunctionNamePrefix)
+	}
+	return false
+}
+
+func withoutKey(m map[string]interface{}, key string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+	return out
+}
+
+func remarshal(in, out interface{}) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,261 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSAMTemplate(t *testing.T) {
+	t.Parallel()
+
+	enabled := false
+	testcases := []struct {
+		name     string
+		data     string
+		function string
+		params   map[string]string
+		want     FunctionManifest
+		wantErr  bool
+	}{
+		{
+			name: "serverless function with globals and parameters",
+			data: `
+AWSTemplateFormatVersion: "2010-09-09"
+Transform: AWS::Serverless-2016-10-31
+Parameters:
+  Stage:
+    Type: String
+    Default: dev
+  QueueArn:
+    Type: String
+Globals:
+  Function:
+    Runtime: python3.9
+    Timeout: 10
+    Environment:
+      Variables:
+        LOG_LEVEL: info
+Resources:
+  HelloFunction:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: !Sub hello-${Stage}
+      Role: arn:aws:iam::123456789012:role/lambda-role
+      Handler: app.handler
+      CodeUri: s3://bucket/hello.zip
+      MemorySize: 256
+      Architectures:
+        - arm64
+      Environment:
+        Variables:
+          STAGE: !Ref Stage
+      Tags:
+        app: hello
+      Events:
+        Queue:
+          Type: SQS
+          Properties:
+            Queue: !Ref QueueArn
+            BatchSize: 5
+            Enabled: false
+        Api:
+          Type: Api
+          Properties:
+            Path: /
+            Method: get
+`,
+			params: map[string]string{
+				"Stage":    "prod",
+				"QueueArn": "arn:aws:sqs:us-east-1:123456789012:queue",
+			},
+			want: FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionManifestSpec{
+					Name:     "hello-prod",
+					Role:     "arn:aws:iam::123456789012:role/lambda-role",
+					Handler:  "app.handler",
+					Runtime:  "python3.9",
+					Memory:   256,
+					Timeout:  10,
+					S3Bucket: "bucket",
+					S3Key:    "hello.zip",
+					Environments: map[string]string{
+						"LOG_LEVEL": "info",
+						"STAGE":     "prod",
+					},
+					Tags:          map[string]string{"app": "hello"},
+					Architectures: []Architecture{{Name: "arm64"}},
+					EventSourceMappings: []EventSourceMapping{
+						{EventSourceArn: "arn:aws:sqs:us-east-1:123456789012:queue", BatchSize: 5, Enabled: &enabled},
+					},
+				},
+			},
+		},
+		{
+			name: "cloudformation function with event source mapping resource",
+			data: `
+Resources:
+  Function:
+    Type: AWS::Lambda::Function
+    Properties:
+      FunctionName: worker
+      Role: arn:aws:iam::123456789012:role/lambda-role
+      Handler: index.handler
+      Runtime: nodejs18.x
+      Code:
+        S3Bucket: bucket
+        S3Key: worker.zip
+        S3ObjectVersion: v1
+      Tags:
+        - Key: app
+          Value: worker
+  Mapping:
+    Type: AWS::Lambda::EventSourceMapping
+    Properties:
+      FunctionName: !GetAtt Function.Arn
+      EventSourceArn: arn:aws:kinesis:us-east-1:123456789012:stream/stream
+      StartingPosition: LATEST
+  OtherMapping:
+    Type: AWS::Lambda::EventSourceMapping
+    Properties:
+      FunctionName: !Ref OtherFunction
+      EventSourceArn: arn:aws:sqs:us-east-1:123456789012:other
+`,
+			want: FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionManifestSpec{
+					Name:            "worker",
+					Role:            "arn:aws:iam::123456789012:role/lambda-role",
+					Handler:         "index.handler",
+					Runtime:         "nodejs18.x",
+					S3Bucket:        "bucket",
+					S3Key:           "worker.zip",
+					S3ObjectVersion: "v1",
+					Memory:          128,
+					Timeout:         3,
+					Tags:            map[string]string{"app": "worker"},
+					EventSourceMappings: []EventSourceMapping{
+						{EventSourceArn: "arn:aws:kinesis:us-east-1:123456789012:stream/stream", StartingPosition: "LATEST"},
+					},
+				},
+			},
+		},
+		{
+			name:     "specified function among multiple functions",
+			function: "Second",
+			data: `
+Resources:
+  First:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: first
+      ImageUri: ecr.region.amazonaws.com/first:v0.0.1
+  Second:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: second
+      Role: arn:aws:iam::123456789012:role/lambda-role
+      ImageUri: ecr.region.amazonaws.com/second:v0.0.1
+`,
+			want: FunctionManifest{
+				Kind:       "LambdaFunction",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: FunctionManifestSpec{
+					Name:                "second",
+					Role:                "arn:aws:iam::123456789012:role/lambda-role",
+					Memory:              128,
+					Timeout:             3,
+					ImageURI:            "ecr.region.amazonaws.com/second:v0.0.1",
+					EventSourceMappings: []EventSourceMapping{},
+				},
+			},
+		},
+		{
+			name: "multiple functions without specifying one",
+			data: `
+Resources:
+  First:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: first
+      ImageUri: ecr.region.amazonaws.com/first:v0.0.1
+  Second:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: second
+      ImageUri: ecr.region.amazonaws.com/second:v0.0.1
+`,
+			wantErr: true,
+		},
+		{
+			name: "local code uri",
+			data: `
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: hello
+      Handler: app.handler
+      Runtime: python3.9
+      CodeUri: ./src
+`,
+			wantErr: true,
+		},
+		{
+			name: "unsupported intrinsic function",
+			data: `
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      FunctionName: hello
+      Role: !GetAtt Role.Arn
+      ImageUri: ecr.region.amazonaws.com/hello:v0.0.1
+`,
+			wantErr: true,
+		},
+		{
+			name: "missing function name",
+			data: `
+Resources:
+  Function:
+    Type: AWS::Serverless::Function
+    Properties:
+      ImageUri: ecr.region.amazonaws.com/hello:v0.0.1
+`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseSAMTemplate([]byte(tc.data), tc.function, tc.params)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
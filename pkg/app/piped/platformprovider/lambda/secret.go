@@ -0,0 +1,78 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lambda
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"text/template"
+)
+
+type secretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
+// DecryptEnvironments replaces the references to the encrypted secrets in the environment
+// variables of the given function manifest, such as "{{ .encryptedSecrets.password }}",
+// with the decrypted values. This allows using the secrets in the function without
+// listing the function manifest file in the decryption targets.
+// The names of the variables containing secrets are returned in sorted order.
+func DecryptEnvironments(fm *FunctionManifest, encryptedSecrets map[string]string, dcr secretDecrypter) ([]string, error) {
+	var names []string
+	for name, value := range fm.Spec.Environments {
+		if strings.Contains(value, "{{") {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+	if dcr == nil {
+		return nil, fmt.Errorf("secret management is not configured in piped to decrypt the environment variables")
+	}
+	sort.Strings(names)
+
+	secrets := make(map[string]string, len(encryptedSecrets))
+	for k, v := range encryptedSecrets {
+		decrypted, err := dcr.Decrypt(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s secret (%w)", k, err)
+		}
+		secrets[k] = decrypted
+	}
+	data := map[string]interface{}{
+		"encryptedSecrets": secrets,
+	}
+
+	envs := make(map[string]string, len(fm.Spec.Environments))
+	for name, value := range fm.Spec.Environments {
+		envs[name] = value
+	}
+	for _, name := range names {
+		tmpl, err := template.New(name).Option("missingkey=error").Parse(envs[name])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse environment variable %s (%w)", name, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to decrypt environment variable %s (%w)", name, err)
+		}
+		envs[name] = buf.String()
+	}
+	fm.Spec.Environments = envs
+	return names, nil
+}
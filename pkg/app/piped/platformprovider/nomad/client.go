@@ -0,0 +1,284 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAddress = "http://127.0.0.1:4646"
+	requestTimeout = 30 * time.Second
+)
+
+type client struct {
+	address    string
+	region     string
+	namespace  string
+	token      string
+	httpClient *http.Client
+	logger     *zap.Logger
+}
+
+func newClient(address, region, namespace, tokenFile, caCertFile string, logger *zap.Logger) (*client, error) {
+	if address == "" {
+		address = os.Getenv("NOMAD_ADDR")
+	}
+	if address == "" {
+		address = defaultAddress
+	}
+
+	token := os.Getenv("NOMAD_TOKEN")
+	if tokenFile != "" {
+		data, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read token file %s: %w", tokenFile, err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caCertFile != "" {
+		pem, err := os.ReadFile(caCertFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA certificate file %s: %w", caCertFile, err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no valid certificate was found in %s", caCertFile)
+		}
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    pool,
+			MinVersion: tls.VersionTLS12,
+		}
+	}
+
+	return &client{
+		address:   strings.TrimSuffix(address, "/"),
+		region:    region,
+		namespace: namespace,
+		token:     token,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   requestTimeout,
+		},
+		logger: logger.Named("nomad"),
+	}, nil
+}
+
+func (c *client) ParseJob(ctx context.Context, hcl string, variables map[string]string) (*Job, error) {
+	in := struct {
+		JobHCL       string `json:"JobHCL"`
+		Variables    string `json:"Variables,omitempty"`
+		Canonicalize bool   `json:"Canonicalize"`
+	}{
+		JobHCL:       hcl,
+		Variables:    makeVariables(variables),
+		Canonicalize: true,
+	}
+	var job Job
+	if err := c.call(ctx, http.MethodPost, "/v1/jobs/parse", "", nil, in, &job); err != nil {
+		return nil, fmt.Errorf("failed to parse job: %w", err)
+	}
+	return &job, nil
+}
+
+// makeVariables returns the content of the variable file assigning the given values.
+func makeVariables(variables map[string]string) string {
+	if len(variables) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(variables))
+	for k := range variables {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		// The JSON string literal is also a valid HCL string literal.
+		v, _ := json.Marshal(variables[k])
+		fmt.Fprintf(&b, "%s = %s\n", k, v)
+	}
+	return b.String()
+}
+
+func (c *client) RegisterJob(ctx context.Context, job *Job) (uint64, error) {
+	in := struct {
+		Job *Job `json:"Job"`
+	}{
+		Job: job,
+	}
+	var out struct {
+		EvalID   string `json:"EvalID"`
+		Warnings string `json:"Warnings"`
+	}
+	if err := c.call(ctx, http.MethodPost, "/v1/jobs", job.Namespace(), nil, in, &out); err != nil {
+		return 0, fmt.Errorf("failed to register job %s: %w", job.ID(), err)
+	}
+	if out.Warnings != "" {
+		c.logger.Warn(fmt.Sprintf("job %s was registered with warnings: %s", job.ID(), out.Warnings))
+	}
+
+	registered, err := c.GetJob(ctx, job.Namespace(), job.ID())
+	if err != nil {
+		return 0, err
+	}
+	return registered.Version(), nil
+}
+
+func (c *client) GetJob(ctx context.Context, namespace, jobID string) (*Job, error) {
+	var job Job
+	if err := c.call(ctx, http.MethodGet, "/v1/job/"+url.PathEscape(jobID), namespace, nil, nil, &job); err != nil {
+		return nil, fmt.Errorf("failed to get job %s: %w", jobID, err)
+	}
+	return &job, nil
+}
+
+func (c *client) ListJobs(ctx context.Context) ([]JobStub, error) {
+	query := url.Values{"meta": {"true"}}
+	var jobs []JobStub
+	if err := c.call(ctx, http.MethodGet, "/v1/jobs", "*", query, nil, &jobs); err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+func (c *client) LatestDeployment(ctx context.Context, namespace, jobID string) (*Deployment, error) {
+	var d *Deployment
+	if err := c.call(ctx, http.MethodGet, "/v1/job/"+url.PathEscape(jobID)+"/deployment", namespace, nil, nil, &d); err != nil {
+		return nil, fmt.Errorf("failed to get the latest deployment of job %s: %w", jobID, err)
+	}
+	// The API returns null when the job has no deployment.
+	if d == nil {
+		return nil, ErrNotFound
+	}
+	return d, nil
+}
+
+func (c *client) PromoteDeployment(ctx context.Context, namespace, deploymentID string) error {
+	in := struct {
+		DeploymentID string `json:"DeploymentID"`
+		All          bool   `json:"All"`
+	}{
+		DeploymentID: deploymentID,
+		All:          true,
+	}
+	if err := c.call(ctx, http.MethodPost, "/v1/deployment/promote/"+url.PathEscape(deploymentID), namespace, nil, in, nil); err != nil {
+		return fmt.Errorf("failed to promote deployment %s: %w", deploymentID, err)
+	}
+	return nil
+}
+
+func (c *client) FailDeployment(ctx context.Context, namespace, deploymentID string) error {
+	if err := c.call(ctx, http.MethodPost, "/v1/deployment/fail/"+url.PathEscape(deploymentID), namespace, nil, nil, nil); err != nil {
+		return fmt.Errorf("failed to fail deployment %s: %w", deploymentID, err)
+	}
+	return nil
+}
+
+func (c *client) ListJobAllocations(ctx context.Context, namespace, jobID string) ([]Allocation, error) {
+	var allocs []Allocation
+	if err := c.call(ctx, http.MethodGet, "/v1/job/"+url.PathEscape(jobID)+"/allocations", namespace, nil, nil, &allocs); err != nil {
+		return nil, fmt.Errorf("failed to list allocations of job %s: %w", jobID, err)
+	}
+	return allocs, nil
+}
+
+// call sends a request to the given path of the HTTP API and decodes the response into out.
+// The namespace of the platform provider is used when the given namespace is empty.
+func (c *client) call(ctx context.Context, method, path, namespace string, query url.Values, in, out interface{}) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	if namespace == "" {
+		namespace = c.namespace
+	}
+	if namespace != "" {
+		query.Set("namespace", namespace)
+	}
+	if c.region != "" {
+		query.Set("region", c.region)
+	}
+
+	var body io.Reader
+	if in != nil {
+		data, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(data)
+	}
+
+	u := c.address + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, body)
+	if err != nil {
+		return err
+	}
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.token != "" {
+		req.Header.Set("X-Nomad-Token", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read the response: %w", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNotFound
+	}
+	if resp.StatusCode >= 300 {
+		return &APIError{StatusCode: resp.StatusCode, Message: strings.TrimSpace(string(data))}
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(data, out)
+}
+
+// APIError represents an error returned by Nomad HTTP API.
+type APIError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *APIError) Error() string {
+	return fmt.Sprintf("unexpected response code %d: %s", e.StatusCode, e.Message)
+}
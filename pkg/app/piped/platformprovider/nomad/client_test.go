@@ -0,0 +1,130 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	return &client{
+		address:    ts.URL,
+		region:     "global",
+		namespace:  "apps",
+		token:      "secret",
+		httpClient: ts.Client(),
+		logger:     zap.NewNop(),
+	}
+}
+
+func TestClientParseJob(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/v1/jobs/parse", r.URL.Path)
+		assert.Equal(t, "apps", r.URL.Query().Get("namespace"))
+		assert.Equal(t, "global", r.URL.Query().Get("region"))
+		assert.Equal(t, "secret", r.Header.Get("X-Nomad-Token"))
+
+		var in map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+		assert.Equal(t, `job "web" {}`, in["JobHCL"])
+		assert.Equal(t, "count = \"2\"\ntag = \"v1\\\"\"\n", in["Variables"])
+		assert.Equal(t, true, in["Canonicalize"])
+
+		w.Write([]byte(`{"ID": "web", "Type": "service"}`))
+	})
+
+	job, err := c.ParseJob(context.Background(), `job "web" {}`, map[string]string{"tag": `v1"`, "count": "2"})
+	require.NoError(t, err)
+	assert.Equal(t, "web", job.ID())
+}
+
+func TestClientLatestDeployment(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		status      int
+		body        string
+		expected    *Deployment
+		expectedErr error
+	}{
+		{
+			name:     "found",
+			status:   http.StatusOK,
+			body:     `{"ID": "d1", "JobID": "web", "JobVersion": 2, "Status": "running"}`,
+			expected: &Deployment{ID: "d1", JobID: "web", JobVersion: 2, Status: "running"},
+		},
+		{
+			name:        "job has no deployment",
+			status:      http.StatusOK,
+			body:        `null`,
+			expectedErr: ErrNotFound,
+		},
+		{
+			name:        "job not found",
+			status:      http.StatusNotFound,
+			body:        `job not found`,
+			expectedErr: ErrNotFound,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/v1/job/web/deployment", r.URL.Path)
+				assert.Equal(t, "default", r.URL.Query().Get("namespace"))
+				w.WriteHeader(tc.status)
+				w.Write([]byte(tc.body))
+			})
+			d, err := c.LatestDeployment(context.Background(), "default", "web")
+			assert.Equal(t, tc.expected, d)
+			if tc.expectedErr != nil {
+				assert.True(t, errors.Is(err, tc.expectedErr))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestClientAPIError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("Permission denied\n"))
+	})
+
+	err := c.PromoteDeployment(context.Background(), "", "d1")
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusForbidden, apiErr.StatusCode)
+	assert.Equal(t, "Permission denied", apiErr.Message)
+}
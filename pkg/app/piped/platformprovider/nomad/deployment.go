@@ -0,0 +1,152 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// The statuses of Nomad deployment.
+const (
+	DeploymentStatusRunning      = "running"
+	DeploymentStatusPaused       = "paused"
+	DeploymentStatusFailed       = "failed"
+	DeploymentStatusSuccessful   = "successful"
+	DeploymentStatusCancelled    = "cancelled"
+	DeploymentStatusPending      = "pending"
+	DeploymentStatusBlocked      = "blocked"
+	DeploymentStatusUnblocking   = "unblocking"
+	DeploymentStatusInitializing = "initializing"
+)
+
+// Deployment represents a Nomad deployment rolling out a version of the job.
+type Deployment struct {
+	ID                string                     `json:"ID"`
+	Namespace         string                     `json:"Namespace"`
+	JobID             string                     `json:"JobID"`
+	JobVersion        uint64                     `json:"JobVersion"`
+	Status            string                     `json:"Status"`
+	StatusDescription string                     `json:"StatusDescription"`
+	TaskGroups        map[string]DeploymentState `json:"TaskGroups"`
+	CreateTime        int64                      `json:"CreateTime"`
+	ModifyTime        int64                      `json:"ModifyTime"`
+}
+
+// DeploymentState represents the progress of a task group in the deployment.
+type DeploymentState struct {
+	AutoPromote     bool     `json:"AutoPromote"`
+	AutoRevert      bool     `json:"AutoRevert"`
+	Promoted        bool     `json:"Promoted"`
+	PlacedCanaries  []string `json:"PlacedCanaries"`
+	DesiredCanaries int      `json:"DesiredCanaries"`
+	DesiredTotal    int      `json:"DesiredTotal"`
+	PlacedAllocs    int      `json:"PlacedAllocs"`
+	HealthyAllocs   int      `json:"HealthyAllocs"`
+	UnhealthyAllocs int      `json:"UnhealthyAllocs"`
+}
+
+// Active reports whether the deployment is still in progress.
+func (d *Deployment) Active() bool {
+	switch d.Status {
+	case DeploymentStatusSuccessful, DeploymentStatusFailed, DeploymentStatusCancelled:
+		return false
+	}
+	return true
+}
+
+// RequiresPromotion reports whether any task group has canaries which have not been promoted yet.
+func (d *Deployment) RequiresPromotion() bool {
+	for _, s := range d.TaskGroups {
+		if s.DesiredCanaries > 0 && !s.Promoted {
+			return true
+		}
+	}
+	return false
+}
+
+// CanariesHealthy reports whether all canaries of the task groups requiring promotion have become healthy.
+func (d *Deployment) CanariesHealthy() bool {
+	for _, s := range d.TaskGroups {
+		if s.DesiredCanaries == 0 || s.Promoted {
+			continue
+		}
+		// The healthy allocations are only the canaries until the promotion.
+		if len(s.PlacedCanaries) < s.DesiredCanaries || s.HealthyAllocs < s.DesiredCanaries {
+			return false
+		}
+	}
+	return true
+}
+
+// Progress returns the human-readable progress of the task groups,
+// e.g. "web: 1/3 healthy, 1/1 canaries".
+func (d *Deployment) Progress() string {
+	names := make([]string, 0, len(d.TaskGroups))
+	for name := range d.TaskGroups {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		s := d.TaskGroups[name]
+		p := fmt.Sprintf("%s: %d/%d healthy", name, s.HealthyAllocs, s.DesiredTotal)
+		if s.DesiredCanaries > 0 {
+			p += fmt.Sprintf(", %d/%d canaries", len(s.PlacedCanaries), s.DesiredCanaries)
+		}
+		if s.UnhealthyAllocs > 0 {
+			p += fmt.Sprintf(", %d unhealthy", s.UnhealthyAllocs)
+		}
+		parts = append(parts, p)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// The client statuses of Nomad allocation.
+const (
+	AllocClientStatusPending  = "pending"
+	AllocClientStatusRunning  = "running"
+	AllocClientStatusComplete = "complete"
+	AllocClientStatusFailed   = "failed"
+	AllocClientStatusLost     = "lost"
+	AllocClientStatusUnknown  = "unknown"
+)
+
+// Allocation represents a summary of an allocation placing a task group of the job on a client node.
+type Allocation struct {
+	ID                string                      `json:"ID"`
+	Name              string                      `json:"Name"`
+	Namespace         string                      `json:"Namespace"`
+	NodeName          string                      `json:"NodeName"`
+	TaskGroup         string                      `json:"TaskGroup"`
+	JobVersion        uint64                      `json:"JobVersion"`
+	DeploymentID      string                      `json:"DeploymentID"`
+	DesiredStatus     string                      `json:"DesiredStatus"`
+	ClientStatus      string                      `json:"ClientStatus"`
+	ClientDescription string                      `json:"ClientDescription"`
+	DeploymentStatus  *AllocationDeploymentStatus `json:"DeploymentStatus"`
+	// The timestamps in nanoseconds.
+	CreateTime int64 `json:"CreateTime"`
+	ModifyTime int64 `json:"ModifyTime"`
+}
+
+// AllocationDeploymentStatus represents the health of the allocation reported to the deployment.
+type AllocationDeploymentStatus struct {
+	// Nil means the health has not been determined yet.
+	Healthy *bool `json:"Healthy"`
+	Canary  bool  `json:"Canary"`
+}
@@ -0,0 +1,306 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	JobTypeService  = "service"
+	JobTypeBatch    = "batch"
+	JobTypeSystem   = "system"
+	JobTypeSysBatch = "sysbatch"
+)
+
+// JobSpec is the content of a job specification file written in HCL or JSON.
+type JobSpec struct {
+	Name   string
+	Data   []byte
+	IsJSON bool
+}
+
+// Job represents a Nomad job in the JSON format of the HTTP API.
+// The fields not used by piped are kept as is, so the job can be registered without any loss.
+type Job struct {
+	raw map[string]interface{}
+}
+
+// DecodeJob returns the job written in the JSON job specification.
+// Both the job object and the one wrapped by "Job" key as the output of "nomad job run -output" are accepted.
+func DecodeJob(data []byte) (*Job, error) {
+	var raw map[string]interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	// Keep the numbers as is to not lose the precision of large values such as durations in nanoseconds.
+	d.UseNumber()
+	if err := d.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if wrapped, ok := raw["Job"].(map[string]interface{}); ok {
+		raw = wrapped
+	}
+	job := &Job{raw: raw}
+	if job.ID() == "" {
+		return nil, fmt.Errorf("missing ID of the job")
+	}
+	return job, nil
+}
+
+func (j *Job) MarshalJSON() ([]byte, error) {
+	return json.Marshal(j.raw)
+}
+
+func (j *Job) UnmarshalJSON(data []byte) error {
+	job, err := DecodeJob(data)
+	if err != nil {
+		return err
+	}
+	*j = *job
+	return nil
+}
+
+// ID returns the ID of the job.
+func (j *Job) ID() string {
+	return stringField(j.raw, "ID")
+}
+
+// Namespace returns the namespace of the job.
+// Empty means the namespace of the platform provider is used.
+func (j *Job) Namespace() string {
+	return stringField(j.raw, "Namespace")
+}
+
+// Type returns the type of the job such as service and batch.
+func (j *Job) Type() string {
+	if t := stringField(j.raw, "Type"); t != "" {
+		return t
+	}
+	return JobTypeService
+}
+
+// Version returns the version of the registered job.
+func (j *Job) Version() uint64 {
+	return uintField(j.raw, "Version")
+}
+
+// Meta returns the value of the given meta key.
+func (j *Job) Meta(key string) string {
+	meta, _ := j.raw["Meta"].(map[string]interface{})
+	return stringField(meta, key)
+}
+
+// SetMeta sets the given meta to the job.
+func (j *Job) SetMeta(meta map[string]string) {
+	m, _ := j.raw["Meta"].(map[string]interface{})
+	if m == nil {
+		m = make(map[string]interface{}, len(meta))
+	}
+	for k, v := range meta {
+		m[k] = v
+	}
+	j.raw["Meta"] = m
+}
+
+// IsBatch reports whether the job runs its tasks to completion,
+// in which case no deployment is created by Nomad.
+func (j *Job) IsBatch() bool {
+	return isBatch(j.Type())
+}
+
+// Canaries returns the total number of the canary allocations
+// created for each update of the job.
+func (j *Job) Canaries() int {
+	jobUpdate, _ := j.raw["Update"].(map[string]interface{})
+	var total int
+	for _, tg := range j.taskGroups() {
+		update, ok := tg["Update"].(map[string]interface{})
+		if !ok {
+			update = jobUpdate
+		}
+		total += int(uintField(update, "Canary"))
+	}
+	return total
+}
+
+// Images returns the unique container images used by the tasks of the job.
+func (j *Job) Images() []string {
+	var (
+		images []string
+		seen   = make(map[string]struct{})
+	)
+	for _, tg := range j.taskGroups() {
+		tasks, _ := tg["Tasks"].([]interface{})
+		for _, t := range tasks {
+			task, _ := t.(map[string]interface{})
+			cfg, _ := task["Config"].(map[string]interface{})
+			image := stringField(cfg, "image")
+			if image == "" {
+				continue
+			}
+			if _, ok := seen[image]; ok {
+				continue
+			}
+			seen[image] = struct{}{}
+			images = append(images, image)
+		}
+	}
+	return images
+}
+
+func isBatch(jobType string) bool {
+	return jobType == JobTypeBatch || jobType == JobTypeSysBatch
+}
+
+func (j *Job) taskGroups() []map[string]interface{} {
+	groups, _ := j.raw["TaskGroups"].([]interface{})
+	out := make([]map[string]interface{}, 0, len(groups))
+	for _, g := range groups {
+		if tg, ok := g.(map[string]interface{}); ok {
+			out = append(out, tg)
+		}
+	}
+	return out
+}
+
+func stringField(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+func uintField(m map[string]interface{}, key string) uint64 {
+	switch v := m[key].(type) {
+	case json.Number:
+		n, _ := v.Int64()
+		if n > 0 {
+			return uint64(n)
+		}
+	case float64:
+		if v > 0 {
+			return uint64(v)
+		}
+	}
+	return 0
+}
+
+// JobStub is the summary of a job returned by the job list API.
+type JobStub struct {
+	ID        string            `json:"ID"`
+	Name      string            `json:"Name"`
+	Namespace string            `json:"Namespace"`
+	Type      string            `json:"Type"`
+	Status    string            `json:"Status"`
+	Stop      bool              `json:"Stop"`
+	Version   uint64            `json:"Version"`
+	Meta      map[string]string `json:"Meta"`
+}
+
+// FindArtifactVersions parses artifact versions from the container images used by the tasks.
+// The images written in HCL are found without resolving the variables,
+// so the ones using variables or functions are ignored.
+func FindArtifactVersions(spec JobSpec) ([]*model.ArtifactVersion, error) {
+	var images []string
+	if spec.IsJSON {
+		job, err := DecodeJob(spec.Data)
+		if err != nil {
+			return nil, err
+		}
+		images = job.Images()
+	} else {
+		var err error
+		if images, err = findHCLImages(spec); err != nil {
+			return nil, err
+		}
+	}
+
+	versions := make([]*model.ArtifactVersion, 0, len(images))
+	for _, image := range images {
+		name, tag := parseContainerImage(image)
+		if name == "" {
+			return nil, fmt.Errorf("image name could not be empty")
+		}
+		versions = append(versions, &model.ArtifactVersion{
+			Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+			Version: tag,
+			Name:    name,
+			Url:     image,
+		})
+	}
+	return versions, nil
+}
+
+// findHCLImages returns the literal "image" values in the "config" blocks of the tasks.
+func findHCLImages(spec JobSpec) ([]string, error) {
+	f, diags := hclsyntax.ParseConfig(spec.Data, spec.Name, hcl.Pos{Line: 1, Column: 1})
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	body, ok := f.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("unexpected body type of %s", spec.Name)
+	}
+
+	var (
+		images []string
+		seen   = make(map[string]struct{})
+		walk   func(b *hclsyntax.Body, parent string)
+	)
+	walk = func(b *hclsyntax.Body, parent string) {
+		for _, block := range b.Blocks {
+			if block.Type == "config" && parent == "task" {
+				attr, ok := block.Body.Attributes["image"]
+				if !ok {
+					continue
+				}
+				v, diags := attr.Expr.Value(nil)
+				if diags.HasErrors() || !v.Type().Equals(cty.String) || v.IsNull() {
+					continue
+				}
+				image := v.AsString()
+				if _, ok := seen[image]; ok {
+					continue
+				}
+				seen[image] = struct{}{}
+				images = append(images, image)
+				continue
+			}
+			walk(block.Body, block.Type)
+		}
+	}
+	walk(body, "")
+	return images, nil
+}
+
+// parseContainerImage returns the name and the tag of the given image.
+func parseContainerImage(image string) (name, tag string) {
+	// The image can be pinned by its digest instead of its tag.
+	if i := strings.Index(image, "@"); i >= 0 {
+		image, tag = image[:i], image[i+1:]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		image, tag = image[:i], image[i+1:]
+	}
+	paths := strings.Split(image, "/")
+	name = paths[len(paths)-1]
+	return
+}
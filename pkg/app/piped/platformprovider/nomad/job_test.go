@@ -0,0 +1,178 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const testJSONJob = `{
+  "Job": {
+    "ID": "web",
+    "Namespace": "apps",
+    "Version": 3,
+    "Update": {"Canary": 1, "MinHealthyTime": 10000000000},
+    "TaskGroups": [
+      {
+        "Name": "web",
+        "Tasks": [
+          {"Name": "server", "Driver": "docker", "Config": {"image": "ghcr.io/pipe-cd/helloworld:v0.30.0"}},
+          {"Name": "sidecar", "Driver": "docker", "Config": {"image": "envoyproxy/envoy:v1.26.0"}}
+        ]
+      },
+      {
+        "Name": "worker",
+        "Update": {"Canary": 2},
+        "Tasks": [
+          {"Name": "worker", "Driver": "docker", "Config": {"image": "ghcr.io/pipe-cd/helloworld:v0.30.0"}}
+        ]
+      }
+    ]
+  }
+}`
+
+func TestDecodeJob(t *testing.T) {
+	t.Parallel()
+
+	job, err := DecodeJob([]byte(testJSONJob))
+	require.NoError(t, err)
+
+	assert.Equal(t, "web", job.ID())
+	assert.Equal(t, "apps", job.Namespace())
+	assert.Equal(t, JobTypeService, job.Type())
+	assert.Equal(t, uint64(3), job.Version())
+	assert.False(t, job.IsBatch())
+	assert.Equal(t, 3, job.Canaries())
+	assert.Equal(t, []string{"ghcr.io/pipe-cd/helloworld:v0.30.0", "envoyproxy/envoy:v1.26.0"}, job.Images())
+
+	job.SetMeta(map[string]string{LabelManagedBy: ManagedByPiped})
+	assert.Equal(t, ManagedByPiped, job.Meta(LabelManagedBy))
+
+	// The large numbers must be kept as is.
+	data, err := json.Marshal(job)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"MinHealthyTime":10000000000`)
+
+	_, err = DecodeJob([]byte(`{"Name": "web"}`))
+	assert.Error(t, err)
+}
+
+func TestFindArtifactVersions(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		spec     JobSpec
+		expected []*model.ArtifactVersion
+		wantErr  bool
+	}{
+		{
+			name: "json job specification",
+			spec: JobSpec{Name: "job.json", Data: []byte(testJSONJob), IsJSON: true},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v0.30.0",
+					Name:    "helloworld",
+					Url:     "ghcr.io/pipe-cd/helloworld:v0.30.0",
+				},
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v1.26.0",
+					Name:    "envoy",
+					Url:     "envoyproxy/envoy:v1.26.0",
+				},
+			},
+		},
+		{
+			name: "hcl job specification",
+			spec: JobSpec{Name: "job.nomad.hcl", Data: []byte(`
+variable "tag" {
+  type    = string
+  default = "v0.1.0"
+}
+
+job "web" {
+  group "web" {
+    task "server" {
+      driver = "docker"
+      config {
+        image = "ghcr.io/pipe-cd/helloworld:v0.30.0"
+      }
+    }
+    task "sidecar" {
+      driver = "docker"
+      config {
+        image = "envoyproxy/envoy:${var.tag}"
+      }
+    }
+  }
+}
+`)},
+			expected: []*model.ArtifactVersion{
+				{
+					Kind:    model.ArtifactVersion_CONTAINER_IMAGE,
+					Version: "v0.30.0",
+					Name:    "helloworld",
+					Url:     "ghcr.io/pipe-cd/helloworld:v0.30.0",
+				},
+			},
+		},
+		{
+			name:    "malformed hcl job specification",
+			spec:    JobSpec{Name: "job.nomad.hcl", Data: []byte(`job "web" {`)},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			versions, err := FindArtifactVersions(tc.spec)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestParseContainerImage(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		image        string
+		expectedName string
+		expectedTag  string
+	}{
+		{image: "nginx", expectedName: "nginx"},
+		{image: "nginx:1.25", expectedName: "nginx", expectedTag: "1.25"},
+		{image: "localhost:5000/team/app:v1", expectedName: "app", expectedTag: "v1"},
+		{image: "ghcr.io/pipe-cd/app@sha256:abc", expectedName: "app", expectedTag: "sha256:abc"},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.image, func(t *testing.T) {
+			t.Parallel()
+			name, tag := parseContainerImage(tc.image)
+			assert.Equal(t, tc.expectedName, name)
+			assert.Equal(t, tc.expectedTag, tag)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The keys of the job meta added by piped.
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+)
+
+// ErrNotFound is returned when the requested job or deployment does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of Nomad HTTP API.
+type Client interface {
+	// ParseJob converts the given HCL job specification into a job
+	// by resolving the given input variables.
+	ParseJob(ctx context.Context, hcl string, variables map[string]string) (*Job, error)
+	// RegisterJob registers the given job and returns its new version.
+	RegisterJob(ctx context.Context, job *Job) (uint64, error)
+	// GetJob returns the job having the given ID.
+	// ErrNotFound is returned when there is no such job.
+	GetJob(ctx context.Context, namespace, jobID string) (*Job, error)
+	// ListJobs returns the jobs in all namespaces together with their meta.
+	ListJobs(ctx context.Context) ([]JobStub, error)
+	// LatestDeployment returns the most recent deployment of the given job.
+	// ErrNotFound is returned when the job has never been deployed.
+	LatestDeployment(ctx context.Context, namespace, jobID string) (*Deployment, error)
+	PromoteDeployment(ctx context.Context, namespace, deploymentID string) error
+	FailDeployment(ctx context.Context, namespace, deploymentID string) error
+	ListJobAllocations(ctx context.Context, namespace, jobID string) ([]Allocation, error)
+}
+
+// Registry holds a pool of nomad client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderNomadConfig, logger *zap.Logger) (Client, error)
+}
+
+// LoadJobSpec returns JobSpec object from a given job specification file.
+func LoadJobSpec(appDir, jobFile string) (JobSpec, error) {
+	path := filepath.Join(appDir, jobFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return JobSpec{}, err
+	}
+	if len(data) == 0 {
+		return JobSpec{}, fmt.Errorf("job specification %s is empty", jobFile)
+	}
+	return JobSpec{
+		Name:   jobFile,
+		Data:   data,
+		IsJSON: filepath.Ext(jobFile) == ".json",
+	}, nil
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderNomadConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.Address, cfg.Region, cfg.Namespace, cfg.TokenFile, cfg.CACertFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of nomad clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
@@ -0,0 +1,170 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	jobKind        = "Job"
+	deploymentKind = "Deployment"
+	allocationKind = "Allocation"
+
+	// The desired status of the allocations which should be running.
+	allocDesiredStatusRun = "run"
+)
+
+// MakeResourceStates returns the states of the given job, its latest deployment and its allocations.
+// Allocations placed by the deployment are placed under that deployment, the others are placed under the job.
+// The allocations which are being stopped are ignored.
+func MakeResourceStates(job JobStub, deployment *Deployment, allocs []Allocation, updatedAt time.Time) []*model.NomadResourceState {
+	states := make([]*model.NomadResourceState, 0, len(allocs)+2)
+
+	// Set job state.
+	jobID := job.Namespace + "/" + job.ID
+	status, desc := jobHealthStatus(job)
+	states = append(states, makeResourceState(
+		jobID,
+		nil,
+		job.Name,
+		jobKind,
+		status,
+		desc,
+		0,
+		updatedAt,
+	))
+
+	// Set deployment state.
+	if deployment != nil {
+		status, desc := deploymentHealthStatus(deployment)
+		states = append(states, makeResourceState(
+			deployment.ID,
+			[]string{jobID},
+			fmt.Sprintf("%s (version %d)", deployment.ID[:shortIDLength(deployment.ID)], deployment.JobVersion),
+			deploymentKind,
+			status,
+			desc,
+			deployment.CreateTime,
+			updatedAt,
+		))
+	}
+
+	// Set allocation states.
+	for _, a := range allocs {
+		if a.DesiredStatus != allocDesiredStatusRun {
+			continue
+		}
+		parent := jobID
+		if deployment != nil && a.DeploymentID == deployment.ID {
+			parent = deployment.ID
+		}
+
+		status, desc := allocationHealthStatus(job.Type, a)
+		states = append(states, makeResourceState(
+			a.ID,
+			[]string{parent},
+			a.Name,
+			allocationKind,
+			status,
+			desc,
+			a.CreateTime,
+			updatedAt,
+		))
+	}
+
+	return states
+}
+
+func shortIDLength(id string) int {
+	if len(id) < 8 {
+		return len(id)
+	}
+	return 8
+}
+
+func makeResourceState(id string, parentIDs []string, name, kind string, status model.NomadResourceState_HealthStatus, desc string, createdAt int64, updatedAt time.Time) *model.NomadResourceState {
+	// The creation time is not given for jobs.
+	creationTime := updatedAt
+	if createdAt > 0 {
+		creationTime = time.Unix(0, createdAt)
+	}
+
+	return &model.NomadResourceState{
+		Id:        id,
+		OwnerIds:  parentIDs,
+		ParentIds: parentIDs,
+		Name:      name,
+		Kind:      kind,
+
+		HealthStatus:      status,
+		HealthDescription: desc,
+
+		CreatedAt: creationTime.Unix(),
+		UpdatedAt: updatedAt.Unix(),
+	}
+}
+
+func jobHealthStatus(job JobStub) (model.NomadResourceState_HealthStatus, string) {
+	if job.Stop {
+		return model.NomadResourceState_OTHER, "Job is stopped"
+	}
+	desc := fmt.Sprintf("Job is %s at version %d", job.Status, job.Version)
+	if job.Status == "dead" && !isBatch(job.Type) {
+		return model.NomadResourceState_OTHER, desc
+	}
+	return model.NomadResourceState_HEALTHY, desc
+}
+
+func deploymentHealthStatus(d *Deployment) (model.NomadResourceState_HealthStatus, string) {
+	desc := fmt.Sprintf("Deployment is %s", d.Status)
+	if d.StatusDescription != "" {
+		desc = fmt.Sprintf("%s: %s", desc, d.StatusDescription)
+	}
+	if p := d.Progress(); p != "" {
+		desc = fmt.Sprintf("%s (%s)", desc, p)
+	}
+	if d.Status != DeploymentStatusSuccessful {
+		return model.NomadResourceState_OTHER, desc
+	}
+	return model.NomadResourceState_HEALTHY, desc
+}
+
+func allocationHealthStatus(jobType string, a Allocation) (model.NomadResourceState_HealthStatus, string) {
+	desc := fmt.Sprintf("Allocation of version %d is %s", a.JobVersion, a.ClientStatus)
+	if a.NodeName != "" {
+		desc = fmt.Sprintf("%s on node %s", desc, a.NodeName)
+	}
+	if ds := a.DeploymentStatus; ds != nil && ds.Canary {
+		desc += " as a canary"
+	}
+	// The allocations of batch jobs complete once their tasks have finished successfully.
+	if a.ClientStatus == AllocClientStatusComplete && isBatch(jobType) {
+		return model.NomadResourceState_HEALTHY, desc
+	}
+	if a.ClientStatus != AllocClientStatusRunning {
+		if a.ClientDescription != "" {
+			desc = fmt.Sprintf("%s: %s", desc, a.ClientDescription)
+		}
+		return model.NomadResourceState_OTHER, desc
+	}
+	if ds := a.DeploymentStatus; ds != nil && ds.Healthy != nil && !*ds.Healthy {
+		return model.NomadResourceState_OTHER, desc + " but unhealthy"
+	}
+	return model.NomadResourceState_HEALTHY, desc
+}
@@ -0,0 +1,197 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nomad
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDeployment(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name                      string
+		deployment                *Deployment
+		expectedRequiresPromotion bool
+		expectedCanariesHealthy   bool
+		expectedProgress          string
+	}{
+		{
+			name: "no canary",
+			deployment: &Deployment{TaskGroups: map[string]DeploymentState{
+				"web": {DesiredTotal: 3, HealthyAllocs: 1},
+			}},
+			expectedCanariesHealthy: true,
+			expectedProgress:        "web: 1/3 healthy",
+		},
+		{
+			name: "canaries are being placed",
+			deployment: &Deployment{TaskGroups: map[string]DeploymentState{
+				"web":    {DesiredTotal: 3, DesiredCanaries: 2, PlacedCanaries: []string{"a1", "a2"}, HealthyAllocs: 1},
+				"worker": {DesiredTotal: 1},
+			}},
+			expectedRequiresPromotion: true,
+			expectedProgress:          "web: 1/3 healthy, 2/2 canaries; worker: 0/1 healthy",
+		},
+		{
+			name: "canaries are healthy",
+			deployment: &Deployment{TaskGroups: map[string]DeploymentState{
+				"web": {DesiredTotal: 3, DesiredCanaries: 1, PlacedCanaries: []string{"a1"}, HealthyAllocs: 1, UnhealthyAllocs: 1},
+			}},
+			expectedRequiresPromotion: true,
+			expectedCanariesHealthy:   true,
+			expectedProgress:          "web: 1/3 healthy, 1/1 canaries, 1 unhealthy",
+		},
+		{
+			name: "canaries were promoted",
+			deployment: &Deployment{TaskGroups: map[string]DeploymentState{
+				"web": {DesiredTotal: 3, DesiredCanaries: 1, PlacedCanaries: []string{"a1"}, Promoted: true},
+			}},
+			expectedCanariesHealthy: true,
+			expectedProgress:        "web: 0/3 healthy, 1/1 canaries",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expectedRequiresPromotion, tc.deployment.RequiresPromotion())
+			assert.Equal(t, tc.expectedCanariesHealthy, tc.deployment.CanariesHealthy())
+			assert.Equal(t, tc.expectedProgress, tc.deployment.Progress())
+		})
+	}
+}
+
+func TestMakeResourceStates(t *testing.T) {
+	t.Parallel()
+
+	var (
+		now     = time.Unix(1700000000, 0)
+		healthy = true
+		job     = JobStub{ID: "web", Name: "web", Namespace: "default", Type: JobTypeService, Status: "running", Version: 2}
+		d       = &Deployment{
+			ID:         "0123456789abcdef",
+			JobVersion: 2,
+			Status:     DeploymentStatusRunning,
+			TaskGroups: map[string]DeploymentState{
+				"web": {DesiredTotal: 2, DesiredCanaries: 1, PlacedCanaries: []string{"a2"}, HealthyAllocs: 1},
+			},
+			CreateTime: now.Add(-time.Minute).UnixNano(),
+		}
+		allocs = []Allocation{
+			{ID: "a1", Name: "web.web[0]", JobVersion: 1, DesiredStatus: "run", ClientStatus: AllocClientStatusRunning, NodeName: "node-1"},
+			{ID: "a2", Name: "web.web[1]", JobVersion: 2, DeploymentID: d.ID, DesiredStatus: "run", ClientStatus: AllocClientStatusRunning, DeploymentStatus: &AllocationDeploymentStatus{Healthy: &healthy, Canary: true}},
+			{ID: "a0", Name: "web.web[0]", JobVersion: 0, DesiredStatus: "stop", ClientStatus: AllocClientStatusComplete},
+		}
+	)
+
+	states := MakeResourceStates(job, d, allocs, now)
+	for _, s := range states {
+		s.CreatedAt, s.UpdatedAt = 0, 0
+	}
+	expected := []*model.NomadResourceState{
+		{
+			Id:                "default/web",
+			Name:              "web",
+			Kind:              "Job",
+			HealthStatus:      model.NomadResourceState_HEALTHY,
+			HealthDescription: "Job is running at version 2",
+		},
+		{
+			Id:                "0123456789abcdef",
+			OwnerIds:          []string{"default/web"},
+			ParentIds:         []string{"default/web"},
+			Name:              "01234567 (version 2)",
+			Kind:              "Deployment",
+			HealthStatus:      model.NomadResourceState_OTHER,
+			HealthDescription: "Deployment is running (web: 1/2 healthy, 1/1 canaries)",
+		},
+		{
+			Id:                "a1",
+			OwnerIds:          []string{"default/web"},
+			ParentIds:         []string{"default/web"},
+			Name:              "web.web[0]",
+			Kind:              "Allocation",
+			HealthStatus:      model.NomadResourceState_HEALTHY,
+			HealthDescription: "Allocation of version 1 is running on node node-1",
+		},
+		{
+			Id:                "a2",
+			OwnerIds:          []string{"0123456789abcdef"},
+			ParentIds:         []string{"0123456789abcdef"},
+			Name:              "web.web[1]",
+			Kind:              "Allocation",
+			HealthStatus:      model.NomadResourceState_HEALTHY,
+			HealthDescription: "Allocation of version 2 is running as a canary",
+		},
+	}
+	assert.Equal(t, expected, states)
+}
+
+func TestAllocationHealthStatus(t *testing.T) {
+	t.Parallel()
+
+	unhealthy := false
+	testcases := []struct {
+		name     string
+		jobType  string
+		alloc    Allocation
+		expected model.NomadResourceState_HealthStatus
+	}{
+		{
+			name:     "running",
+			jobType:  JobTypeService,
+			alloc:    Allocation{ClientStatus: AllocClientStatusRunning},
+			expected: model.NomadResourceState_HEALTHY,
+		},
+		{
+			name:     "running but unhealthy",
+			jobType:  JobTypeService,
+			alloc:    Allocation{ClientStatus: AllocClientStatusRunning, DeploymentStatus: &AllocationDeploymentStatus{Healthy: &unhealthy}},
+			expected: model.NomadResourceState_OTHER,
+		},
+		{
+			name:     "completed service",
+			jobType:  JobTypeService,
+			alloc:    Allocation{ClientStatus: AllocClientStatusComplete},
+			expected: model.NomadResourceState_OTHER,
+		},
+		{
+			name:     "completed batch",
+			jobType:  JobTypeBatch,
+			alloc:    Allocation{ClientStatus: AllocClientStatusComplete},
+			expected: model.NomadResourceState_HEALTHY,
+		},
+		{
+			name:     "failed",
+			jobType:  JobTypeBatch,
+			alloc:    Allocation{ClientStatus: AllocClientStatusFailed},
+			expected: model.NomadResourceState_OTHER,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			status, _ := allocationHealthStatus(tc.jobType, tc.alloc)
+			assert.Equal(t, tc.expected, status)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+)
+
+const (
+	// The statuses of the distributions and the invalidations.
+	distributionStatusDeployed = "Deployed"
+	invalidationStatusComplete = "Completed"
+)
+
+// findOrigin returns the origin having the given ID in the given distribution config.
+// The only origin is returned when the ID is empty.
+func findOrigin(config *types.DistributionConfig, id string) (*types.Origin, error) {
+	var origins []types.Origin
+	if config.Origins != nil {
+		origins = config.Origins.Items
+	}
+	if id == "" {
+		if len(origins) != 1 {
+			return nil, fmt.Errorf("originId must be specified since the distribution has %d origins", len(origins))
+		}
+		return &origins[0], nil
+	}
+	for i := range origins {
+		if aws.ToString(origins[i].Id) == id {
+			return &origins[i], nil
+		}
+	}
+	return nil, fmt.Errorf("origin %s was not found in the distribution: %w", id, ErrNotFound)
+}
+
+// wrapNotFound converts the error returned when the distribution does not exist into ErrNotFound.
+func wrapNotFound(err error) error {
+	var e *types.NoSuchDistribution
+	if errors.As(err, &e) {
+		return fmt.Errorf("%w: %s", ErrNotFound, e.ErrorMessage())
+	}
+	return err
+}
@@ -0,0 +1,183 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const testDistributionConfig = `<?xml version="1.0" encoding="UTF-8"?>
+<DistributionConfig xmlns="http://cloudfront.amazonaws.com/doc/2020-05-31/">
+  <CallerReference>docs</CallerReference>
+  <Origins>
+    <Quantity>2</Quantity>
+    <Items>
+      <Origin>
+        <Id>api</Id>
+        <DomainName>api.example.com</DomainName>
+        <OriginPath></OriginPath>
+      </Origin>
+      <Origin>
+        <Id>docs-s3</Id>
+        <DomainName>docs.s3.ap-northeast-1.amazonaws.com</DomainName>
+        <OriginPath>/site/blue</OriginPath>
+        <S3OriginConfig><OriginAccessIdentity></OriginAccessIdentity></S3OriginConfig>
+      </Origin>
+    </Items>
+  </Origins>
+  <DefaultCacheBehavior>
+    <TargetOriginId>docs-s3</TargetOriginId>
+    <ViewerProtocolPolicy>redirect-to-https</ViewerProtocolPolicy>
+  </DefaultCacheBehavior>
+  <Comment>docs &amp; blog</Comment>
+  <Enabled>true</Enabled>
+</DistributionConfig>`
+
+func newTestS3Client(t *testing.T, h http.HandlerFunc) *s3Client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &s3Client{
+		cloudFront: cloudfront.NewFromConfig(cfg, func(o *cloudfront.Options) {
+			o.EndpointResolver = cloudfront.EndpointResolverFromURL(ts.URL)
+		}),
+		pollInterval: time.Millisecond,
+		logger:       zap.NewNop(),
+	}
+}
+
+func TestCloudFrontGetOriginPath(t *testing.T) {
+	t.Parallel()
+
+	c := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/2020-05-31/distribution/E2EXAMPLE/config", r.URL.Path)
+		w.Write([]byte(testDistributionConfig))
+	})
+
+	path, err := c.GetOriginPath(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE", OriginID: "docs-s3"}})
+	require.NoError(t, err)
+	assert.Equal(t, "/site/blue", path)
+
+	// The origin must be specified when the distribution has multiple origins.
+	_, err = c.GetOriginPath(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE"}})
+	assert.Error(t, err)
+
+	_, err = c.GetOriginPath(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE", OriginID: "unknown"}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCloudFrontUpdateOriginPath(t *testing.T) {
+	t.Parallel()
+
+	var (
+		updated []byte
+		gets    int32
+	)
+	c := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/config"):
+			w.Header().Set("ETag", "E1")
+			w.Write([]byte(testDistributionConfig))
+		case r.Method == http.MethodPut:
+			assert.Equal(t, "E1", r.Header.Get("If-Match"))
+			updated, _ = io.ReadAll(r.Body)
+			w.Header().Set("ETag", "E2")
+		case r.Method == http.MethodGet:
+			assert.Equal(t, "/2020-05-31/distribution/E2EXAMPLE", r.URL.Path)
+			if atomic.AddInt32(&gets, 1) == 1 {
+				w.Write([]byte(`<Distribution><Id>E2EXAMPLE</Id><Status>InProgress</Status></Distribution>`))
+				return
+			}
+			w.Write([]byte(`<Distribution><Id>E2EXAMPLE</Id><Status>Deployed</Status></Distribution>`))
+		}
+	})
+
+	err := c.UpdateOriginPath(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE", OriginID: "docs-s3"}}, "/site/green")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&gets))
+
+	// Only the origin path of the specified origin is changed.
+	var got struct {
+		Origins []struct {
+			ID         string `xml:"Id"`
+			OriginPath string `xml:"OriginPath"`
+		} `xml:"Origins>Items>Origin"`
+		Comment string `xml:"Comment"`
+		Enabled bool   `xml:"Enabled"`
+	}
+	require.NoError(t, xml.Unmarshal(updated, &got))
+	require.Len(t, got.Origins, 2)
+	assert.Equal(t, "", got.Origins[0].OriginPath)
+	assert.Equal(t, "/site/green", got.Origins[1].OriginPath)
+	assert.Equal(t, "docs & blog", got.Comment)
+	assert.True(t, got.Enabled)
+	assert.Contains(t, string(updated), "<S3OriginConfig><OriginAccessIdentity></OriginAccessIdentity></S3OriginConfig>")
+}
+
+func TestCloudFrontDistributionNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`<ErrorResponse><Error><Type>Sender</Type><Code>NoSuchDistribution</Code><Message>The specified distribution does not exist.</Message></Error></ErrorResponse>`))
+	})
+
+	_, err := c.GetOriginPath(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE", OriginID: "docs-s3"}})
+	assert.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestCloudFrontInvalidate(t *testing.T) {
+	t.Parallel()
+
+	c := newTestS3Client(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "/2020-05-31/distribution/E2EXAMPLE/invalidation", r.URL.Path)
+			var in struct {
+				Quantity int      `xml:"Paths>Quantity"`
+				Items    []string `xml:"Paths>Items>Path"`
+			}
+			require.NoError(t, xml.NewDecoder(r.Body).Decode(&in))
+			assert.Equal(t, 2, in.Quantity)
+			assert.Equal(t, []string{"/*", "/index.html"}, in.Items)
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`<Invalidation><Id>I1</Id><Status>InProgress</Status></Invalidation>`))
+		case http.MethodGet:
+			assert.Equal(t, "/2020-05-31/distribution/E2EXAMPLE/invalidation/I1", r.URL.Path)
+			w.Write([]byte(`<Invalidation><Id>I1</Id><Status>Completed</Status></Invalidation>`))
+		}
+	})
+
+	err := c.Invalidate(context.Background(), CDN{CloudFront: &CloudFront{DistributionID: "E2EXAMPLE"}}, []string{"/*", "/index.html"})
+	require.NoError(t, err)
+}
@@ -0,0 +1,89 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"encoding/json"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+// DiffSiteManifests calculates the diff between the specs of the two given manifests.
+func DiffSiteManifests(old, new SiteManifest) (*diff.Result, error) {
+	o, err := toUnstructured(old.Spec)
+	if err != nil {
+		return nil, err
+	}
+	n, err := toUnstructured(new.Spec)
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(o, n, new.Spec.Bucket, diff.WithEquateEmpty())
+}
+
+// FileChanges represents the keys of the files changed between two versions of the site.
+type FileChanges struct {
+	Added    []string
+	Modified []string
+	Deleted  []string
+}
+
+// NumChanges returns the number of the changed files.
+func (c FileChanges) NumChanges() int {
+	return len(c.Added) + len(c.Modified) + len(c.Deleted)
+}
+
+// DiffFiles returns the files added, modified and deleted from the old files to the new ones.
+// The files are compared by their keys and contents.
+func DiffFiles(old, new []File) FileChanges {
+	hashes := make(map[string]string, len(old))
+	for _, f := range old {
+		hashes[f.Key] = f.MD5
+	}
+
+	var c FileChanges
+	for _, f := range new {
+		hash, ok := hashes[f.Key]
+		switch {
+		case !ok:
+			c.Added = append(c.Added, f.Key)
+		case hash != f.MD5:
+			c.Modified = append(c.Modified, f.Key)
+		}
+		delete(hashes, f.Key)
+	}
+	for k := range hashes {
+		c.Deleted = append(c.Deleted, k)
+	}
+	sort.Strings(c.Added)
+	sort.Strings(c.Modified)
+	sort.Strings(c.Deleted)
+	return c
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
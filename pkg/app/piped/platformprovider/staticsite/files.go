@@ -0,0 +1,118 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+)
+
+const defaultContentType = "application/octet-stream"
+
+// File represents a file of the site to be uploaded.
+type File struct {
+	// The key of the object in the bucket.
+	Key string
+	// The path to the local file.
+	Path string
+	// The MD5 hash in hex of the content.
+	MD5          string
+	ContentType  string
+	CacheControl string
+}
+
+// LoadFiles returns all files in the source directory of the given manifest
+// with the keys under the given prefix of the bucket.
+func LoadFiles(appDir string, m SiteManifest, prefix string) ([]File, error) {
+	root := filepath.Join(appDir, m.Spec.Source)
+	var files []File
+	err := filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !d.Type().IsRegular() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		hash, err := md5File(p)
+		if err != nil {
+			return err
+		}
+		contentType := mime.TypeByExtension(path.Ext(rel))
+		if contentType == "" {
+			contentType = defaultContentType
+		}
+		files = append(files, File{
+			Key:          path.Join(prefix, rel),
+			Path:         p,
+			MD5:          hash,
+			ContentType:  contentType,
+			CacheControl: m.Spec.CacheControl(rel),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load files in source directory %s: %w", m.Spec.Source, err)
+	}
+	return files, nil
+}
+
+func md5File(p string) (string, error) {
+	f, err := os.Open(p)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// PlanSync returns the files to be uploaded and the keys of the objects to be deleted
+// to make the given objects in the bucket the same as the given files.
+// The files whose content was not changed are not uploaded again.
+func PlanSync(files []File, objects map[string]string) (uploads []File, deletes []string) {
+	keys := make(map[string]struct{}, len(files))
+	for _, f := range files {
+		keys[f.Key] = struct{}{}
+		if hash, ok := objects[f.Key]; ok && hash == f.MD5 {
+			continue
+		}
+		uploads = append(uploads, f)
+	}
+	for k := range objects {
+		if _, ok := keys[k]; !ok {
+			deletes = append(deletes, k)
+		}
+	}
+	sort.Strings(deletes)
+	return
+}
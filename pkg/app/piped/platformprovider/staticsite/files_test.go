@@ -0,0 +1,83 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"mime"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFiles(t *testing.T) {
+	t.Parallel()
+
+	appDir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(appDir, "public", "assets"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "public", "index.html"), []byte("<html></html>"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "public", "assets", "app.js"), []byte("console.log(1)"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(appDir, "site.yaml"), []byte("kind: StaticSite"), 0644))
+
+	m := SiteManifest{
+		Spec: SiteManifestSpec{
+			Source: "public",
+			CacheControls: []CacheControl{
+				{Pattern: "*.html", Value: "no-cache"},
+			},
+		},
+	}
+	files, err := LoadFiles(appDir, m, "site/blue")
+	require.NoError(t, err)
+	assert.Equal(t, []File{
+		{
+			Key:         "site/blue/assets/app.js",
+			Path:        filepath.Join(appDir, "public", "assets", "app.js"),
+			MD5:         "6114f5adc373accd7b2051bd87078f62",
+			ContentType: mime.TypeByExtension(".js"),
+		},
+		{
+			Key:          "site/blue/index.html",
+			Path:         filepath.Join(appDir, "public", "index.html"),
+			MD5:          "c83301425b2ad1d496473a5ff3d9ecca",
+			ContentType:  mime.TypeByExtension(".html"),
+			CacheControl: "no-cache",
+		},
+	}, files)
+}
+
+func TestPlanSync(t *testing.T) {
+	t.Parallel()
+
+	files := []File{
+		{Key: "site/index.html", MD5: "1"},
+		{Key: "site/app.js", MD5: "2"},
+		{Key: "site/new.css", MD5: "3"},
+	}
+	objects := map[string]string{
+		"site/index.html": "1",
+		"site/app.js":     "0",
+		"site/old.css":    "4",
+		"site/large.zip":  "",
+	}
+	uploads, deletes := PlanSync(files, objects)
+	assert.Equal(t, []File{
+		{Key: "site/app.js", MD5: "2"},
+		{Key: "site/new.css", MD5: "3"},
+	}, uploads)
+	assert.Equal(t, []string{"site/large.zip", "site/old.css"}, deletes)
+}
@@ -0,0 +1,226 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"go.uber.org/zap"
+	"google.golang.org/api/compute/v1"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/iterator"
+	"google.golang.org/api/option"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const operationStatusDone = "DONE"
+
+type gcsClient struct {
+	project string
+	storage *storage.Client
+	compute *compute.Service
+	logger  *zap.Logger
+}
+
+func newGCSClient(ctx context.Context, cfg *config.StaticSiteGCSConfig, logger *zap.Logger) (*gcsClient, error) {
+	if cfg.Project == "" {
+		return nil, fmt.Errorf("gcs.project is required field")
+	}
+
+	var options []option.ClientOption
+	if len(cfg.CredentialsFile) > 0 {
+		data, err := os.ReadFile(cfg.CredentialsFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read credentials file (%w)", err)
+		}
+		options = append(options, option.WithCredentialsJSON(data))
+	}
+
+	storageClient, err := storage.NewClient(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+	computeService, err := compute.NewService(ctx, options...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gcsClient{
+		project: cfg.Project,
+		storage: storageClient,
+		compute: computeService,
+		logger:  logger.Named("staticsite-gcs"),
+	}, nil
+}
+
+func (c *gcsClient) ListObjects(ctx context.Context, bucket, prefix string) (map[string]string, error) {
+	q := &storage.Query{}
+	if prefix != "" {
+		q.Prefix = prefix + "/"
+	}
+	if err := q.SetAttrSelection([]string{"Name", "MD5"}); err != nil {
+		return nil, err
+	}
+
+	objects := make(map[string]string)
+	it := c.storage.Bucket(bucket).Objects(ctx, q)
+	for {
+		attrs, err := it.Next()
+		if errors.Is(err, iterator.Done) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in bucket %s: %w", bucket, err)
+		}
+		// The composite objects have no MD5 hash.
+		objects[attrs.Name] = hex.EncodeToString(attrs.MD5)
+	}
+	return objects, nil
+}
+
+func (c *gcsClient) PutObject(ctx context.Context, bucket string, f File) error {
+	hash, err := hex.DecodeString(f.MD5)
+	if err != nil {
+		return err
+	}
+	body, err := os.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	w := c.storage.Bucket(bucket).Object(f.Key).NewWriter(ctx)
+	w.ContentType = f.ContentType
+	w.CacheControl = f.CacheControl
+	w.MD5 = hash
+	if _, err := io.Copy(w, body); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to put object %s: %w", f.Key, err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to put object %s: %w", f.Key, err)
+	}
+	return nil
+}
+
+func (c *gcsClient) DeleteObjects(ctx context.Context, bucket string, keys []string) error {
+	for _, k := range keys {
+		err := c.storage.Bucket(bucket).Object(k).Delete(ctx)
+		if err != nil && !errors.Is(err, storage.ErrObjectNotExist) {
+			return fmt.Errorf("failed to delete object %s: %w", k, err)
+		}
+	}
+	return nil
+}
+
+// GetOriginPath returns the path prefix the default route of the URL map rewrites the requests to.
+func (c *gcsClient) GetOriginPath(ctx context.Context, cdn CDN) (string, error) {
+	urlMap, err := c.getURLMap(ctx, cdn)
+	if err != nil {
+		return "", err
+	}
+	if a := urlMap.DefaultRouteAction; a != nil && a.UrlRewrite != nil {
+		return strings.TrimSuffix(a.UrlRewrite.PathPrefixRewrite, "/"), nil
+	}
+	return "", nil
+}
+
+// UpdateOriginPath rewrites the requests to the given path by the default route of the URL map.
+func (c *gcsClient) UpdateOriginPath(ctx context.Context, cdn CDN, originPath string) error {
+	urlMap, err := c.getURLMap(ctx, cdn)
+	if err != nil {
+		return err
+	}
+	if urlMap.DefaultRouteAction == nil {
+		urlMap.DefaultRouteAction = &compute.HttpRouteAction{}
+	}
+	if urlMap.DefaultRouteAction.UrlRewrite == nil {
+		urlMap.DefaultRouteAction.UrlRewrite = &compute.UrlRewrite{}
+	}
+	urlMap.DefaultRouteAction.UrlRewrite.PathPrefixRewrite = originPath + "/"
+
+	// The fingerprint of the fetched URL map prevents overwriting the concurrent changes.
+	op, err := c.compute.UrlMaps.Update(c.project, urlMap.Name, urlMap).Context(ctx).Do()
+	if err != nil {
+		return fmt.Errorf("failed to update url map %s: %w", urlMap.Name, err)
+	}
+	return c.waitOperation(ctx, op)
+}
+
+func (c *gcsClient) Invalidate(ctx context.Context, cdn CDN, paths []string) error {
+	urlMap, err := cloudCDNURLMap(cdn)
+	if err != nil {
+		return err
+	}
+	for _, p := range paths {
+		op, err := c.compute.UrlMaps.InvalidateCache(c.project, urlMap, &compute.CacheInvalidationRule{Path: p}).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to invalidate cache of url map %s: %w", urlMap, err)
+		}
+		if err := c.waitOperation(ctx, op); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *gcsClient) getURLMap(ctx context.Context, cdn CDN) (*compute.UrlMap, error) {
+	name, err := cloudCDNURLMap(cdn)
+	if err != nil {
+		return nil, err
+	}
+	urlMap, err := c.compute.UrlMaps.Get(c.project, name).Context(ctx).Do()
+	var e *googleapi.Error
+	if errors.As(err, &e) && e.Code == http.StatusNotFound {
+		return nil, fmt.Errorf("url map %s was not found: %w", name, ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get url map %s: %w", name, err)
+	}
+	return urlMap, nil
+}
+
+// waitOperation waits until the given global operation has been done.
+func (c *gcsClient) waitOperation(ctx context.Context, op *compute.Operation) error {
+	var err error
+	for op.Status != operationStatusDone {
+		// Wait returns when the operation has been done or about 2 minutes have passed.
+		op, err = c.compute.GlobalOperations.Wait(c.project, op.Name).Context(ctx).Do()
+		if err != nil {
+			return fmt.Errorf("failed to wait for operation: %w", err)
+		}
+	}
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		e := op.Error.Errors[0]
+		return fmt.Errorf("operation %s failed: %s: %s", op.Name, e.Code, e.Message)
+	}
+	return nil
+}
+
+func cloudCDNURLMap(cdn CDN) (string, error) {
+	if cdn.CloudCDN == nil {
+		return "", fmt.Errorf("cdn.cloudCDN must be specified for the sites stored in Cloud Storage")
+	}
+	return cdn.CloudCDN.URLMap, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+const (
+	versionV1Beta1   = "pipecd.dev/v1beta1"
+	siteManifestKind = "StaticSite"
+
+	// The paths in the bucket where the two versions of the site are placed
+	// when the site is served by a CDN.
+	SlotBlue  = "blue"
+	SlotGreen = "green"
+
+	defaultInvalidationPath = "/*"
+)
+
+type SiteManifest struct {
+	Kind       string           `json:"kind"`
+	APIVersion string           `json:"apiVersion,omitempty"`
+	Spec       SiteManifestSpec `json:"spec"`
+}
+
+func (m *SiteManifest) validate() error {
+	if m.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", m.APIVersion)
+	}
+	if m.Kind != siteManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", m.Kind)
+	}
+	return m.Spec.validate()
+}
+
+// SiteManifestSpec contains configuration for StaticSite.
+type SiteManifestSpec struct {
+	// The path to the directory of the built site relative to the application directory.
+	Source string `json:"source"`
+	// The name of the bucket where the site is uploaded.
+	Bucket string `json:"bucket"`
+	// The path in the bucket where the site is uploaded.
+	// Default is the root of the bucket.
+	Prefix string `json:"prefix,omitempty"`
+	// The version of the site shown in the deployment.
+	Version string `json:"version,omitempty"`
+	// The Cache-Control headers of the uploaded files.
+	// The first one matching the file is used.
+	CacheControls []CacheControl `json:"cacheControls,omitempty"`
+	// The CDN serving the site.
+	// When it is specified, the two versions of the site are placed under the prefix
+	// and the origin path of the CDN is switched between them.
+	CDN *CDN `json:"cdn,omitempty"`
+}
+
+type CacheControl struct {
+	// The glob pattern of the files such as "*.html" or "assets/*".
+	// The pattern without a slash is matched against the file names,
+	// otherwise against the paths relative to the source directory.
+	Pattern string `json:"pattern"`
+	// The value of the Cache-Control header such as "no-cache".
+	Value string `json:"value"`
+}
+
+type CDN struct {
+	// The CloudFront distribution serving the site from Amazon S3.
+	CloudFront *CloudFront `json:"cloudFront,omitempty"`
+	// The load balancer serving the site from Cloud Storage with Cloud CDN.
+	CloudCDN *CloudCDN `json:"cloudCDN,omitempty"`
+	// The paths invalidated after switching the origin path.
+	// Default is "/*".
+	InvalidationPaths []string `json:"invalidationPaths,omitempty"`
+}
+
+type CloudFront struct {
+	// The ID of the distribution.
+	DistributionID string `json:"distributionId"`
+	// The ID of the origin pointing to the bucket.
+	// It can be omitted when the distribution has only one origin.
+	OriginID string `json:"originId,omitempty"`
+}
+
+type CloudCDN struct {
+	// The name of the URL map of the load balancer whose default backend bucket points to the bucket.
+	URLMap string `json:"urlMap"`
+}
+
+func (s SiteManifestSpec) validate() error {
+	if s.Bucket == "" {
+		return fmt.Errorf("bucket is missing")
+	}
+	if s.Source == "" {
+		return fmt.Errorf("source is missing")
+	}
+	if src := filepath.Clean(s.Source); filepath.IsAbs(s.Source) || src == "." || strings.HasPrefix(src, "..") {
+		return fmt.Errorf("source must be a directory inside the application directory")
+	}
+	if strings.HasPrefix(s.Prefix, "/") || strings.HasSuffix(s.Prefix, "/") {
+		return fmt.Errorf("prefix must not start or end with a slash")
+	}
+	for _, c := range s.CacheControls {
+		if _, err := path.Match(c.Pattern, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q of cacheControls: %w", c.Pattern, err)
+		}
+	}
+	if c := s.CDN; c != nil {
+		if (c.CloudFront == nil) == (c.CloudCDN == nil) {
+			return fmt.Errorf("either cdn.cloudFront or cdn.cloudCDN must be specified")
+		}
+		if c.CloudFront != nil && c.CloudFront.DistributionID == "" {
+			return fmt.Errorf("cdn.cloudFront.distributionId is missing")
+		}
+		if c.CloudCDN != nil && c.CloudCDN.URLMap == "" {
+			return fmt.Errorf("cdn.cloudCDN.urlMap is missing")
+		}
+		for _, p := range c.InvalidationPaths {
+			if !strings.HasPrefix(p, "/") {
+				return fmt.Errorf("invalidation path %q must start with a slash", p)
+			}
+		}
+	}
+	return nil
+}
+
+// UploadPrefix returns the path in the bucket where the files of the site are uploaded.
+// The slot is ignored when the site is not served by a CDN.
+func (s SiteManifestSpec) UploadPrefix(slot string) string {
+	if s.CDN == nil {
+		return s.Prefix
+	}
+	return path.Join(s.Prefix, slot)
+}
+
+// OriginPath returns the origin path of the CDN to serve the given slot.
+func (s SiteManifestSpec) OriginPath(slot string) string {
+	return "/" + path.Join(s.Prefix, slot)
+}
+
+// InactiveSlot returns the slot not served by the CDN for the given origin path.
+func (s SiteManifestSpec) InactiveSlot(originPath string) string {
+	if originPath == s.OriginPath(SlotBlue) {
+		return SlotGreen
+	}
+	return SlotBlue
+}
+
+// Paths returns the paths invalidated after switching the origin path.
+func (c CDN) Paths() []string {
+	if len(c.InvalidationPaths) == 0 {
+		return []string{defaultInvalidationPath}
+	}
+	return c.InvalidationPaths
+}
+
+// CacheControl returns the Cache-Control header of the file at the given path relative to the source directory.
+func (s SiteManifestSpec) CacheControl(rel string) string {
+	for _, c := range s.CacheControls {
+		name := rel
+		if !strings.Contains(c.Pattern, "/") {
+			name = path.Base(rel)
+		}
+		if ok, _ := path.Match(c.Pattern, name); ok {
+			return c.Value
+		}
+	}
+	return ""
+}
+
+// LoadSiteManifest returns SiteManifest object from a given site manifest file.
+func LoadSiteManifest(appDir, manifestFilename string) (SiteManifest, error) {
+	path := filepath.Join(appDir, manifestFilename)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return SiteManifest{}, err
+	}
+	return parseSiteManifest(data)
+}
+
+func parseSiteManifest(data []byte) (SiteManifest, error) {
+	var m SiteManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return SiteManifest{}, err
+	}
+	if err := m.validate(); err != nil {
+		return SiteManifest{}, err
+	}
+	return m, nil
+}
+
+// FindArtifactVersions returns the version of the site if it was specified in the manifest.
+func FindArtifactVersions(m SiteManifest) []*model.ArtifactVersion {
+	if m.Spec.Version == "" {
+		return nil
+	}
+	return []*model.ArtifactVersion{
+		{
+			Kind:    model.ArtifactVersion_UNKNOWN,
+			Version: m.Spec.Version,
+			Name:    m.Spec.Bucket,
+		},
+	}
+}
@@ -0,0 +1,200 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSiteManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		data        string
+		expected    SiteManifest
+		expectedErr bool
+	}{
+		{
+			name: "cloudfront",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: public
+  bucket: docs
+  prefix: site
+  cacheControls:
+    - pattern: "*.html"
+      value: no-cache
+  cdn:
+    cloudFront:
+      distributionId: E2EXAMPLE
+`,
+			expected: SiteManifest{
+				Kind:       "StaticSite",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: SiteManifestSpec{
+					Source: "public",
+					Bucket: "docs",
+					Prefix: "site",
+					CacheControls: []CacheControl{
+						{Pattern: "*.html", Value: "no-cache"},
+					},
+					CDN: &CDN{
+						CloudFront: &CloudFront{DistributionID: "E2EXAMPLE"},
+					},
+				},
+			},
+		},
+		{
+			name: "without cdn",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: dist
+  bucket: docs
+`,
+			expected: SiteManifest{
+				Kind:       "StaticSite",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: SiteManifestSpec{
+					Source: "dist",
+					Bucket: "docs",
+				},
+			},
+		},
+		{
+			name: "source outside the application directory",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: ../dist
+  bucket: docs
+`,
+			expectedErr: true,
+		},
+		{
+			name: "application directory as source",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: .
+  bucket: docs
+`,
+			expectedErr: true,
+		},
+		{
+			name: "prefix ending with slash",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: dist
+  bucket: docs
+  prefix: site/
+`,
+			expectedErr: true,
+		},
+		{
+			name: "both cloudfront and cloud cdn",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: dist
+  bucket: docs
+  cdn:
+    cloudFront:
+      distributionId: E2EXAMPLE
+    cloudCDN:
+      urlMap: docs
+`,
+			expectedErr: true,
+		},
+		{
+			name: "invalid cache control pattern",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StaticSite
+spec:
+  source: dist
+  bucket: docs
+  cacheControls:
+    - pattern: "[*.html"
+      value: no-cache
+`,
+			expectedErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			m, err := parseSiteManifest([]byte(tc.data))
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, m)
+		})
+	}
+}
+
+func TestSlots(t *testing.T) {
+	t.Parallel()
+
+	spec := SiteManifestSpec{
+		Prefix: "site",
+		CDN:    &CDN{CloudCDN: &CloudCDN{URLMap: "docs"}},
+	}
+	assert.Equal(t, "site/green", spec.UploadPrefix(SlotGreen))
+	assert.Equal(t, "/site/blue", spec.OriginPath(SlotBlue))
+	assert.Equal(t, SlotGreen, spec.InactiveSlot("/site/blue"))
+	assert.Equal(t, SlotBlue, spec.InactiveSlot("/site/green"))
+	// The first deployment uses the blue slot.
+	assert.Equal(t, SlotBlue, spec.InactiveSlot(""))
+	assert.Equal(t, []string{"/*"}, spec.CDN.Paths())
+
+	// The files are uploaded to the prefix directly without CDN.
+	spec.CDN = nil
+	assert.Equal(t, "site", spec.UploadPrefix(SlotGreen))
+}
+
+func TestCacheControl(t *testing.T) {
+	t.Parallel()
+
+	spec := SiteManifestSpec{
+		CacheControls: []CacheControl{
+			{Pattern: "*.html", Value: "no-cache"},
+			{Pattern: "assets/*", Value: "public, max-age=31536000, immutable"},
+			{Pattern: "*", Value: "public, max-age=300"},
+		},
+	}
+	assert.Equal(t, "no-cache", spec.CacheControl("index.html"))
+	assert.Equal(t, "no-cache", spec.CacheControl("docs/index.html"))
+	assert.Equal(t, "public, max-age=31536000, immutable", spec.CacheControl("assets/app.js"))
+	assert.Equal(t, "public, max-age=300", spec.CacheControl("docs/logo.png"))
+
+	spec.CacheControls = nil
+	assert.Equal(t, "", spec.CacheControl("index.html"))
+}
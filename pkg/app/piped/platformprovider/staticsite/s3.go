@@ -0,0 +1,302 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	cftypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"go.uber.org/zap"
+
+	pipedconfig "github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	// The maximum number of keys can be deleted by a request.
+	deleteObjectsMaxKeys = 1000
+)
+
+type s3Client struct {
+	s3           *s3.Client
+	cloudFront   *cloudfront.Client
+	pollInterval time.Duration
+	logger       *zap.Logger
+}
+
+func newS3Client(cfg *pipedconfig.StaticSiteS3Config, logger *zap.Logger) (*s3Client, error) {
+	if cfg.Region == "" {
+		return nil, fmt.Errorf("s3.region is required field")
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(cfg.Region)}
+	if cfg.CredentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{cfg.CredentialsFile}))
+	}
+	if cfg.Profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(cfg.Profile))
+	}
+	if cfg.TokenFile != "" && cfg.RoleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = cfg.RoleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(cfg.TokenFile)
+		}))
+	}
+
+	awsCfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create s3 client: %w", err)
+	}
+
+	// CloudFront is a global service, whose endpoint is resolved regardless of the region.
+	return &s3Client{
+		s3:           s3.NewFromConfig(awsCfg),
+		cloudFront:   cloudfront.NewFromConfig(awsCfg),
+		pollInterval: 10 * time.Second,
+		logger:       logger.Named("staticsite-s3"),
+	}, nil
+}
+
+func (c *s3Client) ListObjects(ctx context.Context, bucket, prefix string) (map[string]string, error) {
+	in := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if prefix != "" {
+		in.Prefix = aws.String(prefix + "/")
+	}
+
+	objects := make(map[string]string)
+	p := s3.NewListObjectsV2Paginator(c.s3, in)
+	for p.HasMorePages() {
+		out, err := p.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list objects in bucket %s: %w", bucket, err)
+		}
+		for _, o := range out.Contents {
+			// The ETag is the MD5 hash of the content unless the object was uploaded in multiple parts.
+			hash := strings.Trim(aws.ToString(o.ETag), `"`)
+			if strings.Contains(hash, "-") {
+				hash = ""
+			}
+			objects[aws.ToString(o.Key)] = hash
+		}
+	}
+	return objects, nil
+}
+
+func (c *s3Client) PutObject(ctx context.Context, bucket string, f File) error {
+	hash, err := hex.DecodeString(f.MD5)
+	if err != nil {
+		return err
+	}
+	body, err := os.Open(f.Path)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	in := &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(f.Key),
+		Body:        body,
+		ContentType: aws.String(f.ContentType),
+		ContentMD5:  aws.String(base64.StdEncoding.EncodeToString(hash)),
+	}
+	if f.CacheControl != "" {
+		in.CacheControl = aws.String(f.CacheControl)
+	}
+	if _, err := c.s3.PutObject(ctx, in); err != nil {
+		return fmt.Errorf("failed to put object %s: %w", f.Key, err)
+	}
+	return nil
+}
+
+func (c *s3Client) DeleteObjects(ctx context.Context, bucket string, keys []string) error {
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > deleteObjectsMaxKeys {
+			n = deleteObjectsMaxKeys
+		}
+		objects := make([]s3types.ObjectIdentifier, 0, n)
+		for _, k := range keys[:n] {
+			objects = append(objects, s3types.ObjectIdentifier{Key: aws.String(k)})
+		}
+		keys = keys[n:]
+
+		out, err := c.s3.DeleteObjects(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucket),
+			Delete: &s3types.Delete{
+				Objects: objects,
+				Quiet:   true,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to delete objects in bucket %s: %w", bucket, err)
+		}
+		if len(out.Errors) > 0 {
+			e := out.Errors[0]
+			return fmt.Errorf("failed to delete object %s: %s", aws.ToString(e.Key), aws.ToString(e.Message))
+		}
+	}
+	return nil
+}
+
+func (c *s3Client) GetOriginPath(ctx context.Context, cdn CDN) (string, error) {
+	cf, err := cloudFrontOf(cdn)
+	if err != nil {
+		return "", err
+	}
+	config, _, err := c.getDistributionConfig(ctx, cf.DistributionID)
+	if err != nil {
+		return "", err
+	}
+	origin, err := findOrigin(config, cf.OriginID)
+	if err != nil {
+		return "", err
+	}
+	return aws.ToString(origin.OriginPath), nil
+}
+
+func (c *s3Client) UpdateOriginPath(ctx context.Context, cdn CDN, originPath string) error {
+	cf, err := cloudFrontOf(cdn)
+	if err != nil {
+		return err
+	}
+	config, etag, err := c.getDistributionConfig(ctx, cf.DistributionID)
+	if err != nil {
+		return err
+	}
+	origin, err := findOrigin(config, cf.OriginID)
+	if err != nil {
+		return err
+	}
+	origin.OriginPath = aws.String(originPath)
+
+	_, err = c.cloudFront.UpdateDistribution(ctx, &cloudfront.UpdateDistributionInput{
+		Id:                 aws.String(cf.DistributionID),
+		IfMatch:            etag,
+		DistributionConfig: config,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update distribution %s: %w", cf.DistributionID, err)
+	}
+
+	// The change must be propagated to all edge locations before invalidating the cache,
+	// otherwise the old version can be cached again.
+	return c.poll(ctx, func() (bool, error) {
+		out, err := c.cloudFront.GetDistribution(ctx, &cloudfront.GetDistributionInput{
+			Id: aws.String(cf.DistributionID),
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get distribution %s: %w", cf.DistributionID, err)
+		}
+		return out.Distribution != nil && aws.ToString(out.Distribution.Status) == distributionStatusDeployed, nil
+	})
+}
+
+func (c *s3Client) Invalidate(ctx context.Context, cdn CDN, paths []string) error {
+	cf, err := cloudFrontOf(cdn)
+	if err != nil {
+		return err
+	}
+
+	out, err := c.cloudFront.CreateInvalidation(ctx, &cloudfront.CreateInvalidationInput{
+		DistributionId: aws.String(cf.DistributionID),
+		InvalidationBatch: &cftypes.InvalidationBatch{
+			Paths: &cftypes.Paths{
+				Quantity: aws.Int32(int32(len(paths))),
+				Items:    paths,
+			},
+			CallerReference: aws.String(fmt.Sprintf("pipecd-%d", time.Now().UnixNano())),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create invalidation of distribution %s: %w", cf.DistributionID, err)
+	}
+	if out.Invalidation == nil {
+		return fmt.Errorf("no invalidation was returned for distribution %s", cf.DistributionID)
+	}
+
+	inv := out.Invalidation
+	return c.poll(ctx, func() (bool, error) {
+		if aws.ToString(inv.Status) == invalidationStatusComplete {
+			return true, nil
+		}
+		out, err := c.cloudFront.GetInvalidation(ctx, &cloudfront.GetInvalidationInput{
+			DistributionId: aws.String(cf.DistributionID),
+			Id:             inv.Id,
+		})
+		if err != nil {
+			return false, fmt.Errorf("failed to get invalidation %s: %w", aws.ToString(inv.Id), err)
+		}
+		if out.Invalidation != nil {
+			inv = out.Invalidation
+		}
+		return aws.ToString(inv.Status) == invalidationStatusComplete, nil
+	})
+}
+
+// getDistributionConfig returns the config of the given distribution together with its ETag,
+// which must be sent to update the config.
+func (c *s3Client) getDistributionConfig(ctx context.Context, id string) (*cftypes.DistributionConfig, *string, error) {
+	out, err := c.cloudFront.GetDistributionConfig(ctx, &cloudfront.GetDistributionConfigInput{
+		Id: aws.String(id),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get config of distribution %s: %w", id, wrapNotFound(err))
+	}
+	if out.DistributionConfig == nil {
+		return nil, nil, fmt.Errorf("distribution %s has no config", id)
+	}
+	return out.DistributionConfig, out.ETag, nil
+}
+
+// poll calls the given function until it returns true or an error.
+func (c *s3Client) poll(ctx context.Context, done func() (bool, error)) error {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		ok, err := done()
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func cloudFrontOf(cdn CDN) (*CloudFront, error) {
+	if cdn.CloudFront == nil {
+		return nil, fmt.Errorf("cdn.cloudFront must be specified for the sites stored in Amazon S3")
+	}
+	return cdn.CloudFront, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package staticsite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+// ErrNotFound is returned when the requested CDN configuration or origin does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of the storage service hosting the site and the CDN serving it.
+// It is implemented for Amazon S3 with CloudFront and Cloud Storage with Cloud CDN.
+type Client interface {
+	// ListObjects returns the MD5 hashes in hex of the objects under the given prefix keyed by their keys.
+	// The hash is empty when it is unknown, e.g. for the objects uploaded in multiple parts.
+	ListObjects(ctx context.Context, bucket, prefix string) (map[string]string, error)
+	// PutObject uploads the given file to the bucket.
+	PutObject(ctx context.Context, bucket string, f File) error
+	DeleteObjects(ctx context.Context, bucket string, keys []string) error
+	// GetOriginPath returns the path in the bucket currently served by the CDN, e.g. "/site/blue".
+	// The empty string means the root of the bucket.
+	GetOriginPath(ctx context.Context, cdn CDN) (string, error)
+	// UpdateOriginPath changes the path in the bucket served by the CDN
+	// and waits until the change has been applied.
+	UpdateOriginPath(ctx context.Context, cdn CDN, path string) error
+	// Invalidate removes the given paths from the cache of the CDN
+	// and waits until the invalidation has been done.
+	Invalidate(ctx context.Context, cdn CDN, paths []string) error
+}
+
+// Registry holds a pool of static site clients.
+type Registry interface {
+	Client(ctx context.Context, name string, cfg *config.PlatformProviderStaticSiteConfig, logger *zap.Logger) (Client, error)
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(ctx context.Context, name string, cfg *config.PlatformProviderStaticSiteConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		switch {
+		case cfg.S3 != nil && cfg.GCS != nil:
+			return nil, fmt.Errorf("only one of s3 or gcs can be specified")
+		case cfg.S3 != nil:
+			return newS3Client(cfg.S3, logger)
+		case cfg.GCS != nil:
+			return newGCSClient(ctx, cfg.GCS, logger)
+		default:
+			return nil, fmt.Errorf("either s3 or gcs must be specified")
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of static site clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
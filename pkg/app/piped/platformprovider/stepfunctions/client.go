@@ -0,0 +1,301 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/aws/smithy-go"
+	"go.uber.org/zap"
+)
+
+const listStateMachinesMaxResults = 100
+
+// StateMachine represents the current state of a state machine.
+type StateMachine struct {
+	StateMachineArn      string
+	Name                 string
+	Status               string
+	Type                 string
+	Definition           string
+	RoleArn              string
+	LoggingConfiguration *LoggingConfiguration
+	TracingConfiguration *TracingConfiguration
+}
+
+// Alias represents a state machine alias routing the executions to at most two versions.
+type Alias struct {
+	StateMachineAliasArn string
+	Name                 string
+	RoutingConfiguration []Route
+}
+
+// Route is the percentage of the executions routed to a version.
+type Route struct {
+	StateMachineVersionArn string
+	Weight                 int
+}
+
+type client struct {
+	sfnClient *sfn.Client
+	logger    *zap.Logger
+}
+
+func newClient(region, profile, credentialsFile, roleARN, tokenPath string, logger *zap.Logger) (*client, error) {
+	if region == "" {
+		return nil, fmt.Errorf("region is required field")
+	}
+
+	optFns := []func(*config.LoadOptions) error{config.WithRegion(region)}
+	if credentialsFile != "" {
+		optFns = append(optFns, config.WithSharedCredentialsFiles([]string{credentialsFile}))
+	}
+	if profile != "" {
+		optFns = append(optFns, config.WithSharedConfigProfile(profile))
+	}
+	if tokenPath != "" && roleARN != "" {
+		optFns = append(optFns, config.WithWebIdentityRoleCredentialOptions(func(v *stscreds.WebIdentityRoleOptions) {
+			v.RoleARN = roleARN
+			v.TokenRetriever = stscreds.IdentityTokenFile(tokenPath)
+		}))
+	}
+
+	cfg, err := config.LoadDefaultConfig(context.Background(), optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config to create stepfunctions client: %w", err)
+	}
+
+	return &client{
+		sfnClient: sfn.NewFromConfig(cfg),
+		logger:    logger.Named("stepfunctions"),
+	}, nil
+}
+
+func (c *client) FindStateMachine(ctx context.Context, name string) (*StateMachine, error) {
+	in := &sfn.ListStateMachinesInput{
+		MaxResults: listStateMachinesMaxResults,
+	}
+	for {
+		out, err := c.sfnClient.ListStateMachines(ctx, in)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list state machines: %w", err)
+		}
+		for _, sm := range out.StateMachines {
+			if aws.ToString(sm.Name) == name {
+				return c.DescribeStateMachine(ctx, aws.ToString(sm.StateMachineArn))
+			}
+		}
+		if out.NextToken == nil {
+			return nil, ErrNotFound
+		}
+		in.NextToken = out.NextToken
+	}
+}
+
+func (c *client) DescribeStateMachine(ctx context.Context, stateMachineArn string) (*StateMachine, error) {
+	out, err := c.sfnClient.DescribeStateMachine(ctx, &sfn.DescribeStateMachineInput{
+		StateMachineArn: aws.String(stateMachineArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe state machine %s: %w", stateMachineArn, wrapNotFound(err))
+	}
+	return &StateMachine{
+		StateMachineArn:      aws.ToString(out.StateMachineArn),
+		Name:                 aws.ToString(out.Name),
+		Status:               string(out.Status),
+		Type:                 string(out.Type),
+		Definition:           aws.ToString(out.Definition),
+		RoleArn:              aws.ToString(out.RoleArn),
+		LoggingConfiguration: makeLoggingConfiguration(out.LoggingConfiguration),
+		TracingConfiguration: makeTracingConfiguration(out.TracingConfiguration),
+	}, nil
+}
+
+func (c *client) CreateStateMachine(ctx context.Context, sm StateMachineManifest, publish bool, versionDescription string) (string, string, error) {
+	in := &sfn.CreateStateMachineInput{
+		Name:                 aws.String(sm.Spec.Name),
+		Definition:           aws.String(sm.Definition),
+		RoleArn:              aws.String(sm.Spec.RoleArn),
+		Type:                 types.StateMachineType(sm.Spec.Type),
+		LoggingConfiguration: sm.Spec.LoggingConfiguration.toSDK(),
+		TracingConfiguration: sm.Spec.TracingConfiguration.toSDK(),
+		Tags:                 makeTags(sm.Spec.Tags),
+		Publish:              publish,
+	}
+	if publish {
+		in.VersionDescription = aws.String(versionDescription)
+	}
+	out, err := c.sfnClient.CreateStateMachine(ctx, in)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to create state machine %s: %w", sm.Spec.Name, err)
+	}
+	return aws.ToString(out.StateMachineArn), aws.ToString(out.StateMachineVersionArn), nil
+}
+
+func (c *client) UpdateStateMachine(ctx context.Context, stateMachineArn string, sm StateMachineManifest, publish bool, versionDescription string) (string, error) {
+	// The name, the type and the tags of the state machine can not be updated by UpdateStateMachine.
+	in := &sfn.UpdateStateMachineInput{
+		StateMachineArn:      aws.String(stateMachineArn),
+		Definition:           aws.String(sm.Definition),
+		RoleArn:              aws.String(sm.Spec.RoleArn),
+		LoggingConfiguration: sm.Spec.LoggingConfiguration.toSDK(),
+		TracingConfiguration: sm.Spec.TracingConfiguration.toSDK(),
+		Publish:              publish,
+	}
+	if publish {
+		in.VersionDescription = aws.String(versionDescription)
+	}
+	out, err := c.sfnClient.UpdateStateMachine(ctx, in)
+	if err != nil {
+		return "", fmt.Errorf("failed to update state machine %s: %w", sm.Spec.Name, wrapNotFound(err))
+	}
+	return aws.ToString(out.StateMachineVersionArn), nil
+}
+
+func (c *client) TagResource(ctx context.Context, resourceArn string, tags map[string]string) error {
+	_, err := c.sfnClient.TagResource(ctx, &sfn.TagResourceInput{
+		ResourceArn: aws.String(resourceArn),
+		Tags:        makeTags(tags),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag step functions resource %s: %w", resourceArn, wrapNotFound(err))
+	}
+	return nil
+}
+
+func (c *client) DescribeAlias(ctx context.Context, aliasArn string) (*Alias, error) {
+	out, err := c.sfnClient.DescribeStateMachineAlias(ctx, &sfn.DescribeStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(aliasArn),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to describe state machine alias %s: %w", aliasArn, wrapNotFound(err))
+	}
+	routes := make([]Route, 0, len(out.RoutingConfiguration))
+	for _, r := range out.RoutingConfiguration {
+		routes = append(routes, Route{
+			StateMachineVersionArn: aws.ToString(r.StateMachineVersionArn),
+			Weight:                 int(r.Weight),
+		})
+	}
+	return &Alias{
+		StateMachineAliasArn: aws.ToString(out.StateMachineAliasArn),
+		Name:                 aws.ToString(out.Name),
+		RoutingConfiguration: routes,
+	}, nil
+}
+
+func (c *client) CreateAlias(ctx context.Context, name string, routes []Route) (*Alias, error) {
+	out, err := c.sfnClient.CreateStateMachineAlias(ctx, &sfn.CreateStateMachineAliasInput{
+		Name:                 aws.String(name),
+		RoutingConfiguration: makeRoutingConfiguration(routes),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create state machine alias %s: %w", name, err)
+	}
+	return &Alias{
+		StateMachineAliasArn: aws.ToString(out.StateMachineAliasArn),
+		Name:                 name,
+		RoutingConfiguration: routes,
+	}, nil
+}
+
+func (c *client) UpdateAlias(ctx context.Context, aliasArn string, routes []Route) error {
+	_, err := c.sfnClient.UpdateStateMachineAlias(ctx, &sfn.UpdateStateMachineAliasInput{
+		StateMachineAliasArn: aws.String(aliasArn),
+		RoutingConfiguration: makeRoutingConfiguration(routes),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update state machine alias %s: %w", aliasArn, wrapNotFound(err))
+	}
+	return nil
+}
+
+func makeRoutingConfiguration(routes []Route) []types.RoutingConfigurationListItem {
+	out := make([]types.RoutingConfigurationListItem, 0, len(routes))
+	for _, r := range routes {
+		out = append(out, types.RoutingConfigurationListItem{
+			StateMachineVersionArn: aws.String(r.StateMachineVersionArn),
+			Weight:                 int32(r.Weight),
+		})
+	}
+	return out
+}
+
+func makeTags(tags map[string]string) []types.Tag {
+	out := make([]types.Tag, 0, len(tags))
+	for k, v := range tags {
+		out = append(out, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return aws.ToString(out[i].Key) < aws.ToString(out[j].Key)
+	})
+	return out
+}
+
+func makeLoggingConfiguration(cfg *types.LoggingConfiguration) *LoggingConfiguration {
+	if cfg == nil {
+		return nil
+	}
+	out := &LoggingConfiguration{
+		Level:                string(cfg.Level),
+		IncludeExecutionData: cfg.IncludeExecutionData,
+	}
+	for _, d := range cfg.Destinations {
+		dest := LogDestination{}
+		if d.CloudWatchLogsLogGroup != nil {
+			dest.CloudWatchLogsLogGroup = &CloudWatchLogsLogGroup{
+				LogGroupArn: aws.ToString(d.CloudWatchLogsLogGroup.LogGroupArn),
+			}
+		}
+		out.Destinations = append(out.Destinations, dest)
+	}
+	return out
+}
+
+func makeTracingConfiguration(cfg *types.TracingConfiguration) *TracingConfiguration {
+	if cfg == nil {
+		return nil
+	}
+	return &TracingConfiguration{Enabled: cfg.Enabled}
+}
+
+// wrapNotFound converts the error returned when the state machine or the alias does not exist into ErrNotFound.
+func wrapNotFound(err error) error {
+	var (
+		smNotFound       *types.StateMachineDoesNotExist
+		resourceNotFound *types.ResourceNotFound
+	)
+	switch {
+	case errors.As(err, &smNotFound):
+		return fmt.Errorf("%w: %s", ErrNotFound, smNotFound.ErrorMessage())
+	case errors.As(err, &resourceNotFound):
+		return fmt.Errorf("%w: %s", ErrNotFound, resourceNotFound.ErrorMessage())
+	}
+	return err
+}
+
+// IsAPIError reports whether the given error is an AWS API error having the given code.
+func IsAPIError(err error, code string) bool {
+	var e smithy.APIError
+	return errors.As(err, &e) && e.ErrorCode() == code
+}
@@ -0,0 +1,132 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/sfn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func newTestClient(t *testing.T, h http.HandlerFunc) *client {
+	ts := httptest.NewServer(h)
+	t.Cleanup(ts.Close)
+	cfg := aws.Config{
+		Region:      "ap-northeast-1",
+		Credentials: credentials.NewStaticCredentialsProvider("key", "secret", ""),
+	}
+	return &client{
+		sfnClient: sfn.NewFromConfig(cfg, func(o *sfn.Options) {
+			o.EndpointResolver = sfn.EndpointResolverFromURL(ts.URL)
+		}),
+		logger: zap.NewNop(),
+	}
+}
+
+func TestFindStateMachine(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/x-amz-json-1.0", r.Header.Get("Content-Type"))
+		assert.Contains(t, r.Header.Get("Authorization"), "/states/aws4_request")
+
+		var in map[string]interface{}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&in))
+
+		switch r.Header.Get("X-Amz-Target") {
+		case "AWSStepFunctions.ListStateMachines":
+			if in["nextToken"] == nil {
+				io.WriteString(w, `{"stateMachines":[{"name":"other","stateMachineArn":"arn:other"}],"nextToken":"next"}`)
+				return
+			}
+			io.WriteString(w, `{"stateMachines":[{"name":"hello","stateMachineArn":"arn:hello"}]}`)
+		case "AWSStepFunctions.DescribeStateMachine":
+			assert.Equal(t, "arn:hello", in["stateMachineArn"])
+			io.WriteString(w, `{"name":"hello","stateMachineArn":"arn:hello","type":"STANDARD","status":"ACTIVE","definition":"{}","roleArn":"arn:role"}`)
+		default:
+			t.Errorf("unexpected target %s", r.Header.Get("X-Amz-Target"))
+		}
+	})
+
+	sm, err := c.FindStateMachine(context.Background(), "hello")
+	require.NoError(t, err)
+	assert.Equal(t, &StateMachine{
+		StateMachineArn: "arn:hello",
+		Name:            "hello",
+		Status:          "ACTIVE",
+		Type:            StateMachineTypeStandard,
+		Definition:      "{}",
+		RoleArn:         "arn:role",
+	}, sm)
+
+	_, err = c.FindStateMachine(context.Background(), "missing")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestUpdateAlias(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AWSStepFunctions.UpdateStateMachineAlias", r.Header.Get("X-Amz-Target"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"stateMachineAliasArn":"arn:hello:live","routingConfiguration":[{"stateMachineVersionArn":"arn:hello:2","weight":10},{"stateMachineVersionArn":"arn:hello:1","weight":90}]}`, string(body))
+		io.WriteString(w, `{"updateDate":1.6e9}`)
+	})
+
+	err := c.UpdateAlias(context.Background(), "arn:hello:live", []Route{
+		{StateMachineVersionArn: "arn:hello:2", Weight: 10},
+		{StateMachineVersionArn: "arn:hello:1", Weight: 90},
+	})
+	require.NoError(t, err)
+}
+
+func TestDescribeAliasNotFound(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "AWSStepFunctions.DescribeStateMachineAlias", r.Header.Get("X-Amz-Target"))
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"__type":"com.amazonaws.swf.service.v2.model#ResourceNotFound","message":"alias not found"}`)
+	})
+
+	_, err := c.DescribeAlias(context.Background(), "arn:hello:live")
+	assert.True(t, errors.Is(err, ErrNotFound))
+}
+
+func TestUpdateStateMachineError(t *testing.T) {
+	t.Parallel()
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"__type":"com.amazonaws.swf.service.v2.model#InvalidDefinition","message":"invalid state machine definition"}`)
+	})
+
+	_, err := c.UpdateStateMachine(context.Background(), "arn:hello", StateMachineManifest{}, false, "")
+	require.Error(t, err)
+	assert.False(t, errors.Is(err, ErrNotFound))
+	assert.True(t, IsAPIError(err, "InvalidDefinition"))
+}
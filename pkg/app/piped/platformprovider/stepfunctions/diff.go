@@ -0,0 +1,107 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+)
+
+const loggingLevelOff = "OFF"
+
+// comparedStateMachine contains the fields of the state machine compared by drift detection and plan-preview.
+type comparedStateMachine struct {
+	Type                 string                 `json:"type,omitempty"`
+	RoleArn              string                 `json:"roleArn,omitempty"`
+	LoggingConfiguration *LoggingConfiguration  `json:"loggingConfiguration,omitempty"`
+	TracingConfiguration *TracingConfiguration  `json:"tracingConfiguration,omitempty"`
+	Definition           map[string]interface{} `json:"definition,omitempty"`
+}
+
+// DiffStateMachines calculates the diff between the two given state machine manifests.
+// The definitions are compared as parsed JSON so that the formatting of the files is ignored.
+func DiffStateMachines(old, new StateMachineManifest) (*diff.Result, error) {
+	o, err := makeComparedStateMachine(old.Spec.Type, old.Spec.RoleArn, old.Definition, old.Spec.LoggingConfiguration, old.Spec.TracingConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	n, err := makeComparedStateMachine(new.Spec.Type, new.Spec.RoleArn, new.Definition, new.Spec.LoggingConfiguration, new.Spec.TracingConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return diffComparedStateMachines(o, n, new.Spec.Name)
+}
+
+// DiffLiveStateMachine calculates the diff between the live state machine and the one defined in Git.
+// The type, role, logging, tracing and definition are compared.
+func DiffLiveStateMachine(live *StateMachine, expected StateMachineManifest) (*diff.Result, error) {
+	l, err := makeComparedStateMachine(live.Type, live.RoleArn, live.Definition, live.LoggingConfiguration, live.TracingConfiguration)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the live definition: %w", err)
+	}
+	e, err := makeComparedStateMachine(expected.Spec.Type, expected.Spec.RoleArn, expected.Definition, expected.Spec.LoggingConfiguration, expected.Spec.TracingConfiguration)
+	if err != nil {
+		return nil, err
+	}
+	return diffComparedStateMachines(l, e, expected.Spec.Name)
+}
+
+func makeComparedStateMachine(typ, roleArn, definition string, logging *LoggingConfiguration, tracing *TracingConfiguration) (comparedStateMachine, error) {
+	out := comparedStateMachine{
+		Type:    typ,
+		RoleArn: roleArn,
+	}
+	// The disabled logging and tracing are returned by the API even when they were not configured.
+	if logging != nil && !(logging.Level == loggingLevelOff && len(logging.Destinations) == 0) {
+		out.LoggingConfiguration = logging
+	}
+	if tracing != nil && tracing.Enabled {
+		out.TracingConfiguration = tracing
+	}
+	if definition != "" {
+		if err := json.Unmarshal([]byte(definition), &out.Definition); err != nil {
+			return comparedStateMachine{}, err
+		}
+	}
+	return out, nil
+}
+
+func diffComparedStateMachines(x, y comparedStateMachine, name string) (*diff.Result, error) {
+	xu, err := toUnstructured(x)
+	if err != nil {
+		return nil, err
+	}
+	yu, err := toUnstructured(y)
+	if err != nil {
+		return nil, err
+	}
+	return diff.DiffUnstructureds(xu, yu, name, diff.WithEquateEmpty())
+}
+
+func toUnstructured(obj interface{}) (unstructured.Unstructured, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		return unstructured.Unstructured{}, err
+	}
+	return unstructured.Unstructured{Object: m}, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiffLiveStateMachine(t *testing.T) {
+	t.Parallel()
+
+	expected := StateMachineManifest{
+		Spec: StateMachineManifestSpec{
+			Name:    "hello",
+			Type:    StateMachineTypeStandard,
+			RoleArn: "arn:aws:iam::123456789012:role/sfn",
+		},
+		Definition: `{
+  "StartAt": "Hello",
+  "States": {
+    "Hello": {"Type": "Pass", "Result": "world", "End": true}
+  }
+}`,
+	}
+
+	testcases := []struct {
+		name          string
+		live          *StateMachine
+		expectedPaths []string
+	}{
+		{
+			name: "no diff with different formatting and disabled logging",
+			live: &StateMachine{
+				Name:                 "hello",
+				Type:                 StateMachineTypeStandard,
+				RoleArn:              "arn:aws:iam::123456789012:role/sfn",
+				Definition:           `{"States":{"Hello":{"End":true,"Result":"world","Type":"Pass"}},"StartAt":"Hello"}`,
+				LoggingConfiguration: &LoggingConfiguration{Level: loggingLevelOff},
+				TracingConfiguration: &TracingConfiguration{Enabled: false},
+			},
+			expectedPaths: []string{},
+		},
+		{
+			name: "changed on the console",
+			live: &StateMachine{
+				Name:                 "hello",
+				Type:                 StateMachineTypeStandard,
+				RoleArn:              "arn:aws:iam::123456789012:role/other",
+				Definition:           `{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","Result":"pipecd","End":true}}}`,
+				TracingConfiguration: &TracingConfiguration{Enabled: true},
+			},
+			expectedPaths: []string{"roleArn", "definition.States.Hello.Result", "tracingConfiguration"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := DiffLiveStateMachine(tc.live, expected)
+			require.NoError(t, err)
+			paths := make([]string, 0, result.NumNodes())
+			for _, n := range result.Nodes() {
+				paths = append(paths, n.PathString)
+			}
+			assert.ElementsMatch(t, tc.expectedPaths, paths)
+		})
+	}
+}
+
+func TestDiffStateMachines(t *testing.T) {
+	t.Parallel()
+
+	old := StateMachineManifest{
+		Spec: StateMachineManifestSpec{
+			Name:    "hello",
+			Type:    StateMachineTypeStandard,
+			RoleArn: "arn:aws:iam::123456789012:role/sfn",
+		},
+		Definition: `{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`,
+	}
+	new := old
+	new.Definition = `{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","Next":"Wait"},"Wait":{"Type":"Wait","Seconds":10,"End":true}}}`
+
+	result, err := DiffStateMachines(old, new)
+	require.NoError(t, err)
+	paths := make([]string, 0, result.NumNodes())
+	for _, n := range result.Nodes() {
+		paths = append(paths, n.PathString)
+	}
+	assert.ElementsMatch(t, []string{"definition.States.Hello.End", "definition.States.Hello.Next", "definition.States.Wait"}, paths)
+}
@@ -0,0 +1,222 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	versionV1Beta1           = "pipecd.dev/v1beta1"
+	stateMachineManifestKind = "StepFunctionsStateMachine"
+
+	StateMachineTypeStandard = "STANDARD"
+	StateMachineTypeExpress  = "EXPRESS"
+
+	defaultAliasName = "live"
+)
+
+var (
+	// The name of the state machine must be 1 to 80 characters long.
+	stateMachineNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]{1,80}$`)
+	// The name of the alias must not be a number since it would be confused with a version.
+	aliasNameRegex = regexp.MustCompile(`^[A-Za-z_-][A-Za-z0-9_-]{0,79}$`)
+)
+
+type StateMachineManifest struct {
+	Kind       string                   `json:"kind"`
+	APIVersion string                   `json:"apiVersion,omitempty"`
+	Spec       StateMachineManifestSpec `json:"spec"`
+
+	// The content of the definition file written in Amazon States Language.
+	Definition string `json:"-"`
+}
+
+func (sm *StateMachineManifest) validate() error {
+	if sm.APIVersion != versionV1Beta1 {
+		return fmt.Errorf("unsupported version: %s", sm.APIVersion)
+	}
+	if sm.Kind != stateMachineManifestKind {
+		return fmt.Errorf("invalid manifest kind given: %s", sm.Kind)
+	}
+	return sm.Spec.validate()
+}
+
+// StateMachineManifestSpec contains configuration for StepFunctionsStateMachine.
+type StateMachineManifestSpec struct {
+	Name string `json:"name"`
+	// Either STANDARD or EXPRESS. Default is STANDARD.
+	// The type can not be changed after the state machine was created.
+	Type string `json:"type,omitempty"`
+	// The ARN of the IAM role used by the state machine.
+	RoleArn string `json:"roleArn"`
+	// The path to the file of the state machine definition written in Amazon States Language.
+	// It is relative to the application directory.
+	DefinitionFile string `json:"definitionFile"`
+	// The name of the alias the executions are started with.
+	// Default is live.
+	Alias                string                `json:"alias,omitempty"`
+	LoggingConfiguration *LoggingConfiguration `json:"loggingConfiguration,omitempty"`
+	TracingConfiguration *TracingConfiguration `json:"tracingConfiguration,omitempty"`
+	// Tags are only added when the state machine is created, use TagResource to update them later.
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+type LoggingConfiguration struct {
+	// One of ALL, ERROR, FATAL or OFF.
+	Level                string           `json:"level,omitempty"`
+	IncludeExecutionData bool             `json:"includeExecutionData,omitempty"`
+	Destinations         []LogDestination `json:"destinations,omitempty"`
+}
+
+type LogDestination struct {
+	CloudWatchLogsLogGroup *CloudWatchLogsLogGroup `json:"cloudWatchLogsLogGroup,omitempty"`
+}
+
+type CloudWatchLogsLogGroup struct {
+	LogGroupArn string `json:"logGroupArn"`
+}
+
+type TracingConfiguration struct {
+	Enabled bool `json:"enabled"`
+}
+
+func (c *LoggingConfiguration) toSDK() *types.LoggingConfiguration {
+	if c == nil {
+		return nil
+	}
+	out := &types.LoggingConfiguration{
+		Level:                types.LogLevel(c.Level),
+		IncludeExecutionData: c.IncludeExecutionData,
+	}
+	for _, d := range c.Destinations {
+		dest := types.LogDestination{}
+		if d.CloudWatchLogsLogGroup != nil {
+			dest.CloudWatchLogsLogGroup = &types.CloudWatchLogsLogGroup{
+				LogGroupArn: aws.String(d.CloudWatchLogsLogGroup.LogGroupArn),
+			}
+		}
+		out.Destinations = append(out.Destinations, dest)
+	}
+	return out
+}
+
+func (c *TracingConfiguration) toSDK() *types.TracingConfiguration {
+	if c == nil {
+		return nil
+	}
+	return &types.TracingConfiguration{Enabled: c.Enabled}
+}
+
+func (s StateMachineManifestSpec) validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("name is missing")
+	}
+	if !stateMachineNameRegex.MatchString(s.Name) {
+		return fmt.Errorf("name must be 1 to 80 characters long and consist of alphanumeric characters, hyphens and underscores")
+	}
+	switch s.Type {
+	case StateMachineTypeStandard, StateMachineTypeExpress:
+	default:
+		return fmt.Errorf("type must be %s or %s", StateMachineTypeStandard, StateMachineTypeExpress)
+	}
+	if s.RoleArn == "" {
+		return fmt.Errorf("roleArn is missing")
+	}
+	if s.DefinitionFile == "" {
+		return fmt.Errorf("definitionFile is missing")
+	}
+	if !aliasNameRegex.MatchString(s.Alias) {
+		return fmt.Errorf("alias must be 1 to 80 characters long, consist of alphanumeric characters, hyphens and underscores and not start with a number")
+	}
+	return nil
+}
+
+// AddTags adds the given tags to the state machine.
+func (sm *StateMachineManifest) AddTags(tags map[string]string) {
+	if sm.Spec.Tags == nil {
+		sm.Spec.Tags = make(map[string]string, len(tags))
+	}
+	for k, v := range tags {
+		sm.Spec.Tags[k] = v
+	}
+}
+
+// LoadStateMachineManifest returns StateMachineManifest object from a given state machine manifest file
+// together with the definition loaded from its definition file.
+func LoadStateMachineManifest(appDir, manifestFilename string) (StateMachineManifest, error) {
+	data, err := os.ReadFile(filepath.Join(appDir, manifestFilename))
+	if err != nil {
+		return StateMachineManifest{}, err
+	}
+	sm, err := parseStateMachineManifest(data)
+	if err != nil {
+		return StateMachineManifest{}, err
+	}
+
+	def, err := os.ReadFile(filepath.Join(appDir, sm.Spec.DefinitionFile))
+	if err != nil {
+		return StateMachineManifest{}, fmt.Errorf("failed to read definition file %s: %w", sm.Spec.DefinitionFile, err)
+	}
+	if err := validateDefinition(def); err != nil {
+		return StateMachineManifest{}, fmt.Errorf("invalid definition file %s: %w", sm.Spec.DefinitionFile, err)
+	}
+	sm.Definition = string(def)
+	return sm, nil
+}
+
+func parseStateMachineManifest(data []byte) (StateMachineManifest, error) {
+	var sm StateMachineManifest
+	if err := yaml.Unmarshal(data, &sm); err != nil {
+		return StateMachineManifest{}, err
+	}
+	if sm.Spec.Type == "" {
+		sm.Spec.Type = StateMachineTypeStandard
+	}
+	if sm.Spec.Alias == "" {
+		sm.Spec.Alias = defaultAliasName
+	}
+	if err := sm.validate(); err != nil {
+		return StateMachineManifest{}, err
+	}
+	return sm, nil
+}
+
+// validateDefinition checks the minimal structure of a state machine definition.
+// The rest of the definition is validated by Step Functions while applying it.
+func validateDefinition(data []byte) error {
+	var def struct {
+		StartAt string                     `json:"StartAt"`
+		States  map[string]json.RawMessage `json:"States"`
+	}
+	if err := json.Unmarshal(data, &def); err != nil {
+		return fmt.Errorf("definition must be a JSON object: %w", err)
+	}
+	if def.StartAt == "" {
+		return fmt.Errorf("StartAt is missing")
+	}
+	if _, ok := def.States[def.StartAt]; !ok {
+		return fmt.Errorf("StartAt state %s is not defined in States", def.StartAt)
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStateMachineManifest(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		data      string
+		expected  StateMachineManifest
+		expectErr bool
+	}{
+		{
+			name: "valid manifest with defaults",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StepFunctionsStateMachine
+spec:
+  name: hello
+  roleArn: arn:aws:iam::123456789012:role/sfn
+  definitionFile: definition.asl.json
+  tracingConfiguration:
+    enabled: true
+  tags:
+    app: hello
+`,
+			expected: StateMachineManifest{
+				Kind:       "StepFunctionsStateMachine",
+				APIVersion: "pipecd.dev/v1beta1",
+				Spec: StateMachineManifestSpec{
+					Name:                 "hello",
+					Type:                 StateMachineTypeStandard,
+					RoleArn:              "arn:aws:iam::123456789012:role/sfn",
+					DefinitionFile:       "definition.asl.json",
+					Alias:                "live",
+					TracingConfiguration: &TracingConfiguration{Enabled: true},
+					Tags:                 map[string]string{"app": "hello"},
+				},
+			},
+		},
+		{
+			name: "invalid type",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StepFunctionsStateMachine
+spec:
+  name: hello
+  type: SYNC
+  roleArn: arn:aws:iam::123456789012:role/sfn
+  definitionFile: definition.asl.json
+`,
+			expectErr: true,
+		},
+		{
+			name: "numeric alias",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StepFunctionsStateMachine
+spec:
+  name: hello
+  roleArn: arn:aws:iam::123456789012:role/sfn
+  definitionFile: definition.asl.json
+  alias: "1"
+`,
+			expectErr: true,
+		},
+		{
+			name: "missing definition file",
+			data: `
+apiVersion: pipecd.dev/v1beta1
+kind: StepFunctionsStateMachine
+spec:
+  name: hello
+  roleArn: arn:aws:iam::123456789012:role/sfn
+`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			sm, err := parseStateMachineManifest([]byte(tc.data))
+			assert.Equal(t, tc.expectErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, sm)
+			}
+		})
+	}
+}
+
+func TestLoadStateMachineManifest(t *testing.T) {
+	t.Parallel()
+
+	const manifest = `
+apiVersion: pipecd.dev/v1beta1
+kind: StepFunctionsStateMachine
+spec:
+  name: hello
+  roleArn: arn:aws:iam::123456789012:role/sfn
+  definitionFile: definition.asl.json
+`
+	testcases := []struct {
+		name       string
+		definition string
+		expectErr  bool
+	}{
+		{
+			name:       "valid definition",
+			definition: `{"StartAt":"Hello","States":{"Hello":{"Type":"Pass","End":true}}}`,
+		},
+		{
+			name:       "not a json",
+			definition: `StartAt: Hello`,
+			expectErr:  true,
+		},
+		{
+			name:       "undefined start state",
+			definition: `{"StartAt":"Hello","States":{"World":{"Type":"Pass","End":true}}}`,
+			expectErr:  true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			dir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "statemachine.yaml"), []byte(manifest), 0644))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, "definition.asl.json"), []byte(tc.definition), 0644))
+
+			sm, err := LoadStateMachineManifest(dir, "statemachine.yaml")
+			assert.Equal(t, tc.expectErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.definition, sm.Definition)
+			}
+		})
+	}
+}
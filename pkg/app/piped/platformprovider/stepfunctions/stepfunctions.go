@@ -0,0 +1,110 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stepfunctions
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/pipe-cd/pipecd/pkg/config"
+)
+
+const (
+	LabelManagedBy   string = "pipecd-dev-managed-by"  // Always be piped.
+	LabelPiped       string = "pipecd-dev-piped"       // The id of piped handling this application.
+	LabelApplication string = "pipecd-dev-application" // The application this resource belongs to.
+	LabelCommitHash  string = "pipecd-dev-commit-hash" // Hash value of the deployed commit.
+	ManagedByPiped   string = "piped"
+)
+
+// ErrNotFound is returned when the requested state machine or alias does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Client is wrapper of Step Functions API.
+type Client interface {
+	// FindStateMachine returns the state machine having the given name.
+	// ErrNotFound is returned when there is no such state machine.
+	FindStateMachine(ctx context.Context, name string) (*StateMachine, error)
+	DescribeStateMachine(ctx context.Context, stateMachineArn string) (*StateMachine, error)
+	// CreateStateMachine creates a new state machine and returns its ARN
+	// together with the ARN of the published version if publish is true.
+	CreateStateMachine(ctx context.Context, sm StateMachineManifest, publish bool, versionDescription string) (stateMachineArn, versionArn string, err error)
+	// UpdateStateMachine updates the state machine and returns the ARN of the published version if publish is true.
+	UpdateStateMachine(ctx context.Context, stateMachineArn string, sm StateMachineManifest, publish bool, versionDescription string) (versionArn string, err error)
+	TagResource(ctx context.Context, resourceArn string, tags map[string]string) error
+	// DescribeAlias returns the alias having the given ARN.
+	// ErrNotFound is returned when there is no such alias.
+	DescribeAlias(ctx context.Context, aliasArn string) (*Alias, error)
+	CreateAlias(ctx context.Context, name string, routes []Route) (*Alias, error)
+	UpdateAlias(ctx context.Context, aliasArn string, routes []Route) error
+}
+
+// Registry holds a pool of aws client wrappers.
+type Registry interface {
+	Client(name string, cfg *config.PlatformProviderStepFunctionsConfig, logger *zap.Logger) (Client, error)
+}
+
+// AliasArn returns the ARN of the alias having the given name of the given state machine.
+func AliasArn(stateMachineArn, alias string) string {
+	return stateMachineArn + ":" + alias
+}
+
+// VersionDescription returns the description of the version published for the given commit.
+func VersionDescription(commitHash string) string {
+	return "Deployed by PipeCD at commit " + commitHash
+}
+
+type registry struct {
+	clients  map[string]Client
+	mu       sync.RWMutex
+	newGroup *singleflight.Group
+}
+
+func (r *registry) Client(name string, cfg *config.PlatformProviderStepFunctionsConfig, logger *zap.Logger) (Client, error) {
+	r.mu.RLock()
+	client, ok := r.clients[name]
+	r.mu.RUnlock()
+	if ok {
+		return client, nil
+	}
+
+	c, err, _ := r.newGroup.Do(name, func() (interface{}, error) {
+		return newClient(cfg.Region, cfg.Profile, cfg.CredentialsFile, cfg.RoleARN, cfg.TokenFile, logger)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client = c.(Client)
+	r.mu.Lock()
+	r.clients[name] = client
+	r.mu.Unlock()
+
+	return client, nil
+}
+
+var defaultRegistry = &registry{
+	clients:  make(map[string]Client),
+	newGroup: &singleflight.Group{},
+}
+
+// DefaultRegistry returns a pool of aws clients and a mutex associated with it.
+func DefaultRegistry() Registry {
+	return defaultRegistry
+}
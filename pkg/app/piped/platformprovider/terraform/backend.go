@@ -0,0 +1,68 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"text/template"
+)
+
+// BackendConfigData is the data used to render the templated backend configuration values.
+// For example, "{{ .App.Labels.env }}/{{ .App.Name }}" can be used as the key prefix
+// to isolate the state of each environment.
+type BackendConfigData struct {
+	App       BackendConfigApp
+	Workspace string
+}
+
+type BackendConfigApp struct {
+	ID     string
+	Name   string
+	Labels map[string]string
+}
+
+// RenderBackendConfig renders the templated values of the given backend configuration.
+// An error is returned when a value refers to an undefined data such as a missing label.
+func RenderBackendConfig(cfg map[string]string, data BackendConfigData) (map[string]string, error) {
+	out := make(map[string]string, len(cfg))
+	for k, v := range cfg {
+		tmpl, err := template.New(k).Option("missingkey=error").Parse(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse backend config %q (%w)", k, err)
+		}
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("failed to render backend config %q (%w)", k, err)
+		}
+		out[k] = buf.String()
+	}
+	return out, nil
+}
+
+func makeBackendConfigArgs(cfg map[string]string) []string {
+	keys := make([]string, 0, len(cfg))
+	for k := range cfg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	args := make([]string, 0, len(keys))
+	for _, k := range keys {
+		args = append(args, fmt.Sprintf("-backend-config=%s=%s", k, cfg[k]))
+	}
+	return args
+}
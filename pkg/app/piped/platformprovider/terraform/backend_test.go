@@ -0,0 +1,101 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderBackendConfig(t *testing.T) {
+	t.Parallel()
+
+	data := BackendConfigData{
+		App: BackendConfigApp{
+			ID:     "app-id",
+			Name:   "network",
+			Labels: map[string]string{"env": "prod"},
+		},
+		Workspace: "default",
+	}
+
+	testcases := []struct {
+		name        string
+		cfg         map[string]string
+		expected    map[string]string
+		expectedErr bool
+	}{
+		{
+			name:     "empty",
+			cfg:      nil,
+			expected: map[string]string{},
+		},
+		{
+			name: "templated values",
+			cfg: map[string]string{
+				"bucket":   "tfstate-{{ .App.Labels.env }}",
+				"key":      "{{ .App.Labels.env }}/{{ .App.Name }}/terraform.tfstate",
+				"role_arn": "arn:aws:iam::123456789012:role/terraform",
+			},
+			expected: map[string]string{
+				"bucket":   "tfstate-prod",
+				"key":      "prod/network/terraform.tfstate",
+				"role_arn": "arn:aws:iam::123456789012:role/terraform",
+			},
+		},
+		{
+			name: "missing label",
+			cfg: map[string]string{
+				"bucket": "tfstate-{{ .App.Labels.team }}",
+			},
+			expectedErr: true,
+		},
+		{
+			name: "malformed template",
+			cfg: map[string]string{
+				"bucket": "tfstate-{{ .App.Name",
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := RenderBackendConfig(tc.cfg, data)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			if err == nil {
+				assert.Equal(t, tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestMakeBackendConfigArgs(t *testing.T) {
+	t.Parallel()
+
+	args := makeBackendConfigArgs(map[string]string{
+		"key":    "prod/network/terraform.tfstate",
+		"bucket": "tfstate-prod",
+	})
+	assert.Equal(t, []string{
+		"-backend-config=bucket=tfstate-prod",
+		"-backend-config=key=prod/network/terraform.tfstate",
+	}, args)
+	assert.Empty(t, makeBackendConfigArgs(nil))
+}
@@ -0,0 +1,145 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+)
+
+// CostEstimate represents the monthly cost of the planned changes estimated by Infracost.
+type CostEstimate struct {
+	Currency string
+	// The monthly cost before applying the changes.
+	PastMonthlyCost float64
+	// The monthly cost after applying the changes.
+	MonthlyCost float64
+	// The difference of the monthly cost caused by the changes.
+	DiffMonthlyCost float64
+}
+
+// FormatDiff returns the signed difference of the monthly cost, e.g. "+12.34 USD".
+func (c CostEstimate) FormatDiff() string {
+	sign := "+"
+	if c.DiffMonthlyCost < 0 {
+		sign = "-"
+	}
+	diff := c.DiffMonthlyCost
+	if diff < 0 {
+		diff = -diff
+	}
+	return fmt.Sprintf("%s%.2f %s", sign, diff, c.Currency)
+}
+
+func (c CostEstimate) String() string {
+	return fmt.Sprintf("%s per month (%.2f %s -> %.2f %s)", c.FormatDiff(), c.PastMonthlyCost, c.Currency, c.MonthlyCost, c.Currency)
+}
+
+type Infracost struct {
+	execPath string
+	dir      string
+	envs     []string
+}
+
+func NewInfracost(execPath, dir string, envs []string) *Infracost {
+	return &Infracost{
+		execPath: execPath,
+		dir:      dir,
+		envs:     envs,
+	}
+}
+
+// Breakdown estimates the monthly cost of the given plan
+// which is formatted by "terraform show -json" command.
+func (i *Infracost) Breakdown(ctx context.Context, planJSON []byte) (*CostEstimate, error) {
+	planFile, err := os.CreateTemp("", "terraform-plan-*.json")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(planFile.Name())
+
+	if _, err := planFile.Write(planJSON); err != nil {
+		planFile.Close()
+		return nil, err
+	}
+	planFile.Close()
+
+	args := []string{
+		"breakdown",
+		"--path",
+		planFile.Name(),
+		"--format",
+		"json",
+		"--no-color",
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, i.execPath, args...)
+	cmd.Dir = i.dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), i.envs...)
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("failed to run infracost breakdown: %s (%w)", stderr.String(), err)
+	}
+	return parseInfracostOutput(stdout.Bytes())
+}
+
+// infracostOutput is the subset of the JSON output of "infracost breakdown" command.
+// The costs are formatted as decimal strings and may be null when there is no cost.
+type infracostOutput struct {
+	Currency             string  `json:"currency"`
+	PastTotalMonthlyCost *string `json:"pastTotalMonthlyCost"`
+	TotalMonthlyCost     *string `json:"totalMonthlyCost"`
+	DiffTotalMonthlyCost *string `json:"diffTotalMonthlyCost"`
+}
+
+func parseInfracostOutput(data []byte) (*CostEstimate, error) {
+	var out infracostOutput
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, fmt.Errorf("failed to parse infracost output (%w)", err)
+	}
+
+	var (
+		estimate = &CostEstimate{Currency: out.Currency}
+		err      error
+	)
+	if estimate.PastMonthlyCost, err = parseCost(out.PastTotalMonthlyCost); err != nil {
+		return nil, err
+	}
+	if estimate.MonthlyCost, err = parseCost(out.TotalMonthlyCost); err != nil {
+		return nil, err
+	}
+	if estimate.DiffMonthlyCost, err = parseCost(out.DiffTotalMonthlyCost); err != nil {
+		return nil, err
+	}
+	return estimate, nil
+}
+
+func parseCost(v *string) (float64, error) {
+	if v == nil || *v == "" {
+		return 0, nil
+	}
+	cost, err := strconv.ParseFloat(*v, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cost value %q (%w)", *v, err)
+	}
+	return cost, nil
+}
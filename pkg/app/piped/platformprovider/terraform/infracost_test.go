@@ -0,0 +1,104 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseInfracostOutput(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name        string
+		input       string
+		expected    *CostEstimate
+		expectedErr bool
+	}{
+		{
+			name:  "cost increased",
+			input: `{"version":"0.2","currency":"USD","totalHourlyCost":"0.1","totalMonthlyCost":"123.45","pastTotalMonthlyCost":"100","diffTotalMonthlyCost":"23.45"}`,
+			expected: &CostEstimate{
+				Currency:        "USD",
+				PastMonthlyCost: 100,
+				MonthlyCost:     123.45,
+				DiffMonthlyCost: 23.45,
+			},
+		},
+		{
+			name:  "null costs",
+			input: `{"currency":"USD","totalMonthlyCost":null,"pastTotalMonthlyCost":null,"diffTotalMonthlyCost":null}`,
+			expected: &CostEstimate{
+				Currency: "USD",
+			},
+		},
+		{
+			name:        "invalid cost",
+			input:       `{"currency":"USD","totalMonthlyCost":"abc"}`,
+			expectedErr: true,
+		},
+		{
+			name:        "invalid json",
+			input:       `not json`,
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := parseInfracostOutput([]byte(tc.input))
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestCostEstimateString(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		estimate CostEstimate
+		expected string
+	}{
+		{
+			name:     "increased",
+			estimate: CostEstimate{Currency: "USD", PastMonthlyCost: 100, MonthlyCost: 123.45, DiffMonthlyCost: 23.45},
+			expected: "+23.45 USD per month (100.00 USD -> 123.45 USD)",
+		},
+		{
+			name:     "decreased",
+			estimate: CostEstimate{Currency: "USD", PastMonthlyCost: 100, MonthlyCost: 90, DiffMonthlyCost: -10},
+			expected: "-10.00 USD per month (100.00 USD -> 90.00 USD)",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, tc.estimate.String())
+		})
+	}
+}
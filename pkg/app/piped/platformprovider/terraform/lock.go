@@ -0,0 +1,63 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"regexp"
+	"strings"
+)
+
+const stateLockErrorMessage = "Error acquiring the state lock"
+
+var stateLockInfoRegex = regexp.MustCompile(`(?m)^[ \t]*(ID|Path|Operation|Who|Version|Created):[ \t]*(.*?)[ \t]*$`)
+
+// StateLockInfo represents the information of the state lock held by another operation.
+type StateLockInfo struct {
+	ID        string
+	Path      string
+	Operation string
+	Who       string
+	Version   string
+	Created   string
+}
+
+// ParseStateLockError parses the output of a terraform command and
+// returns the information of the lock holder if the command failed to acquire the state lock.
+func ParseStateLockError(out string) (StateLockInfo, bool) {
+	out = stripAnsiCodes(out)
+	i := strings.Index(out, stateLockErrorMessage)
+	if i < 0 {
+		return StateLockInfo{}, false
+	}
+
+	var info StateLockInfo
+	for _, m := range stateLockInfoRegex.FindAllStringSubmatch(out[i:], -1) {
+		switch m[1] {
+		case "ID":
+			info.ID = m[2]
+		case "Path":
+			info.Path = m[2]
+		case "Operation":
+			info.Operation = m[2]
+		case "Who":
+			info.Who = m[2]
+		case "Version":
+			info.Version = m[2]
+		case "Created":
+			info.Created = m[2]
+		}
+	}
+	return info, true
+}
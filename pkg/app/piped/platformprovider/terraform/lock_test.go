@@ -0,0 +1,83 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseStateLockError(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		out      string
+		expected StateLockInfo
+		locked   bool
+	}{
+		{
+			name:   "no lock error",
+			out:    "Apply complete! Resources: 1 added, 0 changed, 0 destroyed.",
+			locked: false,
+		},
+		{
+			name: "lock held by another operation",
+			out: `
+Error: Error acquiring the state lock
+
+Error message: ConditionalCheckFailedException: The conditional request failed
+Lock Info:
+  ID:        4a3c5f1e-8a8b-0c2d-6e1f-3b4a5c6d7e8f
+  Path:      tfstate-prod/network/terraform.tfstate
+  Operation: OperationTypeApply
+  Who:       piped@piped-7d9f8
+  Version:   1.5.7
+  Created:   2023-09-01 01:02:03.456 +0000 UTC
+  Info:
+
+Terraform acquires a state lock to protect the state from being written
+by multiple users at the same time.
+`,
+			expected: StateLockInfo{
+				ID:        "4a3c5f1e-8a8b-0c2d-6e1f-3b4a5c6d7e8f",
+				Path:      "tfstate-prod/network/terraform.tfstate",
+				Operation: "OperationTypeApply",
+				Who:       "piped@piped-7d9f8",
+				Version:   "1.5.7",
+				Created:   "2023-09-01 01:02:03.456 +0000 UTC",
+			},
+			locked: true,
+		},
+		{
+			name:     "lock error without info",
+			out:      "\x1b[31mError: Error acquiring the state lock\x1b[0m",
+			expected: StateLockInfo{},
+			locked:   true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, locked := ParseStateLockError(tc.out)
+			assert.Equal(t, tc.locked, locked)
+			assert.Equal(t, tc.expected, info)
+		})
+	}
+}
@@ -28,8 +28,15 @@ import (
 
 // FileMapping is a schema for Terraform file.
 type FileMapping struct {
-	ModuleMappings []*ModuleMapping `hcl:"module,block"`
-	Remain         hcl.Body         `hcl:",remain"`
+	TerraformMappings []*TerraformMapping `hcl:"terraform,block"`
+	ModuleMappings    []*ModuleMapping    `hcl:"module,block"`
+	Remain            hcl.Body            `hcl:",remain"`
+}
+
+// TerraformMapping is a schema for "terraform" block in Terraform file.
+type TerraformMapping struct {
+	RequiredVersion string   `hcl:"required_version,optional"`
+	Remain          hcl.Body `hcl:",remain"`
 }
 
 // ModuleMapping is a schema for "module" block in Terraform file.
@@ -43,6 +50,8 @@ type ModuleMapping struct {
 // File represents a Terraform file.
 type File struct {
 	Modules []*Module
+	// The version constraints specified by "required_version" in "terraform" block.
+	RequiredVersions []string
 }
 
 // Module represents a "module" block in Terraform file.
@@ -102,6 +111,11 @@ func LoadTerraformFiles(dir string) ([]File, error) {
 				Version: m.Version,
 			})
 		}
+		for _, t := range fm.TerraformMappings {
+			if t.RequiredVersion != "" {
+				tf.RequiredVersions = append(tf.RequiredVersions, t.RequiredVersion)
+			}
+		}
 
 		tfs = append(tfs, tf)
 	}
@@ -110,6 +110,17 @@ func TestLoadTerraformFiles(t *testing.T) {
 			},
 			expectedErr: false,
 		},
+		{
+			name:      "module with required version",
+			moduleDir: "./testdata/required_version",
+			expected: []File{
+				{
+					Modules:          []*Module{},
+					RequiredVersions: []string{">= 1.3.0, < 2.0.0"},
+				},
+			},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -0,0 +1,250 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	PlanActionCreate  = "create"
+	PlanActionUpdate  = "update"
+	PlanActionDelete  = "delete"
+	PlanActionReplace = "replace"
+
+	planActionNoop = "no-op"
+	planActionRead = "read"
+)
+
+const (
+	sensitiveValue  = "(sensitive value)"
+	knownAfterApply = "(known after apply)"
+	// The maximum length of the rendered value of an attribute.
+	maxAttributeLength = 256
+)
+
+// PlanSummary is a structured summary of a terraform plan
+// which contains the changes of each resource.
+type PlanSummary struct {
+	Resources []ResourceChange `json:"resources"`
+}
+
+// ResourceChange represents the planned change of a resource.
+type ResourceChange struct {
+	// The absolute address of the resource. e.g. module.foo.aws_instance.bar
+	Address string `json:"address"`
+	// One of create, update, delete and replace.
+	Action string `json:"action"`
+	// List of the changed attributes.
+	// The values of the sensitive attributes are redacted.
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+}
+
+// AttributeChange represents the change of a top-level attribute of a resource.
+type AttributeChange struct {
+	Name   string `json:"name"`
+	Before string `json:"before,omitempty"`
+	After  string `json:"after,omitempty"`
+}
+
+// planJSON is the subset of the JSON output of "terraform show -json <plan-file>".
+type planJSON struct {
+	ResourceChanges []struct {
+		Address string `json:"address"`
+		Change  struct {
+			Actions         []string    `json:"actions"`
+			Before          interface{} `json:"before"`
+			After           interface{} `json:"after"`
+			AfterUnknown    interface{} `json:"after_unknown"`
+			BeforeSensitive interface{} `json:"before_sensitive"`
+			AfterSensitive  interface{} `json:"after_sensitive"`
+		} `json:"change"`
+	} `json:"resource_changes"`
+}
+
+func parsePlanJSON(data []byte) (*PlanSummary, error) {
+	var plan planJSON
+	if err := json.Unmarshal(data, &plan); err != nil {
+		return nil, fmt.Errorf("failed to parse plan json: %w", err)
+	}
+
+	summary := &PlanSummary{
+		Resources: make([]ResourceChange, 0, len(plan.ResourceChanges)),
+	}
+	for _, rc := range plan.ResourceChanges {
+		action := determinePlanAction(rc.Change.Actions)
+		if action == planActionNoop || action == planActionRead {
+			continue
+		}
+		summary.Resources = append(summary.Resources, ResourceChange{
+			Address: rc.Address,
+			Action:  action,
+			Attributes: diffAttributes(
+				asMap(rc.Change.Before),
+				asMap(rc.Change.After),
+				asMap(rc.Change.AfterUnknown),
+				asMap(rc.Change.BeforeSensitive),
+				asMap(rc.Change.AfterSensitive),
+			),
+		})
+	}
+	return summary, nil
+}
+
+func determinePlanAction(actions []string) string {
+	switch len(actions) {
+	case 1:
+		return actions[0]
+	case 2:
+		// ["delete", "create"] or ["create", "delete"] means replacing the resource.
+		return PlanActionReplace
+	default:
+		return planActionNoop
+	}
+}
+
+func diffAttributes(before, after, unknown, beforeSensitive, afterSensitive map[string]interface{}) []AttributeChange {
+	names := make(map[string]struct{}, len(before)+len(after)+len(unknown))
+	for k := range before {
+		names[k] = struct{}{}
+	}
+	for k := range after {
+		names[k] = struct{}{}
+	}
+	for k := range unknown {
+		names[k] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(names))
+	for k := range names {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var changes []AttributeChange
+	for _, k := range keys {
+		isUnknown := isMarked(unknown[k])
+		if !isUnknown && reflect.DeepEqual(before[k], after[k]) {
+			continue
+		}
+		change := AttributeChange{
+			Name:   k,
+			Before: formatAttribute(before[k], isMarked(beforeSensitive[k])),
+			After:  formatAttribute(after[k], isMarked(afterSensitive[k])),
+		}
+		if isUnknown {
+			change.After = knownAfterApply
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// isMarked reports whether the given value of after_unknown or *_sensitive
+// marks the attribute. A nested value means that a part of the attribute is marked.
+func isMarked(v interface{}) bool {
+	switch mv := v.(type) {
+	case bool:
+		return mv
+	case map[string]interface{}:
+		for _, v := range mv {
+			if isMarked(v) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, v := range mv {
+			if isMarked(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func formatAttribute(v interface{}, sensitive bool) string {
+	if v == nil {
+		return ""
+	}
+	if sensitive {
+		return sensitiveValue
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	if len(data) > maxAttributeLength {
+		return string(data[:maxAttributeLength]) + "..."
+	}
+	return string(data)
+}
+
+func asMap(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+	return m
+}
+
+// Render returns a human-readable text of the summary.
+func (s *PlanSummary) Render() string {
+	var b strings.Builder
+	for _, r := range s.Resources {
+		fmt.Fprintf(&b, "%s %s will be %s\n", actionSymbol(r.Action), r.Address, actionText(r.Action))
+		for _, a := range r.Attributes {
+			switch {
+			case a.Before == "":
+				fmt.Fprintf(&b, "    %s: %s\n", a.Name, a.After)
+			case a.After == "":
+				fmt.Fprintf(&b, "    %s: %s -> null\n", a.Name, a.Before)
+			default:
+				fmt.Fprintf(&b, "    %s: %s -> %s\n", a.Name, a.Before, a.After)
+			}
+		}
+	}
+	return b.String()
+}
+
+func actionSymbol(action string) string {
+	switch action {
+	case PlanActionCreate:
+		return "+"
+	case PlanActionUpdate:
+		return "~"
+	case PlanActionDelete:
+		return "-"
+	case PlanActionReplace:
+		return "-/+"
+	default:
+		return " "
+	}
+}
+
+func actionText(action string) string {
+	switch action {
+	case PlanActionCreate:
+		return "created"
+	case PlanActionUpdate:
+		return "updated in-place"
+	case PlanActionDelete:
+		return "destroyed"
+	case PlanActionReplace:
+		return "replaced"
+	default:
+		return action
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePlanJSON(t *testing.T) {
+	t.Parallel()
+
+	data, err := os.ReadFile("testdata/plan.json")
+	require.NoError(t, err)
+
+	got, err := parsePlanJSON(data)
+	require.NoError(t, err)
+
+	expected := &PlanSummary{
+		Resources: []ResourceChange{
+			{
+				Address: "aws_instance.web",
+				Action:  PlanActionUpdate,
+				Attributes: []AttributeChange{
+					{Name: "instance_type", Before: `"t3.micro"`, After: `"t3.small"`},
+				},
+			},
+			{
+				Address: "aws_db_instance.main",
+				Action:  PlanActionReplace,
+				Attributes: []AttributeChange{
+					{Name: "engine", Before: `"mysql"`, After: `"postgres"`},
+					{Name: "id", Before: `"db-1"`, After: "(known after apply)"},
+					{Name: "password", Before: "(sensitive value)", After: "(sensitive value)"},
+				},
+			},
+			{
+				Address: "module.network.aws_vpc.main",
+				Action:  PlanActionCreate,
+				Attributes: []AttributeChange{
+					{Name: "arn", After: "(known after apply)"},
+					{Name: "cidr_block", After: `"10.0.0.0/16"`},
+				},
+			},
+			{
+				Address: "aws_s3_bucket.logs",
+				Action:  PlanActionDelete,
+				Attributes: []AttributeChange{
+					{Name: "bucket", Before: `"logs"`},
+				},
+			},
+		},
+	}
+	assert.Equal(t, expected, got)
+
+	_, err = parsePlanJSON([]byte("invalid"))
+	assert.Error(t, err)
+}
+
+func TestPlanSummaryRender(t *testing.T) {
+	t.Parallel()
+
+	summary := &PlanSummary{
+		Resources: []ResourceChange{
+			{
+				Address: "aws_instance.web",
+				Action:  PlanActionUpdate,
+				Attributes: []AttributeChange{
+					{Name: "instance_type", Before: `"t3.micro"`, After: `"t3.small"`},
+				},
+			},
+			{
+				Address: "aws_vpc.main",
+				Action:  PlanActionCreate,
+				Attributes: []AttributeChange{
+					{Name: "cidr_block", After: `"10.0.0.0/16"`},
+				},
+			},
+			{
+				Address: "aws_s3_bucket.logs",
+				Action:  PlanActionDelete,
+				Attributes: []AttributeChange{
+					{Name: "bucket", Before: `"logs"`},
+				},
+			},
+		},
+	}
+	expected := `~ aws_instance.web will be updated in-place
+    instance_type: "t3.micro" -> "t3.small"
++ aws_vpc.main will be created
+    cidr_block: "10.0.0.0/16"
+- aws_s3_bucket.logs will be destroyed
+    bucket: "logs" -> null
+`
+	assert.Equal(t, expected, summary.Render())
+}
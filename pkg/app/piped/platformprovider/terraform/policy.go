@@ -0,0 +1,76 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"go.uber.org/zap"
+)
+
+// Conftest checks terraform plans against the OPA policies written in Rego.
+type Conftest struct {
+	execPath string
+	logger   *zap.Logger
+}
+
+func NewConftest(execPath string, logger *zap.Logger) *Conftest {
+	return &Conftest{
+		execPath: execPath,
+		logger:   logger,
+	}
+}
+
+// Test checks the given plan which is formatted by "terraform show -json" command
+// against the policies in the given directories.
+// The returned output contains all failures and warnings reported by the policies.
+func (c *Conftest) Test(ctx context.Context, planJSON []byte, policyDirs []string) (string, error) {
+	planFile, err := os.CreateTemp("", "terraform-plan-*.json")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(planFile.Name())
+
+	if _, err := planFile.Write(planJSON); err != nil {
+		planFile.Close()
+		return "", err
+	}
+	planFile.Close()
+
+	args := conftestArgs(planFile.Name(), policyDirs)
+
+	var out bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.execPath, args...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	c.logger.Info("start checking terraform plan by conftest", zap.Any("args", args))
+	if err := cmd.Run(); err != nil {
+		return out.String(), fmt.Errorf("failed to check policies: %w", err)
+	}
+	return out.String(), nil
+}
+
+func conftestArgs(path string, policyDirs []string) []string {
+	args := []string{"test", "--all-namespaces", "--no-color", "--parser", "json"}
+	for _, d := range policyDirs {
+		args = append(args, "--policy", d)
+	}
+	return append(args, path)
+}
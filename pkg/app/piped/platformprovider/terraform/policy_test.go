@@ -0,0 +1,51 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConftestArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name       string
+		policyDirs []string
+		expected   []string
+	}{
+		{
+			name:       "single policy directory",
+			policyDirs: []string{"/app/policies"},
+			expected:   []string{"test", "--all-namespaces", "--no-color", "--parser", "json", "--policy", "/app/policies", "plan.json"},
+		},
+		{
+			name:       "multiple policy directories",
+			policyDirs: []string{"/app/policies", "/etc/piped/policies"},
+			expected:   []string{"test", "--all-namespaces", "--no-color", "--parser", "json", "--policy", "/app/policies", "--policy", "/etc/piped/policies", "plan.json"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			args := conftestArgs("plan.json", tc.policyDirs)
+			assert.Equal(t, tc.expected, args)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+)
+
+type secretDecrypter interface {
+	Decrypt(string) (string, error)
+}
+
+// MakeSecretVarEnvs decrypts the secrets referenced by the given variables
+// and returns them as "TF_VAR_" environment variables, so that they are passed
+// to terraform commands without being written to disk.
+// The vars is a map from the variable name to the key of the secret in the encrypted secrets.
+func MakeSecretVarEnvs(vars, encryptedSecrets map[string]string, dcr secretDecrypter) ([]string, error) {
+	if len(vars) == 0 {
+		return nil, nil
+	}
+	if dcr == nil {
+		return nil, fmt.Errorf("secret management is not configured in piped to decrypt the secret variables")
+	}
+
+	names := make([]string, 0, len(vars))
+	for name := range vars {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	envs := make([]string, 0, len(vars))
+	for _, name := range names {
+		encrypted, ok := encryptedSecrets[vars[name]]
+		if !ok {
+			return nil, fmt.Errorf("secret %q of variable %q was not found", vars[name], name)
+		}
+		decrypted, err := dcr.Decrypt(encrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt the secret of variable %q (%w)", name, err)
+		}
+		envs = append(envs, fmt.Sprintf("TF_VAR_%s=%s", name, decrypted))
+	}
+	return envs, nil
+}
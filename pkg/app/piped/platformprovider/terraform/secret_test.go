@@ -0,0 +1,97 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeDecrypter struct{}
+
+func (fakeDecrypter) Decrypt(s string) (string, error) {
+	if !strings.HasPrefix(s, "encrypted-") {
+		return "", fmt.Errorf("invalid secret")
+	}
+	return strings.TrimPrefix(s, "encrypted-"), nil
+}
+
+func TestMakeSecretVarEnvs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		vars             map[string]string
+		encryptedSecrets map[string]string
+		decrypter        secretDecrypter
+		expected         []string
+		expectedErr      bool
+	}{
+		{
+			name: "no variables",
+		},
+		{
+			name: "multiple variables",
+			vars: map[string]string{
+				"db_password": "dbPassword",
+				"api_key":     "apiKey",
+			},
+			encryptedSecrets: map[string]string{
+				"dbPassword": "encrypted-pass",
+				"apiKey":     "encrypted-key",
+			},
+			decrypter: fakeDecrypter{},
+			expected:  []string{"TF_VAR_api_key=key", "TF_VAR_db_password=pass"},
+		},
+		{
+			name:             "missing secret",
+			vars:             map[string]string{"db_password": "dbPassword"},
+			encryptedSecrets: map[string]string{},
+			decrypter:        fakeDecrypter{},
+			expectedErr:      true,
+		},
+		{
+			name:             "failed to decrypt",
+			vars:             map[string]string{"db_password": "dbPassword"},
+			encryptedSecrets: map[string]string{"dbPassword": "plain"},
+			decrypter:        fakeDecrypter{},
+			expectedErr:      true,
+		},
+		{
+			name:             "no decrypter",
+			vars:             map[string]string{"db_password": "dbPassword"},
+			encryptedSecrets: map[string]string{"dbPassword": "encrypted-pass"},
+			expectedErr:      true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := MakeSecretVarEnvs(tc.vars, tc.encryptedSecrets, tc.decrypter)
+			if tc.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
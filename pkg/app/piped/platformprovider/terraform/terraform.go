@@ -28,9 +28,14 @@ import (
 )
 
 type options struct {
-	noColor  bool
-	vars     []string
-	varFiles []string
+	noColor        bool
+	terragruntPath string
+	vars           []string
+	varFiles       []string
+	targets        []string
+	planOut        string
+	destroy        bool
+	backendConfig  map[string]string
 
 	sharedFlags []string
 	initFlags   []string
@@ -51,6 +56,14 @@ func WithoutColor() Option {
 	}
 }
 
+// WithTerragrunt makes all commands be executed through the given terragrunt binary.
+// The terraform binary is still used by terragrunt as the underlying tool.
+func WithTerragrunt(execPath string) Option {
+	return func(opts *options) {
+		opts.terragruntPath = execPath
+	}
+}
+
 func WithVars(vars []string) Option {
 	return func(opts *options) {
 		opts.vars = vars
@@ -63,6 +76,36 @@ func WithVarFiles(files []string) Option {
 	}
 }
 
+// WithTargets limits the plan and apply commands to the given resource addresses.
+func WithTargets(targets []string) Option {
+	return func(opts *options) {
+		opts.targets = targets
+	}
+}
+
+// WithPlanOut makes the plan command save the plan to the given path
+// so that it can be applied later by ApplyPlan.
+func WithPlanOut(path string) Option {
+	return func(opts *options) {
+		opts.planOut = path
+	}
+}
+
+// WithBackendConfig sets the given values to the backend configuration while initializing.
+func WithBackendConfig(cfg map[string]string) Option {
+	return func(opts *options) {
+		opts.backendConfig = cfg
+	}
+}
+
+// WithDestroy makes the plan command show the changes to destroy all managed resources
+// when the given value is true.
+func WithDestroy(destroy bool) Option {
+	return func(opts *options) {
+		opts.destroy = destroy
+	}
+}
+
 func WithAdditionalFlags(shared, init, plan, apply []string) Option {
 	return func(opts *options) {
 		opts.sharedFlags = append(opts.sharedFlags, shared...)
@@ -82,6 +125,8 @@ func WithAdditionalEnvs(shared, init, plan, apply []string) Option {
 }
 
 type Terraform struct {
+	// The name of the executed command, terraform or terragrunt.
+	name     string
 	execPath string
 	dir      string
 
@@ -94,7 +139,18 @@ func NewTerraform(execPath, dir string, opts ...Option) *Terraform {
 		o(&opt)
 	}
 
+	name := "terraform"
+	if opt.terragruntPath != "" {
+		name = "terragrunt"
+		opt.sharedEnvs = append(opt.sharedEnvs,
+			fmt.Sprintf("TERRAGRUNT_TFPATH=%s", execPath),
+			"TERRAGRUNT_NON_INTERACTIVE=true",
+		)
+		execPath = opt.terragruntPath
+	}
+
 	return &Terraform{
+		name:     name,
 		execPath: execPath,
 		dir:      dir,
 		options:  opt,
@@ -120,6 +176,7 @@ func (t *Terraform) Init(ctx context.Context, w io.Writer) error {
 		"init",
 	}
 	args = append(args, t.makeCommonCommandArgs()...)
+	args = append(args, makeBackendConfigArgs(t.options.backendConfig)...)
 	args = append(args, t.options.initFlags...)
 
 	cmd := exec.CommandContext(ctx, t.execPath, args...)
@@ -131,7 +188,7 @@ func (t *Terraform) Init(ctx context.Context, w io.Writer) error {
 	env = append(env, t.options.initEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	return cmd.Run()
 }
 
@@ -153,17 +210,124 @@ func (t *Terraform) SelectWorkspace(ctx context.Context, workspace string) error
 	return nil
 }
 
+// ListWorkspaces returns the names of all existing workspaces.
+func (t *Terraform) ListWorkspaces(ctx context.Context) ([]string, error) {
+	args := []string{
+		"workspace",
+		"list",
+	}
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspaces: %s (%w)", string(out), err)
+	}
+
+	return parseWorkspaces(string(out)), nil
+}
+
+// ListState returns the addresses of all resources managed in the current state.
+func (t *Terraform) ListState(ctx context.Context) ([]string, error) {
+	args := []string{
+		"state",
+		"list",
+	}
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resources in state (%w)", err)
+	}
+
+	addresses := make([]string, 0)
+	for _, line := range strings.Split(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			addresses = append(addresses, line)
+		}
+	}
+	return addresses, nil
+}
+
+// Import imports the existing resource identified by the given id into the given address.
+func (t *Terraform) Import(ctx context.Context, w io.Writer, address, id string) error {
+	args := []string{
+		"import",
+		"-input=false",
+	}
+	args = append(args, t.makeCommonCommandArgs()...)
+	args = append(args, address, id)
+
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	env := append(os.Environ(), t.options.sharedEnvs...)
+	env = append(env, t.options.applyEnvs...)
+	cmd.Env = env
+
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
+	return cmd.Run()
+}
+
+// parseWorkspaces parses the output of "terraform workspace list" command
+// where the current workspace is marked by "*".
+func parseWorkspaces(out string) []string {
+	var workspaces []string
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		name := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(scanner.Text()), "*"))
+		if name == "" {
+			continue
+		}
+		workspaces = append(workspaces, name)
+	}
+	return workspaces
+}
+
+// NewWorkspace creates a new workspace and selects it.
+func (t *Terraform) NewWorkspace(ctx context.Context, workspace string) error {
+	args := []string{
+		"workspace",
+		"new",
+		workspace,
+	}
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to create workspace: %s (%w)", string(out), err)
+	}
+
+	return nil
+}
+
 type PlanResult struct {
 	Adds     int
 	Changes  int
 	Destroys int
 	Imports  int
+	// Whether the plan changes the output values without changing any resource.
+	// Such a plan has no "Plan:" line but is reported as changed by "-detailed-exitcode".
+	OutputChanges bool
 
 	PlanOutput string
+	// The structured summary of the changes.
+	// This is nil when the plan could not be converted to JSON.
+	Summary *PlanSummary
+	// The plan in the JSON format produced by "terraform show -json".
+	// This is nil when the plan could not be converted to JSON.
+	PlanJSON []byte
 }
 
 func (r PlanResult) NoChanges() bool {
-	return r.Adds == 0 && r.Changes == 0 && r.Destroys == 0 && r.Imports == 0
+	return r.Adds == 0 && r.Changes == 0 && r.Destroys == 0 && r.Imports == 0 && !r.OutputChanges
 }
 
 func (r PlanResult) Render() (string, error) {
@@ -270,12 +434,29 @@ func GetExitCode(err error) int {
 }
 
 func (t *Terraform) Plan(ctx context.Context, w io.Writer) (PlanResult, error) {
+	// Save the plan to a file to get its structured summary.
+	planFile := t.options.planOut
+	if planFile == "" {
+		f, err := os.CreateTemp("", "terraform-plan-")
+		if err != nil {
+			return PlanResult{}, err
+		}
+		f.Close()
+		planFile = f.Name()
+		defer os.Remove(planFile)
+	}
+
 	args := []string{
 		"plan",
 		"-lock=false",
 		"-detailed-exitcode",
+		fmt.Sprintf("-out=%s", planFile),
+	}
+	if t.options.destroy {
+		args = append(args, "-destroy")
 	}
 	args = append(args, t.makeCommonCommandArgs()...)
+	args = append(args, t.makeTargetArgs()...)
 	args = append(args, t.options.planFlags...)
 
 	var buf bytes.Buffer
@@ -290,18 +471,57 @@ func (t *Terraform) Plan(ctx context.Context, w io.Writer) (PlanResult, error) {
 	env = append(env, t.options.planEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	err := cmd.Run()
 	switch GetExitCode(err) {
 	case 0:
 		return PlanResult{}, nil
 	case 2:
-		return parsePlanResult(buf.String(), !t.options.noColor)
+		result, err := parsePlanResult(buf.String(), !t.options.noColor)
+		if err != nil {
+			return result, err
+		}
+		// The summary is optional so the plan does not fail even if it could not be built.
+		planJSON, err := t.showPlanJSON(ctx, planFile)
+		if err != nil {
+			io.WriteString(w, fmt.Sprintf("\nunable to build the structured summary of the plan (%v)\n", err))
+			return result, nil
+		}
+		summary, err := parsePlanJSON(planJSON)
+		if err != nil {
+			io.WriteString(w, fmt.Sprintf("\nunable to build the structured summary of the plan (%v)\n", err))
+			return result, nil
+		}
+		result.Summary = summary
+		result.PlanJSON = planJSON
+		return result, nil
 	default:
 		return PlanResult{}, err
 	}
 }
 
+// showPlanJSON converts the given plan file into JSON format
+// by using "terraform show -json" command.
+func (t *Terraform) showPlanJSON(ctx context.Context, planFile string) ([]byte, error) {
+	args := []string{
+		"show",
+		"-json",
+		"-no-color",
+		planFile,
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.Env = append(os.Environ(), t.options.sharedEnvs...)
+
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%w: %s", err, stderr.String())
+	}
+	return stdout.Bytes(), nil
+}
+
 func (t *Terraform) makeCommonCommandArgs() (args []string) {
 	if t.options.noColor {
 		args = append(args, "-no-color")
@@ -316,11 +536,21 @@ func (t *Terraform) makeCommonCommandArgs() (args []string) {
 	return
 }
 
+// makeTargetArgs returns the args to limit the plan and apply commands to the specified resources.
+func (t *Terraform) makeTargetArgs() (args []string) {
+	for _, target := range t.options.targets {
+		args = append(args, fmt.Sprintf("-target=%s", target))
+	}
+	return
+}
+
 var (
 	// Import block was introduced from Terraform v1.5.0.
 	// Keep this regex for backward compatibility.
 	planHasChangeRegex = regexp.MustCompile(`(?m)^Plan:(?: (\d+) to import,)?? (\d+) to add, (\d+) to change, (\d+) to destroy.$`)
 	planNoChangesRegex = regexp.MustCompile(`(?m)^No changes. Infrastructure is up-to-date.$`)
+	// The plan changing only the output values does not contain the "Plan:" line.
+	planOutputChangesRegex = regexp.MustCompile(`(?m)^Changes to Outputs:$`)
 )
 
 // Borrowed from https://github.com/acarl005/stripansi
@@ -382,6 +612,13 @@ func parsePlanResult(out string, ansiIncluded bool) (PlanResult, error) {
 		return PlanResult{}, nil
 	}
 
+	if planOutputChangesRegex.MatchString(out) {
+		return PlanResult{
+			OutputChanges: true,
+			PlanOutput:    out,
+		}, nil
+	}
+
 	return PlanResult{}, fmt.Errorf("unable to parse plan output")
 }
 
@@ -392,7 +629,36 @@ func (t *Terraform) Apply(ctx context.Context, w io.Writer) error {
 		"-input=false",
 	}
 	args = append(args, t.makeCommonCommandArgs()...)
+	args = append(args, t.makeTargetArgs()...)
+	args = append(args, t.options.applyFlags...)
+
+	cmd := exec.CommandContext(ctx, t.execPath, args...)
+	cmd.Dir = t.dir
+	cmd.Stdout = w
+	cmd.Stderr = w
+
+	env := append(os.Environ(), t.options.sharedEnvs...)
+	env = append(env, t.options.applyEnvs...)
+	cmd.Env = env
+
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
+	return cmd.Run()
+}
+
+// ApplyPlan applies the changes saved in the given plan file.
+// Variables and targets are not passed since they are already included in the plan.
+func (t *Terraform) ApplyPlan(ctx context.Context, w io.Writer, planFile string) error {
+	args := []string{
+		"apply",
+		"-auto-approve",
+		"-input=false",
+	}
+	if t.options.noColor {
+		args = append(args, "-no-color")
+	}
+	args = append(args, t.options.sharedFlags...)
 	args = append(args, t.options.applyFlags...)
+	args = append(args, planFile)
 
 	cmd := exec.CommandContext(ctx, t.execPath, args...)
 	cmd.Dir = t.dir
@@ -403,6 +669,6 @@ func (t *Terraform) Apply(ctx context.Context, w io.Writer) error {
 	env = append(env, t.options.applyEnvs...)
 	cmd.Env = env
 
-	io.WriteString(w, fmt.Sprintf("terraform %s", strings.Join(args, " ")))
+	io.WriteString(w, fmt.Sprintf("%s %s", t.name, strings.Join(args, " ")))
 	return cmd.Run()
 }
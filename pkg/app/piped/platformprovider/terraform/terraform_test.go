@@ -85,6 +85,12 @@ func TestParsePlanResult(t *testing.T) {
 			input:       `No changes. Infrastructure is up-to-date.`,
 			expectedErr: false,
 		},
+		{
+			name:        "Only output changes",
+			input:       "Changes to Outputs:\n  + endpoint = \"example.com\"",
+			expected:    PlanResult{OutputChanges: true, PlanOutput: "Changes to Outputs:\n  + endpoint = \"example.com\""},
+			expectedErr: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -98,6 +104,113 @@ func TestParsePlanResult(t *testing.T) {
 	}
 }
 
+func TestParseWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name:     "only default",
+			input:    "* default\n",
+			expected: []string{"default"},
+		},
+		{
+			name:     "multiple workspaces",
+			input:    "  default\n* dev\n  prod\n\n",
+			expected: []string{"default", "dev", "prod"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := parseWorkspaces(tc.input)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
+func TestNewTerraformWithTerragrunt(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name             string
+		opts             []Option
+		expectedName     string
+		expectedExecPath string
+		expectedEnvs     []string
+	}{
+		{
+			name:             "terraform",
+			opts:             []Option{WithAdditionalEnvs([]string{"A=B"}, nil, nil, nil)},
+			expectedName:     "terraform",
+			expectedExecPath: "/bin/terraform",
+			expectedEnvs:     []string{"A=B"},
+		},
+		{
+			name: "terragrunt",
+			opts: []Option{
+				WithAdditionalEnvs([]string{"A=B"}, nil, nil, nil),
+				WithTerragrunt("/bin/terragrunt"),
+			},
+			expectedName:     "terragrunt",
+			expectedExecPath: "/bin/terragrunt",
+			expectedEnvs: []string{
+				"A=B",
+				"TERRAGRUNT_TFPATH=/bin/terraform",
+				"TERRAGRUNT_NON_INTERACTIVE=true",
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got := NewTerraform("/bin/terraform", "dir", tc.opts...)
+			assert.Equal(t, tc.expectedName, got.name)
+			assert.Equal(t, tc.expectedExecPath, got.execPath)
+			assert.Equal(t, tc.expectedEnvs, got.options.sharedEnvs)
+		})
+	}
+}
+
+func TestMakeTargetArgs(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		targets  []string
+		expected []string
+	}{
+		{
+			name: "no targets",
+		},
+		{
+			name:     "multiple targets",
+			targets:  []string{"aws_instance.web", `module.network.aws_subnet.private["a"]`},
+			expected: []string{"-target=aws_instance.web", `-target=module.network.aws_subnet.private["a"]`},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tf := NewTerraform("terraform", "dir", WithTargets(tc.targets))
+			assert.Equal(t, tc.expected, tf.makeTargetArgs())
+		})
+	}
+}
+
 func TestRender(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,193 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/Masterminds/semver/v3"
+
+	"github.com/pipe-cd/pipecd/pkg/app/piped/toolregistry"
+)
+
+// DetermineVersion returns the terraform version that should be used for the module placed in dir
+// when the application does not specify it explicitly.
+// The version is chosen based on "required_version" of the module, and an empty string
+// is returned when the module has no constraint, meaning that the default one should be used.
+// Unless autoInstall is true, only the installed versions are considered.
+func DetermineVersion(dir string, autoInstall bool) (string, error) {
+	tfs, err := LoadTerraformFiles(dir)
+	if err != nil {
+		// The module may not be loadable directly such as the one generated by terragrunt,
+		// so in that case the default version is used as before.
+		return "", nil
+	}
+	constraints := FindRequiredVersion(tfs)
+	if constraints == "" {
+		return "", nil
+	}
+
+	version, installed, err := ResolveVersion(constraints, toolregistry.DefaultRegistry().TerraformVersions())
+	if err != nil {
+		return "", err
+	}
+	if !installed && !autoInstall {
+		return "", fmt.Errorf("no installed terraform satisfies %q and auto installing is disabled", constraints)
+	}
+	return version, nil
+}
+
+// FindRequiredVersion returns the version constraints specified by
+// "required_version" in the given Terraform files.
+// Constraints from multiple files are joined since all of them must be satisfied.
+func FindRequiredVersion(tfs []File) string {
+	constraints := make([]string, 0)
+	for _, tf := range tfs {
+		constraints = append(constraints, tf.RequiredVersions...)
+	}
+	return strings.Join(constraints, ", ")
+}
+
+// ResolveVersion returns the terraform version satisfying the given constraints.
+// The newest one of the installed versions is preferred.
+// When none of them matches, the lowest version satisfying the constraints
+// is returned with false to indicate that it must be installed.
+func ResolveVersion(constraints string, installed []string) (string, bool, error) {
+	cs, err := parseVersionConstraints(constraints)
+	if err != nil {
+		return "", false, err
+	}
+
+	versions := make([]*semver.Version, 0, len(installed))
+	for _, iv := range installed {
+		v, err := semver.StrictNewVersion(iv)
+		if err != nil {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	sort.Sort(sort.Reverse(semver.Collection(versions)))
+	for _, v := range versions {
+		if cs.check(v) {
+			return v.Original(), true, nil
+		}
+	}
+
+	// The lowest satisfying version is always one of the versions
+	// written in the constraints or the next patch of them.
+	candidates := make([]*semver.Version, 0, 2*len(cs))
+	for _, c := range cs {
+		next := c.version.IncPatch()
+		candidates = append(candidates, c.version, &next)
+	}
+	sort.Sort(semver.Collection(candidates))
+	for _, v := range candidates {
+		if v.Prerelease() == "" && cs.check(v) {
+			return v.String(), false, nil
+		}
+	}
+	return "", false, fmt.Errorf("unable to determine a terraform version satisfying %q", constraints)
+}
+
+type versionConstraint struct {
+	operator string
+	version  *semver.Version
+	// The number of segments written in the constraint.
+	// This is used by the pessimistic constraint operator.
+	segments int
+}
+
+type versionConstraints []versionConstraint
+
+func (cs versionConstraints) check(v *semver.Version) bool {
+	for _, c := range cs {
+		if !c.check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseVersionConstraints parses the constraints written in the Terraform syntax
+// such as ">= 1.2.0, < 2.0.0" or "~> 1.5".
+func parseVersionConstraints(constraints string) (versionConstraints, error) {
+	cs := make(versionConstraints, 0)
+	for _, raw := range strings.Split(constraints, ",") {
+		raw = strings.TrimSpace(raw)
+		if raw == "" {
+			continue
+		}
+
+		var operator string
+		for _, op := range []string{"~>", ">=", "<=", "!=", ">", "<", "="} {
+			if strings.HasPrefix(raw, op) {
+				operator = op
+				break
+			}
+		}
+		value := strings.TrimSpace(strings.TrimPrefix(raw, operator))
+		if operator == "" {
+			operator = "="
+		}
+
+		v, err := semver.NewVersion(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint %q (%w)", raw, err)
+		}
+		cs = append(cs, versionConstraint{
+			operator: operator,
+			version:  v,
+			segments: len(strings.Split(strings.SplitN(value, "-", 2)[0], ".")),
+		})
+	}
+	if len(cs) == 0 {
+		return nil, fmt.Errorf("no version constraint was given")
+	}
+	return cs, nil
+}
+
+func (c versionConstraint) check(v *semver.Version) bool {
+	switch c.operator {
+	case "=":
+		return v.Equal(c.version)
+	case "!=":
+		return !v.Equal(c.version)
+	case ">":
+		return v.GreaterThan(c.version)
+	case ">=":
+		return !v.LessThan(c.version)
+	case "<":
+		return v.LessThan(c.version)
+	case "<=":
+		return !v.GreaterThan(c.version)
+	case "~>":
+		// Only the right-most written segment is allowed to be increased.
+		// e.g. "~> 1.5" allows 1.x (x >= 5) and "~> 1.5.0" allows 1.5.x.
+		if v.LessThan(c.version) {
+			return false
+		}
+		upper := c.version.IncMajor()
+		if c.segments >= 3 {
+			upper = c.version.IncMinor()
+		}
+		if c.segments <= 1 {
+			return true
+		}
+		return v.LessThan(&upper)
+	}
+	return false
+}
@@ -0,0 +1,121 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package terraform
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindRequiredVersion(t *testing.T) {
+	t.Parallel()
+
+	tfs, err := LoadTerraformFiles("./testdata/required_version")
+	require.NoError(t, err)
+	assert.Equal(t, ">= 1.3.0, < 2.0.0", FindRequiredVersion(tfs))
+
+	tfs, err = LoadTerraformFiles("./testdata/single_module")
+	require.NoError(t, err)
+	assert.Equal(t, "", FindRequiredVersion(tfs))
+}
+
+func TestResolveVersion(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name              string
+		constraints       string
+		installed         []string
+		expected          string
+		expectedInstalled bool
+		expectedErr       bool
+	}{
+		{
+			name:              "exact version",
+			constraints:       "1.5.7",
+			expected:          "1.5.7",
+			expectedInstalled: false,
+		},
+		{
+			name:              "newest installed version is preferred",
+			constraints:       ">= 1.3.0, < 2.0.0",
+			installed:         []string{"1.2.9", "1.4.6", "1.5.7", "2.0.0"},
+			expected:          "1.5.7",
+			expectedInstalled: true,
+		},
+		{
+			name:              "lowest satisfying version when nothing installed matches",
+			constraints:       ">= 1.3.0, < 2.0.0",
+			installed:         []string{"1.2.9"},
+			expected:          "1.3.0",
+			expectedInstalled: false,
+		},
+		{
+			name:              "greater than",
+			constraints:       "> 1.3.0",
+			expected:          "1.3.1",
+			expectedInstalled: false,
+		},
+		{
+			name:              "pessimistic constraint with two segments",
+			constraints:       "~> 1.5",
+			installed:         []string{"1.4.0", "1.9.2", "2.1.0"},
+			expected:          "1.9.2",
+			expectedInstalled: true,
+		},
+		{
+			name:              "pessimistic constraint with three segments",
+			constraints:       "~> 1.5.0",
+			installed:         []string{"1.6.0"},
+			expected:          "1.5.0",
+			expectedInstalled: false,
+		},
+		{
+			name:              "excluded version",
+			constraints:       ">= 1.5.0, != 1.5.0",
+			expected:          "1.5.1",
+			expectedInstalled: false,
+		},
+		{
+			name:        "only upper bound",
+			constraints: "< 2.0.0",
+			expectedErr: true,
+		},
+		{
+			name:        "invalid constraint",
+			constraints: ">= foo",
+			expectedErr: true,
+		},
+		{
+			name:        "empty constraint",
+			constraints: "",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			version, installed, err := ResolveVersion(tc.constraints, tc.installed)
+			assert.Equal(t, tc.expectedErr, err != nil)
+			assert.Equal(t, tc.expected, version)
+			assert.Equal(t, tc.expectedInstalled, installed)
+		})
+	}
+}
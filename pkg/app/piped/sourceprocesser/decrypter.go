@@ -37,13 +37,9 @@ func DecryptSecrets(appDir string, enc config.SecretEncryption, dcr secretDecryp
 		return fmt.Errorf("no encrypted secret was specified to decrypt (%q)", enc.DecryptionTargets)
 	}
 
-	secrets := make(map[string]string, len(enc.EncryptedSecrets))
-	for k, v := range enc.EncryptedSecrets {
-		ds, err := dcr.Decrypt(v)
-		if err != nil {
-			return fmt.Errorf("failed to decrypt %s secret (%w)", k, err)
-		}
-		secrets[k] = ds
+	secrets, err := DecryptSecretValues(enc, dcr)
+	if err != nil {
+		return err
 	}
 	data := map[string](map[string]string){
 		"encryptedSecrets": secrets,
@@ -78,3 +74,16 @@ func DecryptSecrets(appDir string, enc config.SecretEncryption, dcr secretDecryp
 
 	return nil
 }
+
+// DecryptSecretValues returns the decrypted values of all encrypted secrets keyed by their names.
+func DecryptSecretValues(enc config.SecretEncryption, dcr secretDecrypter) (map[string]string, error) {
+	secrets := make(map[string]string, len(enc.EncryptedSecrets))
+	for k, v := range enc.EncryptedSecrets {
+		ds, err := dcr.Decrypt(v)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt %s secret (%w)", k, err)
+		}
+		secrets[k] = ds
+	}
+	return secrets, nil
+}
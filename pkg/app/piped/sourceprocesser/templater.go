@@ -0,0 +1,58 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/Masterminds/sprig/v3"
+)
+
+// RenderTemplates renders the given target files in place as Go templates
+// by using the given data. The given funcs are added to the sprig functions.
+func RenderTemplates(appDir string, targets []string, data map[string]interface{}, funcs template.FuncMap) error {
+	for _, t := range targets {
+		targetPath := filepath.Join(appDir, t)
+		fileName := filepath.Base(targetPath)
+		tmpl := template.
+			New(fileName).
+			Funcs(sprig.TxtFuncMap()).
+			Funcs(funcs).
+			Option("missingkey=error")
+		tmpl, err := tmpl.ParseFiles(targetPath)
+		if err != nil {
+			return fmt.Errorf("failed to parse template target %s (%w)", t, err)
+		}
+
+		f, err := os.OpenFile(targetPath, os.O_WRONLY|os.O_TRUNC, 0644)
+		if err != nil {
+			return fmt.Errorf("failed to open template target %s (%w)", t, err)
+		}
+
+		if err := tmpl.Execute(f, data); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to render template target %s (%w)", t, err)
+		}
+
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to close template target %s (%w)", t, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sourceprocesser
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderTemplates(t *testing.T) {
+	t.Parallel()
+
+	workspace, err := os.MkdirTemp("", "test-render-templates")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(workspace)
+	})
+
+	data := map[string]interface{}{
+		"env":      "prod",
+		"imageTag": "v1.0.0",
+		"app": map[string]interface{}{
+			"name": "simple",
+			"labels": map[string]string{
+				"team": "payment",
+			},
+		},
+	}
+	funcs := template.FuncMap{
+		"upper": func(s string) string {
+			return "UPPER-" + s
+		},
+	}
+
+	testcases := []struct {
+		name                string
+		sources             map[string]string
+		targets             []string
+		expected            map[string]string
+		expectedErrorPrefix string
+	}{
+		{
+			name: "target not found",
+			sources: map[string]string{
+				"taskdef.yaml": "image: app:{{ .imageTag }}",
+			},
+			targets:             []string{"not-found.yaml"},
+			expectedErrorPrefix: "failed to parse template target not-found.yaml",
+		},
+		{
+			name: "multi targets",
+			sources: map[string]string{
+				"taskdef.yaml":    "family: {{ .app.name }}-{{ .env }}\nimage: app:{{ .imageTag }}",
+				"servicedef.yaml": "team: {{ .app.labels.team }}",
+			},
+			targets: []string{"taskdef.yaml", "servicedef.yaml"},
+			expected: map[string]string{
+				"taskdef.yaml":    "family: simple-prod\nimage: app:v1.0.0",
+				"servicedef.yaml": "team: payment",
+			},
+		},
+		{
+			name: "additional functions take precedence over sprig",
+			sources: map[string]string{
+				"taskdef.yaml": "env: {{ upper .env }}",
+			},
+			targets: []string{"taskdef.yaml"},
+			expected: map[string]string{
+				"taskdef.yaml": "env: UPPER-prod",
+			},
+		},
+		{
+			name: "missing label",
+			sources: map[string]string{
+				"taskdef.yaml": "owner: {{ .app.labels.owner }}",
+			},
+			targets:             []string{"taskdef.yaml"},
+			expectedErrorPrefix: "failed to render template target taskdef.yaml",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			appDir, err := os.MkdirTemp(workspace, "app-dir")
+			require.NoError(t, err)
+
+			for p, c := range tc.sources {
+				err := os.WriteFile(filepath.Join(appDir, p), []byte(c), 0600)
+				require.NoError(t, err)
+			}
+
+			err = RenderTemplates(appDir, tc.targets, data, funcs)
+			if tc.expectedErrorPrefix != "" {
+				require.Error(t, err)
+				assert.True(t, strings.HasPrefix(err.Error(), tc.expectedErrorPrefix), fmt.Sprintf("Error: %v", err))
+				return
+			}
+			require.NoError(t, err)
+
+			for p, c := range tc.expected {
+				data, err := os.ReadFile(filepath.Join(appDir, p))
+				require.NoError(t, err)
+				assert.Equal(t, c, string(data))
+			}
+		})
+	}
+}
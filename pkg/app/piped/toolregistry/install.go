@@ -26,17 +26,31 @@ import (
 )
 
 const (
-	defaultKubectlVersion   = "1.18.2"
-	defaultKustomizeVersion = "3.8.1"
-	defaultHelmVersion      = "3.8.2"
-	defaultTerraformVersion = "0.13.0"
+	defaultKubectlVersion       = "1.18.2"
+	defaultKustomizeVersion     = "3.8.1"
+	defaultHelmVersion          = "3.8.2"
+	defaultTerraformVersion     = "0.13.0"
+	defaultTerragruntVersion    = "0.50.17"
+	defaultInfracostVersion     = "0.10.29"
+	defaultJsonnetVersion       = "0.20.0"
+	defaultCueVersion           = "0.6.0"
+	defaultKubeconformVersion   = "0.6.3"
+	defaultConftestVersion      = "0.46.0"
+	defaultDockerComposeVersion = "2.23.3"
 )
 
 var (
-	kubectlInstallScriptTmpl   = template.Must(template.New("kubectl").Parse(kubectlInstallScript))
-	kustomizeInstallScriptTmpl = template.Must(template.New("kustomize").Parse(kustomizeInstallScript))
-	helmInstallScriptTmpl      = template.Must(template.New("helm").Parse(helmInstallScript))
-	terraformInstallScriptTmpl = template.Must(template.New("terraform").Parse(terraformInstallScript))
+	kubectlInstallScriptTmpl       = template.Must(template.New("kubectl").Parse(kubectlInstallScript))
+	kustomizeInstallScriptTmpl     = template.Must(template.New("kustomize").Parse(kustomizeInstallScript))
+	helmInstallScriptTmpl          = template.Must(template.New("helm").Parse(helmInstallScript))
+	terraformInstallScriptTmpl     = template.Must(template.New("terraform").Parse(terraformInstallScript))
+	terragruntInstallScriptTmpl    = template.Must(template.New("terragrunt").Parse(terragruntInstallScript))
+	infracostInstallScriptTmpl     = template.Must(template.New("infracost").Parse(infracostInstallScript))
+	jsonnetInstallScriptTmpl       = template.Must(template.New("jsonnet").Parse(jsonnetInstallScript))
+	cueInstallScriptTmpl           = template.Must(template.New("cue").Parse(cueInstallScript))
+	kubeconformInstallScriptTmpl   = template.Must(template.New("kubeconform").Parse(kubeconformInstallScript))
+	conftestInstallScriptTmpl      = template.Must(template.New("conftest").Parse(conftestInstallScript))
+	dockerComposeInstallScriptTmpl = template.Must(template.New("docker-compose").Parse(dockerComposeInstallScript))
 )
 
 func (r *registry) installKubectl(ctx context.Context, version string) error {
@@ -226,3 +240,332 @@ func (r *registry) installTerraform(ctx context.Context, version string) error {
 	r.logger.Info("just installed terraform", zap.String("version", version))
 	return nil
 }
+
+func (r *registry) installTerragrunt(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "terragrunt-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultTerragruntVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := terragruntInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render terragrunt install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install terragrunt %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install terragrunt",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install terragrunt %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed terragrunt", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installInfracost(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "infracost-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultInfracostVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := infracostInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render infracost install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install infracost %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install infracost",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install infracost %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed infracost", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installJsonnet(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "jsonnet-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultJsonnetVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := jsonnetInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render jsonnet install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install jsonnet %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install jsonnet",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install jsonnet %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed jsonnet", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installCue(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "cue-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultCueVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := cueInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render cue install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install cue %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install cue",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install cue %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed cue", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installKubeconform(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "kubeconform-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultKubeconformVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := kubeconformInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render kubeconform install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install kubeconform %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install kubeconform",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install kubeconform %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed kubeconform", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installConftest(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "conftest-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultConftestVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := conftestInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render conftest install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install conftest %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install conftest",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install conftest %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed conftest", zap.String("version", version))
+	return nil
+}
+
+func (r *registry) installDockerCompose(ctx context.Context, version string) error {
+	workingDir, err := os.MkdirTemp("", "docker-compose-install")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workingDir)
+
+	asDefault := version == ""
+	if asDefault {
+		version = defaultDockerComposeVersion
+	}
+
+	var (
+		buf  bytes.Buffer
+		data = map[string]interface{}{
+			"WorkingDir": workingDir,
+			"Version":    version,
+			"BinDir":     r.binDir,
+			"AsDefault":  asDefault,
+		}
+	)
+	if err := dockerComposeInstallScriptTmpl.Execute(&buf, data); err != nil {
+		r.logger.Error("failed to render docker-compose install script",
+			zap.String("version", version),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install docker-compose %s (%w)", version, err)
+	}
+
+	var (
+		script = buf.String()
+		cmd    = exec.CommandContext(ctx, "/bin/sh", "-c", script)
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		r.logger.Error("failed to install docker-compose",
+			zap.String("version", version),
+			zap.String("script", script),
+			zap.String("out", string(out)),
+			zap.Error(err),
+		)
+		return fmt.Errorf("failed to install docker-compose %s, %s (%w)", version, string(out), err)
+	}
+
+	r.logger.Info("just installed docker-compose", zap.String("version", version))
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 
 	"go.uber.org/zap"
@@ -33,6 +34,14 @@ type Registry interface {
 	Kustomize(ctx context.Context, version string) (string, bool, error)
 	Helm(ctx context.Context, version string) (string, bool, error)
 	Terraform(ctx context.Context, version string) (string, bool, error)
+	TerraformVersions() []string
+	Terragrunt(ctx context.Context, version string) (string, bool, error)
+	Infracost(ctx context.Context, version string) (string, bool, error)
+	Jsonnet(ctx context.Context, version string) (string, bool, error)
+	Cue(ctx context.Context, version string) (string, bool, error)
+	Kubeconform(ctx context.Context, version string) (string, bool, error)
+	Conftest(ctx context.Context, version string) (string, bool, error)
+	DockerCompose(ctx context.Context, version string) (string, bool, error)
 }
 
 var defaultRegistry *registry
@@ -92,10 +101,17 @@ func loadPreinstalledTool(binDir string) (map[string]struct{}, error) {
 }
 
 const (
-	kubectlPrefix   = "kubectl"
-	kustomizePrefix = "kustomize"
-	helmPrefix      = "helm"
-	terraformPrefix = "terraform"
+	kubectlPrefix       = "kubectl"
+	kustomizePrefix     = "kustomize"
+	helmPrefix          = "helm"
+	terraformPrefix     = "terraform"
+	terragruntPrefix    = "terragrunt"
+	infracostPrefix     = "infracost"
+	jsonnetPrefix       = "jsonnet"
+	cuePrefix           = "cue"
+	kubeconformPrefix   = "kubeconform"
+	conftestPrefix      = "conftest"
+	dockerComposePrefix = "docker-compose"
 )
 
 type registry struct {
@@ -217,3 +233,216 @@ func (r *registry) Terraform(ctx context.Context, version string) (string, bool,
 
 	return path, true, nil
 }
+
+// TerraformVersions returns the list of terraform versions available in the registry.
+// The default one installed without version suffix is not included.
+func (r *registry) TerraformVersions() []string {
+	prefix := terraformPrefix + "-"
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	versions := make([]string, 0)
+	for name := range r.versions {
+		if strings.HasPrefix(name, prefix) {
+			versions = append(versions, strings.TrimPrefix(name, prefix))
+		}
+	}
+	return versions
+}
+
+func (r *registry) Terragrunt(ctx context.Context, version string) (string, bool, error) {
+	name := terragruntPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", terragruntPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installTerragrunt(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) Infracost(ctx context.Context, version string) (string, bool, error) {
+	name := infracostPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", infracostPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installInfracost(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) Jsonnet(ctx context.Context, version string) (string, bool, error) {
+	name := jsonnetPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", jsonnetPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installJsonnet(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) Cue(ctx context.Context, version string) (string, bool, error) {
+	name := cuePrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", cuePrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installCue(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) Kubeconform(ctx context.Context, version string) (string, bool, error) {
+	name := kubeconformPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", kubeconformPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installKubeconform(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) Conftest(ctx context.Context, version string) (string, bool, error) {
+	name := conftestPrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", conftestPrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installConftest(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
+
+func (r *registry) DockerCompose(ctx context.Context, version string) (string, bool, error) {
+	name := dockerComposePrefix
+	if version != "" {
+		name = fmt.Sprintf("%s-%s", dockerComposePrefix, version)
+	}
+	path := filepath.Join(r.binDir, name)
+
+	r.mu.RLock()
+	_, ok := r.versions[name]
+	r.mu.RUnlock()
+	if ok {
+		return path, false, nil
+	}
+
+	_, err, _ := r.installGroup.Do(name, func() (interface{}, error) {
+		return nil, r.installDockerCompose(ctx, version)
+	})
+	if err != nil {
+		return "", true, err
+	}
+
+	r.mu.Lock()
+	r.versions[name] = struct{}{}
+	r.mu.Unlock()
+
+	return path, true, nil
+}
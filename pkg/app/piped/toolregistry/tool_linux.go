@@ -53,3 +53,73 @@ mv terraform {{ .BinDir }}/terraform-{{ .Version }}
 cp -f {{ .BinDir }}/terraform-{{ .Version }} {{ .BinDir }}/terraform
 {{ end }}
 `
+
+var terragruntInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/gruntwork-io/terragrunt/releases/download/v{{ .Version }}/terragrunt_linux_amd64 -o terragrunt
+mv terragrunt {{ .BinDir }}/terragrunt-{{ .Version }}
+chmod +x {{ .BinDir }}/terragrunt-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/terragrunt-{{ .Version }} {{ .BinDir }}/terragrunt
+{{ end }}
+`
+
+var infracostInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/infracost/infracost/releases/download/v{{ .Version }}/infracost-linux-amd64.tar.gz | tar xvz
+mv infracost-linux-amd64 {{ .BinDir }}/infracost-{{ .Version }}
+chmod +x {{ .BinDir }}/infracost-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/infracost-{{ .Version }} {{ .BinDir }}/infracost
+{{ end }}
+`
+
+var jsonnetInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/google/go-jsonnet/releases/download/v{{ .Version }}/go-jsonnet_{{ .Version }}_Linux_x86_64.tar.gz | tar xvz
+mv jsonnet {{ .BinDir }}/jsonnet-{{ .Version }}
+chmod +x {{ .BinDir }}/jsonnet-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/jsonnet-{{ .Version }} {{ .BinDir }}/jsonnet
+{{ end }}
+`
+
+var cueInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/cue-lang/cue/releases/download/v{{ .Version }}/cue_v{{ .Version }}_linux_amd64.tar.gz | tar xvz
+mv cue {{ .BinDir }}/cue-{{ .Version }}
+chmod +x {{ .BinDir }}/cue-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/cue-{{ .Version }} {{ .BinDir }}/cue
+{{ end }}
+`
+
+var kubeconformInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/yannh/kubeconform/releases/download/v{{ .Version }}/kubeconform-linux-amd64.tar.gz | tar xvz
+mv kubeconform {{ .BinDir }}/kubeconform-{{ .Version }}
+chmod +x {{ .BinDir }}/kubeconform-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/kubeconform-{{ .Version }} {{ .BinDir }}/kubeconform
+{{ end }}
+`
+
+var conftestInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/open-policy-agent/conftest/releases/download/v{{ .Version }}/conftest_{{ .Version }}_Linux_x86_64.tar.gz | tar xvz
+mv conftest {{ .BinDir }}/conftest-{{ .Version }}
+chmod +x {{ .BinDir }}/conftest-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/conftest-{{ .Version }} {{ .BinDir }}/conftest
+{{ end }}
+`
+
+var dockerComposeInstallScript = `
+cd {{ .WorkingDir }}
+curl -L https://github.com/docker/compose/releases/download/v{{ .Version }}/docker-compose-linux-x86_64 -o docker-compose
+mv docker-compose {{ .BinDir }}/docker-compose-{{ .Version }}
+chmod +x {{ .BinDir }}/docker-compose-{{ .Version }}
+{{ if .AsDefault }}
+cp -f {{ .BinDir }}/docker-compose-{{ .Version }} {{ .BinDir }}/docker-compose
+{{ end }}
+`
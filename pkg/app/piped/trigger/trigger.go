@@ -263,6 +263,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		}
 
 		var (
+			commit                    = headCommit
 			commander                 string
 			strategy                  model.SyncStrategy
 			strategySummary           string
@@ -274,12 +275,34 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		case model.TriggerKind_ON_COMMAND:
 			strategy = c.command.GetSyncApplication().SyncStrategy
 			commander = c.command.Commander
-			if strategy == model.SyncStrategy_QUICK_SYNC {
+			switch strategy {
+			case model.SyncStrategy_QUICK_SYNC:
 				strategySummary = "Quick sync because piped received a command from user via web console or pipectl"
-			} else {
+			case model.SyncStrategy_DESTROY:
+				strategySummary = "Destroy all resources because piped received a command from user via web console or pipectl"
+			default:
 				strategySummary = "Sync with the specified pipeline because piped received a command from user via web console or pipectl"
 			}
 
+			// Redeploy the previous commit specified in the command.
+			if hash := c.command.GetSyncApplication().CommitHash; hash != "" {
+				commit, err = gitRepo.GetCommitForRev(ctx, hash)
+				if err != nil {
+					msg := fmt.Sprintf("failed to find commit %s to redeploy application %s: %v", hash, app.Name, err)
+					t.notifyDeploymentTriggerFailed(app, appCfg, msg, headCommit)
+					t.logger.Error(msg, zap.Error(err))
+					// Mark the command as failed to not retry it forever.
+					if err := c.command.Report(ctx, model.CommandStatus_COMMAND_FAILED, nil, nil); err != nil {
+						t.logger.Error("failed to report command status", zap.Error(err))
+					}
+					continue
+				}
+				// The previous commit is always deployed by quick sync
+				// since the pipeline was designed for the changes made by the following commits.
+				strategy = model.SyncStrategy_QUICK_SYNC
+				strategySummary = fmt.Sprintf("Quick sync to redeploy the previous commit %s because piped received a command from user via web console", hash)
+			}
+
 		case model.TriggerKind_ON_CHAIN:
 			strategy = c.command.GetChainSyncApplication().SyncStrategy
 			commander = c.command.Commander
@@ -299,7 +322,7 @@ func (t *Trigger) checkRepoCandidates(ctx context.Context, repoID string, cs []c
 		deployment, err := buildDeployment(
 			app,
 			branch,
-			headCommit,
+			commit,
 			commander,
 			strategy,
 			strategySummary,
@@ -185,6 +185,14 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		return nil, status.Error(codes.InvalidArgument, "Requested application does not belong to your project")
 	}
 
+	strategy := model.SyncStrategy_AUTO
+	if req.Destroy {
+		if app.Kind != model.ApplicationKind_TERRAFORM {
+			return nil, status.Error(codes.InvalidArgument, "Destroying is supported by only Terraform application")
+		}
+		strategy = model.SyncStrategy_DESTROY
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
@@ -194,7 +202,7 @@ func (a *API) SyncApplication(ctx context.Context, req *apiservice.SyncApplicati
 		Commander:     key.Id,
 		SyncApplication: &model.Command_SyncApplication{
 			ApplicationId: app.Id,
-			SyncStrategy:  model.SyncStrategy_AUTO,
+			SyncStrategy:  strategy,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -707,6 +707,25 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 		return nil, status.Error(codes.PermissionDenied, "Requested application does not belong to your project")
 	}
 
+	if req.SyncStrategy == model.SyncStrategy_DESTROY {
+		if app.Kind != model.ApplicationKind_TERRAFORM {
+			return nil, status.Error(codes.InvalidArgument, "Destroying is supported by only Terraform application")
+		}
+		if req.CommitHash != "" {
+			return nil, status.Error(codes.InvalidArgument, "Destroying can not be requested with a commit to redeploy")
+		}
+	}
+
+	if req.CommitHash != "" {
+		deployed, err := a.isSuccessfullyDeployedCommit(ctx, app, req.CommitHash)
+		if err != nil {
+			return nil, err
+		}
+		if !deployed {
+			return nil, status.Error(codes.InvalidArgument, "Requested commit has never been deployed successfully")
+		}
+	}
+
 	cmd := model.Command{
 		Id:            uuid.New().String(),
 		PipedId:       app.PipedId,
@@ -717,6 +736,7 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 		SyncApplication: &model.Command_SyncApplication{
 			ApplicationId: app.Id,
 			SyncStrategy:  req.SyncStrategy,
+			CommitHash:    req.CommitHash,
 		},
 	}
 	if err := addCommand(ctx, a.commandStore, &cmd, a.logger); err != nil {
@@ -728,6 +748,56 @@ func (a *WebAPI) SyncApplication(ctx context.Context, req *webservice.SyncApplic
 	}, nil
 }
 
+// isSuccessfullyDeployedCommit reports whether the given commit has ever been deployed successfully for the application.
+func (a *WebAPI) isSuccessfullyDeployedCommit(ctx context.Context, app *model.Application, commitHash string) (bool, error) {
+	options := datastore.ListOptions{
+		Filters: []datastore.ListFilter{
+			{
+				Field:    "ProjectId",
+				Operator: datastore.OperatorEqual,
+				Value:    app.ProjectId,
+			},
+			{
+				Field:    "ApplicationId",
+				Operator: datastore.OperatorEqual,
+				Value:    app.Id,
+			},
+			{
+				Field:    "Status",
+				Operator: datastore.OperatorEqual,
+				Value:    model.DeploymentStatus_DEPLOYMENT_SUCCESS,
+			},
+		},
+		Orders: []datastore.Order{
+			{
+				Field:     "UpdatedAt",
+				Direction: datastore.Desc,
+			},
+			{
+				Field:     "Id",
+				Direction: datastore.Asc,
+			},
+		},
+		Limit: 100,
+	}
+	for {
+		deployments, cursor, err := a.deploymentStore.List(ctx, options)
+		if err != nil {
+			a.logger.Error("failed to get deployments", zap.Error(err))
+			return false, gRPCStoreError(err, "get deployments")
+		}
+		for _, d := range deployments {
+			if d.GetTrigger().GetCommit().GetHash() == commitHash {
+				return true, nil
+			}
+		}
+		if len(deployments) < options.Limit {
+			return false, nil
+		}
+		options.Cursor = cursor
+	}
+}
+
 func (a *WebAPI) GetApplication(ctx context.Context, req *webservice.GetApplicationRequest) (*webservice.GetApplicationResponse, error) {
 	claims, err := rpcauth.ExtractClaims(ctx)
 	if err != nil {
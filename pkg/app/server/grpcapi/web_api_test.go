@@ -21,6 +21,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
 
 	"github.com/pipe-cd/pipecd/pkg/cache"
 	"github.com/pipe-cd/pipecd/pkg/cache/cachetest"
@@ -213,6 +214,73 @@ func TestValidateDeploymentBelongsToProject(t *testing.T) {
 	}
 }
 
+func TestIsSuccessfullyDeployedCommit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	app := &model.Application{Id: "appID", ProjectId: "projectID"}
+	deployments := []*model.Deployment{
+		{Id: "deployment-1", Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "hash-1"}}},
+		{Id: "deployment-2", Trigger: &model.DeploymentTrigger{Commit: &model.Commit{Hash: "hash-2"}}},
+	}
+
+	tests := []struct {
+		name            string
+		commitHash      string
+		deploymentStore datastore.DeploymentStore
+		want            bool
+		wantErr         bool
+	}{
+		{
+			name:       "deployed commit",
+			commitHash: "hash-2",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					List(gomock.Any(), gomock.Any()).Return(deployments, "", nil)
+				return s
+			}(),
+			want: true,
+		},
+		{
+			name:       "never deployed commit",
+			commitHash: "hash-3",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					List(gomock.Any(), gomock.Any()).Return(deployments, "", nil)
+				return s
+			}(),
+			want: false,
+		},
+		{
+			name:       "failed to list deployments",
+			commitHash: "hash-1",
+			deploymentStore: func() datastore.DeploymentStore {
+				s := datastoretest.NewMockDeploymentStore(ctrl)
+				s.EXPECT().
+					List(gomock.Any(), gomock.Any()).Return(nil, "", errors.New("error"))
+				return s
+			}(),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			api := &WebAPI{
+				deploymentStore: tt.deploymentStore,
+				logger:          zap.NewNop(),
+			}
+			got, err := api.isSuccessfullyDeployedCommit(ctx, app, tt.commitHash)
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
 func TestValidatePipedBelongsToProject(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
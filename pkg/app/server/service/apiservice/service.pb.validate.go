@@ -373,6 +373,8 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Destroy
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -4007,6 +4007,8 @@ func (m *SyncApplicationRequest) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for CommitHash
+
 	if len(errors) > 0 {
 		return SyncApplicationRequestMultiError(errors)
 	}
@@ -233,23 +233,92 @@ type PipelineStage struct {
 	K8sBaselineRolloutStageOptions *K8sBaselineRolloutStageOptions
 	K8sBaselineCleanStageOptions   *K8sBaselineCleanStageOptions
 	K8sTrafficRoutingStageOptions  *K8sTrafficRoutingStageOptions
-
-	TerraformSyncStageOptions  *TerraformSyncStageOptions
-	TerraformPlanStageOptions  *TerraformPlanStageOptions
-	TerraformApplyStageOptions *TerraformApplyStageOptions
-
-	CloudRunSyncStageOptions    *CloudRunSyncStageOptions
-	CloudRunPromoteStageOptions *CloudRunPromoteStageOptions
-
-	LambdaSyncStageOptions          *LambdaSyncStageOptions
-	LambdaCanaryRolloutStageOptions *LambdaCanaryRolloutStageOptions
-	LambdaPromoteStageOptions       *LambdaPromoteStageOptions
-
-	ECSSyncStageOptions           *ECSSyncStageOptions
-	ECSCanaryRolloutStageOptions  *ECSCanaryRolloutStageOptions
-	ECSPrimaryRolloutStageOptions *ECSPrimaryRolloutStageOptions
-	ECSCanaryCleanStageOptions    *ECSCanaryCleanStageOptions
-	ECSTrafficRoutingStageOptions *ECSTrafficRoutingStageOptions
+	K8sWaitJobStageOptions         *K8sWaitJobStageOptions
+	K8sValidateStageOptions        *K8sValidateStageOptions
+	K8sRolloutRestartStageOptions  *K8sRolloutRestartStageOptions
+	K8sPrimaryCleanStageOptions    *K8sPrimaryCleanStageOptions
+
+	TerraformSyncStageOptions           *TerraformSyncStageOptions
+	TerraformPlanStageOptions           *TerraformPlanStageOptions
+	TerraformApplyStageOptions          *TerraformApplyStageOptions
+	TerraformCostEstimationStageOptions *TerraformCostEstimationStageOptions
+	TerraformPolicyCheckStageOptions    *TerraformPolicyCheckStageOptions
+	TerraformImportStageOptions         *TerraformImportStageOptions
+
+	CloudRunSyncStageOptions           *CloudRunSyncStageOptions
+	CloudRunPromoteStageOptions        *CloudRunPromoteStageOptions
+	CloudRunTrafficRoutingStageOptions *CloudRunTrafficRoutingStageOptions
+	CloudRunJobExecuteStageOptions     *CloudRunJobExecuteStageOptions
+
+	LambdaSyncStageOptions                   *LambdaSyncStageOptions
+	LambdaCanaryRolloutStageOptions          *LambdaCanaryRolloutStageOptions
+	LambdaPromoteStageOptions                *LambdaPromoteStageOptions
+	LambdaTrafficRoutingStageOptions         *LambdaTrafficRoutingStageOptions
+	LambdaProvisionedConcurrencyStageOptions *LambdaProvisionedConcurrencyStageOptions
+
+	ECSSyncStageOptions               *ECSSyncStageOptions
+	ECSCanaryRolloutStageOptions      *ECSCanaryRolloutStageOptions
+	ECSPrimaryRolloutStageOptions     *ECSPrimaryRolloutStageOptions
+	ECSCanaryCleanStageOptions        *ECSCanaryCleanStageOptions
+	ECSTrafficRoutingStageOptions     *ECSTrafficRoutingStageOptions
+	ECSTaskRunStageOptions            *ECSTaskRunStageOptions
+	ECSWaitHealthyStageOptions        *ECSWaitHealthyStageOptions
+	ECSCodeDeployDeployStageOptions   *ECSCodeDeployDeployStageOptions
+	ECSCodeDeployContinueStageOptions *ECSCodeDeployContinueStageOptions
+
+	AppRunnerSyncStageOptions           *AppRunnerSyncStageOptions
+	AppRunnerCanaryRolloutStageOptions  *AppRunnerCanaryRolloutStageOptions
+	AppRunnerTrafficRoutingStageOptions *AppRunnerTrafficRoutingStageOptions
+	AppRunnerPromoteStageOptions        *AppRunnerPromoteStageOptions
+	AppRunnerCanaryCleanStageOptions    *AppRunnerCanaryCleanStageOptions
+
+	CloudFormationSyncStageOptions      *CloudFormationSyncStageOptions
+	CloudFormationChangeSetStageOptions *CloudFormationChangeSetStageOptions
+	CloudFormationExecuteStageOptions   *CloudFormationExecuteStageOptions
+
+	NomadSyncStageOptions          *NomadSyncStageOptions
+	NomadCanaryRolloutStageOptions *NomadCanaryRolloutStageOptions
+	NomadPromoteStageOptions       *NomadPromoteStageOptions
+
+	ContainerAppsSyncStageOptions           *ContainerAppsSyncStageOptions
+	ContainerAppsCanaryRolloutStageOptions  *ContainerAppsCanaryRolloutStageOptions
+	ContainerAppsTrafficRoutingStageOptions *ContainerAppsTrafficRoutingStageOptions
+	ContainerAppsPromoteStageOptions        *ContainerAppsPromoteStageOptions
+
+	AppEngineSyncStageOptions    *AppEngineSyncStageOptions
+	AppEnginePromoteStageOptions *AppEnginePromoteStageOptions
+
+	StepFunctionsSyncStageOptions           *StepFunctionsSyncStageOptions
+	StepFunctionsCanaryRolloutStageOptions  *StepFunctionsCanaryRolloutStageOptions
+	StepFunctionsPromoteStageOptions        *StepFunctionsPromoteStageOptions
+	StepFunctionsTrafficRoutingStageOptions *StepFunctionsTrafficRoutingStageOptions
+
+	EC2ASGSyncStageOptions           *EC2ASGSyncStageOptions
+	EC2ASGGreenRolloutStageOptions   *EC2ASGGreenRolloutStageOptions
+	EC2ASGTrafficRoutingStageOptions *EC2ASGTrafficRoutingStageOptions
+	EC2ASGPromoteStageOptions        *EC2ASGPromoteStageOptions
+
+	GCEMIGSyncStageOptions          *GCEMIGSyncStageOptions
+	GCEMIGCanaryRolloutStageOptions *GCEMIGCanaryRolloutStageOptions
+	GCEMIGPromoteStageOptions       *GCEMIGPromoteStageOptions
+
+	AzureFunctionsSyncStageOptions       *AzureFunctionsSyncStageOptions
+	AzureFunctionsSlotDeployStageOptions *AzureFunctionsSlotDeployStageOptions
+	AzureFunctionsSwapStageOptions       *AzureFunctionsSwapStageOptions
+
+	StaticSiteSyncStageOptions         *StaticSiteSyncStageOptions
+	StaticSiteGreenRolloutStageOptions *StaticSiteGreenRolloutStageOptions
+	StaticSitePromoteStageOptions      *StaticSitePromoteStageOptions
+
+	DockerComposeSyncStageOptions    *DockerComposeSyncStageOptions
+	DockerComposeRolloutStageOptions *DockerComposeRolloutStageOptions
+
+	KnativeSyncStageOptions    *KnativeSyncStageOptions
+	KnativePromoteStageOptions *KnativePromoteStageOptions
+
+	FlyIOSyncStageOptions         *FlyIOSyncStageOptions
+	FlyIOGreenRolloutStageOptions *FlyIOGreenRolloutStageOptions
+	FlyIOPromoteStageOptions      *FlyIOPromoteStageOptions
 }
 
 type genericPipelineStage struct {
@@ -328,6 +397,26 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.K8sTrafficRoutingStageOptions)
 		}
+	case model.StageK8sWaitJob:
+		s.K8sWaitJobStageOptions = &K8sWaitJobStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sWaitJobStageOptions)
+		}
+	case model.StageK8sValidate:
+		s.K8sValidateStageOptions = &K8sValidateStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sValidateStageOptions)
+		}
+	case model.StageK8sRolloutRestart:
+		s.K8sRolloutRestartStageOptions = &K8sRolloutRestartStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sRolloutRestartStageOptions)
+		}
+	case model.StageK8sPrimaryClean:
+		s.K8sPrimaryCleanStageOptions = &K8sPrimaryCleanStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.K8sPrimaryCleanStageOptions)
+		}
 
 	case model.StageTerraformSync:
 		s.TerraformSyncStageOptions = &TerraformSyncStageOptions{}
@@ -344,6 +433,21 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.TerraformApplyStageOptions)
 		}
+	case model.StageTerraformCostEstimation:
+		s.TerraformCostEstimationStageOptions = &TerraformCostEstimationStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.TerraformCostEstimationStageOptions)
+		}
+	case model.StageTerraformPolicyCheck:
+		s.TerraformPolicyCheckStageOptions = &TerraformPolicyCheckStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.TerraformPolicyCheckStageOptions)
+		}
+	case model.StageTerraformImport:
+		s.TerraformImportStageOptions = &TerraformImportStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.TerraformImportStageOptions)
+		}
 
 	case model.StageCloudRunSync:
 		s.CloudRunSyncStageOptions = &CloudRunSyncStageOptions{}
@@ -355,6 +459,16 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.CloudRunPromoteStageOptions)
 		}
+	case model.StageCloudRunTrafficRouting:
+		s.CloudRunTrafficRoutingStageOptions = &CloudRunTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.CloudRunTrafficRoutingStageOptions)
+		}
+	case model.StageCloudRunJobExecute:
+		s.CloudRunJobExecuteStageOptions = &CloudRunJobExecuteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.CloudRunJobExecuteStageOptions)
+		}
 
 	case model.StageLambdaSync:
 		s.LambdaSyncStageOptions = &LambdaSyncStageOptions{}
@@ -371,6 +485,16 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.LambdaCanaryRolloutStageOptions)
 		}
+	case model.StageLambdaTrafficRouting:
+		s.LambdaTrafficRoutingStageOptions = &LambdaTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.LambdaTrafficRoutingStageOptions)
+		}
+	case model.StageLambdaProvisionedConcurrency:
+		s.LambdaProvisionedConcurrencyStageOptions = &LambdaProvisionedConcurrencyStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.LambdaProvisionedConcurrencyStageOptions)
+		}
 
 	case model.StageECSSync:
 		s.ECSSyncStageOptions = &ECSSyncStageOptions{}
@@ -397,6 +521,244 @@ func (s *PipelineStage) UnmarshalJSON(data []byte) error {
 		if len(gs.With) > 0 {
 			err = json.Unmarshal(gs.With, s.ECSTrafficRoutingStageOptions)
 		}
+	case model.StageECSTaskRun:
+		s.ECSTaskRunStageOptions = &ECSTaskRunStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSTaskRunStageOptions)
+		}
+	case model.StageECSWaitHealthy:
+		s.ECSWaitHealthyStageOptions = &ECSWaitHealthyStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSWaitHealthyStageOptions)
+		}
+	case model.StageECSCodeDeployDeploy:
+		s.ECSCodeDeployDeployStageOptions = &ECSCodeDeployDeployStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSCodeDeployDeployStageOptions)
+		}
+	case model.StageECSCodeDeployContinue:
+		s.ECSCodeDeployContinueStageOptions = &ECSCodeDeployContinueStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ECSCodeDeployContinueStageOptions)
+		}
+
+	case model.StageAppRunnerSync:
+		s.AppRunnerSyncStageOptions = &AppRunnerSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppRunnerSyncStageOptions)
+		}
+	case model.StageAppRunnerCanaryRollout:
+		s.AppRunnerCanaryRolloutStageOptions = &AppRunnerCanaryRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppRunnerCanaryRolloutStageOptions)
+		}
+	case model.StageAppRunnerTrafficRouting:
+		s.AppRunnerTrafficRoutingStageOptions = &AppRunnerTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppRunnerTrafficRoutingStageOptions)
+		}
+	case model.StageAppRunnerPromote:
+		s.AppRunnerPromoteStageOptions = &AppRunnerPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppRunnerPromoteStageOptions)
+		}
+	case model.StageAppRunnerCanaryClean:
+		s.AppRunnerCanaryCleanStageOptions = &AppRunnerCanaryCleanStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppRunnerCanaryCleanStageOptions)
+		}
+
+	case model.StageCloudFormationSync:
+		s.CloudFormationSyncStageOptions = &CloudFormationSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.CloudFormationSyncStageOptions)
+		}
+	case model.StageCloudFormationChangeSet:
+		s.CloudFormationChangeSetStageOptions = &CloudFormationChangeSetStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.CloudFormationChangeSetStageOptions)
+		}
+	case model.StageCloudFormationExecute:
+		s.CloudFormationExecuteStageOptions = &CloudFormationExecuteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.CloudFormationExecuteStageOptions)
+		}
+
+	case model.StageNomadSync:
+		s.NomadSyncStageOptions = &NomadSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.NomadSyncStageOptions)
+		}
+	case model.StageNomadCanaryRollout:
+		s.NomadCanaryRolloutStageOptions = &NomadCanaryRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.NomadCanaryRolloutStageOptions)
+		}
+	case model.StageNomadPromote:
+		s.NomadPromoteStageOptions = &NomadPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.NomadPromoteStageOptions)
+		}
+
+	case model.StageContainerAppsSync:
+		s.ContainerAppsSyncStageOptions = &ContainerAppsSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ContainerAppsSyncStageOptions)
+		}
+	case model.StageContainerAppsCanaryRollout:
+		s.ContainerAppsCanaryRolloutStageOptions = &ContainerAppsCanaryRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ContainerAppsCanaryRolloutStageOptions)
+		}
+	case model.StageContainerAppsTrafficRouting:
+		s.ContainerAppsTrafficRoutingStageOptions = &ContainerAppsTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ContainerAppsTrafficRoutingStageOptions)
+		}
+	case model.StageContainerAppsPromote:
+		s.ContainerAppsPromoteStageOptions = &ContainerAppsPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.ContainerAppsPromoteStageOptions)
+		}
+
+	case model.StageAppEngineSync:
+		s.AppEngineSyncStageOptions = &AppEngineSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppEngineSyncStageOptions)
+		}
+	case model.StageAppEnginePromote:
+		s.AppEnginePromoteStageOptions = &AppEnginePromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AppEnginePromoteStageOptions)
+		}
+
+	case model.StageStepFunctionsSync:
+		s.StepFunctionsSyncStageOptions = &StepFunctionsSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StepFunctionsSyncStageOptions)
+		}
+	case model.StageStepFunctionsCanaryRollout:
+		s.StepFunctionsCanaryRolloutStageOptions = &StepFunctionsCanaryRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StepFunctionsCanaryRolloutStageOptions)
+		}
+	case model.StageStepFunctionsPromote:
+		s.StepFunctionsPromoteStageOptions = &StepFunctionsPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StepFunctionsPromoteStageOptions)
+		}
+	case model.StageStepFunctionsTrafficRouting:
+		s.StepFunctionsTrafficRoutingStageOptions = &StepFunctionsTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StepFunctionsTrafficRoutingStageOptions)
+		}
+
+	case model.StageEC2ASGSync:
+		s.EC2ASGSyncStageOptions = &EC2ASGSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.EC2ASGSyncStageOptions)
+		}
+	case model.StageEC2ASGGreenRollout:
+		s.EC2ASGGreenRolloutStageOptions = &EC2ASGGreenRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.EC2ASGGreenRolloutStageOptions)
+		}
+	case model.StageEC2ASGTrafficRouting:
+		s.EC2ASGTrafficRoutingStageOptions = &EC2ASGTrafficRoutingStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.EC2ASGTrafficRoutingStageOptions)
+		}
+	case model.StageEC2ASGPromote:
+		s.EC2ASGPromoteStageOptions = &EC2ASGPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.EC2ASGPromoteStageOptions)
+		}
+
+	case model.StageGCEMIGSync:
+		s.GCEMIGSyncStageOptions = &GCEMIGSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.GCEMIGSyncStageOptions)
+		}
+	case model.StageGCEMIGCanaryRollout:
+		s.GCEMIGCanaryRolloutStageOptions = &GCEMIGCanaryRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.GCEMIGCanaryRolloutStageOptions)
+		}
+	case model.StageGCEMIGPromote:
+		s.GCEMIGPromoteStageOptions = &GCEMIGPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.GCEMIGPromoteStageOptions)
+		}
+
+	case model.StageAzureFunctionsSync:
+		s.AzureFunctionsSyncStageOptions = &AzureFunctionsSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AzureFunctionsSyncStageOptions)
+		}
+	case model.StageAzureFunctionsSlotDeploy:
+		s.AzureFunctionsSlotDeployStageOptions = &AzureFunctionsSlotDeployStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AzureFunctionsSlotDeployStageOptions)
+		}
+	case model.StageAzureFunctionsSwap:
+		s.AzureFunctionsSwapStageOptions = &AzureFunctionsSwapStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.AzureFunctionsSwapStageOptions)
+		}
+
+	case model.StageStaticSiteSync:
+		s.StaticSiteSyncStageOptions = &StaticSiteSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StaticSiteSyncStageOptions)
+		}
+	case model.StageStaticSiteGreenRollout:
+		s.StaticSiteGreenRolloutStageOptions = &StaticSiteGreenRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StaticSiteGreenRolloutStageOptions)
+		}
+	case model.StageStaticSitePromote:
+		s.StaticSitePromoteStageOptions = &StaticSitePromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.StaticSitePromoteStageOptions)
+		}
+
+	case model.StageDockerComposeSync:
+		s.DockerComposeSyncStageOptions = &DockerComposeSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.DockerComposeSyncStageOptions)
+		}
+	case model.StageDockerComposeRollout:
+		s.DockerComposeRolloutStageOptions = &DockerComposeRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.DockerComposeRolloutStageOptions)
+		}
+
+	case model.StageKnativeSync:
+		s.KnativeSyncStageOptions = &KnativeSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.KnativeSyncStageOptions)
+		}
+	case model.StageKnativePromote:
+		s.KnativePromoteStageOptions = &KnativePromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.KnativePromoteStageOptions)
+		}
+
+	case model.StageFlyIOSync:
+		s.FlyIOSyncStageOptions = &FlyIOSyncStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.FlyIOSyncStageOptions)
+		}
+	case model.StageFlyIOGreenRollout:
+		s.FlyIOGreenRolloutStageOptions = &FlyIOGreenRolloutStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.FlyIOGreenRolloutStageOptions)
+		}
+	case model.StageFlyIOPromote:
+		s.FlyIOPromoteStageOptions = &FlyIOPromoteStageOptions{}
+		if len(gs.With) > 0 {
+			err = json.Unmarshal(gs.With, s.FlyIOPromoteStageOptions)
+		}
 
 	default:
 		err = fmt.Errorf("unsupported stage name: %s", s.Name)
@@ -0,0 +1,98 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// AppEngineApplicationSpec represents an application configuration for App Engine application.
+type AppEngineApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for App Engine deployment such as where to fetch app.yaml...
+	Input AppEngineDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync AppEngineSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *AppEngineApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if err := s.Input.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.AppEnginePromoteStageOptions != nil {
+				if err := stage.AppEnginePromoteStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type AppEngineDeploymentInput struct {
+	// The name of app.yaml file placing in application directory.
+	// All files in the application directory are uploaded as the source of the new version.
+	// Default is app.yaml
+	AppYAMLFile string `json:"appYamlFile" default:"app.yaml"`
+	// The method used to split traffic between versions.
+	// Must be one of "IP", "COOKIE" and "RANDOM".
+	// Default is IP.
+	SplitBy string `json:"splitBy" default:"IP"`
+	// Automatically reverts to the previous state when the deployment is failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// The number of the latest versions created by piped to be kept
+	// in addition to the ones receiving traffic.
+	// The older versions are deleted after the new version started receiving all traffic.
+	// Zero means no version is deleted.
+	VersionRetention int `json:"versionRetention,omitempty"`
+}
+
+func (in *AppEngineDeploymentInput) Validate() error {
+	switch in.SplitBy {
+	case "IP", "COOKIE", "RANDOM":
+	default:
+		return fmt.Errorf("splitBy must be one of IP, COOKIE and RANDOM")
+	}
+	if in.VersionRetention < 0 {
+		return fmt.Errorf("versionRetention must not be negative")
+	}
+	return nil
+}
+
+// AppEngineSyncStageOptions contains all configurable values for a APPENGINE_SYNC stage.
+type AppEngineSyncStageOptions struct {
+}
+
+// AppEnginePromoteStageOptions contains all configurable values for a APPENGINE_PROMOTE stage.
+type AppEnginePromoteStageOptions struct {
+	// Percentage of traffic should be routed to the new version.
+	Percent Percentage `json:"percent"`
+}
+
+func (o *AppEnginePromoteStageOptions) Validate() error {
+	if percent := o.Percent.Int(); percent < 0 || percent > 100 {
+		return fmt.Errorf("percent %d of %s stage should be in range [0, 100]", percent, model.StageAppEnginePromote)
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAppEngineApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/appengine-app.yaml",
+			expectedKind:       KindAppEngineApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AppEngineApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AppEngineDeploymentInput{
+					AppYAMLFile:      "app.yaml",
+					SplitBy:          "IP",
+					AutoRollback:     newBoolPointer(true),
+					VersionRetention: 3,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/appengine-app-promote.yaml",
+			expectedKind:       KindAppEngineApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AppEngineApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageAppEnginePromote,
+								AppEnginePromoteStageOptions: &AppEnginePromoteStageOptions{
+									Percent: Percentage{
+										Number: 10,
+									},
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageAppEnginePromote,
+								AppEnginePromoteStageOptions: &AppEnginePromoteStageOptions{
+									Percent: Percentage{
+										Number: 100,
+									},
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AppEngineDeploymentInput{
+					AppYAMLFile:  "service.yaml",
+					SplitBy:      "COOKIE",
+					AutoRollback: newBoolPointer(false),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/appengine-app-invalid-promote.yaml",
+			expectedKind:       KindAppEngineApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("percent 120 of APPENGINE_PROMOTE stage should be in range [0, 100]"),
+		},
+		{
+			fileName:           "testdata/application/appengine-app-invalid-split.yaml",
+			expectedKind:       KindAppEngineApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("splitBy must be one of IP, COOKIE and RANDOM"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
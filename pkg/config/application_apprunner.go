@@ -0,0 +1,123 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// AppRunnerApplicationSpec represents an application configuration for App Runner application.
+type AppRunnerApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for App Runner deployment such as where to fetch the service manifest...
+	Input AppRunnerDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync AppRunnerSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *AppRunnerApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Input.TrafficRouting != nil {
+		if err := s.Input.TrafficRouting.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.AppRunnerTrafficRoutingStageOptions != nil {
+				if s.Input.TrafficRouting == nil {
+					return fmt.Errorf("trafficRouting must be specified in the input to use %s stage", model.StageAppRunnerTrafficRouting)
+				}
+				if err := stage.AppRunnerTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type AppRunnerDeploymentInput struct {
+	// The name of service manifest file placing in application directory.
+	// Default is apprunner.yaml
+	ServiceManifestFile string `json:"serviceManifestFile" default:"apprunner.yaml"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// Configuration of the weighted DNS records splitting the traffic
+	// between the PRIMARY and CANARY services.
+	// This is required to use APPRUNNER_TRAFFIC_ROUTING stage.
+	TrafficRouting *AppRunnerTrafficRouting `json:"trafficRouting,omitempty"`
+}
+
+// AppRunnerTrafficRouting represents a pair of Route 53 weighted records
+// pointing to the PRIMARY and CANARY services.
+type AppRunnerTrafficRouting struct {
+	// The ID of the Route 53 hosted zone where the records are managed.
+	HostedZoneID string `json:"hostedZoneId"`
+	// The domain name the clients access the application with, e.g. api.example.com.
+	RecordName string `json:"recordName"`
+	// The TTL of the records in seconds.
+	// Default is 60.
+	TTL int64 `json:"ttl" default:"60"`
+}
+
+func (t *AppRunnerTrafficRouting) Validate() error {
+	if t.HostedZoneID == "" {
+		return fmt.Errorf("hostedZoneId of trafficRouting must be specified")
+	}
+	if t.RecordName == "" {
+		return fmt.Errorf("recordName of trafficRouting must be specified")
+	}
+	if t.TTL <= 0 {
+		return fmt.Errorf("ttl of trafficRouting must be positive")
+	}
+	return nil
+}
+
+// AppRunnerSyncStageOptions contains all configurable values for a APPRUNNER_SYNC stage.
+type AppRunnerSyncStageOptions struct {
+}
+
+// AppRunnerCanaryRolloutStageOptions contains all configurable values for a APPRUNNER_CANARY_ROLLOUT stage.
+type AppRunnerCanaryRolloutStageOptions struct {
+}
+
+// AppRunnerTrafficRoutingStageOptions contains all configurable values for a APPRUNNER_TRAFFIC_ROUTING stage.
+type AppRunnerTrafficRoutingStageOptions struct {
+	// The percentage of traffic routed to the CANARY service.
+	// The rest of traffic is routed to the PRIMARY service.
+	Canary Percentage `json:"canary"`
+}
+
+func (o *AppRunnerTrafficRoutingStageOptions) Validate() error {
+	if canary := o.Canary.Int(); canary < 0 || canary > 100 {
+		return fmt.Errorf("canary %d of %s stage should be in range [0, 100]", canary, model.StageAppRunnerTrafficRouting)
+	}
+	return nil
+}
+
+// AppRunnerPromoteStageOptions contains all configurable values for a APPRUNNER_PROMOTE stage.
+type AppRunnerPromoteStageOptions struct {
+}
+
+// AppRunnerCanaryCleanStageOptions contains all configurable values for a APPRUNNER_CANARY_CLEAN stage.
+type AppRunnerCanaryCleanStageOptions struct {
+}
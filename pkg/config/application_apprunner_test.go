@@ -0,0 +1,170 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAppRunnerApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/apprunner-app.yaml",
+			expectedKind:       KindAppRunnerApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AppRunnerApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AppRunnerDeploymentInput{
+					ServiceManifestFile: "service.yaml",
+					AutoRollback:        newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/apprunner-app-canary.yaml",
+			expectedKind:       KindAppRunnerApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AppRunnerApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                               model.StageAppRunnerCanaryRollout,
+								AppRunnerCanaryRolloutStageOptions: &AppRunnerCanaryRolloutStageOptions{},
+							},
+							{
+								Name: model.StageAppRunnerTrafficRouting,
+								AppRunnerTrafficRoutingStageOptions: &AppRunnerTrafficRoutingStageOptions{
+									Canary: Percentage{
+										Number: 10,
+									},
+								},
+							},
+							{
+								Name:                         model.StageAppRunnerPromote,
+								AppRunnerPromoteStageOptions: &AppRunnerPromoteStageOptions{},
+							},
+							{
+								Name:                             model.StageAppRunnerCanaryClean,
+								AppRunnerCanaryCleanStageOptions: &AppRunnerCanaryCleanStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AppRunnerDeploymentInput{
+					ServiceManifestFile: "apprunner.yaml",
+					AutoRollback:        newBoolPointer(true),
+					TrafficRouting: &AppRunnerTrafficRouting{
+						HostedZoneID: "Z0123456789ABCDEFGHIJ",
+						RecordName:   "app.example.com",
+						TTL:          60,
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/apprunner-app-missing-traffic-routing.yaml",
+			expectedKind:       KindAppRunnerApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("trafficRouting must be specified in the input to use APPRUNNER_TRAFFIC_ROUTING stage"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
+
+func TestAppRunnerTrafficRoutingValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		routing AppRunnerTrafficRouting
+		wantErr bool
+	}{
+		{
+			name:    "valid",
+			routing: AppRunnerTrafficRouting{HostedZoneID: "Z123", RecordName: "app.example.com", TTL: 60},
+		},
+		{
+			name:    "missing hosted zone",
+			routing: AppRunnerTrafficRouting{RecordName: "app.example.com", TTL: 60},
+			wantErr: true,
+		},
+		{
+			name:    "missing record name",
+			routing: AppRunnerTrafficRouting{HostedZoneID: "Z123", TTL: 60},
+			wantErr: true,
+		},
+		{
+			name:    "invalid ttl",
+			routing: AppRunnerTrafficRouting{HostedZoneID: "Z123", RecordName: "app.example.com", TTL: 0},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.routing.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// AzureFunctionsApplicationSpec represents an application configuration for Azure Functions application.
+type AzureFunctionsApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Azure Functions deployment such as where to fetch the function app manifest...
+	Input AzureFunctionsDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync AzureFunctionsSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *AzureFunctionsApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if strings.EqualFold(s.Input.Slot, azureFunctionsProductionSlot) {
+		return fmt.Errorf("slot must not be %s", azureFunctionsProductionSlot)
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+
+	hasSlotDeploy := false
+	for _, stage := range s.Pipeline.Stages {
+		switch {
+		case stage.AzureFunctionsSlotDeployStageOptions != nil:
+			if s.Input.Slot == "" {
+				return fmt.Errorf("%s stage requires slot to be configured in the input", model.StageAzureFunctionsSlotDeploy)
+			}
+			hasSlotDeploy = true
+		case stage.AzureFunctionsSwapStageOptions != nil:
+			if !hasSlotDeploy {
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageAzureFunctionsSwap, model.StageAzureFunctionsSlotDeploy)
+			}
+		}
+	}
+	return nil
+}
+
+const azureFunctionsProductionSlot = "production"
+
+type AzureFunctionsDeploymentInput struct {
+	// The name of function app manifest file placing in application directory.
+	// Default is function.yaml
+	FunctionAppManifestFile string `json:"functionAppManifestFile" default:"function.yaml"`
+	// The name of the deployment slot where the new version is deployed before being swapped into production.
+	// The slot is created when it does not exist yet.
+	// When it is not configured, the new version is deployed to production directly.
+	Slot string `json:"slot,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// AzureFunctionsSyncStageOptions contains all configurable values for a AZUREFUNCTIONS_SYNC stage.
+type AzureFunctionsSyncStageOptions struct {
+}
+
+// AzureFunctionsSlotDeployStageOptions contains all configurable values for a AZUREFUNCTIONS_SLOT_DEPLOY stage.
+type AzureFunctionsSlotDeployStageOptions struct {
+}
+
+// AzureFunctionsSwapStageOptions contains all configurable values for a AZUREFUNCTIONS_SWAP stage.
+type AzureFunctionsSwapStageOptions struct {
+}
@@ -0,0 +1,137 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestAzureFunctionsApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/azurefunctions-app.yaml",
+			expectedKind:       KindAzureFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AzureFunctionsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AzureFunctionsDeploymentInput{
+					FunctionAppManifestFile: "orders-function.yaml",
+					AutoRollback:            newBoolPointer(false),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/azurefunctions-app-slot.yaml",
+			expectedKind:       KindAzureFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &AzureFunctionsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                                 model.StageAzureFunctionsSlotDeploy,
+								AzureFunctionsSlotDeployStageOptions: &AzureFunctionsSlotDeployStageOptions{},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name:                           model.StageAzureFunctionsSwap,
+								AzureFunctionsSwapStageOptions: &AzureFunctionsSwapStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: AzureFunctionsDeploymentInput{
+					FunctionAppManifestFile: "function.yaml",
+					Slot:                    "staging",
+					AutoRollback:            newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/azurefunctions-app-slot-deploy-without-slot.yaml",
+			expectedKind:       KindAzureFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("AZUREFUNCTIONS_SLOT_DEPLOY stage requires slot to be configured in the input"),
+		},
+		{
+			fileName:           "testdata/application/azurefunctions-app-swap-without-slot-deploy.yaml",
+			expectedKind:       KindAzureFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("AZUREFUNCTIONS_SWAP stage must be placed after AZUREFUNCTIONS_SLOT_DEPLOY stage"),
+		},
+		{
+			fileName:           "testdata/application/azurefunctions-app-production-slot.yaml",
+			expectedKind:       KindAzureFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("slot must not be production"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+)
+
+var cloudFormationStackNameRegex = regexp.MustCompile(`^[a-zA-Z][-a-zA-Z0-9]{0,127}$`)
+
+var cloudFormationCapabilities = map[string]struct{}{
+	"CAPABILITY_IAM":         {},
+	"CAPABILITY_NAMED_IAM":   {},
+	"CAPABILITY_AUTO_EXPAND": {},
+}
+
+// CloudFormationApplicationSpec represents an application configuration for CloudFormation application.
+type CloudFormationApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for CloudFormation deployment such as the stack name, the template file...
+	Input CloudFormationDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync CloudFormationSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *CloudFormationApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if err := s.Input.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+type CloudFormationDeploymentInput struct {
+	// The name of the stack to be deployed.
+	StackName string `json:"stackName"`
+	// The name of template file placing in application directory.
+	// Default is template.yaml
+	TemplateFile string `json:"templateFile" default:"template.yaml"`
+	// The values of the template parameters.
+	// The parameters not specified here use their default values.
+	Parameters map[string]string `json:"parameters,omitempty"`
+	// The capabilities to acknowledge for the stack,
+	// e.g. CAPABILITY_IAM to create IAM resources.
+	Capabilities []string `json:"capabilities,omitempty"`
+	// The tags to be added to the stack and propagated to its resources.
+	Tags map[string]string `json:"tags,omitempty"`
+	// The ARN of the IAM role CloudFormation assumes to change the resources of the stack.
+	// Empty means the credentials of piped are used.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is false.
+	AutoRollback bool `json:"autoRollback"`
+}
+
+func (in *CloudFormationDeploymentInput) Validate() error {
+	if in.StackName == "" {
+		return fmt.Errorf("stackName must be specified")
+	}
+	if !cloudFormationStackNameRegex.MatchString(in.StackName) {
+		return fmt.Errorf("stackName %q must start with a letter and contain only alphanumeric characters and hyphens up to 128 characters", in.StackName)
+	}
+	for _, c := range in.Capabilities {
+		if _, ok := cloudFormationCapabilities[c]; !ok {
+			return fmt.Errorf("unsupported capability %q, it must be one of CAPABILITY_IAM, CAPABILITY_NAMED_IAM and CAPABILITY_AUTO_EXPAND", c)
+		}
+	}
+	return nil
+}
+
+// CloudFormationSyncStageOptions contains all configurable values for a CLOUDFORMATION_SYNC stage.
+type CloudFormationSyncStageOptions struct {
+}
+
+// CloudFormationChangeSetStageOptions contains all configurable values for a CLOUDFORMATION_CHANGE_SET stage.
+type CloudFormationChangeSetStageOptions struct {
+	// Exit the pipeline if the change set contains no changes with success status.
+	ExitOnNoChanges bool `json:"exitOnNoChanges"`
+}
+
+// CloudFormationExecuteStageOptions contains all configurable values for a CLOUDFORMATION_EXECUTE stage.
+type CloudFormationExecuteStageOptions struct {
+}
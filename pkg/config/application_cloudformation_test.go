@@ -0,0 +1,166 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestCloudFormationApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/cloudformation-app.yaml",
+			expectedKind:       KindCloudFormationApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudFormationApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudFormationDeploymentInput{
+					StackName:    "my-stack",
+					TemplateFile: "template.yaml",
+					Parameters:   map[string]string{"Env": "prod"},
+					Capabilities: []string{"CAPABILITY_IAM"},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudformation-app-with-approval.yaml",
+			expectedKind:       KindCloudFormationApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudFormationApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageCloudFormationChangeSet,
+								CloudFormationChangeSetStageOptions: &CloudFormationChangeSetStageOptions{
+									ExitOnNoChanges: true,
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Approvers:      []string{"foo"},
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name:                              model.StageCloudFormationExecute,
+								CloudFormationExecuteStageOptions: &CloudFormationExecuteStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudFormationDeploymentInput{
+					StackName:    "my-stack",
+					TemplateFile: "stack.yaml",
+					AutoRollback: true,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudformation-app-invalid-capability.yaml",
+			expectedKind:       KindCloudFormationApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("unsupported capability \"CAPABILITY_RESOURCE_POLICY\", it must be one of CAPABILITY_IAM, CAPABILITY_NAMED_IAM and CAPABILITY_AUTO_EXPAND"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
+
+func TestCloudFormationDeploymentInputValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		input   CloudFormationDeploymentInput
+		wantErr bool
+	}{
+		{
+			name:  "valid",
+			input: CloudFormationDeploymentInput{StackName: "my-stack-1"},
+		},
+		{
+			name:    "missing stack name",
+			input:   CloudFormationDeploymentInput{},
+			wantErr: true,
+		},
+		{
+			name:    "stack name starting with a digit",
+			input:   CloudFormationDeploymentInput{StackName: "1-stack"},
+			wantErr: true,
+		},
+		{
+			name:    "stack name containing an underscore",
+			input:   CloudFormationDeploymentInput{StackName: "my_stack"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.input.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
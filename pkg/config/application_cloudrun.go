@@ -14,6 +14,13 @@
 
 package config
 
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
 // CloudRunApplicationSpec represents an application configuration for CloudRun application.
 type CloudRunApplicationSpec struct {
 	GenericApplicationSpec
@@ -21,6 +28,8 @@ type CloudRunApplicationSpec struct {
 	Input CloudRunDeploymentInput `json:"input"`
 	// Configuration for quick sync.
 	QuickSync CloudRunSyncStageOptions `json:"quickSync"`
+	// Configuration for deploying the same service to multiple regions.
+	MultiRegion *CloudRunMultiRegion `json:"multiRegion,omitempty"`
 }
 
 // Validate returns an error if any wrong configuration value was found.
@@ -28,6 +37,36 @@ func (s *CloudRunApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if s.Input.RevisionRetention < 0 {
+		return fmt.Errorf("revisionRetention must not be negative")
+	}
+	if s.MultiRegion != nil {
+		if err := s.MultiRegion.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.CloudRunPromoteStageOptions != nil {
+				if err := stage.CloudRunPromoteStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+			if stage.CloudRunTrafficRoutingStageOptions != nil {
+				if err := stage.CloudRunTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+			if stage.CloudRunJobExecuteStageOptions != nil {
+				if s.Input.JobManifestFile == "" {
+					return fmt.Errorf("jobManifestFile must be specified to use %s stage", model.StageCloudRunJobExecute)
+				}
+				if err := stage.CloudRunJobExecuteStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -35,9 +74,50 @@ type CloudRunDeploymentInput struct {
 	// The name of service manifest file placing in application directory.
 	// Default is service.yaml
 	ServiceManifestFile string `json:"serviceManifestFile"`
+	// The name of job manifest file placing in application directory.
+	// The job is deployed together with the service when it is specified.
+	JobManifestFile string `json:"jobManifestFile,omitempty"`
+	// The name of IAM policy file placing in application directory.
+	// The IAM policy of the service is reconciled with it during the deployment when it is specified.
+	IAMPolicyFile string `json:"iamPolicyFile,omitempty"`
 	// Automatically reverts to the previous state when the deployment is failed.
 	// Default is true.
 	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// The number of the latest revisions created by piped to be kept
+	// in addition to the ones serving traffic or having a tag.
+	// The older revisions are deleted after the new revision started serving all traffic.
+	// Zero means no revision is deleted.
+	RevisionRetention int `json:"revisionRetention,omitempty"`
+}
+
+// CloudRunMultiRegion represents the configuration for deploying
+// the same service to multiple regions from a single application.
+type CloudRunMultiRegion struct {
+	// List of regions where the service should be deployed
+	// in addition to the region of the platform provider.
+	Regions []string `json:"regions"`
+	// Whether to deploy to all regions at the same time or not.
+	// Default is false, the regions are deployed one by one starting from
+	// the region of the platform provider, and the remaining ones are skipped
+	// once a region failed.
+	Parallel bool `json:"parallel"`
+}
+
+func (m *CloudRunMultiRegion) Validate() error {
+	if len(m.Regions) == 0 {
+		return fmt.Errorf("multiRegion.regions must not be empty")
+	}
+	regions := make(map[string]struct{}, len(m.Regions))
+	for _, r := range m.Regions {
+		if r == "" {
+			return fmt.Errorf("multiRegion.regions must not contain an empty region")
+		}
+		if _, ok := regions[r]; ok {
+			return fmt.Errorf("multiRegion.regions contains duplicated region %s", r)
+		}
+		regions[r] = struct{}{}
+	}
+	return nil
 }
 
 // CloudRunSyncStageOptions contains all configurable values for a CLOUDRUN_SYNC stage.
@@ -48,4 +128,108 @@ type CloudRunSyncStageOptions struct {
 type CloudRunPromoteStageOptions struct {
 	// Percentage of traffic should be routed to the new version.
 	Percent Percentage `json:"percent"`
+	// The autoscaling settings applied only to the new revision deployed by this stage
+	// instead of the ones in the service manifest.
+	Scaling *CloudRunRevisionScaling `json:"scaling,omitempty"`
+}
+
+func (o *CloudRunPromoteStageOptions) Validate() error {
+	if o.Scaling != nil {
+		if err := o.Scaling.Validate(); err != nil {
+			return fmt.Errorf("invalid scaling of %s stage: %w", model.StageCloudRunPromote, err)
+		}
+	}
+	return nil
+}
+
+// CloudRunRevisionScaling represents the autoscaling settings of a revision
+// which override the ones in the service manifest, e.g. to constrain the canary revision.
+// Since the settings of a revision can't be changed once it was created, the revision deployed with
+// these settings is a separate one from the revision deployed without them.
+type CloudRunRevisionScaling struct {
+	// The minimum number of instances.
+	MinInstances *int `json:"minInstances,omitempty"`
+	// The maximum number of instances.
+	MaxInstances *int `json:"maxInstances,omitempty"`
+	// Whether CPU is always allocated to the instances, not only during request processing.
+	CPUAlwaysAllocated *bool `json:"cpuAlwaysAllocated,omitempty"`
+}
+
+func (s *CloudRunRevisionScaling) Validate() error {
+	if s.MinInstances == nil && s.MaxInstances == nil && s.CPUAlwaysAllocated == nil {
+		return fmt.Errorf("at least one of minInstances, maxInstances or cpuAlwaysAllocated must be specified")
+	}
+	if s.MinInstances != nil && *s.MinInstances < 0 {
+		return fmt.Errorf("minInstances must not be negative")
+	}
+	if s.MaxInstances != nil && *s.MaxInstances < 1 {
+		return fmt.Errorf("maxInstances must be positive")
+	}
+	if s.MinInstances != nil && s.MaxInstances != nil && *s.MinInstances > *s.MaxInstances {
+		return fmt.Errorf("minInstances must not be greater than maxInstances")
+	}
+	return nil
+}
+
+// The revision tag must be a lowercase DNS label since it is used as the prefix of the tag URL.
+var cloudRunRevisionTagRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+// CloudRunTrafficRoutingStageOptions contains all configurable values for a CLOUDRUN_TRAFFIC_ROUTING stage.
+type CloudRunTrafficRoutingStageOptions struct {
+	// List of the percentages of traffic routed to the new revision at each step, e.g. [10, 50, 100].
+	// The traffic is shifted step by step in the given order.
+	Steps []Percentage `json:"steps"`
+	// How long to wait after shifting the traffic before the next step.
+	// Default is 1m.
+	Interval Duration `json:"interval" default:"1m"`
+	// The tag assigned to the new revision, e.g. canary.
+	// The tagged revision can be accessed at its own URL regardless of its traffic percentage.
+	Tag string `json:"tag,omitempty"`
+	// Whether to store the URL of the tag in the stage metadata to be used for smoke testing.
+	ExposeTagURL bool `json:"exposeTagUrl,omitempty"`
+	// The autoscaling settings applied only to the new revision deployed by this stage
+	// instead of the ones in the service manifest.
+	Scaling *CloudRunRevisionScaling `json:"scaling,omitempty"`
+}
+
+func (o *CloudRunTrafficRoutingStageOptions) Validate() error {
+	if len(o.Steps) == 0 {
+		return fmt.Errorf("steps must be specified for %s stage", model.StageCloudRunTrafficRouting)
+	}
+	prev := 0
+	for _, p := range o.Steps {
+		if p.Int() <= prev || p.Int() > 100 {
+			return fmt.Errorf("steps of %s stage must be increasing percentages up to 100", model.StageCloudRunTrafficRouting)
+		}
+		prev = p.Int()
+	}
+	if o.Interval < 0 {
+		return fmt.Errorf("interval of %s stage must not be negative", model.StageCloudRunTrafficRouting)
+	}
+	if o.Tag != "" && !cloudRunRevisionTagRegex.MatchString(o.Tag) {
+		return fmt.Errorf("tag of %s stage must consist of lowercase letters, digits and dashes, and start with a letter", model.StageCloudRunTrafficRouting)
+	}
+	if o.ExposeTagURL && o.Tag == "" {
+		return fmt.Errorf("tag must be specified to expose the tag URL by %s stage", model.StageCloudRunTrafficRouting)
+	}
+	if o.Scaling != nil {
+		if err := o.Scaling.Validate(); err != nil {
+			return fmt.Errorf("invalid scaling of %s stage: %w", model.StageCloudRunTrafficRouting, err)
+		}
+	}
+	return nil
+}
+
+// CloudRunJobExecuteStageOptions contains all configurable values for a CLOUDRUN_JOB_EXECUTE stage.
+type CloudRunJobExecuteStageOptions struct {
+	// Maximum time to wait for the execution of the job to complete.
+	// Default is 30m.
+	Timeout Duration `json:"timeout" default:"30m"`
+}
+
+func (o *CloudRunJobExecuteStageOptions) Validate() error {
+	if o.Timeout <= 0 {
+		return fmt.Errorf("timeout of %s stage must be positive", model.StageCloudRunJobExecute)
+	}
+	return nil
 }
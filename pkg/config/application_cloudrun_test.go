@@ -20,6 +20,8 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
 )
 
 func TestCloudRunApplicationConfig(t *testing.T) {
@@ -59,6 +61,160 @@ func TestCloudRunApplicationConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/cloudrun-app-traffic-routing.yaml",
+			expectedKind:       KindCloudRunApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudRunApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageCloudRunTrafficRouting,
+								CloudRunTrafficRoutingStageOptions: &CloudRunTrafficRoutingStageOptions{
+									Steps: []Percentage{
+										{Number: 10},
+										{Number: 50},
+										{Number: 100},
+									},
+									Interval:     Duration(5 * time.Minute),
+									Tag:          "canary",
+									ExposeTagURL: true,
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudRunDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudrun-app-job.yaml",
+			expectedKind:       KindCloudRunApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudRunApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageCloudRunJobExecute,
+								CloudRunJobExecuteStageOptions: &CloudRunJobExecuteStageOptions{
+									Timeout: Duration(10 * time.Minute),
+								},
+							},
+							{
+								Name:                     model.StageCloudRunSync,
+								CloudRunSyncStageOptions: &CloudRunSyncStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudRunDeploymentInput{
+					ServiceManifestFile: "service.yaml",
+					JobManifestFile:     "job.yaml",
+					AutoRollback:        newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudrun-app-multi-region.yaml",
+			expectedKind:       KindCloudRunApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudRunApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudRunDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+				},
+				MultiRegion: &CloudRunMultiRegion{
+					Regions:  []string{"europe-west1", "us-central1"},
+					Parallel: true,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudrun-app-revision-retention.yaml",
+			expectedKind:       KindCloudRunApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudRunApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudRunDeploymentInput{
+					AutoRollback:      newBoolPointer(true),
+					RevisionRetention: 3,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/cloudrun-app-iam-policy.yaml",
+			expectedKind:       KindCloudRunApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &CloudRunApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: CloudRunDeploymentInput{
+					IAMPolicyFile: "policy.yaml",
+					AutoRollback:  newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -72,3 +228,205 @@ func TestCloudRunApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCloudRunTrafficRoutingStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    CloudRunTrafficRoutingStageOptions
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: CloudRunTrafficRoutingStageOptions{
+				Steps:        []Percentage{{Number: 10}, {Number: 50}, {Number: 100}},
+				Tag:          "canary",
+				ExposeTagURL: true,
+			},
+			wantErr: false,
+		},
+		{
+			name:    "no steps",
+			opts:    CloudRunTrafficRoutingStageOptions{},
+			wantErr: true,
+		},
+		{
+			name: "not increasing",
+			opts: CloudRunTrafficRoutingStageOptions{
+				Steps: []Percentage{{Number: 50}, {Number: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative interval",
+			opts: CloudRunTrafficRoutingStageOptions{
+				Steps:    []Percentage{{Number: 100}},
+				Interval: Duration(-time.Minute),
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid tag",
+			opts: CloudRunTrafficRoutingStageOptions{
+				Steps: []Percentage{{Number: 100}},
+				Tag:   "Canary_1",
+			},
+			wantErr: true,
+		},
+		{
+			name: "expose tag url without tag",
+			opts: CloudRunTrafficRoutingStageOptions{
+				Steps:        []Percentage{{Number: 100}},
+				ExposeTagURL: true,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestCloudRunJobExecuteStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    CloudRunJobExecuteStageOptions
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: CloudRunJobExecuteStageOptions{
+				Timeout: Duration(10 * time.Minute),
+			},
+		},
+		{
+			name: "zero timeout",
+			opts: CloudRunJobExecuteStageOptions{
+				Timeout: 0,
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestCloudRunRevisionScalingValidate(t *testing.T) {
+	t.Parallel()
+
+	newIntPointer := func(v int) *int { return &v }
+	testcases := []struct {
+		name    string
+		scaling CloudRunRevisionScaling
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			scaling: CloudRunRevisionScaling{
+				MinInstances:       newIntPointer(0),
+				MaxInstances:       newIntPointer(1),
+				CPUAlwaysAllocated: newBoolPointer(true),
+			},
+		},
+		{
+			name: "only cpu allocation",
+			scaling: CloudRunRevisionScaling{
+				CPUAlwaysAllocated: newBoolPointer(true),
+			},
+		},
+		{
+			name:    "empty",
+			wantErr: true,
+		},
+		{
+			name: "negative min instances",
+			scaling: CloudRunRevisionScaling{
+				MinInstances: newIntPointer(-1),
+			},
+			wantErr: true,
+		},
+		{
+			name: "zero max instances",
+			scaling: CloudRunRevisionScaling{
+				MaxInstances: newIntPointer(0),
+			},
+			wantErr: true,
+		},
+		{
+			name: "min instances greater than max instances",
+			scaling: CloudRunRevisionScaling{
+				MinInstances: newIntPointer(2),
+				MaxInstances: newIntPointer(1),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.scaling.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestCloudRunMultiRegionValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		mr      CloudRunMultiRegion
+		wantErr bool
+	}{
+		{
+			name:    "no region",
+			wantErr: true,
+		},
+		{
+			name: "duplicated regions",
+			mr: CloudRunMultiRegion{
+				Regions: []string{"us-central1", "us-central1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty region",
+			mr: CloudRunMultiRegion{
+				Regions: []string{""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			mr: CloudRunMultiRegion{
+				Regions:  []string{"europe-west1", "us-central1"},
+				Parallel: true,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.mr.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
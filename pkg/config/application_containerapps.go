@@ -0,0 +1,92 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// ContainerAppsApplicationSpec represents an application configuration for Azure Container Apps application.
+type ContainerAppsApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Azure Container Apps deployment such as where to fetch the app manifest...
+	Input ContainerAppsDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync ContainerAppsSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *ContainerAppsApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline != nil {
+		hasCanaryRollout := false
+		for _, stage := range s.Pipeline.Stages {
+			switch {
+			case stage.ContainerAppsCanaryRolloutStageOptions != nil:
+				hasCanaryRollout = true
+			case stage.ContainerAppsTrafficRoutingStageOptions != nil:
+				if !hasCanaryRollout {
+					return fmt.Errorf("%s stage must be placed after %s stage", model.StageContainerAppsTrafficRouting, model.StageContainerAppsCanaryRollout)
+				}
+				if err := stage.ContainerAppsTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			case stage.ContainerAppsPromoteStageOptions != nil && !hasCanaryRollout:
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageContainerAppsPromote, model.StageContainerAppsCanaryRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type ContainerAppsDeploymentInput struct {
+	// The name of app manifest file placing in application directory.
+	// The manifest is the resource of the container app in the format of Azure Resource Manager API.
+	// Default is app.yaml
+	AppManifestFile string `json:"appManifestFile" default:"app.yaml"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// ContainerAppsSyncStageOptions contains all configurable values for a CONTAINERAPPS_SYNC stage.
+type ContainerAppsSyncStageOptions struct {
+}
+
+// ContainerAppsCanaryRolloutStageOptions contains all configurable values for a CONTAINERAPPS_CANARY_ROLLOUT stage.
+type ContainerAppsCanaryRolloutStageOptions struct {
+}
+
+// ContainerAppsTrafficRoutingStageOptions contains all configurable values for a CONTAINERAPPS_TRAFFIC_ROUTING stage.
+type ContainerAppsTrafficRoutingStageOptions struct {
+	// The percentage of traffic routed to the new revision.
+	// The rest of traffic is routed to the revision running before the deployment.
+	Canary Percentage `json:"canary"`
+}
+
+func (o *ContainerAppsTrafficRoutingStageOptions) Validate() error {
+	if canary := o.Canary.Int(); canary < 0 || canary > 100 {
+		return fmt.Errorf("canary %d of %s stage should be in range [0, 100]", canary, model.StageContainerAppsTrafficRouting)
+	}
+	return nil
+}
+
+// ContainerAppsPromoteStageOptions contains all configurable values for a CONTAINERAPPS_PROMOTE stage.
+type ContainerAppsPromoteStageOptions struct {
+}
@@ -0,0 +1,138 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestContainerAppsApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/containerapps-app.yaml",
+			expectedKind:       KindContainerAppsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ContainerAppsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ContainerAppsDeploymentInput{
+					AppManifestFile: "containerapp.yaml",
+					AutoRollback:    newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/containerapps-app-canary.yaml",
+			expectedKind:       KindContainerAppsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ContainerAppsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                                   model.StageContainerAppsCanaryRollout,
+								ContainerAppsCanaryRolloutStageOptions: &ContainerAppsCanaryRolloutStageOptions{},
+							},
+							{
+								Name: model.StageContainerAppsTrafficRouting,
+								ContainerAppsTrafficRoutingStageOptions: &ContainerAppsTrafficRoutingStageOptions{
+									Canary: Percentage{
+										Number:    10,
+										HasSuffix: true,
+									},
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name:                             model.StageContainerAppsPromote,
+								ContainerAppsPromoteStageOptions: &ContainerAppsPromoteStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ContainerAppsDeploymentInput{
+					AppManifestFile: "app.yaml",
+					AutoRollback:    newBoolPointer(false),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/containerapps-app-invalid-canary.yaml",
+			expectedKind:       KindContainerAppsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("canary 120 of CONTAINERAPPS_TRAFFIC_ROUTING stage should be in range [0, 100]"),
+		},
+		{
+			fileName:           "testdata/application/containerapps-app-traffic-routing-without-canary.yaml",
+			expectedKind:       KindContainerAppsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("CONTAINERAPPS_TRAFFIC_ROUTING stage must be placed after CONTAINERAPPS_CANARY_ROLLOUT stage"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
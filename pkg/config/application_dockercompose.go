@@ -0,0 +1,91 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// DockerComposeApplicationSpec represents an application configuration for Docker Compose application.
+type DockerComposeApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Docker Compose deployment such as where to fetch the compose file...
+	Input DockerComposeDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync DockerComposeSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *DockerComposeApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+
+	inputHosts := make(map[string]struct{}, len(s.Input.Hosts))
+	for _, h := range s.Input.Hosts {
+		inputHosts[h] = struct{}{}
+	}
+	for _, stage := range s.Pipeline.Stages {
+		opts := stage.DockerComposeRolloutStageOptions
+		if opts == nil {
+			continue
+		}
+		if len(opts.Hosts) == 0 {
+			return fmt.Errorf("%s stage requires hosts to be configured", model.StageDockerComposeRollout)
+		}
+		for _, h := range opts.Hosts {
+			if _, ok := inputHosts[h]; len(inputHosts) > 0 && !ok {
+				return fmt.Errorf("host %s of %s stage must be one of the hosts in the input", h, model.StageDockerComposeRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type DockerComposeDeploymentInput struct {
+	// The name of compose file placing in application directory.
+	// Default is docker-compose.yaml
+	ComposeFile string `json:"composeFile" default:"docker-compose.yaml"`
+	// The name of the compose project.
+	// Default is the application name.
+	ProjectName string `json:"projectName,omitempty"`
+	// The names of the hosts of the platform provider where the compose file is applied in order.
+	// Empty means all hosts of the platform provider.
+	Hosts []string `json:"hosts,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// DockerComposeSyncStageOptions contains all configurable values for a DOCKERCOMPOSE_SYNC stage.
+type DockerComposeSyncStageOptions struct {
+	// How long to wait for the services of each host to become running and healthy.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
+
+// DockerComposeRolloutStageOptions contains all configurable values for a DOCKERCOMPOSE_ROLLOUT stage.
+type DockerComposeRolloutStageOptions struct {
+	// The names of the hosts where the compose file is applied in order.
+	Hosts []string `json:"hosts"`
+	// How long to wait for the services of each host to become running and healthy.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
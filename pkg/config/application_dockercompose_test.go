@@ -0,0 +1,142 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestDockerComposeApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/dockercompose-app.yaml",
+			expectedKind:       KindDockerComposeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &DockerComposeApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: DockerComposeDeploymentInput{
+					ComposeFile:  "compose.yaml",
+					ProjectName:  "web",
+					Hosts:        []string{"edge-01", "edge-02"},
+					AutoRollback: newBoolPointer(true),
+				},
+				QuickSync: DockerComposeSyncStageOptions{
+					WaitTimeout: Duration(10 * time.Minute),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/dockercompose-app-rollout.yaml",
+			expectedKind:       KindDockerComposeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &DockerComposeApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageDockerComposeRollout,
+								DockerComposeRolloutStageOptions: &DockerComposeRolloutStageOptions{
+									Hosts:       []string{"edge-01"},
+									WaitTimeout: Duration(5 * time.Minute),
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageDockerComposeSync,
+								DockerComposeSyncStageOptions: &DockerComposeSyncStageOptions{
+									WaitTimeout: Duration(5 * time.Minute),
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: DockerComposeDeploymentInput{
+					ComposeFile:  "docker-compose.yaml",
+					AutoRollback: newBoolPointer(true),
+				},
+				QuickSync: DockerComposeSyncStageOptions{
+					WaitTimeout: Duration(5 * time.Minute),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/dockercompose-app-rollout-without-hosts.yaml",
+			expectedKind:       KindDockerComposeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("DOCKERCOMPOSE_ROLLOUT stage requires hosts to be configured"),
+		},
+		{
+			fileName:           "testdata/application/dockercompose-app-rollout-unknown-host.yaml",
+			expectedKind:       KindDockerComposeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("host edge-03 of DOCKERCOMPOSE_ROLLOUT stage must be one of the hosts in the input"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
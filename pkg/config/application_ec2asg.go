@@ -0,0 +1,150 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// EC2ASGApplicationSpec represents an application configuration for AWS EC2 Auto Scaling Group application.
+type EC2ASGApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for EC2 Auto Scaling Group deployment such as where to fetch the group manifest...
+	Input EC2ASGDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync EC2ASGSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *EC2ASGApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Input.TrafficRouting != nil {
+		if err := s.Input.TrafficRouting.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := s.QuickSync.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+
+	hasGreenRollout := false
+	for _, stage := range s.Pipeline.Stages {
+		switch {
+		case stage.EC2ASGSyncStageOptions != nil:
+			if err := stage.EC2ASGSyncStageOptions.Validate(); err != nil {
+				return err
+			}
+		case stage.EC2ASGGreenRolloutStageOptions != nil:
+			if s.Input.TrafficRouting == nil {
+				return fmt.Errorf("%s stage requires trafficRouting to be configured in the input", model.StageEC2ASGGreenRollout)
+			}
+			hasGreenRollout = true
+		case stage.EC2ASGTrafficRoutingStageOptions != nil:
+			if !hasGreenRollout {
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageEC2ASGTrafficRouting, model.StageEC2ASGGreenRollout)
+			}
+			if err := stage.EC2ASGTrafficRoutingStageOptions.Validate(); err != nil {
+				return err
+			}
+		case stage.EC2ASGPromoteStageOptions != nil:
+			if !hasGreenRollout {
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageEC2ASGPromote, model.StageEC2ASGGreenRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type EC2ASGDeploymentInput struct {
+	// The name of auto scaling group manifest file placing in application directory.
+	// Default is asg.yaml
+	AutoScalingGroupManifestFile string `json:"autoScalingGroupManifestFile" default:"asg.yaml"`
+	// Configuration for the blue/green deployment behind an Application Load Balancer.
+	// When it is not configured, the instances of the group are replaced by an instance refresh.
+	TrafficRouting *EC2ASGTrafficRouting `json:"trafficRouting,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// EC2ASGTrafficRouting represents the listeners and the target groups
+// used to shift the traffic between the BLUE and GREEN auto scaling groups.
+type EC2ASGTrafficRouting struct {
+	// The ARNs of the listeners of the Application Load Balancer.
+	// Their default actions and the rules forwarding to the target groups are updated.
+	ListenerArns []string `json:"listenerArns"`
+	// The ARNs of the two target groups.
+	// The GREEN group is attached to the one which is not receiving the traffic.
+	TargetGroupArns []string `json:"targetGroupArns"`
+}
+
+func (r *EC2ASGTrafficRouting) Validate() error {
+	if len(r.ListenerArns) == 0 {
+		return fmt.Errorf("trafficRouting.listenerArns must not be empty")
+	}
+	if len(r.TargetGroupArns) != 2 || r.TargetGroupArns[0] == r.TargetGroupArns[1] {
+		return fmt.Errorf("trafficRouting.targetGroupArns must contain two different target groups")
+	}
+	return nil
+}
+
+// EC2ASGSyncStageOptions contains all configurable values for a EC2ASG_SYNC stage.
+type EC2ASGSyncStageOptions struct {
+	// The percentage of the desired capacity that must stay in service during the instance refresh.
+	// Default is 90.
+	MinHealthyPercentage int `json:"minHealthyPercentage" default:"90"`
+	// How long to wait after a new instance became in service before the next one is replaced.
+	// Default is the health check grace period of the group.
+	InstanceWarmup Duration `json:"instanceWarmup,omitempty"`
+}
+
+func (o *EC2ASGSyncStageOptions) Validate() error {
+	if o.MinHealthyPercentage < 0 || o.MinHealthyPercentage > 100 {
+		return fmt.Errorf("minHealthyPercentage %d of %s stage should be in range [0, 100]", o.MinHealthyPercentage, model.StageEC2ASGSync)
+	}
+	if o.InstanceWarmup < 0 {
+		return fmt.Errorf("instanceWarmup of %s stage must not be negative", model.StageEC2ASGSync)
+	}
+	return nil
+}
+
+// EC2ASGGreenRolloutStageOptions contains all configurable values for a EC2ASG_GREEN_ROLLOUT stage.
+type EC2ASGGreenRolloutStageOptions struct {
+}
+
+// EC2ASGTrafficRoutingStageOptions contains all configurable values for a EC2ASG_TRAFFIC_ROUTING stage.
+type EC2ASGTrafficRoutingStageOptions struct {
+	// Percentage of traffic should be routed to the GREEN group.
+	// The rest of traffic is routed to the BLUE group.
+	Green Percentage `json:"green"`
+}
+
+func (o *EC2ASGTrafficRoutingStageOptions) Validate() error {
+	if percent := o.Green.Int(); percent < 0 || percent > 100 {
+		return fmt.Errorf("green %d of %s stage should be in range [0, 100]", percent, model.StageEC2ASGTrafficRouting)
+	}
+	return nil
+}
+
+// EC2ASGPromoteStageOptions contains all configurable values for a EC2ASG_PROMOTE stage.
+type EC2ASGPromoteStageOptions struct {
+}
@@ -0,0 +1,160 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestEC2ASGApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/ec2asg-app.yaml",
+			expectedKind:       KindEC2ASGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &EC2ASGApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: EC2ASGDeploymentInput{
+					AutoScalingGroupManifestFile: "asg.yaml",
+					AutoRollback:                 newBoolPointer(true),
+				},
+				QuickSync: EC2ASGSyncStageOptions{
+					MinHealthyPercentage: 50,
+					InstanceWarmup:       Duration(2 * time.Minute),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ec2asg-app-bluegreen.yaml",
+			expectedKind:       KindEC2ASGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &EC2ASGApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                           model.StageEC2ASGGreenRollout,
+								EC2ASGGreenRolloutStageOptions: &EC2ASGGreenRolloutStageOptions{},
+							},
+							{
+								Name: model.StageEC2ASGTrafficRouting,
+								EC2ASGTrafficRoutingStageOptions: &EC2ASGTrafficRoutingStageOptions{
+									Green: Percentage{
+										Number: 20,
+									},
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name:                      model.StageEC2ASGPromote,
+								EC2ASGPromoteStageOptions: &EC2ASGPromoteStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: EC2ASGDeploymentInput{
+					AutoScalingGroupManifestFile: "asg.yaml",
+					TrafficRouting: &EC2ASGTrafficRouting{
+						ListenerArns: []string{
+							"arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:listener/app/web/1234/5678",
+						},
+						TargetGroupArns: []string{
+							"arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:targetgroup/web-1/1234",
+							"arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:targetgroup/web-2/5678",
+						},
+					},
+					AutoRollback: newBoolPointer(true),
+				},
+				QuickSync: EC2ASGSyncStageOptions{
+					MinHealthyPercentage: 90,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ec2asg-app-green-rollout-without-traffic-routing.yaml",
+			expectedKind:       KindEC2ASGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("EC2ASG_GREEN_ROLLOUT stage requires trafficRouting to be configured in the input"),
+		},
+		{
+			fileName:           "testdata/application/ec2asg-app-invalid-traffic-routing.yaml",
+			expectedKind:       KindEC2ASGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("trafficRouting.targetGroupArns must contain two different target groups"),
+		},
+		{
+			fileName:           "testdata/application/ec2asg-app-promote-without-green-rollout.yaml",
+			expectedKind:       KindEC2ASGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("EC2ASG_PROMOTE stage must be placed after EC2ASG_GREEN_ROLLOUT stage"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 const (
 	AccessTypeELB              string = "ELB"
 	AccessTypeServiceDiscovery string = "SERVICE_DISCOVERY"
+	AccessTypeAppMesh          string = "APP_MESH"
 )
 
 // ECSApplicationSpec represents an application configuration for ECS application.
@@ -43,6 +44,27 @@ func (s *ECSApplicationSpec) Validate() error {
 		return err
 	}
 
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.ECSCanaryRolloutStageOptions != nil {
+				if err := stage.ECSCanaryRolloutStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+			if stage.ECSTaskRunStageOptions != nil {
+				if err := stage.ECSTaskRunStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+			if stage.ECSWaitHealthyStageOptions != nil && s.Input.IsStandaloneTask() {
+				return fmt.Errorf("the ECS_WAIT_HEALTHY stage requires serviceDefinitionFile field")
+			}
+			if (stage.ECSCodeDeployDeployStageOptions != nil || stage.ECSCodeDeployContinueStageOptions != nil) && s.Input.CodeDeploy == nil {
+				return fmt.Errorf("the %s stage requires codeDeploy field", stage.Name)
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -60,8 +82,27 @@ type ECSDeploymentInput struct {
 	// The name of task definition file placing in application directory.
 	// Default is taskdef.json
 	TaskDefinitionFile string `json:"taskDefinitionFile" default:"taskdef.json"`
+	// The IAM role arn to assume while deploying this application.
+	// This overrides the assumeRoleARN of the platform provider so that a single piped
+	// can deploy applications to the ECS clusters in multiple AWS accounts.
+	AssumeRoleARN string `json:"assumeRoleARN,omitempty"`
+	// The external ID used when assuming the role of AssumeRoleARN.
+	// When AssumeRoleARN is empty, this is used to assume the role of the platform provider.
+	ExternalID string `json:"externalID,omitempty"`
+	// The name of scheduled task file placing in application directory.
+	// When specified, the task is run periodically by the EventBridge rule defined in this file
+	// instead of running as a service.
+	ScheduledTaskFile string `json:"scheduledTaskFile,omitempty"`
+	// The values used to render the Go-template placeholders in the task and service definition files.
+	// When specified, the definition files are rendered before planning so that
+	// a single definition can be reused across multiple environments.
+	Templating *ECSTemplating `json:"templating,omitempty"`
 	// ECSTargetGroups
 	TargetGroups ECSTargetGroups `json:"targetGroups"`
+	// Whether to keep waiting the service to be stable even when the deployment
+	// circuit breaker of the service marked the deployment as FAILED.
+	// By default, the stage fails immediately so that the rollback can be started.
+	IgnoreCircuitBreaker bool `json:"ignoreCircuitBreaker"`
 	// Automatically reverts all changes from all stages when one of them failed.
 	// Default is true.
 	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
@@ -72,24 +113,228 @@ type ECSDeploymentInput struct {
 	// Possible values are:
 	//  - ELB -  The service is accessed via ELB and target groups.
 	//  - SERVICE_DISCOVERY -  The service is accessed via ECS Service Discovery.
+	//  - APP_MESH - The service is accessed via AWS App Mesh, the traffic is routed by updating the mesh route.
 	// Default is ELB.
 	AccessType string `json:"accessType" default:"ELB"`
+	// The App Mesh configuration used to route traffic between PRIMARY and CANARY variants.
+	// Required when accessType is APP_MESH.
+	AppMesh *ECSAppMesh `json:"appMesh,omitempty"`
+	// The Application Auto Scaling configuration of the service.
+	// When specified, the scalable target and the scaling policies are registered while syncing the service.
+	// When not specified, the auto scaling of the service is left as it is.
+	AutoScaling *ECSAutoScaling `json:"autoScaling,omitempty"`
+	// The services deployed together with the main service, e.g. a worker sharing the same image.
+	// They are synced in the declared order after the main service by the ECS_SYNC and ECS_PRIMARY_ROLLOUT stages,
+	// and are rolled back together with the main service.
+	AdditionalServices []ECSServiceInput `json:"additionalServices,omitempty"`
+	// The number of the latest ACTIVE task definition revisions to keep for each family.
+	// When specified, the older ACTIVE revisions are deregistered
+	// after the service or the task was successfully synced.
+	// Default is 0, which means no revision is deregistered.
+	KeepTaskDefinitionRevisions int `json:"keepTaskDefinitionRevisions,omitempty"`
+	// The CodeDeploy configuration used to deploy the service by the CodeDeploy blue/green deployment.
+	// When specified, piped creates the AppSpec and triggers a CodeDeploy deployment
+	// instead of driving the task sets directly.
+	// The service must be created with CODE_DEPLOY deployment controller in advance.
+	CodeDeploy *ECSCodeDeploy `json:"codeDeploy,omitempty"`
+	// The configuration to update only the image of a sidecar container, e.g. envoy or log router.
+	// When specified, the other containers keep running the images of the currently running
+	// PRIMARY task set, and the image of the sidecar container is reported as the deployment version.
+	SidecarOnly *ECSSidecarOnly `json:"sidecarOnly,omitempty"`
+}
+
+// ECSSidecarOnly contains the configuration to update only a sidecar container of the service.
+type ECSSidecarOnly struct {
+	// The name of the sidecar container defined in the task definition.
+	ContainerName string `json:"containerName"`
+}
+
+// ECSCodeDeploy contains the configuration of the CodeDeploy deployment of the service.
+type ECSCodeDeploy struct {
+	// The name of the CodeDeploy application.
+	ApplicationName string `json:"applicationName"`
+	// The name of the deployment group of the application which targets the service.
+	DeploymentGroupName string `json:"deploymentGroupName"`
+	// The name of the deployment configuration, e.g. CodeDeployDefault.ECSLinear10PercentEvery1Minutes.
+	// Default is the one of the deployment group.
+	DeploymentConfigName string `json:"deploymentConfigName,omitempty"`
+	// The name of the container which receives the traffic from the load balancer.
+	ContainerName string `json:"containerName"`
+	// The port of the container which receives the traffic from the load balancer.
+	ContainerPort int `json:"containerPort"`
+	// The Lambda functions invoked at the lifecycle events of the deployment.
+	Hooks []ECSCodeDeployHook `json:"hooks,omitempty"`
+}
+
+// ECSCodeDeployHook represents a Lambda function invoked at a lifecycle event of the CodeDeploy deployment.
+type ECSCodeDeployHook struct {
+	// The name of the lifecycle event. One of BeforeInstall, AfterInstall, AfterAllowTestTraffic,
+	// BeforeAllowTraffic or AfterAllowTraffic.
+	Event string `json:"event"`
+	// The name or the ARN of the Lambda function.
+	FunctionName string `json:"functionName"`
+}
+
+// ECSServiceInput contains the definition files of a service deployed together with the main service.
+type ECSServiceInput struct {
+	// The name of service definition file placing in application directory.
+	ServiceDefinitionFile string `json:"serviceDefinitionFile"`
+	// The name of task definition file placing in application directory.
+	TaskDefinitionFile string `json:"taskDefinitionFile"`
+}
+
+// ECSTemplating contains the values available in the task and service definition files.
+// In addition to the following fields, the application name and labels are available
+// as {{ .app.name }} and {{ .app.labels.xxx }}, and the encrypted secrets
+// are available as {{ .encryptedSecrets.xxx }}.
+type ECSTemplating struct {
+	// The name of the environment the application is deployed to.
+	// Available as {{ .env }}.
+	Env string `json:"env"`
+	// The image tag to be deployed.
+	// Available as {{ .imageTag }}.
+	ImageTag string `json:"imageTag"`
+	// Arbitrary values available as {{ .values.xxx }}.
+	Values map[string]string `json:"values"`
+}
+
+// TaskDefinitionFiles returns the task definition files of the main service
+// and all additional services in the deployed order.
+func (in *ECSDeploymentInput) TaskDefinitionFiles() []string {
+	files := make([]string, 0, len(in.AdditionalServices)+1)
+	files = append(files, in.TaskDefinitionFile)
+	for _, s := range in.AdditionalServices {
+		files = append(files, s.TaskDefinitionFile)
+	}
+	return files
 }
 
 func (in *ECSDeploymentInput) IsStandaloneTask() bool {
 	return in.ServiceDefinitionFile == ""
 }
 
+// IsScheduledTask returns true when the task is run by an EventBridge rule.
+func (in *ECSDeploymentInput) IsScheduledTask() bool {
+	return in.ScheduledTaskFile != ""
+}
+
 func (in *ECSDeploymentInput) IsAccessedViaELB() bool {
 	return in.AccessType == AccessTypeELB
 }
 
+func (in *ECSDeploymentInput) IsAccessedViaAppMesh() bool {
+	return in.AccessType == AccessTypeAppMesh
+}
+
 type ECSVpcConfiguration struct {
 	Subnets        []string
 	AssignPublicIP string
 	SecurityGroups []string
 }
 
+// ECSAppMesh contains the configuration of the App Mesh route
+// whose weighted targets are updated while routing traffic.
+type ECSAppMesh struct {
+	// The name of the service mesh.
+	MeshName string `json:"meshName"`
+	// The AWS account ID of the mesh owner.
+	// Empty means the account of the piped credentials.
+	MeshOwner string `json:"meshOwner,omitempty"`
+	// The name of the virtual router which the route belongs to.
+	VirtualRouterName string `json:"virtualRouterName"`
+	// The name of the route to be updated.
+	RouteName string `json:"routeName"`
+	// The name of the virtual node which discovers the tasks of PRIMARY variant.
+	PrimaryVirtualNode string `json:"primaryVirtualNode"`
+	// The name of the virtual node which discovers the tasks of CANARY variant.
+	CanaryVirtualNode string `json:"canaryVirtualNode"`
+	// The service registry of the CANARY task set,
+	// it should be the one discovered by the CANARY virtual node.
+	CanaryServiceRegistry json.RawMessage `json:"canaryServiceRegistry,omitempty"`
+}
+
+func (m *ECSAppMesh) validate() error {
+	if m.MeshName == "" {
+		return fmt.Errorf("appMesh.meshName is required")
+	}
+	if m.VirtualRouterName == "" {
+		return fmt.Errorf("appMesh.virtualRouterName is required")
+	}
+	if m.RouteName == "" {
+		return fmt.Errorf("appMesh.routeName is required")
+	}
+	if m.PrimaryVirtualNode == "" || m.CanaryVirtualNode == "" {
+		return fmt.Errorf("appMesh.primaryVirtualNode and appMesh.canaryVirtualNode are required")
+	}
+	return nil
+}
+
+const (
+	ECSScalingPolicyTypeTargetTracking string = "TargetTrackingScaling"
+	ECSScalingPolicyTypeStepScaling    string = "StepScaling"
+)
+
+// ECSAutoScaling contains the configuration of the scalable target
+// and the scaling policies of the ECS service.
+type ECSAutoScaling struct {
+	// The minimum value that the desired count of the service can scale in to.
+	MinCapacity int32 `json:"minCapacity"`
+	// The maximum value that the desired count of the service can scale out to.
+	MaxCapacity int32 `json:"maxCapacity"`
+	// The scaling policies of the service.
+	// The policies which are registered to the service but not listed here will be deleted.
+	Policies []ECSScalingPolicy `json:"policies,omitempty"`
+}
+
+// ECSScalingPolicy represents a scaling policy of Application Auto Scaling.
+type ECSScalingPolicy struct {
+	// The name of the scaling policy.
+	Name string `json:"name"`
+	// The type of the scaling policy.
+	// Possible values are TargetTrackingScaling and StepScaling.
+	// Default is TargetTrackingScaling.
+	PolicyType string `json:"policyType" default:"TargetTrackingScaling"`
+	// The configuration of the target tracking scaling policy.
+	// https://docs.aws.amazon.com/autoscaling/application/APIReference/API_TargetTrackingScalingPolicyConfiguration.html
+	TargetTrackingScalingPolicyConfiguration json.RawMessage `json:"targetTrackingScalingPolicyConfiguration,omitempty"`
+	// The configuration of the step scaling policy.
+	// https://docs.aws.amazon.com/autoscaling/application/APIReference/API_StepScalingPolicyConfiguration.html
+	StepScalingPolicyConfiguration json.RawMessage `json:"stepScalingPolicyConfiguration,omitempty"`
+}
+
+func (a *ECSAutoScaling) validate() error {
+	if a.MinCapacity < 0 {
+		return fmt.Errorf("autoScaling.minCapacity must not be negative")
+	}
+	if a.MaxCapacity < a.MinCapacity {
+		return fmt.Errorf("autoScaling.maxCapacity must be greater than or equal to autoScaling.minCapacity")
+	}
+	names := make(map[string]struct{}, len(a.Policies))
+	for _, p := range a.Policies {
+		if p.Name == "" {
+			return fmt.Errorf("autoScaling.policies.name is required")
+		}
+		if _, ok := names[p.Name]; ok {
+			return fmt.Errorf("duplicated scaling policy name %s", p.Name)
+		}
+		names[p.Name] = struct{}{}
+
+		switch p.PolicyType {
+		case ECSScalingPolicyTypeTargetTracking:
+			if len(p.TargetTrackingScalingPolicyConfiguration) == 0 {
+				return fmt.Errorf("targetTrackingScalingPolicyConfiguration is required for scaling policy %s", p.Name)
+			}
+		case ECSScalingPolicyTypeStepScaling:
+			if len(p.StepScalingPolicyConfiguration) == 0 {
+				return fmt.Errorf("stepScalingPolicyConfiguration is required for scaling policy %s", p.Name)
+			}
+		default:
+			return fmt.Errorf("invalid policyType %s of scaling policy %s", p.PolicyType, p.Name)
+		}
+	}
+	return nil
+}
+
 type ECSTargetGroups struct {
 	Primary json.RawMessage `json:"primary"`
 	Canary  json.RawMessage `json:"canary"`
@@ -109,6 +354,13 @@ type ECSCanaryRolloutStageOptions struct {
 	Scale Percentage `json:"scale"`
 }
 
+func (opts *ECSCanaryRolloutStageOptions) Validate() error {
+	if scale := opts.Scale.Int(); scale <= 0 || scale > 100 {
+		return fmt.Errorf("scale %d of ECS_CANARY_ROLLOUT stage should be in range (0, 100]", scale)
+	}
+	return nil
+}
+
 // ECSPrimaryRolloutStageOptions contains all configurable values for a ECS_PRIMARY_ROLLOUT stage.
 type ECSPrimaryRolloutStageOptions struct {
 }
@@ -125,6 +377,50 @@ type ECSTrafficRoutingStageOptions struct {
 	Primary Percentage `json:"primary"`
 }
 
+// ECSTaskRunStageOptions contains all configurable values for a ECS_TASK_RUN stage.
+type ECSTaskRunStageOptions struct {
+	// The path to the task definition file of the task to run, placing in application directory.
+	TaskDefinitionFile string `json:"taskDefinitionFile"`
+	// The Amazon Resource Name (ARN) of the cluster where the task is run.
+	// Default is the clusterArn of the input, or the cluster of the service definition.
+	ClusterArn string `json:"clusterArn"`
+	// The network configuration of the task.
+	// Default is the awsvpcConfiguration of the input.
+	AwsVpcConfiguration *ECSVpcConfiguration `json:"awsvpcConfiguration"`
+	// The maximum length of time to wait until all the run tasks are stopped.
+	// The tasks still running after that are stopped and the stage fails.
+	// Defaults to 30m.
+	Timeout Duration `json:"timeout" default:"30m"`
+}
+
+func (opts *ECSTaskRunStageOptions) Validate() error {
+	if opts.TaskDefinitionFile == "" {
+		return fmt.Errorf("the ECS_TASK_RUN stage requires taskDefinitionFile field")
+	}
+	return nil
+}
+
+// ECSWaitHealthyStageOptions contains all configurable values for a ECS_WAIT_HEALTHY stage.
+type ECSWaitHealthyStageOptions struct {
+	// The maximum length of time to wait until all tasks of the service become healthy.
+	// Defaults to 10m.
+	Timeout Duration `json:"timeout" default:"10m"`
+}
+
+// ECSCodeDeployDeployStageOptions contains all configurable values for a ECS_CODEDEPLOY_DEPLOY stage.
+type ECSCodeDeployDeployStageOptions struct {
+	// The maximum length of time to wait until the replacement task set becomes ready to receive the traffic.
+	// Defaults to 1h.
+	Timeout Duration `json:"timeout" default:"1h"`
+}
+
+// ECSCodeDeployContinueStageOptions contains all configurable values for a ECS_CODEDEPLOY_CONTINUE stage.
+type ECSCodeDeployContinueStageOptions struct {
+	// The maximum length of time to wait until the traffic is shifted to the replacement task set.
+	// Defaults to 1h.
+	Timeout Duration `json:"timeout" default:"1h"`
+}
+
 func (opts ECSTrafficRoutingStageOptions) Percentage() (primary, canary int) {
 	primary = opts.Primary.Int()
 	if primary > 0 && primary <= 100 {
@@ -147,8 +443,91 @@ func (in *ECSDeploymentInput) validate() error {
 	switch in.AccessType {
 	case AccessTypeELB, AccessTypeServiceDiscovery:
 		break
+	case AccessTypeAppMesh:
+		if in.AppMesh == nil {
+			return fmt.Errorf("appMesh is required when accessType is %s", AccessTypeAppMesh)
+		}
+		if err := in.AppMesh.validate(); err != nil {
+			return err
+		}
 	default:
 		return fmt.Errorf("invalid accessType: %s", in.AccessType)
 	}
+	if in.IsScheduledTask() && !in.IsStandaloneTask() {
+		return fmt.Errorf("scheduledTaskFile can not be used with serviceDefinitionFile")
+	}
+	if in.AutoScaling != nil {
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("autoScaling can not be used with standalone task")
+		}
+		if err := in.AutoScaling.validate(); err != nil {
+			return err
+		}
+	}
+	if len(in.AdditionalServices) > 0 && in.IsStandaloneTask() {
+		return fmt.Errorf("additionalServices can not be used with standalone task")
+	}
+	for i, s := range in.AdditionalServices {
+		if s.ServiceDefinitionFile == "" || s.TaskDefinitionFile == "" {
+			return fmt.Errorf("additionalServices[%d] requires both serviceDefinitionFile and taskDefinitionFile", i)
+		}
+	}
+	if in.KeepTaskDefinitionRevisions < 0 {
+		return fmt.Errorf("keepTaskDefinitionRevisions must be greater than or equal to 0")
+	}
+	if in.CodeDeploy != nil {
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("codeDeploy can not be used with standalone task")
+		}
+		if len(in.AdditionalServices) > 0 {
+			return fmt.Errorf("codeDeploy can not be used with additionalServices")
+		}
+		if in.IsAccessedViaAppMesh() {
+			return fmt.Errorf("codeDeploy can not be used with accessType %s", AccessTypeAppMesh)
+		}
+		if err := in.CodeDeploy.validate(); err != nil {
+			return err
+		}
+	}
+	if in.SidecarOnly != nil {
+		if in.SidecarOnly.ContainerName == "" {
+			return fmt.Errorf("sidecarOnly.containerName is required")
+		}
+		if in.IsStandaloneTask() {
+			return fmt.Errorf("sidecarOnly can not be used with standalone task")
+		}
+		if len(in.AdditionalServices) > 0 {
+			return fmt.Errorf("sidecarOnly can not be used with additionalServices")
+		}
+		if in.CodeDeploy != nil {
+			return fmt.Errorf("sidecarOnly can not be used with codeDeploy")
+		}
+	}
+	return nil
+}
+
+func (c *ECSCodeDeploy) validate() error {
+	if c.ApplicationName == "" {
+		return fmt.Errorf("codeDeploy.applicationName is required")
+	}
+	if c.DeploymentGroupName == "" {
+		return fmt.Errorf("codeDeploy.deploymentGroupName is required")
+	}
+	if c.ContainerName == "" {
+		return fmt.Errorf("codeDeploy.containerName is required")
+	}
+	if c.ContainerPort <= 0 || c.ContainerPort > 65535 {
+		return fmt.Errorf("codeDeploy.containerPort must be in range [1, 65535]")
+	}
+	for _, h := range c.Hooks {
+		switch h.Event {
+		case "BeforeInstall", "AfterInstall", "AfterAllowTestTraffic", "BeforeAllowTraffic", "AfterAllowTraffic":
+		default:
+			return fmt.Errorf("invalid lifecycle event %q of codeDeploy.hooks", h.Event)
+		}
+		if h.FunctionName == "" {
+			return fmt.Errorf("functionName is required for the hook of lifecycle event %s", h.Event)
+		}
+	}
 	return nil
 }
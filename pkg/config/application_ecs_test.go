@@ -138,6 +138,300 @@ func TestECSApplicationConfig(t *testing.T) {
 			},
 			expectedError: fmt.Errorf("invalid accessType: XXX"),
 		},
+		{
+			fileName:           "testdata/application/ecs-app-app-mesh.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "APP_MESH",
+					AppMesh: &ECSAppMesh{
+						MeshName:              "mesh",
+						VirtualRouterName:     "web-router",
+						RouteName:             "web-route",
+						PrimaryVirtualNode:    "web-primary",
+						CanaryVirtualNode:     "web-canary",
+						CanaryServiceRegistry: json.RawMessage(`{"registryArn":"arn:aws:servicediscovery:xyz"}`),
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-app-mesh-missing.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("appMesh is required when accessType is APP_MESH"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-canary-scale.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("scale 120 of ECS_CANARY_ROLLOUT stage should be in range (0, 100]"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-task-run-missing-taskdef.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("the ECS_TASK_RUN stage requires taskDefinitionFile field"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-wait-healthy-standalone-task.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("the ECS_WAIT_HEALTHY stage requires serviceDefinitionFile field"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-additional-service.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("additionalServices[0] requires both serviceDefinitionFile and taskDefinitionFile"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-keep-task-definition-revisions.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("keepTaskDefinitionRevisions must be greater than or equal to 0"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-auto-scaling.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					AutoScaling: &ECSAutoScaling{
+						MinCapacity: 1,
+						MaxCapacity: 4,
+						Policies: []ECSScalingPolicy{
+							{
+								Name:                                     "cpu",
+								PolicyType:                               "TargetTrackingScaling",
+								TargetTrackingScalingPolicyConfiguration: json.RawMessage(`{"predefinedMetricSpecification":{"predefinedMetricType":"ECSServiceAverageCPUUtilization"},"targetValue":70}`),
+							},
+							{
+								Name:                           "step",
+								PolicyType:                     "StepScaling",
+								StepScalingPolicyConfiguration: json.RawMessage(`{"adjustmentType":"ChangeInCapacity"}`),
+							},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					CodeDeploy: &ECSCodeDeploy{
+						ApplicationName:      "AppECS-cluster-service",
+						DeploymentGroupName:  "DgpECS-cluster-service",
+						DeploymentConfigName: "CodeDeployDefault.ECSLinear10PercentEvery1Minutes",
+						ContainerName:        "web",
+						ContainerPort:        80,
+						Hooks: []ECSCodeDeployHook{
+							{Event: "AfterAllowTestTraffic", FunctionName: "run-e2e-test"},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-codedeploy.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("codeDeploy.containerPort must be in range [1, 65535]"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-codedeploy-stage-without-codedeploy.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("the ECS_CODEDEPLOY_DEPLOY stage requires codeDeploy field"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-sidecar-only.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "/path/to/servicedef.yaml",
+					TaskDefinitionFile:    "/path/to/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					SidecarOnly: &ECSSidecarOnly{
+						ContainerName: "envoy",
+					},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-sidecar-only.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("sidecarOnly can not be used with standalone task"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-auto-scaling.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("autoScaling.maxCapacity must be greater than or equal to autoScaling.minCapacity"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-invalid-scheduled-task.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("scheduledTaskFile can not be used with serviceDefinitionFile"),
+		},
+		{
+			fileName:           "testdata/application/ecs-app-templating.yaml",
+			expectedKind:       KindECSApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &ECSApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Labels: map[string]string{
+						"team": "payment",
+					},
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: ECSDeploymentInput{
+					ServiceDefinitionFile: "../shared/servicedef.yaml",
+					TaskDefinitionFile:    "../shared/taskdef.yaml",
+					LaunchType:            "FARGATE",
+					AutoRollback:          newBoolPointer(true),
+					RunStandaloneTask:     newBoolPointer(true),
+					AccessType:            "ELB",
+					Templating: &ECSTemplating{
+						Env:      "prod",
+						ImageTag: "v1.2.3",
+						Values: map[string]string{
+							"desiredCount": "3",
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
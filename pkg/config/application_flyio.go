@@ -0,0 +1,74 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+)
+
+// FlyIOApplicationSpec represents an application configuration for Fly.io application.
+type FlyIOApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Fly.io deployment such as where to fetch the fly.toml file...
+	Input FlyIODeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync FlyIOSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *FlyIOApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Input.Machines <= 0 {
+		return fmt.Errorf("input.machines must be greater than 0")
+	}
+	return nil
+}
+
+type FlyIODeploymentInput struct {
+	// The name of the Fly.io configuration file placing in application directory.
+	// Default is fly.toml
+	ConfigFile string `json:"configFile" default:"fly.toml"`
+	// The number of machines created in the primary region
+	// when the app does not have any machines yet.
+	// Otherwise, the same number of machines as the running version are created in each region.
+	// Default is 1.
+	Machines int `json:"machines,omitempty" default:"1"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// FlyIOSyncStageOptions contains all configurable values for a FLYIO_SYNC stage.
+type FlyIOSyncStageOptions struct {
+	// How long to wait for the new machines to become healthy.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
+
+// FlyIOGreenRolloutStageOptions contains all configurable values for a FLYIO_GREEN_ROLLOUT stage.
+type FlyIOGreenRolloutStageOptions struct {
+	// How long to wait for the new machines to become healthy.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
+
+// FlyIOPromoteStageOptions contains all configurable values for a FLYIO_PROMOTE stage.
+type FlyIOPromoteStageOptions struct {
+	// How long to wait for the new machines to become healthy again before routing the traffic to them.
+	// Default is 1m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"1m"`
+}
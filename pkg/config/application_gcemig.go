@@ -0,0 +1,87 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// GCEMIGApplicationSpec represents an application configuration for GCE Managed Instance Group application.
+type GCEMIGApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for GCE Managed Instance Group deployment such as where to fetch the group manifest...
+	Input GCEMIGDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync GCEMIGSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *GCEMIGApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+
+	hasCanaryRollout := false
+	for _, stage := range s.Pipeline.Stages {
+		switch {
+		case stage.GCEMIGCanaryRolloutStageOptions != nil:
+			if err := stage.GCEMIGCanaryRolloutStageOptions.Validate(); err != nil {
+				return err
+			}
+			hasCanaryRollout = true
+		case stage.GCEMIGPromoteStageOptions != nil:
+			if !hasCanaryRollout {
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageGCEMIGPromote, model.StageGCEMIGCanaryRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type GCEMIGDeploymentInput struct {
+	// The name of managed instance group manifest file placing in application directory.
+	// Default is mig.yaml
+	ManagedInstanceGroupManifestFile string `json:"managedInstanceGroupManifestFile" default:"mig.yaml"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// GCEMIGSyncStageOptions contains all configurable values for a GCEMIG_SYNC stage.
+type GCEMIGSyncStageOptions struct {
+}
+
+// GCEMIGCanaryRolloutStageOptions contains all configurable values for a GCEMIG_CANARY_ROLLOUT stage.
+type GCEMIGCanaryRolloutStageOptions struct {
+	// Percentage of the instances should be replaced by the ones launched from the new instance template.
+	// The stage can be placed multiple times with increasing percentages.
+	Percent Percentage `json:"percent"`
+}
+
+func (o *GCEMIGCanaryRolloutStageOptions) Validate() error {
+	if percent := o.Percent.Int(); percent <= 0 || percent > 100 {
+		return fmt.Errorf("percent %d of %s stage should be in range (0, 100]", percent, model.StageGCEMIGCanaryRollout)
+	}
+	return nil
+}
+
+// GCEMIGPromoteStageOptions contains all configurable values for a GCEMIG_PROMOTE stage.
+type GCEMIGPromoteStageOptions struct {
+}
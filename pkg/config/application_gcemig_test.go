@@ -0,0 +1,142 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestGCEMIGApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/gcemig-app.yaml",
+			expectedKind:       KindGCEMIGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &GCEMIGApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: GCEMIGDeploymentInput{
+					ManagedInstanceGroupManifestFile: "web-mig.yaml",
+					AutoRollback:                     newBoolPointer(false),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/gcemig-app-canary.yaml",
+			expectedKind:       KindGCEMIGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &GCEMIGApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageGCEMIGCanaryRollout,
+								GCEMIGCanaryRolloutStageOptions: &GCEMIGCanaryRolloutStageOptions{
+									Percent: Percentage{
+										Number: 10,
+									},
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageGCEMIGCanaryRollout,
+								GCEMIGCanaryRolloutStageOptions: &GCEMIGCanaryRolloutStageOptions{
+									Percent: Percentage{
+										Number:    50,
+										HasSuffix: true,
+									},
+								},
+							},
+							{
+								Name:                      model.StageGCEMIGPromote,
+								GCEMIGPromoteStageOptions: &GCEMIGPromoteStageOptions{},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: GCEMIGDeploymentInput{
+					ManagedInstanceGroupManifestFile: "mig.yaml",
+					AutoRollback:                     newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/gcemig-app-invalid-canary-percent.yaml",
+			expectedKind:       KindGCEMIGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("percent 0 of GCEMIG_CANARY_ROLLOUT stage should be in range (0, 100]"),
+		},
+		{
+			fileName:           "testdata/application/gcemig-app-promote-without-canary-rollout.yaml",
+			expectedKind:       KindGCEMIGApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("GCEMIG_PROMOTE stage must be placed after GCEMIG_CANARY_ROLLOUT stage"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
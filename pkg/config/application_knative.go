@@ -0,0 +1,90 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// KnativeApplicationSpec represents an application configuration for Knative Serving application.
+type KnativeApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Knative deployment such as where to fetch the service manifest...
+	Input KnativeDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync KnativeSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *KnativeApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+	for _, stage := range s.Pipeline.Stages {
+		if stage.KnativePromoteStageOptions != nil {
+			if err := stage.KnativePromoteStageOptions.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type KnativeDeploymentInput struct {
+	// The name of Knative service manifest file placing in application directory.
+	// Default is service.yaml
+	ServiceManifestFile string `json:"serviceManifestFile" default:"service.yaml"`
+	// The namespace where the service is deployed.
+	// Default is the namespace in the service manifest, or "default" if it was not specified there.
+	Namespace string `json:"namespace,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// KnativeSyncStageOptions contains all configurable values for a KNATIVE_SYNC stage.
+type KnativeSyncStageOptions struct {
+	// How long to wait for the new revision to become ready.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
+
+// The revision tag must be a lowercase DNS label since it is used as the prefix of the tag URL.
+var knativeRevisionTagRegex = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+// KnativePromoteStageOptions contains all configurable values for a KNATIVE_PROMOTE stage.
+type KnativePromoteStageOptions struct {
+	// Percentage of traffic should be routed to the new revision.
+	Percent Percentage `json:"percent"`
+	// The tag assigned to the new revision in the traffic of the service, e.g. candidate.
+	// The tagged revision can be accessed at its own URL regardless of its traffic percentage.
+	Tag string `json:"tag,omitempty"`
+	// How long to wait for the new revision to become ready.
+	// Default is 5m.
+	WaitTimeout Duration `json:"waitTimeout,omitempty" default:"5m"`
+}
+
+func (o *KnativePromoteStageOptions) Validate() error {
+	if o.Tag != "" && !knativeRevisionTagRegex.MatchString(o.Tag) {
+		return fmt.Errorf("tag %q of %s stage must be a lowercase DNS label", o.Tag, model.StageKnativePromote)
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestKnativeApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/knative-app.yaml",
+			expectedKind:       KindKnativeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KnativeApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KnativeDeploymentInput{
+					ServiceManifestFile: "ksvc.yaml",
+					Namespace:           "web",
+					AutoRollback:        newBoolPointer(true),
+				},
+				QuickSync: KnativeSyncStageOptions{
+					WaitTimeout: Duration(10 * time.Minute),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/knative-app-canary.yaml",
+			expectedKind:       KindKnativeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KnativeApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageKnativePromote,
+								KnativePromoteStageOptions: &KnativePromoteStageOptions{
+									Percent:     Percentage{Number: 10},
+									Tag:         "candidate",
+									WaitTimeout: Duration(5 * time.Minute),
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageKnativePromote,
+								KnativePromoteStageOptions: &KnativePromoteStageOptions{
+									Percent:     Percentage{Number: 100},
+									WaitTimeout: Duration(5 * time.Minute),
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KnativeDeploymentInput{
+					ServiceManifestFile: "service.yaml",
+					AutoRollback:        newBoolPointer(true),
+				},
+				QuickSync: KnativeSyncStageOptions{
+					WaitTimeout: Duration(5 * time.Minute),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/knative-app-invalid-tag.yaml",
+			expectedKind:       KindKnativeApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("tag \"Canary_1\" of KNATIVE_PROMOTE stage must be a lowercase DNS label"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
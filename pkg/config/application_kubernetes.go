@@ -14,6 +14,14 @@
 
 package config
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pipe-cd/pipecd/pkg/diff"
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
 // KubernetesApplicationSpec represents an application configuration for Kubernetes application.
 type KubernetesApplicationSpec struct {
 	GenericApplicationSpec
@@ -43,6 +51,14 @@ type KubernetesApplicationSpec struct {
 	// Any resource which does not match any specified route will be applied
 	// to the default platform provider which had been specified while registering the application.
 	ResourceRoutes []KubernetesResourceRoute `json:"resourceRoutes"`
+	// Configuration for deploying the same manifests to multiple clusters.
+	MultiCluster *KubernetesMultiCluster `json:"multiCluster,omitempty"`
+	// List of field paths of every resource that should be ignored
+	// while calculating the diff at planning time and by drift detection.
+	// e.g.
+	// - spec.replicas
+	// - metadata.annotations["deployment.kubernetes.io/revision"]
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
 }
 
 // Validate returns an error if any wrong configuration value was found.
@@ -50,6 +66,121 @@ func (s *KubernetesApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if c := s.Input.HelmChart; c != nil && c.Digest != "" {
+		if c.Repository == "" {
+			return fmt.Errorf("helmChart.digest can be used only with helmChart.repository")
+		}
+		if !strings.HasPrefix(c.Digest, "sha256:") {
+			return fmt.Errorf("helmChart.digest must be in the form of sha256:<hex>")
+		}
+	}
+	if o := s.Input.HelmOptions; o != nil && len(o.ValueFileHeaders) > 0 {
+		for _, f := range o.ValueFiles {
+			if strings.HasPrefix(f, "http://") {
+				return fmt.Errorf("valueFileHeaders can not be used with the http value file %s, use https instead", f)
+			}
+		}
+	}
+	for k, v := range s.Input.RemoteSourceDigests {
+		if !strings.HasPrefix(v, "sha256:") {
+			return fmt.Errorf("remoteSourceDigests of %s must be in the form of sha256:<hex>", k)
+		}
+	}
+	switch s.Input.TemplatingMethod {
+	case "", KubernetesTemplatingMethodKustomize, KubernetesTemplatingMethodJsonnet, KubernetesTemplatingMethodCue, KubernetesTemplatingMethodNone:
+	case KubernetesTemplatingMethodHelm:
+		if s.Input.HelmChart == nil {
+			return fmt.Errorf("helmChart must be set when templatingMethod is helm")
+		}
+	default:
+		return fmt.Errorf("unsupported templatingMethod %q", s.Input.TemplatingMethod)
+	}
+	if s.Input.NamespaceMetadata != nil && !s.Input.AutoCreateNamespace {
+		return fmt.Errorf("namespaceMetadata can be used only with autoCreateNamespace")
+	}
+	if s.Input.KubectlIdentity != nil {
+		if err := s.Input.KubectlIdentity.Validate(); err != nil {
+			return err
+		}
+	}
+	for _, f := range s.IgnoreFields {
+		if _, err := diff.NormalizeFieldPath(f); err != nil {
+			return fmt.Errorf("invalid ignoreFields: %v", err)
+		}
+	}
+	if s.MultiCluster != nil {
+		if err := s.validateMultiCluster(); err != nil {
+			return err
+		}
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			o := stage.K8sTrafficRoutingStageOptions
+			if o == nil || o.CanaryMatch == nil {
+				continue
+			}
+			if DetermineKubernetesTrafficRoutingMethod(s.TrafficRouting) != KubernetesTrafficRoutingMethodIstio {
+				return fmt.Errorf("canaryMatch of %s stage can be used only with istio traffic routing method", stage.Name)
+			}
+			if err := o.CanaryMatch.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *KubernetesApplicationSpec) validateMultiCluster() error {
+	if err := s.MultiCluster.Validate(); err != nil {
+		return err
+	}
+	if len(s.ResourceRoutes) > 0 {
+		return fmt.Errorf("multiCluster can not be used with resourceRoutes")
+	}
+	if s.QuickSync.Prune || s.QuickSync.PruneDryRun {
+		return fmt.Errorf("multiCluster can not be used with quickSync.prune")
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+	for _, stage := range s.Pipeline.Stages {
+		if stage.Name == model.StageK8sSync {
+			continue
+		}
+		if strings.HasPrefix(string(stage.Name), "K8S_") {
+			return fmt.Errorf("multiCluster can not be used with %s stage", stage.Name)
+		}
+	}
+	return nil
+}
+
+// KubernetesMultiCluster represents the configuration for deploying
+// the same manifests to multiple clusters from a single application.
+type KubernetesMultiCluster struct {
+	// List of names of the platform providers where the manifests should be deployed
+	// in addition to the platform provider of the application.
+	PlatformProviders []string `json:"platformProviders"`
+	// Whether to sync all clusters at the same time or not.
+	// Default is false, the clusters are synced one by one starting from
+	// the platform provider of the application, and the remaining ones are skipped
+	// once a cluster failed.
+	Parallel bool `json:"parallel"`
+}
+
+func (m *KubernetesMultiCluster) Validate() error {
+	if len(m.PlatformProviders) == 0 {
+		return fmt.Errorf("multiCluster.platformProviders must not be empty")
+	}
+	names := make(map[string]struct{}, len(m.PlatformProviders))
+	for _, name := range m.PlatformProviders {
+		if name == "" {
+			return fmt.Errorf("multiCluster.platformProviders must not contain an empty name")
+		}
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("multiCluster.platformProviders contains duplicated name %s", name)
+		}
+		names[name] = struct{}{}
+	}
 	return nil
 }
 
@@ -79,7 +210,11 @@ type KubernetesDeploymentInput struct {
 	// Version of kustomize will be used.
 	KustomizeVersion string `json:"kustomizeVersion"`
 	// List of options that should be used by Kustomize commands.
+	// e.g. "enable-helm", "load-restrictor", "enable-alpha-plugins".
 	KustomizeOptions map[string]string `json:"kustomizeOptions"`
+	// List of environment variables that should be set while running Kustomize commands.
+	// This can be used to pass the configuration to the exec plugins.
+	KustomizeEnvs map[string]string `json:"kustomizeEnvs,omitempty"`
 
 	// Version of helm will be used.
 	HelmVersion string `json:"helmVersion"`
@@ -88,6 +223,27 @@ type KubernetesDeploymentInput struct {
 	// Configurable parameters for helm commands.
 	HelmOptions *InputHelmOptions `json:"helmOptions"`
 
+	// Version of jsonnet will be used.
+	JsonnetVersion string `json:"jsonnetVersion"`
+	// Configurable parameters for jsonnet commands.
+	JsonnetOptions *InputJsonnetOptions `json:"jsonnetOptions"`
+
+	// Version of cue will be used.
+	CueVersion string `json:"cueVersion"`
+	// Configurable parameters for cue commands.
+	CueOptions *InputCueOptions `json:"cueOptions"`
+
+	// Map of the remote kustomize bases and helm chart dependencies to their expected digests (sha256:<hex>).
+	// The key is a remote base in kustomization.yaml e.g. https://github.com/org/repo//base?ref=v1.0.0,
+	// or a chart dependency in the form of <repository>/<name>:<version>.
+	// Piped caches those remote sources and fails the deployment when the digest does not match.
+	RemoteSourceDigests map[string]string `json:"remoteSourceDigests,omitempty"`
+
+	// The method to render the manifests.
+	// One of helm, kustomize, jsonnet, cue or none.
+	// Empty means it is determined by helmChart and the existence of kustomization.yaml.
+	TemplatingMethod KubernetesTemplatingMethod `json:"templatingMethod,omitempty"`
+
 	// The namespace where manifests will be applied.
 	Namespace string `json:"namespace"`
 
@@ -98,6 +254,33 @@ type KubernetesDeploymentInput struct {
 	// Automatically create a new namespace if it does not exist.
 	// Default is false.
 	AutoCreateNamespace bool `json:"autoCreateNamespace,omitempty"`
+	// The labels and annotations added to the namespace created by autoCreateNamespace.
+	// They are not added to the namespace which already exists.
+	NamespaceMetadata *K8sNamespaceMetadata `json:"namespaceMetadata,omitempty"`
+
+	// Configuration for using server-side apply instead of client-side apply.
+	ServerSideApply *K8sServerSideApply `json:"serverSideApply,omitempty"`
+
+	// The identity used by kubectl while deploying this application.
+	// This takes precedence over the one configured in the platform provider.
+	KubectlIdentity *KubernetesKubectlIdentity `json:"kubectlIdentity,omitempty"`
+}
+
+// K8sNamespaceMetadata contains the metadata of the namespace created by piped.
+type K8sNamespaceMetadata struct {
+	// The labels added to the namespace.
+	Labels map[string]string `json:"labels,omitempty"`
+	// The annotations added to the namespace.
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// K8sServerSideApply contains configurable values for kubectl server-side apply.
+type K8sServerSideApply struct {
+	// Whether to apply manifests by server-side apply or not.
+	// The field manager "piped" is used for all applied resources.
+	Enabled bool `json:"enabled"`
+	// Whether to take the ownership of the fields conflicting with other field managers or not.
+	ForceConflicts bool `json:"forceConflicts"`
 }
 
 type InputHelmChart struct {
@@ -113,9 +296,16 @@ type InputHelmChart struct {
 	Repository string `json:"repository"`
 	Name       string `json:"name"`
 	Version    string `json:"version"`
+	// The digest of the chart pulled from the OCI chart repository. e.g. sha256:xxx
+	// When specified, the manifests are rendered only when the pulled chart has the same digest,
+	// so that the same chart is always used even if its version tag was overwritten.
+	Digest string `json:"digest,omitempty"`
 	// Whether to skip TLS certificate checks for the repository or not.
 	// This option will automatically set the value of HelmChartRepository.Insecure.
 	Insecure bool `json:"-"`
+	// The address of the OCI chart repository.
+	// This option will automatically set the value of HelmChartRepository.Address of OCI type.
+	OCIAddress string `json:"-"`
 }
 
 type InputHelmOptions struct {
@@ -125,7 +315,11 @@ type InputHelmOptions struct {
 	// List of values.
 	SetValues map[string]string `json:"setValues"`
 	// List of value files should be loaded.
+	// A file ending with .enc is decrypted by the secret management of piped before loading.
 	ValueFiles []string `json:"valueFiles"`
+	// List of HTTP headers added while fetching the value files from https URLs.
+	// The header values can refer to the encrypted secrets, e.g. "Bearer {{ .encryptedSecrets.token }}".
+	ValueFileHeaders map[string]string `json:"valueFileHeaders,omitempty"`
 	// List of file path for values.
 	SetFiles map[string]string `json:"setFiles"`
 	// Set of supported Kubernetes API versions.
@@ -134,6 +328,39 @@ type InputHelmOptions struct {
 	KubeVersion string `json:"kubeVersion"`
 }
 
+type InputJsonnetOptions struct {
+	// Relative path from the application directory to the jsonnet file to evaluate.
+	// Default is main.jsonnet.
+	File string `json:"file" default:"main.jsonnet"`
+	// List of external variables passed by --ext-str.
+	ExtVars map[string]string `json:"extVars"`
+	// List of top-level arguments passed by --tla-str.
+	TLAs map[string]string `json:"tlas"`
+	// List of library search directories relative to the application directory.
+	JPaths []string `json:"jpaths"`
+}
+
+type InputCueOptions struct {
+	// The package to be exported.
+	// Empty means the package in the application directory.
+	Package string `json:"package"`
+	// The expression to be exported.
+	// Empty means the whole package.
+	Expression string `json:"expression"`
+	// List of tags passed by --inject.
+	Tags map[string]string `json:"tags"`
+}
+
+type KubernetesTemplatingMethod string
+
+const (
+	KubernetesTemplatingMethodHelm      KubernetesTemplatingMethod = "helm"
+	KubernetesTemplatingMethodKustomize KubernetesTemplatingMethod = "kustomize"
+	KubernetesTemplatingMethodJsonnet   KubernetesTemplatingMethod = "jsonnet"
+	KubernetesTemplatingMethodCue       KubernetesTemplatingMethod = "cue"
+	KubernetesTemplatingMethodNone      KubernetesTemplatingMethod = "none"
+)
+
 type KubernetesTrafficRoutingMethod string
 
 const (
@@ -145,6 +372,7 @@ const (
 type KubernetesTrafficRouting struct {
 	Method KubernetesTrafficRoutingMethod `json:"method"`
 	Istio  *IstioTrafficRouting           `json:"istio"`
+	SMI    *SMITrafficRouting             `json:"smi"`
 }
 
 // DetermineKubernetesTrafficRoutingMethod determines the routing method should be used based on the TrafficRouting config.
@@ -171,6 +399,14 @@ type IstioTrafficRouting struct {
 	VirtualService K8sResourceReference `json:"virtualService"`
 }
 
+type SMITrafficRouting struct {
+	// The reference to TrafficSplit manifest.
+	// Empty means the first TrafficSplit resource will be used.
+	// The backends of the TrafficSplit are the services of variants,
+	// named by adding the variant suffix to the root service of the TrafficSplit.
+	TrafficSplit K8sResourceReference `json:"trafficSplit"`
+}
+
 type K8sResourceReference struct {
 	Kind string `json:"kind"`
 	Name string `json:"name"`
@@ -182,6 +418,9 @@ type K8sSyncStageOptions struct {
 	AddVariantLabelToSelector bool `json:"addVariantLabelToSelector"`
 	// Whether the resources that are no longer defined in Git should be removed or not.
 	Prune bool `json:"prune"`
+	// Whether to only list the resources that are no longer defined in Git in the stage log without removing them.
+	// This can be used to check the resources to be removed before enabling prune.
+	PruneDryRun bool `json:"pruneDryRun"`
 }
 
 // K8sPrimaryRolloutStageOptions contains all configurable values for a K8S_PRIMARY_ROLLOUT stage.
@@ -210,7 +449,7 @@ type K8sCanaryRolloutStageOptions struct {
 	// Whether the CANARY service should be created.
 	CreateService bool `json:"createService"`
 	// List of patches used to customize manifests for CANARY variant.
-	Patches []K8sResourcePatch
+	Patches []K8sResourcePatch `json:"patches"`
 }
 
 type K8sResourcePatch struct {
@@ -231,17 +470,20 @@ type K8sResourcePatchOpName string
 
 const (
 	K8sResourcePatchOpYAMLReplace = "yaml-replace"
+	K8sResourcePatchOpYAMLAdd     = "yaml-add"
 )
 
 type K8sResourcePatchOp struct {
 	// The operation type.
-	// This must be one of "yaml-replace", "yaml-add", "yaml-remove", "json-replace" or "text-regex".
+	// This must be one of "yaml-replace" or "yaml-add".
 	// Default is "yaml-replace".
 	Op K8sResourcePatchOpName `json:"op" default:"yaml-replace"`
 	// The path string pointing to the manipulated field.
 	// E.g. "$.spec.foos[0].bar"
 	Path string `json:"path"`
 	// The value string whose content will be used as new value for the field.
+	// For "yaml-add", this must be a YAML mapping or sequence which will be
+	// merged into the mapping or appended to the sequence placed at the path.
 	Value string `json:"value"`
 }
 
@@ -278,6 +520,41 @@ type K8sTrafficRoutingStageOptions struct {
 	Canary Percentage `json:"canary"`
 	// The percentage of traffic should be routed to BASELINE variant.
 	Baseline Percentage `json:"baseline"`
+	// Conditions to route the matched requests to CANARY variant regardless of the percentages.
+	// The percentages are applied to the remaining requests.
+	// This can be used only when the traffic routing method is istio.
+	CanaryMatch *K8sTrafficRoutingCanaryMatch `json:"canaryMatch,omitempty"`
+}
+
+// K8sTrafficRoutingCanaryMatch represents the conditions of the requests routed to CANARY variant.
+// A request must satisfy all of the specified conditions.
+type K8sTrafficRoutingCanaryMatch struct {
+	// Map of header names and their exact values.
+	// e.g. x-canary: "true"
+	Headers map[string]string `json:"headers"`
+	// The cookie in the form of name=value.
+	// e.g. canary=always
+	Cookie string `json:"cookie"`
+}
+
+func (m *K8sTrafficRoutingCanaryMatch) Validate() error {
+	if len(m.Headers) == 0 && m.Cookie == "" {
+		return fmt.Errorf("canaryMatch must have at least one of headers or cookie")
+	}
+	for name := range m.Headers {
+		if name == "" {
+			return fmt.Errorf("canaryMatch.headers must not contain an empty name")
+		}
+		if m.Cookie != "" && strings.EqualFold(name, "cookie") {
+			return fmt.Errorf("canaryMatch.headers must not contain cookie header when canaryMatch.cookie was specified")
+		}
+	}
+	if m.Cookie != "" {
+		if name, _, ok := strings.Cut(m.Cookie, "="); !ok || name == "" {
+			return fmt.Errorf("canaryMatch.cookie must be in the form of name=value")
+		}
+	}
+	return nil
 }
 
 func (opts K8sTrafficRoutingStageOptions) Percentages() (primary, canary, baseline int) {
@@ -295,6 +572,52 @@ func (opts K8sTrafficRoutingStageOptions) Percentages() (primary, canary, baseli
 	return opts.Primary.Int(), opts.Canary.Int(), opts.Baseline.Int()
 }
 
+// K8sWaitJobStageOptions contains all configurable values for a K8S_WAIT_JOB stage.
+type K8sWaitJobStageOptions struct {
+	// List of names of the Job resources to be applied and waited for.
+	// Empty means all Job resources in the manifests.
+	Jobs []string `json:"jobs"`
+	// The maximum length of time to wait until all Jobs complete.
+	// Defaults to 30m.
+	Timeout Duration `json:"timeout" default:"30m"`
+}
+
+// K8sPrimaryCleanStageOptions contains all configurable values for a K8S_PRIMARY_CLEAN stage.
+type K8sPrimaryCleanStageOptions struct {
+	// How long the PRIMARY variant should be kept running before being scaled down.
+	// This gives a chance to instantly roll back by switching the traffic back to it.
+	// Default is 0, which means scaling down immediately.
+	GracePeriod Duration `json:"gracePeriod"`
+}
+
+// K8sRolloutRestartStageOptions contains all configurable values for a K8S_ROLLOUT_RESTART stage.
+type K8sRolloutRestartStageOptions struct {
+	// List of workloads to be restarted.
+	// Empty means the workloads configured in the application spec.
+	Workloads []K8sResourceReference `json:"workloads"`
+	// The maximum length of time to wait until all restarted workloads are rolled out.
+	// Defaults to 10m.
+	Timeout Duration `json:"timeout" default:"10m"`
+}
+
+// K8sValidateStageOptions contains all configurable values for a K8S_VALIDATE stage.
+type K8sValidateStageOptions struct {
+	// Whether to skip the schema validation by kubeconform.
+	SkipSchemaValidation bool `json:"skipSchemaValidation"`
+	// The version of Kubernetes used for the schema validation. e.g. 1.27.0
+	// Empty means the latest version.
+	KubernetesVersion string `json:"kubernetesVersion"`
+	// List of additional locations of the schemas used to validate custom resources.
+	// The built-in resources are always validated by the default schemas.
+	SchemaLocations []string `json:"schemaLocations"`
+	// Whether to fail the validation when no schema was found for a resource.
+	// Default is false, meaning those resources are skipped.
+	FailOnMissingSchemas bool `json:"failOnMissingSchemas"`
+	// List of directories containing OPA policies written in Rego.
+	// The paths are relative to the application directory.
+	Policies []string `json:"policies"`
+}
+
 type KubernetesResourceRoute struct {
 	Provider KubernetesProviderMatcher       `json:"provider"`
 	Match    *KubernetesResourceRouteMatcher `json:"match"`
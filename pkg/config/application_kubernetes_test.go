@@ -15,6 +15,7 @@
 package config
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -172,6 +173,255 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/k8s-app-server-side-apply.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+					ServerSideApply: &K8sServerSideApply{
+						Enabled:        true,
+						ForceConflicts: true,
+					},
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/k8s-app-helm-digest-without-repository.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("helmChart.digest can be used only with helmChart.repository"),
+		},
+		{
+			fileName:           "testdata/application/k8s-app-ignore-fields.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+				IgnoreFields: []string{
+					"spec.replicas",
+					`metadata.annotations["deployment.kubernetes.io/revision"]`,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/k8s-app-jsonnet.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					AutoRollback:     newBoolPointer(true),
+					TemplatingMethod: KubernetesTemplatingMethodJsonnet,
+					JsonnetVersion:   "0.20.0",
+					JsonnetOptions: &InputJsonnetOptions{
+						File: "main.jsonnet",
+						ExtVars: map[string]string{
+							"env": "production",
+						},
+						JPaths: []string{"lib"},
+					},
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/k8s-app-kubectl-identity.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					AutoRollback: newBoolPointer(true),
+					KubectlIdentity: &KubernetesKubectlIdentity{
+						Context:  "team-a",
+						As:       "system:serviceaccount:team-a:deployer",
+						AsGroups: []string{"team-a"},
+					},
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/k8s-app-invalid-kubectl-identity.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("kubectlIdentity.asGroups can be used only with kubectlIdentity.as"),
+		},
+		{
+			fileName:           "testdata/application/k8s-app-namespace-metadata.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &KubernetesApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: KubernetesDeploymentInput{
+					Namespace:           "team-a",
+					AutoRollback:        newBoolPointer(true),
+					AutoCreateNamespace: true,
+					NamespaceMetadata: &K8sNamespaceMetadata{
+						Labels: map[string]string{
+							"istio-injection": "enabled",
+						},
+						Annotations: map[string]string{
+							"owner": "team-a",
+						},
+					},
+				},
+				VariantLabel: KubernetesVariantLabel{
+					Key:           "pipecd.dev/variant",
+					PrimaryValue:  "primary",
+					BaselineValue: "baseline",
+					CanaryValue:   "canary",
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/k8s-app-invalid-namespace-metadata.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("namespaceMetadata can be used only with autoCreateNamespace"),
+		},
+		{
+			fileName:           "testdata/application/k8s-app-invalid-templating-method.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf(`unsupported templatingMethod "ytt"`),
+		},
+		{
+			fileName:           "testdata/application/k8s-app-invalid-ignore-fields.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf(`invalid ignoreFields: missing closing bracket in field path "metadata.annotations[\"deployment.kubernetes.io/revision"`),
+		},
+		{
+			fileName:           "testdata/application/k8s-app-invalid-multi-cluster.yaml",
+			expectedKind:       KindKubernetesApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("multiCluster can not be used with K8S_CANARY_ROLLOUT stage"),
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -185,3 +435,99 @@ func TestKubernetesApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestKubernetesMultiClusterValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		mc      KubernetesMultiCluster
+		wantErr bool
+	}{
+		{
+			name:    "no platform provider",
+			wantErr: true,
+		},
+		{
+			name: "duplicated platform providers",
+			mc: KubernetesMultiCluster{
+				PlatformProviders: []string{"cluster-asia", "cluster-asia"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "empty platform provider name",
+			mc: KubernetesMultiCluster{
+				PlatformProviders: []string{""},
+			},
+			wantErr: true,
+		},
+		{
+			name: "valid",
+			mc: KubernetesMultiCluster{
+				PlatformProviders: []string{"cluster-asia", "cluster-europe"},
+				Parallel:          true,
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.mc.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestK8sTrafficRoutingCanaryMatchValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		match   K8sTrafficRoutingCanaryMatch
+		wantErr bool
+	}{
+		{
+			name:    "no condition",
+			wantErr: true,
+		},
+		{
+			name: "valid headers",
+			match: K8sTrafficRoutingCanaryMatch{
+				Headers: map[string]string{"x-canary": "true"},
+			},
+		},
+		{
+			name: "valid cookie",
+			match: K8sTrafficRoutingCanaryMatch{
+				Cookie: "canary=always",
+			},
+		},
+		{
+			name: "malformed cookie",
+			match: K8sTrafficRoutingCanaryMatch{
+				Cookie: "canary",
+			},
+			wantErr: true,
+		},
+		{
+			name: "cookie header with cookie",
+			match: K8sTrafficRoutingCanaryMatch{
+				Headers: map[string]string{"Cookie": "canary=always"},
+				Cookie:  "canary=always",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.match.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -14,6 +14,12 @@
 
 package config
 
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
 // LambdaApplicationSpec represents an application configuration for Lambda application.
 type LambdaApplicationSpec struct {
 	GenericApplicationSpec
@@ -28,6 +34,29 @@ func (s *LambdaApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	if s.Input.ProvisionedConcurrency < 0 {
+		return fmt.Errorf("provisionedConcurrency must not be negative")
+	}
+	if s.Input.VersionRetention < 0 {
+		return fmt.Errorf("versionRetention must not be negative")
+	}
+	if len(s.Input.Layers) > maxLambdaLayers {
+		return fmt.Errorf("up to %d layers can be added to a lambda function", maxLambdaLayers)
+	}
+	for _, l := range s.Input.Layers {
+		if err := l.Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.LambdaTrafficRoutingStageOptions != nil {
+				if err := stage.LambdaTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
@@ -35,9 +64,71 @@ type LambdaDeploymentInput struct {
 	// The name of service manifest file placing in application directory.
 	// Default is function.yaml
 	FunctionManifestFile string `json:"functionManifestFile" default:"function.yaml"`
+	// The path to the SAM or CloudFormation template file placing in application directory.
+	// The function is built from the template instead of the function manifest file when this is specified.
+	SAMTemplateFile string `json:"samTemplateFile,omitempty"`
+	// The logical ID of the function to be deployed in the SAM template.
+	// This can be omitted when the template contains only one function.
+	SAMFunction string `json:"samFunction,omitempty"`
+	// The values of the template parameters used to resolve the SAM template.
+	// The default values in the template are used for the parameters not specified here.
+	SAMParameters map[string]string `json:"samParameters,omitempty"`
 	// Automatically reverts all changes from all stages when one of them failed.
 	// Default is true.
 	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+	// The number of the provisioned concurrency allocated to the alias
+	// by LAMBDA_PROVISIONED_CONCURRENCY stage.
+	// Zero means the provisioned concurrency of the alias is removed.
+	ProvisionedConcurrency int32 `json:"provisionedConcurrency,omitempty"`
+	// List of the layers to be published before updating the function.
+	// The ARNs of the published layer versions are added to the function
+	// configuration in addition to the layers specified in the function manifest.
+	Layers []LambdaLayer `json:"layers,omitempty"`
+	// The number of the latest function versions to be kept.
+	// The older versions are deleted after publishing a new version,
+	// except the ones routed by any alias of the function.
+	// Zero means no version is deleted.
+	VersionRetention int `json:"versionRetention,omitempty"`
+}
+
+// The maximum number of layers which can be added to a single function.
+const maxLambdaLayers = 5
+
+// LambdaLayer represents a layer to be published by piped.
+// Exactly one of path or s3Bucket/s3Key must be specified.
+type LambdaLayer struct {
+	// The name of the layer.
+	Name string `json:"name"`
+	// The relative path from the application directory to the directory
+	// containing the layer content. The directory is archived as a zip file.
+	Path string `json:"path,omitempty"`
+	// The S3 bucket storing the zip file of the layer content.
+	S3Bucket string `json:"s3Bucket,omitempty"`
+	// The S3 key of the zip file of the layer content.
+	S3Key string `json:"s3Key,omitempty"`
+	// The version of the S3 object to use.
+	S3ObjectVersion string `json:"s3ObjectVersion,omitempty"`
+	// List of the function runtimes compatible with the layer, e.g. python3.9.
+	CompatibleRuntimes []string `json:"compatibleRuntimes,omitempty"`
+	// List of the instruction set architectures compatible with the layer, e.g. arm64.
+	CompatibleArchitectures []string `json:"compatibleArchitectures,omitempty"`
+}
+
+func (l *LambdaLayer) Validate() error {
+	if l.Name == "" {
+		return fmt.Errorf("name of lambda layer must be specified")
+	}
+	hasS3 := l.S3Bucket != "" || l.S3Key != ""
+	if l.Path == "" && !hasS3 {
+		return fmt.Errorf("either path or s3Bucket and s3Key must be specified for lambda layer %s", l.Name)
+	}
+	if l.Path != "" && hasS3 {
+		return fmt.Errorf("only one of path or s3Bucket and s3Key can be specified for lambda layer %s", l.Name)
+	}
+	if hasS3 && (l.S3Bucket == "" || l.S3Key == "") {
+		return fmt.Errorf("both s3Bucket and s3Key must be specified for lambda layer %s", l.Name)
+	}
+	return nil
 }
 
 // LambdaSyncStageOptions contains all configurable values for a LAMBDA_SYNC stage.
@@ -53,3 +144,47 @@ type LambdaPromoteStageOptions struct {
 	// Percentage of traffic should be routed to the new version.
 	Percent Percentage `json:"percent"`
 }
+
+// The maximum number of alarms which can be described by a single CloudWatch API call.
+const maxLambdaTrafficRoutingAlarms = 100
+
+// LambdaTrafficRoutingStageOptions contains all configurable values for a LAMBDA_TRAFFIC_ROUTING stage.
+type LambdaTrafficRoutingStageOptions struct {
+	// List of the percentages of traffic routed to the new version at each step, e.g. [10, 50, 100].
+	// The alias weights are shifted step by step in the given order.
+	Steps []Percentage `json:"steps"`
+	// How long to wait after shifting the traffic before the next step.
+	// Default is 1m.
+	Interval Duration `json:"interval" default:"1m"`
+	// List of the names of CloudWatch alarms to watch during the traffic shifting,
+	// e.g. the ones for errors, throttles or duration of the function.
+	// The alias is reverted to the previous version when any of them fires.
+	Alarms []string `json:"alarms,omitempty"`
+}
+
+func (o *LambdaTrafficRoutingStageOptions) Validate() error {
+	if len(o.Steps) == 0 {
+		return fmt.Errorf("steps must be specified for %s stage", model.StageLambdaTrafficRouting)
+	}
+	prev := 0
+	for _, p := range o.Steps {
+		if p.Int() <= prev || p.Int() > 100 {
+			return fmt.Errorf("steps of %s stage must be increasing percentages up to 100", model.StageLambdaTrafficRouting)
+		}
+		prev = p.Int()
+	}
+	if o.Interval < 0 {
+		return fmt.Errorf("interval of %s stage must not be negative", model.StageLambdaTrafficRouting)
+	}
+	if len(o.Alarms) > maxLambdaTrafficRoutingAlarms {
+		return fmt.Errorf("up to %d alarms can be watched by %s stage", maxLambdaTrafficRoutingAlarms, model.StageLambdaTrafficRouting)
+	}
+	return nil
+}
+
+// LambdaProvisionedConcurrencyStageOptions contains all configurable values for a LAMBDA_PROVISIONED_CONCURRENCY stage.
+type LambdaProvisionedConcurrencyStageOptions struct {
+	// How long to wait for the provisioned concurrency to be ready.
+	// Default is 10m.
+	Timeout Duration `json:"timeout" default:"10m"`
+}
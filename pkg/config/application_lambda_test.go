@@ -153,6 +153,187 @@ func TestLambdaApplicationConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/lambda-app-traffic-routing.yaml",
+			expectedKind:       KindLambdaApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &LambdaApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                            model.StageLambdaCanaryRollout,
+								LambdaCanaryRolloutStageOptions: &LambdaCanaryRolloutStageOptions{},
+							},
+							{
+								Name: model.StageLambdaTrafficRouting,
+								LambdaTrafficRoutingStageOptions: &LambdaTrafficRoutingStageOptions{
+									Steps: []Percentage{
+										{Number: 10},
+										{Number: 50},
+										{Number: 100},
+									},
+									Interval: Duration(5 * time.Minute),
+									Alarms:   []string{"my-function-errors", "my-function-throttles"},
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: LambdaDeploymentInput{
+					FunctionManifestFile: "function.yaml",
+					AutoRollback:         newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/lambda-app-provisioned-concurrency.yaml",
+			expectedKind:       KindLambdaApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &LambdaApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                            model.StageLambdaCanaryRollout,
+								LambdaCanaryRolloutStageOptions: &LambdaCanaryRolloutStageOptions{},
+							},
+							{
+								Name: model.StageLambdaPromote,
+								LambdaPromoteStageOptions: &LambdaPromoteStageOptions{
+									Percent: Percentage{
+										Number:    100,
+										HasSuffix: false,
+									},
+								},
+							},
+							{
+								Name: model.StageLambdaProvisionedConcurrency,
+								LambdaProvisionedConcurrencyStageOptions: &LambdaProvisionedConcurrencyStageOptions{
+									Timeout: Duration(10 * time.Minute),
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: LambdaDeploymentInput{
+					FunctionManifestFile:   "function.yaml",
+					AutoRollback:           newBoolPointer(true),
+					ProvisionedConcurrency: 10,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/lambda-app-version-retention.yaml",
+			expectedKind:       KindLambdaApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &LambdaApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: LambdaDeploymentInput{
+					FunctionManifestFile: "function.yaml",
+					AutoRollback:         newBoolPointer(true),
+					VersionRetention:     10,
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/lambda-app-sam.yaml",
+			expectedKind:       KindLambdaApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &LambdaApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: LambdaDeploymentInput{
+					FunctionManifestFile: "function.yaml",
+					AutoRollback:         newBoolPointer(true),
+					SAMTemplateFile:      "template.yaml",
+					SAMFunction:          "HelloFunction",
+					SAMParameters:        map[string]string{"Stage": "prod"},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/lambda-app-layers.yaml",
+			expectedKind:       KindLambdaApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &LambdaApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: LambdaDeploymentInput{
+					FunctionManifestFile: "function.yaml",
+					AutoRollback:         newBoolPointer(true),
+					Layers: []LambdaLayer{
+						{
+							Name:               "my-dependencies",
+							Path:               "layers/dependencies",
+							CompatibleRuntimes: []string{"python3.9"},
+						},
+						{
+							Name:                    "my-extension",
+							S3Bucket:                "my-bucket",
+							S3Key:                   "layers/extension.zip",
+							CompatibleArchitectures: []string{"arm64"},
+						},
+					},
+				},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -166,3 +347,107 @@ func TestLambdaApplicationConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestLambdaTrafficRoutingStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		opts    LambdaTrafficRoutingStageOptions
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			opts: LambdaTrafficRoutingStageOptions{
+				Steps: []Percentage{{Number: 10}, {Number: 50}, {Number: 100}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "no steps",
+			opts:    LambdaTrafficRoutingStageOptions{},
+			wantErr: true,
+		},
+		{
+			name: "not increasing",
+			opts: LambdaTrafficRoutingStageOptions{
+				Steps: []Percentage{{Number: 50}, {Number: 10}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "over 100",
+			opts: LambdaTrafficRoutingStageOptions{
+				Steps: []Percentage{{Number: 50}, {Number: 150}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative interval",
+			opts: LambdaTrafficRoutingStageOptions{
+				Steps:    []Percentage{{Number: 100}},
+				Interval: Duration(-time.Minute),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.opts.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestLambdaLayerValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		layer   LambdaLayer
+		wantErr bool
+	}{
+		{
+			name:    "valid path",
+			layer:   LambdaLayer{Name: "layer", Path: "layer"},
+			wantErr: false,
+		},
+		{
+			name:    "valid s3",
+			layer:   LambdaLayer{Name: "layer", S3Bucket: "bucket", S3Key: "layer.zip"},
+			wantErr: false,
+		},
+		{
+			name:    "missing name",
+			layer:   LambdaLayer{Path: "layer"},
+			wantErr: true,
+		},
+		{
+			name:    "missing content",
+			layer:   LambdaLayer{Name: "layer"},
+			wantErr: true,
+		},
+		{
+			name:    "both path and s3",
+			layer:   LambdaLayer{Name: "layer", Path: "layer", S3Bucket: "bucket", S3Key: "layer.zip"},
+			wantErr: true,
+		},
+		{
+			name:    "missing s3 key",
+			layer:   LambdaLayer{Name: "layer", S3Bucket: "bucket"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			err := tc.layer.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
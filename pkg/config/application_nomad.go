@@ -0,0 +1,85 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// NomadApplicationSpec represents an application configuration for Nomad application.
+type NomadApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Nomad deployment such as where to fetch the job specification...
+	Input NomadDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync NomadSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *NomadApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if err := s.Input.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline != nil {
+		hasCanaryRollout := false
+		for _, stage := range s.Pipeline.Stages {
+			switch {
+			case stage.NomadCanaryRolloutStageOptions != nil:
+				hasCanaryRollout = true
+			case stage.NomadPromoteStageOptions != nil && !hasCanaryRollout:
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageNomadPromote, model.StageNomadCanaryRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type NomadDeploymentInput struct {
+	// The name of job specification file placing in application directory.
+	// The file is treated as JSON when its extension is .json, otherwise as HCL.
+	// Default is job.nomad.hcl
+	JobFile string `json:"jobFile" default:"job.nomad.hcl"`
+	// The values of the HCL2 input variables declared in the job specification.
+	// The variables not specified here use their default values.
+	Variables map[string]string `json:"variables,omitempty"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+func (in *NomadDeploymentInput) Validate() error {
+	if len(in.Variables) > 0 && filepath.Ext(in.JobFile) == ".json" {
+		return fmt.Errorf("variables can not be used with the JSON job specification %s", in.JobFile)
+	}
+	return nil
+}
+
+// NomadSyncStageOptions contains all configurable values for a NOMAD_SYNC stage.
+type NomadSyncStageOptions struct {
+}
+
+// NomadCanaryRolloutStageOptions contains all configurable values for a NOMAD_CANARY_ROLLOUT stage.
+type NomadCanaryRolloutStageOptions struct {
+}
+
+// NomadPromoteStageOptions contains all configurable values for a NOMAD_PROMOTE stage.
+type NomadPromoteStageOptions struct {
+}
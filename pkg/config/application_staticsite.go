@@ -0,0 +1,74 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// StaticSiteApplicationSpec represents an application configuration for Static Site application.
+type StaticSiteApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Static Site deployment such as where to fetch the site manifest...
+	Input StaticSiteDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync StaticSiteSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *StaticSiteApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline == nil {
+		return nil
+	}
+
+	hasGreenRollout := false
+	for _, stage := range s.Pipeline.Stages {
+		switch {
+		case stage.StaticSiteGreenRolloutStageOptions != nil:
+			hasGreenRollout = true
+		case stage.StaticSitePromoteStageOptions != nil:
+			if !hasGreenRollout {
+				return fmt.Errorf("%s stage must be placed after %s stage", model.StageStaticSitePromote, model.StageStaticSiteGreenRollout)
+			}
+		}
+	}
+	return nil
+}
+
+type StaticSiteDeploymentInput struct {
+	// The name of site manifest file placing in application directory.
+	// Default is site.yaml
+	SiteManifestFile string `json:"siteManifestFile" default:"site.yaml"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// StaticSiteSyncStageOptions contains all configurable values for a STATICSITE_SYNC stage.
+type StaticSiteSyncStageOptions struct {
+}
+
+// StaticSiteGreenRolloutStageOptions contains all configurable values for a STATICSITE_GREEN_ROLLOUT stage.
+type StaticSiteGreenRolloutStageOptions struct {
+}
+
+// StaticSitePromoteStageOptions contains all configurable values for a STATICSITE_PROMOTE stage.
+type StaticSitePromoteStageOptions struct {
+}
@@ -0,0 +1,118 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+// StepFunctionsApplicationSpec represents an application configuration for AWS Step Functions application.
+type StepFunctionsApplicationSpec struct {
+	GenericApplicationSpec
+	// Input for Step Functions deployment such as where to fetch the state machine manifest...
+	Input StepFunctionsDeploymentInput `json:"input"`
+	// Configuration for quick sync.
+	QuickSync StepFunctionsSyncStageOptions `json:"quickSync"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (s *StepFunctionsApplicationSpec) Validate() error {
+	if err := s.GenericApplicationSpec.Validate(); err != nil {
+		return err
+	}
+	if s.Pipeline != nil {
+		hasCanaryRollout := false
+		for _, stage := range s.Pipeline.Stages {
+			switch {
+			case stage.StepFunctionsCanaryRolloutStageOptions != nil:
+				hasCanaryRollout = true
+			case stage.StepFunctionsPromoteStageOptions != nil:
+				if !hasCanaryRollout {
+					return fmt.Errorf("%s stage must be placed after %s stage", model.StageStepFunctionsPromote, model.StageStepFunctionsCanaryRollout)
+				}
+				if err := stage.StepFunctionsPromoteStageOptions.Validate(); err != nil {
+					return err
+				}
+			case stage.StepFunctionsTrafficRoutingStageOptions != nil:
+				if !hasCanaryRollout {
+					return fmt.Errorf("%s stage must be placed after %s stage", model.StageStepFunctionsTrafficRouting, model.StageStepFunctionsCanaryRollout)
+				}
+				if err := stage.StepFunctionsTrafficRoutingStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+type StepFunctionsDeploymentInput struct {
+	// The name of state machine manifest file placing in application directory.
+	// Default is statemachine.yaml
+	StateMachineManifestFile string `json:"stateMachineManifestFile" default:"statemachine.yaml"`
+	// Automatically reverts all changes from all stages when one of them failed.
+	// Default is true.
+	AutoRollback *bool `json:"autoRollback,omitempty" default:"true"`
+}
+
+// StepFunctionsSyncStageOptions contains all configurable values for a STEPFUNCTIONS_SYNC stage.
+type StepFunctionsSyncStageOptions struct {
+}
+
+// StepFunctionsCanaryRolloutStageOptions contains all configurable values for a STEPFUNCTIONS_CANARY_ROLLOUT stage.
+type StepFunctionsCanaryRolloutStageOptions struct {
+}
+
+// StepFunctionsPromoteStageOptions contains all configurable values for a STEPFUNCTIONS_PROMOTE stage.
+type StepFunctionsPromoteStageOptions struct {
+	// Percentage of executions should be routed to the new version.
+	Percent Percentage `json:"percent"`
+}
+
+func (o *StepFunctionsPromoteStageOptions) Validate() error {
+	if percent := o.Percent.Int(); percent < 0 || percent > 100 {
+		return fmt.Errorf("percent %d of %s stage should be in range [0, 100]", percent, model.StageStepFunctionsPromote)
+	}
+	return nil
+}
+
+// StepFunctionsTrafficRoutingStageOptions contains all configurable values for a STEPFUNCTIONS_TRAFFIC_ROUTING stage.
+type StepFunctionsTrafficRoutingStageOptions struct {
+	// List of the percentages of executions routed to the new version at each step, e.g. [10, 50, 100].
+	// The alias weights are shifted step by step in the given order.
+	Steps []Percentage `json:"steps"`
+	// How long to wait after shifting the executions before the next step.
+	// Default is 1m.
+	Interval Duration `json:"interval" default:"1m"`
+}
+
+func (o *StepFunctionsTrafficRoutingStageOptions) Validate() error {
+	if len(o.Steps) == 0 {
+		return fmt.Errorf("steps must be specified for %s stage", model.StageStepFunctionsTrafficRouting)
+	}
+	prev := 0
+	for _, p := range o.Steps {
+		if p.Int() <= prev || p.Int() > 100 {
+			return fmt.Errorf("steps of %s stage must be increasing percentages up to 100", model.StageStepFunctionsTrafficRouting)
+		}
+		prev = p.Int()
+	}
+	if o.Interval < 0 {
+		return fmt.Errorf("interval of %s stage must not be negative", model.StageStepFunctionsTrafficRouting)
+	}
+	return nil
+}
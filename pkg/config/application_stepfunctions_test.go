@@ -0,0 +1,143 @@
+// Copyright 2023 The PipeCD Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
+func TestStepFunctionsApplicationConfig(t *testing.T) {
+	testcases := []struct {
+		fileName           string
+		expectedKind       Kind
+		expectedAPIVersion string
+		expectedSpec       interface{}
+		expectedError      error
+	}{
+		{
+			fileName:           "testdata/application/stepfunctions-app.yaml",
+			expectedKind:       KindStepFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &StepFunctionsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: StepFunctionsDeploymentInput{
+					StateMachineManifestFile: "statemachine.yaml",
+					AutoRollback:             newBoolPointer(true),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/stepfunctions-app-canary.yaml",
+			expectedKind:       KindStepFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &StepFunctionsApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Timeout: Duration(6 * time.Hour),
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                                   model.StageStepFunctionsCanaryRollout,
+								StepFunctionsCanaryRolloutStageOptions: &StepFunctionsCanaryRolloutStageOptions{},
+							},
+							{
+								Name: model.StageStepFunctionsTrafficRouting,
+								StepFunctionsTrafficRoutingStageOptions: &StepFunctionsTrafficRoutingStageOptions{
+									Steps: []Percentage{
+										{Number: 10},
+										{Number: 50},
+									},
+									Interval: Duration(5 * time.Minute),
+								},
+							},
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageStepFunctionsPromote,
+								StepFunctionsPromoteStageOptions: &StepFunctionsPromoteStageOptions{
+									Percent: Percentage{
+										Number: 100,
+									},
+								},
+							},
+						},
+					},
+					Trigger: Trigger{
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: StepFunctionsDeploymentInput{
+					StateMachineManifestFile: "statemachine.yaml",
+					AutoRollback:             newBoolPointer(false),
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/stepfunctions-app-promote-without-canary.yaml",
+			expectedKind:       KindStepFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("STEPFUNCTIONS_PROMOTE stage must be placed after STEPFUNCTIONS_CANARY_ROLLOUT stage"),
+		},
+		{
+			fileName:           "testdata/application/stepfunctions-app-invalid-traffic-routing.yaml",
+			expectedKind:       KindStepFunctionsApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec:       nil,
+			expectedError:      fmt.Errorf("steps of STEPFUNCTIONS_TRAFFIC_ROUTING stage must be increasing percentages up to 100"),
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.fileName, func(t *testing.T) {
+			cfg, err := LoadFromYAML(tc.fileName)
+			require.Equal(t, tc.expectedError, err)
+			if err == nil {
+				assert.Equal(t, tc.expectedKind, cfg.Kind)
+				assert.Equal(t, tc.expectedAPIVersion, cfg.APIVersion)
+				assert.Equal(t, tc.expectedSpec, cfg.spec)
+			}
+		})
+	}
+}
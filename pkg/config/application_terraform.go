@@ -14,6 +14,12 @@
 
 package config
 
+import (
+	"fmt"
+
+	"github.com/pipe-cd/pipecd/pkg/model"
+)
+
 // TerraformApplicationSpec represents an application configuration for Terraform application.
 type TerraformApplicationSpec struct {
 	GenericApplicationSpec
@@ -28,16 +34,48 @@ func (s *TerraformApplicationSpec) Validate() error {
 	if err := s.GenericApplicationSpec.Validate(); err != nil {
 		return err
 	}
+	for name, secret := range s.Input.SecretVars {
+		if s.Encryption == nil {
+			return fmt.Errorf("encryption must be configured to use secretVars")
+		}
+		if _, ok := s.Encryption.EncryptedSecrets[secret]; !ok {
+			return fmt.Errorf("secret %q of variable %q was not found in encryptedSecrets", secret, name)
+		}
+	}
+	if s.Pipeline != nil {
+		for _, stage := range s.Pipeline.Stages {
+			if stage.Name == model.StageTerraformDestroy {
+				return fmt.Errorf("%s stage can not be used in the pipeline, it is run only by the destroy deployment", stage.Name)
+			}
+			if stage.TerraformCostEstimationStageOptions != nil {
+				if err := stage.TerraformCostEstimationStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+			if stage.TerraformImportStageOptions != nil {
+				if err := stage.TerraformImportStageOptions.Validate(); err != nil {
+					return err
+				}
+			}
+		}
+	}
 	return nil
 }
 
 type TerraformDeploymentInput struct {
 	// The terraform workspace name.
+	// The workspace is created before planning if it does not exist.
 	// Empty means "default" workpsace.
 	Workspace string `json:"workspace,omitempty"`
 	// The version of terraform should be used.
 	// Empty means the pre-installed version will be used.
 	TerraformVersion string `json:"terraformVersion,omitempty"`
+	// Whether to run the terraform commands through terragrunt in the application directory.
+	// Default is false.
+	Terragrunt bool `json:"terragrunt,omitempty"`
+	// The version of terragrunt should be used when terragrunt is enabled.
+	// Empty means the pre-installed version will be used.
+	TerragruntVersion string `json:"terragruntVersion,omitempty"`
 	// List of variables that will be set directly on terraform commands with "-var" flag.
 	// The variable must be formatted by "key=value" as below:
 	// "image_id=ami-abc123"
@@ -46,6 +84,18 @@ type TerraformDeploymentInput struct {
 	Vars []string `json:"vars,omitempty"`
 	// List of variable files that will be set on terraform commands with "-var-file" flag.
 	VarFiles []string `json:"varFiles,omitempty"`
+	// Map of variables whose values are the secrets encrypted by piped's secret management.
+	// The key is the name of the variable and the value is the key of the secret in encryption.encryptedSecrets.
+	// The secrets are decrypted at execution time and passed through "TF_VAR_" environment variables.
+	SecretVars map[string]string `json:"secretVars,omitempty"`
+	// Map of the backend configuration values passed to "terraform init" with "-backend-config" flag.
+	// The values can be templated with the application data such as "{{ .App.Labels.env }}/{{ .App.Name }}"
+	// to keep the state of each environment isolated without writing the backend files.
+	BackendConfig map[string]string `json:"backendConfig,omitempty"`
+	// How long to wait for the state lock held by another operation before failing.
+	// The deployments of the same piped waiting for the same lock are queued in order.
+	// Default is 10m.
+	StateLockTimeout Duration `json:"stateLockTimeout,omitempty"`
 	// Automatically reverts all changes from all stages when one of them failed.
 	// Default is false.
 	AutoRollback bool `json:"autoRollback"`
@@ -65,12 +115,81 @@ type TerraformSyncStageOptions struct {
 type TerraformPlanStageOptions struct {
 	// Exit the pipeline if the result is "No Changes" with success status.
 	ExitOnNoChanges bool `json:"exitOnNoChanges"`
+	// List of resource addresses to limit the plan to.
+	// Empty means all resources in the module are planned.
+	Targets []string `json:"targets,omitempty"`
 }
 
 // TerraformApplyStageOptions contains all configurable values for a TERRAFORM_APPLY stage.
 type TerraformApplyStageOptions struct {
 	// How many times to retry applying terraform changes.
 	Retries int `json:"retries"`
+	// List of resource addresses to limit the apply to.
+	// Empty means all resources in the module are applied.
+	// This is ignored when usePlanFile is enabled.
+	Targets []string `json:"targets,omitempty"`
+	// Whether to apply the plan saved by the previous TERRAFORM_PLAN stage
+	// instead of planning again, so that exactly the approved changes are applied.
+	// Default is false.
+	UsePlanFile bool `json:"usePlanFile,omitempty"`
+}
+
+// TerraformCostEstimationStageOptions contains all configurable values for a TERRAFORM_COST_ESTIMATION stage.
+type TerraformCostEstimationStageOptions struct {
+	// The version of infracost should be used.
+	// Empty means the pre-installed version will be used.
+	InfracostVersion string `json:"infracostVersion,omitempty"`
+	// The maximum increase of the monthly cost allowed for the changes.
+	// The stage fails when the estimated increase exceeds this value.
+	// Zero means no limit.
+	MaxMonthlyCostIncrease float64 `json:"maxMonthlyCostIncrease,omitempty"`
+}
+
+// Validate returns an error if any wrong configuration value was found.
+func (o *TerraformCostEstimationStageOptions) Validate() error {
+	if o.MaxMonthlyCostIncrease < 0 {
+		return fmt.Errorf("maxMonthlyCostIncrease must not be negative")
+	}
+	return nil
+}
+
+// TerraformPolicyCheckStageOptions contains all configurable values for a TERRAFORM_POLICY_CHECK stage.
+type TerraformPolicyCheckStageOptions struct {
+	// List of directories containing OPA policies written in Rego.
+	// The paths are relative to the application directory.
+	Policies []string `json:"policies"`
+}
+
+// TerraformImportStageOptions contains all configurable values for a TERRAFORM_IMPORT stage.
+type TerraformImportStageOptions struct {
+	// List of the existing resources to be imported into the state.
+	// The resources already managed in the state are skipped.
+	Resources []TerraformImportResource `json:"resources"`
+}
+
+// TerraformImportResource represents an existing resource to be imported.
+type TerraformImportResource struct {
+	// The address in the module to import the resource to, e.g. aws_s3_bucket.logs.
+	Address string `json:"address"`
+	// The provider-specific ID of the existing resource.
+	ID string `json:"id"`
+}
+
+func (o *TerraformImportStageOptions) Validate() error {
+	if len(o.Resources) == 0 {
+		return fmt.Errorf("resources must be specified for %s stage", model.StageTerraformImport)
+	}
+	addresses := make(map[string]struct{}, len(o.Resources))
+	for _, r := range o.Resources {
+		if r.Address == "" || r.ID == "" {
+			return fmt.Errorf("both address and id must be specified for the resource to import")
+		}
+		if _, ok := addresses[r.Address]; ok {
+			return fmt.Errorf("resource %s is imported more than once", r.Address)
+		}
+		addresses[r.Address] = struct{}{}
+	}
+	return nil
 }
 
 // TerraformCommandFlags contains all additional flags will be used while executing terraform commands.
@@ -229,6 +229,103 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 			},
 			expectedError: nil,
 		},
+		{
+			fileName:           "testdata/application/terraform-app-with-cost-estimation.yaml",
+			expectedKind:       KindTerraformApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &TerraformApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name:                      model.StageTerraformPlan,
+								TerraformPlanStageOptions: &TerraformPlanStageOptions{},
+							},
+							{
+								Name: model.StageTerraformCostEstimation,
+								TerraformCostEstimationStageOptions: &TerraformCostEstimationStageOptions{
+									InfracostVersion:       "0.10.29",
+									MaxMonthlyCostIncrease: 100.5,
+								},
+							},
+							{
+								Name:                       model.StageTerraformApply,
+								TerraformApplyStageOptions: &TerraformApplyStageOptions{},
+							},
+						},
+					},
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: TerraformDeploymentInput{
+					TerraformVersion: "1.5.7",
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			fileName:           "testdata/application/terraform-app-with-import.yaml",
+			expectedKind:       KindTerraformApp,
+			expectedAPIVersion: "pipecd.dev/v1beta1",
+			expectedSpec: &TerraformApplicationSpec{
+				GenericApplicationSpec: GenericApplicationSpec{
+					Pipeline: &DeploymentPipeline{
+						Stages: []PipelineStage{
+							{
+								Name: model.StageWaitApproval,
+								WaitApprovalStageOptions: &WaitApprovalStageOptions{
+									Timeout:        Duration(6 * time.Hour),
+									MinApproverNum: 1,
+								},
+							},
+							{
+								Name: model.StageTerraformImport,
+								TerraformImportStageOptions: &TerraformImportStageOptions{
+									Resources: []TerraformImportResource{
+										{
+											Address: "aws_s3_bucket.logs",
+											ID:      "my-logs-bucket",
+										},
+									},
+								},
+							},
+						},
+					},
+					Timeout: Duration(6 * time.Hour),
+					Trigger: Trigger{
+						OnCommit: OnCommit{
+							Disabled: false,
+						},
+						OnCommand: OnCommand{
+							Disabled: false,
+						},
+						OnOutOfSync: OnOutOfSync{
+							Disabled:  newBoolPointer(true),
+							MinWindow: Duration(5 * time.Minute),
+						},
+						OnChain: OnChain{
+							Disabled: newBoolPointer(true),
+						},
+					},
+				},
+				Input: TerraformDeploymentInput{},
+			},
+			expectedError: nil,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.fileName, func(t *testing.T) {
@@ -242,3 +339,50 @@ func TestTerraformApplicationtConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestTerraformImportStageOptionsValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name      string
+		resources []TerraformImportResource
+		wantErr   bool
+	}{
+		{
+			name: "valid",
+			resources: []TerraformImportResource{
+				{Address: "aws_s3_bucket.logs", ID: "my-logs-bucket"},
+				{Address: "aws_s3_bucket.assets", ID: "my-assets-bucket"},
+			},
+			wantErr: false,
+		},
+		{
+			name:      "no resources",
+			resources: nil,
+			wantErr:   true,
+		},
+		{
+			name: "missing id",
+			resources: []TerraformImportResource{
+				{Address: "aws_s3_bucket.logs"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "duplicated address",
+			resources: []TerraformImportResource{
+				{Address: "aws_s3_bucket.logs", ID: "my-logs-bucket"},
+				{Address: "aws_s3_bucket.logs", ID: "my-other-bucket"},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			o := &TerraformImportStageOptions{Resources: tc.resources}
+			assert.Equal(t, tc.wantErr, o.Validate() != nil)
+		})
+	}
+}
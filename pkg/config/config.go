@@ -49,6 +49,32 @@ const (
 	KindCloudRunApp Kind = "CloudRunApp"
 	// KindECSApp represents application configuration for an AWS ECS.
 	KindECSApp Kind = "ECSApp"
+	// KindAppRunnerApp represents application configuration for an AWS App Runner application.
+	KindAppRunnerApp Kind = "AppRunnerApp"
+	// KindCloudFormationApp represents application configuration for an AWS CloudFormation stack.
+	KindCloudFormationApp Kind = "CloudFormationApp"
+	// KindNomadApp represents application configuration for a HashiCorp Nomad job.
+	KindNomadApp Kind = "NomadApp"
+	// KindContainerAppsApp represents application configuration for an Azure Container App.
+	KindContainerAppsApp Kind = "ContainerAppsApp"
+	// KindAppEngineApp represents application configuration for Google App Engine application.
+	KindAppEngineApp Kind = "AppEngineApp"
+	// KindStepFunctionsApp represents application configuration for AWS Step Functions application.
+	KindStepFunctionsApp Kind = "StepFunctionsApp"
+	// KindEC2ASGApp represents application configuration for AWS EC2 Auto Scaling Group application.
+	KindEC2ASGApp Kind = "EC2ASGApp"
+	// KindGCEMIGApp represents application configuration for GCE Managed Instance Group application.
+	KindGCEMIGApp Kind = "GCEMIGApp"
+	// KindAzureFunctionsApp represents application configuration for Azure Functions.
+	KindAzureFunctionsApp Kind = "AzureFunctionsApp"
+	// KindStaticSiteApp represents application configuration for Static Site.
+	KindStaticSiteApp Kind = "StaticSiteApp"
+	// KindDockerComposeApp represents application configuration for Docker Compose.
+	KindDockerComposeApp Kind = "DockerComposeApp"
+	// KindKnativeApp represents application configuration for Knative.
+	KindKnativeApp Kind = "KnativeApp"
+	// KindFlyIOApp represents application configuration for Fly.io.
+	KindFlyIOApp Kind = "FlyIOApp"
 )
 
 const (
@@ -76,11 +102,24 @@ type Config struct {
 	APIVersion string
 	spec       interface{}
 
-	KubernetesApplicationSpec *KubernetesApplicationSpec
-	TerraformApplicationSpec  *TerraformApplicationSpec
-	CloudRunApplicationSpec   *CloudRunApplicationSpec
-	LambdaApplicationSpec     *LambdaApplicationSpec
-	ECSApplicationSpec        *ECSApplicationSpec
+	KubernetesApplicationSpec     *KubernetesApplicationSpec
+	TerraformApplicationSpec      *TerraformApplicationSpec
+	CloudRunApplicationSpec       *CloudRunApplicationSpec
+	LambdaApplicationSpec         *LambdaApplicationSpec
+	ECSApplicationSpec            *ECSApplicationSpec
+	AppRunnerApplicationSpec      *AppRunnerApplicationSpec
+	CloudFormationApplicationSpec *CloudFormationApplicationSpec
+	NomadApplicationSpec          *NomadApplicationSpec
+	ContainerAppsApplicationSpec  *ContainerAppsApplicationSpec
+	AppEngineApplicationSpec      *AppEngineApplicationSpec
+	StepFunctionsApplicationSpec  *StepFunctionsApplicationSpec
+	EC2ASGApplicationSpec         *EC2ASGApplicationSpec
+	GCEMIGApplicationSpec         *GCEMIGApplicationSpec
+	AzureFunctionsApplicationSpec *AzureFunctionsApplicationSpec
+	StaticSiteApplicationSpec     *StaticSiteApplicationSpec
+	DockerComposeApplicationSpec  *DockerComposeApplicationSpec
+	KnativeApplicationSpec        *KnativeApplicationSpec
+	FlyIOApplicationSpec          *FlyIOApplicationSpec
 
 	PipedSpec            *PipedSpec
 	ControlPlaneSpec     *ControlPlaneSpec
@@ -119,6 +158,58 @@ func (c *Config) init(kind Kind, apiVersion string) error {
 		c.ECSApplicationSpec = &ECSApplicationSpec{}
 		c.spec = c.ECSApplicationSpec
 
+	case KindAppRunnerApp:
+		c.AppRunnerApplicationSpec = &AppRunnerApplicationSpec{}
+		c.spec = c.AppRunnerApplicationSpec
+
+	case KindCloudFormationApp:
+		c.CloudFormationApplicationSpec = &CloudFormationApplicationSpec{}
+		c.spec = c.CloudFormationApplicationSpec
+
+	case KindNomadApp:
+		c.NomadApplicationSpec = &NomadApplicationSpec{}
+		c.spec = c.NomadApplicationSpec
+
+	case KindContainerAppsApp:
+		c.ContainerAppsApplicationSpec = &ContainerAppsApplicationSpec{}
+		c.spec = c.ContainerAppsApplicationSpec
+
+	case KindAppEngineApp:
+		c.AppEngineApplicationSpec = &AppEngineApplicationSpec{}
+		c.spec = c.AppEngineApplicationSpec
+
+	case KindStepFunctionsApp:
+		c.StepFunctionsApplicationSpec = &StepFunctionsApplicationSpec{}
+		c.spec = c.StepFunctionsApplicationSpec
+
+	case KindEC2ASGApp:
+		c.EC2ASGApplicationSpec = &EC2ASGApplicationSpec{}
+		c.spec = c.EC2ASGApplicationSpec
+
+	case KindGCEMIGApp:
+		c.GCEMIGApplicationSpec = &GCEMIGApplicationSpec{}
+		c.spec = c.GCEMIGApplicationSpec
+
+	case KindAzureFunctionsApp:
+		c.AzureFunctionsApplicationSpec = &AzureFunctionsApplicationSpec{}
+		c.spec = c.AzureFunctionsApplicationSpec
+
+	case KindStaticSiteApp:
+		c.StaticSiteApplicationSpec = &StaticSiteApplicationSpec{}
+		c.spec = c.StaticSiteApplicationSpec
+
+	case KindDockerComposeApp:
+		c.DockerComposeApplicationSpec = &DockerComposeApplicationSpec{}
+		c.spec = c.DockerComposeApplicationSpec
+
+	case KindKnativeApp:
+		c.KnativeApplicationSpec = &KnativeApplicationSpec{}
+		c.spec = c.KnativeApplicationSpec
+
+	case KindFlyIOApp:
+		c.FlyIOApplicationSpec = &FlyIOApplicationSpec{}
+		c.spec = c.FlyIOApplicationSpec
+
 	case KindPiped:
 		c.PipedSpec = &PipedSpec{}
 		c.spec = c.PipedSpec
@@ -234,6 +325,32 @@ func (k Kind) ToApplicationKind() (model.ApplicationKind, bool) {
 		return model.ApplicationKind_CLOUDRUN, true
 	case KindECSApp:
 		return model.ApplicationKind_ECS, true
+	case KindAppRunnerApp:
+		return model.ApplicationKind_APPRUNNER, true
+	case KindCloudFormationApp:
+		return model.ApplicationKind_CLOUDFORMATION, true
+	case KindNomadApp:
+		return model.ApplicationKind_NOMAD, true
+	case KindContainerAppsApp:
+		return model.ApplicationKind_CONTAINERAPPS, true
+	case KindAppEngineApp:
+		return model.ApplicationKind_APPENGINE, true
+	case KindStepFunctionsApp:
+		return model.ApplicationKind_STEPFUNCTIONS, true
+	case KindEC2ASGApp:
+		return model.ApplicationKind_EC2ASG, true
+	case KindGCEMIGApp:
+		return model.ApplicationKind_GCEMIG, true
+	case KindAzureFunctionsApp:
+		return model.ApplicationKind_AZUREFUNCTIONS, true
+	case KindStaticSiteApp:
+		return model.ApplicationKind_STATICSITE, true
+	case KindDockerComposeApp:
+		return model.ApplicationKind_DOCKERCOMPOSE, true
+	case KindKnativeApp:
+		return model.ApplicationKind_KNATIVE, true
+	case KindFlyIOApp:
+		return model.ApplicationKind_FLYIO, true
 	}
 	return model.ApplicationKind_KUBERNETES, false
 }
@@ -250,6 +367,32 @@ func (c *Config) GetGenericApplication() (GenericApplicationSpec, bool) {
 		return c.LambdaApplicationSpec.GenericApplicationSpec, true
 	case KindECSApp:
 		return c.ECSApplicationSpec.GenericApplicationSpec, true
+	case KindAppRunnerApp:
+		return c.AppRunnerApplicationSpec.GenericApplicationSpec, true
+	case KindCloudFormationApp:
+		return c.CloudFormationApplicationSpec.GenericApplicationSpec, true
+	case KindNomadApp:
+		return c.NomadApplicationSpec.GenericApplicationSpec, true
+	case KindContainerAppsApp:
+		return c.ContainerAppsApplicationSpec.GenericApplicationSpec, true
+	case KindAppEngineApp:
+		return c.AppEngineApplicationSpec.GenericApplicationSpec, true
+	case KindStepFunctionsApp:
+		return c.StepFunctionsApplicationSpec.GenericApplicationSpec, true
+	case KindEC2ASGApp:
+		return c.EC2ASGApplicationSpec.GenericApplicationSpec, true
+	case KindGCEMIGApp:
+		return c.GCEMIGApplicationSpec.GenericApplicationSpec, true
+	case KindAzureFunctionsApp:
+		return c.AzureFunctionsApplicationSpec.GenericApplicationSpec, true
+	case KindStaticSiteApp:
+		return c.StaticSiteApplicationSpec.GenericApplicationSpec, true
+	case KindDockerComposeApp:
+		return c.DockerComposeApplicationSpec.GenericApplicationSpec, true
+	case KindKnativeApp:
+		return c.KnativeApplicationSpec.GenericApplicationSpec, true
+	case KindFlyIOApp:
+		return c.FlyIOApplicationSpec.GenericApplicationSpec, true
 	}
 	return GenericApplicationSpec{}, false
 }
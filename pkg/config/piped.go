@@ -149,6 +149,23 @@ func (s *PipedSpec) Validate() error {
 			return err
 		}
 	}
+	for _, p := range s.PlatformProviders {
+		if p.KubernetesConfig != nil {
+			if err := p.KubernetesConfig.Validate(); err != nil {
+				return err
+			}
+		}
+		if p.DockerComposeConfig != nil {
+			if err := p.DockerComposeConfig.Validate(); err != nil {
+				return err
+			}
+		}
+		if p.FlyIOConfig != nil {
+			if err := p.FlyIOConfig.Validate(); err != nil {
+				return err
+			}
+		}
+	}
 	return nil
 }
 
@@ -373,21 +390,28 @@ type HelmChartRepositoryType string
 const (
 	HTTPHelmChartRepository HelmChartRepositoryType = "HTTP"
 	GITHelmChartRepository  HelmChartRepositoryType = "GIT"
+	OCIHelmChartRepository  HelmChartRepositoryType = "OCI"
 )
 
+// ociScheme is the scheme of the address of the OCI chart repository.
+const ociScheme = "oci://"
+
 type HelmChartRepository struct {
-	// The repository type. Currently, HTTP and GIT are supported.
+	// The repository type. Currently, HTTP, GIT and OCI are supported.
 	// Default is HTTP.
 	Type HelmChartRepositoryType `json:"type" default:"HTTP"`
 
-	// Configuration for HTTP type.
+	// Configuration for HTTP and OCI types.
 	// The name of the Helm chart repository.
 	Name string `json:"name,omitempty"`
 	// The address to the Helm chart repository.
+	// e.g. oci://ghcr.io/org/charts for OCI type.
 	Address string `json:"address,omitempty"`
-	// Username used for the repository backed by HTTP basic authentication.
+	// Username used for the repository backed by HTTP basic authentication,
+	// or used to login to the registry of OCI type.
 	Username string `json:"username,omitempty"`
-	// Password used for the repository backed by HTTP basic authentication.
+	// Password used for the repository backed by HTTP basic authentication,
+	// or used to login to the registry of OCI type.
 	Password string `json:"password,omitempty"`
 	// Whether to skip TLS certificate checks for the repository or not.
 	Insecure bool `json:"insecure"`
@@ -408,6 +432,10 @@ func (r *HelmChartRepository) IsGitRepository() bool {
 	return r.Type == GITHelmChartRepository
 }
 
+func (r *HelmChartRepository) IsOCIRepository() bool {
+	return r.Type == OCIHelmChartRepository
+}
+
 func (r *HelmChartRepository) Validate() error {
 	if r.IsHTTPRepository() {
 		if r.Name == "" {
@@ -426,7 +454,17 @@ func (r *HelmChartRepository) Validate() error {
 		return nil
 	}
 
-	return fmt.Errorf("either %s repository or %s repository must be configured", HTTPHelmChartRepository, GITHelmChartRepository)
+	if r.IsOCIRepository() {
+		if r.Name == "" {
+			return errors.New("name must be set")
+		}
+		if !strings.HasPrefix(r.Address, ociScheme) {
+			return fmt.Errorf("address must start with %s", ociScheme)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("one of %s, %s or %s repository must be configured", HTTPHelmChartRepository, GITHelmChartRepository, OCIHelmChartRepository)
 }
 
 func (r *HelmChartRepository) Mask() {
@@ -458,6 +496,27 @@ func (s *PipedSpec) GitHelmChartRepositories() []HelmChartRepository {
 	return repos
 }
 
+func (s *PipedSpec) OCIHelmChartRepositories() []HelmChartRepository {
+	repos := make([]HelmChartRepository, 0, len(s.ChartRepositories))
+	for _, r := range s.ChartRepositories {
+		if r.IsOCIRepository() {
+			repos = append(repos, r)
+		}
+	}
+	return repos
+}
+
+// OCIChartRepositoryAddress returns the address of the OCI chart repository of the given name.
+// Empty is returned when no such repository was configured.
+func (s *PipedSpec) OCIChartRepositoryAddress(name string) string {
+	for _, cr := range s.ChartRepositories {
+		if cr.Name == name && cr.IsOCIRepository() {
+			return cr.Address
+		}
+	}
+	return ""
+}
+
 type HelmChartRegistryType string
 
 // The registry types that hosts Helm charts.
@@ -503,11 +562,24 @@ type PipedPlatformProvider struct {
 	Type   model.PlatformProviderType `json:"type"`
 	Labels map[string]string          `json:"labels,omitempty"`
 
-	KubernetesConfig *PlatformProviderKubernetesConfig
-	TerraformConfig  *PlatformProviderTerraformConfig
-	CloudRunConfig   *PlatformProviderCloudRunConfig
-	LambdaConfig     *PlatformProviderLambdaConfig
-	ECSConfig        *PlatformProviderECSConfig
+	KubernetesConfig     *PlatformProviderKubernetesConfig
+	TerraformConfig      *PlatformProviderTerraformConfig
+	CloudRunConfig       *PlatformProviderCloudRunConfig
+	LambdaConfig         *PlatformProviderLambdaConfig
+	ECSConfig            *PlatformProviderECSConfig
+	AppRunnerConfig      *PlatformProviderAppRunnerConfig
+	CloudFormationConfig *PlatformProviderCloudFormationConfig
+	NomadConfig          *PlatformProviderNomadConfig
+	ContainerAppsConfig  *PlatformProviderContainerAppsConfig
+	AppEngineConfig      *PlatformProviderAppEngineConfig
+	StepFunctionsConfig  *PlatformProviderStepFunctionsConfig
+	EC2ASGConfig         *PlatformProviderEC2ASGConfig
+	GCEMIGConfig         *PlatformProviderGCEMIGConfig
+	AzureFunctionsConfig *PlatformProviderAzureFunctionsConfig
+	StaticSiteConfig     *PlatformProviderStaticSiteConfig
+	DockerComposeConfig  *PlatformProviderDockerComposeConfig
+	KnativeConfig        *PlatformProviderKnativeConfig
+	FlyIOConfig          *PlatformProviderFlyIOConfig
 }
 
 type genericPipedPlatformProvider struct {
@@ -534,6 +606,32 @@ func (p *PipedPlatformProvider) MarshalJSON() ([]byte, error) {
 		config, err = json.Marshal(p.LambdaConfig)
 	case model.PlatformProviderECS:
 		config, err = json.Marshal(p.ECSConfig)
+	case model.PlatformProviderAppRunner:
+		config, err = json.Marshal(p.AppRunnerConfig)
+	case model.PlatformProviderCloudFormation:
+		config, err = json.Marshal(p.CloudFormationConfig)
+	case model.PlatformProviderNomad:
+		config, err = json.Marshal(p.NomadConfig)
+	case model.PlatformProviderContainerApps:
+		config, err = json.Marshal(p.ContainerAppsConfig)
+	case model.PlatformProviderAppEngine:
+		config, err = json.Marshal(p.AppEngineConfig)
+	case model.PlatformProviderStepFunctions:
+		config, err = json.Marshal(p.StepFunctionsConfig)
+	case model.PlatformProviderEC2ASG:
+		config, err = json.Marshal(p.EC2ASGConfig)
+	case model.PlatformProviderGCEMIG:
+		config, err = json.Marshal(p.GCEMIGConfig)
+	case model.PlatformProviderAzureFunctions:
+		config, err = json.Marshal(p.AzureFunctionsConfig)
+	case model.PlatformProviderStaticSite:
+		config, err = json.Marshal(p.StaticSiteConfig)
+	case model.PlatformProviderDockerCompose:
+		config, err = json.Marshal(p.DockerComposeConfig)
+	case model.PlatformProviderKnative:
+		config, err = json.Marshal(p.KnativeConfig)
+	case model.PlatformProviderFlyIO:
+		config, err = json.Marshal(p.FlyIOConfig)
 	default:
 		err = fmt.Errorf("unsupported platform provider type: %s", p.Name)
 	}
@@ -586,6 +684,71 @@ func (p *PipedPlatformProvider) UnmarshalJSON(data []byte) error {
 		if len(gp.Config) > 0 {
 			err = json.Unmarshal(gp.Config, p.ECSConfig)
 		}
+	case model.PlatformProviderAppRunner:
+		p.AppRunnerConfig = &PlatformProviderAppRunnerConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.AppRunnerConfig)
+		}
+	case model.PlatformProviderCloudFormation:
+		p.CloudFormationConfig = &PlatformProviderCloudFormationConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.CloudFormationConfig)
+		}
+	case model.PlatformProviderNomad:
+		p.NomadConfig = &PlatformProviderNomadConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.NomadConfig)
+		}
+	case model.PlatformProviderContainerApps:
+		p.ContainerAppsConfig = &PlatformProviderContainerAppsConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.ContainerAppsConfig)
+		}
+	case model.PlatformProviderAppEngine:
+		p.AppEngineConfig = &PlatformProviderAppEngineConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.AppEngineConfig)
+		}
+	case model.PlatformProviderStepFunctions:
+		p.StepFunctionsConfig = &PlatformProviderStepFunctionsConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.StepFunctionsConfig)
+		}
+	case model.PlatformProviderEC2ASG:
+		p.EC2ASGConfig = &PlatformProviderEC2ASGConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.EC2ASGConfig)
+		}
+	case model.PlatformProviderGCEMIG:
+		p.GCEMIGConfig = &PlatformProviderGCEMIGConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.GCEMIGConfig)
+		}
+	case model.PlatformProviderAzureFunctions:
+		p.AzureFunctionsConfig = &PlatformProviderAzureFunctionsConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.AzureFunctionsConfig)
+		}
+	case model.PlatformProviderStaticSite:
+		p.StaticSiteConfig = &PlatformProviderStaticSiteConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.StaticSiteConfig)
+		}
+	case model.PlatformProviderDockerCompose:
+		p.DockerComposeConfig = &PlatformProviderDockerComposeConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.DockerComposeConfig)
+		}
+	case model.PlatformProviderKnative:
+		p.KnativeConfig = &PlatformProviderKnativeConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.KnativeConfig)
+		}
+	case model.PlatformProviderFlyIO:
+		p.FlyIOConfig = &PlatformProviderFlyIOConfig{}
+		if len(gp.Config) > 0 {
+			err = json.Unmarshal(gp.Config, p.FlyIOConfig)
+		}
 	default:
 		err = fmt.Errorf("unsupported platform provider type: %s", p.Name)
 	}
@@ -602,6 +765,42 @@ func (p *PipedPlatformProvider) Mask() {
 	if p.ECSConfig != nil {
 		p.ECSConfig.Mask()
 	}
+	if p.AppRunnerConfig != nil {
+		p.AppRunnerConfig.Mask()
+	}
+	if p.CloudFormationConfig != nil {
+		p.CloudFormationConfig.Mask()
+	}
+	if p.NomadConfig != nil {
+		p.NomadConfig.Mask()
+	}
+	if p.ContainerAppsConfig != nil {
+		p.ContainerAppsConfig.Mask()
+	}
+	if p.AppEngineConfig != nil {
+		p.AppEngineConfig.Mask()
+	}
+	if p.StepFunctionsConfig != nil {
+		p.StepFunctionsConfig.Mask()
+	}
+	if p.EC2ASGConfig != nil {
+		p.EC2ASGConfig.Mask()
+	}
+	if p.GCEMIGConfig != nil {
+		p.GCEMIGConfig.Mask()
+	}
+	if p.AzureFunctionsConfig != nil {
+		p.AzureFunctionsConfig.Mask()
+	}
+	if p.StaticSiteConfig != nil {
+		p.StaticSiteConfig.Mask()
+	}
+	if p.DockerComposeConfig != nil {
+		p.DockerComposeConfig.Mask()
+	}
+	if p.FlyIOConfig != nil {
+		p.FlyIOConfig.Mask()
+	}
 }
 
 type PlatformProviderKubernetesConfig struct {
@@ -615,6 +814,49 @@ type PlatformProviderKubernetesConfig struct {
 	AppStateInformer KubernetesAppStateInformer `json:"appStateInformer"`
 	// Version of kubectl will be used.
 	KubectlVersion string `json:"kubectlVersion"`
+	// List of rules used to determine the health status of custom resources.
+	// A matching rule takes precedence over the built-in health assessment.
+	HealthRules []KubernetesResourceHealthRule `json:"healthRules,omitempty"`
+	// The identity used by kubectl while deploying applications.
+	// This can be overridden by the application configuration.
+	KubectlIdentity *KubernetesKubectlIdentity `json:"kubectlIdentity,omitempty"`
+	// List of directories on the piped host containing OPA policies written in Rego.
+	// Those policies are checked in every K8S_VALIDATE stage in addition to the ones in the application directory.
+	PolicyDirs []string `json:"policyDirs,omitempty"`
+}
+
+func (c *PlatformProviderKubernetesConfig) Validate() error {
+	for _, r := range c.HealthRules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+	if c.KubectlIdentity != nil {
+		if err := c.KubectlIdentity.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// KubernetesKubectlIdentity represents the identity of kubectl commands
+// so that the permissions of a deployment can be restricted by Kubernetes RBAC.
+type KubernetesKubectlIdentity struct {
+	// The name of the kubeconfig context to use.
+	// Empty means the current context of the kubeconfig.
+	Context string `json:"context,omitempty"`
+	// The user or service account to impersonate (--as).
+	// e.g. system:serviceaccount:team-a:piped-deployer
+	As string `json:"as,omitempty"`
+	// The groups to impersonate (--as-group).
+	AsGroups []string `json:"asGroups,omitempty"`
+}
+
+func (i *KubernetesKubectlIdentity) Validate() error {
+	if len(i.AsGroups) > 0 && i.As == "" {
+		return fmt.Errorf("kubectlIdentity.asGroups can be used only with kubectlIdentity.as")
+	}
+	return nil
 }
 
 type KubernetesAppStateInformer struct {
@@ -635,6 +877,41 @@ type KubernetesResourceMatcher struct {
 	Kind string `json:"kind,omitempty"`
 }
 
+type KubernetesResourceHealthRule struct {
+	// The APIVersion of the kubernetes resource.
+	APIVersion string `json:"apiVersion"`
+	// The kind name of the kubernetes resource.
+	Kind string `json:"kind"`
+	// List of checks that must all pass
+	// for the resource to be considered as healthy.
+	Checks []KubernetesResourceHealthCheck `json:"checks"`
+}
+
+func (r *KubernetesResourceHealthRule) Validate() error {
+	if r.APIVersion == "" || r.Kind == "" {
+		return errors.New("both apiVersion and kind must be set for healthRules")
+	}
+	if len(r.Checks) == 0 {
+		return fmt.Errorf("healthRules for %s/%s must contain at least one check", r.APIVersion, r.Kind)
+	}
+	for _, c := range r.Checks {
+		if (c.Field == "") == (c.Condition == "") {
+			return fmt.Errorf("healthRules for %s/%s: either field or condition must be set for each check", r.APIVersion, r.Kind)
+		}
+	}
+	return nil
+}
+
+type KubernetesResourceHealthCheck struct {
+	// The dot-separated path to the field to check, e.g. "status.phase".
+	Field string `json:"field,omitempty"`
+	// The type of the item in "status.conditions" to check, e.g. "Ready".
+	Condition string `json:"condition,omitempty"`
+	// The expected value of the field or the expected status of the condition.
+	// Empty means "True" for a condition check.
+	Value string `json:"value,omitempty"`
+}
+
 type PlatformProviderTerraformConfig struct {
 	// List of variables that will be set directly on terraform commands with "-var" flag.
 	// The variable must be formatted by "key=value" as below:
@@ -645,6 +922,16 @@ type PlatformProviderTerraformConfig struct {
 	// Enable drift detection.
 	// TODO: This is a temporary option because Terraform drift detection is buggy and has performance issues. This will be possibly removed in the future release.
 	DriftDetectionEnabled *bool `json:"driftDetectionEnabled" default:"true"`
+	// How often to run terraform plan to detect the drift of applications.
+	// Default is 10m.
+	DriftDetectionInterval Duration `json:"driftDetectionInterval,omitempty" default:"10m"`
+	// List of directories on the piped host containing OPA policies written in Rego.
+	// Those policies are checked in every TERRAFORM_POLICY_CHECK stage in addition to the ones in the application directory.
+	PolicyDirs []string `json:"policyDirs,omitempty"`
+	// Whether to install the terraform version matching "required_version" of the module
+	// when the application does not specify terraformVersion and none of the installed versions matches.
+	// Default is true.
+	AutoInstallRequiredVersion *bool `json:"autoInstallRequiredVersion" default:"true"`
 }
 
 type PlatformProviderCloudRunConfig struct {
@@ -706,6 +993,12 @@ type PlatformProviderECSConfig struct {
 	// If empty, the environment variable "AWS_PROFILE" is used.
 	// "default" is populated if the environment variable is also not set.
 	Profile string `json:"profile,omitempty"`
+	// The IAM role arn to assume by using the above credentials before sending requests.
+	// This is used to deploy to the ECS clusters in another AWS account.
+	// It can be overridden by the assumeRoleARN of each application.
+	AssumeRoleARN string `json:"assumeRoleARN,omitempty"`
+	// The external ID used when assuming the role of AssumeRoleARN.
+	ExternalID string `json:"externalID,omitempty"`
 }
 
 func (c *PlatformProviderECSConfig) Mask() {
@@ -718,6 +1011,394 @@ func (c *PlatformProviderECSConfig) Mask() {
 	if len(c.TokenFile) != 0 {
 		c.TokenFile = maskString
 	}
+	if len(c.AssumeRoleARN) != 0 {
+		c.AssumeRoleARN = maskString
+	}
+	if len(c.ExternalID) != 0 {
+		c.ExternalID = maskString
+	}
+}
+
+type PlatformProviderAppRunnerConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	// A full list of regions is: https://docs.aws.amazon.com/general/latest/gr/rande.html
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (c *PlatformProviderAppRunnerConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+	if len(c.RoleARN) != 0 {
+		c.RoleARN = maskString
+	}
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type PlatformProviderCloudFormationConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	// A full list of regions is: https://docs.aws.amazon.com/general/latest/gr/rande.html
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (c *PlatformProviderCloudFormationConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+	if len(c.RoleARN) != 0 {
+		c.RoleARN = maskString
+	}
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type PlatformProviderNomadConfig struct {
+	// The address of the Nomad HTTP API.
+	// If empty, the environment variable "NOMAD_ADDR" is used.
+	// "http://127.0.0.1:4646" is populated if the environment variable is also not set.
+	Address string `json:"address,omitempty"`
+	// The region of the jobs.
+	// Empty means the region of the agent receiving the requests.
+	Region string `json:"region,omitempty"`
+	// The namespace of the jobs not specifying their own namespace.
+	// Empty means the default namespace.
+	Namespace string `json:"namespace,omitempty"`
+	// Path to the file containing the ACL token.
+	// If empty, the environment variable "NOMAD_TOKEN" is used.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// Path to the PEM-encoded CA certificate to verify the Nomad server.
+	CACertFile string `json:"caCertFile,omitempty"`
+}
+
+func (c *PlatformProviderNomadConfig) Mask() {
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type PlatformProviderContainerAppsConfig struct {
+	// The ID of the subscription where the container apps are deployed.
+	SubscriptionID string `json:"subscriptionId"`
+	// The name of the resource group where the container apps are deployed.
+	ResourceGroup string `json:"resourceGroup"`
+	// The credentials used to call Azure Resource Manager API.
+	Credentials AzureCredentials `json:"credentials"`
+}
+
+func (c *PlatformProviderContainerAppsConfig) Mask() {
+	c.Credentials.Mask()
+}
+
+// AzureCredentials represents the identity used by piped to access Azure.
+type AzureCredentials struct {
+	// The type of the credentials.
+	// Must be one of "default", "clientSecret", "workloadIdentity" and "managedIdentity".
+	// "default" uses the client secret or the federated token file if specified,
+	// otherwise uses DefaultAzureCredential of Azure SDK.
+	// Empty means "default".
+	Type string `json:"type,omitempty"`
+	// The ID of the Microsoft Entra tenant of the application.
+	// If empty, the environment variable "AZURE_TENANT_ID" is used.
+	TenantID string `json:"tenantId,omitempty"`
+	// The client ID of the application or the user-assigned managed identity.
+	// If empty, the environment variable "AZURE_CLIENT_ID" is used.
+	ClientID string `json:"clientId,omitempty"`
+	// Path to the file containing the client secret of the application.
+	// This is required when the type is "clientSecret".
+	ClientSecretFile string `json:"clientSecretFile,omitempty"`
+	// Path to the file containing the token issued by the federated identity provider.
+	// If empty, the environment variable "AZURE_FEDERATED_TOKEN_FILE" is used.
+	FederatedTokenFile string `json:"federatedTokenFile,omitempty"`
+}
+
+func (c *AzureCredentials) Mask() {
+	if len(c.ClientSecretFile) != 0 {
+		c.ClientSecretFile = maskString
+	}
+	if len(c.FederatedTokenFile) != 0 {
+		c.FederatedTokenFile = maskString
+	}
+}
+
+type PlatformProviderAppEngineConfig struct {
+	// The GCP project hosting the App Engine application.
+	Project string `json:"project"`
+	// The path to the service account file for accessing App Engine.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The Cloud Storage bucket where the source files of the new versions are uploaded.
+	// Default is staging.{PROJECT}.appspot.com which is created together with the App Engine application.
+	StagingBucket string `json:"stagingBucket,omitempty"`
+}
+
+func (c *PlatformProviderAppEngineConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+}
+
+type PlatformProviderStepFunctionsConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	// A full list of regions is: https://docs.aws.amazon.com/general/latest/gr/rande.html
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (c *PlatformProviderStepFunctionsConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+	if len(c.RoleARN) != 0 {
+		c.RoleARN = maskString
+	}
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type PlatformProviderEC2ASGConfig struct {
+	// The region to send requests to. This parameter is required.
+	// e.g. "us-west-2"
+	// A full list of regions is: https://docs.aws.amazon.com/general/latest/gr/rande.html
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (c *PlatformProviderEC2ASGConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+	if len(c.RoleARN) != 0 {
+		c.RoleARN = maskString
+	}
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type PlatformProviderGCEMIGConfig struct {
+	// The GCP project hosting the managed instance groups.
+	Project string `json:"project"`
+	// The path to the service account file for accessing Compute Engine.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+func (c *PlatformProviderGCEMIGConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+}
+
+type PlatformProviderAzureFunctionsConfig struct {
+	// The ID of the subscription where the function apps are running.
+	SubscriptionID string `json:"subscriptionId"`
+	// The name of the resource group where the function apps are running.
+	ResourceGroup string `json:"resourceGroup"`
+	// The credentials used to call Azure Resource Manager API and to deploy the packages.
+	Credentials AzureCredentials `json:"credentials"`
+}
+
+func (c *PlatformProviderAzureFunctionsConfig) Mask() {
+	c.Credentials.Mask()
+}
+
+// PlatformProviderStaticSiteConfig represents the storage service hosting the static sites.
+// Either s3 or gcs must be specified.
+type PlatformProviderStaticSiteConfig struct {
+	// Configuration for the sites stored in Amazon S3 and served by CloudFront.
+	S3 *StaticSiteS3Config `json:"s3,omitempty"`
+	// Configuration for the sites stored in Cloud Storage and served by Cloud CDN.
+	GCS *StaticSiteGCSConfig `json:"gcs,omitempty"`
+}
+
+func (c *PlatformProviderStaticSiteConfig) Mask() {
+	if c.S3 != nil {
+		c.S3.Mask()
+	}
+	if c.GCS != nil {
+		c.GCS.Mask()
+	}
+}
+
+type StaticSiteS3Config struct {
+	// The region of the buckets. This parameter is required.
+	Region string `json:"region"`
+	// Path to the shared credentials file.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+	// The IAM role arn to use when assuming an role.
+	RoleARN string `json:"roleARN,omitempty"`
+	// Path to the WebIdentity token the SDK should use to assume a role with.
+	TokenFile string `json:"tokenFile,omitempty"`
+	// AWS Profile to extract credentials from the shared credentials file.
+	// If empty, the environment variable "AWS_PROFILE" is used.
+	// "default" is populated if the environment variable is also not set.
+	Profile string `json:"profile,omitempty"`
+}
+
+func (c *StaticSiteS3Config) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+	if len(c.RoleARN) != 0 {
+		c.RoleARN = maskString
+	}
+	if len(c.TokenFile) != 0 {
+		c.TokenFile = maskString
+	}
+}
+
+type StaticSiteGCSConfig struct {
+	// The GCP project hosting the URL maps of the load balancers using Cloud CDN.
+	Project string `json:"project"`
+	// The path to the service account file for accessing Cloud Storage and Compute Engine.
+	CredentialsFile string `json:"credentialsFile,omitempty"`
+}
+
+func (c *StaticSiteGCSConfig) Mask() {
+	if len(c.CredentialsFile) != 0 {
+		c.CredentialsFile = maskString
+	}
+}
+
+type PlatformProviderDockerComposeConfig struct {
+	// The hosts where the compose files are applied.
+	Hosts []DockerComposeHost `json:"hosts"`
+	// The version of docker-compose used to apply the compose files.
+	// Empty means the default version.
+	ComposeVersion string `json:"composeVersion,omitempty"`
+}
+
+// DockerComposeHost represents a Docker daemon where the compose files are applied.
+type DockerComposeHost struct {
+	// The unique name of the host used in the application configuration.
+	Name string `json:"name"`
+	// The address of the Docker daemon passed as DOCKER_HOST,
+	// e.g. ssh://deploy@edge-01.example.com or tcp://edge-01.example.com:2376.
+	Address string `json:"address"`
+	// The path to the directory containing ca.pem, cert.pem and key.pem
+	// to connect to the Docker daemon over TLS.
+	CertPath string `json:"certPath,omitempty"`
+}
+
+func (c *PlatformProviderDockerComposeConfig) Validate() error {
+	if len(c.Hosts) == 0 {
+		return fmt.Errorf("hosts must contain at least one host")
+	}
+	names := make(map[string]struct{}, len(c.Hosts))
+	for _, h := range c.Hosts {
+		if h.Name == "" {
+			return fmt.Errorf("name of host must be set")
+		}
+		if _, ok := names[h.Name]; ok {
+			return fmt.Errorf("host %s is defined more than once", h.Name)
+		}
+		names[h.Name] = struct{}{}
+		if h.Address == "" {
+			return fmt.Errorf("address of host %s must be set", h.Name)
+		}
+		if !strings.HasPrefix(h.Address, "ssh://") && !strings.HasPrefix(h.Address, "tcp://") && !strings.HasPrefix(h.Address, "unix://") {
+			return fmt.Errorf("address of host %s must start with ssh://, tcp:// or unix://", h.Name)
+		}
+	}
+	return nil
+}
+
+// FindHost finds the host with the given name.
+func (c *PlatformProviderDockerComposeConfig) FindHost(name string) (DockerComposeHost, bool) {
+	for _, h := range c.Hosts {
+		if h.Name == name {
+			return h, true
+		}
+	}
+	return DockerComposeHost{}, false
+}
+
+func (c *PlatformProviderDockerComposeConfig) Mask() {
+	for i := range c.Hosts {
+		if len(c.Hosts[i].CertPath) != 0 {
+			c.Hosts[i].CertPath = maskString
+		}
+	}
+}
+
+type PlatformProviderKnativeConfig struct {
+	// The master URL of the kubernetes cluster where Knative Serving is installed.
+	// Empty means in-cluster.
+	MasterURL string `json:"masterURL,omitempty"`
+	// The path to the kubeconfig file.
+	// Empty means in-cluster.
+	KubeConfigPath string `json:"kubeConfigPath,omitempty"`
+}
+
+type PlatformProviderFlyIOConfig struct {
+	// The slug of the organization owning the apps.
+	// It is used to list the apps for the application livestate.
+	Org string `json:"org"`
+	// Path to the file containing the access token of Fly.io.
+	// If empty, the environment variable "FLY_API_TOKEN" is used.
+	AccessTokenFile string `json:"accessTokenFile,omitempty"`
+	// The endpoint of the Fly.io Machines API.
+	// Default is https://api.machines.dev.
+	APIURL string `json:"apiURL,omitempty"`
+}
+
+func (c *PlatformProviderFlyIOConfig) Validate() error {
+	if c.Org == "" {
+		return fmt.Errorf("org must be set")
+	}
+	if c.APIURL != "" && !strings.HasPrefix(c.APIURL, "https://") && !strings.HasPrefix(c.APIURL, "http://") {
+		return fmt.Errorf("apiURL must start with https:// or http://")
+	}
+	return nil
+}
+
+func (c *PlatformProviderFlyIOConfig) Mask() {
+	if len(c.AccessTokenFile) != 0 {
+		c.AccessTokenFile = maskString
+	}
 }
 
 type PipedAnalysisProvider struct {
@@ -891,6 +1572,9 @@ func (a *AnalysisProviderDatadogConfig) Mask() {
 type AnalysisProviderStackdriverConfig struct {
 	// The path to the service account file.
 	ServiceAccountFile string `json:"serviceAccountFile"`
+	// The ID of GCP project whose metrics are queried.
+	// Default is the project of the service account.
+	ProjectID string `json:"projectId,omitempty"`
 }
 
 func (a *AnalysisProviderStackdriverConfig) Mask() {
@@ -129,7 +129,9 @@ func TestPipedConfig(t *testing.T) {
 								"project=gcp-project",
 								"region=us-centra1",
 							},
-							DriftDetectionEnabled: newBoolPointer(false),
+							DriftDetectionEnabled:      newBoolPointer(false),
+							DriftDetectionInterval:     Duration(10 * time.Minute),
+							AutoInstallRequiredVersion: newBoolPointer(true),
 						},
 					},
 					{
@@ -1369,3 +1371,214 @@ func TestFindPlatformProvidersByLabel(t *testing.T) {
 		})
 	}
 }
+
+func TestHelmChartRepositoryValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		repo    HelmChartRepository
+		wantErr bool
+	}{
+		{
+			name: "valid http repository",
+			repo: HelmChartRepository{Type: HTTPHelmChartRepository, Name: "pipecd", Address: "https://charts.pipecd.dev"},
+		},
+		{
+			name: "valid oci repository",
+			repo: HelmChartRepository{Type: OCIHelmChartRepository, Name: "pipecd", Address: "oci://ghcr.io/pipe-cd/chart"},
+		},
+		{
+			name:    "oci repository without oci scheme",
+			repo:    HelmChartRepository{Type: OCIHelmChartRepository, Name: "pipecd", Address: "https://ghcr.io/pipe-cd/chart"},
+			wantErr: true,
+		},
+		{
+			name:    "oci repository without name",
+			repo:    HelmChartRepository{Type: OCIHelmChartRepository, Address: "oci://ghcr.io/pipe-cd/chart"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			repo:    HelmChartRepository{Type: "S3", Name: "pipecd"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.repo.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestOCIChartRepositoryAddress(t *testing.T) {
+	t.Parallel()
+
+	spec := &PipedSpec{
+		ChartRepositories: []HelmChartRepository{
+			{Type: HTTPHelmChartRepository, Name: "stable", Address: "https://charts.helm.sh/stable"},
+			{Type: OCIHelmChartRepository, Name: "pipecd", Address: "oci://ghcr.io/pipe-cd/chart"},
+		},
+	}
+
+	assert.Equal(t, "oci://ghcr.io/pipe-cd/chart", spec.OCIChartRepositoryAddress("pipecd"))
+	assert.Equal(t, "", spec.OCIChartRepositoryAddress("stable"))
+	assert.Equal(t, "", spec.OCIChartRepositoryAddress("unknown"))
+}
+
+func TestKubernetesResourceHealthRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		rule    KubernetesResourceHealthRule
+		wantErr bool
+	}{
+		{
+			name: "valid condition check",
+			rule: KubernetesResourceHealthRule{
+				APIVersion: "cert-manager.io/v1",
+				Kind:       "Certificate",
+				Checks:     []KubernetesResourceHealthCheck{{Condition: "Ready"}},
+			},
+		},
+		{
+			name: "valid field check",
+			rule: KubernetesResourceHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+				Checks:     []KubernetesResourceHealthCheck{{Field: "status.phase", Value: "Running"}},
+			},
+		},
+		{
+			name: "missing kind",
+			rule: KubernetesResourceHealthRule{
+				APIVersion: "example.com/v1",
+				Checks:     []KubernetesResourceHealthCheck{{Condition: "Ready"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "no checks",
+			rule: KubernetesResourceHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+			},
+			wantErr: true,
+		},
+		{
+			name: "both field and condition",
+			rule: KubernetesResourceHealthRule{
+				APIVersion: "example.com/v1",
+				Kind:       "Database",
+				Checks:     []KubernetesResourceHealthCheck{{Field: "status.phase", Condition: "Ready"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rule.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPlatformProviderDockerComposeConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		cfg     PlatformProviderDockerComposeConfig
+		wantErr bool
+	}{
+		{
+			name: "valid hosts",
+			cfg: PlatformProviderDockerComposeConfig{
+				Hosts: []DockerComposeHost{
+					{Name: "edge-01", Address: "ssh://deploy@edge-01"},
+					{Name: "edge-02", Address: "tcp://edge-02:2376", CertPath: "/etc/piped/certs/edge-02"},
+				},
+			},
+		},
+		{
+			name:    "no host",
+			cfg:     PlatformProviderDockerComposeConfig{},
+			wantErr: true,
+		},
+		{
+			name: "duplicated host",
+			cfg: PlatformProviderDockerComposeConfig{
+				Hosts: []DockerComposeHost{
+					{Name: "edge-01", Address: "ssh://deploy@edge-01"},
+					{Name: "edge-01", Address: "ssh://deploy@edge-02"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "address without scheme",
+			cfg: PlatformProviderDockerComposeConfig{
+				Hosts: []DockerComposeHost{
+					{Name: "edge-01", Address: "edge-01:2376"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
+
+func TestPlatformProviderFlyIOConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name    string
+		cfg     PlatformProviderFlyIOConfig
+		wantErr bool
+	}{
+		{
+			name: "valid config",
+			cfg: PlatformProviderFlyIOConfig{
+				Org:             "my-team",
+				AccessTokenFile: "/etc/piped-secret/fly-token",
+			},
+		},
+		{
+			name: "custom api url",
+			cfg: PlatformProviderFlyIOConfig{
+				Org:    "my-team",
+				APIURL: "http://_api.internal:4280",
+			},
+		},
+		{
+			name:    "no org",
+			cfg:     PlatformProviderFlyIOConfig{},
+			wantErr: true,
+		},
+		{
+			name: "api url without scheme",
+			cfg: PlatformProviderFlyIOConfig{
+				Org:    "my-team",
+				APIURL: "api.machines.dev",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			assert.Equal(t, tc.wantErr, err != nil)
+		})
+	}
+}
@@ -29,6 +29,7 @@ type differ struct {
 	compareNumberAndNumericString bool
 	ignoredPaths                  map[string]struct{}
 	ignoreConfig                  map[string][]string
+	ignoredFieldPaths             []string
 
 	result *Result
 }
@@ -66,13 +67,89 @@ func WithIgnoreConfig(config map[string][]string) Option {
 	}
 }
 
+// WithIgnoredFieldPaths configures differ to ignore the given fields of every object.
+// Each path is a field path pattern accepted by NormalizeFieldPath,
+// the invalid ones are skipped.
+func WithIgnoredFieldPaths(paths []string) Option {
+	return func(d *differ) {
+		for _, p := range paths {
+			if np, err := NormalizeFieldPath(p); err == nil {
+				d.ignoredFieldPaths = append(d.ignoredFieldPaths, np)
+			}
+		}
+	}
+}
+
 func (d *differ) initIgnoredPaths(key string) {
 	paths := d.ignoreConfig[key]
-	d.ignoredPaths = make(map[string]struct{}, len(paths))
+	d.ignoredPaths = make(map[string]struct{}, len(paths)+len(d.ignoredFieldPaths))
 
 	for _, path := range paths {
 		d.ignoredPaths[path] = struct{}{}
 	}
+	for _, path := range d.ignoredFieldPaths {
+		d.ignoredPaths[path] = struct{}{}
+	}
+}
+
+// NormalizeFieldPath converts a field path pattern into the dot-separated form
+// used to identify the diff nodes.
+// Map keys containing dots or slashes can be written in brackets with quotes
+// and slice elements can be written in brackets with their index.
+// e.g.
+//   - spec.replicas
+//   - metadata.annotations["deployment.kubernetes.io/revision"]
+//   - spec.template.spec.containers[0].image
+func NormalizeFieldPath(path string) (string, error) {
+	var (
+		steps = make([]string, 0, strings.Count(path, ".")+1)
+		rest  = path
+	)
+	for rest != "" {
+		if strings.HasPrefix(rest, `["`) {
+			end := strings.Index(rest[2:], `"]`)
+			if end < 0 {
+				return "", fmt.Errorf("missing closing bracket in field path %q", path)
+			}
+			key, err := strconv.Unquote(rest[1 : end+3])
+			if err != nil {
+				return "", fmt.Errorf("invalid quoted key in field path %q: %w", path, err)
+			}
+			steps = append(steps, key)
+			rest = rest[end+4:]
+		} else if strings.HasPrefix(rest, "[") {
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return "", fmt.Errorf("missing closing bracket in field path %q", path)
+			}
+			if _, err := strconv.Atoi(rest[1:end]); err != nil {
+				return "", fmt.Errorf("invalid index in field path %q", path)
+			}
+			steps = append(steps, rest[1:end])
+			rest = rest[end+1:]
+		} else {
+			end := strings.IndexAny(rest, ".[")
+			if end == 0 {
+				return "", fmt.Errorf("empty field name in field path %q", path)
+			}
+			if end < 0 {
+				end = len(rest)
+			}
+			steps = append(steps, rest[:end])
+			rest = rest[end:]
+		}
+
+		if strings.HasPrefix(rest, ".") {
+			rest = rest[1:]
+			if rest == "" {
+				return "", fmt.Errorf("empty field name in field path %q", path)
+			}
+		}
+	}
+	if len(steps) == 0 {
+		return "", fmt.Errorf("field path must not be empty")
+	}
+	return strings.Join(steps, "."), nil
 }
 
 // DiffUnstructureds calculates the diff between two unstructured objects.
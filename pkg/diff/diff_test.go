@@ -107,6 +107,42 @@ func TestDiff(t *testing.T) {
 +           maxUnavailable: 25%
 +         type: RollingUpdate
 
+`,
+		},
+		{
+			name:     "diff by ignoring specified field paths of every object",
+			yamlFile: "testdata/has_diff.yaml",
+			options: []Option{
+				WithIgnoredFieldPaths([]string{
+					"spec.replicas",
+					"spec.template.spec.containers[0].args",
+					`spec.template["spec"].strategy`,
+					"spec.template.spec.containers[3]",
+				}),
+			},
+			diffNum: 4,
+			diffString: `  spec:
+    template:
+      metadata:
+        labels:
+          #spec.template.metadata.labels.app
+-         app: simple
++         app: simple2
+
+          #spec.template.metadata.labels.component
+-         component: foo
+
+      spec:
+        containers:
+          -
+            #spec.template.spec.containers.1.image
+-           image: gcr.io/pipecd/helloworld:v2.0.0
++           image: gcr.io/pipecd/helloworld:v2.1.0
+
+          -
+            #spec.template.spec.containers.2.image
+-           image: 
+
 `,
 		},
 		{
@@ -272,6 +308,69 @@ func loadUnstructureds(path string) ([]unstructured.Unstructured, error) {
 	return out, nil
 }
 
+func TestNormalizeFieldPath(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		name     string
+		path     string
+		expected string
+		wantErr  bool
+	}{
+		{
+			name:     "dot-separated path",
+			path:     "spec.replicas",
+			expected: "spec.replicas",
+		},
+		{
+			name:     "quoted map key",
+			path:     `metadata.annotations["deployment.kubernetes.io/revision"]`,
+			expected: "metadata.annotations.deployment.kubernetes.io/revision",
+		},
+		{
+			name:     "slice index",
+			path:     "spec.template.spec.containers[0].image",
+			expected: "spec.template.spec.containers.0.image",
+		},
+		{
+			name:    "empty path",
+			path:    "",
+			wantErr: true,
+		},
+		{
+			name:    "trailing dot",
+			path:    "spec.",
+			wantErr: true,
+		},
+		{
+			name:    "consecutive dots",
+			path:    "spec..replicas",
+			wantErr: true,
+		},
+		{
+			name:    "unclosed bracket",
+			path:    `metadata.annotations["foo`,
+			wantErr: true,
+		},
+		{
+			name:    "non numeric index",
+			path:    "spec.containers[first]",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := NormalizeFieldPath(tc.path)
+			assert.Equal(t, tc.wantErr, err != nil)
+			assert.Equal(t, tc.expected, got)
+		})
+	}
+}
+
 func TestIsEmptyInterface(t *testing.T) {
 	testcases := []struct {
 		name     string
@@ -38,6 +38,7 @@ type Repo interface {
 	ListCommits(ctx context.Context, visionRange string) ([]Commit, error)
 	GetLatestCommit(ctx context.Context) (Commit, error)
 	GetCommitHashForRev(ctx context.Context, rev string) (string, error)
+	GetCommitForRev(ctx context.Context, rev string) (Commit, error)
 	ChangedFiles(ctx context.Context, from, to string) ([]string, error)
 	Checkout(ctx context.Context, commitish string) error
 	CheckoutPullRequest(ctx context.Context, number int, branch string) error
@@ -139,6 +140,29 @@ func (r *repo) GetCommitHashForRev(ctx context.Context, rev string) (string, err
 	return strings.TrimSpace(string(out)), nil
 }
 
+// GetCommitForRev returns the commit for a given rev.
+func (r *repo) GetCommitForRev(ctx context.Context, rev string) (Commit, error) {
+	out, err := r.runGitCommand(ctx,
+		"log",
+		"-1",
+		"--no-decorate",
+		fmt.Sprintf("--pretty=format:%s", commitLogFormat),
+		rev,
+	)
+	if err != nil {
+		return Commit{}, formatCommandError(err, out)
+	}
+
+	commits, err := parseCommits(string(out))
+	if err != nil {
+		return Commit{}, err
+	}
+	if len(commits) != 1 {
+		return Commit{}, fmt.Errorf("commits must contain one item, got: %d", len(commits))
+	}
+	return commits[0], nil
+}
+
 // ChangedFiles returns a list of files those were touched between two commits.
 func (r *repo) ChangedFiles(ctx context.Context, from, to string) ([]string, error) {
 	out, err := r.runGitCommand(ctx, "diff", "--name-only", from, to)
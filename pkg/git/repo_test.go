@@ -52,6 +52,36 @@ func TestGetCommitHashForRev(t *testing.T) {
 	assert.Equal(t, commits[0].Hash, latestCommitHash)
 }
 
+func TestGetCommitForRev(t *testing.T) {
+	faker, err := newFaker()
+	require.NoError(t, err)
+	defer faker.clean()
+
+	var (
+		org      = "test-repo-org"
+		repoName = "repo-get-commit-for-rev"
+		ctx      = context.Background()
+	)
+
+	err = faker.makeRepo(org, repoName)
+	require.NoError(t, err)
+	r := &repo{
+		dir:     faker.repoDir(org, repoName),
+		gitPath: faker.gitPath,
+	}
+
+	commits, err := r.ListCommits(ctx, "")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(commits))
+
+	commit, err := r.GetCommitForRev(ctx, commits[0].Hash)
+	require.NoError(t, err)
+	assert.Equal(t, commits[0], commit)
+
+	_, err = r.GetCommitForRev(ctx, "unknown")
+	assert.Error(t, err)
+}
+
 func TestChangedFiles(t *testing.T) {
 	faker, err := newFaker()
 	require.NoError(t, err)
@@ -101,6 +101,32 @@ func (ak ApplicationKind) CompatiblePlatformProviderType() PlatformProviderType
 		return PlatformProviderCloudRun
 	case ApplicationKind_ECS:
 		return PlatformProviderECS
+	case ApplicationKind_APPRUNNER:
+		return PlatformProviderAppRunner
+	case ApplicationKind_CLOUDFORMATION:
+		return PlatformProviderCloudFormation
+	case ApplicationKind_NOMAD:
+		return PlatformProviderNomad
+	case ApplicationKind_CONTAINERAPPS:
+		return PlatformProviderContainerApps
+	case ApplicationKind_APPENGINE:
+		return PlatformProviderAppEngine
+	case ApplicationKind_STEPFUNCTIONS:
+		return PlatformProviderStepFunctions
+	case ApplicationKind_EC2ASG:
+		return PlatformProviderEC2ASG
+	case ApplicationKind_GCEMIG:
+		return PlatformProviderGCEMIG
+	case ApplicationKind_AZUREFUNCTIONS:
+		return PlatformProviderAzureFunctions
+	case ApplicationKind_STATICSITE:
+		return PlatformProviderStaticSite
+	case ApplicationKind_DOCKERCOMPOSE:
+		return PlatformProviderDockerCompose
+	case ApplicationKind_KNATIVE:
+		return PlatformProviderKnative
+	case ApplicationKind_FLYIO:
+		return PlatformProviderFlyIO
 	default:
 		return PlatformProviderKubernetes
 	}
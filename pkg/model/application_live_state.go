@@ -14,6 +14,8 @@
 
 package model
 
+import "google.golang.org/protobuf/proto"
+
 func (v ApplicationLiveStateVersion) IsBefore(a ApplicationLiveStateVersion) bool {
 	if v.Timestamp < a.Timestamp {
 		return true
@@ -43,6 +45,17 @@ func (s KubernetesResourceState) HasDiff(a KubernetesResourceState) bool {
 	if len(s.ParentIds) != len(a.ParentIds) {
 		return true
 	}
+	if !proto.Equal(s.PodState, a.PodState) {
+		return true
+	}
+	if len(s.WarningEvents) != len(a.WarningEvents) {
+		return true
+	}
+	for i := range s.WarningEvents {
+		if !proto.Equal(s.WarningEvents[i], a.WarningEvents[i]) {
+			return true
+		}
+	}
 
 	for i := range s.OwnerIds {
 		if s.OwnerIds[i] != a.OwnerIds[i] {
@@ -60,13 +73,23 @@ func (s KubernetesResourceState) HasDiff(a KubernetesResourceState) bool {
 }
 
 // DetermineAppHealthStatus updates its own health status, which is determined based on its resources status.
-// TODO: Determine health state of other than k8s and cloud run app
+// TODO: Determine health state of other than k8s, cloud run, ecs, nomad, container apps and app engine app
 func (s *ApplicationLiveStateSnapshot) DetermineAppHealthStatus() {
 	switch s.Kind {
 	case ApplicationKind_KUBERNETES:
 		s.determineKubernetesAppHealthStatus()
 	case ApplicationKind_CLOUDRUN:
 		s.determineCloudRunAppHealthStatus()
+	case ApplicationKind_ECS:
+		s.determineECSAppHealthStatus()
+	case ApplicationKind_NOMAD:
+		s.determineNomadAppHealthStatus()
+	case ApplicationKind_CONTAINERAPPS:
+		s.determineContainerAppsAppHealthStatus()
+	case ApplicationKind_APPENGINE:
+		s.determineAppEngineAppHealthStatus()
+	case ApplicationKind_FLYIO:
+		s.determineFlyIOAppHealthStatus()
 	}
 }
 
@@ -103,3 +126,98 @@ func (s *ApplicationLiveStateSnapshot) determineCloudRunAppHealthStatus() {
 	}
 	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
 }
+
+func (s *ApplicationLiveStateSnapshot) determineECSAppHealthStatus() {
+	app := s.Ecs
+	if app == nil {
+		return
+	}
+	for _, r := range app.Resources {
+		if r.HealthStatus == ECSResourceState_OTHER {
+			s.HealthStatus = ApplicationLiveStateSnapshot_OTHER
+			return
+		}
+
+		if r.HealthStatus == ECSResourceState_UNKNOWN {
+			s.HealthStatus = ApplicationLiveStateSnapshot_UNKNOWN
+			return
+		}
+	}
+	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
+}
+
+func (s *ApplicationLiveStateSnapshot) determineNomadAppHealthStatus() {
+	app := s.Nomad
+	if app == nil {
+		return
+	}
+	for _, r := range app.Resources {
+		if r.HealthStatus == NomadResourceState_OTHER {
+			s.HealthStatus = ApplicationLiveStateSnapshot_OTHER
+			return
+		}
+
+		if r.HealthStatus == NomadResourceState_UNKNOWN {
+			s.HealthStatus = ApplicationLiveStateSnapshot_UNKNOWN
+			return
+		}
+	}
+	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
+}
+
+func (s *ApplicationLiveStateSnapshot) determineContainerAppsAppHealthStatus() {
+	app := s.Containerapps
+	if app == nil {
+		return
+	}
+	for _, r := range app.Resources {
+		if r.HealthStatus == ContainerAppsResourceState_OTHER {
+			s.HealthStatus = ApplicationLiveStateSnapshot_OTHER
+			return
+		}
+
+		if r.HealthStatus == ContainerAppsResourceState_UNKNOWN {
+			s.HealthStatus = ApplicationLiveStateSnapshot_UNKNOWN
+			return
+		}
+	}
+	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
+}
+
+func (s *ApplicationLiveStateSnapshot) determineAppEngineAppHealthStatus() {
+	app := s.Appengine
+	if app == nil {
+		return
+	}
+	for _, r := range app.Resources {
+		if r.HealthStatus == AppEngineResourceState_OTHER {
+			s.HealthStatus = ApplicationLiveStateSnapshot_OTHER
+			return
+		}
+
+		if r.HealthStatus == AppEngineResourceState_UNKNOWN {
+			s.HealthStatus = ApplicationLiveStateSnapshot_UNKNOWN
+			return
+		}
+	}
+	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
+}
+
+func (s *ApplicationLiveStateSnapshot) determineFlyIOAppHealthStatus() {
+	app := s.Flyio
+	if app == nil {
+		return
+	}
+	for _, r := range app.Resources {
+		if r.HealthStatus == FlyIOResourceState_OTHER {
+			s.HealthStatus = ApplicationLiveStateSnapshot_OTHER
+			return
+		}
+
+		if r.HealthStatus == FlyIOResourceState_UNKNOWN {
+			s.HealthStatus = ApplicationLiveStateSnapshot_UNKNOWN
+			return
+		}
+	}
+	s.HealthStatus = ApplicationLiveStateSnapshot_HEALTHY
+}
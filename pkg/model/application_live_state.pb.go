@@ -130,7 +130,7 @@ func (x KubernetesResourceState_HealthStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubernetesResourceState_HealthStatus.Descriptor instead.
 func (KubernetesResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{6, 0}
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{11, 0}
 }
 
 type KubernetesResourceStateEvent_Type int32
@@ -176,7 +176,7 @@ func (x KubernetesResourceStateEvent_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KubernetesResourceStateEvent_Type.Descriptor instead.
 func (KubernetesResourceStateEvent_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{7, 0}
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type CloudRunResourceState_HealthStatus int32
@@ -225,7 +225,252 @@ func (x CloudRunResourceState_HealthStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use CloudRunResourceState_HealthStatus.Descriptor instead.
 func (CloudRunResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{8, 0}
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{16, 0}
+}
+
+type ECSResourceState_HealthStatus int32
+
+const (
+	ECSResourceState_UNKNOWN ECSResourceState_HealthStatus = 0
+	ECSResourceState_HEALTHY ECSResourceState_HealthStatus = 1
+	ECSResourceState_OTHER   ECSResourceState_HealthStatus = 2
+)
+
+// Enum value maps for ECSResourceState_HealthStatus.
+var (
+	ECSResourceState_HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "OTHER",
+	}
+	ECSResourceState_HealthStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"HEALTHY": 1,
+		"OTHER":   2,
+	}
+)
+
+func (x ECSResourceState_HealthStatus) Enum() *ECSResourceState_HealthStatus {
+	p := new(ECSResourceState_HealthStatus)
+	*p = x
+	return p
+}
+
+func (x ECSResourceState_HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ECSResourceState_HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_application_live_state_proto_enumTypes[4].Descriptor()
+}
+
+func (ECSResourceState_HealthStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_application_live_state_proto_enumTypes[4]
+}
+
+func (x ECSResourceState_HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ECSResourceState_HealthStatus.Descriptor instead.
+func (ECSResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{19, 0}
+}
+
+type NomadResourceState_HealthStatus int32
+
+const (
+	NomadResourceState_UNKNOWN NomadResourceState_HealthStatus = 0
+	NomadResourceState_HEALTHY NomadResourceState_HealthStatus = 1
+	NomadResourceState_OTHER   NomadResourceState_HealthStatus = 2
+)
+
+// Enum value maps for NomadResourceState_HealthStatus.
+var (
+	NomadResourceState_HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "OTHER",
+	}
+	NomadResourceState_HealthStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"HEALTHY": 1,
+		"OTHER":   2,
+	}
+)
+
+func (x NomadResourceState_HealthStatus) Enum() *NomadResourceState_HealthStatus {
+	p := new(NomadResourceState_HealthStatus)
+	*p = x
+	return p
+}
+
+func (x NomadResourceState_HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NomadResourceState_HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_application_live_state_proto_enumTypes[5].Descriptor()
+}
+
+func (NomadResourceState_HealthStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_application_live_state_proto_enumTypes[5]
+}
+
+func (x NomadResourceState_HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NomadResourceState_HealthStatus.Descriptor instead.
+func (NomadResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{20, 0}
+}
+
+type ContainerAppsResourceState_HealthStatus int32
+
+const (
+	ContainerAppsResourceState_UNKNOWN ContainerAppsResourceState_HealthStatus = 0
+	ContainerAppsResourceState_HEALTHY ContainerAppsResourceState_HealthStatus = 1
+	ContainerAppsResourceState_OTHER   ContainerAppsResourceState_HealthStatus = 2
+)
+
+// Enum value maps for ContainerAppsResourceState_HealthStatus.
+var (
+	ContainerAppsResourceState_HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "OTHER",
+	}
+	ContainerAppsResourceState_HealthStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"HEALTHY": 1,
+		"OTHER":   2,
+	}
+)
+
+func (x ContainerAppsResourceState_HealthStatus) Enum() *ContainerAppsResourceState_HealthStatus {
+	p := new(ContainerAppsResourceState_HealthStatus)
+	*p = x
+	return p
+}
+
+func (x ContainerAppsResourceState_HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ContainerAppsResourceState_HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_application_live_state_proto_enumTypes[6].Descriptor()
+}
+
+func (ContainerAppsResourceState_HealthStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_application_live_state_proto_enumTypes[6]
+}
+
+func (x ContainerAppsResourceState_HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ContainerAppsResourceState_HealthStatus.Descriptor instead.
+func (ContainerAppsResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{21, 0}
+}
+
+type AppEngineResourceState_HealthStatus int32
+
+const (
+	AppEngineResourceState_UNKNOWN AppEngineResourceState_HealthStatus = 0
+	AppEngineResourceState_HEALTHY AppEngineResourceState_HealthStatus = 1
+	AppEngineResourceState_OTHER   AppEngineResourceState_HealthStatus = 2
+)
+
+// Enum value maps for AppEngineResourceState_HealthStatus.
+var (
+	AppEngineResourceState_HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "OTHER",
+	}
+	AppEngineResourceState_HealthStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"HEALTHY": 1,
+		"OTHER":   2,
+	}
+)
+
+func (x AppEngineResourceState_HealthStatus) Enum() *AppEngineResourceState_HealthStatus {
+	p := new(AppEngineResourceState_HealthStatus)
+	*p = x
+	return p
+}
+
+func (x AppEngineResourceState_HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AppEngineResourceState_HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_application_live_state_proto_enumTypes[7].Descriptor()
+}
+
+func (AppEngineResourceState_HealthStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_application_live_state_proto_enumTypes[7]
+}
+
+func (x AppEngineResourceState_HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AppEngineResourceState_HealthStatus.Descriptor instead.
+func (AppEngineResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{22, 0}
+}
+
+type FlyIOResourceState_HealthStatus int32
+
+const (
+	FlyIOResourceState_UNKNOWN FlyIOResourceState_HealthStatus = 0
+	FlyIOResourceState_HEALTHY FlyIOResourceState_HealthStatus = 1
+	FlyIOResourceState_OTHER   FlyIOResourceState_HealthStatus = 2
+)
+
+// Enum value maps for FlyIOResourceState_HealthStatus.
+var (
+	FlyIOResourceState_HealthStatus_name = map[int32]string{
+		0: "UNKNOWN",
+		1: "HEALTHY",
+		2: "OTHER",
+	}
+	FlyIOResourceState_HealthStatus_value = map[string]int32{
+		"UNKNOWN": 0,
+		"HEALTHY": 1,
+		"OTHER":   2,
+	}
+)
+
+func (x FlyIOResourceState_HealthStatus) Enum() *FlyIOResourceState_HealthStatus {
+	p := new(FlyIOResourceState_HealthStatus)
+	*p = x
+	return p
+}
+
+func (x FlyIOResourceState_HealthStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlyIOResourceState_HealthStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pkg_model_application_live_state_proto_enumTypes[8].Descriptor()
+}
+
+func (FlyIOResourceState_HealthStatus) Type() protoreflect.EnumType {
+	return &file_pkg_model_application_live_state_proto_enumTypes[8]
+}
+
+func (x FlyIOResourceState_HealthStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlyIOResourceState_HealthStatus.Descriptor instead.
+func (FlyIOResourceState_HealthStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{23, 0}
 }
 
 // ApplicationLiveStateSnapshot represents the full live state information of an application
@@ -244,6 +489,11 @@ type ApplicationLiveStateSnapshot struct {
 	Terraform     *TerraformApplicationLiveState      `protobuf:"bytes,11,opt,name=terraform,proto3" json:"terraform,omitempty"`
 	Cloudrun      *CloudRunApplicationLiveState       `protobuf:"bytes,12,opt,name=cloudrun,proto3" json:"cloudrun,omitempty"`
 	Lambda        *LambdaApplicationLiveState         `protobuf:"bytes,13,opt,name=lambda,proto3" json:"lambda,omitempty"`
+	Ecs           *ECSApplicationLiveState            `protobuf:"bytes,14,opt,name=ecs,proto3" json:"ecs,omitempty"`
+	Nomad         *NomadApplicationLiveState          `protobuf:"bytes,16,opt,name=nomad,proto3" json:"nomad,omitempty"`
+	Containerapps *ContainerAppsApplicationLiveState  `protobuf:"bytes,17,opt,name=containerapps,proto3" json:"containerapps,omitempty"`
+	Appengine     *AppEngineApplicationLiveState      `protobuf:"bytes,18,opt,name=appengine,proto3" json:"appengine,omitempty"`
+	Flyio         *FlyIOApplicationLiveState          `protobuf:"bytes,19,opt,name=flyio,proto3" json:"flyio,omitempty"`
 	Version       *ApplicationLiveStateVersion        `protobuf:"bytes,15,opt,name=version,proto3" json:"version,omitempty"`
 }
 
@@ -342,6 +592,41 @@ func (x *ApplicationLiveStateSnapshot) GetLambda() *LambdaApplicationLiveState {
 	return nil
 }
 
+func (x *ApplicationLiveStateSnapshot) GetEcs() *ECSApplicationLiveState {
+	if x != nil {
+		return x.Ecs
+	}
+	return nil
+}
+
+func (x *ApplicationLiveStateSnapshot) GetNomad() *NomadApplicationLiveState {
+	if x != nil {
+		return x.Nomad
+	}
+	return nil
+}
+
+func (x *ApplicationLiveStateSnapshot) GetContainerapps() *ContainerAppsApplicationLiveState {
+	if x != nil {
+		return x.Containerapps
+	}
+	return nil
+}
+
+func (x *ApplicationLiveStateSnapshot) GetAppengine() *AppEngineApplicationLiveState {
+	if x != nil {
+		return x.Appengine
+	}
+	return nil
+}
+
+func (x *ApplicationLiveStateSnapshot) GetFlyio() *FlyIOApplicationLiveState {
+	if x != nil {
+		return x.Flyio
+	}
+	return nil
+}
+
 func (x *ApplicationLiveStateSnapshot) GetVersion() *ApplicationLiveStateVersion {
 	if x != nil {
 		return x.Version
@@ -576,38 +861,16 @@ func (*LambdaApplicationLiveState) Descriptor() ([]byte, []int) {
 	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{5}
 }
 
-// KubernetesResourceState represents the state of a single kubernetes resource object.
-type KubernetesResourceState struct {
+type ECSApplicationLiveState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique ID across the whole cluster generated by Kubernetes.
-	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// The sorted list of unique IDs of the owners that depended by this resource.
-	// The owner is another resource that created and managing this resource.
-	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
-	// The sorted list of unique IDs of the parents.
-	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
-	// The unique name for the type of resource.
-	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// The api version of this resource represented by "group/version".
-	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	// The kind of this resource.
-	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
-	// The namespace this resource belongs to.
-	// This can be empty for non-namespaced resources.
-	Namespace         string                               `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	HealthStatus      KubernetesResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.KubernetesResourceState_HealthStatus" json:"health_status,omitempty"`
-	HealthDescription string                               `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
-	// The timestamp when this resource was created.
-	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	// The timestamp of the last time when this resource was updated.
-	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Resources []*ECSResourceState `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
 }
 
-func (x *KubernetesResourceState) Reset() {
-	*x = KubernetesResourceState{}
+func (x *ECSApplicationLiveState) Reset() {
+	*x = ECSApplicationLiveState{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pkg_model_application_live_state_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -615,13 +878,13 @@ func (x *KubernetesResourceState) Reset() {
 	}
 }
 
-func (x *KubernetesResourceState) String() string {
+func (x *ECSApplicationLiveState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesResourceState) ProtoMessage() {}
+func (*ECSApplicationLiveState) ProtoMessage() {}
 
-func (x *KubernetesResourceState) ProtoReflect() protoreflect.Message {
+func (x *ECSApplicationLiveState) ProtoReflect() protoreflect.Message {
 	mi := &file_pkg_model_application_live_state_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -633,47 +896,309 @@ func (x *KubernetesResourceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesResourceState.ProtoReflect.Descriptor instead.
-func (*KubernetesResourceState) Descriptor() ([]byte, []int) {
+// Deprecated: Use ECSApplicationLiveState.ProtoReflect.Descriptor instead.
+func (*ECSApplicationLiveState) Descriptor() ([]byte, []int) {
 	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *KubernetesResourceState) GetId() string {
+func (x *ECSApplicationLiveState) GetResources() []*ECSResourceState {
 	if x != nil {
-		return x.Id
+		return x.Resources
 	}
-	return ""
+	return nil
 }
 
-func (x *KubernetesResourceState) GetOwnerIds() []string {
-	if x != nil {
-		return x.OwnerIds
-	}
-	return nil
+type NomadApplicationLiveState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*NomadResourceState `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
 }
 
-func (x *KubernetesResourceState) GetParentIds() []string {
-	if x != nil {
-		return x.ParentIds
+func (x *NomadApplicationLiveState) Reset() {
+	*x = NomadApplicationLiveState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *KubernetesResourceState) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *NomadApplicationLiveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *KubernetesResourceState) GetApiVersion() string {
-	if x != nil {
-		return x.ApiVersion
+func (*NomadApplicationLiveState) ProtoMessage() {}
+
+func (x *NomadApplicationLiveState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *KubernetesResourceState) GetKind() string {
+// Deprecated: Use NomadApplicationLiveState.ProtoReflect.Descriptor instead.
+func (*NomadApplicationLiveState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NomadApplicationLiveState) GetResources() []*NomadResourceState {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type ContainerAppsApplicationLiveState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*ContainerAppsResourceState `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *ContainerAppsApplicationLiveState) Reset() {
+	*x = ContainerAppsApplicationLiveState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerAppsApplicationLiveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerAppsApplicationLiveState) ProtoMessage() {}
+
+func (x *ContainerAppsApplicationLiveState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerAppsApplicationLiveState.ProtoReflect.Descriptor instead.
+func (*ContainerAppsApplicationLiveState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ContainerAppsApplicationLiveState) GetResources() []*ContainerAppsResourceState {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type AppEngineApplicationLiveState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*AppEngineResourceState `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *AppEngineApplicationLiveState) Reset() {
+	*x = AppEngineApplicationLiveState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEngineApplicationLiveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEngineApplicationLiveState) ProtoMessage() {}
+
+func (x *AppEngineApplicationLiveState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEngineApplicationLiveState.ProtoReflect.Descriptor instead.
+func (*AppEngineApplicationLiveState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AppEngineApplicationLiveState) GetResources() []*AppEngineResourceState {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+type FlyIOApplicationLiveState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resources []*FlyIOResourceState `protobuf:"bytes,1,rep,name=resources,proto3" json:"resources,omitempty"`
+}
+
+func (x *FlyIOApplicationLiveState) Reset() {
+	*x = FlyIOApplicationLiveState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlyIOApplicationLiveState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlyIOApplicationLiveState) ProtoMessage() {}
+
+func (x *FlyIOApplicationLiveState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlyIOApplicationLiveState.ProtoReflect.Descriptor instead.
+func (*FlyIOApplicationLiveState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FlyIOApplicationLiveState) GetResources() []*FlyIOResourceState {
+	if x != nil {
+		return x.Resources
+	}
+	return nil
+}
+
+// KubernetesResourceState represents the state of a single kubernetes resource object.
+type KubernetesResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID across the whole cluster generated by Kubernetes.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The unique name for the type of resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The api version of this resource represented by "group/version".
+	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The kind of this resource.
+	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The namespace this resource belongs to.
+	// This can be empty for non-namespaced resources.
+	Namespace         string                               `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	HealthStatus      KubernetesResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.KubernetesResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                               `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The detailed state of the pod.
+	// This is set only when the resource is a Pod.
+	PodState *KubernetesPodState `protobuf:"bytes,10,opt,name=pod_state,json=podState,proto3" json:"pod_state,omitempty"`
+	// The recent warning events of this resource sorted by the last timestamp in descending order.
+	WarningEvents []*KubernetesResourceEvent `protobuf:"bytes,11,rep,name=warning_events,json=warningEvents,proto3" json:"warning_events,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *KubernetesResourceState) Reset() {
+	*x = KubernetesResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesResourceState) ProtoMessage() {}
+
+func (x *KubernetesResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesResourceState.ProtoReflect.Descriptor instead.
+func (*KubernetesResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KubernetesResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KubernetesResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *KubernetesResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *KubernetesResourceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubernetesResourceState) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *KubernetesResourceState) GetKind() string {
 	if x != nil {
 		return x.Kind
 	}
@@ -701,6 +1226,20 @@ func (x *KubernetesResourceState) GetHealthDescription() string {
 	return ""
 }
 
+func (x *KubernetesResourceState) GetPodState() *KubernetesPodState {
+	if x != nil {
+		return x.PodState
+	}
+	return nil
+}
+
+func (x *KubernetesResourceState) GetWarningEvents() []*KubernetesResourceEvent {
+	if x != nil {
+		return x.WarningEvents
+	}
+	return nil
+}
+
 func (x *KubernetesResourceState) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
@@ -715,37 +1254,33 @@ func (x *KubernetesResourceState) GetUpdatedAt() int64 {
 	return 0
 }
 
-type KubernetesResourceStateEvent struct {
+type KubernetesPodState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The uniquely generated identifier for this event.
-	Id              string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	ApplicationId   string                            `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
-	Type            KubernetesResourceStateEvent_Type `protobuf:"varint,3,opt,name=type,proto3,enum=model.KubernetesResourceStateEvent_Type" json:"type,omitempty"`
-	State           *KubernetesResourceState          `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	SnapshotVersion *ApplicationLiveStateVersion      `protobuf:"bytes,5,opt,name=snapshot_version,json=snapshotVersion,proto3" json:"snapshot_version,omitempty"`
-	CreatedAt       int64                             `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The phase of the pod such as Pending, Running, Succeeded, Failed or Unknown.
+	Phase      string                      `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase,omitempty"`
+	Containers []*KubernetesContainerState `protobuf:"bytes,2,rep,name=containers,proto3" json:"containers,omitempty"`
 }
 
-func (x *KubernetesResourceStateEvent) Reset() {
-	*x = KubernetesResourceStateEvent{}
+func (x *KubernetesPodState) Reset() {
+	*x = KubernetesPodState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_model_application_live_state_proto_msgTypes[7]
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *KubernetesResourceStateEvent) String() string {
+func (x *KubernetesPodState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*KubernetesResourceStateEvent) ProtoMessage() {}
+func (*KubernetesPodState) ProtoMessage() {}
 
-func (x *KubernetesResourceStateEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_model_application_live_state_proto_msgTypes[7]
+func (x *KubernetesPodState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -756,100 +1291,1130 @@ func (x *KubernetesResourceStateEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use KubernetesResourceStateEvent.ProtoReflect.Descriptor instead.
-func (*KubernetesResourceStateEvent) Descriptor() ([]byte, []int) {
-	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use KubernetesPodState.ProtoReflect.Descriptor instead.
+func (*KubernetesPodState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KubernetesPodState) GetPhase() string {
+	if x != nil {
+		return x.Phase
+	}
+	return ""
+}
+
+func (x *KubernetesPodState) GetContainers() []*KubernetesContainerState {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+type KubernetesContainerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name         string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ready        bool   `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	RestartCount int32  `protobuf:"varint,3,opt,name=restart_count,json=restartCount,proto3" json:"restart_count,omitempty"`
+	// The state of the container such as Waiting, Running or Terminated.
+	State string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	// The reason of the waiting or terminated state, e.g. CrashLoopBackOff, OOMKilled.
+	Reason  string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *KubernetesContainerState) Reset() {
+	*x = KubernetesContainerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesContainerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesContainerState) ProtoMessage() {}
+
+func (x *KubernetesContainerState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesContainerState.ProtoReflect.Descriptor instead.
+func (*KubernetesContainerState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *KubernetesContainerState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *KubernetesContainerState) GetReady() bool {
+	if x != nil {
+		return x.Ready
+	}
+	return false
+}
+
+func (x *KubernetesContainerState) GetRestartCount() int32 {
+	if x != nil {
+		return x.RestartCount
+	}
+	return 0
+}
+
+func (x *KubernetesContainerState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *KubernetesContainerState) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KubernetesContainerState) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type KubernetesResourceEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason  string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// The number of times this event has occurred.
+	Count int32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// The timestamp of the last time when this event occurred.
+	LastTimestamp int64 `protobuf:"varint,4,opt,name=last_timestamp,json=lastTimestamp,proto3" json:"last_timestamp,omitempty"`
+}
+
+func (x *KubernetesResourceEvent) Reset() {
+	*x = KubernetesResourceEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesResourceEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesResourceEvent) ProtoMessage() {}
+
+func (x *KubernetesResourceEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesResourceEvent.ProtoReflect.Descriptor instead.
+func (*KubernetesResourceEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KubernetesResourceEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *KubernetesResourceEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *KubernetesResourceEvent) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *KubernetesResourceEvent) GetLastTimestamp() int64 {
+	if x != nil {
+		return x.LastTimestamp
+	}
+	return 0
+}
+
+type KubernetesResourceStateEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The uniquely generated identifier for this event.
+	Id              string                            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ApplicationId   string                            `protobuf:"bytes,2,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	Type            KubernetesResourceStateEvent_Type `protobuf:"varint,3,opt,name=type,proto3,enum=model.KubernetesResourceStateEvent_Type" json:"type,omitempty"`
+	State           *KubernetesResourceState          `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	SnapshotVersion *ApplicationLiveStateVersion      `protobuf:"bytes,5,opt,name=snapshot_version,json=snapshotVersion,proto3" json:"snapshot_version,omitempty"`
+	CreatedAt       int64                             `protobuf:"varint,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (x *KubernetesResourceStateEvent) Reset() {
+	*x = KubernetesResourceStateEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesResourceStateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesResourceStateEvent) ProtoMessage() {}
+
+func (x *KubernetesResourceStateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesResourceStateEvent.ProtoReflect.Descriptor instead.
+func (*KubernetesResourceStateEvent) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KubernetesResourceStateEvent) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *KubernetesResourceStateEvent) GetApplicationId() string {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return ""
+}
+
+func (x *KubernetesResourceStateEvent) GetType() KubernetesResourceStateEvent_Type {
+	if x != nil {
+		return x.Type
+	}
+	return KubernetesResourceStateEvent_ADD_OR_UPDATED
+}
+
+func (x *KubernetesResourceStateEvent) GetState() *KubernetesResourceState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *KubernetesResourceStateEvent) GetSnapshotVersion() *ApplicationLiveStateVersion {
+	if x != nil {
+		return x.SnapshotVersion
+	}
+	return nil
+}
+
+func (x *KubernetesResourceStateEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// CloudRunResourceState represents the state of a single CloudRun resource object.
+type CloudRunResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The unique ID across the whole cluster generated by Kubernetes.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The unique name for the type of resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The api version of this resource represented by "group/version".
+	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
+	// The kind of this resource.
+	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The namespace this resource belongs to.
+	// This can be empty for non-namespaced resources.
+	Namespace         string                             `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	HealthStatus      CloudRunResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.CloudRunResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                             `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The detailed state of the revision.
+	// This is set only when the resource is a Revision.
+	RevisionState *CloudRunRevisionState `protobuf:"bytes,10,opt,name=revision_state,json=revisionState,proto3" json:"revision_state,omitempty"`
+	// The status conditions of this resource reported by Cloud Run.
+	Conditions []*CloudRunResourceCondition `protobuf:"bytes,11,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *CloudRunResourceState) Reset() {
+	*x = CloudRunResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudRunResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudRunResourceState) ProtoMessage() {}
+
+func (x *CloudRunResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudRunResourceState.ProtoReflect.Descriptor instead.
+func (*CloudRunResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CloudRunResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *CloudRunResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *CloudRunResourceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetApiVersion() string {
+	if x != nil {
+		return x.ApiVersion
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetHealthStatus() CloudRunResourceState_HealthStatus {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return CloudRunResourceState_UNKNOWN
+}
+
+func (x *CloudRunResourceState) GetHealthDescription() string {
+	if x != nil {
+		return x.HealthDescription
+	}
+	return ""
+}
+
+func (x *CloudRunResourceState) GetRevisionState() *CloudRunRevisionState {
+	if x != nil {
+		return x.RevisionState
+	}
+	return nil
+}
+
+func (x *CloudRunResourceState) GetConditions() []*CloudRunResourceCondition {
+	if x != nil {
+		return x.Conditions
+	}
+	return nil
+}
+
+func (x *CloudRunResourceState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CloudRunResourceState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CloudRunRevisionState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The percentage of traffic currently routed to this revision.
+	TrafficPercent int32 `protobuf:"varint,1,opt,name=traffic_percent,json=trafficPercent,proto3" json:"traffic_percent,omitempty"`
+	// The tags assigned to this revision to be accessed at their own URLs.
+	Tags []string `protobuf:"bytes,2,rep,name=tags,proto3" json:"tags,omitempty"`
+	// The minimum number of instances configured by the autoscaling annotation.
+	MinInstances int32 `protobuf:"varint,3,opt,name=min_instances,json=minInstances,proto3" json:"min_instances,omitempty"`
+	// The maximum number of instances configured by the autoscaling annotation.
+	// Zero means the default limit of Cloud Run.
+	MaxInstances int32 `protobuf:"varint,4,opt,name=max_instances,json=maxInstances,proto3" json:"max_instances,omitempty"`
+	// The maximum number of concurrent requests to an instance.
+	ContainerConcurrency int32 `protobuf:"varint,5,opt,name=container_concurrency,json=containerConcurrency,proto3" json:"container_concurrency,omitempty"`
+	// The digest of the image resolved when this revision was created.
+	ImageDigest string `protobuf:"bytes,6,opt,name=image_digest,json=imageDigest,proto3" json:"image_digest,omitempty"`
+}
+
+func (x *CloudRunRevisionState) Reset() {
+	*x = CloudRunRevisionState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudRunRevisionState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudRunRevisionState) ProtoMessage() {}
+
+func (x *CloudRunRevisionState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudRunRevisionState.ProtoReflect.Descriptor instead.
+func (*CloudRunRevisionState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *CloudRunRevisionState) GetTrafficPercent() int32 {
+	if x != nil {
+		return x.TrafficPercent
+	}
+	return 0
+}
+
+func (x *CloudRunRevisionState) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *CloudRunRevisionState) GetMinInstances() int32 {
+	if x != nil {
+		return x.MinInstances
+	}
+	return 0
+}
+
+func (x *CloudRunRevisionState) GetMaxInstances() int32 {
+	if x != nil {
+		return x.MaxInstances
+	}
+	return 0
+}
+
+func (x *CloudRunRevisionState) GetContainerConcurrency() int32 {
+	if x != nil {
+		return x.ContainerConcurrency
+	}
+	return 0
+}
+
+func (x *CloudRunRevisionState) GetImageDigest() string {
+	if x != nil {
+		return x.ImageDigest
+	}
+	return ""
+}
+
+type CloudRunResourceCondition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The status of the condition such as True, False or Unknown.
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	// The timestamp of the last time when the condition transitioned.
+	LastTransitionTime int64 `protobuf:"varint,5,opt,name=last_transition_time,json=lastTransitionTime,proto3" json:"last_transition_time,omitempty"`
+}
+
+func (x *CloudRunResourceCondition) Reset() {
+	*x = CloudRunResourceCondition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloudRunResourceCondition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloudRunResourceCondition) ProtoMessage() {}
+
+func (x *CloudRunResourceCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloudRunResourceCondition.ProtoReflect.Descriptor instead.
+func (*CloudRunResourceCondition) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *CloudRunResourceCondition) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CloudRunResourceCondition) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CloudRunResourceCondition) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CloudRunResourceCondition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CloudRunResourceCondition) GetLastTransitionTime() int64 {
+	if x != nil {
+		return x.LastTransitionTime
+	}
+	return 0
+}
+
+// ECSResourceState represents the state of a single ECS resource object.
+type ECSResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ARN of this resource.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The name of this resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of this resource. One of Service, TaskSet and Task.
+	Kind              string                        `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	HealthStatus      ECSResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.ECSResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                        `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ECSResourceState) Reset() {
+	*x = ECSResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ECSResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ECSResourceState) ProtoMessage() {}
+
+func (x *ECSResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ECSResourceState.ProtoReflect.Descriptor instead.
+func (*ECSResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ECSResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ECSResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *ECSResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *ECSResourceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ECSResourceState) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ECSResourceState) GetHealthStatus() ECSResourceState_HealthStatus {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ECSResourceState_UNKNOWN
+}
+
+func (x *ECSResourceState) GetHealthDescription() string {
+	if x != nil {
+		return x.HealthDescription
+	}
+	return ""
+}
+
+func (x *ECSResourceState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ECSResourceState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// NomadResourceState represents the state of a single Nomad resource object.
+type NomadResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of this resource.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The name of this resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of this resource. One of Job, Deployment and Allocation.
+	Kind              string                          `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	HealthStatus      NomadResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.NomadResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                          `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *NomadResourceState) Reset() {
+	*x = NomadResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NomadResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NomadResourceState) ProtoMessage() {}
+
+func (x *NomadResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NomadResourceState.ProtoReflect.Descriptor instead.
+func (*NomadResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *NomadResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *NomadResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *NomadResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *NomadResourceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NomadResourceState) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *NomadResourceState) GetHealthStatus() NomadResourceState_HealthStatus {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return NomadResourceState_UNKNOWN
+}
+
+func (x *NomadResourceState) GetHealthDescription() string {
+	if x != nil {
+		return x.HealthDescription
+	}
+	return ""
+}
+
+func (x *NomadResourceState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *NomadResourceState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// ContainerAppsResourceState represents the state of a single Azure Container Apps resource object.
+type ContainerAppsResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of this resource.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The name of this resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of this resource. One of App and Revision.
+	Kind              string                                  `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	HealthStatus      ContainerAppsResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.ContainerAppsResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                                  `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ContainerAppsResourceState) Reset() {
+	*x = ContainerAppsResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContainerAppsResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContainerAppsResourceState) ProtoMessage() {}
+
+func (x *ContainerAppsResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContainerAppsResourceState.ProtoReflect.Descriptor instead.
+func (*ContainerAppsResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ContainerAppsResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ContainerAppsResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *ContainerAppsResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
+}
+
+func (x *ContainerAppsResourceState) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ContainerAppsResourceState) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *ContainerAppsResourceState) GetHealthStatus() ContainerAppsResourceState_HealthStatus {
+	if x != nil {
+		return x.HealthStatus
+	}
+	return ContainerAppsResourceState_UNKNOWN
+}
+
+func (x *ContainerAppsResourceState) GetHealthDescription() string {
+	if x != nil {
+		return x.HealthDescription
+	}
+	return ""
+}
+
+func (x *ContainerAppsResourceState) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ContainerAppsResourceState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+// AppEngineResourceState represents the state of a single App Engine resource object.
+type AppEngineResourceState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The ID of this resource.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The sorted list of unique IDs of the owners that depended by this resource.
+	// The owner is another resource that created and managing this resource.
+	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
+	// The sorted list of unique IDs of the parents.
+	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
+	// The name of this resource.
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// The kind of this resource. One of Service and Version.
+	Kind              string                              `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	HealthStatus      AppEngineResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.AppEngineResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                              `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The timestamp when this resource was created.
+	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// The timestamp of the last time when this resource was updated.
+	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *AppEngineResourceState) Reset() {
+	*x = AppEngineResourceState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppEngineResourceState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppEngineResourceState) ProtoMessage() {}
+
+func (x *AppEngineResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppEngineResourceState.ProtoReflect.Descriptor instead.
+func (*AppEngineResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AppEngineResourceState) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *AppEngineResourceState) GetOwnerIds() []string {
+	if x != nil {
+		return x.OwnerIds
+	}
+	return nil
+}
+
+func (x *AppEngineResourceState) GetParentIds() []string {
+	if x != nil {
+		return x.ParentIds
+	}
+	return nil
 }
 
-func (x *KubernetesResourceStateEvent) GetId() string {
+func (x *AppEngineResourceState) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *KubernetesResourceStateEvent) GetApplicationId() string {
+func (x *AppEngineResourceState) GetKind() string {
 	if x != nil {
-		return x.ApplicationId
+		return x.Kind
 	}
 	return ""
 }
 
-func (x *KubernetesResourceStateEvent) GetType() KubernetesResourceStateEvent_Type {
+func (x *AppEngineResourceState) GetHealthStatus() AppEngineResourceState_HealthStatus {
 	if x != nil {
-		return x.Type
+		return x.HealthStatus
 	}
-	return KubernetesResourceStateEvent_ADD_OR_UPDATED
+	return AppEngineResourceState_UNKNOWN
 }
 
-func (x *KubernetesResourceStateEvent) GetState() *KubernetesResourceState {
+func (x *AppEngineResourceState) GetHealthDescription() string {
 	if x != nil {
-		return x.State
+		return x.HealthDescription
 	}
-	return nil
+	return ""
 }
 
-func (x *KubernetesResourceStateEvent) GetSnapshotVersion() *ApplicationLiveStateVersion {
+func (x *AppEngineResourceState) GetCreatedAt() int64 {
 	if x != nil {
-		return x.SnapshotVersion
+		return x.CreatedAt
 	}
-	return nil
+	return 0
 }
 
-func (x *KubernetesResourceStateEvent) GetCreatedAt() int64 {
+func (x *AppEngineResourceState) GetUpdatedAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.UpdatedAt
 	}
 	return 0
 }
 
-// CloudRunResourceState represents the state of a single CloudRun resource object.
-type CloudRunResourceState struct {
+// FlyIOResourceState represents the state of a single Fly.io resource object.
+type FlyIOResourceState struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The unique ID across the whole cluster generated by Kubernetes.
+	// The ID of this resource.
 	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The sorted list of unique IDs of the owners that depended by this resource.
 	// The owner is another resource that created and managing this resource.
 	OwnerIds []string `protobuf:"bytes,2,rep,name=owner_ids,json=ownerIds,proto3" json:"owner_ids,omitempty"`
 	// The sorted list of unique IDs of the parents.
 	ParentIds []string `protobuf:"bytes,3,rep,name=parent_ids,json=parentIds,proto3" json:"parent_ids,omitempty"`
-	// The unique name for the type of resource.
+	// The name of this resource.
 	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	// The api version of this resource represented by "group/version".
-	ApiVersion string `protobuf:"bytes,5,opt,name=api_version,json=apiVersion,proto3" json:"api_version,omitempty"`
-	// The kind of this resource.
-	Kind string `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
-	// The namespace this resource belongs to.
-	// This can be empty for non-namespaced resources.
-	Namespace         string                             `protobuf:"bytes,7,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	HealthStatus      CloudRunResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.CloudRunResourceState_HealthStatus" json:"health_status,omitempty"`
-	HealthDescription string                             `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
+	// The kind of this resource. One of App and Machine.
+	Kind              string                          `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`
+	HealthStatus      FlyIOResourceState_HealthStatus `protobuf:"varint,8,opt,name=health_status,json=healthStatus,proto3,enum=model.FlyIOResourceState_HealthStatus" json:"health_status,omitempty"`
+	HealthDescription string                          `protobuf:"bytes,9,opt,name=health_description,json=healthDescription,proto3" json:"health_description,omitempty"`
 	// The timestamp when this resource was created.
 	CreatedAt int64 `protobuf:"varint,14,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	// The timestamp of the last time when this resource was updated.
 	UpdatedAt int64 `protobuf:"varint,15,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
 }
 
-func (x *CloudRunResourceState) Reset() {
-	*x = CloudRunResourceState{}
+func (x *FlyIOResourceState) Reset() {
+	*x = FlyIOResourceState{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pkg_model_application_live_state_proto_msgTypes[8]
+		mi := &file_pkg_model_application_live_state_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CloudRunResourceState) String() string {
+func (x *FlyIOResourceState) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CloudRunResourceState) ProtoMessage() {}
+func (*FlyIOResourceState) ProtoMessage() {}
 
-func (x *CloudRunResourceState) ProtoReflect() protoreflect.Message {
-	mi := &file_pkg_model_application_live_state_proto_msgTypes[8]
+func (x *FlyIOResourceState) ProtoReflect() protoreflect.Message {
+	mi := &file_pkg_model_application_live_state_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,82 +2425,68 @@ func (x *CloudRunResourceState) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CloudRunResourceState.ProtoReflect.Descriptor instead.
-func (*CloudRunResourceState) Descriptor() ([]byte, []int) {
-	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use FlyIOResourceState.ProtoReflect.Descriptor instead.
+func (*FlyIOResourceState) Descriptor() ([]byte, []int) {
+	return file_pkg_model_application_live_state_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *CloudRunResourceState) GetId() string {
+func (x *FlyIOResourceState) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *CloudRunResourceState) GetOwnerIds() []string {
+func (x *FlyIOResourceState) GetOwnerIds() []string {
 	if x != nil {
 		return x.OwnerIds
 	}
 	return nil
 }
 
-func (x *CloudRunResourceState) GetParentIds() []string {
+func (x *FlyIOResourceState) GetParentIds() []string {
 	if x != nil {
 		return x.ParentIds
 	}
 	return nil
 }
 
-func (x *CloudRunResourceState) GetName() string {
+func (x *FlyIOResourceState) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *CloudRunResourceState) GetApiVersion() string {
-	if x != nil {
-		return x.ApiVersion
-	}
-	return ""
-}
-
-func (x *CloudRunResourceState) GetKind() string {
+func (x *FlyIOResourceState) GetKind() string {
 	if x != nil {
 		return x.Kind
 	}
 	return ""
 }
 
-func (x *CloudRunResourceState) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *CloudRunResourceState) GetHealthStatus() CloudRunResourceState_HealthStatus {
+func (x *FlyIOResourceState) GetHealthStatus() FlyIOResourceState_HealthStatus {
 	if x != nil {
 		return x.HealthStatus
 	}
-	return CloudRunResourceState_UNKNOWN
+	return FlyIOResourceState_UNKNOWN
 }
 
-func (x *CloudRunResourceState) GetHealthDescription() string {
+func (x *FlyIOResourceState) GetHealthDescription() string {
 	if x != nil {
 		return x.HealthDescription
 	}
 	return ""
 }
 
-func (x *CloudRunResourceState) GetCreatedAt() int64 {
+func (x *FlyIOResourceState) GetCreatedAt() int64 {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *CloudRunResourceState) GetUpdatedAt() int64 {
+func (x *FlyIOResourceState) GetUpdatedAt() int64 {
 	if x != nil {
 		return x.UpdatedAt
 	}
@@ -951,7 +2502,7 @@ var file_pkg_model_application_live_state_proto_rawDesc = []byte{
 	0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
 	0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f,
 	0x64, 0x65, 0x6c, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0xa5, 0x05, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xdb, 0x07, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
 	0x74, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
@@ -985,38 +2536,178 @@ var file_pkg_model_application_live_state_proto_rawDesc = []byte{
 	0x12, 0x39, 0x0a, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x21, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x41,
 	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x12, 0x46, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
+	0x61, 0x74, 0x65, 0x52, 0x06, 0x6c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x12, 0x30, 0x0a, 0x03, 0x65,
+	0x63, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x45, 0x43, 0x53, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
+	0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x03, 0x65, 0x63, 0x73, 0x12, 0x36, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6d, 0x61, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x6f, 0x6d, 0x61, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05,
+	0x6e, 0x6f, 0x6d, 0x61, 0x64, 0x12, 0x4e, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x61, 0x70, 0x70, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x70,
+	0x70, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x61, 0x70, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x09, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x41, 0x70, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09,
+	0x61, 0x70, 0x70, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x05, 0x66, 0x6c, 0x79,
+	0x69, 0x6f, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x46, 0x6c, 0x79, 0x49, 0x4f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x66, 0x6c, 0x79, 0x69,
+	0x6f, 0x12, 0x46, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2d, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a,
+	0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x63,
+	0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x22, 0x5e, 0x0a, 0x1e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c,
+	0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x22, 0x5a, 0x0a, 0x1c, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e,
+	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x61, 0x6d, 0x62, 0x64, 0x61, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x50,
+	0x0a, 0x17, 0x45, 0x43, 0x53, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x43, 0x53, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0x54, 0x0a, 0x19, 0x4e, 0x6f, 0x6d, 0x61, 0x64, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x6f, 0x6d, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x21, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x41, 0x70, 0x70, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x09, 0x72,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
+	0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x5c, 0x0a, 0x1d,
+	0x41, 0x70, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x54, 0x0a, 0x19, 0x46, 0x6c,
+	0x79, 0x49, 0x4f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x46, 0x6c, 0x79, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73,
+	0x22, 0xff, 0x04, 0x0a, 0x17, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x28,
+	0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x61, 0x70,
+	0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10,
+	0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36,
+	0x0a, 0x09, 0x70, 0x6f, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e,
+	0x65, 0x74, 0x65, 0x73, 0x50, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x08, 0x70, 0x6f,
+	0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45, 0x0a, 0x0e, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e,
+	0x67, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x0d,
+	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
+	0x20, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a,
+	0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a,
 	0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45,
 	0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52,
-	0x10, 0x02, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x22, 0x63, 0x0a, 0x1b, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
-	0x02, 0x20, 0x00, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d,
-	0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x22, 0x02, 0x28, 0x00, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x5e, 0x0a,
-	0x1e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x3c, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x52, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x1f, 0x0a,
-	0x1d, 0x54, 0x65, 0x72, 0x72, 0x61, 0x66, 0x6f, 0x72, 0x6d, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x5a,
-	0x0a, 0x1c, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3a,
-	0x0a, 0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52,
-	0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
-	0x09, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x61,
-	0x6d, 0x62, 0x64, 0x61, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c,
-	0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x80, 0x04, 0x0a, 0x17, 0x4b, 0x75, 0x62,
-	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x10, 0x02, 0x22, 0x6b, 0x0a, 0x12, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73,
+	0x50, 0x6f, 0x64, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x3f,
+	0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22,
+	0xb1, 0x01, 0x0a, 0x18, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x88, 0x01, 0x0a, 0x17, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x99,
+	0x03, 0x0a, 0x1c, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12,
+	0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b,
+	0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65,
+	0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x3e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x57, 0x0a, 0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08,
+	0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x27, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x44, 0x44,
+	0x5f, 0x4f, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0x83, 0x05, 0x0a, 0x15, 0x43,
+	0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
 	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
 	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
@@ -1030,67 +2721,126 @@ var file_pkg_model_application_live_state_proto_rawDesc = []byte{
 	0x12, 0x1b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
 	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
 	0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x68,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x68,
 	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72,
-	0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42,
-	0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
-	0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26,
-	0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
-	0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01,
-	0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x22, 0x99, 0x03, 0x0a, 0x1c,
-	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x17, 0x0a, 0x02,
+	0x28, 0x0e, 0x32, 0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64,
+	0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa,
+	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x43, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02,
+	0x22, 0xf6, 0x01, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x72,
+	0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x50, 0x65, 0x72, 0x63,
+	0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x73, 0x12, 0x33, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x63,
+	0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x14, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f,
+	0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x44, 0x69, 0x67, 0x65, 0x73, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x19, 0x43, 0x6c,
+	0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x22, 0xaa, 0x03, 0x0a, 0x10, 0x45, 0x43, 0x53, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02,
 	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x0e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x0d, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x46, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x28, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65,
-	0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x42, 0x08, 0xfa,
-	0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x3e, 0x0a,
-	0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d,
-	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x08, 0xfa, 0x42,
-	0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a,
-	0x10, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
-	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x76, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x42, 0x08, 0xfa, 0x42, 0x05,
-	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x56,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
-	0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x27,
-	0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x44, 0x44, 0x5f, 0x4f, 0x52,
-	0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45,
-	0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x22, 0xfc, 0x03, 0x0a, 0x15, 0x43, 0x6c, 0x6f, 0x75,
-	0x64, 0x52, 0x75, 0x6e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77,
-	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x0b, 0x61, 0x70, 0x69, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
-	0x01, 0x52, 0x0a, 0x61, 0x70, 0x69, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a,
-	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
-	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61,
-	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x29, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x75, 0x64, 0x52, 0x75, 0x6e,
+	0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49,
+	0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64,
+	0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x53, 0x0a, 0x0d, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x45, 0x43, 0x53, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02,
+	0x10, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x33, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48,
+	0x45, 0x52, 0x10, 0x02, 0x22, 0xae, 0x03, 0x0a, 0x12, 0x4e, 0x6f, 0x6d, 0x61, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73,
+	0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x68, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x26, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4e, 0x6f, 0x6d, 0x61, 0x64, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01,
+	0x02, 0x10, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x33, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54,
+	0x48, 0x45, 0x52, 0x10, 0x02, 0x22, 0xbe, 0x03, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b,
+	0x69, 0x6e, 0x64, 0x12, 0x5d, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x6d, 0x6f, 0x64,
+	0x65, 0x6c, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x70, 0x70, 0x73,
 	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65,
 	0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82,
 	0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74,
@@ -1105,10 +2855,64 @@ var file_pkg_model_application_live_state_proto_rawDesc = []byte{
 	0x74, 0x22, 0x33, 0x0a, 0x0c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75,
 	0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b,
 	0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f,
-	0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70,
-	0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x22, 0xb6, 0x03, 0x0a, 0x16, 0x41, 0x70, 0x70, 0x45, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa,
+	0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x77,
+	0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x12, 0x59, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2a, 0x2e, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x2e,
+	0x41, 0x70, 0x70, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x44,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c, 0x54,
+	0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02, 0x22,
+	0xae, 0x03, 0x0a, 0x12, 0x46, 0x6c, 0x79, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x17, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x1b, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02,
+	0x10, 0x01, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x12, 0x55, 0x0a, 0x0d, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x6d,
+	0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x46, 0x6c, 0x79, 0x49, 0x4f, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0c,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x26, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x0c, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x55,
+	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x59, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x02,
+	0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70,
+	0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1123,44 +2927,84 @@ func file_pkg_model_application_live_state_proto_rawDescGZIP() []byte {
 	return file_pkg_model_application_live_state_proto_rawDescData
 }
 
-var file_pkg_model_application_live_state_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pkg_model_application_live_state_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_pkg_model_application_live_state_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_pkg_model_application_live_state_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_pkg_model_application_live_state_proto_goTypes = []interface{}{
-	(ApplicationLiveStateSnapshot_Status)(0),  // 0: model.ApplicationLiveStateSnapshot.Status
-	(KubernetesResourceState_HealthStatus)(0), // 1: model.KubernetesResourceState.HealthStatus
-	(KubernetesResourceStateEvent_Type)(0),    // 2: model.KubernetesResourceStateEvent.Type
-	(CloudRunResourceState_HealthStatus)(0),   // 3: model.CloudRunResourceState.HealthStatus
-	(*ApplicationLiveStateSnapshot)(nil),      // 4: model.ApplicationLiveStateSnapshot
-	(*ApplicationLiveStateVersion)(nil),       // 5: model.ApplicationLiveStateVersion
-	(*KubernetesApplicationLiveState)(nil),    // 6: model.KubernetesApplicationLiveState
-	(*TerraformApplicationLiveState)(nil),     // 7: model.TerraformApplicationLiveState
-	(*CloudRunApplicationLiveState)(nil),      // 8: model.CloudRunApplicationLiveState
-	(*LambdaApplicationLiveState)(nil),        // 9: model.LambdaApplicationLiveState
-	(*KubernetesResourceState)(nil),           // 10: model.KubernetesResourceState
-	(*KubernetesResourceStateEvent)(nil),      // 11: model.KubernetesResourceStateEvent
-	(*CloudRunResourceState)(nil),             // 12: model.CloudRunResourceState
-	(ApplicationKind)(0),                      // 13: model.ApplicationKind
+	(ApplicationLiveStateSnapshot_Status)(0),     // 0: model.ApplicationLiveStateSnapshot.Status
+	(KubernetesResourceState_HealthStatus)(0),    // 1: model.KubernetesResourceState.HealthStatus
+	(KubernetesResourceStateEvent_Type)(0),       // 2: model.KubernetesResourceStateEvent.Type
+	(CloudRunResourceState_HealthStatus)(0),      // 3: model.CloudRunResourceState.HealthStatus
+	(ECSResourceState_HealthStatus)(0),           // 4: model.ECSResourceState.HealthStatus
+	(NomadResourceState_HealthStatus)(0),         // 5: model.NomadResourceState.HealthStatus
+	(ContainerAppsResourceState_HealthStatus)(0), // 6: model.ContainerAppsResourceState.HealthStatus
+	(AppEngineResourceState_HealthStatus)(0),     // 7: model.AppEngineResourceState.HealthStatus
+	(FlyIOResourceState_HealthStatus)(0),         // 8: model.FlyIOResourceState.HealthStatus
+	(*ApplicationLiveStateSnapshot)(nil),         // 9: model.ApplicationLiveStateSnapshot
+	(*ApplicationLiveStateVersion)(nil),          // 10: model.ApplicationLiveStateVersion
+	(*KubernetesApplicationLiveState)(nil),       // 11: model.KubernetesApplicationLiveState
+	(*TerraformApplicationLiveState)(nil),        // 12: model.TerraformApplicationLiveState
+	(*CloudRunApplicationLiveState)(nil),         // 13: model.CloudRunApplicationLiveState
+	(*LambdaApplicationLiveState)(nil),           // 14: model.LambdaApplicationLiveState
+	(*ECSApplicationLiveState)(nil),              // 15: model.ECSApplicationLiveState
+	(*NomadApplicationLiveState)(nil),            // 16: model.NomadApplicationLiveState
+	(*ContainerAppsApplicationLiveState)(nil),    // 17: model.ContainerAppsApplicationLiveState
+	(*AppEngineApplicationLiveState)(nil),        // 18: model.AppEngineApplicationLiveState
+	(*FlyIOApplicationLiveState)(nil),            // 19: model.FlyIOApplicationLiveState
+	(*KubernetesResourceState)(nil),              // 20: model.KubernetesResourceState
+	(*KubernetesPodState)(nil),                   // 21: model.KubernetesPodState
+	(*KubernetesContainerState)(nil),             // 22: model.KubernetesContainerState
+	(*KubernetesResourceEvent)(nil),              // 23: model.KubernetesResourceEvent
+	(*KubernetesResourceStateEvent)(nil),         // 24: model.KubernetesResourceStateEvent
+	(*CloudRunResourceState)(nil),                // 25: model.CloudRunResourceState
+	(*CloudRunRevisionState)(nil),                // 26: model.CloudRunRevisionState
+	(*CloudRunResourceCondition)(nil),            // 27: model.CloudRunResourceCondition
+	(*ECSResourceState)(nil),                     // 28: model.ECSResourceState
+	(*NomadResourceState)(nil),                   // 29: model.NomadResourceState
+	(*ContainerAppsResourceState)(nil),           // 30: model.ContainerAppsResourceState
+	(*AppEngineResourceState)(nil),               // 31: model.AppEngineResourceState
+	(*FlyIOResourceState)(nil),                   // 32: model.FlyIOResourceState
+	(ApplicationKind)(0),                         // 33: model.ApplicationKind
 }
 var file_pkg_model_application_live_state_proto_depIdxs = []int32{
-	13, // 0: model.ApplicationLiveStateSnapshot.kind:type_name -> model.ApplicationKind
+	33, // 0: model.ApplicationLiveStateSnapshot.kind:type_name -> model.ApplicationKind
 	0,  // 1: model.ApplicationLiveStateSnapshot.health_status:type_name -> model.ApplicationLiveStateSnapshot.Status
-	6,  // 2: model.ApplicationLiveStateSnapshot.kubernetes:type_name -> model.KubernetesApplicationLiveState
-	7,  // 3: model.ApplicationLiveStateSnapshot.terraform:type_name -> model.TerraformApplicationLiveState
-	8,  // 4: model.ApplicationLiveStateSnapshot.cloudrun:type_name -> model.CloudRunApplicationLiveState
-	9,  // 5: model.ApplicationLiveStateSnapshot.lambda:type_name -> model.LambdaApplicationLiveState
-	5,  // 6: model.ApplicationLiveStateSnapshot.version:type_name -> model.ApplicationLiveStateVersion
-	10, // 7: model.KubernetesApplicationLiveState.resources:type_name -> model.KubernetesResourceState
-	12, // 8: model.CloudRunApplicationLiveState.resources:type_name -> model.CloudRunResourceState
-	1,  // 9: model.KubernetesResourceState.health_status:type_name -> model.KubernetesResourceState.HealthStatus
-	2,  // 10: model.KubernetesResourceStateEvent.type:type_name -> model.KubernetesResourceStateEvent.Type
-	10, // 11: model.KubernetesResourceStateEvent.state:type_name -> model.KubernetesResourceState
-	5,  // 12: model.KubernetesResourceStateEvent.snapshot_version:type_name -> model.ApplicationLiveStateVersion
-	3,  // 13: model.CloudRunResourceState.health_status:type_name -> model.CloudRunResourceState.HealthStatus
-	14, // [14:14] is the sub-list for method output_type
-	14, // [14:14] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	11, // 2: model.ApplicationLiveStateSnapshot.kubernetes:type_name -> model.KubernetesApplicationLiveState
+	12, // 3: model.ApplicationLiveStateSnapshot.terraform:type_name -> model.TerraformApplicationLiveState
+	13, // 4: model.ApplicationLiveStateSnapshot.cloudrun:type_name -> model.CloudRunApplicationLiveState
+	14, // 5: model.ApplicationLiveStateSnapshot.lambda:type_name -> model.LambdaApplicationLiveState
+	15, // 6: model.ApplicationLiveStateSnapshot.ecs:type_name -> model.ECSApplicationLiveState
+	16, // 7: model.ApplicationLiveStateSnapshot.nomad:type_name -> model.NomadApplicationLiveState
+	17, // 8: model.ApplicationLiveStateSnapshot.containerapps:type_name -> model.ContainerAppsApplicationLiveState
+	18, // 9: model.ApplicationLiveStateSnapshot.appengine:type_name -> model.AppEngineApplicationLiveState
+	19, // 10: model.ApplicationLiveStateSnapshot.flyio:type_name -> model.FlyIOApplicationLiveState
+	10, // 11: model.ApplicationLiveStateSnapshot.version:type_name -> model.ApplicationLiveStateVersion
+	20, // 12: model.KubernetesApplicationLiveState.resources:type_name -> model.KubernetesResourceState
+	25, // 13: model.CloudRunApplicationLiveState.resources:type_name -> model.CloudRunResourceState
+	28, // 14: model.ECSApplicationLiveState.resources:type_name -> model.ECSResourceState
+	29, // 15: model.NomadApplicationLiveState.resources:type_name -> model.NomadResourceState
+	30, // 16: model.ContainerAppsApplicationLiveState.resources:type_name -> model.ContainerAppsResourceState
+	31, // 17: model.AppEngineApplicationLiveState.resources:type_name -> model.AppEngineResourceState
+	32, // 18: model.FlyIOApplicationLiveState.resources:type_name -> model.FlyIOResourceState
+	1,  // 19: model.KubernetesResourceState.health_status:type_name -> model.KubernetesResourceState.HealthStatus
+	21, // 20: model.KubernetesResourceState.pod_state:type_name -> model.KubernetesPodState
+	23, // 21: model.KubernetesResourceState.warning_events:type_name -> model.KubernetesResourceEvent
+	22, // 22: model.KubernetesPodState.containers:type_name -> model.KubernetesContainerState
+	2,  // 23: model.KubernetesResourceStateEvent.type:type_name -> model.KubernetesResourceStateEvent.Type
+	20, // 24: model.KubernetesResourceStateEvent.state:type_name -> model.KubernetesResourceState
+	10, // 25: model.KubernetesResourceStateEvent.snapshot_version:type_name -> model.ApplicationLiveStateVersion
+	3,  // 26: model.CloudRunResourceState.health_status:type_name -> model.CloudRunResourceState.HealthStatus
+	26, // 27: model.CloudRunResourceState.revision_state:type_name -> model.CloudRunRevisionState
+	27, // 28: model.CloudRunResourceState.conditions:type_name -> model.CloudRunResourceCondition
+	4,  // 29: model.ECSResourceState.health_status:type_name -> model.ECSResourceState.HealthStatus
+	5,  // 30: model.NomadResourceState.health_status:type_name -> model.NomadResourceState.HealthStatus
+	6,  // 31: model.ContainerAppsResourceState.health_status:type_name -> model.ContainerAppsResourceState.HealthStatus
+	7,  // 32: model.AppEngineResourceState.health_status:type_name -> model.AppEngineResourceState.HealthStatus
+	8,  // 33: model.FlyIOResourceState.health_status:type_name -> model.FlyIOResourceState.HealthStatus
+	34, // [34:34] is the sub-list for method output_type
+	34, // [34:34] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_pkg_model_application_live_state_proto_init() }
@@ -1243,7 +3087,7 @@ func file_pkg_model_application_live_state_proto_init() {
 			}
 		}
 		file_pkg_model_application_live_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KubernetesResourceState); i {
+			switch v := v.(*ECSApplicationLiveState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1255,7 +3099,7 @@ func file_pkg_model_application_live_state_proto_init() {
 			}
 		}
 		file_pkg_model_application_live_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KubernetesResourceStateEvent); i {
+			switch v := v.(*NomadApplicationLiveState); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1267,6 +3111,102 @@ func file_pkg_model_application_live_state_proto_init() {
 			}
 		}
 		file_pkg_model_application_live_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerAppsApplicationLiveState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEngineApplicationLiveState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlyIOApplicationLiveState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesPodState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesContainerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesResourceEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KubernetesResourceStateEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CloudRunResourceState); i {
 			case 0:
 				return &v.state
@@ -1278,14 +3218,98 @@ func file_pkg_model_application_live_state_proto_init() {
 				return nil
 			}
 		}
+		file_pkg_model_application_live_state_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudRunRevisionState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloudRunResourceCondition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ECSResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NomadResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContainerAppsResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppEngineResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pkg_model_application_live_state_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlyIOResourceState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pkg_model_application_live_state_proto_rawDesc,
-			NumEnums:      4,
-			NumMessages:   9,
+			NumEnums:      9,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -219,6 +219,151 @@ func (m *ApplicationLiveStateSnapshot) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetEcs()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Ecs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Ecs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEcs()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationLiveStateSnapshotValidationError{
+				field:  "Ecs",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNomad()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Nomad",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Nomad",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNomad()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationLiveStateSnapshotValidationError{
+				field:  "Nomad",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetContainerapps()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Containerapps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Containerapps",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetContainerapps()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationLiveStateSnapshotValidationError{
+				field:  "Containerapps",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAppengine()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Appengine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Appengine",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAppengine()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationLiveStateSnapshotValidationError{
+				field:  "Appengine",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetFlyio()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Flyio",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ApplicationLiveStateSnapshotValidationError{
+					field:  "Flyio",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFlyio()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ApplicationLiveStateSnapshotValidationError{
+				field:  "Flyio",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if m.GetVersion() == nil {
 		err := ApplicationLiveStateSnapshotValidationError{
 			field:  "Version",
@@ -944,123 +1089,76 @@ var _ interface {
 	ErrorName() string
 } = LambdaApplicationLiveStateValidationError{}
 
-// Validate checks the field values on KubernetesResourceState with the rules
+// Validate checks the field values on ECSApplicationLiveState with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *KubernetesResourceState) Validate() error {
+func (m *ECSApplicationLiveState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on KubernetesResourceState with the
+// ValidateAll checks the field values on ECSApplicationLiveState with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// KubernetesResourceStateMultiError, or nil if none found.
-func (m *KubernetesResourceState) ValidateAll() error {
+// ECSApplicationLiveStateMultiError, or nil if none found.
+func (m *ECSApplicationLiveState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *KubernetesResourceState) validate(all bool) error {
+func (m *ECSApplicationLiveState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := KubernetesResourceStateValidationError{
-			field:  "Id",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := KubernetesResourceStateValidationError{
-			field:  "Name",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetApiVersion()) < 1 {
-		err := KubernetesResourceStateValidationError{
-			field:  "ApiVersion",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetKind()) < 1 {
-		err := KubernetesResourceStateValidationError{
-			field:  "Kind",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for Namespace
-
-	if _, ok := KubernetesResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
-		err := KubernetesResourceStateValidationError{
-			field:  "HealthStatus",
-			reason: "value must be one of the defined enum values",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for HealthDescription
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
 
-	if m.GetCreatedAt() <= 0 {
-		err := KubernetesResourceStateValidationError{
-			field:  "CreatedAt",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ECSApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ECSApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ECSApplicationLiveStateValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
-	}
 
-	if m.GetUpdatedAt() <= 0 {
-		err := KubernetesResourceStateValidationError{
-			field:  "UpdatedAt",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return KubernetesResourceStateMultiError(errors)
+		return ECSApplicationLiveStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// KubernetesResourceStateMultiError is an error wrapping multiple validation
-// errors returned by KubernetesResourceState.ValidateAll() if the designated
+// ECSApplicationLiveStateMultiError is an error wrapping multiple validation
+// errors returned by ECSApplicationLiveState.ValidateAll() if the designated
 // constraints aren't met.
-type KubernetesResourceStateMultiError []error
+type ECSApplicationLiveStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m KubernetesResourceStateMultiError) Error() string {
+func (m ECSApplicationLiveStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1069,11 +1167,11 @@ func (m KubernetesResourceStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m KubernetesResourceStateMultiError) AllErrors() []error { return m }
+func (m ECSApplicationLiveStateMultiError) AllErrors() []error { return m }
 
-// KubernetesResourceStateValidationError is the validation error returned by
-// KubernetesResourceState.Validate if the designated constraints aren't met.
-type KubernetesResourceStateValidationError struct {
+// ECSApplicationLiveStateValidationError is the validation error returned by
+// ECSApplicationLiveState.Validate if the designated constraints aren't met.
+type ECSApplicationLiveStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1081,24 +1179,24 @@ type KubernetesResourceStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e KubernetesResourceStateValidationError) Field() string { return e.field }
+func (e ECSApplicationLiveStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e KubernetesResourceStateValidationError) Reason() string { return e.reason }
+func (e ECSApplicationLiveStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e KubernetesResourceStateValidationError) Cause() error { return e.cause }
+func (e ECSApplicationLiveStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e KubernetesResourceStateValidationError) Key() bool { return e.key }
+func (e ECSApplicationLiveStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e KubernetesResourceStateValidationError) ErrorName() string {
-	return "KubernetesResourceStateValidationError"
+func (e ECSApplicationLiveStateValidationError) ErrorName() string {
+	return "ECSApplicationLiveStateValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e KubernetesResourceStateValidationError) Error() string {
+func (e ECSApplicationLiveStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1110,14 +1208,14 @@ func (e KubernetesResourceStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sKubernetesResourceState.%s: %s%s",
+		"invalid %sECSApplicationLiveState.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = KubernetesResourceStateValidationError{}
+var _ error = ECSApplicationLiveStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -1125,32 +1223,2261 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = KubernetesResourceStateValidationError{}
+} = ECSApplicationLiveStateValidationError{}
 
-// Validate checks the field values on KubernetesResourceStateEvent with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on NomadApplicationLiveState with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *KubernetesResourceStateEvent) Validate() error {
+func (m *NomadApplicationLiveState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on KubernetesResourceStateEvent with the
+// ValidateAll checks the field values on NomadApplicationLiveState with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// KubernetesResourceStateEventMultiError, or nil if none found.
-func (m *KubernetesResourceStateEvent) ValidateAll() error {
+// NomadApplicationLiveStateMultiError, or nil if none found.
+func (m *NomadApplicationLiveState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *KubernetesResourceStateEvent) validate(all bool) error {
+func (m *NomadApplicationLiveState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetId()) < 1 {
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, NomadApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, NomadApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return NomadApplicationLiveStateValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return NomadApplicationLiveStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// NomadApplicationLiveStateMultiError is an error wrapping multiple validation
+// errors returned by NomadApplicationLiveState.ValidateAll() if the
+// designated constraints aren't met.
+type NomadApplicationLiveStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NomadApplicationLiveStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NomadApplicationLiveStateMultiError) AllErrors() []error { return m }
+
+// NomadApplicationLiveStateValidationError is the validation error returned by
+// NomadApplicationLiveState.Validate if the designated constraints aren't met.
+type NomadApplicationLiveStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NomadApplicationLiveStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NomadApplicationLiveStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NomadApplicationLiveStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NomadApplicationLiveStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NomadApplicationLiveStateValidationError) ErrorName() string {
+	return "NomadApplicationLiveStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NomadApplicationLiveStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectRBACRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NomadApplicationLiveStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NomadApplicationLiveStateValidationError{}
+
+// Validate checks the field values on KubernetesResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesResourceState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesResourceStateMultiError, or nil if none found.
+func (m *KubernetesResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := KubernetesResourceStateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := KubernetesResourceStateValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApiVersion()) < 1 {
+		err := KubernetesResourceStateValidationError{
+			field:  "ApiVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := KubernetesResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Namespace
+
+	if _, ok := KubernetesResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := KubernetesResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HealthDescription
+
+	if all {
+		switch v := interface{}(m.GetPodState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KubernetesResourceStateValidationError{
+					field:  "PodState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KubernetesResourceStateValidationError{
+					field:  "PodState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPodState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KubernetesResourceStateValidationError{
+				field:  "PodState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetWarningEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KubernetesResourceStateValidationError{
+						field:  fmt.Sprintf("WarningEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KubernetesResourceStateValidationError{
+						field:  fmt.Sprintf("WarningEvents[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KubernetesResourceStateValidationError{
+					field:  fmt.Sprintf("WarningEvents[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetCreatedAt() <= 0 {
+		err := KubernetesResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := KubernetesResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KubernetesResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesResourceStateMultiError is an error wrapping multiple validation
+// errors returned by KubernetesResourceState.ValidateAll() if the designated
+// constraints aren't met.
+type KubernetesResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesResourceStateMultiError) AllErrors() []error { return m }
+
+// KubernetesResourceStateValidationError is the validation error returned by
+// KubernetesResourceState.Validate if the designated constraints aren't met.
+type KubernetesResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesResourceStateValidationError) ErrorName() string {
+	return "KubernetesResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesResourceState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesResourceStateValidationError{}
+
+// Validate checks the field values on KubernetesPodState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesPodState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesPodState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesPodStateMultiError, or nil if none found.
+func (m *KubernetesPodState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesPodState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Phase
+
+	for idx, item := range m.GetContainers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, KubernetesPodStateValidationError{
+						field:  fmt.Sprintf("Containers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, KubernetesPodStateValidationError{
+						field:  fmt.Sprintf("Containers[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return KubernetesPodStateValidationError{
+					field:  fmt.Sprintf("Containers[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return KubernetesPodStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesPodStateMultiError is an error wrapping multiple validation errors
+// returned by KubernetesPodState.ValidateAll() if the designated constraints
+// aren't met.
+type KubernetesPodStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesPodStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesPodStateMultiError) AllErrors() []error { return m }
+
+// KubernetesPodStateValidationError is the validation error returned by
+// KubernetesPodState.Validate if the designated constraints aren't met.
+type KubernetesPodStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesPodStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesPodStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesPodStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesPodStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesPodStateValidationError) ErrorName() string {
+	return "KubernetesPodStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesPodStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesPodState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesPodStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesPodStateValidationError{}
+
+var _KubernetesPodState_Path_Pattern = regexp.MustCompile("^[^/].+$")
+
+// Validate checks the field values on KubernetesContainerState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesContainerState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesContainerState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesContainerStateMultiError, or nil if none found.
+func (m *KubernetesContainerState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesContainerState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Ready
+
+	// no validation rules for RestartCount
+
+	// no validation rules for State
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return KubernetesContainerStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesContainerStateMultiError is an error wrapping multiple validation
+// errors returned by KubernetesContainerState.ValidateAll() if the designated
+// constraints aren't met.
+type KubernetesContainerStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesContainerStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesContainerStateMultiError) AllErrors() []error { return m }
+
+// KubernetesContainerStateValidationError is the validation error returned by
+// KubernetesContainerState.Validate if the designated constraints aren't met.
+type KubernetesContainerStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesContainerStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesContainerStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesContainerStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesContainerStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesContainerStateValidationError) ErrorName() string {
+	return "KubernetesContainerStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesContainerStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesContainerState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesContainerStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesContainerStateValidationError{}
+
+// Validate checks the field values on KubernetesResourceEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesResourceEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesResourceEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesResourceEventMultiError, or nil if none found.
+func (m *KubernetesResourceEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesResourceEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for Count
+
+	// no validation rules for LastTimestamp
+
+	if len(errors) > 0 {
+		return KubernetesResourceEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesResourceEventMultiError is an error wrapping multiple validation
+// errors returned by KubernetesResourceEvent.ValidateAll() if the designated
+// constraints aren't met.
+type KubernetesResourceEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesResourceEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesResourceEventMultiError) AllErrors() []error { return m }
+
+// KubernetesResourceEventValidationError is the validation error returned by
+// KubernetesResourceEvent.Validate if the designated constraints aren't met.
+type KubernetesResourceEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesResourceEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesResourceEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesResourceEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesResourceEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesResourceEventValidationError) ErrorName() string {
+	return "KubernetesResourceEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesResourceEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesResourceEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesResourceEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesResourceEventValidationError{}
+
+// Validate checks the field values on KubernetesResourceStateEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *KubernetesResourceStateEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KubernetesResourceStateEvent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KubernetesResourceStateEventMultiError, or nil if none found.
+func (m *KubernetesResourceStateEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KubernetesResourceStateEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := KubernetesResourceStateEventValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
+		err := KubernetesResourceStateEventValidationError{
+			field:  "ApplicationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := KubernetesResourceStateEvent_Type_name[int32(m.GetType())]; !ok {
+		err := KubernetesResourceStateEventValidationError{
+			field:  "Type",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetState() == nil {
+		err := KubernetesResourceStateEventValidationError{
+			field:  "State",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KubernetesResourceStateEventValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KubernetesResourceStateEventValidationError{
+					field:  "State",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KubernetesResourceStateEventValidationError{
+				field:  "State",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetSnapshotVersion() == nil {
+		err := KubernetesResourceStateEventValidationError{
+			field:  "SnapshotVersion",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetSnapshotVersion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KubernetesResourceStateEventValidationError{
+					field:  "SnapshotVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KubernetesResourceStateEventValidationError{
+					field:  "SnapshotVersion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSnapshotVersion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KubernetesResourceStateEventValidationError{
+				field:  "SnapshotVersion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetCreatedAt() <= 0 {
 		err := KubernetesResourceStateEventValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KubernetesResourceStateEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// KubernetesResourceStateEventMultiError is an error wrapping multiple
+// validation errors returned by KubernetesResourceStateEvent.ValidateAll() if
+// the designated constraints aren't met.
+type KubernetesResourceStateEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KubernetesResourceStateEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KubernetesResourceStateEventMultiError) AllErrors() []error { return m }
+
+// KubernetesResourceStateEventValidationError is the validation error returned
+// by KubernetesResourceStateEvent.Validate if the designated constraints
+// aren't met.
+type KubernetesResourceStateEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KubernetesResourceStateEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KubernetesResourceStateEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KubernetesResourceStateEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KubernetesResourceStateEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KubernetesResourceStateEventValidationError) ErrorName() string {
+	return "KubernetesResourceStateEventValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KubernetesResourceStateEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKubernetesResourceStateEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KubernetesResourceStateEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KubernetesResourceStateEventValidationError{}
+
+// Validate checks the field values on CloudRunResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloudRunResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloudRunResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloudRunResourceStateMultiError, or nil if none found.
+func (m *CloudRunResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloudRunResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := CloudRunResourceStateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := CloudRunResourceStateValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApiVersion()) < 1 {
+		err := CloudRunResourceStateValidationError{
+			field:  "ApiVersion",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := CloudRunResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Namespace
+
+	if _, ok := CloudRunResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := CloudRunResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HealthDescription
+
+	if all {
+		switch v := interface{}(m.GetRevisionState()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CloudRunResourceStateValidationError{
+					field:  "RevisionState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CloudRunResourceStateValidationError{
+					field:  "RevisionState",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRevisionState()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CloudRunResourceStateValidationError{
+				field:  "RevisionState",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetConditions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CloudRunResourceStateValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CloudRunResourceStateValidationError{
+						field:  fmt.Sprintf("Conditions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CloudRunResourceStateValidationError{
+					field:  fmt.Sprintf("Conditions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if m.GetCreatedAt() <= 0 {
+		err := CloudRunResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := CloudRunResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CloudRunResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloudRunResourceStateMultiError is an error wrapping multiple validation
+// errors returned by CloudRunResourceState.ValidateAll() if the designated
+// constraints aren't met.
+type CloudRunResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloudRunResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloudRunResourceStateMultiError) AllErrors() []error { return m }
+
+// CloudRunResourceStateValidationError is the validation error returned by
+// CloudRunResourceState.Validate if the designated constraints aren't met.
+type CloudRunResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloudRunResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloudRunResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloudRunResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloudRunResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloudRunResourceStateValidationError) ErrorName() string {
+	return "CloudRunResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloudRunResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCloudRunResourceState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloudRunResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloudRunResourceStateValidationError{}
+
+// Validate checks the field values on CloudRunRevisionState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloudRunRevisionState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloudRunRevisionState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloudRunRevisionStateMultiError, or nil if none found.
+func (m *CloudRunRevisionState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloudRunRevisionState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TrafficPercent
+
+	// no validation rules for MinInstances
+
+	// no validation rules for MaxInstances
+
+	// no validation rules for ContainerConcurrency
+
+	// no validation rules for ImageDigest
+
+	if len(errors) > 0 {
+		return CloudRunRevisionStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloudRunRevisionStateMultiError is an error wrapping multiple validation
+// errors returned by CloudRunRevisionState.ValidateAll() if the designated
+// constraints aren't met.
+type CloudRunRevisionStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloudRunRevisionStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloudRunRevisionStateMultiError) AllErrors() []error { return m }
+
+// CloudRunRevisionStateValidationError is the validation error returned by
+// CloudRunRevisionState.Validate if the designated constraints aren't met.
+type CloudRunRevisionStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloudRunRevisionStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloudRunRevisionStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloudRunRevisionStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloudRunRevisionStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloudRunRevisionStateValidationError) ErrorName() string {
+	return "CloudRunRevisionStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloudRunRevisionStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddApplicationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloudRunRevisionStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloudRunRevisionStateValidationError{}
+
+// Validate checks the field values on CloudRunResourceCondition with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CloudRunResourceCondition) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CloudRunResourceCondition with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CloudRunResourceConditionMultiError, or nil if none found.
+func (m *CloudRunResourceCondition) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CloudRunResourceCondition) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for Message
+
+	// no validation rules for LastTransitionTime
+
+	if len(errors) > 0 {
+		return CloudRunResourceConditionMultiError(errors)
+	}
+
+	return nil
+}
+
+// CloudRunResourceConditionMultiError is an error wrapping multiple validation
+// errors returned by CloudRunResourceCondition.ValidateAll() if the
+// designated constraints aren't met.
+type CloudRunResourceConditionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CloudRunResourceConditionMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CloudRunResourceConditionMultiError) AllErrors() []error { return m }
+
+// CloudRunResourceConditionValidationError is the validation error returned by
+// CloudRunResourceCondition.Validate if the designated constraints aren't met.
+type CloudRunResourceConditionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CloudRunResourceConditionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CloudRunResourceConditionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CloudRunResourceConditionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CloudRunResourceConditionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CloudRunResourceConditionValidationError) ErrorName() string {
+	return "CloudRunResourceConditionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CloudRunResourceConditionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectRBACRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CloudRunResourceConditionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CloudRunResourceConditionValidationError{}
+
+// Validate checks the field values on ECSResourceState with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ECSResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ECSResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ECSResourceStateMultiError, or nil if none found.
+func (m *ECSResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ECSResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ECSResourceStateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ECSResourceStateValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := ECSResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ECSResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := ECSResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HealthDescription
+
+	if m.GetCreatedAt() <= 0 {
+		err := ECSResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := ECSResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ECSResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ECSResourceStateMultiError is an error wrapping multiple validation errors
+// returned by ECSResourceState.ValidateAll() if the designated constraints
+// aren't met.
+type ECSResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ECSResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ECSResourceStateMultiError) AllErrors() []error { return m }
+
+// ECSResourceStateValidationError is the validation error returned by
+// ECSResourceState.Validate if the designated constraints aren't met.
+type ECSResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ECSResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ECSResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ECSResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ECSResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ECSResourceStateValidationError) ErrorName() string {
+	return "ECSResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ECSResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sECSResourceState.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ECSResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ECSResourceStateValidationError{}
+
+// Validate checks the field values on NomadResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *NomadResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NomadResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NomadResourceStateMultiError, or nil if none found.
+func (m *NomadResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NomadResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := NomadResourceStateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := NomadResourceStateValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := NomadResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := NomadResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := NomadResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HealthDescription
+
+	if m.GetCreatedAt() <= 0 {
+		err := NomadResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := NomadResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return NomadResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// NomadResourceStateMultiError is an error wrapping multiple validation errors
+// returned by NomadResourceState.ValidateAll() if the designated constraints
+// aren't met.
+type NomadResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NomadResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NomadResourceStateMultiError) AllErrors() []error { return m }
+
+// NomadResourceStateValidationError is the validation error returned by
+// NomadResourceState.Validate if the designated constraints aren't met.
+type NomadResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NomadResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NomadResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NomadResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NomadResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NomadResourceStateValidationError) ErrorName() string {
+	return "NomadResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e NomadResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationGitPath.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NomadResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NomadResourceStateValidationError{}
+
+// Validate checks the field values on ContainerAppsApplicationLiveState with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ContainerAppsApplicationLiveState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContainerAppsApplicationLiveState
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ContainerAppsApplicationLiveStateMultiError, or nil if none found.
+func (m *ContainerAppsApplicationLiveState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContainerAppsApplicationLiveState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ContainerAppsApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ContainerAppsApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ContainerAppsApplicationLiveStateValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ContainerAppsApplicationLiveStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContainerAppsApplicationLiveStateMultiError is an error wrapping multiple
+// validation errors returned by
+// ContainerAppsApplicationLiveState.ValidateAll() if the designated
+// constraints aren't met.
+type ContainerAppsApplicationLiveStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContainerAppsApplicationLiveStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContainerAppsApplicationLiveStateMultiError) AllErrors() []error { return m }
+
+// ContainerAppsApplicationLiveStateValidationError is the validation error
+// returned by ContainerAppsApplicationLiveState.Validate if the designated
+// constraints aren't met.
+type ContainerAppsApplicationLiveStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContainerAppsApplicationLiveStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContainerAppsApplicationLiveStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContainerAppsApplicationLiveStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContainerAppsApplicationLiveStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContainerAppsApplicationLiveStateValidationError) ErrorName() string {
+	return "ContainerAppsApplicationLiveStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContainerAppsApplicationLiveStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetInsightApplicationCountRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContainerAppsApplicationLiveStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContainerAppsApplicationLiveStateValidationError{}
+
+// Validate checks the field values on ContainerAppsResourceState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ContainerAppsResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ContainerAppsResourceState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ContainerAppsResourceStateMultiError, or nil if none found.
+func (m *ContainerAppsResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ContainerAppsResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "Id",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ContainerAppsResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for HealthDescription
+
+	if m.GetCreatedAt() <= 0 {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetUpdatedAt() <= 0 {
+		err := ContainerAppsResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ContainerAppsResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// ContainerAppsResourceStateMultiError is an error wrapping multiple
+// validation errors returned by ContainerAppsResourceState.ValidateAll() if
+// the designated constraints aren't met.
+type ContainerAppsResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ContainerAppsResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ContainerAppsResourceStateMultiError) AllErrors() []error { return m }
+
+// ContainerAppsResourceStateValidationError is the validation error returned
+// by ContainerAppsResourceState.Validate if the designated constraints aren't met.
+type ContainerAppsResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ContainerAppsResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ContainerAppsResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ContainerAppsResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ContainerAppsResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ContainerAppsResourceStateValidationError) ErrorName() string {
+	return "ContainerAppsResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ContainerAppsResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProjectRBACRoleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ContainerAppsResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ContainerAppsResourceStateValidationError{}
+
+// Validate checks the field values on AppEngineApplicationLiveState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppEngineApplicationLiveState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppEngineApplicationLiveState with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AppEngineApplicationLiveStateMultiError, or nil if none found.
+func (m *AppEngineApplicationLiveState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppEngineApplicationLiveState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppEngineApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppEngineApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppEngineApplicationLiveStateValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AppEngineApplicationLiveStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppEngineApplicationLiveStateMultiError is an error wrapping multiple
+// validation errors returned by AppEngineApplicationLiveState.ValidateAll()
+// if the designated constraints aren't met.
+type AppEngineApplicationLiveStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppEngineApplicationLiveStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppEngineApplicationLiveStateMultiError) AllErrors() []error { return m }
+
+// AppEngineApplicationLiveStateValidationError is the validation error
+// returned by AppEngineApplicationLiveState.Validate if the designated
+// constraints aren't met.
+type AppEngineApplicationLiveStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppEngineApplicationLiveStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppEngineApplicationLiveStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppEngineApplicationLiveStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppEngineApplicationLiveStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppEngineApplicationLiveStateValidationError) ErrorName() string {
+	return "AppEngineApplicationLiveStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppEngineApplicationLiveStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateDeploymentChainResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppEngineApplicationLiveStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppEngineApplicationLiveStateValidationError{}
+
+// Validate checks the field values on AppEngineResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AppEngineResourceState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppEngineResourceState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppEngineResourceStateMultiError, or nil if none found.
+func (m *AppEngineResourceState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppEngineResourceState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetId()) < 1 {
+		err := AppEngineResourceStateValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1160,9 +3487,9 @@ func (m *KubernetesResourceStateEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetApplicationId()) < 1 {
-		err := KubernetesResourceStateEventValidationError{
-			field:  "ApplicationId",
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := AppEngineResourceStateValidationError{
+			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -1171,10 +3498,10 @@ func (m *KubernetesResourceStateEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if _, ok := KubernetesResourceStateEvent_Type_name[int32(m.GetType())]; !ok {
-		err := KubernetesResourceStateEventValidationError{
-			field:  "Type",
-			reason: "value must be one of the defined enum values",
+	if utf8.RuneCountInString(m.GetKind()) < 1 {
+		err := AppEngineResourceStateValidationError{
+			field:  "Kind",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -1182,10 +3509,10 @@ func (m *KubernetesResourceStateEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetState() == nil {
-		err := KubernetesResourceStateEventValidationError{
-			field:  "State",
-			reason: "value is required",
+	if _, ok := AppEngineResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := AppEngineResourceStateValidationError{
+			field:  "HealthStatus",
+			reason: "value must be one of the defined enum values",
 		}
 		if !all {
 			return err
@@ -1193,39 +3520,23 @@ func (m *KubernetesResourceStateEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetState()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, KubernetesResourceStateEventValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, KubernetesResourceStateEventValidationError{
-					field:  "State",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
+	// no validation rules for HealthDescription
+
+	if m.GetCreatedAt() <= 0 {
+		err := AppEngineResourceStateValidationError{
+			field:  "CreatedAt",
+			reason: "value must be greater than 0",
 		}
-	} else if v, ok := interface{}(m.GetState()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return KubernetesResourceStateEventValidationError{
-				field:  "State",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
 	}
 
-	if m.GetSnapshotVersion() == nil {
-		err := KubernetesResourceStateEventValidationError{
-			field:  "SnapshotVersion",
-			reason: "value is required",
+	if m.GetUpdatedAt() <= 0 {
+		err := AppEngineResourceStateValidationError{
+			field:  "UpdatedAt",
+			reason: "value must be greater than 0",
 		}
 		if !all {
 			return err
@@ -1233,60 +3544,156 @@ func (m *KubernetesResourceStateEvent) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if all {
-		switch v := interface{}(m.GetSnapshotVersion()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, KubernetesResourceStateEventValidationError{
-					field:  "SnapshotVersion",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	if len(errors) > 0 {
+		return AppEngineResourceStateMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppEngineResourceStateMultiError is an error wrapping multiple validation
+// errors returned by AppEngineResourceState.ValidateAll() if the designated
+// constraints aren't met.
+type AppEngineResourceStateMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppEngineResourceStateMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppEngineResourceStateMultiError) AllErrors() []error { return m }
+
+// AppEngineResourceStateValidationError is the validation error returned by
+// AppEngineResourceState.Validate if the designated constraints aren't met.
+type AppEngineResourceStateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppEngineResourceStateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppEngineResourceStateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppEngineResourceStateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppEngineResourceStateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppEngineResourceStateValidationError) ErrorName() string {
+	return "AppEngineResourceStateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppEngineResourceStateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddApplicationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppEngineResourceStateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppEngineResourceStateValidationError{}
+
+// Validate checks the field values on FlyIOApplicationLiveState with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *FlyIOApplicationLiveState) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on FlyIOApplicationLiveState with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// FlyIOApplicationLiveStateMultiError, or nil if none found.
+func (m *FlyIOApplicationLiveState) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *FlyIOApplicationLiveState) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, FlyIOApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, FlyIOApplicationLiveStateValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, KubernetesResourceStateEventValidationError{
-					field:  "SnapshotVersion",
+				return FlyIOApplicationLiveStateValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSnapshotVersion()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return KubernetesResourceStateEventValidationError{
-				field:  "SnapshotVersion",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
-	}
 
-	if m.GetCreatedAt() <= 0 {
-		err := KubernetesResourceStateEventValidationError{
-			field:  "CreatedAt",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
 	}
 
 	if len(errors) > 0 {
-		return KubernetesResourceStateEventMultiError(errors)
+		return FlyIOApplicationLiveStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// KubernetesResourceStateEventMultiError is an error wrapping multiple
-// validation errors returned by KubernetesResourceStateEvent.ValidateAll() if
-// the designated constraints aren't met.
-type KubernetesResourceStateEventMultiError []error
+// FlyIOApplicationLiveStateMultiError is an error wrapping multiple validation
+// errors returned by FlyIOApplicationLiveState.ValidateAll() if the
+// designated constraints aren't met.
+type FlyIOApplicationLiveStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m KubernetesResourceStateEventMultiError) Error() string {
+func (m FlyIOApplicationLiveStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1295,12 +3702,11 @@ func (m KubernetesResourceStateEventMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m KubernetesResourceStateEventMultiError) AllErrors() []error { return m }
+func (m FlyIOApplicationLiveStateMultiError) AllErrors() []error { return m }
 
-// KubernetesResourceStateEventValidationError is the validation error returned
-// by KubernetesResourceStateEvent.Validate if the designated constraints
-// aren't met.
-type KubernetesResourceStateEventValidationError struct {
+// FlyIOApplicationLiveStateValidationError is the validation error returned by
+// FlyIOApplicationLiveState.Validate if the designated constraints aren't met.
+type FlyIOApplicationLiveStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1308,24 +3714,24 @@ type KubernetesResourceStateEventValidationError struct {
 }
 
 // Field function returns field value.
-func (e KubernetesResourceStateEventValidationError) Field() string { return e.field }
+func (e FlyIOApplicationLiveStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e KubernetesResourceStateEventValidationError) Reason() string { return e.reason }
+func (e FlyIOApplicationLiveStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e KubernetesResourceStateEventValidationError) Cause() error { return e.cause }
+func (e FlyIOApplicationLiveStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e KubernetesResourceStateEventValidationError) Key() bool { return e.key }
+func (e FlyIOApplicationLiveStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e KubernetesResourceStateEventValidationError) ErrorName() string {
-	return "KubernetesResourceStateEventValidationError"
+func (e FlyIOApplicationLiveStateValidationError) ErrorName() string {
+	return "FlyIOApplicationLiveStateValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e KubernetesResourceStateEventValidationError) Error() string {
+func (e FlyIOApplicationLiveStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1337,14 +3743,14 @@ func (e KubernetesResourceStateEventValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sKubernetesResourceStateEvent.%s: %s%s",
+		"invalid %sAddProjectRBACRoleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = KubernetesResourceStateEventValidationError{}
+var _ error = FlyIOApplicationLiveStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -1352,24 +3758,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = KubernetesResourceStateEventValidationError{}
+} = FlyIOApplicationLiveStateValidationError{}
 
-// Validate checks the field values on CloudRunResourceState with the rules
+// Validate checks the field values on FlyIOResourceState with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CloudRunResourceState) Validate() error {
+func (m *FlyIOResourceState) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CloudRunResourceState with the rules
+// ValidateAll checks the field values on FlyIOResourceState with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CloudRunResourceStateMultiError, or nil if none found.
-func (m *CloudRunResourceState) ValidateAll() error {
+// FlyIOResourceStateMultiError, or nil if none found.
+func (m *FlyIOResourceState) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CloudRunResourceState) validate(all bool) error {
+func (m *FlyIOResourceState) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -1377,7 +3783,7 @@ func (m *CloudRunResourceState) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetId()) < 1 {
-		err := CloudRunResourceStateValidationError{
+		err := FlyIOResourceStateValidationError{
 			field:  "Id",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1388,7 +3794,7 @@ func (m *CloudRunResourceState) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetName()) < 1 {
-		err := CloudRunResourceStateValidationError{
+		err := FlyIOResourceStateValidationError{
 			field:  "Name",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1398,19 +3804,8 @@ func (m *CloudRunResourceState) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetApiVersion()) < 1 {
-		err := CloudRunResourceStateValidationError{
-			field:  "ApiVersion",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
 	if utf8.RuneCountInString(m.GetKind()) < 1 {
-		err := CloudRunResourceStateValidationError{
+		err := FlyIOResourceStateValidationError{
 			field:  "Kind",
 			reason: "value length must be at least 1 runes",
 		}
@@ -1420,10 +3815,8 @@ func (m *CloudRunResourceState) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Namespace
-
-	if _, ok := CloudRunResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
-		err := CloudRunResourceStateValidationError{
+	if _, ok := FlyIOResourceState_HealthStatus_name[int32(m.GetHealthStatus())]; !ok {
+		err := FlyIOResourceStateValidationError{
 			field:  "HealthStatus",
 			reason: "value must be one of the defined enum values",
 		}
@@ -1436,7 +3829,7 @@ func (m *CloudRunResourceState) validate(all bool) error {
 	// no validation rules for HealthDescription
 
 	if m.GetCreatedAt() <= 0 {
-		err := CloudRunResourceStateValidationError{
+		err := FlyIOResourceStateValidationError{
 			field:  "CreatedAt",
 			reason: "value must be greater than 0",
 		}
@@ -1447,7 +3840,7 @@ func (m *CloudRunResourceState) validate(all bool) error {
 	}
 
 	if m.GetUpdatedAt() <= 0 {
-		err := CloudRunResourceStateValidationError{
+		err := FlyIOResourceStateValidationError{
 			field:  "UpdatedAt",
 			reason: "value must be greater than 0",
 		}
@@ -1458,19 +3851,19 @@ func (m *CloudRunResourceState) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return CloudRunResourceStateMultiError(errors)
+		return FlyIOResourceStateMultiError(errors)
 	}
 
 	return nil
 }
 
-// CloudRunResourceStateMultiError is an error wrapping multiple validation
-// errors returned by CloudRunResourceState.ValidateAll() if the designated
-// constraints aren't met.
-type CloudRunResourceStateMultiError []error
+// FlyIOResourceStateMultiError is an error wrapping multiple validation errors
+// returned by FlyIOResourceState.ValidateAll() if the designated constraints
+// aren't met.
+type FlyIOResourceStateMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CloudRunResourceStateMultiError) Error() string {
+func (m FlyIOResourceStateMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1479,11 +3872,11 @@ func (m CloudRunResourceStateMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CloudRunResourceStateMultiError) AllErrors() []error { return m }
+func (m FlyIOResourceStateMultiError) AllErrors() []error { return m }
 
-// CloudRunResourceStateValidationError is the validation error returned by
-// CloudRunResourceState.Validate if the designated constraints aren't met.
-type CloudRunResourceStateValidationError struct {
+// FlyIOResourceStateValidationError is the validation error returned by
+// FlyIOResourceState.Validate if the designated constraints aren't met.
+type FlyIOResourceStateValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1491,24 +3884,24 @@ type CloudRunResourceStateValidationError struct {
 }
 
 // Field function returns field value.
-func (e CloudRunResourceStateValidationError) Field() string { return e.field }
+func (e FlyIOResourceStateValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CloudRunResourceStateValidationError) Reason() string { return e.reason }
+func (e FlyIOResourceStateValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CloudRunResourceStateValidationError) Cause() error { return e.cause }
+func (e FlyIOResourceStateValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CloudRunResourceStateValidationError) Key() bool { return e.key }
+func (e FlyIOResourceStateValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CloudRunResourceStateValidationError) ErrorName() string {
-	return "CloudRunResourceStateValidationError"
+func (e FlyIOResourceStateValidationError) ErrorName() string {
+	return "FlyIOResourceStateValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CloudRunResourceStateValidationError) Error() string {
+func (e FlyIOResourceStateValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1520,14 +3913,14 @@ func (e CloudRunResourceStateValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCloudRunResourceState.%s: %s%s",
+		"invalid %sApplicationGitPath.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CloudRunResourceStateValidationError{}
+var _ error = FlyIOResourceStateValidationError{}
 
 var _ interface {
 	Field() string
@@ -1535,4 +3928,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CloudRunResourceStateValidationError{}
+} = FlyIOResourceStateValidationError{}
@@ -100,6 +100,29 @@ func TestApplicationLiveStateSnapshot_DetermineAppHealthStatus(t *testing.T) {
 			},
 			want: ApplicationLiveStateSnapshot_UNKNOWN,
 		},
+		{
+			name: "ecs: healthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_ECS,
+				Ecs: &ECSApplicationLiveState{
+					Resources: []*ECSResourceState{{HealthStatus: ECSResourceState_HEALTHY}},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_HEALTHY,
+		},
+		{
+			name: "ecs: unhealthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_ECS,
+				Ecs: &ECSApplicationLiveState{
+					Resources: []*ECSResourceState{
+						{HealthStatus: ECSResourceState_HEALTHY},
+						{HealthStatus: ECSResourceState_OTHER},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_OTHER,
+		},
 		{
 			name: "ecs: unknown",
 			snapshot: &ApplicationLiveStateSnapshot{
@@ -107,6 +130,113 @@ func TestApplicationLiveStateSnapshot_DetermineAppHealthStatus(t *testing.T) {
 			},
 			want: ApplicationLiveStateSnapshot_UNKNOWN,
 		},
+		{
+			name: "nomad: healthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_NOMAD,
+				Nomad: &NomadApplicationLiveState{
+					Resources: []*NomadResourceState{{HealthStatus: NomadResourceState_HEALTHY}},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_HEALTHY,
+		},
+		{
+			name: "nomad: unhealthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_NOMAD,
+				Nomad: &NomadApplicationLiveState{
+					Resources: []*NomadResourceState{
+						{HealthStatus: NomadResourceState_HEALTHY},
+						{HealthStatus: NomadResourceState_OTHER},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_OTHER,
+		},
+		{
+			name: "container apps: healthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_CONTAINERAPPS,
+				Containerapps: &ContainerAppsApplicationLiveState{
+					Resources: []*ContainerAppsResourceState{{HealthStatus: ContainerAppsResourceState_HEALTHY}},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_HEALTHY,
+		},
+		{
+			name: "container apps: unknown",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_CONTAINERAPPS,
+				Containerapps: &ContainerAppsApplicationLiveState{
+					Resources: []*ContainerAppsResourceState{
+						{HealthStatus: ContainerAppsResourceState_HEALTHY},
+						{HealthStatus: ContainerAppsResourceState_UNKNOWN},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_UNKNOWN,
+		},
+		{
+			name: "app engine: healthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_APPENGINE,
+				Appengine: &AppEngineApplicationLiveState{
+					Resources: []*AppEngineResourceState{{HealthStatus: AppEngineResourceState_HEALTHY}},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_HEALTHY,
+		},
+		{
+			name: "app engine: other",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_APPENGINE,
+				Appengine: &AppEngineApplicationLiveState{
+					Resources: []*AppEngineResourceState{
+						{HealthStatus: AppEngineResourceState_HEALTHY},
+						{HealthStatus: AppEngineResourceState_OTHER},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_OTHER,
+		},
+		{
+			name: "fly.io: healthy",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_FLYIO,
+				Flyio: &FlyIOApplicationLiveState{
+					Resources: []*FlyIOResourceState{
+						{HealthStatus: FlyIOResourceState_HEALTHY},
+						{HealthStatus: FlyIOResourceState_HEALTHY},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_HEALTHY,
+		},
+		{
+			name: "fly.io: other",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_FLYIO,
+				Flyio: &FlyIOApplicationLiveState{
+					Resources: []*FlyIOResourceState{
+						{HealthStatus: FlyIOResourceState_HEALTHY},
+						{HealthStatus: FlyIOResourceState_OTHER},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_OTHER,
+		},
+		{
+			name: "fly.io: unknown",
+			snapshot: &ApplicationLiveStateSnapshot{
+				Kind: ApplicationKind_FLYIO,
+				Flyio: &FlyIOApplicationLiveState{
+					Resources: []*FlyIOResourceState{
+						{HealthStatus: FlyIOResourceState_UNKNOWN},
+					},
+				},
+			},
+			want: ApplicationLiveStateSnapshot_UNKNOWN,
+		},
 	}
 	for _, tc := range testcases {
 		t.Run(tc.name, func(t *testing.T) {
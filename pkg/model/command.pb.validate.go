@@ -480,6 +480,8 @@ func (m *Command_SyncApplication) validate(all bool) error {
 
 	// no validation rules for SyncStrategy
 
+	// no validation rules for CommitHash
+
 	if len(errors) > 0 {
 		return Command_SyncApplicationMultiError(errors)
 	}
@@ -35,6 +35,32 @@ func (ak ApplicationKind) ToRollbackKind() RollbackKind {
 		return RollbackKind_Rollback_CLOUDRUN
 	case ApplicationKind_ECS:
 		return RollbackKind_Rollback_ECS
+	case ApplicationKind_APPRUNNER:
+		return RollbackKind_Rollback_APPRUNNER
+	case ApplicationKind_CLOUDFORMATION:
+		return RollbackKind_Rollback_CLOUDFORMATION
+	case ApplicationKind_NOMAD:
+		return RollbackKind_Rollback_NOMAD
+	case ApplicationKind_CONTAINERAPPS:
+		return RollbackKind_Rollback_CONTAINERAPPS
+	case ApplicationKind_APPENGINE:
+		return RollbackKind_Rollback_APPENGINE
+	case ApplicationKind_STEPFUNCTIONS:
+		return RollbackKind_Rollback_STEPFUNCTIONS
+	case ApplicationKind_EC2ASG:
+		return RollbackKind_Rollback_EC2ASG
+	case ApplicationKind_GCEMIG:
+		return RollbackKind_Rollback_GCEMIG
+	case ApplicationKind_AZUREFUNCTIONS:
+		return RollbackKind_Rollback_AZUREFUNCTIONS
+	case ApplicationKind_STATICSITE:
+		return RollbackKind_Rollback_STATICSITE
+	case ApplicationKind_DOCKERCOMPOSE:
+		return RollbackKind_Rollback_DOCKERCOMPOSE
+	case ApplicationKind_KNATIVE:
+		return RollbackKind_Rollback_KNATIVE
+	case ApplicationKind_FLYIO:
+		return RollbackKind_Rollback_FLYIO
 	default:
 		return RollbackKind_Rollback_KUBERNETES
 	}
@@ -38,28 +38,67 @@ const (
 type ApplicationKind int32
 
 const (
-	ApplicationKind_KUBERNETES ApplicationKind = 0
-	ApplicationKind_TERRAFORM  ApplicationKind = 1
-	ApplicationKind_LAMBDA     ApplicationKind = 3
-	ApplicationKind_CLOUDRUN   ApplicationKind = 4
-	ApplicationKind_ECS        ApplicationKind = 5
+	ApplicationKind_KUBERNETES     ApplicationKind = 0
+	ApplicationKind_TERRAFORM      ApplicationKind = 1
+	ApplicationKind_LAMBDA         ApplicationKind = 3
+	ApplicationKind_CLOUDRUN       ApplicationKind = 4
+	ApplicationKind_ECS            ApplicationKind = 5
+	ApplicationKind_APPRUNNER      ApplicationKind = 6
+	ApplicationKind_CLOUDFORMATION ApplicationKind = 7
+	ApplicationKind_NOMAD          ApplicationKind = 8
+	ApplicationKind_CONTAINERAPPS  ApplicationKind = 9
+	ApplicationKind_APPENGINE      ApplicationKind = 10
+	ApplicationKind_STEPFUNCTIONS  ApplicationKind = 11
+	ApplicationKind_EC2ASG         ApplicationKind = 12
+	ApplicationKind_GCEMIG         ApplicationKind = 13
+	ApplicationKind_AZUREFUNCTIONS ApplicationKind = 14
+	ApplicationKind_STATICSITE     ApplicationKind = 15
+	ApplicationKind_DOCKERCOMPOSE  ApplicationKind = 16
+	ApplicationKind_KNATIVE        ApplicationKind = 17
+	ApplicationKind_FLYIO          ApplicationKind = 18
 )
 
 // Enum value maps for ApplicationKind.
 var (
 	ApplicationKind_name = map[int32]string{
-		0: "KUBERNETES",
-		1: "TERRAFORM",
-		3: "LAMBDA",
-		4: "CLOUDRUN",
-		5: "ECS",
+		0:  "KUBERNETES",
+		1:  "TERRAFORM",
+		3:  "LAMBDA",
+		4:  "CLOUDRUN",
+		5:  "ECS",
+		6:  "APPRUNNER",
+		7:  "CLOUDFORMATION",
+		8:  "NOMAD",
+		9:  "CONTAINERAPPS",
+		10: "APPENGINE",
+		11: "STEPFUNCTIONS",
+		12: "EC2ASG",
+		13: "GCEMIG",
+		14: "AZUREFUNCTIONS",
+		15: "STATICSITE",
+		16: "DOCKERCOMPOSE",
+		17: "KNATIVE",
+		18: "FLYIO",
 	}
 	ApplicationKind_value = map[string]int32{
-		"KUBERNETES": 0,
-		"TERRAFORM":  1,
-		"LAMBDA":     3,
-		"CLOUDRUN":   4,
-		"ECS":        5,
+		"KUBERNETES":     0,
+		"TERRAFORM":      1,
+		"LAMBDA":         3,
+		"CLOUDRUN":       4,
+		"ECS":            5,
+		"APPRUNNER":      6,
+		"CLOUDFORMATION": 7,
+		"NOMAD":          8,
+		"CONTAINERAPPS":  9,
+		"APPENGINE":      10,
+		"STEPFUNCTIONS":  11,
+		"EC2ASG":         12,
+		"GCEMIG":         13,
+		"AZUREFUNCTIONS": 14,
+		"STATICSITE":     15,
+		"DOCKERCOMPOSE":  16,
+		"KNATIVE":        17,
+		"FLYIO":          18,
 	}
 )
 
@@ -93,12 +132,25 @@ func (ApplicationKind) EnumDescriptor() ([]byte, []int) {
 type RollbackKind int32
 
 const (
-	RollbackKind_Rollback_KUBERNETES  RollbackKind = 0
-	RollbackKind_Rollback_TERRAFORM   RollbackKind = 1
-	RollbackKind_Rollback_LAMBDA      RollbackKind = 3
-	RollbackKind_Rollback_CLOUDRUN    RollbackKind = 4
-	RollbackKind_Rollback_ECS         RollbackKind = 5
-	RollbackKind_Rollback_CUSTOM_SYNC RollbackKind = 15
+	RollbackKind_Rollback_KUBERNETES     RollbackKind = 0
+	RollbackKind_Rollback_TERRAFORM      RollbackKind = 1
+	RollbackKind_Rollback_LAMBDA         RollbackKind = 3
+	RollbackKind_Rollback_CLOUDRUN       RollbackKind = 4
+	RollbackKind_Rollback_ECS            RollbackKind = 5
+	RollbackKind_Rollback_APPRUNNER      RollbackKind = 6
+	RollbackKind_Rollback_CLOUDFORMATION RollbackKind = 7
+	RollbackKind_Rollback_NOMAD          RollbackKind = 8
+	RollbackKind_Rollback_CONTAINERAPPS  RollbackKind = 9
+	RollbackKind_Rollback_APPENGINE      RollbackKind = 10
+	RollbackKind_Rollback_STEPFUNCTIONS  RollbackKind = 11
+	RollbackKind_Rollback_EC2ASG         RollbackKind = 12
+	RollbackKind_Rollback_GCEMIG         RollbackKind = 13
+	RollbackKind_Rollback_AZUREFUNCTIONS RollbackKind = 14
+	RollbackKind_Rollback_STATICSITE     RollbackKind = 16
+	RollbackKind_Rollback_DOCKERCOMPOSE  RollbackKind = 17
+	RollbackKind_Rollback_KNATIVE        RollbackKind = 18
+	RollbackKind_Rollback_FLYIO          RollbackKind = 19
+	RollbackKind_Rollback_CUSTOM_SYNC    RollbackKind = 15
 )
 
 // Enum value maps for RollbackKind.
@@ -109,15 +161,41 @@ var (
 		3:  "Rollback_LAMBDA",
 		4:  "Rollback_CLOUDRUN",
 		5:  "Rollback_ECS",
+		6:  "Rollback_APPRUNNER",
+		7:  "Rollback_CLOUDFORMATION",
+		8:  "Rollback_NOMAD",
+		9:  "Rollback_CONTAINERAPPS",
+		10: "Rollback_APPENGINE",
+		11: "Rollback_STEPFUNCTIONS",
+		12: "Rollback_EC2ASG",
+		13: "Rollback_GCEMIG",
+		14: "Rollback_AZUREFUNCTIONS",
+		16: "Rollback_STATICSITE",
+		17: "Rollback_DOCKERCOMPOSE",
+		18: "Rollback_KNATIVE",
+		19: "Rollback_FLYIO",
 		15: "Rollback_CUSTOM_SYNC",
 	}
 	RollbackKind_value = map[string]int32{
-		"Rollback_KUBERNETES":  0,
-		"Rollback_TERRAFORM":   1,
-		"Rollback_LAMBDA":      3,
-		"Rollback_CLOUDRUN":    4,
-		"Rollback_ECS":         5,
-		"Rollback_CUSTOM_SYNC": 15,
+		"Rollback_KUBERNETES":     0,
+		"Rollback_TERRAFORM":      1,
+		"Rollback_LAMBDA":         3,
+		"Rollback_CLOUDRUN":       4,
+		"Rollback_ECS":            5,
+		"Rollback_APPRUNNER":      6,
+		"Rollback_CLOUDFORMATION": 7,
+		"Rollback_NOMAD":          8,
+		"Rollback_CONTAINERAPPS":  9,
+		"Rollback_APPENGINE":      10,
+		"Rollback_STEPFUNCTIONS":  11,
+		"Rollback_EC2ASG":         12,
+		"Rollback_GCEMIG":         13,
+		"Rollback_AZUREFUNCTIONS": 14,
+		"Rollback_STATICSITE":     16,
+		"Rollback_DOCKERCOMPOSE":  17,
+		"Rollback_KNATIVE":        18,
+		"Rollback_FLYIO":          19,
+		"Rollback_CUSTOM_SYNC":    15,
 	}
 )
 
@@ -203,6 +281,9 @@ const (
 	SyncStrategy_AUTO       SyncStrategy = 0
 	SyncStrategy_QUICK_SYNC SyncStrategy = 1
 	SyncStrategy_PIPELINE   SyncStrategy = 2
+	// Destroy all resources managed by the application.
+	// This is currently supported by only Terraform application.
+	SyncStrategy_DESTROY SyncStrategy = 3
 )
 
 // Enum value maps for SyncStrategy.
@@ -211,11 +292,13 @@ var (
 		0: "AUTO",
 		1: "QUICK_SYNC",
 		2: "PIPELINE",
+		3: "DESTROY",
 	}
 	SyncStrategy_value = map[string]int32{
 		"AUTO":       0,
 		"QUICK_SYNC": 1,
 		"PIPELINE":   2,
+		"DESTROY":    3,
 	}
 )
 
@@ -689,32 +772,66 @@ var file_pkg_model_common_proto_rawDesc = []byte{
 	0x53, 0x33, 0x5f, 0x4f, 0x42, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
 	0x49, 0x54, 0x5f, 0x53, 0x4f, 0x55, 0x52, 0x43, 0x45, 0x10, 0x03, 0x12, 0x14, 0x0a, 0x10, 0x54,
 	0x45, 0x52, 0x52, 0x41, 0x46, 0x4f, 0x52, 0x4d, 0x5f, 0x4d, 0x4f, 0x44, 0x55, 0x4c, 0x45, 0x10,
-	0x04, 0x2a, 0x53, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45, 0x52, 0x4e, 0x45, 0x54,
-	0x45, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x52, 0x41, 0x46, 0x4f, 0x52,
-	0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x41, 0x4d, 0x42, 0x44, 0x41, 0x10, 0x03, 0x12,
-	0x0c, 0x0a, 0x08, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x52, 0x55, 0x4e, 0x10, 0x04, 0x12, 0x07, 0x0a,
-	0x03, 0x45, 0x43, 0x53, 0x10, 0x05, 0x2a, 0x97, 0x01, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x5f, 0x4b, 0x55, 0x42, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x45, 0x53, 0x10, 0x00,
-	0x12, 0x16, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x54, 0x45, 0x52,
-	0x52, 0x41, 0x46, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c,
-	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x4c, 0x41, 0x4d, 0x42, 0x44, 0x41, 0x10, 0x03, 0x12, 0x15, 0x0a,
-	0x11, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x52,
-	0x55, 0x4e, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x5f, 0x45, 0x43, 0x53, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
-	0x63, 0x6b, 0x5f, 0x43, 0x55, 0x53, 0x54, 0x4f, 0x4d, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x0f,
-	0x2a, 0x41, 0x0a, 0x17, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x45,
-	0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41,
-	0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x2a, 0x36, 0x0a, 0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x61, 0x74,
-	0x65, 0x67, 0x79, 0x12, 0x08, 0x0a, 0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0e, 0x0a,
-	0x0a, 0x51, 0x55, 0x49, 0x43, 0x4b, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x0c, 0x0a,
-	0x08, 0x50, 0x49, 0x50, 0x45, 0x4c, 0x49, 0x4e, 0x45, 0x10, 0x02, 0x42, 0x25, 0x5a, 0x23, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63,
-	0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64,
-	0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x04, 0x2a, 0x9d, 0x02, 0x0a, 0x0f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45, 0x52, 0x4e, 0x45,
+	0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x45, 0x52, 0x52, 0x41, 0x46, 0x4f,
+	0x52, 0x4d, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x4c, 0x41, 0x4d, 0x42, 0x44, 0x41, 0x10, 0x03,
+	0x12, 0x0c, 0x0a, 0x08, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x52, 0x55, 0x4e, 0x10, 0x04, 0x12, 0x07,
+	0x0a, 0x03, 0x45, 0x43, 0x53, 0x10, 0x05, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x50, 0x52, 0x55,
+	0x4e, 0x4e, 0x45, 0x52, 0x10, 0x06, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x09, 0x0a, 0x05, 0x4e, 0x4f,
+	0x4d, 0x41, 0x44, 0x10, 0x08, 0x12, 0x11, 0x0a, 0x0d, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x45, 0x52, 0x41, 0x50, 0x50, 0x53, 0x10, 0x09, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x50, 0x50, 0x45,
+	0x4e, 0x47, 0x49, 0x4e, 0x45, 0x10, 0x0a, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x54, 0x45, 0x50, 0x46,
+	0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x0b, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x43,
+	0x32, 0x41, 0x53, 0x47, 0x10, 0x0c, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x43, 0x45, 0x4d, 0x49, 0x47,
+	0x10, 0x0d, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x5a, 0x55, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x53, 0x10, 0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x53, 0x54, 0x41, 0x54, 0x49, 0x43,
+	0x53, 0x49, 0x54, 0x45, 0x10, 0x0f, 0x12, 0x11, 0x0a, 0x0d, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52,
+	0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53, 0x45, 0x10, 0x10, 0x12, 0x0b, 0x0a, 0x07, 0x4b, 0x4e, 0x41,
+	0x54, 0x49, 0x56, 0x45, 0x10, 0x11, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x4c, 0x59, 0x49, 0x4f, 0x10,
+	0x12, 0x2a, 0xd6, 0x03, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x4b, 0x69,
+	0x6e, 0x64, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x4b,
+	0x55, 0x42, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x52,
+	0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x54, 0x45, 0x52, 0x52, 0x41, 0x46, 0x4f, 0x52,
+	0x4d, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f,
+	0x4c, 0x41, 0x4d, 0x42, 0x44, 0x41, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x6f, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x52, 0x55, 0x4e, 0x10, 0x04, 0x12,
+	0x10, 0x0a, 0x0c, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x45, 0x43, 0x53, 0x10,
+	0x05, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x41, 0x50,
+	0x50, 0x52, 0x55, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x06, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x6f, 0x6c,
+	0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x43, 0x4c, 0x4f, 0x55, 0x44, 0x46, 0x4f, 0x52, 0x4d, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x4e, 0x4f, 0x4d, 0x41, 0x44, 0x10, 0x08, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52,
+	0x41, 0x50, 0x50, 0x53, 0x10, 0x09, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x47, 0x49, 0x4e, 0x45, 0x10, 0x0a, 0x12, 0x1a,
+	0x0a, 0x16, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x46,
+	0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x0b, 0x12, 0x13, 0x0a, 0x0f, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x45, 0x43, 0x32, 0x41, 0x53, 0x47, 0x10, 0x0c, 0x12,
+	0x13, 0x0a, 0x0f, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x47, 0x43, 0x45, 0x4d,
+	0x49, 0x47, 0x10, 0x0d, 0x12, 0x1b, 0x0a, 0x17, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
+	0x5f, 0x41, 0x5a, 0x55, 0x52, 0x45, 0x46, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10,
+	0x0e, 0x12, 0x17, 0x0a, 0x13, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x49, 0x43, 0x53, 0x49, 0x54, 0x45, 0x10, 0x10, 0x12, 0x1a, 0x0a, 0x16, 0x52, 0x6f,
+	0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x44, 0x4f, 0x43, 0x4b, 0x45, 0x52, 0x43, 0x4f, 0x4d,
+	0x50, 0x4f, 0x53, 0x45, 0x10, 0x11, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x5f, 0x4b, 0x4e, 0x41, 0x54, 0x49, 0x56, 0x45, 0x10, 0x12, 0x12, 0x12, 0x0a, 0x0e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x46, 0x4c, 0x59, 0x49, 0x4f, 0x10, 0x13,
+	0x12, 0x18, 0x0a, 0x14, 0x52, 0x6f, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x5f, 0x43, 0x55, 0x53,
+	0x54, 0x4f, 0x4d, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x0f, 0x2a, 0x41, 0x0a, 0x17, 0x41, 0x70,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x45, 0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x49, 0x53, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x01,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x43, 0x0a,
+	0x0c, 0x53, 0x79, 0x6e, 0x63, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x08, 0x0a,
+	0x04, 0x41, 0x55, 0x54, 0x4f, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x49, 0x43, 0x4b,
+	0x5f, 0x53, 0x59, 0x4e, 0x43, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x49, 0x50, 0x45, 0x4c,
+	0x49, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x45, 0x53, 0x54, 0x52, 0x4f, 0x59,
+	0x10, 0x03, 0x42, 0x25, 0x5a, 0x23, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x70, 0x69, 0x70, 0x65, 0x2d, 0x63, 0x64, 0x2f, 0x70, 0x69, 0x70, 0x65, 0x63, 0x64, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
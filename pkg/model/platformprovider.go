@@ -17,11 +17,24 @@ package model
 type PlatformProviderType string
 
 const (
-	PlatformProviderKubernetes PlatformProviderType = "KUBERNETES"
-	PlatformProviderTerraform  PlatformProviderType = "TERRAFORM"
-	PlatformProviderLambda     PlatformProviderType = "LAMBDA"
-	PlatformProviderCloudRun   PlatformProviderType = "CLOUDRUN"
-	PlatformProviderECS        PlatformProviderType = "ECS"
+	PlatformProviderKubernetes     PlatformProviderType = "KUBERNETES"
+	PlatformProviderTerraform      PlatformProviderType = "TERRAFORM"
+	PlatformProviderLambda         PlatformProviderType = "LAMBDA"
+	PlatformProviderCloudRun       PlatformProviderType = "CLOUDRUN"
+	PlatformProviderECS            PlatformProviderType = "ECS"
+	PlatformProviderAppRunner      PlatformProviderType = "APPRUNNER"
+	PlatformProviderCloudFormation PlatformProviderType = "CLOUDFORMATION"
+	PlatformProviderNomad          PlatformProviderType = "NOMAD"
+	PlatformProviderContainerApps  PlatformProviderType = "CONTAINERAPPS"
+	PlatformProviderAppEngine      PlatformProviderType = "APPENGINE"
+	PlatformProviderStepFunctions  PlatformProviderType = "STEPFUNCTIONS"
+	PlatformProviderEC2ASG         PlatformProviderType = "EC2ASG"
+	PlatformProviderGCEMIG         PlatformProviderType = "GCEMIG"
+	PlatformProviderAzureFunctions PlatformProviderType = "AZUREFUNCTIONS"
+	PlatformProviderStaticSite     PlatformProviderType = "STATICSITE"
+	PlatformProviderDockerCompose  PlatformProviderType = "DOCKERCOMPOSE"
+	PlatformProviderKnative        PlatformProviderType = "KNATIVE"
+	PlatformProviderFlyIO          PlatformProviderType = "FLYIO"
 )
 
 func (t PlatformProviderType) String() string {
@@ -52,6 +52,18 @@ const (
 	// StageK8sTrafficRouting represents the state where the traffic to application
 	// should be splitted as the specified percentage to PRIMARY, CANARY, BASELINE variants.
 	StageK8sTrafficRouting Stage = "K8S_TRAFFIC_ROUTING"
+	// StageK8sWaitJob represents the state where the Job resources have been applied
+	// and waited until they complete.
+	StageK8sWaitJob Stage = "K8S_WAIT_JOB"
+	// StageK8sPrimaryClean represents the state where
+	// the PRIMARY variant workloads have been scaled down after the grace period.
+	StageK8sPrimaryClean Stage = "K8S_PRIMARY_CLEAN"
+	// StageK8sValidate represents the state where the rendered manifests are validated
+	// against the Kubernetes schemas and the configured policies before applying them.
+	StageK8sValidate Stage = "K8S_VALIDATE"
+	// StageK8sRolloutRestart represents the state where the pods of the workloads
+	// have been restarted and all of them have been rolled out.
+	StageK8sRolloutRestart Stage = "K8S_ROLLOUT_RESTART"
 
 	// StageTerraformSync synced infrastructure with all the tf defined in Git.
 	// Firstly, it does plan and if there are any changes detected it applies those changes automatically.
@@ -61,12 +73,28 @@ const (
 	// StageTerraformApply represents the state where
 	// the new configuration has been applied.
 	StageTerraformApply Stage = "TERRAFORM_APPLY"
+	// StageTerraformCostEstimation represents the state where
+	// the monthly cost of the planned changes has been estimated by Infracost.
+	StageTerraformCostEstimation Stage = "TERRAFORM_COST_ESTIMATION"
+	// StageTerraformPolicyCheck represents the state where
+	// the planned changes have been checked against the OPA policies.
+	StageTerraformPolicyCheck Stage = "TERRAFORM_POLICY_CHECK"
+	// StageTerraformDestroy represents the state where
+	// all resources managed by the application are being destroyed.
+	StageTerraformDestroy Stage = "TERRAFORM_DESTROY"
+	// StageTerraformImport represents the state where
+	// the existing resources have been imported into the terraform state.
+	StageTerraformImport Stage = "TERRAFORM_IMPORT"
 
 	// StageCloudRunSync does quick sync by rolling out the new version
 	// and switching all traffic to it.
 	StageCloudRunSync Stage = "CLOUDRUN_SYNC"
 	// StageCloudRunPromote promotes the new version to receive amount of traffic.
 	StageCloudRunPromote Stage = "CLOUDRUN_PROMOTE"
+	// StageCloudRunTrafficRouting shifts the traffic to the new revision step by step.
+	StageCloudRunTrafficRouting Stage = "CLOUDRUN_TRAFFIC_ROUTING"
+	// StageCloudRunJobExecute runs the Cloud Run job and waits for its completion.
+	StageCloudRunJobExecute Stage = "CLOUDRUN_JOB_EXECUTE"
 
 	// StageLambdaSync does quick sync by rolling out the new version
 	// and switching all traffic to it.
@@ -76,6 +104,11 @@ const (
 	StageLambdaCanaryRollout Stage = "LAMBDA_CANARY_ROLLOUT"
 	// StageLambdaPromote prmotes the new version to receive amount of traffic.
 	StageLambdaPromote Stage = "LAMBDA_PROMOTE"
+	// StageLambdaTrafficRouting shifts the traffic to the new version step by step.
+	StageLambdaTrafficRouting Stage = "LAMBDA_TRAFFIC_ROUTING"
+	// StageLambdaProvisionedConcurrency applies the provisioned concurrency to the alias
+	// and waits until it becomes ready.
+	StageLambdaProvisionedConcurrency Stage = "LAMBDA_PROVISIONED_CONCURRENCY"
 
 	// StageECSSync does quick sync by rolling out the new version
 	// and switching all traffic to it.
@@ -94,6 +127,148 @@ const (
 	// StageECSCanaryClean represents the stage where
 	// the CANARY variant resources has been cleaned.
 	StageECSCanaryClean Stage = "ECS_CANARY_CLEAN"
+	// StageECSTaskRun represents the stage where a standalone task (e.g. database migration)
+	// is run and waited until it finishes successfully.
+	StageECSTaskRun Stage = "ECS_TASK_RUN"
+	// StageECSWaitHealthy represents the stage where piped waits until
+	// all tasks of the service are running and registered as healthy targets.
+	StageECSWaitHealthy Stage = "ECS_WAIT_HEALTHY"
+	// StageECSCodeDeployDeploy represents the stage where a CodeDeploy deployment is created
+	// and piped waits until the replacement task set becomes ready to receive the traffic.
+	StageECSCodeDeployDeploy Stage = "ECS_CODEDEPLOY_DEPLOY"
+	// StageECSCodeDeployContinue represents the stage where the CodeDeploy deployment is continued
+	// and piped waits until the traffic is shifted to the replacement task set.
+	StageECSCodeDeployContinue Stage = "ECS_CODEDEPLOY_CONTINUE"
+
+	// StageAppRunnerSync does quick sync by deploying the new version
+	// to the App Runner service and switching all traffic to it.
+	StageAppRunnerSync Stage = "APPRUNNER_SYNC"
+	// StageAppRunnerCanaryRollout represents the stage where
+	// the new version has been deployed to the CANARY service.
+	StageAppRunnerCanaryRollout Stage = "APPRUNNER_CANARY_ROLLOUT"
+	// StageAppRunnerTrafficRouting represents the stage where the traffic
+	// is split between the PRIMARY and CANARY services by weighted DNS records.
+	StageAppRunnerTrafficRouting Stage = "APPRUNNER_TRAFFIC_ROUTING"
+	// StageAppRunnerPromote represents the stage where the new version has been deployed
+	// to the PRIMARY service and all traffic has been switched to it.
+	StageAppRunnerPromote Stage = "APPRUNNER_PROMOTE"
+	// StageAppRunnerCanaryClean represents the stage where
+	// the CANARY service has been deleted.
+	StageAppRunnerCanaryClean Stage = "APPRUNNER_CANARY_CLEAN"
+
+	// StageCloudFormationSync does quick sync by creating a change set
+	// of the stack and executing it right away.
+	StageCloudFormationSync Stage = "CLOUDFORMATION_SYNC"
+	// StageCloudFormationChangeSet represents the stage where a change set
+	// of the stack is created to show the changes to be made.
+	StageCloudFormationChangeSet Stage = "CLOUDFORMATION_CHANGE_SET"
+	// StageCloudFormationExecute represents the stage where the change set
+	// created by CLOUDFORMATION_CHANGE_SET stage is executed.
+	StageCloudFormationExecute Stage = "CLOUDFORMATION_EXECUTE"
+
+	// StageNomadSync does quick sync by registering the new version of the job
+	// and promoting its canaries if any once they become healthy.
+	StageNomadSync Stage = "NOMAD_SYNC"
+	// StageNomadCanaryRollout represents the stage where the new version of the job
+	// has been registered and its canary allocations have become healthy.
+	StageNomadCanaryRollout Stage = "NOMAD_CANARY_ROLLOUT"
+	// StageNomadPromote represents the stage where the canaries of the job
+	// have been promoted and all allocations have been replaced with the new version.
+	StageNomadPromote Stage = "NOMAD_PROMOTE"
+
+	// StageContainerAppsSync does quick sync by deploying a new revision
+	// of the container app and routing all traffic to it.
+	StageContainerAppsSync Stage = "CONTAINERAPPS_SYNC"
+	// StageContainerAppsCanaryRollout represents the stage where a new revision
+	// of the container app has been deployed without receiving any traffic.
+	StageContainerAppsCanaryRollout Stage = "CONTAINERAPPS_CANARY_ROLLOUT"
+	// StageContainerAppsTrafficRouting represents the stage where the traffic
+	// is split between the revision deployed by CONTAINERAPPS_CANARY_ROLLOUT stage
+	// and the revision running before the deployment.
+	StageContainerAppsTrafficRouting Stage = "CONTAINERAPPS_TRAFFIC_ROUTING"
+	// StageContainerAppsPromote represents the stage where all traffic has been routed
+	// to the new revision and the previous revision has been deactivated.
+	StageContainerAppsPromote Stage = "CONTAINERAPPS_PROMOTE"
+
+	// StageAppEngineSync does quick sync by deploying a new version
+	// of the App Engine service and migrating all traffic to it.
+	StageAppEngineSync Stage = "APPENGINE_SYNC"
+	// StageAppEnginePromote promotes the new version to receive amount of traffic.
+	StageAppEnginePromote Stage = "APPENGINE_PROMOTE"
+
+	// StageStepFunctionsSync does quick sync by publishing a new version
+	// of the state machine and pointing the alias to it.
+	StageStepFunctionsSync Stage = "STEPFUNCTIONS_SYNC"
+	// StageStepFunctionsCanaryRollout represents the state where
+	// the new version of the state machine has been published without receiving any executions.
+	StageStepFunctionsCanaryRollout Stage = "STEPFUNCTIONS_CANARY_ROLLOUT"
+	// StageStepFunctionsPromote promotes the new version to receive amount of executions through the alias.
+	StageStepFunctionsPromote Stage = "STEPFUNCTIONS_PROMOTE"
+	// StageStepFunctionsTrafficRouting shifts the executions to the new version step by step.
+	StageStepFunctionsTrafficRouting Stage = "STEPFUNCTIONS_TRAFFIC_ROUTING"
+
+	// StageEC2ASGSync does quick sync by updating the launch template to the new image
+	// and replacing the instances by an instance refresh, or by a blue/green deployment when the traffic routing is configured.
+	StageEC2ASGSync Stage = "EC2ASG_SYNC"
+	// StageEC2ASGGreenRollout represents the state where
+	// the GREEN auto scaling group has been launched without receiving any traffic.
+	StageEC2ASGGreenRollout Stage = "EC2ASG_GREEN_ROLLOUT"
+	// StageEC2ASGTrafficRouting represents the state where
+	// the traffic is split between the BLUE and GREEN auto scaling groups.
+	StageEC2ASGTrafficRouting Stage = "EC2ASG_TRAFFIC_ROUTING"
+	// StageEC2ASGPromote routes all traffic to the GREEN auto scaling group
+	// and deletes the BLUE auto scaling group.
+	StageEC2ASGPromote Stage = "EC2ASG_PROMOTE"
+
+	// StageGCEMIGSync does quick sync by rolling out the new instance template
+	// to all instances of the managed instance group.
+	StageGCEMIGSync Stage = "GCEMIG_SYNC"
+	// StageGCEMIGCanaryRollout represents the state where
+	// the new instance template has been rolled out to a part of the instances.
+	StageGCEMIGCanaryRollout Stage = "GCEMIG_CANARY_ROLLOUT"
+	// StageGCEMIGPromote rolls out the new instance template to all instances.
+	StageGCEMIGPromote Stage = "GCEMIG_PROMOTE"
+
+	// StageAzureFunctionsSync does quick sync by deploying the new package or image
+	// and swapping the deployment slot into production if a slot was configured.
+	StageAzureFunctionsSync Stage = "AZUREFUNCTIONS_SYNC"
+	// StageAzureFunctionsSlotDeploy represents the state where
+	// the new package or image has been deployed to the deployment slot.
+	StageAzureFunctionsSlotDeploy Stage = "AZUREFUNCTIONS_SLOT_DEPLOY"
+	// StageAzureFunctionsSwap swaps the deployment slot into production.
+	StageAzureFunctionsSwap Stage = "AZUREFUNCTIONS_SWAP"
+
+	// StageStaticSiteSync does quick sync by uploading the files of the site
+	// and switching the origin path of the CDN to them if a CDN was configured.
+	StageStaticSiteSync Stage = "STATICSITE_SYNC"
+	// StageStaticSiteGreenRollout represents the state where
+	// the files of the site have been uploaded to the path not served by the CDN.
+	StageStaticSiteGreenRollout Stage = "STATICSITE_GREEN_ROLLOUT"
+	// StageStaticSitePromote switches the origin path of the CDN to the uploaded files
+	// and invalidates the cache of the CDN.
+	StageStaticSitePromote Stage = "STATICSITE_PROMOTE"
+	// StageDockerComposeSync does quick sync by applying the compose file
+	// to all hosts one by one and waiting until the services become healthy.
+	StageDockerComposeSync Stage = "DOCKERCOMPOSE_SYNC"
+	// StageDockerComposeRollout applies the compose file to the specified hosts only
+	// so that the new version can be checked on them before applying it to the others.
+	StageDockerComposeRollout Stage = "DOCKERCOMPOSE_ROLLOUT"
+	// StageKnativeSync does quick sync by creating a new revision of the Knative service
+	// and routing all traffic to it.
+	StageKnativeSync Stage = "KNATIVE_SYNC"
+	// StageKnativePromote creates a new revision of the Knative service
+	// and splits the traffic between it and the running revision.
+	StageKnativePromote Stage = "KNATIVE_PROMOTE"
+	// StageFlyIOSync does quick sync by creating the machines of the new version
+	// of the Fly.io app, waiting until they become healthy and destroying the old ones.
+	StageFlyIOSync Stage = "FLYIO_SYNC"
+	// StageFlyIOGreenRollout creates the machines of the new version of the Fly.io app
+	// without registering them to the services and waits until they become healthy.
+	StageFlyIOGreenRollout Stage = "FLYIO_GREEN_ROLLOUT"
+	// StageFlyIOPromote routes the traffic to the machines created by the green rollout
+	// and destroys the machines of the old version.
+	StageFlyIOPromote Stage = "FLYIO_PROMOTE"
+
 	// StageCustomSync represents the stage where users can use their
 	// defined scripts to sync the application's state instead of the KIND_SYNC stage.
 	StageCustomSync Stage = "CUSTOM_SYNC"
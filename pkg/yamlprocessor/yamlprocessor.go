@@ -17,6 +17,8 @@ package yamlprocessor
 import (
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 
 	goyaml "github.com/goccy/go-yaml"
 	"github.com/goccy/go-yaml/ast"
@@ -103,6 +105,171 @@ func (p *Processor) ReplaceString(path, value string) error {
 	return yamlPath.ReplaceWithNode(p.file, newNode)
 }
 
+// AddYAML merges a given YAML value into the node placed at a given path.
+// When the node is a mapping, the fields of the value are added to it
+// (overwriting the existing ones with the same key).
+// When the node is a sequence, the items of the value are appended to it.
+// When the node does not exist, it will be created with the given value.
+// Note that the comments in the data will be removed by this operation.
+//
+// Unlike GetValue, the path can contain only the child operator
+// and the index operator. e.g. "$.foo.bar[0].baz"
+func (p *Processor) AddYAML(path, value string) error {
+	if path == "" {
+		return errors.New("no path given")
+	}
+	selectors, err := parseSelectors(path)
+	if err != nil {
+		return err
+	}
+
+	var add interface{}
+	if err := goyaml.UnmarshalWithOptions([]byte(value), &add, goyaml.UseOrderedMap()); err != nil {
+		return fmt.Errorf("failed to parse value: %w", err)
+	}
+	var root interface{}
+	if err := goyaml.UnmarshalWithOptions(p.Bytes(), &root, goyaml.UseOrderedMap()); err != nil {
+		return err
+	}
+
+	root, err = addValue(root, selectors, add)
+	if err != nil {
+		return fmt.Errorf("failed to add value at path %s: %w", path, err)
+	}
+	data, err := goyaml.Marshal(root)
+	if err != nil {
+		return err
+	}
+	f, err := parser.ParseBytes(data, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	p.file = f
+	return nil
+}
+
+// parseSelectors splits the given path into a list of selectors.
+// A selector is a string for the child operator or an int for the index operator.
+func parseSelectors(path string) ([]interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path %s must start with $", path)
+	}
+
+	var (
+		selectors []interface{}
+		rest      = path[1:]
+	)
+	for rest != "" {
+		switch rest[0] {
+		case '.':
+			rest = rest[1:]
+			end := strings.IndexAny(rest, ".[")
+			if end < 0 {
+				end = len(rest)
+			}
+			if end == 0 {
+				return nil, fmt.Errorf("invalid path %s", path)
+			}
+			selectors = append(selectors, rest[:end])
+			rest = rest[end:]
+		case '[':
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("invalid path %s", path)
+			}
+			index, err := strconv.Atoi(rest[1:end])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("invalid index in path %s", path)
+			}
+			selectors = append(selectors, index)
+			rest = rest[end+1:]
+		default:
+			return nil, fmt.Errorf("invalid path %s", path)
+		}
+	}
+	return selectors, nil
+}
+
+func addValue(node interface{}, selectors []interface{}, add interface{}) (interface{}, error) {
+	if len(selectors) == 0 {
+		return mergeValue(node, add)
+	}
+
+	switch selector := selectors[0].(type) {
+	case string:
+		m, ok := node.(goyaml.MapSlice)
+		if !ok && node != nil {
+			return nil, fmt.Errorf("%s is not a field of a mapping", selector)
+		}
+		for i := range m {
+			if fmt.Sprint(m[i].Key) != selector {
+				continue
+			}
+			v, err := addValue(m[i].Value, selectors[1:], add)
+			if err != nil {
+				return nil, err
+			}
+			m[i].Value = v
+			return m, nil
+		}
+		v, err := addValue(nil, selectors[1:], add)
+		if err != nil {
+			return nil, err
+		}
+		return append(m, goyaml.MapItem{Key: selector, Value: v}), nil
+
+	case int:
+		s, ok := node.([]interface{})
+		if !ok || selector >= len(s) {
+			return nil, fmt.Errorf("index %d is out of range", selector)
+		}
+		v, err := addValue(s[selector], selectors[1:], add)
+		if err != nil {
+			return nil, err
+		}
+		s[selector] = v
+		return s, nil
+	}
+	return nil, fmt.Errorf("unknown selector %v", selectors[0])
+}
+
+func mergeValue(cur, add interface{}) (interface{}, error) {
+	switch cv := cur.(type) {
+	case nil:
+		return add, nil
+
+	case goyaml.MapSlice:
+		av, ok := add.(goyaml.MapSlice)
+		if !ok {
+			return nil, errors.New("a mapping value is required to add into a mapping")
+		}
+		for _, item := range av {
+			found := false
+			for i := range cv {
+				if cv[i].Key == item.Key {
+					cv[i].Value = item.Value
+					found = true
+					break
+				}
+			}
+			if !found {
+				cv = append(cv, item)
+			}
+		}
+		return cv, nil
+
+	case []interface{}:
+		av, ok := add.([]interface{})
+		if !ok {
+			return nil, errors.New("a sequence value is required to add into a sequence")
+		}
+		return append(cv, av...), nil
+
+	default:
+		return nil, errors.New("the value can be added only into a mapping or a sequence")
+	}
+}
+
 func (p *Processor) Bytes() []byte {
 	return []byte(p.file.String())
 }
@@ -272,3 +272,121 @@ foo:
 		})
 	}
 }
+
+func TestAddYAML(t *testing.T) {
+	testcases := []struct {
+		name    string
+		yml     string
+		path    string
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{
+			name:    "empty path given",
+			yml:     "foo: bar",
+			path:    "",
+			value:   "baz: qux",
+			wantErr: true,
+		},
+		{
+			name:    "wrong path given",
+			yml:     "foo: bar",
+			path:    "wrong",
+			value:   "baz: qux",
+			wantErr: true,
+		},
+		{
+			name:    "add into a scalar",
+			yml:     "foo: bar",
+			path:    "$.foo",
+			value:   "baz: qux",
+			wantErr: true,
+		},
+		{
+			name:    "add a sequence into a mapping",
+			yml:     "foo:\n  bar: baz",
+			path:    "$.foo",
+			value:   "- qux",
+			wantErr: true,
+		},
+		{
+			name:    "index out of range",
+			yml:     "foo:\n  - bar",
+			path:    "$.foo[1].baz",
+			value:   "baz: qux",
+			wantErr: true,
+		},
+		{
+			name: "add fields into a mapping",
+			yml: `foo:
+  a: b
+  c: d
+`,
+			path:  "$.foo",
+			value: "c: e\nf: g",
+			want: `foo:
+  a: b
+  c: e
+  f: g
+`,
+		},
+		{
+			name: "append items to a sequence",
+			yml: `foo:
+  bar:
+  - name: a
+`,
+			path:  "$.foo.bar",
+			value: "- name: b\n  value: \"true\"",
+			want: `foo:
+  bar:
+  - name: a
+  - name: b
+    value: "true"
+`,
+		},
+		{
+			name: "add into an element of array",
+			yml: `foo:
+- name: a
+  env:
+  - name: A
+`,
+			path:  "$.foo[0].env",
+			value: "- name: B",
+			want: `foo:
+- name: a
+  env:
+  - name: A
+  - name: B
+`,
+		},
+		{
+			name: "missing field is created",
+			yml: `foo:
+  bar: baz
+`,
+			path:  "$.foo.annotations",
+			value: "a: b",
+			want: `foo:
+  bar: baz
+  annotations:
+    a: b
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := NewProcessor([]byte(tc.yml))
+			require.NotNil(t, p)
+			require.NoError(t, err)
+
+			err = p.AddYAML(tc.path, tc.value)
+			assert.Equal(t, tc.wantErr, err != nil)
+			if !tc.wantErr {
+				assert.Equal(t, tc.want, string(p.Bytes()))
+			}
+		})
+	}
+}
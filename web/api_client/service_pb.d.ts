@@ -747,6 +747,9 @@ export class SyncApplicationRequest extends jspb.Message {
   getSyncStrategy(): pkg_model_common_pb.SyncStrategy;
   setSyncStrategy(value: pkg_model_common_pb.SyncStrategy): SyncApplicationRequest;
 
+  getCommitHash(): string;
+  setCommitHash(value: string): SyncApplicationRequest;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): SyncApplicationRequest.AsObject;
   static toObject(includeInstance: boolean, msg: SyncApplicationRequest): SyncApplicationRequest.AsObject;
@@ -759,6 +762,7 @@ export namespace SyncApplicationRequest {
   export type AsObject = {
     applicationId: string,
     syncStrategy: pkg_model_common_pb.SyncStrategy,
+    commitHash: string,
   }
 }
 
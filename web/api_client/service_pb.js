@@ -7711,7 +7711,8 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.toObject = functi
 proto.grpc.service.webservice.SyncApplicationRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    commitHash: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -7756,6 +7757,10 @@ proto.grpc.service.webservice.SyncApplicationRequest.deserializeBinaryFromReader
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitHash(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -7799,6 +7804,13 @@ proto.grpc.service.webservice.SyncApplicationRequest.serializeBinaryToWriter = f
       f
     );
   }
+  f = message.getCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -7838,6 +7850,24 @@ proto.grpc.service.webservice.SyncApplicationRequest.prototype.setSyncStrategy =
 };
 
 
+/**
+ * optional string commit_hash = 3;
+ * @return {string}
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.grpc.service.webservice.SyncApplicationRequest} returns this
+ */
+proto.grpc.service.webservice.SyncApplicationRequest.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -40,6 +40,31 @@ export class ApplicationLiveStateSnapshot extends jspb.Message {
   hasLambda(): boolean;
   clearLambda(): ApplicationLiveStateSnapshot;
 
+  getEcs(): ECSApplicationLiveState | undefined;
+  setEcs(value?: ECSApplicationLiveState): ApplicationLiveStateSnapshot;
+  hasEcs(): boolean;
+  clearEcs(): ApplicationLiveStateSnapshot;
+
+  getNomad(): NomadApplicationLiveState | undefined;
+  setNomad(value?: NomadApplicationLiveState): ApplicationLiveStateSnapshot;
+  hasNomad(): boolean;
+  clearNomad(): ApplicationLiveStateSnapshot;
+
+  getContainerapps(): ContainerAppsApplicationLiveState | undefined;
+  setContainerapps(value?: ContainerAppsApplicationLiveState): ApplicationLiveStateSnapshot;
+  hasContainerapps(): boolean;
+  clearContainerapps(): ApplicationLiveStateSnapshot;
+
+  getAppengine(): AppEngineApplicationLiveState | undefined;
+  setAppengine(value?: AppEngineApplicationLiveState): ApplicationLiveStateSnapshot;
+  hasAppengine(): boolean;
+  clearAppengine(): ApplicationLiveStateSnapshot;
+
+  getFlyio(): FlyIOApplicationLiveState | undefined;
+  setFlyio(value?: FlyIOApplicationLiveState): ApplicationLiveStateSnapshot;
+  hasFlyio(): boolean;
+  clearFlyio(): ApplicationLiveStateSnapshot;
+
   getVersion(): ApplicationLiveStateVersion | undefined;
   setVersion(value?: ApplicationLiveStateVersion): ApplicationLiveStateSnapshot;
   hasVersion(): boolean;
@@ -64,6 +89,11 @@ export namespace ApplicationLiveStateSnapshot {
     terraform?: TerraformApplicationLiveState.AsObject,
     cloudrun?: CloudRunApplicationLiveState.AsObject,
     lambda?: LambdaApplicationLiveState.AsObject,
+    ecs?: ECSApplicationLiveState.AsObject,
+    nomad?: NomadApplicationLiveState.AsObject,
+    containerapps?: ContainerAppsApplicationLiveState.AsObject,
+    appengine?: AppEngineApplicationLiveState.AsObject,
+    flyio?: FlyIOApplicationLiveState.AsObject,
     version?: ApplicationLiveStateVersion.AsObject,
   }
 
@@ -164,6 +194,106 @@ export namespace LambdaApplicationLiveState {
   }
 }
 
+export class ECSApplicationLiveState extends jspb.Message {
+  getResourcesList(): Array<ECSResourceState>;
+  setResourcesList(value: Array<ECSResourceState>): ECSApplicationLiveState;
+  clearResourcesList(): ECSApplicationLiveState;
+  addResources(value?: ECSResourceState, index?: number): ECSResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ECSApplicationLiveState.AsObject;
+  static toObject(includeInstance: boolean, msg: ECSApplicationLiveState): ECSApplicationLiveState.AsObject;
+  static serializeBinaryToWriter(message: ECSApplicationLiveState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ECSApplicationLiveState;
+  static deserializeBinaryFromReader(message: ECSApplicationLiveState, reader: jspb.BinaryReader): ECSApplicationLiveState;
+}
+
+export namespace ECSApplicationLiveState {
+  export type AsObject = {
+    resourcesList: Array<ECSResourceState.AsObject>,
+  }
+}
+
+export class NomadApplicationLiveState extends jspb.Message {
+  getResourcesList(): Array<NomadResourceState>;
+  setResourcesList(value: Array<NomadResourceState>): NomadApplicationLiveState;
+  clearResourcesList(): NomadApplicationLiveState;
+  addResources(value?: NomadResourceState, index?: number): NomadResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NomadApplicationLiveState.AsObject;
+  static toObject(includeInstance: boolean, msg: NomadApplicationLiveState): NomadApplicationLiveState.AsObject;
+  static serializeBinaryToWriter(message: NomadApplicationLiveState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NomadApplicationLiveState;
+  static deserializeBinaryFromReader(message: NomadApplicationLiveState, reader: jspb.BinaryReader): NomadApplicationLiveState;
+}
+
+export namespace NomadApplicationLiveState {
+  export type AsObject = {
+    resourcesList: Array<NomadResourceState.AsObject>,
+  }
+}
+
+export class ContainerAppsApplicationLiveState extends jspb.Message {
+  getResourcesList(): Array<ContainerAppsResourceState>;
+  setResourcesList(value: Array<ContainerAppsResourceState>): ContainerAppsApplicationLiveState;
+  clearResourcesList(): ContainerAppsApplicationLiveState;
+  addResources(value?: ContainerAppsResourceState, index?: number): ContainerAppsResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ContainerAppsApplicationLiveState.AsObject;
+  static toObject(includeInstance: boolean, msg: ContainerAppsApplicationLiveState): ContainerAppsApplicationLiveState.AsObject;
+  static serializeBinaryToWriter(message: ContainerAppsApplicationLiveState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ContainerAppsApplicationLiveState;
+  static deserializeBinaryFromReader(message: ContainerAppsApplicationLiveState, reader: jspb.BinaryReader): ContainerAppsApplicationLiveState;
+}
+
+export namespace ContainerAppsApplicationLiveState {
+  export type AsObject = {
+    resourcesList: Array<ContainerAppsResourceState.AsObject>,
+  }
+}
+
+export class AppEngineApplicationLiveState extends jspb.Message {
+  getResourcesList(): Array<AppEngineResourceState>;
+  setResourcesList(value: Array<AppEngineResourceState>): AppEngineApplicationLiveState;
+  clearResourcesList(): AppEngineApplicationLiveState;
+  addResources(value?: AppEngineResourceState, index?: number): AppEngineResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AppEngineApplicationLiveState.AsObject;
+  static toObject(includeInstance: boolean, msg: AppEngineApplicationLiveState): AppEngineApplicationLiveState.AsObject;
+  static serializeBinaryToWriter(message: AppEngineApplicationLiveState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AppEngineApplicationLiveState;
+  static deserializeBinaryFromReader(message: AppEngineApplicationLiveState, reader: jspb.BinaryReader): AppEngineApplicationLiveState;
+}
+
+export namespace AppEngineApplicationLiveState {
+  export type AsObject = {
+    resourcesList: Array<AppEngineResourceState.AsObject>,
+  }
+}
+
+export class FlyIOApplicationLiveState extends jspb.Message {
+  getResourcesList(): Array<FlyIOResourceState>;
+  setResourcesList(value: Array<FlyIOResourceState>): FlyIOApplicationLiveState;
+  clearResourcesList(): FlyIOApplicationLiveState;
+  addResources(value?: FlyIOResourceState, index?: number): FlyIOResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FlyIOApplicationLiveState.AsObject;
+  static toObject(includeInstance: boolean, msg: FlyIOApplicationLiveState): FlyIOApplicationLiveState.AsObject;
+  static serializeBinaryToWriter(message: FlyIOApplicationLiveState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FlyIOApplicationLiveState;
+  static deserializeBinaryFromReader(message: FlyIOApplicationLiveState, reader: jspb.BinaryReader): FlyIOApplicationLiveState;
+}
+
+export namespace FlyIOApplicationLiveState {
+  export type AsObject = {
+    resourcesList: Array<FlyIOResourceState.AsObject>,
+  }
+}
+
 export class KubernetesResourceState extends jspb.Message {
   getId(): string;
   setId(value: string): KubernetesResourceState;
@@ -196,6 +326,16 @@ export class KubernetesResourceState extends jspb.Message {
   getHealthDescription(): string;
   setHealthDescription(value: string): KubernetesResourceState;
 
+  getPodState(): KubernetesPodState | undefined;
+  setPodState(value?: KubernetesPodState): KubernetesResourceState;
+  hasPodState(): boolean;
+  clearPodState(): KubernetesResourceState;
+
+  getWarningEventsList(): Array<KubernetesResourceEvent>;
+  setWarningEventsList(value: Array<KubernetesResourceEvent>): KubernetesResourceState;
+  clearWarningEventsList(): KubernetesResourceState;
+  addWarningEvents(value?: KubernetesResourceEvent, index?: number): KubernetesResourceEvent;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): KubernetesResourceState;
 
@@ -221,6 +361,8 @@ export namespace KubernetesResourceState {
     namespace: string,
     healthStatus: KubernetesResourceState.HealthStatus,
     healthDescription: string,
+    podState?: KubernetesPodState.AsObject,
+    warningEventsList: Array<KubernetesResourceEvent.AsObject>,
     createdAt: number,
     updatedAt: number,
   }
@@ -232,6 +374,98 @@ export namespace KubernetesResourceState {
   }
 }
 
+export class KubernetesPodState extends jspb.Message {
+  getPhase(): string;
+  setPhase(value: string): KubernetesPodState;
+
+  getContainersList(): Array<KubernetesContainerState>;
+  setContainersList(value: Array<KubernetesContainerState>): KubernetesPodState;
+  clearContainersList(): KubernetesPodState;
+  addContainers(value?: KubernetesContainerState, index?: number): KubernetesContainerState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesPodState.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesPodState): KubernetesPodState.AsObject;
+  static serializeBinaryToWriter(message: KubernetesPodState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesPodState;
+  static deserializeBinaryFromReader(message: KubernetesPodState, reader: jspb.BinaryReader): KubernetesPodState;
+}
+
+export namespace KubernetesPodState {
+  export type AsObject = {
+    phase: string,
+    containersList: Array<KubernetesContainerState.AsObject>,
+  }
+}
+
+export class KubernetesContainerState extends jspb.Message {
+  getName(): string;
+  setName(value: string): KubernetesContainerState;
+
+  getReady(): boolean;
+  setReady(value: boolean): KubernetesContainerState;
+
+  getRestartCount(): number;
+  setRestartCount(value: number): KubernetesContainerState;
+
+  getState(): string;
+  setState(value: string): KubernetesContainerState;
+
+  getReason(): string;
+  setReason(value: string): KubernetesContainerState;
+
+  getMessage(): string;
+  setMessage(value: string): KubernetesContainerState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesContainerState.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesContainerState): KubernetesContainerState.AsObject;
+  static serializeBinaryToWriter(message: KubernetesContainerState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesContainerState;
+  static deserializeBinaryFromReader(message: KubernetesContainerState, reader: jspb.BinaryReader): KubernetesContainerState;
+}
+
+export namespace KubernetesContainerState {
+  export type AsObject = {
+    name: string,
+    ready: boolean,
+    restartCount: number,
+    state: string,
+    reason: string,
+    message: string,
+  }
+}
+
+export class KubernetesResourceEvent extends jspb.Message {
+  getReason(): string;
+  setReason(value: string): KubernetesResourceEvent;
+
+  getMessage(): string;
+  setMessage(value: string): KubernetesResourceEvent;
+
+  getCount(): number;
+  setCount(value: number): KubernetesResourceEvent;
+
+  getLastTimestamp(): number;
+  setLastTimestamp(value: number): KubernetesResourceEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesResourceEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesResourceEvent): KubernetesResourceEvent.AsObject;
+  static serializeBinaryToWriter(message: KubernetesResourceEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesResourceEvent;
+  static deserializeBinaryFromReader(message: KubernetesResourceEvent, reader: jspb.BinaryReader): KubernetesResourceEvent;
+}
+
+export namespace KubernetesResourceEvent {
+  export type AsObject = {
+    reason: string,
+    message: string,
+    count: number,
+    lastTimestamp: number,
+  }
+}
+
 export class KubernetesResourceStateEvent extends jspb.Message {
   getId(): string;
   setId(value: string): KubernetesResourceStateEvent;
@@ -311,6 +545,16 @@ export class CloudRunResourceState extends jspb.Message {
   getHealthDescription(): string;
   setHealthDescription(value: string): CloudRunResourceState;
 
+  getRevisionState(): CloudRunRevisionState | undefined;
+  setRevisionState(value?: CloudRunRevisionState): CloudRunResourceState;
+  hasRevisionState(): boolean;
+  clearRevisionState(): CloudRunResourceState;
+
+  getConditionsList(): Array<CloudRunResourceCondition>;
+  setConditionsList(value: Array<CloudRunResourceCondition>): CloudRunResourceState;
+  clearConditionsList(): CloudRunResourceState;
+  addConditions(value?: CloudRunResourceCondition, index?: number): CloudRunResourceCondition;
+
   getCreatedAt(): number;
   setCreatedAt(value: number): CloudRunResourceState;
 
@@ -336,6 +580,262 @@ export namespace CloudRunResourceState {
     namespace: string,
     healthStatus: CloudRunResourceState.HealthStatus,
     healthDescription: string,
+    revisionState?: CloudRunRevisionState.AsObject,
+    conditionsList: Array<CloudRunResourceCondition.AsObject>,
+    createdAt: number,
+    updatedAt: number,
+  }
+
+  export enum HealthStatus { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    OTHER = 2,
+  }
+}
+
+export class CloudRunRevisionState extends jspb.Message {
+  getTrafficPercent(): number;
+  setTrafficPercent(value: number): CloudRunRevisionState;
+
+  getTagsList(): Array<string>;
+  setTagsList(value: Array<string>): CloudRunRevisionState;
+  clearTagsList(): CloudRunRevisionState;
+  addTags(value: string, index?: number): CloudRunRevisionState;
+
+  getMinInstances(): number;
+  setMinInstances(value: number): CloudRunRevisionState;
+
+  getMaxInstances(): number;
+  setMaxInstances(value: number): CloudRunRevisionState;
+
+  getContainerConcurrency(): number;
+  setContainerConcurrency(value: number): CloudRunRevisionState;
+
+  getImageDigest(): string;
+  setImageDigest(value: string): CloudRunRevisionState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CloudRunRevisionState.AsObject;
+  static toObject(includeInstance: boolean, msg: CloudRunRevisionState): CloudRunRevisionState.AsObject;
+  static serializeBinaryToWriter(message: CloudRunRevisionState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CloudRunRevisionState;
+  static deserializeBinaryFromReader(message: CloudRunRevisionState, reader: jspb.BinaryReader): CloudRunRevisionState;
+}
+
+export namespace CloudRunRevisionState {
+  export type AsObject = {
+    trafficPercent: number,
+    tagsList: Array<string>,
+    minInstances: number,
+    maxInstances: number,
+    containerConcurrency: number,
+    imageDigest: string,
+  }
+}
+
+export class CloudRunResourceCondition extends jspb.Message {
+  getType(): string;
+  setType(value: string): CloudRunResourceCondition;
+
+  getStatus(): string;
+  setStatus(value: string): CloudRunResourceCondition;
+
+  getReason(): string;
+  setReason(value: string): CloudRunResourceCondition;
+
+  getMessage(): string;
+  setMessage(value: string): CloudRunResourceCondition;
+
+  getLastTransitionTime(): number;
+  setLastTransitionTime(value: number): CloudRunResourceCondition;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CloudRunResourceCondition.AsObject;
+  static toObject(includeInstance: boolean, msg: CloudRunResourceCondition): CloudRunResourceCondition.AsObject;
+  static serializeBinaryToWriter(message: CloudRunResourceCondition, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CloudRunResourceCondition;
+  static deserializeBinaryFromReader(message: CloudRunResourceCondition, reader: jspb.BinaryReader): CloudRunResourceCondition;
+}
+
+export namespace CloudRunResourceCondition {
+  export type AsObject = {
+    type: string,
+    status: string,
+    reason: string,
+    message: string,
+    lastTransitionTime: number,
+  }
+}
+
+export class ECSResourceState extends jspb.Message {
+  getId(): string;
+  setId(value: string): ECSResourceState;
+
+  getOwnerIdsList(): Array<string>;
+  setOwnerIdsList(value: Array<string>): ECSResourceState;
+  clearOwnerIdsList(): ECSResourceState;
+  addOwnerIds(value: string, index?: number): ECSResourceState;
+
+  getParentIdsList(): Array<string>;
+  setParentIdsList(value: Array<string>): ECSResourceState;
+  clearParentIdsList(): ECSResourceState;
+  addParentIds(value: string, index?: number): ECSResourceState;
+
+  getName(): string;
+  setName(value: string): ECSResourceState;
+
+  getKind(): string;
+  setKind(value: string): ECSResourceState;
+
+  getHealthStatus(): ECSResourceState.HealthStatus;
+  setHealthStatus(value: ECSResourceState.HealthStatus): ECSResourceState;
+
+  getHealthDescription(): string;
+  setHealthDescription(value: string): ECSResourceState;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): ECSResourceState;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): ECSResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ECSResourceState.AsObject;
+  static toObject(includeInstance: boolean, msg: ECSResourceState): ECSResourceState.AsObject;
+  static serializeBinaryToWriter(message: ECSResourceState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ECSResourceState;
+  static deserializeBinaryFromReader(message: ECSResourceState, reader: jspb.BinaryReader): ECSResourceState;
+}
+
+export namespace ECSResourceState {
+  export type AsObject = {
+    id: string,
+    ownerIdsList: Array<string>,
+    parentIdsList: Array<string>,
+    name: string,
+    kind: string,
+    healthStatus: ECSResourceState.HealthStatus,
+    healthDescription: string,
+    createdAt: number,
+    updatedAt: number,
+  }
+
+  export enum HealthStatus { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    OTHER = 2,
+  }
+}
+
+export class NomadResourceState extends jspb.Message {
+  getId(): string;
+  setId(value: string): NomadResourceState;
+
+  getOwnerIdsList(): Array<string>;
+  setOwnerIdsList(value: Array<string>): NomadResourceState;
+  clearOwnerIdsList(): NomadResourceState;
+  addOwnerIds(value: string, index?: number): NomadResourceState;
+
+  getParentIdsList(): Array<string>;
+  setParentIdsList(value: Array<string>): NomadResourceState;
+  clearParentIdsList(): NomadResourceState;
+  addParentIds(value: string, index?: number): NomadResourceState;
+
+  getName(): string;
+  setName(value: string): NomadResourceState;
+
+  getKind(): string;
+  setKind(value: string): NomadResourceState;
+
+  getHealthStatus(): NomadResourceState.HealthStatus;
+  setHealthStatus(value: NomadResourceState.HealthStatus): NomadResourceState;
+
+  getHealthDescription(): string;
+  setHealthDescription(value: string): NomadResourceState;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): NomadResourceState;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): NomadResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): NomadResourceState.AsObject;
+  static toObject(includeInstance: boolean, msg: NomadResourceState): NomadResourceState.AsObject;
+  static serializeBinaryToWriter(message: NomadResourceState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): NomadResourceState;
+  static deserializeBinaryFromReader(message: NomadResourceState, reader: jspb.BinaryReader): NomadResourceState;
+}
+
+export namespace NomadResourceState {
+  export type AsObject = {
+    id: string,
+    ownerIdsList: Array<string>,
+    parentIdsList: Array<string>,
+    name: string,
+    kind: string,
+    healthStatus: NomadResourceState.HealthStatus,
+    healthDescription: string,
+    createdAt: number,
+    updatedAt: number,
+  }
+
+  export enum HealthStatus { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    OTHER = 2,
+  }
+}
+
+export class ContainerAppsResourceState extends jspb.Message {
+  getId(): string;
+  setId(value: string): ContainerAppsResourceState;
+
+  getOwnerIdsList(): Array<string>;
+  setOwnerIdsList(value: Array<string>): ContainerAppsResourceState;
+  clearOwnerIdsList(): ContainerAppsResourceState;
+  addOwnerIds(value: string, index?: number): ContainerAppsResourceState;
+
+  getParentIdsList(): Array<string>;
+  setParentIdsList(value: Array<string>): ContainerAppsResourceState;
+  clearParentIdsList(): ContainerAppsResourceState;
+  addParentIds(value: string, index?: number): ContainerAppsResourceState;
+
+  getName(): string;
+  setName(value: string): ContainerAppsResourceState;
+
+  getKind(): string;
+  setKind(value: string): ContainerAppsResourceState;
+
+  getHealthStatus(): ContainerAppsResourceState.HealthStatus;
+  setHealthStatus(value: ContainerAppsResourceState.HealthStatus): ContainerAppsResourceState;
+
+  getHealthDescription(): string;
+  setHealthDescription(value: string): ContainerAppsResourceState;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): ContainerAppsResourceState;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): ContainerAppsResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ContainerAppsResourceState.AsObject;
+  static toObject(includeInstance: boolean, msg: ContainerAppsResourceState): ContainerAppsResourceState.AsObject;
+  static serializeBinaryToWriter(message: ContainerAppsResourceState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ContainerAppsResourceState;
+  static deserializeBinaryFromReader(message: ContainerAppsResourceState, reader: jspb.BinaryReader): ContainerAppsResourceState;
+}
+
+export namespace ContainerAppsResourceState {
+  export type AsObject = {
+    id: string,
+    ownerIdsList: Array<string>,
+    parentIdsList: Array<string>,
+    name: string,
+    kind: string,
+    healthStatus: ContainerAppsResourceState.HealthStatus,
+    healthDescription: string,
     createdAt: number,
     updatedAt: number,
   }
@@ -347,3 +847,122 @@ export namespace CloudRunResourceState {
   }
 }
 
+export class AppEngineResourceState extends jspb.Message {
+  getId(): string;
+  setId(value: string): AppEngineResourceState;
+
+  getOwnerIdsList(): Array<string>;
+  setOwnerIdsList(value: Array<string>): AppEngineResourceState;
+  clearOwnerIdsList(): AppEngineResourceState;
+  addOwnerIds(value: string, index?: number): AppEngineResourceState;
+
+  getParentIdsList(): Array<string>;
+  setParentIdsList(value: Array<string>): AppEngineResourceState;
+  clearParentIdsList(): AppEngineResourceState;
+  addParentIds(value: string, index?: number): AppEngineResourceState;
+
+  getName(): string;
+  setName(value: string): AppEngineResourceState;
+
+  getKind(): string;
+  setKind(value: string): AppEngineResourceState;
+
+  getHealthStatus(): AppEngineResourceState.HealthStatus;
+  setHealthStatus(value: AppEngineResourceState.HealthStatus): AppEngineResourceState;
+
+  getHealthDescription(): string;
+  setHealthDescription(value: string): AppEngineResourceState;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): AppEngineResourceState;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): AppEngineResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): AppEngineResourceState.AsObject;
+  static toObject(includeInstance: boolean, msg: AppEngineResourceState): AppEngineResourceState.AsObject;
+  static serializeBinaryToWriter(message: AppEngineResourceState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): AppEngineResourceState;
+  static deserializeBinaryFromReader(message: AppEngineResourceState, reader: jspb.BinaryReader): AppEngineResourceState;
+}
+
+export namespace AppEngineResourceState {
+  export type AsObject = {
+    id: string,
+    ownerIdsList: Array<string>,
+    parentIdsList: Array<string>,
+    name: string,
+    kind: string,
+    healthStatus: AppEngineResourceState.HealthStatus,
+    healthDescription: string,
+    createdAt: number,
+    updatedAt: number,
+  }
+
+  export enum HealthStatus { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    OTHER = 2,
+  }
+}
+
+export class FlyIOResourceState extends jspb.Message {
+  getId(): string;
+  setId(value: string): FlyIOResourceState;
+
+  getOwnerIdsList(): Array<string>;
+  setOwnerIdsList(value: Array<string>): FlyIOResourceState;
+  clearOwnerIdsList(): FlyIOResourceState;
+  addOwnerIds(value: string, index?: number): FlyIOResourceState;
+
+  getParentIdsList(): Array<string>;
+  setParentIdsList(value: Array<string>): FlyIOResourceState;
+  clearParentIdsList(): FlyIOResourceState;
+  addParentIds(value: string, index?: number): FlyIOResourceState;
+
+  getName(): string;
+  setName(value: string): FlyIOResourceState;
+
+  getKind(): string;
+  setKind(value: string): FlyIOResourceState;
+
+  getHealthStatus(): FlyIOResourceState.HealthStatus;
+  setHealthStatus(value: FlyIOResourceState.HealthStatus): FlyIOResourceState;
+
+  getHealthDescription(): string;
+  setHealthDescription(value: string): FlyIOResourceState;
+
+  getCreatedAt(): number;
+  setCreatedAt(value: number): FlyIOResourceState;
+
+  getUpdatedAt(): number;
+  setUpdatedAt(value: number): FlyIOResourceState;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): FlyIOResourceState.AsObject;
+  static toObject(includeInstance: boolean, msg: FlyIOResourceState): FlyIOResourceState.AsObject;
+  static serializeBinaryToWriter(message: FlyIOResourceState, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): FlyIOResourceState;
+  static deserializeBinaryFromReader(message: FlyIOResourceState, reader: jspb.BinaryReader): FlyIOResourceState;
+}
+
+export namespace FlyIOResourceState {
+  export type AsObject = {
+    id: string,
+    ownerIdsList: Array<string>,
+    parentIdsList: Array<string>,
+    name: string,
+    kind: string,
+    healthStatus: FlyIOResourceState.HealthStatus,
+    healthDescription: string,
+    createdAt: number,
+    updatedAt: number,
+  }
+
+  export enum HealthStatus { 
+    UNKNOWN = 0,
+    HEALTHY = 1,
+    OTHER = 2,
+  }
+}
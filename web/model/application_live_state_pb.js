@@ -19,18 +19,38 @@ var global = Function('return this')();
 
 var pkg_model_common_pb = require('pipecd/web/model/common_pb.js');
 goog.object.extend(proto, pkg_model_common_pb);
+goog.exportSymbol('proto.model.AppEngineApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.AppEngineResourceState', null, global);
+goog.exportSymbol('proto.model.AppEngineResourceState.HealthStatus', null, global);
 goog.exportSymbol('proto.model.ApplicationLiveStateSnapshot', null, global);
 goog.exportSymbol('proto.model.ApplicationLiveStateSnapshot.Status', null, global);
 goog.exportSymbol('proto.model.ApplicationLiveStateVersion', null, global);
 goog.exportSymbol('proto.model.CloudRunApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.CloudRunResourceCondition', null, global);
 goog.exportSymbol('proto.model.CloudRunResourceState', null, global);
 goog.exportSymbol('proto.model.CloudRunResourceState.HealthStatus', null, global);
+goog.exportSymbol('proto.model.CloudRunRevisionState', null, global);
+goog.exportSymbol('proto.model.ContainerAppsApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.ContainerAppsResourceState', null, global);
+goog.exportSymbol('proto.model.ContainerAppsResourceState.HealthStatus', null, global);
+goog.exportSymbol('proto.model.ECSApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.ECSResourceState', null, global);
+goog.exportSymbol('proto.model.ECSResourceState.HealthStatus', null, global);
+goog.exportSymbol('proto.model.FlyIOApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.FlyIOResourceState', null, global);
+goog.exportSymbol('proto.model.FlyIOResourceState.HealthStatus', null, global);
 goog.exportSymbol('proto.model.KubernetesApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.KubernetesContainerState', null, global);
+goog.exportSymbol('proto.model.KubernetesPodState', null, global);
+goog.exportSymbol('proto.model.KubernetesResourceEvent', null, global);
 goog.exportSymbol('proto.model.KubernetesResourceState', null, global);
 goog.exportSymbol('proto.model.KubernetesResourceState.HealthStatus', null, global);
 goog.exportSymbol('proto.model.KubernetesResourceStateEvent', null, global);
 goog.exportSymbol('proto.model.KubernetesResourceStateEvent.Type', null, global);
 goog.exportSymbol('proto.model.LambdaApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.NomadApplicationLiveState', null, global);
+goog.exportSymbol('proto.model.NomadResourceState', null, global);
+goog.exportSymbol('proto.model.NomadResourceState.HealthStatus', null, global);
 goog.exportSymbol('proto.model.TerraformApplicationLiveState', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -158,6 +178,111 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.LambdaApplicationLiveState.displayName = 'proto.model.LambdaApplicationLiveState';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ECSApplicationLiveState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.ECSApplicationLiveState.repeatedFields_, null);
+};
+goog.inherits(proto.model.ECSApplicationLiveState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ECSApplicationLiveState.displayName = 'proto.model.ECSApplicationLiveState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NomadApplicationLiveState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NomadApplicationLiveState.repeatedFields_, null);
+};
+goog.inherits(proto.model.NomadApplicationLiveState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NomadApplicationLiveState.displayName = 'proto.model.NomadApplicationLiveState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ContainerAppsApplicationLiveState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.ContainerAppsApplicationLiveState.repeatedFields_, null);
+};
+goog.inherits(proto.model.ContainerAppsApplicationLiveState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ContainerAppsApplicationLiveState.displayName = 'proto.model.ContainerAppsApplicationLiveState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AppEngineApplicationLiveState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AppEngineApplicationLiveState.repeatedFields_, null);
+};
+goog.inherits(proto.model.AppEngineApplicationLiveState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AppEngineApplicationLiveState.displayName = 'proto.model.AppEngineApplicationLiveState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.FlyIOApplicationLiveState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.FlyIOApplicationLiveState.repeatedFields_, null);
+};
+goog.inherits(proto.model.FlyIOApplicationLiveState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.FlyIOApplicationLiveState.displayName = 'proto.model.FlyIOApplicationLiveState';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -179,6 +304,69 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.KubernetesResourceState.displayName = 'proto.model.KubernetesResourceState';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.KubernetesPodState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.KubernetesPodState.repeatedFields_, null);
+};
+goog.inherits(proto.model.KubernetesPodState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.KubernetesPodState.displayName = 'proto.model.KubernetesPodState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.KubernetesContainerState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.KubernetesContainerState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.KubernetesContainerState.displayName = 'proto.model.KubernetesContainerState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.KubernetesResourceEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.KubernetesResourceEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.KubernetesResourceEvent.displayName = 'proto.model.KubernetesResourceEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -221,6 +409,4616 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.model.CloudRunResourceState.displayName = 'proto.model.CloudRunResourceState';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.CloudRunRevisionState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.CloudRunRevisionState.repeatedFields_, null);
+};
+goog.inherits(proto.model.CloudRunRevisionState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.CloudRunRevisionState.displayName = 'proto.model.CloudRunRevisionState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.CloudRunResourceCondition = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.model.CloudRunResourceCondition, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.CloudRunResourceCondition.displayName = 'proto.model.CloudRunResourceCondition';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ECSResourceState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.ECSResourceState.repeatedFields_, null);
+};
+goog.inherits(proto.model.ECSResourceState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ECSResourceState.displayName = 'proto.model.ECSResourceState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.NomadResourceState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.NomadResourceState.repeatedFields_, null);
+};
+goog.inherits(proto.model.NomadResourceState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.NomadResourceState.displayName = 'proto.model.NomadResourceState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.ContainerAppsResourceState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.ContainerAppsResourceState.repeatedFields_, null);
+};
+goog.inherits(proto.model.ContainerAppsResourceState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.ContainerAppsResourceState.displayName = 'proto.model.ContainerAppsResourceState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.AppEngineResourceState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.AppEngineResourceState.repeatedFields_, null);
+};
+goog.inherits(proto.model.AppEngineResourceState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.AppEngineResourceState.displayName = 'proto.model.AppEngineResourceState';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.model.FlyIOResourceState = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.model.FlyIOResourceState.repeatedFields_, null);
+};
+goog.inherits(proto.model.FlyIOResourceState, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.model.FlyIOResourceState.displayName = 'proto.model.FlyIOResourceState';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ApplicationLiveStateSnapshot.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ApplicationLiveStateSnapshot} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ApplicationLiveStateSnapshot.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    pipedId: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    projectId: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    kubernetes: (f = msg.getKubernetes()) && proto.model.KubernetesApplicationLiveState.toObject(includeInstance, f),
+    terraform: (f = msg.getTerraform()) && proto.model.TerraformApplicationLiveState.toObject(includeInstance, f),
+    cloudrun: (f = msg.getCloudrun()) && proto.model.CloudRunApplicationLiveState.toObject(includeInstance, f),
+    lambda: (f = msg.getLambda()) && proto.model.LambdaApplicationLiveState.toObject(includeInstance, f),
+    ecs: (f = msg.getEcs()) && proto.model.ECSApplicationLiveState.toObject(includeInstance, f),
+    nomad: (f = msg.getNomad()) && proto.model.NomadApplicationLiveState.toObject(includeInstance, f),
+    containerapps: (f = msg.getContainerapps()) && proto.model.ContainerAppsApplicationLiveState.toObject(includeInstance, f),
+    appengine: (f = msg.getAppengine()) && proto.model.AppEngineApplicationLiveState.toObject(includeInstance, f),
+    flyio: (f = msg.getFlyio()) && proto.model.FlyIOApplicationLiveState.toObject(includeInstance, f),
+    version: (f = msg.getVersion()) && proto.model.ApplicationLiveStateVersion.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ApplicationLiveStateSnapshot}
+ */
+proto.model.ApplicationLiveStateSnapshot.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ApplicationLiveStateSnapshot;
+  return proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ApplicationLiveStateSnapshot} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ApplicationLiveStateSnapshot}
+ */
+proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPipedId(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setProjectId(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
+      msg.setKind(value);
+      break;
+    case 6:
+      var value = /** @type {!proto.model.ApplicationLiveStateSnapshot.Status} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 10:
+      var value = new proto.model.KubernetesApplicationLiveState;
+      reader.readMessage(value,proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader);
+      msg.setKubernetes(value);
+      break;
+    case 11:
+      var value = new proto.model.TerraformApplicationLiveState;
+      reader.readMessage(value,proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader);
+      msg.setTerraform(value);
+      break;
+    case 12:
+      var value = new proto.model.CloudRunApplicationLiveState;
+      reader.readMessage(value,proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader);
+      msg.setCloudrun(value);
+      break;
+    case 13:
+      var value = new proto.model.LambdaApplicationLiveState;
+      reader.readMessage(value,proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader);
+      msg.setLambda(value);
+      break;
+    case 14:
+      var value = new proto.model.ECSApplicationLiveState;
+      reader.readMessage(value,proto.model.ECSApplicationLiveState.deserializeBinaryFromReader);
+      msg.setEcs(value);
+      break;
+    case 16:
+      var value = new proto.model.NomadApplicationLiveState;
+      reader.readMessage(value,proto.model.NomadApplicationLiveState.deserializeBinaryFromReader);
+      msg.setNomad(value);
+      break;
+    case 17:
+      var value = new proto.model.ContainerAppsApplicationLiveState;
+      reader.readMessage(value,proto.model.ContainerAppsApplicationLiveState.deserializeBinaryFromReader);
+      msg.setContainerapps(value);
+      break;
+    case 18:
+      var value = new proto.model.AppEngineApplicationLiveState;
+      reader.readMessage(value,proto.model.AppEngineApplicationLiveState.deserializeBinaryFromReader);
+      msg.setAppengine(value);
+      break;
+    case 19:
+      var value = new proto.model.FlyIOApplicationLiveState;
+      reader.readMessage(value,proto.model.FlyIOApplicationLiveState.deserializeBinaryFromReader);
+      msg.setFlyio(value);
+      break;
+    case 15:
+      var value = new proto.model.ApplicationLiveStateVersion;
+      reader.readMessage(value,proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader);
+      msg.setVersion(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ApplicationLiveStateSnapshot.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ApplicationLiveStateSnapshot} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ApplicationLiveStateSnapshot.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPipedId();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getProjectId();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      6,
+      f
+    );
+  }
+  f = message.getKubernetes();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getTerraform();
+  if (f != null) {
+    writer.writeMessage(
+      11,
+      f,
+      proto.model.TerraformApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getCloudrun();
+  if (f != null) {
+    writer.writeMessage(
+      12,
+      f,
+      proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getLambda();
+  if (f != null) {
+    writer.writeMessage(
+      13,
+      f,
+      proto.model.LambdaApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getEcs();
+  if (f != null) {
+    writer.writeMessage(
+      14,
+      f,
+      proto.model.ECSApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getNomad();
+  if (f != null) {
+    writer.writeMessage(
+      16,
+      f,
+      proto.model.NomadApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getContainerapps();
+  if (f != null) {
+    writer.writeMessage(
+      17,
+      f,
+      proto.model.ContainerAppsApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getAppengine();
+  if (f != null) {
+    writer.writeMessage(
+      18,
+      f,
+      proto.model.AppEngineApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getFlyio();
+  if (f != null) {
+    writer.writeMessage(
+      19,
+      f,
+      proto.model.FlyIOApplicationLiveState.serializeBinaryToWriter
+    );
+  }
+  f = message.getVersion();
+  if (f != null) {
+    writer.writeMessage(
+      15,
+      f,
+      proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.ApplicationLiveStateSnapshot.Status = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
+};
+
+/**
+ * optional string application_id = 1;
+ * @return {string}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string piped_id = 3;
+ * @return {string}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getPipedId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.setPipedId = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string project_id = 4;
+ * @return {string}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getProjectId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.setProjectId = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional ApplicationKind kind = 5;
+ * @return {!proto.model.ApplicationKind}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getKind = function() {
+  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {!proto.model.ApplicationKind} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.setKind = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
+
+
+/**
+ * optional Status health_status = 6;
+ * @return {!proto.model.ApplicationLiveStateSnapshot.Status}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.ApplicationLiveStateSnapshot.Status} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {!proto.model.ApplicationLiveStateSnapshot.Status} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 6, value);
+};
+
+
+/**
+ * optional KubernetesApplicationLiveState kubernetes = 10;
+ * @return {?proto.model.KubernetesApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getKubernetes = function() {
+  return /** @type{?proto.model.KubernetesApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.KubernetesApplicationLiveState, 10));
+};
+
+
+/**
+ * @param {?proto.model.KubernetesApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setKubernetes = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearKubernetes = function() {
+  return this.setKubernetes(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasKubernetes = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * optional TerraformApplicationLiveState terraform = 11;
+ * @return {?proto.model.TerraformApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getTerraform = function() {
+  return /** @type{?proto.model.TerraformApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.TerraformApplicationLiveState, 11));
+};
+
+
+/**
+ * @param {?proto.model.TerraformApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setTerraform = function(value) {
+  return jspb.Message.setWrapperField(this, 11, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearTerraform = function() {
+  return this.setTerraform(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasTerraform = function() {
+  return jspb.Message.getField(this, 11) != null;
+};
+
+
+/**
+ * optional CloudRunApplicationLiveState cloudrun = 12;
+ * @return {?proto.model.CloudRunApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getCloudrun = function() {
+  return /** @type{?proto.model.CloudRunApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.CloudRunApplicationLiveState, 12));
+};
+
+
+/**
+ * @param {?proto.model.CloudRunApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setCloudrun = function(value) {
+  return jspb.Message.setWrapperField(this, 12, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearCloudrun = function() {
+  return this.setCloudrun(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasCloudrun = function() {
+  return jspb.Message.getField(this, 12) != null;
+};
+
+
+/**
+ * optional LambdaApplicationLiveState lambda = 13;
+ * @return {?proto.model.LambdaApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getLambda = function() {
+  return /** @type{?proto.model.LambdaApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.LambdaApplicationLiveState, 13));
+};
+
+
+/**
+ * @param {?proto.model.LambdaApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setLambda = function(value) {
+  return jspb.Message.setWrapperField(this, 13, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearLambda = function() {
+  return this.setLambda(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasLambda = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * optional ECSApplicationLiveState ecs = 14;
+ * @return {?proto.model.ECSApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getEcs = function() {
+  return /** @type{?proto.model.ECSApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.ECSApplicationLiveState, 14));
+};
+
+
+/**
+ * @param {?proto.model.ECSApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setEcs = function(value) {
+  return jspb.Message.setWrapperField(this, 14, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearEcs = function() {
+  return this.setEcs(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasEcs = function() {
+  return jspb.Message.getField(this, 14) != null;
+};
+
+
+/**
+ * optional NomadApplicationLiveState nomad = 16;
+ * @return {?proto.model.NomadApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getNomad = function() {
+  return /** @type{?proto.model.NomadApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.NomadApplicationLiveState, 16));
+};
+
+
+/**
+ * @param {?proto.model.NomadApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setNomad = function(value) {
+  return jspb.Message.setWrapperField(this, 16, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearNomad = function() {
+  return this.setNomad(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasNomad = function() {
+  return jspb.Message.getField(this, 16) != null;
+};
+
+
+/**
+ * optional ContainerAppsApplicationLiveState containerapps = 17;
+ * @return {?proto.model.ContainerAppsApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getContainerapps = function() {
+  return /** @type{?proto.model.ContainerAppsApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.ContainerAppsApplicationLiveState, 17));
+};
+
+
+/**
+ * @param {?proto.model.ContainerAppsApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setContainerapps = function(value) {
+  return jspb.Message.setWrapperField(this, 17, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearContainerapps = function() {
+  return this.setContainerapps(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasContainerapps = function() {
+  return jspb.Message.getField(this, 17) != null;
+};
+
+
+/**
+ * optional AppEngineApplicationLiveState appengine = 18;
+ * @return {?proto.model.AppEngineApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getAppengine = function() {
+  return /** @type{?proto.model.AppEngineApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.AppEngineApplicationLiveState, 18));
+};
+
+
+/**
+ * @param {?proto.model.AppEngineApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setAppengine = function(value) {
+  return jspb.Message.setWrapperField(this, 18, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearAppengine = function() {
+  return this.setAppengine(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasAppengine = function() {
+  return jspb.Message.getField(this, 18) != null;
+};
+
+
+/**
+ * optional FlyIOApplicationLiveState flyio = 19;
+ * @return {?proto.model.FlyIOApplicationLiveState}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getFlyio = function() {
+  return /** @type{?proto.model.FlyIOApplicationLiveState} */ (
+    jspb.Message.getWrapperField(this, proto.model.FlyIOApplicationLiveState, 19));
+};
+
+
+/**
+ * @param {?proto.model.FlyIOApplicationLiveState|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setFlyio = function(value) {
+  return jspb.Message.setWrapperField(this, 19, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearFlyio = function() {
+  return this.setFlyio(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasFlyio = function() {
+  return jspb.Message.getField(this, 19) != null;
+};
+
+
+/**
+ * optional ApplicationLiveStateVersion version = 15;
+ * @return {?proto.model.ApplicationLiveStateVersion}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.getVersion = function() {
+  return /** @type{?proto.model.ApplicationLiveStateVersion} */ (
+    jspb.Message.getWrapperField(this, proto.model.ApplicationLiveStateVersion, 15));
+};
+
+
+/**
+ * @param {?proto.model.ApplicationLiveStateVersion|undefined} value
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+*/
+proto.model.ApplicationLiveStateSnapshot.prototype.setVersion = function(value) {
+  return jspb.Message.setWrapperField(this, 15, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.clearVersion = function() {
+  return this.setVersion(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.ApplicationLiveStateSnapshot.prototype.hasVersion = function() {
+  return jspb.Message.getField(this, 15) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ApplicationLiveStateVersion.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ApplicationLiveStateVersion.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ApplicationLiveStateVersion} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ApplicationLiveStateVersion.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    timestamp: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    index: jspb.Message.getFieldWithDefault(msg, 2, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ApplicationLiveStateVersion}
+ */
+proto.model.ApplicationLiveStateVersion.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ApplicationLiveStateVersion;
+  return proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ApplicationLiveStateVersion} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ApplicationLiveStateVersion}
+ */
+proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setTimestamp(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setIndex(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ApplicationLiveStateVersion.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ApplicationLiveStateVersion} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getIndex();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 timestamp = 1;
+ * @return {number}
+ */
+proto.model.ApplicationLiveStateVersion.prototype.getTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.ApplicationLiveStateVersion} returns this
+ */
+proto.model.ApplicationLiveStateVersion.prototype.setTimestamp = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 index = 2;
+ * @return {number}
+ */
+proto.model.ApplicationLiveStateVersion.prototype.getIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.ApplicationLiveStateVersion} returns this
+ */
+proto.model.ApplicationLiveStateVersion.prototype.setIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.KubernetesApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.KubernetesResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesApplicationLiveState}
+ */
+proto.model.KubernetesApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesApplicationLiveState;
+  return proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesApplicationLiveState}
+ */
+proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.KubernetesResourceState;
+      reader.readMessage(value,proto.model.KubernetesResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.KubernetesResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated KubernetesResourceState resources = 1;
+ * @return {!Array<!proto.model.KubernetesResourceState>}
+ */
+proto.model.KubernetesApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.KubernetesResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.KubernetesResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.KubernetesResourceState>} value
+ * @return {!proto.model.KubernetesApplicationLiveState} returns this
+*/
+proto.model.KubernetesApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.KubernetesResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.KubernetesResourceState}
+ */
+proto.model.KubernetesApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.KubernetesResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.KubernetesApplicationLiveState} returns this
+ */
+proto.model.KubernetesApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.TerraformApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.TerraformApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.TerraformApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.TerraformApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.TerraformApplicationLiveState}
+ */
+proto.model.TerraformApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.TerraformApplicationLiveState;
+  return proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.TerraformApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.TerraformApplicationLiveState}
+ */
+proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.TerraformApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.TerraformApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.TerraformApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.TerraformApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.CloudRunApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.CloudRunApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.CloudRunApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.CloudRunApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.CloudRunResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.CloudRunApplicationLiveState}
+ */
+proto.model.CloudRunApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.CloudRunApplicationLiveState;
+  return proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.CloudRunApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.CloudRunApplicationLiveState}
+ */
+proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.CloudRunResourceState;
+      reader.readMessage(value,proto.model.CloudRunResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.CloudRunApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.CloudRunApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.CloudRunResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated CloudRunResourceState resources = 1;
+ * @return {!Array<!proto.model.CloudRunResourceState>}
+ */
+proto.model.CloudRunApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.CloudRunResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.CloudRunResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.CloudRunResourceState>} value
+ * @return {!proto.model.CloudRunApplicationLiveState} returns this
+*/
+proto.model.CloudRunApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.CloudRunResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.CloudRunResourceState}
+ */
+proto.model.CloudRunApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.CloudRunResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.CloudRunApplicationLiveState} returns this
+ */
+proto.model.CloudRunApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.LambdaApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.LambdaApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.LambdaApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.LambdaApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.LambdaApplicationLiveState}
+ */
+proto.model.LambdaApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.LambdaApplicationLiveState;
+  return proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.LambdaApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.LambdaApplicationLiveState}
+ */
+proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.LambdaApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.LambdaApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.LambdaApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.LambdaApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.ECSApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ECSApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ECSApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ECSApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ECSApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.ECSResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ECSApplicationLiveState}
+ */
+proto.model.ECSApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ECSApplicationLiveState;
+  return proto.model.ECSApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ECSApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ECSApplicationLiveState}
+ */
+proto.model.ECSApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.ECSResourceState;
+      reader.readMessage(value,proto.model.ECSResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ECSApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ECSApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ECSApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ECSApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.ECSResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ECSResourceState resources = 1;
+ * @return {!Array<!proto.model.ECSResourceState>}
+ */
+proto.model.ECSApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.ECSResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ECSResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ECSResourceState>} value
+ * @return {!proto.model.ECSApplicationLiveState} returns this
+*/
+proto.model.ECSApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.ECSResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ECSResourceState}
+ */
+proto.model.ECSApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ECSResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ECSApplicationLiveState} returns this
+ */
+proto.model.ECSApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.KubernetesResourceState.repeatedFields_ = [2,3,11];
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.NomadApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.NomadApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NomadApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.NomadApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NomadApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.NomadResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.NomadApplicationLiveState}
+ */
+proto.model.NomadApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.NomadApplicationLiveState;
+  return proto.model.NomadApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.NomadApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.NomadApplicationLiveState}
+ */
+proto.model.NomadApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.NomadResourceState;
+      reader.readMessage(value,proto.model.NomadResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.NomadApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.NomadApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.NomadApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.NomadApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.NomadResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated NomadResourceState resources = 1;
+ * @return {!Array<!proto.model.NomadResourceState>}
+ */
+proto.model.NomadApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.NomadResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.NomadResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.NomadResourceState>} value
+ * @return {!proto.model.NomadApplicationLiveState} returns this
+*/
+proto.model.NomadApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.NomadResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.NomadResourceState}
+ */
+proto.model.NomadApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.NomadResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NomadApplicationLiveState} returns this
+ */
+proto.model.NomadApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.ContainerAppsApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.ContainerAppsApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ContainerAppsApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ContainerAppsApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ContainerAppsApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.ContainerAppsResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ContainerAppsApplicationLiveState}
+ */
+proto.model.ContainerAppsApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ContainerAppsApplicationLiveState;
+  return proto.model.ContainerAppsApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ContainerAppsApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ContainerAppsApplicationLiveState}
+ */
+proto.model.ContainerAppsApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.ContainerAppsResourceState;
+      reader.readMessage(value,proto.model.ContainerAppsResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.ContainerAppsApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ContainerAppsApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ContainerAppsApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.ContainerAppsApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.ContainerAppsResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ContainerAppsResourceState resources = 1;
+ * @return {!Array<!proto.model.ContainerAppsResourceState>}
+ */
+proto.model.ContainerAppsApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.ContainerAppsResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.ContainerAppsResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.ContainerAppsResourceState>} value
+ * @return {!proto.model.ContainerAppsApplicationLiveState} returns this
+*/
+proto.model.ContainerAppsApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.ContainerAppsResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.ContainerAppsResourceState}
+ */
+proto.model.ContainerAppsApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.ContainerAppsResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ContainerAppsApplicationLiveState} returns this
+ */
+proto.model.ContainerAppsApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AppEngineApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.AppEngineApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AppEngineApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.AppEngineApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AppEngineApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.AppEngineResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.AppEngineApplicationLiveState}
+ */
+proto.model.AppEngineApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.AppEngineApplicationLiveState;
+  return proto.model.AppEngineApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.AppEngineApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.AppEngineApplicationLiveState}
+ */
+proto.model.AppEngineApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.AppEngineResourceState;
+      reader.readMessage(value,proto.model.AppEngineResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.AppEngineApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.AppEngineApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.AppEngineApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.AppEngineApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.AppEngineResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated AppEngineResourceState resources = 1;
+ * @return {!Array<!proto.model.AppEngineResourceState>}
+ */
+proto.model.AppEngineApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.AppEngineResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.AppEngineResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.AppEngineResourceState>} value
+ * @return {!proto.model.AppEngineApplicationLiveState} returns this
+*/
+proto.model.AppEngineApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.AppEngineResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.AppEngineResourceState}
+ */
+proto.model.AppEngineApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.AppEngineResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AppEngineApplicationLiveState} returns this
+ */
+proto.model.AppEngineApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.FlyIOApplicationLiveState.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.FlyIOApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.FlyIOApplicationLiveState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.FlyIOApplicationLiveState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.FlyIOApplicationLiveState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
+    proto.model.FlyIOResourceState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.FlyIOApplicationLiveState}
+ */
+proto.model.FlyIOApplicationLiveState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.FlyIOApplicationLiveState;
+  return proto.model.FlyIOApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.FlyIOApplicationLiveState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.FlyIOApplicationLiveState}
+ */
+proto.model.FlyIOApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.model.FlyIOResourceState;
+      reader.readMessage(value,proto.model.FlyIOResourceState.deserializeBinaryFromReader);
+      msg.addResources(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.FlyIOApplicationLiveState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.FlyIOApplicationLiveState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.FlyIOApplicationLiveState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.FlyIOApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getResourcesList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.model.FlyIOResourceState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated FlyIOResourceState resources = 1;
+ * @return {!Array<!proto.model.FlyIOResourceState>}
+ */
+proto.model.FlyIOApplicationLiveState.prototype.getResourcesList = function() {
+  return /** @type{!Array<!proto.model.FlyIOResourceState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.FlyIOResourceState, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.model.FlyIOResourceState>} value
+ * @return {!proto.model.FlyIOApplicationLiveState} returns this
+*/
+proto.model.FlyIOApplicationLiveState.prototype.setResourcesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.model.FlyIOResourceState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.FlyIOResourceState}
+ */
+proto.model.FlyIOApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.FlyIOResourceState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.FlyIOApplicationLiveState} returns this
+ */
+proto.model.FlyIOApplicationLiveState.prototype.clearResourcesList = function() {
+  return this.setResourcesList([]);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.KubernetesResourceState.repeatedFields_ = [2,3,11];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesResourceState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesResourceState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    apiVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    namespace: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    podState: (f = msg.getPodState()) && proto.model.KubernetesPodState.toObject(includeInstance, f),
+    warningEventsList: jspb.Message.toObjectList(msg.getWarningEventsList(),
+    proto.model.KubernetesResourceEvent.toObject, includeInstance),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesResourceState}
+ */
+proto.model.KubernetesResourceState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesResourceState;
+  return proto.model.KubernetesResourceState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesResourceState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesResourceState}
+ */
+proto.model.KubernetesResourceState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addOwnerIds(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addParentIds(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiVersion(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKind(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.model.KubernetesResourceState.HealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHealthDescription(value);
+      break;
+    case 10:
+      var value = new proto.model.KubernetesPodState;
+      reader.readMessage(value,proto.model.KubernetesPodState.deserializeBinaryFromReader);
+      msg.setPodState(value);
+      break;
+    case 11:
+      var value = new proto.model.KubernetesResourceEvent;
+      reader.readMessage(value,proto.model.KubernetesResourceEvent.deserializeBinaryFromReader);
+      msg.addWarningEvents(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesResourceState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesResourceState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesResourceState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOwnerIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getParentIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getApiVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealthDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getPodState();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.model.KubernetesPodState.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarningEventsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.model.KubernetesResourceEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      14,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      15,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.KubernetesResourceState.HealthStatus = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string owner_ids = 2;
+ * @return {!Array<string>}
+ */
+proto.model.KubernetesResourceState.prototype.getOwnerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setOwnerIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.addOwnerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.clearOwnerIdsList = function() {
+  return this.setOwnerIdsList([]);
+};
+
+
+/**
+ * repeated string parent_ids = 3;
+ * @return {!Array<string>}
+ */
+proto.model.KubernetesResourceState.prototype.getParentIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setParentIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.addParentIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.clearParentIdsList = function() {
+  return this.setParentIdsList([]);
+};
+
+
+/**
+ * optional string name = 4;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string api_version = 5;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getApiVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setApiVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string kind = 6;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getKind = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setKind = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string namespace = 7;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional HealthStatus health_status = 8;
+ * @return {!proto.model.KubernetesResourceState.HealthStatus}
+ */
+proto.model.KubernetesResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.KubernetesResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.model.KubernetesResourceState.HealthStatus} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional string health_description = 9;
+ * @return {string}
+ */
+proto.model.KubernetesResourceState.prototype.getHealthDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setHealthDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional KubernetesPodState pod_state = 10;
+ * @return {?proto.model.KubernetesPodState}
+ */
+proto.model.KubernetesResourceState.prototype.getPodState = function() {
+  return /** @type{?proto.model.KubernetesPodState} */ (
+    jspb.Message.getWrapperField(this, proto.model.KubernetesPodState, 10));
+};
+
+
+/**
+ * @param {?proto.model.KubernetesPodState|undefined} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+*/
+proto.model.KubernetesResourceState.prototype.setPodState = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.clearPodState = function() {
+  return this.setPodState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.KubernetesResourceState.prototype.hasPodState = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * repeated KubernetesResourceEvent warning_events = 11;
+ * @return {!Array<!proto.model.KubernetesResourceEvent>}
+ */
+proto.model.KubernetesResourceState.prototype.getWarningEventsList = function() {
+  return /** @type{!Array<!proto.model.KubernetesResourceEvent>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.KubernetesResourceEvent, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.model.KubernetesResourceEvent>} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+*/
+proto.model.KubernetesResourceState.prototype.setWarningEventsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.model.KubernetesResourceEvent=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.KubernetesResourceEvent}
+ */
+proto.model.KubernetesResourceState.prototype.addWarningEvents = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.model.KubernetesResourceEvent, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.clearWarningEventsList = function() {
+  return this.setWarningEventsList([]);
+};
+
+
+/**
+ * optional int64 created_at = 14;
+ * @return {number}
+ */
+proto.model.KubernetesResourceState.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional int64 updated_at = 15;
+ * @return {number}
+ */
+proto.model.KubernetesResourceState.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesResourceState} returns this
+ */
+proto.model.KubernetesResourceState.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.KubernetesPodState.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesPodState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesPodState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesPodState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesPodState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    phase: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    containersList: jspb.Message.toObjectList(msg.getContainersList(),
+    proto.model.KubernetesContainerState.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesPodState}
+ */
+proto.model.KubernetesPodState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesPodState;
+  return proto.model.KubernetesPodState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesPodState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesPodState}
+ */
+proto.model.KubernetesPodState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPhase(value);
+      break;
+    case 2:
+      var value = new proto.model.KubernetesContainerState;
+      reader.readMessage(value,proto.model.KubernetesContainerState.deserializeBinaryFromReader);
+      msg.addContainers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesPodState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesPodState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesPodState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesPodState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPhase();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getContainersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.model.KubernetesContainerState.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string phase = 1;
+ * @return {string}
+ */
+proto.model.KubernetesPodState.prototype.getPhase = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesPodState} returns this
+ */
+proto.model.KubernetesPodState.prototype.setPhase = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated KubernetesContainerState containers = 2;
+ * @return {!Array<!proto.model.KubernetesContainerState>}
+ */
+proto.model.KubernetesPodState.prototype.getContainersList = function() {
+  return /** @type{!Array<!proto.model.KubernetesContainerState>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.KubernetesContainerState, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.model.KubernetesContainerState>} value
+ * @return {!proto.model.KubernetesPodState} returns this
+*/
+proto.model.KubernetesPodState.prototype.setContainersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.model.KubernetesContainerState=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.KubernetesContainerState}
+ */
+proto.model.KubernetesPodState.prototype.addContainers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.model.KubernetesContainerState, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.KubernetesPodState} returns this
+ */
+proto.model.KubernetesPodState.prototype.clearContainersList = function() {
+  return this.setContainersList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesContainerState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesContainerState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesContainerState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesContainerState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ready: jspb.Message.getBooleanFieldWithDefault(msg, 2, false),
+    restartCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    state: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 6, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesContainerState}
+ */
+proto.model.KubernetesContainerState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesContainerState;
+  return proto.model.KubernetesContainerState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesContainerState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesContainerState}
+ */
+proto.model.KubernetesContainerState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setReady(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setRestartCount(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setState(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesContainerState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesContainerState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesContainerState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesContainerState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getReady();
+  if (f) {
+    writer.writeBool(
+      2,
+      f
+    );
+  }
+  f = message.getRestartCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getState();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string name = 1;
+ * @return {string}
+ */
+proto.model.KubernetesContainerState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bool ready = 2;
+ * @return {boolean}
+ */
+proto.model.KubernetesContainerState.prototype.getReady = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 2, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setReady = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 2, value);
+};
+
+
+/**
+ * optional int32 restart_count = 3;
+ * @return {number}
+ */
+proto.model.KubernetesContainerState.prototype.getRestartCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setRestartCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional string state = 4;
+ * @return {string}
+ */
+proto.model.KubernetesContainerState.prototype.getState = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setState = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string reason = 5;
+ * @return {string}
+ */
+proto.model.KubernetesContainerState.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string message = 6;
+ * @return {string}
+ */
+proto.model.KubernetesContainerState.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesContainerState} returns this
+ */
+proto.model.KubernetesContainerState.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesResourceEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesResourceEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesResourceEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    reason: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    count: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    lastTimestamp: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesResourceEvent}
+ */
+proto.model.KubernetesResourceEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesResourceEvent;
+  return proto.model.KubernetesResourceEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesResourceEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesResourceEvent}
+ */
+proto.model.KubernetesResourceEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setCount(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastTimestamp(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesResourceEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesResourceEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesResourceEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getReason();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getCount();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getLastTimestamp();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string reason = 1;
+ * @return {string}
+ */
+proto.model.KubernetesResourceEvent.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceEvent} returns this
+ */
+proto.model.KubernetesResourceEvent.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string message = 2;
+ * @return {string}
+ */
+proto.model.KubernetesResourceEvent.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceEvent} returns this
+ */
+proto.model.KubernetesResourceEvent.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional int32 count = 3;
+ * @return {number}
+ */
+proto.model.KubernetesResourceEvent.prototype.getCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesResourceEvent} returns this
+ */
+proto.model.KubernetesResourceEvent.prototype.setCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int64 last_timestamp = 4;
+ * @return {number}
+ */
+proto.model.KubernetesResourceEvent.prototype.getLastTimestamp = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesResourceEvent} returns this
+ */
+proto.model.KubernetesResourceEvent.prototype.setLastTimestamp = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.KubernetesResourceStateEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.KubernetesResourceStateEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceStateEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    applicationId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    state: (f = msg.getState()) && proto.model.KubernetesResourceState.toObject(includeInstance, f),
+    snapshotVersion: (f = msg.getSnapshotVersion()) && proto.model.ApplicationLiveStateVersion.toObject(includeInstance, f),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.KubernetesResourceStateEvent}
+ */
+proto.model.KubernetesResourceStateEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.KubernetesResourceStateEvent;
+  return proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.KubernetesResourceStateEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.KubernetesResourceStateEvent}
+ */
+proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApplicationId(value);
+      break;
+    case 3:
+      var value = /** @type {!proto.model.KubernetesResourceStateEvent.Type} */ (reader.readEnum());
+      msg.setType(value);
+      break;
+    case 4:
+      var value = new proto.model.KubernetesResourceState;
+      reader.readMessage(value,proto.model.KubernetesResourceState.deserializeBinaryFromReader);
+      msg.setState(value);
+      break;
+    case 5:
+      var value = new proto.model.ApplicationLiveStateVersion;
+      reader.readMessage(value,proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader);
+      msg.setSnapshotVersion(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.KubernetesResourceStateEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getApplicationId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getType();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      3,
+      f
+    );
+  }
+  f = message.getState();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.model.KubernetesResourceState.serializeBinaryToWriter
+    );
+  }
+  f = message.getSnapshotVersion();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      15,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.KubernetesResourceStateEvent.Type = {
+  ADD_OR_UPDATED: 0,
+  DELETED: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string application_id = 2;
+ * @return {string}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getApplicationId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.setApplicationId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional Type type = 3;
+ * @return {!proto.model.KubernetesResourceStateEvent.Type}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getType = function() {
+  return /** @type {!proto.model.KubernetesResourceStateEvent.Type} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {!proto.model.KubernetesResourceStateEvent.Type} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.setType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 3, value);
+};
+
+
+/**
+ * optional KubernetesResourceState state = 4;
+ * @return {?proto.model.KubernetesResourceState}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getState = function() {
+  return /** @type{?proto.model.KubernetesResourceState} */ (
+    jspb.Message.getWrapperField(this, proto.model.KubernetesResourceState, 4));
+};
+
+
+/**
+ * @param {?proto.model.KubernetesResourceState|undefined} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+*/
+proto.model.KubernetesResourceStateEvent.prototype.setState = function(value) {
+  return jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.clearState = function() {
+  return this.setState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.hasState = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional ApplicationLiveStateVersion snapshot_version = 5;
+ * @return {?proto.model.ApplicationLiveStateVersion}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getSnapshotVersion = function() {
+  return /** @type{?proto.model.ApplicationLiveStateVersion} */ (
+    jspb.Message.getWrapperField(this, proto.model.ApplicationLiveStateVersion, 5));
+};
+
+
+/**
+ * @param {?proto.model.ApplicationLiveStateVersion|undefined} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+*/
+proto.model.KubernetesResourceStateEvent.prototype.setSnapshotVersion = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.clearSnapshotVersion = function() {
+  return this.setSnapshotVersion(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.hasSnapshotVersion = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional int64 created_at = 15;
+ * @return {number}
+ */
+proto.model.KubernetesResourceStateEvent.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ */
+proto.model.KubernetesResourceStateEvent.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.CloudRunResourceState.repeatedFields_ = [2,3,11];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.CloudRunResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.CloudRunResourceState.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.CloudRunResourceState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunResourceState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    apiVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    namespace: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    revisionState: (f = msg.getRevisionState()) && proto.model.CloudRunRevisionState.toObject(includeInstance, f),
+    conditionsList: jspb.Message.toObjectList(msg.getConditionsList(),
+    proto.model.CloudRunResourceCondition.toObject, includeInstance),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.CloudRunResourceState}
+ */
+proto.model.CloudRunResourceState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.CloudRunResourceState;
+  return proto.model.CloudRunResourceState.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.CloudRunResourceState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.CloudRunResourceState}
+ */
+proto.model.CloudRunResourceState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addOwnerIds(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addParentIds(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiVersion(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKind(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setNamespace(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.model.CloudRunResourceState.HealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHealthDescription(value);
+      break;
+    case 10:
+      var value = new proto.model.CloudRunRevisionState;
+      reader.readMessage(value,proto.model.CloudRunRevisionState.deserializeBinaryFromReader);
+      msg.setRevisionState(value);
+      break;
+    case 11:
+      var value = new proto.model.CloudRunResourceCondition;
+      reader.readMessage(value,proto.model.CloudRunResourceCondition.deserializeBinaryFromReader);
+      msg.addConditions(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.CloudRunResourceState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.CloudRunResourceState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.CloudRunResourceState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunResourceState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOwnerIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getParentIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getApiVersion();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getNamespace();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealthDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getRevisionState();
+  if (f != null) {
+    writer.writeMessage(
+      10,
+      f,
+      proto.model.CloudRunRevisionState.serializeBinaryToWriter
+    );
+  }
+  f = message.getConditionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      11,
+      f,
+      proto.model.CloudRunResourceCondition.serializeBinaryToWriter
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      14,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      15,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.model.CloudRunResourceState.HealthStatus = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string owner_ids = 2;
+ * @return {!Array<string>}
+ */
+proto.model.CloudRunResourceState.prototype.getOwnerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setOwnerIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.addOwnerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.clearOwnerIdsList = function() {
+  return this.setOwnerIdsList([]);
+};
+
+
+/**
+ * repeated string parent_ids = 3;
+ * @return {!Array<string>}
+ */
+proto.model.CloudRunResourceState.prototype.getParentIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setParentIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.addParentIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.clearParentIdsList = function() {
+  return this.setParentIdsList([]);
+};
+
+
+/**
+ * optional string name = 4;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string api_version = 5;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getApiVersion = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setApiVersion = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional string kind = 6;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getKind = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setKind = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string namespace = 7;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getNamespace = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setNamespace = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional HealthStatus health_status = 8;
+ * @return {!proto.model.CloudRunResourceState.HealthStatus}
+ */
+proto.model.CloudRunResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.CloudRunResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.model.CloudRunResourceState.HealthStatus} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional string health_description = 9;
+ * @return {string}
+ */
+proto.model.CloudRunResourceState.prototype.getHealthDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setHealthDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional CloudRunRevisionState revision_state = 10;
+ * @return {?proto.model.CloudRunRevisionState}
+ */
+proto.model.CloudRunResourceState.prototype.getRevisionState = function() {
+  return /** @type{?proto.model.CloudRunRevisionState} */ (
+    jspb.Message.getWrapperField(this, proto.model.CloudRunRevisionState, 10));
+};
+
+
+/**
+ * @param {?proto.model.CloudRunRevisionState|undefined} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+*/
+proto.model.CloudRunResourceState.prototype.setRevisionState = function(value) {
+  return jspb.Message.setWrapperField(this, 10, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.clearRevisionState = function() {
+  return this.setRevisionState(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.model.CloudRunResourceState.prototype.hasRevisionState = function() {
+  return jspb.Message.getField(this, 10) != null;
+};
+
+
+/**
+ * repeated CloudRunResourceCondition conditions = 11;
+ * @return {!Array<!proto.model.CloudRunResourceCondition>}
+ */
+proto.model.CloudRunResourceState.prototype.getConditionsList = function() {
+  return /** @type{!Array<!proto.model.CloudRunResourceCondition>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.model.CloudRunResourceCondition, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.model.CloudRunResourceCondition>} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+*/
+proto.model.CloudRunResourceState.prototype.setConditionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 11, value);
+};
+
+
+/**
+ * @param {!proto.model.CloudRunResourceCondition=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.model.CloudRunResourceCondition}
+ */
+proto.model.CloudRunResourceState.prototype.addConditions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 11, opt_value, proto.model.CloudRunResourceCondition, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.clearConditionsList = function() {
+  return this.setConditionsList([]);
+};
+
+
+/**
+ * optional int64 created_at = 14;
+ * @return {number}
+ */
+proto.model.CloudRunResourceState.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional int64 updated_at = 15;
+ * @return {number}
+ */
+proto.model.CloudRunResourceState.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunResourceState} returns this
+ */
+proto.model.CloudRunResourceState.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.CloudRunRevisionState.repeatedFields_ = [2];
 
 
 
@@ -237,8 +5035,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.ApplicationLiveStateSnapshot.toObject(opt_includeInstance, this);
+proto.model.CloudRunRevisionState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.CloudRunRevisionState.toObject(opt_includeInstance, this);
 };
 
 
@@ -247,22 +5045,18 @@ proto.model.ApplicationLiveStateSnapshot.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.ApplicationLiveStateSnapshot} msg The msg instance to transform.
+ * @param {!proto.model.CloudRunRevisionState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.ApplicationLiveStateSnapshot.toObject = function(includeInstance, msg) {
+proto.model.CloudRunRevisionState.toObject = function(includeInstance, msg) {
   var f, obj = {
-    applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    pipedId: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    projectId: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    kind: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    healthStatus: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    kubernetes: (f = msg.getKubernetes()) && proto.model.KubernetesApplicationLiveState.toObject(includeInstance, f),
-    terraform: (f = msg.getTerraform()) && proto.model.TerraformApplicationLiveState.toObject(includeInstance, f),
-    cloudrun: (f = msg.getCloudrun()) && proto.model.CloudRunApplicationLiveState.toObject(includeInstance, f),
-    lambda: (f = msg.getLambda()) && proto.model.LambdaApplicationLiveState.toObject(includeInstance, f),
-    version: (f = msg.getVersion()) && proto.model.ApplicationLiveStateVersion.toObject(includeInstance, f)
+    trafficPercent: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    tagsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    minInstances: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    maxInstances: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    containerConcurrency: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    imageDigest: jspb.Message.getFieldWithDefault(msg, 6, "")
   };
 
   if (includeInstance) {
@@ -276,23 +5070,23 @@ proto.model.ApplicationLiveStateSnapshot.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.ApplicationLiveStateSnapshot}
+ * @return {!proto.model.CloudRunRevisionState}
  */
-proto.model.ApplicationLiveStateSnapshot.deserializeBinary = function(bytes) {
+proto.model.CloudRunRevisionState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.ApplicationLiveStateSnapshot;
-  return proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.CloudRunRevisionState;
+  return proto.model.CloudRunRevisionState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.ApplicationLiveStateSnapshot} msg The message object to deserialize into.
+ * @param {!proto.model.CloudRunRevisionState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.ApplicationLiveStateSnapshot}
+ * @return {!proto.model.CloudRunRevisionState}
  */
-proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.CloudRunRevisionState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -300,49 +5094,321 @@ proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader = function(
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setTrafficPercent(value);
+      break;
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      msg.addTags(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPipedId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMinInstances(value);
       break;
     case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setProjectId(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setMaxInstances(value);
       break;
     case 5:
-      var value = /** @type {!proto.model.ApplicationKind} */ (reader.readEnum());
-      msg.setKind(value);
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setContainerConcurrency(value);
       break;
     case 6:
-      var value = /** @type {!proto.model.ApplicationLiveStateSnapshot.Status} */ (reader.readEnum());
-      msg.setHealthStatus(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setImageDigest(value);
       break;
-    case 10:
-      var value = new proto.model.KubernetesApplicationLiveState;
-      reader.readMessage(value,proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader);
-      msg.setKubernetes(value);
+    default:
+      reader.skipField();
       break;
-    case 11:
-      var value = new proto.model.TerraformApplicationLiveState;
-      reader.readMessage(value,proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader);
-      msg.setTerraform(value);
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.model.CloudRunRevisionState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.CloudRunRevisionState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.CloudRunRevisionState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunRevisionState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTrafficPercent();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getTagsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getMinInstances();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+  f = message.getMaxInstances();
+  if (f !== 0) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getContainerConcurrency();
+  if (f !== 0) {
+    writer.writeInt32(
+      5,
+      f
+    );
+  }
+  f = message.getImageDigest();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 traffic_percent = 1;
+ * @return {number}
+ */
+proto.model.CloudRunRevisionState.prototype.getTrafficPercent = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setTrafficPercent = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * repeated string tags = 2;
+ * @return {!Array<string>}
+ */
+proto.model.CloudRunRevisionState.prototype.getTagsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setTagsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.addTags = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.clearTagsList = function() {
+  return this.setTagsList([]);
+};
+
+
+/**
+ * optional int32 min_instances = 3;
+ * @return {number}
+ */
+proto.model.CloudRunRevisionState.prototype.getMinInstances = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setMinInstances = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional int32 max_instances = 4;
+ * @return {number}
+ */
+proto.model.CloudRunRevisionState.prototype.getMaxInstances = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setMaxInstances = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional int32 container_concurrency = 5;
+ * @return {number}
+ */
+proto.model.CloudRunRevisionState.prototype.getContainerConcurrency = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setContainerConcurrency = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional string image_digest = 6;
+ * @return {string}
+ */
+proto.model.CloudRunRevisionState.prototype.getImageDigest = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.CloudRunRevisionState} returns this
+ */
+proto.model.CloudRunRevisionState.prototype.setImageDigest = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.model.CloudRunResourceCondition.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.CloudRunResourceCondition.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.CloudRunResourceCondition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.model.CloudRunResourceCondition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    type: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    status: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    reason: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    message: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    lastTransitionTime: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.CloudRunResourceCondition}
+ */
+proto.model.CloudRunResourceCondition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.CloudRunResourceCondition;
+  return proto.model.CloudRunResourceCondition.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.CloudRunResourceCondition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.CloudRunResourceCondition}
+ */
+proto.model.CloudRunResourceCondition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setStatus(value);
       break;
-    case 12:
-      var value = new proto.model.CloudRunApplicationLiveState;
-      reader.readMessage(value,proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader);
-      msg.setCloudrun(value);
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setReason(value);
       break;
-    case 13:
-      var value = new proto.model.LambdaApplicationLiveState;
-      reader.readMessage(value,proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader);
-      msg.setLambda(value);
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMessage(value);
       break;
-    case 15:
-      var value = new proto.model.ApplicationLiveStateVersion;
-      reader.readMessage(value,proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader);
-      msg.setVersion(value);
+    case 5:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastTransitionTime(value);
       break;
     default:
       reader.skipField();
@@ -357,9 +5423,9 @@ proto.model.ApplicationLiveStateSnapshot.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.serializeBinary = function() {
+proto.model.CloudRunResourceCondition.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.ApplicationLiveStateSnapshot.serializeBinaryToWriter(this, writer);
+  proto.model.CloudRunResourceCondition.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -367,541 +5433,569 @@ proto.model.ApplicationLiveStateSnapshot.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.ApplicationLiveStateSnapshot} message
+ * @param {!proto.model.CloudRunResourceCondition} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.ApplicationLiveStateSnapshot.serializeBinaryToWriter = function(message, writer) {
+proto.model.CloudRunResourceCondition.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getApplicationId();
+  f = message.getType();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPipedId();
+  f = message.getStatus();
   if (f.length > 0) {
     writer.writeString(
-      3,
+      2,
       f
     );
   }
-  f = message.getProjectId();
+  f = message.getReason();
   if (f.length > 0) {
     writer.writeString(
-      4,
+      3,
       f
     );
   }
-  f = message.getKind();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      5,
+  f = message.getMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
       f
     );
   }
-  f = message.getHealthStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      6,
+  f = message.getLastTransitionTime();
+  if (f !== 0) {
+    writer.writeInt64(
+      5,
       f
     );
   }
-  f = message.getKubernetes();
-  if (f != null) {
-    writer.writeMessage(
-      10,
-      f,
-      proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter
-    );
-  }
-  f = message.getTerraform();
-  if (f != null) {
-    writer.writeMessage(
-      11,
-      f,
-      proto.model.TerraformApplicationLiveState.serializeBinaryToWriter
-    );
-  }
-  f = message.getCloudrun();
-  if (f != null) {
-    writer.writeMessage(
-      12,
-      f,
-      proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter
-    );
-  }
-  f = message.getLambda();
-  if (f != null) {
-    writer.writeMessage(
-      13,
-      f,
-      proto.model.LambdaApplicationLiveState.serializeBinaryToWriter
-    );
-  }
-  f = message.getVersion();
-  if (f != null) {
-    writer.writeMessage(
-      15,
-      f,
-      proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter
-    );
-  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.model.ApplicationLiveStateSnapshot.Status = {
-  UNKNOWN: 0,
-  HEALTHY: 1,
-  OTHER: 2
-};
-
-/**
- * optional string application_id = 1;
+ * optional string type = 1;
  * @return {string}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getApplicationId = function() {
+proto.model.CloudRunResourceCondition.prototype.getType = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @return {!proto.model.CloudRunResourceCondition} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.setApplicationId = function(value) {
+proto.model.CloudRunResourceCondition.prototype.setType = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string piped_id = 3;
+ * optional string status = 2;
  * @return {string}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getPipedId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.model.CloudRunResourceCondition.prototype.getStatus = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @return {!proto.model.CloudRunResourceCondition} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.setPipedId = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.model.CloudRunResourceCondition.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string project_id = 4;
+ * optional string reason = 3;
  * @return {string}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getProjectId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.model.CloudRunResourceCondition.prototype.getReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @return {!proto.model.CloudRunResourceCondition} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.setProjectId = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.model.CloudRunResourceCondition.prototype.setReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
 /**
- * optional ApplicationKind kind = 5;
- * @return {!proto.model.ApplicationKind}
+ * optional string message = 4;
+ * @return {string}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getKind = function() {
-  return /** @type {!proto.model.ApplicationKind} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.model.CloudRunResourceCondition.prototype.getMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!proto.model.ApplicationKind} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @param {string} value
+ * @return {!proto.model.CloudRunResourceCondition} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.setKind = function(value) {
-  return jspb.Message.setProto3EnumField(this, 5, value);
+proto.model.CloudRunResourceCondition.prototype.setMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional Status health_status = 6;
- * @return {!proto.model.ApplicationLiveStateSnapshot.Status}
+ * optional int64 last_transition_time = 5;
+ * @return {number}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getHealthStatus = function() {
-  return /** @type {!proto.model.ApplicationLiveStateSnapshot.Status} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.model.CloudRunResourceCondition.prototype.getLastTransitionTime = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
 };
 
 
 /**
- * @param {!proto.model.ApplicationLiveStateSnapshot.Status} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @param {number} value
+ * @return {!proto.model.CloudRunResourceCondition} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.setHealthStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 6, value);
+proto.model.CloudRunResourceCondition.prototype.setLastTransitionTime = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 /**
- * optional KubernetesApplicationLiveState kubernetes = 10;
- * @return {?proto.model.KubernetesApplicationLiveState}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getKubernetes = function() {
-  return /** @type{?proto.model.KubernetesApplicationLiveState} */ (
-    jspb.Message.getWrapperField(this, proto.model.KubernetesApplicationLiveState, 10));
-};
-
+proto.model.ECSResourceState.repeatedFields_ = [2,3];
 
-/**
- * @param {?proto.model.KubernetesApplicationLiveState|undefined} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
-*/
-proto.model.ApplicationLiveStateSnapshot.prototype.setKubernetes = function(value) {
-  return jspb.Message.setWrapperField(this, 10, value);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.clearKubernetes = function() {
-  return this.setKubernetes(undefined);
+proto.model.ECSResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ECSResourceState.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.model.ECSResourceState} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.hasKubernetes = function() {
-  return jspb.Message.getField(this, 10) != null;
+proto.model.ECSResourceState.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional TerraformApplicationLiveState terraform = 11;
- * @return {?proto.model.TerraformApplicationLiveState}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.model.ECSResourceState}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getTerraform = function() {
-  return /** @type{?proto.model.TerraformApplicationLiveState} */ (
-    jspb.Message.getWrapperField(this, proto.model.TerraformApplicationLiveState, 11));
+proto.model.ECSResourceState.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.model.ECSResourceState;
+  return proto.model.ECSResourceState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.model.TerraformApplicationLiveState|undefined} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
-*/
-proto.model.ApplicationLiveStateSnapshot.prototype.setTerraform = function(value) {
-  return jspb.Message.setWrapperField(this, 11, value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.model.ECSResourceState} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.model.ECSResourceState}
+ */
+proto.model.ECSResourceState.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addOwnerIds(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addParentIds(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKind(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.model.ECSResourceState.HealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHealthDescription(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.clearTerraform = function() {
-  return this.setTerraform(undefined);
+proto.model.ECSResourceState.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.model.ECSResourceState.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.model.ECSResourceState} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.hasTerraform = function() {
-  return jspb.Message.getField(this, 11) != null;
+proto.model.ECSResourceState.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getOwnerIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getParentIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealthDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      14,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      15,
+      f
+    );
+  }
 };
 
 
 /**
- * optional CloudRunApplicationLiveState cloudrun = 12;
- * @return {?proto.model.CloudRunApplicationLiveState}
+ * @enum {number}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getCloudrun = function() {
-  return /** @type{?proto.model.CloudRunApplicationLiveState} */ (
-    jspb.Message.getWrapperField(this, proto.model.CloudRunApplicationLiveState, 12));
+proto.model.ECSResourceState.HealthStatus = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
 };
 
-
 /**
- * @param {?proto.model.CloudRunApplicationLiveState|undefined} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
-*/
-proto.model.ApplicationLiveStateSnapshot.prototype.setCloudrun = function(value) {
-  return jspb.Message.setWrapperField(this, 12, value);
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.ECSResourceState.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * @param {string} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.clearCloudrun = function() {
-  return this.setCloudrun(undefined);
+proto.model.ECSResourceState.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated string owner_ids = 2;
+ * @return {!Array<string>}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.hasCloudrun = function() {
-  return jspb.Message.getField(this, 12) != null;
+proto.model.ECSResourceState.prototype.getOwnerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * optional LambdaApplicationLiveState lambda = 13;
- * @return {?proto.model.LambdaApplicationLiveState}
+ * @param {!Array<string>} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getLambda = function() {
-  return /** @type{?proto.model.LambdaApplicationLiveState} */ (
-    jspb.Message.getWrapperField(this, proto.model.LambdaApplicationLiveState, 13));
+proto.model.ECSResourceState.prototype.setOwnerIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
- * @param {?proto.model.LambdaApplicationLiveState|undefined} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
-*/
-proto.model.ApplicationLiveStateSnapshot.prototype.setLambda = function(value) {
-  return jspb.Message.setWrapperField(this, 13, value);
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.ECSResourceState} returns this
+ */
+proto.model.ECSResourceState.prototype.addOwnerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.clearLambda = function() {
-  return this.setLambda(undefined);
+proto.model.ECSResourceState.prototype.clearOwnerIdsList = function() {
+  return this.setOwnerIdsList([]);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * repeated string parent_ids = 3;
+ * @return {!Array<string>}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.hasLambda = function() {
-  return jspb.Message.getField(this, 13) != null;
+proto.model.ECSResourceState.prototype.getParentIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * optional ApplicationLiveStateVersion version = 15;
- * @return {?proto.model.ApplicationLiveStateVersion}
+ * @param {!Array<string>} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.getVersion = function() {
-  return /** @type{?proto.model.ApplicationLiveStateVersion} */ (
-    jspb.Message.getWrapperField(this, proto.model.ApplicationLiveStateVersion, 15));
+proto.model.ECSResourceState.prototype.setParentIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * @param {?proto.model.ApplicationLiveStateVersion|undefined} value
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
-*/
-proto.model.ApplicationLiveStateSnapshot.prototype.setVersion = function(value) {
-  return jspb.Message.setWrapperField(this, 15, value);
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.ECSResourceState} returns this
+ */
+proto.model.ECSResourceState.prototype.addParentIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.ApplicationLiveStateSnapshot} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.clearVersion = function() {
-  return this.setVersion(undefined);
+proto.model.ECSResourceState.prototype.clearParentIdsList = function() {
+  return this.setParentIdsList([]);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string name = 4;
+ * @return {string}
  */
-proto.model.ApplicationLiveStateSnapshot.prototype.hasVersion = function() {
-  return jspb.Message.getField(this, 15) != null;
+proto.model.ECSResourceState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
+/**
+ * @param {string} value
+ * @return {!proto.model.ECSResourceState} returns this
+ */
+proto.model.ECSResourceState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
 
 
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional string kind = 5;
+ * @return {string}
  */
-proto.model.ApplicationLiveStateVersion.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.ApplicationLiveStateVersion.toObject(opt_includeInstance, this);
+proto.model.ECSResourceState.prototype.getKind = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.model.ApplicationLiveStateVersion} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateVersion.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    timestamp: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    index: jspb.Message.getFieldWithDefault(msg, 2, 0)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.model.ECSResourceState.prototype.setKind = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.ApplicationLiveStateVersion}
+ * optional HealthStatus health_status = 8;
+ * @return {!proto.model.ECSResourceState.HealthStatus}
  */
-proto.model.ApplicationLiveStateVersion.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.ApplicationLiveStateVersion;
-  return proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader(msg, reader);
+proto.model.ECSResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.ECSResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.model.ApplicationLiveStateVersion} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.ApplicationLiveStateVersion}
+ * @param {!proto.model.ECSResourceState.HealthStatus} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setTimestamp(value);
-      break;
-    case 2:
-      var value = /** @type {number} */ (reader.readInt64());
-      msg.setIndex(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.model.ECSResourceState.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional string health_description = 9;
+ * @return {string}
  */
-proto.model.ApplicationLiveStateVersion.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.model.ECSResourceState.prototype.getHealthDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.model.ApplicationLiveStateVersion} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {string} value
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getTimestamp();
-  if (f !== 0) {
-    writer.writeInt64(
-      1,
-      f
-    );
-  }
-  f = message.getIndex();
-  if (f !== 0) {
-    writer.writeInt64(
-      2,
-      f
-    );
-  }
+proto.model.ECSResourceState.prototype.setHealthDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * optional int64 timestamp = 1;
+ * optional int64 created_at = 14;
  * @return {number}
  */
-proto.model.ApplicationLiveStateVersion.prototype.getTimestamp = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.model.ECSResourceState.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.ApplicationLiveStateVersion} returns this
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateVersion.prototype.setTimestamp = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.model.ECSResourceState.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
 };
 
 
 /**
- * optional int64 index = 2;
+ * optional int64 updated_at = 15;
  * @return {number}
  */
-proto.model.ApplicationLiveStateVersion.prototype.getIndex = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.model.ECSResourceState.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.ApplicationLiveStateVersion} returns this
+ * @return {!proto.model.ECSResourceState} returns this
  */
-proto.model.ApplicationLiveStateVersion.prototype.setIndex = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.model.ECSResourceState.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
 };
 
 
-
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.KubernetesApplicationLiveState.repeatedFields_ = [1];
+proto.model.NomadResourceState.repeatedFields_ = [2,3];
 
 
 
@@ -918,8 +6012,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.KubernetesApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.KubernetesApplicationLiveState.toObject(opt_includeInstance, this);
+proto.model.NomadResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.NomadResourceState.toObject(opt_includeInstance, this);
 };
 
 
@@ -928,14 +6022,21 @@ proto.model.KubernetesApplicationLiveState.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.KubernetesApplicationLiveState} msg The msg instance to transform.
+ * @param {!proto.model.NomadResourceState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesApplicationLiveState.toObject = function(includeInstance, msg) {
+proto.model.NomadResourceState.toObject = function(includeInstance, msg) {
   var f, obj = {
-    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
-    proto.model.KubernetesResourceState.toObject, includeInstance)
+    id: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
   };
 
   if (includeInstance) {
@@ -949,23 +6050,23 @@ proto.model.KubernetesApplicationLiveState.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.KubernetesApplicationLiveState}
+ * @return {!proto.model.NomadResourceState}
  */
-proto.model.KubernetesApplicationLiveState.deserializeBinary = function(bytes) {
+proto.model.NomadResourceState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.KubernetesApplicationLiveState;
-  return proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.NomadResourceState;
+  return proto.model.NomadResourceState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.KubernetesApplicationLiveState} msg The message object to deserialize into.
+ * @param {!proto.model.NomadResourceState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.KubernetesApplicationLiveState}
+ * @return {!proto.model.NomadResourceState}
  */
-proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.NomadResourceState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -973,9 +6074,40 @@ proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader = functio
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.model.KubernetesResourceState;
-      reader.readMessage(value,proto.model.KubernetesResourceState.deserializeBinaryFromReader);
-      msg.addResources(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setId(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addOwnerIds(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addParentIds(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKind(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.model.NomadResourceState.HealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHealthDescription(value);
+      break;
+    case 14:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setCreatedAt(value);
+      break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
       break;
     default:
       reader.skipField();
@@ -990,9 +6122,9 @@ proto.model.KubernetesApplicationLiveState.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.KubernetesApplicationLiveState.prototype.serializeBinary = function() {
+proto.model.NomadResourceState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter(this, writer);
+  proto.model.NomadResourceState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1000,430 +6132,293 @@ proto.model.KubernetesApplicationLiveState.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.KubernetesApplicationLiveState} message
+ * @param {!proto.model.NomadResourceState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
+proto.model.NomadResourceState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getResourcesList();
+  f = message.getId();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeString(
       1,
-      f,
-      proto.model.KubernetesResourceState.serializeBinaryToWriter
+      f
     );
   }
-};
-
-
-/**
- * repeated KubernetesResourceState resources = 1;
- * @return {!Array<!proto.model.KubernetesResourceState>}
- */
-proto.model.KubernetesApplicationLiveState.prototype.getResourcesList = function() {
-  return /** @type{!Array<!proto.model.KubernetesResourceState>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.model.KubernetesResourceState, 1));
-};
-
-
-/**
- * @param {!Array<!proto.model.KubernetesResourceState>} value
- * @return {!proto.model.KubernetesApplicationLiveState} returns this
-*/
-proto.model.KubernetesApplicationLiveState.prototype.setResourcesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
-};
-
-
-/**
- * @param {!proto.model.KubernetesResourceState=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.KubernetesResourceState}
- */
-proto.model.KubernetesApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.KubernetesResourceState, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.model.KubernetesApplicationLiveState} returns this
- */
-proto.model.KubernetesApplicationLiveState.prototype.clearResourcesList = function() {
-  return this.setResourcesList([]);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.model.TerraformApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.TerraformApplicationLiveState.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.model.TerraformApplicationLiveState} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.model.TerraformApplicationLiveState.toObject = function(includeInstance, msg) {
-  var f, obj = {
-
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
+  f = message.getOwnerIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+  f = message.getParentIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getKind();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealthDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      14,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      15,
+      f
+    );
   }
-  return obj;
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.TerraformApplicationLiveState}
- */
-proto.model.TerraformApplicationLiveState.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.TerraformApplicationLiveState;
-  return proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+ * @enum {number}
+ */
+proto.model.NomadResourceState.HealthStatus = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
 };
 
-
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.model.TerraformApplicationLiveState} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.TerraformApplicationLiveState}
+ * optional string id = 1;
+ * @return {string}
  */
-proto.model.TerraformApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.model.NomadResourceState.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.TerraformApplicationLiveState.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.model.TerraformApplicationLiveState.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.model.NomadResourceState.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.model.TerraformApplicationLiveState} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * repeated string owner_ids = 2;
+ * @return {!Array<string>}
  */
-proto.model.TerraformApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.model.NomadResourceState.prototype.getOwnerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * @param {!Array<string>} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.repeatedFields_ = [1];
-
+proto.model.NomadResourceState.prototype.setOwnerIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.CloudRunApplicationLiveState.toObject(opt_includeInstance, this);
+proto.model.NomadResourceState.prototype.addOwnerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.model.CloudRunApplicationLiveState} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    resourcesList: jspb.Message.toObjectList(msg.getResourcesList(),
-    proto.model.CloudRunResourceState.toObject, includeInstance)
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.model.NomadResourceState.prototype.clearOwnerIdsList = function() {
+  return this.setOwnerIdsList([]);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.CloudRunApplicationLiveState}
+ * repeated string parent_ids = 3;
+ * @return {!Array<string>}
  */
-proto.model.CloudRunApplicationLiveState.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.CloudRunApplicationLiveState;
-  return proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+proto.model.NomadResourceState.prototype.getParentIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.model.CloudRunApplicationLiveState} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.CloudRunApplicationLiveState}
+ * @param {!Array<string>} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = new proto.model.CloudRunResourceState;
-      reader.readMessage(value,proto.model.CloudRunResourceState.deserializeBinaryFromReader);
-      msg.addResources(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.model.NomadResourceState.prototype.setParentIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.model.NomadResourceState.prototype.addParentIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.model.CloudRunApplicationLiveState} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getResourcesList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      1,
-      f,
-      proto.model.CloudRunResourceState.serializeBinaryToWriter
-    );
-  }
+proto.model.NomadResourceState.prototype.clearParentIdsList = function() {
+  return this.setParentIdsList([]);
 };
 
 
 /**
- * repeated CloudRunResourceState resources = 1;
- * @return {!Array<!proto.model.CloudRunResourceState>}
+ * optional string name = 4;
+ * @return {string}
  */
-proto.model.CloudRunApplicationLiveState.prototype.getResourcesList = function() {
-  return /** @type{!Array<!proto.model.CloudRunResourceState>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.model.CloudRunResourceState, 1));
+proto.model.NomadResourceState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * @param {!Array<!proto.model.CloudRunResourceState>} value
- * @return {!proto.model.CloudRunApplicationLiveState} returns this
-*/
-proto.model.CloudRunApplicationLiveState.prototype.setResourcesList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.model.NomadResourceState} returns this
+ */
+proto.model.NomadResourceState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {!proto.model.CloudRunResourceState=} opt_value
- * @param {number=} opt_index
- * @return {!proto.model.CloudRunResourceState}
+ * optional string kind = 5;
+ * @return {string}
  */
-proto.model.CloudRunApplicationLiveState.prototype.addResources = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.model.CloudRunResourceState, opt_index);
+proto.model.NomadResourceState.prototype.getKind = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.model.CloudRunApplicationLiveState} returns this
+ * @param {string} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.CloudRunApplicationLiveState.prototype.clearResourcesList = function() {
-  return this.setResourcesList([]);
+proto.model.NomadResourceState.prototype.setKind = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional HealthStatus health_status = 8;
+ * @return {!proto.model.NomadResourceState.HealthStatus}
  */
-proto.model.LambdaApplicationLiveState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.LambdaApplicationLiveState.toObject(opt_includeInstance, this);
+proto.model.NomadResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.NomadResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.model.LambdaApplicationLiveState} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.model.NomadResourceState.HealthStatus} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.LambdaApplicationLiveState.toObject = function(includeInstance, msg) {
-  var f, obj = {
+proto.model.NomadResourceState.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
 
-  };
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+/**
+ * optional string health_description = 9;
+ * @return {string}
+ */
+proto.model.NomadResourceState.prototype.getHealthDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.LambdaApplicationLiveState}
+ * @param {string} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.LambdaApplicationLiveState.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.LambdaApplicationLiveState;
-  return proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader(msg, reader);
+proto.model.NomadResourceState.prototype.setHealthDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.model.LambdaApplicationLiveState} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.LambdaApplicationLiveState}
+ * optional int64 created_at = 14;
+ * @return {number}
  */
-proto.model.LambdaApplicationLiveState.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.model.NomadResourceState.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {number} value
+ * @return {!proto.model.NomadResourceState} returns this
  */
-proto.model.LambdaApplicationLiveState.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.model.LambdaApplicationLiveState.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.model.NomadResourceState.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.model.LambdaApplicationLiveState} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional int64 updated_at = 15;
+ * @return {number}
  */
-proto.model.LambdaApplicationLiveState.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
+proto.model.NomadResourceState.prototype.getUpdatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
+/**
+ * @param {number} value
+ * @return {!proto.model.NomadResourceState} returns this
+ */
+proto.model.NomadResourceState.prototype.setUpdatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 15, value);
+};
+
 
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.KubernetesResourceState.repeatedFields_ = [2,3];
+proto.model.ContainerAppsResourceState.repeatedFields_ = [2,3];
 
 
 
@@ -1440,8 +6435,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.KubernetesResourceState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.KubernetesResourceState.toObject(opt_includeInstance, this);
+proto.model.ContainerAppsResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.ContainerAppsResourceState.toObject(opt_includeInstance, this);
 };
 
 
@@ -1450,19 +6445,17 @@ proto.model.KubernetesResourceState.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.KubernetesResourceState} msg The msg instance to transform.
+ * @param {!proto.model.ContainerAppsResourceState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesResourceState.toObject = function(includeInstance, msg) {
+proto.model.ContainerAppsResourceState.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
     parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
     name: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    apiVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    kind: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    namespace: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, ""),
     healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
     healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
@@ -1480,23 +6473,23 @@ proto.model.KubernetesResourceState.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.KubernetesResourceState}
+ * @return {!proto.model.ContainerAppsResourceState}
  */
-proto.model.KubernetesResourceState.deserializeBinary = function(bytes) {
+proto.model.ContainerAppsResourceState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.KubernetesResourceState;
-  return proto.model.KubernetesResourceState.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.ContainerAppsResourceState;
+  return proto.model.ContainerAppsResourceState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.KubernetesResourceState} msg The message object to deserialize into.
+ * @param {!proto.model.ContainerAppsResourceState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.KubernetesResourceState}
+ * @return {!proto.model.ContainerAppsResourceState}
  */
-proto.model.KubernetesResourceState.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.ContainerAppsResourceState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1520,19 +6513,11 @@ proto.model.KubernetesResourceState.deserializeBinaryFromReader = function(msg,
       msg.setName(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiVersion(value);
-      break;
-    case 6:
       var value = /** @type {string} */ (reader.readString());
       msg.setKind(value);
       break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNamespace(value);
-      break;
     case 8:
-      var value = /** @type {!proto.model.KubernetesResourceState.HealthStatus} */ (reader.readEnum());
+      var value = /** @type {!proto.model.ContainerAppsResourceState.HealthStatus} */ (reader.readEnum());
       msg.setHealthStatus(value);
       break;
     case 9:
@@ -1560,9 +6545,9 @@ proto.model.KubernetesResourceState.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.KubernetesResourceState.prototype.serializeBinary = function() {
+proto.model.ContainerAppsResourceState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.KubernetesResourceState.serializeBinaryToWriter(this, writer);
+  proto.model.ContainerAppsResourceState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1570,11 +6555,11 @@ proto.model.KubernetesResourceState.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.KubernetesResourceState} message
+ * @param {!proto.model.ContainerAppsResourceState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesResourceState.serializeBinaryToWriter = function(message, writer) {
+proto.model.ContainerAppsResourceState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -1604,24 +6589,10 @@ proto.model.KubernetesResourceState.serializeBinaryToWriter = function(message,
       f
     );
   }
-  f = message.getApiVersion();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
   f = message.getKind();
   if (f.length > 0) {
     writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getNamespace();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
+      5,
       f
     );
   }
@@ -1659,7 +6630,7 @@ proto.model.KubernetesResourceState.serializeBinaryToWriter = function(message,
 /**
  * @enum {number}
  */
-proto.model.KubernetesResourceState.HealthStatus = {
+proto.model.ContainerAppsResourceState.HealthStatus = {
   UNKNOWN: 0,
   HEALTHY: 1,
   OTHER: 2
@@ -1669,16 +6640,16 @@ proto.model.KubernetesResourceState.HealthStatus = {
  * optional string id = 1;
  * @return {string}
  */
-proto.model.KubernetesResourceState.prototype.getId = function() {
+proto.model.ContainerAppsResourceState.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setId = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -1687,16 +6658,16 @@ proto.model.KubernetesResourceState.prototype.setId = function(value) {
  * repeated string owner_ids = 2;
  * @return {!Array<string>}
  */
-proto.model.KubernetesResourceState.prototype.getOwnerIdsList = function() {
+proto.model.ContainerAppsResourceState.prototype.getOwnerIdsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setOwnerIdsList = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setOwnerIdsList = function(value) {
   return jspb.Message.setField(this, 2, value || []);
 };
 
@@ -1704,18 +6675,18 @@ proto.model.KubernetesResourceState.prototype.setOwnerIdsList = function(value)
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.addOwnerIds = function(value, opt_index) {
+proto.model.ContainerAppsResourceState.prototype.addOwnerIds = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.clearOwnerIdsList = function() {
+proto.model.ContainerAppsResourceState.prototype.clearOwnerIdsList = function() {
   return this.setOwnerIdsList([]);
 };
 
@@ -1724,16 +6695,16 @@ proto.model.KubernetesResourceState.prototype.clearOwnerIdsList = function() {
  * repeated string parent_ids = 3;
  * @return {!Array<string>}
  */
-proto.model.KubernetesResourceState.prototype.getParentIdsList = function() {
+proto.model.ContainerAppsResourceState.prototype.getParentIdsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setParentIdsList = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setParentIdsList = function(value) {
   return jspb.Message.setField(this, 3, value || []);
 };
 
@@ -1741,18 +6712,18 @@ proto.model.KubernetesResourceState.prototype.setParentIdsList = function(value)
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.addParentIds = function(value, opt_index) {
+proto.model.ContainerAppsResourceState.prototype.addParentIds = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.clearParentIdsList = function() {
+proto.model.ContainerAppsResourceState.prototype.clearParentIdsList = function() {
   return this.setParentIdsList([]);
 };
 
@@ -1761,88 +6732,52 @@ proto.model.KubernetesResourceState.prototype.clearParentIdsList = function() {
  * optional string name = 4;
  * @return {string}
  */
-proto.model.KubernetesResourceState.prototype.getName = function() {
+proto.model.ContainerAppsResourceState.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setName = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string api_version = 5;
+ * optional string kind = 5;
  * @return {string}
  */
-proto.model.KubernetesResourceState.prototype.getApiVersion = function() {
+proto.model.ContainerAppsResourceState.prototype.getKind = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setApiVersion = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setKind = function(value) {
   return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
-/**
- * optional string kind = 6;
- * @return {string}
- */
-proto.model.KubernetesResourceState.prototype.getKind = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
- */
-proto.model.KubernetesResourceState.prototype.setKind = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional string namespace = 7;
- * @return {string}
- */
-proto.model.KubernetesResourceState.prototype.getNamespace = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
- */
-proto.model.KubernetesResourceState.prototype.setNamespace = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
 /**
  * optional HealthStatus health_status = 8;
- * @return {!proto.model.KubernetesResourceState.HealthStatus}
+ * @return {!proto.model.ContainerAppsResourceState.HealthStatus}
  */
-proto.model.KubernetesResourceState.prototype.getHealthStatus = function() {
-  return /** @type {!proto.model.KubernetesResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.model.ContainerAppsResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.ContainerAppsResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {!proto.model.KubernetesResourceState.HealthStatus} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @param {!proto.model.ContainerAppsResourceState.HealthStatus} value
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setHealthStatus = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setHealthStatus = function(value) {
   return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
@@ -1851,16 +6786,16 @@ proto.model.KubernetesResourceState.prototype.setHealthStatus = function(value)
  * optional string health_description = 9;
  * @return {string}
  */
-proto.model.KubernetesResourceState.prototype.getHealthDescription = function() {
+proto.model.ContainerAppsResourceState.prototype.getHealthDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setHealthDescription = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setHealthDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 9, value);
 };
 
@@ -1869,16 +6804,16 @@ proto.model.KubernetesResourceState.prototype.setHealthDescription = function(va
  * optional int64 created_at = 14;
  * @return {number}
  */
-proto.model.KubernetesResourceState.prototype.getCreatedAt = function() {
+proto.model.ContainerAppsResourceState.prototype.getCreatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setCreatedAt = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setCreatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 14, value);
 };
 
@@ -1887,20 +6822,26 @@ proto.model.KubernetesResourceState.prototype.setCreatedAt = function(value) {
  * optional int64 updated_at = 15;
  * @return {number}
  */
-proto.model.KubernetesResourceState.prototype.getUpdatedAt = function() {
+proto.model.ContainerAppsResourceState.prototype.getUpdatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.KubernetesResourceState} returns this
+ * @return {!proto.model.ContainerAppsResourceState} returns this
  */
-proto.model.KubernetesResourceState.prototype.setUpdatedAt = function(value) {
+proto.model.ContainerAppsResourceState.prototype.setUpdatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 15, value);
 };
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.model.AppEngineResourceState.repeatedFields_ = [2,3];
 
 
 
@@ -1917,8 +6858,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.KubernetesResourceStateEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.KubernetesResourceStateEvent.toObject(opt_includeInstance, this);
+proto.model.AppEngineResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.AppEngineResourceState.toObject(opt_includeInstance, this);
 };
 
 
@@ -1927,18 +6868,21 @@ proto.model.KubernetesResourceStateEvent.prototype.toObject = function(opt_inclu
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.KubernetesResourceStateEvent} msg The msg instance to transform.
+ * @param {!proto.model.AppEngineResourceState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesResourceStateEvent.toObject = function(includeInstance, msg) {
+proto.model.AppEngineResourceState.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    applicationId: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    type: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    state: (f = msg.getState()) && proto.model.KubernetesResourceState.toObject(includeInstance, f),
-    snapshotVersion: (f = msg.getSnapshotVersion()) && proto.model.ApplicationLiveStateVersion.toObject(includeInstance, f),
-    createdAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
+    ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
+    parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    name: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
+    updatedAt: jspb.Message.getFieldWithDefault(msg, 15, 0)
   };
 
   if (includeInstance) {
@@ -1952,23 +6896,23 @@ proto.model.KubernetesResourceStateEvent.toObject = function(includeInstance, ms
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.KubernetesResourceStateEvent}
+ * @return {!proto.model.AppEngineResourceState}
  */
-proto.model.KubernetesResourceStateEvent.deserializeBinary = function(bytes) {
+proto.model.AppEngineResourceState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.KubernetesResourceStateEvent;
-  return proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.AppEngineResourceState;
+  return proto.model.AppEngineResourceState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.KubernetesResourceStateEvent} msg The message object to deserialize into.
+ * @param {!proto.model.AppEngineResourceState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.KubernetesResourceStateEvent}
+ * @return {!proto.model.AppEngineResourceState}
  */
-proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.AppEngineResourceState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1981,26 +6925,36 @@ proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader = function(
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApplicationId(value);
+      msg.addOwnerIds(value);
       break;
     case 3:
-      var value = /** @type {!proto.model.KubernetesResourceStateEvent.Type} */ (reader.readEnum());
-      msg.setType(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addParentIds(value);
       break;
     case 4:
-      var value = new proto.model.KubernetesResourceState;
-      reader.readMessage(value,proto.model.KubernetesResourceState.deserializeBinaryFromReader);
-      msg.setState(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     case 5:
-      var value = new proto.model.ApplicationLiveStateVersion;
-      reader.readMessage(value,proto.model.ApplicationLiveStateVersion.deserializeBinaryFromReader);
-      msg.setSnapshotVersion(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setKind(value);
       break;
-    case 15:
+    case 8:
+      var value = /** @type {!proto.model.AppEngineResourceState.HealthStatus} */ (reader.readEnum());
+      msg.setHealthStatus(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHealthDescription(value);
+      break;
+    case 14:
       var value = /** @type {number} */ (reader.readInt64());
       msg.setCreatedAt(value);
       break;
+    case 15:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setUpdatedAt(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -2014,9 +6968,9 @@ proto.model.KubernetesResourceStateEvent.deserializeBinaryFromReader = function(
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.KubernetesResourceStateEvent.prototype.serializeBinary = function() {
+proto.model.AppEngineResourceState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter(this, writer);
+  proto.model.AppEngineResourceState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2024,11 +6978,11 @@ proto.model.KubernetesResourceStateEvent.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.KubernetesResourceStateEvent} message
+ * @param {!proto.model.AppEngineResourceState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter = function(message, writer) {
+proto.model.AppEngineResourceState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2037,37 +6991,56 @@ proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter = function(mess
       f
     );
   }
-  f = message.getApplicationId();
+  f = message.getOwnerIdsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       2,
       f
     );
   }
-  f = message.getType();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getParentIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       3,
       f
     );
   }
-  f = message.getState();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
       4,
-      f,
-      proto.model.KubernetesResourceState.serializeBinaryToWriter
+      f
     );
   }
-  f = message.getSnapshotVersion();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getKind();
+  if (f.length > 0) {
+    writer.writeString(
       5,
-      f,
-      proto.model.ApplicationLiveStateVersion.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getHealthStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealthDescription();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
     );
   }
   f = message.getCreatedAt();
+  if (f !== 0) {
+    writer.writeInt64(
+      14,
+      f
+    );
+  }
+  f = message.getUpdatedAt();
   if (f !== 0) {
     writer.writeInt64(
       15,
@@ -2078,166 +7051,220 @@ proto.model.KubernetesResourceStateEvent.serializeBinaryToWriter = function(mess
 
 
 /**
- * @enum {number}
+ * @enum {number}
+ */
+proto.model.AppEngineResourceState.HealthStatus = {
+  UNKNOWN: 0,
+  HEALTHY: 1,
+  OTHER: 2
+};
+
+/**
+ * optional string id = 1;
+ * @return {string}
+ */
+proto.model.AppEngineResourceState.prototype.getId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.Type = {
-  ADD_OR_UPDATED: 0,
-  DELETED: 2
+proto.model.AppEngineResourceState.prototype.setId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
+
 /**
- * optional string id = 1;
- * @return {string}
+ * repeated string owner_ids = 2;
+ * @return {!Array<string>}
  */
-proto.model.KubernetesResourceStateEvent.prototype.getId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.model.AppEngineResourceState.prototype.getOwnerIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.AppEngineResourceState} returns this
+ */
+proto.model.AppEngineResourceState.prototype.setOwnerIdsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * @param {number=} opt_index
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.setId = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.model.AppEngineResourceState.prototype.addOwnerIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
- * optional string application_id = 2;
- * @return {string}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.getApplicationId = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.model.AppEngineResourceState.prototype.clearOwnerIdsList = function() {
+  return this.setOwnerIdsList([]);
+};
+
+
+/**
+ * repeated string parent_ids = 3;
+ * @return {!Array<string>}
+ */
+proto.model.AppEngineResourceState.prototype.getParentIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.model.AppEngineResourceState} returns this
+ */
+proto.model.AppEngineResourceState.prototype.setParentIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * @param {number=} opt_index
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.setApplicationId = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.model.AppEngineResourceState.prototype.addParentIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * optional Type type = 3;
- * @return {!proto.model.KubernetesResourceStateEvent.Type}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.getType = function() {
-  return /** @type {!proto.model.KubernetesResourceStateEvent.Type} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.model.AppEngineResourceState.prototype.clearParentIdsList = function() {
+  return this.setParentIdsList([]);
 };
 
 
 /**
- * @param {!proto.model.KubernetesResourceStateEvent.Type} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * optional string name = 4;
+ * @return {string}
  */
-proto.model.KubernetesResourceStateEvent.prototype.setType = function(value) {
-  return jspb.Message.setProto3EnumField(this, 3, value);
+proto.model.AppEngineResourceState.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * optional KubernetesResourceState state = 4;
- * @return {?proto.model.KubernetesResourceState}
+ * @param {string} value
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.getState = function() {
-  return /** @type{?proto.model.KubernetesResourceState} */ (
-    jspb.Message.getWrapperField(this, proto.model.KubernetesResourceState, 4));
+proto.model.AppEngineResourceState.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {?proto.model.KubernetesResourceState|undefined} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
-*/
-proto.model.KubernetesResourceStateEvent.prototype.setState = function(value) {
-  return jspb.Message.setWrapperField(this, 4, value);
+ * optional string kind = 5;
+ * @return {string}
+ */
+proto.model.AppEngineResourceState.prototype.getKind = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * @param {string} value
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.clearState = function() {
-  return this.setState(undefined);
+proto.model.AppEngineResourceState.prototype.setKind = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional HealthStatus health_status = 8;
+ * @return {!proto.model.AppEngineResourceState.HealthStatus}
  */
-proto.model.KubernetesResourceStateEvent.prototype.hasState = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.model.AppEngineResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.AppEngineResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * optional ApplicationLiveStateVersion snapshot_version = 5;
- * @return {?proto.model.ApplicationLiveStateVersion}
+ * @param {!proto.model.AppEngineResourceState.HealthStatus} value
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.getSnapshotVersion = function() {
-  return /** @type{?proto.model.ApplicationLiveStateVersion} */ (
-    jspb.Message.getWrapperField(this, proto.model.ApplicationLiveStateVersion, 5));
+proto.model.AppEngineResourceState.prototype.setHealthStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
 
 /**
- * @param {?proto.model.ApplicationLiveStateVersion|undefined} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
-*/
-proto.model.KubernetesResourceStateEvent.prototype.setSnapshotVersion = function(value) {
-  return jspb.Message.setWrapperField(this, 5, value);
+ * optional string health_description = 9;
+ * @return {string}
+ */
+proto.model.AppEngineResourceState.prototype.getHealthDescription = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * @param {string} value
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.clearSnapshotVersion = function() {
-  return this.setSnapshotVersion(undefined);
+proto.model.AppEngineResourceState.prototype.setHealthDescription = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional int64 created_at = 14;
+ * @return {number}
  */
-proto.model.KubernetesResourceStateEvent.prototype.hasSnapshotVersion = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.model.AppEngineResourceState.prototype.getCreatedAt = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
- * optional int64 created_at = 15;
+ * @param {number} value
+ * @return {!proto.model.AppEngineResourceState} returns this
+ */
+proto.model.AppEngineResourceState.prototype.setCreatedAt = function(value) {
+  return jspb.Message.setProto3IntField(this, 14, value);
+};
+
+
+/**
+ * optional int64 updated_at = 15;
  * @return {number}
  */
-proto.model.KubernetesResourceStateEvent.prototype.getCreatedAt = function() {
+proto.model.AppEngineResourceState.prototype.getUpdatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.KubernetesResourceStateEvent} returns this
+ * @return {!proto.model.AppEngineResourceState} returns this
  */
-proto.model.KubernetesResourceStateEvent.prototype.setCreatedAt = function(value) {
+proto.model.AppEngineResourceState.prototype.setUpdatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 15, value);
 };
 
 
-
 /**
  * List of repeated fields within this message type.
  * @private {!Array<number>}
  * @const
  */
-proto.model.CloudRunResourceState.repeatedFields_ = [2,3];
+proto.model.FlyIOResourceState.repeatedFields_ = [2,3];
 
 
 
@@ -2254,8 +7281,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.model.CloudRunResourceState.prototype.toObject = function(opt_includeInstance) {
-  return proto.model.CloudRunResourceState.toObject(opt_includeInstance, this);
+proto.model.FlyIOResourceState.prototype.toObject = function(opt_includeInstance) {
+  return proto.model.FlyIOResourceState.toObject(opt_includeInstance, this);
 };
 
 
@@ -2264,19 +7291,17 @@ proto.model.CloudRunResourceState.prototype.toObject = function(opt_includeInsta
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.model.CloudRunResourceState} msg The msg instance to transform.
+ * @param {!proto.model.FlyIOResourceState} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.CloudRunResourceState.toObject = function(includeInstance, msg) {
+proto.model.FlyIOResourceState.toObject = function(includeInstance, msg) {
   var f, obj = {
     id: jspb.Message.getFieldWithDefault(msg, 1, ""),
     ownerIdsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f,
     parentIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
     name: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    apiVersion: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    kind: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    namespace: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    kind: jspb.Message.getFieldWithDefault(msg, 5, ""),
     healthStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
     healthDescription: jspb.Message.getFieldWithDefault(msg, 9, ""),
     createdAt: jspb.Message.getFieldWithDefault(msg, 14, 0),
@@ -2294,23 +7319,23 @@ proto.model.CloudRunResourceState.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.model.CloudRunResourceState}
+ * @return {!proto.model.FlyIOResourceState}
  */
-proto.model.CloudRunResourceState.deserializeBinary = function(bytes) {
+proto.model.FlyIOResourceState.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.model.CloudRunResourceState;
-  return proto.model.CloudRunResourceState.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.model.FlyIOResourceState;
+  return proto.model.FlyIOResourceState.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.model.CloudRunResourceState} msg The message object to deserialize into.
+ * @param {!proto.model.FlyIOResourceState} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.model.CloudRunResourceState}
+ * @return {!proto.model.FlyIOResourceState}
  */
-proto.model.CloudRunResourceState.deserializeBinaryFromReader = function(msg, reader) {
+proto.model.FlyIOResourceState.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2334,19 +7359,11 @@ proto.model.CloudRunResourceState.deserializeBinaryFromReader = function(msg, re
       msg.setName(value);
       break;
     case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiVersion(value);
-      break;
-    case 6:
       var value = /** @type {string} */ (reader.readString());
       msg.setKind(value);
       break;
-    case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setNamespace(value);
-      break;
     case 8:
-      var value = /** @type {!proto.model.CloudRunResourceState.HealthStatus} */ (reader.readEnum());
+      var value = /** @type {!proto.model.FlyIOResourceState.HealthStatus} */ (reader.readEnum());
       msg.setHealthStatus(value);
       break;
     case 9:
@@ -2374,9 +7391,9 @@ proto.model.CloudRunResourceState.deserializeBinaryFromReader = function(msg, re
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.model.CloudRunResourceState.prototype.serializeBinary = function() {
+proto.model.FlyIOResourceState.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.model.CloudRunResourceState.serializeBinaryToWriter(this, writer);
+  proto.model.FlyIOResourceState.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2384,11 +7401,11 @@ proto.model.CloudRunResourceState.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.model.CloudRunResourceState} message
+ * @param {!proto.model.FlyIOResourceState} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.model.CloudRunResourceState.serializeBinaryToWriter = function(message, writer) {
+proto.model.FlyIOResourceState.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
   f = message.getId();
   if (f.length > 0) {
@@ -2418,24 +7435,10 @@ proto.model.CloudRunResourceState.serializeBinaryToWriter = function(message, wr
       f
     );
   }
-  f = message.getApiVersion();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
   f = message.getKind();
   if (f.length > 0) {
     writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getNamespace();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
+      5,
       f
     );
   }
@@ -2473,7 +7476,7 @@ proto.model.CloudRunResourceState.serializeBinaryToWriter = function(message, wr
 /**
  * @enum {number}
  */
-proto.model.CloudRunResourceState.HealthStatus = {
+proto.model.FlyIOResourceState.HealthStatus = {
   UNKNOWN: 0,
   HEALTHY: 1,
   OTHER: 2
@@ -2483,16 +7486,16 @@ proto.model.CloudRunResourceState.HealthStatus = {
  * optional string id = 1;
  * @return {string}
  */
-proto.model.CloudRunResourceState.prototype.getId = function() {
+proto.model.FlyIOResourceState.prototype.getId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setId = function(value) {
+proto.model.FlyIOResourceState.prototype.setId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -2501,16 +7504,16 @@ proto.model.CloudRunResourceState.prototype.setId = function(value) {
  * repeated string owner_ids = 2;
  * @return {!Array<string>}
  */
-proto.model.CloudRunResourceState.prototype.getOwnerIdsList = function() {
+proto.model.FlyIOResourceState.prototype.getOwnerIdsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setOwnerIdsList = function(value) {
+proto.model.FlyIOResourceState.prototype.setOwnerIdsList = function(value) {
   return jspb.Message.setField(this, 2, value || []);
 };
 
@@ -2518,18 +7521,18 @@ proto.model.CloudRunResourceState.prototype.setOwnerIdsList = function(value) {
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.addOwnerIds = function(value, opt_index) {
+proto.model.FlyIOResourceState.prototype.addOwnerIds = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.clearOwnerIdsList = function() {
+proto.model.FlyIOResourceState.prototype.clearOwnerIdsList = function() {
   return this.setOwnerIdsList([]);
 };
 
@@ -2538,16 +7541,16 @@ proto.model.CloudRunResourceState.prototype.clearOwnerIdsList = function() {
  * repeated string parent_ids = 3;
  * @return {!Array<string>}
  */
-proto.model.CloudRunResourceState.prototype.getParentIdsList = function() {
+proto.model.FlyIOResourceState.prototype.getParentIdsList = function() {
   return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
  * @param {!Array<string>} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setParentIdsList = function(value) {
+proto.model.FlyIOResourceState.prototype.setParentIdsList = function(value) {
   return jspb.Message.setField(this, 3, value || []);
 };
 
@@ -2555,18 +7558,18 @@ proto.model.CloudRunResourceState.prototype.setParentIdsList = function(value) {
 /**
  * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.addParentIds = function(value, opt_index) {
+proto.model.FlyIOResourceState.prototype.addParentIds = function(value, opt_index) {
   return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.clearParentIdsList = function() {
+proto.model.FlyIOResourceState.prototype.clearParentIdsList = function() {
   return this.setParentIdsList([]);
 };
 
@@ -2575,88 +7578,52 @@ proto.model.CloudRunResourceState.prototype.clearParentIdsList = function() {
  * optional string name = 4;
  * @return {string}
  */
-proto.model.CloudRunResourceState.prototype.getName = function() {
+proto.model.FlyIOResourceState.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setName = function(value) {
+proto.model.FlyIOResourceState.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional string api_version = 5;
+ * optional string kind = 5;
  * @return {string}
  */
-proto.model.CloudRunResourceState.prototype.getApiVersion = function() {
+proto.model.FlyIOResourceState.prototype.getKind = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setApiVersion = function(value) {
+proto.model.FlyIOResourceState.prototype.setKind = function(value) {
   return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
-/**
- * optional string kind = 6;
- * @return {string}
- */
-proto.model.CloudRunResourceState.prototype.getKind = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
- */
-proto.model.CloudRunResourceState.prototype.setKind = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
-};
-
-
-/**
- * optional string namespace = 7;
- * @return {string}
- */
-proto.model.CloudRunResourceState.prototype.getNamespace = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
- */
-proto.model.CloudRunResourceState.prototype.setNamespace = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
 /**
  * optional HealthStatus health_status = 8;
- * @return {!proto.model.CloudRunResourceState.HealthStatus}
+ * @return {!proto.model.FlyIOResourceState.HealthStatus}
  */
-proto.model.CloudRunResourceState.prototype.getHealthStatus = function() {
-  return /** @type {!proto.model.CloudRunResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+proto.model.FlyIOResourceState.prototype.getHealthStatus = function() {
+  return /** @type {!proto.model.FlyIOResourceState.HealthStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
 };
 
 
 /**
- * @param {!proto.model.CloudRunResourceState.HealthStatus} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @param {!proto.model.FlyIOResourceState.HealthStatus} value
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setHealthStatus = function(value) {
+proto.model.FlyIOResourceState.prototype.setHealthStatus = function(value) {
   return jspb.Message.setProto3EnumField(this, 8, value);
 };
 
@@ -2665,16 +7632,16 @@ proto.model.CloudRunResourceState.prototype.setHealthStatus = function(value) {
  * optional string health_description = 9;
  * @return {string}
  */
-proto.model.CloudRunResourceState.prototype.getHealthDescription = function() {
+proto.model.FlyIOResourceState.prototype.getHealthDescription = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setHealthDescription = function(value) {
+proto.model.FlyIOResourceState.prototype.setHealthDescription = function(value) {
   return jspb.Message.setProto3StringField(this, 9, value);
 };
 
@@ -2683,16 +7650,16 @@ proto.model.CloudRunResourceState.prototype.setHealthDescription = function(valu
  * optional int64 created_at = 14;
  * @return {number}
  */
-proto.model.CloudRunResourceState.prototype.getCreatedAt = function() {
+proto.model.FlyIOResourceState.prototype.getCreatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 14, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setCreatedAt = function(value) {
+proto.model.FlyIOResourceState.prototype.setCreatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 14, value);
 };
 
@@ -2701,16 +7668,16 @@ proto.model.CloudRunResourceState.prototype.setCreatedAt = function(value) {
  * optional int64 updated_at = 15;
  * @return {number}
  */
-proto.model.CloudRunResourceState.prototype.getUpdatedAt = function() {
+proto.model.FlyIOResourceState.prototype.getUpdatedAt = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 15, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.model.CloudRunResourceState} returns this
+ * @return {!proto.model.FlyIOResourceState} returns this
  */
-proto.model.CloudRunResourceState.prototype.setUpdatedAt = function(value) {
+proto.model.FlyIOResourceState.prototype.setUpdatedAt = function(value) {
   return jspb.Message.setProto3IntField(this, 15, value);
 };
 
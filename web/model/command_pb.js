@@ -633,7 +633,8 @@ proto.model.Command.SyncApplication.prototype.toObject = function(opt_includeIns
 proto.model.Command.SyncApplication.toObject = function(includeInstance, msg) {
   var f, obj = {
     applicationId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    syncStrategy: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    commitHash: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -678,6 +679,10 @@ proto.model.Command.SyncApplication.deserializeBinaryFromReader = function(msg,
       var value = /** @type {!proto.model.SyncStrategy} */ (reader.readEnum());
       msg.setSyncStrategy(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCommitHash(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -721,6 +726,13 @@ proto.model.Command.SyncApplication.serializeBinaryToWriter = function(message,
       f
     );
   }
+  f = message.getCommitHash();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -760,6 +772,24 @@ proto.model.Command.SyncApplication.prototype.setSyncStrategy = function(value)
 };
 
 
+/**
+ * optional string commit_hash = 3;
+ * @return {string}
+ */
+proto.model.Command.SyncApplication.prototype.getCommitHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.model.Command.SyncApplication} returns this
+ */
+proto.model.Command.SyncApplication.prototype.setCommitHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 
 
@@ -155,6 +155,19 @@ export enum ApplicationKind {
   LAMBDA = 3,
   CLOUDRUN = 4,
   ECS = 5,
+  APPRUNNER = 6,
+  CLOUDFORMATION = 7,
+  NOMAD = 8,
+  CONTAINERAPPS = 9,
+  APPENGINE = 10,
+  STEPFUNCTIONS = 11,
+  EC2ASG = 12,
+  GCEMIG = 13,
+  AZUREFUNCTIONS = 14,
+  STATICSITE = 15,
+  DOCKERCOMPOSE = 16,
+  KNATIVE = 17,
+  FLYIO = 18,
 }
 export enum RollbackKind { 
   ROLLBACK_KUBERNETES = 0,
@@ -162,6 +175,19 @@ export enum RollbackKind {
   ROLLBACK_LAMBDA = 3,
   ROLLBACK_CLOUDRUN = 4,
   ROLLBACK_ECS = 5,
+  ROLLBACK_APPRUNNER = 6,
+  ROLLBACK_CLOUDFORMATION = 7,
+  ROLLBACK_NOMAD = 8,
+  ROLLBACK_CONTAINERAPPS = 9,
+  ROLLBACK_APPENGINE = 10,
+  ROLLBACK_STEPFUNCTIONS = 11,
+  ROLLBACK_EC2ASG = 12,
+  ROLLBACK_GCEMIG = 13,
+  ROLLBACK_AZUREFUNCTIONS = 14,
+  ROLLBACK_STATICSITE = 16,
+  ROLLBACK_DOCKERCOMPOSE = 17,
+  ROLLBACK_KNATIVE = 18,
+  ROLLBACK_FLYIO = 19,
   ROLLBACK_CUSTOM_SYNC = 15,
 }
 export enum ApplicationActiveStatus { 
@@ -173,4 +199,5 @@ export enum SyncStrategy {
   AUTO = 0,
   QUICK_SYNC = 1,
   PIPELINE = 2,
+  DESTROY = 3,
 }
@@ -1153,7 +1153,20 @@ proto.model.ApplicationKind = {
   TERRAFORM: 1,
   LAMBDA: 3,
   CLOUDRUN: 4,
-  ECS: 5
+  ECS: 5,
+  APPRUNNER: 6,
+  CLOUDFORMATION: 7,
+  NOMAD: 8,
+  CONTAINERAPPS: 9,
+  APPENGINE: 10,
+  STEPFUNCTIONS: 11,
+  EC2ASG: 12,
+  GCEMIG: 13,
+  AZUREFUNCTIONS: 14,
+  STATICSITE: 15,
+  DOCKERCOMPOSE: 16,
+  KNATIVE: 17,
+  FLYIO: 18
 };
 
 /**
@@ -1165,6 +1178,19 @@ proto.model.RollbackKind = {
   ROLLBACK_LAMBDA: 3,
   ROLLBACK_CLOUDRUN: 4,
   ROLLBACK_ECS: 5,
+  ROLLBACK_APPRUNNER: 6,
+  ROLLBACK_CLOUDFORMATION: 7,
+  ROLLBACK_NOMAD: 8,
+  ROLLBACK_CONTAINERAPPS: 9,
+  ROLLBACK_APPENGINE: 10,
+  ROLLBACK_STEPFUNCTIONS: 11,
+  ROLLBACK_EC2ASG: 12,
+  ROLLBACK_GCEMIG: 13,
+  ROLLBACK_AZUREFUNCTIONS: 14,
+  ROLLBACK_STATICSITE: 16,
+  ROLLBACK_DOCKERCOMPOSE: 17,
+  ROLLBACK_KNATIVE: 18,
+  ROLLBACK_FLYIO: 19,
   ROLLBACK_CUSTOM_SYNC: 15
 };
 
@@ -1183,7 +1209,8 @@ proto.model.ApplicationActiveStatus = {
 proto.model.SyncStrategy = {
   AUTO: 0,
   QUICK_SYNC: 1,
-  PIPELINE: 2
+  PIPELINE: 2,
+  DESTROY: 3
 };
 
 goog.object.extend(exports, proto.model);
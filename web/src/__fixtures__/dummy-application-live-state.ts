@@ -26,6 +26,7 @@ export const resourcesList: KubernetesResourceState.AsObject[] = [
     namespace: "default",
     healthStatus: KubernetesResourceState.HealthStatus.HEALTHY,
     healthDescription: "",
+    warningEventsList: [],
     createdAt: resourceTimes[0].unix(),
     updatedAt: resourceTimes[0].unix(),
   },
@@ -39,6 +40,7 @@ export const resourcesList: KubernetesResourceState.AsObject[] = [
     namespace: "default",
     healthStatus: KubernetesResourceState.HealthStatus.HEALTHY,
     healthDescription: "",
+    warningEventsList: [],
     createdAt: resourceTimes[1].unix(),
     updatedAt: resourceTimes[1].unix(),
   },
@@ -52,6 +54,7 @@ export const resourcesList: KubernetesResourceState.AsObject[] = [
     namespace: "default",
     healthStatus: KubernetesResourceState.HealthStatus.OTHER,
     healthDescription: "",
+    warningEventsList: [],
     createdAt: resourceTimes[2].unix(),
     updatedAt: resourceTimes[2].unix(),
   },
@@ -66,6 +69,7 @@ export const dummyApplicationLiveState: ApplicationLiveState = {
   projectId: "project-1",
   cloudrun: { resourcesList: [] },
   lambda: {},
+  ecs: { resourcesList: [] },
   terraform: {},
   kubernetes: { resourcesList },
 };
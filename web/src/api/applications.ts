@@ -107,12 +107,14 @@ export const addApplication = async ({
 export const syncApplication = async ({
   applicationId,
   syncStrategy,
+  commitHash,
 }: SyncApplicationRequest.AsObject): Promise<
   SyncApplicationResponse.AsObject
 > => {
   const req = new SyncApplicationRequest();
   req.setApplicationId(applicationId);
   req.setSyncStrategy(syncStrategy);
+  req.setCommitHash(commitHash);
   return apiRequest(req, apiClient.syncApplication);
 };
 
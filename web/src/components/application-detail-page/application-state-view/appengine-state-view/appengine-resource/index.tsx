@@ -0,0 +1,42 @@
+import { makeStyles, Paper, Typography } from "@material-ui/core";
+import { FC, memo } from "react";
+import { AppEngineResourceState } from "~/modules/applications-live-state";
+import { AppEngineResourceHealthStatusIcon } from "./health-status-icon";
+
+const useStyles = makeStyles((theme) => ({
+  root: {
+    display: "inline-flex",
+    flexDirection: "column",
+    padding: theme.spacing(2),
+    width: 300,
+    cursor: "pointer",
+  },
+  nameLine: {
+    display: "flex",
+  },
+  name: {
+    marginLeft: theme.spacing(0.5),
+  },
+}));
+
+export interface AppEngineResourceProps {
+  resource: AppEngineResourceState.AsObject;
+  onClick: (resource: AppEngineResourceState.AsObject) => void;
+}
+
+export const AppEngineResource: FC<AppEngineResourceProps> = memo(
+  function AppEngineResource({ resource, onClick }) {
+    const classes = useStyles();
+    return (
+      <Paper square className={classes.root} onClick={() => onClick(resource)}>
+        <Typography variant="caption">{resource.kind}</Typography>
+        <div className={classes.nameLine}>
+          <AppEngineResourceHealthStatusIcon health={resource.healthStatus} />
+          <Typography variant="subtitle2" className={classes.name}>
+            {resource.name}
+          </Typography>
+        </div>
+      </Paper>
+    );
+  }
+);
@@ -1,6 +1,10 @@
 import { IconButton, makeStyles, Paper, Typography } from "@material-ui/core";
 import CloseIcon from "@material-ui/icons/Close";
 import { FC } from "react";
+import {
+  CloudRunResourceCondition,
+  CloudRunRevisionState,
+} from "pipecd/web/model/application_live_state_pb";
 
 const DETAIL_WIDTH = 400;
 
@@ -48,6 +52,8 @@ export interface CloudRunResourceDetailProps {
     kind: string;
     apiVersion: string;
     healthDescription: string;
+    revisionState?: CloudRunRevisionState.AsObject;
+    conditionsList?: CloudRunResourceCondition.AsObject[];
   };
   onClose: () => void;
 }
@@ -92,6 +98,67 @@ export const CloudRunResourceDetail: FC<CloudRunResourceDetailProps> = ({
           {resource.healthDescription || "Empty"}
         </Typography>
       </div>
+
+      {resource.revisionState && (
+        <>
+          <div className={classes.section}>
+            <Typography variant="subtitle1" className={classes.sectionTitle}>
+              Traffic
+            </Typography>
+            <Typography variant="body1" className={classes.sectionBody}>
+              {`${resource.revisionState.trafficPercent}%`}
+              {resource.revisionState.tagsList.length > 0 &&
+                ` (${resource.revisionState.tagsList.join(", ")})`}
+            </Typography>
+          </div>
+
+          <div className={classes.section}>
+            <Typography variant="subtitle1" className={classes.sectionTitle}>
+              Instances
+            </Typography>
+            <Typography variant="body1" className={classes.sectionBody}>
+              {`min ${resource.revisionState.minInstances}, max ${
+                resource.revisionState.maxInstances || "default"
+              }`}
+            </Typography>
+          </div>
+
+          <div className={classes.section}>
+            <Typography variant="subtitle1" className={classes.sectionTitle}>
+              Concurrency
+            </Typography>
+            <Typography variant="body1" className={classes.sectionBody}>
+              {resource.revisionState.containerConcurrency || "default"}
+            </Typography>
+          </div>
+
+          {resource.revisionState.imageDigest && (
+            <div className={classes.multilineSection}>
+              <Typography variant="subtitle1" className={classes.sectionTitle}>
+                Image Digest
+              </Typography>
+              <Typography variant="body2" className={classes.sectionBody}>
+                {resource.revisionState.imageDigest}
+              </Typography>
+            </div>
+          )}
+        </>
+      )}
+
+      {resource.conditionsList && resource.conditionsList.length > 0 && (
+        <div className={classes.multilineSection}>
+          <Typography variant="subtitle1" className={classes.sectionTitle}>
+            Conditions
+          </Typography>
+          {resource.conditionsList.map((c) => (
+            <Typography variant="body2" key={c.type}>
+              {`${c.type}: ${c.status}${c.reason ? ` (${c.reason})` : ""}${
+                c.message ? `, ${c.message}` : ""
+              }`}
+            </Typography>
+          ))}
+        </div>
+      )}
     </Paper>
   );
 };
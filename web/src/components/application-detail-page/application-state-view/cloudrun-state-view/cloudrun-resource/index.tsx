@@ -29,7 +29,11 @@ export const CloudRunResource: FC<CloudRunResourceProps> = memo(
     const classes = useStyles();
     return (
       <Paper square className={classes.root} onClick={() => onClick(resource)}>
-        <Typography variant="caption">{resource.kind}</Typography>
+        <Typography variant="caption">
+          {resource.revisionState
+            ? `${resource.kind} (${resource.revisionState.trafficPercent}% traffic)`
+            : resource.kind}
+        </Typography>
         <div className={classes.nameLine}>
           <CloudRunResourceHealthStatusIcon health={resource.healthStatus} />
           <Typography variant="subtitle2" className={classes.name}>
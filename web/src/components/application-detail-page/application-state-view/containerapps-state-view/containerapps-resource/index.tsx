@@ -0,0 +1,42 @@
+import { makeStyles, Paper, Typography } from "@material-ui/core";
+import { FC, memo } from "react";
+import { ContainerAppsResourceState } from "~/modules/applications-live-state";
+import { ContainerAppsResourceHealthStatusIcon } from "./health-status-icon";
+
+const useStyles = makeStyles((theme) => ({
+  root: {
+    display: "inline-flex",
+    flexDirection: "column",
+    padding: theme.spacing(2),
+    width: 300,
+    cursor: "pointer",
+  },
+  nameLine: {
+    display: "flex",
+  },
+  name: {
+    marginLeft: theme.spacing(0.5),
+  },
+}));
+
+export interface ContainerAppsResourceProps {
+  resource: ContainerAppsResourceState.AsObject;
+  onClick: (resource: ContainerAppsResourceState.AsObject) => void;
+}
+
+export const ContainerAppsResource: FC<ContainerAppsResourceProps> = memo(
+  function ContainerAppsResource({ resource, onClick }) {
+    const classes = useStyles();
+    return (
+      <Paper square className={classes.root} onClick={() => onClick(resource)}>
+        <Typography variant="caption">{resource.kind}</Typography>
+        <div className={classes.nameLine}>
+          <ContainerAppsResourceHealthStatusIcon health={resource.healthStatus} />
+          <Typography variant="subtitle2" className={classes.name}>
+            {resource.name}
+          </Typography>
+        </div>
+      </Paper>
+    );
+  }
+);
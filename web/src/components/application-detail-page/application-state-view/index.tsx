@@ -23,11 +23,21 @@ import {
 } from "~/modules/applications-live-state";
 import { KubernetesStateView } from "./kubernetes-state-view";
 import { CloudRunStateView } from "./cloudrun-state-view";
+import { ECSStateView } from "./ecs-state-view";
+import { NomadStateView } from "./nomad-state-view";
+import { ContainerAppsStateView } from "./containerapps-state-view";
+import { AppEngineStateView } from "./appengine-state-view";
+import { FlyIOStateView } from "./flyio-state-view";
 
 const isDisplayLiveState = (app: Application.AsObject | undefined): boolean => {
   return (
     app?.kind === ApplicationKind.KUBERNETES ||
-    app?.kind === ApplicationKind.CLOUDRUN
+    app?.kind === ApplicationKind.CLOUDRUN ||
+    app?.kind === ApplicationKind.ECS ||
+    app?.kind === ApplicationKind.NOMAD ||
+    app?.kind === ApplicationKind.CONTAINERAPPS ||
+    app?.kind === ApplicationKind.APPENGINE ||
+    app?.kind === ApplicationKind.FLYIO
   );
 };
 
@@ -77,12 +87,12 @@ export const ApplicationStateView: FC<ApplicationStateViewProps> = memo(
 
     useInterval(
       () => {
-        // Only fetch kubernetes or cloud run application.
+        // Only fetch kubernetes, cloud run, ecs, nomad, container apps, app engine or fly.io application.
         if (app && isDisplayLiveState(app)) {
           dispatch(fetchApplicationStateById(app.id));
         }
       },
-      // Only fetch kubernetes or cloud run application.
+      // Only fetch kubernetes, cloud run, ecs, nomad, container apps, app engine or fly.io application.
       isDisplayLiveState(app) && hasError === false ? FETCH_INTERVAL : null
     );
 
@@ -149,6 +159,26 @@ export const ApplicationStateView: FC<ApplicationStateViewProps> = memo(
         const resources = liveState.cloudrun?.resourcesList || [];
         return <CloudRunStateView resources={resources} />;
       }
+      case ApplicationKind.ECS: {
+        const resources = liveState.ecs?.resourcesList || [];
+        return <ECSStateView resources={resources} />;
+      }
+      case ApplicationKind.NOMAD: {
+        const resources = liveState.nomad?.resourcesList || [];
+        return <NomadStateView resources={resources} />;
+      }
+      case ApplicationKind.CONTAINERAPPS: {
+        const resources = liveState.containerapps?.resourcesList || [];
+        return <ContainerAppsStateView resources={resources} />;
+      }
+      case ApplicationKind.APPENGINE: {
+        const resources = liveState.appengine?.resourcesList || [];
+        return <AppEngineStateView resources={resources} />;
+      }
+      case ApplicationKind.FLYIO: {
+        const resources = liveState.flyio?.resourcesList || [];
+        return <FlyIOStateView resources={resources} />;
+      }
       default:
     }
 
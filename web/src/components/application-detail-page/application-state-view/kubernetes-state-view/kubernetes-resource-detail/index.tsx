@@ -1,6 +1,10 @@
 import { IconButton, makeStyles, Paper, Typography } from "@material-ui/core";
 import CloseIcon from "@material-ui/icons/Close";
 import { FC } from "react";
+import {
+  KubernetesPodState,
+  KubernetesResourceEvent,
+} from "pipecd/web/model/application_live_state_pb";
 
 const DETAIL_WIDTH = 400;
 
@@ -49,6 +53,8 @@ export interface KubernetesResourceDetailProps {
     namespace: string;
     apiVersion: string;
     healthDescription: string;
+    podState?: KubernetesPodState.AsObject;
+    warningEventsList?: KubernetesResourceEvent.AsObject[];
   };
   onClose: () => void;
 }
@@ -102,6 +108,35 @@ export const KubernetesResourceDetail: FC<KubernetesResourceDetailProps> = ({
           {resource.healthDescription || "Empty"}
         </Typography>
       </div>
+
+      {resource.podState && (
+        <div className={classes.multilineSection}>
+          <Typography variant="subtitle1" className={classes.sectionTitle}>
+            Pod Phase
+          </Typography>
+          <Typography variant="body1">{resource.podState.phase}</Typography>
+          {resource.podState.containersList.map((c) => (
+            <Typography variant="body2" key={c.name}>
+              {`${c.name}: ${c.state}${c.reason ? ` (${c.reason})` : ""}, ${
+                c.ready ? "ready" : "not ready"
+              }, ${c.restartCount} restarts`}
+            </Typography>
+          ))}
+        </div>
+      )}
+
+      {resource.warningEventsList && resource.warningEventsList.length > 0 && (
+        <div className={classes.multilineSection}>
+          <Typography variant="subtitle1" className={classes.sectionTitle}>
+            Warning Events
+          </Typography>
+          {resource.warningEventsList.map((e) => (
+            <Typography variant="body2" key={`${e.reason}-${e.message}`}>
+              {`${e.reason} (x${e.count}): ${e.message}`}
+            </Typography>
+          ))}
+        </div>
+      )}
     </Paper>
   );
 };
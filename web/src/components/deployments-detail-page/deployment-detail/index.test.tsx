@@ -1,6 +1,11 @@
 import userEvent from "@testing-library/user-event";
 import { MemoryRouter } from "react-router-dom";
-import { cancelDeployment, DeploymentStatus } from "~/modules/deployments";
+import { syncApplication } from "~/modules/applications";
+import {
+  cancelDeployment,
+  DeploymentStatus,
+  SyncStrategy,
+} from "~/modules/deployments";
 import { dummyDeployment } from "~/__fixtures__/dummy-deployment";
 import { dummyPiped } from "~/__fixtures__/dummy-piped";
 import { createStore, render, screen } from "~~/test-utils";
@@ -20,6 +25,9 @@ const baseState = {
     },
     ids: [dummyPiped.id],
   },
+  applications: {
+    syncing: {},
+  },
 };
 
 describe("DeploymentDetail", () => {
@@ -88,4 +96,31 @@ describe("DeploymentDetail", () => {
       ]);
     });
   });
+
+  it("dispatch syncApplication action with the commit hash if click redeploy button", () => {
+    const store = createStore(baseState);
+    render(
+      <MemoryRouter>
+        <DeploymentDetail deploymentId={dummyDeployment.id} />
+      </MemoryRouter>,
+      {
+        store,
+      }
+    );
+
+    userEvent.click(screen.getByRole("button", { name: "Redeploy this commit" }));
+
+    expect(store.getActions()).toMatchObject([
+      {
+        type: syncApplication.pending.type,
+        meta: {
+          arg: {
+            applicationId: dummyDeployment.applicationId,
+            syncStrategy: SyncStrategy.QUICK_SYNC,
+            commitHash: dummyDeployment.trigger?.commit?.hash,
+          },
+        },
+      },
+    ]);
+  });
 });
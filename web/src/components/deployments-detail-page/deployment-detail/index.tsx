@@ -1,5 +1,6 @@
 import {
   Box,
+  Button,
   Chip,
   CircularProgress,
   Link,
@@ -9,6 +10,7 @@ import {
 } from "@material-ui/core";
 import CancelIcon from "@material-ui/icons/Cancel";
 import OpenInNewIcon from "@material-ui/icons/OpenInNew";
+import ReplayIcon from "@material-ui/icons/Replay";
 import dayjs from "dayjs";
 import { FC, memo } from "react";
 import { Link as RouterLink } from "react-router-dom";
@@ -17,14 +19,17 @@ import { DetailTableRow } from "~/components/detail-table-row";
 import { SplitButton } from "~/components/split-button";
 import { DEPLOYMENT_STATE_TEXT } from "~/constants/deployment-status-text";
 import { PAGE_PATH_APPLICATIONS } from "~/constants/path";
-import { useAppDispatch, useAppSelector } from "~/hooks/redux";
+import { unwrapResult, useAppDispatch, useAppSelector } from "~/hooks/redux";
 import { useInterval } from "~/hooks/use-interval";
+import { syncApplication } from "~/modules/applications";
 import {
   cancelDeployment,
   Deployment,
+  DeploymentStatus,
   isDeploymentRunning,
   selectById as selectDeploymentById,
   selectDeploymentIsCanceling,
+  SyncStrategy,
 } from "~/modules/deployments";
 import { selectPipedById } from "~/modules/pipeds";
 import { fetchStageLog } from "~/modules/stage-logs";
@@ -50,6 +55,11 @@ const useStyles = makeStyles((theme) => ({
     top: theme.spacing(2),
     right: theme.spacing(2),
   },
+  redeployButton: {
+    position: "absolute",
+    top: theme.spacing(2),
+    right: theme.spacing(2),
+  },
   statusReason: {
     paddingTop: theme.spacing(1),
     paddingBottom: theme.spacing(1),
@@ -89,6 +99,9 @@ export const DeploymentDetail: FC<DeploymentDetailProps> = memo(
     const isCanceling = useAppSelector(
       selectDeploymentIsCanceling(deploymentId)
     );
+    const isSyncing = useAppSelector<boolean>((state) =>
+      deployment ? state.applications.syncing[deployment.applicationId] : false
+    );
 
     useInterval(
       () => {
@@ -236,6 +249,29 @@ export const DeploymentDetail: FC<DeploymentDetailProps> = memo(
                 disabled={isCanceling}
               />
             )}
+            {deployment.status === DeploymentStatus.DEPLOYMENT_SUCCESS &&
+              deployment.trigger?.commit && (
+                <Button
+                  className={classes.redeployButton}
+                  variant="outlined"
+                  color="primary"
+                  startIcon={<ReplayIcon />}
+                  disabled={isSyncing}
+                  onClick={() => {
+                    dispatch(
+                      syncApplication({
+                        applicationId: deployment.applicationId,
+                        syncStrategy: SyncStrategy.QUICK_SYNC,
+                        commitHash: deployment.trigger?.commit?.hash,
+                      })
+                    )
+                      .then(unwrapResult)
+                      .catch(() => undefined);
+                  }}
+                >
+                  Redeploy this commit
+                </Button>
+              )}
           </Box>
         </Box>
       </Paper>
@@ -33,6 +33,7 @@ import {
 } from "~/modules/deployments";
 import { selectStageLogById, StageLog } from "~/modules/stage-logs";
 import { Log } from "./log";
+import { TerraformPlan } from "./terraform-plan";
 
 const INITIAL_HEIGHT = 400;
 const TOOLBAR_HEIGHT = 48;
@@ -202,6 +203,7 @@ export const LogViewer: FC = memo(function LogViewer() {
           </div>
         </Toolbar>
         <div className={classes.logContainer} style={{ height: logViewHeight }}>
+          <TerraformPlan metadata={activeStage.metadataMap} />
           <Log
             loading={isStageRunning(activeStage.status)}
             logs={stageLog.logBlocks}
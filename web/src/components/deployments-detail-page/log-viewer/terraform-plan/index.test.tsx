@@ -0,0 +1,54 @@
+import { render, screen } from "~~/test-utils";
+import { TerraformPlan, TERRAFORM_PLAN_SUMMARY_METADATA_KEY } from ".";
+
+const summary = {
+  resources: [
+    {
+      address: "aws_instance.web",
+      action: "update",
+      attributes: [
+        { name: "instance_type", before: '"t3.micro"', after: '"t3.small"' },
+      ],
+    },
+    {
+      address: "aws_db_instance.main",
+      action: "replace",
+      attributes: [
+        {
+          name: "password",
+          before: "(sensitive value)",
+          after: "(sensitive value)",
+        },
+      ],
+    },
+  ],
+};
+
+it("should not render anything if there is no plan summary", () => {
+  render(<TerraformPlan metadata={[]} />, {});
+
+  expect(screen.queryByTestId("terraform-plan")).not.toBeInTheDocument();
+});
+
+it("should render the changes of resources", () => {
+  render(
+    <TerraformPlan
+      metadata={[
+        [TERRAFORM_PLAN_SUMMARY_METADATA_KEY, JSON.stringify(summary)],
+      ]}
+    />,
+    {}
+  );
+
+  expect(
+    screen.getByText("Plan: 1 to add, 1 to change, 1 to destroy.")
+  ).toBeInTheDocument();
+  expect(screen.getByText("~ aws_instance.web")).toBeInTheDocument();
+  expect(
+    screen.getByText('instance_type: "t3.micro" -> "t3.small"')
+  ).toBeInTheDocument();
+  expect(screen.getByText("-/+ aws_db_instance.main")).toBeInTheDocument();
+  expect(
+    screen.getByText("password: (sensitive value) -> (sensitive value)")
+  ).toBeInTheDocument();
+});
@@ -0,0 +1,137 @@
+import { Box, makeStyles, Typography } from "@material-ui/core";
+import green from "@material-ui/core/colors/green";
+import red from "@material-ui/core/colors/red";
+import yellow from "@material-ui/core/colors/yellow";
+import { FC, memo } from "react";
+
+export const TERRAFORM_PLAN_SUMMARY_METADATA_KEY = "terraform-plan-summary";
+
+const useStyles = makeStyles((theme) => ({
+  root: {
+    fontFamily: theme.typography.fontFamilyMono,
+    padding: theme.spacing(1, 2),
+    wordBreak: "break-all",
+    whiteSpace: "pre-wrap",
+  },
+  create: {
+    color: green[800],
+    backgroundColor: green[50],
+  },
+  delete: {
+    color: red[800],
+    backgroundColor: red[50],
+  },
+  update: {
+    color: yellow[900],
+    backgroundColor: yellow[50],
+  },
+  attribute: {
+    marginLeft: theme.spacing(4),
+    color: theme.palette.text.secondary,
+  },
+}));
+
+interface AttributeChange {
+  name: string;
+  before?: string;
+  after?: string;
+}
+
+interface ResourceChange {
+  address: string;
+  action: string;
+  attributes?: AttributeChange[];
+}
+
+interface PlanSummary {
+  resources: ResourceChange[];
+}
+
+const ACTION_SYMBOL: Record<string, string> = {
+  create: "+",
+  update: "~",
+  delete: "-",
+  replace: "-/+",
+};
+
+const ACTION_TEXT: Record<string, string> = {
+  create: "created",
+  update: "updated in-place",
+  delete: "destroyed",
+  replace: "replaced",
+};
+
+const ACTION_CLASS: Record<string, "create" | "update" | "delete"> = {
+  create: "create",
+  update: "update",
+  delete: "delete",
+  replace: "delete",
+};
+
+const parsePlanSummary = (value: string): PlanSummary | null => {
+  try {
+    return JSON.parse(value) as PlanSummary;
+  } catch {
+    return null;
+  }
+};
+
+const formatAttribute = ({ name, before, after }: AttributeChange): string => {
+  if (!before) {
+    return `${name}: ${after}`;
+  }
+  if (!after) {
+    return `${name}: ${before} -> null`;
+  }
+  return `${name}: ${before} -> ${after}`;
+};
+
+export interface TerraformPlanProps {
+  metadata: [string, string][];
+}
+
+export const TerraformPlan: FC<TerraformPlanProps> = memo(
+  function TerraformPlan({ metadata }) {
+    const classes = useStyles();
+    const value = metadata.find(
+      ([key]) => key === TERRAFORM_PLAN_SUMMARY_METADATA_KEY
+    )?.[1];
+    const summary = value ? parsePlanSummary(value) : null;
+
+    if (!summary || summary.resources.length === 0) {
+      return null;
+    }
+
+    const count = (...actions: string[]): number =>
+      summary.resources.filter((r) => actions.includes(r.action)).length;
+
+    return (
+      <div className={classes.root} data-testid="terraform-plan">
+        <Typography variant="subtitle2">
+          {`Plan: ${count("create", "replace")} to add, ${count(
+            "update"
+          )} to change, ${count("delete", "replace")} to destroy.`}
+        </Typography>
+        {summary.resources.map((r) => (
+          <Box key={r.address} mt={1}>
+            <Typography variant="body2">
+              <span className={classes[ACTION_CLASS[r.action]]}>
+                {`${ACTION_SYMBOL[r.action] ?? " "} ${r.address}`}
+              </span>
+              {` will be ${ACTION_TEXT[r.action] ?? r.action}`}
+            </Typography>
+            {r.attributes?.map((a) => (
+              <Typography
+                key={a.name}
+                variant="body2"
+                className={classes.attribute}
+              >
+                {formatAttribute(a)}
+              </Typography>
+            ))}
+          </Box>
+        ))}
+      </div>
+    );
+  }
+);
@@ -0,0 +1,20 @@
+import { render, screen } from "~~/test-utils";
+import { StageStatus } from "~/modules/deployments";
+import { PipelineStage } from ".";
+
+it("should show the estimated difference of monthly cost", () => {
+  render(
+    <PipelineStage
+      id="stage-1"
+      name="TERRAFORM_COST_ESTIMATION"
+      status={StageStatus.STAGE_SUCCESS}
+      active={false}
+      isDeploymentRunning={false}
+      metadata={[["monthly-cost-diff", "+23.45 USD"]]}
+      onClick={() => null}
+    />,
+    {}
+  );
+
+  expect(screen.getByText("Monthly cost +23.45 USD")).toBeInTheDocument();
+});
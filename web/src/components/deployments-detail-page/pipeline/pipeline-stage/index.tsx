@@ -74,6 +74,11 @@ const trafficPercentageMetaKey: Record<string, string> = {
   [TRAFFIC_PERCENTAGE_META_KEY.PROMOTE]: "Promoted",
 };
 
+const MONTHLY_COST_DIFF_META_KEY = "monthly-cost-diff";
+
+const findMonthlyCostDiff = (meta: [string, string][]): string | undefined =>
+  meta.find(([key]) => key === MONTHLY_COST_DIFF_META_KEY)?.[1];
+
 const createTrafficPercentageText = (meta: [string, string][]): string => {
   const map = meta.reduce<Record<string, string>>((prev, [key, value]) => {
     if (trafficPercentageMetaKey[key]) {
@@ -131,6 +136,7 @@ export const PipelineStage: FC<PipelineStageProps> = memo(
     }
 
     const trafficPercentage = createTrafficPercentageText(metadata);
+    const monthlyCostDiff = findMonthlyCostDiff(metadata);
 
     return (
       <Paper
@@ -171,6 +177,13 @@ export const PipelineStage: FC<PipelineStageProps> = memo(
             </Typography>
           </div>
         )}
+        {monthlyCostDiff && (
+          <div className={classes.metadata}>
+            <Typography variant="body2" color="inherit">
+              {`Monthly cost ${monthlyCostDiff}`}
+            </Typography>
+          </div>
+        )}
       </Paper>
     );
   }
@@ -6,6 +6,19 @@ export const APPLICATION_KIND_TEXT: Record<ApplicationKind, string> = {
   [ApplicationKind.LAMBDA]: "LAMBDA",
   [ApplicationKind.CLOUDRUN]: "CLOUDRUN",
   [ApplicationKind.ECS]: "ECS",
+  [ApplicationKind.APPRUNNER]: "APPRUNNER",
+  [ApplicationKind.CLOUDFORMATION]: "CLOUDFORMATION",
+  [ApplicationKind.NOMAD]: "NOMAD",
+  [ApplicationKind.CONTAINERAPPS]: "CONTAINERAPPS",
+  [ApplicationKind.APPENGINE]: "APPENGINE",
+  [ApplicationKind.STEPFUNCTIONS]: "STEPFUNCTIONS",
+  [ApplicationKind.EC2ASG]: "EC2ASG",
+  [ApplicationKind.GCEMIG]: "GCEMIG",
+  [ApplicationKind.AZUREFUNCTIONS]: "AZUREFUNCTIONS",
+  [ApplicationKind.STATICSITE]: "STATICSITE",
+  [ApplicationKind.DOCKERCOMPOSE]: "DOCKERCOMPOSE",
+  [ApplicationKind.KNATIVE]: "KNATIVE",
+  [ApplicationKind.FLYIO]: "FLYIO",
 };
 
 export const APPLICATION_KIND_BY_NAME: Record<string, ApplicationKind> = {
@@ -15,4 +28,17 @@ export const APPLICATION_KIND_BY_NAME: Record<string, ApplicationKind> = {
   [APPLICATION_KIND_TEXT[ApplicationKind.LAMBDA]]: ApplicationKind.LAMBDA,
   [APPLICATION_KIND_TEXT[ApplicationKind.CLOUDRUN]]: ApplicationKind.CLOUDRUN,
   [APPLICATION_KIND_TEXT[ApplicationKind.ECS]]: ApplicationKind.ECS,
+  [APPLICATION_KIND_TEXT[ApplicationKind.APPRUNNER]]: ApplicationKind.APPRUNNER,
+  [APPLICATION_KIND_TEXT[ApplicationKind.CLOUDFORMATION]]: ApplicationKind.CLOUDFORMATION,
+  [APPLICATION_KIND_TEXT[ApplicationKind.NOMAD]]: ApplicationKind.NOMAD,
+  [APPLICATION_KIND_TEXT[ApplicationKind.CONTAINERAPPS]]: ApplicationKind.CONTAINERAPPS,
+  [APPLICATION_KIND_TEXT[ApplicationKind.APPENGINE]]: ApplicationKind.APPENGINE,
+  [APPLICATION_KIND_TEXT[ApplicationKind.STEPFUNCTIONS]]: ApplicationKind.STEPFUNCTIONS,
+  [APPLICATION_KIND_TEXT[ApplicationKind.EC2ASG]]: ApplicationKind.EC2ASG,
+  [APPLICATION_KIND_TEXT[ApplicationKind.GCEMIG]]: ApplicationKind.GCEMIG,
+  [APPLICATION_KIND_TEXT[ApplicationKind.AZUREFUNCTIONS]]: ApplicationKind.AZUREFUNCTIONS,
+  [APPLICATION_KIND_TEXT[ApplicationKind.STATICSITE]]: ApplicationKind.STATICSITE,
+  [APPLICATION_KIND_TEXT[ApplicationKind.DOCKERCOMPOSE]]: ApplicationKind.DOCKERCOMPOSE,
+  [APPLICATION_KIND_TEXT[ApplicationKind.KNATIVE]]: ApplicationKind.KNATIVE,
+  [APPLICATION_KIND_TEXT[ApplicationKind.FLYIO]]: ApplicationKind.FLYIO,
 };
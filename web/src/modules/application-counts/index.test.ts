@@ -28,14 +28,54 @@ describe("applicationCountsSlice reducer", () => {
       })
     ).toEqual({
       counts: {
+        APPENGINE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        APPRUNNER: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        AZUREFUNCTIONS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        CLOUDFORMATION: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         CLOUDRUN: {
           DISABLED: 0,
           ENABLED: 0,
         },
+        CONTAINERAPPS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        EC2ASG: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        DOCKERCOMPOSE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         ECS: {
           DISABLED: 0,
           ENABLED: 0,
         },
+        FLYIO: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        GCEMIG: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        KNATIVE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         KUBERNETES: {
           DISABLED: 0,
           ENABLED: 0,
@@ -44,6 +84,18 @@ describe("applicationCountsSlice reducer", () => {
           DISABLED: 0,
           ENABLED: 0,
         },
+        NOMAD: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        STATICSITE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        STEPFUNCTIONS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         TERRAFORM: {
           DISABLED: 0,
           ENABLED: 0,
@@ -62,14 +114,54 @@ test("fetchApplicationCount", async () => {
   expect(store.getState().applicationCounts).toEqual(
     expect.objectContaining({
       counts: {
+        APPENGINE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        APPRUNNER: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        AZUREFUNCTIONS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        CLOUDFORMATION: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         CLOUDRUN: {
           DISABLED: 0,
           ENABLED: 0,
         },
+        CONTAINERAPPS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        EC2ASG: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        DOCKERCOMPOSE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         ECS: {
           DISABLED: 0,
           ENABLED: 0,
         },
+        FLYIO: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        GCEMIG: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        KNATIVE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         KUBERNETES: {
           DISABLED: 8,
           ENABLED: 123,
@@ -78,6 +170,18 @@ test("fetchApplicationCount", async () => {
           DISABLED: 0,
           ENABLED: 0,
         },
+        NOMAD: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        STATICSITE: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
+        STEPFUNCTIONS: {
+          DISABLED: 0,
+          ENABLED: 0,
+        },
         TERRAFORM: {
           DISABLED: 2,
           ENABLED: 75,
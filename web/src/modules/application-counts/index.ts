@@ -35,6 +35,19 @@ const createInitialCounts = (): Record<string, Record<string, number>> => ({
   [APPLICATION_KIND_TEXT[ApplicationKind.LAMBDA]]: createInitialCount(),
   [APPLICATION_KIND_TEXT[ApplicationKind.CLOUDRUN]]: createInitialCount(),
   [APPLICATION_KIND_TEXT[ApplicationKind.ECS]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.APPRUNNER]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.CLOUDFORMATION]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.NOMAD]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.CONTAINERAPPS]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.APPENGINE]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.STEPFUNCTIONS]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.EC2ASG]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.GCEMIG]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.AZUREFUNCTIONS]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.STATICSITE]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.DOCKERCOMPOSE]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.KNATIVE]]: createInitialCount(),
+  [APPLICATION_KIND_TEXT[ApplicationKind.FLYIO]]: createInitialCount(),
 });
 
 const initialState: ApplicationCounts = {
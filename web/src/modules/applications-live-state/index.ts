@@ -86,4 +86,9 @@ export {
   ApplicationLiveStateSnapshot,
   KubernetesResourceState,
   CloudRunResourceState,
+  ECSResourceState,
+  NomadResourceState,
+  ContainerAppsResourceState,
+  AppEngineResourceState,
+  FlyIOResourceState,
 } from "pipecd/web/model/application_live_state_pb";
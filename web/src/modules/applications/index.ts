@@ -85,9 +85,12 @@ export const fetchApplication = createAsyncThunk<
 
 export const syncApplication = createAsyncThunk<
   void,
-  { applicationId: string; syncStrategy: SyncStrategy }
+  { applicationId: string; syncStrategy: SyncStrategy; commitHash?: string }
 >(`${MODULE_NAME}/sync`, async (values, thunkAPI) => {
-  const { commandId } = await applicationsAPI.syncApplication(values);
+  const { commandId } = await applicationsAPI.syncApplication({
+    commitHash: "",
+    ...values,
+  });
 
   await thunkAPI.dispatch(fetchCommand(commandId));
 });